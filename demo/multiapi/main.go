@@ -0,0 +1,38 @@
+//go:generate go run ../.. -o internal/gl -gl 3.3 -profile core
+//go:generate go run ../.. -api egl -o internal/egl
+//go:generate go run ../.. -api glx -o internal/glx
+
+// +build demo
+
+// Command multiapi imports the gl, egl and glx packages generated by gogl in
+// the same program, which checks that they can be linked together. It prints
+// the versions supported by the packages and, if an X11 display is available,
+// the GLX version of the display.
+//
+package main
+
+import (
+	"log"
+
+	"github.com/db47h/gogl/demo/multiapi/internal/egl"
+	"github.com/db47h/gogl/demo/multiapi/internal/gl"
+	"github.com/db47h/gogl/demo/multiapi/internal/glx"
+)
+
+func main() {
+	log.Printf("gl %v, egl %v, glx %v", gl.APIVersion(), egl.APIVersion(), glx.APIVersion())
+
+	egl.InitGo(nil)
+	glx.InitGo(nil)
+	dpy := glx.OpenDisplay("")
+	if dpy == nil {
+		log.Print("glx: no X11 display")
+		return
+	}
+	defer glx.CloseDisplay(dpy)
+	if err := glx.InitDisplay(dpy, glx.DefaultScreen(dpy)); err != nil {
+		log.Printf("glx: %v", err)
+		return
+	}
+	log.Printf("glx runtime version %v", glx.RuntimeVersion())
+}
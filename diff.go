@@ -0,0 +1,99 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/db47h/gogl/generator"
+)
+
+// runDiff implements the diff command.
+//
+func runDiff(args []string) {
+	var (
+		cfg     generator.Config
+		asJSON  bool
+		verbose bool
+	)
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	fs.BoolVar(&asJSON, "json", false, "JSON output")
+	fs.BoolVar(&cfg.ForceUpdate, "f", false, "force update of the registry file")
+	fs.StringVar(&cfg.RegistryFile, "registry", "", "read the registry from `file` instead of the cache or network")
+	fs.BoolVar(&verbose, "v", false, "verbose output")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: gogl diff [flags] from to\n\n"+
+			"Lists the commands and enums added and removed between two targets of the\n"+
+			"form api:version[:profile], e.g. gl:3.3:core or gles2:3.0.\n\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	from, err := generator.ParseTarget(fs.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	to, err := generator.ParseTarget(fs.Arg(1))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if verbose {
+		cfg.Logger = log.New(os.Stderr, "", log.LstdFlags)
+	}
+
+	ch, err := generator.Diff(context.Background(), cfg, from, to)
+	if err != nil {
+		log.Fatal(err)
+	}
+	w := bufio.NewWriter(os.Stdout)
+	if asJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "\t")
+		err = enc.Encode(ch)
+	} else {
+		writeChanges(w, ch)
+	}
+	if ferr := w.Flush(); err == nil {
+		err = ferr
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeChanges(w *bufio.Writer, ch *generator.Changes) {
+	fmt.Fprintf(w, "%s -> %s\n", ch.From, ch.To)
+	n := 0
+	for _, s := range []struct {
+		title string
+		names []string
+	}{
+		{"Added commands", ch.AddedCommands},
+		{"Removed commands", ch.RemovedCommands},
+		{"Added enums", ch.AddedEnums},
+		{"Removed enums", ch.RemovedEnums},
+	} {
+		if len(s.names) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\n%s (%d):\n", s.title, len(s.names))
+		for _, name := range s.names {
+			fmt.Fprintf(w, "    %s\n", name)
+		}
+		n += len(s.names)
+	}
+	if n == 0 {
+		fmt.Fprintln(w, "\nNo changes.")
+	}
+}
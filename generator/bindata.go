@@ -0,0 +1,722 @@
+// Code generated by go-bindata.
+// sources:
+// registry/egl.xml
+// registry/gl.xml
+// registry/glx.xml
+// templates/cmdbuf.tmpl
+// templates/debug.tmpl
+// templates/debug_disabled.tmpl
+// templates/debug_enabled.tmpl
+// templates/debugcb.tmpl
+// templates/debugcb_api.tmpl
+// templates/egl.tmpl
+// templates/eglheader.tmpl
+// templates/enumnames.tmpl
+// templates/gl.tmpl
+// templates/gles2.tmpl
+// templates/glx.tmpl
+// templates/glxheader.tmpl
+// templates/header.tmpl
+// templates/queries.tmpl
+// templates/strings.tmpl
+// templates/trace.tmpl
+// templates/trace_disabled.tmpl
+// templates/trace_enabled.tmpl
+// DO NOT EDIT!
+
+package generator
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _registryEglXml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x69\x73\xdb\x38\xf2\xf7\xfb\xfd\x14\xaa\x3c\x55\x5b\x33\xa9\x4c\xc2\x43\x92\xe5\x67\x3d\xd9\x02\x49\x50\xe6\x86\x22\x39\x3c\x64\x7b\xde\xb0\x64\x99\xb1\xb9\x23\x53\x5e\x8a\xca\xb1\x9f\xfe\x5f\xe0\x21\x0b\x12\x88\x06\xe5\x2b\x9e\x9d\xd9\xaa\xad\x44\xe1\xef\x07\xa0\xd1\xe8\x6e\x34\x9a\xe0\xc9\x3f\xbf\xdd\x2e\x7a\x5f\x92\x7c\x95\x2e\xb3\x5f\xdf\xc8\xef\xa5\x37\xbd\x24\x9b\x2f\xaf\xd2\xec\xfa\xd7\x37\x51\x68\xfe\x32\x7a\xf3\xcf\x8f\x7f\x3b\xc9\x93\xeb\x74\x55\xe4\xdf\x3f\xfe\xad\xd7\xeb\xf5\x4e\xe6\xcb\xdb\xdb\x24\x2b\x3e\x06\xd9\xec\x6e\x75\xb3\x2c\x7a\xcb\xcf\xbd\xe2\x26\xe9\xe1\xb1\xdd\x6b\x1e\xed\xe5\xc9\xe5\x3a\x5d\x14\xbd\xcf\xf9\xf2\xb6\xfc\xd7\x4f\x37\xf9\x32\x5b\xae\x7a\x37\xc9\xec\x2a\xc9\x57\x04\x94\x5c\x2f\x92\x6f\xc5\xfb\x9b\xa6\x0b\x3d\x45\x52\x64\x59\x91\xa5\xde\x4f\x8b\xf4\xf2\x7a\xf1\x25\xbb\xea\xc9\xef\x87\xef\xa5\xff\x4f\xb8\x3f\x24\xd7\x8b\xf7\x37\xef\x9a\x3f\x96\xc8\x9f\xdf\xf7\xfc\xe4\x6e\x31\x9b\x27\xbd\xaf\x69\x71\x53\x36\xb4\xbe\x5b\x15\x79\x32\xbb\xed\x91\xe7\xc9\x00\xbf\xde\x24\x59\xef\x6e\xb9\x5a\xa5\x97\x8b\xe4\xfd\xc9\x87\xa6\xff\xd5\x68\x8a\xef\x77\xc9\xaa\x1a\xd9\xe6\xef\xbd\x6c\x76\x9b\xfc\xfa\xe6\x8f\x9b\xfc\x6e\x31\x2b\x3e\x2f\xf3\xdb\x37\x1f\xff\x5f\x9a\xcd\x17\xeb\xab\xa4\xf7\xf7\x45\xf1\x8f\x4f\xa7\xfe\x87\xad\x7f\x7d\x7f\xf3\xf7\xeb\xe2\x1f\x27\x1f\x08\x17\x9b\x2a\xb9\x5e\x6c\xa8\x7a\x79\xf2\x9f\x75\x9a\x27\x2b\x5e\x0b\xf5\x28\xc5\x5b\xf8\xa3\x92\x6f\xbc\x2e\xd2\xdb\x24\xce\x66\xd9\x72\x95\xcc\x97\xd9\xd5\x2a\x2e\xb6\x5b\xdc\xee\xc8\x07\x3e\xd3\xea\xd1\x98\xd6\x69\x56\x0c\xfb\x87\x77\x64\x95\xfe\x37\xe9\x8c\xc6\x63\xdb\x99\x15\xe9\x97\xc4\x48\x57\x77\x8b\xd9\xf7\xf0\xfb\x5d\x72\x28\x85\x97\x7e\xbb\x9d\xdd\x3d\x84\xe1\x2c\xcd\xae\x96\x5f\x0f\x63\x48\xb3\xae\x63\x7f\xe8\xc0\x1f\x38\xea\x07\x0e\x79\x55\xe4\xeb\x79\xd1\x43\xa7\xb3\xfc\xea\xeb\x2c\x4f\xb4\xf5\xe7\xcf\x49\x0e\x3c\xfd\x75\x11\x5f\x0a\x3e\x77\x55\x29\x04\xfc\x60\x9e\xac\x96\xeb\x7c\x9e\xec\x3d\xf9\x91\xf0\x5e\x25\x9f\x7b\xeb\x6c\x95\x5e\x67\xc9\x55\x2f\xcd\x8a\xde\x09\xc1\x7f\xc4\x63\x5b\x5b\x2e\x17\xc9\x2c\x3b\xf9\x50\xfe\xc0\x5e\xb6\x1b\x8a\x2f\xcb\xf4\xaa\xf7\x76\x83\xad\x27\xed\x20\xac\xbe\xcc\x3e\xa7\xd7\x07\x41\x83\x75\xfe\x79\x36\x4f\x0e\x6d\xb6\x48\xbe\x15\x1d\xb0\x3f\xd5\xe0\x38\x4e\xae\x17\x93\xf5\xaa\xd0\x67\xab\x22\x5c\x7a\xf9\xf2\x2e\xc9\xcd\x75\x36\x2f\xd2\x65\xe6\x2d\xd3\xac\x48\x72\xa2\x40\x35\xf5\xcf\x3f\x11\xf4\xcf\x40\x0b\xec\x39\x49\xb2\xf5\xed\x61\xa3\x5b\xa4\x49\x56\x54\x3a\x78\x10\x41\xf0\x3d\x9b\x8b\x01\xd3\xac\xb8\x2b\xf2\x78\xab\xd7\xa8\x28\xf2\xf4\x52\x0c\xcd\xf1\x00\xf7\x84\x61\x7a\x7b\xd8\x24\x5b\xb7\xb3\xeb\xc3\x90\x64\xfc\x9f\x4e\xfd\x87\x89\x40\x98\x60\xa7\x71\x7b\x76\x99\x2c\x0e\x05\xbb\x97\xff\x4e\xe6\x45\x37\xf4\x4f\x27\xb3\x3b\xa2\x30\xf9\xf7\x0f\x1f\x7b\x5b\x5c\x06\xd6\xa2\xb1\xe7\xbb\xfa\x3d\xdd\xcf\x3f\xd5\x7a\xd9\x4b\xf2\x7c\x99\xbf\x9b\x2f\xb3\x55\xd1\x9b\xdf\xcc\xf2\xde\x5b\x12\xa4\xcc\xb2\xab\x77\x95\xf9\xef\xdd\x26\xab\xd5\xec\x3a\x21\x6b\xe1\xdd\xd6\xa8\x7a\xc5\x4d\x9e\xcc\xae\xca\xbf\x52\xbf\x2f\xcb\x9e\x97\x7f\xdd\xa2\x7d\xdb\xf0\xfc\xfc\x58\xaa\x74\xa8\x64\x4b\x6d\x3a\x14\x1c\x94\xf1\x9d\x30\x7a\x37\x0a\xb9\x1f\x41\xf5\x8b\x30\x11\x65\x4f\x2a\x1f\x67\xa6\x8b\xc4\x48\x56\xf3\x3c\xbd\x2b\x96\x79\xe7\x2e\xd5\x91\xcd\x3d\x2b\xf9\x7b\x8a\x1c\xc3\x77\x2d\xe3\x00\x7b\x4a\xac\x4d\x52\x68\x8b\xe5\x25\x31\xa2\x34\xcf\xcf\xbd\x9f\x2a\x4d\x28\x11\x6f\xff\x48\xbe\xbf\xeb\xed\xb4\xd8\xfb\x23\xf9\x1e\xa4\xff\x4d\xde\xf5\xb6\x9f\xfc\x32\x5b\xac\x93\xfd\x67\xcb\x9f\xc9\xd3\x90\x32\xed\x02\xb7\x3a\x3b\x7e\x94\xce\x3e\x4e\x37\x37\x73\xc2\xd3\xf9\x6c\x95\xcc\x57\x74\x57\xbb\xe9\xae\x91\x7c\x49\xe7\x09\x3e\x0f\x0f\x42\xbb\xeb\xe2\x6e\x5d\xd8\xb3\xef\x49\xfe\x30\x0a\x6f\x99\x17\x87\x32\x10\x7b\xee\x4c\xbb\x29\x3a\x68\x48\x1e\x95\xb0\x5a\xd7\x6c\xca\x93\x0f\x5b\x3b\xbe\x13\x12\x15\xac\xca\x00\x71\x75\x37\x9b\x57\xe1\xf6\x9b\xde\x97\x24\xbb\x5a\xe6\xbf\xbe\xf9\x74\xea\xbf\xd9\xea\x08\x79\xb8\xd2\xa7\x5f\xdf\x48\xdf\x54\x49\x91\xdf\xdc\x07\xe9\x31\xb2\xbd\x53\x14\x07\xd6\xef\xf8\xcd\x07\x0e\x48\x52\xb6\x41\x1a\x32\x62\xa4\xeb\x38\x08\xf8\x20\x75\x0f\x64\xdb\xae\xce\xc7\xf4\xf7\x30\x61\xe8\x5b\x5a\x14\x02\x1d\x1c\xec\xe2\x74\xd7\x31\xad\x31\x1f\x34\x64\x80\x42\x7c\x1e\xf2\x51\x47\x7b\xa8\xc8\xf7\xb1\x13\xc6\x41\xe4\x9b\x48\x07\x3a\x3a\xda\x45\x1b\x56\xe0\xd9\xe8\x82\x8f\x3a\xde\x45\x4d\x50\xa8\x9f\xf2\x31\x68\x17\xe3\xa0\xd0\x9a\xe2\xd8\xb3\xce\x27\xc8\xe3\x63\xb5\x16\xec\x99\xe5\x18\xee\x19\x1f\xab\xef\x62\x3d\xe4\xa3\x09\x0e\xb1\xcf\xc7\x19\xbb\x38\x11\x79\x2a\xb4\x66\xda\x11\x86\xb5\x59\x91\x28\x4c\x64\x9a\xd8\x17\x40\x51\xf3\x5e\xa9\x57\xac\xa3\x29\x46\x7c\x7d\x51\x46\x0c\x9c\x65\x70\x31\x03\x4a\xfe\xba\xeb\xe3\x66\x02\xb0\x33\xb6\x1c\xa0\xa3\xd4\x5a\x30\xb0\x17\x9e\xf2\x47\x47\xa4\xa0\xa3\x20\x24\x91\x5d\x9a\x15\xef\x7e\x91\x7f\xa6\x18\x5c\x27\x8c\x75\xe4\xf3\x5b\x1d\x50\x2a\x6a\xf8\x88\xaf\x25\x03\xaa\x93\xf8\x3c\xc4\x4e\x60\xb9\x0e\xc7\xa2\x6c\x3f\x6f\x22\x3b\xe0\x77\x47\xa1\x8c\xcf\xd8\xc7\xd8\x81\xa7\x78\x40\x19\x84\x53\x6c\x8d\x4f\xf9\x73\x3b\xa0\xe6\xd6\x46\xfe\x18\x07\x61\xec\x55\x2a\xc5\x45\x2a\x94\xb8\x6c\x3c\xc5\x36\xff\x79\x6a\x35\x4f\xd0\x79\xd3\x8a\x48\x37\x15\xad\x0d\xec\x59\xe7\xd8\xe6\x9b\x71\x45\x6f\x03\x9f\x59\x46\xc8\xb7\x41\x0a\xb5\xa6\x6b\x15\xf6\xb1\x63\x60\x1f\x69\x36\x30\x83\x98\x81\x9d\x5a\x41\x84\x6c\x68\xf9\x28\x66\x3b\x34\xbc\xf0\xf8\xed\xaa\xd4\x9c\x3a\x2e\xb0\xda\x06\x94\x3f\x75\x5c\xa7\x5c\xe2\xae\x3f\x41\x4e\x28\xe4\x84\x76\xf0\x61\x6c\x39\x56\x68\x21\xdb\xfa\x1d\x1b\x42\x4b\xb6\xce\x64\xbc\x93\xa8\x55\xeb\xb8\xa0\x37\xdb\x26\xa9\x33\x38\xfb\x24\x90\x7b\xda\x26\xa9\xf3\x31\xfb\x24\xb0\x25\x97\x76\x04\xd1\xe8\x98\x66\xf1\x54\x5b\xda\x89\x4c\x2a\xd7\x06\x80\x54\x69\x40\x2d\x26\x1f\x23\x40\x9d\xa8\xa0\xc4\xc7\x06\x6c\x49\x54\x6a\x30\x01\x9a\x78\x36\xe6\x2f\x33\x95\x1a\x48\x85\xa8\x7d\x13\x1f\x38\xa0\xbc\x59\x60\xbb\x67\x22\x6a\xa7\x50\xa6\x2e\x08\xb1\xa3\x5b\x36\x3c\x2e\x89\x6e\x2c\x82\xa3\x40\x95\x32\xc4\xb5\x22\x08\xac\x42\xca\x3f\x84\x3e\x72\x02\x0f\x95\x51\x56\xe9\xe3\xa7\xc8\x8e\x00\x82\x61\x1b\x41\xe5\x0c\x04\x18\x8e\xda\x18\x88\x0a\xc0\xf8\x81\xd2\x8a\x1f\x6b\xfc\x96\xfb\x6d\x48\xae\xdc\x28\x9d\x0b\x7d\xa8\x7b\xd4\xc4\x4c\xb1\x63\xb8\xfc\x00\x6d\x40\xf5\x6a\x8a\x7d\xe2\xb0\xf9\x08\x4a\x82\x90\xbb\x90\x76\xa2\xff\x2a\xd6\x04\x17\xf3\x88\x02\x69\x48\xff\x54\x2f\x1b\x2e\x4a\xa5\xfc\xaf\x66\x39\x46\x1c\xba\x31\x31\x97\x91\x8f\xe1\x09\x42\x00\x1a\x71\xe1\x12\xe5\xda\x6a\x33\x1d\xdb\x6e\xc0\x1f\xa9\x4a\xbb\x71\xcb\x89\x83\x33\xe4\xc5\x96\x13\x62\x7f\x8a\xf8\xf1\x83\xba\xe7\xc5\xc5\xb1\x23\x4a\x91\x27\x96\x37\x41\x5e\x33\x58\x3e\x50\x65\x00\xe1\x58\x67\x40\xf5\xd5\x71\x85\xda\x1a\x50\x3e\xbf\x46\xc4\x0a\xdf\xb2\x8f\x24\x16\xa8\xf4\xde\xfc\xa9\x18\x18\x2c\x20\xa4\x36\x03\xdc\x82\xe2\xab\xcb\x48\x66\xc1\x42\x12\x6d\xf2\x7b\x39\xa2\x42\x99\x6a\xaf\x2f\x30\xb8\x91\xd6\x06\x8b\x1d\xd7\xf1\xa0\x49\xd7\x5b\xd1\x10\x54\xa5\xe4\x53\xf5\x77\x82\x82\x4f\xb0\x5f\x3a\xa6\x8d\x40\x1d\xdc\xfa\x38\xc0\xfe\x94\x13\x46\x95\xd0\x01\x03\x6a\xe0\x20\xf4\xdd\x0b\x00\x3a\xa2\xb4\x40\xb7\x2d\xe2\x9f\x90\x67\xf1\x3d\xe2\x88\xb2\x8a\xba\x6b\xbb\x7e\xe0\x41\xfb\xdc\xd1\x31\x1b\x14\xaf\x20\xa5\x1b\xa1\x16\xa4\x6d\x39\x18\xf1\x4d\xbe\x4a\x2d\xaa\xb2\xab\x8d\x84\x40\x0f\x7e\xbc\x33\xcc\xca\xc8\xd5\x42\xe2\xfb\x31\x69\x27\xce\xa8\x63\xd0\x38\xd0\x91\x6d\x39\xfc\xc0\xe6\x98\x42\x9e\xba\xbe\xf5\xbb\xeb\x84\xc8\x8e\x7d\x1c\xb8\x76\x14\x42\x3e\x6b\x44\x0d\xd9\x8e\x26\x96\x83\x1c\xbd\x09\xc5\xb8\x50\xd5\x60\x43\x01\xed\xdd\x0d\x7d\x5d\x0f\x3b\x64\x4f\x1c\x74\x0c\x7e\x09\x6e\x3a\x06\xfd\x25\x92\xd8\x8d\x21\xcf\xe2\xe3\xf6\x3a\x39\x1d\x83\xa0\xe3\x21\x03\x64\x4d\xd0\x98\x27\x10\x55\x3a\xa6\x06\x56\x6e\x52\x63\x14\x78\x58\x0f\x63\x1f\x85\x96\xcb\x05\xf7\xa9\x01\xde\xef\x36\x61\x95\x1d\x51\xbd\xad\x90\x22\x13\x3f\xa2\xec\x96\x3f\xd6\x84\x40\x94\xdd\x09\x2c\x67\x6c\x63\x11\xdc\x31\xe5\x54\x4b\x2f\xae\xe1\x53\x34\xb5\x5c\x5f\x68\x93\xc6\xc8\xf0\x44\xce\x27\xc7\x3d\xe3\xaf\x8b\x63\x6a\xfa\xa7\xd8\x0f\x2d\x5d\x7c\x55\xf5\xa9\x09\xbd\xdf\x21\xf3\x9b\x1c\x71\x2c\x88\x48\xfc\xd9\xa7\xfa\x5c\xa6\x4c\x85\x93\xa0\xbb\xd1\xe8\x66\xa1\x28\xe0\x0a\xa3\xdd\xee\x74\x4c\x39\xc1\x0e\x9e\x77\x3a\x7e\xa0\xf3\x9d\x8e\xbb\xf8\x5f\x49\xea\x4b\x00\x1a\x1e\x3a\x65\xf4\xa7\xe3\x2d\x7f\xd3\xc1\xbd\x4d\xc7\x0f\xf0\x70\xd3\x71\x27\x27\x27\xed\xa4\x83\x59\x70\xee\xb0\xb7\xd7\xd6\x5e\x09\xd3\x4e\x2a\xc4\xc0\x26\x8a\xec\x50\x20\xe7\x2f\x29\xb4\x0b\x9c\x44\x76\x68\xd5\x59\x81\xd2\x93\x4d\x71\xac\xb9\xe7\xe0\x84\x1c\x1f\x03\x2c\x7c\x34\x02\xd0\xcd\x88\xf8\x2c\x1a\xc4\xa2\xb9\xe7\x5c\x06\xa4\x30\x96\x22\xdf\xf7\x48\x3b\x47\x2d\x35\x88\x2f\x2f\xa9\x2f\x49\xad\xd6\xf5\x3e\x9e\x84\xa5\xce\xb4\x5c\x13\xf4\x2f\xd7\x17\x32\x5c\xa6\xc6\xc4\x5b\x8e\x28\xde\x60\xe1\x6b\x11\x78\xbe\x6b\x5a\x36\x2e\x23\x6b\x1e\x8b\xac\xf1\x58\x88\x2c\x88\x49\x0a\x2d\xd3\xd2\x89\x4f\x76\xe2\x20\xf4\x51\x88\xc7\x3c\xb5\x56\x65\x8d\xf2\x95\x8e\xcb\x20\xe2\xe3\xe9\xf8\xcc\x0d\xf0\x7d\xcf\x9c\x8a\x8c\x83\x27\x91\xe5\x6e\xbc\xb5\x33\xb0\xf2\xb4\xa5\x91\x11\xa0\x2e\x25\x99\xa4\x70\xd9\x26\x1e\x0a\x2d\xcd\xb2\xad\xf0\x42\x90\x56\x95\x35\x89\x43\x59\x16\xe5\xf0\xe1\xbc\xf1\x99\xae\x7f\x86\x7c\x63\xd3\x33\x7e\x1a\x5e\xd6\x78\xa3\xf3\x5d\x2d\x0a\x42\xf8\x30\x98\x48\x69\xd7\xbd\xd4\x14\x38\x50\x21\x71\x48\xc7\x94\x57\xd3\xed\x18\x4f\x49\x08\x70\x8a\x1c\x03\x38\x44\x30\x29\x6d\x0b\x2e\x1c\x7d\x03\xe7\xe3\xcc\x56\x5c\x29\x38\x1b\x03\x07\xd2\x26\x35\xd6\xb2\x61\xcf\x27\x46\x44\x77\x27\x13\xe4\x18\x81\x20\x0d\xe5\x4f\x4b\x1a\x30\x8a\x35\xa9\xd9\x2f\x31\x41\x88\xc2\x88\xbf\x2b\x35\x29\x93\x55\xa2\x74\xd7\x31\x2c\x30\xb4\x33\x29\x05\x09\xac\xb1\x83\x6c\x60\xdf\x6c\x52\x21\x6c\xe4\x08\x80\x76\x17\x6d\xd9\x41\xd3\x8e\x82\xd3\x7b\x89\xf2\xd5\xc8\xdc\xf9\x6f\x9b\xcd\x74\x7d\x3c\xc5\xfe\x9b\x1e\x29\xb3\xf8\xf5\xcd\x7a\xb1\xe0\x10\xa9\x92\x49\x85\xee\xa1\x35\xc1\x6e\x14\xc6\xf8\xdc\xb3\x7c\x68\xe4\xd4\x0e\x63\x23\xe0\x38\x40\xa1\x15\x98\x16\x36\x84\xc2\x0c\x52\xcc\xc2\x38\x64\xb9\x70\xf8\xc5\x15\x26\x15\x0b\x54\x12\xc4\x0e\x10\x99\x1d\x53\x4e\x80\xca\x20\x74\x88\xe8\x28\x5c\x1c\x74\x8b\xe8\xc6\x76\xa7\x88\x4e\x95\xb4\xdd\xb6\xab\xad\x9c\xc0\xe6\x8a\xb6\x9c\xe2\x89\x44\x4d\x6f\xc1\xc1\xd9\x4e\x4d\x69\x81\xaa\x40\x93\x46\x0b\xee\x77\xd7\x34\xf9\x5e\x50\x95\x34\xb5\x05\xac\x47\x1a\x89\x0c\xbc\xd8\x73\x03\xab\x3c\x37\xe5\x47\x66\x5a\x1f\x22\x72\xf0\x18\x89\x10\x0d\x20\xa2\x4d\x8f\xb8\x01\x86\xa4\x0d\x21\xa2\x4d\x8f\x00\xa2\x23\x88\x68\xd3\xa3\xdf\xf9\x44\x23\x88\x68\xd3\x23\x3e\x91\x41\xa9\x4a\x99\x46\x11\x48\x72\x6e\x5b\x8e\xb2\x84\x75\xdf\x74\x08\x64\x64\x78\x2e\x38\x26\x35\x67\x07\xba\x61\x18\x2b\xe0\x8a\x41\x92\xb6\xfc\x03\x00\x14\xdd\x1c\x82\x34\x42\x3b\x6b\x3e\x0b\x2b\x0c\xf3\xb1\x8d\x51\x80\xef\xb7\x28\xa4\x5c\x01\xe8\x8c\x22\x1d\x1f\x09\x71\x81\x34\x23\x21\x9a\xca\x49\xf3\xc9\x84\x76\x4c\x20\x87\xc0\xae\x09\xe6\xd0\x59\x1c\xa6\x8d\xc6\x01\x8c\x35\x58\xd8\x3a\xce\x6d\x42\x7f\xb2\xeb\x82\x98\x0e\xde\x79\xc1\xc4\xf0\xee\x0b\xe6\x80\x77\x60\x00\x87\xc0\x2e\xac\xdc\xe4\x08\x2d\x2d\x70\x0b\xb6\xbf\xe1\x11\xe7\xed\x73\x78\xa9\xcd\x8f\x38\x65\x97\xbd\xe7\xc3\x07\xdf\xba\xff\x14\xa2\xe6\xed\xd8\x00\x02\x55\xd6\x54\x4e\xbf\x1c\x37\xc6\xbe\x0f\x5a\x19\x55\xa5\xb7\xc2\xae\xf6\x2f\x72\x08\x10\x9e\x92\xa2\x1d\x18\x2b\x33\xb0\xcd\x61\x12\x08\x56\x18\xe0\x66\x14\x20\x58\x65\x80\x9b\xe2\x17\x10\xdc\x67\x80\x4b\x17\x0d\x43\x07\x0c\x68\xe9\x6f\x41\xe4\x90\x85\x0c\x7d\x8c\x26\x70\xab\x54\xbc\x5d\x2d\xdd\x49\x30\x8e\x75\xdf\xaa\x4e\x09\x40\x02\xc4\x26\x10\x54\x11\x8d\x8d\x3e\x43\x3e\x68\xcd\x54\x4d\x67\x83\x2d\xc7\x74\x61\x30\xe5\xb5\x2a\xb0\x8e\x6c\xbb\xac\x42\x81\xc0\x7b\xf5\x41\xfa\xa7\xd8\xc7\x26\xf6\xc9\x9e\x4c\xc0\xdb\x08\x67\x18\x60\x2a\xce\xde\x1f\x06\xb7\xec\x2a\x41\x20\x67\x67\x09\x62\xa1\xdd\x25\x4c\x00\xee\x30\x41\x0a\xee\x2e\x13\x46\xcb\x2d\x9b\x02\x05\xb4\x6b\xc0\x6e\x13\x86\x2b\x2d\x70\x55\xa0\x69\xa3\x05\x5b\xef\x3a\x61\x02\xb5\x85\x60\x7f\x57\x75\x0e\x93\xf5\x21\xb2\xcd\xce\x4a\x80\xac\xc3\x0e\x14\x26\xeb\xb0\x0b\x85\xc9\x3a\xec\x44\x61\xb2\x11\x44\xb6\xe9\x99\x00\x99\xc4\xa8\xb4\xae\xab\x70\x79\xd8\xbd\x3d\xe9\xa7\x53\xbf\x65\x5b\x0a\xf6\x81\xbb\x2b\x06\xd0\xbb\xf1\x58\x19\x56\x34\x7e\x5a\x28\x4a\xa2\x1a\x3f\xf3\xad\x10\x77\xc2\xd3\x15\x68\xb6\xab\x7f\xea\x02\x97\xe9\xe3\x2a\xd7\x0b\xad\x09\xb2\x9b\x6d\x25\x4c\xa0\x4a\x7d\x6a\x39\x56\x47\xe4\x35\x1c\x82\xea\x54\xdb\x35\x88\x14\x3f\x0c\x86\x83\x18\x9f\x23\x5d\x80\x42\xe6\x50\x80\x60\x85\x0d\x46\xf1\x68\x34\x1a\x89\xf6\x40\xe5\x92\x80\x70\xca\x00\x95\x79\xb3\x5a\xf3\xc8\x6b\x56\xd8\x86\xdd\xb8\x4e\x59\x9d\x52\x01\xa2\x80\xa8\xf0\xa9\x25\x90\x1c\xd1\x29\x33\xa3\x59\x61\xd9\x05\xb7\x2c\x09\x85\xc1\x47\x2c\xb0\x45\x54\x00\x84\x8e\x18\x50\xd7\xb7\xc6\x16\x18\x71\x49\xfa\x31\x03\x5b\x4a\xab\x2c\xac\x16\xf4\x23\x3a\x6a\x65\xa9\x0a\xbc\x45\x79\xb4\x56\x9e\xb2\xd2\x5c\x94\x46\x6f\xa5\xa9\xea\x18\x45\x79\x8c\x56\x9e\xfb\x82\x32\x51\x2e\x6a\xb3\x6f\xbb\x67\xd8\x8f\x6d\x6c\x0a\x00\xa9\x1d\x7e\xe4\x79\x82\x40\x59\x96\x5a\x7b\x4f\x8a\xe0\x00\xbc\xbc\x53\xf6\x37\x89\xc2\xb2\x6e\x8b\x2a\xc4\x02\xcd\xe2\xb6\x6b\xa9\xee\x16\xd9\x77\x2c\xf5\x5b\x78\xfc\xee\xa8\x32\x5d\xd0\x57\xb7\x2f\xe0\x92\x64\xba\xf0\xc8\xb3\x51\x48\xcc\x63\x5c\xbf\x83\x0d\xc2\x8d\x23\x26\x7c\xac\x81\x5b\x31\xd9\x18\x31\xa1\x67\xe8\xc2\x46\x0e\x18\xde\xc9\xc6\x80\x09\x3f\x97\x65\x18\x3a\x6c\x85\x06\x7a\xb9\x22\x01\x86\xf6\x83\x4b\xa8\xed\x96\x33\x48\x18\xd6\x72\x0e\x09\x03\x79\x07\x80\x30\x1a\x3a\x04\x84\x19\xd8\xc7\xc2\x30\x8e\x32\x9a\xa5\x84\x7d\x1c\x05\xe5\x2a\xeb\x16\x28\xb5\x9c\xbf\x02\x3d\x68\xce\x5e\x9b\xff\x18\x67\xb0\x25\x83\xc0\x39\xec\xf6\x32\xaf\x2f\x6b\xd9\x5f\xe7\x60\xe6\x83\x62\x69\x6e\xe8\x60\xf0\x08\xe5\x41\x14\xda\xfc\xe9\xae\x13\x44\x13\x62\x3a\x11\x79\xbd\xea\x22\x8e\x02\x0c\xe6\x61\x14\x99\x52\x65\xcf\x77\x8d\x48\xc7\x7e\x6c\x92\x57\xa8\x61\xb0\xca\xec\x80\x20\x98\x8a\x65\xea\x21\x93\xca\x01\x01\xe8\xa0\x15\xaa\xfb\x18\x85\xb0\x4a\x2b\xf2\xb0\x9d\xc2\x75\x1c\xac\x87\x96\x03\x5a\x6c\x45\x3e\x6a\x65\xc1\x13\x2f\x04\xb3\x7e\x8a\x3c\x6a\x25\x70\xf0\x59\x2d\x49\x34\x45\x96\x2d\xb0\x68\x54\x45\x3e\x6e\xa5\x73\x6d\xa3\x3b\x1d\x6a\xa5\x33\xac\xa0\x96\x93\x88\xb0\xe9\xa0\x07\x19\xc2\x1a\x4e\x87\x39\xc8\x10\x55\x10\xcc\x54\x4c\xa4\xff\x16\x59\x3e\xde\x58\x50\x70\x85\x6c\xaf\xd7\x96\xcb\x6c\x76\x8b\x9b\x1d\x37\x2e\xd3\xe9\x06\x0e\x74\xdf\xf2\x42\x38\xa1\x28\x9b\x3a\x43\xcc\xa6\x65\xba\xb1\x8d\x9d\x71\x08\x86\xc7\xb2\x69\x30\x08\x48\x9d\x48\xec\xb8\x67\x30\x1a\xb7\xa1\x37\x82\x03\x29\xcc\x36\x8a\x8d\x45\xe1\x53\x48\x23\x49\x62\x50\xc0\x26\x5e\x95\x34\x4a\x49\xa7\xe3\xb8\x7e\x77\x51\x68\x37\x2f\xf7\x55\x46\x46\xa1\x0e\xbd\xaa\x8d\x51\x1d\x45\xc1\xe7\x22\x32\xc4\xe4\xf9\x6e\x58\x2d\x18\x32\x2e\x61\x5e\x05\xe2\xa5\x12\x81\x9d\xa8\xfb\x10\x75\x93\xac\x11\x63\x55\xe5\x3e\x65\x10\x4b\x7b\x53\xf3\x55\xef\x8f\x89\x91\x50\xeb\xc1\xf4\x5d\x27\xdc\x44\xc3\x51\x48\x0e\x11\x4d\x1f\x07\xa7\x20\xd7\xf6\xea\xdd\xbe\x94\xe8\xdd\x2f\x0a\xb5\x64\x89\xa2\x06\x21\x9a\x78\xb1\x87\x1d\x83\xd8\xfd\xc3\x99\xe5\x16\x66\xcb\x99\x22\xdb\x32\x40\x66\xe9\x9b\xda\x57\x25\x26\x47\x20\x06\xa6\xd4\x90\x9c\xc7\x91\x5c\x1d\x8e\x0d\x8c\x0c\x52\x41\x20\x46\xa2\xb0\x49\x9a\xf7\x37\xc5\x48\x54\x36\x49\xe8\x56\x55\x24\x4e\xb8\x09\x55\x84\xe8\x28\x65\xf5\xf1\x6f\x11\x0e\xc8\x5a\x6a\xb8\x88\xac\xc5\xfa\x45\x45\x0f\xd5\xe2\x21\x93\xbe\x39\xbf\x10\x67\xda\x89\xac\x2b\x59\x93\x53\x0c\xbb\xcc\x6c\x89\x13\xd1\xcb\xc6\xf2\x83\x90\xa2\x0b\x42\xe4\x77\x19\x21\x15\x55\xd8\xe8\x81\x6c\xc7\xfc\xce\x8d\xbd\x28\x36\x2d\xc7\x0a\x4e\xb1\xd1\x81\x95\x32\xdb\xcd\x29\x69\xf7\xc9\xa4\x42\x0b\x83\x68\x45\x84\xcb\xbc\xea\x45\x07\x12\xca\xe0\x10\x70\x10\x1b\xa4\xb4\x45\x94\x41\xee\x4b\xed\x96\x54\x08\x4f\x29\x77\xb9\x8f\xa8\x49\xaa\xc3\x2d\x21\x8e\x01\x9f\xc3\x14\xb2\x3e\x72\x7f\xc8\xa7\xd9\xec\x5a\x21\xb2\x5f\x68\x87\xe8\x1e\xd6\x1b\xca\x12\xf9\x58\x77\x7d\xa3\x8c\x5d\x05\xc0\x3b\xef\xb4\x18\xaa\xb1\x39\x7c\x50\x8c\x38\x38\x45\x3e\x6e\x2a\xca\x10\x79\x1d\x8e\xc7\xa5\xd2\xef\x31\x1a\xaa\x71\x1c\x1b\x78\x6a\xe9\x42\x58\x4a\x12\x86\x6a\xc8\xb2\x30\x58\xa1\xe3\x0a\xd3\x3a\xaf\x2f\x04\x11\x80\x32\x5e\xfd\xad\x56\xae\xd3\xbc\x14\x5b\x67\x21\xb0\x51\xbe\x9a\x3f\x26\xf9\x26\x7f\xc2\xe7\x1c\x81\x9c\xc2\x54\x08\x3a\x52\xb6\x26\x9e\x6d\xe9\x16\x29\x76\x0e\xb1\xef\x20\x1b\xe6\xa4\xb4\x97\xec\x52\x48\x2d\x4e\x7d\x23\x0a\x84\xee\x0f\xfb\xad\x91\x07\x70\x4f\x5b\x7b\xf2\x0e\x00\xaa\xc3\x3e\xa3\xe0\xb0\x7c\x6b\x19\x44\x0e\xda\x90\xd5\x1a\x85\x9a\xee\x0f\x29\x9d\xf6\x7c\x6b\x82\xfc\x8b\x8d\x6d\x2f\x27\xb6\xaa\x3f\x01\x89\x28\x1d\xdd\x4c\x96\x8f\xcd\xd8\x32\x60\x34\x33\xda\x70\xfd\xd8\xf0\x5d\x2f\x76\xf0\x19\xb9\xe5\xaa\x8c\x25\x61\x2a\x66\xcc\x41\xf6\x60\x18\x77\xa4\xd2\x25\xc6\x45\x6a\x01\xb6\x49\xa1\xca\xd8\x77\x23\x0f\x62\x90\xe8\xca\xb5\x9d\x8a\x24\xba\x9c\x0b\xa0\x92\xd5\x11\x8f\x8a\x53\xa1\x07\x11\x8b\x54\xe8\x81\x1c\x02\x15\x7a\x3c\x8e\xed\x68\x7a\x73\xf7\xe7\x7e\x56\xac\xb6\x98\x40\x6f\x14\xfa\xf6\x31\x72\xb1\x9f\x28\x90\x8a\x02\x04\x41\x74\x90\x6b\xf8\x93\xa6\xb5\x32\x09\x00\xa0\x55\xfa\x9a\x14\x82\x9e\xa0\x80\x9c\xa5\x99\xe0\xaa\x51\x8f\x28\xe3\x4e\xb0\xf5\xc1\x85\xe3\x1a\x62\xcd\x2b\x74\xd8\x49\xb4\xe9\xcc\x6c\xfa\x0f\xaf\x5b\x55\x35\xda\xe1\x10\x96\xbe\x74\xa5\x06\x45\x91\x40\xab\xf4\x5d\x28\x86\x6f\x91\x2c\xae\x18\x12\x33\x90\x8e\x80\x2d\x50\xe9\xdb\x5e\x2a\x39\xc3\x3e\x41\xa5\xef\xb3\x68\xdc\x65\x55\x36\xa4\x85\x8a\xa4\x48\x4d\xf1\x0f\xc4\xd4\x97\x40\x26\xef\x37\x90\x65\xa8\xb6\xb3\x6c\x22\x70\x15\xa6\x51\x84\x68\xc4\x86\xd6\x3f\x96\x84\xd8\x3c\x14\x04\xe1\xa9\xef\x46\xe3\x53\xb0\x83\x03\xb9\x9d\x32\xd0\xc9\x81\x3f\xc8\xc0\xe9\x54\xc5\x20\x36\x3a\xe5\x88\x22\xb2\x2d\x27\x3a\x8f\x8d\x09\x22\x01\x02\x8c\x95\x19\x58\x7f\x12\x9b\x6e\xe4\xeb\x3a\x0c\xa7\xa6\xa9\x69\xd4\xb3\x91\x83\x25\x01\x03\xa3\x1c\xa9\x1c\x7c\x7d\x26\x0c\x72\xf4\x39\x1c\xd5\xd9\x3f\x48\x31\x68\xa5\x90\x85\x86\x31\xe4\xe0\x45\x87\x71\xc4\xe1\x10\x1c\xc6\xa8\x95\x42\x11\x1a\xc6\x31\x07\x2f\x3a\x0c\xc4\xe1\x10\x1c\x06\xe5\x5d\x2f\xa2\x69\xbd\x05\xa8\x16\x57\x59\x44\x02\x52\x50\xa6\xbf\x79\x35\x1f\x39\x63\x51\x3c\xe5\x00\xca\x2e\x9c\xfa\xee\x04\x6d\xdf\xbe\x43\x52\x13\xce\x58\x90\x0f\xb7\xf0\x6d\x6e\x1d\xe9\xc4\x46\xd9\x7c\x2b\x8c\x62\x1f\xeb\x43\x49\x06\x81\x23\x89\x01\x3c\x92\x8e\x61\xa0\xcc\x00\x96\x2e\x01\x44\x52\x16\x82\x48\xd2\x8c\x6c\xbb\x9e\x0b\x10\x4c\x99\x07\x02\x76\x90\xef\xbb\x67\xa2\xf0\x7e\x8b\xd4\x6b\x61\x0b\xf4\x7e\x00\x31\x0c\x20\x8e\x7e\x5f\x6a\x5d\x11\xaa\xc0\xaa\xec\xf7\x65\x0e\x5e\x6c\x55\xf6\xfb\x0a\x87\x43\x68\x55\xf6\xfb\x6a\x2b\x85\x14\x4f\x5c\xc3\x32\x2d\x72\x30\xec\xc2\x44\x7d\x11\xa2\x53\x0b\x26\xe2\x59\xed\x4e\x3d\x1a\x8a\x10\x89\xf4\xa8\xdd\x86\x2b\xdd\x7a\x34\x12\x21\x12\xe9\x51\xbb\x49\x57\xbb\xf5\x08\x89\x10\xc1\x3d\x92\xfb\x46\x7b\xd8\xd3\x5c\x26\x03\x75\x86\x8e\x78\xac\x89\xe7\xfa\xf5\x3b\x21\x65\xc2\x09\x84\xd3\xd6\xac\x82\x6f\xf2\x40\x25\x0f\x48\xa1\x30\x28\xf0\x79\x07\x0a\x99\xbe\x12\xb5\xbc\x0d\x67\x6a\xe1\xb3\xb8\xfc\x3f\xdd\x8d\x1c\xf1\x1d\x2d\xfd\x3d\x8a\xfd\x6d\xad\x1b\x85\x5e\x44\x8e\x42\x2e\xb0\xdf\x91\xb4\xfe\x42\x45\x2b\x67\x3d\xf0\x76\x4a\x62\x46\xe9\x4b\xb3\xc9\x7e\x59\xb8\x47\x25\x1c\xb7\xc0\xc5\x1a\xa7\xbc\x25\x75\x47\x28\x88\xa5\xe7\x88\x6c\x80\x75\x3f\x04\xa7\x56\xa1\x8f\x7f\x08\xac\xb4\x93\x30\x8e\x36\x44\xa4\xb9\xaa\x00\xc2\x85\x85\xa4\x8e\x18\x3b\x65\xcf\xf2\x30\xd9\x45\x08\x6c\xb5\x15\xf5\x98\x45\x40\x24\x2c\xb0\xef\x55\x8f\xc1\x94\x2d\x48\x81\x40\x8a\x2a\x33\x0d\x12\x69\x30\x91\xed\x22\x50\x73\x64\xd5\x64\xd6\xff\x89\xa5\x1f\xa0\xba\x45\x10\x3e\x60\xc2\x49\xf1\x21\x08\x1d\xb6\x42\xeb\xba\x45\x90\x41\x67\x33\xe8\xe0\xb6\x56\x36\x70\x2b\x54\xb4\x71\x5a\xec\xf5\x71\x9d\xeb\xa1\xdf\x22\x50\xea\x0a\x9d\x52\xbd\x2f\x87\x28\xb3\x85\x70\x6c\xad\xd2\xf9\x6a\x3d\x44\xa3\xa1\x1c\xab\xe5\xe7\x09\x1a\x0a\x9b\x7c\x9d\xa0\x7e\x31\x09\xa4\x93\x5b\xe9\x9a\x4a\x29\xec\x93\x14\xbe\x18\x1d\x1d\x0b\x06\x13\x2f\xc4\x8a\x34\x1a\xde\x27\x31\xea\xfc\xba\x7f\x0e\x53\x29\x82\x54\x60\x82\x57\xed\xab\x62\x54\x63\x81\x5e\xf5\x05\xa9\x04\x7a\x35\x10\xa3\xd2\x04\x7a\x35\x14\xa4\x12\xe8\xd5\x11\x9b\xea\xec\x94\x54\x50\x94\xef\x39\xc4\x02\x1d\x1a\xc1\x2c\x02\x7d\x39\x66\xb3\x10\xed\xbc\x2f\xcd\x07\x59\x50\x0b\x8b\xe5\x08\xb2\x0c\x76\x6f\xc7\x9d\xe0\x10\x19\x28\x44\xcd\xf5\xb8\x50\x1f\xfa\xf4\x4b\x5b\xcd\xeb\x46\xc4\x01\xf9\x38\x08\x04\x0e\x18\x94\x11\x86\x18\xea\xfd\x00\x48\x64\x0a\x11\x29\xf0\x98\x64\x88\xa8\x72\x87\xe4\x14\x86\xdc\x62\x0d\xce\x54\x5f\x53\x3a\x30\x8a\xc6\xe2\x5a\xbf\x03\xa9\xac\x79\x60\xf4\xd4\xd7\x06\x1d\x18\x15\x21\xc6\x61\x07\x46\x55\x88\xf1\xa8\x03\x63\x5f\x88\x71\xd4\x81\x71\x20\xc4\x78\xdc\x81\x71\x28\xc4\x88\x3a\x30\x1e\x09\x31\x6a\x1d\x18\x47\x42\x8c\x7a\x07\xc6\x63\x21\x46\xa3\x03\xa3\x2c\x09\x51\xe2\x2e\x94\x62\xab\xc6\xec\x42\x29\xb2\x6c\x54\xba\x2c\x84\xa4\xdf\x5c\xdf\x80\xf7\x6b\xaa\xbc\x87\x73\xa2\x89\x86\xfd\xd8\xad\x33\x06\xe0\x61\xb4\x4a\xbf\x33\x40\x9a\x0e\x22\xad\xce\xe3\x82\x58\x6a\x6d\x12\x6c\xf5\xc5\x30\xa1\xa4\xa1\x4a\x7f\x64\x8e\xa0\xf5\x40\x27\xf5\x6c\x8e\x41\xca\x3c\xc0\xc6\xf7\xe0\xe5\x88\x63\xcd\x03\x0f\xf3\x55\xda\x09\x92\xa6\xc5\x0a\x43\x54\x49\x61\x4f\x14\xf9\x13\x88\x55\x5b\xb0\xd3\x08\xc6\xf6\x5b\xb0\xd1\x85\x40\xc3\x03\x36\x38\xba\x98\xc2\xa1\x8b\x34\x64\x83\x2f\xa6\x17\x02\xdd\xde\x53\x90\x0a\x3c\xbd\x88\x04\x5a\x1e\xb1\xc1\x48\x44\xd8\xb2\xda\xae\xd6\xfd\x58\x81\x33\xd2\xaa\xdc\xe7\x33\x80\x71\x85\x2a\x0f\x78\x0c\xfd\xb8\x0f\x33\x8c\x78\x0b\xcc\xb6\x26\x56\x28\xb0\x4d\x97\x8f\x79\x2c\xe5\x29\x01\x44\x21\x69\xdc\xb5\x2a\x70\x24\xa2\x4a\x3a\x97\x42\xe0\x70\x44\x95\x0c\x2e\x85\xc8\x31\x89\x2a\x6b\xed\x66\x43\x00\xad\xb7\xa3\x47\x30\xda\x68\x47\xcb\x50\xe3\x23\xf3\x88\xd2\xc8\xba\x3a\xac\xbe\x30\xa1\x79\x6f\xfb\x94\x77\xaf\xf5\xc8\xa4\xf3\xb9\x55\xf6\xa8\x7e\x71\x1d\x42\x52\xae\xa6\x42\x92\xa3\x73\x08\xa6\x30\x61\x08\xc4\x51\xeb\xb7\x6a\x0e\x81\xed\xa9\x3b\x97\x1a\x34\x95\x4b\x65\x25\x22\xb9\xc4\xaa\xda\xfc\x5b\x13\xee\x77\x40\x64\x49\xe6\x92\x9c\x5a\xe3\x53\x98\x43\xe1\x72\x4c\xb0\x61\x45\x13\x98\x45\xe5\xb2\x90\x0f\xb6\x81\x14\x94\x15\xaa\xcb\x76\x6b\x77\x57\xe6\xc2\x2b\xfd\x0f\x30\xf9\xd4\x6a\x88\x61\xbe\x61\x3b\x5f\x45\x55\x1f\x95\x41\x44\x06\x35\x55\x24\x77\x5b\xb3\xd4\x0a\x39\xc1\x01\xf7\xdb\x46\xb2\x21\xb7\x10\x44\x01\x16\x40\x2b\x2d\xe8\xba\x79\xa2\x6d\xaa\x22\xc0\xa3\xb6\xf0\xc0\xc8\x7e\x0b\x32\x08\x7d\xcb\x00\x87\x50\xbf\xf6\xc3\x13\x42\xa0\x23\x87\x5c\x2f\x2c\xc6\xd4\x26\x90\x92\xe9\x14\xf9\xa2\x3d\x6a\x1b\x16\xe1\xd1\x23\x3f\x70\x45\x64\x73\xc4\x4c\x6e\x92\xd7\xd8\x61\xac\xc1\xc4\xd6\x91\xba\x4d\x4a\x34\x21\x0e\xe9\x88\x0a\xf2\x2f\x62\xcb\x21\x05\x04\xd8\x88\x1d\x97\x7f\xf1\x3d\x7d\xb2\x50\xbe\xe2\x44\x8a\x00\xb1\x1b\x3b\x53\x1e\x50\x1d\x1c\x71\x97\xba\x8f\x91\x5d\xbe\xc1\xc0\xa7\x91\xf0\x8e\x01\xac\xb3\x9e\xf5\xd7\x20\x81\x4e\x48\x58\x66\xa2\x9b\x92\x6b\x3e\x5a\x56\x79\xe8\xcd\x07\x1b\x20\x12\x45\x84\xa4\x49\x9a\x40\x64\xaa\x08\x59\x99\xd3\xe1\x33\x29\xf4\xbb\x29\x7a\x64\x20\xfa\x1a\x5b\x08\x4e\x05\x0e\xe5\xc9\xe7\x16\x07\x04\x36\x78\xe0\xcd\xab\x4e\x7c\x16\x09\xd3\xeb\xbb\x0c\x24\xb1\xa3\xbb\xe5\x4b\x72\x3c\x2c\x0f\x06\x4b\x4e\xc2\x2a\x9f\xa0\x2e\xbe\xe3\xb3\x28\xf4\x67\x13\xcb\xe1\xd7\xc7\x43\x40\xf3\x74\x51\xb4\xe7\x06\xe4\x32\xc5\xe6\x2b\x4b\x71\x10\x79\xe4\x6c\x13\x1b\x00\x8d\x42\xeb\xf6\x6f\x11\x32\xfc\xe8\xfe\x33\xab\x00\x5a\xa5\xdf\x82\x1c\x63\x07\x97\xbe\xb6\x2a\xa7\x76\x9d\x78\x6a\x19\xd8\x8d\x27\x78\xe2\xfa\x17\xb1\x17\xf9\x63\x68\x58\xea\x91\xca\x78\xc5\x76\xf3\x8e\x6f\xf5\xbe\x2c\x44\xd1\x67\x50\x54\x48\x64\x40\x02\x51\x8f\x06\xad\x68\x1f\x4f\x5c\x70\xa1\xab\x47\xc3\x56\x82\xfb\xb7\xda\x01\x0e\x45\x67\x46\xd4\xd2\xe6\xdd\xa0\xc8\xb1\xa0\xe5\xa5\x2a\x06\x93\x44\xee\x46\x82\x99\x24\x4a\x27\x92\x3e\x66\x88\x44\xf7\xdd\x20\xd8\x9c\x80\x40\x0c\x46\x2b\x43\x7d\x2f\x27\x9f\x40\x51\xcd\x56\x02\x0f\xf9\x61\xf5\x0a\x23\xc0\xd1\x1f\xb4\x73\xf8\x2e\xf9\xec\x2f\x28\x88\xf6\x5e\x04\x17\x41\x88\x27\x10\xc1\x91\xcc\x20\x20\xb5\x54\x10\x4e\x69\xc1\x95\x17\x73\x81\xeb\x9c\xfe\x34\x7b\xf3\x06\x72\x75\x00\x09\x41\x11\xa3\xe5\xd2\xe3\x37\x34\x00\x81\x3a\x64\x10\x94\x37\x0f\x10\x77\x73\xea\xbb\x8e\x1b\x81\x82\x57\x8f\x98\x57\x88\xdc\x1f\xa3\x96\x55\x98\x10\x09\x95\xb6\xd8\x18\xa5\x4e\x24\x0a\xfd\x6d\x6a\x82\xad\x87\xb4\x39\xb3\xd2\xc8\x8d\x67\xd0\x88\x94\x81\x2c\xc4\x53\xbd\x86\x07\x71\x29\x10\x57\xe8\x92\xef\x45\x0a\x71\xa9\x6c\x49\x37\x4c\x10\xbc\xcf\x96\xb1\x18\x5c\x55\x46\x2c\x45\x15\x44\x2b\x03\x6a\x81\x37\x28\x49\x6c\xdc\x43\x16\x56\x16\xc3\x1e\xb1\xb0\x8a\x18\x76\xc4\xc2\xaa\x62\xd8\x63\x16\x56\xaa\x4a\x5c\x20\x2c\x62\x61\x65\x31\xac\xc6\xc2\x2a\x62\x58\x9d\x85\x55\x45\xb0\xea\x90\x69\x4a\x4a\x2b\x56\x5f\xd4\x77\x0e\x52\x1c\x41\x14\xa0\x2f\x1b\x8e\x5a\x29\xaa\xcb\xfd\xd1\xb9\x05\x1a\xb4\xe1\x31\x73\x9d\x94\x1b\x33\xd7\x27\x29\x34\x24\xe0\xd2\xd4\x21\x62\xae\xd6\xce\x34\xd4\x84\x96\x17\xea\x41\x08\x6a\x1a\xfd\xb2\x22\x06\x82\x50\x31\x40\xe8\x7a\x20\x80\x0a\x3b\x34\x37\x0c\x5d\xd0\xc1\x0e\x29\x0f\x7d\x2e\x34\x17\x74\xf6\xf1\x42\x04\xa3\xf4\x25\x86\x12\x54\xd7\x16\x59\x8e\x15\x5a\xc8\xb6\x7e\x87\x9d\xa3\xd2\x97\x59\xde\x55\x60\x19\xed\x54\xef\x54\x4a\x48\xaa\x9d\x5c\xdd\xb5\x41\x30\x2b\x48\xc7\x8e\x51\x66\x6b\x41\x30\x2b\x3c\xb7\x5d\x1d\xc1\xcd\xb2\xd6\xde\x46\x67\x21\x30\x6b\xd5\x6d\xd6\x0d\x04\x1e\xf2\xa4\x65\x82\x9b\x0a\xba\x0c\xb4\xde\x9c\x35\xaf\xb1\x02\xd0\x01\x1b\x4a\xb2\x4e\x7c\xa8\xd2\xd7\x78\xbd\x0e\x5c\xfd\x13\xd8\xbc\x42\xef\xf0\x6a\x8c\x58\x6e\x60\x27\x66\xaf\xa0\x42\xba\x69\xb6\x01\x2d\x47\xa0\xc7\xb8\x0d\x1d\x39\x16\x64\xdf\x15\x99\x6e\x9b\xe4\x24\xee\x2f\x39\xe3\x63\x25\x3c\x84\xee\x0a\x10\xce\x69\xd0\x9a\xbe\x75\xed\x23\x04\xa4\xb5\xbc\xb9\x06\x03\x42\x1d\xb7\x5c\xfa\xc8\xc5\xed\x26\x69\xdb\xee\x3f\xe4\x92\x34\xd7\x1e\x36\xff\x6d\x13\x36\xd7\x1f\x3a\x53\xd1\xaf\xd0\x61\xca\x9d\x21\x9b\x5c\x93\x72\x21\x2e\x06\x8d\x77\x85\x25\x04\xd6\xa1\x1b\x2c\x21\x02\x83\x7d\x81\x25\x04\xc3\x9c\x0f\x15\x40\xd8\x7d\x65\xaf\x2e\x3e\x71\xa6\x42\x15\xfe\xc1\xf7\x6c\xee\x4c\xf7\x4b\xfb\x4b\x4d\xe0\xb7\xad\xec\x5c\x20\xe5\x5b\xc2\x59\x27\x05\x49\xed\x47\x35\xa1\xf5\x3b\xe6\x7e\x9c\x51\x41\x32\x03\xdd\x94\xbb\x80\xf0\x9d\xb2\xea\xa6\x18\xbb\x6e\xfd\xcc\xee\x50\x57\xdd\x60\xcb\x84\x0a\x08\xa5\x2c\x42\x93\x7c\xb9\x88\xa3\x78\x0a\x42\x47\x2d\x50\x18\x79\xcc\x46\x9e\x47\xe7\x30\x16\xb1\xb0\x9b\x6b\x37\x20\xb4\xc6\x92\xd4\xd6\x79\xc5\x0e\x7e\x9d\xad\x57\xc9\x55\x6f\x55\xcc\xf2\xa2\x1c\xf5\x80\x9c\x98\x26\xd9\x55\xf5\x17\xd9\x6c\x9e\x3e\xf9\x40\xc4\xb3\xaa\xff\x32\x5f\xde\xde\xce\xb2\xab\x55\xd9\xd6\xea\x6e\x36\xaf\x1a\x7c\xb3\xc5\x5c\x3f\x72\xff\x0b\xf9\xdf\xc9\x5d\xbe\x2c\x96\x1f\x4f\xee\x88\x59\xfa\x88\xc7\xb6\xb6\x5c\x2e\x92\x59\x76\xf2\xa1\xfa\xa5\x77\x42\x08\x3f\x26\xd7\x0b\xfd\x66\xb9\x5c\x25\xd5\xa5\xcb\x27\x1f\xca\x5f\x4f\x3e\x54\xe8\x1d\xc6\x59\x3e\xbb\xdd\x62\xac\xbf\x98\xbd\xc3\x78\x75\xf7\xfd\x9e\xa5\x44\xb0\x58\xe6\xcb\x6c\x55\xf4\xee\xb9\xd2\xac\xd8\xf0\xbc\xad\xba\x36\x2b\x8a\x3c\xbd\x8c\x17\xe9\xaa\x10\x20\xbc\xa7\x6a\x46\x42\xb3\xcd\xcb\x5f\x57\x9d\x98\xb6\x3b\xb5\xcd\x12\xaf\xd2\xff\x26\x07\x33\xd5\x1d\xca\xd6\xb7\xf1\x7c\x47\xe8\x34\xd3\xc9\x87\xbd\x99\x7d\xf0\x5c\x2f\xef\xbe\x6b\xeb\xcf\x9f\x93\x7c\xf5\x6c\x53\x7d\xcf\x12\xac\xf3\xcf\xb3\x79\xb2\xc3\xb2\x6a\x7e\xed\xc0\x54\xdd\x22\xea\xa5\xdf\x6e\x67\x77\xe1\xf7\xbb\x5d\xca\x62\x96\x5f\x27\xc5\xe3\xcb\x55\x5f\x66\x45\xf2\x6d\x57\x29\x88\x5c\xf3\x64\x56\x24\x9b\x7f\x7e\x76\xc9\xee\xe8\xfc\xb6\xb2\x76\xe5\x61\x0c\x70\x75\x33\xcb\x93\x78\xbe\x3b\xba\x27\x5e\xdc\x87\x4f\x13\x5b\xcf\x36\xd3\xe4\x5d\x96\x2b\x60\xf3\xd4\x2b\x9b\xad\x1f\x5d\xba\xe5\xa2\x7c\xad\xc2\x15\x36\x31\x77\xe5\x30\x5f\xff\x74\x9d\xa5\xd9\xd5\xf2\xeb\x9f\x65\xba\xaa\xd1\x30\xa6\xeb\x6b\x9a\xbd\x82\xb9\x6a\xf5\xdb\x46\xb2\x2a\xf2\xe5\xf7\x17\x75\x30\x0c\xc7\x30\x2f\xbe\x3d\xbf\x10\x5e\x4e\x57\x37\x2d\x77\x89\x5f\x9e\x40\x10\xe3\xa4\xa8\x96\x0d\x2a\xed\xfc\x0b\x48\xe2\xb1\x56\xed\xf6\x02\xdb\x5e\x5f\xeb\x22\x39\x98\xa7\x5e\xa8\xe5\x56\xed\x25\x26\x65\xf5\xf2\xf3\xf1\xbf\xb8\xe7\x61\x0b\xb2\x9e\x98\x75\x9e\x27\x59\xb1\x79\x84\x3d\x3f\x87\xb7\xcd\x36\x0c\x54\xdb\x9b\x47\xd8\x6d\x0b\xce\x45\x9e\xcc\xae\xae\xf2\xd9\xd7\xe7\x15\x1f\x20\x37\x66\xdf\x2b\x7f\x5c\x23\x19\x0e\xf9\xaa\xfa\x97\x38\xbd\x7a\xfc\xb1\xec\xcb\xad\x1a\x07\xce\xf3\x65\xde\x36\x8a\x03\x9b\x8b\xe3\xe4\x7a\x31\x59\xaf\x0a\x7d\xb6\x2a\xc2\xa5\x97\x2f\xef\x92\xdc\x5c\x67\xf3\x22\x5d\x66\xde\x32\xcd\x8a\x24\x67\x0c\xbf\xea\x90\x97\x2f\xe7\xe8\xea\x2a\x4f\x56\x22\x46\xa3\x0a\xfc\xe7\x37\xb3\xbc\xf7\xb6\xa6\xb9\xcb\x97\x73\xf2\x87\xc7\x97\x61\xab\x9d\xb3\xb2\xb4\x48\x67\x0b\xca\x1a\xb4\x76\xf9\x9e\x6f\xa3\x43\x0f\xb2\x73\x0c\x9b\x72\x3b\xfb\xf7\x32\x7f\x30\x49\x9a\x2d\xf3\x67\x14\xe2\x64\xf6\x47\x52\x1b\x86\x17\x90\x22\xdb\x5c\xf1\xec\x4a\x07\x1a\x62\xa2\x5e\x5d\x68\xf9\xdb\x3a\xc9\x5f\x53\x74\x0d\xea\xf5\x8f\x1c\x4c\x31\xec\x58\x33\x05\x41\x91\xa7\xd9\xf5\x0b\xcc\xc0\xbe\xec\x9e\xd9\xac\x96\x1a\xb8\x59\x51\xcf\x3e\xfe\x83\xb6\x36\x82\x92\x7c\xb5\x21\x7d\xf0\x75\x76\xf7\xca\xb2\xe5\x4f\x20\x85\x30\xc9\x6f\xd3\x6c\x56\x24\x4f\x2b\x83\x27\xe8\xf9\xd9\x2c\x2d\xc6\x76\x5b\xb7\x9f\xa8\xc1\x2a\xf0\xed\x24\xab\xfd\x25\x93\x64\xd7\x69\x96\x3c\xa3\xa8\xb4\x34\xbb\x0a\x93\x6f\xe5\x57\xbd\x9f\x76\x9e\x1f\x4f\xd7\x05\x65\x59\x65\xfb\x9f\x51\x96\x7e\xb2\x48\x66\xab\xe4\x2f\x71\x3e\x8e\x38\xeb\xc1\xbc\x58\xae\xeb\xc7\x76\x8d\x2f\xe7\x19\x2d\xb2\xb5\xfd\x32\x5b\xbc\xc0\x94\xec\x0b\x20\xdd\xeb\xcc\x93\xcb\x80\x98\x4c\xe4\x59\x9d\x86\x4f\x2a\x3b\x76\x98\x66\x77\xe9\xe3\x77\x9a\xd1\x4e\x13\x63\x72\xba\x7c\x78\x7b\xc0\x21\x53\x7d\xe2\x6a\xe6\xcb\x5b\x7d\x91\x26\x59\xa1\xed\x18\x91\x67\xd3\x1c\x86\x60\x2e\xd7\x9f\x49\xa7\x3b\xd1\xd0\xa3\xe8\x60\x1f\x45\xb2\xb7\xf5\xee\x8f\x9b\x27\x7d\x0d\x87\x56\x8d\x1f\xbc\xa1\x53\x02\x8f\xa5\x73\xad\xed\x92\xb0\xaf\x9a\xa0\xc7\x6f\x94\xbd\x53\xa7\x32\xbd\x9b\x47\x1e\xbb\x6d\x52\xcb\xb8\xdf\x70\x75\x8c\x5b\xfd\x1b\xbb\xc5\xe7\x5d\x4e\x82\x6b\x69\x57\x6b\x1b\xf7\xfe\x23\x28\x6e\x73\xd0\xf8\x32\x32\x65\x4c\xf3\x8a\xea\xc9\x63\x8d\x7f\xdf\x8b\x12\x83\x5d\x2e\x1c\xb2\x84\x5e\xc7\xf0\x99\x1c\xfb\x23\xfb\xbc\x98\x75\x3c\x08\x0b\xd3\xdb\x5d\x7f\x56\xa4\xb7\xc9\x72\xfd\x9c\x9a\x38\x4e\xca\x69\x78\xb9\xd8\xf7\x49\x26\xe3\xb0\xa8\x97\x6d\x21\x9e\x28\xf2\xad\x77\x6e\x6c\x63\xfb\x52\xdb\xba\xc7\x49\x13\xb3\x6c\x36\xb7\x5e\xf2\xc9\x22\xa0\x57\xe0\x03\x5e\x6a\xaa\xeb\x76\x29\x8e\x94\xee\xcb\x63\x89\x80\xdd\xe1\xfa\x80\x72\x31\x2b\x3e\x2f\xf3\xdb\xcd\x33\x1d\x04\xc1\xd0\xb3\xbb\x9a\x4e\x40\x14\x5f\x96\xe9\xd5\xe6\x78\x20\x2b\x73\x6a\xf1\xd5\x6e\x2f\x7e\x64\xcd\x82\xb6\x44\xb5\x28\x5e\x77\xfd\x1d\x6b\x96\xbe\x96\x23\xfa\x53\x4d\xd2\xeb\xae\x69\x65\x4d\xd2\xc1\xe5\xab\x3f\x92\x8d\xfe\xdf\x8a\x52\x1f\xa0\xe5\xdf\xb3\xf9\xa7\x53\xbf\x4d\xcb\x89\x08\x87\xfd\xf2\x81\x67\x97\xe3\x63\x6f\x20\xb7\x87\xf9\x1c\xba\xb9\x3f\x87\x65\xa1\xea\xe5\xfa\x7a\x92\xac\x56\xb3\xeb\xf2\x9d\x90\x7c\xb9\xe8\x2c\x5d\xac\x45\x63\xcf\x77\xf5\xfd\x79\x9b\xcf\x16\x8b\xcb\xd9\xfc\x8f\x83\x65\xf4\xac\x02\x6a\x5d\xbc\xe5\x81\xb7\x91\x5c\xae\xaf\xbb\xca\xe6\x55\x6e\x28\x98\x8a\x62\xcf\x2e\x93\x85\x7b\xf9\xef\x64\x5e\x3c\xd2\xf2\x6b\x7e\xed\x20\x06\xc6\x12\x5c\x96\x5d\x0a\xc5\x16\xe2\x3d\xd3\xd6\x48\x18\x74\x9d\xa8\x4a\xc9\xec\x33\x2d\xc8\xcf\x8f\x3f\x39\x80\x92\x56\x42\xdd\x32\x30\x0f\x9f\xa7\x8e\x66\xb2\x5b\x79\xca\xcb\x6b\xbb\x80\xc7\x79\xed\xfe\x66\x7b\x4a\x5e\xd4\x90\x6e\x65\x2b\x5f\x46\xa6\x9b\x96\x85\xc3\xa1\xc3\xa5\x20\x90\xb3\x7c\x2d\x42\x60\xd2\x74\x8c\x09\x99\x1c\x24\x73\xb9\xdf\x95\x17\x4e\x5e\xfe\xc9\x66\xe5\xb0\x88\x83\x61\x33\x9e\xc8\x00\x97\xf9\x23\x8e\x05\xbe\xff\xf7\x67\x9f\x93\x3f\x7b\x16\xf3\x47\x73\x0c\x2f\x38\xd5\xf7\x4d\x3f\x47\x22\xb3\x55\x10\xf6\x72\xfe\x47\x9d\xbc\x79\x19\x39\x6c\x32\x47\x07\x96\x10\xfd\xb0\x2a\x16\x65\x8b\x57\x29\xdb\x27\x2e\xe0\x1e\xf6\x5f\x91\x2c\x98\x4c\xdb\x1a\xf6\x10\x8f\xb7\xe5\xfc\x69\x7d\x7d\x22\xbf\xd7\x3a\x3d\x41\x52\x18\x33\x62\x77\xfc\xe4\x3a\x5d\x66\x7f\xa6\xf9\xa9\x25\x9a\x27\xf3\x62\x75\x30\x49\xbd\xab\x8c\xb9\x2c\x4f\x31\x2f\xe9\x75\x36\x5b\x6c\xe2\xb4\xe7\x9f\x92\xc7\x89\x10\x19\xe1\xc8\xed\xf2\xea\x09\x14\x3c\x28\xf2\x64\x76\xcb\x89\xec\xb6\x1e\x78\x26\x61\xfe\xe8\x01\xd0\xf3\x4b\xe4\x9e\x65\xab\x6d\x8a\x67\x55\xfe\xfe\x8c\xb2\xa8\x3a\xf2\xa2\x7b\xb1\x83\x44\x21\xba\xda\x1e\xbe\x21\x7b\x19\xbf\xd4\xbc\xf6\xd6\x88\xe6\xaf\x69\xd9\x99\x96\xb7\x2f\x3e\x2f\xeb\x61\xff\xaf\xa9\x69\xa6\x66\x9d\x66\xc5\xb0\xbf\xdd\xa7\xa7\x9d\x20\x41\x7f\xf7\xfc\x86\x6d\xd7\xeb\xb1\xf3\xeb\x2f\xe3\xf8\x82\xa4\xf8\xcb\xde\xb3\xec\xfd\xce\x24\xbd\xb8\x69\xf9\x6b\x7e\xb8\xf3\xf3\xf6\x65\x26\xa8\x92\x8c\xbe\xcc\x56\xeb\xdb\x24\x47\xf3\xff\xac\xd3\x3c\x79\xd5\x53\xf5\x43\x1b\x2b\x4a\xda\xf5\x4b\x23\x7f\x49\xfb\x59\xa4\x3d\xb6\xc3\xe4\x5b\xb1\xce\x13\xfc\xad\x48\xf2\x6c\xb6\x78\x4d\x12\x7f\xae\x95\xff\x97\x4c\xb6\x64\x52\xaf\xcf\xff\x0d\x99\x54\xef\xf2\x9b\xe9\x22\x31\x92\xd5\x3c\x4f\xef\x8a\x65\xbe\xdf\x7a\x7d\xd4\x59\x8a\x89\xf1\xec\x9f\x5e\x4a\x6d\xed\x25\xd7\x0b\x3d\x4f\x66\x45\x12\xfc\x1f\x7b\x4f\xd7\xdc\x28\xae\xec\x5f\x99\xca\xe3\x3c\xdc\xd9\x49\xce\x9e\xda\x5b\xb5\xe7\x41\x36\xb2\xc3\x19\x0c\x2c\x60\x27\x99\x17\x15\xb1\x95\x84\xbb\x0e\x78\x01\x4f\x26\xfb\xeb\x6f\xe9\x03\x8c\x6d\x6c\x5a\x80\xec\x24\xb5\xb5\x5b\x53\x95\xa4\xbb\x51\x7f\xa8\xd5\x6a\xb5\x5a\x1c\x80\xdd\xfe\x7c\x0b\xe2\x81\x29\xf5\x21\x5a\x52\xb2\x28\x01\xfa\x97\x1a\x24\x3c\x2c\x4f\xf2\x4f\x2e\xa4\x76\x36\xa4\x37\x38\xdc\xad\x6b\xd0\x1b\x1d\xd6\xe7\xea\x77\x6c\xda\x4d\x93\xc5\x7a\x5e\xf6\x92\x3e\x8f\xae\xba\x55\x87\xf7\xa8\xf3\xdd\xb8\xa7\x26\x8d\x73\xa6\xa0\x67\xd3\xc8\xe7\x26\xca\x9f\xc4\x41\xd0\x99\x26\x56\xc7\x23\x20\x80\x8c\xdf\xf4\x41\xd0\xfe\xa7\x2a\x85\xfc\xef\xfa\x08\x48\xb1\x74\x4b\x55\x84\xfc\x16\xc7\xc6\xa2\x69\x3e\x58\x26\xf7\xc3\x70\xfe\x44\x59\xb7\xc9\x0c\xd9\x86\xe7\x98\xc6\x39\xc4\x27\x86\xc2\x46\x51\x0e\x62\x8b\x60\xa6\x58\x94\x33\x6e\x22\x78\xa4\xca\xa7\xbd\x61\x1e\x29\x05\x2a\x9d\xbe\x08\x1d\xaa\x90\xe5\x00\x1b\xc5\xfe\x66\x9d\x23\xef\x26\xfd\xbc\x4a\xb2\x28\x4f\xd2\x20\x7a\x8e\xe2\x47\x7f\xbd\x5a\x25\x69\x4e\x17\x70\xf6\x7a\xb7\xaa\x8e\x7e\xb2\x5e\xd0\xcd\xb5\xd3\xa7\x11\xf0\x07\x95\xeb\xfa\x99\xc5\x68\x59\x1e\x3e\xaf\x32\x00\x2d\xc0\x94\x60\xff\x64\x4a\xc3\x8a\x33\x5a\xf1\x86\xdb\xd4\x78\xa6\x37\x3b\xad\xea\x6d\xfa\x33\x1f\xa5\xe1\x33\x35\x3f\xc4\x6c\x3a\x78\xfe\xf3\x20\x78\x3c\xad\x70\xb9\x60\x4b\x9b\xfb\xe0\x6e\x2b\x2f\xf8\x3c\xa7\x8c\xb3\x8f\x20\xdb\x7d\x23\x06\xd9\x30\x50\x4f\x1a\xdc\x60\xae\x42\xef\xbc\xbe\xf0\x78\x1c\xc5\x1c\x62\x5f\x41\x54\x96\xa7\xeb\x79\xfe\x09\x5d\x87\xe9\xe2\x25\x4c\xe9\xce\x37\x3f\x43\xea\xb8\xdb\xf3\xb9\xaf\x77\x56\x71\xb4\x5e\xc9\xf4\x12\x8d\xe7\x74\x64\xc0\x39\xeb\x7d\xb2\x28\x6f\x6a\x34\x78\x0f\x37\xa5\x19\x8d\xf3\x90\xf5\xc2\x67\x53\xe2\x23\xf8\x8e\xda\x39\xb5\x99\xa5\x27\x94\x6e\xb5\xe2\x58\xbe\x34\x80\xec\xb1\x85\xdf\xb5\x78\xf7\xa7\x95\x4a\xb2\x90\x6f\x92\x3f\x7f\xfe\xa4\x35\x33\x78\x50\x21\x63\x9a\x4f\xb2\xb9\x17\xe6\xf4\x63\xe9\x41\x7a\xd2\x98\x9d\x86\x87\x47\x92\xe1\x50\x42\x0b\x1a\x27\xbc\xa5\xf4\x49\xf3\xea\x62\x61\xda\xd4\x1b\xe3\xdb\xe0\x1c\x1a\x6a\xdd\x38\xe2\x2d\x9f\x2a\x6f\xf6\xb7\x3e\xdb\xec\xf2\x0b\x5e\x56\x94\xe5\x30\x19\xef\x72\x56\x63\x32\x54\x9e\x4f\x93\x94\x3e\x90\x68\x91\xb5\x36\xc1\xd2\x94\x09\x8d\xf3\x34\xa2\xd9\x99\xa5\x84\x0a\xef\x96\xa9\xda\xe3\x3e\x57\x3b\x42\x02\xc8\x08\x20\x79\xf9\xec\x27\x29\xdd\xf0\x07\x90\xbd\x68\xba\x04\x37\xd0\x93\x0b\xbd\x24\xfa\x12\xc5\x1f\xc3\xdc\x85\xc8\xb5\x58\xbb\x90\x11\x40\x44\x00\xc1\x8b\xe6\x55\x1f\xc7\xd8\x65\x5f\x7f\x21\xfd\x93\xc9\x5c\xb7\x31\xf9\xd1\xdf\xf4\x1c\x06\x74\x8c\xda\x4b\xb4\xc8\x9f\x3a\xd2\x78\xa2\xd1\xe3\xd3\x99\xd6\xed\x97\x70\xe5\x26\xcb\x68\xfe\xfa\xd6\x04\xbb\xe2\xa3\x3a\xa1\x50\x64\x67\xa2\x1f\x51\xf1\xe8\x80\xaa\x44\x04\x2e\xc7\xda\xa2\xbd\xe0\xbf\xef\x28\x8e\xd2\x35\x29\xd1\xa9\x0f\x16\x4f\xf5\xac\x93\x10\x88\x78\xdc\xe9\x4d\x09\xf3\xc4\x27\x41\x15\x61\xf4\xb0\x00\x3e\x87\x3f\x89\x90\x82\xda\x2a\xb5\x2f\x51\x69\x0e\x6d\x88\x55\x87\x25\xc9\xb0\x35\xaf\x81\xd4\x49\x5a\x35\x9d\x67\x83\xf7\x8e\x66\x2c\x58\xaa\x5c\x99\x83\x28\x0e\xd3\xd7\x77\x33\x7d\x81\x24\xd8\x24\x02\x3e\x6d\xbb\xd5\x47\xf3\xa8\x22\xa0\x33\xe5\xd8\x87\xb5\x29\xf3\x39\x1c\xac\x1f\x46\x49\xfa\x1c\xe6\xd9\x5b\x99\x22\x4c\x0b\xac\xcf\x70\x98\x67\x5d\x65\xda\x13\x19\xe6\xc4\x1a\x48\xe9\xd5\xd0\x24\x59\x44\x0f\x11\x4d\xdf\x8c\x8e\x84\x34\x3a\x12\x61\x8a\x7e\x2e\x38\xeb\x78\x66\x27\x35\xd5\x8e\xdc\xae\x0a\x3e\xef\x84\xae\x49\xbc\x6c\x2f\x30\x49\x8c\xd9\x50\xe3\xe8\x34\x58\xd1\x98\xe6\xce\x3a\x5f\xad\x73\x2b\x7c\x3d\xb1\x01\xed\x6e\xb1\x5b\x27\x29\x6b\x47\x56\xe1\xaa\x26\x7c\x59\x72\x6e\x5b\x2b\x6d\x63\xa0\x1d\x09\xc9\xe1\x30\xed\x1f\xa7\xa4\x53\xf5\x6e\x92\xe6\x1f\x4e\xf3\x8c\xa9\x1a\xc5\xb3\x5a\xb5\x3e\xf4\xde\x8d\x8e\x1c\x0c\x53\xfb\x51\x42\x1a\xb4\x5e\x99\x17\xe7\x0c\x7d\x0f\x4c\xcf\xca\xec\x6c\x2d\xdc\x1e\x23\xe9\x33\x06\xd2\xff\xe8\x49\x41\x4f\x9f\x35\x28\xea\x48\x8e\xa2\x22\x94\x96\x89\x8a\xf7\xa1\x9b\x13\xa7\x3c\x36\x8e\xfb\xfc\x16\xbf\xbb\x7c\x6c\x56\x8f\x8e\x32\xfd\x18\x7e\xe9\x1f\x25\x35\x2b\xe9\x2c\x4e\x89\x89\xe4\xfc\x3e\x49\x9b\x62\xf4\x78\x24\x85\x67\x8d\x54\xa5\xfa\x96\x5e\x36\xaa\x8a\x13\x1e\x63\xb7\x17\x6b\xc3\xb5\xc8\xda\x67\x8d\xce\x63\xb5\x6f\xe8\x65\xa3\x37\xaa\xa4\xad\x67\x8d\x3e\x8a\x92\x5a\xbf\x6c\x74\x6a\x25\x01\x1b\x2e\x08\xe7\x7b\x1e\xed\xf4\x78\x0b\xbc\x7d\x5c\xab\xaf\x72\x56\xde\x16\x61\x87\xe0\x29\xcd\xb2\x28\x89\x59\xed\xe6\x49\xb3\x26\x07\x67\x82\xb4\x40\x31\x15\x32\x00\xa5\x5d\x73\xae\x0f\x5c\x00\x16\x5d\x3b\xba\x9a\xc9\x91\x86\xdd\xcb\x63\x18\x0d\xe8\xf1\xcb\xd1\xc1\xb0\xac\xcb\xd1\x01\x9d\xea\x56\xf9\x99\x26\x6a\xc7\xba\xdf\xf7\x7e\xab\xfc\xa0\x8e\xa6\x71\xf6\x4f\xc1\x6f\xfb\x82\xdf\xa6\x40\xa2\x1a\x40\x5c\x9b\x67\x90\x6e\xb7\xf8\x41\xd2\x91\x17\x89\xca\xcb\x4b\x22\x2e\xba\x36\x4b\xb2\x52\xd4\xc7\x43\x8b\xf6\x52\x3e\xf0\x4c\x42\x29\x66\xc3\x9b\x70\x90\x09\xf6\xd1\xc9\x64\x0c\x70\x09\x5a\x4d\xef\xe0\x94\xc6\x3f\xd9\xb2\x7d\x16\x99\x34\xaa\xec\xe8\xcb\x16\x8d\x4e\xf2\x73\xf3\x7e\x18\x4a\xe3\x29\x8c\x17\xcb\xce\x54\xb2\x3c\x8d\x16\xf4\xf4\xba\x9d\xa0\xc1\x74\xc4\x45\xcc\xb3\x20\xef\x53\xc7\x51\x9c\x97\x7b\x93\x87\x64\x9d\xce\xe7\x8a\x48\x2c\xa6\x59\x2d\xc3\x98\x9e\xea\xa0\xfc\x14\x0a\xfd\x00\xba\x5c\x64\xfd\xcc\xab\xce\x64\x92\x87\x87\x8c\xf6\x17\x4e\xed\x66\x20\xc7\x34\x97\xc2\x37\xd2\xe8\x07\x4d\x8b\xb5\x56\xa7\xf2\xfa\xc9\x9d\xee\x8e\xdc\xde\xf2\xa8\x6f\x61\xdc\x80\xa9\x53\xd9\x5e\x88\x37\x4b\x6c\xe7\x9b\x5e\x26\x6a\xa9\x74\xdd\x5a\xd4\x5b\x70\xe9\xe1\x3c\xe0\xb6\xa2\xeb\x0e\xe5\x14\x0a\xba\xfc\x47\x43\x6f\x4b\x43\x3c\x7a\x10\x17\xf2\xa5\xa8\xed\xd9\x19\x14\xb4\x35\x02\xf1\xaa\xee\xb6\x5c\x64\xfa\xff\xc8\x5d\x09\xbd\xc2\x11\xd9\x7a\x7b\xf6\xa6\x8c\x57\x89\x4c\x95\x8f\x3a\x09\x1f\x4f\xdd\xeb\x95\xae\xd8\x4e\xbe\x7f\xe9\x0a\x3e\xea\xa4\xab\x6b\x63\x7c\x50\xba\x6e\x92\xe5\xfe\xfa\x5e\x78\x5f\x7b\xf6\xc1\x9c\xee\xcf\x8e\xf8\xaf\x1d\xf1\xdf\xe5\x55\x36\x71\x56\xc1\x37\x8d\xc5\xd1\xc9\x30\x89\x63\x3a\xcf\xed\xd9\xfb\x3e\x3a\xd9\x17\x2e\xa8\xb0\xf8\xc8\xd2\xdc\x4b\x2d\xf5\x1b\x48\x4f\xee\x4b\xa6\xf0\xbc\xdb\x67\x68\xf8\x07\x8d\xdf\xbd\x19\xb0\x06\x3a\x6a\x6f\x3a\x37\x17\x2f\x48\x25\x51\x26\x1f\x25\x2a\x07\x94\xbd\xfe\xd9\xbf\x92\x1b\x66\xbc\x6c\xd2\xcf\x27\xfe\x7b\x57\x31\x67\x62\x57\xa8\x2b\xf9\x5b\x7d\x67\x0e\xda\x74\x23\x1f\x0b\xf8\x80\xba\x51\x4b\x1f\xbd\x3d\xcd\x1c\x7c\x00\x44\x4c\xec\xec\x7d\x6a\xeb\x0d\x2c\x49\x0d\x72\x1f\x2d\xd7\xd9\xd3\x3b\x12\xee\x49\x2e\xea\x9e\x45\x1e\xef\xa8\x40\x14\x20\xd4\xf2\xc5\xb7\x09\xcd\xc3\x45\x98\x87\xef\xc8\xc6\x80\xea\x89\x3b\xe2\x8b\x6c\x79\x47\x22\xc0\x5a\x15\xe1\x88\xb6\xca\xd3\x98\x52\x4e\x68\x10\x95\x28\xf8\xa3\x98\x44\x4d\xd9\x6d\xa7\xa3\xd2\xf7\x67\x56\xe7\x34\x28\x8f\x66\x85\x8f\x79\x47\x86\xd4\x5e\x10\xf5\xaf\x42\x94\x35\x19\x42\x12\x0c\xe8\x03\x4e\x2b\xf6\xa7\x16\xe1\x56\xd5\xae\x4f\x11\x6b\x15\xd2\xaf\xd7\x10\x6f\xc1\x7b\x36\x05\xd5\x48\x75\x9e\xc4\x8b\x88\xb5\xc2\x7d\x07\xa2\x6d\x7c\x53\x5d\x5d\xae\xb5\xda\x3a\xf1\xe6\x87\xdb\xc4\xdb\x1d\x77\x55\xcb\xe5\x98\x45\xdf\xd2\xe2\x89\x1c\xdd\x83\xaf\xa5\xa2\xf8\xb4\x4d\x2d\x0d\x96\xbe\xb2\x67\x6a\x09\x2c\x0d\x16\xb0\xe9\xff\x7a\x16\x49\xd6\xb8\x85\xe7\xe4\xa4\x25\x56\x63\xca\xed\xa8\xd5\xce\x4b\x97\x35\xb5\xdb\x79\xd5\xf8\x44\x4d\xdb\x2e\x91\x2f\xb7\x67\x07\xa4\x99\xe5\x94\x77\xfc\x1f\xa5\xf4\xaf\x35\x8d\xe7\xaf\x87\xc5\xaa\x7d\x0c\x3a\x3e\x7d\xd0\x96\x58\x77\xc9\x9b\xf0\x75\x19\xc6\x0b\xb9\x6a\xde\x58\x87\x3e\xaf\x6b\xad\x95\xc5\xbb\x2f\x4b\xb2\xd8\x21\xb6\x7d\xb8\x7e\x88\xa2\x06\xb1\x4c\xe3\xfb\x7f\x04\x53\x27\x18\xbe\x29\x95\x72\x11\x67\xb7\xe7\x14\x4b\x4a\x33\x56\x96\x49\x77\xe5\x72\xfc\xc5\x88\x46\x57\xf4\x0e\x5c\xda\x46\x06\x05\xaf\xc5\x37\x37\xba\x12\x41\xf4\x96\xb2\x46\x69\xf2\xcc\x73\xf9\xa7\xd7\x5a\x91\x80\x57\x2c\xe1\xdc\x91\x4f\xf9\x63\x26\x7f\x7e\xa0\x21\x7b\xfd\xfa\x53\xb8\x8a\xfe\x73\x41\x1f\x97\x17\x9f\xd8\x80\xfe\x73\x81\xc7\x16\x99\x61\xcf\x37\x1d\x9b\x7c\x25\xbf\x5c\x7c\x8a\xd7\xcf\xf7\x34\xfd\xcf\xc5\xd7\xff\xf9\xe5\xa2\x42\x9e\x39\xfc\x28\xa5\x9b\xdf\xb0\xff\x7e\x67\x8b\x7c\x85\x10\xb2\xdc\x6b\x44\x7c\xf3\x3b\xbe\xf8\x72\x1c\x72\x80\x0c\x82\x86\x43\xec\xfb\x20\x48\xcb\x72\x86\x20\xc0\x20\xf0\xcc\xc1\x34\x00\x7d\x7f\xe8\xd8\x23\x73\x0c\x84\x0c\xf0\x6d\x00\x02\x9d\x7a\x1e\xb6\x03\xe2\x4f\xbd\x11\x1a\x82\xc6\x61\x98\xbe\x6b\xa1\x3b\x08\xe8\x04\x05\xc3\x6b\x08\xa0\x8d\x02\x73\x86\x89\x6b\xde\x4e\x90\xab\x80\x70\x63\xda\x86\x73\x03\x41\x70\x91\x87\x26\x38\xc0\x1e\x04\x18\x2a\x0d\x6b\x8a\x61\xe6\x33\x1d\x8d\xb0\x07\x02\x15\x5a\x26\x43\x34\xc3\x28\x00\x02\x9b\x46\x33\xa0\x87\x0b\x99\x61\x7b\x6c\xda\x8d\xe3\x30\xb0\x1b\x5c\x83\x46\x6c\x38\x76\x40\x86\xc8\x6b\x06\xf4\x50\xa3\xaa\xf0\x6d\x80\x6d\x36\xbb\x1b\x27\xda\x08\x59\x7e\xe3\x27\xc7\x1e\xc6\x36\x88\x8b\x6b\x6c\x8e\xaf\x1b\x05\x6e\x21\x6f\x8c\xfd\x80\xb8\x42\xa3\x8d\xe0\x78\x86\xad\x26\xa0\x09\xba\x2d\xe8\x01\x47\x51\xc5\x70\xcd\x5b\x6c\xf9\x2a\x18\x37\xa6\x11\x34\x4e\x4a\x69\x2b\x1e\xb6\x0d\xec\xa1\x81\x85\x81\x08\x33\xd3\x9f\x22\x0b\x60\x91\xdb\xf0\xc1\x9d\xdb\xfc\x05\xa7\xd9\x6a\x6d\xc7\xe6\x93\xc2\xf1\x26\xc8\x0e\x80\x2e\xd3\x76\x02\x62\xda\x66\x60\x22\xcb\xfc\x8e\x9b\x47\xee\x40\x1d\xac\xed\x40\x9d\xa5\xed\x40\x7d\x4e\xa1\xc5\x81\xd9\xf8\x79\xe1\x4d\x21\x90\x1e\x46\x8d\x6c\x7b\xd8\x00\x4d\x24\x1f\x4d\x5c\x0b\x37\x5a\xa4\x00\x93\x9e\xb1\x19\xda\x72\x6e\x80\xda\xf4\x03\x6c\x0f\x4d\x0b\x36\xd6\x29\x68\x4d\x97\xaa\x01\x59\x69\xe0\x21\xdb\x77\x11\x5f\x54\xf9\xfa\x30\x43\xd6\x54\x09\x4b\xf8\x2c\x65\x34\xa6\x1f\x75\xa4\xf1\x40\x05\x1c\x26\x80\xe6\x21\xcc\xb0\x6d\x38\x8d\x2b\xb1\x8c\xf3\x9a\xc0\x40\xfe\x4c\x04\x0a\xb5\x73\x41\x46\x9e\x12\x98\xe5\xf5\x9e\x92\x24\xa3\xe2\x52\x0d\x00\x3a\x59\xbd\xca\xcb\x06\x00\x60\x1e\xbb\xcb\x17\x66\xa0\xe0\xae\xd8\x0b\xc8\xc2\x56\x30\x56\xf5\x0a\x30\x14\x69\xab\x3b\x4c\x33\x92\xcc\x39\x83\xf9\x29\x72\xd4\x50\xfa\xfc\x05\x64\xa6\x06\x91\x14\x53\x40\xc8\x60\xb0\xeb\x34\xa5\x71\x71\x25\x49\x05\x45\x85\x05\x15\xf2\x38\x4d\x93\x14\x04\xe9\xa6\xc9\x1c\x2d\x16\xac\x3f\x46\x33\xbc\x19\x47\x79\x14\x2e\xa3\xbf\x01\x23\x9e\x84\x7f\x52\xc9\x65\x33\x30\x4f\x1c\x80\xf5\x5f\x9c\x7d\x47\x31\x60\x62\x55\x5f\xd1\x6b\x86\xae\xdc\xf9\x69\x06\x0e\x68\xca\x9f\x39\x03\xd0\x65\xe9\xfd\xb1\x05\x83\x13\xd5\xf8\x55\xd8\xdf\xbf\x6c\x6d\x44\x7f\xff\x22\xf7\xb5\x4a\xbb\xdc\xaf\xd5\x5d\xee\x57\xe5\x5d\xee\x00\x0d\xbf\xc9\x75\xf6\xe2\x4b\x03\xa8\x69\x1b\x24\x70\x08\x0b\x6e\xa6\x1e\x86\x2c\x0f\x35\x28\xa8\x09\x47\x86\x4f\xc4\x72\xfc\xc6\xd0\x64\x62\xda\xc4\xbf\x41\x2e\x31\xed\x00\x7b\x33\x04\x0a\xa7\xd5\x10\x4c\x77\x82\xdc\x82\x01\x20\x34\x28\xb2\xb7\x1d\x28\x55\x09\x46\x2e\x0d\x28\x24\x0f\x71\x03\x28\x34\x64\x9d\x57\x50\x60\x01\x1b\xb0\xed\x50\xd0\x3c\x39\xe4\x63\x47\x3c\x45\xd5\x0c\x2d\x8b\x53\xe1\x08\xd2\x49\x40\x17\x09\xe6\x2a\xcc\x38\xa7\xe9\x8f\x70\xd9\xfb\x64\xbd\xac\x4e\xd6\xcb\x96\x29\x29\x98\x72\xab\xb0\x6c\x77\xe4\x7a\x58\x09\x05\x0c\x3f\x41\xfe\x37\x50\x3c\x2d\xf7\x26\xae\x87\x7d\xec\xcd\xb0\x01\x84\x37\xb0\x1f\x78\xce\x5d\x33\xfc\xd0\x32\x59\x18\x8a\x5c\xb3\x31\x66\x1f\x3a\x96\xe3\xf9\x2e\x60\x47\xb5\x81\x24\x19\x60\x9e\x54\xc0\x2d\xd3\xc6\xa8\x31\x8c\xe5\x08\x05\xab\x90\x08\xba\x70\x8f\x92\x5b\x08\x8a\xdc\x66\x12\x7f\x88\x2c\xd3\x6e\xdc\x23\x5d\x3b\x9e\xf9\xdd\xb1\x03\xc4\xb6\x7e\xbe\x63\x4d\x03\x40\x9c\x6d\x4d\x27\xa6\x8d\xec\x61\xb1\x69\x83\xc3\x43\x4c\xc7\x71\xb1\xcd\xf2\x3f\x3e\x64\xbf\xca\x80\x67\x63\x28\xa4\x20\x8b\x5c\x13\x48\x16\x0e\x69\x4e\xd0\xb8\x91\x33\x9e\xa1\x21\xc8\x77\xf1\x30\x20\x1e\x0a\x4c\xa7\x09\x63\x93\x75\x01\xd9\x8b\x00\x07\x6a\xc5\x1b\x0f\x80\x90\xbe\xc9\xde\xca\x85\x02\xdf\xb0\x4c\x03\xbe\x46\x33\xb3\x79\x63\x37\xb5\xbf\xd9\xce\x4d\xa3\xc1\xcd\xb0\x17\x98\xc3\xe3\x36\xba\xe7\xdc\xd9\x52\x53\xa7\xc0\x3d\x40\x1e\x5e\x82\x20\xb7\x76\x61\xec\xa8\xa5\xfa\x44\x3a\x7c\x39\x7b\x4a\x69\xb8\x68\x06\x67\x01\xa7\xf8\x40\xef\x6b\xd3\x55\x75\x6d\xba\x52\x5e\x9b\x36\x89\xb5\x8b\x2f\x8d\x90\x55\x0f\x26\x47\xd0\x84\xc5\x4f\x0b\xd4\x8e\x02\xca\xe9\x7d\x09\x71\x06\xb3\xf1\xd6\x02\xa8\x08\x0e\x5c\x62\x67\x63\xe5\x55\xb6\x06\x05\xc8\xce\x66\x31\x52\x02\x06\x2d\x74\xb3\xb1\xfa\x5a\x57\x87\xb3\xcb\x4a\x2f\xa6\xfc\xaf\xaa\x29\xff\x4b\xd9\x94\x0d\x3c\x42\x53\x2b\x80\xa6\x67\x27\x53\x2b\x30\x65\xc6\x92\x2f\x98\x33\x4c\x06\xce\x2d\x44\x49\x35\xa8\x6d\xbe\x26\x07\xdc\x06\x75\xe0\xdc\x02\xa7\x51\x9d\x37\xac\x87\x04\x30\xbe\xb5\x22\x6c\x42\xc2\x5a\xd4\x23\x89\x96\x9a\xdc\x42\x2f\x06\xf4\x6b\xd5\x80\x7e\x6d\xe3\x0b\xb9\x87\x9b\xa0\xff\x3a\x5e\x41\xf6\xe2\x0b\x10\xc9\xb4\xd5\x91\xa4\xe4\x5d\xcf\x19\x99\x16\xe6\x31\xb9\x22\x2a\x53\x41\x40\x6c\x27\x30\x47\xe6\x90\xc5\x21\x36\xf1\x03\x0f\x05\x78\x0c\x39\x9f\xd8\xc7\x6e\x42\xb2\x1c\x1f\x6f\xc6\x60\xb3\x65\x1c\x07\x4d\x48\x3b\x63\xe6\x07\x97\x05\xcf\x03\x53\x1d\x7d\xe2\xa2\xc0\x1c\x98\x96\x19\xdc\x75\xa0\x63\xe0\xc1\x74\xac\x88\x33\x72\xbc\x1b\xe4\x19\xe5\x18\x2c\xac\x48\xc0\x73\x06\x53\x3f\x00\xd6\x1d\x48\x1c\xec\x5f\x81\xd8\xb3\x08\x9e\xb1\xa5\xf9\x1a\xd9\x46\xf3\xc0\xfc\x3b\x7b\x58\xe2\x28\x01\x73\xee\x2d\xdc\x5c\xe1\xc0\xb1\x5c\x8f\xf9\x8a\xa1\x33\x99\x20\xdb\xf0\xd5\x70\x21\x91\x32\xff\x88\x1f\xa0\x60\xda\x28\x4f\x0e\x3a\x74\x6c\xc3\x84\x98\xba\x6f\x8e\x6d\x64\x35\x6f\x60\xa7\x36\x14\x92\x7f\x7f\x64\x4d\xfd\xeb\x8d\x3c\x00\x8a\x1d\x39\x1e\x9e\x35\xef\xcd\x02\x73\x82\x9d\x69\x40\xf0\xad\x6b\x7a\xcd\x83\x29\xe5\x40\x7c\x14\x98\xfe\xc8\x6c\x46\x61\x27\x9a\x77\x76\x63\x15\x8c\x60\x13\xdb\xcd\x01\xcc\xd6\xd6\x5b\x09\x98\xf8\x80\x68\x67\x6c\xa9\x47\x3b\xe5\xde\x0b\xb6\x47\x52\xca\xf3\x55\x80\x41\xb9\xc6\x0a\xfc\x95\x0a\xf1\xef\xce\x68\x04\x70\xcb\x15\x8c\xe1\x74\xc0\x96\x1f\x97\xb8\x8e\x6f\xf2\x43\xfc\xdb\x36\xd8\x36\x1e\xa3\xf6\xd8\xe5\xb7\xef\xda\x60\x97\xdf\xbe\xeb\xf4\xed\xef\x9d\xbe\xdd\x88\xcd\xb3\x0b\x9b\xc8\xa9\x09\xdc\x76\x0e\xe4\x23\xf6\xa2\x2b\x79\x51\xea\x35\x9e\xc3\x8f\xec\x40\xc0\xdb\x37\x22\x9a\xe1\xb7\x4a\xde\x01\xe4\xf9\xb8\x81\x49\x61\x39\x70\x20\xf4\xfe\xdb\x23\xe0\xbc\x40\xdd\xc3\x1a\xaa\xc8\x8a\x87\xb5\x75\x12\x6e\x88\x8a\xd9\xd3\xef\x31\xeb\xa4\x2f\x2b\x2c\xb7\x7f\x29\x49\x33\x33\xfa\x76\xed\x91\xf9\x92\xf0\xce\x23\x17\x9f\xb2\xa2\x17\x3f\xff\x6e\x25\x4c\x3e\x1c\x2a\xd7\xd9\x66\x19\x0e\x88\x78\x83\x7c\xbb\xde\xf3\x96\xcd\xa1\x44\x2b\xa4\x22\x86\xa8\xc3\xde\x11\x19\xfb\xff\xf7\x2f\xa5\x4c\x14\xe4\x74\xd9\x5e\x50\x07\x0c\x83\x69\xf7\xdf\xff\xd2\x33\x66\x36\x47\xc9\x23\xcd\x49\xb8\x5c\x92\x55\x9a\xcc\x49\x28\xce\x91\x69\x76\x84\x0f\xc5\xaf\xf0\x53\x78\x22\x8a\xad\xb3\xf6\xe2\x39\x98\x82\x82\x5a\xc3\xa1\x8c\x84\x02\x7e\x5d\x6e\x86\x68\x51\x8d\xd8\xeb\x92\x07\xd6\x8b\x82\xff\x94\x26\xcb\x3e\x65\xc7\x96\x32\xe2\x61\x0b\x23\x1f\x6f\xf6\xe6\xb6\x63\x83\x67\xe4\x41\x22\x5d\xf1\x45\xa8\xab\x45\xaa\x7c\x42\x15\xc2\xed\x5d\x9c\x5b\x19\x00\x55\x31\x4e\x4c\xbb\x3d\xf2\xc8\x42\x63\x5f\x15\x49\xee\x13\x8b\xad\x30\x4b\x23\xb4\x24\x71\x24\x9d\x00\xa5\x58\x9b\x56\x80\x22\xd7\xa6\x17\x5a\xf2\xc2\xf7\xf7\x2a\x6e\xa1\x71\xaf\xdf\x81\xd8\xd6\xbe\xbf\x03\x9d\xdd\xdc\x49\x6b\x32\x07\x72\x28\x50\x7a\xdb\xa9\x89\x13\xcc\x71\x12\x27\x84\xf2\x42\xaa\xbe\x27\xbb\x64\xc5\x76\x08\xf6\x3c\xc7\xd3\xc2\xcb\x82\xde\xaf\x1f\x7b\x73\x53\xce\xe0\xbf\xec\xdc\x31\xb8\x66\x35\xbe\x75\xe3\x3d\x82\x54\x9c\x2a\xab\x61\x15\xb2\x52\xc3\x2a\x6a\x6c\xd5\xb0\xf8\x86\x47\xf5\x4b\x2c\x3e\x54\x44\x09\x3c\x8c\x26\x50\x24\xe1\x4c\x26\xfe\x98\x0c\x3d\x53\x9c\x60\x2a\x63\x1e\x34\xaf\xe3\x68\x37\xc8\xb3\xd5\xb1\x4c\x7b\xe4\xa8\x61\x0d\x91\x65\xf1\xba\xb2\x43\x58\x7b\x61\xad\xc1\xac\x7a\x42\xb3\x4c\xb4\x01\xcd\xd3\x64\x09\xc6\xe5\x47\xb5\x9c\x00\x18\xc5\x0a\xef\xe9\xd2\xb9\xff\x3f\x3a\xcf\xb5\xcc\x51\xb1\x47\x24\x29\x7d\xa0\x29\x6b\x5d\xd0\xdb\x7c\x0d\x3c\x26\x56\x0f\x8f\xb0\xc7\xb2\x62\x3e\x01\xb3\xbc\xdf\xbe\x4b\x07\xe7\x0f\x8c\x5b\x92\xb1\x74\x40\x5f\x2c\x1f\x4b\xfd\x42\xcd\x72\x3b\x5d\xab\x84\x35\x62\x72\x86\x8b\x79\xb3\x3f\x03\xa3\x54\x72\x28\xf0\xcf\x6c\xa5\x52\xc0\x68\x5b\x19\x15\x1d\xfa\xd7\xbc\x6f\x7c\x5c\x92\x79\xb2\x4c\xd2\x6c\x15\xf6\x38\xa9\xb6\x93\xbb\x40\xe3\xd8\x46\x62\x39\xe4\x76\x98\x72\xcf\xa9\x45\x19\xec\xae\x74\xbc\xa0\xa9\xb8\x21\x40\xf8\x95\xdb\x3e\xa5\x56\x4d\x6f\xeb\xe2\x80\xed\xca\xd6\x29\x25\x97\x46\xff\xe3\x2f\xb2\xaf\x97\xe0\xd0\xa7\x82\xc4\xf3\xee\xba\xb9\xbe\xd2\xc8\xf5\x55\x1b\xae\xe5\x81\x80\x6e\xbe\xe7\xeb\x7b\xfa\x1c\xae\xf4\x31\xbf\x9f\xae\xbf\x6d\x21\x8d\xfd\xb4\x7d\x27\x2a\xe5\x58\xee\xba\x50\x29\xc7\x72\xd7\xcb\x58\xbe\xf7\x32\x96\xef\x5a\x2c\xa6\x5f\xf3\xd8\xaa\x2d\x83\x72\x5d\x9c\xab\x10\xf0\x22\x5c\x39\xa7\x50\x8d\x11\x0e\x21\xf5\x23\x48\x72\x1f\x66\xfd\x49\x73\xe7\x7c\x8a\xe8\x1b\xb6\x78\xc6\xe2\xc8\xc0\xd5\x88\x2e\x93\xf9\x9f\x44\xbe\x08\xd1\x9b\x34\xf8\xfe\xba\xd8\xc5\x2a\x64\x47\x6e\x3c\x33\xc0\x6d\x10\x2d\x67\xf8\xad\x0d\x9e\xe3\x06\xe6\x04\x59\x45\x42\x5b\x01\x53\x14\x68\x4a\x3c\x20\x8e\x84\x66\xd1\xd3\xaf\xff\xfe\x95\xe0\x5b\x34\x6c\x8b\xab\x8c\x85\xc8\x6f\xbf\xfd\xf6\x5b\xdb\x6f\x4a\x6c\x20\x1e\x73\x28\xc5\x54\x90\x97\xf2\xa1\x9f\xe4\x8a\x9c\xfa\x6c\x32\x5d\x9b\xf0\x93\x8d\x81\x19\xf0\x8f\x3a\xfc\x66\x94\x2a\x96\xc9\x54\xa9\x86\xe3\x78\xe6\xd8\xb4\x15\x91\xb8\x28\xf8\xe5\xe4\xc3\x71\x45\x23\xba\xb8\x12\xdd\x81\x00\xbf\x89\xdd\x01\x5f\x1c\x01\x75\x20\xb0\xb9\x0c\xa1\x46\xc4\x72\x6e\xb0\x47\x2c\x3c\x02\x63\x4c\x5d\xb7\x09\x63\x6f\xf1\xb1\x92\xf9\x9f\xf2\xf0\x19\x8c\x33\x8d\x97\x47\xb1\x3a\xbb\xfe\xaa\x97\xee\x70\xc0\x7a\x4c\x2b\xec\x62\x0a\xd1\x3d\xf6\xab\xf6\x63\x3f\x7a\x27\x56\xcf\xf1\xf0\xf3\x3a\x0f\xef\x97\x54\xee\x2b\x65\x1b\xaa\xde\xa4\x3f\x99\x06\xfc\x5a\xcb\xd6\x95\x95\x43\x8b\x57\x67\x5e\xe2\xa4\x38\x87\xee\xfb\xf0\xcf\x76\x64\x37\x0a\x2d\xe3\x5e\x85\x29\xbb\xad\x4d\xd6\xab\x45\x98\xf7\x17\xaf\x49\x69\x2b\x85\xb2\x3e\xcd\xc5\xf3\xf9\xe2\x21\x45\x2d\xdc\xca\x1a\x18\x12\xc6\x8b\x34\x89\x16\xbd\xf1\xeb\x5a\x28\x60\xcb\x39\x41\xb6\xe1\x39\xa6\x41\xb4\x8e\xfe\xf1\xfe\xb9\xff\x91\x8f\x07\x13\xbd\xa3\x7e\x11\x6d\xe4\xfa\x1f\xf9\x0d\xba\xb3\x90\xad\x59\xe6\x3f\xbf\x7e\xed\x7f\xe4\xb7\x5f\xbf\xd6\x8d\xba\x11\xc7\x1f\xf2\x28\x45\x07\xc3\x29\x5d\x67\xdc\x2b\xf7\x9f\x77\x17\xd5\xd0\x50\x86\x8b\xea\x65\x28\xfc\xd4\x56\xc5\xd8\xa9\x4c\x86\xa2\xd5\x54\x28\x43\x51\xcb\xea\x71\x25\x04\x0f\x4f\x7d\xbe\x94\xa9\x20\xed\xd7\x73\x43\xbf\x29\xeb\xba\xa1\xe0\xb2\xfa\x5a\xc1\xcf\x97\xdd\x97\x75\xd8\xaf\x78\xd5\xa9\x37\xc3\xb5\x1d\xc5\x13\xd9\xa1\x63\xfb\xd3\x09\x0b\x8b\x11\xeb\x0e\x75\x47\xa6\x3e\x06\x9f\x01\xbb\x9e\x63\x4c\x87\xd8\x23\x23\xd6\x41\x50\xf9\x93\x4a\x58\x92\x2d\x36\x29\xdb\xe1\x0c\x3d\x8c\x02\x6c\xb4\xc3\x75\x6c\x1b\x0f\x03\xd3\x1e\xb7\x42\xc7\x13\x37\xb8\x6b\x85\x69\xe3\x1b\x29\x5c\x34\x43\xa6\xa5\x34\xad\xaa\x74\x1c\xcb\xe8\x85\x8e\x61\xfa\x52\x16\x70\x49\xf2\xb6\x91\x4a\x46\x29\x30\x50\xa0\x10\x8d\xc9\xb3\xc7\xe2\x89\x09\xa5\xcc\xa2\x22\x96\x7c\xc3\xef\xd0\x39\xe2\xf1\xfe\x3e\x2a\x1f\xaa\xe0\xac\xf5\x6c\x69\x84\xf7\x91\xb5\xa8\xed\x9d\xd0\x51\x6d\x1c\x3c\x70\xad\x47\x2d\x5f\x85\x6a\x2f\x60\xd5\x2f\x6e\xbd\x2e\x27\xdf\x66\xec\x44\x43\xde\x6b\x3f\x48\xa3\x2f\xbd\xcd\xe5\xf7\xc8\xe3\x52\x1e\x1e\xf5\xb6\x90\x94\x4e\x1a\x0d\xff\x98\x9a\x1e\x2e\xa3\x8f\xa3\xeb\x43\x83\x5c\xf6\x5e\xee\xeb\xa6\xa3\x6e\xda\xd1\xa9\x97\x34\xc9\x32\x5e\x3f\x4e\xb3\x8c\x3c\xf4\xb7\x87\xb0\x1d\xc2\x4b\x16\x0d\xec\x0f\x3d\xd3\x0d\x8e\x14\x42\xed\x09\x61\x5c\x4c\xac\x51\xb4\xa4\x06\xcd\xe6\x69\xb4\xca\x93\xb4\x95\x87\x65\xad\x1e\x1a\xc9\xf4\x25\xcd\x87\xe8\x21\xe9\x4d\x82\x72\x35\x1b\x99\x23\x87\x58\xd8\x1e\x07\xd7\x8a\xeb\x20\x9b\x07\xc4\x76\x6e\xda\xa0\x95\x93\xaa\x05\x6e\x19\x6b\x81\x15\x56\xf1\x88\xec\xa5\x07\x8d\x1a\x5a\xa5\xc9\x62\x3d\xa7\x29\x09\x97\xec\xd1\xb6\x65\x32\x0f\xf3\xa3\x25\xa6\xdd\xbe\x42\x1f\x97\x32\x81\xd8\xb7\x59\x1c\xdb\x76\x1c\x9d\x10\xae\x1c\x9b\xc6\xa4\xaf\x64\x79\xc9\x5c\x4a\x73\xd2\x4e\x91\xf6\x4b\xb8\x92\x09\xcd\x8c\xbc\x44\xf9\x13\x59\x84\xdd\x8e\x97\x8f\x75\x21\xbc\x89\xf2\x27\x91\x3a\xd3\x21\xa7\x1f\x8f\x64\x15\xb2\x46\x94\x3d\x9f\x90\xcf\xc6\x44\xf6\x7a\x3d\x78\xe6\xdd\x79\xec\x2f\x61\x94\x77\xac\x20\x3c\x78\x23\xae\xef\xf1\x16\xa9\xc3\xb1\x85\x7d\xb2\x0c\x5f\x59\x7b\xc9\xc3\xa3\x56\xa7\x7b\xbf\x4c\xee\xc9\x3c\x9c\x3f\xf5\x6a\x85\x34\x1f\x2c\x93\xfb\x21\x23\x3b\x5a\xc7\xf3\x4c\x7e\x4d\x8b\x64\x64\xe9\x7d\xcc\x7b\x5f\x16\xb7\xcb\x7a\x3e\x36\x90\x75\x1b\x32\x81\x2d\x8e\x4a\x0f\xf0\x04\x45\x77\x3d\x27\x10\x1b\x3c\xe6\x0e\x3b\x12\xdb\x2a\x93\xeb\x81\x5e\x51\x65\xd3\x44\x6a\x4f\xf3\x62\x83\x28\xfa\x90\x56\xbb\x54\xe9\x34\x80\x87\x34\x7c\xa6\xb2\x00\x31\x0f\xd3\x47\xda\xdf\x31\x0b\xdf\xcc\x4b\xc9\x88\x5e\x93\x44\x2f\x27\x49\x69\xbb\x24\x5c\xe7\x09\xab\xef\x4e\x69\xf6\xd4\x23\x43\xec\xb1\x04\xc9\x11\x9a\x06\xec\xfe\xd3\xc8\xc3\xfe\xb5\x56\xbe\x58\xcd\x2e\xd7\x12\xc9\xa3\x67\x9a\xe5\xe1\xf3\x2a\xeb\x8d\x25\x16\xb7\xf9\x01\x9a\xb8\xc4\xc5\xb6\xc1\x92\x47\x07\x58\x39\x8e\x6c\xda\x33\x64\x99\x46\x2b\x64\x5f\x05\x8b\x55\x96\xb3\xca\x37\xd6\x53\x09\x19\xac\x38\xb7\x1d\x76\xd1\x5e\xb6\x1d\x76\xe0\xb0\x9b\xa4\x3e\xbb\xd6\x5c\xe4\x24\x15\xe8\x78\xf8\x8f\x29\xf6\x99\xf3\x2a\x88\x30\x59\xa8\x51\x60\x1e\x8b\x69\xab\xac\xb4\x57\x25\x51\x30\xc3\x92\xee\x16\xaf\x0c\x52\xa5\x30\x32\x3d\x3f\xd8\xa2\xe3\x07\xc8\x53\x67\xc6\x42\xbd\x90\xd9\x1f\xce\xd8\x9d\x92\x91\x69\x9b\xfe\x35\x36\x94\xc9\x15\xb7\xa7\xda\xaa\xc8\x60\x4a\x9e\xb2\x3e\x5e\xc8\xb8\x53\xc6\x66\x58\x3e\x31\xd8\xd5\xde\x46\xd4\xbd\x65\x84\xf5\x39\x4f\x9e\x57\x49\x16\xe5\x49\x1a\x44\xcf\x51\xfc\xe8\x17\xde\xa2\x2b\x21\x55\x7c\x9b\xfe\xcc\x47\xcc\x79\x99\xca\x9f\xe6\x68\x41\xe1\xf2\x5a\x73\xb0\x4d\x46\x6b\x30\xc5\x3c\x75\xbf\x91\x54\x1d\x43\xa7\x8d\x0f\xf8\x06\xa5\xe0\x4a\x6b\x60\xa8\x93\x0b\x39\x7e\x5d\xd7\x9f\x24\x23\xe2\x2e\xd2\x01\x3e\x60\xc8\x23\xa3\x1b\x7e\x79\x60\xab\x40\xc5\x76\x94\xc6\xb0\x67\x93\xc6\x7a\x25\x6c\x92\x3f\x03\x3c\x32\x74\x2a\x72\x95\xd2\x8c\xc6\x79\xc8\xde\x7b\xe6\xf1\x50\x8f\x73\xcb\xad\xd0\x66\x7e\x47\x27\x1f\x29\x9d\x27\xe9\x82\x55\x05\xf4\x66\x89\x1e\x1e\x3a\x9e\xc1\x8f\xcd\xf4\x8c\x9c\xf5\x13\x5e\x5c\x2d\x48\xf6\x14\xa6\x94\x3c\x85\xf1\x62\xd9\x9b\xa3\xdb\xe1\xc5\xb8\x32\xca\xcb\x0a\x97\x06\xf1\xaf\x91\x87\x65\xb7\x3b\x31\x10\x1d\xac\xd1\x1f\xd1\x9c\x92\xc5\x55\x7f\xb9\x70\xe3\xca\xf8\x5f\x62\xe0\x99\x39\x3c\x30\xec\x03\x48\x5f\xbf\x1e\xc5\xea\x81\xd9\xbf\x58\xe2\xb5\xa8\x71\x24\xab\x24\x8a\xf3\x2e\xea\x3b\x5a\xea\xe8\x0a\xea\xba\x58\x29\x98\x60\xa6\x59\xdc\x4f\xba\xdc\xb6\xd2\x23\x8c\x29\x7f\xed\x35\x9e\x17\xcd\x5d\x48\x1a\xe6\x7d\x3a\xa0\x31\xcd\x27\xd9\xdc\x0b\x73\xaa\x4b\x56\x2f\xbc\xcd\x15\x79\x88\x7e\xd2\x05\xc9\xa2\xbf\x3b\x8c\x7e\xc7\x6a\x47\xe6\xad\x7c\x62\x4c\x87\xcd\x7a\x13\x19\x88\xb0\xc2\xb1\xb0\xbf\x14\x05\xef\xc0\x2c\x36\x1f\x76\xd1\xad\x5f\x96\x3d\x61\x83\xbf\x41\x32\x66\x91\x89\x37\xb9\xf8\xd2\x92\xd8\x11\x1a\x3d\x88\x64\xb5\x8c\xe6\x51\x4e\xa8\x3c\xab\xd4\x7f\xb3\xdb\x9c\xb8\x96\x39\x34\x59\x85\x57\x80\x3d\x1b\x59\x5a\x18\x13\x37\x86\xc8\xf3\x7a\x99\x47\x59\xf8\xbc\x5a\x52\xf6\xd8\xf2\x3c\x4c\x7b\x74\xcd\xa6\x3f\x64\xfd\x5d\xe5\x83\x77\xbd\xb3\xc1\x7a\x68\xb0\xe7\xaa\x49\x9e\x88\xcc\x94\x9e\x2c\x54\xdd\x3b\x86\x9d\x87\x2d\x53\x68\x3d\x1e\x4c\x14\x21\xfe\x18\x6b\x19\xb1\x0c\x42\xca\x3f\xf7\x93\xe5\xaf\x50\xee\x7f\x5e\xc9\xe6\xfa\x35\xd2\x68\x42\x11\x21\x7e\x9d\x1c\xeb\x57\x15\xb1\x53\xdc\x94\x08\x6a\x51\x40\x99\x26\xe8\x4d\x4a\xae\x67\x4e\x90\x77\x57\x66\x74\xb8\x17\x12\xbd\x69\x80\x42\x2b\x5d\x94\x87\x47\xc4\x34\xa0\x68\x95\x0f\x1a\x9e\xc3\xee\xc8\xde\xb0\x47\x63\x79\x36\xbb\x05\x8d\x6f\x18\x03\x69\xec\x6b\xae\x94\xaa\xcf\x32\x31\xfc\x3c\xd5\x8a\xb2\xbc\x13\x01\x54\xbc\xa0\x9e\xb5\x23\x23\x1e\x19\x6c\x3f\x0c\x81\xdf\x69\x14\xf2\x41\x2a\x41\xa9\xdd\x28\xfc\xe8\x6f\xda\x06\xf3\x25\x5c\xb9\xc9\x32\x9a\xbf\xea\x99\x45\xbc\xed\x62\x46\x97\x74\x9e\x93\xc7\x34\x59\xaf\x7a\x9b\x4e\xf2\xc2\x8b\x8f\x2d\xd6\x12\x69\xec\x39\x53\x97\x68\xe1\x61\xbb\x97\x57\x9a\xdc\xaf\xb3\x3c\x66\xcf\x1c\x6a\xea\xe6\xb5\xdd\x6f\xad\x86\xa5\x8e\xcd\xf0\x80\x14\xeb\x9b\xe1\x01\x91\xeb\x9b\xe1\xe9\x50\x8f\xdc\xe4\xf6\x7a\x93\x9c\x3d\x94\x2c\x76\xab\x40\x76\x59\xed\xac\x1a\x46\x03\xf4\xa1\x9e\x53\x8c\x57\xe1\x69\xda\x60\x8a\xa7\x2e\xdb\x60\xc2\x9d\xda\x7e\xdb\x27\x8d\x5a\x5f\xa4\xfd\x55\xf1\x1b\xde\xa4\xd0\x0a\x2f\xf7\x83\x2a\xd2\x9b\x90\x09\xf2\xd9\x95\xe7\x91\x41\xf4\xf2\x5a\xdc\x82\x8c\x93\x45\x7f\xc6\xce\xf8\x96\xf7\x1f\x6d\xc7\x38\xcc\x7c\x5f\x7c\x30\xf9\xd1\x94\xe7\x24\x8f\xf0\xd0\x8a\x74\xb2\xa2\xf1\xcb\x43\x6f\x92\x61\xbe\xf4\x66\x54\x18\x05\x3c\xde\xda\xc6\xd3\x28\xca\x15\x4d\xb3\x28\xcb\xd9\xb9\x4c\x8f\xf7\x14\xe5\xb8\xa7\x53\x38\xc7\x86\x67\xb2\xdb\x40\x2d\x50\x6c\xa5\xf8\xb1\xe2\x91\x06\x51\x1c\xa6\xaf\x1a\x85\xcb\x13\x8a\x47\x84\xda\x9e\x28\x61\x7f\xea\x4d\x5d\x62\xee\x6a\xda\x39\x6f\xf5\x3f\x23\xf7\xf9\xe5\x2f\x97\xbf\x90\x65\x14\xd3\xb0\xbf\xd4\x78\x91\x68\x12\xed\xc9\x06\x01\xff\x86\xec\x52\x76\x32\x9e\x56\x7f\xe9\xe5\xc7\xfd\xe3\x04\xfa\x29\xda\x40\xae\xae\x34\x31\x53\xd6\x10\x5c\x69\xe1\xe6\xff\x89\xbb\xba\x25\x37\x71\xa6\x7d\xbe\x97\x91\x1b\x88\x7f\xb2\xd9\xec\xc9\x56\x61\x90\x6d\x6d\x00\xb1\x02\xfc\xb3\x27\x2a\x8f\x4d\x26\x7c\xdf\xd8\x4c\x30\xf6\x9b\xb9\xfb\xb7\xf4\x03\xf6\x64\xc0\x96\x84\x34\xef\x49\x2a\x35\xe5\xa7\xd5\xdd\x48\x42\xb4\xba\xfb\xe9\xb2\xc6\xee\x8c\xbb\x32\xea\xdd\x66\xdd\x95\x6d\xcf\x9b\xe3\xb1\xfa\x5e\x16\xa7\xc7\xef\xf6\x0d\x8c\x9c\x38\x4e\xe6\x18\xa5\xb3\xf9\x3b\x58\x79\xdc\x96\x8f\x0f\x96\x8c\x8a\x5d\xda\x87\xe7\x9d\x6c\xb0\x3b\x01\xb9\x29\x16\xe7\x1e\xbf\x69\xd8\xed\x37\xf4\x56\x93\x06\xd9\x8b\xd2\x5c\xf4\xd6\x87\x61\xba\x22\x5e\xe0\xd0\x08\x6e\x9b\xfa\x37\x40\x38\x20\x53\x94\x62\xd7\x95\xc5\xd5\xc3\x44\xbe\x13\x82\x41\xc7\x89\x5b\x02\x28\xba\xde\xe8\x81\x79\xb3\x22\x1d\xec\x50\x57\xe3\x61\xdd\xa7\x47\x0f\xac\xaf\xf1\x48\x57\xe3\x51\x1f\x8d\x47\x6a\x1a\xaf\xd3\x85\xb8\xab\xe2\x0b\x8a\xb5\xad\x92\xc4\xd6\xec\x94\x4e\x38\x53\x03\xb2\x41\xe7\x18\x05\xce\x35\x7f\x35\xcd\xf8\x0b\x67\xba\x82\x1a\x8a\x61\x0d\x31\x30\x49\x09\x06\xee\xe7\xc1\x50\x11\xf1\xc7\xe0\x4f\x45\x04\x3b\x31\x49\x42\xa8\x9b\xa6\xa9\xef\x0b\x0f\x2b\xa0\x42\x07\x63\xb4\x54\xc7\x09\x5f\x0a\x17\x0e\xfa\x40\x7f\x7f\xb7\xed\x98\xec\x8b\x5d\xfe\x2d\xbf\x5d\xcf\xa1\xb6\x31\xbf\x5a\x51\x63\xdd\x75\x3c\xee\xb3\x8e\xc7\x3d\x76\x9e\x01\x09\x90\x07\xa7\x90\xb6\x49\x40\x7d\x25\xcc\xa1\x96\x84\x61\x6f\x1d\x86\xbd\x75\x18\xf5\xd6\x61\xd4\x5b\x87\x71\x6f\x1d\xc6\x52\x3a\x74\x7c\xf0\xef\x37\x93\xd3\xb7\x29\x4b\x94\x50\x0d\x44\x32\x68\x50\xaf\x2d\x7b\xab\xf9\xd5\xa1\xd1\xd2\x39\xb1\x66\x89\xb6\x67\x45\x93\x7f\x71\x9d\x14\x64\xcc\x1a\x18\x44\x08\x0b\xc2\x0b\x96\x4a\xd2\x62\xc8\x0d\x5c\x93\xa9\xc1\x04\xa8\x61\xc1\xea\x0e\xb6\xb7\x03\x59\x6a\xc7\x39\xcf\xfe\x23\x52\x92\x8c\x79\x8d\x71\x68\x2f\x20\x58\x12\xf6\x8f\x8b\xd2\x30\xb1\x62\x41\x71\xaa\x9e\x4f\x95\xd9\x9e\xbc\x21\x22\x28\x4d\xa2\x94\x96\x95\xac\xdb\x63\x54\xb7\x61\xe2\xe9\x49\xa1\xe8\x2d\x8c\xce\x68\x57\x38\x95\xe1\x18\xa3\x78\x53\x76\x23\xbd\x2f\xcd\xb2\x0a\x31\x4f\xfb\xac\x7c\x53\x1d\x17\x15\xa5\xc2\x36\x78\x35\x96\xce\xc5\x91\x39\xb8\xe2\xe5\xd3\xc5\x56\xfd\x71\xfb\xa3\x3b\x95\x36\xb5\xda\x4c\xdf\x60\xb9\x38\x91\xde\x1b\xe9\xef\xd9\xcb\x59\x05\x20\x3a\x11\x21\x4c\x2c\x7a\xc5\xce\x45\x4e\x04\x23\x40\xa3\x2c\xea\x57\x39\x6c\x5b\x80\x9e\x15\x93\x9f\xf3\x9f\xd9\x93\x48\x03\x25\xdf\x9e\x0a\xdb\xc9\xa0\x92\x96\xb7\x62\x79\x4e\x6c\x2f\x09\x3e\x72\x12\x3b\x7e\xac\xbb\x30\xf6\x7b\x81\x49\x50\xf1\x4a\x6f\x26\x37\x18\x79\x35\x65\xbc\x22\xe6\xb5\xea\x45\x7e\x61\x65\xbe\x9d\xa5\xc5\x5b\xd1\xf7\xeb\x22\x6a\x55\x7b\x6b\x5d\x44\x5b\xb4\x96\xed\x22\x6a\xd7\xe0\xed\x83\x05\x83\xdd\x89\xba\xc1\xee\xc4\xaa\xc1\xbc\x1c\x8b\x14\xcf\x9b\x1f\x27\x83\x4b\x4b\x14\xf4\xa2\xc8\xf9\x27\xb5\xb4\xb2\xca\xa2\xca\xb6\x55\xb6\x63\xdf\x84\x26\x99\xb8\x2f\x7d\x2f\x58\x06\x9b\xa5\x4f\x9b\x8b\xfe\xa2\xb6\xe6\x86\xfe\x6a\x92\x45\x9b\xa4\xa6\x29\x5c\xf6\xf8\xc4\xcf\x30\xfa\x1e\xba\xd3\x49\x8d\x1f\x4e\x6d\x78\xa9\xae\x3b\x72\x13\xe7\xcb\xe7\x21\x19\x93\x7d\x56\x6d\x68\x6f\x29\x63\x4f\xbb\x91\x1c\x38\xab\xe6\x81\xfb\x70\x36\x4f\x04\xbb\x55\x8b\x59\x77\xe5\xd4\x2d\x36\x01\xa6\x8d\x54\x3a\xe5\x18\x73\x4f\x1c\x44\x09\x18\x0d\xbe\x7c\x36\xef\x9f\x8b\xe8\xe6\xce\x56\x24\x8d\xe3\x95\xac\x6f\x6e\xc8\x58\xf7\x97\x31\x33\xa0\xc7\x6c\xdd\x5f\xc6\xc4\x80\x1e\x13\x0d\x3d\x96\x73\xda\x2e\x83\x91\x9c\x90\x55\x3f\xb8\xc6\xe8\x74\xd9\x5c\xa8\x3b\xd4\xe1\x30\x54\x87\x07\x20\x71\x3c\x27\x71\x48\xec\x3a\x3e\x0c\x67\x56\xd7\xd6\xb6\xd8\xd3\x82\xe8\xe3\xed\xe4\x40\xc5\x45\x25\x38\x89\xe8\xb7\x0c\x06\x71\xac\x90\xc6\xdc\x06\x15\x61\xfd\xbe\x12\x46\x7d\x74\xe0\xdf\x61\x34\x9f\x9b\xb3\xe5\x9b\x11\x75\x23\xbe\xac\x21\x6d\x38\x89\x5c\x43\xa2\x46\xe6\x44\x8d\xcd\x89\xfa\x64\x4e\xd4\xef\xe6\x44\x7d\x36\x27\xea\x0f\x73\xa2\xbe\x98\x13\xf5\xa7\x39\x51\xc3\x81\x41\x59\x06\xe7\xfb\xf0\xe6\x84\xef\x2a\x4d\x17\x9b\xa5\x7b\xd9\x42\x69\xe1\xb5\x95\x3b\xaf\xff\x55\xeb\x4a\x2b\xb6\xd0\xab\x2e\xca\x68\x61\x52\xfb\xf4\x70\xb4\x5a\x9e\xf8\x72\x3a\xd7\xef\x4c\x7d\xad\x7f\x99\x94\x34\x77\x02\x61\x4f\xfe\xde\x84\x02\xc2\x34\x98\x00\x4c\x90\xb8\xe6\x95\xae\x57\xa2\xd8\x38\x9d\x88\xec\x1b\x05\x90\x07\xa2\x64\xae\x91\x14\x12\x33\x6e\x91\xd0\xa3\x25\xd2\x0a\x38\x66\x15\x99\x44\x91\x0a\xa8\xbb\x94\xb9\x0b\xc1\xfd\x4e\xff\xa7\x0e\x5a\xa4\x3a\x23\xad\x35\x86\x4a\xd7\x8b\xb5\x3a\x6a\xbd\x58\x6b\x68\xb8\x58\xa7\x1a\x63\x39\x8a\x2e\xbc\x4c\xc1\x4f\x64\x44\x06\xfa\xd0\x91\x2e\xf4\x13\xf9\xa4\x3b\xfd\x7d\x18\xc0\x04\x78\xba\x70\x96\x95\xa5\x80\x7d\xb5\x84\x14\xd2\xcc\xde\x60\x15\x12\xce\xde\x60\x55\x53\xcf\x2e\xeb\x57\x13\xf6\x45\x0f\x36\x1c\x10\xd3\xfb\xfe\x1c\x8a\xe2\xfd\xbb\xf4\xb3\x6a\xfb\xbe\xa8\xc2\x17\x2c\xc4\xec\xd3\x96\x65\xe9\x7c\xf8\x28\xf3\xa6\x13\xf7\x13\xd7\xf7\x12\x73\x68\xdc\x6e\x9a\x5a\x43\x63\xc5\x9b\xca\x64\xd9\x2d\xbd\xe1\xa2\xc1\x62\x27\xe9\x32\xb7\x15\x42\xb3\xb5\x15\x7f\xef\x28\x01\x9c\xf6\x11\x7a\x79\x11\x06\xb3\xa6\x86\xf9\xb9\xcc\x8b\x32\xaf\x5e\x0c\xba\x92\x57\x1f\xb3\xde\x2a\x30\x59\x8b\x28\x1c\x0c\x66\x1f\x3e\x6a\xa0\xe7\x70\x36\xd7\x06\x07\xc0\x83\x69\xa0\x0d\xf7\xd1\xb2\x0d\xdb\xdb\xf7\x82\x39\xfa\x69\x73\xc8\x04\xf5\x8b\xb9\x99\x2c\x9a\xdd\x89\x63\x07\x4b\x18\xe2\xfb\x5e\x0c\x22\x87\x7d\x51\x48\x7a\xe3\xb5\x20\x2e\x43\xa4\x7f\x9a\xf6\x49\x00\x62\x87\xd5\xb4\x9a\x6d\x18\x4f\x53\x14\x84\xfa\x62\x65\xd3\x81\x3e\x7c\x54\x42\xa6\x31\xd0\x81\x89\x01\xe9\xea\x1d\x8f\x74\x04\x68\x40\xe2\x04\x43\x4f\x4b\x5b\x6a\x64\xec\x3a\x21\x65\xb7\xd3\x85\xb3\x2e\x76\x9a\x60\x37\xc5\x31\xba\x61\x73\xc7\x9b\xc6\xc3\x01\x23\xa5\x97\xc7\x81\x9f\x74\x62\xdd\xc2\xf5\x9f\xc8\xaf\x53\xba\xb3\x9f\xfd\x2a\x6c\xba\x4c\x08\x9c\x49\x3a\x65\xd6\xb3\xe4\x2a\x65\x17\x5c\xf0\x76\xbc\x60\x9f\x7c\xd4\xb2\xde\xe2\x93\xfa\xc9\x64\x77\x8f\x46\x7f\x11\xf4\xf1\x69\x57\x0f\x3b\x76\xfc\xa0\xb3\x82\xec\xca\xfc\x6c\xb4\xf5\xe1\x2c\xab\x44\xe6\x8b\xc7\x44\xbb\x8c\xc4\xf8\xc3\x47\x1d\x6c\x48\x4b\x9f\x4d\x1a\x1e\xa2\xaf\x3c\x1c\x55\x32\x46\x60\x3b\x11\x28\xce\x36\x1c\xa2\xaf\x36\x55\x1f\xd9\xd4\x7d\x64\x43\xf9\xba\x39\xe5\xb7\xb2\xd8\x13\xc3\x5f\x25\x6b\x02\x43\x5a\x0e\x05\x3c\x62\x5c\xf5\x05\x19\xef\xce\x39\xfd\xab\xf1\x20\x1a\x23\x51\xa0\xdd\x40\x00\x22\xe1\xc2\xb4\xda\xbf\x1e\xe0\x49\x99\x6d\x9e\xfa\x35\x0d\xbe\x77\x1e\xc6\xc0\xf1\x59\xc7\x76\x1b\xd6\x9c\xb3\x92\xbe\x37\x79\x47\x44\x83\x36\x88\x5c\x00\x7e\xda\x88\x5b\x1e\xc4\x4d\x58\xdd\x41\xd1\xba\xc5\xa4\xcc\x8e\xc5\xd3\xd9\x82\xe5\x22\xbc\x84\x41\x8c\xfc\x05\xd0\x73\x40\x83\xae\xef\x05\xfb\x49\x61\x17\x95\x16\x5c\x7a\xda\x6d\x48\x76\x36\x99\x93\xe4\xa6\x9e\x43\xc0\x82\x66\xbd\x8a\x0e\xcd\x72\x96\xb3\xba\x94\x2b\xb0\x1e\xaa\x21\xc0\x30\xef\xab\x5d\xf6\x5c\x7d\x27\x87\xe2\x60\xb8\x9c\x9d\x07\x25\x41\xe8\x22\x46\xb8\x22\x67\xf7\xaf\xa0\xf6\xe9\x21\x87\x14\xb5\xf3\x36\x3c\x46\xb3\x60\xc9\xf6\xb4\x33\x98\x04\x45\x9f\xb5\x48\x83\x35\xaf\xb1\x20\x06\xb8\xd7\xbd\x46\xf5\x40\xc1\x3e\x38\x78\x53\x7c\x71\x9e\x7b\xab\xfa\x5d\x24\xcf\x80\xd6\x00\xf2\xb4\x67\xf3\xce\x7a\x2e\x8e\x15\x8d\x8a\x9d\xb3\x92\x76\xf6\x3b\x1d\x76\xf9\xe1\xf1\x86\xd7\xd4\x85\x1f\x4f\x0f\xe2\xa2\xd4\xd8\xfc\x89\x50\x9c\xd0\x2b\x84\xfa\x63\x3a\x4e\x23\x5a\x9f\x40\x0f\x4a\x92\x9e\x8d\x8a\x63\x15\x9f\x1e\xf8\x01\xd1\xbc\x57\x7f\x9c\x36\xbb\xf2\x44\xdf\x6f\x86\x2d\xff\x27\x75\x3c\x9c\xd2\x97\x8a\x30\xdd\xbc\xee\x97\xf6\x8e\xe4\x9c\xef\xb2\x82\xec\xb3\x7d\x51\xbe\x90\xe7\x53\x69\x30\x4a\x35\x03\x21\x60\x71\x39\xde\x61\x11\x85\x64\x01\x3d\x80\x48\x00\x02\x84\xd7\x24\x4a\xf1\xcc\xc6\xde\xd0\x92\xac\x6a\x36\xfa\x26\x18\x3d\x1b\xca\x55\x18\x38\xad\x86\xdc\xc0\x72\x88\xe3\x79\x3a\x30\x0c\x02\xb4\x00\x3a\xc8\x0b\x43\xbb\xec\x22\xe2\xb4\xd7\x2c\x1a\x53\xa7\xe6\xba\xc5\xe1\x90\x6d\xab\x70\xa1\xcc\x15\x5b\x4b\x00\xf4\x20\xa3\xa6\x81\xe0\x65\x66\x8a\xa8\x21\x05\x27\x73\x07\xd2\xf4\x64\x6b\xe8\xb2\xc9\xcb\xe9\x6c\x6c\xc6\x35\xb7\x6e\x83\x86\x5e\x23\x0d\xa1\xec\xd9\xab\x41\x0f\x7b\xa1\x47\x52\xe8\x8e\xa7\xd0\xc9\xd3\xcd\x2b\x17\x8f\xf6\x9e\x0c\x23\xcc\x16\x9d\xa8\x8c\x6f\x01\x18\xc5\x71\x93\x71\x6b\xd9\x86\xe2\xe1\xff\xb2\x6d\x65\xc7\x04\x34\xf9\x9b\xf6\x17\xb6\x6c\xc1\xf3\xa6\xac\xf2\x3b\x2d\x31\x7b\x18\x11\x39\x38\xe1\x14\x72\xb6\xed\xe0\xf4\xeb\x96\xac\xc0\xc8\xa5\xc1\x53\xcb\x36\x1c\x5f\x8e\x55\xb6\xb7\x63\x42\xbc\x8e\x13\x10\xd8\xb3\x60\xb7\xdf\x98\x56\x9c\xb6\xf1\x78\xab\xef\x1d\x40\x0c\xf0\x02\x60\x7b\x76\x52\x7a\x7a\x72\xb8\xcd\xc6\xad\x66\x6d\xcd\x16\xca\xab\x49\x94\x0c\x66\xe1\xb1\x1a\x6f\xd7\x64\xda\x97\xe3\x7b\x59\x1c\x8a\x93\xf1\x35\xc6\xd8\xf9\x69\x30\x62\x8e\x51\x88\x52\x8b\xcb\xec\xdb\xd3\xa9\x0f\x87\x6d\xc7\x7b\x74\x4a\xa5\xda\xd3\x99\x92\x2e\x92\xa7\x7c\x9f\x1b\xcc\x40\x89\x30\xf2\x52\x17\xe0\xab\x32\x26\xd6\x38\xec\xad\x15\x6d\xe8\xe6\x94\x7d\x17\x6d\xc8\x07\xc6\xcb\x9d\xa8\xe2\x62\xf6\x35\x55\x26\x13\x1f\xb9\x5f\x63\x49\x17\x74\x0a\xe0\xbc\x4d\xfa\x42\x12\x94\x38\xbe\x8a\x90\xcb\xa3\xac\x45\xa8\x3e\x44\x35\x5c\xbd\xdd\x28\xc2\xea\x9f\x0f\x94\x1c\x24\x40\x43\x1d\xd0\x48\x07\x34\xd6\x01\x0d\x78\x87\x01\x35\xd0\x50\x07\x34\xd2\x01\x8d\x6f\x82\xde\x6c\x6a\x6f\xfb\xe8\xcb\x22\xe3\xac\xe2\xdf\x77\x81\x58\xaf\x4a\x43\xde\x83\x1a\xda\x4b\x8a\x32\x7f\xcc\x8d\x9f\x76\xf9\x2e\x88\x30\x9c\xc1\x90\xac\x24\x1f\x50\x1b\x76\xad\x83\x0d\x9c\xbf\x69\xf2\xde\x0a\xc6\xaa\x8b\x9f\xdd\x1a\x22\x4c\xf3\x30\x9d\x8e\x63\xfa\xcd\x4d\x47\x13\xef\x83\xa9\xec\xcb\x06\xb3\x22\x5d\xb9\xdf\x26\x28\x92\xfc\xe5\x04\x25\x09\x0a\x24\x7f\xbc\x52\x71\xed\xba\xeb\xc7\x86\xe6\x6f\x99\xed\x8b\x3e\x54\x89\xed\xf3\x28\x4e\x68\x50\x10\x86\x30\x81\x8e\x0f\xff\x95\xbf\x4c\x11\x78\x85\x6d\x49\x20\x68\xfd\x3d\x72\x91\xaf\x86\x02\xa1\xc7\xf2\x84\xd5\x50\x3e\x72\x1d\xc5\x81\x9a\x19\xae\x84\x6a\xd6\x95\xea\x58\xdc\x15\x53\xcf\xe6\xbc\x39\x66\x06\x23\x15\x3c\xf4\x5f\x13\xf5\xc8\x59\xdb\x60\x68\x06\xa0\xec\xbb\x01\x67\xc7\xfa\xb5\x62\xcd\x37\xc7\x62\xfb\xff\x26\x9d\xf3\xcb\x63\x8d\x91\xfb\x55\xde\x4b\xfc\xc7\x6a\xf7\xbf\x1c\xd3\xb1\x08\x8d\x3a\x89\xe4\x07\x93\x9e\xba\x52\x1c\x86\x20\xb1\xae\xfd\xe9\x90\xff\xb4\xa2\x7d\x1a\xc2\x95\x45\xed\x8d\x93\x26\x86\x60\x29\x0e\x0f\xb2\x0b\x91\xd7\x5b\xf0\x95\x18\xbf\x1c\xb6\x16\x8c\xb5\x4d\xb9\x7a\x23\xc3\xa1\x53\x04\x7d\x33\xa6\xb1\x2c\xa2\xe6\x8c\x97\xfb\x79\x1a\x2a\x02\x98\x46\x53\x3f\x8d\xe7\x17\xa3\x26\xd2\xd7\x06\x53\x84\x41\x7b\x70\xac\xed\xd7\x8e\x8f\x81\xe3\xad\x55\x6d\xa2\x31\x29\x9a\xd9\x0d\x56\x11\xc4\xd2\x28\x17\x85\x1e\x8f\x13\xc7\x4e\x02\xe3\x29\x94\x46\x5e\x9a\xba\x2a\xfc\xfe\x32\x9c\x02\x88\x53\xfa\xcb\x01\x42\x24\x96\x99\xd2\xe2\x62\xac\xff\xed\x6b\xab\x1d\xe6\x65\xc7\xaa\x2c\x5e\x54\x20\x6c\x0c\xd9\x1f\x73\xc2\xd3\xe5\x26\xaf\x54\x86\xb8\x10\xa4\xca\x22\x66\x19\x1b\xa0\xeb\x73\xb3\xff\xc6\x42\x03\xeb\xa4\x5f\xbe\x62\xbb\xd2\x54\x70\x92\xef\xb3\x29\x05\x66\x87\xed\x8b\x92\xc9\x35\xda\xbc\xc9\x55\x99\x5b\xc8\x83\x48\x30\xb4\x97\x04\x91\xc0\x7f\x41\x28\x0a\x1a\x44\x32\x91\x61\xf5\x5f\x57\x19\xb1\xf1\x6c\x5b\x60\x3a\xcd\x57\x98\x50\xf7\x71\x30\x6f\xc3\xd2\xe7\x64\xdb\xa2\x95\x61\x4d\x86\x64\xcc\x80\xba\x93\xa1\x78\x08\x4b\xff\xc3\x47\x79\x10\xbb\xf4\x96\xc4\xd4\x37\xe3\x6b\x92\x92\x85\x3a\x46\x1d\xb2\x4a\x57\xaa\xa0\x86\x57\x59\xcd\x0d\x57\x29\xea\x4b\x5f\x6e\xbb\xa1\x44\xbf\x4b\xfe\x48\x45\x6c\x4f\x16\x99\x1e\x1e\xb4\xb1\x2c\xb0\x27\xa0\x3c\xe9\x6b\xe9\x9b\x9e\xad\x82\x26\xb7\x9e\xaf\x7c\xcb\xa0\x44\xf1\xbd\x2b\xfc\xde\x98\xc3\x8f\xc0\xaf\xec\x99\x96\xc5\x9e\xe5\xb1\xe8\x19\x76\xf5\x87\xe3\x5f\xbf\x51\xc4\x63\x7e\xac\xca\x97\xbf\x7e\xfb\xef\x00\x34\x4a\x36\xe5\x91\x6c\x02\x00")
+
+func registryEglXmlBytes() ([]byte, error) {
+	return bindataRead(
+		_registryEglXml,
+		"registry/egl.xml",
+	)
+}
+
+func registryEglXml() (*asset, error) {
+	bytes, err := registryEglXmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "registry/egl.xml", size: 158865, mode: os.FileMode(420), modTime: time.Unix(1792218352, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _registryGlXml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x7d\x5f\x73\xdb\x3a\x92\xef\xfb\x7c\x0a\x55\xa6\x6a\x6b\x66\x2a\xf7\x84\xff\x45\xdd\xc9\x39\x5b\x20\x00\xca\x9c\x50\xa4\x0e\x49\xd9\xc9\x79\x61\x29\xb6\xe2\xe8\x8e\x2d\xfb\xca\x72\x26\xd9\x5b\xf7\xbb\x6f\x81\xa4\x64\x40\x02\xbb\x41\xc5\xf3\xb0\x27\xb3\x5b\xb1\xc3\xdf\x0f\x0d\xa0\xd1\x00\x1a\x8d\xc6\xfb\xff\xfc\x7e\x7f\x37\xfa\xb6\xda\x3e\xad\x1f\x36\xbf\xbe\xb1\x7f\xb1\xde\x8c\x56\x9b\xeb\x87\x9b\xf5\xe6\xf6\xd7\x37\x8b\x2a\xfe\x5f\xe1\x9b\xff\xfc\xed\x4f\xef\xb7\xab\xdb\xf5\xd3\x6e\xfb\xe3\xb7\x3f\x8d\x46\xa3\xd1\xfb\xeb\x87\xfb\xfb\xd5\x66\xf7\x5b\xb9\x59\x3e\x3e\x7d\x7d\xd8\x8d\x1e\xbe\x8c\x76\x5f\x57\xa3\xfc\x71\xb5\x99\xa6\xa3\xe5\xe6\xa6\xfb\x2b\x2f\x47\x7b\xe8\x68\xbb\xfa\xfc\xbc\xbe\xdb\x8d\xbe\x6c\x1f\xee\x9b\xaf\x3f\x7c\xdd\x3e\x6c\x1e\x9e\x46\x5f\x57\xcb\x9b\xd5\xf6\x49\x90\xdc\xde\xad\xbe\xef\x7e\xf9\xba\x97\x68\xe4\x58\x8e\x63\xf9\xae\x35\xfa\xcb\xdd\xfa\xf3\xed\xdd\xb7\xcd\xcd\xc8\xfe\x25\xf8\xc5\xfa\xdf\xa3\x69\xfa\xee\xf6\xee\xfa\x61\xbb\x5a\x6e\x3f\xff\xf2\xf5\x6d\xfb\x73\x03\x16\x7f\xe7\xa5\xfb\xee\xf6\xce\x75\xf6\x3f\x39\xef\x6e\xef\x9c\xe6\x5f\xff\xfa\xcb\x88\x6f\x9e\xef\x47\xb7\xdb\x87\xe7\xc7\xa7\x46\xd4\xc7\xe5\x76\x79\xbf\xda\xad\xb6\xa3\xbb\xd5\xe6\x76\xf7\xf5\x69\xf4\xb0\xb9\xfb\x31\xba\x7e\xf8\xb6\xda\x36\x82\x8a\xea\x36\xbf\x7a\x7e\x5a\xdd\x34\x3f\x2d\x37\x37\x4f\xbf\x8c\x8a\xd5\xe3\xdd\xf2\x7a\x35\xfa\xd7\x7a\xf7\xb5\xf9\xf0\xf9\xf1\x69\xb7\x5d\x2d\xef\x47\xb7\x77\xbf\x88\x86\xfd\xd7\xd7\xd5\x66\xf4\xf8\xf0\xf4\xb4\xfe\x7c\xb7\xfa\xe5\xfd\xbb\x7d\xbb\xb5\xad\xb8\xfb\xf1\xb8\x7a\x6a\x5b\xf4\xf0\xf3\x68\xb3\xbc\x5f\xfd\xfa\xe6\x69\x77\x73\xb3\xfa\xf2\xe6\xb7\x3f\xaf\x37\xd7\x77\xcf\x37\xab\xd1\x7f\xdc\xed\xfe\xde\xfe\xf2\x97\xaf\xff\x71\xbb\xfb\xfb\xfb\x77\x02\xad\x07\xff\xf3\xeb\xf6\xf1\x6e\xb9\xfb\xf2\xb0\xbd\x3f\x62\xf8\x70\x51\xbc\x93\xfe\x15\xa7\x5a\x6f\x76\xe2\x1f\x9f\x8e\x78\xf6\xbf\x06\x08\x7e\x13\xbf\xbb\x59\x7d\x19\x3d\x6f\x9e\xd6\xb7\x9b\xd5\xcd\x68\xbd\xd9\x8d\xde\x0b\xda\xdf\xa6\xe9\x6a\xf3\x7c\xff\xfe\x5d\xf3\x83\x29\xfc\xfa\xeb\x72\x7b\xc0\x7f\x7e\x78\xb8\x5b\x2d\x37\x03\x29\x64\x09\x3e\xaf\x77\x5f\xd6\xab\xbb\x1b\x90\x62\xd4\x75\xd8\xaf\x6f\xb2\x87\xdd\x68\xb9\x19\x2d\xaf\x77\xcf\xcb\xbb\xd1\x34\x1d\x89\xaf\xdf\x8e\x76\x5f\x1f\x9e\x6f\xbf\xb6\x7a\xb1\xde\x1c\x34\x79\xbd\x69\xd4\xe1\x71\xf9\xb4\x7b\x73\x10\xe3\xdb\xc3\xfa\xe6\x50\xbc\xf8\x01\x2e\x7a\xbb\xfa\xbf\xcf\xeb\xed\xea\xe9\xa8\x3f\xf7\x6c\xff\x6c\x07\x4f\xbd\xde\xec\xc2\x5a\xaa\xd6\x8f\xdd\xea\x55\x78\x9f\x8f\x88\x9f\x5f\x8d\x79\xbd\xd9\xd9\x81\xc4\xfc\xf4\xf5\x61\xbb\x7b\x35\x99\x15\xea\x67\x9c\xfb\x40\x22\x2b\xc7\x7a\xb3\xfb\x09\xd5\x7a\x5e\x6f\x5e\xa7\x3e\xeb\xcd\xce\x75\xa4\xea\x5c\xdf\x2d\xef\x1f\xbf\x0f\xaf\xce\xd3\xfa\xbf\x56\xeb\x57\x91\xe8\xcb\xdd\xc3\x72\x27\x49\xd4\xfc\xfc\x6f\x61\x6e\xea\xfa\x05\xa4\x3e\x70\xdc\x3c\x3c\x7f\xbe\x5b\x1d\xa0\xed\x8f\x67\x41\x9b\x52\x6f\xcc\xa0\x62\x08\x8f\xfe\xb6\x47\xae\x6e\xef\xe8\xdd\x7a\xb5\xd9\x45\xcf\x5f\xbe\xac\xb6\xfc\x63\x75\x26\x4b\x72\xbf\xbc\x5d\xe5\xbc\x34\x83\x2b\x56\x51\xfc\x70\x26\x8c\x14\x11\x88\xec\xe6\x83\x69\xfa\x75\xb9\xb9\xb9\x5b\x91\x22\x7a\xf3\xdb\x9f\xd7\x5f\x04\x57\x5d\x93\xf9\x3c\xe5\x75\xfd\xa7\x3d\x7b\x5b\x27\xe9\xdb\xbf\xff\xe9\xcf\xab\xbb\xa7\xd5\x9f\xb4\xc3\xe6\xe8\xbb\xcd\xcd\xfa\xcb\x4f\x69\xd2\x89\x15\xf8\xba\xbc\xfb\xf2\x2a\x3a\xaa\x65\x46\x5b\xee\xcc\xc1\xfe\x65\xfd\x7d\xf5\x6a\x53\xc4\xe3\x6e\x2b\x51\xb7\xbf\xf8\x77\x72\xbf\x56\xa3\x3c\x09\xd3\x25\xb1\x8b\x1f\xd7\xaf\x25\x7b\x1f\xf9\x2b\xf6\x68\xe0\x49\xfc\xcd\xcf\xff\x3e\x66\xcc\xe4\x98\x92\x3f\x1f\xb3\x3f\xbf\x9e\xe0\x3d\xdc\xc6\xd6\xf2\x69\xb7\x7d\xbe\xde\x8d\xea\x7a\x9a\x3e\xfd\xd8\x5c\xbf\x18\x4e\xf1\x93\xe1\x52\xee\xfa\xe1\xfe\x71\xb9\x13\x2b\xf2\x76\xe1\x2e\x76\x2a\x34\x1d\x5d\xdf\xd5\xd7\x0f\x9b\xdd\xea\xfb\xee\xcd\x6f\x2d\xeb\xbe\xb0\x97\x7f\xf9\xe9\x12\x56\xdf\x56\x1b\x1d\x7f\xf3\x7b\xb3\x26\x68\x8c\xeb\x5f\xde\x2f\x1f\xc5\x64\xb3\xfd\xf1\xee\xb7\x97\x46\x60\x3c\x5a\x4c\xe7\x45\x4e\x3b\xa6\xbf\xfe\xa5\x5d\x69\x8f\x9e\x1e\x9e\xb7\xd7\xab\xb7\xdd\x4f\x82\xe9\x6d\xbb\x4c\x19\xad\x6f\xf6\xbf\x7d\x5a\x7d\x5b\x6d\xd7\xbb\x1f\x6f\xbb\x81\xd0\x6d\x86\xde\x5e\x3f\x6c\x9e\x84\x91\x6e\xa6\x8c\xbf\xdd\xaf\x9e\x9e\x96\xb7\xab\xee\xb7\x8d\x2c\x7f\x7b\x7e\x5a\x6d\xe7\x62\x0f\xf5\xd7\x57\x10\xfd\x65\xf0\xfd\x4f\x94\xfe\xc3\x45\xf1\x3f\xb9\xed\x67\x4c\x92\xfe\x48\xc6\xeb\xe5\x6e\x75\xfb\xb0\xfd\x71\x9e\xcc\x66\xd2\xfe\xd4\x44\x9c\x5d\x76\xc2\xa3\xdc\xfb\x59\xea\x85\x78\xff\x9b\x03\xe1\xb7\x9b\xc7\xe5\x73\xf9\xbc\xfd\xb2\xbc\x5e\x21\xc4\x26\x4d\x7c\xb9\x48\x3f\x90\x4c\x68\xc8\x81\xec\xaf\x7f\x11\x6d\xa2\x36\xc4\xfb\x77\x92\x4f\xe0\xbd\x68\xe6\xa7\x66\xe1\xf5\xf4\xb8\xbc\x6e\x56\x5f\x6f\x5a\xbf\xc5\xaf\x6f\xe8\xdd\x6a\xb9\x6d\x97\x9a\xb3\xe5\xd3\x3f\xdf\x34\xc3\xe2\xd7\x37\x9f\xd7\xbb\xfb\xe6\xc7\x83\x2d\x9a\xa6\xf5\xff\xa3\x79\x9a\x17\x6f\x19\x9f\x57\x17\x6f\xcb\x8a\x67\x34\x49\xff\x7f\x1d\x2d\xe2\x98\x17\x75\x94\x54\xa3\xcf\xeb\xdd\xd3\x1b\xa9\x56\xa2\xe0\xd1\xb7\xe5\xdd\xf3\xea\xd7\x37\xd6\x77\x4b\xfc\x67\x5b\xd6\x9b\xc3\x1a\xb0\x6e\xa8\x24\x86\x5e\xb1\xde\xc1\xac\x9e\xc2\xda\x89\xf6\xf3\xbc\x9e\xa5\xf0\x36\xd5\x1f\xc0\xfa\xfe\x9d\xa0\x04\x7b\xe1\x69\xb7\xdc\xee\xf6\xc5\x09\x97\xd9\x8d\xf8\x21\xee\xfe\x7b\x33\xfa\xb6\xda\xdc\x3c\x6c\x7f\x7d\xd3\xac\x93\x7b\x44\x95\x24\x8c\x49\x5a\xf2\xde\x4a\xd9\xd2\x97\x55\xb1\xe8\xff\x70\x2f\xce\xe1\xeb\x79\x9e\x64\x55\x79\xa8\xef\x7c\xbb\xbe\x5f\xef\xd6\xdf\x56\xd5\x8f\xc7\x15\xcc\x22\x97\x99\x26\x19\x3f\x8b\xc4\x39\x22\xa9\xd3\x3c\x9f\x9f\x43\xe4\x1e\x13\x95\x55\x91\x9c\xc5\xe4\x49\x4c\x55\x91\x90\x4c\x78\x07\xcf\x21\xf2\x35\x44\xe7\x8b\x15\xe8\xd8\x62\x92\x9d\xc3\x35\x96\xb8\x7e\x5f\x10\x76\x46\xf5\x1c\x45\x8b\x32\x7e\xc9\x8b\x03\x09\x5b\x3d\xee\xbe\xc6\xcf\x9b\xeb\xdd\xfa\x61\x03\x93\x28\x4a\xc4\xcb\xf2\x1c\x0e\x59\x87\xf8\xef\x0b\x92\x9e\x43\xa2\xe8\xcf\xd9\x2c\xb2\xee\x4c\x0b\x4e\xaa\xf3\x5a\x45\xd6\x9c\x2c\xaf\xce\x16\x47\xd6\x99\xe9\xd9\x2c\xb2\xb6\x90\xf4\x8a\x7c\x1a\xdc\x4b\x12\xc1\x1f\xbc\xc8\x7b\x3f\x94\xb5\x21\xcf\x40\x2b\xe6\x2a\xfa\x57\x16\xb4\xb5\xe1\x30\xe4\x88\xbf\x9e\x25\xd9\xa2\x34\x05\xcb\x6a\x26\x20\x24\x9d\x5f\x10\x18\xe2\xf6\x96\x67\x00\x96\x75\x89\x95\x95\x09\xc4\xd7\x96\x67\x08\x96\x95\x45\x40\x0c\x9a\x64\xdc\x5b\x9e\x01\x38\xd4\xb5\x67\x5d\x92\x6a\x51\x90\x0a\xe8\x7b\x09\x96\x21\x4a\xa2\x2e\x1e\xe2\x22\xcf\xaa\x3a\xe5\x71\x05\x63\x64\x2d\x69\x31\x45\x32\xbd\x40\x40\xb2\x76\x44\x84\x7e\x30\x28\x47\xd6\x8e\x06\x62\x50\x8c\xac\x14\x8d\x6c\xf0\xe7\xb2\x42\x88\x22\xe0\xaf\x65\x0d\xc0\xc5\x97\x3b\xdf\x40\x72\xb9\xbb\x1b\xc9\x6b\x92\x31\x44\x28\x09\x92\xe5\x35\x2f\x8a\xfc\xc5\x9c\xf2\xed\xf6\x61\x4b\x1f\x6e\xc0\x59\xca\x57\x14\x20\xc9\x2e\x49\x9a\xb0\x9a\x67\x8b\xd9\x50\x1e\x5b\xc3\x73\x49\xd2\x05\x1f\x4a\xe4\x68\x88\xf2\x39\x2f\x48\x95\xe4\xd9\x50\x32\x65\xc0\x2f\xaa\x3a\x8f\xeb\x19\x9f\xe5\xc5\xa7\x61\x44\x13\xa5\x99\xe8\x15\xfc\xad\xdc\x14\x14\xfe\x38\xb2\xe5\x8f\x9b\xb5\x66\x5d\x26\x7f\x80\x83\x36\xb2\x1d\x2d\xa6\x2e\x48\x36\x45\x90\xae\x1e\x39\x2d\x48\xb6\x48\x49\x91\x54\x9f\x40\xbc\x23\x37\x83\x58\xd3\xd6\xe5\x2c\xcf\xab\x0b\x18\x24\x57\xb1\x01\x5d\x25\x0c\xc3\x38\x5a\x8c\x41\x15\x1d\x57\x8f\x34\xad\xa2\x27\x57\x71\x9e\xa7\x9f\xa6\x79\x56\xcf\x72\x06\x97\xea\xa9\xdd\xd8\xa2\x0c\xda\xc6\x93\xed\x15\x5d\xa4\x69\x1d\x13\x2a\x0d\x99\xcd\xf2\xf3\xdd\x8a\x2e\x1f\xdf\x4e\x57\xbb\x79\xb6\xbc\x07\xb5\x34\xf2\x7c\x1d\x9b\x81\xf4\xb2\x61\x6b\x6d\x4f\x23\x06\x84\x19\xcb\xed\xd4\x6e\x66\xf1\xce\x19\xcb\xcd\xd4\x82\x2a\x5e\xbe\xec\x26\x07\xd6\x77\x2c\x6b\x49\x4b\x77\x55\x24\x15\x9f\x91\x12\xb4\xe4\xd1\x58\x56\x92\x16\x48\x53\x4e\x8a\xce\x62\x81\x50\xb9\xc7\x5a\x68\xbc\xc8\x28\x58\xdc\x44\xb7\x45\xd7\x57\x1c\xe6\xb1\x35\x3c\xa6\x82\x4f\x1c\x0d\x18\x17\xdd\xd5\xa0\x9a\xc2\x6a\xb4\x95\x27\x9e\x06\x1b\x93\x24\x85\x51\xbe\x06\x35\x27\x65\xb9\x6f\x6d\x94\x20\x80\x09\xc4\x5f\x61\x82\xb1\x86\xa0\xe0\x31\x0c\x0a\x35\x20\x33\x6d\x24\x72\xcf\x5c\x26\xfc\x6a\x9e\x17\x2f\xaa\x61\x34\x12\x98\x32\x1a\x93\xea\x82\x17\x03\x75\x8b\xcb\x0c\x51\xca\x33\x56\xb3\x12\x5c\xb2\x44\x5c\x56\xc7\x16\x52\x16\xb0\x3a\x71\xb9\xaa\x0d\xe4\xdc\xb1\x1f\xcb\xf2\xa6\xf9\x34\xa1\x75\x3e\x47\x4d\x1d\x55\x26\x67\x56\x90\xab\xce\xa9\x05\x83\x64\xa9\x0b\x4e\x98\x09\xc8\x96\x05\x2c\x69\x52\x96\x79\x51\x47\xf9\xc7\x41\x1d\x4b\x6d\x5b\xc3\x32\xdc\x74\x50\x65\x42\x6d\x36\x1f\xa6\x86\x83\x2a\x33\x6a\x0b\x35\xd2\x6b\xea\xca\x65\xb2\x7c\x11\xa5\xdc\xa0\xdd\xdc\x23\x8b\x53\xf0\xfe\xfd\xb0\x28\xc5\x77\xf4\x6b\x92\xfa\x22\x81\x57\xff\xd4\x77\x7b\x27\x6d\x1c\xac\xe8\xdf\x22\x9b\x8b\xfd\x49\x79\x45\xe6\x75\xf4\xa9\xe2\xa0\x7d\xa1\xb1\x7d\x0a\x4d\xcb\xa8\x8e\x93\x02\x1e\x71\x34\x76\x4e\x91\x45\x7e\x55\xa7\x3c\x9b\xc2\x2b\x0d\x1a\xbb\xa7\xd0\xf2\x43\x32\xaf\x8b\xfc\x0a\x11\xd7\xeb\x41\xce\x93\x8f\x3c\x45\xb0\xfe\x29\x96\xa4\xc9\x34\x9b\x71\xb8\x7d\x99\xb2\xe2\x1e\xd0\xba\x4c\x19\xe1\xe6\x6d\xcb\x94\x41\x3e\xa0\x65\x99\xe2\xc5\x30\x6f\x57\x66\x79\x5a\x1c\xde\xaa\x4c\xd9\xb6\x0e\x68\x53\x65\x68\xcd\xc8\xc7\xba\xe2\x1f\xab\x45\xc1\xdb\x2d\xc7\x10\xbb\xc4\x5c\x72\x44\xb5\x9f\xb7\x6a\x96\xcc\x60\xe9\x7d\xb9\x67\xcb\x45\xd4\x54\x58\x1c\xa2\xc0\x30\x65\x82\xda\x0b\x6e\xb3\x83\xd8\xd5\xea\xfb\xee\x79\xbb\xaa\x96\xdb\xdb\xd5\x0e\x66\xb2\x35\x4c\x4e\x0f\xd3\x5b\x13\xfb\x6a\x5b\x96\x4e\xba\x76\x9f\xb3\xa7\x9d\xae\x76\x1d\xf3\x7c\x1f\x2d\x0b\x53\xea\xc4\xbc\xe0\x8d\x27\xe1\x6c\x4e\x4f\xc3\x19\xe5\x05\xe3\x05\xea\x92\xb2\x8f\x0e\xb0\xc4\x36\x81\x92\xe2\x45\x71\xd8\xea\xf3\xf3\x6d\xd9\x9e\xd5\x36\x7f\x17\x8e\xf6\xf6\x6f\x65\x77\xd2\x09\xd3\xcb\x15\x8e\x49\xd9\x4c\x73\x20\x40\x1e\xb2\x59\x42\x91\xef\x55\x6f\x97\xb0\x24\x07\xd1\xe7\xeb\xef\xab\x3b\xc4\xa5\x6f\xab\x9e\xaf\x45\x56\x26\xd3\x8c\x33\x95\x88\x6d\x97\xff\xe2\x77\x2b\x11\xf1\xfa\x24\xf8\xde\x1a\x32\xcb\x35\x29\x2f\xe4\xf5\x9f\x21\x81\xab\x13\x4d\x65\xd2\xcb\x16\x3f\x6c\xef\x97\x3b\x53\x39\x65\xfd\x49\xb2\x17\x6e\x43\xb8\xaf\x93\x32\xc9\x5e\x57\x46\x65\x37\x9b\xe6\x64\x98\x94\x96\xaf\x18\xf4\xb2\x12\xf6\x35\xbf\xe4\x45\x9c\xe6\x57\xc3\xdc\x46\xbe\xd2\x5c\x2d\xd3\x22\x63\x67\x50\xd9\xaa\xa3\xae\x59\xba\xc1\x9f\xcb\xaa\x4a\x32\x06\x7f\x2c\x6b\x9f\xf0\x3a\x16\xe2\xb8\x0a\x38\x4e\xb5\xbe\xdb\x6a\x2b\xd1\x7c\x0e\xb9\x55\x6c\xb5\x25\x44\x11\x49\x76\xc9\x8b\x8a\x23\x82\xc9\xfa\x92\x89\x93\x4f\xf0\x6b\xb9\xe3\x3f\xc2\xb6\xcc\x57\xdc\xb3\xd8\xb7\xf2\xde\x2e\xc3\x3e\x9e\x48\x1f\xf3\xdf\x17\xc9\x25\xfc\xb9\x3c\x91\xb6\x8d\x02\x7f\x1f\x49\xdf\xe7\x85\x59\x57\x51\x09\x23\xba\xca\xb0\xf5\x99\x5a\x94\x19\x88\x4b\xa0\x0c\xd5\xbc\x58\xfa\xba\xe4\x60\xd5\xc7\x96\x73\x3a\x79\x1d\x46\x51\xfe\xf9\xff\xac\xae\x77\xc9\xcd\x6a\xb3\x5b\x7f\x59\xc3\xd3\x60\xa8\x0e\x26\x6c\xde\x0b\x95\xc1\xd4\x78\x21\xe0\xcf\x9d\x53\x57\x00\x04\x50\x3d\xc6\x1d\xa0\x4e\x32\xc6\x5f\x36\x8b\x92\x19\x84\xa9\x9c\x53\xcf\x56\x3e\x9b\xe7\x19\xcf\xaa\xe1\x64\xf2\x10\x2f\x38\xd3\x11\xbc\x4d\x36\xbb\xd5\x76\xb3\x34\xe2\x93\x8d\xc0\xb4\xe0\x3c\x83\x3f\x97\x47\x7f\x04\x6f\x53\xed\x89\x62\xf6\xb1\xe3\x3c\x7b\xa2\x1e\xcf\x4c\xa3\x9f\xae\x9b\x6c\x23\x8a\x69\x44\x7e\x92\x30\x52\x74\xb4\xf1\xd5\xbf\x30\x3e\xdc\xfd\xb8\x7d\xd8\xcc\x90\x39\x23\x52\xf4\x4a\x6c\x8d\x87\x33\xc8\xea\x14\x27\x69\x3a\x94\x81\x2b\xd5\xf8\xc0\x39\x68\xc2\xb9\x22\x71\xc1\xe7\x29\xec\x86\xb6\xb9\x22\x60\x92\x51\x70\x20\x73\x65\xce\x62\x1c\xfe\x3a\x56\x44\xbf\xe4\x19\x93\x4e\xd7\xca\xdd\x76\xbd\xb9\x45\xb6\x49\x76\x7c\x54\x1f\x31\xf7\xf3\xe1\x24\x72\x1d\xc5\xc4\x2c\x1f\x84\x99\x72\xc8\x35\xe7\x1f\x2b\x9e\x95\x49\x9e\x95\xc3\x68\x9c\x40\x69\x92\x8c\x93\x02\x5e\x72\x3b\x81\xd2\x00\x42\x05\xc1\x75\x8b\x33\xd6\x15\x50\xcf\x92\xf9\x8c\xcc\x4d\xca\x1b\x6b\xca\x1b\x04\x97\x9b\xfa\xa8\x78\x13\xe9\xdd\xde\xd2\x71\xb4\x3a\x2b\xed\x37\x67\x33\x32\xad\xe3\x24\x95\x03\x65\x34\x9b\xbe\xb7\xc7\xbf\xc0\x7a\x52\x9d\xd3\x0e\x85\x25\xd9\xbf\xa7\x30\x47\x53\xd8\x55\x41\xe6\x75\xf9\xca\x05\xb9\x7d\x05\x55\xaf\x5a\x90\x3a\x65\x17\x7c\xce\x49\xbf\x62\xd9\xa7\xc3\xb7\xb6\x6b\x1b\xe0\xb7\x22\xc5\xd3\xd7\xec\xcc\x0f\x4e\x6e\x00\xe7\x10\xcb\xd2\xf8\x34\xf3\x38\x2e\x79\x55\x2f\x32\xd8\xc7\xe2\x10\xcb\xee\x47\xb7\x53\x10\x88\x76\xfa\xd1\x42\xfd\x01\x70\x68\xb9\xe3\x7e\xb0\x32\xf1\x98\xf8\x63\x42\xcb\x0d\x01\x3a\x42\x2b\x70\xc5\x17\x5a\x41\xa8\xd1\xa3\x28\xc9\x58\x92\x4d\x6b\x1b\x5a\xd3\x86\x56\x30\x01\xb0\x0e\x1b\xe2\x67\xb3\xd5\xb8\xcd\x3d\x59\x92\x55\xbc\xc8\x48\x5a\xc7\x79\x31\x03\x14\x4f\x48\xe3\x53\x0d\x41\xc1\x19\x16\x66\x10\x5a\x3e\xd3\x20\x9b\x55\x9b\x01\x96\x6b\xb0\x62\x09\x67\x00\x8d\x35\xd0\x2e\xf6\x09\xc6\xda\x9e\xb2\x99\x6a\x4f\x1c\xc0\xb2\x02\xb9\x75\xe7\x45\xfe\xf1\xd3\xa1\x44\xac\x93\xbd\x5e\x64\x9f\x27\x11\xe0\x73\x88\x72\x5a\x54\xb8\xf5\xd4\xad\x23\x07\x94\xc0\x93\xdb\xa9\x98\x46\x1e\xf8\xb5\xe2\x74\x2d\xa6\x91\x0f\x7f\x2d\xdb\x81\x62\x1a\x85\x87\x1a\xa9\x0b\xd8\xb7\xe5\xfa\xbf\x56\x37\xc6\x8b\xda\xd0\x52\x0e\x6b\x8a\x69\x64\x5b\xf0\xe7\x72\xff\x88\xcf\xe1\x26\xf1\xe5\x4e\x11\x9f\x07\xf0\xe7\xf2\xfe\x42\x2c\xd9\x11\x76\x79\x83\x21\x3e\x47\x5a\xfc\x68\x83\xe1\xd7\x04\x32\xf9\xa1\xe5\xcb\x36\x47\xf0\xbf\x5e\xab\xcb\x16\x49\xb4\x8b\x55\x63\x75\x95\x07\x92\x90\x05\x6b\x79\xd9\x2b\xd1\x7c\x0f\x37\xfd\x58\xee\xa9\xc6\xab\xf0\xb1\x26\x45\x41\x3e\x9d\xb3\x9f\x0f\x2d\xd7\xd1\xf9\x15\x85\x63\xb6\x76\x6b\xb7\x86\x65\x77\x5d\x1d\xb8\x71\x9d\xd6\x5e\xfb\x07\xc6\x7b\xfd\x78\xbf\xf9\x03\xf7\xbb\xeb\xeb\xf0\x22\x36\x2b\x6c\xff\xc0\xe8\xa0\x0f\x6d\x5b\xdd\xff\xe0\xea\x07\x72\x57\x1f\x4f\x57\x2e\x62\x05\x23\xed\x11\x56\x32\x23\x53\xf0\x94\x2e\xb4\x02\x79\x5a\x6a\x90\x0d\x68\x7f\xbe\x01\x42\xd9\xe9\x99\xa2\x71\xb1\xfc\x14\x6b\x5e\xb0\x6e\x66\x72\x87\x5b\xfb\xd0\x1a\x5b\xbd\xb3\x07\xd2\xe2\x63\x5b\x23\x03\xe6\x92\x0a\xad\xb1\xa3\x81\x35\xeb\x62\x78\x1d\xa4\x04\x4a\x89\x13\x43\x97\x1d\xe0\xd8\x4c\xee\x06\xfd\xa3\xd2\x69\xc6\x65\xc1\x21\xdf\x68\xe8\x06\xae\x8e\x60\x3f\xb2\x82\x1a\x9c\xc4\xdc\xc0\x43\xd0\x78\xf9\x3e\x6a\x19\x70\x0e\xed\xf8\x6c\xa5\xb0\x1b\xeb\xe0\xe3\x1c\x63\x1d\x87\x64\x21\x70\x86\xb0\x8f\xc1\xe9\x8c\x84\x6d\x61\x24\x96\x1a\x22\x34\x85\x35\x47\x0d\x0e\x9a\x16\x5a\x7f\x18\xcc\x20\xcb\x2c\x74\x8f\xa7\x5c\x1c\x71\x97\xcd\x84\x21\x4e\xfb\x60\xf8\xa4\x0f\x2e\x96\xf1\x08\xda\x76\xe4\xb1\x4e\x53\x32\x9b\xd7\x55\x5e\x73\x06\x06\x38\x86\xb6\x4b\x34\xe3\x6c\x96\x64\x75\x9a\x83\xe3\xda\x76\x23\x1d\x90\x7c\xc4\x81\xba\xf5\x7d\x44\x4a\x5e\xa7\xfc\x92\xa7\xc7\xc6\xc9\x74\x87\x1b\xda\xae\x6e\xf9\x2f\xda\xf1\xa7\x78\x8f\xa2\x97\xbb\x68\x9b\xf3\x83\x98\x4f\x09\x8c\x63\x99\x35\x72\x9c\x1f\x69\x7c\x4a\x60\x26\x47\xe8\x29\x53\x12\x49\x13\x52\x72\x36\x44\x90\xd0\x52\x94\xae\xe0\x25\x25\x29\xaf\x33\xb1\x35\x84\x02\x25\x43\x3b\x96\x07\x58\x2a\xa6\xbf\x26\x7e\x6e\xef\x6f\xa0\x79\x56\x15\x39\x42\x21\x0f\xb2\x32\x11\x57\x01\xd1\xf3\x94\xd0\x8e\x65\x81\x4b\x3e\x27\xe2\x42\x4b\x5d\xce\x39\x15\x0d\x86\x13\x78\x01\xd3\xb4\xd9\x00\x25\x0a\x3d\x6a\x9d\x6a\xb7\x75\xac\xd4\x8b\xcd\x1a\x9c\xc7\x3d\xaa\x99\x8d\xed\xe1\x2c\xce\x29\x8b\x33\x9c\xc5\x3d\x65\x71\x87\xb3\x78\xa7\x2c\xe0\xf2\xd7\xa3\xfe\x29\x02\x9c\x98\x3d\x1a\x9c\x22\xc0\xed\x82\x47\xc7\xa7\x88\x31\x8c\x08\x4f\x11\x21\x8c\x98\x9c\x22\x26\x30\x82\x9c\x22\xe0\x0d\xad\x47\x35\x66\xde\x06\xb7\x07\x1e\xd5\x18\x78\x78\x37\xe6\x51\x8d\xe9\xb6\x5d\x18\xc2\x35\x10\xa4\xe3\x35\xcb\x61\x1b\xee\x79\x66\x69\x20\x70\xd7\x33\xdd\x10\x83\xfb\x9e\x69\xc6\x93\x0d\x77\x3e\xd3\x0c\x1e\x1b\xee\x7d\xa6\x19\x29\x0e\xdc\xfb\x4c\x33\x54\x1c\xb8\xf7\x99\x66\xac\x38\x70\xef\x33\xcd\x60\x71\xe0\xde\x67\x9a\xd1\xe2\xc0\xbd\xcf\x34\xc3\xc5\x41\x7a\x5f\x33\x5e\x1c\xa4\xf7\x35\xe3\xc5\x41\x7a\x5f\x33\x5e\x1c\xa4\xf7\x35\xe3\xc5\x41\x7a\x5f\x33\x5e\x5c\xa4\xf7\x35\xe3\xc5\x85\x7b\x5f\x59\x71\x13\x5a\x25\x97\x7c\x8f\x84\x70\xd6\x44\xae\xd0\x6c\x91\x56\x49\x49\x66\xf3\x94\x0f\x74\xa2\x4f\xe4\x4a\xb6\x0c\x9d\x27\xb6\xca\x6b\x2a\x62\xa2\x08\xb2\x34\x99\xc4\x00\x03\x7c\x39\x35\xb4\x88\x75\x0a\x36\x2a\x95\x84\xa7\xc0\x36\xf2\x1c\xde\x32\x10\x59\xa7\x5b\x1c\x02\x20\xa7\x05\xed\x25\x44\x23\xeb\x43\x8b\x44\x00\x1c\x8d\x05\x0a\x7d\xdb\x3d\xd5\xa8\x9a\x2e\x22\xb1\x73\x98\x1f\x4f\xfd\xb8\x5b\xc2\xb7\x3d\xc0\x15\x74\xe0\x05\x19\x7c\x40\xa2\x7a\x9e\x97\x49\xa3\xc3\x1f\x61\x31\x02\x88\x24\xe3\x53\x62\x42\x32\x86\x48\x0e\x92\x80\x6b\x73\xdf\x0e\x21\x92\x83\x24\x08\xc9\x04\x22\x39\x48\xf2\x07\x4c\x42\x20\x92\x83\x24\x08\x49\xd4\xeb\x76\xda\x53\xc1\x78\xd9\xb4\x8a\xad\xe0\x41\x00\x53\x9f\x90\xc7\x65\xcb\x44\xf3\xd9\xbc\xe0\xa5\x58\xbc\x17\xd3\x08\xc6\xf1\x5e\x1c\x14\xe7\x13\x7a\x5c\x63\x75\x0f\x04\xe2\x24\x16\xb9\xe9\x11\x06\xc4\x02\x18\x84\x17\xa5\xf1\x1f\x62\x35\x0f\x88\x0d\xd2\xc0\x58\x79\x45\x93\x2d\x66\x72\xf1\x7b\xaa\xf6\x2c\x10\xb4\x57\x01\x91\x0d\xc6\x79\x1c\xb6\xc3\x74\x9e\x91\x36\x86\x1c\x02\x7a\x8a\x47\x88\xa6\x09\xcf\xaa\x01\x73\x9a\xc7\x9d\x9e\xcb\x0b\xd8\xb1\x76\xe8\x71\xc5\x4e\x16\x24\x2b\xe7\x79\xc9\xdb\xcd\xae\xb8\xb7\x50\xcf\x48\x55\x24\xa0\x3d\xf1\xb8\xa7\xe5\x98\x17\xf9\x3f\x38\x15\x57\xd3\x8d\x48\x7c\x2d\xc9\xbe\x26\x26\x0c\x81\x96\xa1\xd9\x32\xe3\x78\x47\x24\x37\x52\xef\x2a\x48\xab\x03\x71\x07\x03\x2a\xdc\x57\x2e\xa3\xb5\x0e\x06\xdc\x66\xc8\xdd\x56\xf0\x38\x3d\xb4\x16\x88\xf3\xf8\x44\xaf\xaa\x58\x64\x5f\xe8\x71\xa2\x47\xa6\x8b\x59\x92\x91\x0c\x0c\x28\x0b\x3d\x1e\x21\x68\x13\x09\xa8\x9e\x43\x9c\xdb\x67\x25\xe2\x10\xf2\x95\xc3\x01\x9a\xcf\x22\x24\x74\xc2\x1f\xdb\xa7\x00\xcc\xa0\xfa\x63\x47\x03\x42\xab\xe6\x87\xb2\x70\x65\xbe\x28\x28\xb7\xd0\xb2\x42\x59\xc0\x16\x64\xe3\x20\x59\xc0\x16\xe4\xe0\xa0\x50\x23\x9e\x41\xad\x26\x1a\x01\x0d\x60\x44\x23\x22\x0e\x53\xae\x83\x37\xa9\x2d\x32\x86\x37\xe2\xc4\x3e\x45\xe1\xad\x38\x71\x4e\x51\x78\x33\x4e\xc2\x53\x94\x49\x3b\x4e\x26\xa7\x38\x93\x86\x9c\x90\x53\x9c\x49\x4b\x2a\xc7\x53\xc5\x34\xaa\x1b\xdf\x27\x0c\x91\xed\x38\x61\x22\x0e\x46\x9c\x85\xc0\x18\xd9\x6c\x8b\x41\x5c\xcc\xf3\x14\xcc\xc1\x23\x8c\x80\xbc\x00\x2d\x17\x51\x55\x10\x0a\x9b\xd7\xb1\x6c\xdb\x69\x9e\x95\x15\x81\x97\x26\xfe\x58\x2e\x63\x5e\x24\x33\x52\x7c\xc2\xdd\xa7\xfe\x58\xee\xde\x79\xc1\x2f\x93\x7c\x01\xce\xa0\x01\x91\x97\x5f\x2c\xaf\x5c\x4c\x85\x02\x12\x6b\x10\x60\x6f\x5a\x34\xd4\x5d\x41\xc7\x4a\xb2\xe8\x44\x77\x0d\x1d\x87\x11\x6d\x69\xa8\xd2\x59\x34\xd2\x96\x87\x02\x6d\x47\x69\xf6\xc6\x5b\x1d\x13\xc6\xeb\xea\xa2\xe0\xe5\x45\x9e\xe2\x31\x59\x36\xf1\xfb\xc3\xfb\x6d\xd0\x95\x62\x93\xa0\x1f\xea\x78\xaf\x14\x6a\x62\x93\x71\x7f\x29\x2e\xe8\xb7\x72\x95\x79\x6f\x96\x88\x64\x46\x9c\x61\xb1\x95\x62\xc2\x8f\x59\xcf\xca\x30\xcd\x59\x1d\x25\x04\x54\x6d\xf5\xa6\xd4\x30\xa4\x5c\xd7\x24\xa3\xe2\x5e\x3c\xb2\x1a\x52\x6e\x12\x31\x6e\x00\x09\x3d\xa2\x91\xaf\x6d\x59\x4c\x5d\x42\x8f\x6a\xb0\xa2\x3f\x48\xc1\xb1\x5c\x09\x61\xe8\x31\x00\x8d\x64\x0e\x09\xd5\xf4\x8e\xed\xf8\x42\x2d\x93\xa5\x5c\x9e\x6a\x41\x22\x2d\x9f\x58\x31\xc2\x38\x5b\x63\x3a\x4d\xca\x73\xb4\x19\xda\x86\x30\xb8\xba\x92\x71\x2b\x62\x79\x70\xc9\x06\x0c\xf2\x80\x16\xdd\x51\x13\x06\xce\x63\x96\x72\x77\xac\x41\x74\xb7\xc7\x8c\xe6\x27\x4b\x89\xae\x6c\xe0\x66\x30\x79\x98\xcc\x12\xa4\x23\xe5\x01\x32\x23\xe0\x66\xc8\x76\xe4\x16\x90\x8e\xff\x90\x42\x6c\x67\xdc\x83\xc3\xca\x93\xb5\xb3\xc5\xb1\x44\x74\x97\xd8\x15\x54\x15\xcf\x70\x4d\xb5\x95\x65\xdc\x94\x67\xbc\x39\xfb\x6c\xaf\x18\xc0\x40\xa7\x1f\x88\x3a\x31\x3c\x25\xfe\x33\xce\xa7\x35\xcd\xf3\x82\x25\x99\x28\xbc\x5d\xb9\xc2\x70\xbb\x17\x0e\xe3\x64\xa1\xe3\x82\x4c\x45\x00\x07\x1e\xaf\xe4\x29\x81\x9f\x74\x51\x14\x02\x36\xa4\x5c\xaf\x57\xde\x36\xce\xb0\xae\x3e\xcd\x11\x0a\x1f\xa3\x10\xa9\x6a\x61\x13\xea\xf9\x01\x46\xd2\xa8\x11\xe2\x39\xf1\xc7\x08\x0b\x8c\x96\x87\x54\x63\x12\xeb\x72\x31\x83\x21\x13\x4d\xf3\x97\x9c\xe6\x19\x33\x5a\x61\x7a\x4a\xfc\xe8\x11\x70\xdf\x7a\xc8\xe4\xe5\xf9\x11\xca\x81\x77\x22\x45\x39\x4c\x7a\x91\xa1\x2c\x46\xdd\xc8\x31\x1a\x08\xae\xde\x00\xdf\x4f\xc7\xed\x6d\x1d\x93\x58\x8d\xd0\x8b\x34\xc9\xd7\x3a\xc7\x13\xba\x14\x90\x45\xdf\x2f\x01\x0a\xe1\xf8\xeb\x28\x20\xf4\x58\x89\xc4\xeb\x92\x88\x63\xdd\x3f\x0e\xfc\x53\xd0\xa2\x44\x8e\x7e\x42\xa5\xa8\xdf\x17\xbc\x51\x98\x85\xd8\xb2\x61\x19\x46\xc2\x30\xf0\x35\x5a\xdf\x70\xc0\x30\x79\x8c\x37\x9f\xd7\x05\x2f\x17\x29\x68\x90\xc3\x60\xdc\x83\xaa\xc9\x25\x49\x52\x82\x5c\x5d\x08\x95\xe9\xa0\x51\x9e\x7d\x52\xaa\xfd\x4a\xbe\xcd\x21\xdf\x1e\xfb\x88\x24\xea\x20\x9b\x6c\x6f\xbb\x40\xb9\x57\x60\xf5\x7a\x64\xdc\x1f\x2a\x0d\xb9\x0b\x13\x86\x13\x1f\x11\xf2\x40\x0b\xb2\xc4\x9a\xd0\xf3\xaa\x2a\x92\x68\x38\x57\x24\x9b\xd6\x26\x31\x58\x9e\xa5\xa0\x35\x0e\x23\xd9\xb4\x36\x79\xb4\x0c\x30\xb2\x35\x6d\x8a\x69\x80\x30\x46\x1e\xed\x5d\x95\x08\xa5\x1c\xcc\x7d\x17\x86\x11\x3d\x85\xcd\xc8\x7c\x0e\x7b\x48\xc2\x88\x69\x61\x26\x66\x31\x54\x0e\xb9\xcb\xaa\xe0\x64\xd6\x64\x66\x3b\x52\xb9\x85\x78\x04\x03\xd3\x38\x6e\x9f\x72\x89\x06\x3b\x8b\xcb\x39\xe5\x12\xc9\x13\xce\xe2\x92\x47\x42\x59\x91\x2a\xa1\xe7\xd7\xd1\x3f\xe5\x3a\xbb\x8e\xc1\x29\xd7\xd9\x75\x94\x07\x03\xfb\x94\x91\xd9\xcf\x54\x72\xa2\x21\x3b\xbb\x96\x44\x43\x76\x66\x35\x27\xb6\x77\x1a\x24\xd0\xe4\x94\x44\x9c\x88\xa1\x5c\xa3\xb2\xa0\x06\x7e\xd1\x70\x12\x68\x6c\xd5\x60\x83\x37\x3e\x3d\xb5\x19\x4e\x22\x77\xae\xbc\xf0\x19\xc2\x21\xb7\x40\x93\xde\xe2\x0c\x0e\xb9\x2b\xf7\xcb\x97\x66\x39\x7c\x06\x97\x6c\x22\x45\x68\x77\x1d\xa7\x64\x7a\x06\x0f\xbe\xc8\x1c\xc2\xc6\x90\xc5\xfe\x20\x32\x79\xdd\x76\xd5\xdc\xad\x19\x4c\xd2\xb3\x77\x44\x72\x7d\xf6\xed\x19\x7f\x62\xdb\x07\x43\x3d\x5d\x69\xc6\xfb\x04\x1f\x40\x9f\xbd\xcf\x1b\xb2\x37\x18\xf7\x13\x9c\xa1\x2e\x83\x3b\xf9\xe8\x60\xaf\xa0\x43\x4f\xf5\x84\x41\x43\x11\xca\x8c\x5a\xd0\xa1\xe7\x79\x42\xaa\xa1\xa7\x72\xa2\x14\x14\x03\xf9\x1d\x31\x19\x03\xc7\xd1\x18\x69\x79\x41\xc9\x33\xb1\x9a\x07\x35\x3f\x70\x5c\x84\x04\xdb\x2f\x05\x8e\x87\x31\xa0\x6a\x1c\x38\x3e\xc2\x81\x0d\xa4\xc0\x09\x34\x23\x58\x61\x02\xe1\x9e\xa6\x2d\xe7\x45\x3e\x2d\xc8\x4c\x72\xd4\xc1\x14\x58\x1d\x4c\xd6\xa4\x96\xa5\xc9\xc8\x24\xde\x62\x40\xfd\xde\xa1\x36\x99\x53\x0b\x85\x33\x57\x87\x6a\x9a\x0c\x05\x2a\xa5\xaf\xc6\x59\x5c\x03\x16\x24\x09\x76\x18\x2a\xea\x24\x0e\x55\xa4\x64\xc9\x08\xd2\xd7\xa7\x58\x06\xa3\x63\x43\x27\xd0\xa3\xc0\x00\xd9\xd0\x19\xeb\x51\xe0\x49\x53\xe8\x84\x7a\x14\x18\x24\x1d\x3a\x13\x3d\x0a\x8c\x93\x0e\x1d\xa2\x47\x81\xa1\xd2\xa1\x13\xe9\x51\xe0\x11\x5f\xe8\x50\x3d\x0a\x0c\x98\x0e\x1d\xa6\x47\x81\x31\xd3\xa1\xc3\xf5\x28\x30\x6c\x3a\x74\x62\x3d\x0a\xbe\x36\x11\xba\x56\x0f\x0c\xd6\x0e\xd7\xee\x81\xc1\xea\xe1\x3a\x3d\x30\x58\x3f\x5c\xb7\x07\x06\x2b\x88\xeb\xf5\xc0\x60\x0d\x71\x59\xff\x8c\x85\xce\x77\xa1\x92\xa3\x44\x8c\x6e\xc5\x50\x96\xc3\xfc\x32\x01\x41\x2c\x6e\xbb\xe9\x48\xfe\x80\xe7\xc0\x70\xec\xf4\x9c\xe3\xb6\x37\xb4\xd1\x38\xbf\xc8\xb5\x74\xa7\x0d\xe5\x05\x61\x92\x03\xab\xfc\xba\xbc\x59\x6d\x91\x24\xa0\x61\xe4\xda\xa7\x95\x3a\x8f\xc9\x3b\x6e\xeb\x83\x64\x8b\x2c\x11\x51\x9b\x2f\x27\xe4\x70\xf5\x3c\xa2\xef\xb4\xa1\x34\xb2\x6d\x11\xf2\x5c\x92\xe2\x93\x88\xf0\x6e\x72\xa5\x22\x58\xaa\x17\x61\x68\x4f\x29\x47\xcb\x82\xa7\x8b\x40\x63\xc3\x99\x64\x8b\xd2\x76\x50\xbb\xdc\x57\x7b\xc9\xf8\xde\x6b\xa4\xee\x76\x44\x93\xd4\x97\x9c\x82\x16\x23\xf2\x6d\x1d\x06\x34\x17\x91\xef\xe8\x30\xa0\xad\x88\x94\xfd\x51\x92\x99\x48\xe6\x9d\x22\x10\xb9\xfc\x53\x04\x22\x95\x3c\x75\x47\x39\x7c\x0c\x11\x29\x3b\x1d\xf1\xb5\x41\x25\x42\x0d\x04\xa9\xc5\x44\x03\x41\xaa\x21\x0f\xaf\x66\x2c\x88\x80\x5a\x44\xb2\x48\x87\x41\x44\xa3\x3a\x0c\x22\x9b\x3c\x5e\xda\x9b\x22\x05\x92\x87\x2a\xf2\xb9\x06\xe3\x20\x98\x58\x83\x81\x53\x52\x44\x81\xa5\xc1\x88\x9b\x01\x30\xca\xd6\xa0\x6c\x91\x9f\x81\xb0\x1c\x7a\x33\x2b\x8c\x02\x47\x03\x75\x8c\xa0\xca\x2e\x97\xf1\x94\x8b\xe3\xef\x8a\x54\x8b\x12\xb2\x17\x6f\xe7\xdb\x87\xdb\xed\xf2\x7e\xbe\x7d\x78\x5c\x6d\x77\x3f\x10\x17\x61\xa4\xec\x8c\x85\x55\x4e\x52\x93\x62\x60\x4e\xb9\xd2\x69\x92\x7d\x38\x26\x1c\x2a\xa3\x6c\x4a\x9a\x57\xd6\x48\xc5\x7f\x96\x53\x35\x36\x71\x2e\x72\xd6\xed\x9f\x50\x00\x2a\x3e\xb8\x7d\x65\x13\x45\xaa\x8a\xd0\x8b\x26\xa7\x86\x30\xfe\xe7\x0b\x1f\x9c\xde\xb5\xeb\x66\xd6\xf3\x39\xc7\xbd\x9c\xcd\x04\x8e\xbe\x2e\x11\x46\xaa\x07\xa4\x9d\xdf\xda\x80\x0d\x83\x86\x85\x99\x27\xa7\xc2\xb5\xcb\xb6\x45\xc5\xcf\xaf\x32\x01\x58\x8d\x2b\x1d\xf5\x2f\xe4\x6a\xc6\x8b\xe4\xb2\xbd\x02\x86\x85\xbd\x44\xa1\x6c\x6b\x85\x7d\x10\xeb\x9c\x94\x64\xd3\x85\x58\x5d\x9c\x95\x4b\x35\x8c\x42\xa6\xf1\x71\x74\x5e\x8a\x41\x2b\x67\x4a\xac\xd3\x40\xa4\xb9\x38\xda\xeb\xbc\x78\x79\x91\x4c\xe1\x58\x26\xaa\x5c\x2b\x4a\xf3\x2b\x5e\x60\x4f\x53\x86\x54\xb9\x4e\xb4\x98\xcf\x8d\x30\xbd\x5e\x05\xf8\x65\xac\x90\x12\xaf\x0f\x69\xf4\x8e\x58\x48\x89\xdf\x47\x60\xf2\x0e\x51\x18\x78\x6e\xbf\x53\xa9\xba\xca\xeb\x12\x71\x8b\x85\x81\xdd\xd3\x4d\x30\x4a\x6e\xe5\xb6\x3f\xf1\x8c\xc6\x61\x38\xb6\x7b\x76\x41\x0d\x05\xb8\x18\x0e\x95\xcb\x2b\xed\xbb\x2a\x4d\x8a\xab\x9f\x38\xc3\x57\xee\xb2\xb4\x94\x8b\xec\xa7\x49\x19\x24\xa7\x89\xbf\x3a\xe4\x31\x2c\x97\x09\x49\x14\xf8\xba\xa5\x98\xf3\x1d\x5e\xc0\x05\x81\x1e\x05\x2f\xe1\x82\xb1\x0e\xe5\x7e\x87\x17\x98\x41\xa8\x47\x21\x65\x4d\x74\x28\x0f\x2b\x4b\xbb\x00\xf6\xe0\xd6\xa0\xca\x83\x99\x25\xe2\x2c\xa7\xca\x43\x99\xe2\x6b\xd0\xcb\x44\x15\x7f\xb0\xf8\x1c\xf7\x6d\x50\x65\xb8\x0b\x4c\xd8\x82\x90\x92\x42\xfd\x9d\x2d\xbc\x46\x93\x7e\x20\x2e\xad\xe7\xc7\x9a\xc9\xa4\x10\x8f\xdf\x14\xc7\x67\x89\xb0\x18\x8a\x95\x1d\x70\x5b\x8d\x2a\x0e\xf3\x17\xa4\x59\xab\x05\x5a\x2c\x8c\x19\x6b\x31\x48\x41\xa4\xa7\x95\x0d\xcb\x8c\x30\x38\xde\x50\x3d\x41\x70\x3c\x36\x0b\x83\x73\xd5\x7b\x97\x34\x4d\xe6\x87\xe8\x61\xc8\xfb\xe9\x1e\x05\xb7\xcb\x38\xc8\xfd\xe9\xaa\xc1\xed\x4a\x79\x90\x1d\x70\x8f\x42\xda\x65\x1c\x64\x09\x5c\x35\xa4\x5d\x29\x0f\xb2\x56\xae\x7a\x53\x40\xc1\x41\x2e\x50\x57\x0d\x80\x57\x70\x90\x9b\xdc\x55\x03\xd2\x15\x1c\xe4\x28\xb7\x98\xe2\x17\x16\x2b\x59\x05\x0b\xce\xcc\x8e\x2d\x2b\xe0\x8c\xfc\x23\x2f\x4e\x56\x9f\x26\x2b\x46\x47\xbd\x82\x9f\x64\x67\xf3\xc8\x33\xb0\xb8\x51\xae\x79\x13\xc0\x8c\x48\x1d\x14\x99\x18\x08\x4d\x1c\xc5\x40\x1e\xc7\xd7\x8f\xd0\x02\x76\x1a\x3b\x4e\xd0\x83\x83\x66\xfe\xee\x1a\xb4\xdd\x23\xba\xb8\x07\x7f\x45\x0a\x71\x61\x65\x36\x27\x55\x12\xe1\x77\xa3\x43\x25\x2c\x48\x64\xc0\x75\x9d\xf8\xd0\x02\x3f\x77\xa9\x2a\xb4\xe5\xb6\x29\xa6\x91\xa0\x06\xbf\x97\x8d\xa5\x90\xc5\x0e\x5e\x4f\x16\x59\x91\x45\x2e\xe1\x00\x96\x25\x66\xa7\x8b\x6f\xe5\x70\x40\x1c\xc6\x4e\xe1\xe8\x88\x30\x96\x27\x49\x31\xf2\xba\xd3\xe8\x6e\x65\x9c\x92\x4f\xc8\xa1\xa4\xfa\xf4\x8c\x48\xc8\x78\xd8\x00\x70\xb1\xfa\x6c\x33\xc3\xc3\x0c\x72\x27\xcc\x88\xb4\x85\x30\x65\x50\xc6\x6e\x9b\x53\x52\x44\xb4\xe1\x93\xfb\xc4\x96\x1b\x31\x4e\x3e\x72\x86\x06\x8d\xf6\xbb\xf9\xcd\x8e\x09\xa8\x36\xf7\x89\xbd\x0f\x49\x19\x9c\x5c\x86\xda\x93\xde\xe4\x23\x07\x56\x10\x4f\x34\xf2\x38\x3f\x21\x4f\xd4\x2b\x8f\x63\x24\x0f\xd5\xc8\xd3\x6d\x3a\x0c\x6b\xc4\x00\x06\x23\x19\xd4\xcc\x8f\xb6\x1d\xd7\x53\xf1\xff\x22\xdb\x02\x07\x25\x75\xa3\xbe\x74\xb0\xb6\x15\xd7\x82\x42\xfc\x1f\x92\x0f\x96\x2a\x47\x90\xc5\x34\x9a\xd4\x1c\x3c\xb3\xa4\x2e\xef\x2b\xd6\xaf\x27\xcd\x1f\xb4\xc8\x58\xd3\x64\xe5\x05\x31\x79\xd0\x80\x2a\xf7\xab\x9b\xdc\x19\x8d\x23\x2e\xe6\x9c\x75\xee\x88\x76\x80\x98\x39\xa9\xe8\x38\x86\xe9\xba\x7d\x28\x76\xcd\x92\x2a\x3e\x69\x51\xb6\x86\xeb\x90\x24\xd3\x70\xf0\x86\x2e\x2c\x5b\x57\x55\x84\xc4\x83\x49\xba\x0a\x96\x15\x81\xf3\x54\xd1\xd0\x37\x23\xc2\x3a\x50\x71\xa7\xce\x8b\x64\xd6\x64\x93\x2a\x0f\xb7\xe1\xc0\x95\x02\x0d\x43\x58\x0a\x89\x50\xf8\x95\x2a\xf0\x75\xb2\x90\x2a\xee\xd3\x76\xc3\x96\xfc\xc1\x0b\xb1\x1e\xa4\x04\x0e\x56\xa4\x21\xc1\xbb\x5c\xcc\x8b\x45\xca\xc9\x25\x67\xa6\x26\x3b\x8c\x70\xda\x83\x26\xed\x8f\xfa\x41\x42\xd9\xc6\xc9\x02\x19\x81\x65\xf3\x30\xac\x58\xd9\x4e\x68\xea\x70\xbe\xe7\x89\x86\x66\x83\xb6\xb3\xc2\x10\x15\x53\xee\xab\x8b\x55\x96\xeb\x2c\x12\x18\x21\x2f\x38\x9b\x75\x1c\x06\x90\x2d\x96\x28\xc2\x0e\x30\x84\x3c\x44\x9a\xe5\x19\x06\x90\xbb\x58\x14\x11\x62\x80\x23\xab\x8f\x7d\xaf\x1c\x5c\x89\x02\x5c\x07\x01\xc8\xb6\xab\x69\x25\xe4\x7b\x79\x5c\x8b\x02\xec\x00\x01\x28\x23\x77\x1a\xa1\xdf\xcb\xea\x28\x0a\x08\x91\xef\x65\x1d\x13\x2d\x04\x7f\x3e\x91\xcd\x6c\xc1\x99\xc9\x8a\x98\x29\x77\xa1\xda\x47\x79\x8c\x60\xb2\x42\x35\xef\xf1\x18\xa1\x8e\x5a\xd8\xac\xa8\xa3\x56\x26\x66\x28\xd9\x32\x46\xd3\xc2\x0c\x24\xdb\xbd\x68\x5a\x98\x15\x45\x2d\xcd\x31\xb2\xc9\xc2\x8d\x51\x5b\x83\x34\x59\xb0\x31\xea\x6a\x90\xfb\x32\x0d\x0e\xb0\x19\xf5\x34\x04\xce\x10\x02\x5f\x43\x20\x4e\xeb\x8d\xc0\x41\xdf\x02\x0e\x8b\xe1\x60\x74\x0c\x40\x41\x0f\x33\xa3\x21\x00\x05\x1d\xe1\x4c\xc9\x0d\x23\x10\x66\xb1\x13\x8c\x92\x1e\x1c\x1c\x3f\xc1\x68\xd4\x83\x83\x63\x28\x18\xa5\x3d\x38\x2c\x8e\x82\x51\xde\x83\x34\xd3\xe4\xb8\x07\x6d\xa4\xcd\xcc\xee\xeb\x98\x17\x21\x60\x02\x07\x23\x40\xda\x9b\xb9\x18\x01\xd2\xf0\xcc\xc3\x08\xd0\x1e\x60\x01\x46\x61\xd4\x15\x6c\x8c\xd1\x98\xf4\x09\x57\x72\xc2\xb6\xb7\x91\xaf\x08\xec\xbb\xe2\x8a\xef\xaa\xc5\x64\xb9\x01\x4c\x36\x25\x2d\x2c\xfa\x54\x17\x7c\x2a\x62\x50\x71\x74\x00\xa0\xf1\xe2\x27\xb6\x2d\xeb\x6e\xb7\x6e\x6b\x6f\xb8\x76\xfe\x47\x08\xec\x58\xa7\x60\x91\x48\x03\xdd\xf7\x4d\x6c\xc7\xd6\x43\x71\x0f\x10\x25\x14\xca\x8e\x04\xef\xd9\x89\xbc\xee\x6a\xb0\xae\x13\xef\x8f\xc3\xc1\xc3\x13\xa6\x40\xdb\x83\x3d\xd7\x51\x75\xcc\xf1\xd0\x57\x64\x2c\xf5\x81\xf5\x24\x6b\xa2\x86\x44\x9c\xed\x8c\x77\xcd\xd0\xa4\x6e\xab\x64\x67\xb4\xc9\xe3\xf6\xa1\xa3\xbc\x7e\x27\x13\xb6\xc1\x3d\x4d\xf4\x47\xe3\x25\xab\x79\x46\x73\x6c\x65\xee\xd8\xb6\x09\x5d\xd7\xee\xe8\x9d\x16\xc7\x76\x70\x3a\x13\x07\x84\x63\xbb\x38\x91\xd9\x03\x8b\x8e\xed\xe1\x54\x46\xef\x2d\x3a\xb6\x8f\x33\x19\x3d\xbf\x18\x3a\x76\x80\x53\x99\x65\xae\x72\xec\x31\x4e\xb5\x0f\x05\xc1\xc9\xc2\x1e\x32\xc6\x63\x82\x24\x6a\x70\xec\x49\x0f\x78\x91\x31\x1e\x27\x48\xee\x40\x47\x49\xdc\xdc\x55\xbe\x93\xfb\xa5\x2a\x10\x83\x77\xf2\x0e\x52\xfb\x8e\x74\x27\x05\x56\x79\x2f\x9e\xf4\x09\x00\xc3\x48\xdf\x74\x24\x4c\x05\x04\x0d\x63\x79\x34\x37\x25\x3a\x9e\x91\xa1\x0a\x63\x5b\xe7\xde\x33\xec\x66\x6a\x5b\x1a\xb4\x18\x98\xd8\x08\xa7\xb6\xad\x41\xb6\x23\x11\xc7\x3a\x1a\x6c\x33\xf4\x70\xa8\xab\x81\x76\xe9\xf1\x51\x6c\xa0\xc1\x76\x59\x5d\x50\xac\x76\xa9\x61\x76\x4b\x83\x92\xa0\x67\x3c\x18\xf8\x4c\x54\x70\x73\x23\x67\x30\x83\x2c\xbb\x32\x12\x8c\xd0\xf2\x58\x6a\x0e\x5f\xa4\xf2\x0f\x93\x56\x2c\x42\x28\x3f\x4b\x5e\x25\x98\x73\x02\xd4\xe9\x5c\x4e\x02\xc8\x69\xd6\x4e\x51\x5f\x3b\x75\x39\x0b\x40\xb4\xf2\x50\x4b\x8f\xf9\xcd\xa3\x7f\x70\x5a\xe1\xda\xc6\x6c\x63\xae\x8c\xcc\x10\x2e\x83\xa9\x78\x3f\x18\xda\x77\xca\x40\x36\xd7\x9c\xed\x90\xea\x3e\x46\x02\xf8\x28\xf3\xcc\x59\x9b\x93\x4b\x98\xad\x6f\x7a\x16\x0b\x18\x11\xbf\x0e\xa3\xfb\x06\x6b\x92\xed\xf1\x92\x58\x30\xd5\x18\xa7\x9a\x25\xa5\x78\x04\xcc\x98\x32\xc2\x29\xa5\x1b\x71\x30\x17\xc5\xb9\x9a\x81\x64\xc2\xc5\x7a\xb8\x16\x59\xb9\x98\xcf\xf3\x02\x3b\x6a\x60\xf2\xd6\x44\x38\xe2\xbb\x5c\x10\x87\x66\x81\xfd\xdf\x4a\x46\x9c\x63\x28\x78\x53\x92\x72\x1b\x80\x82\xb7\x25\x29\x77\x00\x28\xe8\xdc\xa1\xdc\x05\xa0\xa0\x73\x87\x72\x0f\x80\x82\xce\x1d\xca\x7d\x00\x0a\x9f\x44\xf2\x00\x80\x82\x97\x6c\x29\x1f\x03\x50\xf0\xa6\x2d\xe5\x21\x00\x05\x57\x46\x94\x4f\x00\x28\x78\xe7\x96\x72\x02\x40\xe1\x8b\xb7\x94\x47\x10\x16\xd1\x27\x0a\x61\x11\x85\x62\x10\x16\xd1\x28\x0e\x61\x11\x95\x8a\x21\x2c\xac\x53\xb1\x05\x61\x61\xa5\x8a\x6d\x08\x0b\x6b\x55\x0c\x8d\x5b\xf8\xdd\x33\x1a\x43\x03\x17\x7e\x00\x8d\xc6\xd0\xc8\x85\x5f\x42\xa3\x31\x34\x74\xe1\x27\xd1\x68\x0c\x8d\x5d\xf8\x6d\x34\x1a\x43\x83\x17\x7e\x24\x8d\xc6\x21\x84\x85\xf5\x2a\x9e\x40\x58\x44\xaf\xa0\xf1\x0b\xbf\x9f\x46\x63\x68\xfc\xc2\x0f\xa9\xd1\x18\x1a\xbf\xf0\x8b\x6a\x34\x66\x10\x16\xd1\x2b\x68\xfc\xc2\x6f\xac\xd1\x38\x86\xb0\xa0\x5e\x31\x25\x3c\xb7\xdd\x49\xbd\x60\x41\xa4\xe2\x4c\xec\x36\xba\xa6\x58\xcf\xd2\xaf\x35\x7a\x1f\xc2\x7f\x3b\x68\x27\xc1\x3c\xbb\x67\x1f\xd0\x5b\x00\x4c\xe7\xf4\xd0\xb5\xcf\xe6\xc2\x58\xb7\x0f\x8b\x3f\xbe\xce\x3c\xaf\x0f\x2c\xce\xfa\x8a\x8c\xa4\xdd\xeb\x45\x30\x4b\xa0\xe9\xa9\x26\x15\x19\xac\x1b\xde\xb8\x0f\x07\x8e\x7b\xe6\x85\x7d\x38\x70\xfc\x30\x6f\xd2\x87\x83\x27\x12\xe6\x5b\x7d\xcd\x64\xe2\xa1\x64\xbe\xdd\x07\x37\xf3\x4b\x32\xdf\xe9\x23\x30\xf2\x46\x32\xdf\xed\xc3\x9b\xf9\x20\x99\xef\xf5\x11\x74\xbe\x2f\x94\xc0\xef\x23\xd8\xf7\x03\x2e\x43\xa0\x1f\xd3\xf2\x5e\x44\x7e\x95\x11\xe4\x92\x35\x4f\x6c\x25\xf6\xdb\xf7\x21\xd1\xd5\x2c\x9a\xf4\x48\x84\x5c\x6f\xb1\x3d\x25\x67\xfc\x05\x49\xe3\xf6\x16\xd2\xa1\xf8\xe6\x35\x77\x24\x07\xc6\x51\xa8\xb5\x38\x67\x69\xb7\x62\xe0\x09\x8f\xa5\x5e\x60\x10\xa8\xe6\xbe\x1f\x0e\x93\x55\x40\xc0\xba\xa3\x0a\x52\x75\x2f\x43\xe3\x0c\x61\x3f\x43\xd7\x70\x18\x85\xe2\x8c\x14\x14\x71\xba\x28\x2f\x6a\xfe\x71\x9e\x26\x34\xa9\x50\x11\x94\x39\x45\xe0\x17\x59\xf9\x29\xa3\x17\x45\x9e\x09\x17\x1d\x82\x0f\x59\x14\xf5\xc4\xc5\x8b\x37\x35\xa6\x15\x85\xed\x5d\x44\xf5\xe8\xce\x55\x68\x48\xc2\xf4\x24\xc5\xb4\x91\x00\x5c\xa9\xb1\x88\xeb\xc1\x7b\x09\x0c\x38\x1c\x25\x3b\x54\xf1\x92\x6d\xb8\xd1\x59\x3c\xd6\xdd\x51\xf2\x44\x15\x53\x93\xa8\x12\x47\xc9\x12\x55\x84\xaf\x14\x76\xef\x28\x69\xa4\x0a\x78\x12\x70\x94\xec\x51\xc5\x14\x9c\x6a\x1c\x25\x69\x54\x31\xc5\xa8\xe5\x3e\x2d\x90\xe0\x7f\x47\x49\x12\x55\x20\x27\x9c\x8e\x92\x1c\xaa\x98\x62\xe4\x4a\xb6\x9f\x62\x8a\xb1\x2b\x09\x7d\x0a\x38\x26\xcb\x51\xae\xfa\x14\x48\x88\x9b\xa3\x64\x7e\x2a\x90\x70\x32\x47\x49\xf8\x54\x60\x01\x7a\x8e\xab\xcc\x47\x48\x30\x9c\xe3\xca\x53\x4f\x81\x05\x18\x3a\xae\x3a\x42\xb0\x56\x51\xc7\x03\xda\x2c\xca\x50\x98\xa2\xed\xa2\xa8\xf8\x14\x6f\x18\x55\xcb\xd1\x96\x51\xf5\x1c\x6b\x1a\x3f\xf2\x35\x17\x57\x44\xf8\x85\x89\xfb\xdd\x51\x92\x42\x36\x0b\x3e\xe8\x73\xaa\x1c\xe7\x1e\x9c\xc3\x87\xbb\x89\xf8\x49\x92\xaf\xc1\x1f\x9e\x1b\x44\x7d\xf4\x13\xe5\x94\xb2\xbd\x9a\xd2\x55\xd9\xe0\x72\x8a\xdc\x0f\x2d\xf6\x90\xb2\x01\x45\xb3\x89\xac\x81\xed\x1a\xcf\xc0\xcc\x46\x81\x3c\xe4\xa4\x00\x99\x82\xc3\xaf\xd1\x44\x81\xd7\x0f\x34\x0a\x81\x73\x34\xf8\x6e\x47\x05\xe2\x64\xc3\x79\x14\xd5\x83\x09\xcd\x98\xd5\x03\x36\x28\x98\xf9\xba\xc3\xbe\xc1\x12\x84\x18\x0b\x2e\x0a\x75\x88\x46\x4b\x3b\x9c\xf6\x02\xcf\xdb\x21\xa1\xdd\xca\xac\x27\x27\x4e\xd8\x2f\x75\x91\x65\x3b\x75\xa8\x4e\xbc\xee\x3e\x8e\x49\xf5\x98\x0e\xdf\x6d\x3e\x48\x45\xea\xb2\xca\x5f\x18\x21\x2a\x2f\xf6\x35\x54\x42\x4d\x48\x36\x4d\xb9\xbe\xb1\x60\xc2\x40\x43\xb8\xaf\xdb\x0b\x31\x48\x31\xee\xbd\x30\x65\x48\x20\xeb\x90\xe8\xa0\x03\xec\x40\x84\xf5\x51\xac\x06\x4b\x87\x75\x29\x4e\xad\x61\x84\xdc\x94\xc5\xd4\x08\xa2\x4c\xa2\xd3\xc8\x08\x23\xb7\x8e\x08\x77\x36\x02\xc9\x2d\x52\xd8\x81\x09\xe4\x78\x52\x35\xc1\xc8\xe3\xae\xb9\x14\x60\x02\x92\x87\x93\xa8\x90\x19\x4a\x1e\x43\x83\x02\x0b\xe2\x09\xd3\xdd\xee\x11\xaf\xa2\x60\x17\x8c\xe2\x09\x87\xa0\xf8\xfc\x1b\x2b\xcb\x26\xf1\x32\x40\xb7\x41\x3d\xfb\xa2\x49\xac\xac\xac\x1a\xc6\x6e\xf3\x7a\x36\x25\x51\xa2\x54\xf6\x69\xa4\x7e\x82\xcf\x09\x7b\xf9\x4c\x8c\x14\x71\x26\xfd\x78\xb4\xcf\x88\x43\x00\x34\x62\x06\xc8\x89\xad\xef\x56\x2b\x07\x9e\x34\xa7\x1f\xc0\x43\x56\xa2\x58\x7b\x61\x8d\xa6\x3c\x9f\xf1\xaa\xf8\x34\x88\x84\x61\xb9\x3d\x4d\x48\x78\x5f\x32\xcc\x21\x24\xf2\xe6\x49\x90\xe8\x3b\x14\x26\x71\xad\x3e\x12\x51\x0b\xbc\x5b\x5c\xbb\xaf\x26\x47\xfd\x73\x88\xbe\x44\xe4\x71\xfa\xf8\x4e\x3a\xcb\x94\xd1\xed\x63\x3c\xe9\x39\x53\x46\x79\x46\x3a\x6a\xf4\x36\x24\xb8\x26\x69\x32\xcd\xb0\x93\x00\xe2\xfa\xa7\x99\xd3\x0e\x7c\x4d\xfb\x0b\x79\x45\x88\x0c\x1e\xa5\x1c\x12\x37\x40\xd8\x90\x5a\xc9\xe6\x6b\x8f\xc3\xb6\x11\xc4\x0d\x35\x28\x5c\x6b\x74\x76\xc4\xb8\x9e\x5a\x33\xd2\x34\x17\x6a\xf6\xc9\xd1\x3d\xe8\x16\x8c\xc7\x71\x13\x97\x6a\x70\xa6\x59\xf6\x89\xcb\x34\xe8\xf6\xed\x7e\x23\x38\xd7\xc0\x93\xb2\x2e\xf2\xab\x36\xb3\x08\x8c\x8e\x35\xe8\x46\x21\x8c\x6c\xbe\x67\xf5\xc2\x19\xa9\x70\xff\x3c\xf1\xec\x5e\x02\xd3\x2e\xf7\x9c\x5e\x0a\x55\xd1\x61\x15\xf7\x5c\x43\x1a\xb1\x7e\x48\x90\x04\x2f\xc4\xf3\x7a\xd9\x0a\x1e\xf3\x82\x67\x54\x78\x6d\x3f\x1d\xe5\x92\x06\x29\x7d\x43\xca\x83\x31\x34\x21\x0d\x0c\x49\x0f\xf6\x10\x25\x8d\xbb\xff\x24\xe2\xce\x5f\xde\xad\xbc\x46\xbb\x1f\x8f\xab\x5f\xdf\x3c\x9f\x97\x91\x85\x8a\x4d\xd3\xbc\xc8\xe3\x04\x4d\xc3\xd2\xb1\x38\x5a\x96\x36\x95\x4b\x92\x0a\x87\x88\x31\x9d\x6c\x5d\xd2\x24\xe3\x65\x4d\xd8\x3f\x08\xe5\x19\x85\xae\xe1\x58\xea\x53\xbb\x02\xd8\x8c\xec\xb9\x29\x5a\xb6\x2f\x55\x91\x34\x9b\x24\xe3\xa2\x99\x06\x3c\xa0\xf8\xa3\x57\x38\xf6\x69\x4e\xba\xbc\x87\xc8\xf8\xa6\xce\xa4\x6f\x59\x35\x30\xb3\x37\x23\xe3\x9e\x33\xab\x97\x93\xf5\x36\x7e\x12\xde\x57\x30\x12\xf6\xf0\x48\xa7\x71\x0d\x4f\x5d\x91\x62\xca\x11\xa9\x98\x5c\xbf\xe3\x81\x37\x2c\x33\xfc\xc4\x0e\x74\x5c\xc2\x40\x08\x73\x53\xe7\x0b\x48\x39\xc3\x89\x3d\xd6\xc1\x93\x6c\xbe\xc0\xa3\x72\x27\x76\xa8\x03\xe7\x8b\xca\x04\xcd\x4e\x02\x1c\x0f\x0c\xc3\x56\x4f\x8c\x5b\x7d\x44\x9d\x28\xfb\xd6\x80\x59\xec\x3e\x96\x2a\xaf\x48\xba\xe7\x32\x92\x69\x62\x3b\x8e\x7e\x73\x31\x90\xc5\xed\x93\x29\xc9\x86\xd0\x78\x7d\x34\x03\xc5\xf1\xfb\x76\x2a\xc3\xc4\x09\x34\xb6\x75\x6f\x4d\xf1\x4c\xac\xf1\xe9\xfd\x37\xe1\x30\x86\x40\x5c\x79\xc4\xfe\xf7\x05\x61\x65\x1d\xe7\x69\x9a\x5f\xd5\xf3\x22\xbf\xcc\x3f\x08\x0f\x56\xd7\x43\x34\xcf\x2e\x79\x86\xbd\xc1\xcd\x95\xd7\x07\xe2\xa4\x28\xab\xa1\x04\xf2\x12\x2c\x25\xc3\xf1\x72\x3b\x1c\xd7\x02\x42\x86\x0a\xf2\x24\x36\xbd\xe4\x64\x96\xc2\x4f\x7c\x4e\x6c\xc5\x8d\x20\x34\xa1\xe4\xc5\xa5\x08\xe0\x21\x49\x55\x57\xc9\x8c\xc3\x76\x67\x62\x2b\x37\x5e\xcc\x6e\x01\x4c\x6c\xe5\xae\x8b\x38\xc9\x16\x6d\xcd\x12\xa4\xa9\x26\xb6\x72\x32\xd2\xe0\xba\x1c\xe5\x20\x48\x56\xf6\x06\x84\xdf\x08\x55\x8c\x71\x8b\xe1\x70\x5a\xc9\x89\xad\x58\xe0\x06\x33\x9d\x2f\xc4\x88\x9c\x91\x8c\x95\x2f\x21\xfa\x87\x79\xe1\xc7\xe6\x9a\x3e\x6c\x6e\xd6\xbb\xf5\xc3\x06\x66\x96\xcd\x73\xeb\x6f\x27\xf0\x1b\x62\x13\x5b\xb9\xa9\x66\x86\x90\x97\x37\x24\x15\x3e\xaf\x4f\x2f\x48\x59\xea\x72\xb7\xdc\x3d\x3f\xc1\x64\xf2\x92\xa7\xd3\x23\x11\xef\x90\x14\x67\x70\xc9\x63\xfe\xa0\x28\x75\x49\xaa\xa4\x8c\x93\x33\xf8\xe4\x11\x2f\xae\x26\x37\x8f\x71\x0d\xe5\xd9\x2f\x74\xf7\xff\x69\xea\x9b\x4c\xb3\xbc\xa9\x6f\xb7\xe4\xbd\xbb\xc3\x97\xab\xf6\xa9\xb2\x8a\x50\x91\x83\x1a\x89\x45\xaa\x2c\x67\x1b\xb0\x37\x5b\x3e\xfd\x13\xe0\x0e\xb9\x12\x0a\xd6\x9e\xf5\xd4\xf3\xbc\xc4\x86\x5c\xc8\x7d\xfb\x14\x88\x99\x76\xee\x3b\x7a\x50\x9b\xe4\x1b\x86\x4e\xb4\x21\x4e\x2d\xfc\x0a\x49\x7a\x3d\xb1\x2d\x4b\x63\x12\x1d\xa6\x44\x58\x0d\x3d\x30\x99\xd8\x96\xdd\x7b\xda\x71\xc4\x0d\xb2\x38\xa8\x6c\xe8\x9d\xfe\x89\x6d\xb9\x86\xb2\x98\x70\x79\x1a\x89\xba\x9d\xff\x11\x1b\xcc\xe3\x1b\xf3\x98\x48\x15\x68\xd8\xf0\x8b\x6a\x13\xdb\x1a\x6b\x80\x6d\x86\xc4\xae\xf0\x34\xa7\xcd\xab\x58\x08\x8f\x6c\x6f\xa5\x83\x51\xe3\xe6\x90\x75\xf8\xe8\x70\xd5\x98\x83\x60\x67\xac\x43\xc8\x64\x6b\xac\xc7\x9b\xf4\x0b\x35\xaa\x96\x09\x13\x1b\x56\x39\x13\x4a\xae\xbd\x65\x35\x48\x7d\x64\x1b\x2e\x28\xba\x1b\xb4\x03\x28\x8e\x02\xfd\x3e\xee\xc3\x17\x0c\xc0\x61\x18\xcb\x55\xd0\x65\x47\x65\xc9\x65\x52\xc2\x2e\xbd\x50\xb9\x5c\xd0\xbc\x2c\x8d\x86\x5d\x84\xca\xf5\xee\x3c\xe3\xf5\x2c\xc9\x16\xa5\x31\x3a\xea\x47\xe3\x79\xb4\x95\x8b\x05\x4d\xa3\x2f\x48\xba\x7f\xd4\x44\xba\xef\x07\x36\x1d\x55\x0e\x3c\x48\xf6\x69\xc0\x5b\xdc\x13\x75\xa5\xd4\xe9\x5f\x67\xbc\x00\xe0\xc4\x0a\xe4\x42\xc5\x49\xaa\x55\x13\x24\x90\x88\x7b\x8e\xc6\x44\x95\x57\xc9\x1f\x7f\xa4\xbc\x06\xdb\x99\x7b\x2e\x00\x05\x1d\xb5\xdc\xf3\x00\x28\x78\x2e\xc8\x3d\x1f\x80\x82\x5d\xcb\xbd\x00\x80\x8a\x43\x64\x08\x1d\x46\x72\xeb\x8a\x15\x64\xcd\x53\x32\x47\x3a\x93\x2b\xc7\x98\x02\x55\x56\xc8\x96\x92\x4d\xe4\x82\x84\x59\x73\x6a\xdb\xea\xfe\x87\xa6\x20\x95\xab\xd8\xd9\xaa\xee\x65\x19\x18\x27\xcf\x54\x22\x03\xb1\x8a\x35\x59\x27\xf1\xfe\x1c\xc6\x4d\x43\x4f\x49\x75\x71\x38\x75\x82\xa9\xe2\xfe\x64\xc6\x83\xa8\x26\xea\xd3\xc9\x27\x3b\xd2\xf3\xb2\xf3\x4e\x54\xc7\xeb\xf1\xf2\xe2\x88\x1d\xe4\x89\x7a\x97\x4e\x43\x58\xa8\xc6\x5e\x0c\xc1\x33\x14\x8f\xc7\x9e\x4d\x2c\x8b\xf7\xcc\xc6\x43\x44\x89\xb1\x79\xd8\x98\x2c\x8c\x95\xc3\xa4\xc6\x72\x0b\x1b\x2a\xc2\x7a\x3a\x13\x7e\x4e\x5c\x42\xec\xb9\x08\xeb\x5e\x11\x20\x96\x50\x49\xcf\x7b\x70\x9a\x75\x6f\x48\x25\xd9\xa5\xc1\xb2\x30\xe4\x3e\xe9\xf3\xbe\x0d\x25\x92\xf5\x50\x8c\x5d\xc9\xfd\x56\xf1\x62\x9e\xa7\x0d\x89\xd1\xd8\xa5\xfd\x9e\xbc\xa1\x54\xb2\x5e\x82\x34\xe2\xbe\x01\x5c\xc3\x93\x77\x8b\xba\xe5\x4c\x59\x15\x9c\xc0\x47\x71\xb1\xb2\x22\x60\xf9\x42\x24\xdc\xc7\x32\x0a\xc6\x31\xd3\x82\xc0\x4b\x9c\x71\xcc\xb5\x20\xf0\x16\x57\xec\x05\xa7\x20\xec\x69\xc8\xd8\x1b\x6b\x41\xb0\x78\x5e\xa8\x05\x21\xe2\x4d\xb4\x20\xe4\x19\xa3\xd8\x23\x3d\x30\xa4\xb4\x48\x0b\x43\x1e\x32\x8a\x3d\xda\x03\x43\x4a\xd3\x74\x32\xfe\x94\x51\xec\xf1\x1e\x18\xd8\x24\x4c\xc9\x28\xd0\x1d\x02\x97\x8b\xa8\xc8\x17\x95\x38\xfb\x83\xb1\x01\x84\xdd\x1f\xb5\x82\x1c\xdc\x1b\x9b\x70\x18\x6d\x69\x43\xee\x85\x20\x99\x59\x96\x73\xee\x4d\x40\x96\xbd\x48\x66\x6c\x4c\x49\x9e\x20\x30\x2f\x54\x60\x65\x18\x0f\x7b\x81\x43\x9b\x45\x56\xfb\x6c\x31\x93\x1f\xf9\x30\x94\x86\x2b\x6f\x2b\x0c\xc6\x5b\xea\x44\x3e\x27\x15\xbd\x90\x2e\xf4\xcd\xb7\xeb\xfb\xf5\x6e\xfd\x6d\x85\x9d\x1f\x72\xe5\xc1\xec\x86\xe6\x70\x78\x08\xe3\xe4\x09\xb6\xc5\x75\x99\xd0\xea\x24\xcb\x9a\xd7\x00\x91\xd4\x39\x7c\xec\xf5\x52\xe4\x8b\xca\x8c\x42\xdd\x66\x94\xc2\x5f\x9e\x55\x45\x7e\x38\xae\x33\xab\x8b\x3c\xec\x1a\x96\x29\xcf\xd0\xa4\xfb\x7c\x3c\xd6\xc1\xca\x39\xa1\xc8\xba\x82\x8f\x65\x4d\xac\xf8\x7f\x93\x76\x76\x3d\x8e\xeb\xc8\xdd\xff\x4a\x22\xf5\x62\xf2\x92\x22\xa9\x6e\xed\x91\x2d\x3f\xb2\xdc\x33\xb3\x37\xc6\xee\x93\x83\x20\x40\x90\x13\x1c\x64\x83\xe4\xdb\x07\x94\x28\x37\x69\x91\x55\x25\xef\xe5\x60\xfa\xff\x33\x25\x15\x8b\x6f\x55\x45\xaf\xf4\x83\xdd\x38\xc1\xa1\x12\xc2\x9e\x64\xea\x87\xd7\xc3\x75\xbc\xd5\xa1\xed\xf6\xb7\x71\x40\x2d\xf5\x14\x5a\x6a\x37\x39\xbf\x34\xba\x5c\xe9\xd1\xc0\x8b\xaa\x93\x4e\xeb\xec\x17\x5c\x16\xdf\x46\xe5\xc1\x5d\x0f\x3f\x62\x97\x61\xb7\x70\xda\xe7\xfb\x21\x58\x53\x97\xd2\x6e\x16\xb5\xb9\x08\xd2\x29\xa7\xb0\xfb\x2b\x19\x5c\x43\xac\x5b\xa2\x2d\x1e\xe6\x30\x8f\x41\x8d\x3b\x18\x18\x61\x05\x4f\xd1\x82\xd6\x1d\x06\x96\x29\xe0\x4b\x6f\xa4\x3e\x6a\x95\x62\xad\x46\x40\x45\xd4\x29\xc4\xf3\x6d\x1d\x3e\xd1\x17\x56\x34\x29\x62\xf0\xc6\x0e\xe2\x64\x0a\xf7\x6a\x6b\x78\x94\xa5\x15\x0a\x69\x17\x9d\x25\x1a\x9d\x62\x6d\x8d\x3a\x70\xc8\x2f\x44\x63\x90\x66\x1d\xa1\x59\x66\x73\x21\xb7\xc9\xd7\x46\xa5\x76\x20\x35\xf1\x0e\x69\xe0\x2a\x2a\xe3\x03\x05\xc8\x45\xad\x47\x83\xe4\x44\xd5\xb1\x23\xe0\xe0\x01\x70\xb6\x8d\x6e\x46\xc9\xe8\x8f\x05\x27\xd9\xf8\xba\x94\xc4\xb3\x1e\xe4\x71\x0e\xde\xbc\xf1\x56\x39\x12\xcb\x4b\x10\xba\xed\x17\x5c\xd5\x1d\xdd\x45\xac\x48\x24\x37\x80\x7e\xc1\x83\x34\xaf\x11\x12\xbe\x79\x61\x4f\x05\x7e\x93\x0b\xbe\x49\xce\xaa\x68\xe3\x6a\x39\x16\x83\x7e\xb6\x8d\x12\x85\x7a\xb7\x4d\xe5\x36\x04\x56\x2b\x5a\x4e\x05\xd6\xb4\x18\x2c\x3c\xac\x95\x2d\x89\x43\x28\xd8\x12\xf5\x46\x17\xf3\xb3\x56\x9f\x00\x25\xd1\x60\x6b\x4d\x7f\x3f\x53\x54\xa1\x79\x7e\xf6\x1f\x9f\x14\x4d\x68\x7d\xae\x71\xc8\x75\xe4\x26\x2a\x99\xe5\x9b\x86\x6a\x42\x73\x5a\x1a\x86\x2a\xc2\xaf\xe8\xf7\xa9\x9c\xf3\xeb\x07\xd8\x8b\x98\x4e\xec\x85\x6d\x7f\x51\xd3\x2f\x5f\x5b\x07\xf4\x9c\x26\x3a\x87\x72\x2b\xab\x37\x10\x6d\x7a\x13\x69\x73\x97\x5f\x56\xcf\x23\x7c\xd5\x9f\xd9\x1d\x2f\x6d\xf7\x7a\x91\xc4\x26\xb7\xb9\x76\xa4\x09\xd1\xd5\xdf\xd3\x47\x5b\x37\x60\x89\x2f\x5e\x87\xee\x7b\xdb\x8a\xf7\xaf\x6d\xb2\xf3\xd4\xdb\x2f\xd5\x0e\xf8\x7d\xf7\xa7\x8a\xe5\x41\xf8\xf2\xfc\x14\xed\x8e\xb9\x2f\xf8\x82\x20\x7c\xc2\x53\xd7\xe5\x9b\x80\xeb\x13\x21\x31\xde\x04\x9e\xc6\x34\xe3\x72\x9e\xda\xd6\x3c\x02\xa8\x80\xbd\x63\x12\x00\x19\x3c\x49\x10\x56\xa0\x83\x3a\xc2\x49\x04\x2a\xa9\x21\x6c\x03\xf8\x29\x79\x2d\x12\x9f\x72\xbd\xc9\xab\x05\xc3\x0f\x04\xaf\x13\xdb\x46\x9e\x00\xeb\x54\xe2\x27\xaf\xfd\xd5\xba\xa5\x2e\x65\xe8\xe4\xf1\x4e\xbb\xeb\xfe\xbd\xfd\xe1\x6a\xb6\x22\xcf\x1a\x7a\x8d\x4d\xe2\x36\x74\xd6\xeb\xe9\xf1\x77\x65\x52\xfa\x76\xbc\xbb\x40\xc0\xa5\xea\x10\x2c\x0f\x7b\xde\x1a\x8d\x7e\x24\x2e\x94\xd7\x5d\xea\xe7\x97\xf4\x8b\x63\xa0\x26\x34\xba\x67\x89\x7b\x82\x12\xce\xa1\xa5\x7c\x3a\x24\x67\x96\x82\x40\xa7\x71\x94\xf9\x1b\x32\xa7\x44\x27\x93\x92\x45\x85\x87\xee\x97\xab\x9b\xae\x05\x35\x8c\xd6\xd5\x04\x56\x30\x4f\x32\x2e\x08\x18\xb4\x76\x9e\x64\x5c\x12\x38\x4b\xe4\x0b\x8c\x51\x04\x0c\x92\xed\x27\x59\x94\xba\xfb\xf6\x9b\xd1\x28\x84\xf2\x5e\x0c\x4a\x21\xbc\x15\x8b\x42\xb0\x77\x52\x46\xfb\x4d\x6e\xdc\xf5\xb1\x01\x7a\xbc\xc3\xcb\x55\x59\x44\x95\xba\xdc\xc1\xa2\xab\x15\xe6\x3b\x0c\x25\xef\x54\xf2\xe8\x1e\x34\x35\x8f\xe7\x5e\xaf\x3f\x4c\x29\xea\x21\xb9\x66\x98\x9c\xd0\x71\x25\xd7\x1c\xc5\x60\x39\xe5\x92\xeb\x12\x87\x20\xdf\x82\xeb\x0a\x65\x50\x32\x1d\x25\xd7\x35\x0a\xf2\x03\x63\xfc\x9f\xe0\xf7\xe6\xba\x79\x8f\x4a\x48\x62\x94\x5c\x9f\x50\x78\xbc\x55\x11\xcd\xcd\x60\xb4\x38\x88\x4e\x6d\x39\x80\x3f\x20\xdf\xf9\x81\xfd\x36\x09\xf8\x1b\xea\xe0\x6f\xbc\xcc\x1d\x61\x78\x7b\x10\xfe\x32\xb3\x85\xe1\x3a\xbd\xb0\x4a\xfe\x0e\x62\x24\xc9\xdd\xc1\xed\x4b\xbd\x03\xb4\x29\x60\xf0\x65\xde\x61\x76\xb9\xc0\x8d\x37\x60\xa6\xc8\x2d\x0a\xdf\x81\xb1\xdc\xc6\xe5\x3b\x30\x4e\xf9\xac\x08\xa3\x4c\xbd\xfe\xf4\xf7\x44\x48\x55\x8a\x94\xfd\x90\x08\xac\xa6\x7d\x41\x84\xd2\xd0\x3e\x1d\x42\x39\xd1\xbe\x19\x42\x09\x5d\xa0\xa3\x24\x3f\x38\x3e\xb0\x18\x4d\xe2\xf8\x51\x17\x69\x53\xe4\x35\x93\x03\x07\xc9\x0e\x55\xaa\x20\x42\xb2\x59\x58\x55\x06\xc9\x4d\x9b\x0b\x51\x8b\x5b\x86\xaf\xc2\xc3\xae\x96\x8a\xae\x6e\xd5\x34\xf5\xc4\xd5\x78\x54\x8c\xd6\xae\x3b\x9a\xef\x60\xaa\xc4\x9b\x3a\x04\x08\xad\x68\x3b\xe9\xeb\xac\x3b\x5f\x3b\x82\x89\x6b\x96\xfb\x60\xb6\xe5\xbc\xd0\xdf\xa1\x78\x04\x16\x55\x85\xf0\x79\x4b\x87\x5a\x13\x4d\x80\xfd\x8a\xdb\x5b\x31\x9d\x92\xce\x04\xba\x5f\xd7\xb2\xbd\x74\x0e\x8f\x38\xde\x6c\x8f\x63\xaa\xa2\xc8\x24\x9e\x1f\x80\x88\xa2\x80\x57\xa3\x74\x16\x2b\x22\xd6\x6b\x2f\x27\x71\x32\x1b\x4a\x81\x18\xf2\x13\xa2\x8b\x0a\xc4\x38\x17\x48\x3c\xa2\xee\x4a\x99\xf3\xc0\x01\xe1\xe1\xcc\xee\xe9\xe0\xd7\x33\x5d\x04\x1b\xfa\xad\x95\xe4\xdd\x26\x7a\x17\x55\x57\xb6\x59\x2d\x1a\xae\xd0\x95\x3a\x2f\xc6\x6b\x1a\x74\xa5\x81\xe5\xb0\xd8\x66\xc5\x6b\xdf\x07\xd4\xb2\x88\x02\xf9\x56\x35\x78\xcb\xaf\x2c\xa2\x74\xeb\x55\x01\x5e\xeb\x2b\x8b\x28\x7c\x6f\x55\x80\xf7\xf8\xca\xa2\xea\x12\xbf\x81\x95\xb5\x94\x45\x94\x23\xb9\xfe\x10\x72\xdf\xaf\x2c\x6a\xb6\x7f\x7d\xe8\x32\xb9\xa8\x79\xe2\xb5\x11\xe2\xb5\xeb\x32\xf5\x60\x04\x5d\x95\x7c\x32\x62\x94\x78\x5d\xa7\x7e\x35\x48\xcf\x82\xd5\x0d\xa2\xa6\xb4\x20\x9c\x72\xb9\xe0\x28\x92\xa9\xd5\x22\xa9\x42\xcc\xad\x96\x49\x15\x62\x72\xb5\x4a\xaa\x48\x66\xd7\x26\xa5\xb8\xe9\xe9\xa4\x8e\x62\x7e\x26\xa9\xa4\x99\xa0\x4d\x6a\x69\x66\xd8\x25\xb5\x07\x4c\xb1\x29\x92\x84\x03\xe6\xd8\x30\x02\x81\xd2\x12\x9e\x9b\x97\x92\x6c\xb3\x29\x61\x39\x62\xa4\x4d\x05\xcb\x11\x6b\x6d\x6a\x58\x4e\x31\xdb\xa6\x81\x19\xa8\xfd\x36\x27\x18\x40\x30\xe4\x46\xc0\x08\x92\x45\x37\x12\x86\x90\x4c\xbb\x51\x30\xe4\x88\x8d\xb7\x30\xea\x88\xb1\xeb\x23\x28\x4a\xdb\x4c\x72\xca\x86\x67\xb7\xca\xa2\xc9\x4f\x34\xd0\xd0\x0f\x59\x44\x9b\x91\xab\x7a\x55\xbd\xd4\xf2\xc2\x4a\x8f\x14\x5a\xd0\x40\xed\x2f\x74\xed\x5d\x68\x49\x66\xe9\x41\x21\x53\x29\xad\xd2\xbb\x37\xcf\x29\x2d\x96\x31\x20\x0b\xdd\xa6\xb6\x5c\xb6\xcd\x9b\x23\x20\x9d\x02\x05\x7b\x37\x47\x58\x26\xb7\x75\x73\x04\x62\x73\x3b\x37\x47\x20\x1d\xba\x6c\xc0\x20\xc2\x8a\xf0\xd5\x04\x87\x3b\x2e\x9f\xf5\xd1\x5e\x67\xfd\xb8\x63\x75\x91\xad\x30\x69\x86\xbb\x7f\xc7\x97\xbd\xa7\x92\x6c\x9a\xe4\x40\x0b\xc2\x77\x79\x34\x7c\xc9\x8a\x0e\x21\xdd\x2f\x44\x96\x64\xbc\x4b\x2c\xbd\xfb\xf3\xf9\x3e\x2f\x81\x2b\x78\xa4\x17\xb7\xa9\xe8\x21\xe7\x2a\x06\x75\xf9\xb8\xbb\x2f\xfe\x65\xa7\x1b\x96\xde\x71\xaa\x2c\xb2\xe7\x33\x8c\xc8\x49\xd4\xa9\xca\xbe\x16\xf1\xb0\xf0\x9d\x33\x92\x9f\xea\xb4\xfa\x46\x94\x37\x69\xf9\xa2\xbe\xde\x2f\xfa\x73\xfe\x9c\xc6\xfb\xc7\xe7\x6a\x34\x8c\x40\x3c\xa5\x89\xb7\x7f\x02\x29\xd2\x48\xd7\x21\xd6\x67\x7c\x58\xa5\x61\x84\x4c\x23\xd6\x56\x2d\xcf\x46\x25\x15\x69\xd2\xc4\x18\xae\x65\x69\xad\x37\x7b\x12\x82\xa7\x11\xd3\x07\x45\x5c\xa6\xc5\xdb\xef\xe3\x0c\x61\x1a\x09\xd6\x3e\x5f\x82\x3e\xf1\x0a\xe8\xa6\x51\x60\x39\x85\x87\x1e\x2f\xae\x4e\x98\xc2\xc2\x27\x4c\x34\x97\x71\x2e\x77\xdb\x2b\x45\x37\x7e\x59\x74\x4b\x99\x7b\x1d\xf7\xd9\xbe\x15\x6d\x2c\x59\xdb\xee\x1d\xff\xf5\x3e\x3f\x3d\x3e\x1e\x51\x2f\x59\xab\x33\x8c\x6f\xff\x46\xd9\xbc\x92\xac\x35\x19\x10\x7d\x10\xe2\x0d\xcf\x30\x6e\x9f\xca\x5d\xc7\x75\xb6\xe7\x71\x42\x27\x30\x82\x37\x65\x86\x73\x2c\x4c\x9e\x37\x55\x86\x73\xf8\xf4\x40\xf0\xa6\x26\xb1\x10\x48\xb3\x87\xac\x3b\x84\x6f\x3d\xa1\x2c\x6c\xbb\x07\x2e\xad\xfa\x31\x4e\xbf\x3d\x3e\xa6\xf1\x7e\x25\xa6\x7f\x4b\xd6\x5a\x9c\xb5\x3c\x25\x4c\xe9\x70\x0a\x6e\x00\xaf\xe3\xc1\x31\x80\x2c\x6c\xd8\x2d\xa0\x3c\x86\x97\x1e\x0c\x32\xc3\x1e\x42\x39\xfb\x3e\xc2\x0e\xdf\xbd\xe9\x6f\x6b\x2e\xd2\x6b\xa1\x02\x90\xd0\x11\x08\xdb\xd2\xe6\xd8\xc9\x4b\xfc\x1c\xc8\xee\xbb\xe0\x51\x2d\x19\x63\xdb\xfb\xc7\x96\x09\xb5\x5d\x0e\x38\xde\xbf\x33\x49\xed\x7f\xfc\xed\xef\xff\xfe\xbb\xfe\xdb\x7f\xc2\xcc\xd0\x23\xac\xcc\x8b\xab\xea\x39\x8c\x1f\x1f\x8b\x63\xb9\xdd\x9c\x97\xc2\xc3\x87\x79\x54\x73\x66\x25\x69\x35\x0c\x4b\xd4\x5c\x77\xbf\x68\xac\xf2\x1c\xaf\xea\x3c\xe0\x7e\x5b\x12\x39\xb0\x00\xd2\x38\x57\x7f\x41\xf8\xca\x42\xea\xda\x3f\xdf\x8c\xf9\xfd\xef\xff\xf8\xd7\xdb\x1f\xff\xf8\xf3\xff\x83\x49\x2b\xbc\x3a\xe5\x68\x3f\xfa\x8b\x19\x7f\x3c\x6e\xbf\x6e\xb3\x3d\x1f\xe7\x8a\x1c\xf7\x35\x5e\xff\x30\x59\xe6\xc8\xf3\x67\x3f\x19\xf7\x06\xe7\x5f\xc7\xa9\x2a\x47\x55\x57\x77\xa1\xe5\x92\xda\x71\x9c\xda\xe6\xa8\xa3\x2b\x64\x73\x9c\x17\x7a\xa6\x95\xe7\x36\x07\x1e\x76\x9a\xc6\x17\x1a\x96\xae\xc4\x2b\x93\x66\x19\x7b\x9d\xac\x56\xb3\x73\x43\xf6\x53\x7d\xf5\xc7\xc9\x36\x4d\xfe\x0e\xb6\x7d\x17\xdc\xa5\xc1\x2e\x12\x59\xad\xfb\x12\x07\x89\x75\x91\x21\xda\x69\x59\xd1\xb9\xfa\xa8\x07\x89\x2c\x4d\x4c\x7c\x70\x84\x25\x59\xe4\xb8\xdc\x70\xb8\xf2\xc8\x0e\x4b\xb2\xaa\x4a\x12\x62\xcf\x07\x8f\xec\x09\x97\x75\x48\x9e\x70\x57\xf6\xcb\x4e\x6e\x3f\xca\x25\xfb\xc4\xaf\xe4\xf6\xfb\x7f\xff\xfe\xe7\xbf\xfd\xd7\xff\xc2\xc8\x53\x1e\xb9\xe6\x1c\xbd\x03\x15\x79\xe8\x30\xfe\x38\x4c\x14\xbc\x11\x69\x4b\x38\xab\xe9\xb7\x83\xa6\x20\x78\x23\xd3\xb0\xab\x2b\xa4\xba\x4c\xaf\x8e\x02\x55\xae\x2f\x5d\xdf\xe3\xb5\xf9\xf7\x77\x19\xe7\xbe\x4b\xfb\x50\xd2\x8b\xd4\x49\x23\xf6\xb3\xb9\x59\x11\xa2\x98\x05\x8f\x76\x79\xdf\x01\x44\x85\xdc\xfd\x9c\xea\x9d\x94\x4e\x1e\xd5\x72\xf7\xd3\xbb\xf7\x40\x7c\x9f\x4a\xf2\x26\x29\x74\x34\xff\xef\x6e\xa7\x5f\x6f\x72\xaa\x7d\x8b\x9e\xc9\x2d\x6f\x22\x43\xff\xe1\x2b\x78\xbd\x49\x0a\xfb\xa3\x33\xa3\x41\xb5\x76\x20\x78\xd1\xf8\xd3\x87\x93\xd2\x43\x13\x51\x1f\xdd\xc4\x13\xf5\xe5\x5d\xf1\x6e\x0f\xc6\x26\xc8\x8d\xcd\xdc\x60\x45\x29\x0e\x23\x4b\x96\x0b\xd9\xd9\x8a\x9b\xa0\xa9\x09\x25\x63\x08\x02\x4f\x4c\x28\x19\x47\x18\x4b\x86\x10\xf2\x28\x25\xc2\x20\x9c\xe0\x94\xac\x42\x20\xc7\x4b\xed\x96\xac\xde\x6f\xec\x3f\xb9\x84\xf7\xdb\x00\x72\xca\xbb\x3d\x01\x7a\xca\x7b\x15\x80\x1e\x7f\xa7\x82\x47\x35\x44\x5d\xb1\xd8\xe9\xa2\x06\x7f\x88\x74\xbb\x5f\xdd\x6c\x0d\x8e\xfe\x89\xb7\x3c\x5f\x08\xd7\x65\x9d\x8c\xc4\x0f\xc5\x1b\x9f\x2f\x04\xb7\x9b\x84\xee\x22\x9c\x78\x1e\xf0\x31\x59\x7b\x21\x20\xca\x3c\xa2\x1d\xee\xf8\x65\xa0\xfc\x54\xe5\x09\xcb\x16\x32\x01\x51\xe7\x11\xcb\xa0\x47\x40\x34\x79\xc4\x6d\xb6\x17\xdd\x0f\x04\xc8\x09\x80\xac\x3b\x7c\x38\x43\xe4\x19\x93\xc5\xd3\xf6\xe3\x4d\xf9\x17\xc0\xfa\x51\x71\x84\xca\x23\x96\x8f\x8a\x13\xda\x3c\x61\xfd\xa8\x38\x42\xe7\x11\xbe\xc0\x33\x8a\x30\x79\xc4\xf6\x51\xf1\x76\xbc\x0e\x46\x98\x77\x13\x3c\xaa\x5a\xe9\x24\xa8\x47\x13\x5c\x14\xa9\x22\xd6\xb0\x24\xec\xff\xee\x67\x50\xc7\x27\xb8\xe0\xb9\x0d\x56\xd3\x9f\xed\x05\x3d\x9a\xe3\x22\xec\xf0\x6b\x19\x08\xe2\x66\xb3\xa8\xf6\xf7\xc6\x10\x95\x61\xff\xde\x3e\x1c\x55\x1b\x76\xec\xad\x6c\xc5\xc5\x58\x3c\xfb\x39\x2a\xc7\xb2\x5a\x1c\x55\x29\x12\xed\xa5\x6a\xc3\xee\x1b\x8e\x4a\x54\xbd\x42\xf5\x94\xe0\x54\x2e\xda\x0c\xa7\x1d\xec\x05\x91\x86\x3d\x77\xa9\x11\xb2\x04\x83\x23\xdf\xc9\xa4\x45\x3e\x32\x03\xd6\xda\x8c\x16\xed\xdc\x22\x7d\xe2\xfd\x1d\x11\x02\xaa\x65\x91\x55\xa3\xbf\x2c\xc3\xce\xfb\x61\xe7\x37\x7e\x9d\x83\x04\xbc\x05\x61\x4f\x3e\xf7\xd7\xb3\x02\xeb\x6d\xf3\xe8\xd6\xee\xb3\xba\xb8\x7a\xef\x1f\xf6\x62\x27\x35\x5b\x92\x3e\xec\xc5\xea\x3e\x8f\xc7\xd4\xfb\x7e\x6c\x2f\x7a\x44\x36\xeb\x05\x97\x61\x2f\x76\x07\xd3\x4b\x42\x3b\x2c\x89\xba\xaf\x93\x2c\xb7\x3e\xc3\x9a\xa8\xdb\xf5\x03\x9c\xd9\x22\xb8\x6c\xf7\x11\x0e\xfe\xfb\xc1\xba\xb0\x6f\x45\x61\x42\x24\x75\xd8\xc9\x5e\x63\x83\x48\x80\xb0\xa7\x3d\x03\x82\x48\xca\xb0\xa7\x3d\xa3\x80\x28\x4a\x95\x3a\x6a\x21\x09\xc3\x2e\xe6\x05\x78\x85\x6e\xc1\x15\x4f\xe8\xd6\xb2\xec\xb0\xae\xcc\xea\x48\x3f\x1b\xf6\x2f\x7f\x82\xb0\x76\xe5\x61\x44\x2c\x56\xd5\x39\xe9\x6d\x1e\xb1\xb7\xd4\xe4\xb4\xeb\x11\x1e\x2c\x0e\x7b\x97\xf7\x3b\xf6\xa7\x25\xcc\x95\xa3\x0b\x1d\x7d\xac\x65\x14\x7c\x87\x45\xde\x09\xae\xa2\x99\xee\x82\x58\x06\x1a\x8a\xf3\x54\x61\x7f\x0d\xb5\xa8\xdb\x54\x61\x0f\xbc\xf5\x2e\x0e\x53\x5d\xec\x78\xbf\x3d\x3f\xb9\x4b\x60\xd9\xae\x20\xb9\x21\xcd\x30\x14\xd8\x36\x85\xc0\x71\x96\x82\x5b\xdb\x86\xfb\x34\xd5\x51\x68\x5b\xe3\x70\x5e\x9b\xea\x8f\x87\xeb\x46\x08\xde\x72\x0a\x87\x30\xe7\x6e\x4b\x0a\x08\x35\xe5\x36\xec\xb8\x7a\xb0\x6a\x3b\xee\x86\x55\x75\xe2\xc7\x5d\xcd\x19\x58\x15\xf6\x56\xf7\xd7\x87\x7b\x4d\x1b\x76\xd9\xee\xee\x2a\x09\xad\xfb\x15\xb0\x2a\xec\xae\x5a\x7d\x59\x35\xd3\x74\xfb\x3e\xba\x74\xec\x47\xf5\xf8\xf9\x28\xa1\x08\x35\xc1\xdb\x7d\x1f\x5d\xb5\x9c\xa0\x6d\x33\x5a\x46\xd0\xea\x8c\xd6\xb5\x99\x35\xb0\xd6\x64\xb4\x9c\xa0\xb5\x19\x2d\x23\x68\xbb\x8c\xd6\xb5\x59\x80\x52\x5d\x64\xa4\x1c\x97\xb2\x8c\x94\xe1\x52\x9e\x93\xb2\x07\x63\x0f\x56\xc0\xea\x32\xa7\xde\x6e\x80\x81\x3f\x71\x54\x1f\x64\xed\x46\xcb\x23\x33\x2e\xf0\x2a\x51\xba\x4e\x8b\x65\x43\xd0\xee\xfb\xef\xf2\xc3\x4d\x45\xd0\x9e\xd2\xda\x8a\xd2\x66\x91\xd6\x96\x9c\xa0\x95\x69\x2d\xa7\xb4\x59\xa5\xb5\x8c\xf2\xae\xda\xb4\x96\xf2\xb8\x3a\x2d\xbd\x95\xb3\x7e\x98\x9f\x33\x73\xf1\xd1\x30\xc1\x10\x08\xe0\x4d\x48\x71\x49\x8c\x04\xa2\x24\x20\x3a\x18\x51\xe3\x08\x53\xa4\x11\xd3\xc7\xac\xd9\x03\xdb\x06\x30\x2c\xaf\xe6\x8f\x09\x5e\x7b\x19\x9e\x16\xd3\x82\xe4\xe3\x9a\x16\xaf\x72\x34\x9a\x3d\x3e\x83\xf4\x27\x48\xb0\x80\xef\x05\xeb\x1c\x00\x96\x95\x89\x03\xc1\xa5\xb8\x32\x2c\xab\x12\x32\x7f\xe4\x06\xea\x42\xef\xe3\xf7\x64\xbe\xd4\xd4\x23\x3b\x43\xf2\xe5\x94\x71\x5d\x5f\xb8\xe5\x81\x73\x9e\x84\xa7\x14\x89\x6a\x8e\xcf\x9b\x12\x60\xa9\xcc\xad\x59\xa9\x00\x05\x2c\x5b\xa9\x8c\x36\xb5\x72\xa5\x8a\x75\x6a\xf1\x4a\x15\x9b\xc4\xfa\x95\xaa\xb5\xd0\x5b\xa7\x59\x75\x87\xbf\x7d\x12\xa8\x2b\x48\x5f\x81\xc6\x62\xf0\xd7\xa0\x41\x38\xfc\x55\x68\x90\x12\xfc\x3a\x34\x46\x05\x97\x77\xf0\xb5\x6b\x61\x46\xd8\xad\x7d\xf1\xaf\xc9\xae\x91\x74\xd0\x58\x27\x79\x17\xf6\x6b\x77\x0c\xe0\x8a\x1e\x50\x8e\xfc\xbb\x70\x3e\xb1\x08\xef\xe7\xed\xa7\x37\x8f\x82\xfc\xb4\x48\x10\x0e\x5f\xa5\x22\x79\x5c\x74\x98\xda\xfc\xc8\x2b\xc0\xcb\x76\xc9\xbb\xb0\xff\xfb\xcb\xda\xe0\x85\x9d\xe4\x51\x11\x62\xf4\x26\x2e\xc9\xa3\xcb\xac\x16\x97\x8a\xe7\x4b\xf0\xce\xee\x1b\x36\x4f\x3d\x78\x89\x88\xe4\x51\xf5\x8e\xb3\x9a\xa7\xfe\x27\x41\x56\x46\xc5\x43\xfa\xdb\x63\x1a\x7f\x3c\xce\xea\x2f\xe0\x4d\xa1\xb2\x2c\x18\x1a\xd8\x8d\x3e\x67\x59\xf0\xfd\xb8\x85\x47\x5b\xcb\xb2\x28\xf7\x3a\x52\xed\xbe\x32\xaa\xd2\x73\xd4\xb6\xcb\xe8\x6e\xe4\x63\xca\xb0\x43\xc6\x11\xef\x9b\x0b\x47\x03\xde\xcb\xe2\x94\x85\xc4\x4e\x9c\x80\x12\x30\x2a\x74\xe3\x04\x9a\xcc\xd2\xbe\x1d\x39\x01\xa3\xb2\x98\x6f\x57\x4e\xc0\xb4\x59\xcc\xd3\x99\x13\x28\x61\x47\x9f\xc7\xeb\x7a\x77\x12\xd1\x4d\x94\x85\x81\xd4\x84\x4e\x19\x7a\x80\x2d\xfc\x06\x56\x74\x09\x05\xc1\xd7\x44\x37\x77\xf5\xb7\xc7\x75\x89\xc6\x9f\x35\xe8\x68\x0b\xc3\xf7\x3b\xc4\xcf\x89\xe3\xbb\x37\x44\xca\xc2\x94\x18\x96\xe2\x1f\x0a\x53\xa1\x18\xb4\xe6\x68\x61\x6a\x1c\x82\x98\x41\x61\x5e\xc7\xe1\xa8\xb3\x7f\xe3\xd0\x9c\xb5\xc2\x9c\x72\xa9\xd7\x6f\xb0\x5e\x87\xe8\x84\xf7\x38\xc4\x93\x29\xde\xce\x85\x1c\x42\xaa\x7d\x30\x56\xe8\x00\x0e\xb1\xda\x7d\x7c\x43\x98\x9b\x73\x04\xa5\xf7\xa8\x35\x54\xe2\x0d\x56\xe8\x22\x1c\x0b\xb4\x77\x84\x65\x11\x16\xa9\x5c\x71\x61\x42\x1f\x92\x6e\x8e\xbf\x35\x94\x52\x81\xd8\x97\x5c\x2b\x00\x26\xa9\x36\x19\x54\x24\xcc\x03\x7d\xae\x14\x69\x72\x5c\x44\x4b\x47\x1f\x0b\xf6\x3c\x4d\x59\xf7\xdc\x91\x0b\xdb\x24\x93\x26\xb1\x53\x1f\xbd\x22\x58\x6e\xf3\x72\xec\x2b\x31\xd9\xe5\xc5\x07\x3e\x8f\x88\xeb\x31\x6e\xa0\x65\x53\xe5\xdc\x13\xae\x66\x8b\xeb\x55\x46\x7a\x37\xb3\x5a\xf4\xd0\x97\x10\xdc\x18\xb0\x01\x58\xc1\x33\x6e\x2c\xd8\x00\x3c\xf4\xc9\x74\x09\xc0\x77\x75\x03\xca\x23\x84\x43\x8d\xf7\xee\xbe\x2c\x01\x3e\x52\x89\xb8\x18\x6a\x2c\x9f\xac\xbb\xa8\xf7\xcb\xe2\xf6\x24\xe2\x6a\xa8\x1e\xe3\x7f\xfe\x61\xfa\xaf\xfe\x06\xdf\xfb\x1f\xd7\x41\x7d\xd1\x53\x7e\x5e\xe4\xe5\xe8\x64\x47\xc4\xb5\x4a\x83\x51\xf2\x9d\x17\xa1\x40\x94\x6f\x14\xf8\x49\xbb\x28\xdd\xea\xe5\x69\x08\x87\x78\xd1\x84\xca\x25\x95\x0e\xae\x38\x45\x8f\x1c\xd3\xda\x1a\x6c\x38\xe1\x2d\x72\x06\xd7\x0b\x18\xfd\xbc\xce\xde\xb6\x53\x3a\x78\x9f\x57\x73\x95\xe8\x1c\xde\xcf\xf8\xf7\x01\xe8\x5f\x8a\x87\xba\xf2\x74\x57\x3b\xdd\x7a\x77\x36\x3c\x23\x1e\xff\xa5\x6e\xa8\xd3\xea\xf1\xd3\x4e\x04\x65\x5c\x2b\xd4\xfc\xba\x28\xb7\x32\x7d\x0e\x38\xb0\x38\x2e\x10\xaa\x87\xde\xfd\x20\x51\x5b\xb8\x07\x4e\xe9\xcf\xea\x7a\xb5\xdf\xd7\x65\xd0\x86\x3c\xce\x42\x23\xf4\xda\x6f\xc7\xe4\xdb\x04\x12\x78\xb1\x27\x6c\xcf\xe2\x12\x2f\xa0\x4e\x20\xcb\x28\x75\x7f\x3d\xc0\xf6\x26\x00\xca\x2a\x95\x5a\x85\x3c\xe3\x32\x61\x6d\x0b\x6f\x99\x91\xb7\x15\x2a\x4d\x02\xa1\x7d\x4a\x32\x19\x2e\x73\x96\x04\x21\x2f\x06\x54\xbe\xba\x6b\x91\x51\x12\x2d\x40\x32\x59\x66\x09\x68\xe7\x93\x2c\x8a\xcc\x5b\x7f\x7f\xb2\x37\x97\x1e\x72\x19\x1f\x3f\x14\x6c\x7d\xa7\x38\x03\xb3\x77\x69\xb6\xee\xa0\xe5\x7c\x7d\xcc\xe3\xc3\x1a\xd0\xf2\x8a\xba\x38\x25\x72\x7d\x86\xf1\x16\xe4\x0d\xfd\xf9\xe7\x1f\x7f\xea\x3f\xfe\x05\xca\xad\x93\x65\x54\x9f\xf0\x62\x3f\xfc\x28\x70\xb1\xae\x15\x23\xbc\x4d\x5e\x46\x05\x0a\xff\x6a\xa7\x91\x24\x0a\x2d\x47\x0f\xfd\xf5\x31\x4e\xfd\x47\x0f\xaf\xbc\xeb\x70\x16\xb3\x88\xd6\x49\x25\x7a\xeb\x6f\x94\xb1\xb2\x7e\x23\xf7\x65\x5c\xf1\x09\x8b\x79\x67\xcb\xc2\x31\x77\x15\x5f\xc6\x23\x7a\xb9\x37\x2f\x67\x23\x1f\xae\xc3\x1e\xc0\x28\x00\x73\xa4\x41\xf1\x86\xaf\x1b\xba\xb5\x0b\x44\x31\xfd\x6d\x56\x17\x78\x4a\x2f\xe2\x7d\xdf\x68\x81\xb0\x7c\x0f\x17\x7d\x45\xa6\xb1\xa2\x68\x12\x03\xdf\xac\xa6\x0f\x64\xf2\x11\x2d\x2c\xd6\x77\x41\x50\x45\x85\x60\x3f\xee\xfd\x30\xff\x7a\x76\x9a\xc9\x62\x13\x9e\x3a\xec\xe7\xfd\xe5\x32\x6a\x37\xe6\x1c\xd0\x87\x7e\xfe\x7e\xf9\xed\x32\xfe\xb8\x1c\x91\x87\xaf\x6a\xf9\xf3\x28\xeb\xd5\x6d\x75\xa9\xd9\x7e\x40\x95\x08\x05\x8f\x4a\xe8\x0e\xe3\xcd\x3e\x1b\x30\x5e\x08\x6d\x88\xca\x80\x5e\xc6\x44\x33\xf0\xec\xc4\xf0\x2d\x6e\x3f\xee\x06\xc9\xc7\x34\xb6\xf7\xdb\xec\xeb\x27\xa3\xa3\x76\xd7\x26\x38\x93\x1d\xac\xba\xd9\xef\xec\x7f\x90\xa0\x09\x84\x47\x37\xdc\x6f\xd0\x06\x9d\x28\xa2\x23\xfe\x35\x36\x7a\x46\x8e\x83\x45\x11\x9d\xec\x5f\xc7\xdb\x62\x48\x5f\xe3\x70\xf7\xa3\x38\x91\xc2\xf7\x14\xa7\xf4\x47\x12\x64\x4c\xd8\x2f\xae\xd3\xf8\xf3\x17\xbd\x05\xe1\xe7\x5c\xa5\xef\x3f\x4d\x9d\x63\xbd\xf1\x4c\x51\x1a\x6a\xd8\x1a\xb0\xc4\xac\x28\x18\xcb\xe8\xc0\xda\xb2\xa2\x88\xf2\x4d\x9f\x17\xfc\xc1\x15\x69\x45\x11\xdd\xb5\xf6\xd9\xdf\x66\xf4\x7a\xbf\x22\xba\x1b\x77\x7d\x4b\x44\x61\x38\xc6\x9f\xfb\xcb\x59\x81\x73\xbb\xba\x66\xfb\xed\xa4\xe8\x12\xca\xc7\xed\xda\x4f\x8f\x2f\x18\x12\xbd\x96\xe5\xef\x3d\x04\x90\x89\xb8\xe0\x90\x7b\x97\x67\xfb\x7d\xdc\x05\x2b\x3b\x40\x49\x98\xcf\x79\x2f\x25\x12\xbe\x61\xf1\x52\x97\x71\xad\x85\x82\x3a\xa8\x5d\xf0\x80\xbb\x3a\xca\x9d\xc9\x9e\xfb\x19\x1b\xa0\x6d\x97\x5a\x60\x52\xd5\x5d\xb1\x5f\x55\xfb\xef\x47\x2b\xb6\x25\xe2\x08\x81\xd4\x9e\xb5\x5f\xdf\xc2\x90\xf0\xdb\x67\x36\xaa\xc9\x0d\x8a\x86\xee\x97\x0d\xf9\xe0\x0d\x59\xca\xfb\x09\x3b\xdd\xeb\x6e\x37\xb9\x41\x61\x37\x7c\xd9\xe6\x26\x33\xc2\x21\xdd\x4d\x9f\xae\x6e\x17\x69\x09\x5e\x0a\xbe\x3a\x8c\x38\xa5\x10\x7e\x93\x96\xc6\xb0\x2c\x1c\x4a\xaf\xe3\xf0\xeb\xc3\x5d\xe7\xbf\xec\x45\xad\x4b\x11\x40\x2d\xeb\x68\x52\xb5\x7a\x84\x87\xfd\x39\xe3\xd9\x8c\xb2\x8e\x66\x54\x6e\x23\xf3\x88\x5c\x54\x9d\x4d\x4c\x1e\xdd\x94\x54\x5d\xfa\xdb\x38\x4f\xe3\x15\x9c\x0c\x55\x2f\xa7\xf8\xcf\x14\xa0\x03\x0c\x6e\x91\x05\xf0\xf8\x65\xa7\x6e\x40\x72\x4f\xac\x81\x21\xb7\x79\xb2\xea\x4c\x61\x15\x26\x72\xb8\x2e\x65\x19\x89\xd6\x2c\x4c\x3c\x2b\x5e\x72\x94\x71\x4d\xf8\xe5\x96\xa4\x64\x5c\x12\x76\x18\x5f\xae\x17\xd5\x84\x1d\x64\x5d\xea\xe1\xbf\x13\xf6\x88\x2d\x3d\x02\x51\x31\x59\x84\x4b\xa2\xe1\x7e\xee\x2f\x6e\x29\x04\x4b\x54\x4a\xb2\x56\x80\x05\x84\x42\x1b\x99\x49\xee\xec\x2f\xce\x91\x0c\x76\xb6\xb4\x7c\x60\x59\xc6\x49\xc8\x41\x51\xf2\xe5\xae\xdf\x25\x57\x1a\xbd\x39\x57\x96\x82\xe3\x94\x8f\x49\x5d\xee\x83\x72\xd5\x76\x20\xd6\x4b\x9e\xa2\x63\x5d\x07\x44\x11\x1a\xc6\x4d\x3b\x03\x84\xff\x3e\x34\x0a\xd7\x27\x06\xb8\xfc\x7a\x9c\x84\x68\x5c\x6d\x24\xe4\x07\xc2\xc1\x7f\x70\x79\x2c\x98\x20\xfc\xa0\xcb\xcc\xd9\x8c\xf0\xce\x8d\x8c\x53\x16\x17\x4d\x7b\x9f\x90\x9f\x09\xfd\xf4\xa7\x9a\xcc\x80\xe4\xd8\xc8\x38\x61\xf1\x36\x76\x33\x41\x12\x7a\x55\xd3\x77\x3e\xa8\x03\xd4\x44\x49\x82\xf6\xa7\x1e\xee\x37\x78\x2d\x28\xe3\xdc\xab\xcf\xdb\xf0\xf8\xbc\x23\xbf\x11\xb6\xcb\x09\x6e\x6a\xbe\x4f\x68\xb4\x46\x9c\x47\xe5\x74\xcb\xcb\x06\x25\x6d\xf8\x38\x4e\xb2\xf4\xee\xf1\x86\xd9\x7e\x94\x42\xf2\x1c\x7c\xd7\x1b\xac\xdd\xc8\xde\x8e\xf0\x6e\x6a\xf4\xbb\x41\x26\x94\x8b\x32\x7f\xa8\xdb\xac\x1f\xd5\xff\x54\x30\x81\x61\x84\x1a\x23\x70\x9c\x00\xdd\xc4\x2f\xcb\xb6\xc4\x08\x0d\x46\xa8\x70\x02\x94\x90\x23\xcb\xb6\xc6\x08\x02\x6b\x43\x83\x13\x90\x36\x9c\x70\x02\x94\xa5\x23\xcb\x56\x60\x04\x56\x60\x8f\x21\x09\x08\xe4\x39\x14\x01\x81\x3c\x48\x4b\x40\x80\x49\x47\xb2\x6c\x35\xca\xe0\x28\xc3\x50\x18\x50\xf2\x92\x2c\x4d\x91\x66\x44\x65\xd7\x49\x9d\xd5\x30\x2a\x09\xeb\xb4\x86\xd3\x49\xb0\xbd\x98\x92\x4a\xc2\x3a\xb1\xa9\xe8\x24\xd8\x00\x4d\x4d\x25\x61\x9d\xda\x34\x74\x12\xd2\xa6\x13\x9d\x04\xf7\x0d\x23\xa8\x24\xb4\xb3\x1b\x79\x00\x85\x3c\x9f\x3a\x80\x42\x1e\xb0\x3d\x80\x42\x3a\xb0\xd1\x64\x16\xea\x0c\x8c\x39\xc2\xe2\xff\xec\xc8\xff\x40\x22\x32\xe9\x73\x79\x9c\xc4\x71\x52\x30\x9f\x47\x78\xac\x8a\x02\x5e\x9f\x37\x9a\xf4\x97\xb9\xa9\x10\xad\xe0\x51\x68\x82\xab\xb0\xfd\xd0\xc3\xc3\x7e\xb9\xa3\x13\x54\xca\xb2\xd2\xe7\x3a\x09\x61\x88\xe8\x68\x7e\x19\x2a\x96\xd4\xb5\xe5\xc4\x10\x6b\x80\x64\x51\x27\x70\x3b\x21\xdb\x76\xda\x32\x87\xc4\xf5\x91\xe5\x2f\xfa\xe7\x76\x13\x91\x10\xd9\xfb\x42\x70\x05\x54\xa8\xbf\x1f\x5a\xf8\x5a\x66\x70\xbc\x0c\xbf\x10\x9d\x2c\xab\x2a\x13\xc6\xb9\x45\xe0\xfb\xc2\xa6\xc1\x3e\x17\xc6\xcc\x5f\x05\xb0\xb6\xeb\x30\xb0\xac\xea\x0c\xf0\xa5\x91\x2e\xc8\x0f\x83\xe5\xaf\x05\x08\x5b\x47\x20\x11\xcb\xcb\xe3\x94\x23\x05\xe5\x71\x5a\x95\xaf\x09\xbf\x15\x95\xc7\x21\x75\x1e\xf2\x5d\x58\x1e\xc7\x34\xfb\x2a\xbe\xcf\xe2\xf2\xb8\xfa\x94\x53\x47\xc5\xe4\x71\x8e\xc8\x71\x5e\x8a\xc7\xe3\x24\x99\x23\x05\xc5\xe2\x71\x8a\xca\x51\x82\xe2\xf0\x38\xa5\xcd\x51\x96\xda\xe0\xb8\x5e\xa7\x2b\x36\xaf\x07\x1e\xa8\xda\xa4\xd5\x89\x72\xef\x38\xcb\xa6\x59\xfb\x02\xef\x38\xaa\x4b\xa3\x82\x92\xee\x28\x83\x50\xc4\x1d\x67\xb0\x34\x83\xf2\x69\x88\x85\xda\x71\x0a\xa9\x58\x3b\x8e\xd9\xbb\x82\xc3\x88\x26\x5f\xcc\xdb\x15\x6d\xc7\x01\xa7\x3c\x60\x2d\xd1\x8e\x23\x44\x1e\x31\x8c\x3f\x10\xbd\x60\x51\xdd\xa7\x97\x92\x8a\xac\xc1\xe5\x4d\x5e\xce\xd1\x89\x15\x53\xa7\xbc\xbc\xe4\x98\x5c\x54\x2a\x71\x78\xe2\x43\xf4\x09\xe3\x9c\x78\xf1\x35\x4e\xf7\x3c\x3e\x21\xcc\xad\x44\x74\xf2\xbd\x98\xe2\xa4\x7e\xf8\x63\x5a\xcc\x7c\x84\x88\x0e\xc1\x03\x65\x81\x2b\x9b\xb4\x92\xe1\xca\x53\x5a\x89\xbf\x6b\x2e\xd2\xca\x12\x57\xca\xb4\x12\x35\x0f\xc1\x55\x5a\x59\xe3\xca\x36\xad\x44\x2d\x5a\x70\x9d\x56\x9e\x70\xa5\x49\x2b\x05\xae\xb4\x69\xa5\xc4\x95\x5d\x5a\xc9\x70\x23\x2a\x8b\x8c\x14\xb7\xa2\x92\x65\xa4\xb8\x19\x95\x3c\x23\xc5\xed\xa8\x2c\x33\x52\xdc\x90\xca\x2a\x23\xc5\x2d\xa9\x48\x1e\xb4\x6f\xd5\x36\x30\xf5\xa9\x4e\x94\xe8\xf0\x91\x27\xea\xa6\xfb\x1e\x23\x34\xfc\x94\x20\xd0\xe6\xcc\xe2\xd4\x24\xb4\xdb\xaf\x23\xbf\x7b\x3a\x25\xb4\x3e\xf4\x04\xfd\x61\x55\x24\xc4\xfd\xe5\x36\x4f\x77\x4d\x59\x19\x09\xa1\x42\x13\x73\xae\xf5\x3d\x0a\x4f\xb4\xe3\xb2\x06\x06\x1f\x03\x95\x99\xe6\xbc\x05\xab\x12\xad\x9a\xed\xf9\x3a\x4e\x6a\xea\xd1\x89\x87\x10\xaa\xce\xb4\xe6\x10\xa4\xc9\xbf\x9b\x43\x9c\x53\xa6\x31\xef\xb0\x44\xa2\x4d\xcf\x00\x28\x82\x5e\x66\xda\x72\x84\xa1\x12\x6d\xf0\xcf\x72\x04\xd3\x66\x9a\xf2\x06\x4a\x27\x5a\xb4\x66\xfa\x10\xc4\x26\xd3\x0e\x32\xc0\x26\x7e\xdd\x3f\x04\x99\xd1\x65\x1a\x71\x90\xd3\x56\x19\x8e\xcb\x60\x0f\x42\xe5\x08\xa4\x5c\x17\xb2\x97\xaf\x43\x9c\x54\x2f\x72\x2b\xbc\x69\x7b\xb6\xc1\x1d\xa1\xe2\xa0\xa2\x4e\x80\xd4\x70\x3f\xe6\x5a\x8a\x26\x41\x71\x9b\x7d\xc7\x28\x29\xdf\xbf\x52\xd6\xbb\x0e\x69\x94\x54\x67\xde\x3e\xf8\xe1\x07\x93\x79\xd8\xf1\xe7\x03\xba\xf8\xf1\xc7\xcc\x75\xf4\xe3\xcf\xa8\x33\xa4\xe3\x0f\x98\xeb\xf4\xc7\x9f\xce\x66\x48\x6f\x7f\x48\xc4\x15\x1c\x7e\x56\x56\x50\x80\xf4\x47\x6e\xa2\x95\xce\x06\x73\xd9\x5f\xf8\xc4\xa7\xa9\xda\x84\x76\xdd\x7a\xba\xba\xd8\x0b\xc2\x0e\x58\x53\x85\x53\x1f\x7d\x9f\x26\x37\xe1\xf4\x91\xe3\x88\x56\x44\xa5\x59\x97\x50\xbe\xeb\x92\x20\x71\x88\xd2\x54\x45\xbe\x05\xc1\x45\x60\x28\x87\x77\x99\x4f\xb3\x3c\x8d\xc6\x27\x04\x0d\xb7\x10\xe1\x50\x6b\xc4\x89\x65\x42\x25\xf5\x38\x4e\x06\x6d\x8b\x38\xf1\x8c\x3e\xb8\xff\x19\x87\xa4\xe6\x7e\xab\x81\xd0\x4c\x4c\x44\xc5\x56\x97\x17\xf9\x13\x5f\xec\xe9\xf8\xd9\x9d\x08\x5f\xe6\xe9\xf8\x81\x9d\x08\x5f\xe0\x45\x75\x55\x57\x11\xbe\xb4\x8b\x8a\xa9\xae\x22\x7c\x51\x17\x15\x51\x5d\x45\xf8\x72\x4e\x87\x03\xe4\x2a\xc2\xf7\x04\x74\xd8\xab\x56\x11\xbe\x1d\x10\xd5\x4b\x5d\x45\xf8\x4e\x80\x8e\x27\xb0\x4e\x84\x6f\x02\x68\xb5\xff\xb8\x04\x93\x88\xc7\x2d\xf7\x53\x84\xa5\xbf\xd6\x7b\x15\xc1\x28\xe2\xf1\x68\xf9\x2d\x82\x55\xc4\x9d\x7f\x51\x11\xcc\x22\x76\x3a\x8b\x0a\xb7\x0b\x53\xec\x55\xb8\x61\x18\xb6\x57\xe1\x96\x61\xf8\x5e\x85\x9b\x86\x29\xf7\x2a\xdc\x36\x4c\xb5\xef\xc3\xb8\x6d\x98\x7a\xaf\xc2\x6d\xc3\x34\x7b\x15\x6e\x1b\x2f\xa5\x8a\xdc\xf7\xe2\xb8\x6d\x18\xb1\x57\xe1\xb6\x61\xe4\x5e\x45\xb0\x8d\x7d\xff\xe2\x04\xdb\xd8\xf7\x2f\x4e\xb0\x8d\x7d\xff\xe2\x04\xdb\xd8\xf7\x2f\x4e\xb0\x8d\x7d\xff\x2a\x09\xb6\xb1\xef\x5f\x25\x6a\x1b\x6d\x59\xa4\xb6\xd1\xfc\x41\x25\x26\xae\x64\xae\xbc\x93\xaf\xad\xf9\x7d\x6a\x80\x0e\xe3\xad\x68\x81\x96\x18\x3b\xf5\x5f\xeb\xbc\xf1\xb3\x47\xc3\x3b\x8a\x22\x8e\x9f\x77\x55\x2a\x1f\xca\xfc\x45\x69\x7b\xd1\x58\x88\x42\x51\x44\x2b\x87\x25\xac\xc5\x4d\x01\xae\x47\x08\xa1\xb5\xcc\x53\xaf\x2e\x1f\xc3\xb1\x26\x98\x04\xe0\x60\x33\x44\x53\xf1\xc4\xa4\xe6\x3a\xba\x17\x48\x89\x37\xd0\x5c\xe6\xaa\x95\xbd\x31\xc5\x32\xea\x94\x49\x4d\x50\xf3\xac\xf4\xe7\x12\xb9\xe2\xef\xaf\xc2\x59\x22\xc3\x0a\xd2\x1c\x16\x96\x4f\xdd\x26\xb4\x4e\x52\x89\x4b\x7d\x4c\x94\x17\xb9\xb4\xd7\x2a\x6f\xa8\x58\xa5\xc4\xcf\x44\xbf\xf1\x8e\xee\x13\x1b\xd3\xa6\x10\x6b\xfe\xd7\x72\x36\x8c\x02\x74\x0a\xe0\xe3\x4d\x68\x04\x93\x33\x1f\x5f\x36\xf7\x80\x7b\x30\xc6\xa6\x2b\xeb\xbc\x83\xea\x72\xed\x3a\xee\xb6\x8c\x2d\x72\x30\xff\xaa\x9e\x5f\x0d\x25\xb1\x1c\x69\x1e\x67\x35\x6c\x3c\x72\xdb\xe8\x59\xb6\x38\x28\x74\x24\x5e\xe3\x41\xb0\x94\x55\xd1\x46\x05\x29\xac\xaf\x8b\xaa\x8a\xaf\x92\x2f\xab\xd1\x19\x4b\x67\x55\x4a\x87\xce\x59\x3a\xdb\xa6\x74\x78\x3b\xbb\x3a\x1f\xba\x48\x6a\x70\xd7\x80\x00\xbc\xe5\xdd\x09\x04\x60\x8f\xc0\xaa\x68\xa8\xfb\x54\x43\xe7\x43\x18\x91\x1f\x2e\x58\x99\xc9\x64\x6f\xc7\xc9\x39\x38\xac\x68\x4a\xc1\xaa\x0c\x60\xbd\x61\xed\x71\xd3\x0a\x4b\xc2\xaf\x61\x42\xdb\x2b\x28\xbf\x4d\x14\x2c\x7c\xfb\x93\x35\x77\x30\x11\x49\x14\xec\x94\xfb\x41\xf4\x52\xaa\x82\x89\x8c\x16\xbd\x13\xa9\x60\x32\x23\xc5\xaf\x41\x2a\xa2\x20\x53\xe7\x52\x0e\xfe\x74\x0b\xc8\x29\x3f\x1f\x0e\x21\xbb\xd2\x0d\x6e\x9c\x27\x7c\x66\x03\x31\xd6\x6b\x6f\x09\x14\x0b\x51\x96\x24\x53\x02\xa4\x83\x20\xfe\x46\x63\x94\xc2\x0b\x88\xe2\x5e\x0a\x6a\xb9\x9c\x41\x88\x2d\xcd\x16\x83\x70\x08\xe2\xf3\x6e\x31\x46\x09\x31\xb6\x44\x5c\x0c\xd2\xa4\xea\x54\x10\xcc\x93\x9f\x92\x42\x42\x77\xe4\x22\xa9\xa4\x5c\xe3\x5c\x70\x99\xd4\x7a\x4b\x44\xd5\x2a\xa9\x26\x5d\xa8\x5d\xf0\x36\x29\xa6\xdd\xa5\x5d\x70\x9d\x54\x7f\x67\x17\xe3\x04\x93\x24\xdc\xfa\x0b\x74\xc3\x88\x28\xe2\xfd\xe6\xa5\x3a\x08\xc5\x69\x96\xc5\x5e\x86\xfe\x56\x14\x03\xb3\xd4\x98\x79\xcc\xe3\xf8\x18\xdc\x32\x00\x14\xbe\xa4\x37\x7e\x97\x85\x81\x55\x3c\xa3\x0a\xb7\xc0\x61\x42\xd8\x87\xdc\x23\xbe\x47\xa9\xf6\x3d\x31\xc0\xd0\x7c\x6d\x5b\x83\x10\xaa\xb3\x6d\x1b\x10\x43\xf4\xb6\xed\x09\xa4\x50\xdd\x6d\x2b\x40\x0c\xc9\xdf\xb6\x12\x64\x10\x1d\x6e\xab\x40\x0a\xcd\xe3\xb6\x2d\x08\x21\xba\xdc\x97\x5c\xb5\x67\xd5\x23\xc2\xfb\x34\xa7\x8c\x14\xff\x51\x91\x51\x12\x5c\x81\x91\x19\x2d\x3e\x4a\x18\x95\x91\x92\xbc\xbd\x69\x33\x6a\xa2\xbf\x37\x3a\xa3\xa7\x79\x7c\x63\x32\x72\xa2\xcf\x37\x36\xa3\x3f\xe0\xf5\x4d\x97\x61\xb8\x7b\xf7\x2f\x2e\x71\x1d\x65\xb0\x68\x25\xaa\xc7\x8b\xab\x06\x38\xfb\xc5\x02\x2c\x0c\xbd\xe3\x64\xd7\x7c\x05\x4b\x52\x56\xf0\x12\x05\x4b\xd5\x47\x0a\x45\xa1\x4b\x24\x52\xb1\x28\x8c\x22\xa2\x3a\x34\x71\x31\xe1\xf5\x0e\x0b\x5f\x17\x1a\x03\xc5\x75\x24\x5c\x1a\xe2\xc3\x58\x8d\x07\x53\xcb\x52\x64\xee\x6a\xb3\x4a\x3f\x26\xc6\x60\x6d\x05\x68\x3f\x30\x71\x9d\x11\xaf\xf7\xd3\x21\xad\x6e\x60\x31\x54\x45\x45\x96\xe2\x04\xa8\x97\x07\x47\x09\x22\x4d\xd8\x92\xb7\x09\x04\x09\x10\x6a\x12\x41\x81\x04\xf4\x86\x41\x59\x8a\x16\x20\x34\x24\x82\x06\x09\x0d\x81\x60\x00\x82\x20\xb5\xc1\x82\x04\x4a\x1b\x3a\x90\x20\x70\x82\x2c\x00\x82\x4b\xbe\x26\x20\x18\x8c\x20\x3c\x87\xe4\x30\x82\xf2\x20\x25\x8c\x60\x05\x81\x91\x71\x0b\xdf\xc9\xd5\x04\x46\x8d\x30\xd0\x6e\x2e\x44\x14\x4f\xe3\x67\x50\x57\x35\xd8\x99\x90\xfc\x1b\x45\x03\xb9\x69\xfb\x8b\x3e\x98\xb8\xe3\xac\xf0\xb3\x9c\xd5\x37\x84\x1a\x8f\x23\xaa\x32\x11\x19\x14\x51\xf0\x18\xa5\x97\x04\xae\x45\xb3\x5c\x3c\xe4\x47\x1a\x54\x5f\x27\xda\xf0\xc2\x41\x08\x0d\xdc\x02\xca\xa1\x95\xa8\x4e\x30\x84\x72\x74\x21\x2a\x01\x43\xdc\x49\x1c\x21\x11\xa9\x92\x30\x66\x39\x8b\x23\xcc\x25\xa4\xc9\x24\xdd\xa3\xc2\xd0\xf5\x6d\x9a\x65\xea\x38\x8f\x0f\xed\x0a\x5c\x29\x34\xe7\x5f\x14\xb2\x03\x28\xe3\x05\x07\xa8\x62\x0f\x20\xff\xba\x12\x7b\x31\x31\x97\xab\x50\xe1\x07\x58\x9f\x9f\x20\x52\xfb\x1f\x7c\xb6\xd6\x55\x99\x24\xb4\xb9\x05\x10\x6b\x1d\x6d\x84\xc1\x7d\xb1\xd0\xcc\x87\x6f\x7b\x74\x93\xbe\x8a\x22\xd2\xfc\x89\x2d\x1a\x47\x50\x69\xb6\x57\xa1\x71\x04\x95\xe6\x7b\x15\x7a\x00\x52\xe9\x72\xaf\x42\x4f\x3d\x2a\x5d\xed\x55\xe8\x69\x4d\xa5\xeb\xbd\x0a\x8d\x31\xa9\x74\xb3\x57\xa1\x31\x26\x95\x3e\xed\x55\x68\x8c\x49\xa5\xc5\x5e\x85\xc6\x98\x54\x5a\xee\x55\x68\x8c\x49\xa5\xd5\x5e\x85\x07\xa7\x55\xba\x4d\xc8\x08\xd6\xa1\x13\x32\x82\x79\x98\x84\x8c\x60\x1f\x36\x21\x23\x18\x48\x97\x90\xe1\x16\x62\x8a\x84\x0c\x37\x11\xc3\x12\x32\xdc\x46\x0c\x4f\xc8\x70\x23\x31\x65\x42\x86\x5b\x89\xa9\xf6\x32\x3c\x4c\xad\x32\x75\x42\x86\x5b\x89\x69\x12\x32\xdc\x4a\xcc\x29\x21\xc3\xad\xc4\x88\x84\x0c\xb7\x12\x23\x13\x32\x82\x95\x24\xfa\x1b\x1e\xac\x56\x99\x36\x21\x23\x58\x49\xa2\xbf\xe1\xe1\x6a\x95\x31\x09\x19\xc1\x4a\x12\xfd\x0d\x0f\x58\xab\x4c\x97\x90\xe1\x56\x62\x8b\xfd\xed\xad\x5e\x8d\x6b\xd9\xfe\x62\x9f\xa3\x88\x5c\xb4\x39\x2d\xce\xbc\x09\x7b\xd4\x7a\xb9\xc4\xf3\xbe\x5d\x92\x3e\x35\xc9\x5e\x39\xa8\xb4\xc9\xdd\x25\x83\x2a\x4f\x59\xe5\x97\xea\x87\x65\x33\x10\x61\x48\x56\x25\x66\x62\x57\xb5\x54\xeb\x82\xb5\x92\xb5\x45\xfa\x5e\xc4\x67\x21\x95\xf9\xd3\x55\x2e\xc2\xde\x9e\x64\xbb\x12\x96\x83\xf5\xf7\x5b\xa8\x99\x50\x39\x07\x29\xbb\x8e\xeb\xbb\x54\x25\xaf\x23\x04\x5a\xf9\x75\x1c\x43\x2c\xc1\x8e\x83\x8e\x95\x61\xc7\x79\xe1\x38\xf5\x8c\x7f\xda\x1a\xb5\x2b\xc5\x8e\xf3\x2a\x20\xa8\xf4\x50\xc3\xa2\x7e\xe7\x0b\x38\xbd\xc3\x69\x52\x35\xd5\x5f\xcb\xb2\xe3\x98\x53\x0a\xe3\x23\xc4\xe8\x1c\x11\x85\x3e\x5d\xfb\x9f\xd6\x25\x6b\x7e\xdf\xad\x85\xca\xc3\x71\x66\x95\xdf\x2f\x87\x00\x06\xfc\x7d\xea\x2e\xb9\xed\x90\x76\x10\x41\x2c\x8a\x45\x08\x22\x65\xdd\x4d\x9a\xa8\xf6\x94\xd1\x2a\x74\xe7\x83\xc5\xb9\x6d\xcb\xef\x76\xca\xd8\xc5\xb1\xdd\x3e\xc7\xc1\x90\x02\x76\x59\x14\x9b\xb0\x36\x61\xbb\x2b\xc9\x9d\x1a\xd8\x8b\xef\x99\x08\x47\x34\x6c\xff\x28\xd7\xa9\x27\x6c\x8b\x35\xa1\x43\x58\x12\xb8\x96\x53\x1b\x85\x16\x31\xf2\xcb\xdd\x2a\x77\x37\xc6\xee\x06\x1f\xac\x29\x84\xfb\x88\x70\x04\x76\xa9\x13\x4e\xc0\x2f\x76\xc2\x19\xe8\xe5\x4e\x38\x82\x78\xc1\x13\x0a\xc2\x6f\x69\x42\x10\xb2\x2c\xcd\x7e\x6f\xe4\x79\xc7\xe0\xed\xde\xae\x6e\x80\x30\x1b\x92\x65\x69\x01\xd4\xca\xf9\x98\x7a\xe3\xcb\x52\xa2\xb4\x8e\x46\x5b\x23\xdf\xd0\xc6\x55\xc5\x3e\xfa\xfe\xbc\xcc\x95\x5e\xd9\xfe\xec\x9d\xd0\xc3\x6d\x5d\x00\x6d\x44\x5b\xc4\xa8\x2d\x42\x49\x3c\x13\x2d\x0f\x51\xf1\xef\x59\x95\x19\x2c\xf0\x35\x60\xa6\xd0\x65\x68\xfa\x9e\xe3\x06\x6e\xc2\x70\xa0\xcb\xd0\xa5\xbb\x31\xe0\x45\x4f\xda\x0c\x6c\x53\x86\xf0\x18\xdb\xbf\x58\x8d\xf6\xfd\x36\xda\x88\xf6\xf3\x0d\xaa\x34\xec\x1c\x5e\x43\xd9\xfc\x6e\xab\x6e\x2f\xbc\xdd\x5b\x92\x36\xb2\xcf\x35\x96\x98\x12\x0a\xdd\xd6\x2c\xa5\x43\x57\xf1\x6d\xcd\x53\x3a\x74\x19\xdf\x46\x9e\xbd\xbf\x50\x5b\x19\x7b\x73\x6a\x1b\xeb\xbd\x8a\xd0\xc2\xd0\x6e\xdb\x71\x1c\x70\x45\x68\xad\x8b\x82\xf6\x50\x22\x21\x23\x3c\x95\x4c\xc8\x08\x8f\x15\x6e\x84\xf8\x7a\xb9\x6a\x26\xb4\xb2\x4d\xe9\x08\xcd\xd4\x29\x1d\xa1\x9d\xfb\x51\x6a\x7a\x30\xd4\xdd\xb4\xb5\x4d\xe8\x38\x41\xb7\x1f\x7c\xa6\x47\x89\xeb\x9a\x22\xa1\xd3\xf7\x16\xef\xaa\x0d\x4b\x28\x99\x59\x9c\x1b\x5e\xa1\xb0\x6d\x78\x42\xce\xe9\xf2\x32\x2d\x9f\x28\xae\xad\xa9\x00\x31\xb1\x01\xa2\xd8\x7b\x39\x63\x97\x3c\x2f\xda\x76\x40\x2b\xd8\x9e\xe0\xb7\x24\x88\x3b\x0a\xad\xe0\x7b\xc4\xd0\x5f\x7e\x23\xeb\xcb\xbd\xfe\x4b\x0d\xbd\x51\x07\x1e\xa3\xda\x33\xfa\x4b\x37\xba\x82\xc0\xc4\x2a\xc0\xad\xa8\xf7\x8c\x35\xad\xcf\x1a\xff\x6f\x42\x43\x9a\x04\x64\xdd\x9d\xf3\x89\x59\x04\xc6\x09\x63\x2c\xeb\x31\xea\x63\x89\x3d\xcd\x0f\xc2\xbe\xf8\x2d\x15\x14\x7a\xa1\x6d\xf6\x30\xa8\xcb\xc7\xdd\x1d\x08\x7e\xd9\xe9\x86\xcf\xba\x84\x51\x91\x6f\xd9\x36\x1f\xf4\x70\xc7\x8f\xa2\x4d\x94\x54\x75\x51\x67\x17\xfc\xb8\x16\xb6\xa0\x3d\x82\xb1\x2a\x07\xa0\x4c\x0c\x44\x7c\x9b\xb7\xdf\x33\x75\xbd\x45\x51\xab\x7a\x56\x06\x20\xb8\x52\xd3\x38\x21\x7c\x7f\x9b\x72\x72\x37\x4a\x6f\x38\x84\x50\xb4\x1d\xd4\x06\xd5\x0f\xa4\x29\xa1\xbb\x62\x3e\xe0\xdc\xae\x6a\xba\x3d\x6f\x83\x27\xad\x6d\x3b\xb1\xd7\x6f\x33\x70\xc7\x20\xec\x16\xc8\xb8\x48\xec\xf6\x30\x1e\x86\x6a\xc3\x7e\xf6\xd5\x4f\xf3\x5d\x0d\xc1\x4f\x6f\x41\x0e\x08\x24\x32\xa9\xe5\x8e\xbe\xe5\xc7\x07\xfb\x65\x07\xac\xaf\x4b\x16\x05\x06\xb8\x2b\xfe\x76\xed\xc0\x19\xb2\x06\x9f\x03\x7f\x06\xd9\x80\x00\x6c\x5b\x5e\x32\x79\x02\x01\x7f\xc5\x01\xe1\x6b\x70\xce\xcd\xbf\xc5\xe7\x17\xa5\xd8\x82\x94\x69\x48\x69\x0e\x72\x54\x9a\xe3\x63\x6f\x3c\x6a\x49\xcd\x26\x7c\xe0\xb0\x51\x2f\x4f\xd5\xb9\x8b\xc0\x57\xea\x32\xdb\x39\xf7\xd7\xb3\xba\x62\x4c\xc1\xa2\x1c\x96\xe7\x65\xf8\x3e\x26\x18\x11\xeb\xa8\xf0\xed\xd6\x12\xdf\xef\x50\x6d\x9b\x39\x38\xf2\x7a\xc2\xeb\x15\x9a\xeb\x54\x03\xfc\xe6\x26\xb5\x21\x29\x3f\xea\xb5\x46\xcd\x6a\x71\x44\xdf\x54\x14\x67\xf3\x38\x5a\x4d\xd3\xca\xca\xf4\x6d\x29\x6b\x8c\x11\xaa\x56\x69\xf5\x77\x88\x3b\x4a\x68\x51\x02\xad\x25\x3a\xcd\xf9\x0e\x94\x47\x09\x26\x4d\x98\x3e\x5a\x5c\x6b\xb3\x5a\x42\xd3\x73\x03\x9b\x83\xb8\xb9\x09\xa1\xec\x86\x68\x54\x01\x50\xdc\x8b\x38\x3f\xfd\x1a\x8a\x62\x30\x0a\xd5\x87\x33\x6b\x37\x38\x04\xda\x0d\xb7\x9a\x27\xea\x33\x9a\xa8\xca\xec\xfb\x1c\x2b\x32\xf6\xe1\x02\x55\x1f\xed\x75\xd6\x8f\xfb\xc5\xcd\x50\x51\x4e\xc6\x4a\x96\xb0\x7e\x9f\x02\x74\x80\x96\xb7\x9b\xb5\x51\x3e\xad\x9c\x94\x19\x6e\x45\x87\xd0\xee\x97\x23\xbc\x3a\xba\x33\xdd\xbd\x1d\xe5\xbc\xe7\x15\xd7\x85\xdf\x7f\xb2\xdd\xb0\x96\x19\xa4\x69\xc3\xef\xbd\x7d\xe4\x75\x94\xa1\xa8\xab\x84\x7a\xf3\xa6\x74\x4a\x9d\xa0\x3c\xd5\x6b\xd1\xc2\x2f\x7c\x82\x22\x6a\xd6\x40\xa0\x8b\xfd\x50\x54\xd0\x09\x02\x3d\x5b\x84\x3a\xb9\x9a\x09\x08\xf4\x6c\x11\x01\x24\x21\xd0\xb3\x45\xd8\x14\x4a\xd4\x4c\x41\xa0\x67\x8b\x08\xa0\x70\x24\x59\x2f\xd0\xdf\xe1\x50\x46\xe8\x25\xdc\x44\xe1\xa9\xdc\x50\x04\x07\x2a\x8b\xe8\xd6\xe1\x44\x23\xf0\x90\x6e\x19\x97\x51\x02\x4c\x99\xc4\x22\xbd\x1a\x12\x49\x27\x76\x7f\xd2\x8c\xff\x23\xed\x5c\x96\x5c\xc7\x95\xee\xfc\x2a\x0e\x3f\x01\xef\x97\x81\x07\x20\x01\x56\xf1\x34\x45\xaa\x49\xaa\x6a\x57\x4f\x14\x1e\x38\x1c\x8e\xf0\xc0\x11\x9e\x9c\xc7\xff\x23\x01\x52\x05\x48\x00\x57\x52\x67\xef\xee\x1e\xf5\xb7\x08\x42\x89\x0b\x13\xc8\xcc\xb0\x86\xc4\x1a\x2c\x47\x52\x1d\x3d\x67\x38\x79\xbb\x49\x5d\x20\x99\xc7\x7b\x82\x55\x5e\xda\xab\x71\x3b\x5d\x9a\x1e\x5f\xd5\xce\xcb\xd8\x03\x31\xb6\x1f\x79\x99\x78\x40\xd6\xbe\x29\x77\x5c\x71\xc6\xb1\x12\xb1\x9e\xe9\x78\xe0\x0c\x18\xf3\x40\xbb\xb1\x06\x4c\x78\x60\xe5\x69\x2a\xf3\x2d\x6b\x4f\x63\x99\xa8\xf0\x34\x97\x87\x3a\x81\x47\xd3\x55\xcd\x62\x94\xbc\xce\xad\xe3\x57\x92\xd7\xbb\x75\xf2\x4a\xf2\xba\xb7\xae\x5e\x49\x6e\xff\xd6\xf5\x2b\xcb\xed\xe0\x5a\xbc\xb2\xdc\x1e\x2e\xed\xdd\x02\xbd\xa4\x0e\xa2\xc6\x98\xbd\x4d\x10\x52\xee\xdb\x2c\xc8\xd9\x1b\x03\xfa\xb2\x98\xaf\xd3\x20\xf0\xd5\x8c\x4c\xd9\xeb\x37\x9d\xe7\xcd\x02\xbb\xda\xf3\xb2\xf0\x45\xee\x42\xca\x7e\x16\xdd\x47\xa2\x9c\x53\xac\xc2\x82\x79\x69\x9b\xc0\x75\x56\x5f\x3d\xa3\xa8\x5d\xe1\xb8\x46\xe5\xb4\xa6\x1c\x73\x2b\x44\xe7\xa1\xd0\x2f\x5e\xa7\x85\x6f\x4b\xa5\x33\x08\x99\x2d\x26\x27\xac\xb6\xb0\x7b\xe8\x5b\xdf\x28\xa0\x1f\x7f\x0b\x11\x39\xa6\xab\x36\x8e\xfc\x2d\x60\xf9\x62\xdb\x38\xf6\xd0\x26\xa0\x9d\xc7\x27\x1e\x5e\x07\xb4\xf3\x70\xdf\xf6\x7a\x8b\x27\x62\xf1\xbe\x0d\xf6\xef\xb7\x3a\xaf\x0d\xbe\xed\xf5\xef\x77\x3a\x4f\xc3\x67\x06\x5b\xb9\x2a\x16\x5f\xfa\x16\x7c\xf3\x8d\xd3\xff\x83\xa7\x82\xca\xf9\xd2\x20\xcb\x4d\x93\x0e\x43\xf6\x9b\xcf\x1f\x0d\x8b\xb1\xdf\x54\xff\x52\x2c\xca\x7e\xbf\x47\xdf\xb2\x48\x7b\x0e\x78\xfc\xb2\x2c\xb2\xf6\x91\x27\xda\x6c\x2f\x04\xd4\xa5\x71\xc1\x80\xec\xad\xed\xfc\xd1\xb0\x18\x7b\x13\xab\x9b\xc7\xa2\xa4\xaf\x4b\x59\xa4\xf2\x75\x0c\x8b\xec\x7c\x24\xbb\xcd\xca\x39\x11\xa7\x4b\x34\xfb\x15\x98\x7d\xc8\x7c\x08\x5d\xa6\x70\xea\x3a\xc6\x55\x36\x95\x77\x81\xd4\xf3\x6f\xc9\x75\x35\x53\x8e\x9f\x12\x32\x75\xf6\xdd\x97\x9e\x92\xc8\x93\x3b\x45\x5d\x15\xf6\x77\x64\x4e\x52\xc1\xbd\x0d\x74\xae\xad\x73\xc8\x62\xbc\x38\xf8\x62\x3b\x21\x53\x06\x3f\xd6\x4e\x88\xd8\xa3\x98\x7e\xa9\x5f\xf4\xc4\x97\x6c\x95\x38\x6e\x55\x5d\x3c\x81\x1c\x77\xf7\x4e\x29\xd9\xd0\x1d\x5f\x8a\xab\xec\x18\x45\x15\x13\x25\x8f\x85\x96\x75\x56\xe2\xc2\xd6\xcb\x94\xb3\x90\x3d\xaa\x3a\x50\xf2\xc3\x41\x87\xa4\x6f\x21\xbf\x50\x27\xf3\xea\x5c\xe7\xe9\x5f\x0f\xaf\x15\x4f\x28\xf7\x0a\xed\xbd\xcd\x55\x29\xbc\x2a\x6e\xa2\xa1\x63\x8d\x42\x64\xfe\x74\xad\xac\x28\x99\xc2\x29\xa4\xb5\x1d\xdf\x9f\x13\xb0\x5f\xc1\xec\xae\xee\xcb\x8d\x2a\x00\x31\xbc\xf0\x85\x93\xa9\x78\x7b\x6e\x33\xa8\x11\xae\xc8\x85\xa8\x3c\xe1\x39\xdb\xf3\x21\x5b\xbf\xb6\x99\x1f\xb2\x5e\x08\x11\xc2\x79\xc1\xea\x85\x68\x82\x02\x8c\x51\x5a\x88\x36\x84\x33\xa3\xdc\x0b\x21\x43\x0a\xc7\x64\x5c\xba\x61\xd2\xfb\xf0\x83\x21\x68\x79\x24\x7c\x1c\x8c\x41\x2b\x93\xc4\xc7\xc1\x4b\x6c\x65\x92\xfa\x38\x78\x89\xad\x4c\x32\x1f\x07\x2f\xb1\x95\x49\xee\xe3\x60\xb4\x62\x99\x14\x3e\x0e\x86\x2b\x96\x49\xe9\xe3\x60\xbc\x62\x99\x54\x3e\x0e\x06\x2c\x96\x49\xed\xe3\x60\xc4\x62\x99\x08\x1f\x87\xe3\x9f\xcb\xa4\xf1\x82\x0c\x8b\x69\xbd\x20\xc3\x64\xa4\x17\x64\xd8\x8c\xf2\x82\x0c\xa3\xe9\xbc\x20\xb6\x9a\x34\xf2\x82\xd8\x6c\xd2\xd8\x0b\x62\xbb\x49\x13\x2f\x88\x0d\x27\x4d\xbd\x20\xb6\x9c\xd4\x3b\x12\x71\x4c\x74\x99\x7a\x87\x22\xae\xde\x51\xa6\x85\x17\xc4\x96\x93\x96\x5e\x10\x5b\x4e\xea\x1d\x8d\xb8\x8c\x47\x99\x7a\x87\x63\xc2\xb0\x1c\xef\x78\xc4\xf1\xd1\x65\xea\x1d\x8f\xb8\x9e\x47\x99\x7a\xc7\x23\x8e\x91\x2e\x53\xe9\x05\x19\x96\xe3\x1d\x8f\x38\x4e\xba\x4c\xbd\xe3\x11\xd7\xf6\x28\x9d\x5b\xb2\xdb\x8d\x0c\xce\x86\xbb\x2c\xf2\x57\xf0\xb6\x30\xfc\x52\x95\xe3\xf9\x35\x9b\x87\x8d\x87\xa4\x3d\x22\xd5\xa0\x74\xa0\xe6\x39\x85\x2c\xf4\xec\xfd\xfb\x07\x2a\xe4\xa8\x0d\x6c\xa5\xe2\x75\x07\xf9\x9e\x50\xf9\x7a\xfc\xfd\x9e\x90\x3d\xa8\x77\x4f\xec\x3b\x3a\xf6\x18\xb7\x73\x19\x9d\xd5\xb1\x87\xfc\xfe\x81\x62\x02\x06\xdf\xd3\xb3\x67\x02\x25\x3f\x94\x09\x1b\x7c\x4f\xcb\x9e\x1c\x16\xd5\x4e\xa3\xb4\xfd\xd7\xef\x28\xda\xb3\x46\x37\x51\xb9\x8a\x69\x96\xfd\x68\xfc\xf6\xef\x08\xaa\xd0\x5e\xf9\xac\x50\xe7\xb1\x54\x3b\xc1\xe4\x49\x3d\x27\xdf\x2e\x45\xe5\xdf\xa7\x71\x80\x9f\x5c\x95\x93\x62\xf7\x5b\x87\x9a\xf2\x38\xdb\x8c\xf4\xe3\xbe\x59\x71\xaa\x4e\x1e\xdd\xed\x15\xb7\xd8\x52\x88\xb6\xaf\xe8\x45\x5c\xaf\x0c\x87\x6d\x23\xbd\x28\x37\x0f\x58\xe5\xe4\xbe\xd8\xbc\x15\xba\x6c\x3a\x04\xe3\x57\x50\xf7\x16\x04\x93\x57\xb0\x9d\xae\xf8\x87\x51\xf6\x6c\x4c\x91\x08\x7d\xcb\x6c\x6a\xfe\x0a\xf2\x9a\x5a\xbc\x82\xbc\xa6\xda\x26\x2b\x7f\x46\x71\x61\xb7\xb5\xf6\x90\xbc\xc6\x0a\x0f\xc9\x69\x6d\xe6\x84\x6b\x99\x29\x89\x3c\x1b\x00\x2b\x92\xe8\x75\x9c\xef\xae\x4e\xc8\x26\xaf\xac\x33\x47\xa8\x91\xe2\x3d\xe1\x5b\x17\x49\x0a\x84\x38\xdb\x93\x22\xc9\x90\x0a\xcf\xe1\x91\xe4\x40\x87\xe5\x77\x49\x0a\x8f\xcb\xe7\x59\xed\x44\x9d\xae\xa7\xdf\xc6\x4a\x24\x00\x65\xd2\xb0\xcc\xfa\x3d\xdd\x17\x4e\xa7\x64\xa8\x53\xb8\x73\x55\x51\xfb\x3d\x80\xdc\x9a\xe2\x85\x00\x0d\x39\x71\x50\xe6\x24\x90\xd9\xbb\x44\x48\x49\x37\x1d\xef\xb3\xfa\xe8\x17\x5e\x51\xf1\x38\x70\x56\xf0\x86\x54\xe2\x69\xd1\x56\x19\xf9\x0d\xb5\x34\xd0\xb0\x77\x15\x9b\x34\x7e\xed\xfd\x2d\xf2\x09\xa1\x99\xfd\xc3\xb9\xae\xdf\x93\xc5\xb5\x1a\xa7\x6c\xb3\x96\xda\xaa\x7d\xe9\xbb\xa8\x0c\xbe\xf5\x37\xe5\x8d\xf2\x75\x8d\x13\x7e\x44\x5a\xdb\x1d\x27\xf9\x96\x5a\x55\xbf\x46\x96\x6d\x8e\x6e\x33\x40\x6e\x2b\x4e\xdf\xd2\x54\x02\xab\xf0\xe3\xdd\xea\xc4\xf9\x84\xd2\xe9\x26\xaf\xc3\xcf\xfd\xaf\xcf\xa3\x3c\xe9\x75\xe2\xc4\xd0\x2c\xad\xbe\x47\x00\x19\x7b\xd6\xa4\x03\x9c\x41\x30\x1e\x64\x7f\x0c\x49\x31\xff\xc5\x79\x90\xbd\x5c\x0e\xb4\x4f\xe6\x40\xf6\xaf\xa3\xd7\x58\x39\xd1\x27\x05\xe4\xec\xdf\x43\x73\xcd\x6d\x66\x3c\xce\x36\xf3\x4f\x31\x4b\xdd\x4e\x8c\xd9\xd6\xbd\x4c\xdd\xca\xc4\xec\x4f\x07\xd9\xd3\x26\x54\xd1\x81\x2e\xe2\x9c\xcb\xfd\xea\x4f\x3b\xdc\x74\xd0\x22\xc4\xec\x71\xf3\xb9\x0c\xf7\xcf\x1b\xe3\x59\x76\x1b\x09\x5a\xc4\x7a\x9b\xc5\xca\x7a\xa0\xfd\x45\x43\xac\xfe\x21\x20\xe6\xac\x11\x84\xe9\xa3\x6e\xba\x43\x8c\x0d\xd3\x09\x7f\xdd\x4e\x8a\xe4\x17\x45\x8e\xc8\x7b\x3b\x7d\x52\x07\xa3\xdf\xa5\x4a\x3a\xdb\x0a\x7e\xd3\xbb\x0c\x4a\x50\x70\x9f\xfa\x14\x5f\x3d\x7c\x8d\x2a\xe9\x5a\x8e\x4a\x37\xdc\x96\x4f\xa8\xe5\xec\x4b\xa4\x6a\x6e\x8f\x14\x53\xcb\xcf\xd8\x7e\xce\xd3\x48\x57\xb3\xa0\x8a\xbd\x38\x19\x95\x91\x1a\x35\x4c\x1f\x1f\x4a\xde\x2f\x6a\xd1\xae\xa5\x6d\x8a\x82\x6a\xf6\x14\x65\xd4\x5a\x31\x0c\xfa\xfc\x96\x82\x40\x19\x26\x52\x25\x59\x1e\x16\xb9\x2d\x3a\x88\x92\x56\x4f\x28\x63\x4f\x62\xa6\x2d\x5b\x58\xb0\xb8\xf6\x98\x2e\x43\xf4\x77\x3f\xca\xe9\xfb\xbe\xfc\x2c\xab\x62\xb4\xa2\x0a\xe9\x3c\xa7\xc9\x83\x4a\x75\x48\x69\xfd\xec\x67\x49\xbd\x02\x07\x43\x95\x64\x22\xa4\x22\xae\xa6\x7e\x07\xeb\x27\x6a\x42\x2a\x93\xbe\xde\x01\x79\x7b\x1c\x18\x5e\x6f\xe7\x4d\x4d\x7f\x48\x4b\x3f\x2d\xd5\x75\x56\xad\xa0\xd4\x73\xec\x11\x99\x29\xbf\xd6\x6d\x94\xaa\xeb\xc7\x53\x52\x9d\x5f\xea\x3a\xcd\xab\x68\xfa\x01\xcf\x55\x55\x92\x47\x01\x0d\x35\xd3\xf6\x4c\xe0\xa5\xa0\x4a\xf2\xd8\xaf\xc1\xfb\x69\x8a\x57\x83\xd5\x6f\x71\xa1\x15\x9d\x81\xbf\x5a\xa9\xc6\xaf\x34\xa5\x7d\xcc\xd3\xed\x8a\x25\x44\xa8\x1f\xaf\x5c\x05\x8f\x79\xaa\x2f\x35\xd3\x2f\xe0\x64\xc3\x82\x42\xb6\x9d\xd2\x56\xcd\x88\x99\x56\xd8\x45\x04\xa0\xd0\xab\xc9\x9e\x17\x51\xd1\xab\x83\x0a\x32\xb6\x31\x6c\x53\x0e\x64\x7c\x9f\x3f\x00\x8a\x9c\x1b\xd3\xdb\x4e\xde\x7c\x0a\xc2\xc7\xd9\x4b\x90\x49\x60\x0a\x91\xcc\xd3\xc2\x6b\x7f\x55\xba\x20\x38\xa4\xed\x95\x61\x0f\xdf\x80\x90\x3d\x2e\xc8\x14\x06\xd1\xa8\x61\xdf\xbb\x1f\xd3\x75\x9c\xa5\x5e\x43\x3a\xb5\xbc\xd6\x71\x96\x79\x55\xdc\xb5\x1a\xad\xf9\x75\xec\x59\x60\x4f\x4b\x14\xe1\x11\xf6\xd9\x7f\x30\x5e\xa5\x0c\x0b\x5c\x94\xec\x6f\xc8\xe2\xea\x38\xab\xc2\x12\x74\x41\x0c\xf0\xee\x70\x72\x36\x50\xc7\xe4\x96\x0c\x31\xf1\x6c\xe5\xf4\xa9\x86\x91\x6a\x7a\xa8\x93\x47\xb6\x55\x2c\xab\x73\x5d\x0e\xb2\xb6\x2d\x18\x96\xd6\x2c\x16\xac\xdb\x1f\x55\xa1\x17\x18\xa7\x6d\x21\xc6\xef\x70\x22\xfd\x2f\x14\x3a\x4e\xff\x0b\xf0\xa8\x4b\x3d\xaf\xe3\xe4\xa5\xfc\x0f\x71\xd0\x02\x56\x4a\x4b\x28\x01\x53\x5a\x42\x05\x46\x4a\x4b\xa8\x81\x53\x5a\x42\x09\x6e\x4a\x4b\x24\xc4\x48\x69\x79\x2c\x11\xe5\x91\x3d\xd5\xec\xaf\x31\x50\x89\xc7\x63\xb2\xce\x9d\x1b\x2c\xcb\xad\xd9\x96\x6c\x72\x83\x43\x34\xf5\xa2\xb7\x2b\xed\x06\x29\x1a\x7c\xe5\xcc\xb3\x79\x9a\x1d\xcb\x74\x8a\x3e\xbd\x39\x42\xb9\x4f\xe8\xef\x1b\x9d\x86\x0c\x03\xaf\x39\xdb\xc4\x17\xfb\x94\xb6\x86\xdc\x1b\xb1\xf4\x2d\x67\xf2\x7b\x9d\x44\x5f\xb4\xbe\xa6\x55\xf1\xa5\xb2\x23\x29\x31\xf7\xeb\xe7\x45\xad\x67\xda\x56\x1d\x09\x36\x62\x18\xa6\x95\x2d\x16\x47\x47\x62\xcb\xe7\xad\xeb\x06\xc5\x56\x4b\x58\x6a\xb3\x1a\x8c\x83\x99\x2b\x9b\x1d\xca\x92\x4b\x69\x55\x54\x97\x93\xab\x57\x1d\xea\x69\xdb\xc3\x52\x75\xda\x44\xc1\xa4\x05\xe2\xb7\x64\x1f\x54\x89\x91\x4a\xce\x51\x49\xb0\x4a\x8e\x55\x52\xa4\x52\x70\x54\x32\xac\x52\x60\x95\x1c\xa9\x54\x9c\xb6\x14\x58\x85\xd1\x96\x12\xab\x54\x58\xa5\x42\x2a\xba\xa4\x1f\x94\xa9\x19\x32\x8c\x77\x12\x0c\x19\xc6\x4b\x35\x0c\x99\x38\xc2\x3a\x2d\xd4\x49\x58\x3a\x92\xa3\x93\x40\x1d\x19\xf9\x75\x28\x5b\x4a\x65\xc2\x98\x2a\xfe\x20\x97\x31\x57\x8d\x33\xd8\x65\xc2\x57\xc3\xf6\x24\x53\xae\x1a\x67\xf0\xcb\x8c\xaf\x86\x8d\x54\xe6\x5c\x35\xce\x64\x20\x0b\xbe\x1a\xa3\x6d\x25\x5f\x0d\x8f\x23\x59\x71\xd5\x58\x93\x84\xac\x4f\xc8\x31\xde\x55\x9c\x90\x63\xbc\x6c\x73\x42\x8e\x31\xe8\x65\xcb\xd6\x63\x4d\x22\x52\x9e\xd1\x43\x93\x49\xd5\x38\xe9\x14\xb6\x02\x9b\xba\x14\x6f\x75\x9f\xd4\x61\xfd\xf3\xa6\x8e\x03\xa8\x60\xb0\x89\x97\xcd\xef\x1f\xc5\xbd\xc9\x31\x9e\x7a\xf1\x8f\x46\x64\x98\xcd\x02\x6c\x7e\x17\x31\xa6\xf3\x57\xba\x62\xf6\x57\x11\x40\x39\xfd\x55\x7a\x59\x76\x7f\x55\x5e\x9c\xd7\x5f\x75\x80\x65\xf4\x57\x9c\x45\xb6\xfd\x77\xfd\x1f\x25\x01\x52\x35\xce\x4d\xda\x9e\x9c\x1a\x74\x77\xda\x9c\xb2\x98\xa3\x4f\x7d\x23\x4d\x3b\xb8\xa1\x56\xc3\xd2\xda\xf2\xfa\x01\xb5\xa2\x49\x02\xd5\x2b\x53\xd9\xfd\x39\x26\x6d\x83\x7d\xaa\xf9\x89\x61\x5f\xa9\x29\x40\x31\xca\x6d\xe2\xe7\x46\xfe\xb9\x86\x32\x51\x74\x7f\xd6\x98\xf1\xda\x81\xcd\x05\x4d\x12\x2c\x89\x3a\x8a\x1d\x03\x12\xed\x3a\xcd\x3a\xe8\x5a\x5c\xe4\x31\x67\x4f\x94\x3b\x27\xfe\x20\x8e\xe9\x76\x86\x2a\x99\x57\xe5\xd9\x67\x0c\x65\xb0\xdb\x19\x4a\x14\x61\x97\xaf\x76\x3b\x43\x81\x32\x2c\xb0\xb9\x9d\xa1\x44\x15\x96\x20\xdf\x2b\xe4\x6b\xcf\xf1\xf6\xaa\x3e\xa6\xf9\x47\x9f\x4c\x1b\xd7\x2b\x54\x11\x61\x15\xf7\x84\x1a\x2a\x35\x61\xa5\xfd\x44\x95\x66\x2b\xa8\xd3\x86\x75\x3c\xa7\xa9\x50\x4e\x86\xe5\xec\xd3\x50\xa8\xa3\xc2\x3a\xcf\xae\x6a\xa8\xd5\x85\xb5\xec\xe3\x73\xa4\x93\x47\x61\x1d\x73\x4a\x0b\x14\xa2\xd8\x7d\x2b\x3a\x3d\x34\xe9\x72\x47\x25\x18\x74\x17\xa0\x3b\x0c\xc7\xce\xba\x31\x2b\x3a\x73\xd8\xce\x22\xf7\x1c\xd9\xfb\xac\x0e\x95\x9e\x67\x27\xb3\x20\xbe\x96\x96\x61\x48\x65\x58\xea\x6c\xeb\xec\xe9\x8a\x24\x4d\x47\x2d\xab\x1a\xdb\x7e\x78\xaf\x95\xf6\xf4\x35\xde\x2e\x96\x23\xd7\x5e\xcf\x28\x8c\x8c\x21\x66\x4f\x65\xef\x0b\x55\x95\x13\xc2\xb6\xdc\x9a\x0d\xfe\xad\x4d\x06\x1a\xe2\x64\x77\xd2\xb5\x7f\x96\xfb\xf5\xd1\x2b\xf7\x2b\xd5\x36\x9b\xc7\x3b\x5e\xad\x44\xc7\x12\xfa\x01\x42\xdd\xf6\xc7\x12\x13\xc3\x43\xf0\x98\xad\x3a\x27\x79\xbb\xbe\xc9\x1a\x17\xf7\xf1\xeb\x98\xa9\x3d\x8c\x2e\xef\x82\x40\xe1\x07\x53\xf8\xc4\xc6\x0f\x66\xc7\x60\x1d\xb7\xb9\x07\x34\x35\x5e\x0e\x3b\xa6\x8e\xdb\xc2\x4f\xa6\x98\x2c\xfd\x64\x86\x49\xdf\x8f\x41\xad\xfd\x37\xe3\xa9\xbe\x1f\x45\xb3\x8c\xe7\xfa\x7e\x17\xaa\x68\xf3\x6f\x46\x3f\xf9\x7e\x1a\xcd\x32\x9e\xdb\xfa\xd9\x8c\xf3\x5c\x19\x62\x51\x5f\xc5\xd9\x73\x96\xcc\x02\xd8\x51\x9c\x45\x9d\x2f\x3d\x16\x03\xad\x3a\xe7\xdc\xbd\x1f\xd7\x0a\x02\xf6\xc6\x5b\x03\x9c\xb1\xa5\x12\x0f\x05\x07\x96\x4a\x3d\x14\x7e\x25\x7b\xf9\xe9\x47\xc6\x94\xe1\x04\x52\xf5\x23\x77\xc2\x50\x85\x0f\xc3\x6f\x55\xfa\x30\xfc\x5a\xf6\x10\x32\xbf\x2d\xab\x91\xc2\x87\xe1\x46\x36\x3e\x0c\x37\xb2\x0d\x58\x22\xb6\x2b\x19\x24\x59\xaf\xa9\x8e\x70\xfc\xba\xa1\x11\xc4\x33\xb9\x2e\x0a\xe0\x8c\xe5\x2a\x0e\xa3\x9c\x17\xef\x92\x43\x1e\xbe\x79\x97\x1e\xf2\xf8\xd5\xf3\x00\xcf\xb4\xcf\xae\x38\xe4\x71\xfb\xcb\x43\x1e\xb4\xbf\x8e\x85\xe7\xaa\xdc\x1e\xd0\xbe\x7c\xf7\xff\xfc\x33\x30\x36\x5d\xf9\xab\x46\x2f\xd9\xb8\x7b\x47\x94\xea\x4a\x6c\x5b\x59\x08\xda\xbf\xbd\xfd\x1d\x76\x99\xc6\x7e\x65\x7c\xd7\x39\xb5\xfd\xcc\x6d\xbf\x3d\x36\x06\x91\x9e\x6e\x33\x37\x0c\xb9\x02\xb9\xe7\xde\x1f\x8b\xad\x4a\xb7\xec\x62\xbb\xc7\x57\x98\x17\x50\x5f\xf4\xd3\x5d\xc4\xf2\x17\xd0\xd9\xfc\x58\x76\xe7\x1b\x05\xf3\x6d\x41\x15\xd7\x37\xb1\xa6\x47\x6d\xda\xb4\x92\x80\x96\xae\x77\x74\x52\xcb\xee\x60\xa3\xf5\xf8\xda\x79\x43\xad\x0a\xb4\xac\x99\x6e\xa3\x5c\xce\x49\x7a\xf6\xf4\x5b\x5d\xfd\xc1\x12\x81\xbb\xfb\xa6\xb5\x67\xcd\xbd\xb6\xbf\x76\xbe\x42\x32\xf6\x90\xb3\x18\x3f\x18\x68\x12\x9e\x2f\x20\x6b\x0f\x97\xab\x9a\xe9\x3a\x97\xe0\x3c\xd3\xfe\x2d\x69\x9c\x5e\xcc\x25\xb4\xdb\xb0\xde\xc5\x97\xe8\x07\x8a\x09\xc6\x2a\x79\x58\xc5\x44\xbd\x22\x81\x22\x2c\x70\xcc\xd6\xb1\x53\x3c\x51\xfd\xa1\x6f\x3f\xf1\x5b\x84\xe9\xa2\x2e\xe4\x2e\x61\x4e\x5b\x4e\xea\x13\x63\x39\x5c\x32\x0d\x91\x8f\xa4\x0b\x48\xe1\x75\x58\x6d\x3d\x30\x4e\xf7\x6f\x01\x6d\x9f\x53\x07\x0b\x09\x14\x87\x02\xe8\x8b\x9a\x53\x07\x0b\x09\x54\xfe\xd2\x53\x7b\x78\x26\xc3\x9a\x4e\xd4\xc1\x42\x3a\xc2\xaf\xb3\x05\x96\x6f\x52\xa6\x0e\xd6\xb1\x90\xbd\x65\x25\x47\xfe\x26\xa4\x6b\xa4\xe1\x66\xd8\x3b\x57\xa2\x87\x49\xde\xbf\xc5\x3c\x62\xab\xf2\x2c\x24\x8f\x1e\xd8\xfd\x5c\x5b\xe1\x39\x68\x5f\x55\xe9\x04\x05\x2d\x8a\x01\xd8\xef\xbd\x97\x90\xdf\x8a\xeb\x21\xd4\x7e\xe9\x7d\x79\x99\xae\x5c\x5a\x7a\x68\x1d\x5e\xc6\x94\xa8\x63\x11\xbd\x26\xcd\x98\x05\x85\x72\xf7\xff\xb0\xdc\xb7\x6e\xb7\xef\xfb\x08\xde\x74\xe2\xae\xd7\x76\xf9\x08\x2e\x9f\x06\xd6\x92\xd3\x42\xf6\xac\xb4\xd2\xb9\xfb\x40\xd7\x05\x1f\x19\xd2\x11\x9e\x87\xf0\xed\x78\x0a\x3e\xdf\x9e\x92\x64\x4f\x91\xc9\x2b\xe3\xb1\xf6\x3c\x44\x77\x79\xfb\xf1\x46\x61\x8e\xc7\x5c\x25\x9c\x64\xdc\xe2\xf6\xec\xcc\x25\xe7\xbd\x3a\x12\xc8\x1d\xaf\xf7\x6d\xbc\x92\xc9\xb5\x43\x4f\x1b\x8f\xdd\xed\x0d\x45\x44\xd4\x06\x53\x58\x31\x60\x19\x84\xcd\x06\x06\x2b\xa8\xa0\xc2\x23\x6f\x04\x16\xb1\xe7\x0a\x9d\x33\x66\x77\xac\x62\xd4\x9e\x35\x3a\x1d\xd6\x8c\xa0\x38\x73\xa0\x4f\x31\x74\xf8\x41\xaf\xa9\xd7\x8d\xbf\x32\x4d\x38\xac\x6d\xd8\xfa\x70\x98\x8f\xda\x26\xad\x6f\xaf\x9c\x81\x4b\x5f\xf2\xf0\x33\x02\x95\x37\x13\xf8\x09\x81\xda\x2b\x70\xfa\x45\x84\xb7\xf3\xe3\x82\xc3\x36\xbe\xce\xe7\xa1\xad\xbf\xf3\x79\xb0\x0c\x77\x3e\x4f\x40\x79\xfb\xee\x84\x40\xe7\x15\x38\xf9\x22\xc2\x71\xcb\x6e\x67\x60\xd4\x06\x06\xea\xd4\x8d\xd8\x16\x91\x45\xcd\xfd\x96\xc5\xe5\x32\xc9\xbe\xe3\xcc\x53\x71\xfa\xaa\xa3\x23\xda\x69\x57\x73\x1b\x75\x85\x24\x24\x92\x37\x1e\x91\xfd\x4b\x1d\xb7\xc0\x36\xe4\x2d\xba\x5e\x72\x38\xdb\x72\xbf\xa8\x6e\x0b\x15\x0b\x67\x70\x8e\xd5\xaa\x55\xf4\x23\xef\x79\xb6\xc9\xfe\x1e\x91\x33\x40\xdb\x5c\xaf\xb7\xf9\x43\x99\xaf\x39\x0c\xda\xe6\x41\xe3\x2b\x4b\x18\x43\x3a\x6f\x84\x6f\xd3\xb1\x7c\x4e\xf3\x7a\xaf\xee\x15\x47\xa1\x39\x56\x98\xd5\x17\x56\x11\x8e\xd7\x8a\x9a\x4f\xcb\x0f\xeb\x15\x84\x33\xad\xeb\xd5\x7b\x9e\xbe\xef\xcd\x8f\x4e\xe4\x82\xe9\xe2\x75\xf5\x3f\xc1\xe7\xce\xe5\x23\x93\x08\x84\xce\x66\xa9\x6e\xae\x6a\x6f\x83\x98\xc9\x6f\xa8\xbd\x67\x58\xaa\xf4\xec\xf9\x8d\x1f\x62\xbf\xce\x83\x35\xaa\xa0\x06\x7b\x23\x90\x37\xad\x47\x64\xdf\x0b\x99\x82\xa4\x58\xc3\x36\xe4\x9d\xbd\xce\xfd\x97\x58\x19\xe6\x9c\x37\xca\x83\xb7\xa6\xae\x3d\x83\xee\x3c\xf4\xf2\x29\x66\x1e\x1d\x7b\xcb\x15\x70\x5f\x3c\x0f\xf9\x32\x1f\x5e\x05\xa8\x11\xcb\x90\xc6\xe6\x96\xc2\x0a\xea\x58\x81\x6d\x50\x71\x17\x12\x3a\x69\x10\x49\x1c\x12\x3a\x6d\x9e\x99\xe7\xd7\xdd\x76\xec\x90\x16\x51\xe4\x69\x87\x4e\xfd\x44\x29\x21\x63\xfc\x7c\x11\xc5\x47\x0a\x9c\x29\x2b\x4a\x8e\x14\xe2\xcd\xd5\x81\x65\xd2\x63\x99\x76\x52\x1d\x63\x53\x2d\xa2\xec\x58\x67\x2b\xd2\x8d\x75\xf2\x63\x1d\x39\x5d\x44\x3f\x72\x84\x8a\x23\xa1\x84\xdd\x3f\xe5\xb1\x0c\xbb\x7f\xaa\x63\x1d\x76\xff\xd4\xc7\x3a\xdc\xfe\xc9\x9d\xac\xad\x3f\x6d\xd3\xce\xbc\xb5\xb2\x72\x52\x38\x92\x7b\x4c\x7f\xe6\xed\x37\x7c\xc5\xda\x1f\xd3\xf6\xef\x6b\x91\x11\x26\x0b\x3f\x19\x63\xb2\xf4\x93\x09\x26\x2b\x3f\x99\x62\xb2\xf6\x93\x19\x26\x85\x9f\xcc\x31\xd9\xf8\xc9\x02\x93\xad\x9f\x2c\x31\x29\xfd\x64\x85\x49\xe5\x27\x6b\x4c\x76\x7e\x32\xc6\x46\x94\x46\x01\x14\x5b\x51\x1a\x07\x50\x6c\x46\x69\x12\x40\xb1\x1d\xa5\x69\x00\xc5\x86\x94\x66\x01\x14\x5a\x52\x59\x54\x41\x5f\x10\x44\xeb\x20\x6a\x1c\x51\x88\x17\x41\xfe\xb1\xbc\x03\x89\xd2\x9e\x63\x9a\xdb\xe5\x7a\x9f\xa7\xf5\x51\xfd\x08\xc1\xc5\x01\x6c\x16\x0d\xa4\x60\x4f\x37\x5a\x81\x2e\x73\x5a\x05\x90\x10\x6f\x77\xbe\xe6\x4f\xb0\xce\xb4\x73\x93\x5f\x98\x70\xa6\x9b\x5b\x25\xbf\xe0\xd0\x2d\x4b\x7b\xa2\xd1\x2d\x54\xe3\x97\xfe\x74\x47\xa0\x3d\xcf\x68\x70\x15\xf3\x87\x5a\x11\x58\x3b\x31\xed\xdd\x2c\x3e\xb4\x45\x6e\x37\xb4\x21\x6c\x8f\xdc\x59\x7d\xdc\xe1\x3c\x51\x3b\x55\x82\x08\x81\xf3\x43\xed\x24\x48\x26\x04\xce\x0b\xb5\xb3\x94\x12\x02\xe7\x83\xda\x59\x3f\x09\x81\xf3\x40\xed\x2c\x9c\x84\xc0\xf1\x5f\x3b\x2b\x26\x21\x70\x09\xa9\x9d\xa5\x92\x10\xb8\x76\xd4\xce\x1a\x49\x08\xb4\xbc\xda\x59\x1c\x09\x81\xab\x45\xed\xac\x8a\x84\xe0\x65\xa2\x76\xd6\x43\xcd\x30\x7e\x7f\x7b\x25\xd4\x0c\xc3\x00\xec\x35\x50\x33\x0c\x0b\xb0\x57\x3f\xcd\x60\x13\x70\x96\x3d\xcd\x60\x1b\x70\xd6\x3b\xcd\x60\x23\x70\x16\x3a\xcd\x60\x2b\x70\x56\x38\xcd\x60\x33\x70\x96\x36\xcd\x60\x3b\x70\x72\x9c\x10\x93\x60\x3b\x70\x0a\xf8\x68\x06\xdb\x81\x53\xbb\x47\x33\xd8\x0e\x9c\xb2\x3d\x9a\xc1\x76\xe0\x54\xec\xd1\x0c\xc3\x0e\x9e\x87\x4f\xc2\xb0\x83\xe7\xf1\x93\x30\xec\xe0\x79\xfc\x24\x0c\x3b\x78\x1e\x3f\x09\xc3\x0e\x9e\xc7\x4f\xc2\xb0\x83\xe7\xf1\x93\x62\x3b\x70\x72\x9f\x68\x06\xdb\x41\x66\x8f\x9f\x76\x1a\x19\xab\x8e\x93\xb4\x95\x10\xc6\x53\xec\xd1\x43\x08\x36\x36\x27\x4f\x1c\x21\xd8\xd6\x9c\x9c\x70\x84\x60\x53\xcb\xec\xa1\x43\x08\xb6\x34\x27\xab\x2a\x21\xd8\xd0\x9c\x2c\x6f\x84\x60\x3b\xcb\xec\x71\x43\x08\x36\x33\xe7\x06\x04\x21\xd8\xca\x9c\xf4\xe7\x84\x30\x56\x1d\xe7\xf2\x83\x66\x18\xbf\xbf\x3d\x6a\x34\xc3\x30\x00\x7b\xd4\x68\x86\x61\x01\xf6\xa8\xd1\x0c\x36\x01\x27\x90\x4d\x33\xd8\x06\x1c\x27\xbe\x66\xb0\x11\x38\xa9\xd5\x34\x83\xad\xc0\xb9\x68\xaa\x19\x6c\x06\xce\x15\x53\xcd\x60\x3b\x70\x6e\x95\x12\xc3\x58\x75\xf2\xe7\xa1\x93\x60\x3b\xc8\x9f\xc7\x4e\x82\xed\xe0\xa9\x1c\xc9\xc8\x59\x75\xf2\xe7\xd1\x93\x30\xec\xe0\x79\xf8\x30\x56\x9d\xfc\x79\xfc\x30\x56\x9d\xfc\x79\xfc\x30\x56\x9d\xfc\x79\xfc\x30\x56\x1d\xa7\x2a\xb6\x66\x18\x76\xf0\x3c\x7e\x18\xab\x8e\x73\xc5\x51\x33\xd8\x0e\x9c\x8c\x78\x97\x09\x7e\xff\xd5\x85\x3d\x10\x84\x94\x18\xb0\x47\xc1\xe5\x36\x60\xc0\x1e\x02\xcb\xad\xc1\x80\x6d\xff\x72\x5a\xb1\x55\x16\xb6\xf5\xcb\x69\xc5\x36\xe9\x78\x38\x2e\x82\xf1\xda\xb6\xe1\x0f\x6a\x86\x1f\xbb\xb5\xe3\xc9\x68\x47\xc6\x23\x1c\x9b\x1f\x25\xc3\x42\x6c\x8b\x97\xd3\x9a\xf0\x7e\x41\xe9\xad\x91\xa6\x9d\x2b\x57\x3a\xd8\x9e\xf0\x37\x75\x61\x8f\x01\xf2\x6f\x3c\x3e\xca\xad\x42\x25\x48\xa3\x0b\x69\xb4\xd3\x48\xe1\xa5\xd8\xe1\x51\x3b\x05\xdb\x49\x83\x6e\xc9\x2b\x06\x67\x8f\x13\xe2\xfa\x71\x59\xe7\x9b\x2e\xd9\x6d\xe2\x4a\x49\x08\xcb\x24\x47\x32\xeb\xb4\x0a\x3c\x3e\x4a\x7b\x04\x1a\x8d\xeb\x6d\x75\x7f\x0e\xbb\xf0\x0a\x92\xb3\xc7\x27\xc9\x0d\xd3\x74\xd5\x97\x20\xcf\x88\xb8\xcb\xd6\x40\x16\xa1\x2f\x9b\x5c\x45\x3f\xeb\x03\x48\x24\x60\x8f\xe1\x3d\xee\x64\x59\xb1\x61\x95\xa5\x17\xfc\x1b\x83\x95\x17\x9c\xef\x92\xf1\xd0\xda\xcb\xfe\x7d\x97\x8c\xe7\x0a\x2f\x3b\x33\xc8\x26\x44\xc2\xc7\x7a\x6e\xf8\xee\xb9\x17\x59\xa0\x6d\xb6\x1f\xba\xea\x0b\x1b\xb5\xcd\xab\xa1\x3b\xbd\x6c\xd2\x6e\x6d\xf2\xe7\xbd\xc6\x66\x7f\xde\x6b\x69\x75\x82\xb3\x0d\x49\xdf\x29\xe4\x92\x4e\x1e\xcf\xbf\x6f\x62\x26\x6f\x35\x17\x76\x5c\x9c\xba\x84\xfb\x27\x9b\x75\x73\x73\x52\x06\x4d\xb1\x2a\x36\xed\x74\x30\x4d\x12\xef\x75\xf1\xa8\x3e\x4e\x3d\xd5\xee\xe6\xa6\x17\x0b\x83\xac\x4a\xa7\xd2\xca\x57\x33\xdd\xbb\x59\xa9\x3d\xdc\x03\xc2\xbe\x4b\x29\xa7\x04\x64\x28\xbb\xc5\x09\x95\x2a\x89\xbc\x77\x20\xb7\xab\xd5\x00\x76\xdc\x58\x66\x66\x6e\x07\x4a\xeb\x71\x1b\x75\x96\x0e\x25\xf7\x9b\xee\xc7\x42\xa5\x13\x22\x7a\x1d\xef\xeb\xdc\x8b\xf1\x63\xc0\x6b\x67\xd9\xd9\x03\x99\x4e\x80\x1d\xda\x5c\xf7\x36\x97\xc5\xb7\x48\x07\xa4\x97\x84\x5a\xa2\x4f\x7c\x58\xfd\x52\x76\x69\x48\x64\x2b\xbd\xcb\x53\xc9\x42\x2a\x6f\xbc\x56\x1e\xd2\xb2\x5e\x8b\x4a\x12\x08\xb8\x40\x95\x5d\xc1\xd0\x6a\x6f\x4d\xdf\x62\xa9\x32\x24\x65\x77\x14\xb7\x5d\x15\x47\x8c\x92\xf9\x52\x05\x28\xd8\xb8\x2a\x8a\x42\x11\x1c\x54\x20\x08\xe3\x71\x10\xa7\x78\x42\x88\x27\x21\x5c\x6f\x06\xad\xd0\x49\xa8\x94\x32\x94\x38\x3b\xcc\xc4\xe9\x11\x9a\xb3\xce\x1e\x7f\x95\x6e\x5d\x88\x49\xde\x06\x9a\xa6\x19\x1f\x0a\x65\x96\xfb\xc8\xed\x7a\x26\x4b\xa0\xf0\x0a\xdc\x9a\x75\x16\x2d\x9c\xec\x8f\x6e\xea\x23\x32\xf7\x5d\x15\xe7\x80\x85\xff\xa2\x38\x07\x2d\xc3\xd7\xc4\x39\x78\xe5\xbd\xe3\xcd\xc6\x6b\x2f\x7e\xf2\x15\x84\xb7\xc3\x63\xe8\x7b\x39\xb8\x9b\x8f\xc0\xd6\xdf\xe1\x1c\x54\x86\x3b\x9c\x83\x2b\x6f\x8f\xb1\xf1\xce\x8b\x9f\x7a\x85\xd2\xf1\xf8\x5f\x7a\x9d\xdc\x4d\x2f\xe3\x98\x4c\x43\xe4\x3a\x99\x22\xea\x48\xa1\x88\x5e\x8b\x2e\x43\x26\xf6\xd4\x3e\x86\x90\xfd\x92\x94\xc5\x55\xcd\x5f\x8c\xe6\xd9\x2f\x48\x21\x60\x62\xc6\x13\x8e\xe3\x9c\xda\x42\x02\xb6\x1d\x1a\xeb\xee\x46\x91\x07\xf9\x1b\x25\x21\xc4\x02\xce\xfc\x61\xe7\x1f\xd8\x64\xa0\x80\x3d\x8b\x38\x09\x0c\xa6\xae\x5b\xf0\x55\x89\xb2\x68\xfc\x85\x53\x4d\x05\x70\xbc\xbb\x2b\xda\xd7\x1b\x86\x86\x85\xfe\xa8\xb2\x90\x21\x16\x7a\x2e\xcb\x42\x85\x58\x38\x6f\x95\x45\x17\x62\xa1\xef\xb0\x2c\xa3\x10\x0b\xbd\x88\x65\x19\x87\x58\xe8\xe1\x2e\xcb\x24\xc4\xe2\x69\xa3\x4c\x43\x2c\xf4\x78\x97\xbe\x52\x5c\x7b\x91\xbf\x63\x34\x6a\x6d\xd3\xa0\xdb\xa2\xea\xcf\x7a\x0c\xd8\xf6\x40\x00\x45\x72\x40\xc8\x36\x04\x82\xc4\x97\xd2\x31\xcb\x10\xec\x3c\x4f\xe3\xc2\xce\x3e\x4b\x34\x1f\x33\x24\x9c\x4c\x55\x2c\xc0\xb6\x96\xe6\x63\x16\x88\x90\x2a\xf1\x8f\x65\x0a\x03\xd0\x41\x3d\x5b\x61\xe6\x05\x2b\xd9\x16\x43\xdf\x6c\x8f\x5d\xe4\x1b\x5a\xb6\x05\x91\xd6\x87\x9a\x2e\x6a\x9d\x7f\xde\xd1\xb2\x2d\x84\xb4\x9e\x25\xcc\x45\x6d\x28\x63\xdb\xcc\x4e\x6e\x33\x2e\x64\xbb\x30\xbb\xc7\x5b\x00\x0d\xd7\xb7\xb5\x7b\xaf\x37\xc7\x29\x64\xed\x1f\x79\x1a\x15\xe5\xfc\xbd\x2d\xa7\x55\x52\x5f\x0b\xcc\x6e\x04\xb2\xd9\x71\x0b\x98\x2a\xf6\xda\x69\x2a\xe9\x32\x9b\x6e\x6f\x5d\x0d\xa8\xfe\xbe\x99\x4f\x6d\xda\x4a\x02\xbe\x4a\x65\x98\xe7\x35\xbd\xb5\xf7\xde\xa6\x05\x72\x59\x39\x0f\x8f\xda\xd7\xc6\x2f\x73\xcb\x43\x85\xf7\xa9\xcc\x26\x37\xde\xe7\xf2\x60\x27\x50\x9e\x72\x4b\x40\xc0\xee\x20\x1a\xa6\x10\xb0\xf7\x41\xe6\xdb\x5d\xca\xff\xc8\x12\x20\x6b\xf7\xa6\x7e\xe2\xe3\x3b\x13\xa2\x76\x5f\x6a\x74\xa6\x52\x81\x8b\xe2\x4b\x38\xde\xb4\x76\xe8\xaf\xf7\xaf\x69\xb8\x5d\x74\x50\xd1\xf5\x4a\x53\x88\x8e\x71\x82\x2d\xb1\x57\xd8\xf6\xf2\xf3\x17\xee\x32\xdb\xf8\x89\x60\xfc\xfa\xf6\x64\xa9\xa3\x15\x89\x63\x36\xd0\x9d\x2c\xcf\xd1\xb1\xb0\xcd\xc8\x6c\x6e\xf7\x2b\xde\xc3\x44\x5e\x9a\x7e\x5e\x18\x2a\xf5\xb1\x8a\xce\xbf\x84\x54\x5c\x67\x7d\x3b\x8d\xf4\x7b\x69\x43\x8b\xb1\x9d\xc6\x71\x80\x4d\x18\xac\x3d\xdd\x2f\xea\x2a\x66\xbd\xdc\x71\xc8\x34\xf0\xd4\xc6\x44\x0f\x69\x5f\x1b\x14\xc9\x02\x22\x5d\x3f\xd0\x71\xc5\xd2\x8a\x81\xa1\x92\x1f\xab\x68\x7f\x3e\x14\xb1\xe7\x88\x59\xc9\x5b\xcb\x78\x70\x19\x7a\x30\xf9\x1b\xa1\xed\x44\x71\x15\xe0\xbf\x7b\xb9\x7e\x62\xbc\x0e\xe0\x9f\xfa\xc4\x06\xf3\xf6\x24\x45\xf3\xe8\x1b\x4d\x68\x0e\x24\xb8\xcd\xb0\xa7\x99\x2b\x15\x3b\xb4\x45\xe8\x24\x91\x69\x06\xf2\x48\xc7\x1c\x2c\x32\x95\xd4\x91\x92\x3e\x67\x64\x0a\x75\x47\x42\x66\x79\xe4\x29\x25\xd1\x91\x12\x75\x12\xcb\xca\x93\xf8\x48\x66\x3f\x7c\xe5\x08\x25\x47\x42\xdb\x51\x2c\x47\x27\x3d\xd2\x31\x3d\xc4\x11\xca\x9c\x1b\xc8\x2b\x45\xe0\x3e\x42\x75\x20\x6a\x8f\x83\xa6\xdf\x0e\x0b\x98\xac\x3d\x00\xda\xdb\x3c\xd3\xc4\xbf\x3f\x1e\xc2\xad\x07\x7e\x34\x00\xd2\x2a\xf8\xc6\x3a\xb8\x09\xf2\x5d\x90\x5f\xd6\xb9\xc7\xd3\x77\x96\x45\xe1\x8e\x63\x35\x21\x8b\xc3\x02\xdc\x36\x24\xc1\xb7\xd8\x43\xb4\xa0\x44\x1a\x6e\x05\x5b\xc3\x5e\xc9\x74\x70\xf0\xde\x16\x48\xda\xab\x97\x0e\x9b\x65\x93\xf6\x92\xa5\x9f\xf9\x68\x3c\x44\x9d\x3d\x37\x3d\x94\x8b\xc6\xc2\x1e\x2d\xed\x6d\x78\xf8\x6d\x20\xd8\x84\xc0\x1f\x8a\x96\x5f\x7a\xce\xce\x3a\x16\x6d\x40\x65\x73\x4c\x72\x85\x44\xd6\x1d\x15\x66\xdf\xd4\x80\x48\x93\x45\x1e\x11\x2e\x6b\xaf\xfe\xdb\xf1\x19\x0b\x7d\xca\x89\xbb\x79\x07\xb8\x68\x30\xa9\x2d\x24\x6d\x13\xf7\x25\xb5\x3d\x16\xa8\x2a\xa7\xe6\x99\x93\x60\x75\x55\x78\xa7\x5d\xd5\x71\x08\x07\xa4\x4a\xa4\xe7\x57\xda\xa2\x28\x21\xeb\xcc\xb2\xb3\x18\x97\x2b\xd5\xcb\x3e\xab\xd2\x85\x5b\x60\x2a\xa2\x9b\xfe\x00\x3a\x91\xb2\x6d\x86\xf6\x5b\xdb\xb7\xc6\xa2\x47\x41\xdf\x2a\xd8\x19\x91\xaa\x43\x12\xe4\x5e\x62\x28\x64\xce\x55\xfa\x6e\xfa\xb8\xb7\xd3\x34\xcb\x7e\xd4\xc7\xa9\xd3\x6d\xc6\x3b\xe7\x2c\x8f\xc3\x12\x90\xb5\x67\xfc\x87\xe7\x90\xd5\x7f\x59\x9e\x7a\x56\xdc\xb3\xcf\xcf\xc2\x6d\x3f\xb1\xf6\xe5\x39\x92\x61\xae\x80\x79\x81\x84\xb8\x8b\x58\x5e\x22\x25\xa0\xd0\x3a\xdf\xd4\xba\x5c\x9c\x5d\x7c\x06\xd2\xb6\x69\xea\x58\xfa\x53\x74\x71\x44\x33\x9d\xa7\xad\x10\x47\x6f\xc0\x56\x69\x42\x77\xb3\xf6\x02\x3c\x40\x41\xba\xbf\xaa\xd5\x84\x7e\xa4\x5b\x71\x83\x5a\x95\x53\x59\x0e\xea\xb9\x2b\xfe\x1f\x66\x43\xea\xc8\xc9\xa8\xa5\x3f\x56\x24\x65\x67\x9e\x86\x2f\x75\xef\xc4\xc2\x98\xba\xeb\xa8\x69\xc2\x1a\x63\xdf\x62\x89\x28\x77\x52\xa8\xf4\xe3\x97\x18\x7a\xf7\xa7\x99\xae\x6a\x66\x39\xe7\xb2\x97\x49\xd4\xfd\x81\x18\x2e\xfe\x56\x84\x7e\x1d\xb6\x81\x94\x21\x03\xe1\x2a\xc8\x28\xd0\x04\xb1\xae\xa2\xfd\xd4\x93\xe2\xb6\x30\x73\xe6\xa3\x56\xc6\x6c\xbd\x51\x5c\x18\x7a\x09\xd6\xdb\xaf\x3d\x9a\x3b\x6c\x50\x31\xe5\x2b\xb6\xb7\x46\x51\xee\xa1\x7b\x27\xf0\x72\xd4\xca\x8c\xaf\x9c\xca\xfb\x3f\xdb\xe9\x37\x94\xcd\x03\xb2\x8f\x11\x0c\x15\x18\xb3\x80\xd5\x46\x28\x57\x62\xb9\x4b\xbf\x2c\x64\x7f\x67\x64\x6b\x2c\x2b\xfb\x8b\x1a\x17\x1d\x89\x00\xe5\x04\x96\xa3\xd3\x38\xb1\x32\xb4\x1a\xac\x65\xa5\x23\xc1\x7a\x2d\xd6\xd3\xeb\x1e\x57\x4f\x06\xf4\x6e\xe3\x6f\xa1\x34\x28\xd2\x79\x4b\xca\xfd\xfe\x82\xb8\x9f\x54\xf4\x1a\x63\xf1\xc0\x23\x8c\xc7\x07\x78\x8c\xf1\xe4\x00\x87\x27\xfb\xad\x4a\x0f\xf0\x14\xe3\xd9\x01\x9e\x61\x3c\x3f\xc0\x73\x8c\x17\x07\x78\x81\xf1\xf2\x00\x2f\x31\x5e\x1d\xe0\x15\xc6\xeb\x03\xbc\xc6\xb8\x38\xc0\x63\x86\xd9\x35\x47\x3c\xc3\xee\xda\x23\x9e\x61\x78\xf2\x88\x67\x58\x9e\x3a\xe2\x19\xa6\xd7\x1d\xf1\xd0\xf6\xa4\x73\xcf\xc4\x7c\x41\xfd\xf2\x90\x4e\x22\xcf\xbd\xe2\x13\x7c\x16\x05\xe6\x3e\x08\xc6\xa1\xcd\x13\x24\x93\x10\xc9\x3a\xef\x90\x59\x1a\xe2\x79\x87\x1d\x32\xcb\x42\x02\xfa\x13\x6d\x14\x03\xf3\xf0\x48\x66\x85\xa7\xf7\xfb\x51\xaa\x3f\xd0\xee\x65\x56\x86\x58\x68\x73\x32\xab\x42\x2c\x9c\x2e\x64\x56\x87\xd8\x18\xce\x74\x32\x8f\x42\x5d\x47\xc7\x1f\x9c\x6d\xbb\xcc\xe3\x90\xc4\x76\x3c\xc4\x12\x09\xda\x90\x3e\xf6\xe0\x35\x24\x68\x47\xdb\xa1\x10\x4b\x24\x68\x4b\x5b\xc9\x00\x96\x48\x1e\x12\xd9\x7f\x1f\xd6\x0b\x35\x75\x60\x2c\x2f\x8c\xcb\x27\xb2\x11\x47\x74\x2b\xae\xfa\x54\x1a\xa9\x38\xdb\xd0\xc7\x35\xb0\xcd\xb3\x0a\x61\xe1\x83\x1f\x5e\xb5\xe9\x86\x47\xa4\x6c\x7c\x12\x26\x80\x96\xf3\x09\x26\x65\xeb\x13\x98\x6e\x2b\x57\xa1\x4d\xea\xd0\x6d\xb8\xfd\xf3\xa5\xbf\xd0\x05\x44\x93\xc0\x0d\xb6\x47\x86\xd4\xbe\xc4\xfc\x43\x1f\x09\x56\x14\x2f\x14\x53\xfe\xeb\x83\xe7\xa5\x1a\x27\x9f\xc9\x45\xbc\xa3\x21\x65\x17\x7a\xb7\xfd\xd6\xdd\x09\x31\x15\x85\xc4\xb6\x1f\x8f\xeb\x9d\x95\x2a\x0e\x29\x99\xf8\xed\x4d\x8f\xdd\xb6\xc8\xbd\x8a\x44\x91\x54\xcb\x5d\xc8\x7f\x89\x56\x8d\x2d\xf2\xe7\x45\xee\x65\x24\x82\xa9\x0c\x4d\x7f\x3d\xa3\x60\xdb\xf4\x6f\x44\xd6\x09\x01\xe9\x11\x38\xd9\x8c\x4a\x8a\x2a\x30\xc3\x58\xdf\x6d\xba\x8a\xd2\x96\x04\x10\xff\x52\xa2\xe6\x2a\xb2\xae\x00\x49\x51\x06\xf4\xac\x1d\x95\x6e\x21\xe7\x83\x30\xc3\x5a\xfb\x84\xa0\x35\x91\x62\x91\x25\x9e\x03\x0c\xed\x57\xe6\xad\x0f\x4e\x35\xbb\xbd\xfe\x4f\x2c\x79\x6e\x65\xd9\xc6\x1e\x3a\x61\xd3\x89\x87\xde\x3a\x04\xb2\xa9\x87\x7d\xb4\x9b\xd6\x96\xe9\x1b\x37\x20\xf3\x88\x24\x67\x45\x72\x8f\x88\x76\x73\x71\x05\x0a\x5f\x61\x03\xfa\x05\x75\x25\x55\x88\x97\x07\x38\xfc\xd4\x91\x6d\x75\x80\xe3\x5d\x67\x5b\x07\x8a\x51\xe1\x0b\x72\xb2\x15\x01\x16\x5f\x73\x93\x6d\x13\x60\x53\x06\xdb\x06\x58\xfd\xab\x41\x5a\x06\xe8\x84\x7c\xe7\xf8\xb8\x55\xb6\x2a\x20\xc0\x1f\x76\x5d\x40\x81\x3d\xf4\x64\x14\x50\x60\x0e\x3f\x19\x87\xac\xe6\xc4\xef\x2f\x13\x24\xc2\x30\x04\x99\x22\x11\x86\x45\xc8\x0c\x89\xb0\x4c\x43\xe6\x48\x86\x6b\x23\xb2\x40\x4a\x6c\x63\x91\x25\x92\xe2\x5b\x4d\x85\xa4\x78\xe6\x53\x3b\x29\x21\xe8\x6a\xf9\xbe\x72\xad\xea\x8f\x1a\xee\x3c\x37\x7e\xed\x94\x01\xa0\xad\xe2\x3b\x2a\x55\x27\x3d\xd7\x27\x4c\xb2\x7c\xb3\x8c\x91\x03\xe0\x03\xbf\x54\xe4\x24\xea\xfd\xec\x97\xd5\x34\x06\x62\xf6\x5b\x5c\xe7\xe9\xcf\xcf\x19\xb8\xf0\x3e\x93\x77\x49\x34\x29\xbd\x30\xcf\xcf\x11\x25\x95\x97\xe6\x7e\xf3\x47\x49\xed\xe5\xf9\x1f\xfc\x51\x22\xbc\x0a\xec\xaf\xfd\x28\x69\xbc\x02\xfc\x4f\xfd\x28\x69\xbd\x0a\xbf\x91\xbe\x3c\x15\xe9\x55\x59\xfa\x11\x5f\xed\x77\x32\xe4\x5f\xfa\x91\x13\x77\x91\x74\xaf\x0c\xf3\x57\x4f\xa3\x57\x94\xd5\x4e\x27\x53\xf0\xaa\xfd\x06\xeb\x34\xdd\x07\xda\xd5\x23\x38\x16\x76\x07\xf5\xb7\xfe\xfe\x95\x74\x18\x72\xd6\x59\x82\x52\x06\xe4\x2c\xad\xb7\xfe\x3e\xa6\x1d\xeb\x69\x4e\x7d\xa6\x07\xc8\x78\x62\xe3\x74\x4c\xd2\xb1\xdf\xcf\xa9\x27\xb9\x26\x1d\xfb\x1d\x9b\xd4\x03\xb2\xdf\x33\x0b\xc1\x9c\x27\xdb\x73\x9d\xf6\x89\xb2\xae\x98\xc5\x4d\xe1\xe7\x74\x18\x0e\x84\x4b\x3f\x3c\x2b\x46\x83\xed\x49\xce\xb0\x17\x41\x75\x5e\x19\x17\x32\x9b\x3a\xcc\x52\x40\xc7\x45\x31\xee\xfe\xc4\x8d\x08\xab\x70\xce\xd0\xd3\xac\xf6\x5d\xcb\x7a\x68\x40\x5c\xf8\x70\xde\x9d\xd4\x34\x6b\x7d\x30\x2b\xe0\x2f\x75\xea\xf4\x8a\x75\x55\x23\x2f\xc6\x2b\xcd\xec\x71\xbf\x7d\x83\x9d\xe2\xed\x29\x60\xff\x1a\xa7\xa2\x3b\x7d\x6b\x55\xb7\x45\x2a\x79\xe4\x51\x19\x38\x67\x11\x69\x1e\x7b\xd0\x73\x3f\x7a\x9e\x1c\x49\x70\xad\xaf\xce\x2b\xdf\x5b\xac\xfd\x80\x2f\xc2\xd4\x79\x65\xbf\x86\x54\x92\xba\x8f\xaa\x40\x9a\xfc\x57\xdb\x95\x15\x24\xe2\x94\xc7\xbe\xce\xd3\xaa\xda\xf3\x22\x95\xdd\x1b\x94\xed\x70\x7b\x03\x72\xdd\x22\x87\x52\x9d\x57\xce\x74\x79\x8a\xb4\xe7\xca\xe9\xba\xf6\x74\x8b\x9e\xdb\x7b\xf6\x54\xb9\x25\x5a\x62\xa2\x4e\xa9\x76\x7a\x5d\xa9\xbe\xfa\x56\xdd\x6f\xb7\x1e\x5e\xca\xad\x73\xa7\x4e\xbb\x45\x62\xd0\x9e\x2b\xe5\xdc\x7f\xa9\x19\x83\x6e\x59\x44\xfa\xbf\x19\x3b\xa6\x3a\xaf\x6c\xec\x53\x8c\x92\xb6\x13\xe4\xca\x9f\xae\xe2\xef\x9b\xba\x77\xe8\x9b\xaf\xce\xab\xf2\x58\xe2\xbb\x1f\x53\xe4\x7d\xa9\xf3\xaa\xe2\xa8\xfc\x75\x61\x58\x7a\xfd\xda\xef\x03\xab\xdf\xc5\x2b\x38\x52\x94\xdf\x45\x2c\xc7\xfb\x32\xbb\xed\x03\xbf\xef\xeb\xc0\x2b\xcb\x54\xc6\xc9\x7d\xed\x07\x75\x9d\x26\xb4\x38\xd4\x79\x25\x0e\x75\x66\xc5\xba\xb3\x5c\xe7\x55\x73\xa0\x13\x6f\x07\x2b\x50\xa4\x65\x88\xe0\x9f\xb1\x8a\x6a\x7b\xd9\x3a\x71\x11\x34\xaa\x9d\x65\xcb\x30\xe6\x4b\x64\x9d\xf0\x4f\xa9\x15\xec\x85\xeb\x59\x61\x1a\x71\x13\xdc\xba\xf6\x46\x80\xf5\x64\x61\x4f\xf5\xb1\x4e\x59\x06\x11\x7b\x4e\x4e\x34\x02\xef\x95\x44\xc2\x9e\x8a\x0d\x04\x0f\xd5\x23\x61\xcf\xc2\x19\xf7\x49\xf6\xf4\x9b\x71\x9f\x64\xcf\x4a\x06\x82\xfe\xdb\xc8\x39\x5f\x30\x10\xf4\xda\x46\xa2\x7a\xfd\xa5\xf6\xea\x7a\x90\xb5\xc7\x2f\xf3\xda\x73\x24\xc4\xeb\x03\xb5\x69\x98\x3c\x92\x10\x6f\x02\x78\x3f\x92\xcb\x05\xf3\xed\x2b\x7f\xa5\x4d\xdd\x8c\xf6\x73\xc9\x96\x48\x34\x30\x2a\x9b\x1e\x3e\x3c\xeb\xec\x0e\x73\xab\xfe\x43\x54\x84\x3c\x66\x49\x76\x87\xf7\x32\x2a\x27\x61\x80\x7e\x72\x92\xed\xcf\x66\xd0\xb1\x67\xf3\xb6\xb7\x9c\x31\xd5\x57\x6d\x6a\x37\x7f\x8e\xe3\xee\xfe\x41\xff\x69\xe2\x08\x7e\x3a\xb5\x69\x13\x7a\x75\xa2\x49\x86\xfe\x65\xe4\xfa\x69\xdd\x42\x31\x94\x0a\x75\x13\x63\x9f\xb6\x56\x51\x9a\xf8\x5a\x43\xc5\x97\xef\xe9\x3d\x65\x0c\xd3\x34\xf5\x09\x98\x02\xd6\xd9\xf6\x17\x6a\x64\x61\x8d\x5c\xff\xc5\x73\x4c\x9a\xfb\x34\xa8\x5b\xab\xed\x2f\x54\x28\x42\x0a\x71\xb4\xfd\x83\xbb\xc3\x73\x33\x94\x77\xc7\x29\xf2\x5c\x0a\xd5\x24\xe3\x99\x99\x9f\xc4\x1d\xef\xb9\x0a\xca\xbb\x19\x15\x79\x6e\x81\x6a\x32\x66\x34\xb7\x0c\xa0\xf0\x4a\x55\xa4\xa4\x67\xe4\x6a\x98\x35\x6e\x2b\x65\x8f\xbd\x6b\x4f\xde\x70\x9d\x84\x83\xe7\x9e\xaf\x94\x3d\xe0\x0c\x7e\x1b\x4f\x09\xc8\xc3\xe7\x33\x43\x29\x2a\xd5\x81\x76\x30\x85\x52\xc7\x6b\x69\x84\x7e\xeb\x6d\xe3\x43\xa6\xd4\x71\x5e\x1a\xfe\x22\x3e\xf6\x3c\x16\x90\x4e\x5e\xe9\x7e\x64\xd3\xf6\x78\x31\xb4\xc9\xe1\xfb\xcd\xba\xd9\x98\x3a\x65\xd0\x0c\x09\x11\x7b\xb8\x30\x73\xa3\xa5\x69\x71\xdc\xc7\x27\xc2\x29\x53\xa7\x3c\x1a\xb9\x98\xff\x53\xbd\xea\xb8\x6d\xbc\x68\xd1\xd8\x29\x92\x68\x5d\xb2\x60\x24\x47\x8a\x93\x32\xc0\x62\x7f\x7d\xec\x1c\xb5\x98\xe7\x76\x42\xaa\xfb\xfa\x39\xab\xe5\x73\x1a\x58\x1f\x6e\x55\xec\x9c\xb8\xc8\x9e\x12\x87\xb5\xea\x8c\x6b\x2c\x72\x7e\x97\xeb\x34\xfc\x7c\x4c\x23\xf3\x9c\x2d\x4a\xab\x30\xdb\x89\x76\x65\xe4\x00\x4b\xeb\xb0\x04\x27\x19\x85\x8a\x9b\xb0\x80\xc9\xa8\x8a\x14\x1c\x8f\x26\xa5\xac\x5e\xee\xdd\x34\x0c\xd3\xf7\xfd\x3a\x4f\x5f\xd3\x5f\x34\x1b\x6d\x87\x88\xed\x34\x7e\xa9\x91\xd3\xaf\xca\xf1\x75\x9a\xfc\x46\x6f\x88\xd8\x1f\x90\x83\x78\x4f\xc3\x99\x71\x9f\xdf\xe8\x98\xae\x53\xc7\xc6\x67\x8a\x41\x9c\x4f\x7c\x02\xd7\x69\x52\xbd\xf2\xcc\x70\xc8\xd4\x31\x6e\x9a\x32\xce\xf2\xce\x56\xd7\xb0\x5d\xff\x87\xf6\x67\x5a\xe1\x3e\x4c\xc6\xfd\xcb\x90\x6a\x02\x1f\x1b\xa6\x49\xfd\x3f\x7b\xd6\xb9\x61\xfa\x86\x97\xc6\xea\xd4\x39\x63\x54\x5d\xa7\xda\xb5\xff\x52\xe7\xde\xaf\x8a\xdc\xad\xbc\xd2\x01\xa3\x7b\xfe\x75\xc0\x66\x4f\x35\xc0\x68\x27\xb3\xdc\xe0\xe1\x74\x96\xd7\x9e\xa0\xee\xdf\x52\x42\xac\x63\x80\x2c\x17\xde\x3a\x44\x5b\x50\x85\x3e\xa0\xe7\x4c\x7e\x59\xde\x40\x1d\xce\x4d\xde\x2c\x6f\xa1\x0e\x37\x3e\x5c\x42\x25\x76\x80\xb8\x82\x52\xc7\x12\x75\x5e\xd9\xad\x19\xc4\x0f\x5d\xac\xfe\x50\xa3\x9a\xa1\x7d\xd4\x79\xe5\xce\x3c\x9a\xdd\x9e\xfd\x7b\xaf\x11\x8a\x74\xaf\x22\xee\xc7\xf6\x09\xb1\x3a\x42\x62\x3a\x18\x70\x1a\x07\xdc\x33\x75\xfc\xaa\xb5\x5d\x5e\x3f\x21\x92\xbc\x8a\x98\x2d\x08\x8d\xe1\x19\xed\xc9\xea\xbc\x4e\x0f\x04\x24\x3c\x43\xad\xf3\x34\x7a\x15\x30\x5d\xf2\xfb\x16\x6f\xf4\x74\x1a\x87\x64\x2d\x91\x37\x3a\x3d\x3b\xf0\xc7\x26\xf7\x4e\x8d\x0c\xcf\x70\x6d\xef\x63\x6d\x90\xe5\xb4\x6a\x9c\x21\x21\xcc\xac\xbb\x5f\x31\x22\xf6\xbf\xfd\xcf\xff\xf7\x7f\xfe\xc7\x7f\xff\xdf\xff\xf7\x48\x24\xc9\x6b\x9e\xc8\xff\xfa\xff\x09\xae\x86\x63\xf7\xf5\xb6\x26\x6f\x96\x88\xfd\x58\x9b\x44\xe2\x3b\x91\x65\x8b\x74\xdb\x1f\x4b\x44\x0c\x8f\x2c\x39\x0d\x23\x78\x21\x71\x16\x93\xbd\x27\x7e\x53\x1c\x42\x5c\x78\xf0\x47\x7a\x20\xe6\x07\x61\xec\x94\x24\x31\xa7\xb2\x74\xaa\xbb\xaf\x6e\xed\x34\xae\x33\x3c\xc2\xa8\x62\xc7\x33\x48\xd1\xd9\x83\xda\x14\x20\xe9\x2e\x6b\xf4\xf6\x94\xa5\xe5\xaa\xda\xdb\x20\x66\x9e\x88\xc8\x8f\x7e\xcc\x2d\x1b\xfe\xc2\xdd\x21\x74\xce\x06\x9d\x76\x50\x27\x42\x52\x3a\x67\x6b\x4e\x70\x3b\x5d\x9a\x5e\xbb\xa6\x2c\x15\x31\xca\xdf\x76\x9a\x90\x09\x86\xb4\xdd\x55\xa6\x4d\xfb\xcf\xcc\x49\x78\xd0\xa5\x4d\x90\x67\xe5\x37\xe8\xd2\x36\x2c\xc0\xbb\xf8\xdf\xa5\xf2\x58\x02\x0b\xa8\xa0\x80\x68\x5b\x05\x0f\x5c\xea\xc8\xf9\x72\x31\x0a\xf0\x8e\x6e\x1d\x39\x5f\x26\x86\x82\xfe\x92\x3a\x72\xae\x60\x18\x0a\xde\xc2\xad\xa3\xac\xf3\x3d\x8b\x71\x5f\xb6\x8e\x9c\xdb\x16\xe6\x81\x8c\x1b\xbb\x75\x94\xc7\xaf\xdd\xca\x71\x71\xd5\x51\x9e\xf8\xba\x93\xb5\xd7\x8a\xf2\xd4\xf7\xa2\x4c\x36\xf3\xbf\x29\xe5\xd4\x60\x2a\xe4\xbe\xa7\x9f\xf8\x4e\x8b\xf2\x02\x29\x30\x5b\x62\x7f\x2f\x92\x57\x83\x6d\x96\x79\xe5\x25\x19\xa6\x99\xd7\x5e\x92\x61\x9e\xb9\xf0\x92\x6c\x13\x6d\xbc\x38\xcf\x4c\x5b\x2f\xcb\x35\x55\xe9\xa5\xf9\xe6\xaa\xbc\x3c\xdf\x64\x3b\x2f\x7f\xd2\x6c\x9d\x4a\x34\xfd\xb8\xde\xfb\x8b\xf8\x2f\xd2\xbe\x76\xd9\x51\xd5\xf9\xfa\x96\x7c\x17\x3f\x22\x60\xb6\xbf\x63\xd4\xa3\x66\xbf\x9c\x2f\xb9\x8d\xe7\xf2\x9f\x6a\x34\xd9\x90\xa0\xbd\xdc\xff\x9a\xaa\xa9\x9a\x9a\xac\x45\xd3\x36\x4d\x03\x4d\x73\xde\x74\x8b\x38\x28\xcb\x9f\xcc\xb7\x48\xf6\x0e\x52\x60\x3b\x2e\xd2\x63\x0a\xc0\xa0\x8b\xec\x98\x02\xb0\xec\x22\x3f\xa6\x40\x4d\xbc\x28\x8e\x79\x20\x5b\x2f\xca\x63\x12\xd0\xe8\x0b\x71\x4c\x03\x5b\x7f\x51\x1d\x13\xc1\xc3\xa0\x90\xc7\x44\x67\xc7\x43\x7d\x4c\x77\x76\x60\xa8\x53\x74\xa0\x8c\x3a\x18\x52\x62\x11\x69\x15\x79\xaf\xfd\xf8\x41\x0f\x94\xae\xbe\xbf\x6a\xf2\x2e\x78\xd4\x72\x9a\xda\xbf\xae\xa1\xb6\x2a\x8d\x7f\xa5\xca\x42\xcf\x8a\x9c\x25\x11\x81\xd3\xc2\xc6\x2c\xea\xe3\x34\x95\x5f\x3a\x64\x5d\x15\xae\x8a\xdf\xa2\xcc\xb3\x84\x5e\x2d\x11\x35\x5c\xaf\xb4\x04\x38\x4b\xe2\x65\xa1\xae\xc7\x37\x9b\x17\x38\xc9\x14\x47\x51\x40\x55\xb7\x51\xcb\xc5\x9c\xe5\xf2\x1f\x5d\xdc\xe4\xf9\x1b\x55\x16\x45\x3b\xf7\x82\x4f\x12\xf9\x4f\x63\xfe\x1e\x71\x35\xc6\x68\xfb\xb0\xe4\x39\xbe\xd8\xcf\x9b\x91\xcb\x40\x2f\xb9\xd9\x6b\xd3\xa7\x64\xdb\xd9\x35\x70\x08\x38\x5f\x20\x62\xef\x4d\xdf\xf9\x43\xd2\x3d\xa3\xc7\x07\x1c\x65\x67\x16\xbe\x6a\x9b\xf0\xde\x90\x7d\xec\x49\x2d\xf2\x02\x49\x20\x9a\x34\x80\x5e\x9f\xd5\x25\x0e\x68\x4f\xa9\x8a\xa3\x00\x89\xbd\x8e\xb0\x7c\x0d\xf7\x19\xd8\x37\xae\xe2\xf8\x7d\x47\xe9\xc1\x84\x24\x87\xe7\xde\xc3\x2e\x6d\xaf\xa6\xfb\xd7\x24\xd9\xa3\xaf\xdc\x73\x33\xda\x80\xb0\xc8\x5b\x7e\xdb\x84\x46\x3e\x5f\xc3\x5b\x71\x5b\x0c\x90\xd6\xe2\xce\x35\x16\x03\xe4\x68\x78\xcb\xea\x15\xc4\x67\x67\xa4\xae\x21\x3f\xaf\x7a\xf1\xbd\xca\xa2\x10\x8e\xef\x59\x16\x87\x70\x40\xef\xb2\x24\x08\xe4\x7b\x98\xa5\x21\x60\x86\x7e\xbe\x2c\x0b\xc1\x8b\x15\x0e\x88\x9d\x87\xe0\x02\x35\x84\xac\x08\xc1\xe3\x04\x96\xbe\x3c\xc2\x23\x6a\x17\x41\x82\x02\x36\xb0\xac\x0a\xbe\x59\xca\xc2\x5e\x16\xa8\x2b\x0c\xe8\x70\x1d\xc2\x01\x8a\x56\x21\x1c\xa2\x20\x1d\x04\x02\x8a\x71\x87\xeb\x74\xa9\x81\xa6\xdc\xb1\x3a\x5d\x6a\x5e\x1b\xde\x56\xd2\x74\xa9\xd9\x6a\x6d\xfe\x26\xd2\x74\xa9\x79\xcd\x79\x9b\x47\xd3\xa5\xe6\x4b\xea\xf9\x7b\x46\x04\xe1\xfb\xee\x15\xc4\x26\x08\xaf\x60\xaf\xf8\x35\xbd\xed\x0b\xb4\xe2\x0e\x37\x82\x00\x2a\x76\x47\x98\x55\xb1\xe4\xf3\x06\xbd\x2d\x1f\x6a\x07\x50\xb3\x3b\x8e\x48\x01\xd1\x1d\xe9\x90\x3b\x8c\xa8\x21\x44\xd5\xee\x10\xb2\x18\x40\xd7\x2a\x10\x8b\xc2\xd7\xa7\x73\x1d\x40\x9f\xb8\x3c\x9d\x9b\x00\x1e\xbf\x3a\x9d\x37\x01\xf8\x89\x8b\xd3\x45\x14\xc0\x3f\x7d\x25\xc8\x11\x07\x38\x9e\xee\x04\xe4\xf0\x46\xa2\x19\x3b\x20\x80\xf2\x77\x6d\xd6\x2b\xfb\x8f\xe6\xd9\x3d\x1f\x11\x15\xd9\x2e\x9a\xdd\xee\x11\xfe\x5e\xcd\x03\x07\xdf\xa4\xf6\x77\x0d\x6c\x2a\xe5\xfc\x4f\x3b\xae\x6b\x7c\x36\x04\xf6\x37\x09\x2c\xda\x02\xd1\x17\xa5\xbc\x97\x7f\x6f\xfd\xf9\xe6\xcd\x3b\xfe\x9c\x00\x21\xab\x65\x37\xc8\x44\x54\x46\xbb\x9f\x0c\x41\x87\xec\x14\x4a\x5c\x8c\xca\x24\x00\xb5\xd1\x3a\xb7\x03\x26\x22\xef\xfd\x5f\xda\x7c\x49\x7f\x57\x4c\xc8\xd8\x50\xb1\x08\x34\x0e\xee\x9b\x09\x15\x57\xbb\x3a\xc3\x39\x64\x40\x02\x70\xc3\x4d\xa8\xb8\xde\x95\x00\xe7\x08\x39\xe9\xc7\x26\x14\xde\x0f\x7d\xc0\x82\xca\x22\xbc\x05\x34\x7d\xd0\x2d\x6d\x69\xe3\xb3\x05\xc3\xd8\x41\x24\xbc\xb3\x39\xba\x41\x21\xed\xb4\xd3\x6c\x16\xb9\x0c\x4f\x42\x86\x49\x25\x32\xd4\x2b\x64\x7b\x56\x28\xaf\xf4\x06\xf5\xe5\x05\x0f\x19\x68\xa2\x42\x02\x6c\x6a\x45\x05\x09\x7e\x9a\xad\x5c\xa7\x5c\xe4\x7d\x5e\x06\x87\x95\xa5\x33\xfb\x74\x58\x89\x0d\xe5\x39\x1b\xfa\x40\x93\x99\x67\xa3\x7f\x57\x12\xf7\x4e\x2e\x8a\x0d\x98\x54\x19\x87\x79\xb6\xad\xdd\xd3\x74\x49\x98\xee\x97\x67\x9d\xfe\x89\x8d\x0d\x98\x54\x99\x86\xd9\xde\x84\x3b\x41\xaa\xeb\x3a\x4c\x4a\x41\xd5\x74\x01\x3a\xa9\x6b\x15\x66\xd8\xc4\x3a\x41\xa4\xc3\x44\xc4\xb0\x46\x69\xd3\x05\xea\x92\x09\xf3\x38\x02\x9d\xa0\x4b\x9b\x28\x4c\x47\xe5\x5c\xe7\x74\x51\x77\xfd\xcd\x97\x85\x4f\x9b\x1d\xcb\xa2\xc0\xf7\x0c\x4d\x02\xd0\xb0\x77\x21\xd3\x26\x05\x68\xd8\x55\x5c\xee\x6d\xab\x6d\x89\xb1\x57\x60\x5b\x2c\x76\x7b\x31\x99\xa6\x33\xca\xa6\xf7\x42\xd8\x34\xe0\x2d\x9e\xe7\x40\x2c\x3a\x0b\xa0\x1f\x8e\x0a\x67\xc9\x8f\x64\x58\x9f\x6d\xfb\x34\x77\xf6\x96\x42\x1e\x17\x47\x44\xbd\xb9\x48\x94\xa8\x3c\x22\x7a\x4a\xc4\x4e\x98\x79\x2c\x8e\x88\x9e\x12\x01\x44\xd5\x11\xd1\x53\xa2\xff\x78\x22\x79\x44\xf4\x94\x08\x20\xda\x8f\x6d\x9e\x74\x2c\x87\xeb\xec\x68\x0e\x7e\x22\xcf\x84\x89\xf9\xeb\x7c\x45\x69\x5f\x3c\xe8\xc5\x85\x58\x10\x50\x92\x3a\x2f\x93\x00\x10\x7a\xd0\x3c\xf7\xe6\x1c\x6a\x0b\x7a\xe2\x35\x2f\xdd\x61\x26\xf5\xd3\xf7\xb2\x38\x77\x60\xd1\xb2\x74\x1a\x87\x0e\x78\xc8\x2c\x2f\x8b\xd0\x2b\xfd\x2c\xca\x1d\x35\xe3\xd4\x5e\x7f\x33\xc0\x59\xa8\x3b\x4e\xc6\xc9\x7c\xb6\xc3\x8d\x0d\x24\xfd\xda\x30\x6b\xad\x86\x08\x79\xd2\xde\x2f\x0c\xb3\x02\x63\x0c\xe8\x7e\xfb\x15\x98\x60\x40\x11\x10\x15\x33\x1a\x51\x05\x84\x05\xa1\x32\x20\x2e\x06\xf5\xf2\xd7\xed\x0b\x5a\xbd\xc6\x94\x5b\xc5\xef\x48\x4c\xbb\x55\xf2\x8e\xc4\xd4\x5b\x89\x77\x24\xaa\xdf\xaa\x7a\xc7\xa2\x0a\xae\xe4\x3b\x16\xd4\x70\xe9\x1d\xc6\xe8\x61\x49\x91\x9e\x96\x59\x1c\x40\xb1\x8d\x65\x4d\x68\x55\x40\x1e\x57\xf6\xed\x3c\x2c\xd3\x30\xb2\x73\x50\xf6\xb6\xfa\xfb\x13\x4f\x95\x16\x45\x40\x16\xfb\xb0\x3a\x5a\xdc\xaa\x4a\xbd\x1c\x9d\xf5\x1e\xaa\xd1\xe8\x4d\x51\xed\x4d\x18\xa4\xbf\x34\xb9\xb5\x3c\xca\x55\xfd\x74\xa9\x31\x90\x6b\xd3\xd6\x30\x30\x58\x1a\x3a\xa0\xc0\xa0\xee\x6c\xf1\x5c\xc6\x60\xd0\x3c\x04\x3d\x23\xb6\xfb\x69\x49\xb1\x71\x81\xa0\xdc\x8f\x49\xbb\xe4\x10\x48\xbc\x1f\x9c\x22\xb0\x2a\xa4\x58\x0c\x2a\x43\xda\xc1\xa0\x75\x08\x7a\x46\x6c\x37\x52\x22\xc5\x0a\x04\xe4\x2e\x01\xe9\x58\x08\xc1\xb8\x8e\xc2\xca\x07\xa1\x9a\x90\x56\x11\xa4\x88\x42\x9a\x81\x90\x71\x08\x89\xcb\xec\xcd\xe5\xa4\xd2\x34\x01\x40\x2f\x11\x1c\x84\xf1\xc2\x37\x12\x0f\x42\xe5\x61\x0f\x00\x20\x8b\x90\x62\x20\x64\x19\x42\x9e\x90\xd9\x1d\x93\xa4\xd2\xb8\x00\x40\xee\x88\xb4\xa3\x1f\xc0\xc8\xf7\xc1\x0f\xa0\xea\x90\x4a\x21\xa4\x0a\x29\x06\x42\xea\x10\xf2\x84\xcc\xee\x78\x24\x95\x0a\x00\xe3\x8e\x46\x1a\xf7\x3c\xc4\x0b\xf7\xac\x70\x08\x28\x0e\xe9\x13\x01\x26\x21\xa5\x20\xc0\x34\x04\xdc\xc6\x3c\x00\x77\x47\x22\x6d\x84\x81\x95\xb5\xb5\x77\x8f\x6f\xdd\xee\x82\xa1\xee\x68\xb4\xc7\x9b\x30\xd2\x1d\x8d\xb6\x8b\x78\xa3\x2f\x03\x11\x07\xbe\x0c\x46\x89\x23\xdd\x21\x59\x5f\x26\x1c\xe8\x8e\xca\xfa\x32\x9d\x68\x32\x38\x2a\x71\xf8\xc1\xd0\xc4\x49\x5e\xc7\xe7\x13\x89\x14\x69\xcd\x1a\xbd\x13\x53\x77\x83\x86\x2a\x4a\xe4\x51\x14\x88\xa6\xb7\xca\x2e\xe0\x2d\xc2\x3c\x8a\x03\x1c\xa8\x04\xa5\x97\xe4\x75\x6d\xa7\x69\x98\xb0\x5a\x16\x65\x96\xee\x21\x97\xe1\x6e\x34\x1f\xc9\x57\x71\x72\xc0\x50\x0f\x13\xf0\xc0\x97\x7f\x69\xe0\xd1\xfb\x71\x6a\x87\x09\xc9\x79\x2a\xca\x00\x7a\x32\x94\x48\xc9\xef\x99\x44\x85\x08\xa0\x63\xfd\xdc\x44\x65\xf1\x55\x00\x9f\x9c\xc0\xcb\x00\x3e\x85\xf1\xb9\x57\xe2\x7c\x34\xd3\x72\x9b\xf8\xd5\xba\x6c\x02\x8d\x62\x95\x20\x94\xb7\x6a\x46\x9e\x90\x53\xde\x8a\x79\x46\xb2\xa3\x94\x67\xd1\x04\xd9\x9c\x02\x8b\x73\xed\x99\x70\x68\xca\xa0\xf2\x12\x16\xe7\x57\x67\xc4\xa2\xf3\x20\x1a\x6f\xbd\x08\xe2\x79\x5c\x19\xc4\x01\x0d\x8a\xf0\x89\x09\xf6\x41\xab\x03\x30\xa8\x30\xb9\x43\x71\xa2\xef\x35\x4b\x01\xca\xa2\x76\x88\x4e\x1d\x89\xa9\x4c\x1f\xd0\x6c\x79\x51\x38\x99\x81\xc9\xd8\xe3\x31\x95\x35\x30\x19\x7b\x48\xd6\xd4\x6e\x37\xe7\x89\x35\xb5\xa6\x76\xbb\x32\x4f\x17\x16\x21\x33\x11\x70\x4f\x56\x58\x6d\x14\x30\xa9\x4b\xcf\x40\x51\x8c\x6b\x91\x36\x23\x0a\x03\x2a\xef\x62\xf7\x74\xa9\xab\xbb\x61\xb5\xa8\x52\xb3\x77\xd7\x2a\xbf\x57\xf6\x0f\x54\x16\x33\xe4\xc7\xb7\x9b\x11\xc0\xc1\x4d\x15\x79\x77\x11\x36\x4b\xa0\x79\xe0\x18\xe4\xfa\xf3\xdf\xc1\x06\x00\x93\x20\x10\x6e\x37\x0d\xad\x76\x10\xa0\xeb\xdb\x37\x8f\x0c\xa0\xf2\x90\xb4\x10\xb2\x08\x23\xf1\xa6\xcb\x50\x4f\x21\xa4\x78\x5f\x97\x23\xb0\x2a\x24\x31\x06\x95\x3b\xd0\x13\xad\xd7\xa1\xee\x02\x50\xd1\x78\xcb\x66\x5a\x50\x02\x10\xd7\x7c\xa7\x0b\x82\x70\xed\x96\xdc\x10\x00\x71\x6d\xd5\xae\x4a\x38\x4c\x15\x27\xa1\xd1\xdc\x5e\xaf\xb7\xf5\xad\x1c\x2c\x77\x48\x78\xc6\x4e\x2d\xa7\xfc\x93\x2e\xc2\xcb\x00\xb0\xbb\x6a\x00\xc6\xb5\xf2\x6d\x87\x0a\x40\x89\x90\xb9\x40\xc8\xea\x68\x6f\x0c\xc0\xbb\x86\x4a\x8a\x89\x0b\x00\xe4\x9a\xa6\xdd\x1b\x03\x30\xea\x7d\x0c\x02\x28\x13\xea\x1e\x84\x6c\x42\x48\xb4\xe5\x2a\xf5\x4a\xb7\xd3\xfa\x9f\x9d\xa1\x13\xaf\xd4\x1e\x1f\x03\x24\x5e\xae\x1f\x10\x02\x24\xde\x9b\x56\x13\xf0\x79\x13\xaf\xb8\xd5\x74\x41\x20\x5e\xe2\xdf\x04\xa8\x3a\xf1\x86\xe8\x74\x01\x20\x26\x4b\x02\xa3\x7a\xfe\x6a\xff\xfb\xaf\x33\x77\x76\x6d\x6c\xb2\xf4\x00\xce\x2e\x0f\x4d\x96\x1d\xc0\xd9\x48\xdf\x64\xf9\x01\x9c\x8d\xac\x4d\x56\x1c\xc0\x91\x63\x53\x51\xbb\x0a\x5f\x5a\xaa\xf5\xd3\xc9\x71\xe6\x73\x2f\x94\x30\xcc\x3d\x58\x2c\x39\x54\x64\x10\xcd\xbc\x48\xbe\x86\xbd\x12\x39\x46\x06\xe4\xdc\x28\xd1\x40\x5c\xe0\x3e\x82\x12\xae\xd3\xa2\x39\x78\xea\x8c\xfc\xa4\x23\x5d\x7b\x4f\x9e\xdd\x84\x52\x42\x87\xca\x59\xc1\x68\x37\xe2\xa1\xd4\x95\x96\xb2\xa0\xe6\xad\xfe\xe0\x82\x7c\x6f\x71\xac\x0f\x87\xf4\x6b\x6a\x97\xc5\xf4\x3c\xa5\xe7\xe5\xb6\xca\x9d\xbf\x75\xb5\x78\xbc\x3b\xe3\xd0\x19\x7d\x40\x2c\x57\xd5\x78\x31\x7b\x25\x6a\x9e\xfa\xfc\x47\x88\x4e\x90\x9e\x11\xd6\xf3\x61\xef\x9c\xdb\x1b\xf1\x3c\x51\x89\xd9\x3c\xb2\xf1\xab\xca\xe2\x98\xeb\xf1\x70\x3d\xe9\xa1\x33\xfd\x05\xb9\xaa\x91\x05\xca\x4c\x20\x79\xfd\x51\x99\xbf\x67\x9e\x82\x48\xb7\x1b\x78\xb5\x4f\x11\x79\x67\xfe\x6b\x61\x79\x10\x28\x02\x4e\x5d\x0d\xc3\x04\x5e\x62\x88\xbc\x0c\x00\xbb\xc9\xdc\x74\xf2\x82\x82\xe5\x9e\x8e\x11\xaf\x19\x95\xf5\x1e\x1c\x29\xfd\x1a\x95\x6a\x0f\x8e\x55\x7c\x8d\x4a\xbd\x47\x00\x3d\x45\x1f\x95\x66\xcf\x50\x30\xf9\x9b\x3d\x38\x28\xbf\x88\xf6\x08\x30\xf9\x45\xbc\x63\xae\xd0\xd7\x13\xc9\x0e\x1a\xea\xbc\x48\x77\xd0\x68\xdf\xb3\x1d\x3c\xd8\xf5\x7c\x67\xc0\x61\xc2\x17\x3b\x68\x54\xf8\xbd\xe1\x0e\x0a\xcf\x0d\x7a\xec\xfb\x55\x0c\x0b\xa6\x0a\xc9\xb0\xa0\x2a\xa9\x19\x1e\x50\x35\xea\xc0\x9d\xa1\xa2\xe8\x03\x0e\x50\x0c\xb3\xe7\x59\xc0\x12\xd2\x91\x68\xf6\xc6\x36\xca\x50\x45\x3b\x23\x04\x26\x88\x77\xac\x14\x26\x48\x98\x6f\x0a\x13\xa5\x07\x5f\x04\x24\x69\x1a\xd7\x34\xf4\x70\xa3\x6d\x9c\x4f\xc3\x5f\xd2\x69\x1a\x1d\x04\xb2\x5b\xfd\x4d\x63\x82\x40\xf6\x42\x7e\x93\x15\xef\xc0\xab\x5c\x78\x51\xb3\x32\x08\xe4\x45\xcd\x44\x10\x08\x88\x5a\x05\x81\xc9\xff\x03\xda\x94\x3b\x50\xa0\xd5\x3a\x08\x4d\xff\x1f\xa0\xa2\x80\x19\x90\x8a\x90\x56\x03\x86\x40\x4a\x42\x5a\x0d\x98\x82\x85\xb2\x6a\x2a\x45\xf4\xee\x4b\xb6\x52\x60\x2c\x34\xde\x85\x82\x4b\xd1\xd2\x9b\xe5\x87\x71\xf3\x03\x2c\xca\x1d\xb0\xc3\x76\x93\x86\xf5\xbc\xa5\x37\xa9\x0f\xe3\x9a\xd1\xcd\x82\xdc\xa9\x7c\x05\xb1\x9f\xb2\x14\xee\x08\x1b\x46\x2a\xcd\xc7\x63\xdc\xc1\x35\x8c\xf6\xee\x09\x8b\x71\xc7\x15\xb5\x03\x81\xdc\x31\x35\x8c\x54\x81\x8c\x7d\x24\xa8\xf4\x26\x62\xdb\x12\xf0\x99\xdc\x61\x34\x8c\xc8\xc3\x39\xa5\xb7\x37\x31\x8c\x77\x7a\xab\x85\x4f\x4d\x29\x85\x7e\x87\x75\xec\x44\x5a\x7a\x1b\x47\xc3\xb8\x65\xb3\xb0\x28\x77\xf2\x24\x05\x76\x03\x7f\xed\xa6\xf4\x26\xcc\x61\xbc\x4f\xc3\xad\xe7\x3f\xd6\xcb\x05\x8f\xbb\xf9\x1e\xef\xb5\x9c\x0d\xff\x68\x5b\xe9\x1d\x65\x0c\xe3\xbd\x1b\x2e\x30\xf4\x65\x80\x8d\xc3\x17\xe0\x0f\xaa\x97\xf1\x35\x19\xd5\xf2\xda\xac\xf2\x10\x6a\xfe\x97\xdf\x63\x2b\xbd\xa7\x8f\xc9\x58\x6e\xec\x36\x67\xe9\x3d\x78\x4c\x9f\x7c\x1a\xe6\xf9\x3e\x4e\x03\x5d\x89\xe0\xd1\xaf\x03\x8e\x6a\x6e\x8e\xdd\x0f\xf8\xa6\x54\xf9\x72\xff\xe5\x7e\x1d\xd8\x93\xdf\xd2\xbf\xf7\x62\xcb\xa8\x3f\xbc\x2d\x0b\xf5\x46\xe0\x0a\xb5\xab\x19\xb6\x02\x51\x59\xa9\x1d\x28\x9b\xd0\x50\x56\xfa\x1d\xea\xc5\x67\x40\xeb\x86\xa1\x00\xa4\x68\x18\x0a\x7e\x18\xc8\x88\xa1\xe0\xa7\x0f\x19\x33\x14\xfc\x64\x22\x13\x86\x82\x4d\x41\x28\x65\xca\x50\xb0\xf5\x8b\x4a\x99\x31\x14\x25\x4f\x91\x33\x14\xec\x59\x51\x29\x0b\x86\xa2\xe2\x29\x4a\x86\x82\x2f\xcf\x55\x4a\xc1\x71\xf0\xf6\x29\x2b\x8e\x03\x30\x50\xc9\x71\x00\x16\x5a\x73\x1c\x80\x89\x2a\x8e\x03\xb0\x51\xce\x6b\xf0\x05\xcd\x4a\x69\x38\x0e\xc0\x4a\x39\xbf\x11\xf3\x66\x5a\x73\x8e\x23\xe6\xed\xb4\xe6\x3c\x47\xc2\xdb\x69\xcd\xb9\x8e\x84\xb7\xd3\x9a\xf3\x1d\x09\x6f\xa7\x35\xe7\x3c\x12\xde\x4e\x6b\xce\x7b\x24\xbc\x9d\xd6\x9c\xfb\x48\x78\x3b\xad\x39\xff\x91\xf0\x76\x5a\x73\xfe\x23\xe1\xed\xb4\xe6\xfc\x47\x02\xd8\x29\xe7\x3f\x12\xc0\x4e\x39\xff\x91\x02\x76\xca\xf9\x8f\x14\xb0\xd3\x80\xff\x68\x06\x7e\x09\xea\xe7\x2c\x2a\x49\x0f\xf2\xb0\x18\xd7\x47\x7c\x1a\xe4\x39\xcb\x52\xb9\x3e\x01\x0c\x17\x95\xeb\x03\x3e\xe5\xd4\x02\xa5\x01\x4a\xe5\x0e\xfa\xb6\x87\x61\xee\x38\xa7\xe7\x0f\xf1\x6a\x04\xa5\x72\xc7\xf7\x8a\x65\x21\xee\x70\xa6\x83\x37\x7f\x03\xa1\xed\xe7\x65\xba\x29\xe4\x09\x46\x51\xaa\xe2\x90\x6b\xd3\x00\xc0\x53\x1e\xf2\xb4\x3d\xce\x24\x0e\x99\x7c\xf5\x02\x74\x15\x4f\x07\xb0\xb8\xa3\x9d\x58\x86\x71\x69\xaf\xed\x7f\x46\xff\x9f\x94\x5f\x83\xac\xb8\xf2\x14\xc8\x78\x5a\x8d\x1a\x24\x3e\xf3\xa5\x0d\xc8\x89\x7e\x24\xdf\xb5\xfc\xf9\xb3\xe8\x68\x97\x07\xee\x9b\x8e\x77\x39\x4e\x68\x48\x27\xbb\x2c\x67\x3f\xa0\x4e\x8f\xa9\x00\x86\x6c\x97\xe1\xf7\xc3\xb1\x24\xae\xef\xe2\xbd\xb8\x76\xdd\x13\x7b\xec\x5d\x6a\xd7\x0b\xb1\xb5\x81\x4a\xed\xba\x9a\x2f\xfe\xe7\xae\x2b\x79\x96\x20\x02\x3a\x21\x43\x38\xa0\x37\x75\x08\x07\x74\x4b\x85\x70\x40\xff\xdc\x31\xfe\x9f\x99\x06\x1e\xe1\x8e\xe0\x81\xaf\x66\x54\xea\x26\x24\x1a\x82\x34\xee\xa0\x5c\xcf\xe4\xad\xfb\x9d\x64\x0f\x6c\x72\x1a\x77\x38\x36\xb7\xae\x43\x71\x49\xe0\x65\xde\xcd\xf6\x59\xac\x3b\xe2\xae\x9f\x23\x1a\xb9\x98\x2c\x10\xb9\x40\x8f\x26\x94\x26\x0f\x40\xb5\x5c\x24\x72\x62\x5c\x9a\x22\x80\x3e\x73\x40\x5b\x9a\x72\x97\x01\x93\x40\xec\xe2\x59\x68\xb5\x0b\x05\xcf\x21\x4b\xe3\x8e\xd3\xb6\x3f\xa9\xfa\x3a\x08\xc6\x95\xef\x0e\x5a\xdf\xb9\xa3\x12\xe8\x7d\x06\x50\x8c\xa8\xf6\xb6\xbb\x28\x43\xac\xfb\x6c\xcd\x57\x74\x9f\x17\xca\xf6\x82\x6a\xf1\xe6\x51\x12\xe2\x88\x4f\x70\x44\xb5\x2c\x42\x1c\x11\x38\x86\xf2\x28\x08\x8f\x61\xb8\x67\x4c\xeb\x70\x5f\xab\xf7\xf0\x27\x73\x71\x19\x45\xa1\xc6\xd9\xa5\x5c\x1e\xc9\x10\x8e\x5d\xbe\xe5\x51\xbd\xef\xa4\xbe\xa0\x7a\xcf\x79\xe4\x5a\x9f\x0f\x85\x86\x5f\x1e\x69\x86\x00\xc9\x7c\xc9\x23\xc3\xb0\x20\x43\x29\x8f\x1a\x86\x05\xf3\x66\x79\x1c\x31\x3c\x68\x82\x83\x47\xd4\xf6\xaa\xbb\xcd\xed\x27\xdb\x7e\xe3\xdd\x20\x33\xdf\x30\xcc\x1d\x7e\x5f\x6d\xaf\x87\x2f\xfb\x2a\x9d\xb4\xaf\xee\xb2\xe8\xf4\x08\x8d\xe4\x8c\x36\xde\xdd\x1a\x8a\xeb\x5f\x69\xd8\x58\xb3\xf1\x6e\xda\xf4\xb7\xeb\x69\x8a\x2a\xf2\xa6\xfc\xf9\xa7\x57\xf7\xef\x38\x86\xde\xc3\x16\xb1\xff\x4a\xea\xa5\xb7\x85\x8d\xd7\x5b\xfa\x1f\xe3\x31\xd2\x6d\xf5\xe9\x7e\x41\xac\xab\xfa\xc9\x8c\x5d\xab\x28\x85\x18\x04\xbb\x4a\x57\x43\xff\x39\x74\x37\x5a\x85\x3e\xe0\xf6\xec\x8a\x25\x71\xd5\xbe\x16\x8c\x5f\x4b\x3f\x7e\xb3\xc8\x62\x07\xf9\xc3\x22\xdd\x98\x61\x45\xda\x9c\x65\x5b\x01\x92\x6f\x57\x1c\xa0\xf9\xb6\x5d\x3f\xbf\xa2\xa7\x61\x21\xa8\x35\x32\x16\x2e\xf7\xe0\xc3\xd4\x5e\xda\x1e\x50\x5b\xcd\x30\xf0\x5d\x70\x3d\xf7\xca\x70\x95\x97\x47\x65\x0d\x0e\xac\xdf\xc1\x6d\x8f\x82\x5d\x67\xfd\x7c\x99\xb1\x55\x0f\x1f\xc9\xc1\x5d\x2f\xbd\x02\x19\x84\xf7\x14\xc6\xa3\x86\x1e\x87\x89\xc3\xf6\x61\xb3\xd8\x13\xcd\xc2\x5d\x5f\x3a\x0e\xf3\xf2\xc6\xb1\x8e\xab\xf5\xee\x21\x47\xe6\x8e\xef\xb5\x14\xed\xff\x99\xd2\x1d\xb0\x83\xb2\x53\xc4\xd0\xaf\xef\xa4\x71\xd0\x62\x1f\x3a\x99\xf9\xd6\xf1\x0c\xee\xc8\x7d\xec\x39\x77\x8f\x28\xc9\x4e\x15\x1c\x83\x08\x30\x58\x9d\x3c\x1f\x78\xe7\x18\xaa\x10\xc3\x64\x20\x82\x38\x4a\xfd\x57\x8c\xd4\xad\x7b\xce\xf4\x6d\x7d\x74\x0f\x35\xae\x12\xef\x55\xdb\xf5\xde\xcb\x56\xe1\x78\xe8\xef\xb7\x5e\x75\xed\x38\x1a\x7d\xc8\x43\x02\xf8\x56\xdd\x75\x14\x26\x2f\xad\xb2\x21\x3b\x0f\x76\xcd\x7b\x03\x6e\xf2\xaf\xa1\xce\x11\x01\xd5\x04\xf7\x4a\x4c\xae\x45\x71\x68\x51\x6d\x46\x23\x17\xb6\x75\x0f\xec\x35\xdb\xb5\x33\x00\x8f\xf7\x32\x69\x31\x78\xb2\x93\x46\x8b\xa1\xd3\x9d\x1c\x5a\x0c\x9d\x05\xcc\xce\x4d\xa0\xc5\x58\xf2\x83\xec\x59\x8c\xc1\x1d\xc4\x74\x64\x63\x65\x68\x7b\x3b\x85\x9d\xa0\x71\x47\xf2\x6c\xd4\xd0\xeb\xdf\xa2\xc8\x27\xc4\x09\x65\x44\x3a\xf0\x2d\xee\x66\x59\x8e\xed\x02\x65\x39\x34\x0f\x94\xe4\xd0\x4a\x50\x12\xc8\x58\x50\x32\xd6\x66\x50\x22\xcc\x74\x50\x36\xd0\x82\x00\x3a\x5a\x4e\x7a\x4e\x91\x0a\x16\x5c\xdb\x7e\x4b\x31\x6c\xfb\xcb\x74\x8c\x75\xcd\x67\x2d\x82\x77\x06\xed\x9a\x8d\xad\x83\x77\x06\xec\x9a\x8b\xbd\x59\x7e\xaa\x69\xd7\x4e\x6c\xa7\xe5\x37\x8c\xcd\xdf\x3b\x7d\x02\xed\xda\xc2\xda\xe9\x13\xe0\xf2\xbd\xd3\x27\xd0\x5e\x00\x6f\xaf\x79\xd2\xcb\x62\x93\x91\x9a\x05\xa7\xde\xcd\x81\xba\x93\xea\x9f\x8f\x81\xee\x4c\x9b\x9e\x96\x3c\xb4\x38\xef\x8e\xf1\x6e\x08\x4b\xab\x35\x33\x7d\xae\x8f\x2f\x3c\xee\xb1\x4a\x3b\x9b\x03\x4c\x4d\x60\x8c\x5f\xcd\x75\x98\x7e\xee\x9d\xfc\x19\x6e\x0b\x27\x8e\x83\xdf\x00\xda\x34\xf2\xd6\x71\xc0\xf8\x1d\xd8\xb5\x3d\x3d\xb6\x7b\x8c\x4b\x76\x71\x6a\xbc\xdd\x95\x54\x1f\x46\x33\x14\xb6\xe3\xae\xd1\xd2\x4d\xda\xae\x33\x9b\xbb\x9e\x11\x78\xbe\x37\x63\x3c\xb9\xb6\x5d\x16\x88\xad\xd8\x9b\x39\xfe\xc4\x56\xee\xcc\x20\x7f\x22\x13\xcc\x24\x70\x9e\x74\x7b\xd4\xdc\xb5\x9c\xd1\x4c\xcd\xbf\x37\x33\xd1\x7e\x9b\x5d\xc1\x52\x69\x45\xf3\xbd\xa0\x5c\x71\x90\xeb\xd2\x0d\xb5\xec\x60\x2e\x91\x36\x75\x90\xe7\x4b\xb6\x10\x5a\x06\xd1\x4d\x77\x9b\x3f\x10\x78\x15\x84\xeb\xa1\x1f\x16\x90\xa4\xca\x9a\x28\x48\xf2\x78\xd2\xdb\x7c\xd2\x1e\x2b\x40\x13\x1f\xd2\xe8\xdb\xb4\x3a\x18\xb2\x55\x84\x2e\xc1\xe8\x26\xf9\x85\xb0\xa5\x87\x6c\xcb\xc7\x34\xdc\x2e\x1f\x23\xef\xba\x88\x2b\x3b\xe4\x02\x05\xca\x0f\x49\xa8\xb0\xc5\xbc\xac\x13\x31\x4b\x25\x0e\xa9\xec\xe2\xea\xd6\xf6\x4b\x9a\x20\x64\x15\x46\x56\x64\x08\x99\xe4\xc9\x9a\x6e\x90\x90\xd6\x6b\x9e\x6b\xbb\xd3\x04\x90\x29\x9e\xac\x1e\x86\x0e\x53\x99\x0e\x92\xad\x7f\xf7\xf2\x6a\x1e\xd5\x02\x68\x7f\x18\xa0\x33\x41\xba\x87\x6c\x7f\x20\x6c\x0e\x09\xb5\x99\xd5\x29\x42\xbf\xbe\xed\x2f\xe1\x65\xb4\xf5\x5f\x28\x20\xd1\x0f\x76\x60\xba\x28\xf3\x2a\x30\x5d\xc4\x7a\x3b\x43\xbb\x9a\x59\x7e\x1f\xe3\x65\x00\x9f\x9c\xc0\xef\x3f\x9b\x74\x46\x0a\xb5\xcb\x72\x46\x16\xd7\x96\xde\xa4\x78\xdc\x97\x03\x78\x4c\x80\x27\x39\xcd\x53\x7b\x6f\xdf\x35\x93\xbc\x9a\xad\xa2\x46\xd3\xb5\xe3\xfd\xdb\x32\x1c\x13\xd4\x47\x04\x3f\x00\x81\xde\x21\x98\xbf\xe4\x78\xff\xe6\x19\x4a\x6f\xdf\x7c\x7d\x1f\xb6\xa7\xf0\x0b\x40\x7a\x23\x67\x1a\x2e\x93\xbc\x92\xf2\x68\xb9\xb7\x15\x5f\xe3\x38\x6a\x2f\x89\x77\x69\xbb\x47\xbc\x7d\x5f\xcf\x2a\x9a\xf6\xdb\x68\x56\x92\xba\xae\x0e\x59\xda\x5e\x4d\x46\x52\x1c\x04\x7d\x13\x89\x92\xf1\xda\xcd\x6b\x19\xaa\x5b\x39\x7f\x0c\xd3\x72\x17\x77\x01\x10\xd4\xc7\x04\x54\xf6\x92\x23\x29\x73\x37\x7e\xfd\x51\xb5\x9a\x38\x4c\xe5\xbf\xe9\x7f\x19\x6f\x8f\x35\x4c\xdb\x37\xc3\x5d\x1b\x6d\x8f\xa4\xf4\xfd\xb3\xd5\x57\x73\xbd\xf7\x9f\x47\x23\xa5\xf2\x1f\xf8\x7f\x65\x5b\x86\x85\xc2\xf3\x4f\xd9\x76\x76\xab\x7b\xfb\x3f\x96\xb3\x3a\xe0\x7c\x9c\x7e\xff\xb2\xa2\x92\xca\x03\x56\xf3\xd9\xda\xd4\xc1\xad\xc6\x00\xcb\x55\x73\x5c\x46\x83\xbd\xcd\x3d\xb9\x6e\x63\x37\x48\xbd\x52\xca\xf9\x1f\x06\x1c\x09\x6f\x4e\xea\x08\xf6\xac\x4b\x44\x8f\xb5\xd2\x41\x46\xdd\xb2\xfd\x49\x3c\x6b\xa6\xd9\xd0\x52\x5d\xc6\xdb\xcc\x41\x53\xef\x62\xf6\xba\x37\xb0\x0c\x77\x35\x6c\x87\x37\xba\x5d\x3e\xcc\xf4\x5c\xe8\xf6\x9f\xc7\x64\x06\x21\x33\xbd\xb5\x26\x8e\xab\x41\xb8\x74\x3b\x03\x64\x49\x0d\x91\xd9\x03\x11\x86\xc9\x2b\xfc\x52\x77\xa6\xd7\x77\x12\xa9\x93\x23\x8b\x74\xbf\xf5\x8a\x1c\x27\xb3\x5d\xb2\x6c\xc9\x79\x4c\xea\x98\x23\xae\x22\x37\xfe\xb6\xdb\x40\x4c\xa3\xde\xfd\x4a\xbb\x42\xae\x6f\x53\xcf\x89\xea\xdd\xe4\xb4\x28\x3d\xe8\x0b\xdb\x98\xb7\x8d\xaa\x86\xfe\x7f\xb4\x4e\xe6\x40\xde\x25\x42\x5a\xc0\xd2\x14\xc1\x81\xbc\x8b\xae\x5a\x4e\xff\x18\xbe\x4f\xae\x6d\xea\x96\xc2\x01\x9b\x69\xc0\xc0\xbc\xfd\x65\xdd\xce\x50\x9f\xbc\x0a\x24\x7a\x5e\xee\x72\x19\x78\xfb\x70\x9d\x12\x81\x5a\xb6\x4f\xde\x9e\xae\xe6\xd5\xe6\xdd\x24\xa7\xdf\x0f\x37\x1e\xe3\xda\x82\xc5\x7c\x9a\x89\x03\xc9\xe8\x3d\x31\x66\x60\xba\x13\x57\x91\x6b\x3f\xeb\x16\x29\xd3\x4e\xe5\xea\xec\x43\x4e\xda\x1e\x6e\x72\x28\xef\x76\x22\xa1\xae\xed\x37\x8b\x71\x3f\xe9\xc7\xbc\x0d\x0b\x16\xe5\x2a\x9c\x50\x1f\xfc\x90\xf5\xee\xd7\x11\xc6\x96\x42\xa5\x63\xcb\x1f\xb6\x39\xd7\xc6\x09\x3a\xcb\xe5\xb9\x81\xc1\xb4\xea\xea\x7e\x8b\x34\x87\xcf\x0b\xdb\x62\xfa\x0e\xa3\xca\xc2\x0c\xcc\xbb\x6a\x6e\xbf\x19\xff\xad\xbd\x1b\xb6\xeb\x06\x2a\xe2\xcb\xbc\xbb\xbd\x2b\x0c\x72\x66\xde\x55\xda\x15\x07\x19\x97\x77\xa5\xf0\xda\xf6\xb7\x79\xdd\x68\x37\x9a\x43\x56\xae\x89\xad\x48\x0e\xe1\xf6\x6d\x9b\x49\x58\x23\xf1\x0b\x0b\xd8\xb9\x8b\x37\x2c\xf7\x7b\x8d\x6d\x8f\x69\xc2\xdb\x0b\xeb\x1c\x45\xac\xd3\x39\x07\x77\x27\x06\x0f\xce\x00\xbd\xda\x10\x16\x68\x67\x08\xd6\x69\x79\xe5\x28\x2c\xee\x10\x10\x57\x91\xfb\xa9\x27\x40\x30\xd7\x82\x67\x05\x39\x38\x77\x25\x3b\x0f\xcd\x02\x69\xde\x2b\xfd\x31\x4f\x0a\x9b\x7f\xdc\x50\x81\x40\xc0\xfc\xe3\x9a\x12\x1b\xbe\x54\x89\x57\x00\x85\x7e\x8f\xcc\x3f\xae\xe9\x59\x0c\x30\xff\x78\x47\xcb\xb7\x5e\x0d\xd3\x64\x28\x5d\x8d\xfd\x44\x5e\x56\xf3\x67\xfb\xd9\x22\x33\x4a\xec\x67\x33\x7f\xf3\xf3\x82\x77\x46\xbc\xc6\x82\x52\x7f\xd2\x93\x27\xb4\x07\xf5\x41\xa1\x09\xa7\x98\xd4\xaf\xe1\xd8\x9a\xaf\x91\x16\xaf\xcf\xfc\x96\xaf\x2d\xbd\x8f\x63\xd1\x10\xcb\xf7\x5d\x0d\xa6\x69\x58\x99\x0c\xc4\xf6\x83\xb0\xbd\x1c\x9c\x2c\x66\xa2\x8a\xe0\xb4\x32\x32\xff\xde\x6c\xe8\xa6\x86\xeb\x55\xf6\xcc\x37\x7d\x39\x33\xe9\x87\x11\xc6\xb9\x46\xa4\x69\x83\xdc\x74\xe6\x6a\x2b\xc5\xa2\x0c\xae\x83\xb0\x0c\xdb\xb1\x1b\x8a\xcf\x76\x25\xa0\x63\x7a\xbc\x27\xf9\x8e\x1c\xe7\x58\x8a\x5d\x69\x5a\x9b\x46\xbb\x5a\x2f\xc6\x55\xee\x48\x74\x9e\xc9\xf5\x0f\x9b\x40\x54\x5f\x8a\x1e\x9a\x81\x39\xdc\x80\x70\x2d\xf5\x7c\x5b\xcc\x69\x16\xd7\x81\xde\xfa\x96\xf6\xda\x4e\x73\xb8\x01\xed\xea\x18\xd6\x40\x13\xc5\x7b\x1e\x76\x31\xbd\x6a\x69\x6a\x6a\x60\xbc\xeb\x0e\x28\xea\xb9\x7f\xb5\x7a\xf9\x80\xe1\xee\xf8\x1f\x87\xee\xe7\x32\xf4\xf7\xa1\x69\xa8\x64\x15\x4a\xe1\x46\x41\x6b\xa0\x70\x42\xfe\x38\x0a\x39\x20\x14\xec\x3a\x8a\x59\xb5\xf3\x8c\x2b\xde\x7b\x13\xa6\x99\x86\x7e\xb9\x37\x12\xf5\x51\x55\xe4\x9d\x13\x51\x49\x6a\xb2\xbf\xc7\xde\x2d\x87\x55\x07\x58\xda\x4d\xba\x1a\xda\x26\xdd\x36\xa0\x0f\xc9\x84\x89\x5d\x97\xe5\x1c\x0a\x73\x30\xd7\x53\xad\xb0\x7e\xc0\x90\xae\x6f\x5a\x91\xf5\xcf\x7d\x32\x17\x3b\x61\x20\x04\xc5\x01\x01\x24\x44\x95\x66\x05\x96\x66\xc2\xd1\xb8\x5e\x6d\xbe\xd5\x63\xfb\x4d\xe9\x02\x54\xf5\xcb\xae\x7f\xed\x2b\x88\xdf\xf7\xba\x5d\xb8\xd0\x3e\xcd\x04\x40\xf5\x83\x51\xb9\xde\x8d\x76\x07\xf7\xe8\x10\xb2\xb2\x3a\xd6\xd4\x5d\xb7\x14\x68\xb1\x34\x12\xa3\x59\x2f\x8f\x72\x64\x35\x46\x76\x99\x64\x4f\x29\xcb\xfc\x4a\x3a\xcf\x23\x86\xd2\xee\x15\xda\x34\xe6\x9e\xdd\xfa\xcb\x33\x8d\xb0\x71\x24\x06\x21\x59\x73\xb3\x11\x99\x1a\x88\xee\xd1\xc3\x85\x6e\x4b\xda\x6b\x3d\x87\xbc\x42\x14\xae\x98\xeb\x75\xc4\xc7\x3c\xb4\x0c\xeb\x63\x19\x1c\x43\x73\xc4\x40\x0f\xbb\x30\x7d\x8b\xbc\xfa\x00\x64\xf1\xda\x98\xf1\x9e\xea\xe7\x99\xe2\x3a\xa9\x7d\x18\x60\x31\x15\xe9\x98\x21\x5b\x45\x3c\x64\x11\x5a\xba\x36\xba\x22\x9e\x2f\x0f\xd0\x1b\x2f\x1c\xdc\xf5\xf1\x16\x4e\xa0\x4d\x2d\x82\x45\xbb\xf6\xb7\xa6\x2e\xa4\xc9\xef\xa9\x15\xed\x68\x26\xd9\x76\x68\xc5\x51\xbd\x7f\x9c\x6d\x62\x59\x79\x79\x4b\x16\x45\xd6\xbc\xeb\x82\x76\x06\x38\x9c\x57\x65\xc6\x7c\xca\xee\x9e\x30\x13\xab\x28\xbc\x2a\x33\x16\xb3\x5a\x32\xf9\x01\x04\xee\x4e\xeb\x57\x39\xd2\xc5\x8d\xd9\x74\x1d\x30\x1d\x88\x42\xb9\x73\x29\x81\xd7\xd0\xf2\x7e\xdb\x8e\x2c\x39\x78\x16\x86\x7f\x82\x70\x77\x62\xb5\x3d\xa7\x62\xa8\x24\xb7\xec\xce\x75\xc3\x9d\x1c\x2d\xd1\x23\x0d\xd0\x76\x27\x62\xf1\xe5\x21\x3e\x66\xf1\xe2\x10\x9f\xb0\xf8\xea\x10\x9f\xb2\x78\x79\x88\xcf\x58\x7c\x7d\x88\xcf\x59\xbc\x3a\xc4\x17\x2c\x5e\x1f\xe2\x4b\x16\x6f\x0e\xf1\x9c\xf7\x29\x54\x73\x88\xaf\x38\xbc\x8e\x0e\xf1\x31\x6b\x80\x3a\x3e\x26\x60\x2d\x50\x27\xc7\x04\xac\x09\xea\xf4\x98\x80\xb5\x41\x9d\x1d\x13\xb0\x46\xa8\xf3\x63\x02\xd6\x0a\xbd\x42\x2f\x34\xa7\x9e\xf5\x86\x5e\xe9\x17\x22\x58\x0f\x26\xe4\x26\x0e\xe2\xd3\x8c\x17\x96\xcd\xf2\x3a\x76\xe6\x77\x77\x89\xc3\xc6\xef\xd8\xed\x90\xfe\x18\x97\xec\xe0\xd6\xa2\x0f\x1c\xda\xfd\xf2\x8f\x88\xe1\x91\xc9\x34\x51\xd6\xd9\x84\x2d\xcc\xf2\x2c\x40\xe4\x11\xd8\xfc\x3f\x4a\x1b\xf8\x6d\x80\xe3\xcc\x39\x4e\x8e\xc0\x35\x8a\x55\x37\xd3\x39\x02\xd7\x28\x28\x0e\xf9\x13\x89\x08\xe5\xe2\xfc\x99\xcd\x9d\x2e\xc8\x4e\xdd\x6f\xfe\x35\x4c\x9a\x0b\x81\xfd\x24\x60\xd9\x51\x78\x4d\x0c\xec\xf6\xb3\xf0\x13\x7e\xd7\x9b\xf0\x74\x67\x90\x3b\x8a\x10\x7e\x76\x6f\xb3\xed\x8e\xf6\x1a\x18\x18\x55\x9a\xba\xf3\x4b\xd3\x76\xdd\xef\x25\x7e\xa6\x59\xe1\x5d\x27\x5b\x03\x40\x4e\xbf\x42\xc4\xef\x18\xce\x52\x85\x48\x02\x20\xe6\x98\x51\x08\x91\x86\x50\xec\x02\x44\x64\xef\xb0\x98\x9b\x66\x85\xc8\xdf\x51\x29\x37\x33\x08\x51\xbc\xa3\x2e\x40\x63\x65\x00\x06\xb4\x26\x02\xb0\x1a\x68\xae\x0a\xe1\x80\xf6\x64\x08\x27\x81\x06\xeb\x20\x10\x68\x51\x05\x5c\xda\x4a\xe0\xbc\xf4\xc6\x91\xbc\xaf\x97\x54\x67\x2f\xde\xd8\xbd\x58\xc4\xf1\x0b\x61\x82\x3d\x40\x16\x48\x79\xee\xa9\x6d\xb8\xd0\x4b\x7d\x76\x57\x1c\x43\x7b\xf1\xe6\x0f\x6d\x32\xe8\x56\x76\x2c\xcc\xd5\x9c\xf9\x31\xf7\xb1\x93\xbd\xb9\xcb\x7a\xa6\x42\x15\x4c\xab\x55\xa2\x3d\x9d\x4d\xf2\x42\x27\x03\xbf\x29\x56\xcb\x80\x65\x4c\x78\x05\xcb\xde\x69\x00\x0e\x21\xbc\x4b\xeb\xe4\xc1\x9f\x3c\x8f\x5d\xd1\xb5\xf8\xd2\x73\x6f\x94\x35\x88\x32\x0a\x49\xf5\x60\xe3\xc0\xae\xc3\x23\x71\x1e\x66\x69\x2f\x17\xf1\x6d\x27\x3b\xf0\xb5\xd0\xc1\xad\x67\x77\xed\x84\x28\xd3\x23\xf9\xb1\x68\x41\x78\x57\xaa\x1f\x58\x43\x17\xd2\xed\x39\x12\x4f\xe0\x4d\x52\xd4\x93\x27\xc9\xb7\x51\x7e\x05\x48\x8e\x29\xdf\x61\x52\x34\xdd\x22\x1b\x32\xa2\x29\x76\x28\xda\x06\x24\x28\x77\x08\xba\x61\x18\x41\x0a\x71\x44\xf1\xc8\x65\x3d\xa0\xa8\xd2\xd4\x35\xae\xe7\x30\xb9\x0e\xfa\xb6\x45\xe5\x0b\x9f\x1f\x29\x4c\xe2\xda\xf7\x3a\xc6\xd6\xa8\x87\xf9\x12\x55\x9a\xe8\xf7\xcd\x3a\x10\xe9\x8e\xf4\xc7\x06\x1f\x8a\x75\x97\x94\x57\x9b\x8c\xee\xb7\x3d\xdf\x46\x3a\x7a\x66\x0f\xfc\x53\xef\xb9\xe5\x95\xe9\x55\x16\x9c\x2b\x61\xbe\x05\x87\x4f\x19\xfc\xfa\x2d\x6d\xa1\xae\x43\x2a\xa1\xbc\xed\x46\x2f\xfa\x7d\xf2\x3e\xba\x77\xcc\x64\xe2\x68\x9f\x09\xb6\x13\x61\xbc\xb3\x35\x32\x75\x9b\xae\xb4\x85\xd6\x6e\x5f\x01\x2a\xcf\x21\xfe\x95\x46\x25\xae\x03\xb8\x98\xe1\x6a\x96\xe9\xe7\x39\x02\x39\xf0\xde\xe0\xdf\x8a\x73\xd3\xf2\xbe\x55\x80\x10\x7b\x1e\x60\xcd\xbd\xdf\xd8\xd0\xc8\xe5\x25\xa1\x7c\x34\x93\x4d\x45\xf7\x53\xc9\x0f\xf0\xaf\x99\xe4\xa4\x5b\x45\xe9\xb8\x5b\x2a\xf9\x11\x34\xf7\x2e\xac\x6c\x17\xbc\x9d\x44\xf8\x43\x6c\x26\x0e\xc4\xe6\xb0\x55\x50\xe4\x4d\x91\xd7\x75\xc8\xac\x76\x46\xd3\x3e\x3f\x10\x85\x9f\x88\x7a\x6d\xfb\xdf\xaf\x62\xe8\x14\x6e\x3b\xd0\xe6\x48\xf6\xe6\xa8\x53\x24\xae\x91\x3e\x08\xb6\x7d\x60\x38\x9e\xad\xa2\x32\xc0\xb2\x95\x10\x3a\xc1\x22\x76\x3a\x74\x5e\x9e\x6a\x87\xe9\xac\x4c\x5a\xe6\x3b\x4c\xf6\xc1\xed\x56\x6d\xb2\xf1\x3c\x05\xc3\xb3\x4a\xc6\xf1\x18\x2f\x72\x27\x9e\x37\xc7\xd2\xf6\x9f\x9b\x15\xf2\x64\xae\x13\x27\x33\x7c\x46\x6e\x74\x3d\x71\x1a\x87\x6d\x5a\x80\x4c\xc9\xe4\x6a\x2f\x2a\xfe\x13\x5b\x30\xdc\xff\xed\x64\x80\x91\x3f\x5e\x16\x26\x37\xfb\x23\xcf\x1e\xb6\x5d\xa4\xbd\xf7\x01\x0a\xd9\xec\x7c\xd5\xbf\xb0\x35\xd9\x5e\xf8\x3a\xdf\xea\x69\xb8\x2d\x94\x2d\x03\x2f\x29\x9a\x2c\xe7\xd9\xa8\xae\xe2\x21\x4d\x9c\x79\xa5\x45\x3f\x64\xd7\x6c\x6b\xcd\x43\x58\x95\x96\x71\x78\x0e\x65\xc4\xae\x52\x6f\x35\x32\xdf\x46\x33\x6d\xbe\xf5\x91\x27\xc8\xe1\xd3\x43\x3c\x77\x30\x9f\x7a\x8b\x10\x35\xf4\x94\x64\x25\xb9\x30\x59\xe4\xbe\x47\xa7\x4d\xbd\x8f\xb6\x6f\x7b\xca\x11\xe3\x90\xaf\xdf\x69\x1e\x07\x7a\x70\x62\x75\x50\xc7\xe8\x2a\xf7\xb2\xcf\xe5\xb2\xac\x45\x3b\xb6\xab\x65\x43\xfd\x3f\xa3\x78\x8a\xfc\x88\x02\xb0\xdc\x2a\xf7\xbd\xdb\x8a\x5c\x99\xec\xec\x28\xbb\xf6\xd2\x5f\x91\xde\x94\x87\x3c\x7c\x60\x5a\xe5\x52\x1c\x52\x70\x68\x77\xe2\xd0\xc6\xd7\x45\xdb\x2b\x39\xf5\x48\xa4\x9d\x4b\x19\xe2\xd9\xbc\x02\x37\x06\x72\x59\x87\xd0\x6b\x7c\xcd\x83\x5f\xdd\xf0\xf9\xe6\xf5\x1e\x03\x28\x82\x77\x75\xfd\x6a\xe6\x8f\xc7\x2b\x01\x1c\xce\xd5\xda\x42\xdb\xe1\x08\x4e\x18\xaf\x56\x14\x09\x6c\xdb\x7c\x64\x48\xd6\xdd\xa0\xfe\x61\x64\x16\xa6\x88\x43\x1c\x9b\xca\x4e\x6c\x85\x98\x22\x09\x11\x3d\x09\xc8\x9f\xf0\x1c\x69\x88\xe3\xd1\x21\x38\x7a\x31\xc5\xab\x57\xb2\x3c\x72\x19\xae\xad\x7a\x56\x3a\x80\x3e\xaa\x30\x45\xce\x73\xf1\x24\xaf\x61\x90\x6b\x1d\xcf\x65\x04\xf6\xc1\x3c\x5f\x21\x29\x81\xfc\x5a\xb7\x94\x6e\xf2\x57\x65\xbd\x86\x9f\xd6\x02\x4f\x5a\x51\x15\xe2\xf8\x8b\x15\xc9\x10\xd1\x49\x2b\xaa\x43\x1c\x7f\x50\xcc\xab\x47\xb1\x3c\x7f\xb4\x22\xcd\x73\xf1\x24\x26\x44\xf2\x37\x2b\x6a\xf6\xac\xe8\x4f\xca\xaa\x72\x99\x84\x2c\xfc\x6b\x98\xfe\xb9\x5f\xa6\xe1\x36\xe2\xf1\x79\x95\xcb\x34\xd4\xcf\xbf\x71\xa5\xc1\x91\xb7\xee\x02\x6c\xf3\x1b\x32\xbd\xa6\xaf\xa3\xce\xaa\xe9\x3c\xcd\xeb\x58\xb3\xd2\x9c\xda\xdb\xa8\xf2\xb4\x3a\x20\x19\xa7\xf6\xda\x52\xba\x21\x4f\x13\x1c\x6a\x1b\x0d\xb2\x47\x9a\xa7\x6f\x13\x26\x5d\xc5\xb0\xd2\x10\xd7\x8c\xb1\xe4\x65\xa8\x3b\x74\xc9\x85\xeb\x42\xe2\x3d\xaa\xe2\x69\xc1\x4c\x8f\x3c\x8c\x13\xc9\xa9\x59\x7a\x40\xf7\x54\xec\x19\xc6\xb4\x66\x46\x05\x64\x34\x8a\x19\x0e\x10\x89\xe7\x3b\xfe\x22\x85\xab\xeb\xbf\x48\xe0\x65\x38\x5b\x3d\x3c\x0d\x9e\xbf\xae\x96\x97\x9e\xb5\x12\xda\xb1\x74\x04\x5f\xef\x7c\x5b\xfb\xb0\x02\x03\xf6\xde\x35\x7e\xf8\x46\x3b\x33\xde\x27\xb3\x5d\x78\xd7\xf7\xfa\xc7\x9b\xd6\x39\x4e\x0d\x72\xc2\x01\x61\x95\x7b\xd7\xc3\xff\x2c\x99\x8c\x77\x59\x60\x59\xe8\x92\x59\x14\x65\x5e\x80\xea\x48\xc0\xee\x98\x12\x3a\x8a\xbc\x34\x08\xb7\x6d\x7e\xc7\x35\xf7\xae\xf1\xad\xbd\x77\x36\x00\x18\xac\xfb\x6d\xd6\x76\x71\xac\x39\x68\xf7\xf1\x9d\x39\x8e\xe6\xa0\x7d\x90\xc3\xab\xcb\x10\x0c\x5a\xfe\x6e\x21\x55\x73\x92\x1b\xb6\x1b\x91\xa7\x59\x78\x07\xe5\x59\x9b\x9f\xad\x13\x21\x84\x17\xff\xaf\x37\x3c\x9e\xe2\xf1\x41\xa8\x28\xf2\xc0\xb3\x32\xbf\x85\xe2\xd7\xbb\x30\x2d\x73\x6c\x25\x44\x51\x84\xa5\x60\x71\xe5\x0e\xee\xb7\xb6\xcf\x31\x83\x5f\xb6\x6f\x3b\xc0\xdb\x0e\xdf\x38\xa4\xdc\x4b\xf9\xb2\x89\xec\xc7\x68\x2d\xa3\x9d\xbd\xb7\xe7\xf6\xdd\xc3\x3c\xb6\xa3\x71\xee\x53\x68\x19\xa3\x8c\xfc\x14\x24\xb4\x17\xa4\x6e\xf1\xc1\x2e\x21\xc7\x95\x1e\x1d\x73\x9d\x66\xcb\x8e\xb6\x7d\xcf\xb1\x55\x91\x97\x56\x31\xca\xe5\xe3\x51\x9f\x6c\x66\x2b\x4f\xf8\xb5\xe9\x5d\xec\xc8\x7c\xac\xca\xaf\x4b\x4f\xd7\x4d\xb5\x9c\xf4\xbd\xa1\x3b\x7b\xb6\x4e\x21\x07\x77\x35\x3a\xff\xcc\x8b\xb9\x9e\x00\x7b\x0a\xa4\x92\x68\x10\xca\x1d\xe9\xb6\xb3\xf3\x32\x0d\xff\x3c\x2e\xb2\x70\x68\x6f\x80\x13\xda\xde\x2b\x95\x80\xa6\xbc\x21\x4e\xc8\xb6\x6f\x17\x4a\x26\xda\x18\x58\x02\x77\x21\x61\x05\xdf\xee\x71\xe3\x0c\xae\x8f\xb0\x0c\x54\x82\xe7\x3e\x2f\x3f\xec\x76\x61\xe4\x05\x63\x16\x7b\x6d\xc9\x3a\x3b\x8a\xc8\x58\xb0\x1b\x89\x59\xb0\x96\xf3\x07\xa6\x36\x77\x4e\xf7\xd4\xf6\xa0\x60\x19\xf4\xae\xde\x60\x0a\x13\x94\x1f\x39\xeb\xa8\x22\x6f\x66\xb7\x60\xd5\xb5\xb4\x43\xbc\x95\xdc\x64\xe0\x5e\x40\x64\xe1\x36\xaf\x94\xcf\x5e\xab\xfc\xb7\x05\x1c\x28\x7f\x8a\xec\x3f\x28\xf0\x0b\xb5\xd9\x05\x40\xe6\x5c\xe5\x3f\x26\xe0\x0e\xb3\x13\x14\xd9\x0e\x05\x70\x0c\x1e\x79\xb5\x2e\xd6\xb9\xf4\xc6\x7e\x67\xaf\x40\xd3\x0a\xd2\xc3\x17\xb7\x05\x1e\x79\xe5\x3e\xac\xa0\xdb\x71\x44\x3d\xdc\xec\x9c\x77\xaf\x07\xee\x34\x27\xf2\xd2\x34\xe9\x4d\x2b\xf3\x7d\xff\xa0\x27\x5a\x38\x9c\x6b\xde\xa7\x1a\x74\x8d\xfa\xf7\x1d\x29\x1e\xd7\x04\x71\xdc\xc2\x38\xaa\xa2\x20\x8e\xbb\x38\x56\x45\x55\x1c\x04\xa6\x3c\xd0\x35\x62\xd9\x34\x6d\x0f\x35\x97\xbd\xa3\x80\xb6\xdc\x89\xc1\xf6\x6e\x1c\x66\x73\xa2\x55\x71\x84\x07\xda\x77\x6d\xf0\xb6\x34\x4c\xe0\x56\x45\x5e\xbd\xaf\xdb\xd2\x70\x89\xc4\x55\x54\xa9\x3d\x4b\x1b\x1a\xef\xdf\xec\x46\x54\xe4\xad\x84\xed\x88\x79\x5c\xee\x47\xf6\x8f\xa2\xca\xbc\xc3\x87\x09\x05\xbf\xf9\x63\x3b\x0d\xd8\x72\x22\x18\x83\x8c\xde\x9b\xb7\xa5\x02\x34\xe8\xd3\x65\x1c\xf4\xae\x9e\x4e\x39\x8a\x64\xc7\x39\x9e\x22\xf1\xc2\xaf\x7f\x6f\x72\x62\x9d\xb2\x77\x0a\x3b\x51\x5b\x2c\xc2\x0d\xb8\x9c\x1b\xa3\x1c\xcc\x1d\x50\xb5\xf9\x34\xac\x27\xf4\xca\x8c\xad\xd1\xc9\x64\x28\xe4\x67\x81\xee\xd8\x5b\x81\xcb\x74\xeb\x15\x7f\xf9\x3e\xf2\x4e\x51\xe7\x7f\xda\xf1\x7e\x6d\x67\x5b\x39\xf7\xd2\xfd\x8c\xbc\x21\x78\xa3\x76\x36\x27\xd1\xee\x10\xb6\x36\xb0\xa6\x10\x63\xd7\xb8\xaa\xc8\x3b\xfd\x94\x4a\xdd\xae\x77\xa9\xff\x27\x15\x05\x27\xa3\x6c\xb9\x23\x8e\x2a\xf2\x8a\xe4\x9d\x84\xba\xa3\xb0\x69\xa7\x79\xa1\x32\xa2\x93\x61\x2b\x2f\x46\x5e\x51\x3f\xdb\xeb\x8b\xe9\xa1\x80\xa2\x8e\x43\x48\xa4\x1a\x53\x54\xa7\x61\x28\x78\x56\x12\xd5\x6f\xd1\xff\x23\x35\xb6\xb9\xf5\x5c\xb1\xaf\xa8\x16\x7b\x68\xaa\xe7\xc2\x81\xab\x3d\xf0\x7a\xf9\x02\x89\xa6\x6a\xbd\xc7\xb1\x6e\x35\x6c\x91\x70\x23\xd5\xc2\xde\x4b\x88\x6a\x03\x91\x01\x87\x87\x55\x54\x37\xef\xae\x98\xe2\xcb\x55\x2c\x48\xb7\x59\x70\x3a\xd8\x84\x98\x0c\x10\xe1\xd7\xae\x87\xbb\x0e\x9f\x66\x33\x65\x03\xc8\x5f\x04\xa0\x54\xba\xb5\xed\x6f\xdc\x34\xea\x25\xa8\xaa\x8e\xc2\x05\x2b\xff\x31\x28\x09\x34\x78\x8c\x70\x4d\xdf\xd6\x7e\xa3\x13\x11\x0c\xea\xea\xd6\x96\x40\x62\x11\x79\xa8\x31\x0c\xea\xaa\xf2\x63\x98\xda\xff\x86\x7e\x91\x68\xbb\x65\xa8\xdd\xd3\x2c\xee\x38\xa5\x99\xa7\x55\x30\xb4\x0a\x09\x70\x92\xc3\x9d\x4d\xfe\xbd\x49\x4d\xd7\x82\x15\x6d\x67\x22\xdf\xaa\x0e\x09\x70\x9a\xc5\x8d\x11\xd7\x87\x4e\x41\xa0\x0e\x35\x7f\x8a\xc1\x75\x2b\xf3\x75\x18\x96\x8f\xf3\x4a\x68\x42\x52\xfc\x91\x2c\x8e\xde\x05\x3a\xd3\xa1\x38\x3e\x10\xe6\x14\x91\x3b\xe6\xe7\x2b\xdd\xeb\x51\xea\xeb\x2e\x27\xc5\x43\x83\x83\xff\x24\x47\xf6\xde\x3c\x88\xcc\x0f\x5a\x07\x29\x5c\xaf\xd0\xc9\xe9\x62\x70\xb9\x83\x3e\xe1\x24\x87\x78\x6f\x1e\x44\x56\x07\xad\x63\x14\xde\xbb\x4e\x14\x5a\xc9\x69\x01\xa6\x08\xef\xce\xb5\xbe\x8d\x5b\x78\x76\xc6\xe2\xbc\xeb\x69\x44\xd1\xc9\xb3\x0c\xee\x77\x9b\xd8\xdc\xce\xc6\xd5\xb3\x6a\xa7\xf5\x39\x5c\xf4\x2b\x35\x32\x88\x06\xc1\x2a\x04\xa6\x4b\xdf\x14\x47\x43\x0c\xae\xe7\x82\x00\x41\x37\x85\x20\xbd\x02\x9e\xf5\xd0\x69\xfe\x5c\xd5\x8b\x18\xda\x45\x76\xad\x02\x40\x6e\x3b\xeb\x62\x66\xdd\x5f\x3f\xd7\xdc\x8a\xdc\x8a\x4b\xf1\xd0\xec\x1d\xfa\x3b\x83\xd7\x46\xda\x9b\x95\xdf\x80\x0c\x02\x21\xfa\xe1\x89\xe2\x08\x21\xda\xea\xd5\xf2\x74\xde\xdd\xc2\x55\xae\x67\x84\x70\xa2\x7b\x19\x40\x03\x74\x4e\x00\x34\x70\xd7\xe2\x28\xa0\x2a\x39\xdf\xff\x31\x53\x4f\x44\x2c\x01\x15\xa7\x7d\xa9\x73\x6b\x4f\x92\xbe\xed\x75\x0f\xbb\x2b\x32\x43\x2c\x49\x88\xe5\xe7\x2c\x4b\xb6\x23\x8b\xfc\x3e\xc3\x22\x76\x64\x39\xc5\x12\xbf\x3e\x9c\x68\xf5\xb2\x2e\xaf\x28\xcd\xc9\x5c\x11\x96\x24\xc8\x22\x67\xb5\x5e\x4f\x03\x18\xb2\x20\x83\x36\x27\x28\x44\x90\x02\xf6\x0f\xf1\xdb\x1b\x92\x56\x15\x94\x60\xf5\x30\x54\xd8\x4b\xf1\x54\xb8\xdb\x0a\x73\xdd\x48\xb1\x76\xf1\xf0\xdc\xd0\xe1\xb9\x04\xc7\xb5\x7c\xb4\xea\x1f\x7b\x5b\x83\x25\x8b\xa3\x30\xd9\xa9\x71\x69\x5c\x5f\x6a\x37\x0c\x8d\x06\x26\x74\x13\x0c\x7f\x4e\xe0\xe5\x4e\xb3\x4c\xd9\x0b\x53\x73\xed\x72\x04\x6a\xa7\x61\xa6\xae\x94\xd1\x5c\xc3\x1c\x81\xd9\x69\x98\x39\x0a\x30\x0d\xd7\x30\x43\xd0\x04\xe3\x64\xfe\x13\x55\xa9\x97\x87\x6e\xed\xd4\x4e\x6a\x33\x9a\xf6\x52\xa5\x85\x6b\x26\x96\x61\xa1\x08\x99\x06\x0f\xce\x21\xdf\x47\x0b\x0e\xae\xdf\xc1\x74\x65\xaf\xeb\xda\x4b\xcb\xe2\x63\xb7\x69\x35\xf4\x70\x78\x1c\x07\xcd\xf4\x0c\x43\xb2\x33\xb8\xe9\xa2\x9c\xfd\x0a\x77\xca\xd7\x51\x06\xf0\x13\x55\x5a\xa8\xdd\x34\x90\x2d\x95\xe4\x90\x20\xce\xbc\xfd\x97\xe4\x5e\xff\x2c\xdc\xee\x57\x9c\x79\x51\x5a\x0a\x62\x5c\x7b\xc9\x10\x4c\x92\x79\x3a\xa2\x6a\x2e\xdb\xd3\xd1\x1c\xcc\x0d\x81\xb7\x03\x61\x04\x29\x72\x2f\xc9\x84\xea\xc9\x2e\xec\xb5\xbc\x2a\x92\xea\x3d\x89\xe7\x02\x6e\x8d\x8b\x3c\xf1\xd0\x53\x7b\xfd\x7d\xd0\x9e\x43\xea\x83\xc7\xf0\x0f\xb1\x55\x54\x27\xe1\x5d\x75\x82\x42\x76\x53\x7a\x4a\xb6\xbb\x9f\xe3\x34\x90\xaa\xd9\xe3\x8f\xb8\xf4\xbe\xab\xc5\x92\xa6\x3a\x4a\x43\x3f\x86\x46\xb5\x4c\xf7\xa1\xeb\xab\xa3\x40\xd1\x92\xa8\x96\xc5\x3e\xcd\x55\x2e\x13\xf7\x96\x52\xa4\xbd\xbb\x0e\x6b\x1f\xe4\xb7\xc3\x02\x0b\x23\x32\xf3\xd6\xa7\xdf\x63\xe8\x93\x62\xd5\x32\x3b\xf8\x2c\x67\x14\x54\x1e\xf0\x80\x1a\x12\x21\x0d\xfd\x45\x1c\x91\x99\x6c\x5f\x45\x27\x05\xab\xd2\x42\x87\xf2\xff\xda\x7e\xe4\x32\xdb\xbd\xda\xbd\xf3\x87\xa4\xe7\x72\x0c\xfb\x0c\x93\x10\xa5\xab\x89\xaf\xa9\xa5\xb2\xf8\x36\x45\xd5\x56\x3b\x04\xca\x83\x0b\xe1\x65\x90\x4d\x46\xea\xf3\x0c\x92\x17\x02\x39\x44\x17\xc2\x4b\xef\x9f\x8c\xd4\xf7\xb1\xfd\x36\xdd\xfd\xff\xb3\xf6\x36\xcd\xb2\xaa\x4c\x9f\xf7\x57\x79\xe2\x99\x9f\x08\x51\x51\x1c\xf4\x00\x01\xd7\xf2\x3a\x56\xe9\xa5\xd6\x7a\xd9\x93\x1a\xf5\xec\x1e\xf4\xa0\x3b\xe2\xee\x6f\xdf\x01\xa8\x05\x4b\x20\xd3\x75\xae\x13\x3b\x4e\xec\x3d\xf8\xff\xa0\x10\x92\xb7\x24\xf3\x3a\x48\xc0\x55\xda\xf2\xd4\x83\x28\x09\xb6\x0f\x92\xe4\x3d\xd2\x34\x9a\xe7\x32\x99\x6f\x06\x09\x5d\x9b\x6a\xdd\x22\x66\x35\x0d\x5c\xc0\xca\x22\x56\x24\xc6\x5f\x8b\x29\x2f\x0a\x8c\x49\xd4\x0b\x2a\xdc\xe1\xdd\xf5\x6a\x00\x03\x42\xaa\x9c\x05\x1c\xb5\xd7\x1e\x51\x96\xdb\x75\xf5\x22\xc7\xb8\x5c\x2c\xeb\xac\x38\xfc\xbe\x30\x77\x7b\xed\xa4\x2f\x05\xb1\xa5\xb6\x01\xe1\x2b\x13\x7e\x52\xec\x87\x81\x79\x3d\x46\xda\x4f\x72\x21\x75\x93\x54\xf7\x77\xa9\x20\x1b\x45\xbd\x1b\x73\xfd\x50\x40\x6f\xf9\x4c\x4e\x55\xfb\x3e\xd3\x7f\x25\x05\xc1\x72\x00\xc6\x07\xb5\xae\x0a\xe5\x83\x43\xbd\x33\x2c\xeb\x9e\x3f\xab\x65\x7c\xcc\x42\xd9\xf7\x3d\x5b\x2e\x5c\x3e\x0c\x23\x74\x07\x4c\xcb\x3c\x0e\xbb\xa9\x9b\xcb\x83\x48\x65\x9c\xb4\x7c\x2f\xe6\x56\x78\xfe\x00\xe3\x5f\xd1\x92\xc6\x39\xfb\x1b\x5b\x08\x51\xc5\x11\x5e\xf0\x29\x88\x53\xc7\x39\x96\xb0\x2d\x6e\x93\x1c\x46\x73\xb7\x91\x75\x52\x92\x45\x9b\xc1\xed\x11\x2a\xe4\x30\xc2\x68\xee\x5a\xa7\x0f\x3e\xf7\x66\x7b\x08\x05\x20\xa5\x79\x19\x92\x41\xe1\x4e\x69\x4e\x43\x32\xa0\x23\x31\x9a\x57\x21\x19\xf0\xb5\x19\xcd\xeb\x90\x0c\xf8\xc2\x8c\xe6\x2c\x24\x03\x1c\x42\x18\xcd\x9b\x90\x0c\x0a\x39\x48\x73\x1e\xda\x95\x98\xc5\x3a\x14\x36\x9c\xe6\x6d\x54\x0b\x45\x0c\xa7\xb9\x7b\xae\xb1\x4c\x7c\x56\x70\x71\xee\x91\x86\x91\x80\xa5\x78\xc1\xeb\x64\xbf\x08\xfd\x8e\x01\xd2\xb8\xd6\x51\x99\xf9\x60\x81\x9b\xbf\xc8\xcf\xbf\xc7\xa4\x48\xbc\xb4\x79\x62\xfe\x33\xe7\xd7\xbb\x1d\xa9\xee\x2b\xf8\x4c\x95\xf9\xaf\x9b\x9d\x57\x3f\xb0\xbf\x1c\xf3\x9f\x34\xab\xaf\x49\xfb\x6d\xde\xf5\x76\x0d\x70\xd1\x63\xfe\x3b\xe6\x5d\xa9\xde\x40\x47\x3b\xe6\x3f\x5d\x36\xc1\x79\x4c\xda\x1e\x64\xb1\x6d\x58\x8c\x2b\xd9\x5d\x1e\x5e\x6d\x65\x79\xd6\x22\x7f\xaf\xd7\xeb\x4d\x40\x20\xfd\xa8\xee\x13\x38\xd5\x61\xfe\xa3\xdd\x43\xd8\x8d\x0f\xb0\x43\x95\x59\x48\x39\xde\x95\x6d\x32\x48\xed\x0e\x06\xf3\x6d\xda\x6f\xfb\x6d\xf5\xd2\x00\x4d\x71\x07\xc7\x3e\x37\x60\x36\x45\x8c\x96\x45\x48\x7a\xe3\xd3\x04\xc7\x53\xf5\xe7\xed\x43\x7c\xf8\xf7\x3d\x1f\x0b\x18\x3e\x8f\xf9\x93\xf6\x01\xe1\xed\x53\x8e\xfa\x95\xfe\x28\x1f\xf0\x2c\x59\x56\x21\x86\x90\x97\x18\x75\x88\x71\x7b\xe8\x74\x55\xc0\x0a\x86\xd1\x92\x85\xc4\x88\x04\x98\xcc\x0f\x1f\x78\x48\x4d\x5f\x80\x94\x3c\xa4\xe4\xed\xfe\x62\x1b\x92\xb7\x21\xb9\x90\x58\xb9\x08\xc9\x75\x5b\x21\xf5\xee\x28\xd7\x37\x40\x26\xd2\x1d\x1f\xf0\x8b\x1b\x2f\x21\x95\xde\x9c\x5c\x27\x74\xe7\xb0\xb3\x8f\x1b\x2a\x19\xd0\xcf\x0c\x5d\xb6\x44\x70\x92\xa5\x24\xa0\x02\xe7\x59\x1a\x1a\xe0\xc0\x95\x0a\xa3\xb4\x08\xa8\xa0\x4c\x1f\x94\x96\x01\x15\x70\x8b\xc2\x28\xa5\x01\x15\x94\xd3\x83\xd2\x2a\xa0\x02\x9e\x54\x30\x3f\x10\xc6\xae\x82\xb2\xc8\xd0\xc2\xad\xa1\xde\x82\x2d\xab\xb6\x4e\xfb\xba\x0a\xe8\x2b\x4d\xe1\xbd\x48\x9b\xd5\xb6\x2d\xb5\x86\xfa\x38\x88\x5a\x61\x57\xec\xbc\x75\xc7\xdd\xf4\x98\xdf\x4c\xd6\xd2\xbb\xde\xa0\xa0\x3c\x67\x0b\x7f\x7e\xfc\x11\x59\xf4\xc8\xad\x07\x47\x3b\x6c\x8a\x42\x25\x48\xf6\x38\xe7\x6d\xee\xe5\x76\xbf\x0b\xc1\x3a\x1c\x6c\xbb\xe1\x05\x68\xde\xbc\x9a\x8c\xa5\x8a\x0e\x4a\x1c\xcc\xde\x72\x60\xa0\xfa\x10\x6c\x7d\x20\x90\x3b\x96\xcd\x81\xcf\xb6\xa3\x4c\x41\xc1\x2f\x59\x16\x11\x6a\xe2\x43\xa4\x91\xfe\x90\xde\xb3\x91\x9a\x0e\xae\xbe\xcc\x13\x7f\xf8\xf0\x82\x56\x01\x84\x7e\xfb\x84\x24\xb0\x8e\xb8\x7d\x7d\xfb\x49\x3a\xa8\xef\x9e\xd6\x16\x90\x7b\x41\x12\xae\xe8\xdc\xdf\xae\x27\xa7\x2d\x26\xc3\x56\x03\x14\x45\xdf\x13\xfe\x48\x08\xbb\x51\xde\x86\xb1\xe5\xc3\x93\x0b\xa1\x21\x2d\x9f\xe7\x1e\xe7\xc9\x61\xdd\x75\x5c\xe0\xa3\xb5\x81\x65\x3a\xc5\x75\xec\x3e\xfd\xdc\x7f\x35\x49\x57\x14\xc2\x49\xad\x29\xbc\xad\xc5\x27\x9f\x51\x11\x6a\x0a\x6f\x5b\xa1\x55\xd6\x25\x66\x81\x0e\x7a\x0a\x2f\xcc\xcf\x72\xc3\x1d\x5a\x55\x45\xfa\x04\x0c\x92\x97\x31\xf9\x7d\xbc\x12\x20\xab\xa2\x31\x0e\x71\x30\xa6\x21\xec\x18\x83\x78\x15\x9a\x47\xbe\x72\x7b\x24\x0e\x57\xb2\x46\x43\xf3\x2f\x82\x85\xb2\x0b\x50\x74\x4d\x9b\x0b\xd0\x12\x0b\xe5\x68\x68\x89\xaf\x69\x7b\x01\x8a\xae\xa9\x88\x41\x73\x07\xba\x5c\xe8\x4e\x32\x06\x2c\x7f\x09\x54\x31\x20\xfb\x25\xb0\x8b\x01\x49\xf5\x3b\x22\x6d\x63\x44\x6b\x19\x50\x79\x45\x1a\x3f\xf1\x4c\x00\x63\xe3\xa5\x63\xd6\x40\x94\x4a\x0c\x0a\xb5\x02\xa2\x54\xa5\x59\xfb\xc9\x3f\xc2\x62\x53\xea\xb6\xbd\x9e\xcf\xc4\xc8\xe7\xc5\x59\xb8\xe2\xa2\x2f\x53\x1e\xad\xd3\xb6\xce\xb0\x59\xf4\xa4\xea\xf8\x63\x00\x7f\x21\xef\x50\x34\xdb\xff\x6f\xfc\x5f\xa0\x6f\x04\x6d\x33\x14\x71\xfb\x07\x02\xc9\x2a\xc9\xa2\x91\x75\x56\xf1\x8e\x8c\xef\xde\x10\xb7\xe1\x74\xd6\x42\xb3\xe4\x9f\xc7\x63\x2d\x09\x02\xdc\xb6\x32\x00\xa5\x5f\x22\x6e\xb6\x07\xc5\x10\x75\x09\x55\xe2\x62\x38\x1e\x51\x53\x44\xad\x2e\x42\xfd\x41\xa4\x6e\xed\xb8\x2c\xcf\x01\x1e\x31\xcc\x1f\x31\x9b\xf0\x38\x37\x87\xb4\xdd\x59\x7b\x83\x82\x9c\x30\xea\x3d\x37\xda\xce\x55\x31\x32\x77\x13\x30\xab\x6e\x38\x9c\x20\x40\x69\x56\x9c\x77\xbf\xe0\xf6\xdc\x0b\x2c\x62\xaf\xa1\x0a\x44\x02\x3b\xca\xda\x80\x8e\x0f\xd3\x3b\x78\x85\xd4\xb8\x25\x8e\x93\x9a\xf9\x5d\xa2\x8a\x6c\xda\x90\x10\x53\x26\xf3\x83\x6c\x6c\x17\x7e\xc7\x31\xfd\xac\xf4\x87\x81\xef\xe4\x9b\xac\xa4\x01\xce\x91\xdc\x05\x67\x2a\xb3\xb2\x0a\x42\xf0\x29\x6b\xca\x8e\x06\x08\xd8\xd4\x88\x65\x57\x05\xd4\xfb\x8c\x88\xa7\xb8\x0b\xca\x69\x1e\xbf\xbe\x7f\x51\x93\x9f\x16\xf4\xa5\xdc\x59\xf0\x1c\xc6\x2a\xef\xc0\x71\x7b\x69\x7c\xb5\x2e\x55\x29\x31\x10\xcc\xc1\x6d\xe5\x1d\x3f\xba\x07\xcd\x97\x2b\x25\xdd\xb5\xb0\x4e\x33\xf8\xba\x5b\x72\xb9\xb8\x53\xf9\x4a\xba\x8b\xe0\x03\x64\x1c\x53\xd8\x73\x61\x4f\x06\xc6\xa7\xab\x64\x1b\x43\x68\xf1\x62\xfe\xc0\xb9\xda\x2b\xe9\x7e\x31\xb9\x48\xfd\x13\xde\x34\x46\xdd\x17\xf8\x1e\xa8\x92\xf2\xbc\x75\xd6\xe6\xbc\xd7\xef\xf8\x21\x83\x5e\x79\x89\xfc\xf6\x4f\xb2\x51\x20\x69\x77\x2e\xd8\x98\x30\xf8\x7c\x87\x55\xca\x5d\x82\x88\xc7\x16\x75\x0a\x1a\xec\x95\x22\xf1\x8e\x89\x71\x8d\x63\x95\xca\xe3\x04\x54\x87\x56\x45\x1c\x80\xb8\x8f\x48\xfe\x84\x5c\xfe\x07\x7e\x45\x2e\xff\xf9\x0f\xc9\x25\xee\xb7\xb8\x4b\xa5\x69\x56\x1f\xfd\xf8\x58\x0e\x08\xe6\x6a\xad\x52\xae\xf9\xd6\xfd\x76\x35\x7e\xd0\x90\xca\x35\xdb\x13\x5f\x96\xe7\xfa\x3e\x8f\x8f\x37\x60\xeb\xc1\x2a\xe5\x5a\x6a\xd3\xef\x8e\xc5\x3d\xa4\x64\xc9\xe6\x82\xd4\xae\xf1\x92\x6a\xd2\x4e\x32\xe6\x09\xe7\x15\x06\x0f\x32\xde\xda\x2b\x0c\x11\x31\xc7\x90\x4e\x46\x74\xd6\xd7\x16\xe7\x06\x58\x29\x15\xa1\xe0\x7f\x40\x97\x01\x08\xf1\x68\x15\x62\x85\x58\x75\x24\x02\x92\x7d\xd7\x3d\x96\x0b\xa0\x3c\x02\xda\x56\xac\x78\x50\x11\x01\xbd\x86\xc5\x65\xa4\x3b\x40\xdf\xfb\x01\xba\xe2\xaf\xbc\xd5\x94\x99\x8c\x20\x41\x15\x9a\xbd\x20\x51\x1d\x12\x7d\xf4\xd0\xb2\xb7\xf2\xde\x62\xeb\xdf\x03\xe6\x28\xae\xbc\xe8\xb5\xdb\x1c\x8d\x6a\x09\x1e\xd6\x21\x4a\x6c\xcf\x4a\x44\x42\xeb\xaa\x13\x61\x1d\xb8\x10\xe9\x54\x50\x08\xca\xba\xa0\x0c\x2a\xce\x7f\x18\xb1\xe9\x86\x87\x0e\x7b\x79\x07\x4d\x40\x9d\xe5\x09\x31\x5c\x74\x91\x50\xa3\xb6\x3f\x75\x56\x26\x10\xcc\x32\xe0\x7a\xd0\x33\x04\x57\x7a\x15\x11\xc2\x45\xd6\x67\x25\x76\x91\x58\x67\x2c\x21\x86\x8b\xf6\x26\xaf\x45\x42\xcf\xfe\x58\x9d\x79\x53\x95\x51\xdc\xf8\x1b\x2c\x73\x47\x8e\x23\xbb\x50\xd5\xf0\x18\x7a\x2d\xcf\x71\x5f\x49\x06\x29\xec\x07\x06\xae\x8d\x3b\x30\xdf\x7b\xd4\xba\xac\xf6\x02\xc5\x0c\x23\x4e\xe4\x5d\xa0\xc9\x05\x29\xf2\xa6\xc0\x15\x29\x72\x07\xf0\x8d\xbf\xdd\xfb\xf5\x21\x71\x6b\xe7\xda\x0b\xf9\xf2\xd1\xb7\xb3\x19\xb6\x38\xa9\x3f\x93\x61\x96\xa7\x35\x71\x47\xe9\x30\xe2\x34\xfe\x7c\x86\xd3\x78\xd3\xd9\x8a\xd3\xb0\x60\x33\xa2\xa4\x4d\xa8\x15\x51\x4a\x77\x58\xee\x0b\xa6\xd6\x9e\x46\xeb\x13\x49\x70\x03\x56\x93\x36\x40\x78\xef\x31\x47\x13\x35\x11\x01\xed\x30\xe2\xb4\x32\xa0\x95\x0b\x4e\xab\x42\xda\x15\xa7\xed\x02\x5a\xbd\x3f\x47\x88\x2b\xd5\x45\x16\x74\x3b\x08\x8a\xbd\xca\x18\xcd\xce\xbb\x8e\xfd\x39\xd8\x87\xc2\xaf\x9b\x19\x25\x58\x10\x66\x38\x32\x9a\xa3\x70\xd8\xc3\x1d\x46\x8b\x8b\x3c\x5c\x2d\xcb\x33\xd5\xac\xfd\x2e\xb4\x1b\x05\x10\xf8\x9f\x58\x45\x48\xbf\xfb\x9e\x35\x9e\x86\xaf\x22\x0b\xee\x30\xaf\x36\x59\x13\xa4\xcc\x6f\xed\x95\x8e\xcf\x41\x08\x72\x13\xc4\x68\x1b\x19\x86\x17\x0e\x0e\x18\x15\x11\xc8\x5e\x1b\x02\xff\x24\x19\x41\x6c\x91\x98\xaf\x6c\xa5\x99\x77\x8b\xa3\x3f\x10\xb4\x18\x61\xb4\x3b\x2f\x6a\x50\x42\x2f\x31\x64\x37\xea\x77\x42\xad\x8e\xee\xb9\x8e\xc6\xf7\x3a\xa9\x6e\x68\xe3\x0e\x20\xfd\x8e\x41\x3f\x54\x7f\x2e\xfa\x9a\xe1\x7d\x9c\x95\x9d\x77\x20\x88\x17\x23\xf5\xa5\xc5\x64\x5b\x6a\xcb\xb8\xb4\xed\xef\xfa\x4d\x04\x44\xa0\x71\xc2\xf1\x83\x20\x86\x3b\xf8\xf5\xe9\x7e\xb4\x25\xf4\xf1\x83\xcd\x75\x93\xae\x17\x13\xb5\x6b\x02\x5a\xfd\xf0\xf9\xca\xeb\x7a\xe1\xbd\xd9\x35\xf2\x6b\xaf\x44\x84\xf7\x62\xf7\x18\x94\xe6\x59\x28\xa4\x74\x07\xb7\x18\xfa\xe9\x29\x7b\xfd\x6e\x07\xf1\x9b\xdb\x73\xce\x15\x30\x77\x8e\xf0\x9e\xe1\xbe\x1e\x15\x22\x84\xee\x90\xf5\x93\x95\x83\x52\x6f\xd5\xa1\xdf\x72\xeb\xb0\x03\xcf\x4e\x29\x69\x1a\x1b\x77\x25\x2e\x3c\x87\xda\x00\x66\xbb\x2c\x86\x2f\xec\x98\xf0\x82\x6a\x99\x3e\x78\xc6\x2d\x4a\xdf\x43\xaf\xea\xf5\x3e\x01\xac\xa1\x97\x0a\x82\xdb\x99\xe7\x83\xcf\xdf\x88\x84\x3c\x82\xe5\x51\xad\x79\x4b\x8f\x7a\x65\x2d\x58\x91\x6e\x23\x74\x6d\xca\x34\x67\x6b\x6b\xcc\x13\x59\xc1\x28\x8e\x05\x2f\x23\x05\xab\xd2\xa8\x59\x09\xc4\x98\x63\x75\x68\x0c\x2c\xdb\x73\x80\x15\x7a\x37\xca\x04\x63\xe9\x6a\x38\x4c\xfd\xd2\x7d\x55\xd0\x25\x90\xf0\x72\x62\xcc\x5c\x3f\xda\xec\xff\xa8\x19\xf9\x46\x4e\x30\x0e\xf7\x67\x7d\x72\x30\x0f\x8a\xeb\x77\xb1\x17\xba\x74\x0b\x93\x8f\x91\x82\x1c\xc8\xcc\xb5\x41\x6e\xb5\xb0\x7a\xd7\x14\x5d\x2e\x5c\xa1\x7a\x23\x44\xc1\xd9\x22\x94\x43\x19\x93\x5e\x80\xfa\x81\x7f\xa7\xcb\xff\xcb\xb5\x14\x77\xfd\x0a\x00\xae\xf3\x5f\xde\xaa\x41\x47\xd0\x7f\x75\x81\xa4\x33\xc8\x5f\x65\x5c\x98\x7c\x1a\xf2\x17\x8d\x0b\x93\x2f\x51\xfe\xaa\xe2\x42\xe8\xe1\x8b\xca\xf3\xf4\x77\x81\xe4\x05\xea\xb3\x4e\xfc\xb1\x80\x56\x42\xe5\x25\x0a\xb6\xd9\x7a\x08\x06\x19\x51\x4c\x4f\x6b\x0a\x2f\xd3\xf1\x9e\xf0\x70\xab\x88\xfe\x67\x0f\xfd\xac\xa6\xa8\xba\x38\x02\xe5\xe7\xde\x14\x75\x16\x47\xa0\xe6\xb9\xca\x0b\x20\xb7\x3c\xe6\x4e\xef\x13\x96\x15\x7e\xf2\x59\x75\x32\xa0\xdc\x1f\xca\x83\x1f\xd5\x0f\x67\xb4\xcb\xb5\xa3\x05\x28\x65\x5e\x44\xfe\x4f\x13\xf2\x03\xf9\x04\x9a\xc8\xf3\x52\xcf\x66\x52\xc1\x44\x83\xa1\x44\xa5\xe5\xa8\x26\xa7\xa4\x4b\x53\x3e\xf8\x00\xae\x22\xa9\x17\x5b\x55\x4f\x27\x01\x90\x1a\x14\xe2\x16\x9a\xe6\x24\x5d\x1f\x5c\x08\x18\x5a\x14\x69\xcc\x67\xbf\xbe\xeb\x34\xb8\xdd\xf0\x58\xa0\x36\xea\x82\x2d\x6d\xe6\xa5\x8d\x89\x1a\x63\xac\xf3\xda\x7a\x6f\x8f\x4b\x84\x3c\x03\x6a\x82\x1a\xaa\xac\x8b\xb7\x32\x16\xe0\xcd\x56\xd6\xe1\xf1\x1a\xc0\xfd\x40\x76\x37\x76\x4d\xef\x9a\x61\x1b\x8f\xe5\x9a\x9e\x46\xf7\x76\xd7\x38\xee\xbc\x66\x22\x49\x75\x03\x7f\xbb\xc8\x70\x57\xae\x3f\xf7\xa8\xd7\x48\xee\x12\x56\xc7\xb0\xfb\xcd\x2f\x6a\xa2\xbd\x14\x4b\xe0\x81\xde\xe5\xf6\x52\x94\x69\xea\xf2\x36\x80\xb9\xa2\x17\x67\xa7\xdc\x4b\xe5\xcb\x48\x17\x45\xca\x55\xa4\x87\x22\xe5\x1d\xd0\x41\x71\x98\x22\x4b\xf4\x4f\x24\x82\x80\xdd\x13\x09\xca\x13\xbd\x13\x89\x28\xa2\x9d\x13\x07\xf0\x1e\x94\x9a\x04\xfc\x7a\x89\xa5\x0f\x90\xb1\x36\xb8\x24\x51\x02\x72\x7c\x94\x79\x94\x80\xfa\x11\x55\x68\x1a\xd8\xdd\xec\x21\x29\x39\x4b\xed\x0a\x0b\x0b\x70\xbf\x80\x37\xaa\x31\x37\x46\x79\x19\x55\xaf\x73\x0f\x1e\xf1\x54\x39\x8d\xe9\xe1\xa3\x52\x56\xe5\x55\x20\x00\xda\x46\x81\xa4\xfe\xe9\x82\x6d\x2a\xe4\xd7\x62\x01\xe9\xb2\xce\xf0\x16\xb2\xf2\x6c\xf1\x2b\x90\xe4\x7e\x05\x02\xbb\xa1\x7a\xa6\x18\x19\x79\xa5\xf2\x0c\x6f\x7f\xff\x50\xfa\x8d\x10\xa4\x71\x8d\xad\xd9\xc9\x4c\x23\x42\x25\x03\x25\xe1\xd5\xea\x7c\x8a\x21\xfe\xde\x3d\x4c\xf1\xb1\x29\xab\xbc\x8b\x83\x04\xec\xb4\xeb\xd9\x58\x5b\x3a\x14\x01\xa2\xf2\xe2\x1f\x59\x0d\xb4\x0d\xae\xbc\xe8\x47\x56\x93\xdc\x73\xeb\x16\xf6\x16\xc2\x56\x03\xc5\x7e\xa8\xfc\x9c\xf2\x46\x03\x3d\x2d\xa9\x7e\x3c\x95\xd6\x1a\x28\xee\x43\xe5\x45\x61\xb2\x75\x03\x7d\xcf\xbc\xd8\x4b\x56\x03\xc5\x7c\xa8\xca\x2c\x30\xec\xaf\xf7\x92\x92\xc4\x31\x90\x34\x3f\x5b\xdd\xdd\x12\x6c\xd1\x22\x11\xc6\xb3\x2c\xe2\x94\xf5\x53\xfb\x19\xc0\x06\xd4\x0b\xd6\x73\x7e\x9a\x05\xa9\xa3\xe6\x17\xb7\x2f\xab\xca\x2a\x50\xfc\x16\x76\x1c\x92\x86\x2c\xf0\xac\xf4\x8f\x86\x9d\x9b\xbd\xc8\x3c\x9e\x99\x80\x84\x4d\x4c\xf8\x3a\xae\x81\x10\x81\xe5\xf0\x5e\x7f\xdc\x51\x62\x55\xb6\x81\xdf\x7e\x25\x61\x25\xab\x68\x76\xae\x85\xfb\x05\x41\x8b\x45\x49\x1a\x00\x9a\x2f\x9a\xa7\x01\xa0\x2d\xa3\x45\x1a\x00\x1a\x36\x5a\xa6\x01\xa0\x95\xa3\x34\x0d\x00\x4d\x1e\xad\xd2\x00\xd0\xfe\xd1\x3a\x0d\x00\x8d\x21\x65\x69\x00\x74\x5b\x5e\xd1\x26\x0d\x68\x40\x00\x4f\x03\x08\xdc\x15\x5b\x80\x00\xf7\x45\x01\x10\xe0\xce\x28\x01\x02\xdc\x1b\x15\x40\x80\xbb\x63\x07\x10\xc0\xfe\xe8\xb9\x3e\xdc\xf8\x44\x7c\x4c\xf6\x04\xeb\x50\x91\x24\x81\x20\x08\x79\x92\x90\x23\x08\x45\x92\x50\x20\x08\x65\x92\x50\x22\x08\x34\x49\xa0\x08\x42\x95\x24\x54\x08\x42\x9d\x24\xd4\x08\x02\x4b\x12\x18\x82\xd0\x24\x09\x0d\x82\xc0\x93\x04\x82\xe9\x94\x6d\x1a\x81\xe9\x95\x22\x8d\xc0\x74\x4b\x99\x46\x60\xfa\xa5\x4a\x23\x30\x1d\xb3\x4b\x23\x10\x3d\xd3\xbb\xd9\xb9\xf1\x29\xbf\x6c\x25\x6a\x92\x24\x20\xbe\x47\x9d\x27\x09\x88\xcf\x51\x17\x49\x02\xe2\x6b\xd4\x65\x92\x80\xf8\x18\x35\x4d\x12\x30\xdf\xa2\x4a\x12\x10\x56\xa2\xae\x93\x04\x84\x95\xa8\x59\x92\x80\xb0\x12\x75\x93\x24\x20\xac\x44\xcd\x93\x04\x8c\x95\xa8\xdb\x34\x02\xd3\x2b\x45\x1a\x81\xe9\x96\x32\x8d\xc0\xf4\x4b\x95\x46\x60\x3a\x66\x97\x46\xc0\x3d\x93\x79\x77\xb0\x9e\x7a\xdb\x23\xea\x1d\xe2\x1b\xb4\x47\x6c\x32\xff\x74\xb3\x97\x6a\xdc\x6f\x91\x21\x21\x89\x09\x71\xf7\xe8\x99\x77\xab\x65\x72\x2a\x3c\x1f\xd3\x84\x28\xd8\xb5\x2c\x56\x37\x8c\x9f\x08\x9d\x6b\x4f\x74\xe8\x52\x5b\x69\xc1\x27\xe3\x89\x8c\x4a\xb0\xd0\x64\xde\x99\xa8\xf1\x1c\xf1\x31\x5b\x1b\xe8\x53\xde\x07\x0c\x73\xcd\x8b\xcf\x59\xc7\x67\x99\xe7\xcf\xe5\x31\x4d\xe3\x0c\xfa\x53\x35\x99\x77\x64\x6a\x92\xd8\xfa\x3c\x64\x85\x58\xec\xa3\x4e\xbd\x8e\xbc\x03\xc9\x9b\xf3\xef\x31\xb7\x8d\x62\x34\x07\x9b\xe8\x9c\x33\x59\xce\x31\x24\x18\xd3\x22\x30\x3d\xb0\x9d\x6f\xb2\x5c\xc0\x98\xcd\x6b\x1e\x22\xc9\x58\x03\xeb\xf1\x3a\xdf\xf9\x80\xca\x27\xd5\x64\xde\x71\xac\x89\x40\xc8\xb5\x33\x85\x0d\x6f\x08\x69\x5d\xf3\x83\xd4\x78\xc7\xae\x1d\xef\x07\x38\xb1\x43\xe6\x1d\xbb\x7e\x8b\xf6\x5b\xcc\xcc\xf4\x6a\x48\xe7\x5a\x86\x6f\xd1\x72\x2d\xe4\x5a\x09\x98\xc5\x26\xf3\x4e\x60\xff\x54\xdf\x24\xfb\x53\x89\x56\xff\xdf\xfe\x75\x26\x19\xaa\x06\x65\x0c\xc3\x3d\xd6\x9f\x8a\x93\x0c\x57\x31\xd7\x6e\xfc\x29\xbf\x49\xfe\xa7\x14\xad\xfe\xbf\xfd\xeb\x4c\x72\x54\xc5\xaa\x18\x86\x7b\xac\x3f\x25\x27\x39\xae\x62\x75\x8c\x68\xeb\x54\xc2\x04\x16\xb5\x62\x26\xd6\x2a\x2a\x8c\x5b\x56\x34\x00\x05\x15\xc1\x2d\x2b\x78\x1c\xe3\xcc\x31\x48\x58\x0b\xc0\xec\xc4\x83\x84\x89\x28\x6c\x77\x82\x1a\xe7\xfe\x0d\xb4\x46\x85\x7f\xb8\xd8\xab\x4f\x3d\x43\x3c\x97\xcf\xfe\xcf\x9f\x61\x4f\xc1\xfa\xa1\x9e\x90\x71\x2c\x28\x49\x71\x8e\x88\xf8\x30\x27\x4f\x71\x8e\xfa\x40\x2f\x23\x0a\x5a\xa4\x38\x47\x7d\x60\x4e\x99\xe2\x1c\xf5\xf9\x03\x72\x68\x8a\x73\xd4\x07\xe6\x54\x29\xce\x51\x1f\x20\x97\x5f\x53\xd0\x3a\xc5\x39\xea\x03\x73\x58\x8a\x03\x7f\xee\x26\x25\x87\xbf\x0e\x4f\xc9\xe1\xc6\x6c\x53\x72\xe0\xb7\xb3\xc6\x7b\xb5\x60\xa6\x5c\x93\x70\x6c\xbc\x0d\x69\x19\x09\xca\x4c\xe2\x34\x50\xeb\x76\xc7\x49\xdf\x69\xcc\x6a\x8f\x8c\x08\x49\xdd\x1e\xf8\xb8\x5f\x14\xbb\xdd\xee\x50\xe9\x07\x61\xbd\xe0\x2b\x42\x5f\x87\xf4\x7f\xd4\x3c\xda\x8c\x64\xa0\x9e\x85\xf4\x7c\x8b\xe8\x06\xaa\x9b\x90\x5a\x2a\xd1\xdf\x50\x95\x77\x6d\xd2\xb6\xa2\x5a\x1e\xed\x86\xc9\xcb\x67\x5e\xc2\x8c\x22\xcd\xd0\x10\x88\xd2\x54\x45\x16\x89\x12\xce\xd7\x95\x8b\xf7\x2d\x84\xfd\x96\x28\xcb\xec\x4a\xd4\xe7\xf2\x1c\x3f\xe6\x34\x35\xc7\x53\x5b\xbe\x28\x83\xdd\x1c\xa3\x40\xb6\xdb\xd9\xf5\x22\x1b\x59\xa5\x22\x52\xa5\xfe\xae\x1d\xe5\x07\xb5\x6e\xb5\xb0\x37\x90\x10\x90\x70\xe2\xc5\x67\x99\x66\xa5\x61\x72\x7c\xb4\xc3\x4e\x7e\xd7\x97\xb9\xd3\x5b\x0f\x60\xdc\x35\xb7\x8e\x7e\xa3\x74\xda\xe1\x9b\xba\x8d\xf3\x37\x16\xe1\xae\xb6\x67\x25\x06\xde\xdf\x2e\x11\x72\x2f\x52\xc9\xdd\xa6\x30\x78\x9b\xf9\xf4\xde\x8b\xe5\xf9\xce\xef\x72\x50\x08\x48\x91\x80\xb4\x4a\xaf\x1d\x90\xb5\x29\x13\x20\x75\x97\x58\x8c\xbb\xa4\xe1\xc3\x27\xff\x5e\x9e\x9d\xde\x7b\x22\xe5\xf2\x2c\x5f\xc6\x0e\x2d\x77\x3f\x8a\x4e\x9f\xf7\x69\xfd\xbb\xc6\xf6\x5f\x58\x42\x17\x26\x7c\xa2\x1b\x92\x64\x61\x42\x37\xbf\x61\x09\x24\x4c\xd0\x39\xfc\x90\x04\xd7\xd0\x6b\x57\xa7\x3d\x90\x56\xf7\xb8\x0b\x2c\xa3\x3e\x33\x4c\xfc\x57\x7d\x90\x83\x44\xb0\x33\x62\xcf\x3f\x80\x24\xb8\x36\xbf\xd3\xe1\xd4\x96\xb5\x9f\xf4\x5c\x87\xd3\x7b\x67\x59\xe6\x21\xa8\x49\x62\x8d\x14\xbb\xdf\xc1\x88\x3b\xbc\xd6\x1d\xdc\x9f\xda\xb3\xce\x3c\xc6\x45\x8a\xdd\x41\xdd\xea\x29\xde\xba\xcf\x2e\xd8\xc2\xbd\x05\x95\x3d\x09\x34\x09\x61\x91\x72\x6f\x41\x65\xe5\x4e\xc4\x47\x24\xc4\x35\x04\x7a\x82\x9e\xcd\x71\x84\x6e\x09\x24\x40\x86\x1f\x35\x20\xd4\x36\x41\x83\xf7\xa8\xc4\x9c\xf1\x14\x26\x19\x03\xa8\xcd\x43\xda\x12\xa7\x2d\x7f\x68\xb5\x4b\xba\xf1\xf8\x45\xa9\xd9\x0f\xb5\x9d\x9d\x31\x52\xf2\x33\x21\xfe\x8d\xaf\x4f\x7e\x6b\x7b\xbd\x98\xc0\x00\xf2\x14\x40\x67\xc9\xdb\xc3\xd6\x61\x60\x65\x08\x76\x05\xc0\x42\x00\x75\xeb\x17\x73\x3e\x87\x20\x90\x2c\x44\x30\x5f\xd2\xae\x79\xd4\x82\xa9\x48\x1e\xc4\x2c\xef\xfd\xbd\xbf\xab\x05\x85\x28\xc3\x88\x49\x89\xc7\xc0\x67\x0c\x81\x9d\x08\x9b\x37\x3d\xac\x25\xd9\x49\xbb\xaa\x2f\xe3\xf7\x4d\x10\x45\xe7\x71\x79\x8e\x90\x97\x71\x39\x66\x28\xb2\xb8\x1c\x39\x1a\xf5\x1f\x77\x5d\x63\x4d\x48\x8e\xb4\x03\xfa\x8f\x3b\x83\x59\x35\xa6\x68\x56\x36\x6e\xad\x17\x31\x2b\x75\x7f\x9a\x9a\xf7\x77\xbe\x2a\x9d\x4c\xf7\x2e\xd3\x00\x77\xf2\x31\xae\xb9\xfa\xc8\x7f\x23\x7d\x82\xfa\x82\xbb\x15\xd0\x51\x4d\x96\x62\x15\x69\x85\x5b\xe2\xfc\xd6\x96\x08\x89\x3b\xcb\x99\x10\x84\xb0\xc4\x9d\xdb\xb4\x04\x53\x8c\xfb\x0d\xb5\xe6\x29\xbf\x56\x8a\xd0\xb9\x9b\x65\xad\x2b\x71\xc2\xcc\x8b\x29\x22\xd5\xca\xfb\xd7\x1b\x10\x1d\xfe\xf6\xad\x5f\xd2\xfa\x2a\xa9\xdf\xaf\xc3\x60\x8e\xbb\x06\xd3\x0b\x08\x3e\xef\x38\x58\xcb\xa2\x5a\x13\x5c\x0e\x51\x7a\x13\x25\x6c\x11\xd4\x41\x82\xbb\x10\xf9\xd1\x0e\x83\xfa\x50\x98\x9f\xd1\xc6\x11\xda\x23\x1f\x41\x10\x71\x82\x59\x0f\x1b\xdf\xd9\x05\x04\x11\x6f\x59\xa5\xdf\xcf\x18\x35\x2c\x6b\x43\x32\x74\xa1\xfe\x3a\xea\xeb\x3a\xc1\xb3\x24\x7b\x34\x86\xe7\xad\x9f\x74\xc8\x58\x58\x9d\x27\xd4\x66\x2d\x06\x21\xb2\xc6\x5b\xc9\x3d\x86\xb5\xdf\x0e\x4c\x60\xa5\xbb\x9f\xdb\x44\xb6\xf3\xae\xe3\xf3\xc6\x97\xbf\xc1\xfa\x67\x4d\x97\x40\x8c\x77\x44\x25\x78\x76\x26\xe0\xca\xf6\x2c\x2a\x31\xd1\xa0\x60\x8d\xdb\xde\xb9\xd1\x64\x88\x92\x5c\xab\x6a\x55\x04\xa1\x72\xed\x6a\x89\x2e\xcb\x35\x8e\x25\xba\x2c\xd7\x24\x5a\x55\x8e\x50\xb9\x06\xd0\xaa\x0a\x84\x8a\x9d\xbf\x98\x3d\x1f\xc2\x7c\x00\xd7\xea\xed\x39\x22\x60\x15\x3f\x17\x69\x3a\x89\x0d\x3f\x05\xeb\xdb\x88\x7e\x4b\x6b\x0d\x03\xc4\x19\x30\xf1\x55\xdf\x20\x83\xa5\xfb\xf7\x2c\x36\xd1\xcf\x6e\x21\x61\xad\xdb\x89\xac\xf6\x08\x79\x6e\xd6\x1d\xe3\x63\x16\x18\x0e\x8d\x73\xb6\x09\x0b\x4b\x72\xbb\xda\x9e\x69\x51\xa7\xe7\xc3\xcd\x5a\xc4\x0b\x01\xae\xa3\x62\x1f\xc1\xa3\xd6\x71\x7f\x2e\x02\x32\xdc\xa1\x3f\xb6\xff\xd2\x0f\x1c\x7f\x83\xc9\x13\x55\xd1\x53\x32\x02\x51\xa4\x6b\x82\xa4\xb8\x5f\x59\x57\x04\xfb\x03\xe8\xb9\x74\xac\xd4\xfd\x8c\xba\x48\x64\x4d\xeb\x73\x89\x38\xa5\xe7\x7b\xb3\x55\x52\x3f\x0b\xba\xf5\xf0\x10\x22\x79\x1d\x11\xf3\x2f\x84\xd8\x35\x58\xb6\xe4\x8e\x4b\xa5\x63\x07\xaa\xe5\x7d\x1c\xa4\x65\xc1\x1c\xd7\x76\x1d\x1f\x59\x5b\x81\xfb\x96\x0d\x0a\x42\x64\x5c\x9e\x17\x7d\xcb\x3b\x9f\x27\x85\x11\xab\xb8\x18\xb9\xe8\xe4\x5d\x1c\x81\x5c\x75\xfe\x4c\x3f\x66\x8a\xff\xd5\x8a\xcf\xbb\xa9\x31\x77\x6d\x26\x74\xce\xc7\x38\x3c\x6e\x88\x59\x81\x78\xee\x2e\x46\x6f\x93\xc6\xd9\x57\x91\xb0\x3c\x3f\x5f\xf6\x5d\xac\x40\x71\x26\x5c\xab\x82\x3b\xee\xf7\x36\x2c\xe1\x2d\x10\xf1\xbc\x5b\xfc\x94\x45\x28\x79\x15\x2c\x17\x37\x0c\x8a\x3a\x20\xfe\x9c\xf9\xf4\xfc\x37\x42\xec\x8e\x45\xbd\xe0\x2e\xe5\xc1\xc0\x15\x5f\x76\x81\xe2\x4b\xfc\xb6\x8f\x78\x2e\x61\x36\x0d\xfa\x3a\xee\x71\x94\x41\xb5\x0a\x15\x6f\x87\x8e\x89\x8f\x83\x5a\xbb\x92\xbc\x0b\xd5\x41\x1f\x67\xc2\x5a\xef\x95\x61\xd7\x0f\xab\x9a\x4b\x84\x2a\xf4\xd5\x0e\x35\xee\xc3\xf3\x00\x42\x9b\xef\x61\xc4\xf4\xb9\x36\xa4\xe6\x5f\x48\xb5\x08\xa8\xcd\x45\x2b\x6e\xa7\x4b\x0a\x19\x00\xe8\x0e\x88\xd4\xfb\x21\xda\x1f\x7c\xdb\xe7\x23\x5a\x9e\x90\xa0\x92\x21\x94\x79\x50\x49\xe0\xa5\x3d\x21\x45\x58\x5a\x21\xa4\xae\x51\x32\xa5\x1e\x09\x16\x30\xbf\x96\x46\xd5\x98\x5f\x5c\x45\xd5\xa8\x5f\x5d\xc7\xe5\x98\x5f\xee\x1a\x27\x23\x3f\x52\x17\x60\x7e\x79\x13\x55\x63\x7e\x39\x8f\xaa\x51\xbf\xbc\x8d\xcb\x31\xbf\x5c\x44\x1b\x0e\xdf\xcf\x25\xc0\xc0\xb4\x82\xbb\xc4\xf9\xf7\x83\x4b\x7c\xe9\x5d\x50\x89\x28\xd3\xbb\xc5\x34\xca\xa3\xde\x88\x72\x73\x12\x55\x63\xca\x76\x47\xb8\x29\xfb\xf8\x6c\x98\xb2\x8b\xa8\x1a\x53\xf6\x69\x9c\xef\x66\x71\x51\x3a\x57\x27\x82\xe0\x8e\x75\x53\xfe\x45\x42\xe1\x2d\xa9\xf9\xf2\x7d\x17\xcf\x1b\x9f\xff\xd6\x0e\xfd\x6f\xfd\x57\x5a\xea\x76\x59\x2b\x7d\xef\x97\x75\x0b\x40\x02\xaa\xdd\xce\xaa\xa7\x81\xcb\x04\xef\x91\xad\x55\xeb\xab\x5c\xbb\xfe\x83\xd5\x6e\xf9\x56\x6d\xbc\x10\xb6\xcc\xda\xb0\xde\x1d\x28\x56\x6f\x9c\xe3\xd0\xfa\x2e\xf8\xfb\x2f\xfc\x82\x2a\x0b\x12\x2e\xfd\x8a\x8a\x04\x19\x97\x7e\x89\x37\x7c\x8d\xb5\xd8\xb7\x94\x80\x8e\x9c\x75\xfc\x0b\xd4\x11\xcf\x4b\x4d\xf0\x61\xe8\x37\x47\x9e\xd7\x81\x0a\xc8\xa8\xb3\xf3\x36\x4c\xbb\x40\xe8\x53\xdf\x7d\x57\x06\x32\xdc\xfa\xef\xe3\x6e\x87\x08\xa5\x5d\x2d\x11\x90\x3c\x01\x31\x3e\x65\x08\x46\x91\x60\x6c\xaf\x34\x60\x48\x99\x80\x7c\xf4\xf3\xaa\x67\xe3\x63\x57\x05\xb0\x68\x82\xa5\xd7\xaa\xe8\x4a\x55\x09\x10\xb6\x32\xee\x92\x44\x0f\xb4\xab\x7a\x16\xd1\x5f\x6b\x94\xd2\x4b\x0a\xab\x7b\x98\x5a\xd6\x83\xb5\xff\x1b\xa6\xa8\x04\x05\xd9\x73\x4b\x6f\x07\xb7\x89\xae\xd6\xa4\xf0\x56\x8a\xfa\x75\xd1\x38\x3c\xcc\x19\xcc\x7e\x6b\x92\x94\x13\xee\xf6\x11\xdb\x84\x47\xc8\x5e\x52\x21\xf4\x55\x5c\x9f\x97\x08\x7d\x1d\xd7\x17\x39\xac\xf7\xee\x1f\xf5\xa5\x15\xb6\x4f\x37\x4d\x58\x78\x9c\xa4\x27\xe5\xa5\xe7\x2f\x70\x18\xbc\xc3\x73\x0d\xd6\x93\x90\xde\xee\x9f\x5f\x7e\x4c\x20\x25\x47\x50\x6c\x7c\x5d\x10\x55\x20\x50\xaf\x10\x42\x30\xcf\x35\x64\x7a\xbc\x1e\x4c\xd3\x48\xf0\x84\x56\x7a\xd9\xe9\x34\x61\x8b\xb6\x8c\xd6\x57\x81\x48\x4e\x36\x3e\xfb\xee\xda\x02\x33\xdc\xee\x69\x0a\x7e\xaa\xfb\xc7\x71\x25\x0c\x88\x59\xa8\x49\x2d\x45\x13\x74\x36\x2f\xc1\xed\x6b\x02\x54\x93\x36\x10\xef\x88\x0f\x05\xb3\x38\xc4\xda\xbd\xb2\x60\x54\x0b\xa1\xb6\xd6\xd6\x4b\xfa\x79\x1a\x87\xe3\x8c\x18\xe0\x8a\x28\x37\x43\xa8\x65\x54\x4d\x10\x6a\x15\x55\xc3\x46\xa9\xcc\xba\xa8\xba\x80\xd5\x24\x8b\xaa\x61\x83\x5a\x12\x12\x55\x53\x84\x3a\x68\x50\x8c\x1a\x9e\x0c\x4a\x52\x44\xd5\x35\xa8\x26\xec\x47\x3f\xba\xa9\x3f\xe3\x08\x6f\x40\x08\x13\x41\x5d\xc7\xc5\x3a\xc2\xa3\x8a\x30\x79\x36\x54\x57\x11\xee\x17\xeb\xef\xcb\x3a\x3f\x4c\xbb\x6d\xaf\x05\xf6\xa8\x69\x30\x87\x84\x39\x37\xc5\x97\xc7\x6c\xc2\xa0\xc2\x76\x2f\x6b\xca\xe0\x9b\x21\xb0\x74\x2f\x38\x40\x3f\x3b\x15\x80\x07\x0c\xf1\x52\xa0\x0c\xfd\xa2\xd3\x42\xf5\xe3\xac\x93\x7a\x82\xda\xa2\x09\xdd\x0b\xeb\x94\x1e\xa0\xb6\xc8\xdb\xa8\x16\x65\xa4\x49\xa1\xce\x80\x5e\xdf\xe4\xeb\xd5\x17\x1f\xfa\xb7\x3b\x6e\x2b\x53\x74\x61\x8e\xe0\xe2\x5d\x3d\xfb\xbb\x98\x15\x0e\x54\x66\x61\x90\x7d\xcc\x0a\xcb\x49\x58\xbe\xbd\x15\x85\xf5\x79\x58\xff\x36\xf7\x12\x5d\x87\x22\xc1\x40\x57\xa4\x4c\x40\x90\x9b\x86\x92\x86\x19\xf6\xa3\xec\xf7\x2c\x5f\xb0\x83\x26\x09\x6c\x80\xa4\xb2\x6f\xb3\x76\x5f\x61\x1c\xc9\x6d\xde\x37\x35\xde\xd4\x3a\x7f\x5f\x45\x31\xd2\x94\x10\x06\x46\x50\xe0\x37\xc1\x04\x7f\xed\x7f\x28\x97\xc3\x71\x09\xd0\xbb\x8b\x2b\xbd\xc0\x73\x4b\x3f\xae\xa1\xd2\x0c\x2f\xae\x8a\x79\xa9\x65\x72\x8e\x4d\x03\xbf\x23\x06\x7f\xad\x12\x6a\xf5\xef\xd7\x6d\x76\x12\x53\xe6\x2a\xfa\xd2\x12\xd6\x76\xe7\xbb\x53\xbc\xda\x0b\x3b\x10\x78\x6b\x09\x03\xca\x10\xe0\xf5\xd8\x12\x06\x64\x21\xc0\xf1\x5e\x12\xd2\x17\xde\xc9\xaf\x49\x87\xd9\xf6\x7c\x7b\x82\x02\x89\x49\xc3\x03\x7d\x58\xef\x1f\xf9\x0c\x17\x4d\x9a\x36\xa1\x1e\x27\x35\x73\xd4\xec\xdf\x88\x00\x66\xd0\xbd\x67\x78\x62\xe4\x32\x20\x7f\xc3\xca\xb3\xd6\xed\x3f\xcb\x3b\x97\xe3\xe7\xf1\xa0\x02\x2c\xdb\x0b\x98\xba\x4c\xfa\xde\x16\xae\x70\xd9\x9c\x45\xb8\xb9\xb6\xe4\x67\x25\xff\xea\xe1\x35\x0d\x29\xdb\xb3\xd2\x84\x66\x45\x6e\x26\x48\x29\xce\x00\xfe\x85\xd9\x64\x93\x52\x9e\xa5\x9b\xc7\x8f\x59\x1f\x28\x89\x60\xa8\x33\x43\x3b\x1b\x61\x01\xd4\x73\x0f\x35\x46\xe2\xf5\x22\x78\xc6\x0c\x34\xca\xc9\xd9\xd0\x5c\x66\xb8\x83\xd5\xce\xc9\x2f\x44\x59\x96\xf0\xfe\x84\xf2\x22\x81\xc8\x4b\xc4\x99\x11\xe5\x65\x02\x51\xe6\x25\xbc\x43\xcb\x5a\x15\x18\x74\x7a\x67\x6f\x8c\x0f\x24\x2f\xaa\x26\x20\xd7\x33\x98\x75\xa3\xc0\x10\x78\x92\x00\x8f\xdd\xa2\x6a\x93\x04\x84\xe5\x61\xa1\x46\xd0\xe7\xc2\xb6\x11\x10\x80\x2e\x05\x40\xd8\x9f\x26\x4b\x01\x10\x3f\x21\x57\x01\x80\x71\xfe\xde\xc3\x1b\xe1\x26\x12\x2f\x52\xdd\x34\x2e\xaf\x47\xec\xd6\x35\x04\xd7\x2f\x48\xcd\x01\x8c\x99\xe0\x10\x9c\x16\xe0\x98\xea\xd8\x24\x47\x30\x4c\x00\x30\x5b\x29\x1c\xad\x50\xea\x1c\xff\x6d\xd2\x2f\xd3\xfb\x09\x21\xee\xe2\x62\xdc\x87\x6a\xdd\x96\xf9\x16\xb3\x68\x75\xb0\x21\x84\x4e\x9c\x75\x08\x73\x55\x78\xae\x10\x56\x07\x6b\xdc\xce\x64\x34\x1c\x14\x65\xad\x6b\x9e\x8f\x63\x57\x1d\x75\x7e\x49\x3f\x02\xcb\xda\x3c\xaa\x74\xc2\xde\x83\x14\xd7\x2e\x1b\x43\xf4\x6b\x92\x67\x9e\xf5\x20\xf0\x2a\x35\xab\x3d\x39\x3b\x08\xa2\x49\xd0\x9b\x79\xa4\x86\x44\x55\x49\x94\x49\x04\x8d\x24\xd5\x49\xd2\xe6\x7f\x8a\x43\xb1\x24\x4a\x37\xd4\x6e\x76\xd2\x9c\x26\xc9\xb1\xed\x84\x23\xf1\x24\xc9\x34\x13\x0e\xd4\x26\x41\xb6\x95\x50\x24\xe9\x4e\x11\xb6\x23\xad\xbc\x45\xb4\xae\x24\xe7\x2a\xbc\x6e\xc7\x2e\x91\xf2\xe4\x8f\xb9\x84\xf2\x56\x3f\xc6\x5f\xf5\x92\xdc\x1b\x5a\x46\xfe\xcf\x7e\x19\x8d\xf1\x7e\xfb\xfb\xdc\x71\xe6\x09\x51\x23\x42\xd6\x11\x39\xae\xab\xb0\x88\x7a\x8f\xa1\x03\xe9\x9b\x88\xfe\x38\xf6\x4a\xcb\x79\x44\xae\x07\xf2\x7e\xd6\x94\x26\xb4\x11\xc2\x66\xea\x50\x0c\x11\x61\x98\xc1\x8b\xab\x86\x8c\x20\x36\xe3\x86\x62\xa8\x08\xe3\xf0\x0a\x43\x72\xba\x08\xe7\xf0\xf0\xc2\x71\x5a\xb7\x59\xf6\xf5\x8f\xcb\x03\x01\x32\xea\x69\x7e\x01\x43\x24\x3d\xef\xc2\x74\xd4\x8b\x95\xdf\x57\x1b\x3d\x63\x79\xdc\x93\x2b\x05\x22\xab\xe0\x4f\xb9\xc6\x70\x0d\x89\xf1\x59\xdf\xfd\xbe\x1f\xf7\xb4\xd0\x1d\x23\x6f\xc3\xd8\xee\x1e\xb5\xb0\x92\xc7\x94\xfb\x35\x4b\x1a\x50\x11\xb7\xce\xc6\xcb\xee\xa6\x96\x77\x58\xe6\xb6\xf8\x3a\xf7\xfc\xfe\x36\x28\x94\x94\x0a\xb7\xd3\x2c\x43\xaf\x1d\x38\x85\x78\xdc\x00\xe1\x8f\xf3\xe2\x59\xd9\x54\xeb\xa0\xc8\x9d\x66\x74\xb8\x34\x7d\x6b\x73\xeb\xa5\x8e\xd0\x04\x6a\x8b\x80\x76\x1c\xa4\x5a\xa0\x72\x19\x91\x75\xa8\x81\x86\x1e\x23\x65\xe7\x62\x37\xbf\x01\x09\x55\x9a\x51\x91\xa5\xd4\x5b\xc2\x38\x88\x41\x60\x86\x49\x1a\x07\x56\x26\x87\x41\x3a\xb8\x10\xe6\x77\x15\x30\xea\xb8\x13\x84\x58\x6e\xa7\x9f\xc9\xa3\x7f\x7e\x14\x1d\xac\x72\xfb\xbc\x51\x89\xf2\xd1\xe2\xa4\xae\x75\xb1\xd2\xa2\xc3\x29\xdd\x7e\x64\x94\x77\xac\xd2\xeb\x46\x5a\x29\xca\x0e\xaf\x76\x4d\x92\x29\x77\xcd\x91\x4a\x1e\x52\xa2\xcb\x6d\x43\xea\x0b\x35\xcf\x94\x5b\xfe\xf4\x3e\xde\xdf\x9e\x9f\x3d\x20\x71\x0b\xb5\x12\xb3\x71\x06\x75\xae\x29\xd3\xee\x4e\x47\x68\x98\x7d\x06\x01\x08\xb2\xa2\x81\x69\x47\x7d\x6d\xc1\xa3\x47\x95\x74\x70\x96\x55\x1d\x50\xef\x4f\x95\xf0\x14\xdf\xde\xfc\xfb\xd1\xeb\xb5\xd5\x8e\xb3\x0e\xbb\x8f\x7b\xbf\x2e\x30\xc9\xed\xe6\xf6\xe8\x70\xbe\x50\x0f\x77\x54\xaa\x55\x90\xe7\xfc\xd6\x32\x48\x06\x39\xcb\x81\xf2\x3a\x2e\x2f\x72\x48\x2e\x65\x13\xba\x33\xd4\x57\x06\x6a\x06\xc4\xdb\xdd\x65\x99\x00\xe8\x1b\x50\xa0\x06\x0d\xa9\x42\x80\xa1\xbf\xff\xad\xa4\xc9\x44\x60\xf2\x30\x8e\x0f\x04\xa9\x4e\x90\xfa\xfb\xf4\x58\xad\xd5\x07\x39\x2c\xc1\x19\x1f\x2b\x16\xc4\xea\x2e\x04\xda\xda\xa6\xbf\x7f\x8c\x62\xbb\x1a\x05\x40\xb9\xe7\xd0\x3e\xf0\x6f\xed\x3f\x32\x8f\x1f\xe3\xdf\x7a\xa0\x6c\x47\x66\x69\x46\x96\x79\xce\x5d\xda\xb1\x74\x79\x72\xf9\x2f\x2e\xd4\x5d\x7c\x03\xbf\x24\xcb\x3c\x77\x2e\x2d\x36\xd3\xde\x74\x85\x20\x02\x0b\x8a\x4b\x55\x90\x01\xc0\xc5\x6a\x30\x29\xdd\x4f\xa2\x4f\xb4\x8e\xcf\xa2\x73\x00\xeb\x94\x7e\xc7\xe8\x01\x3f\x0b\xcf\x05\x04\x6b\x87\x51\xfc\x0d\x83\xbc\xd7\xb6\xba\x56\x62\xbc\xb5\xbd\xbe\x03\xfa\x7d\xf5\x1a\xff\x05\x8c\x57\x3d\x3b\x18\xae\xa0\xca\x18\x6a\x1b\x0f\x68\x16\x93\x2a\x03\x58\xaf\x41\x0f\x91\x48\x8c\xb4\x8e\x2b\x1f\xae\xd7\x4d\x51\x1e\x23\x5e\x1f\xb5\x22\x6f\x62\xb0\xeb\x93\x53\x93\x8b\x68\xcf\xe5\xeb\x78\xeb\xc5\x53\x8c\x0f\xed\x4b\xb9\xdd\x71\xc0\x40\x49\x71\x40\x90\x94\x09\x19\x23\x1d\x3f\x50\xf7\x5d\x18\x24\xeb\x18\x68\x6b\xfe\x65\x1d\x4d\xc0\x66\xdc\xb8\x52\xde\x2d\x6d\xd7\xcf\xcb\xea\x04\x95\xfc\xd0\x29\x91\xc7\x3b\x0c\xf1\x8d\xef\x6f\x18\xb9\xf7\x1e\xe8\x71\x97\xaa\x33\xa3\x7b\x03\x01\x6a\xc1\xdc\x66\x99\xe6\xfe\xd6\x6b\x9f\xe7\xe5\x88\x89\x24\x81\xf2\x9b\x82\xe4\x91\x70\xc8\x52\x75\xfc\x31\xac\x76\x52\x01\x3f\x50\x41\xea\x90\x5f\xe4\xc6\x42\x31\x98\xe4\x2c\x52\x17\x27\x34\xb3\x41\xbd\x62\x33\x43\xc4\x3a\x42\x74\xe2\x4f\x1b\x22\xa2\xa5\xb2\x26\xe4\xfd\x23\x9f\xed\xf7\xa9\x33\x02\x3f\xb4\x2e\x49\x20\x75\x6b\xdb\xdf\xf9\x7c\xa4\xac\x87\x10\x5e\xa8\x67\x9d\x26\xe8\x07\x46\x0f\x2b\x0e\xb7\x50\xdd\x75\xf1\xaa\x20\x19\xcc\xbb\x51\x30\xcf\xf9\x9f\xe3\x7d\x00\xa7\x5c\xe6\x1d\xfb\xef\x1f\xc6\xa6\x79\x01\xa5\xe2\x2c\xbd\xf1\x69\x02\xbf\x23\x63\xad\x0c\x4a\x8f\x3d\x36\xa0\x2f\x3b\xf7\xd7\x6e\x77\x5c\xab\xba\x8b\x1e\xdc\x08\x94\x9d\x3b\x89\x3c\xee\x8b\xf5\xe6\xd0\x1b\xb2\xbc\x7c\x82\x1b\x02\xe6\xc5\x00\x32\x25\x6b\xff\x07\x5b\x36\xa4\x6e\xf2\x56\x85\x6c\xc5\xb3\x1d\x1f\x76\x5b\xd5\x8e\xa0\xbd\xf1\x93\x83\x6c\x2f\x85\x01\x0d\xf4\x66\x08\x2a\xd2\x4b\x70\x7d\x1c\x07\x97\xfb\x0b\x67\x50\x4e\x43\x72\x86\xac\x7c\x56\x66\x61\x39\xa4\xf3\xc2\x3d\xd9\x28\x8f\xa0\xc4\xb5\x55\xf3\x5b\x4b\x9f\x9c\x40\x22\x59\xb9\xe6\x5b\x8b\x2a\x0a\x17\xe4\x5a\x1f\xcc\x4e\x34\xf3\x52\x71\xe8\x5f\x03\x4a\x84\x97\xc7\x7d\x73\xb6\xd1\xd6\x51\xf7\x34\x50\xeb\xb6\x84\x79\x95\xea\xeb\xed\xa3\x2b\x80\xa2\xbc\x14\x1c\x9a\x72\x3c\x31\xf0\x5f\x96\x6c\x2f\xb9\x40\x9c\x38\x4f\x70\xff\x04\xe7\x2d\x40\x92\xa8\x16\x71\x42\xd1\xfe\x7c\x0e\xb1\x3d\xa9\xb5\x8b\x23\xa9\xe6\xfe\xc3\x06\xf3\x37\xc7\x3f\x00\x4c\x7a\xb1\x52\x36\x03\x63\x23\x14\xc3\x3d\xb2\xac\x63\x5a\x70\x08\xfc\x88\xac\xee\x4c\xd7\xaf\x85\x51\x1a\x91\x65\xde\x53\x9c\x89\xaf\xe2\x1d\xde\x2e\x28\x2f\x8f\xa8\x11\xa1\xb7\x1a\xca\xcb\xde\xb9\xea\xb9\x4b\x8c\xf7\x75\x1e\x87\xab\x9b\x16\x55\xbb\xad\x6e\x48\xc7\xbb\x04\x50\x5a\x87\xa4\xcb\xc4\x05\x62\xbc\xa9\x9a\x85\xd4\xfb\x12\x74\x46\xac\x68\x54\xdd\x84\x10\x66\x4a\x45\xfe\x02\x77\x6a\xec\x97\x51\x1f\x25\x40\x4d\x96\x65\xde\x83\x3b\x7d\xc9\x83\x68\xe5\x1f\x03\x45\xe8\x65\xba\xde\x12\x4a\x70\xe9\xa0\x6a\x11\xd6\xaa\x0f\x05\xef\x16\x3c\xdf\x73\x6d\x40\xae\x76\x34\xb7\x67\x6b\xfd\xd1\xcc\x36\x16\x0f\xa8\xff\xb9\x4d\xf4\x3a\xeb\xe5\x53\x04\xa6\x58\x16\x02\x2a\x6d\x9d\xad\xfd\xfa\x0d\x93\x84\x98\x7b\x25\x7f\x71\x74\xab\x58\x1e\x22\x3a\xb5\xfc\x15\xb4\x48\x55\xf3\xfa\xe9\x02\xfb\x79\x8a\x62\x78\xb6\x83\x5c\xc1\xd0\x10\xe6\x68\xbd\x5f\x1e\x7d\xb0\x2a\x44\x75\x5a\xf0\x17\xc8\x26\x84\xfc\xd9\x17\x91\x7b\x79\xc6\x43\xb0\x40\x3f\xc4\xf1\x58\x25\x42\xbc\xbd\x72\x17\x4f\xc8\x18\xab\x64\x08\xe7\x54\xef\x2a\x51\x11\x15\x3b\x14\x0c\x36\xe3\x15\x72\x97\x24\x07\xda\x14\x0d\x6f\x72\x21\x53\xed\xfa\xcb\xb3\x2a\xa1\x80\xd6\xfd\x25\x57\x16\xf8\xca\x22\x68\xe5\xa5\x5a\x82\xc0\x4c\xb4\x21\xe0\x5e\xbd\x8b\x87\x6b\x42\x84\x60\x4e\xed\x2e\xf2\x24\x0b\xf1\xf6\xca\xfd\xe6\xc0\xae\xf1\xbd\xad\x42\x75\xfc\x0d\x96\xf9\xe9\x74\x5e\x9b\xe2\xdd\x09\xa3\x1b\xe7\x63\x11\xf9\xca\x71\x0c\xd4\x35\x57\xee\xaa\xa4\x5f\x9e\x3a\x5b\xb4\xa1\x40\xca\x22\xab\xa3\x67\x4c\xa1\x36\x04\x71\x2c\x8d\x3b\x37\x5f\x9a\xc8\x14\x73\x89\xd7\xab\xc4\x94\x77\x62\xf9\xab\x4a\x6c\xb7\x8b\x50\x45\xe0\x1b\xc6\xed\xad\x66\x06\x57\x08\x71\x5b\x99\x79\x7b\x88\x7d\x4d\x61\x7c\xa5\xc0\x46\xc9\xaa\x2e\xa0\x2d\xc0\xf5\x68\x56\xf1\xc4\x35\x39\x42\x5f\xff\x34\x72\x85\x13\x8b\x4c\xfb\xc9\x01\x80\x96\x76\x81\x7b\x71\xb8\x60\x21\x4b\xf8\x5c\x76\xaf\x48\x21\x9f\x7f\x50\x9b\x6a\xe2\x5f\x39\xef\xfa\xcd\x55\xcd\xba\xdd\x8d\xea\x1f\x44\x39\x05\xc4\x22\xe7\xa1\xe2\xcd\x84\xf3\x8f\xb4\xfb\x17\x05\x19\xe7\x49\xc1\xe3\x60\x3e\xa9\xc8\x45\xa8\x22\x5b\x0d\x36\x10\xc8\x90\x21\xc6\x76\xaf\x60\x3c\x0e\xd7\xd1\xa1\xa6\x71\x0d\x69\xba\x38\x6e\xeb\x19\xaf\xa7\xf7\x40\xdd\xa4\xc8\x03\x5d\x16\xf7\xbb\xa4\xe7\x5d\xae\xb7\xb6\x57\xf5\x2c\x76\xfe\x7b\x09\xd4\xf8\x47\x78\x76\x76\xc6\x2a\xdd\xcf\xab\x7f\xc2\x25\x75\x55\xc7\x7e\xc0\x15\x4c\xf8\x11\xeb\xae\xc5\x0c\xf5\x86\x34\x2a\x8e\x40\xf4\xf3\xa6\xf0\xdc\x09\xf5\x2a\x76\x56\xcb\xa2\xa4\x76\xcd\xe1\x4f\xbe\xac\xe2\x59\xfc\x77\xf1\xdf\x05\xcc\x21\x10\xa7\xc4\x71\x72\x98\x53\x62\x38\x05\x86\x03\x9d\xc5\x35\x85\x28\x21\x0e\xc5\x71\x28\xcc\xa1\x18\x4e\x85\xe1\x40\xa7\xdf\x4d\x21\x6a\x88\x53\xe1\x38\x0c\xe6\x54\x18\x4e\x83\xe1\x40\xf7\x24\x4d\xa1\xb2\x30\x67\x31\x27\xfc\xdb\x75\xc7\x85\x7e\xad\x08\x96\x87\xeb\xdf\x2a\xc7\xf3\x30\xfd\x5c\x15\x57\x78\x70\xff\x52\x25\x96\x87\xeb\xf7\x8a\xe2\x79\x98\xfe\xaf\xaa\x2b\x3c\xb8\xdf\xa9\x1a\xcb\xc3\x8d\x07\xc5\xf0\x3c\xcc\xb8\x50\xcd\x15\x1e\x38\x3e\xb2\xac\x09\x4c\x18\xe2\xd1\xea\x77\xd4\xd3\xe6\x66\x0d\x32\x78\x62\x6d\x74\x95\xe5\x4e\xc4\xfb\xf4\x7f\x95\x21\x61\xc6\x16\xe4\x01\x44\xa9\xc8\xd2\xe6\x6a\x95\xba\xd8\x0a\xe1\x97\x40\x2f\x95\x8f\x5d\x65\x5c\x25\x74\xc1\x25\xcf\x45\x4a\xc5\x63\x3f\xec\x17\x38\x26\xbd\x18\xbe\xef\x7c\xe8\x9e\xdd\x30\x72\x68\xdd\x43\x9a\x8c\xc4\xae\xd7\xc0\x22\x4b\x16\x93\x42\xd7\xb9\x0d\xc9\xf2\x40\xcf\xcf\xa5\x97\x39\x0d\xd5\x8e\x24\xa3\x01\xd2\x3e\x86\x7e\x47\x74\xb7\x3d\x7b\x2f\xfb\x1d\xe9\xe7\xe2\xf8\x9f\xd1\x64\xac\xc7\xfc\x03\x2c\xcb\x65\x1b\x68\x40\x1d\x14\xd2\x04\x70\x46\xdd\x05\xe5\x52\xc4\x18\xda\x95\xc8\x30\xe0\xb3\x33\x29\x63\x10\x53\x11\xed\x56\x05\x33\x54\xb2\x22\x28\xbf\x31\xda\xba\x5d\x6a\xbb\xb6\xb4\x9d\x71\xef\x57\x00\x41\x76\x55\xec\x53\x91\x6c\xfb\x03\x7b\xcc\x77\xee\x8c\x7a\x4d\x9b\xfb\xce\x0a\xfc\xcb\xb4\xe3\x34\xce\xf0\x99\x7d\xee\xed\xe8\x76\x99\x0e\x71\x62\x23\xae\x60\xbc\x07\x72\x2a\x43\x0c\xe3\x1a\xb4\x07\x8e\x00\x11\x5d\x08\x61\x9c\x06\xae\xba\xa4\xb3\xda\xf3\xb1\x2a\xa4\x78\x7e\x3d\xf9\x2d\x99\x5b\xb6\xf6\x7c\xab\x8c\xe4\x1b\xd2\x08\x2f\x8f\x24\x5f\x85\x5e\x74\xc3\xa2\xe2\x2c\xe2\x4f\xf5\x35\x0d\xbd\xe8\xf7\xb4\x70\x00\xa4\x56\x2a\x04\x79\xb9\x7f\x28\x89\x04\x79\x0e\x4a\x7f\xca\x2c\xdb\x9d\xf7\x00\xa1\xf0\x9c\x2d\x4d\xe8\x78\xef\x1c\x4e\xfb\xd0\xa7\xf5\xee\x27\x32\xe1\xeb\x2f\xea\xab\x94\x7e\x1f\xb8\x08\x0e\x4f\xfd\x8e\x0b\x1c\x77\x04\xea\x4c\xc6\x6a\xde\x18\x7b\xbe\x45\x90\x21\x3d\xaf\xaf\x88\x03\xab\x67\xf0\x61\xa2\x6b\x53\xf4\x91\x1e\xba\x32\xac\x53\x01\xab\xb8\xae\x73\xdf\x6e\x13\x8d\xec\x3f\xfa\x65\x84\xbf\x55\xe3\xe7\x3a\xde\x62\xce\x7d\xa8\x79\x51\xcf\x59\x2f\x2d\xcd\xe1\x28\x82\x52\xc5\x5d\x4d\x21\x35\x2b\xba\x3c\xbc\x1f\x38\x32\x3c\xeb\x34\xca\x05\xdc\x2e\x45\x57\x20\x40\x14\xd3\x2a\x79\x60\xee\x7a\x2c\x7a\x31\x88\x10\x17\x91\xae\xe2\x9c\x80\x23\x28\xee\xe8\xdf\x23\x9c\x29\x79\x5c\x86\xd9\xe4\x94\x9b\x4b\x31\x84\xcb\xe4\xf9\xb5\xc9\xd0\x4f\x47\xce\xbe\xe5\xc9\xa7\x29\x49\x28\xfc\xc4\xe8\x9e\x3a\xc3\xa8\x49\x4c\x4d\x30\xea\x3c\xa6\xce\x31\xea\x22\xa6\x2e\x30\xea\x32\xa6\x2e\x31\x6a\x1a\x53\x53\x8c\xba\x8a\xa9\x2b\x8c\xba\x8e\xa9\x6b\x50\x8d\xb2\x9a\xd3\xf4\x9f\xb6\x9a\xd3\xf4\x6b\xab\x09\x49\xa1\x69\x71\x9a\xfe\xd9\xb4\x08\xeb\xab\x94\xfe\x98\xce\xa6\xe9\x3f\x33\x2d\x42\x1c\xee\x65\xe1\x35\x69\x66\xf6\x60\x86\x80\xb4\x21\x5e\x6c\x71\xf3\x15\xd4\xfc\xa1\xe6\xe7\x27\xef\xd7\xe7\xda\xdf\xd4\xf8\x40\x61\xdc\x61\xbd\x15\x6e\x1e\x59\x22\xa4\xa7\xca\x8b\xf1\x2e\x7b\x73\xe1\x8d\x50\xbb\x63\xda\xa8\x97\x95\xaf\x0f\xb8\x17\x35\x7e\x3a\x37\x23\xed\x06\xfe\x86\x52\xba\xdf\xdf\x2a\xb5\x0b\x00\x46\xe9\x76\x7a\xa3\x7c\x9b\x1e\x7a\xa6\xbc\xf1\xbb\x5c\x9e\xc7\x50\x42\x90\xdc\x31\x60\x37\xae\x7c\x50\x12\xa3\x74\x7b\xff\x15\x9d\xdb\x5b\xf9\xa0\xfb\xeb\xf7\x25\xbd\x6b\x86\xf6\xae\xa5\xbe\xa6\x7e\xc6\xc9\xdd\x2d\xd4\xab\x8b\x2c\x7c\xed\x97\xae\xc7\x21\xdc\x1d\x94\xe9\xe1\x1d\xef\x31\x95\xdf\xdc\x19\xc8\xb9\xc3\x3c\x96\xf7\xd7\xe7\xd3\x2e\x08\x10\xaa\xfb\xf1\x5f\xa0\x4d\xfa\xb7\xfb\xf8\x6a\x93\xff\xef\x7f\xff\xdf\xff\xf5\x3f\xff\xc7\xff\xff\x7f\xfe\xeb\xbf\xe2\xd4\xff\xc7\xde\xb9\xf6\xb6\x8d\x63\x7d\xfc\xab\x14\x7d\xf5\xec\x62\x80\xb1\x64\x5b\xa6\x80\x4e\x01\x5d\x5d\xed\xd8\xb2\x57\x96\xd3\x76\xde\x18\x6e\xa2\xa4\xc2\x3a\x72\x60\x2b\x9d\x76\x3e\xfd\x03\xea\x92\x88\x36\xc9\x73\xe8\x8b\x92\xa6\x41\x31\x03\xb4\xf0\xff\xa7\x43\xf2\xf0\x4e\x1e\xc2\x8f\x40\x42\x66\x11\xa7\x6b\x8b\xa7\xd7\xfe\x42\xd3\xca\xff\x22\xef\x02\xc3\xf2\x44\xac\xfe\xc2\x2c\xfe\x20\x39\x4c\x03\x49\xbf\x3f\xa4\xff\xb3\xa9\x45\x08\x75\x33\x4b\xa2\xa1\x6d\x2e\x10\x5d\x35\xf1\x77\x5e\xee\x1a\x05\x7b\xe3\xe0\x68\x2c\x07\x34\xc7\x57\x63\x6b\x14\x3c\x1e\x51\xc1\xe9\x9b\x0d\x93\xef\xd1\x33\x49\xd3\x87\xce\x1a\x56\x1b\x92\x4b\x06\xcd\xfe\xa5\x24\x8f\xa3\x18\x46\x5a\x1c\xdf\x9a\x87\xe5\x1b\x20\xc1\x5f\x9e\xbb\x70\x26\x93\xc8\x0d\x42\x2b\xf6\x66\x00\xcd\xd4\x99\x7d\xf1\xd9\xd8\x9a\x7e\x98\x75\x3b\xbb\x79\xec\x8e\xa7\x72\x48\xb3\xc3\x29\x21\xca\x88\x66\xc7\xe3\x8e\xa7\xca\xfa\x66\x31\xb3\x25\x0c\x48\x0d\x66\x5f\x7b\x36\x8f\x8a\xf7\x7c\xea\x69\x0e\x6d\xd5\xbc\x4f\xb1\x1c\xa0\x01\x00\x3f\xf8\x44\xa7\x4b\x56\xec\x2d\xc2\x49\xe8\xc1\x40\x1d\x0f\xac\x6f\x9d\xca\x99\xc4\x66\x76\xaf\xf6\x02\x72\xe8\xee\xe2\xb3\xfe\x19\x82\x10\x66\x95\x69\x16\x39\x5a\x15\x9b\x0b\xd0\xf5\xc9\x9e\xae\x5c\xab\x01\xbf\xd7\xf4\x75\x9a\x73\xe3\x20\x9c\xcf\x14\xbe\x4c\x7c\x5b\x4c\xc0\xd8\xd0\x61\x8f\x04\xce\x22\xa7\x5a\x66\x9a\x59\xf1\xbc\x28\x00\xb9\xde\xec\x32\xfb\x3b\xa3\xea\xc6\x7b\xb5\x01\x21\xd7\x12\xe2\x37\xbb\x59\xda\x7f\x14\xcf\x67\x56\x93\xd4\x62\xac\x5b\x9f\xce\x95\x93\x3a\xec\xb5\x3c\xba\x7f\x37\xf5\xa2\x59\x40\x6f\x2a\xc5\xc5\x8b\x08\x90\x7e\xe7\xea\xc2\x74\xe1\x4c\x3e\x78\x11\x52\xad\x31\x93\x5c\xf7\x73\x68\xd1\xb3\xc0\x0f\x07\x4f\x61\x80\xbe\x3b\x4b\xa6\x1f\x56\xd0\xd3\xd8\x2a\x7b\x33\x6d\xca\xa8\x6e\xc0\xd6\x83\x7c\x2b\x8a\x02\x2f\x42\x10\x89\xae\x35\x8b\xb5\xd2\x07\xe3\xf1\xbc\x0a\x53\x57\xd9\x06\x51\xf4\xce\x3e\xa5\x4e\x57\x39\x00\x06\x08\x0e\x13\x76\x7c\x34\xf9\x48\x2f\x8f\x7b\x3e\x68\xbf\xc3\xac\xc5\xcc\xa7\x53\x9c\xce\x64\x1f\x04\x0d\xbd\x61\x79\x49\x8e\xd6\xac\x78\xb2\x40\x34\x6e\xec\x8b\xa0\xc5\x43\x08\x58\x21\x33\xe6\xa4\x2b\x25\x93\x28\x18\x06\x60\xfb\xcc\xbe\x81\x5a\x4e\xd8\xe9\x55\xe0\xf2\x9a\x95\x5c\x0c\xaf\xf0\xc8\xf5\x44\x67\x9a\x4c\x5a\x85\x9d\xf9\x68\xa4\xa2\xb7\x44\x17\x0c\x8a\x94\x58\xa1\xab\x46\x94\xaf\x38\x81\x5a\x4d\xa4\xd5\x60\xad\x2e\xd2\xea\xb0\xb6\x2b\xd2\x76\x61\xad\x64\xa5\x09\xd4\xf6\x45\xda\x3e\xfc\x5d\x43\xa4\x35\x60\xed\x40\xa4\x1d\x00\x5a\xa2\xef\xbe\x50\xc6\x5b\x28\x7d\xb8\x84\x52\x86\x71\x02\x90\x8e\x36\xe0\x4d\x21\x1a\x43\x4e\x00\x60\xf4\xfc\xfd\x2b\xed\xc5\x59\x61\xb9\x50\x23\xac\xb3\x22\xba\x7c\x8d\x30\x6b\xa2\xe5\xf5\x79\x50\xa2\x73\x36\xfa\xe5\x22\x42\x8c\xa6\x5f\xfd\x77\xee\x45\x9f\xeb\xdb\x28\xb4\x03\x01\x6b\x36\x31\xfa\x9c\xf7\x13\x4b\x0e\x28\x6d\xba\x56\x29\x89\xbc\x19\x62\x18\x48\x8c\x81\x48\x69\x5d\x58\xc1\xa8\xe8\xbc\x00\x86\xa7\x37\x47\x44\x74\xed\x60\x16\xc3\x45\x49\x7c\x26\x78\x05\x5d\x69\x71\x83\xd9\x7f\x8a\xfb\xae\x80\x92\xec\x85\x4c\x52\x18\xfa\x10\xa2\x37\xf3\xb9\xa1\x84\x5a\x3b\x42\x74\x83\xaf\x84\xda\x3a\x42\xf4\x01\x5f\x09\xb5\x74\x84\xe8\x84\xaf\x84\xda\x39\x42\x74\x93\xaf\x84\x5a\x39\x42\x74\x8b\xaf\x84\xda\x38\x42\x74\x9b\xaf\x84\x5a\x38\x42\x74\x87\xaf\x04\xdb\x37\xa2\xbb\x7c\x25\x81\x95\x1e\x5f\x69\xc2\x4a\x9f\xaf\xd4\x60\x27\xea\x76\x04\x52\xd8\x8b\xba\x9a\x40\x0a\xbb\x51\x57\x17\x48\x61\x3f\xea\x76\x05\x52\xd8\x91\xba\x3d\x81\x14\xf2\x24\xd3\x60\x96\xf1\xeb\xb8\x15\xc5\x04\x4b\xfb\xa4\xd5\x8f\xb4\x83\x90\x81\x18\xa2\xa3\x21\x44\x04\xd1\x15\x2c\x31\xc5\x10\xbc\x25\x96\x08\xa2\x7d\xea\xa1\x21\xb6\x08\xd2\x53\x48\x8e\x23\x86\xe0\x93\xe3\x8a\x20\xba\x42\x72\x3c\x11\xa4\x87\x87\xb8\x1d\x11\x04\x54\x6a\x22\x65\x63\x74\x05\x42\x74\xd1\xaa\xe0\x03\xad\x1a\xe5\x47\x8b\xc9\x74\xf1\xa7\xe7\x41\xbd\xab\x69\xb8\x5d\x25\x66\x15\x36\x19\xc6\xf6\x94\xb0\x63\x78\x3a\x66\xb8\x7d\x35\xa4\x05\xad\x93\x98\x86\x6b\xa8\x21\xe7\xd0\xe8\xce\x34\xdc\x81\x28\x18\x8e\xa8\xd4\x63\x8f\x9e\x1e\x2b\xdf\x32\x00\xe9\x44\x14\x1b\xe7\x24\x74\xf3\x30\xdb\xab\xa7\x43\x41\xbc\x75\x98\xf1\x58\xbc\x7d\x18\xde\xa2\x21\x97\xe9\x0b\xe4\x71\x30\x81\x3f\xe2\xa8\x7e\xa4\x3a\x5a\x09\x82\x9b\x8d\x1c\x1f\xfa\x31\xa0\xef\x05\xd5\x81\x86\x9a\xe1\xd0\x8a\x50\x70\xcd\x8b\xd3\xe0\xd7\x3c\xe4\xd7\x8a\x05\xdf\xea\xdd\x50\x3c\xdd\x07\xe9\x8d\x0c\x2a\x3e\x54\x2f\x47\x37\xe7\x9d\xd8\xe4\x10\xdf\x80\x3f\x18\xd2\x00\x24\x51\x70\x41\x9f\x16\xac\xeb\x34\x3e\xc7\xaa\xbd\xc3\x66\xa3\xb6\x1b\x8a\x10\xb1\xf8\xa7\x10\x68\x19\x24\x0d\x24\xa4\x46\xa0\x65\x90\x43\x24\x9c\x2a\xdc\x08\x06\x84\x0f\xb4\x0c\x80\x50\x81\x96\x01\x06\x3e\x2a\x30\x04\xea\xea\xa2\x55\xb4\x3d\x62\x23\x3e\x87\x9c\xaa\x14\x15\x18\x44\xf5\x44\xa8\xfd\x58\x31\x72\x96\x62\xe4\x5d\xc0\x30\xf5\x58\xb9\x10\x10\x1f\x2b\x17\x20\xe1\x63\xe5\x42\x20\xd5\x58\xb9\x72\x1e\x14\xa2\x16\xb0\x06\x1d\x60\x16\xe4\xec\xa6\xaa\xc9\x42\x32\x14\x42\xb8\xca\x51\x0a\xa7\x6b\xe5\xa0\x9d\x93\x1f\x74\x2b\x8b\x1e\x7e\x41\x34\xdb\x3b\x2f\xc0\x50\x65\xd1\xdf\xe2\xa4\x6c\xfd\x9c\xd2\x3a\x64\x8d\x02\x97\xf6\x81\xe5\xcb\x8e\x28\x0a\x11\x53\xaa\x82\xc1\x60\xb4\xa6\x7f\x51\x4c\x79\xf4\xe5\xe1\x44\x3d\x86\xc1\xec\x3c\x51\xc6\x3c\xa4\xa7\x68\x3e\x44\x93\xb0\x58\xd1\x85\x19\xc4\x35\x1c\x81\xa7\x36\x46\x05\xf5\x09\x85\xfa\x40\xa1\x9c\x69\x76\x98\x90\xad\xe5\xee\x72\x83\x06\xaa\x19\xdf\xa0\x47\x20\xe9\xc5\x0a\xe0\xa3\x1d\xa7\xa3\xf1\xd7\x2d\x60\x61\xd3\xa3\x4a\x3f\xc4\x08\xcd\x0e\x73\x38\x9b\x56\xce\x47\x63\x91\xeb\x9a\x0e\xb3\x26\x65\x85\x9f\x1f\xb2\x78\x6a\x15\xc7\xb3\x01\xbd\x6b\x58\x72\x3d\x7d\x36\xcb\x8b\xaa\x28\x98\x72\x18\x2e\x56\xae\x9c\xc1\x09\x2e\x43\x03\x20\x79\x9f\xe2\x62\x27\x74\x31\x8d\x26\xb1\xe7\xd0\x21\x5e\xf1\xcf\xa8\x9d\x67\x62\xfb\xbc\xd3\x32\x8f\x28\x40\x6e\x31\x77\x29\x77\xee\xd4\x2e\xa6\x17\x51\xec\x2c\x74\x7b\x3a\xbd\x00\xd7\xf2\xac\x7e\x1f\x24\xf5\x90\x24\x43\x42\x2a\x0f\x27\xa8\x59\x36\x40\xf2\x50\xf6\x99\x5d\xbf\x83\xe4\x15\xf6\xe9\x8b\x60\x3c\x94\xf3\x34\x24\xaf\x87\xe1\x91\xae\xd1\xe7\x6d\x66\xcd\x3e\x4c\xa2\x78\xd1\xab\xfe\xd0\x13\x71\x40\xbe\x75\x0d\x43\xcc\xd1\x16\xfd\xe2\x0f\x82\xe3\x9b\xcd\x4e\x21\xd2\x8c\xc5\x8c\x6e\xac\xc1\x32\xa6\x6b\x1e\xe2\x75\xcd\xe9\x35\xbd\x0b\x82\x55\xb2\x67\xb4\x86\xf3\x60\x14\xd3\x1d\xaf\xb2\x8a\x46\x1e\x8d\x41\x02\x12\x9a\xd5\x29\x08\xc3\x89\x43\xe7\x77\x8a\x0c\xb6\xf8\xfe\x0c\x27\x1f\x43\x25\x84\xd9\xd9\xb9\xd0\x52\x49\x27\xf6\x7c\x16\xd7\xe1\xd4\x41\x2b\x9a\x85\x5f\x7e\x35\x9c\xc4\x81\x1f\x54\xe7\x7b\x66\x31\x9d\xad\x0e\xc1\xad\x3c\xf6\xec\xdc\x68\x32\xf3\x1e\x0c\x9a\x84\xa8\xe4\x10\x9d\x39\x23\x19\x4e\x78\xe6\x40\x08\xad\x03\xf7\xe4\xd5\xee\x6b\xe8\x4c\xea\x47\xa5\x64\x48\xdf\x68\x66\x32\xed\xe1\xaa\x71\x62\xb1\x44\x5b\x1c\x84\x1a\x3d\x1e\x75\xb1\x66\x71\xf9\xce\x24\x48\x1d\xe0\xa9\x38\x60\xd3\x25\x25\x30\x39\xc7\x34\xfa\x1d\xbe\x61\x0f\x13\x4d\x16\x87\x4d\xb1\x69\xf4\x35\x25\x30\x8e\xa9\x0b\x8a\xbb\x71\x81\x24\x08\x67\x73\xdf\x0f\x9c\xa0\x5e\x82\x11\x7f\x53\xfe\x39\x42\x7a\x0e\xa7\x13\xa6\x1f\xb2\x22\x0f\x73\x0a\x87\x90\x9e\x2b\x21\xf8\xf3\xd0\x81\x09\xcd\x01\x4a\xad\x8c\x3c\x9f\x46\x2b\xab\x81\x00\xc3\x36\x74\xce\x19\x4a\x9d\xb6\xf9\x45\xc4\x08\xb9\xdc\x64\x9f\x27\xab\xbf\x39\x9b\x5a\xd1\x0c\xfa\xb4\xc9\xbe\x4d\x76\x11\x44\x31\x3d\x08\x38\x7d\x28\x70\xcc\x71\x42\x53\x63\xf6\x93\xe8\xbd\xf5\xea\xe3\xd5\xf5\x75\x50\xde\xec\xa8\xa8\x7c\xcf\x0e\x98\x61\xf6\xa5\xe9\x80\xd3\x60\x1a\x52\x00\xd4\xe0\x9a\x9a\x29\xcf\xc9\xbf\x60\x40\x33\x1b\x8a\x9a\x5e\xe6\xe2\x43\x89\x22\x6a\xa0\x66\x9a\x7c\x48\xd7\x55\xe4\x58\x7c\x4e\xf5\x32\x67\x85\xc2\xcd\xf6\x35\xe6\x2a\xd6\x4e\xaa\x7c\x7a\xc8\xac\xa4\x96\x81\x3b\x82\xe9\xd8\x9a\x42\x4c\x41\x78\x79\xb9\x48\x1e\x5e\x1e\xd4\xf6\x45\x71\x2e\xab\x95\x34\x3c\xc9\xe0\x85\x68\xc7\xb4\x57\x40\x78\x79\x50\x4d\x78\xea\x6a\xcd\xa4\x0c\xce\x08\x22\x4c\x1e\xa2\x11\x5e\x1e\x04\x70\xc3\xcb\xcb\x55\xdc\xf0\xf2\xe0\x87\x6c\x7e\x88\xf8\x89\x0b\x4e\xcf\xe4\xe1\xe5\x41\x31\x10\x5e\x1e\xd4\x7b\xbc\x00\xbb\x8f\xe1\xe5\x41\xfd\xee\xca\x2a\xe3\xac\xca\xcb\xd1\x87\x85\x97\x07\x99\x9a\xcc\xc8\xba\x71\x78\x58\x6f\xc5\x10\x75\x1e\xb1\x61\xe5\x41\xd0\xae\xcc\x4c\xf5\x25\x74\x64\x78\x79\x10\xd3\xe7\x61\xa0\xf0\xf2\x20\xd5\xe0\x51\x1b\x39\x78\x00\xd2\xe4\x21\x77\x7d\x11\xb7\xfc\xad\x1c\x5e\x1e\xe0\xa9\x86\x97\x07\x71\x2e\x60\x9e\x2a\xf1\x88\xf0\xf2\x20\xd9\x97\x92\x39\x79\x8a\x86\x1f\x18\x5e\x1e\x84\x7a\x40\xee\x1e\xc8\x55\x0b\x2f\x0f\xd2\x7a\x4a\x56\x82\x40\xb5\xf0\xf2\x20\xcc\x01\xac\x53\xe4\x1d\x12\x5e\x1e\x64\x9a\x80\x8d\x07\x61\x25\x91\xe0\x01\xa5\x6a\x24\x78\x10\x47\xe4\xb8\xfd\x94\xca\x89\x88\x48\xf0\x20\xa0\x99\xc2\x83\x8c\xa8\x8e\x3f\x40\x86\xc0\x7b\x3f\x9c\xc5\x7a\x81\x41\x30\x0b\x13\x85\x5c\x4e\x00\xa2\x90\x03\x62\x54\x24\x71\x39\x43\x31\xe2\x36\x08\x73\x41\x18\x88\xf0\xc4\x08\xc4\xc4\x92\xf8\x86\xc9\x01\x14\xd1\x43\x5d\xcf\xa1\x53\xa0\x69\xe4\x39\xc1\x0c\xb1\xb8\xe8\x11\x87\xbf\x80\x4f\x17\x9f\x17\xf6\x34\x76\x16\x73\xcc\xfa\xb3\x47\x5c\x3e\xa7\xb1\x11\xa0\x42\xf3\xf8\x34\x0a\x2b\x30\xd5\x82\x7e\x19\x65\x12\xa4\xf9\x00\x6d\x1e\x2a\xf0\x70\xc1\x57\x41\x06\xd7\xaf\x2b\x87\x56\x65\x39\x9c\xa5\x2f\x55\x86\x0b\x33\x70\x4b\x69\xe8\xe0\xab\x20\xc7\xe7\xed\xe1\x1c\x0c\x24\xbe\xed\x73\xf2\x9c\xf6\xfa\x56\x5c\x6e\x58\x4d\x2e\xbc\x28\x0a\xe0\x09\xb8\xce\xb4\x4a\x74\x5b\x08\x14\x34\x8b\x28\x1a\xc2\x0a\xdf\x64\x3e\x31\xb4\x91\x3b\x41\x9a\xc9\x5c\xc7\x8b\xe0\x7d\x54\x9d\xb9\x0f\x13\x81\x2b\x20\xbe\xd1\x2c\x5f\xba\xd4\x28\xbf\x05\x0f\x8e\x2b\x0c\xa7\x87\xbf\x52\xaf\xd9\x53\x68\x3d\xd9\x34\x9c\x3e\x1e\xa8\xa3\x80\x06\x1e\xd8\x45\x01\x07\x78\x60\x0f\x05\x24\x78\x60\x1f\x05\x34\xf1\x40\x03\x05\xb4\xf0\xc0\x01\x0a\x68\xe3\x81\x04\x05\x74\xf0\x40\x13\x05\x74\xf1\x40\xad\x83\x22\x7a\x0a\x44\x5c\x5d\xf1\x15\x88\x88\xca\x42\xba\x06\x11\xb5\xdb\xfa\x63\x10\x59\xc4\xe6\x3b\x2a\x36\x30\xc8\x70\x44\x8c\x46\x6c\x60\x10\xe2\x8a\x20\x8f\xb1\x81\x41\x86\x27\x62\x34\x62\x03\xcb\x21\x1d\x87\x39\x99\x34\x0f\xcb\xe0\x95\x93\x8f\x75\x4c\x44\x50\xde\xdd\x97\xcf\xfe\x0c\xa6\x94\x81\xf8\x78\x4f\xa0\xc6\x5d\xec\x61\x4f\x5e\x0e\x1d\x67\x36\x79\x9c\x87\x14\xe1\x7e\xfc\xff\xc8\xf4\x5a\xd7\x81\x37\x46\xd9\x78\xa4\xee\xc2\x9d\x7c\x0c\xab\xbf\x02\x47\x59\xd8\xb8\x50\xb4\x4c\x1a\xda\x99\x63\x8d\xbc\x19\x74\x1a\x46\x63\xa2\x39\x1d\x20\xf7\x05\x09\x6c\x6c\xf4\xd7\x9e\x53\x58\x04\x13\xbb\x50\x40\x43\x90\xd0\x13\xd8\x24\xc8\x74\x90\xb7\xbb\xea\x8a\x36\xc4\xe0\xd4\x1f\xb4\xb8\xe9\x7a\xb3\xe1\xa7\xdd\x08\x46\x72\x3d\xe9\x30\xcf\x87\xd8\xc3\xc8\x3a\xd9\x41\x26\x80\xe3\x74\x98\x5e\x73\xf8\x09\x69\xb1\xd3\xe9\x88\xe7\x3c\x8d\x13\x59\x1a\x0c\xd2\x20\x90\x8e\x04\xe9\x42\x90\xa5\x68\x52\x17\x24\xa1\x6c\x32\xb5\xee\x00\x47\x02\x0f\xc2\x69\x5d\x02\x92\x50\x47\xe0\x4c\xad\xdb\x1c\x78\x39\x73\x3b\x70\x60\x49\xd3\x49\x4a\x49\xb9\x05\xbc\x08\x3d\x2b\xf2\x66\x31\x4c\xb0\x45\x04\xfa\x74\xb5\x15\x01\x00\xe2\x33\x2f\xe4\x3b\x93\xe9\x67\xe6\xc4\x6d\x78\x21\x17\xb3\xc5\x30\xfd\x5c\x1f\xfe\xc6\xa8\x3d\xe6\xc2\xa8\x43\xe7\x70\x74\x01\xf6\x61\xad\x1d\x94\x6b\x52\x79\x0f\xd4\xeb\x3c\x7d\xa3\xc9\x86\xf4\x5d\x9e\xbe\x5e\x7a\x87\xc4\x3d\x9e\xb8\x6e\x16\x21\x71\x9f\x27\xb6\x46\x8f\xd7\xbb\x60\x84\xc1\x43\x78\xee\xd0\x53\x60\x0c\x78\x0c\x6b\x1e\x4f\xc6\x56\x1c\x38\x72\x39\x5d\xea\x24\x3b\x61\x73\x6a\x44\xe5\x3e\x74\x95\x13\x30\x41\x77\xf6\x43\xa0\xd4\x0e\xa0\x19\x34\x30\x5c\x55\x0d\xe4\x1c\x20\x18\x06\x24\xee\xf3\x0f\xba\x77\x00\xeb\x25\xa1\x30\x20\xe1\x80\x2f\xd4\xc1\x2f\x12\xbe\xb0\x0b\x0a\x4d\xbe\x10\xaa\x65\x92\x30\x18\x90\xd0\xe6\x0b\x0d\xf0\x8b\x0e\x5f\x38\x00\x85\x2e\x5f\x48\x40\xa1\xc7\x17\x9a\xa0\xd0\xe7\x0b\x35\xd0\x75\x24\x01\x30\x20\xa5\x26\x50\x82\xce\x23\x09\x7f\x01\x29\xbb\x02\x25\xe8\x3e\x92\xe0\x17\x72\xa5\xe3\xc9\xee\xbc\x40\xf9\xeb\x78\x9a\x44\x0d\xe5\xb1\xe3\xe9\x12\x35\x94\xcf\x8e\xd7\x95\xa8\xa1\xbc\x76\xbc\x9e\x44\x0d\xe5\xb7\xe3\xf5\x25\x6a\x38\xcf\x0d\x89\x1a\xaa\xb8\x8e\x37\x90\xa8\xa1\xda\xeb\x78\x44\xa2\x86\xaa\xb0\xe3\x99\x12\x35\x54\x8f\x1d\xcf\x92\xa8\xc1\xca\xec\x78\xb6\x4c\x0e\x7b\x9b\x23\x93\xc3\xee\xe6\xca\xe4\xb0\xbf\x79\x32\x39\xec\x70\xbe\x4c\x0e\x7a\x1c\x73\x9b\x9e\x76\xdc\xbb\x08\xa8\xf7\x06\x42\xed\x43\xe2\xbd\x6e\x51\x45\x6c\xc8\xc4\xf5\x26\x29\x04\xb1\x64\xe6\x63\x21\x36\xb4\xdc\x10\x5e\x9c\xf4\xf1\x04\x08\x37\x10\x2c\x36\x84\x17\xa7\xb9\x0e\x2b\xe7\xd8\xcc\x22\x40\xb9\x57\x39\xb6\x62\xfd\x3b\x54\x19\x6c\xc3\xe0\x0b\xa1\x6a\x60\x1b\x03\x9e\xb0\xfb\x1d\xaa\xbd\xb6\x41\xf8\x42\xf8\x8b\x26\x4f\xd8\x43\x7c\xd1\xe2\x0b\xa1\xcc\x21\x4c\x33\x57\xac\x3d\x2e\x8a\xb5\xc8\xca\x55\x20\x75\xb3\x95\x2b\xd5\xf3\x50\x45\xef\x4a\xbf\x8e\xab\x29\xc4\xf3\x01\x2b\x50\x9c\x8e\xcd\x84\x0e\x9e\x4e\x46\x9f\x87\x93\xea\x14\xb4\x54\xa8\x5b\x1d\x8d\x23\xac\xce\x2f\x94\x27\x91\x21\x80\x2e\x06\xd0\xc9\x92\x5c\xaf\xd9\xcc\x94\x0d\xf1\x45\xcd\x66\x4c\x46\x7d\xa2\x69\xa2\x1f\x8c\x46\x72\x81\xe4\xc6\xb0\x54\x47\x9c\x5e\xb3\xba\xce\x46\xf3\x71\x10\xd2\x90\xad\xf2\xcf\x11\xa7\xd7\xe3\xcb\xca\x7b\x93\x90\xb8\x99\x1b\x74\xf7\x1a\x1c\x9b\xf4\x06\xdc\xcf\xc1\xba\xbe\x40\x57\x3d\x5b\x0b\xc9\x1d\xfe\xca\x18\xb5\xf9\xe1\x85\x2a\x70\x70\xd2\x73\x25\x94\x2a\xea\x37\x9a\xe5\xa1\x59\x50\x63\xe4\xf4\x7c\x34\x0b\x1a\x84\x10\x66\x08\xe4\xc5\x8e\x86\x2a\x57\x97\xdd\x3e\xaf\x8e\x46\xe8\x2e\x7b\x68\x03\x62\xf4\x39\x8c\xe2\x78\x05\x46\x8f\x39\x25\x16\x5e\x1c\x7e\x48\x2c\xbc\x38\xf4\xb9\x49\x48\xe9\xf0\x9e\x79\x64\x5f\x9b\x84\x10\x2e\x0f\xc1\x3c\x36\x09\x11\x7c\x1e\x41\xf0\xd6\xa4\x94\xd5\xb1\x99\xf7\x12\x4a\x57\x8e\xe9\xfa\xef\x7f\x9d\x89\xec\xad\x89\x8e\xed\x68\x7c\x61\x71\x7f\x0f\x56\xeb\x7c\x35\xbd\xc3\x07\x88\x89\x6f\x37\x6d\xb6\x83\x30\xa4\xc9\xad\x4f\x3d\x7e\xa0\x1d\x03\x8c\x68\x5a\xef\x4c\xe7\x8b\xc9\x34\x0e\xc6\x45\xac\x0c\x58\xdb\xb4\x7d\xa8\xa8\x6d\x4e\xb1\xcb\x01\xef\xc2\x0d\x22\x1a\xea\x2c\x9e\x30\x03\x58\x08\x65\xbb\x3a\xa7\x06\x95\x21\xe5\x60\x6d\x97\xa3\xad\x22\xba\xc1\x62\x5e\xd5\x2d\x43\x9f\xc1\xda\x3e\x47\x5b\xbc\xfb\x19\x5a\xa3\xfa\x68\x15\x4c\x31\x38\x14\xb4\x78\xc0\x11\x17\xd1\xb4\x60\x29\xe1\x48\xcb\xe3\xdb\x45\xe4\x17\xc4\xc7\x4d\x0e\xa1\x71\xb0\x00\x06\x58\x1c\x40\x6c\x45\x43\x0f\x93\x74\x9b\x23\xae\xde\x14\xc3\x26\xa0\xd9\x00\xd2\x07\xc1\xe8\xbd\x5b\x7a\x75\xd8\x83\x4f\x2a\x37\xeb\x9c\x3f\xb9\xf0\xaa\xdb\xec\x61\x11\xa7\xda\x0e\xc0\x04\x54\x18\x9d\x8b\x29\xf6\xb7\x5d\xba\xdb\xb9\x18\x7b\xf1\x87\x89\x8b\x21\x12\xc7\x6c\xb6\x25\xe3\x49\x69\xd1\x2c\x0e\xc6\x15\xd5\xb3\x22\xe7\x43\x79\xde\x7d\xf1\x09\x81\xd3\xf0\xb8\xcf\x20\xce\xb7\x9a\xd6\x4d\xbd\xc8\x1f\x4f\xc2\xc5\x70\x34\xb1\x2d\x4a\x77\x41\x97\x35\x0d\x4b\x17\x4c\x90\xcb\x37\x8a\xc2\x49\xf8\xf0\xf6\x07\xcc\x6a\x56\x7c\x26\x8e\x1f\x2c\xed\x8b\xa4\x74\xfc\x43\x1f\xc6\x63\x23\x3e\xc2\x40\x43\x04\x6c\x44\x18\x86\x29\x03\x31\x45\xc7\x53\x88\x88\xa2\xab\xd8\x62\x8a\x29\x0a\xb6\x38\x22\x4a\x4f\x85\xe2\x89\x29\x3d\x24\x85\xd8\x3e\xaf\xb9\x29\x6b\x2c\x3d\x3a\xed\x59\xd5\x19\xe6\x18\xc3\x72\x64\x2c\x7a\xaa\xaa\xb0\x6a\xe1\x7a\xe1\x2c\x88\xe1\xaa\x65\xfb\xae\x0c\x58\x1b\x57\x06\xd5\x87\x61\x9e\x0c\x46\xdb\x77\xbf\x88\xeb\x50\x4c\x5b\x67\x68\xaa\x2f\xa8\xbb\x8d\xc5\xad\xc7\x06\x10\xc0\x99\x86\xd5\x91\x19\xe9\xcc\xe3\x89\xef\x63\xf3\xaf\x6a\x8a\x7b\xfc\xa6\x78\x6e\x97\x33\x02\x97\x3e\x61\x4f\xdf\x22\x54\x68\x91\x7d\x4b\x10\x22\xa3\x41\x7d\xb8\x31\x15\xcf\x90\x86\x36\x99\xe5\xf4\xa2\xca\x4a\x3b\x88\x3b\x07\xf4\x3b\xbb\x0c\xed\x80\x0c\xdb\x65\xe8\x48\x06\x91\x30\xba\x38\x86\xd6\x91\x30\x7a\x38\x86\x2e\x63\xf4\x71\x8c\x9e\x8c\x61\xe0\x18\x44\xc6\x18\xa0\x18\x3b\x4f\x5a\x15\x43\x59\x55\xff\xd0\xa5\x0c\x9c\x7f\xf4\xa4\x0c\x9c\x7f\x10\x29\x03\xe5\x1f\x5a\x47\xca\x40\xf9\x87\x2e\x67\xa0\xfc\xa3\x27\x67\xa0\xfc\x83\xc8\x19\x18\xff\xd0\x3a\x2c\xa3\x8e\xef\xac\xe6\x21\x3a\x48\xc1\xf8\x48\x0f\xa4\x60\xbc\x84\x80\x14\x84\x9f\x68\x1d\x90\x82\xf0\x14\x1d\xa6\x20\x7c\xa5\x07\x53\x10\xde\x42\x60\x0a\xec\x2f\x5a\x67\x97\xd2\xdc\x7f\x52\xf1\x19\x1d\x49\x82\xfd\xa6\x87\x24\xc1\xbe\x43\x90\x24\xd0\x7f\xb4\x0e\x92\x04\xfa\x90\x8e\x25\x81\x7e\xd4\xc3\x92\x40\x5f\x22\x58\x12\xe8\x4f\x84\x30\x71\xa9\x8b\x63\x8e\x93\x70\xf4\xb9\x5a\x3c\xa2\xe3\x72\x88\xe0\xb3\xeb\xbd\xcc\x09\xfa\x8b\xe0\x61\x79\xf0\xdd\xef\xb4\x67\xdc\x56\x7f\xb9\x5c\xdf\xde\x2e\xb3\xab\x6d\x51\xd0\xdb\xbb\xe5\x65\x51\xda\x6f\x1b\x5f\xa9\x7e\xf1\xf8\x2f\xf4\xcf\xbb\xbb\xcd\x3a\x5f\xbf\xff\xb6\x4e\xaf\xde\xbc\xa3\xd2\xf7\x37\x2b\xe7\x7e\xb5\xf2\x97\x97\xc9\xbb\xdf\x8b\x7f\x78\xf7\x7b\xf9\x9b\x1d\xdd\x72\xb3\xbc\x7d\xff\xee\x8e\x3e\xc9\xfc\x7e\x38\xa2\xa6\xbc\xfb\xbd\xfc\x5b\x05\xba\x5d\x5f\x35\x10\xc5\xcf\x1f\x10\xef\x7e\xdf\xb3\x06\x6f\x9f\xbf\x59\x67\xf9\x73\x36\xf0\x43\x9a\xe5\x47\xda\x96\x2f\x37\x37\x49\x2e\xb2\xee\xa9\x13\x38\x4a\xb3\xe4\x63\x7a\x95\x7f\x55\x49\xe5\xf5\x6a\xbd\xcc\x77\x2c\xfc\x9b\x85\x9c\xd0\xc4\xe9\x3a\xcd\xf2\x59\xfa\x4f\x72\xac\x89\xdb\xf4\x9f\xf3\x64\xe2\x74\xbd\xfa\x71\xb3\xce\xc6\xeb\x2b\x84\x8d\x6f\x6e\x36\xeb\xfb\xbb\x3f\xde\xc6\x9b\x74\x99\xdd\xac\x12\xea\xfe\x6f\xa5\x85\x7f\xbd\xbc\x14\xda\xbd\xcf\x6d\x18\x23\xc7\x9e\xad\x56\xcf\x2e\xd3\xed\x76\xbd\x81\xb3\xe2\xd1\xbc\xa2\x9a\x31\xd6\x7d\x87\x53\x2c\x93\xff\x50\x91\x53\xc7\x48\x55\xfc\x19\x0b\xf9\x9a\xa4\x37\x5f\xf3\xb3\x64\x72\x9c\x7c\x9f\x52\x03\x92\x3c\xd9\x5c\xc3\x59\xfd\xe0\x75\xc9\xf7\xfc\x7e\x93\xc4\x45\xa3\xf4\xf6\x24\x0d\xd7\x0e\xfa\xc1\xaa\x70\x79\x0b\x38\xf6\x1d\xfd\x3f\xfc\x01\x79\xad\x2e\x32\xf5\xfc\x59\xfc\xed\x85\xe7\xf1\xe5\x3a\xdb\xe6\x6f\x04\x39\xfd\xef\x46\x56\x6f\xcf\x9e\xd7\xe9\x0b\xcf\x6a\x49\xb3\xd5\x8e\x33\xa7\xbf\x98\x33\xa7\x59\xcb\xae\x1c\xdc\x2e\x6f\x12\xcd\x7d\xd2\x5c\x7e\xcf\x4d\x7e\x69\xe4\x2a\xf9\x96\xac\x8e\x43\xa4\x59\x9e\x6c\xb2\xe5\xea\x7a\xbd\xb9\x5d\xe6\xc7\x76\x94\xca\xbd\xed\xbe\x41\x5f\xd6\x9b\xab\x64\x03\x33\xea\x2c\x9f\xa6\xdf\x93\x95\x5f\x58\x2f\xcf\x70\x6c\x0a\x19\x70\xfc\xe3\x0e\xf0\x65\xfa\x09\x04\x74\x95\x64\x7f\xbc\xa5\x7b\x0e\xb3\xe0\x2f\xef\xff\x4a\x5b\x7e\xa3\xda\xdf\x8a\x3c\xfb\xad\x1c\x62\xfc\xeb\x6d\xe5\xf3\x85\x27\xd6\x3e\x4e\x53\x78\x5e\x1f\xd7\x5f\x7d\xfc\x7c\x3e\xae\x3e\xa2\xc4\x25\xeb\xb5\xa6\xb4\x58\x53\xdc\xcd\xf2\x6f\xfb\xfe\xfa\x3a\xd9\xc0\x35\x45\x9a\x0b\x5f\xee\xaf\xcf\x62\xa0\xb3\x4a\x96\x08\xdb\xea\x32\x2b\x7e\x5e\x26\x68\xbc\xdc\xfe\xaf\x51\x72\x5f\xd2\xfc\x3a\x4d\x56\x57\x3b\x76\xdf\x2e\xb7\xff\x3b\x9f\xe1\xce\x7a\xa5\x36\xd3\xe4\x4d\x21\x36\xc9\x95\xc8\x42\x2c\xe2\x66\x93\x24\xd9\xb1\x90\x2f\xab\xfb\xe4\x58\xc6\x72\x75\xf7\x75\x79\xbe\xfc\x9e\xe5\x49\x76\x99\xae\x54\x72\x7c\xbf\x01\x3a\x4f\x4d\x2b\x3c\xd3\x4d\xee\xf2\xaf\x2a\xd6\x5d\xad\xef\xbf\xac\x92\x1d\x03\xaf\x58\xca\x09\x8d\xac\xf2\x6f\xcc\x54\x0a\xd8\xca\xfb\xfd\x4c\x3c\x5f\xbd\xa2\x55\x4a\xd5\xc0\x2f\xeb\xf5\x2a\x59\x66\xc7\x55\x2c\x3e\x44\xb9\x6a\xf1\x31\xaa\x95\x8b\x4f\x39\x5f\xf5\x2a\x3c\xf7\x34\xd9\x7e\xbd\x5a\xde\x9c\xc7\xc6\x74\xbb\x2c\x6a\x0b\xb6\xb7\xf0\x32\xfa\x7b\x67\x79\x27\xef\xe1\x2f\x97\x77\x67\xb1\xd7\xcb\x7e\x2a\x73\xfd\x34\x4b\xb7\xc2\xd6\xeb\x38\xf4\xea\xfe\x4c\x64\x7b\x95\x64\x57\xfe\x7d\x76\x09\x67\xb3\x34\x53\xb7\xd7\xcb\xcb\x7c\xbd\x11\x65\x2c\x92\x72\x25\xa7\x1c\x95\xd2\xd1\xfa\x26\xbd\x9c\xdc\x1d\x99\xce\xf5\xdd\xe5\xd9\x16\xde\xcb\xce\xe5\x04\x85\x71\xcd\x20\x58\x13\x71\x7d\xfc\x26\xb9\x56\x01\xb4\xd9\xc1\x55\x7d\xf0\xd1\x25\x79\xbd\x4c\x57\x22\x03\x91\x88\x7f\x4e\xc1\xb8\x5b\x6e\xcf\x34\x77\xa1\x5d\x12\xce\x9b\xea\x16\xf4\x41\x92\xa7\xeb\xec\xed\xc1\x3e\x76\x94\xd9\xc5\x7c\x75\x96\xaf\x19\x17\x3c\xa8\x84\x95\x57\x74\xdb\xdd\xa0\x78\x4c\x68\xda\x76\x42\xd3\xac\xbd\x64\x46\xc9\xf2\xea\x24\x53\xe8\xed\xe6\x3c\x0e\x47\x0d\x9c\xee\x2c\x21\xc0\x06\xfe\x92\x7b\x9e\xfb\x2d\xc6\x4b\x5b\x5d\x6a\x69\x5d\x69\x98\xe4\x76\x39\x43\x51\xd8\xcc\x19\x26\xf9\xf4\x64\xbb\x2c\x3b\x59\x52\xa8\xfe\xf5\x56\x3c\x37\xa9\xf2\xe4\x6a\x99\x9f\x67\x02\x35\x4c\x72\xb7\x98\xcc\x3f\xd7\x0c\xd9\x59\x6a\x38\x7d\x7e\xd4\xa9\xf2\x36\x9b\xf5\xc6\x01\x0f\x80\x14\x59\x56\xfc\x56\x94\x61\xc7\x96\x87\x4f\xbb\xc3\xe7\x5a\x1c\x6c\x5f\xdd\x82\x77\x06\x59\x9e\xdc\x24\x9b\xe7\x9a\x1f\x69\x76\xce\xdc\xa8\x93\x34\xcb\x37\x69\x76\x53\x2f\xc2\x3f\x7c\xfc\xfe\xcb\x8f\xbc\x51\x35\x9a\xd9\x56\x2a\xf0\x99\x56\xfe\x1e\xce\x36\x59\xae\x1d\x5b\xd4\xf5\xfe\x18\x6c\xf5\x73\xd9\x07\xfb\xb9\xba\xe3\x16\x7b\x59\xd5\x53\x40\x27\x29\xd0\x3a\xd3\xca\xef\x33\xa7\x24\x5a\x69\x0b\xda\x3e\xfe\xb3\x93\xcf\x98\x03\x2a\x2f\x22\x9f\xd3\xac\xfd\x5c\x1e\xd1\x06\xa1\x7d\x97\x3e\x41\x1b\xd5\x46\x89\x3c\x8d\xe7\xb3\x65\xd2\x9a\xfb\xff\x24\x65\x92\x66\x67\x2e\x11\xe1\x9c\xa5\x2e\xa5\x60\x5b\x6e\x0d\x5c\xc1\x05\xf3\x4c\xf6\x12\x8a\x85\xb8\x68\x99\xdd\x24\x47\xef\x86\x2a\x6d\xbe\x71\x09\xd7\x67\x49\xe2\x45\x9a\xfc\x7d\xb7\xde\xe4\x2a\x09\xfc\x25\x17\x5d\x8e\x73\xa4\xcd\xf2\x6f\x6b\xb3\x59\xfe\xd8\xc2\xf9\x5c\xfb\xfe\x74\x93\xde\xa6\x79\xfa\x2d\x81\xc7\x7b\xb2\x5b\x08\xb8\x12\xb8\x4e\x37\xdb\x5c\x05\xc1\xcb\xc0\xcb\xf5\x7d\x26\x84\x1c\x9d\x7f\xde\x2a\xb9\x4d\xb2\xfc\x79\xe4\xa0\x72\xf2\xf7\xad\x6b\xa6\xe9\x54\x43\xfa\xb2\x63\x2e\x0c\xe1\x1d\xd1\x4a\xb3\xab\xf4\x32\xd9\x8a\x38\x47\x15\x11\x5d\x16\xa3\x97\x8c\x50\x73\xf3\x33\x76\x78\x85\x51\xff\x3e\x4b\x0f\xc7\xbb\xaf\x34\xb9\xbe\xde\x26\xb9\x4a\x8a\xd9\xc1\x51\x55\xfd\xa4\x1b\xae\x58\xca\x7d\x96\xe6\x5b\x11\xe4\xa8\xc4\x3a\xeb\xbb\x1f\x2a\x47\xb2\xa5\xe5\xdb\xe2\xc0\xaa\xae\x6b\x41\x75\x08\x15\x33\x27\x3f\xfc\xc0\xea\x0b\xe8\x17\x55\x0f\xba\x9e\xcc\xab\x30\x87\xa0\xa5\xc5\xf6\xea\x55\xcf\xd7\xab\xd4\x47\x5b\xb8\x94\x9c\xdf\x37\x67\xf7\x5f\x7e\xba\x46\x4f\x86\xf8\xbe\xde\xe9\xaf\x0e\x82\x3c\x67\xff\x3b\x65\xa9\xff\x4c\x8d\xd2\xd9\x4b\xfd\xc7\x8b\x77\x9d\xd3\x34\x5d\x47\x39\xa0\x62\x93\x53\xf7\x44\x3f\xdf\xb5\xa0\x03\x5c\xf2\x24\xe5\xc9\xc9\x11\x6c\x97\x7c\x96\x4d\x18\xfc\x99\x88\x56\x6f\xdc\x28\x36\x82\xbf\x94\x1f\x9e\xa4\x69\x3c\x89\x33\xab\x37\x4e\x3c\xca\x6b\x95\x40\x55\x09\x3b\xcd\xae\x2a\xff\x7e\xd2\x0a\xf1\xe6\x72\xb5\xdc\x6e\xff\x78\x9b\x97\xb6\xbc\x95\x9e\xff\xcd\x77\x0d\x3e\x61\x86\xb8\xc9\x2a\xc9\x93\x2a\x85\x5b\x38\x4f\xe4\x7e\x9b\xa9\xa7\xbc\x74\x94\xac\xf6\x03\x7e\x3e\xfc\x9b\xc9\x88\xf3\xb8\xc6\x30\xc9\x9e\x43\x36\xb4\x9a\x01\x88\xed\x9e\x2a\x4f\xe0\x2c\xd9\x4d\x91\x20\x25\x4c\x42\xce\x52\x90\x74\x4d\xb6\xd8\xef\x79\x56\x8b\xcd\x9c\x4c\xd8\xe6\xcb\x4d\x7e\x24\x23\xc9\x94\x2e\x96\x1d\xb1\xe4\x2d\xcd\x8d\x67\xbf\xb2\x5d\x2f\x51\x75\x5f\xf4\x40\xe8\xf0\x05\xaa\x67\x34\x94\xe1\x51\xa4\xf7\x50\x71\x79\x23\x5f\x67\xda\x2f\xfd\x97\x76\x1c\xfb\xa9\xa6\x1e\xdd\xd7\xa9\xc7\x19\xa6\x1e\xfb\x90\x7f\xd4\x21\x2f\xad\xd2\xbf\x56\x4e\x54\xe5\xdc\x59\x20\xc5\x54\xd0\xd7\xaa\x78\xe6\xaa\xf8\xba\xca\xaa\xba\xca\x6a\x5d\x16\xa7\x42\xd0\xd3\x13\xb6\x8b\x99\x67\x29\xd4\xc1\x9c\x71\x8a\x32\x5b\xde\xde\xad\x12\x67\xfd\x2d\xd9\x28\x9e\x8d\xe7\x1d\x19\x28\xc2\x2c\x8b\xec\xe4\x41\xf8\xb3\xbd\x34\xfb\x96\x6c\xf2\xb3\xa4\xd7\x59\xdf\xde\x6d\x92\xed\x36\xb9\x7a\x39\xe3\xf0\x76\x37\x90\x9f\xa8\x86\x9e\xad\xab\x3e\x7c\x7c\x2e\x37\x24\xa5\x43\x4e\x36\x46\xb2\x10\xb4\xdf\xe9\x9e\xed\xd2\xd3\x7e\x05\xd0\x5f\x2b\xc0\xcf\x5a\x01\x7e\x79\xdf\xd5\x5e\x7d\xb7\x7d\xdf\xfd\x95\xbd\xee\x75\x2d\xe1\x75\x2d\xe1\xe7\x59\x4b\x78\x39\xf5\xed\xf5\xd8\xc0\xeb\xb1\x01\xd1\xb1\x81\x97\xe3\xe5\xaf\x87\xb4\x9e\xd9\x21\xad\x9f\xd2\xb5\x86\x49\xce\x78\xd7\x4f\x16\xf6\x80\x09\x24\x90\xde\x9e\x27\x6c\xa6\xb3\x4a\x93\x2c\x57\x5c\xb9\x7b\xb2\x55\xba\xf1\xfd\x2a\x4f\xe3\xe4\xbb\xb3\x5e\x6f\xae\xb4\xab\x63\x2d\x55\x2e\x4b\xee\x0d\xd2\x6d\x1b\x49\x6d\xed\xda\xf5\xce\xa1\x9f\x9d\x14\x57\xde\xf8\x4d\x04\x3a\x61\x92\xaf\xdb\x4a\xb1\x74\x25\xb7\x8d\xc2\xbd\x7e\xaa\xc2\x65\x13\xdc\x62\xd9\xa6\x6d\x25\x58\xd2\x0a\xb7\x51\xb2\xe9\x53\x95\x6c\x9a\x3d\x49\xb9\x6e\xdb\x4a\xee\x03\x64\xfb\x75\xbd\x79\x4c\x6a\x7b\x25\xbb\x7d\xaa\x92\x65\x13\xdc\x5e\xd9\xea\xcf\xbd\xb7\x45\x13\xf2\x36\x32\xeb\xa9\xdc\x63\x27\xc5\x2d\xfa\xc7\x33\xef\xaf\xb1\x80\xbc\x8d\xac\x7a\x2a\xef\x60\x13\xdc\xa2\x73\x3c\xeb\x0e\x1f\x27\xcf\xdb\xc8\xa6\xa7\x72\x8c\x34\x7b\x12\xb7\xd8\xb6\x95\x5c\x41\xf7\x79\x80\x63\xf0\x00\x79\x1b\x59\xf5\x54\xae\xc1\x26\xb8\x3d\xe7\xe8\xbe\xfc\x01\x07\x9a\xb0\x69\x23\xbb\x9f\xca\xc1\x76\x52\xdc\xa2\x87\xbd\xf4\x21\x0b\x16\xd0\x86\x7b\xfd\x72\x8b\x1c\xdd\x97\x3c\xe6\xc1\xc9\xdb\xf0\xab\x5f\x6c\x89\xa5\xbb\x6d\x2b\xb9\x82\x01\xc0\x01\x7e\xc5\x03\x1c\x6d\x41\x1b\xbe\xf5\xcb\x2d\xf2\xf4\x5e\xc7\x5c\xf0\x98\xeb\xcd\x9b\xff\x67\xef\xda\x7a\xdc\xd6\xad\xee\x7f\xf1\xdb\xe7\x97\xef\xf8\x82\x83\x16\x28\x0a\x64\x26\xc9\xc0\x40\x26\x27\xcd\x4c\xd2\xc7\x82\xb6\x68\x87\x8d\x4d\x39\xba\x38\xe3\xf9\xf5\x85\x2e\xf6\x58\x12\x29\x6d\x8a\xe4\x16\x6d\xe9\xdd\x5e\xda\x6b\xad\xcd\x9b\x44\x72\x03\x11\x7e\x61\x18\xd6\x55\x8a\x96\x18\x23\xe6\xe8\x30\x6b\x6b\x98\xb5\x41\x01\x30\xf2\xb3\x77\xd3\xbe\xf9\x30\xed\x93\x4e\xfb\x60\x7f\xc7\x48\xcb\x9e\xcd\x1a\xe7\x21\x16\x5d\xc9\x14\xa6\x45\x5a\x8a\x00\xb4\x23\x08\x74\x01\x30\x92\xb3\x0f\xd3\xce\x4f\x3e\xf1\x9e\x03\xc2\xc3\xbd\x1f\xd2\x47\x12\x05\xec\x05\x32\xae\x43\x3a\xf9\x1d\x56\xc0\x9e\x7a\xc0\xe2\x59\x93\x9d\x88\x93\xa4\xba\x2a\x89\x05\x01\x3b\x2e\xf1\xb9\xc0\xf0\x13\x4d\x62\x89\xa8\x99\x42\xc3\x5f\x1f\xee\x64\xe1\xaa\xd5\x1a\xd6\x07\xca\x23\x7a\x57\x57\x5b\x5c\x2d\xa6\x5a\x28\xed\xfc\x61\x2a\x35\x01\x6a\xc3\x85\xdd\x5d\x2f\xb8\x40\xa7\x6e\xac\x07\x16\x04\xa8\x43\x2d\x6e\xc0\x1d\xb7\xbc\x31\x48\xb4\x8d\xd7\x0b\xc8\xef\x5a\x20\x33\xee\xc0\x2f\x81\xb2\xec\x8f\x19\x25\x35\xef\xa2\x28\x84\x96\x0a\x36\x4e\xc3\x84\xdd\xc0\xe4\x86\xb5\x69\x85\x82\xb7\xb2\x4d\x9a\xc6\x02\x0b\x15\xfc\x53\x32\x44\xd9\x2e\xf9\x5b\x22\x7b\x40\x62\x0b\x19\x96\xad\xd6\x65\xb8\x64\xcd\x90\x48\x0b\xbb\xd2\x0b\xae\x18\x54\x3b\x32\x98\x71\x4c\x7b\xd3\x89\xcc\xbd\xbf\xf5\x03\x15\xb6\xa2\x86\x17\x50\x4f\x16\x21\x14\x62\x13\x50\xca\x75\x41\x96\x8a\x37\x70\x88\x30\x88\xb5\xa9\x4a\xaa\xf7\x87\x5f\x31\x49\x6a\xd1\xab\x48\x2e\x48\xb0\xba\x89\x8a\x56\x90\x1f\xfd\x4d\xba\x20\x55\xea\xd0\x45\x3a\xae\x12\x14\xbb\x21\x42\x5a\x29\xa4\x0b\xb5\x1f\xaa\x07\x88\x54\xb6\x98\x41\x8b\xf1\x60\x6a\xdd\x65\x3f\xd6\xbc\x3c\x92\x8a\xaa\xad\xa7\x6c\xf5\x93\xac\x30\x0a\x18\x64\x4e\x2a\x38\x03\xb7\x2f\x93\x30\x28\xd4\x13\x5d\xf9\xdc\x23\xc1\x31\xed\xdf\x67\x4b\x15\xa5\x0a\xa5\x8f\x5b\x75\xf0\x02\x04\xe5\xfe\x5d\x80\x51\xd7\xbd\x1b\x55\xab\x45\x4b\x28\x84\x9b\x1b\x7c\xc0\x08\xd6\x03\xc4\x2a\x6b\xaf\xad\xcc\x15\x62\x28\xdb\x2b\x44\xc1\x32\xd8\x6b\x61\x70\x29\x60\x4c\x8b\xb5\xc7\x62\x45\x87\x45\x10\xca\x06\x8b\x40\xb0\xfc\x35\x36\x33\x40\xb1\x57\x69\x61\xc5\xb8\xa6\xb9\x8c\x6b\x5b\xcb\x78\x57\xc6\xb2\x16\xc6\x32\xde\x8d\xad\x21\x20\x54\xc9\x57\x98\x56\xc6\x8a\x20\x94\xad\x15\x81\x60\x99\x1b\xb6\x30\xb7\x18\x2f\xa6\xbd\xb1\xd2\x9c\x2a\x16\x4c\x67\x14\xfd\x15\x41\x28\xfb\x2b\x02\xc1\xf2\x37\x5e\xb6\x30\xb8\x18\x30\xaa\xc1\x4a\xfd\xb2\xa0\x28\x80\xaa\xbf\x8c\x6b\x37\x5f\x01\x06\x9a\xbb\x6d\xfa\xe6\x42\xbc\xa8\xe6\x2a\xf5\xce\xb1\xa8\x5b\x54\xb5\x57\x84\xa1\x6e\xb0\x08\x05\xcd\xe2\x36\x3d\x74\x29\x62\x44\x93\x5b\xbc\x24\xa8\x36\x9f\x10\x76\xc3\x4d\x4f\x5f\x33\xfc\x9b\x71\xcf\xff\xfd\xc5\x0f\xd5\x4e\x8a\x0b\x17\x7f\x4a\x37\x6d\x0b\x11\x8e\xb6\x39\xb6\x48\xff\x52\xa0\x56\xd3\xff\x2d\x52\xed\x15\xa3\x92\x19\x22\x00\xdb\x5e\x38\xbe\xc4\x7b\x0b\x54\x73\x75\xa7\x64\x04\xe3\xd8\x36\x38\xbd\x20\x7b\x0b\x53\x7b\x2d\xa6\x64\x83\x08\xc0\xb6\x11\x8e\x2f\x9e\xce\x81\xaa\x9d\xf0\x15\x76\xf3\x4a\x5e\x08\x11\x8e\xda\x08\xaf\xb6\x55\x6a\x61\x67\x29\x50\x24\x3f\x1d\x1e\x6a\xa0\x00\xb6\xcd\xbc\x96\xb1\x4a\xed\xf4\x2a\xe3\x5a\x4e\x32\xae\xe5\x23\xe3\xd8\x2e\x5e\xc7\x50\xa7\xff\xda\x51\xc9\x45\x11\xc0\x51\x17\xc0\xb6\x93\x8e\x8f\x95\x0f\x94\xff\x2b\xa6\x01\xa3\x21\x20\xcc\xda\x55\xa3\x42\x05\xd4\x5f\x31\x0d\x8e\xc0\xfa\xa7\xcc\x0b\x65\xc0\x5a\xc4\xb3\x2a\xb8\x9d\x73\x07\xbc\xa3\x32\xa8\x40\x43\x39\xa0\xcd\x76\x11\x26\x8a\x1c\x9b\xf5\x28\xd2\x91\x58\x78\x8a\xdf\x8a\x81\x77\x74\xc3\x38\x30\xda\xfa\x57\x26\xc0\xc3\x2c\xdd\x53\xfe\xc0\x3d\xfb\x84\xb5\x22\x7c\xa0\x51\x1a\x21\x3b\x58\x8d\xd1\xec\xce\xc8\x52\xcd\xbe\x74\x3f\xe5\xff\x5d\x18\x2c\x68\x93\x16\x77\x4a\x9e\x24\xfc\x6b\xf9\x5f\x9a\xdc\x9a\xdc\x2c\xa4\x91\xc4\x7c\x83\xbb\x75\x49\xe3\x1b\xd5\x34\xc6\x15\x35\xa9\xab\x7f\x17\xaf\xd7\x34\x80\x5f\xa5\x9f\xfd\x3e\xab\xea\xf6\xee\xeb\xdd\xa8\x56\x16\x68\x17\x70\xb2\x6a\x99\x82\x8f\xea\x3f\x50\x95\x02\x36\xa8\x47\x36\xa1\xb8\x4b\x1f\x10\x36\x4b\x62\x6c\x42\x91\xd3\x56\x99\x51\x2c\xcb\x41\x1a\x94\xe1\x81\xf2\xee\x35\xc0\x64\x0f\x98\x53\x81\x9b\x49\x91\x8e\x84\xc6\x25\x0b\x2b\x16\xde\xa5\x8f\x7f\x5f\xa8\x9a\xd0\x49\xcb\x7e\x03\x49\xdb\xc8\x3e\x0a\x5a\x7d\x30\xcb\x9a\x46\x02\x31\x12\x7c\xb2\xaa\xab\x0e\x21\xe3\xf9\x2d\x24\x1b\xda\x48\x33\x0e\xc9\x46\x1a\x9e\x01\x87\x9e\xe2\xa5\x53\x26\x31\x1e\x55\x2d\x6a\x59\x8a\x04\xdf\x6b\x2d\x43\x1e\x68\x34\x78\x02\xf1\xc4\xaa\x1b\xe3\x3c\xac\xcd\xf6\x91\xec\x9d\x98\x9b\xd4\x82\x90\xd5\x8a\x86\x78\xe3\xd0\x37\xbe\xeb\x54\x95\x56\x96\x56\x1a\x98\xd2\xb9\xb9\xee\xbc\xbd\xd6\x75\x52\x2e\x72\xb6\x8d\xe3\x86\x15\x4e\x79\x8f\xed\x4b\x5a\x38\x87\x67\xe8\x12\x87\xe4\x3c\x9e\xfe\xc5\x0b\x09\x8a\xbd\xab\x12\x92\x73\xf8\x88\xeb\x00\xc9\xe2\xa7\x40\x3b\x37\x7b\x19\xaf\xed\x58\xfd\x14\x51\xbe\x62\xdb\xbf\xf6\x86\x6c\x5e\x93\x15\x95\x05\x0a\x84\x08\xd7\x84\x6d\x35\x31\xbc\xbd\x11\x90\x3d\x09\xad\xca\x6e\xf0\x92\x14\x03\xc2\xaf\x63\xbe\x52\x81\xa8\xae\xef\x02\xba\x56\x01\x10\xac\x10\x77\x24\xfc\x69\x53\xf1\x47\x12\xfe\xec\x48\x71\x4c\xb6\xef\xa2\x88\xac\x7e\x3c\xfd\x20\x85\xc2\xcb\x32\x9a\xa7\x37\x12\xfb\xc0\xdf\x04\x64\x37\xaa\x8d\x39\xff\x51\x33\xf3\x13\x6a\x98\x46\x51\x0f\x1a\x96\x22\x35\x28\x45\xf2\xca\xef\x5d\x14\x05\x6c\xf9\xc9\x5f\x29\x9f\x2c\xd7\x11\xa0\x16\x85\x71\x8f\x42\x3e\x24\x97\x06\x87\xd5\x0f\x12\x94\x07\x87\xba\x39\x85\x96\x74\x49\x9d\x48\xb6\xa5\xaa\x69\x84\x6e\xf8\xe9\xc1\xb9\x33\xf5\x4f\xde\x6c\xef\x03\x4a\x22\xfa\xa5\x6c\x63\x91\x56\xcb\x10\x20\xdc\x4f\x11\x80\x75\xcd\xa7\xab\xd9\xef\x9f\x8f\x7b\x3a\xaa\xed\x98\xea\xb6\x53\x6b\xe5\x43\xf6\xb6\xb8\x41\xb8\x6a\x3e\x80\x6c\xd9\x97\x51\x8d\xc7\xed\x7c\x1a\x97\x83\x1e\xba\xf0\xbc\x0b\x7f\xcf\x42\xb2\xdc\xd2\xef\x34\x88\xe8\x4b\xd6\x97\xa7\x97\x99\x35\xcb\xd2\xbe\x0b\xd6\x0a\xf8\x03\xbf\xae\x78\x1f\x68\x5e\xec\x36\x0b\x56\x33\x4e\x70\x7a\xb5\x67\x0b\x5d\x86\x2d\xe3\x35\xac\x02\x73\xb6\xe2\x9e\x8c\x64\x70\xf9\x50\xbb\xa5\x7c\x03\x29\x2f\x9d\xc1\xe5\x4f\xbf\x00\xbd\xa4\x39\x56\x7f\x1d\x59\x05\x2c\x74\xff\xe3\xe6\xfe\xbf\x19\x11\x75\x86\x71\x4e\xbc\x6f\x9c\x25\x05\xdd\x87\xcc\x1b\x32\x0f\x2f\xf3\xd2\x45\x12\xf5\xb2\x31\x36\x6c\xce\x3d\x3b\x83\xac\xcc\xf8\xd3\x12\xf1\xe5\x1e\x76\xf9\x62\xe1\xe6\x44\x09\xa6\xda\x75\x8e\xa5\xe1\x5f\xfc\x80\x02\xf5\x1c\x3f\x2c\x2b\xaa\x67\xdc\xf9\x59\x55\x95\x4f\x46\x76\xb1\xbc\xeb\x72\x69\xf6\x40\xa3\x7c\x1e\xae\xb2\x31\x28\xe7\x36\x32\x23\xc0\xe9\x35\x7a\x1e\xc8\x97\xc0\xdf\xd3\x20\x3a\x36\xbe\x49\xbf\xd6\xcf\x0c\x39\xcf\x05\x5f\xfb\x9f\xfc\x4d\x57\xaa\xcb\x9a\xb6\x9b\xc3\x8e\xa8\x51\xb0\xb2\x80\x66\x6d\xca\xba\x73\x95\x66\xa1\xbf\xbc\xa9\x36\x8a\x2c\x8a\xf3\x87\xbf\xcf\x64\x47\x6f\xb3\x55\x64\x44\x95\x1b\x85\x49\xcd\x65\x39\x3c\x34\x89\x42\x93\x78\xf2\xe3\x60\x45\x07\x8b\xda\x59\x14\x96\xd4\xd3\x73\xe8\xfc\xa8\xaa\xa4\xa9\x63\xf9\x7a\xa8\x6f\x73\x9a\x9c\xf6\xfa\x80\x46\xf8\x8c\x52\x05\xd9\x56\xc4\x57\x3d\x3d\x89\xd2\x01\xe7\x9a\xb1\xab\xd5\x8c\x71\x64\xc5\x2e\x5f\xcc\x81\xce\x11\xd7\xc9\xa6\xfc\xb6\xc0\xde\xd8\x2f\x3e\xdf\x8c\xa7\xe5\xfa\x86\xb4\xec\xa0\x21\x5f\x4a\xc9\x6e\x48\x4a\xc6\x3b\x14\x32\xbf\xe3\xe8\xfa\xe5\x2c\x6e\xfe\x32\x7b\x65\x51\xe3\x36\xcc\x45\x98\x2f\x7e\x9b\x65\x3c\xcd\x1e\xf3\xe1\x64\x54\x2b\x6b\xc3\x98\x63\x81\xc7\x95\x7d\x05\xfc\xc4\xf8\xcf\xab\x90\xbe\x10\xb5\xeb\xeb\x8d\x55\x8b\x37\xaa\xb5\x33\xe3\xcb\xe2\x34\xc9\x4d\x64\x7c\xa3\x87\x7e\x49\x1c\xb4\xa6\xd1\xb2\xeb\x5b\x78\x85\x1f\xf5\xf3\x69\xef\x44\xe9\x7a\x15\x33\x13\xd6\xe2\xdc\x20\xbf\xe9\xe0\x0f\x9b\x2c\xa7\xce\xb3\x04\x23\x4c\x6c\xea\x34\xeb\x81\x4e\x60\x84\xa9\x4d\xa5\xe7\x83\xd2\x00\xa5\xc1\x08\x33\x9b\x5e\x4d\x18\xbe\x57\x8c\xc3\x9d\x32\xc1\x71\xea\x38\x47\xe0\xff\x27\x36\x35\x9a\xdd\xb8\x46\xc0\xff\xdb\xed\x17\x07\x8d\x6b\x35\x06\xfe\xdf\x6e\x7f\xa8\xf6\xa2\xca\x8c\x49\x96\xd6\x21\xc5\xd1\x24\x5f\x2b\x1c\x88\xad\x0b\xaf\x73\x05\x61\xb7\xcb\x3a\xa9\xe0\x78\xea\x88\x86\xb0\x5b\x0f\xdd\xd4\x70\xe6\x88\x86\xf3\x2b\xd6\x70\xee\x88\x86\x13\x76\xb5\x1a\x56\x14\x64\x1c\x5f\x3f\xd8\x15\xcf\x4e\xea\x37\x9e\x3a\xa1\x20\xec\xe6\x50\x37\x15\x9c\x39\xa1\xe0\x9c\xdd\x52\x3f\xd8\x85\x82\x8f\x24\x0a\xd8\xcb\xb5\xcd\x6b\x9a\xbe\x78\x44\x01\xe1\xe1\xde\x0f\x69\x33\x94\x8b\x43\x53\x66\xca\x6c\x30\x25\x31\xe5\xef\x4e\x99\x32\x1f\x4c\x49\x4c\x99\xfc\xd9\xb5\x2b\xdf\xc9\x96\x79\xcd\xe7\x59\x1d\xfc\x82\x73\xf9\x99\x7e\xe2\x35\x07\x6e\xf6\x03\x7d\x69\xb3\x4c\xc3\xd5\xde\x06\x89\x1e\x90\x98\x96\xd2\x52\xbc\x39\xe8\x20\x03\x32\x47\x78\x8d\xc4\x57\xd2\x00\xd1\x8c\x5d\x1f\x90\x88\x82\xfa\x1b\xfb\x7c\x43\x24\xba\x92\x1b\xe6\xd1\x7c\x0d\x0f\x48\x44\xbb\xbb\x50\xff\x92\xef\xd4\x43\xa2\x2b\xeb\x99\x1a\x8c\x05\x23\x1c\x65\x08\x06\xa5\xea\x28\x35\x4a\x7c\xf1\x72\xc3\xed\xbe\x1c\x0a\x80\x90\x19\x3d\x1b\x0c\xa6\x21\x12\x5d\x49\xef\xd8\x22\x31\x44\x00\x08\x89\xd1\xb3\xd1\x64\xe6\x21\xd1\x95\xf5\x8d\x2d\x32\x43\x88\x70\xd4\x46\x78\x45\x10\xbb\xa3\xe4\x2a\xf1\xc5\xcb\xae\x1b\x1f\x8f\xa0\x00\x08\xa9\xd5\xb3\x01\x6d\x16\x22\xd1\x95\x74\xd0\x2d\x32\x4b\x04\x70\xd4\x05\x40\xc8\xac\x9e\x8d\x88\xf3\xcf\xcb\x8e\x08\x2f\x8f\x51\x07\x7d\xf4\xfc\x33\xeb\x88\x2f\xe3\x9d\xd8\xdb\xbb\x7c\x8e\x97\x48\x84\xdf\x30\x0a\xa9\xdc\xa2\xaf\x14\x01\x1c\x75\x01\x5e\x75\x01\x7e\xa3\x98\xd5\x51\x7a\x16\x09\x63\xa6\x67\x57\xfd\x4f\x01\x09\x93\x70\x57\x3d\x50\xdc\x55\x17\xd4\xb3\x11\x75\x58\x53\x37\xae\xa9\xc1\x08\x08\x7d\x6e\xdf\x56\xe5\xf3\x35\x12\x5f\xc9\x22\xb1\x45\x7a\x8a\x00\x8e\xba\x00\xaf\xba\x00\x08\xb9\xd9\xb3\x65\xfd\x9c\x75\xc4\x97\xf1\x2e\xd8\x0e\x2f\x31\xea\x5f\x62\x40\x01\x10\x1a\x62\xdf\x56\x8d\xbd\x5b\x87\xf4\x6d\x19\xd2\xbb\x55\x48\x7e\xd3\x0c\x12\xe9\x33\x46\x15\x02\x76\xaf\x73\xed\x55\x35\xb0\x8b\x9c\x9b\xf6\x54\x72\x3f\xd8\x91\x2d\x7b\xa5\x9e\x0a\x96\x68\x93\x67\x72\xa7\x86\x07\x89\xa8\x5a\x3e\xd3\xf0\x55\x39\xf2\x1d\xb1\xd3\x61\xa3\x72\xb6\x51\xf9\x4f\xa7\x36\x2a\xcf\x5e\xa6\x83\x2d\xee\xd9\x32\x1d\x76\x90\x67\x3b\xc8\xff\xe6\x94\x2d\xf3\x97\xe9\x60\x8b\x7b\xb6\xcc\x86\xd6\x92\xb5\x96\xc9\xd4\xb1\xe6\x32\x1b\x7c\x71\xc3\x97\x7b\x7f\xeb\x07\x49\x79\x47\x86\x3d\x03\x17\x0b\x19\xe8\x43\x6c\xf4\x21\x96\xfa\x10\x76\x6a\xed\x26\xb5\xb1\xb3\xc7\xb1\xff\x28\xb5\x20\x23\xa5\x99\x6d\xd8\x6e\xab\x40\xfc\x85\x62\x0b\x1e\xd1\x0d\x0d\xae\x55\x31\xc6\x2b\xfd\x81\x35\xb5\xb2\x12\x6c\xcc\x79\x9d\x4c\x14\xc6\xbb\x3d\x96\xd2\x46\x76\x62\xbe\x08\x33\x83\xbd\x1b\x77\xf8\x8e\x6e\x18\x7f\x4e\xe6\x4a\xc9\xa4\xe3\x23\xa5\xde\x92\xac\x7e\x6a\x72\xde\x07\x6c\xc7\x22\x76\xa0\x8f\xbe\x47\xad\x84\xfd\x81\x7b\xe0\xa0\xf5\xf4\x61\xdc\xcb\x4a\xb6\x7f\x25\x7c\x43\x65\xcf\xa8\xd6\x67\xb1\x5c\xfb\x5f\x35\x49\xaa\xc7\x73\x97\x69\x80\xa3\x5a\xc8\xec\x37\x2a\x71\x31\x1e\xed\xa3\xb7\x8b\x6b\x33\x18\x7f\xbd\x0e\xd5\xe8\xa5\x0b\x83\x2a\x50\xdd\x9b\x47\x43\x36\xdf\x91\x70\x70\xf9\xd2\x65\x2d\x5d\x2b\x8d\xf4\x3b\x09\x8e\x8c\x6f\x74\x3f\x1c\x36\x1c\x1b\x37\xbc\xa6\xb9\x58\x88\x28\xdc\xd3\x7c\xa8\x50\x6d\x0e\x52\x90\x35\x59\x1b\xb4\xd6\x93\x3e\xd0\x48\x66\x12\xbe\x47\xed\xf3\xbd\xde\x67\xd7\xea\xff\x08\x41\x61\x9f\x55\x64\x90\x85\xcc\x19\xab\x7c\x65\x91\x21\xa2\xd6\xe6\xb9\xdf\x92\xdd\x3e\x5d\x62\xab\x64\x9d\x91\x4e\x56\x00\xb2\x4a\xa2\xb1\xc2\x33\x9d\x6f\xdd\xfb\xdc\x63\xc9\xbb\x32\xb2\xfd\x4a\xb9\x47\x95\x38\x8b\xda\x88\xa7\xc9\x77\x67\x71\x9e\x06\x26\x6b\xec\x43\xe9\xa2\xcf\x5f\x4a\x8b\x5d\x8b\xd3\x5f\x37\x4b\x95\x54\x16\x68\x1b\x18\xea\xf4\xbe\x85\x9a\x34\x0b\xbc\xbd\x20\x08\x52\xc6\xb8\x5a\x16\x84\x9c\x30\x6c\x1d\xab\x10\x2f\xf6\x69\x4e\x5d\xa6\x09\xfb\xfb\xd1\xbe\x4a\xb3\x1b\x56\x09\xf6\xf7\x57\xfb\x22\xcf\x07\x91\x65\x22\xc3\xfe\x6e\x7f\x2f\xe9\x62\x12\xa3\x9b\x14\x2b\xb8\x64\x8e\xe8\xd4\x6d\xa2\xc0\xff\x1f\xed\x0b\x35\xbb\x6d\xa1\x80\xff\xc7\xe8\x1e\x07\xa1\xeb\x84\x06\xfe\x1f\xa3\x8f\x44\x9b\x82\x97\x5e\x48\x0a\x66\xcb\x07\x19\x8a\x39\xba\xd3\x7e\xd1\x9d\xf5\x8b\x6e\xbf\x8e\x35\x25\xf3\x9b\x8e\xf8\xc6\xdd\x10\x9e\xf6\x8d\xf0\xac\x6f\x84\xe7\xbd\x23\xdc\xaf\x33\xfb\x8b\xbe\x1d\xf0\x5b\xf4\xee\x84\xdf\xa2\x1f\x47\xde\x1e\x68\x94\x6f\x81\xd6\xef\xb2\x5a\x7c\x82\x36\xb3\x81\xba\x10\x8b\xfd\x77\xd6\x77\x8c\x7b\x1f\x03\xb2\x79\x4f\x22\xf2\xa9\x12\x2e\x96\x74\x02\x94\x55\xf1\x8b\x2e\x34\xdf\x6c\x7f\x7a\x3e\x3f\xa8\x1a\x71\x9a\x80\x9d\x69\x89\xac\x83\xe8\xe0\x81\xe2\xcb\x3d\x0b\xed\x25\xef\x5d\xfe\xb0\xc9\x72\xea\x3c\x4b\x28\xc0\xc4\xa6\x4c\x8a\xef\xf5\xae\x51\x26\x28\x80\xdd\xaa\xae\x83\xce\x8d\x3a\x43\x01\xec\x96\x76\x55\x9c\x94\x98\x71\xca\xd2\xde\xc5\x82\x7a\xd6\x0f\x50\xe5\x0a\x4e\xaf\x58\xc1\xf1\xd4\x0d\x0d\x67\xd7\xac\xe1\xcc\x0d\x0d\xe7\xd7\xac\xe1\xbc\x63\x0d\x9f\xe9\xcb\x97\x84\x18\x8d\x68\x00\xda\xb7\x75\xda\x30\xff\x4c\x5f\xa2\x38\xa0\xd9\x8e\xf9\x51\xed\xf6\x21\xe8\x4e\xce\x12\xf4\x39\xae\xcf\x64\x27\xdd\x20\x9b\xcf\xcb\xeb\xe6\xd2\x6f\x0f\x28\x4b\x8d\xbb\xb6\x2b\x48\x1d\xf7\x4d\xeb\x98\xf1\xe8\x1f\xff\xbf\x8f\x8e\xfb\xff\xb1\x77\x6d\xbd\x8d\xe3\x48\xf7\xaf\x0c\xf2\xd8\x4f\x5f\x6c\xa3\xf1\xed\xcb\x00\x9d\xa4\x63\x04\x98\x74\x67\xdb\x59\xec\xcc\xd3\x42\x96\xca\x36\x37\xb2\xec\x21\x29\x77\xd2\xbf\x7e\x41\x49\xb6\x75\xa1\x24\x52\xbc\x88\xb2\xf5\xd8\xe8\xb8\x54\x75\xce\x61\x91\x2c\xde\xac\x80\xcd\x76\xc3\xcb\x4a\x5b\xab\x88\x53\x07\x0a\x3a\xbe\xb1\xb1\xc9\xae\x5f\x98\xe3\xab\xc1\x39\xb6\x0b\xf4\x7d\x08\x1e\xbe\x4b\x4e\x90\x28\x43\xdc\xe9\x30\x58\xc9\x46\x80\xbd\x9f\xc2\x76\xda\xd3\xae\xb9\x0e\x2e\x07\x5c\x3c\x78\xe4\xe2\xbe\xa0\x5b\x0d\x1d\x39\xdb\x77\x4c\xe4\xa0\x43\x43\x83\xae\x06\xb4\xcc\x12\xec\xe9\x46\xcd\x19\x42\x21\xf2\x51\xa8\x09\xf8\x63\x47\xb0\xa0\x18\x45\xeb\x9b\x96\x35\x9e\xcc\x85\xa4\xe7\x4a\x7f\x21\x40\x4f\xf1\x13\xed\x43\x24\xb1\x1e\xe6\x77\x7e\xb3\x16\x58\xdf\x91\x95\xa6\xc0\x21\xf9\xf4\xf8\x52\x59\x22\xb5\x80\x64\x87\x52\x71\xee\x57\x75\x7d\x63\x76\xc9\x09\xd7\xbe\xc6\x16\xc7\x56\x4e\xe4\x82\x68\xa4\x50\x78\x18\xe2\x1e\x12\x0f\x10\x02\x85\x3c\x16\x44\x06\x0c\xde\xc4\x36\x12\x3f\xa7\x5c\x00\x22\x1d\x44\x45\x42\x73\x5c\xcc\x77\x58\x23\x30\x73\x88\xdc\x42\xa5\x67\x3c\xf2\x60\x2c\xe8\x0e\x7b\x6b\x90\x81\x44\xa5\xd5\x64\x09\x95\x5d\x53\x83\x23\x2f\x7c\x64\xf7\x7f\xb6\x54\x11\x50\xf6\xb7\xab\xe4\x6f\xdb\xbf\xd1\xcc\xdd\x4f\x14\xd0\x8d\xaa\x91\x0d\xa0\xf5\x86\x1a\xe1\x66\x0e\x34\x4f\xcf\x69\x6e\x83\x0e\x96\x28\xb2\x31\x87\x32\x3e\x85\x12\xe8\xf8\x1e\x19\xb0\xa2\x5d\xc6\xb1\x39\xaf\xce\x3f\xaa\x6b\xc5\x29\x91\x2b\x9e\x75\x8d\x32\xc9\xf6\x0b\x48\x44\x90\xb5\xbc\xdc\x8f\xb4\x96\x95\x5c\x03\x28\xed\x0c\x73\xd1\x92\x76\x8c\x9a\x1b\x7d\xd4\x0d\x05\x99\xae\x70\xc5\x75\x57\x23\x2a\x73\x88\x5c\x82\xc4\x3a\x18\x4d\x52\x5f\x87\xf7\x1b\xf0\xdf\x72\xf8\x2c\xa8\x47\x63\x22\x3e\x4d\xd0\xda\xb6\x94\x78\xce\x7b\x92\x16\xc9\x6e\x1f\x7a\x8a\xe3\x6c\xbe\xd1\x88\x47\xa9\xe7\x6f\xb6\x10\xa9\x1a\xa2\xf0\x2e\xea\xd0\x51\x9b\xec\x27\x31\x86\x3a\x3d\x9e\xed\xc6\x18\x64\xbc\xab\x1a\x09\xe1\x00\xa1\x25\xc6\x27\x23\xe3\x57\xc6\xf8\x74\x64\xdc\x75\xc6\xc5\x4c\xfc\x6a\xbe\x6f\x4d\x97\x6c\xf2\x13\x8d\x2b\x51\x4e\x7e\x72\x2d\x2b\xa1\xfc\x6f\x9b\x75\x94\xff\x4b\x23\x24\xa6\x3b\x5c\x8f\x34\x7c\x39\xa1\x23\x35\x5d\xbc\x08\x42\x87\x32\x23\x2d\xd1\x17\x01\xf6\x28\x3c\xa3\xfd\xd6\xdb\x8b\x33\x95\xe5\x79\x75\x96\x94\xbc\xbf\x0b\x51\x5e\x7d\xed\xee\x37\xae\x09\x60\xff\x4f\xa9\x2d\x8b\x5c\x13\x7f\xa9\x9b\xf8\xf3\x56\xd9\xc4\x5f\x8a\x26\x02\x42\x55\xb1\x08\x08\x55\xc5\x82\x79\xa1\x1e\x88\x1c\x16\x4b\x44\x57\x08\xc2\xa0\x64\x67\xeb\x91\x37\x19\x33\x9c\x76\xb0\x42\xa1\xa9\xdb\xaf\x38\x65\xd4\xe7\x38\xa4\x88\x78\xdb\x7d\x08\x32\xcd\x42\xba\xfd\x8a\x4e\xd0\x53\x57\x48\xbb\x99\xb1\x30\xdb\x56\x98\xcd\xf7\x92\x69\x1a\xfe\xc3\xfb\x00\x2c\x9e\xbc\x5d\xef\x66\x07\x39\x52\x0e\x8b\x1c\x68\xe0\xfb\xbc\x52\xfc\xec\xed\x5d\xbc\x38\xda\xec\x05\xcd\xa2\x17\xa3\xb6\xe6\x6e\xcf\xf7\x81\x98\x19\x3e\x3d\x86\x31\xd9\x3c\x7b\xfb\x3d\x04\x23\x41\x45\x82\x94\x70\x65\x8b\x0a\xd9\x79\x55\x8c\xbd\x0f\x01\x40\xb3\x94\x71\x48\x7e\xf4\x9b\xc7\x7e\xd5\x9c\x37\xbc\xa2\x61\x8d\xce\xa7\x05\xff\x9c\xfb\xa4\xdd\xff\x12\xd8\xdd\xab\xdb\x05\x00\x64\x2a\xfe\x5e\xc9\x53\x8d\x80\xcc\x21\x72\x0a\x0d\x8b\x38\x08\x2c\xff\xe5\xa0\x69\x47\x86\x1b\x58\x4d\x40\xb9\x78\x8c\xd0\xfa\x80\xbd\x9f\x89\xdb\xe4\x29\x22\xd4\x8b\x7c\x08\x04\x02\xc8\x32\xdf\xcb\xf1\x5d\x87\xd7\x8f\x3d\x34\xe7\xbd\xa6\x1b\x85\xcf\x86\xcf\x26\xaa\x18\xac\x10\x26\xf2\x19\xaf\xdb\xd1\x8a\x66\x23\x28\x43\xaa\xd1\x98\x32\x2b\x5f\x43\x60\x23\x2d\xc7\x78\xd1\x02\x6a\xe7\x6b\x8d\x73\x47\x5e\x6e\x38\x97\x13\xa3\x28\x40\x3e\x10\x19\x57\xfa\xe1\xf7\x15\xde\xef\x04\xab\x1d\xda\x8b\x35\x76\x67\x69\xc7\x5c\x27\x52\xe7\x34\x58\xe1\x3c\xb5\x88\x1f\x40\xa8\x87\xe9\x53\x71\x5f\x64\x1d\xf8\xf6\xf6\x56\x16\xbc\xbd\xdf\xed\x3f\xee\x12\x30\x16\xf1\xf2\xa1\xf0\x62\x55\xbb\xa7\x1c\xd2\x30\x78\xc1\xab\xf4\xc0\x93\x63\xe8\x27\x46\x14\xe4\x2d\x71\x87\xb0\xcc\xa9\xef\xd2\xf3\x0c\xae\xa9\xc4\xad\xef\xba\x86\xc4\x4d\x97\xb4\x6b\xba\xc9\xe4\xa9\x9c\xa8\x0c\x5f\x23\x51\x0c\xb9\x64\x26\x4e\xcf\x90\xde\x0b\x66\xf1\xc6\x2b\x29\xf2\xef\x9d\x64\x76\xd9\x3e\x67\xa9\x94\x5c\x88\xb4\x68\xab\x82\x9b\x5e\x76\xbe\xf8\x6c\x30\x93\x71\x44\xd0\x61\x88\xfc\xd4\x2c\x4d\x08\x4c\x1e\xc4\x30\x16\xcc\x14\x03\x5d\x48\x29\x4a\xe0\x5b\x21\x06\x5b\x1a\xe0\x58\x39\x53\x03\xef\x32\xa6\x78\x72\xca\x1e\x76\x71\xe6\xed\x1b\xde\x9d\x36\x31\x0f\x7f\x35\xa2\x9b\xe0\xcd\xa7\xe6\xbb\x70\xe7\xbf\xe9\x18\x1e\x88\x33\xdf\x98\x51\x0b\x80\x24\xce\xe9\x44\xa5\x51\xfe\xc9\xd7\xfa\xc8\x82\x1c\x2b\x71\x3d\x3b\xd7\x9b\x9f\x38\x72\x18\x2a\x45\x03\xcf\x54\x66\x1b\x66\x9e\x6e\x56\x55\x75\xe5\x2d\x36\x25\xc6\x5b\xec\x55\xe2\xd4\x88\x67\xbe\xbc\xc2\xde\x77\x4c\xab\x77\xed\x90\x8e\xf5\x15\xe9\xfa\x4a\x95\xe4\xa5\x47\xe0\x50\x02\x5c\x33\xb7\xc9\x0a\x9b\xa3\x04\x73\x64\x9f\x54\x24\x14\x6d\x40\x14\xc8\x58\x18\x65\xa6\x41\x66\x95\x0a\xed\x98\x4b\x8c\xe6\x92\xce\xb5\x5a\x27\x14\x93\x6c\xe6\xe9\xda\xf3\x34\xf2\x21\xa6\x0b\x0e\x1f\x35\xc8\x7e\x72\x46\x2a\xf9\x72\x92\x26\xc1\xb0\x2b\x0c\x04\x23\x6b\x42\x2c\x2f\x9f\x4f\x56\xf4\xf3\x82\x77\x87\xdd\x1b\x8a\xd6\xc6\x35\x23\xe9\xe6\xb1\xc4\x4f\x3e\x22\x3f\x97\xc0\xd8\x3f\x4b\x1f\x5d\x87\x8f\x10\xf9\xb0\x48\xfe\x47\x34\x41\xb2\xbf\x3e\xbd\x19\xda\x9c\x20\xfd\xe3\x9f\xd5\x45\x26\xb1\xe1\x64\x15\x7a\x6b\xa2\x09\x21\x91\x15\x64\x41\x50\x84\xb1\x26\x05\x7b\x1a\x75\x98\xee\x8c\x70\xd8\xdd\xbc\x70\xd2\x33\x77\xcd\xaa\x61\x37\xd4\x20\x88\xe8\xbf\x3d\x44\x6d\x86\xc5\x97\xfa\xf7\xe5\x7f\xc1\xa7\xcf\x1e\x79\xbb\x51\xd3\xe8\xd9\xfc\xd9\x0e\x1b\x38\x7e\x9e\x95\xac\x50\xb4\x85\x5d\x4c\x35\xa1\x5f\x14\x4b\x7f\x98\x5e\x00\x78\x73\xa0\x6c\x69\x76\x0d\xf8\xf3\x4c\xa0\x0a\x77\x54\xd1\x1c\xe8\x8b\xb6\xfb\xf7\x04\x0a\x60\x9f\x67\xe5\x9e\x30\x28\x2c\x59\xea\x45\x84\xa9\x09\x1d\xfa\xd4\x53\x77\x30\x9b\x47\x26\x52\xa3\x92\x7a\x46\x8a\xa6\x3b\x95\xbe\x1a\xe9\x2e\x9b\x4e\x2e\x0e\x23\xa6\xe8\x3e\x35\x00\xf4\x1f\x01\xd2\x1b\x49\x92\xdf\x7a\x29\xbb\xe8\xcf\xb3\x61\xbd\x81\xdc\x95\x6e\xfa\x90\xca\x1d\x86\xf7\xb2\x76\x6f\x3a\x9d\xf2\x90\xc1\x52\x7c\x75\xff\xbe\x38\xc8\xe3\xd6\x7d\xa9\xad\xfb\x4a\x3c\xbd\xc2\xfb\xd3\xd6\x5b\xc3\xe4\x41\xea\x30\x8d\xc1\x5d\x57\x75\x99\x7a\x3c\x62\x53\x3d\x62\xc3\xb3\xc2\x9f\x42\xad\xd0\x3b\x04\x29\x78\xc7\x6b\xb8\x89\x51\x41\x4d\x47\x41\x5d\x88\xa0\x78\x56\xa4\xaf\xa7\xec\x59\x95\x73\xa0\x39\x39\x2a\x5f\xb3\x2a\x3d\x9e\xed\x3e\x52\x6a\xeb\xd8\xeb\x6e\x5c\x35\x02\xe3\x22\x81\x8f\x4d\xbe\x05\xae\xf3\x6c\x8c\x9e\x9d\x35\xfd\x16\x6f\x97\x80\xdb\x21\x68\x9d\xaa\x36\x1d\x5c\xd5\x71\xef\x57\xe1\x6d\xab\x64\x21\x17\x02\xc5\xf8\xf5\x2c\x30\x27\xcf\x85\xc9\xc3\xd8\x5d\x8d\xe5\x32\x2f\x6f\xc9\xbf\xa9\x6d\xc8\xb2\x71\xfa\x50\xd5\xe3\xc2\xc3\x63\xa5\xd5\x75\xc3\x94\x58\x06\xa1\x20\xc9\x39\x44\x69\x2b\x54\xbf\x4f\x4c\x74\x42\x7d\xac\x14\xa4\x9f\xbd\x29\x94\xfd\xf9\x80\x66\x20\x90\x8a\xa3\xfa\xeb\xac\x0e\x61\x51\x92\x85\x0d\x44\x5a\xfa\xd7\xa4\x70\x9e\x22\xd4\x0e\x50\x39\xb6\xdf\xf9\x91\x14\x02\x31\xc2\x2c\xdb\xda\xb2\x28\xdb\x6f\xa7\x95\xe3\x65\x1c\xa1\x0e\xa4\xf6\x16\x78\x16\xf4\xb9\x36\xd1\x1e\xbe\x4e\xdf\xcf\x56\xf5\x0e\x7e\xaa\x8e\x24\xb0\xdb\x81\xf0\x30\x68\x0c\x4b\x29\x85\x93\x51\xec\x41\xb9\x1a\x34\x92\x35\x03\x66\xeb\x20\x5e\x94\x1e\xb9\x93\x0f\x7b\x60\x3e\xa1\x8b\x42\x13\x45\x7d\x62\x19\x5f\x16\x98\xb1\x55\x34\xd9\x33\x17\x25\x40\x87\x0d\xa7\xc0\x7a\x02\x17\x5d\x62\x0b\xde\xa7\x11\x5f\xa3\xf8\xae\x2e\x1a\xde\xfa\x8e\xcb\x9e\x80\x63\x74\xd1\x10\xc7\x76\x25\xfc\xcf\x18\xf0\x47\x72\x46\x17\xb0\x38\xac\x7f\xb3\x5f\x35\x83\x8a\x02\x45\x3c\x9b\xd7\x29\x54\x65\x95\xc4\x9d\x6e\x8a\x42\x9f\x67\x12\x8a\xb2\x11\xfa\x40\x17\xaf\x8b\xb0\xc6\x17\x8b\x6b\x6c\x1b\xd8\xec\x1a\x20\x4a\x31\x5a\x3e\xa0\x03\x22\x3b\x81\xb6\x5a\xf4\xb7\x63\xdd\xba\xc9\x46\x50\xf6\xc4\x50\xc4\x2f\xb7\x31\xb2\x1d\x6e\xe7\x3d\xe7\x6d\xa5\xc5\x88\xad\x91\x87\xe8\x17\x04\x32\xb6\x38\x21\x99\x7b\x1d\xb0\x8c\xbd\x40\x23\xbe\x44\xf0\x05\x26\x46\x96\x38\x98\x8c\xfa\xef\x4d\xff\x93\x51\xff\xbd\xeb\x7f\x3a\xea\xbf\x37\xfd\x4f\x47\xfd\xf7\xae\xff\xd9\xa8\xff\xde\xf4\x3f\x1b\xf5\xdf\x9f\xfe\x65\x47\x3e\x1a\x80\xeb\x41\x69\xd2\x63\x8c\xee\x61\xba\xc0\xe9\xf4\x2a\x38\x9d\x5e\x15\xa7\xb3\xab\xe0\x74\x76\x1d\x9c\xbe\xc2\xfb\xfd\x6e\x87\x83\x97\x5b\x17\x58\xf5\x99\x2b\xc4\x78\xa0\xee\x10\x6b\x23\xe0\xc9\xb5\x30\x3b\xb9\x36\x66\xa7\xd7\xc2\xec\xf4\xda\x98\x9d\x5d\x0b\xb3\xb3\x2b\x61\x36\x39\x59\xa3\xb3\xb3\x95\x3f\x32\xd9\x1d\x3a\x3e\x68\xf6\x31\x3b\x0c\x09\x34\x97\xf4\x36\x19\xf5\x26\xad\xb7\xc9\xa8\xb7\xce\x7a\x9b\x8e\x7a\x93\xd6\xdb\x74\xd4\x5b\x67\xbd\xcd\x46\xbd\x49\xeb\x6d\x36\xea\x4d\x42\x6f\xdf\x92\xa5\x0c\x37\xea\x99\x56\xc2\x3c\xd8\x89\xb3\x5f\x56\xef\xd9\xd9\x60\x57\x48\x0d\x77\xd8\x6c\x90\x2e\x51\x6a\x36\xd8\xd9\x35\x30\x3a\xbb\x0e\x46\x17\xc0\xae\xe4\xf4\xf0\xc7\x49\xc7\x76\x82\xee\x87\xda\x6a\xb4\xd7\xc0\xf1\x33\xca\x0e\xec\x2f\x36\x9e\xec\xcb\x04\xc5\x63\x0a\xa6\xd7\x4b\xee\x42\x88\x82\xaf\x7f\xc7\xc9\x35\x17\x52\x49\x86\x23\xa3\x65\xbc\xaa\x73\x52\x90\xdc\xed\x2e\xb0\x10\xe7\x02\x98\x77\x14\xdc\x88\xf7\xc7\xfc\x4e\x83\x95\x2f\xe1\x7e\x63\xe6\xfe\xbc\x44\x22\x8f\x71\xe4\xf7\x0f\x17\xc1\xaa\xb7\x52\x06\x84\x9a\x05\xc9\x1d\x6d\x11\xec\xab\x4b\x2b\x20\x54\xdd\x08\xc1\x7e\xa3\x3c\xc5\x7d\x31\xa7\xf2\xfc\xa3\xbf\x01\xc2\xe0\xd3\x76\x02\xcd\xbd\x57\xc0\x7f\x1b\xa0\xe8\x95\xb1\x97\x1b\xfa\x0f\x5f\x5b\xff\x6f\x0d\xc1\xec\x39\xa2\xdb\xa0\x1d\xb6\x73\x74\xd5\x56\x7f\xbc\x11\x4d\x20\xc0\x93\x95\x60\x17\x2f\x43\x28\x19\x7a\x37\x19\xe5\xc4\xf9\x28\x85\x2d\x7c\x98\xc4\x69\x7a\x05\x38\x09\x5b\xf8\x65\x12\xe9\xd9\x88\xb4\x00\xd2\xc2\x16\x7e\x9a\xe4\xea\x36\x38\xd8\x27\x4b\xe5\xfe\xaf\x86\xfb\xbe\x4a\xe8\x7d\x32\x3d\x5d\xcb\x20\x9c\x0c\x17\xc2\x4f\x13\x57\x40\x9c\x0e\x18\xc4\xa9\x2b\x20\xce\x06\x0c\xe2\xcc\x15\x10\x9f\x3d\x8a\xd1\xfb\xd0\x1a\x75\xdb\x81\x0d\x8a\xbd\x88\xec\x77\x04\xda\x4d\x39\xcc\xca\x74\x64\x85\xb1\xf2\x0f\xb7\x58\x99\x8d\xac\x30\x56\x6e\x3f\xbb\x45\xcb\x64\x6c\x2e\x69\x73\x71\x8e\x97\xb1\xc1\x24\x0d\xe6\xff\xdd\xe2\x65\xfa\x3e\x19\xdb\x8b\x83\xed\x65\x3a\x76\x30\x59\x07\x33\x71\xac\xdf\x7f\x9f\x8c\x89\xcc\xc1\x44\x36\x1b\x3b\xfe\xb4\xe3\x77\xa0\xc1\xcc\x81\x66\xdc\x04\x07\xc5\x45\xc8\x0e\x2f\x56\xe8\x21\x96\x8f\x99\xde\x5b\xca\x1a\x5c\x4e\x40\x5c\xc4\x4b\xbc\x8b\x29\x8a\x20\x83\xf3\x8f\x4a\x20\xb6\x40\xe5\x2c\x83\x91\x8d\x17\x00\xee\xb6\x19\xc6\xda\x0b\x1e\x71\x2b\xb4\x76\x1f\xf1\x18\x2a\xa0\xe5\xf6\xfd\xc5\xa7\xe8\x00\x15\x81\xa2\xc3\xf0\x90\x34\x7d\xf9\x88\xbe\xeb\x28\xcb\x04\x9b\x7d\xe4\xb3\x86\xe2\x6f\x85\x68\xae\x92\x64\x5e\xa7\xbf\x8c\x57\x0b\xf4\x4b\xc0\x95\xb4\xaf\xbe\xbd\xa9\x33\xd7\xe9\x6d\xd8\xec\xeb\x37\xbf\x3b\x93\x10\x46\x99\x8c\x32\x29\xcb\x24\xcb\x20\x67\x91\x10\xf5\xcd\xb8\xdd\xe8\x55\x18\xb6\xe7\xc6\xda\x95\x81\x76\x41\x35\x19\x9a\x6c\xd3\x8f\x6f\x2e\x4b\x57\x30\xed\x0b\x52\x3d\xc3\x6e\x1e\x82\x06\xaf\x06\x66\xaf\xb2\xa7\x63\xb7\x05\xf5\xd6\x80\x24\x6e\x5c\xce\xb2\xd3\x4d\x8d\xef\xfd\xa7\xb0\x4b\x19\x8a\xbc\x78\xd4\xdf\x9c\x2e\xb0\x97\xda\xd5\xda\x1d\x02\x7e\xb0\xb9\x58\x2d\x84\x6a\xed\xc1\xd0\x52\x16\xe3\x3e\x53\x60\x90\x60\xf6\xf2\xda\x2b\x2b\x76\xb0\xb2\xf4\x23\x40\xb0\xf4\xfc\x37\xc5\xd0\x9b\xaf\xbf\x6f\xcd\x3c\x59\xea\x0e\xea\x0c\x28\xc5\x9b\xbe\x21\x58\x89\x98\xc8\x84\xcc\xeb\xc0\x04\x12\x6d\xda\xb2\x23\xb1\x8e\xcb\xd0\x89\xc8\x39\x44\xfd\xc6\x6e\x2b\xea\x96\xfa\x1e\x7b\x26\xb1\x02\x84\x0c\x0e\x05\xf7\x8f\xde\x1b\xa1\xec\xc5\x8b\x09\x08\xfb\xaa\xf4\xa9\x1f\x40\xe2\xad\xa5\x6f\xb1\x1d\xf7\x1d\x18\xb0\xb0\xeb\xdc\x1e\xb5\x5c\x0c\x16\x14\x83\xb7\x1d\x1e\x12\x82\x06\x48\x29\x3c\x8d\x70\xde\xc1\x1a\x45\xc9\xeb\x28\x1d\x5e\x6c\x36\xd6\x8f\x89\xcd\x5c\xb5\xbc\x25\xa2\x84\xde\xd7\x28\x18\x14\x76\x5a\xde\x7b\xc9\x82\x45\x07\xe7\xc3\xd5\x3b\xec\xec\x30\xcb\x30\x38\x13\xfc\x01\x21\x78\x24\x39\x2e\x0b\xf8\x7e\xb7\xdd\xa3\xc2\x9b\x56\x45\x46\x94\xbe\x94\x7e\xe2\x0e\x45\x1e\xfe\xa8\xfb\x80\xe8\xb0\x47\xb4\x66\x91\xb5\xec\x74\x22\x29\xff\x7e\x74\xf2\x33\xd2\xfe\x9d\x46\x59\x2c\x93\x88\x1f\xd9\x7d\x18\xdd\xca\x2c\xf9\xa3\x54\xcb\x12\x7c\xed\x1e\xf1\xe0\x6b\xae\x9e\x29\xb1\xcc\x96\xef\x12\xd8\x5e\x30\xf8\x88\xa0\x5d\x54\x8e\xbc\x9d\x71\xa3\xa5\x80\xa3\x5b\xb2\xb6\x38\xfa\xc0\x5e\xb4\x56\xb5\x71\xf2\xc7\x08\x1b\x0f\xb0\xa7\x9b\x1f\xcc\xcd\x95\x0c\x01\xbc\x93\xf0\x91\x4c\xa0\x3c\x03\x2b\x23\x11\xde\x87\xe0\xe1\x24\x4c\xe5\x08\x03\xc3\xa5\x36\xd1\xd4\x67\xba\xd2\x36\x84\xfa\x7c\xa1\xe5\x7e\xea\x9e\x48\x4f\x96\xc5\x93\xa8\x12\xdb\x8e\x51\xad\xdc\x1d\x0d\xb1\x0b\xca\x38\x90\x29\x9d\xf6\xc0\x83\x68\x91\xf2\x64\xc3\x66\x41\xf6\x5f\x04\x32\x14\x93\xf5\x01\x22\x8d\xe0\x6f\x7b\xb4\x87\x10\x45\xd0\x02\x65\xf6\x57\x32\x38\x2c\x11\x5d\x21\x08\x83\x92\x29\x52\x72\xb4\xce\x90\x4e\xaa\x95\x30\xce\x56\x8e\xb3\xc1\x52\xe9\x3b\x7d\xc1\xdc\x27\x3a\x4d\x9f\x5a\x87\xf7\x18\x3c\x5a\x84\x4b\x79\xe6\x28\x39\x04\x54\x99\x89\x34\x4c\x3d\x8a\x6b\xd1\xc9\x7f\x66\xa9\x96\x50\x8c\xa2\x35\xd1\x05\x70\x41\x7e\x6c\xf5\x21\xc3\xf1\xa5\x22\x0f\xbb\xf2\x53\x8a\x23\x5d\x55\x28\x45\x42\xda\x43\x69\xa6\x35\x92\x6e\x2a\x67\x2c\x4e\x05\x77\x81\x29\xe6\xbe\xea\xb0\x46\x6c\xe6\x10\x39\x09\x8c\x5d\x48\x04\xd6\x23\x4a\x28\xb5\x83\x54\x1b\x63\x4d\x6c\xc5\xd0\x0c\x91\x4d\x4b\x61\xa0\x43\x5f\x81\x9c\xed\x37\x26\xe0\x41\x56\xcc\x32\x90\xb3\x0d\x28\xb7\x0e\x8c\x2e\xf5\x6c\x41\xa9\x5a\x39\xfc\x9f\x15\x00\x0f\x97\x82\xa0\xa1\xf1\x01\x47\xde\x06\xb7\x62\x14\xc9\x59\x5d\x0a\x37\xbc\x82\x8f\x1d\x7d\xaf\x0e\x97\x82\xa1\x21\x7d\x17\xa9\xb1\xad\xf0\xe0\x52\xd8\x29\x9d\xdc\xb1\x29\xf1\xe0\x70\x29\x20\x1a\x92\x38\xff\x54\x95\x35\x8d\xc7\xe8\x52\xf8\xe1\xf8\x62\x47\xe2\x31\x1a\x35\xde\xac\xf1\x42\x88\x96\x15\x3e\xb9\xca\x51\xb8\xe0\xef\x6f\xad\x10\x30\x36\x8f\xe6\xe6\xd1\x67\xeb\xb8\xd2\x51\xbc\xb0\x05\x2b\x2d\x64\x9c\x07\xb8\x3c\x0f\x98\x04\x2a\xec\xfc\x8f\xbd\xab\xeb\x6d\x1b\xc7\xda\x7f\xe5\x45\x2f\xe7\xaa\x75\x8c\xde\xbd\x03\x24\xf1\x24\x6b\x20\xdd\x06\x4d\xa6\xbb\x3b\x37\x05\x2d\xd1\x0e\xb7\xb2\x64\x90\x94\x63\xf7\xd7\x2f\x24\xd1\x63\x7d\xd0\x14\x29\x7e\x48\xb2\x78\xd9\x22\x3a\x26\x9f\xe7\x39\xe4\x39\x87\x5f\x23\xce\x03\xe4\x4d\x38\xf1\x12\x9f\x4a\x0c\x3a\x95\x90\x7a\xff\x71\x1c\xfc\x70\xda\xb2\xff\xa8\x6b\xc0\x89\x8f\xf8\x5c\x64\xc8\xb9\xc8\x8d\xcf\x45\x3a\xe4\x22\x92\xdf\xcf\x9c\x10\xe8\xdd\x4b\xec\x5e\x7d\x7a\x97\xcf\x65\x3a\xe6\x32\xd2\x16\x9c\xf8\x98\xcf\x86\x86\x9c\x0d\xdd\x84\xd7\xc2\x0e\x3f\x95\xf9\xa8\x6f\xe2\x93\xbe\x09\x27\x8e\xe6\x13\xaa\x41\x27\x54\x52\xaf\x50\x8e\x83\x1f\x4e\x5b\xf6\x1f\x75\x0d\x7c\xd2\x35\xe0\xc4\xc9\x7c\x46\x36\xe4\x8c\x6c\xee\x33\xb2\x1e\x32\x32\xc9\xef\x6f\x9c\x08\xc0\xbb\xa7\xd8\x3d\xfb\xf4\x4e\x9f\xd1\xf5\x96\xd1\x49\x5b\x70\xe2\xa5\x3e\x27\x1c\x72\x4e\x38\x0f\xaf\x85\x1d\x7e\x36\xf6\x51\xdf\xc4\x27\x7d\x13\x33\x7d\x13\x4e\x7c\xd5\xa7\x95\x83\x4e\x2b\xe7\x3e\xad\xec\x29\xad\x94\x35\xe0\xc4\x4b\x7d\x5e\x3a\xe4\xbc\x94\x3d\x11\x35\xf1\xb0\xa7\x08\x7b\xda\x4e\xe9\x75\x7b\x76\x6a\x58\x11\x14\x7b\xe2\xc8\xf3\x3d\x29\xbe\xe7\x9e\xef\x49\xf1\x3d\x0b\x3d\xdf\xce\xf8\xee\x37\xca\x66\x03\xba\x27\x7c\x5a\x84\xcf\x3d\xe1\xd3\x22\x7c\xe6\x83\xb6\x89\x05\x6d\x37\x3e\x2d\x9b\x58\x5a\x36\xf3\x81\xfa\xc4\x02\xf5\xb9\xf7\xf1\x89\xf9\xf8\x8d\xf7\xf1\xc9\xf9\xb8\x8f\xdc\xa6\x15\xb9\xcd\x7c\x3e\x3e\xb5\x7c\xfc\xe6\x30\xf3\x94\x4f\x8b\xf2\x99\x2f\xc2\x4c\xad\x08\x33\xf7\x5e\x3e\x35\x2f\xbf\xf1\x5e\x3e\x3d\x2f\xf7\xe1\xdb\x15\x87\x6f\xdf\x41\x84\x42\xd0\xb8\xaf\x5b\x99\xf0\x61\x5e\xb8\xbc\x8c\xd7\xc9\x53\xb2\xe9\xab\x37\x67\xfb\x62\x09\xb2\x47\x51\x06\xf3\xd8\x0b\xef\x31\x76\x54\x87\xd2\x20\x71\xdf\x21\xa6\xf0\x70\x4b\x29\x46\xab\x27\x99\x0b\x40\x85\xac\x28\x3f\x1d\x57\xf3\xb9\xa2\xbf\x07\xfb\x3d\x9d\x0d\xbc\xa7\xd2\x16\x8e\xf6\xb1\x92\x39\x00\x3d\x6e\xac\xa4\x2d\xfc\xb2\x8f\xb6\xcc\xd1\x82\xa9\xa3\x2d\x6d\xe1\xdd\x3e\x5f\x52\x17\xfe\x1a\x21\x4c\x2e\x5c\xb1\xdf\xe3\xd9\xe4\x7a\x7c\x33\xb9\x1e\xcf\x27\xd7\xe3\xe7\x04\xc5\x14\x62\x47\xbd\xfe\xdb\x46\xd3\x04\x91\x0a\x47\x85\x6f\x89\xa8\xbe\xe7\xc9\x0b\x8c\xb3\xf7\x96\x42\xd8\xe9\x55\xbc\x5d\x1d\x4a\x83\x9c\x3d\x42\x5a\xa1\xcd\x99\x4e\x45\x78\x1b\x7a\xbb\x85\xaf\x7d\x8b\xcf\xb7\x7c\x47\xf0\x7d\x97\x60\x7a\x8b\x31\x38\xea\x02\xb9\x46\x98\x50\x5d\xd5\x49\x56\x04\xd4\xd7\xdd\xac\xe2\xc7\x9e\xef\x5e\x6b\x22\xa8\x2c\xc5\x6a\x57\x3b\x04\x6e\x3c\x03\x47\x5d\x03\xef\xba\x06\xde\x9c\x90\xb5\x77\xc4\x56\x7f\xf2\x7c\x09\x10\x21\x09\xbe\x2a\xef\x46\xb1\x5b\xf0\x98\x5a\x34\xd1\x53\xf6\xec\xa6\x89\x08\xae\xa9\x9e\x85\x55\x42\x69\xb2\xd5\xe5\xf0\x1d\x85\xf4\x4d\xd7\xc8\x1b\x44\x9b\x37\xea\x80\xb7\xbd\x23\xe2\xfa\x52\xe9\xf9\x51\xf3\xab\xf2\x72\x97\x59\xc0\x19\xc2\x9e\x7c\xbd\xd6\x59\xe9\xb7\x25\x5b\x2c\xac\x2f\x59\xd0\x42\xeb\x11\xd2\x87\x6c\x06\x43\x3f\x94\xb4\xc6\x4b\x52\x00\xde\x40\x25\xb1\x99\x00\x9b\x3b\xfd\x86\x80\x02\x5b\x68\x2d\x72\x76\xc7\x0a\x17\xdf\x11\xad\xe1\xb5\xc0\xe0\x3d\x1f\xc8\xc8\x32\x26\x14\xc4\x01\x0c\xef\x00\x81\xa7\x7f\xb4\x43\xf8\x7f\x1b\x9c\xa4\xbb\xff\xff\xf0\x8c\xd1\x16\x65\x6f\x4a\xbf\x1e\x77\xf0\x83\x10\xd8\x6d\x12\x42\x15\x48\xfa\x1d\x35\xc5\x46\x10\x03\x4a\xd9\x18\x47\x2b\x2b\x40\xe0\xc9\x9e\x35\xb2\xff\x88\xe0\x16\xc6\x74\xc0\x74\x1b\xe1\xaa\x73\x8d\x86\x33\x49\x96\x2b\x2d\x28\x0e\x51\x00\x49\xbb\x9d\x29\xc8\xa6\xa8\x0b\x79\x01\x8d\x49\x40\x7c\xfd\xec\x73\x26\x87\x2e\xc4\x47\x48\x97\x59\x9d\x33\x06\x51\xb6\x67\x07\x50\x99\x0b\x66\xc4\x94\x4a\xce\xf1\x27\xd5\x9e\x7e\xfe\x21\xff\x79\xb1\x6c\x51\xa5\xa9\xed\xbf\x61\xa2\xe0\x29\x16\x8d\xa4\x58\x86\xf5\xe2\xf5\x23\xa4\xb7\x41\x16\x58\xdc\xd2\x64\x8b\x82\xfb\xac\x0f\x10\xdf\xa5\xeb\x35\xc4\x43\xb8\x60\x88\xe7\x08\x79\xe3\x96\xaa\xa1\x9f\xbd\x3a\xb7\x5b\xc6\xee\x50\x1c\x2e\xb7\x60\x03\x5f\xe1\x81\xa6\x18\xb6\x73\x24\x44\x33\x8d\x91\x8c\x68\x19\xcd\xb4\xf8\x4d\x31\xcd\xb4\xde\xb0\x4b\x56\xcf\x56\x9a\x46\x22\xb8\x87\x91\xfe\x5e\xba\x08\x1c\x21\x86\xa1\x8a\x21\x4e\x5b\x32\x23\x9a\x5a\x03\x41\x00\x09\xd1\x34\x22\x1e\xec\xb4\x64\xf5\x05\x6e\x13\x7c\xbc\x03\x18\x23\x88\x55\x34\xb5\x42\x74\x8d\x60\x14\xd6\x9a\xba\x2a\x2c\xd9\xf1\x81\x57\x78\x78\xa1\x09\x06\x1b\xf8\x69\x21\x1f\x14\x31\x87\x79\xcd\x67\xa5\x0f\x42\xa0\xd5\xb3\x53\xde\x8c\x90\x6b\x98\xc8\xcf\x7f\xd9\xde\xb9\xd0\xcd\x24\xa8\x5c\x0a\x35\xc4\xd7\xcc\xf3\xe5\x86\xaf\x01\x94\xae\xcf\xac\xdf\x78\xd6\xc7\xc4\xba\xac\x95\x10\xee\x2e\x37\x45\x4b\x3a\x59\xe1\xec\x35\x3b\xf9\x90\x8d\x6c\x0f\x10\x86\x2b\x10\xfc\xfc\x3b\x3b\x96\x17\x93\xc5\x3c\x98\x13\xf8\xa0\x50\x17\x51\xa9\xc4\xd3\x3c\xb2\x2f\x14\x43\xb0\xbd\x32\x7c\x39\x06\x48\xde\xcf\xe1\x93\x74\x1f\x41\xc0\x92\xb0\x45\xa5\x36\xdd\xc6\x47\xf1\x4d\x31\x76\xde\x7e\xbb\x33\x33\x7c\x9e\x8c\xbb\x19\xf3\x38\xc6\x8c\x18\x91\xab\x2f\x71\x6a\x4a\xd6\x56\x07\x4a\x2c\xbf\xa4\xab\xc9\x11\x8d\x62\xba\xa3\xe7\xc3\x21\x85\x6e\x92\xf5\x9a\x40\x25\x33\xb9\x87\x36\x0d\x19\xd8\x76\x78\xa5\xb2\x5b\x20\xb2\x03\x34\x78\xbb\x4f\xb6\xbb\x94\xc2\x76\xcd\x09\x87\xd4\x38\xdd\xfe\xc8\x65\x43\x7e\x1c\x34\x47\xe7\x92\xa9\xa3\x39\x53\xbf\x5c\x80\xb8\x8c\x43\x84\x61\x40\x55\xc0\xe4\xea\x1f\x35\x0c\x19\x6c\xf4\x7d\xb2\x3b\xe6\xa5\x23\xe9\xe1\x46\x08\x32\xc1\xc1\x3f\xc1\x56\xd7\xcb\x08\x0e\x5e\x25\xc7\xa7\xb3\x1d\x6e\x63\x9e\x54\x4b\x46\x5c\x2b\xff\xd6\xb6\xf0\x1f\x6d\x0b\x7f\x69\xca\x3f\x24\xd4\x00\x33\x21\xa1\x26\x98\x09\x09\x35\xc0\x4c\x48\xa8\x26\x33\x21\xa1\x9a\xcc\x84\x84\xfe\xa5\x1b\x3c\x12\x1c\xfc\xcb\x44\x06\x47\x70\xf0\x0f\x23\x49\x1c\xc1\xc1\xc2\x5a\x1e\xf7\x80\xc1\x16\xae\xf2\x10\xe7\x39\xeb\x13\xa4\x10\x23\xf9\x28\xa7\xf4\xb9\x9d\x7a\x40\xf7\x45\x01\x91\x4e\xf2\x3e\x58\xc1\x33\xdb\xae\xc4\x83\x74\x7f\x15\x98\x0e\x6e\x69\xac\xb6\x1e\xfa\x79\x2e\x81\xb3\x11\x34\xdd\x46\xe2\xdd\x19\x13\x0f\x2d\xc2\x04\x59\x89\xf6\xcf\x73\x87\xc4\x2f\xe3\x3d\xbb\xca\x20\xab\x60\xa6\xab\x3c\x64\x52\x21\x7e\x30\x6b\x62\x4d\x13\x07\xf5\x04\xab\x69\xe4\x68\xc2\xc8\x2f\x75\x23\x93\x2d\x87\x56\x14\x39\x0e\x39\x1a\xea\xaf\x6a\x79\x84\xad\x53\x17\xf3\xdb\x07\x21\x06\xc5\xdf\x28\x42\xd0\xcc\xd8\x3a\xca\xb8\x69\x48\x7c\xd5\x86\x51\x3c\x5d\x83\x69\xa8\xf1\xa5\xd0\x65\x40\xf1\x0e\x6f\x28\x88\xd3\xed\x2d\xa5\x20\x78\xcb\xb7\xf5\xa9\x1f\xb8\xa8\x34\x8c\xcd\x78\xa0\xdd\xa0\x21\x9c\x5f\xd2\x95\x87\xba\x05\x6a\x5e\xf3\x9a\x6e\x71\xd0\xfb\xfc\xa8\x0b\x8e\x83\xd9\x51\x4b\x73\x5f\xd2\x88\xa2\xf2\x2e\x79\xf5\x32\x1a\x47\x58\x92\x4b\x4d\xcd\x9a\x6b\x5b\xf5\x4d\x16\xb1\x10\x83\x77\xc9\xe0\x57\xe7\xd4\xba\x19\xe8\xcf\x9b\x8f\x5d\x82\x6f\xa5\x66\x3e\x25\xfe\xce\x57\x94\xe5\x49\xe2\x1a\x04\x70\x08\x1b\x36\x39\x74\xee\x6a\xcd\x74\x93\x9a\xf6\x5e\x4c\x10\x01\x5b\xa6\xef\x1b\x24\x49\x8a\x03\x58\xdb\xd1\x3a\x6e\x02\x6b\x93\x2c\xef\x1a\x38\x11\x8d\x86\x3c\xe3\x04\x6d\xb5\x18\x7e\x25\xae\x61\xe2\x3c\xdc\x58\xaf\x10\x74\xa8\x1d\xb4\xf7\xca\x91\x54\xce\x0e\x27\xbb\x7b\xb9\x69\x93\x73\x8e\x87\xdf\x5b\x46\x78\x66\x9a\xe8\xb6\x2f\xe8\xd0\xb6\x0b\x06\x3b\x69\xbb\x6e\xb2\x4c\x83\xdd\x29\xa8\x49\x38\x4f\xea\x4f\x8d\x7b\x70\xfd\x24\xa4\x30\x09\x75\x44\xdb\xcf\xfb\x86\xe6\xfd\x97\x37\x10\x42\xcc\xb6\x1c\xdf\x45\x49\xf0\x33\x3b\x2b\x83\xe2\x4d\xff\xe0\x72\xac\x90\x52\x3b\x6b\x12\xd0\xb6\xd7\xe8\xb7\x41\x94\x5f\xe1\xe1\x2e\xaf\x0b\xe5\x77\x4c\x48\x40\x6b\x6f\x5f\xb7\x8b\x55\x32\x73\x45\xd0\xb3\x4d\x57\x15\x65\xd1\x1e\x38\x43\x3b\xfb\x67\x8b\xbc\x84\x43\xc0\x76\x17\xf5\x2b\x86\x1a\x1a\x75\x28\xf2\x06\x12\x79\x4d\x4d\x73\x97\x3f\xff\x10\xdd\x1a\x1d\x60\x58\x50\x7c\xba\x2a\x9f\x58\xd6\xd5\x8d\xd7\xd5\x35\xe9\x4a\x79\xb9\x74\x98\xe2\xcc\x26\xb0\xec\xde\x3d\x09\x41\xb2\x69\x83\x2d\xb4\x8a\xe7\x0d\xe9\xd5\x58\x27\x32\xe7\x34\x30\xc1\x68\xa3\xdc\x48\x37\x22\xe7\xb4\x76\x8b\x62\xe5\x9d\x12\x1c\x33\x71\xba\x95\x3d\x1a\xd6\xda\x1c\xd5\x03\xc4\x17\x9a\x93\x99\xb1\x23\xee\x2c\x62\x64\x17\x8e\x48\x2e\xfa\x09\x1b\xbb\x2a\x02\x50\xc9\xaa\xc2\x78\x62\x2c\x77\xe5\xfd\xf2\xad\xc0\x0f\x35\xaf\xe8\x44\x09\xc8\xef\x85\x56\xae\xf3\x34\x0d\x19\x38\x59\x21\xb9\xc0\xd3\x32\xf2\xc7\x19\x2c\x51\x16\xa7\xa9\xd8\xe2\x40\x83\x61\x94\xdd\x76\x02\xc5\xa2\x30\x46\xe7\x72\xf2\x7c\xf6\xcf\xc1\x93\xe7\xa0\x77\x0e\xa4\xab\x33\x36\x38\xe8\x38\x69\x19\xe8\x3c\xeb\xf6\x02\xed\x11\x49\xdc\x4e\xb4\x42\x53\x61\xbd\x41\x06\x7b\xbe\x80\xab\x74\xf3\x05\x12\x02\x36\xf0\x3e\x89\x29\x4e\xa2\xf6\x8e\x9f\x02\xc9\xfc\xe3\x97\xbc\x66\x2a\x8e\x22\x8b\x2a\x76\x3b\x0c\x15\xc3\xed\x27\x97\xe5\x3c\xab\xda\x5a\xb8\x87\x18\xd1\x63\x4b\x7b\xd9\x5f\xb5\x1b\x17\x87\x21\x5d\xd6\x35\x6a\x85\xda\x8a\x1e\x58\xa1\x16\x85\x44\x7f\x92\x86\x31\x58\x45\x30\xb4\xae\xaa\x65\x4c\x20\xa6\xd7\x26\xaa\xdf\xf9\x04\x9d\xf8\x19\xb4\x2a\x65\xd7\xc6\x64\xd6\x0c\x56\xe9\xda\xba\x82\xee\x41\x14\xad\x40\xf0\xb3\x5d\x43\xe7\x7e\x2f\xfe\xb8\xfb\xf3\xf1\xf9\xdb\xd7\xfb\x5a\xdf\x83\x86\x2d\x85\x5d\x4c\x29\x61\x47\x7b\x4c\x75\x59\x24\xa2\x7c\x1d\xb0\x0c\x83\xd4\x23\x87\x42\x93\x92\xc3\x91\x58\x3d\x8a\xbb\x10\x9a\xdb\x06\x2a\xca\x66\xc8\x16\xbe\x4c\xcc\x1a\xcd\xfe\xa1\x61\xb2\x02\xa0\xca\xc8\xab\xd2\x46\xe6\xd3\x48\xa7\xa1\x55\x96\x98\xe1\xc2\xc9\x35\xac\xf2\xbc\x7d\xcb\x51\xa2\x9e\x07\x54\x9c\xfe\x39\x25\x6f\xb9\xe2\x1f\xb3\xb1\x51\x45\xec\x15\x68\xcb\x92\x6a\xef\xff\x05\xc2\xe5\x47\xf2\x4b\x44\x98\x1f\x6c\x19\xfc\x76\xb0\x4f\x76\xed\xd0\x6b\xfd\xc2\xd7\xd5\x7f\x61\x40\x9f\xc0\x0a\x46\x97\xec\x37\xa7\xc7\xe2\xa3\x65\x08\x63\x8a\xd6\x08\xe2\x4b\xee\x74\xa2\xeb\xf4\x77\x97\x30\x2a\x01\x2e\xe2\x5d\xb4\x14\xde\x07\xf3\x51\x15\x35\x83\xbc\x3f\x42\x3a\x35\x62\xd8\x58\x37\xe8\x7d\x74\xf6\x08\x2f\xd8\x7e\xa6\x58\x92\x70\x4e\x1c\x94\x2f\x68\xb7\xf5\xf6\x12\x68\xa3\x74\x0e\x79\xb8\x6a\x24\x5b\x01\x8f\x59\x9f\xa8\x80\xd9\xc9\xc3\x62\x39\x5a\x82\x10\x36\x60\x59\xb9\x98\x49\x6b\xa7\x87\xd0\xc7\x44\xb7\x0c\x29\xdc\xf6\xba\x8e\xc0\x86\x58\xa1\x21\xbf\x24\x6b\x34\xa7\x5e\x9b\x13\xd8\x33\x3a\x40\xa9\x25\x50\xd9\xa5\xcf\x8a\x61\x63\x75\x06\x35\x65\x18\x61\xd4\x9f\xac\xf7\x27\xeb\x4d\x9c\xac\xe7\x19\x99\xb2\x7f\x65\xeb\x0b\xc5\x2c\x44\xb2\xc7\x43\xda\xdd\xcb\xd1\xd4\x95\xf6\xfb\xd2\x50\x6d\x93\x81\x6a\x31\xbc\xf8\x8c\xd8\x66\x4c\x71\x53\xad\xa7\xac\x3b\x65\xa5\xdf\x11\x18\xae\xed\x1e\x61\xa6\x8b\x11\x56\xd3\x74\x23\x96\x63\xc6\xb3\xff\xb7\x27\x34\xb6\x77\x8c\xb4\x8b\x6c\x70\x3a\x60\x53\xbd\xb2\x10\x68\xa3\xcb\x86\x31\x7d\xc9\xb7\x1f\xe2\x31\x62\x5a\xec\xb0\x55\x77\x2e\xd2\xe8\xb2\x61\x4c\xcb\x6f\x9c\x78\xb1\x1a\x14\x6b\x79\x9b\xdf\x18\x81\xbd\xe6\xd9\xa0\x6e\xb9\xb8\xff\xc1\x8e\x16\xee\x23\xb4\x93\xde\x8b\x21\x0c\x2c\xb2\xed\xc1\x48\xe9\x85\x57\x8e\x11\x61\x8c\xaf\xd7\x51\x0c\xb3\xbb\xdc\xea\x97\xde\x13\x95\x5e\xf3\x74\x2b\xd1\xe3\x42\x4b\xf1\x87\xdf\x85\xd2\x44\xa1\x1d\x86\x1b\x5d\xbe\xcb\x7d\x40\x2e\x2b\xb8\xd0\x64\x96\xec\xae\x57\xed\x9d\x17\x5a\x90\xdc\xb1\x54\x73\x7a\x8d\xad\xc1\x36\x90\x94\x8c\xd6\x85\xad\x1e\x21\x94\xbc\x76\xd5\x46\xc6\xf2\xc0\x68\xb9\xbe\xa9\x45\xec\x23\xa4\x0d\x6e\x65\x4e\xfc\x0b\xd1\x53\xa4\xd4\xfd\x1d\x27\xee\xa0\xfc\x31\x1a\x2c\x8d\xb8\xd8\xe0\xf9\xf8\x3c\xf7\x8c\xd4\x18\xb9\x70\xb7\xac\x15\x4e\x8a\x50\xa4\x43\xec\xdd\x35\xfe\xa8\x8e\xf9\x72\xe1\x48\x4b\xa4\xac\x05\x40\x76\x13\x0f\xab\x75\xb1\x93\x9e\xed\x28\xd8\x9d\xb8\x3a\xcc\x38\x9d\xeb\xba\x83\x58\x51\x2b\x51\x30\x84\x4b\x55\xb5\xf0\x2f\x04\x4d\x2e\xac\x81\xab\x52\xc1\x19\xaa\x52\x6b\x3b\xa1\xca\x9e\x30\x85\xeb\x6d\x6d\x13\xaa\x45\x46\xf6\x32\x4a\x17\x42\x84\x0c\x60\x08\x98\xbd\xf6\x7e\x0b\x0d\xbd\x63\x44\xa1\xba\x25\x2e\x9f\x59\xa3\xbe\x2a\x73\xca\x35\x95\x37\xeb\xab\x0b\x7d\xe8\x71\x9b\xad\x37\x97\xc8\xed\xcf\xd5\xdc\x9e\x8c\xd6\x58\x03\x1d\xd9\x8a\x67\x9d\xe1\x5e\xc7\x53\xb7\x24\x0f\x61\xcc\x16\x76\xed\xca\x24\xf7\x1b\x6b\xd1\x26\xfa\x02\x76\x25\xc9\xf5\xa3\x35\x21\x68\xe2\x17\xc7\x8d\xf6\x5e\x76\xd5\xda\x2a\x04\x76\x5d\x41\x7a\x27\x69\x5b\x60\x6f\x96\x96\x96\xd3\x77\x9b\xe8\xcf\x78\xdb\x9b\x50\x3b\x69\xec\xd4\xf0\x87\x28\x25\x6f\x5f\xc0\x6e\x07\x43\xaf\xb4\xb3\xd2\xb4\x30\x7d\x84\xb4\x84\xa5\xda\xf3\x51\xae\x87\x2f\xcb\x25\x50\xe2\x14\xe0\xcf\xf3\xeb\x86\xf8\xf3\xbc\x3f\x90\x93\x3c\x7a\xba\x3e\x7c\xf3\x3e\xff\xe6\x1a\xce\x5e\x63\x67\x57\x43\xac\x64\x5c\xeb\x26\x79\xc9\x4b\xc2\xa5\xa7\x53\x48\x3b\xf6\xb5\x0e\x76\xaf\x0b\xaf\xcf\x3f\x2b\x59\x1c\x5e\x73\x1b\x6a\x10\x8f\xdc\xb5\x4b\x70\x7c\x83\x71\x08\x71\x5d\x41\x6d\xaa\x2c\x77\xec\x42\x87\x1a\xfd\x69\x87\x4d\xe8\xed\xe7\x47\x63\x34\x0d\xe1\x52\x8f\x65\xf7\x6c\x9e\xfa\x5d\xfe\x56\xdc\xf1\xf2\x5f\x3a\x21\xf2\x3c\x1b\x0e\x9a\x46\xc9\x41\xfb\x6c\xa3\xd9\x18\x7b\xeb\x47\x75\x4c\xd9\xfe\xb8\x41\x03\xaa\xe2\x17\xf5\xcd\x73\xc2\x26\xb2\x3f\x52\x69\x9e\xe2\xc9\x0f\x1b\x5c\x3d\x55\xef\x02\xf4\x84\x99\x24\x4c\xd2\x84\xe8\x3a\x46\xa3\x9c\x67\xef\x2b\xa9\xe6\xdd\x3d\x30\x6e\xeb\x7a\x95\xcb\x70\x90\xe1\xe0\xd1\x35\x84\x3a\x85\x4b\xb5\x7d\x9c\x15\x78\x59\xd0\xb4\x4a\xd7\xae\x82\x25\x10\x8e\x40\x6f\x04\x07\x56\xe0\x38\x3f\x61\xd8\x50\x9e\x52\x4e\xd3\x8b\xe4\x46\xfd\x64\x64\x1d\x6f\xe5\x34\x72\xa8\x90\xff\x8f\xbd\x6b\xeb\x6d\x1b\xc7\xc2\x7f\xa5\xc8\x63\x5f\xba\x69\x83\x3e\xed\x0e\x50\xe7\x36\x01\x92\x6d\x36\x69\x67\xda\x7d\x09\x68\x89\x76\x38\x95\x29\x0f\x49\x39\x71\x7e\xfd\x80\x92\x2c\xeb\x42\x49\xa4\x48\xea\x62\x13\xe8\x4b\x81\xf8\x88\xe7\xfb\x3e\x92\x87\x97\x73\x68\x01\x72\x51\xf3\xaa\xd2\x7a\xd5\xfb\xf9\xc1\x3f\x1d\xb9\x3f\xf4\xcb\xc9\x0e\x6d\xc6\xa3\x38\xf1\x3c\xab\x68\xa4\xda\x1a\xfe\x36\xa1\xb4\x9d\x92\x60\xf3\xd6\x52\xbd\x6e\x40\x10\xc1\xfe\x08\x8a\x1c\x43\x8d\x0c\x45\xc3\x53\xb4\x70\x0c\x35\x31\xb4\x08\x42\x30\x38\x45\xc8\x51\x94\xa7\xa8\x86\x1c\x89\xc4\x22\xb9\xc6\x50\x06\xb1\x87\xec\x6c\x13\xcc\x02\xc4\xca\xfc\xb6\xb3\xdb\xc8\x24\xbf\xe3\x25\xb4\xd6\xee\xbb\xc0\x1a\x67\xa2\xa3\xb5\xaa\x31\x4a\xbc\x1f\xff\xd2\x36\xf1\x53\xdf\xc4\x8f\x53\xfd\x56\x68\x9a\xf0\x29\xd3\xc5\xc2\xa7\x4c\x17\x0b\xde\x0a\x7d\x47\x7e\x9e\x1a\xb8\x1a\xb1\x02\xf4\x97\x8a\x19\xc1\xd0\xb3\x40\x01\x83\xc4\x54\x47\x6d\xfa\xd2\x32\x38\x7f\x86\xde\xaf\x72\xd7\x7d\x64\x80\x45\xb4\xbd\x03\xdb\x1d\x9e\x77\x97\xd0\x72\x0d\xd3\x7f\x2c\xc6\xc8\x29\xa3\xf0\x40\x60\xdc\x11\x87\xde\x31\x6e\x66\x19\xe1\x4a\xd8\x60\xef\xa0\x40\x00\xf7\x7e\x77\x61\x2a\xc0\xef\xd7\xd2\xe3\x66\x90\x5a\xa1\x30\xc9\x15\xca\x1f\x7f\x4a\x0c\x2b\xcd\xcb\x71\xdc\xf1\x20\x51\xee\x68\x98\x88\x9b\x6a\x10\x92\x78\x04\xc9\x23\xa2\x9c\x43\x54\x70\xab\x25\x88\xda\xff\xa5\x04\x6c\xbd\xde\xfa\x9d\xe2\x7e\x4d\x1d\x77\x6a\xef\xfc\x59\xa6\xd1\xf0\x63\x7f\x4e\x0d\x75\x6a\xd8\xcd\x4f\x79\x41\x74\x9a\x96\xec\x2b\xe1\xb0\xe6\x93\xf4\x14\x9a\xb6\x23\xac\x11\x29\xca\x0a\x0c\xab\x1f\x30\xcb\x4d\x45\xac\xe2\xa5\x41\x20\x53\x08\x55\xcf\xd9\x76\x1e\xd4\xb4\xbc\xd0\x70\x09\x5c\x7a\x18\x62\x76\x2d\x97\xe9\x5d\xcd\xfd\xca\x1c\xde\x8a\x17\xc0\x8f\x19\xf4\xbd\xcd\x31\xdc\xab\x34\x21\x81\x34\x64\x38\xbd\x18\x4a\x00\x25\x04\x4a\x66\x64\x9f\x0b\x1d\x79\x78\x60\x90\xa9\x8f\x8e\x29\x9b\x4c\x8d\x20\x90\x2b\xf2\xfd\xc9\xf1\x3d\x7e\xbe\x65\xad\xd8\xab\xc8\x56\x14\xcd\x47\xc5\xb7\xde\x07\xd0\x8f\x5b\xf9\xe5\x57\x7e\xf2\xaf\xff\xf5\xfd\x38\x7b\x36\x0c\x39\x45\x4d\x5e\x51\xca\x43\xd2\x88\x65\x99\x3e\x75\x30\x86\xc0\xb5\x6a\x64\x98\xc7\x0f\x8c\x28\x4d\xa0\x7d\x75\xcd\x9b\x2c\x38\xb0\xe6\xaf\x06\xf4\xa2\xa5\x8f\x4e\x4b\x99\x96\x8c\x3c\xa4\x61\x44\x90\x66\x86\xbe\x23\x96\xf5\x18\x56\x10\x07\x25\x6b\xf7\x3e\x8c\xd9\xf7\x61\x26\xd7\xc1\xce\xc3\xd5\x9a\x40\x4a\xa1\x5f\xea\x6a\x2e\x1a\x19\x63\x34\x22\x6a\x09\xe2\x74\xc9\xbd\xc5\xd7\x47\x11\xa4\x56\x65\xb9\xd8\xc4\xc5\x26\x8d\xb1\xc9\x21\x88\xdc\x45\x2a\x2e\x52\x99\x48\xa4\x32\xa9\xee\xb6\xde\xba\x38\xa5\x36\x4e\x11\x18\x19\x73\x9e\xa0\x69\x29\xb8\xc0\xc2\x70\x60\x21\x68\x89\x66\x1b\xac\xea\xc9\xcc\x60\x6d\x5a\x95\x2e\x12\x18\x63\x24\xe0\x52\xaa\x55\x53\xaa\x53\x59\x67\xf7\x30\x17\xc3\xcb\xba\xfb\xe5\xcb\xe6\xa4\x40\x7b\x99\x17\x15\x10\x37\x93\x46\x51\x26\xfb\xb5\x3f\x30\xd1\xa4\xb1\xcc\x6c\x20\x3c\x9c\x1e\x6f\xd0\x66\xd2\x20\x96\x04\x89\xb0\x58\x8e\xb4\x1f\x30\xa3\xc3\x42\x33\x1a\x16\xce\xe3\x90\xa6\x15\x28\xaf\x21\x86\x64\x7f\xbf\xff\x0e\xad\x57\x60\xdd\x2f\x9a\xa6\x9e\xa6\xfe\x8e\x11\x6b\x6f\x79\x63\x4b\xa3\x82\x89\x62\x33\x47\xe8\x3c\x7f\xaa\x2d\x31\x1f\x6f\x78\xb4\x3b\x6f\xb2\xe5\x7b\xab\x7b\x2b\x06\x56\x13\x46\x36\xae\xba\x1f\xb1\x35\xc6\xd0\xf3\x68\x21\xb7\xf3\x95\x64\x9b\xa4\x7f\xbe\x2b\xc9\x6c\xfd\xc4\xee\x1a\xb2\xca\xae\xf3\xe1\x08\x63\x9a\x84\xa4\x34\xdc\x72\x6f\xb3\xf9\x6a\xb1\x39\x08\x46\xba\x4f\x79\x6d\x19\x6e\xf5\xcb\x85\x7e\x89\x42\x8e\xa8\x66\xa2\x10\x1e\x86\xa6\x51\x75\xa5\x43\xec\x07\x87\xb2\xee\x1b\xbd\x80\x6f\xa2\xc3\x06\x38\x1a\x1c\x61\x27\x60\x73\x02\x4e\x92\xc1\xff\x80\x84\xc1\xd7\x2f\x84\x80\x2d\x6d\xc7\xb6\x39\x84\x53\xc8\xe5\xde\xc4\x9f\x7d\x07\xf8\x77\x25\x6b\x8b\x80\x52\x1b\x0d\x42\x71\x81\x28\x98\x07\x79\x2c\xbe\x30\x46\xd0\x5c\x05\x10\x81\xb8\x36\x20\x9c\xff\xd5\x8e\x49\xf7\x47\xae\xb5\x9c\xbe\xc4\xc7\xe7\x73\xce\xdb\xcb\x00\xf2\xb2\x42\xb2\x29\xfc\x26\x9c\x36\x97\xd7\x6d\x0a\x84\x04\x8f\x3e\x31\x68\x76\x1a\x61\x1f\xe1\x65\x23\xff\x36\xe0\xb4\x9d\x26\x5f\xb2\x42\x19\x41\x3e\xec\x93\x5b\xa5\xa1\xdd\x12\xb9\x0b\x44\xa8\x36\x74\x5e\x18\x61\xa6\x2c\x8d\x64\x82\x89\x7f\x5b\x29\xd6\x2f\x9a\x6a\x5a\x0a\x58\xe5\xbe\xd3\x60\xb8\x24\xa0\xd4\x74\x72\x88\xad\x69\xba\x88\x4c\x6a\x39\x51\x15\xb5\x2d\xab\x64\x66\x9c\x25\x3d\x75\x04\xb2\x02\x71\x7b\x24\x87\x0c\xed\xd1\xc7\x2c\x8a\x57\xa5\xdd\xd0\xa9\x81\x58\x35\x24\xf9\xac\x5a\x53\x30\xad\xba\xbb\x2b\xce\xef\xc4\x1c\xda\x00\xbd\x41\x5f\x13\x1a\x02\x03\xc0\xd0\x06\x36\x0f\xfd\x66\x75\x71\xe3\x84\x61\x40\x18\x23\x21\xf3\xd6\x91\x79\x08\x64\xa6\x33\xde\x05\xda\x20\x1a\x4e\x2f\x58\x6e\x34\xe5\x97\x9d\x32\x08\xe1\x35\x64\x39\x14\x65\x36\x70\xcc\x22\xd7\xff\x76\x4d\x0f\x30\xde\x70\xd2\xa1\xdf\x3f\x9a\x02\x1b\xca\x02\x3c\x64\x46\x3e\x9f\x39\x4e\x2a\x9c\xd4\x3c\x0d\x6d\x85\x95\x64\x57\xf3\x31\x2e\xec\x41\xa8\x0a\x15\x9a\x3b\x9a\x49\x7d\x20\xd9\x42\xc9\xb4\xd2\x40\xe3\x18\xdc\x93\x70\x49\xc0\xea\x1e\xad\x61\x80\x30\xec\x13\x8b\x75\xf2\xe9\x77\xeb\xf4\xdb\x92\xa0\xac\xab\x4d\x35\x8e\xca\xff\x22\x48\x90\x1a\x18\x63\xa8\x7d\xfa\x77\x04\x89\xec\x46\x39\xf2\xed\x00\x78\x0d\x19\x47\x6f\x3b\x8b\x77\x47\xbe\xce\xff\x82\x1e\x53\x7b\xd6\x3e\xf1\xa2\x79\xe4\xf2\xe5\x41\xb1\xb3\xf1\xd7\x7d\x28\xfc\xad\x6e\xef\x47\x66\xf3\xd0\x3c\x35\x8e\x98\x71\x12\x13\xb9\x4e\x33\xda\x4e\x13\x21\xc7\xcc\xf0\xcc\xdc\xc1\x55\x48\xb6\x33\x40\x08\x82\x64\xb6\x7d\x80\x4b\x14\xe2\x76\x5e\x5a\x5f\x17\x9a\x27\x16\x6d\x5f\x57\xd8\x25\x05\xca\x2b\xc9\xd6\x5d\x05\x03\x17\xce\x0e\x2c\x25\x70\xa0\xa4\xbc\x71\x17\x07\x30\xb0\x3f\x37\xcd\x2b\xbd\xb5\x95\x3d\x5c\xd7\x75\x5d\xd7\x76\xd7\x9d\x46\x97\x69\x1a\x24\xe2\x49\xef\x9a\x80\xf5\x33\xf2\xe8\x03\xa4\x90\x35\xbf\x7d\xa7\xdb\x5d\x71\xa1\xbf\x4a\x76\x54\xc3\x0b\xf9\xaa\x94\x83\xd0\x57\x31\x30\xcd\x91\x12\x8f\x09\x70\xb9\x81\x70\x57\x36\xf9\x9e\x57\xae\x94\xa9\x99\x2c\x3b\x71\x16\x0c\x7f\xdb\xae\x61\xb3\xd9\xe3\x98\x4a\xf1\x77\x8c\x38\x80\xbe\xc4\x02\xaa\x71\xae\x4c\x37\x0f\xdb\x5d\x6d\x94\x48\x5a\xd3\x79\x28\xdc\x33\xa3\x7e\x18\xcd\x03\x98\x59\xb5\x7f\xad\x37\x47\x85\x4c\x42\xc5\x11\x51\x31\x40\x06\xc6\x8e\x09\x99\x5d\x85\x23\x62\x02\xe1\x81\x78\x90\xda\xde\x39\x22\x22\xa2\x7e\x99\x78\x80\xc0\xc7\xf7\xa5\x69\xa8\x9d\x86\x2a\x80\x4a\x67\x9b\xd3\x2c\xe3\x53\x13\x6e\x1c\x79\x1c\xc3\xe3\x18\x6b\x85\x07\xd3\x3d\x80\x74\xdf\xb1\x4e\xa1\x5a\x9f\xe0\x63\xd1\x1d\x58\xab\x05\x49\x46\xc2\x68\x81\x91\xf8\x64\x61\x28\x46\x5b\x22\xa4\x4d\x9d\x41\x13\xf0\x2f\x1c\xfc\x19\xfc\xc2\xa8\xc8\x2a\xfa\xc8\xa1\x9f\xa1\x8f\x70\x8f\xd8\xc7\xe3\xbc\x09\xf9\x17\xea\xca\xd4\xb9\x6c\x19\x39\xb1\x6e\x41\x10\x41\x6a\x1d\xc0\x08\x1d\x02\x82\xa2\xe0\xaf\x2f\x00\xe9\x41\x00\x48\x9f\x43\xd2\x3b\x84\x61\xb0\x5d\x86\xf8\x91\xa1\xb5\xd4\xcb\x66\xb6\x31\x98\x6f\x99\x60\x7b\x83\xf1\xd7\xc7\xac\x61\x70\x1e\x06\x21\xf9\xc6\xd3\x57\x55\xfc\xb7\x35\x93\xc8\x86\xdb\x93\x08\xae\x59\x11\x55\xe3\xc4\xe1\x4d\x18\x44\x7c\xed\x7b\x85\x02\x56\x1f\x64\xcb\xa2\xe6\xf8\x2b\xf1\x87\x8a\x7b\xf4\x86\xf9\x7b\x84\xdc\x8d\x79\x00\x1d\x7b\x15\xf6\x48\xf8\x32\x93\x25\xb0\x40\x19\x09\x5f\x74\xbf\xed\x85\x41\xb4\xc2\xdd\x3e\x9f\xfc\x56\xf1\x47\x74\x0d\xec\x0d\xef\xbf\x23\xca\x4a\xbb\x6c\x3d\xe9\x4b\x9c\x4b\x48\x20\x85\xba\x0a\x3b\xa8\x41\xc6\x72\x88\x73\x87\xf0\x0a\xbc\x3a\xf2\x8f\x8e\xfc\xc7\x35\xf4\x50\x9c\xb5\xfb\xf8\x0c\x7c\x48\xe4\x6f\xe1\xd0\xf8\xef\xeb\x16\x37\xe9\x98\x55\xb2\x59\xe7\x7b\x39\xdb\xde\x7b\x06\xfb\xbb\x8f\x29\x08\xeb\x4b\xcc\xc8\xf6\x3e\x44\x98\xa9\x70\x22\x68\x15\x8e\x56\x99\xdb\xf1\x91\xe5\x39\xff\x3a\xc0\x8c\xaa\xb7\xb4\x60\x7e\xd7\xd2\x9d\xbd\x1b\xc9\x9c\x20\x15\x9b\x7f\x70\x2d\x58\x91\x42\xfc\x7a\xf3\x05\x01\x2f\x71\x76\x15\xbd\xc1\x3e\x22\xd0\x63\xe7\xc5\xfa\x0f\x9d\x46\x86\x55\xe8\x43\x69\x1c\xf2\xca\x47\x69\x23\x24\x7e\xdc\x7c\x75\xd6\x27\xe0\x45\xb2\x92\x45\x73\x7f\x5e\x81\x57\x63\xb6\x2c\x56\x25\xc9\xc8\x4c\x8b\xee\x0c\x41\xa7\x81\xa1\xd5\x29\xc2\x98\x22\xd2\x5d\x8c\xaf\xf1\x4d\xc2\xf3\x00\xac\xd6\x2a\x2a\x28\xee\xfd\xa5\x73\x2c\xf0\x58\x48\x54\x5c\x16\x59\xe1\x55\xa3\xa9\xae\x11\xaf\xe8\x8f\x41\xd8\x66\x01\xc4\xbe\xcd\xf3\xa9\x7b\x82\x56\x88\x27\xd5\xcf\xc2\x28\x4e\x2f\x9f\x85\x4a\x81\x98\x08\x8e\x15\xc2\x3f\xea\xd0\x50\xb0\xf1\xd3\x80\x8d\xff\x1b\xb0\xf1\xa7\xb6\x0d\xf0\xaa\x8f\x07\x78\xd5\xc7\x03\xbc\xea\xe3\x01\x5e\xff\xb4\xa2\x74\x91\x10\xbf\x3c\xcc\x9c\x16\x9d\x16\xfb\xd0\x62\xf6\xa1\xa8\x98\xbf\x5e\x4d\xf6\xf9\x1d\x60\x3f\x80\x52\xd2\x34\x99\x32\x60\xcf\xa1\x34\x77\x7e\x20\xbf\xea\x13\xeb\x1b\xad\xa6\x7f\x64\x0a\xad\xc2\x50\x74\x07\x7e\xed\xde\xd4\x48\x40\x79\x80\x14\xf9\x10\x33\x29\x70\x5a\x90\x7f\x8e\x2d\xf6\xd3\xee\xff\x86\x78\xbc\x4d\x6f\xfe\x56\x2c\xd0\xf8\xc2\xbf\x82\x2e\x8b\x36\xbb\xea\x70\x6f\xa5\x6a\x44\x39\x25\x47\xbc\xc7\x14\x80\x2d\x24\xd0\xd7\x6c\x0b\x37\xa2\xb9\x10\x6a\xde\xa8\xd2\x56\x63\x8e\xc0\xfe\x84\x28\xe9\x3a\xf0\x3c\x48\x69\x1f\xae\x8f\xba\x1b\x16\x1a\x9e\xde\x9a\x4d\xba\x1c\x4f\x5b\x57\x6c\xaf\x99\x9b\xaf\x42\xaf\x37\xd6\x76\x9e\x2a\x4e\x6f\x06\xf1\x5a\xb4\x20\x97\x5c\xd5\xb7\x95\x3b\xcd\x81\xf9\xde\x36\x9a\x69\x15\x9a\x6e\x4a\xda\x85\x00\xe9\xa5\xeb\x93\x71\xde\xcc\xee\x59\x9f\x75\x88\x6e\x0e\x08\xd2\xfe\xc5\x6f\x6c\xe4\x6f\x99\xea\x97\xc1\x0d\x9d\x48\x30\x29\xe1\xc9\x20\x13\xba\x56\xef\x49\x4b\xb6\xc5\x95\x30\x6f\x4f\x3b\xcc\x69\x26\xaa\xad\x71\x1b\x9f\xcf\x2e\x7f\x7c\x2b\x19\x7a\xed\xc9\xe5\x4d\x7f\x3e\x0b\x7b\x60\xde\xf3\x5d\x27\xb4\xe2\xfa\xbe\x46\x5f\x42\x78\xcf\xce\x37\xc5\x9b\x86\xca\xeb\xd5\x02\x6a\x36\x8d\x27\xfb\x1e\xdd\x62\xaf\xe4\xc9\xae\xb4\xda\xe3\x16\x7b\x57\x24\x5c\x9d\xf3\x87\xb7\x64\x07\x02\xca\x48\xe4\xb1\x77\x4f\x5e\xf0\xe4\x85\x98\x6f\x0e\xec\x1c\x48\xff\xdb\x0e\x51\xde\x46\xfc\xe5\x9d\x85\xf8\x3f\x12\xbf\x6f\x2b\x1b\xb3\x08\xc0\x92\x5a\xd1\x67\x7c\xf2\x11\xdf\x66\x54\x14\xa5\x91\x0b\x0f\x02\x23\x8d\x47\x17\xfc\xdf\xbf\x41\x80\x00\x7d\xc7\xff\xf8\x3f\x27\x79\x07\x4e\x3e\x18\xc2\xe4\x02\xd1\x35\x60\xde\x33\xcf\xf5\x8f\x18\xbc\xe6\x39\x4a\xfc\xd2\x82\x7e\xb7\xc5\xd1\xea\x29\x4e\xdd\xa6\x4f\x4a\xbd\xb7\xd9\xd4\xd6\x9c\xa9\x37\x4d\x53\xb1\x99\x27\x1e\x3c\x3d\xbd\x9a\x33\xb5\x35\x67\xea\xcd\x4a\x2f\xba\x80\xf3\x68\x79\x07\x29\x05\x4b\x78\x1e\x62\x46\xc2\x40\x4a\x2c\xbb\xfc\xb7\xf8\xf7\x8f\x61\x44\x3c\x78\xd2\xd8\x3b\x68\xfc\x37\xed\x70\x14\x0c\x9b\x4a\xac\x2b\xb6\x16\x6e\x20\x41\x6c\xdb\x6c\x98\xa6\x7f\xd5\x6e\xbc\x97\xd0\x3b\xb3\xda\x5e\xa8\x52\xd4\x32\x71\x18\x0a\xe3\x67\x8c\x7c\x85\x31\x4b\x20\x97\x93\x0f\x16\xa4\x78\x83\x29\x24\x72\xd3\xe0\xb4\x94\xf8\x9b\x98\xd5\x1d\xa9\xa3\x96\x72\x00\xf1\x92\x3d\xab\x07\xae\xa2\x4b\x60\xf3\x68\xd1\x51\x76\x89\x34\xac\xa8\xee\x1c\x04\xc1\x1c\x78\xbf\xa4\x74\xb7\xc7\xea\xe2\x72\xf6\xfd\xfa\xfe\xe1\xeb\x79\xfc\xbb\x02\x64\x5e\x6a\x51\x1a\xb4\xfc\x0d\x99\x88\x42\x72\xdf\x54\xed\xb9\xad\x83\xa6\x1f\xd7\xc1\xaa\x49\xae\xf1\xc1\x42\xfe\x83\xb7\xe1\x52\x11\x3a\x81\x55\xc9\x01\xb3\x59\xaa\x5d\x2f\x6b\x8a\xbb\x51\xca\x47\x32\x85\x51\xb3\x46\xf9\x7f\x34\x4c\x16\x00\x54\x99\x1b\x54\xda\x98\x0e\x20\x08\x6a\xd8\x2d\xb2\x94\x1a\x4e\x46\x14\x0d\xab\xa2\xa1\x65\x95\x89\x51\xa1\xdf\x54\x95\x6c\x6e\x84\x21\x80\x67\x37\xf0\xa7\xc4\x14\x7b\x87\x48\xd9\x12\x7b\x81\x59\xa9\xeb\xd2\x38\x2c\xa2\x76\x1e\x2d\xa8\x02\x4c\x39\x5f\x8c\xe1\x13\x5f\xcc\xba\xfc\x3b\x8a\x37\x8f\x91\xfe\x00\xd2\x36\xb5\x94\x2c\x14\x50\x91\xbc\x16\x59\x04\xa5\xe8\x80\x1d\x5c\x92\xec\x21\x06\x47\x83\xcf\xc3\xf5\xcc\x80\x95\x2f\xc1\xfa\x19\x74\x85\x3a\xc3\xc4\x2c\xe4\x57\x11\xf6\x46\x01\x33\x25\x9e\xa6\x05\x9f\x32\x55\x70\x63\xef\xcd\x03\x3a\x2a\xfd\x52\xe2\xe9\xcb\xd7\xa7\x4c\xdf\x08\x25\x5e\x7b\x17\x90\x6a\x4b\xa7\x9e\x54\x60\xc6\x18\xe9\xf9\xd4\x03\x9e\x8e\xe1\x41\x5f\x8a\xf5\xdd\xd2\x26\xbb\xbf\xd8\xbe\xe8\x52\xbd\xc0\x3e\xec\xd3\x9a\xcd\x46\xd6\x04\xad\xda\x0d\x15\x59\x14\x40\x6d\x94\xc6\x7d\xd2\xc1\xc8\x88\x34\xc2\x42\xe7\x55\xbc\x60\xb7\x26\xbf\x70\xe3\xa9\x0d\x52\x0b\x05\x3b\x82\xa8\x90\x76\xf2\xc1\xec\xf9\xf9\x23\x23\x08\xab\x2e\xf5\x6c\x6d\xbb\xab\x27\x08\x8a\xb0\x0e\x20\xee\xb4\x46\xe7\x79\x1e\x78\x59\xf7\x53\x2d\xb0\xf9\xab\x79\x29\xe0\xfd\x41\x6d\xf2\x8e\x83\x96\xf7\x17\x30\x80\xd9\x43\x4c\x7d\x2d\x9c\x4a\xce\xd7\x2d\xa4\x0a\x50\xbc\x2f\x60\x41\xad\x80\x71\x0d\xf1\x48\x90\x18\x0e\x83\x14\x80\x4b\xbc\x89\xf7\xc4\x20\x83\xe4\xcc\x57\x44\x43\xa3\x63\xd4\x78\xde\xf1\x50\xbb\x54\xb8\xad\x43\x65\x46\xa1\x85\xad\xb6\x85\x37\x6d\x0b\x2f\xfd\xb1\xbf\x99\x18\xfd\xa5\x61\xa4\x04\x9f\x95\xcb\x07\xad\x20\x2e\xa6\xda\x85\x44\x69\x2c\xda\x06\xb6\xba\x06\xde\x74\x0d\xf4\xd7\x7d\x16\x13\xef\x3e\x45\xf4\x7a\xeb\x3d\xb7\xa1\x07\x02\x37\x05\x1d\xed\x14\x54\xe6\xdf\x4d\x42\x9d\x26\xa1\x12\x8c\x6e\x1a\x3a\xa2\x69\xa8\xcc\xbd\x9b\x88\x94\x26\xa2\x6b\xc8\x04\xf3\xf9\xe4\x06\xa2\xe1\x86\x20\x31\x80\x8b\xc9\x02\x38\x9c\x00\x8b\x3d\xd9\x49\xb0\x83\x04\x8b\x10\x3a\x11\xaa\x8b\x10\x0d\x82\x99\xbd\xbc\x04\x84\x15\x71\x14\x9c\x03\xe4\xd1\x39\xf9\x60\x1c\xf2\x51\x9d\x00\xe8\xc1\x6e\x6f\x37\xbf\xe5\xf6\x2d\x4f\x67\x4b\xe1\x94\x02\xb2\xbc\x27\xdb\xd8\x7d\xd3\x3f\x52\x50\x4c\xd6\x18\xd3\xa7\x45\xd9\xd8\x86\x86\x71\xd3\x98\x80\xf6\x36\xaa\x0d\x69\xcc\x59\x15\xa0\x5d\xc1\xc6\x18\xea\x57\x1c\xed\xe4\xad\xeb\x34\x59\x52\xe9\xcc\x36\xff\xf3\x78\x4c\x3c\x69\x84\xce\x48\x07\x06\x8c\x01\xef\x79\x25\x95\x71\xb4\x93\x47\x5a\x83\xe2\x64\x0c\x85\x2a\x8a\xd4\x56\x09\xb0\xc8\xed\x2d\xaf\x5c\xe1\x08\xb6\x4c\xb0\x81\x02\x22\x6d\x1a\x89\x89\xb4\x28\x94\x2b\xe0\x41\xa7\x93\x7e\x75\x22\xf0\x70\x01\xea\x53\x4f\x8c\xd6\xff\x95\xe3\xda\x95\x00\x1e\x6d\x09\x60\xc1\xa8\x51\xe6\xd8\xd8\x70\x91\x16\xc4\x38\x55\x4f\xe9\x17\xf4\x92\x0e\x35\x2b\x44\x85\x0c\x5e\xad\x74\x93\xd4\xd3\x8f\x93\xf0\x54\xd6\xc0\xd6\x26\x54\x9f\x8e\x03\x2a\x59\x03\x6f\x36\xb1\x3e\x73\x58\x4b\x61\x2d\x6b\xe0\xc5\x26\x59\xa7\x1d\x0a\x62\x98\x61\x4b\xe3\xf2\x6b\x53\x9e\x71\x11\xc1\xf7\x12\x0b\x5b\x13\x30\x7e\x74\x30\x9a\x80\xf1\x93\x83\xd1\x04\x8c\x67\x0e\x46\x13\x30\x9e\x46\xe8\xf3\xd9\x97\x87\x7f\xd8\x3b\x9b\xdd\xc6\x71\x24\x8e\xbf\x8b\x8f\xb9\xf4\xc6\x36\x16\x58\x60\x11\x20\x49\x77\x67\x02\x74\xb6\x7b\x3b\xd9\xc6\x5e\x19\x8b\xb6\x39\x23\x53\x1a\x8a\x72\xec\x79\xfa\x81\x3e\x12\x4b\x32\x25\x91\x52\x91\x94\x2c\x9e\x13\x95\x59\xff\x5f\xb1\x58\xa2\xf8\x61\x21\x1a\x63\xa5\xa1\x0c\x22\x64\xe6\x23\xf1\x55\xda\xc2\x51\xa7\x5a\x8b\xc9\xa8\x25\x6d\x41\x6f\x49\xe9\xf4\x96\xd6\x5b\xda\x82\xde\xba\xb2\xcb\x49\x6b\x83\x1e\x83\x62\x3b\x63\xf9\xdc\x09\x09\x23\xe4\xc2\x09\x09\x23\xe4\xd2\x09\xd9\x53\xc8\x07\xcc\x73\x2d\x3b\x28\x59\x9a\x8c\xed\xfc\x01\x19\x86\x87\x50\x37\xbd\xcb\x65\x72\xe1\xe2\x91\x2b\x17\x5b\x90\x8e\x5e\x46\xd0\x89\x92\x40\x7e\xa8\x4e\xf7\x33\x78\x2c\xe2\x88\x1d\x8f\x73\x1e\xc6\xfb\x47\xf9\x54\x75\xe9\x8f\x48\xef\x1f\x1f\x73\xf5\x2b\x1e\x0c\x05\x91\xd2\x4b\x0c\xa0\x8c\xf3\x09\xcb\x28\x6b\xe0\x68\x80\xc3\xc2\x71\xe8\xce\x41\xd6\xc0\x5f\x06\x40\x2e\x1d\x48\x8b\x20\xed\x7e\xb1\x2b\x47\x82\xfc\x87\xbb\x71\x84\x82\xa6\x57\x3b\x61\x19\xa1\xef\xcd\xae\xcc\x68\xee\x18\x0d\x9e\xd1\xc2\x31\x1a\x3c\xa3\xa5\x63\x34\x78\x46\xf2\x57\xe9\x8c\x83\x51\xac\x54\x9b\x00\x0a\x39\x9f\xb4\x90\xd2\x16\x8e\x06\x50\x2c\x1c\x8a\x5e\x28\xa4\x2d\x18\x79\x75\x72\x30\x2d\xc3\xb4\xfc\x65\xba\x1c\x0e\x0a\x1f\xa8\x27\x5d\x54\xc4\x56\xab\x8a\xb9\xa3\x34\x02\x4a\x0b\x47\x69\x04\x94\x96\x8e\xd2\x20\x29\xa5\xd7\xff\xbd\x24\x97\x58\xb5\x83\x69\xdc\x96\x27\xbb\x7b\xf1\x7d\x73\xe4\x23\xe5\x98\x51\xe4\x7f\x4d\x0f\x1f\x9e\x35\xda\x26\xf9\xff\xc2\x1c\x54\xfc\x46\x3c\xbe\x55\x31\x02\x72\x64\x72\xe7\x93\xb2\x05\x47\x26\xf3\x32\x2f\x2d\xe1\xf0\xb1\xaf\x7e\xbd\x37\x14\x19\x20\x07\x0c\x54\xba\x93\xe9\x23\x55\x04\xfa\x91\x31\xeb\x47\xa8\x59\xf5\xc2\xe3\x49\x41\x43\xb2\x99\x4d\x48\xe7\x83\xcf\xa1\xdf\xe3\x47\xad\xe9\xb0\x17\xce\x07\xcc\x8d\xd3\x1c\x4c\xd6\x36\x93\xaf\x4b\x12\x8f\x33\x65\xb7\x9d\xe5\x64\xe1\x54\xac\xb1\x67\xf1\x36\x49\x09\x35\x29\x68\xaa\xe6\x73\xfc\x6a\x27\x0d\x88\x52\x5e\xc4\x11\xe3\x86\x8a\xfd\x46\x77\x0c\x26\x24\xc1\x4b\x47\x31\x45\x79\x88\x23\x4d\xf4\xc3\xe3\x05\x44\xc0\x94\xc6\xed\xfb\x80\xee\x03\x3f\x4e\xe6\x00\xbf\x12\x9f\x63\x76\xfd\xd9\x10\x34\xf7\x72\xd8\xff\xe5\x90\xec\xd0\x06\x9b\x89\x8b\xb9\x8b\x8b\xda\xb8\x10\x19\xd9\x62\xb2\xd9\x2a\x35\x65\x9a\xd1\x75\x2a\x64\x0d\xc5\x17\x44\xd1\x55\x57\xb1\x1a\xa8\xaf\x04\xc2\x8d\xa9\x5c\xcd\xc3\xac\x46\xbf\x2b\x2b\x02\x92\x31\xe9\xd7\x50\x66\x98\x96\x6d\xcc\x71\x47\xa8\xd9\xa8\x0b\x8f\x05\x09\x2f\xba\xd2\x9a\x56\xf5\x1c\x1e\xa7\x52\x29\x8d\x8c\x2b\x4c\x9d\xd6\x2b\x3a\xd2\xd9\xa5\x4a\x70\x18\x0a\x8d\x46\x23\xea\xec\xbb\x57\x95\x66\xea\xc9\xb2\xd2\x6e\x6e\x14\x6c\x6e\x74\xe4\xa3\xfe\xb0\x26\x47\x1f\x30\xcf\xae\x0f\x7f\xf5\xb1\xcb\x06\x2c\x78\x53\x7c\x62\x15\xf8\xf1\x8e\x2a\x3e\x14\x85\x88\x6a\xa1\x59\x41\xe9\xe6\x47\x26\x37\x3f\x22\x17\xc1\xe7\xcf\x35\xc7\x71\xdf\x14\xf3\x1b\x89\x78\x65\x9d\x94\xa1\xe4\x22\xbe\xb8\x83\xe1\x08\xf7\x45\x64\x92\x73\x91\x54\xba\x16\x2b\xd2\x4e\xca\x15\x2c\x40\x05\xcb\xb9\xa2\xae\x5c\xe9\x53\xae\x3c\x11\xba\x43\x07\xe3\x0a\xba\x44\xa2\x96\x48\x32\x4c\x2e\x8b\x00\x65\x91\x8a\x9c\x2e\x85\x74\x4e\x21\x1f\xf9\xd8\xb8\x82\x3d\xea\x56\xb3\x05\xb6\x38\xd7\x45\x84\xfe\xa1\x85\x88\xd1\x8c\x7e\xd1\x4a\xfe\xc4\x51\xa1\xe0\xd0\xaa\x68\xff\x76\x1a\xc0\xde\xab\x91\x4f\xb1\xcf\xc9\x67\x86\xde\x6e\x19\x43\xc7\xe8\x91\x7a\x84\xe1\x15\xbf\x4f\xd6\x5d\x49\x6d\x24\x69\x6c\xf8\x2e\xf0\x70\xa7\x37\x35\x92\xb7\x43\xe2\xe1\x8f\xdf\x27\x94\x87\xfc\x74\x87\x4f\xbe\xe4\x8a\xa1\x37\xe5\x45\x64\xa2\x1c\xb6\x43\x07\x30\x5b\xc9\xfd\x8d\x9e\xca\x8d\x3a\x4d\x94\x66\x9f\xa0\x43\xe1\x8b\x8f\x93\xbb\xad\x2c\x05\x03\x40\x01\xe8\xe2\x49\x36\x9e\x84\xa8\xc1\x22\xea\x17\x66\x1c\x1f\x6e\x39\x67\xe4\xf5\x33\xd9\x93\x28\x90\xbb\x03\xb0\x71\x33\x9a\xf2\x8d\xbf\x02\x1b\x5e\xd6\x18\x05\xc5\x04\xae\x80\xc9\x74\x1f\x33\x86\x29\xff\x81\x7c\xcc\x39\x7e\x42\x9c\x91\x83\xa2\x4e\x8a\x32\xf5\x6a\x6e\xd6\xc0\xf4\xa2\xb1\xf8\x75\xdf\xbb\xa1\x11\xf9\x4b\x7e\x8c\xf8\xb0\x13\xbf\x1e\xf9\xd9\x45\xd8\x49\x2f\x5f\xe1\x48\xbb\xd7\x91\x35\xaf\xa3\x6d\xc0\xb8\x2d\xb7\x89\x35\xb7\x09\xb5\xe5\x74\x7a\xf9\x1f\x66\xa6\x1c\x07\x18\xfc\xfa\x8e\x14\x75\x43\x68\x98\x29\xa1\x45\xf0\x67\xb4\x0b\x7d\x7c\x1f\xec\x31\x43\x1b\xac\x28\x76\x79\xa2\xa2\x30\xed\x23\xe1\x66\xcb\x3b\x0c\xa1\x7b\xcc\xb8\xc2\x30\x51\x76\x04\x6c\x84\xb8\x5d\x71\xb2\xc7\xf9\x15\xa9\x8a\xf2\x88\x22\x49\xe2\x9a\xd1\xb2\x5f\xa5\x06\xc0\x0d\x7c\x3e\xc1\x94\x9b\x75\xae\x57\x83\xd3\x3a\xfc\x05\x1f\xee\x83\x80\x79\xd7\x1e\x40\x63\x1b\xdf\xec\x44\x46\xbc\x20\x4e\xf7\x77\x94\xcc\x44\x26\xbc\xdd\x1b\x74\xb7\x32\x00\x54\x9c\xce\x33\xd2\xbe\xce\x10\xa0\xd7\x6b\x1b\x8c\x45\x19\xcd\x04\xe2\xb5\x45\xc4\x65\x9f\x0d\x12\x26\x06\x7d\x6e\x2a\x10\x4c\xb8\x6a\x91\x2f\xa1\x56\xe8\x46\x36\xe8\x96\xab\x74\x73\x7c\xa3\xbd\x41\x6f\x2b\x7c\x85\x6f\x26\x06\x08\xcf\x47\x30\x0a\x4b\x5b\xe0\x26\xf4\xb2\x18\x24\x15\xa7\x0d\x46\xc9\xf0\xc7\x71\x59\x03\xdc\x84\x5a\x16\x63\xa4\xec\xb3\xc1\x10\x19\x7a\x21\x20\xf7\x38\x37\xa1\x94\xc5\xf0\x20\xd4\x4a\x70\x0c\xbf\x8e\x90\x35\xc0\x4d\xa8\x65\x31\x40\xca\x3e\x9b\x0b\x91\xc5\x24\x0a\x11\x69\x0b\xcc\x84\xe2\x16\xc3\xac\xe2\xb4\xc1\x38\x9b\x40\x29\x23\x6b\xc0\x44\x90\x4d\x71\x52\x64\x71\xe1\xb5\x90\xdc\xe3\x26\xa2\x6b\x7a\x53\x32\x8b\x09\x94\x52\xb2\x06\x4c\x44\xd8\x14\x27\x85\x96\xae\x16\x93\xaa\xc5\xa4\x2d\xfc\x69\x82\x99\xc5\x40\xad\x38\x6d\x30\x52\x5d\x35\xd7\x5e\xcd\xc9\x1a\x30\x11\xa5\x53\x2c\x07\x97\xae\x1c\x6c\x2a\x07\xe5\x1e\x37\x11\x9c\xd3\xab\x26\x97\xae\x9a\x6c\xaf\x26\x65\x0d\x98\x08\xd1\x89\x94\xa3\x0f\x98\xfe\x37\xc6\x8c\x60\xd5\xf8\x14\xad\xda\xa3\xed\xae\xbe\xdf\xe7\xf1\x67\x8c\xd9\x71\x96\xed\xed\xa3\xb3\x9b\xe6\x25\x94\x5e\xa4\xb0\x04\xec\xe4\x11\xd8\xfa\xaf\xcf\xd8\xc7\x1c\x0f\x41\x27\x99\x05\xa7\x4a\x6a\x95\x5c\xeb\x23\x58\xcb\x0a\xc5\x8d\xff\x18\x25\xbf\x72\x94\x92\xaf\xec\xfd\x8d\xd8\xdf\x77\x77\x15\xbc\xcd\xdb\x00\x16\x18\x77\x78\x43\xa8\xbc\x5b\x20\xc9\x42\x97\x36\x27\x5f\xc0\xe4\xf9\x42\x3d\x73\xe2\x94\xbd\x79\xff\x69\x30\x5f\x1e\x30\x4f\x7d\x31\x5a\xba\x0c\x71\xbf\xaf\x40\xec\x93\x36\xe0\x72\x7f\x7f\xfd\x1d\xaf\xb8\xa4\xe8\x70\x5d\x63\x94\xf2\xbf\x6b\xa5\x09\x42\x7c\xb9\x14\x62\x78\x0c\x31\x20\x87\x27\x74\x78\xde\x22\x0f\xb3\xfb\x60\x17\x12\x1f\xb3\x97\x2d\xc3\xc8\x53\x2d\x45\x04\x00\x1a\xb7\xf5\xf5\x6a\x73\xba\x21\xe5\x74\xe2\x84\x62\x53\xbb\xa3\xbe\xa9\x99\x85\x28\x40\x55\x60\x5a\xf1\x62\xf6\x49\x8f\x3a\x7b\x73\xf2\xc8\x4c\xd5\x28\x87\x7e\xd5\x9d\x3e\x3a\x35\x39\x99\x54\xf7\xfc\x81\xa1\x70\x4b\x56\x51\xba\xbf\xfe\x99\x23\x1e\xb7\x76\x84\x72\x6b\xc5\x36\xc0\xd8\x3e\x60\x4e\x5f\xf0\xe1\x71\xa7\xbe\x45\x08\xa4\x56\x38\xef\xe5\x3e\xde\x63\x5f\xfe\x34\x86\x1f\xe4\x80\xa5\x8e\x62\x90\x3d\x82\xa1\x64\xf8\xe5\x18\xe2\x99\xf6\x0d\x64\xaf\xf1\xfa\x59\x6a\x2b\x5b\xf6\x0a\x9a\xff\xfb\xac\x74\x26\x0f\xd9\x6d\x14\xfa\x40\x11\x3b\x58\x2c\xfd\xc4\xc8\xa3\xa9\x6c\xaa\xc9\xfe\x3c\x0a\x0e\xfd\x82\x68\x0c\xa7\xf1\xd6\x84\xdc\xc4\x63\x39\x89\xe5\xa6\x2b\x7d\x04\xc1\x5c\x88\x3b\xd0\xbc\x98\x14\x30\x0c\x47\x11\xf6\x06\x96\x21\x03\x6f\x08\x94\xd4\x33\xce\xb9\xa0\xa0\xbc\xf2\x0b\x7b\xd7\xfb\xfe\xa5\xe6\xa0\x2e\xe7\xed\x0a\x0b\xb8\x6e\x2a\x49\xac\x03\x9c\xe4\x2b\xdb\x84\xc0\x11\x0a\x8a\x8d\x68\xc1\x16\x3b\x6e\x55\x6e\x31\x30\xb8\x58\x0f\x39\xcf\x81\xab\x80\x13\x2f\x59\xe9\x83\xce\x83\x25\xf7\x84\x42\xa3\x4b\x7a\x9a\x8c\xa4\x73\x66\x03\x45\xa6\xeb\x03\x64\x4a\x60\xed\x08\x14\x09\x08\xcb\x0b\xad\x00\x88\x03\x50\x04\x40\xa8\x41\xf9\xd3\x77\x49\xa0\x4e\xb0\x43\x61\xbb\xd7\x9a\xc5\x13\x47\xaf\xd6\x13\x8e\x3f\x34\x8c\xc9\x85\x88\x28\x2a\x78\x4c\x69\x18\x5d\x8a\x86\xe2\x95\x34\x9a\x55\x0c\xfc\xe3\x26\xa0\xcf\x9c\x84\xa1\xaf\x3a\xbf\xa1\x47\x06\xd1\x21\x74\x21\xe2\x1c\x33\xaa\x4d\x86\xd3\xbd\xe9\x03\x19\x58\x64\x67\xf8\x46\x31\x9f\xc7\xcb\xb7\x67\x83\xb3\xfb\xb8\xd7\x29\xbb\x87\xc8\x21\x84\x47\xa8\xf5\xb6\x33\x5a\xb9\x7d\xc8\x01\x2c\x03\x64\xc1\xdb\x9d\x2c\xc3\x1b\xf5\x3b\x7d\x1a\x7f\x3b\xbb\xde\xa7\xdb\xcf\x0f\xeb\x8a\xab\x24\xd0\x3e\x4e\x38\xb7\x11\x62\x97\x71\x11\x48\x63\xb4\x40\xa4\x1a\xcd\x15\x4f\x76\x78\xbc\xe3\x3f\x45\xfe\x5f\x93\x35\x2c\xaf\xf1\x7a\x8d\x59\x76\xa8\xe8\xb7\x7c\xe2\x31\x92\x7c\x9b\x7d\xff\xa0\x5a\x30\xf4\x92\xae\x79\x98\x35\x4b\xab\x1c\x2b\x82\x99\xd6\x88\x23\xa6\x64\x43\x04\x48\xf2\x68\xf4\x0c\x4f\xfa\xcf\x67\xeb\xb8\x0d\x4e\xf7\xfc\x07\xed\xb0\xd7\x1f\x5a\xbe\xc6\x6f\x7d\x32\x34\x6b\x54\xba\xf0\x8f\xed\x52\x39\x66\x65\x66\x5f\x92\xfc\x8d\x38\xfe\x8c\x43\xbe\xfd\x95\xf6\xe5\x06\x4a\xbd\x7e\xea\x89\xd0\xac\x1b\x27\x0b\x0e\x09\xdd\x48\x45\xc3\x4d\x8d\x28\xb2\x67\x1c\x97\x67\xfa\xab\x4d\x00\x9b\xec\xcf\xf6\x3a\x64\xab\x64\x15\xfd\xda\x22\xea\xe5\x2f\xb2\x25\xdf\x82\xd7\xdf\xeb\x3c\x53\x6d\x6a\xeb\x6f\xa5\xe5\xd6\x6f\xa7\x3f\xca\xb7\x5e\x75\xb9\x60\x4f\x95\x39\x5a\x6d\x41\x55\x5e\x05\x94\x23\x42\x31\xfb\x5e\x2f\xb7\x8a\x3d\xc4\x93\x26\x62\xef\xbb\x51\x7a\xf7\x0c\x23\x9e\x06\x35\x66\x9d\xe4\x11\x60\x8c\x52\x6b\x2f\xc7\x50\x0f\xcb\xac\xb1\xcf\x41\xcc\x56\xaa\x31\x57\xa7\x43\xf4\xee\x7f\x5d\x83\x0d\xa6\xf0\xd5\x16\xb1\x62\x0b\xaf\xf2\x2c\x9e\xdc\x53\x42\x37\xfd\x6c\x13\x7a\x36\x38\xf8\x98\x6e\xf8\x56\x21\x13\x16\xe5\x07\xcb\x82\xf9\x1a\xf2\xcc\xb6\x51\xa8\x65\xe7\x4a\xed\xe8\xe3\x9d\x74\xd7\xfb\xc1\xd2\xf7\xd4\xd6\xbe\xd7\x4b\xde\x5b\x3e\xf8\xf4\x07\x38\x68\x95\x5c\xff\x46\xe8\x1f\xb9\xc6\x40\x9e\xe7\x2b\x21\xd4\x02\xab\xd0\x0c\xb0\x4e\xf3\xbf\x48\x3a\x7a\x60\xfd\xeb\xd5\xea\x5f\xc8\x27\xde\x29\xf0\x6d\x42\xa9\x34\x05\x0e\x4c\xb6\x24\xe4\x5a\x75\x83\x09\xcc\x82\x19\x61\xb9\xfb\x0f\x05\x59\x3e\x9a\x0f\x2d\xc8\x7c\x34\x82\xc8\x58\xb8\x56\x97\x74\x0e\x2e\xe9\x62\x42\x92\xca\x58\x98\xab\x43\x59\x80\x43\x59\x3a\x28\x0a\x50\x64\x2c\x2c\xd4\xb1\x2e\xc1\xb1\x5e\x13\x2b\x58\x09\x55\x84\x2a\x94\xe3\x9a\x40\xcb\x31\x1f\x89\x1c\xed\xcf\x5f\xab\xcb\x39\x07\x97\x53\xf9\xd8\xd0\x91\xca\xd9\xfe\x7c\x97\x1c\x0e\x8e\x43\xf9\xd8\xae\x89\xe2\x68\x7f\xbe\x4b\xee\x06\xc7\xa9\x7e\x3d\x13\x0c\x4f\xa3\xf3\xfc\x8a\x73\xda\x27\x69\xa0\xd5\x9e\x3b\xb5\xeb\xd4\x9e\xc3\xab\xbd\x70\x6a\xd7\xa9\xbd\x80\x57\x7b\xe9\xd4\xae\x53\x7b\x09\xaf\xf6\x35\xb9\x28\xb5\x09\x85\xd2\xfa\x9a\x80\x6b\x3d\x77\x5a\x8b\xb5\x9e\xc3\x6b\xbd\x70\x5a\x8b\xb5\x5e\xc0\x6b\xbd\x74\x5a\x8b\xb5\x5e\xc2\x6b\x9d\x5d\x79\x3e\xc2\xfa\xaf\x6d\xf9\x1e\x67\x88\x46\x61\x10\x61\x25\x78\x57\x4b\xad\x03\xee\x87\xda\x7a\x30\x2e\x1c\xc6\x1c\xe3\xbf\x0c\x60\xd4\x50\xab\x66\x18\x97\x0e\x63\x8e\xf1\xfa\x9f\x06\x38\x42\x56\xc1\x0f\x98\x67\x2b\x03\xba\x1f\xcb\xd6\xe1\x83\xbb\xe4\x3a\x1f\xa0\x73\x0e\x85\x04\x9a\xb7\xe2\x03\x6b\x4a\x2e\x4e\x53\x42\x0d\x2b\xfa\x48\xd7\xc1\xb7\x60\x63\x47\x47\x51\xa2\xd9\xa1\xc3\xb7\xf6\xa5\x4d\x8d\x86\xe4\x57\x48\x55\xcc\x9c\x2d\xe1\xca\xf7\x56\x65\x1a\xe9\x42\x70\x7b\x5a\x40\x88\x57\x3c\x02\x42\xd1\x75\x9d\x4f\x0d\x93\x7b\xb9\xfc\x5f\x48\xda\xb3\x3a\x9b\x57\x4a\x23\x8a\xd8\xe2\xb9\xd7\x57\xad\x11\xa8\xca\x49\xd8\x29\x0b\xdc\xf2\xc1\xe3\x7d\xb5\xbb\xd9\x05\x31\xc2\xf3\x37\x6b\xe2\xb7\x35\x35\x95\x07\xc5\x73\xd7\x20\x87\xc5\xdb\xe4\x50\x61\x9c\xff\x80\x0d\xcd\x9a\xb6\x03\x10\xea\xe1\x03\x40\x6f\xb1\x96\xc1\x8a\x2e\xe5\x46\x22\xa9\xfd\x3e\x35\x83\xdb\x55\xb7\xad\x47\x60\x81\x58\x8a\x16\xc8\x30\xcc\x4d\xae\xf7\x56\x43\x10\xa6\x5e\xef\x50\x88\x35\x76\xfb\xf5\x5e\x83\xd2\xe4\x12\x94\x26\x14\x52\x67\xc0\x59\x9c\x07\xcc\x8b\xeb\xbb\x5d\x39\xd7\x50\xce\x45\xe9\x0e\x04\x35\x62\x45\x71\xc1\xa0\xa5\xbb\xe7\x9e\xd3\xed\x01\x8a\xc0\x00\x76\x87\x9e\x77\x88\xc4\x8c\x8f\x69\xb7\xb2\xa3\x2a\x72\x6b\xaa\x6f\x6d\x4e\xb6\x6f\x02\xac\x41\xcd\xdb\x30\x7a\x71\xcc\xb6\x82\x75\xa7\x69\x11\x05\xdc\xe6\x8f\x47\xba\xf2\x63\x0f\x2b\x6d\xf8\xcc\xb6\xef\xcc\x1a\x4b\xb2\xec\x7f\x24\x94\xa8\xc9\x1e\xdd\x5f\x36\x1a\xa5\x4d\xff\x98\x0b\x1c\x22\xbe\xed\x67\xbb\xe8\xb6\x54\xba\x53\xe5\xd6\x32\x2d\x97\x5c\xa8\x34\xbd\xf8\x7d\xc0\xdc\x8a\xd3\x95\xbd\xf5\x3d\x25\x90\xed\x02\xf9\xef\x29\x37\x4f\xe8\xf1\x95\x72\x8e\xae\xb3\x6a\x38\x57\x97\x99\x93\xbd\x69\xea\x67\x53\x7f\x1a\xe8\x5f\xca\xf4\xe4\x5d\xba\xc3\xff\x07\xda\xe0\xfb\x60\xb7\x23\x7c\x87\x29\x57\x3a\x05\xe2\xae\x70\x00\xc4\xed\xcf\xbb\x59\xa3\x44\xea\xe7\x85\x10\xca\x43\xce\xaa\x35\xf3\x7a\xad\x78\x5e\x48\xda\x59\xcf\x0d\xa9\xbe\xb7\x8b\x33\x7b\xd2\x31\x08\xd7\x82\x27\xcd\x9d\x22\x46\x5f\xfe\xff\x22\xc1\x28\xaf\x01\x64\xce\x7b\x50\x3f\xea\xc1\xa1\x11\xa3\xb9\xfd\x79\xf7\xef\x4f\x14\xed\xf0\xcd\xdf\xec\x5d\xdf\x53\xdb\xba\x12\xfe\x5f\x78\xec\xcb\x1d\x92\x3c\x9f\x99\x02\x6d\x4e\xe7\x02\x65\x80\x9e\x3e\x2b\xb1\x0c\xba\xd7\xc8\xa9\x2c\x99\x84\xbf\xfe\x8c\x7f\x40\xe2\x44\x76\x24\x6b\xd7\xb2\x4d\x1e\x3b\xd3\x2c\xda\xef\xfb\x76\x25\xad\x57\xd2\x89\x1a\x3f\xd4\x3c\xd2\xb5\x43\x46\x7b\xa4\x6b\xa9\x04\xc5\xb9\xd3\xe6\x90\x5d\xc3\xb7\x7e\x9a\x4c\xac\xed\xa9\x3d\x34\xb2\x81\x30\xf2\x66\x6f\xc4\xfb\xcb\x33\xcd\x56\x02\xba\xb2\x1b\x8a\x0f\xb5\x5f\xe4\x19\xc2\xb7\xca\xdf\x4d\xff\xe0\xd9\x3d\xa5\xc4\xe8\x45\x2a\x56\xfe\x5f\xd3\xdb\xbb\x80\xf3\x66\xb5\x08\xf5\x81\x24\x58\xf9\xe9\xf0\xb5\x97\xe9\xd5\x08\x92\x51\x17\x4c\x37\x87\xe5\x90\x33\x84\x46\xa9\xb1\x00\x28\xc0\xe4\xf7\x93\x9a\xed\x3f\xcb\x9a\x80\xc3\x83\x53\x3a\x65\x23\x86\xcd\xe4\x14\x36\x03\x0e\x9b\x91\x2a\x7e\x82\xa9\xf8\xf3\x93\xe2\xbd\x28\x7e\xa4\x5a\x3d\x47\xd2\xea\x83\x5a\x8c\x67\x5d\x73\xda\x64\x0d\x64\x93\xa5\x91\x09\x4c\xba\xf0\x17\xa5\xdb\x40\xc2\x0d\xd4\x51\xac\xa4\xfa\x18\xa8\x3d\x8a\xb1\x11\x87\xc7\x04\x39\x3c\x46\xb1\xec\x02\x0e\x0f\x10\x65\x8f\x58\x93\x80\x6b\xab\x39\x95\xbd\x7d\x72\xd9\x50\x7b\xbb\xe8\x5a\xbf\x8f\x7c\xe8\x3c\x18\xb4\xd7\x31\x09\x1e\xdf\xcf\x46\x15\xa7\xbf\x0c\xef\x22\xdb\xfb\x7e\xac\xed\x05\xad\x7d\xfd\x13\x7a\xcc\x41\xab\x31\xeb\x5f\x88\xc4\x19\xf4\x8d\x8a\xe4\xd0\x80\xd6\x8c\xb9\xff\x40\xff\xce\x17\x06\x0b\xf7\x06\x0b\xc3\x2f\x7a\x7b\xae\xea\x1e\x29\x7b\xcd\x87\x84\xd3\xab\x50\xb8\x9b\xf8\x72\x57\xfb\x34\x1d\xbe\xbf\xcc\x97\xbf\x8c\x7b\xf0\x36\xf4\xe5\xad\x36\xd7\xe0\xfb\x1b\xf8\xf2\x57\x9f\xa7\xf0\x1d\x56\xde\xd2\x95\xf6\x51\xc5\x0e\x1c\xf6\x96\xb0\x94\xa7\x8c\xa5\x98\x37\x8f\x19\xf7\xe0\xef\x5d\x9c\x97\xac\xbb\xf2\xb9\x71\x9d\x6f\x79\x42\x41\xb7\x9f\xca\x3a\x43\x83\x76\x9b\xa9\x55\x81\x04\x0a\xd6\xff\x50\x21\xe9\xfa\x22\xa2\xdc\x70\x61\xd6\x80\x74\x63\xab\xba\xd3\x28\x2f\x18\x0f\x5a\x74\x63\xa0\x74\x51\xa2\xf6\x4a\x6c\x1d\x05\xdb\xa0\x15\x67\x3c\x0a\xa3\xb6\x47\xb4\x75\x42\xe6\xd6\x10\x15\xbd\xb9\xfc\xa0\x63\x58\x97\x57\x0a\xc4\x12\x0b\xc8\x2a\xfe\x81\xa1\x36\xa7\xbc\x17\x90\x01\x83\xb5\x75\xcb\x05\xa9\x23\x5d\x59\xd9\x99\x0c\x9b\x68\xad\xba\x5e\xe3\xf2\xae\xc7\x16\x0e\xbf\x8f\x04\x4c\x18\x85\xb9\x2b\x22\x89\xff\x54\x74\xd8\x53\x7a\x78\x12\xd2\x6a\xca\xb7\x2a\xe1\xed\x0d\x41\xe3\x87\x4a\x1a\x9e\xf6\xd5\x90\xb5\xc5\x16\x98\xae\x07\xb5\xe8\x1b\x63\x45\x9f\xf7\x21\x5f\x2d\xeb\xc8\x1d\x73\xaf\xe3\xad\x04\x19\x8c\xba\x39\x95\x27\xf6\x8e\xb3\xe7\xc0\xdb\x3e\xc2\xce\xd4\x7d\x29\x87\xfe\x14\xdd\x90\x55\x5f\x16\x6c\x8d\x46\xc8\x72\x49\x13\x9b\x19\xf4\xc3\x31\x17\xb0\x8e\x4e\xa0\xbf\xf8\x4b\x2f\x00\xac\xfa\xbe\x33\x2a\x67\xa9\x1c\x44\xb9\xed\xdd\x58\xfe\x34\xe3\xeb\x48\x5a\x53\x04\xef\xa0\x87\x40\x4d\xb1\x01\x1d\x37\x2f\xb9\xeb\x5f\xdc\x89\x28\xb1\x02\x63\xa1\xd8\xa1\x7f\x95\x52\xb0\xc5\xb9\xed\x26\x1d\xe2\xa6\xa0\xbd\x9a\x66\xf9\xbd\xdb\x02\x9b\xaa\x07\x48\xc0\xa4\xdd\x21\x63\x54\xf3\x4d\x5b\x03\x94\xe2\x20\x14\x76\x07\x50\x4d\xf9\xdf\x51\x39\x21\x12\x2e\xfe\x94\x63\xf7\x76\xf6\x51\x7c\x90\x84\x93\x74\x87\x4f\xcd\x57\x41\x47\xe1\x24\x48\xb8\xf8\x13\x8e\xf6\x13\x44\x6b\xe1\x24\x38\xc2\x99\x0c\x64\xb2\x32\xb1\xb0\x69\x89\xed\x04\x67\xba\x9b\x04\x69\x77\xd8\xee\x69\x6f\x0f\x1f\x47\xf1\x4d\x02\x24\xf1\x0d\x63\xba\x33\x30\xd0\x5a\x7a\x38\xf3\xe5\x24\xf4\x27\xbd\x2a\x3c\xae\xca\x0b\x53\x1c\x80\x86\x31\x5f\x1a\x18\x68\xad\x3c\x9c\x09\x77\x32\x96\x09\x77\x82\x34\xe1\x4e\x3f\xcd\x84\x6b\x62\xe1\xad\x25\x3b\x53\x9c\x29\x7b\x3a\x9a\x29\x7b\x8a\x34\x65\x4f\xc3\xee\x00\xaa\x99\x52\x5a\xa8\x57\x67\x60\xe3\x6a\xa0\xb5\x76\x71\xe6\xfc\x69\xe8\x4f\xbb\x55\x78\x5c\xa5\x8b\x34\xe7\x4f\x3f\xc9\x9c\x6f\x60\xa0\xb5\x74\x71\x16\x0d\xd3\xb1\x2c\x1a\xa6\x48\x8b\x86\xd9\xed\xc2\x1f\x42\xba\xde\xd1\xb6\x00\x65\x8e\x20\x21\xc4\xfc\x21\xc4\x38\x98\x82\x66\xb7\x0c\x0b\xa0\xb1\x04\xd9\xec\x16\x2d\xca\xd4\xa2\x3b\x88\x6a\x9a\xb3\x5b\x4c\x11\x3a\x03\x1b\x57\x03\x6f\xae\x06\x5e\x5b\xd3\xab\x16\x68\xf4\xfa\x0b\x81\x2a\x44\xce\x21\xa0\xd0\xf2\xa8\x62\x1e\x41\x62\x1c\x30\x4d\x28\xb4\x54\xaa\x3c\xe6\x52\xfd\xd1\x06\x07\x94\xb0\xb2\xe9\x58\x96\x2c\x58\x91\x76\x2a\x04\x19\x17\x82\x4c\x2c\xb4\x9e\x6f\x70\x4a\x49\xb3\xd1\x94\x92\x66\x48\xa5\xa4\xd9\xa9\x94\x64\x56\x4a\x32\x30\xd0\x5a\xfc\x38\xb5\xa8\x59\xe8\x4f\xfc\x55\x78\x5c\xb5\x8f\x54\x8b\x9a\x31\x7f\x00\x31\x0e\x07\x0f\xd6\x0a\xeb\x54\xaa\x33\x2b\xd5\x19\x18\x68\x9d\x1a\x70\x6a\x7d\xb3\xd1\x94\x21\xb0\xd6\xcd\xe3\xd9\xa5\xa2\x6d\x52\x47\xb3\x47\x45\xdb\xa2\x8e\x68\x87\xaa\x90\x02\xad\xdd\xd9\x75\x07\x98\x3e\x6c\x1c\x9a\x30\x3c\x46\xd4\xd4\x58\x6f\xfb\x42\x9f\xfe\x54\x0b\xcf\xee\x25\x8b\xd8\x1b\x0d\x6c\x6c\x75\x7b\x96\xfe\x88\x60\x4a\x5e\xc1\x34\xf3\x8d\x93\x45\x44\x77\xff\xc2\x57\x21\xc8\xa6\x0b\xdd\x54\x9d\xac\x19\x08\x98\xa3\x57\x2c\xe9\x89\xa7\x75\x23\x01\x73\x75\x4e\xe5\xae\xf1\x2e\xf7\xea\x4d\x31\x0c\x74\x68\x49\xbf\xff\x6f\x73\x5c\xa6\x0a\x12\x16\xfc\x5d\xee\x16\x3b\x80\x5f\xbb\x03\x75\x45\x3f\x44\x43\x9f\x8d\x0b\x7d\xc6\xc1\xb1\x67\x68\xd8\x97\x73\xd5\x28\x18\xa8\x9e\xcd\xb3\x9e\xc2\x6b\x90\x01\x43\x3e\xbb\xb5\xa5\x00\xfd\xba\x7c\x9a\x1b\xe8\x19\xeb\x76\x2f\x86\xc3\x2d\xb7\x61\x5e\xc3\x3f\x84\x07\x52\xf3\xc5\x43\xfb\xe5\x4c\x3e\x50\xd8\x1b\x57\xbb\x3e\x1f\x22\xdf\x75\xe9\x0b\xc0\x7e\xe2\x4b\xbb\x0d\x05\x8c\x0e\xf7\xc4\xe2\x22\x42\x2d\x40\xbb\xa2\xf4\x9e\x0d\x50\x22\xf9\xc0\x33\xb0\x40\xbe\xca\x9e\x7b\xba\x27\xfc\x89\xe6\xfb\x82\x20\xbd\xfd\xc7\x06\x32\x4d\xec\x85\x4c\x24\xb2\x7d\xc8\x18\x5c\x69\x66\xf6\x42\xb6\xe5\x17\x33\x20\x14\x7f\x64\x99\x87\x06\x81\x33\x8c\xd6\x29\x6c\xcf\xdf\x52\x67\xce\x16\x42\x14\xc0\x7e\x33\x1e\xc4\xaf\x77\x71\x62\x7d\x58\x51\x3b\x48\x77\xa0\x36\xd8\x6e\x1a\xae\x07\x3d\x8a\x78\x3b\x58\xdb\xaf\xb8\xd5\xdd\x51\x0b\x4a\x74\x06\xb0\x19\x09\xdb\x31\x52\x1d\x6a\x47\x84\x30\x4b\x42\x18\x77\xa2\x83\xf1\xae\xc9\x60\xed\xc8\x60\xbc\x73\x2a\x6c\x3f\x63\x56\x0b\xe7\x2d\xc8\xd0\x19\xc0\xa6\x23\x69\x47\x47\x75\xa8\xdd\x10\x62\x7d\xf8\x4e\x9b\xfe\xad\x18\xd1\x5a\xd8\x38\x5b\x78\xc3\x06\xaa\x1d\xa9\x7b\x63\xed\x88\xd5\x7e\x4f\x41\xa6\x06\xb0\x29\x1d\xd0\x1c\x36\xed\xef\x1c\x66\xf6\x73\x6c\x2e\x07\x33\x05\x5a\x1f\xba\xd3\xcd\x60\x56\x5c\xea\x0c\x6c\x5c\x0d\x60\xf3\xd9\xff\x39\x34\xbf\x7d\xfc\x82\x08\xc1\xa8\xf8\xef\xdf\xf7\x75\x63\x75\xfa\x1b\x57\x74\xa1\x9e\x6e\x68\x92\xdd\x48\x7b\x19\x73\x29\xe2\xa8\xe1\x4f\x1d\xde\x66\x97\xff\xfe\x21\x56\x62\x49\xcf\x6a\x6a\x5b\x65\x79\x2c\xff\x3f\x75\x38\xd5\x18\x7e\xdc\xac\xe8\x19\xc0\x27\xf8\xea\x68\x69\x4a\x05\x93\x9b\x23\xe3\x2d\xff\xd7\x71\xe3\xe8\x95\x12\xa5\xc9\x21\x2c\x48\x6c\x46\xa6\xef\x3c\xa0\xf9\xd7\xed\xc0\xa2\xd0\xa5\x91\xcb\xd9\x7f\x10\xa4\xf8\x83\x27\x54\xc8\x11\x2a\xf1\x2f\x3d\xab\xef\xa4\xf6\x5a\xca\xc6\x65\xf2\x3d\xfd\x66\x55\xea\x7d\xfd\x2e\x54\xd8\x52\x76\x85\x34\x50\x54\x77\x49\xa2\x68\x41\x96\xff\x37\xd2\xdd\x16\xab\xab\x6f\x17\xbf\xe6\x77\xf7\x3f\x2f\xf3\xdf\x55\x20\x5b\x96\x16\x8d\x41\xdb\xed\xc9\x51\x49\x79\x7f\x69\x4b\xa4\xde\xdd\x71\xc1\xaa\x49\xae\x79\x45\x7f\xf7\x0f\x5e\xc7\x4f\x96\xd0\x69\xac\x1a\x26\xcc\x66\xa9\x2e\x54\x68\xf6\x46\x63\x51\xa1\x2e\xff\x7b\x5d\x18\x95\x7c\x14\x89\x23\x81\x35\x9a\xfd\xc3\xc1\x64\x05\x40\x9b\xb9\xc1\x66\x8c\x65\x02\x61\x2e\x03\xad\xb2\x54\x1a\x2e\x32\x8a\x83\x55\x5d\x6a\x79\xf9\x10\xa3\x45\xdc\x1c\x2a\x19\x2c\xc3\xdc\xa9\xe4\x39\x8f\x92\x79\x96\xb9\x2d\x03\xa4\x42\xc7\xae\x0c\x6d\x22\xa4\xe5\x54\x53\x47\x1e\xfc\x6c\x50\x52\x66\xc1\x57\x15\x54\x38\xae\xe2\x95\x39\x55\x7b\x23\x8a\x57\x08\x03\xfa\xb9\xf8\x1f\x5d\xca\x6b\xb2\xa0\x66\xeb\xf2\x46\xe5\xb0\x80\x72\xc9\x42\x46\x85\x0d\xf9\x1a\xf5\x1c\xfd\x3c\xda\xb1\x7e\xa2\x0c\x1f\x0b\xf5\xec\xa0\x0a\xc6\xd4\x9c\xca\xf1\x92\x55\x66\xde\xe3\x66\x8a\xf4\x7f\x6e\x96\xf8\x61\xf3\xbe\xad\x08\xaa\x84\x01\x47\xec\x9d\x14\x16\x3a\xd0\xf4\x63\x4b\xe1\xca\x99\xff\x00\x7b\x07\x01\x3e\xc6\xac\xe0\xdd\x13\x11\x0a\xd8\xa5\xf5\x71\x06\x08\x06\x8f\xef\xcd\x86\x66\x01\xd2\x94\x28\x21\x5b\x28\x5b\x74\xad\x82\x77\x4d\xde\x90\xf5\xc3\x33\x09\xa8\xc8\x5e\x27\x67\x11\x15\x8f\xcf\x82\x92\x20\xb1\x44\xca\x76\x6b\x65\x3b\xe6\x26\x4a\x72\x60\xe6\x82\xac\x9e\xd9\x32\xb9\xa7\x09\x95\x0f\x92\x48\x75\xd4\x85\x03\x70\x35\x36\xc0\x70\xbe\xa7\x24\xe0\x77\x6c\x4d\x23\x5b\x68\x19\x77\x2a\x97\x33\xee\x56\x2c\xd7\xa4\x9f\x57\x16\xc8\x67\x57\x23\xcf\xf9\xfb\xa2\xe6\x25\xa8\x1c\xbb\xef\xd9\xe9\x25\x79\xd6\x28\x87\x30\xff\x3f\x96\x86\xe1\x2b\x6e\x00\x79\xfb\x23\xd1\x3a\xbc\x2e\xb5\xa3\x3b\x30\x2d\xcf\xa9\xe4\xbf\x38\xcb\x80\x0e\x53\xf7\x44\x51\x76\x54\xda\x80\x7b\x68\x24\x2a\x7b\x21\x7d\x51\xd4\xfc\x39\xb5\x4d\xaa\xdf\x42\x8c\x41\x1c\x3b\x11\xb7\x47\x1c\xe3\xa0\xb4\x31\x14\xda\xd4\x89\xb7\x7d\xde\x14\x30\x71\x90\x47\xb6\x6f\x54\x24\xd9\x23\x5d\x5f\xc6\xb1\x08\xce\x17\x3f\xbf\x3d\xd8\x50\xa7\x9b\x85\xe8\x5a\x2a\x61\x80\xd6\x5f\xfa\xbb\xb2\xca\xa2\x5e\xdd\xef\x21\x9d\x4d\xbb\xf4\x76\x6f\x57\xa9\xbb\xe5\x60\x99\x0d\xab\x03\xcf\x27\xfd\xa7\xd9\xf0\xf7\xb2\x0b\xb0\x3e\xab\x4c\xa6\xe3\x97\x89\xe1\xef\x45\x17\x60\x7f\x56\x99\xcd\x4e\x32\x3b\x26\x33\xc3\xdf\xff\xe9\x82\xac\x4f\x24\xd3\xd6\xcb\x22\x1b\x6d\x01\x8d\xd0\x90\x96\x7e\x00\x3a\xc1\x04\xd4\x35\x58\x81\x3c\x1c\x14\x21\xd6\x53\x7d\x97\x84\xb8\x66\x4f\x20\x84\x06\x45\xa8\xf5\xa4\x3a\x24\x42\xbd\x4e\x87\x5b\x84\x07\x21\x88\xe2\xaa\x0f\x88\x84\x6b\x55\xd3\xd7\xfc\x7e\x83\xea\xdf\x80\xc8\x98\xf6\x99\x0c\xc3\xdf\xbf\xa1\xe2\x33\x20\x32\x67\x63\x26\xd3\xf0\xf7\xaf\xa8\xf8\x0e\x42\x0c\x5f\xa3\xd5\x33\xf9\xae\xf8\x72\xed\xbe\x3d\x0a\x15\x5f\xd6\x0d\x52\x67\x22\x64\x6b\x1a\xec\xd9\x10\x14\xe7\x22\x84\xcb\x88\x12\x71\x19\x47\xb1\xb0\x75\x54\x3f\xca\xc0\xd5\xd1\x27\x41\x29\x77\x35\xb2\x88\x14\x75\xb5\x41\x32\x05\xe0\x61\x9e\x5f\x7c\x02\x81\x79\x90\x19\x42\x1a\x27\x5b\xdd\x45\x84\x53\x80\x18\x58\x65\x76\xea\x46\x59\x1f\xf5\x55\x87\xcb\xb0\xa7\x7f\x54\xe3\x77\x20\x37\xa7\xb3\x58\x98\x9d\x82\xa1\xab\x60\xd8\x5e\x5d\x03\x81\xb9\x33\x58\x38\x59\xf6\x7b\xfc\x04\x11\x43\xb6\x9d\xae\x3a\x07\x57\x4d\x07\x6e\x9c\x9d\x4c\xbb\xf3\xd2\x24\x53\x20\x3a\x2b\x54\x22\xd5\x0b\x84\x66\x9b\x5b\x16\x0d\x0c\x08\x57\x03\x0b\x57\x03\xd2\xd5\x40\x4f\xe3\x76\x4e\xa5\x8f\x49\xb0\x59\xd4\xa8\xd3\xdf\x9c\xca\xef\xd9\x1f\xed\x2e\x90\x6b\xfa\x56\x9b\x31\x68\xee\xb6\x70\x45\xe0\x91\xae\xbf\xf1\x74\x0d\x80\x81\x24\xe2\x89\x5a\x45\xc7\xd8\x80\xcc\x0f\x78\x52\x49\x45\x97\x70\xbe\x37\x5a\x16\x43\xc8\xbe\x4d\x7d\x0c\xe3\xac\xf1\x0f\x0c\x14\xea\xeb\xac\xb1\xf5\x26\x0e\x68\x04\x91\xa5\xcc\x30\xa8\xf3\x76\xc7\x59\x6c\x5f\xd3\xee\x9c\xf5\xbb\xda\xc8\xf9\x05\xa0\x36\x32\x6b\x80\x06\x41\xac\x06\xab\x6e\xa0\x4a\x87\x85\x95\xb1\xba\xb0\xd2\x07\xa7\xbf\xb3\x0e\x7b\x5b\x89\xe9\xa8\x6d\x6c\xd5\x77\x1b\x66\x4c\x82\x1b\x22\x05\x5b\x1b\x8e\xd3\x04\x56\x1c\x15\xde\x10\x49\x05\x23\x10\xe9\x38\x24\x4b\xda\x91\x0c\x6b\x90\xda\xd1\x1f\x2e\x58\xe9\xa0\xd0\x32\x0e\x5a\x1c\xd0\x54\x24\x87\x12\x0c\x95\x86\xa3\x75\x97\xfd\x46\x35\xee\xb6\xf8\x1e\xae\x33\x20\x5d\x0d\x38\xef\xdc\x71\x3e\x89\xdf\x66\xe7\x99\xa2\xa9\x2d\x53\xba\x01\xf2\xb5\xab\x8b\x7c\xe3\x6c\x01\xe7\xe3\xe6\x4f\x21\x9f\xe3\x53\x01\x68\xd4\x05\xa0\xfc\xf0\x2b\xe8\x16\x76\x20\x4b\xc2\xdc\xf1\xec\x94\x0c\x84\xc0\x9b\x9e\x8e\x70\x1c\x65\xb4\x79\x8a\xf9\xcf\x30\x4c\xa8\x84\x18\x69\x48\x96\x32\x76\x0e\x27\xc5\x99\xc4\xa1\xe5\x3e\x96\x44\x82\x70\x42\xf8\x53\x44\x5d\x1d\x75\x4e\xee\xce\xb9\x1d\x27\xb5\x3f\x2c\x49\x04\x82\xf2\x58\x01\x2a\x2b\xa4\x46\x08\xbd\x17\xe3\xca\x4a\xdc\x63\x5e\x12\x3d\x03\xa9\xf3\x81\x64\xda\x46\xf8\xf0\x16\xf0\x56\x45\xe6\xbe\x63\xe8\x79\xb6\xaa\xd4\x99\x7d\x03\xba\x67\xfa\x63\x60\xb7\xe4\x85\x36\xff\x85\xfe\x2b\xd6\x76\x29\x34\x68\x98\x7d\x8b\x5a\x10\x9e\x44\x44\x9e\x26\xa2\x86\x89\xe8\xeb\x72\x69\xff\x15\x5e\xa3\x89\x78\xe5\xea\x5f\x4a\x1a\xfa\x6c\x9c\x7c\xbc\x60\xf2\x85\xac\x6c\x9d\xf4\x7e\x8d\x4a\x33\x5c\xeb\x58\xb0\x27\x57\x23\x1b\x08\x23\xeb\x97\x38\xa5\xae\x46\x36\x86\x46\x4c\x1e\xc7\x5f\xe4\x7c\xe3\x48\x29\xbb\x72\xfd\xd4\xee\xd9\x7d\xbb\x67\xab\x3c\x75\xc2\xbc\x35\xe6\x99\xc4\xa7\x9f\x05\x6f\x08\xa8\xcc\xd6\x73\x46\x0b\xfd\x65\xfc\xb2\x8a\x39\xe5\x32\xc1\xec\xd0\x1d\xd2\x80\x79\x1a\x47\x2a\xeb\xd9\xda\xae\x9f\xcd\x46\xdf\xb4\x64\x31\x5d\x28\x83\xac\x85\x6b\x00\xdc\x59\x04\x77\x07\x9d\x21\xf3\xbd\xc1\xce\xf3\x3e\xe2\x5b\x4a\xa2\xfc\x0c\xe9\x39\x44\x3e\x54\xd8\x63\x04\x8d\x6b\xb4\xf3\x41\x1f\x03\x9e\xa0\x82\x6a\x6a\x20\xc5\x76\x72\x18\xac\x7c\xa7\x34\xc8\x5e\x84\xb8\x50\x61\x48\x05\xc4\x8e\x89\x3b\xe6\x08\xb3\x3b\x1c\x8b\xd6\x4d\x7e\x66\x84\xdf\x22\x77\x0e\x05\xbf\xac\xdf\x79\x04\x19\xb7\x87\x9d\xb0\x7f\xb3\x44\xe6\x57\xe0\x9d\x40\x85\x03\x75\x80\x6d\x91\x3d\x44\xf1\x86\xac\x7a\xa3\xc5\x3f\x8a\x0a\x87\x4a\xe3\x17\xcc\xd9\x30\x87\xea\xd4\xd6\x67\xdc\xd6\x97\xdd\x1d\x9e\xdd\x02\x9c\xeb\xcb\x11\xad\x86\x7a\x98\xd9\xdd\xa1\x4d\x2d\x08\xc7\xa1\x7a\xd7\x55\x56\xe2\x45\x8b\xc3\x47\xba\x9e\x53\x0e\x11\x8a\xf9\xb9\x78\x1b\x6f\xdb\x8b\xab\x87\xf9\xec\x91\xae\xaf\x69\x4a\x23\xcf\x33\xed\xa1\x06\xa3\x6c\x54\x9f\x93\x96\x1f\x3c\xa0\xa6\x8d\xaa\x35\x83\xdc\x2b\xd5\x20\x0e\x13\x74\xaf\x83\x39\xdc\xeb\x98\x04\xf9\x57\xc2\x55\x9c\xd0\xc1\x74\xc6\xaf\xce\xd7\x3e\xe2\x51\xbb\xef\x3e\x77\xb6\x30\x71\xcb\x09\x89\x14\x2c\xa0\x6e\x36\x62\x11\x50\xe1\xea\xc8\x2a\x6b\xf8\xc3\x09\xfe\x1b\xb2\x9a\x9c\x28\xff\xa0\x5c\x41\x70\xae\x40\x48\x4f\x9d\xc1\x48\x1d\xc1\x48\x21\xc0\x48\x07\x10\x01\x73\xc1\x02\xeb\xc4\x77\xe8\x2a\xef\xb3\xfe\x21\x30\x9a\x8c\x1c\xa3\x3e\x84\xa6\x1b\x4f\x2a\x92\x3e\x16\x1d\xff\xb2\x77\x35\x4d\x8a\xe3\x48\xfb\xaf\x4c\x70\xac\x5b\x41\xc5\xdc\xde\x89\xa8\x2f\x88\x8a\x98\xea\xe6\x2d\xe8\xde\x89\xbd\x09\x5b\xa6\xb4\x6d\x64\xaf\x24\xd3\x50\xbf\x7e\xc3\xb2\x01\x1b\x84\x90\x2c\xc9\x1f\xe0\xcb\xc4\x1c\xa8\xa7\x33\x9f\x27\x95\x92\xa5\x54\xca\xda\x0d\xa4\xfb\x0e\xdc\x40\xb2\xe9\xed\xba\x4e\x77\x1b\x3e\x00\x28\xb9\x3e\xdc\xd4\xe9\x79\x35\xa1\x55\x01\x58\x1d\x74\xdd\x6c\xa4\x8c\x36\x75\x7a\xde\x50\xa4\xa8\x02\x90\x3a\xf8\xbe\xd9\x50\x7b\xb8\x29\xd7\x77\x37\x49\x15\x7d\x6e\xd8\xda\x29\xa0\x74\xfe\x49\xa2\x64\x69\xa5\x13\x02\x8b\x7e\x41\xec\xc6\xd0\xdd\xbe\xba\x61\x1c\xd5\xb5\xad\xae\xa2\xab\xc3\xcd\x75\xfe\xde\xe2\x8c\x45\x04\x9a\x12\xa6\xb8\xfd\xfa\xd7\x19\x57\x5d\x1f\xb9\x70\x47\xf9\xe2\xbc\x42\xf9\x43\x47\xdd\xfd\x77\x14\x59\x29\xac\xdd\xd8\xb9\xfb\xb9\x95\xc3\x98\xf9\x4b\x50\x44\x10\x43\x5f\x30\xbf\xf4\x42\x6b\x2a\x71\xf9\xc3\x0b\x01\xa5\xff\x37\xc8\xe7\x9d\xc1\xb9\x7a\x15\xd1\xc3\x78\xf9\xdf\x50\x85\x7f\x45\xa7\x0a\x26\xce\xb9\x70\x94\x32\x3e\x00\x65\x90\x4c\x23\xaa\xfd\x11\x21\x0a\x8a\xcd\x39\x1b\x8d\x6f\xdf\xd8\x72\xb2\x1b\x13\xf4\xde\xe0\x51\xbb\x55\x69\xf6\x4e\x54\x81\xa5\x8e\xc9\xfa\x70\xe5\xb2\xaa\x02\xfc\x76\x4d\x73\x47\xe2\x02\x7a\x56\xba\x3a\x6c\x8c\xf7\x52\xb7\xc6\x08\x1b\xe3\x1d\xe1\xad\x9b\xfd\x5c\x4e\xb2\xc5\x78\x50\xda\xb8\x56\x02\x72\xe3\x6f\xe5\x6d\x60\xad\xdd\x1a\x4b\x26\x76\x63\x9c\x56\xde\x70\xd5\xa2\xd4\x78\x03\xcc\x92\x8f\xdd\xd2\x64\xd4\x6e\x4d\x54\x01\x88\x63\x92\xba\x25\xea\xc3\x95\x8b\xda\x6c\xfb\xbb\x03\xcb\x9d\x89\x8a\xb4\x70\xd4\x7c\xbb\xa7\xc6\xba\xd1\xbf\xce\xb0\xe5\x7a\xcb\xa8\x93\x45\xb6\x4a\x01\xe6\xb0\x7a\x33\x7f\x93\xcf\xe9\xc7\x99\x15\x03\xbb\x31\x5e\xf3\x57\xf1\x9c\xd2\x69\xfc\xad\x6b\xc5\xc3\x2e\xe9\x71\xed\x7b\x0f\x56\x28\x6a\xad\xa0\xfb\x7f\x6b\x81\x58\x80\x60\x78\x4c\xcb\x32\xfc\xff\x04\x92\xad\x56\x91\xb4\xdc\xfe\x15\xc0\x0c\x51\x7a\xb6\x7f\xc5\xa5\x03\xb4\x1c\x06\x6e\x1c\x16\x88\x1f\x5e\x9a\x0b\x34\x5d\xf6\x42\xb0\x8a\x83\xfa\x9f\x9a\x0b\xcc\x27\xe6\xaa\x4f\xcd\x85\x11\x60\xb5\xbe\xb5\x73\x78\xfa\xcc\x8a\x3a\x0a\xe7\x38\x17\x10\xdc\xf4\xd0\xcd\x9f\xca\xd2\xf5\xb1\xac\x47\x7e\x83\x46\xc7\x45\x11\x00\x31\x05\x58\x98\x02\x30\x53\x00\x6c\x0a\xe0\xfe\xa9\xac\x1a\x07\x71\x83\xc3\x97\xf7\xff\xee\xa3\xfa\xaa\xa3\x7a\x9e\x35\x5b\x78\x07\xf4\xd7\xe8\x65\xfc\x8f\x8e\xd2\xa5\xb3\xf0\xdd\x92\x81\xfe\x72\x62\xe6\x0b\x5c\x24\xcb\x77\x48\x29\x58\xc2\x57\x0c\x16\x21\x7c\x7c\x7f\x31\x1c\x81\x1e\x60\x70\x19\x91\xad\x7a\x27\x4e\x6e\xc5\x0c\xae\x21\x41\x6c\x3b\x90\x82\xd3\xfc\x57\x97\xc1\xe5\xb5\x0b\x5e\x94\x60\x76\x19\x24\xab\x57\xe0\x3f\x56\xaa\x59\x40\x3e\xbd\x0c\x7a\xb0\x6c\x11\x45\x21\x04\xf8\xc8\x36\xc8\x85\xf0\x9d\x0b\xfe\x86\x29\x24\xec\x0a\x05\x17\x8c\x20\xe4\x9b\x46\x4c\x08\xf1\x92\x7d\xea\x2f\x0f\xbd\x4f\x40\x8e\xc3\x64\x91\x04\xce\xd5\x7d\x06\x61\x98\xb6\xb4\xd1\xd4\xf7\xe5\xf5\xe9\xc7\x64\xfa\xf1\xfd\x99\xff\x5d\x89\x01\x2f\x47\x54\xe0\x80\x27\x99\xdc\xd9\x84\x42\x32\xb5\xb9\x2f\x27\xd3\x99\xaf\x27\x8a\x34\xfc\x1d\x2d\x35\x19\x10\xa0\x2a\x66\x0b\x79\x00\x2d\x92\x60\xa6\x54\xf5\x98\x25\x9d\xfc\xe7\xe7\x46\xc7\x5d\x69\xec\x21\x48\xab\xe3\x96\x1c\xbe\x2b\x0d\x3b\xeb\xb8\xc8\x37\x00\x2c\xb3\x9a\x23\x66\xe3\xd2\x00\x55\x34\x40\x57\x59\xf0\xd8\x8a\xd8\xd2\x20\xe5\xad\x54\xc7\x09\xf6\xf8\xdd\x76\xe8\x9b\x87\xa7\x24\x9d\x88\x10\x44\x59\x96\x78\x86\x08\x3e\x65\x6e\xd9\x9a\xc1\x14\x96\xc1\x76\xb1\xf6\x31\x79\x32\x04\xf1\x29\x33\x07\xa1\xc4\x7b\x94\xf5\x44\x55\xb7\x45\x0a\x63\xae\xe4\x6b\xfe\x61\xd5\x22\x15\x57\x91\x0f\xdd\x3b\xdc\xc2\xf0\x4d\x1d\x37\x0f\xbd\x14\xc5\x5d\xd0\x7c\x40\xec\x43\x92\x7d\x4c\xa5\x85\xf8\x60\x09\xf9\xad\x18\x0a\x56\x71\x08\x1f\xfd\x35\xc0\x9e\x36\x9b\x56\x7a\x04\x88\xa6\xf8\xcc\x2a\x6a\x0c\x93\x39\x3a\x53\x45\xdb\x2d\xab\xdf\x30\x83\x04\x83\x70\x9c\x5e\x9e\xb9\xf0\x5a\x02\xca\x7f\x1b\xf0\xdf\x9a\x5a\x5c\x43\x7f\x78\xa3\x30\x4a\x9f\xd0\xf0\x2d\xc6\xd2\xae\xae\x9d\x14\x20\x07\xd2\xe1\x5a\xfc\xa5\x29\x51\x7d\x98\xb5\x34\xcc\xc6\x69\xe3\xa8\x3c\xc0\xb8\x46\xd3\x88\xa2\x34\xfb\xd3\x60\xad\x16\x57\x39\xc5\x05\x20\x9b\x4f\x9f\x48\x23\x14\x27\xab\x0a\x81\x25\x00\x8a\xd3\xcb\x44\x28\x9d\xe0\xf5\xbf\x96\xcb\x3b\x74\xee\x6f\x78\xf1\xc4\x60\x2e\x5b\x9e\x0e\x82\x03\xd0\x40\x4a\x51\xe1\x87\x0a\x1c\xf5\xaa\x1d\xa9\x36\x81\xac\xa0\xd9\xbe\x5f\x5b\xdb\x06\x99\x41\xe1\x8e\x4c\xa9\x66\x24\x97\xe6\x54\xb5\xeb\x9c\x17\x9b\xcb\xd5\x1f\x46\xc7\xe3\x5f\x3b\x96\x6a\x1a\xf9\x7d\x28\xb5\x3b\x94\x7e\x60\x94\xae\xa4\xef\xd1\x9f\x0f\xdf\x7e\x5e\x0e\x9b\x83\xa1\xa7\x54\x86\x91\x27\x3d\x7b\x3c\x83\xf2\xe7\xc3\xeb\x3f\xf3\x23\xa8\x8d\x4b\x5f\x87\x1d\xf1\x55\x03\x63\xeb\x92\xaf\xd1\x0d\xf1\xa5\x81\xf1\xe5\x92\xf3\x87\x9e\x73\x2d\xce\x35\x30\x7e\xbb\xd4\x2d\xcd\xa3\xeb\x26\x84\x73\x74\x56\x7c\xc2\x61\x71\x46\x72\x49\xe4\xb0\x27\xd2\x0e\x91\xa3\x9e\x48\x3b\x44\x3e\xf4\x44\xda\x21\xf2\x3e\x69\x68\x72\x4b\x74\x67\x37\x1b\xde\x0e\x3b\xe3\xad\x0e\xc8\xd6\x25\x65\xa3\xdb\xa2\x4c\x07\xc4\xed\xa2\xb3\xe7\x5d\x9b\x77\x1d\x10\xb7\x2b\xcf\xe4\xca\xe6\xa7\xa4\xb1\x09\x6a\xd8\x53\x69\x8b\xca\x51\x4f\xa5\x2d\x2a\x1f\x7a\x2a\x8d\xa9\x9c\x40\x96\xb3\xa9\xcf\xa5\x68\xf3\x98\xf0\x17\xee\x2e\xd3\x60\x5b\x91\x73\xcc\x39\xbc\x12\x7d\xa0\x2e\xe9\x36\x77\x49\x03\xe4\x4d\x33\x67\x35\x37\xdb\x77\xa7\xb3\x39\x55\x83\x76\xf2\xa9\xbb\x76\xb3\x48\xe4\xf0\xa6\x89\xd4\xc0\xd8\xd6\x20\xc6\xa8\x17\xc3\x50\x0c\x0d\x8c\xaf\x1a\x04\x7d\xe8\x05\x6d\x81\xa0\x8d\x9f\x6c\x94\x83\x42\xf9\x80\xa3\x1b\x51\xe1\x68\x69\x7b\x6e\x89\xe1\x6e\x65\x5b\x56\x69\xd8\xab\xd4\x01\x95\x46\xbd\x4a\x1d\x50\xe9\xa1\x57\xa9\x03\x2a\x29\x1f\x2a\x75\x43\xa5\x44\x77\xb9\x62\x91\xca\xe1\x8d\x53\xa9\x03\xb2\xad\x41\x8f\x51\xaf\x87\xb9\x1e\x3a\x20\xb5\x7c\x5c\xf5\xa2\xb6\x44\xd4\xe6\x4f\xf0\xca\x91\xa1\x7e\x90\x77\xd3\x0b\x8e\xa4\xe9\x15\xc7\xb0\xd7\xa9\x13\x3a\x8d\x7a\x9d\x3a\xa1\xd3\x43\xaf\x53\x4b\x75\xca\xda\xc5\x3e\x32\x46\xd0\x62\x7f\xcf\x08\x29\x5d\x33\x92\x4a\xa3\x7d\xed\xc6\xc6\x65\xa2\x53\x33\xdc\xf5\xde\xe6\x37\xfe\x5f\x08\xf8\xfd\x48\x08\xd8\xd2\x37\xec\x23\x02\x3d\x0b\x9d\xab\x56\x91\xaf\xe2\x74\x16\x3a\xc5\x9e\x46\x28\x37\x41\x87\x31\x51\x20\xc7\x04\xad\x14\x83\x59\x0e\x24\x7f\x0d\xdf\x0e\xfb\xaf\x21\x5c\x41\xcc\xea\xe7\x5f\x06\x91\xfe\x7f\x2f\xe1\x05\x09\x27\x10\xa7\x17\x1d\xa9\xb9\x62\xc8\x87\x98\xa1\x00\xd9\xb8\xb7\x5c\x19\x21\x57\x30\xfd\x8f\x9b\x3a\x83\x17\x18\x42\x06\xbb\x49\x99\x60\x9e\x73\x4a\xdc\x85\x66\x86\xcb\xf0\x8d\xa6\x44\xb6\x89\x47\xc9\x54\x67\x14\x36\xbc\x53\xf8\xf7\xc5\x7f\xa0\xc7\xf6\xf3\x7b\x82\xcc\x3d\x57\xbd\x79\xbe\x5b\xd0\xfd\x37\xb5\x63\x60\xb1\x1f\xa2\x8d\x05\x43\x52\xe7\x8a\x61\x02\xd9\x14\x92\xe0\x3d\xc2\x88\x45\x64\x92\x36\xd5\xd0\x1d\xc9\xa2\x71\x93\xac\x32\x28\x1d\xbf\x45\xa9\x7e\xc9\x51\xd4\xba\x01\x9e\x61\x30\x37\x69\x29\xb5\xc7\x22\x87\xcf\xe9\x14\x07\x89\x2e\x8b\x02\xd5\xb9\xc9\x3a\x7e\x0b\xdc\xc6\xc9\x6a\x67\x90\x21\xd2\x0a\x6c\x1e\x3d\x86\xd6\xb0\x0a\x9e\x48\x5b\xbe\x9c\x83\xc4\x86\xb8\xde\x05\x93\x6c\x0f\x91\x19\x23\x08\x2f\x1b\x50\x58\xc4\x63\xde\xa8\xf1\x32\x4c\x76\x9c\x76\x3f\x38\x07\x77\xa7\xd7\xbf\x55\xa7\x4d\xe4\xf2\xc0\x5a\x0d\x1a\xe5\x11\xda\x98\x4a\x02\x8c\x3c\x42\x6f\x41\xeb\xdc\xd5\xba\xd5\x7e\xc3\x41\xd4\x59\xad\xab\xaf\x1b\xce\xf4\xf7\x28\x7e\xc0\xf9\x80\xb9\xe9\x39\x38\x81\xb8\xa0\x83\xee\x8c\x27\x8a\x6f\xac\xf1\x86\xb9\x58\x82\xdc\xe7\x55\x6e\x93\x13\xbf\xb3\xaf\x9e\x9b\x74\x7d\x06\xc3\x74\xd1\x7e\x32\xfa\x74\x19\x28\x19\x5e\xb2\xfb\x32\x0d\x97\xbe\xa4\xb2\xb6\xf0\x3a\x38\x36\x12\xc1\x29\x44\xc5\xb5\x97\x48\xd3\x3c\xa5\xfc\x8d\x5c\x35\x0f\x86\x4b\x54\x1c\xcb\xce\xd5\x34\xb2\xf6\x15\xfb\x9d\xb1\x55\x38\x57\xbd\x00\x06\x9c\x9b\x6d\x77\x9e\x91\x27\xaf\x74\x8a\x99\x59\xe9\x47\x25\x8a\x7e\xd9\x04\xa6\x8e\x2c\x00\x5e\x6c\x19\xa4\xff\x22\x88\x31\x88\x9d\xa8\x3f\x83\xac\xd0\x39\x55\xb1\x55\x9a\x5d\xb9\x0c\xce\x41\x14\x9b\x17\x3a\x61\x6e\x0e\x37\x79\xeb\xd9\x59\x0c\x08\x85\x5a\x0d\x0b\xe7\x70\xc3\x12\x02\x6b\x6b\x56\xb8\x6b\xde\x3a\xee\x4a\xf3\x56\x55\x14\xe9\x7b\x74\xaa\x20\x21\xd8\x6a\x4e\x81\x67\x9e\x18\x0c\x42\xb0\xa4\xae\xa2\x2d\x0d\x98\x0a\x11\x97\xef\x24\xb2\x2c\xe2\x06\xd2\x71\x97\xff\xe8\x32\x13\x7d\x1c\xf7\x71\x5c\x29\x8e\x67\x0c\x62\x0f\x85\xdf\xe3\x9f\x69\x51\x92\x52\x04\x4b\x03\x22\x00\x9e\xe9\x64\xe3\xae\x4c\x60\x0e\x29\x85\x61\xc8\x0b\x42\xc7\xc0\xd3\x5f\x0a\x96\x27\xb4\xbd\xc7\xae\x96\x82\x45\x7b\xdf\x23\xdf\x82\x3c\xb2\x63\x79\x23\x53\xf3\x13\xec\x69\xc4\x33\xc2\xe3\x74\xfa\xf7\xab\xa1\xa9\x95\x8f\x9f\xe3\xcc\x06\x27\x6e\x16\x0e\xeb\x79\xc5\x84\xa2\xa3\xbb\xf4\x3c\x25\x68\x85\xd2\x2d\xf1\xf9\x36\x86\x03\xa9\xfb\xba\x07\xf8\xa7\xe3\x28\x40\x84\x32\x1d\x08\x51\x0a\x93\x9e\xbf\x1b\x53\xc9\x5f\x5a\x6d\x27\x9f\x82\xc4\x44\x19\x20\xcc\x10\x03\x62\xad\xe3\xc1\xce\xa9\x7a\x5c\xd2\xa2\xa3\xaa\x05\xfd\x64\x75\x69\x08\x9f\x7c\x8b\x28\x92\x29\x43\x2d\xd3\x7b\xa7\xc0\xaf\xc8\xe1\x4a\xe5\x2f\x76\x84\xaa\x3a\x06\x2d\xa8\x25\x1d\x29\xb5\x8d\xb6\x3e\x66\x54\x63\x66\x02\xf1\x18\x62\x0f\x52\xed\x10\x71\xba\x7f\x1d\x70\x9b\x9c\x78\x9c\x6d\xdc\x37\xe4\xf4\x51\xd4\xd4\xec\xfa\x0c\x32\xee\xb7\xb6\xdb\x25\x3b\x0b\x66\xda\xb2\xf2\xc2\x66\x7e\x5a\x16\xd5\x51\xc3\xe7\x90\xb6\x94\xf3\x52\x64\x8c\x11\x46\xf4\xb3\xc3\x1c\x67\x85\x64\x36\xe6\xb9\x88\x23\x9d\x33\x5e\x91\x00\xd9\x06\xad\x05\xa1\x9a\xf4\xb6\x46\x67\x9f\xca\xaf\x8c\x20\xcd\x4f\x89\xa7\xc2\x6b\x35\x8f\x1f\x4f\x35\xec\x9d\xc5\x32\x2a\xd4\xc8\x74\x57\xe6\x37\x0e\x13\xfa\xf9\x0e\xe2\x18\xfa\x19\x33\x7c\x99\xd8\x2e\x4e\x11\x66\x31\x23\xc7\x31\x1a\x04\x54\x0f\x86\xaf\x4d\x4e\x81\x64\xaf\xa5\xe8\x52\x2b\xf3\x7b\x19\xe6\x75\xad\x09\x59\xc2\xf4\x1c\x5a\x91\x64\x29\x68\x36\x52\xe7\x6a\x5b\x28\x55\x73\x93\xaa\x2d\xb1\xec\x62\x92\x0b\x2a\x7f\xe0\xb8\x27\x53\x85\xcc\xd2\x90\x9f\x40\x76\x54\x61\x8d\xd6\xd7\x46\x9f\x62\xce\xad\x70\x32\xec\xb0\x37\x63\x7e\xa8\x53\x25\x03\x3b\x3d\x0f\x12\x7d\xdf\xa8\xd6\x07\xd6\xbc\x6f\x3b\x81\x6c\x0e\x37\xfb\xc0\xce\xb7\xa9\xad\x84\xb7\x2a\x6f\x3b\x49\x32\x53\xd2\xb3\xbf\xbd\x39\x03\x87\x31\xcb\x69\xb8\x73\x1f\xa4\x4f\x08\xfb\xf9\x35\x4c\x8d\x4d\xa4\xdd\xbd\x8a\x35\xff\xcb\x3f\x40\xfa\xa7\x03\x69\x12\xe0\x3f\x71\xe2\x41\xf6\xad\x5f\xf0\xa1\xbe\x2f\x7e\x11\x0b\x3a\xdb\x00\xfc\x2f\xdc\xe8\x3a\x81\xb8\x7d\x94\xd4\x48\xc6\xc5\x0f\xca\x37\x5a\xe0\x47\x91\x1e\xa1\x77\x67\xbc\x2a\x38\xe5\x44\xe0\x82\xf5\x3a\x33\x8c\x5c\x61\xe5\x69\xa0\xae\x09\x80\x7f\xcf\xdc\x86\xab\x05\x2f\xb5\xbf\x86\x6d\x4c\x44\x07\x8c\x3a\x3f\x58\x5f\x79\x0d\x6f\xee\x3b\x6f\x04\xa0\xed\xb2\x41\xe1\x5b\x65\xda\x8c\x7c\x7e\x41\xf4\x26\x9c\x56\xcf\xc1\x5c\xf8\x2c\x14\xfc\xeb\xd7\xff\x1d\xc4\x45\xbf\xef\x9b\x71\x59\x80\xa1\xf6\xd2\xec\x01\xc2\x8f\x92\x45\x08\x8f\x40\x92\x7b\x73\x88\xa1\x0e\x84\xc0\x0f\x69\xe3\x00\x35\x8c\x88\xf8\x90\x28\x40\x1c\x2d\xf3\x8e\xfc\x29\xce\x1b\xb4\x96\x60\x0a\xba\x1a\x4c\xa2\x22\xad\xe4\xde\x18\xa1\xbb\xa1\x54\x76\xa7\xf6\x48\x1a\xf6\x69\xc9\x72\x5a\x4a\x6c\x04\x53\xa2\x1a\x4d\x72\x5f\xd6\xe6\x74\xac\x0d\xe9\x58\xdb\xa0\x63\x5d\x71\x70\x35\x9d\xa7\x87\x7d\x9e\xb6\x9a\xa7\x1b\x1a\x5b\x22\x57\xd6\xf7\xc6\x08\x5d\x1e\x59\x75\x4f\x5b\x69\x31\x5c\x76\x62\x49\x1f\xe7\x6f\x3a\x03\xaa\xea\x1e\xd7\x99\x1d\xbe\xd2\x37\x44\xee\xf7\x22\x09\xdc\x78\x7d\x54\xb8\xad\xe7\x78\x67\xcb\xb6\xe7\x6f\xea\x67\x28\x0e\x8b\x8c\x45\x81\x53\x77\xc5\x75\x4b\xa8\x68\xb2\x02\xb4\x29\x41\xe6\x70\xf3\x94\xac\xe2\xc3\x2e\xdd\x5a\x33\xf1\xe8\xee\x36\x9c\x4f\xb6\x45\xe6\xee\x5c\xef\xd5\x1d\xfb\x1d\x34\xe7\xb7\x78\x92\x71\xe6\x79\x76\xf8\xd7\x94\xe8\x55\x8f\xb5\xeb\xa2\x22\x68\x0f\x15\xee\xe3\x42\x96\xb5\xf8\xb1\xdb\x98\x80\x65\x9a\xa6\x67\x9f\xc0\xd7\x5f\x93\x08\x50\x49\x7a\xe6\xe1\x44\xcb\xf4\xf0\xb7\x6c\xae\xb9\xb5\xc8\x77\x62\x6a\x5e\xd1\xdd\x0d\x63\x79\x97\x0d\x65\x62\x8d\xfe\xa9\x57\xec\xd7\xf3\x0f\x4d\x01\xa5\x73\xb8\x79\x8e\x22\xe2\x9b\x13\xef\xab\xdc\xc5\x90\x22\x78\xa9\x21\x3a\x18\x82\xa4\x43\x7f\xa3\xaf\xaf\xf3\x1d\x64\x8c\xf8\x9a\xf1\x4e\x10\xef\x20\x6e\x03\x59\x7c\x89\x1e\xb7\x98\xad\xe7\x28\x8c\xc8\x2e\x90\xbf\xc7\xf7\x9a\xa4\x09\xac\x8d\x62\x43\xce\xcc\x59\xf7\x29\x7b\x07\xf4\x97\x05\x94\xc8\x37\x04\x01\x64\x79\x6f\x01\xe2\x03\x9a\xb2\x9a\xa2\xbc\x47\x7e\x1d\x41\x34\xec\x83\xe8\x36\x83\x48\x1d\x65\x68\x01\xc2\x8a\x3b\xc3\x9a\xc6\xc4\xa8\x1f\x13\xfd\x98\xb8\x82\x31\xa1\x8e\x32\xb2\x00\x61\xc5\x9d\x91\xab\x21\xfe\x18\xc6\x9f\xe0\x1a\xd7\x4e\x37\x33\x38\x6d\xca\x3f\xec\xe5\xef\x9a\xfc\x57\x96\x9b\x6d\x46\xf3\xf5\xac\x57\xfa\x68\xee\x66\x34\xf7\x2b\x8d\xc3\x4a\x23\xed\x2d\x91\x0f\xcd\x6c\xb3\xf1\x39\x3d\x95\x01\x98\x69\x8e\xd2\xca\x83\x4c\xe5\x14\xc8\x7e\x1f\xba\xbb\x3d\x01\xef\x20\xce\xee\x5a\x66\x05\x07\x4a\x6e\xef\x2e\x8d\x2c\xf8\x9f\x0c\xa4\x2c\x64\xbf\x71\xa2\xdd\x0f\xbc\xea\xae\xf1\xd3\x6f\x73\x82\x00\x5e\x86\x90\x22\x25\xbb\xa5\x33\x82\xe2\x59\xd3\x01\xe3\xd4\x57\x77\xe7\x6b\x05\x57\x83\x06\x5c\x2d\x0f\x29\xd7\xce\xee\xdb\x58\xce\x60\x0a\xcb\xa0\xb9\xc7\xba\x9d\x2c\x05\x10\x34\x00\x28\x34\xc4\xf0\x63\x2b\x20\x31\xa0\xd4\x25\xf3\xe3\x04\x7b\x16\xb9\x27\x11\x66\x41\x82\x3d\x43\xbf\x17\xc0\xfb\xa5\x0b\x73\x3a\x46\x09\x0c\x74\x00\x04\x19\x6d\x25\xd9\x64\xd2\x65\x5f\xf6\xef\x2c\xc3\x6f\xf0\xb7\x76\x6e\x3e\x40\x8a\xca\x5f\x14\xcb\x3a\xb5\x0b\xba\x14\x25\x4c\x28\xb0\x77\x68\xad\x70\x91\xa8\xab\x33\xdb\x8f\xd8\x07\x0c\x36\x68\xfd\x01\x53\x0a\x52\xb1\x3f\x4b\xbb\x82\x32\x26\x90\x42\xb2\x86\x4e\x94\xdc\xf7\xe1\x78\xe2\x7c\x07\xeb\x16\x08\x59\x7d\x45\x50\xbd\xd0\x86\xd6\xc1\x2e\xba\x6e\x76\x11\xae\x93\xdb\x31\x81\x4d\x66\x20\x23\xdb\x79\x31\x6c\xde\x2a\x4d\xc5\x6c\xa9\x7a\xd2\xdb\xfa\x22\x8c\x53\x57\x15\x33\x9c\x85\x52\x6c\x79\xa2\xd5\xbe\x00\x60\x65\x44\x68\x4f\x1c\x46\xda\x4f\x20\x2b\xc8\x1f\xac\x1b\x08\x00\x77\x29\xa0\x81\x04\x5b\x20\x13\x5d\x17\x99\x08\xd7\x49\xe5\x4f\x90\x6e\x56\x14\x63\x53\x93\x4c\xad\x4a\x45\x45\x26\xfb\xac\xa2\x9e\x55\x4e\x05\xd4\x4e\x2e\x2e\x24\xec\x6e\x66\x39\x25\x14\x5d\x11\xa1\x08\xd7\x49\x67\xf1\x16\x69\x81\x50\x0b\x74\xea\x5e\x26\x6d\xc5\xea\x47\xbc\x25\x80\xd3\x07\xba\x42\xf4\x05\xfd\x3e\xe7\x1d\xe7\xbc\xff\xb1\x77\x75\xcd\x69\xeb\x40\xf4\xbf\xe4\xb1\x6f\x25\x7d\xce\x4c\x93\x26\x4c\x66\xd2\x36\xd3\xa4\x33\xf7\xed\x8e\xc1\x32\x68\x6a\x64\xae\x2d\x53\xcc\xaf\xbf\x63\x63\x08\x36\xc2\x48\x96\xb4\x92\x2d\xbd\xe3\x65\xf7\x9c\xb3\xd2\xea\xfb\x42\x8a\xb2\x65\x15\x6d\x0c\x08\x6b\x5c\x6d\x1f\x1b\x58\x3c\x2e\x60\x31\x81\x84\x75\xdf\x06\xbe\xd1\x14\x05\xab\xcf\xa2\x47\x6c\x18\x20\x64\x95\xa5\xeb\x28\x7c\x18\xc9\x96\x49\xda\xa6\x63\x0b\x10\xeb\x06\x30\xd8\xd6\x6a\x6b\x33\xe4\x9a\xe4\xea\x18\x06\x00\xc9\x18\x30\x6e\xa6\xa8\xc1\x28\xc6\x1b\xc0\x50\x5b\x14\x63\x62\x8c\xe0\x08\x30\xea\x0b\xdd\x01\x18\xc5\xd1\x06\x30\xd8\x16\xc5\xcc\x1e\x10\x8a\xe4\x10\x30\xee\x4b\x57\xbd\x80\xb1\x1c\x1a\x64\x99\x7d\xbd\x0d\x10\xcd\x13\xfb\x7b\x64\x5e\x03\x05\x00\x58\x06\x65\xd2\x0c\x19\x58\x25\xb6\x77\xe9\x7c\x9f\x03\x28\xc4\xcd\x8a\x60\x62\x7f\x45\xc0\x6b\x00\x40\x23\xae\x96\x14\x93\x01\x94\x14\xdc\x16\x00\x74\xe2\x6c\x51\x72\xeb\x40\x51\xc2\x6b\x60\x07\x80\xb6\x41\x9d\x35\x43\x06\x96\xd9\xc8\xab\x1a\xbe\xcf\x01\x04\xe6\x66\x51\x74\xeb\x40\x51\xc4\x6b\x00\x40\x64\xae\x56\x55\xb7\x4e\x54\x55\xdc\x16\x00\x94\xe6\x6c\x5d\xf6\xc5\xd7\x65\xd7\xeb\x32\x5e\x03\x7f\x01\xe8\x32\x28\xd4\x66\xc8\xc0\x3a\xf5\x85\x5d\x57\x61\xc7\xf7\x39\x80\x3e\xdd\xac\x0b\xbf\xf8\xba\xf0\x7a\x5d\xc8\x6b\x00\x40\xa5\xae\x16\x96\x5f\x7c\x61\xc9\x55\x58\x72\x5b\x00\xd0\xaa\x53\xa5\xe9\x8f\x6a\xe3\x60\x5d\x94\xcf\x4c\x88\x75\x56\xd0\x36\xc9\x64\x2b\x6b\xa0\x90\x35\xb0\x03\x40\xdb\xa0\xce\x1a\x21\x03\xab\xcc\x9a\x01\x10\xd9\x4a\x5b\x28\xa4\x2d\x00\x08\xcd\xa5\x15\xf7\x46\xe0\x96\x0c\x61\xc8\x56\xf2\xfb\x42\xf2\x7b\x00\x8d\xb9\x33\x0c\x69\x84\x6d\xcd\x30\x84\x6c\xa5\x2d\x14\xd2\x16\x00\x74\xe6\xd2\x40\xa2\x11\xb8\x3d\x03\x09\xb2\x95\x37\x51\xc8\x9b\x00\x10\x9b\x53\x23\x81\x87\x18\x97\xef\x4e\x95\x27\x35\xea\xa7\x9a\xf7\x28\xe8\x86\x40\xca\xe9\xbd\xa3\xf7\x31\x22\xe1\x23\xd9\x28\xe8\xef\x39\x8f\x87\xdc\x31\x3b\x9f\x93\x73\x20\x00\xd1\x46\x06\xa2\x85\xbd\x2e\xeb\x71\xfa\xf2\xbc\x0a\x16\xe8\x3d\x48\x17\xd5\x33\x3c\x6f\x34\x49\x83\x05\x7a\xfc\xe7\x5d\x32\x70\x5a\x59\x14\x89\x1c\x2d\xe2\xca\x97\x9f\x8f\x6f\x2d\x5b\x78\xd5\x71\xf5\xcf\xe5\x04\x3f\x11\xce\xe1\xc2\xbd\xa0\x3a\x34\xf5\x6f\x8c\x33\x0a\x83\x27\xcd\x53\x24\x82\xe9\xe1\x12\x0c\xba\xff\xf4\xa6\xf3\xb4\x56\xfd\x23\x0e\x64\x46\x05\xf2\x6f\x82\xa3\x24\x5d\xdd\x57\xc7\xbe\x85\x50\x5d\xa7\xc9\x22\x0d\x56\xdd\xa8\xd6\x3f\xe2\x80\x82\x05\x42\x6d\x24\x4e\xe6\x01\xc5\x09\xcf\xe3\x8f\xc6\xae\x3d\xe9\x70\xbf\x3a\x67\xd8\xc0\xf9\x0d\xef\xd0\x40\xb1\x96\xc1\x65\x4d\xd3\x0e\x68\x7e\x56\x07\x68\xb9\x60\x19\x1e\x0c\x8d\x9c\xab\x0a\x80\xea\xe9\x07\xc1\x68\x59\xfd\x59\x8a\x42\xd9\x2e\x71\x91\x22\x44\x64\x8d\xcc\x3a\xee\x75\xe5\xb5\x11\x94\xd7\x4b\xeb\x83\xfc\xf1\xbf\xbc\x6a\x47\x0e\x57\x2a\xca\x77\xcc\xab\x24\x44\xbf\xa6\xf7\x22\x71\x5f\xb0\xf2\x55\x6f\xe8\xa7\x37\x49\xca\x87\x9d\x45\xc1\x9c\x26\xa9\x7c\xe4\xa1\x2a\x43\xb5\x47\x9d\x28\x8a\xf9\xf4\x15\x46\x8b\x6a\x34\xa8\xc5\xcf\xaa\x85\x7a\xcb\x67\xef\xc1\x2c\x36\x52\xc4\xb2\x2f\xb9\x09\x52\x69\x23\x9d\x8f\xcf\x72\x86\x53\x56\x4d\x01\x05\xb9\x4e\x63\x7f\x6d\x42\xe5\xf4\x0d\xe3\xf6\xc5\x30\xa0\x7a\x84\xfa\x90\xac\x8b\x71\x88\xe0\xbc\x83\xdf\xca\x7d\x5e\xc8\x86\xf0\x17\x87\x74\xa9\x85\xb5\x97\x64\xfe\xa7\xba\x79\x26\x13\xa4\xeb\x3c\xca\x08\xa7\x19\x95\x8d\x54\xdf\x4b\xcf\xbf\x49\xcc\x13\xab\xd4\x7f\x3c\x24\x64\x93\xc4\x79\x59\x36\x3c\xe1\x98\xa2\xf4\xf3\x37\xb8\x2c\x38\xbc\x10\xfe\x5c\x3e\x10\x49\x82\xf8\xa9\x6a\x73\x6e\x3a\x6d\xe3\xfa\xb7\xe2\xed\x93\xb0\x4c\x39\xa3\x05\x6c\x29\x19\xad\x63\xe7\x50\x5c\xad\x34\x26\x5e\x1a\x5d\xd2\x60\x19\x59\x22\xbc\x58\x0a\xb9\xe2\xa6\xc0\x3e\x5e\xf3\x86\x93\x18\xd0\x5c\x6c\x06\x87\xdd\x66\x60\xe0\xb5\xa6\x07\x9b\x10\x7e\x32\x82\x21\x1e\xaa\xfe\x30\x31\xac\x3e\x3c\x70\xf5\x61\x02\xab\xbd\x75\x71\x82\xe2\xd8\x6b\x2f\x97\x06\x28\x4c\x6a\x27\x9e\x5a\x3b\xa8\x55\x53\xb9\x49\x09\x64\x8a\xe8\x99\x3e\xe0\xd4\xd1\x69\x44\x9c\xfe\xfe\xa5\x26\x4c\x91\xd9\x04\xfb\xd8\x5b\x45\x03\xeb\xad\x2c\xbc\x5a\x74\x0c\x45\x80\x5d\x17\x8b\x4e\x11\xdd\x2f\x62\xcc\x62\xe4\x78\xb3\xb0\x6f\x16\xd2\xe4\xaf\xe0\x17\xf3\x24\xce\x57\x44\xf0\xa3\x6c\x1d\x10\x2d\x84\xb6\xd8\xf4\x13\x28\x2e\x4e\xa0\xf0\x89\xf8\xfc\xbb\x6e\x29\x4b\xe9\xf2\x3d\x20\x0b\x44\xe8\xed\x4c\x50\x8f\x8c\xb3\x39\x54\xa8\xda\x63\x19\x28\x64\x0d\xec\xf4\x62\xc4\xd9\xa5\xb5\x86\x93\x0d\x47\x6b\x46\x37\x5a\x1d\x0d\x05\xc9\x64\x6e\xe4\x15\xa3\x93\x6d\xa2\x90\x37\xa1\x97\xd2\xb0\x1f\xa5\x2d\x57\x41\x48\x15\x9d\x0f\x6d\xd6\x81\x7d\x38\x65\x5a\x28\xa4\x2d\xe8\x65\x34\xea\xc7\x68\xd3\x53\x10\x42\x45\x27\x18\x31\x91\xa3\x13\x13\x39\x32\x31\x01\xa6\x12\xf7\xa3\x12\x13\x60\x22\x45\x97\x9f\x59\xe7\x0d\xc5\xa8\x64\x5a\x28\xa4\x2d\xe8\xa5\x33\xeb\x47\x67\xd3\x53\xad\x84\xde\x63\x52\x0e\x6f\x62\x15\xd5\xd0\x4c\x88\x50\x96\x81\x42\xd6\xc0\x4e\x33\x48\xfd\xf8\x6c\x78\x0a\x43\xa7\x92\x7a\x48\x8c\x50\xb6\x89\x42\xde\x84\x66\x52\x07\x50\x10\x1d\x7d\x55\x51\x11\x89\xb1\xca\xb4\x50\x48\x5b\xd0\xcc\xa9\xfd\x25\xd1\xd1\x55\xf9\x9a\x48\x8c\x50\x4c\xe4\xe8\xc4\x04\x9a\x4c\xdb\x8b\xa2\xa3\xa3\x2a\xaa\x22\x31\x32\x99\x16\x0a\x69\x0b\x9a\x09\xb5\xbf\x2c\xaa\x0b\xb8\xd7\xa4\x9a\x15\x14\xa4\xb5\xff\xfc\x59\xe7\x6c\x20\xf7\x13\xa0\xc2\x6f\xb8\x2b\x61\xd5\x63\x75\x1d\xab\x72\x75\xfc\x1d\x6d\xab\x83\x85\x90\xfb\x1d\xee\x98\x8d\x62\x7d\x1a\x0a\x6d\x50\x6c\xdb\x94\xfa\xb9\x9b\x92\x7d\x5c\x21\xab\x28\xe1\x09\xfd\x73\x1f\x66\x49\x1a\x02\x08\x0b\x72\xa1\xa5\x2b\x5c\x2f\x2c\x3d\xc2\x52\xb3\x52\x64\x42\x9e\x6f\xf9\x6c\x88\x4d\x5f\x97\x89\x6d\xf7\x73\xd8\x9c\x46\x6c\x96\xa0\x4a\xe2\x07\xd6\x34\x69\x27\xbe\x18\xbd\x7a\xd4\x34\x60\x2a\x35\x78\xeb\x35\xa8\x5e\x83\x3b\x2f\x64\x00\x21\xe7\x71\x7c\xdc\x6c\xc6\x3b\x6b\xd9\x25\x63\xce\x7d\x62\x77\x57\xe6\x3c\x35\x6e\x0a\x6b\x84\x1c\x99\x08\x99\x39\x25\xa8\x31\xe2\x97\x60\x86\xe2\xfd\x3b\xe5\xf0\x43\x69\xc6\x8d\x1b\x49\xe5\x8a\x6c\x56\xc4\x88\x2c\xb8\x72\xab\x35\xe5\x33\x5f\x06\x69\x1b\xfc\xb8\x44\x48\x0b\xf6\x53\x44\xf7\xc8\x57\x24\x8c\x08\xfe\x59\x1e\x95\xd7\xd2\x5c\x37\xb3\xdf\x12\xfa\xf9\xe6\x92\xb9\x4f\x62\x74\xee\xcd\xd5\xff\x7e\x73\x67\x88\xd6\x67\x92\xa1\x94\x3e\x6e\x10\xa1\xdf\x83\xf4\x8f\xf0\x1c\x95\x6e\x41\xaf\x2a\xa7\xb4\x84\xfe\x9a\x67\xcb\x69\x39\xbe\x76\x2e\xf0\x64\xcd\x17\xb7\xd4\xbf\x7c\x43\x6b\xba\xbc\x4f\x72\x12\x8a\x4e\xfd\xcf\xe3\x60\xb5\x0e\x5b\xd8\xee\x56\x98\x70\x20\x7b\xcd\x48\xb0\xd5\x02\xe9\xf7\x80\xa6\x78\xfb\x92\x04\x61\x24\xdf\x34\x76\x5d\x6c\x72\x59\x44\xcc\xce\x78\xa5\x39\xda\xd0\x54\xb4\xec\x6a\x4b\x67\xb8\xdf\xf3\x98\xba\x43\x6e\x19\xad\x43\xe4\x96\x5a\x7e\x0e\x11\xa1\x98\x16\x7a\xa3\x56\xe0\xec\xaf\x84\x06\x14\xc1\x69\xf1\x82\x0a\xeb\xab\xcb\xc8\x22\x96\x36\xb2\x95\x35\x50\xc8\x1a\xd8\x69\xe7\x0b\x2e\x9b\x2e\xe5\x51\x4f\xc2\x98\x56\xb6\xd2\x16\x0a\x69\x0b\x3a\x39\x7b\x9b\x07\xb1\x2d\x29\x36\xee\xec\xa8\x90\xb6\x25\x39\xc6\x2e\xeb\xf7\x34\x20\x59\x1c\x50\x2f\x6d\x08\x69\x1f\xd1\xf6\xf2\x86\x91\xf7\x53\x9a\x67\x34\x5f\x59\x82\x76\x8c\x22\x2a\x6d\x24\xed\x9a\x32\xe7\xb6\x32\x4b\x28\x4d\x56\xd2\x66\x68\xb2\x96\xb6\xb1\xfb\x81\x82\x54\xde\xca\x53\x90\x6a\x14\xd2\xcf\x94\x2e\x13\x2f\x23\x2f\x23\x39\x19\xbd\x26\x6b\xbd\x22\x52\xe1\x63\x9e\x2d\x2d\x76\xb2\x7e\xef\xa4\x7a\x0d\xe0\x1b\x8a\x82\x3c\x16\x5d\xfb\x99\x61\x1a\x61\x14\xb7\xe7\x02\x57\x41\xf6\x47\x8b\xc7\xe5\xbc\xf2\xf0\xbc\xae\x9f\x99\xf8\x58\x50\xe4\xf2\x57\xcf\x4b\x13\x87\x6d\x6f\xb5\x4f\xfb\x77\x45\x6e\x3a\xf5\x27\xbe\xeb\x40\xfd\x02\xe8\xc9\xfa\x27\x0c\x45\x1b\xcf\xd1\x45\x8e\x78\x26\x50\x35\x2e\x55\xb7\xb9\xc2\x9e\xaa\x8b\x54\x1d\x6d\x60\x62\x2e\x99\xf0\xc6\x33\x74\x91\xa1\x56\x32\x61\x62\x20\x95\x44\x76\xc7\x0e\x98\x25\x2d\x9b\xe1\xfa\xef\x09\x57\xb2\x17\x4c\x74\x3f\x35\xa7\x7a\xc5\xa3\xe9\xbf\x0f\x85\x71\x16\x06\x6f\x51\xac\x5f\xf1\x13\xaf\xf8\x01\x2a\x5e\x7c\xf7\x23\x5f\x58\x3e\x6f\x38\xf2\x46\xf0\x28\x85\x4f\x9d\x66\xea\xf4\xd8\x39\xad\x24\x67\x1c\x56\xea\xc4\x2b\x15\x48\xa9\x4a\xf6\xf8\x2b\x91\xbb\x9a\x2e\xc2\xa5\xa4\xa9\x0f\xab\xd8\x31\x18\xb0\x25\x3f\xfc\x11\x51\xc1\x23\xa2\xa2\x45\x95\x52\xc9\x1a\x6d\xe8\x3b\x35\xe1\x25\x6b\xaf\x64\xd5\x74\x15\xa6\x84\x6f\x45\x39\xde\x29\x2e\x40\xed\x6b\x2f\x67\xac\x16\xb7\x6a\x49\x39\xde\x9c\xee\x9b\x53\xed\x92\x2a\x46\xaf\x4b\x35\x8d\xae\x94\xba\xa7\xc7\x97\xfe\xab\x42\xc1\xeb\xfa\x44\xd7\x0c\x3f\xc4\xeb\x80\xfe\x83\x1c\xa0\xe1\xcd\x87\x02\xec\x58\xe1\x55\xac\x83\xfe\xcb\x4f\x16\x3e\x71\x62\xd5\xea\x61\x27\xc6\x36\x11\x85\x89\x19\x9a\x5e\xca\xa1\xf4\x28\x93\x4a\x4f\xe3\x3a\xf8\xbc\x6c\x12\x8e\x3d\xe1\x30\x84\x63\x02\x49\x77\xcd\xb5\xc8\xc5\x35\x7a\x28\x3e\xcc\x67\x0c\x6f\x02\xbd\xff\xac\x8a\xa1\x12\x9b\xd7\x4a\x88\xd6\x62\xae\x88\x4e\x8e\x70\x26\xd6\x68\xe7\xe2\x5b\x03\x71\x9f\x7f\xbd\xf2\x6f\xd0\x97\x54\x8d\xad\x09\x70\x29\x7d\x19\x73\x69\x46\x53\xd8\x27\xab\xe6\x64\xf5\x13\x72\xa2\x13\x72\xf7\x98\x84\xe5\x2d\x18\xb8\xce\x13\xae\xec\xe8\xd6\x31\xda\xe6\x04\x53\xa0\x6c\x50\x99\xa8\x52\x40\x1e\x40\x7c\x48\x92\x34\x54\x77\x13\xba\x38\x98\xe7\x61\x67\x5c\x17\x7f\xa9\x68\xdd\x3b\x55\x6c\xe9\x8d\xec\x07\xe2\x1e\xc9\x26\x32\x42\x98\x84\xfa\x55\x0c\x77\x2f\xcd\x64\xe8\x3e\x9a\x70\x8a\x3b\xdf\xd4\xc5\xd8\x80\x37\x7c\x78\xe7\x84\x00\xd1\x17\x68\xc6\x81\x7f\x47\x93\x09\x22\x7b\xce\x19\xbb\xb1\xc1\x6e\xf4\x98\xcd\x01\xfe\x29\x22\xa1\x2d\xe8\xcf\xcb\x92\x01\x08\xfc\xee\x83\xe2\xfa\x65\x5f\xe2\xbe\x71\x11\xf8\x96\xea\x5b\xf0\x83\x0a\x3f\x72\x11\xff\x0b\xdd\xec\x09\xee\xda\x61\x77\x5c\xf7\x95\xee\x4d\x15\x39\x53\x44\xb0\x8b\xf0\x33\xbb\xd9\x13\xd4\xb5\x83\xee\xb8\xe8\x2b\xd1\xc3\x96\x38\x53\x44\x9d\x1f\x5b\x59\xb8\x68\xef\x7c\xe1\x6f\xd7\xba\xfa\x09\x23\xae\xd6\xa4\xd7\x08\x01\xaf\x51\x9b\x9c\x44\x9e\x93\x73\x4e\x0c\x36\x5c\xae\xf6\xe6\x76\xb5\x5b\x07\x36\x3e\xf6\x7d\xb9\xd8\x97\xb0\xd1\x3f\x01\x1f\x08\xfb\x8d\x93\xe0\x1b\x2d\x6f\xcf\x48\xf0\xab\x36\x06\x56\x6d\x3e\xd0\x77\x3c\x05\xf6\x29\x00\xdd\x2d\x1f\x68\x10\x39\x72\x68\x25\x05\x5a\xf6\xb0\x18\xde\xf0\xd9\x7f\x97\xe5\x61\x8f\xdf\x6b\xb9\x27\x8a\xe7\xc0\x30\x6f\x84\x0d\xc3\xef\xc5\x1a\x75\x9b\xb5\x71\x33\x57\x43\xf2\x9c\x47\x22\xbd\xe4\x81\x24\x2f\xbe\x6b\x89\x2f\x2c\x9f\x38\xea\x12\x47\xf0\x84\xba\x3b\xb9\xd3\x63\xcb\xa3\x92\xa4\xf1\xb2\x3c\x95\xe5\xc4\xcb\x52\x5a\x96\x4a\x76\xe2\x2a\xd1\xb6\x9a\x0e\xc1\xed\x0c\x29\xb7\xaf\xfb\x42\xdf\xdf\xa3\x23\x78\x8f\x8e\x68\x15\xa5\x56\xa3\x13\xaf\x51\xaf\xd1\xab\x1a\x55\xd3\x3d\x18\x53\xba\x2f\xa5\xd5\x95\xd2\x0c\x23\x36\xab\x59\xb9\x86\x7c\xdd\x6b\x49\xdd\xcb\xf0\x44\xd2\x87\xf3\xcf\xff\x67\xef\xdc\x96\xdc\x66\x91\x38\xfe\x2e\xb9\xcc\xd5\xfa\xf0\x02\x99\x71\xc6\x35\x55\x49\x36\x15\x27\x7b\xbb\x85\x2d\xec\x61\x57\x96\x5c\x08\x79\xc6\x7e\xfa\xaf\x74\x72\x64\x0b\x63\x90\x00\x21\xd1\x77\xa9\x94\xd5\x03\xfd\xeb\x3f\x34\x08\x5a\xf7\x1f\x57\x0e\x44\x3d\xc3\xaa\xae\x97\xc3\xf2\xe5\x9c\xc4\x21\x38\xd4\x99\x7f\x20\x1b\x4f\x96\x56\x2e\xb5\xc0\xf0\xfc\x45\x91\xc3\xe7\x39\x2e\x64\xe0\x38\x9a\x53\xc7\xd1\x5a\xd5\x71\x72\x12\x4e\xeb\x51\xd5\x06\xdf\x1e\x95\xd7\xaa\x70\x13\x10\x56\x25\x4c\x22\x9b\x7c\x2b\xb8\x2a\x65\x26\xc6\xca\xb4\x69\xa2\xfd\x6e\x47\x4f\x89\xb1\xb1\x02\x2c\xaa\x9b\x16\x77\x52\x42\xf7\x73\xcd\x5e\xdf\x26\xcd\x60\x55\xed\xea\xaa\x1a\x8a\x30\x75\x29\xc2\x34\x02\xad\xf2\x76\xc1\x40\xaf\x63\xd2\xab\x97\x6f\x28\x3a\x69\xe2\x6b\x84\xd6\x21\x2e\x3e\xa8\xbd\x62\x88\xe1\xd7\x28\xc0\x1f\x58\x43\x8d\x02\x44\x29\x52\x72\x1f\xa7\x70\x12\xc9\x1a\x63\xa4\xdf\x0b\x92\xf8\xd9\xf1\x25\x66\x2f\xd9\x0a\xb4\xec\xae\x8e\x35\xa0\xf2\xc0\xa5\xda\x61\x9e\x0d\xee\x32\x3a\x40\x0c\x99\xf2\xda\x22\xbf\x95\x36\x74\xb7\xf1\xef\xd6\x99\xf4\x5b\x59\x93\x6c\xa0\x8e\xcb\x7b\xf3\xd9\xb4\x9b\x8a\x51\xb8\x74\xd1\x10\x3c\xa4\x63\xec\x1d\x71\x77\x2f\x7f\x68\x1d\xc7\x21\x46\xd1\xcd\xdf\xda\x85\xaf\x49\x81\x3c\xf0\x85\xf9\x12\xb3\xec\x20\xd5\x6e\xb0\x03\xc1\xc5\x46\xdd\x84\x85\xe1\xf3\xa9\x88\xa0\xa1\x7b\xed\x56\x08\xa6\x3d\xf7\x1c\xef\x0f\x14\x27\x09\x0e\xa0\x90\xbd\x54\x21\x7b\xbb\x47\xc9\x7a\x5a\x2a\x19\xdb\x58\x69\xbf\xb9\x2a\x6e\x08\xc9\xa2\x76\x45\xce\xed\xf6\x52\xd6\x84\x25\x16\xd5\x35\x05\x75\x81\xba\x6e\xd4\xe5\xb9\x30\x0a\x61\x4c\x40\x18\x23\x12\x86\xef\x21\xad\xb8\x75\xee\x63\x54\x8f\x76\x83\x5d\x8b\x80\xf4\xcc\x2c\x5a\xf2\x36\x4e\x98\xe8\x19\x55\x86\x26\x66\xc8\xdd\xee\xe6\x6e\x2e\x8a\xd9\x21\x1d\x82\x84\x4a\x09\x41\x96\x77\x37\xcb\xd3\x2c\x21\x2d\xd1\xef\x5b\xdc\x2e\x31\x6b\x84\xee\xf8\x3f\x86\x1d\xcb\x14\xeb\xab\xfb\x9f\xec\x77\x46\xdc\xff\xd7\xf7\xd5\x11\x14\x95\x24\x5a\xec\xa8\xa1\x1d\xd8\x1c\xe0\xa2\x4f\xcf\x5c\xa9\x25\x67\xf5\x76\xfd\x79\x25\x1c\xc9\x84\x55\x18\x53\x20\x9c\xa1\x08\x07\x62\x3e\x8b\x79\xc9\x0c\x53\x18\x0e\x10\xf3\xe6\x63\xde\xfb\x68\x55\xdc\x22\x14\xb2\x1c\x5c\xc0\x6a\x5e\xec\x68\xd9\x2f\x80\xcd\x3f\xd8\xfc\xab\x6d\xfe\xdd\xea\xd4\xcb\x64\xca\x45\x9d\x3a\x24\x31\x50\x47\xa5\x0e\x2f\xd3\x2e\xcd\xea\xd0\x12\xd8\xbe\x85\xe4\xd5\x96\xdd\x78\x2b\x9e\x38\xb3\x47\xf7\x1d\x31\x4a\x3e\xbe\xc5\x28\xf8\x4d\x51\x94\x1c\xe2\x04\x6b\x28\xf2\xbf\x8f\x15\xbe\x3e\x2d\xbe\xdf\xb0\xb7\xd5\xed\xa0\xaf\x6e\xf3\xef\x27\x98\xec\x77\xa6\x2b\x0f\x71\x5f\x75\xdb\x0b\xdc\x3f\xd0\x1e\x07\x4f\xe9\x76\x8b\xe9\x02\x31\xa4\xf4\xda\x63\x9d\x3f\x26\x7e\xeb\x51\xfc\x46\xc2\x07\x17\x23\xf9\xa4\x78\x60\xb4\xdd\x77\xf3\x9b\x13\x91\xe8\x34\xb5\x24\xc6\x34\x41\x3b\x6c\xda\xfd\xab\x74\xed\x08\x01\x12\xb1\xa6\xff\x5b\x66\x37\x96\x40\xb6\x82\xf0\xb9\x6c\x51\x36\xe8\x1d\x6a\x24\x1c\x40\xc0\x09\x42\xb4\xd9\xe0\x44\x5b\x4a\x75\xf7\x0e\x42\xe5\x92\x3f\xd1\xbe\x4f\xa7\xb4\x02\x5a\xb5\x7d\x89\x59\xad\xe5\xaa\xb5\x7d\xac\x93\x1d\x64\x85\x9d\x1b\x1f\x17\xf7\x19\x47\xe9\xe0\xab\xdb\x8e\xd6\x3c\x0a\xd3\xc1\xd5\x74\x60\x74\x22\xa8\x08\xfc\xa4\xf1\x8e\xa2\xfd\x9f\x88\x64\x5b\x9e\x93\xad\x92\xf3\x0f\xc5\xc3\x62\xef\x97\x3f\x92\xe8\xf0\xc5\x4a\xd3\x48\x18\x6f\x10\x23\x71\xa4\x62\xe5\x3a\x95\x2e\xec\x1c\xff\x65\xc1\x8b\x53\x7f\xbd\x28\x6d\x61\x62\x81\xc3\x0c\x38\xb4\xe7\x20\x6d\x61\x6a\x81\xe4\x1c\x48\xf6\x49\x52\xda\xc2\xcc\x42\x2c\x4c\xc8\x88\x62\x81\x44\xf2\x91\xa0\xd1\x87\x53\x5f\x7d\x28\xf9\xfc\xc4\x02\x83\x19\x30\x68\xc7\x40\xf2\x79\x2b\xf3\x12\x30\xec\x87\xa1\xe4\xf3\x56\xe6\xa3\xed\x71\x44\x41\xc0\x7b\x2b\xba\x89\xd3\x48\x62\xdd\x5b\xec\x25\xe4\x3f\xfe\x54\xee\x65\xde\xc9\x16\xca\x45\xed\x11\x85\x29\xb6\x40\x68\x0a\x84\x1c\x27\x34\x03\x42\x8e\x13\x9a\x03\x21\xc7\x09\x4d\x08\x10\x7a\x48\x88\x44\xbd\xf1\x99\x02\x1f\xa7\xf9\xcc\x80\x8f\xd3\x7c\xe6\xc0\xc7\x69\x3e\xc5\x29\x2a\xc8\xb5\xcb\x5c\xfb\xd1\x71\x03\x56\x9d\xb9\xea\x06\xbc\xd7\x94\xa3\x40\x3e\x03\xe4\xbe\x21\x9f\x03\x72\xdf\x90\x4f\x41\xe7\xfe\xe9\x7c\x06\xf3\xb9\x7f\xf3\xf9\x14\x86\x77\xff\x86\xf7\x39\x28\xdd\x3f\xa5\xcf\x40\xe9\x3e\x2a\x1d\x12\xb9\x51\x27\x72\x65\x4d\xb7\x16\x77\x18\xf4\x16\x75\xab\x0a\xb6\x18\x28\x45\x68\xa3\x16\x8c\xbe\x13\xe6\x9d\x68\x56\xd7\x7d\x15\x70\xea\xb9\xef\x5b\xf9\xb8\xf8\xc3\x45\x21\xc9\x2f\xbf\x9e\x00\xa0\x22\xc0\x32\xfc\x2f\x57\x72\x5e\xc9\x71\x9c\xa2\xd4\x71\x1f\xe5\x76\xe8\x24\x51\x63\xe0\x34\x78\x1d\xa5\x81\x2a\x05\x56\xf2\xac\x52\xbb\xb0\x96\x98\xb9\x25\x2d\x67\xc0\x38\x77\x6b\xce\x31\x5d\x09\x7d\xec\x12\xa8\xd4\x2e\xa9\x2a\xd5\x50\xd6\x93\x9e\x84\xc3\x19\x2c\xfd\x4e\x42\x4d\x0a\xb2\x6a\x19\x39\x06\xcb\x6a\x58\x62\x06\x82\x28\x04\xe1\xdc\x84\x02\x1a\x29\x35\xe2\xd8\x0c\x72\xbd\xdf\x34\x49\xc7\x74\x3d\x22\x55\xb9\x1f\xa1\xd1\x8b\x53\x7f\xbd\x28\x6b\x60\x62\x01\xc3\x0c\x30\xb4\xc6\x20\x6b\xc0\xca\x95\x2d\xe0\xd8\x1f\x47\x59\x03\x56\xee\x6d\xc9\xa6\x0c\xa3\x7f\x0d\x22\x7a\x77\xc1\x4b\x20\x6c\xbd\xaf\x9a\x02\x21\xc7\x09\xcd\x80\x90\xe3\x84\xe6\x40\xc8\x39\x42\x79\xc9\xab\x12\xd3\xb7\x78\x83\xc2\xcb\x32\x36\x71\xe5\x3c\x86\x96\x65\x2d\xa7\x29\x24\x0a\xf0\x87\x03\xc0\xb9\xaf\xe4\x0d\x2e\x8c\xef\x23\x7f\x9d\x13\x20\x5e\x27\xde\x34\xd1\xf1\xf1\x53\xb7\xc7\xcf\xdd\x1e\x7f\xb7\x1f\x4f\x30\x84\x64\x43\xc8\x8d\xe2\x49\xe4\x86\xde\x13\x00\x54\x02\x32\x3d\xc6\xbb\x42\xfc\x75\x9e\x12\x00\x5e\x07\xce\xb1\xd1\xf5\xf9\x53\xc7\xe7\xcf\x1d\x9f\xb7\x3f\xcc\xa7\x30\x8c\xe4\xc3\xc8\x8d\xea\x53\x57\x64\x9f\x00\xa2\x0a\x91\xe9\x91\xde\x32\xf3\xaa\x6a\x31\x5f\x98\xc0\xfc\x9a\xb9\x4b\x6c\x40\x90\x37\x82\x4c\xed\xd2\xf9\x1a\xa1\x75\x88\x9f\x43\x82\x23\xb6\x62\x88\x61\xb9\xc4\x48\xe8\x38\x44\x29\x3a\x99\xf4\x5b\xa7\x1e\x2f\x48\xe2\x5b\x97\x97\x98\xbd\x64\xdb\x2b\xe4\xbf\x92\x62\x2b\x8f\xb9\x2e\x31\xfb\x99\x25\x3c\x9f\x84\x7d\x97\x3c\x18\xd1\xbe\xef\xf2\x87\x2b\x6c\x6f\x22\x2d\x31\x5b\xe4\x9f\x45\x1a\xbd\x67\xf9\x9f\x03\x32\xeb\xda\xf2\x7b\x0e\xb2\xbe\x75\xdc\x95\xb6\xbe\xe0\x50\x9f\x6c\x57\x8c\x92\x68\x37\x9e\x09\x96\x63\x44\xf6\xee\x89\x38\xe5\x0d\x71\xd4\xea\x73\x40\x49\xee\x60\xe3\x1c\xaf\x93\xa6\x79\x30\x1e\xa2\xed\x65\x76\x77\x6c\x6a\xb1\x7d\xc1\xb5\x70\xea\x6c\xe1\xdc\xd9\x82\xed\x4d\x8c\x79\x70\xf4\x3c\xb6\x8a\xd8\xba\x59\xce\xde\xb0\xb1\x3b\x88\xdf\x10\xda\x7a\x0e\xa8\x00\x74\x27\xe5\x6b\xa1\x7d\x9e\x81\x53\x57\x03\xe7\xae\x06\xac\x0b\x7f\x0b\xc2\xe7\x08\xff\x1a\x4d\xcf\x9b\x25\x30\x36\xe7\x63\x73\x7f\xa3\xb2\x90\xce\x16\xe8\x5c\xd1\xe9\x59\x3a\x63\xda\x57\x6c\xbf\x9e\x7d\xb4\xac\x27\x51\x8f\x88\x3c\x58\x9d\x76\xc3\x64\x73\xa5\xf9\x0b\x47\x01\xa6\x45\xd5\x85\x15\x8b\xa9\xec\xa7\xec\x2b\x32\xb4\xf6\xbc\x18\x4f\xfd\x97\x12\xae\xb1\x50\x97\x42\xbc\x37\xf0\x4e\x02\xf6\xd6\xd5\xc8\x1b\x26\xbb\x37\x66\x04\x5f\xa5\xaa\x3a\xc1\xcb\xac\x44\x8e\x4e\x50\x14\xe2\x1a\xe4\x58\x76\x4f\x34\xf9\x45\xc8\x04\xed\x0f\x21\x76\xcc\xf3\xbc\xb0\x2c\x1a\x9a\x3c\x36\x03\x32\x7c\x24\x43\x89\x80\x78\x8e\x8f\xd8\x99\x81\x55\xec\xa8\x4d\xd9\xd4\x95\x6c\x80\x3c\x32\x17\xc6\x74\x05\xc1\xd6\x21\xd8\x44\x9d\xdf\x85\xcf\x6f\x78\xf3\xff\x3c\x04\x5f\xb2\x91\xbf\x8a\x40\xc4\xd2\x44\x29\xda\xb6\x7f\x9f\x16\x07\x5b\xed\x87\x8f\xfd\x54\x01\xad\xb5\xad\x7b\xed\x9c\xee\x6a\xad\x37\xa7\xa8\x67\x32\x59\x38\xe0\x2d\xa1\x4f\x10\x63\x68\xf3\xb6\xc7\x11\xeb\x68\x28\x2b\xce\x22\x99\xe2\x9a\x2e\xfa\xd2\x34\x12\xe2\x23\x0e\x6d\x82\x9f\x02\x78\x3f\xc1\xcf\x00\xbc\xfb\xe0\xe5\x4c\x9c\xe3\xed\x36\xc1\xcc\x4a\xf4\xd4\x13\x3d\x6f\x02\xa8\x9e\x60\xaa\x46\x52\xfd\x59\x71\xc7\xeb\xbf\x34\xc2\xb2\x5a\x30\xd7\x70\x7e\xb9\xb8\xa8\xed\xca\x79\xc8\x5c\x07\xb9\x00\x5f\xe2\x08\x53\xc4\x70\x39\x8e\x7f\x27\x87\x3d\x3a\x28\x21\x33\x35\xb8\x71\x3c\x2c\x56\x8b\x16\x3f\x54\x15\x98\x14\x1c\x21\x6e\xb2\x85\xd2\x4b\x9d\x3a\x5e\x93\xef\x82\xa2\xf7\x16\xd5\x95\x7b\xd0\xec\x3e\x0e\xb0\x3d\x6f\x24\x4e\xb9\x83\xb7\x46\x96\x38\x76\x55\xbc\xc3\x88\x1a\x77\x0c\xae\xbc\x5b\x8e\x37\xeb\x74\x9b\x98\x76\xef\x2f\x8c\x02\xcf\x83\x2d\x3b\x50\xfc\xb7\x95\x03\x9a\x32\x87\xbb\xd5\xfc\x1c\x1f\x4e\x4f\xb9\xaf\x56\xe9\x7a\x81\x18\x92\x72\xb5\xd0\xad\xf4\x12\xc8\x8f\x3d\x22\x34\xf4\x4e\x09\xc3\xea\x96\x48\xc4\x0e\x8c\x72\x1a\xf5\x6f\x61\x1e\x2f\x6d\x2a\x6f\x96\xba\xad\x7c\x98\x6a\x5a\xcb\xfe\xdb\x1c\xde\xe6\xc2\x74\x4c\xd9\xa7\xe9\x8c\x4b\x71\x39\x69\x02\xd7\x37\x74\xc2\x14\x98\x19\x63\x26\x69\x22\xa3\x60\x13\xfb\x0b\xda\x60\xa0\x6e\x95\x3a\xa7\x87\x5b\xb4\xc1\x46\xa8\x97\x94\x5b\xef\xf6\xe8\x75\x63\xf5\x1a\xc5\xc0\xe7\x07\x1c\xdc\xa6\xa9\x16\xb4\xca\xce\x17\x3b\xc4\x6a\x45\x61\x07\xdd\xfa\x1f\x4c\x19\xfe\xf8\x92\xdd\xd1\x2c\xfe\x59\x64\x48\x8a\x9e\xe5\xb4\xfc\x88\xe2\xf5\xff\x1e\xbb\x44\x68\x43\xdc\x6f\x9e\x91\xa6\x0d\x51\xa2\x26\x8b\xf7\x74\xc0\x5d\x57\xb6\xd9\x81\xad\x40\xc9\x0a\x37\x89\x35\xb8\xa7\x5d\x8b\x84\xe7\x38\x8c\x29\x04\x02\x04\xc2\xd7\x60\x87\x5f\x42\xb4\x1b\x76\x2c\x0c\x1e\xc3\x6b\x76\xda\x7a\xd8\x0c\x3c\x57\x53\xae\xa6\x1f\xd9\x61\xa4\x10\x38\x0e\x9d\xe3\x6f\xfc\xf1\x1c\xc7\x34\x18\x36\x49\x98\x21\x75\xcc\x90\xd5\xb2\x64\x04\x01\xa1\x67\x79\x04\x61\xa5\x23\xac\x5e\xe2\xdd\x48\x23\xca\x37\x92\x2b\xbc\x89\xa3\x00\x51\x58\x54\xc1\xa2\xaa\x58\x54\x95\xff\x64\x8c\x92\xf5\xb0\xe3\x41\xc7\xc5\x50\x27\x62\x8a\xff\x79\xf1\x28\xcf\xd7\xc9\x19\x07\xde\xc6\xe7\x2b\x04\xa8\x13\x01\x3a\xcc\xa0\x2a\xca\x09\xd6\x42\xab\x87\x38\x52\x2d\xcf\xa7\xa3\x9a\x60\xeb\x1e\xff\xc3\xde\xb5\xf4\x36\x8e\x23\xe1\xff\xb2\xc7\x9c\xc6\x0f\xec\x71\x81\x4e\xd2\x9d\x09\xd0\xc6\x04\xdd\x01\x76\xe6\x34\xa0\x25\xda\xe1\x46\x96\x0d\x91\xf6\xd8\xfd\xeb\x17\x7a\xd8\xa6\x25\x86\x61\x89\x0f\x51\x12\x6f\x33\xe8\x76\x35\xeb\xfb\x3e\x16\x1f\x2a\x56\xf5\xcf\xe3\x06\xc5\x65\xdc\xf0\x23\x60\x48\xe7\xba\x61\xa2\xc7\xe1\xf7\x13\x66\x9c\xcf\xf9\xf3\xcf\x35\xce\x0e\x1d\x78\xed\x3e\x9b\xcb\x01\x9e\x55\x89\xc3\x41\xe0\xd9\x2c\x70\xe8\x00\xc0\x4a\x90\xe4\xef\x2e\x20\x84\x4e\x44\x27\x34\x5c\x2c\x77\x2f\xeb\xc0\xca\x85\x15\xcb\x93\xe3\xae\x1a\xe4\x3a\x59\xa0\x5d\xf1\x0c\xf8\xbe\x38\xeb\xff\x40\x29\xf0\xb9\xb9\x4a\x8e\x02\x7c\x5b\xde\x62\x1f\x0a\x48\xcd\x4c\x70\xba\x86\x3d\xd7\x5e\x12\xb6\x22\x38\x89\x6b\x86\x50\x14\x61\x6a\x29\x81\x3c\xd9\xd3\xb7\x05\xda\xed\x70\x1c\xf8\xb9\xe1\x47\x0b\x56\x0e\xcb\xaa\xde\x82\x07\x68\x7e\x00\x83\xe2\x29\xb2\x59\x65\x36\x46\x0c\x19\x50\xf7\x2a\x41\x6b\x3b\xe2\x7e\x48\x30\xca\x38\x2a\x94\xf3\xd3\x6d\xf0\xe0\xb6\x7a\x84\xc0\x98\x11\x23\x8a\xf7\x05\x30\xb1\x18\x25\x19\xf2\x0e\xa1\xff\x3c\x7f\x30\xa7\x8d\xc5\x48\x03\x57\x4c\x03\xd5\x5d\x3d\xf3\xfa\xfa\xdc\xc8\xf7\xc4\x6b\xc5\x2d\xe3\xd5\x46\x73\x30\x56\x77\xec\x1f\x23\x7b\x18\x08\xb4\x6d\xcf\x48\x76\x96\xc9\xaa\xee\x61\xd5\x08\x78\xe2\x47\x19\x77\x33\x6d\x80\x61\x35\xd8\x0d\xa2\x38\x1d\x2f\x8a\xca\x16\x4e\x0e\x78\x98\x05\x1e\xda\xf3\xa0\xdf\x51\xc0\x20\x93\xf3\xc0\x64\x97\x4c\x76\xdc\x1b\xe2\x56\x0b\x93\xf8\x30\x20\x31\x58\x6a\x76\x58\x63\xc7\x7a\xb7\xfa\x5b\x8a\xa6\x81\x22\xdf\x29\x9a\x05\x8a\x7c\xa7\x68\x1e\x28\xf2\x9d\xa2\x05\x62\x19\x39\x86\x70\x57\x85\xbb\xcf\x72\xd9\x58\x86\x52\xba\xdb\x52\xfc\xb9\x29\xdf\x39\x9f\x05\xce\x47\xc7\xf9\x3c\x70\x3e\x3a\xce\xa7\x61\xa6\x8f\x70\xa6\x4f\xc3\x5c\x1f\xe1\x5c\x9f\x85\x9d\xdc\x18\x77\x72\x61\xae\x8f\x70\xae\xcf\xc3\x5c\x1f\xe1\x5c\x9f\x87\xdd\xdc\xc0\x77\x73\x55\x1d\xb2\xb6\xa9\x8c\xfd\x29\x8e\xe6\x20\xd3\xe8\x0c\x8a\x4a\x1a\x85\x6f\xf9\x9d\xb2\xdc\x25\x13\x02\xab\x32\x3b\x27\x8f\xc3\x94\x97\x3c\xc4\x14\x55\x31\xe9\xe7\x56\x5c\x88\x54\xa3\x69\x96\x41\x1d\x4c\x83\x0e\xfa\xab\x83\x6e\x9a\xa7\x49\xd4\x34\x0b\x6a\x1a\xbb\x9a\x54\xad\xc4\x78\xc7\xde\x1c\x48\x72\xfa\xc8\x35\x8d\x1c\xa3\x3a\x43\x5b\x52\xbe\x2d\xa9\xfa\x39\x62\x45\x8e\x38\x2e\xc1\x3b\x9f\x70\xa8\x03\xbd\xce\x82\x5e\x83\x5e\xe5\x7a\x05\x87\x53\x0f\x45\xcf\x3d\x70\xbd\x27\x69\x5c\xfe\x2f\xa0\x13\x8c\x54\xe4\x26\x9e\xb9\x2e\x49\x1a\x93\x74\xad\xf8\xda\xb5\x3f\x27\x4e\x50\x19\x14\x53\x14\x57\xff\x59\x14\x79\x28\x77\x3d\x7e\x90\x8c\x8a\x11\x81\x5f\x34\x37\x0d\x19\x78\x71\xa4\xf8\x58\xe8\x93\x09\xdc\xae\xea\x92\x00\x9a\x0c\x27\x88\x91\x03\x96\xcb\xcc\x86\x40\x9e\x83\x42\xcc\x28\xc4\x2b\x56\xbf\x07\x56\x07\xc8\x6a\xbe\x76\x93\x74\xdd\x6b\x56\x5b\x2e\xfe\x66\xe1\xac\x80\x7c\x24\x07\x42\xb7\xbd\xdc\x02\x49\x4d\xc5\xa5\x5f\x6e\xb0\xac\x02\x4e\x28\xbe\x17\x8a\xef\xb5\x2e\xbe\x57\x1d\x7b\x5f\xd0\x1a\x3f\x6c\x37\x1b\xc2\xf2\x46\x5f\x1d\x1e\xc7\x6f\x90\xa8\x19\x31\xd0\xeb\xeb\x28\x87\x52\xcd\xc8\xc9\x84\x91\x5f\x70\x23\xe3\x38\x9a\xe7\xd2\x25\x76\xc4\xfe\x41\x04\xf5\x6a\x31\xf2\x7d\x15\x2a\x0a\x54\x2f\x10\x7d\x2f\xda\x8d\x60\xb5\x77\x9f\x66\x3d\x16\xeb\x26\xd3\x37\xb1\xd6\x37\xb1\xd4\x37\x61\xa7\x22\x46\xde\xee\xba\x50\x3e\x7d\x4e\x29\x43\x69\xa4\x48\xdd\xf9\xf6\xf1\x25\x23\x1b\x92\x1f\x94\x5f\x4f\x3b\xfc\x2f\xe9\xca\x29\x6b\x21\x2c\x82\xa1\x29\x09\xca\x50\xc6\x9c\xe7\xd9\x88\x8c\xec\x32\xb2\x91\x1a\xd2\x26\xe5\x6b\x82\xf3\x35\xd7\x3f\x5a\x8c\x60\xda\x7a\x63\x25\xc8\x59\xe2\x2b\xc7\xe4\x5b\xf6\x08\x53\xc8\x50\xba\xa1\xb7\x4c\x71\xaa\x38\xf6\x85\xda\xbd\x81\x29\x27\xb0\x81\xd3\x78\x2c\x02\xd3\xd2\xc5\x6d\xed\xb9\x63\xf9\xe5\x1b\xa4\x8d\xd2\x42\xf9\xb1\xea\xcb\x8f\x7b\xd3\xdf\xab\x4c\x5e\xc4\x37\x0d\x81\x0f\x67\x79\xb1\x38\x82\x53\xc6\x7d\x37\xb9\x31\x18\x71\x7f\xea\x75\xcd\x3b\x41\xe5\x41\x10\xfb\xbd\xf9\xfa\x12\x48\xbf\x92\x7e\xee\xd2\xb3\x52\xe2\xf8\x3a\xd0\x55\xb2\x45\x75\x56\xa3\xdc\x90\xdd\x51\xaa\xa5\x5f\x57\x11\xf3\x83\xc1\xde\xb9\x1a\x6d\x0c\xc4\xb4\x96\xed\xec\x6c\x98\xed\x40\x15\xe7\x66\xdb\x1f\x6e\x55\x8a\x19\x88\x6d\xeb\x95\x58\xbe\x23\x50\xbe\x43\x6b\xae\xe1\xbb\xd2\x0f\x2b\x58\xdd\x27\x84\x71\x65\xf0\x80\x58\x35\xe3\x35\xcd\xa2\x3f\x7f\x53\xf0\x52\x6e\xe2\x2f\x7d\x13\x7f\x4e\xf4\x47\xa1\x69\x22\xa6\x4c\x17\x8b\x98\x32\x5d\x2c\xf2\x51\xe8\x3b\xf2\xd7\xc4\xc0\xe2\xb4\x41\xf4\x1d\x62\x46\x30\x15\x57\x24\xb1\x35\x15\xf8\x66\xeb\xd5\xb6\x06\x9a\xdb\x65\x78\xff\x1a\xf2\xad\x3e\xcb\xb7\x82\x12\xfe\xc9\x9d\xd5\x3a\x79\xa6\xe0\x9e\xfb\x1e\x36\xba\xcf\x3f\x4f\x82\xfd\x90\x2a\x43\x55\xbc\x1e\x82\xf1\x88\x13\xcc\x30\x0f\x07\x05\xe2\x21\xd2\x65\xda\x12\x8b\xf2\x42\x28\x3d\x9f\xd5\xc5\xc8\xdc\x35\xa1\xb1\xb3\xa9\x7f\xc2\xa9\x77\xc0\x74\x0c\x89\x60\x19\x70\x38\x7d\x42\xd0\x6e\x06\xed\x9a\x62\x19\xcf\x10\xb4\x6e\xb3\x14\x49\x55\x96\xa4\x46\xfa\x52\x9e\x59\x61\x29\x84\x1e\x0c\x6c\x14\xc5\xd6\x12\x4b\xbe\x0e\x82\x9d\xa8\xa6\x20\xf7\x3b\x93\xd9\xf4\xde\x61\x54\x2e\x8f\x9c\xb7\x8e\x17\x01\x1e\x08\xc8\xe2\xc8\xfd\xce\xce\x42\xf0\x84\x53\xcf\x50\x71\x8e\x87\x4c\xf0\xeb\xe4\xe1\x0d\x47\xef\x1c\x44\x3f\x19\x62\x7b\x0a\xfa\x00\x60\x74\x92\x69\xb1\xcd\x8f\xa4\x4c\x05\x9a\x3c\x76\xe7\xca\xd5\xbc\xd4\x08\x62\x0c\x45\x6f\xf9\x37\x39\x4d\x43\x79\x62\x93\xe2\x80\x3c\x4b\x98\x32\x4c\xfa\x34\x90\x3e\x3e\xd2\x67\x81\xf4\x1e\x90\x6e\x24\x21\xd1\x94\x72\xf8\x33\xc8\x78\xc4\xc3\x9f\xbe\xa1\x2a\xe2\x7f\x2b\x97\x12\xff\x37\xad\xf0\xf8\x84\xf9\x6f\x1e\x5f\x2e\xe8\x80\x9b\x00\x0d\x81\xd3\xbe\x9c\x57\x6b\x0c\xa6\x38\x43\x0c\x2f\xc8\x6e\x83\x76\x20\xb2\xaa\x98\xaf\x4f\x94\x89\xaa\x61\x57\xc5\xa9\xf9\x60\xb9\x5a\x58\x7b\x79\x88\x85\x60\xbb\x0a\x57\x85\xe2\xd7\xf4\x70\x01\x92\xce\x55\x3f\xfd\xb7\x67\x5e\x64\xc4\x44\x46\xb0\x46\x26\x99\x20\x0d\x4c\x9e\xd4\x60\x71\x72\x56\xac\x7c\xdf\x46\x28\x09\xbc\xf8\xc3\xcb\x13\x66\x55\x95\xc2\x3d\xf8\xb6\xd4\x4c\x7c\x69\x1a\x39\x97\x2d\x68\x4d\xa9\x7d\xd8\xaa\x4b\xc4\x75\xde\xd9\xf5\x7b\x35\xdc\x4e\xd0\x13\x58\x89\xf2\x67\x0c\x0a\x36\x6a\xca\x8b\xde\x50\x56\x47\x50\x16\xe7\xa1\xf8\x5d\xfe\xa1\xe6\x88\xcf\xbb\xaf\xce\xe0\x74\x0c\xc5\x8d\x94\xaa\xe9\x37\xd9\x13\xa0\xc7\x16\x26\x4e\xb5\x36\xff\x66\xd3\xd1\x69\x1f\x1c\x55\x35\x30\xb1\x89\xd4\x6c\x14\x48\xa9\x1a\x98\xda\x84\x7a\x1e\xa0\x56\x81\x5a\xd5\xc0\xcc\x26\x57\x13\xf8\x4e\xc5\x0c\x59\x96\xb6\x7c\x37\x00\xde\xd9\x3e\x1e\x55\x20\x4e\x03\x88\xfa\x20\xce\x02\x88\xfa\x20\xce\x03\x88\x1a\x20\xf2\x8f\xbd\x9f\x27\xd0\x45\xcc\xc4\x41\xb8\x69\xe2\x68\xdf\xd3\xa9\xe7\x9e\xaa\xfd\xfc\x64\x1f\xa8\xd9\xb0\x81\x52\xfb\xf9\x2f\xfb\x38\xcf\x03\xce\x12\x9c\xd5\x7e\xfe\x8f\x7d\x9a\x26\xfb\x2e\x78\xda\x03\x88\x32\xe7\xeb\xd4\x7b\x5f\x15\x7f\x7f\xb2\x8f\x15\xfc\xa8\xdd\x37\xac\x14\x7f\xef\x22\x54\x06\xac\x3f\xc1\x5a\xf1\xf7\x2e\xe2\x25\x78\x6f\xae\xc1\x55\x6d\x23\x2d\xda\x47\xdb\xf7\x78\x3a\x3a\x8f\x67\xa3\xf3\x78\x3e\x3a\x8f\x27\xfb\x0e\x5d\xde\x77\xe3\xf3\x74\x84\x3e\xcf\x46\xe8\xf3\x7c\x8c\x3e\x2f\xbb\x73\x79\x79\x62\xb8\x0b\x97\x69\x77\x2e\xd3\xb7\x6d\xc6\xba\xf0\x79\xdf\x21\xcf\xfb\xae\x88\xde\x77\xc8\xf4\xbe\x2b\xaa\xdb\x15\x26\xd1\x70\xfc\x62\xa3\x69\x02\x5c\xd0\x68\x54\xe5\x51\x9e\x30\xbb\x61\xce\xe5\xea\x23\xcb\x83\xeb\x69\xd2\xee\x2d\x98\x4e\xd7\x72\x07\x68\xee\x9d\xc3\xf9\x3b\xa1\xac\x48\x23\x03\xe2\x68\x24\xb3\x52\xfc\x6e\x38\xc3\x14\x33\x4d\x3e\xe0\x2f\xd9\xdb\x47\x25\x3e\x92\x14\x89\xca\xf6\xc9\xba\xe4\xc4\x76\x93\x11\x6b\x6f\x02\x74\x90\xd0\xda\x04\x95\x0c\x0c\x54\xe7\x41\x65\x41\xd2\x0d\x3a\x86\x88\xe2\x7f\x44\x29\x99\x0a\xe1\xc4\x5c\x38\xa9\x21\x1a\x62\x89\x4e\x2c\xb9\xc4\xe6\x2e\x40\xd4\xa8\x6d\xe3\xb6\x08\x8f\x38\xe8\x51\x92\xbe\x5b\x21\xc5\x75\x74\x1f\x34\x98\x3f\x30\xe5\xb6\x20\xb6\x41\xd5\x1f\xaa\x1b\xf2\xb5\xc6\x59\xf4\xe3\xf8\xb6\x4f\x23\xfd\x51\xae\xf6\x69\xf4\xd1\x18\x45\x26\xa2\x04\x6d\x76\xab\x9a\x91\x0c\xaf\xec\xf9\xb9\x40\xf9\x22\x83\x12\x03\xbe\xa2\x08\x43\x7c\x15\x98\x90\x55\xdf\xd7\x72\xf5\xcb\x6e\x97\x9c\xaa\x67\xb7\xfa\x9e\x5a\x1b\x66\x35\xc2\xef\x79\xe9\x32\xfd\x61\x4a\x57\x6c\x13\xe3\x1c\x87\x76\x8a\x37\x8b\x94\xac\x53\x1c\xdf\x9f\x18\x3e\x58\x26\x46\x7d\x2b\x25\xbc\x31\x8f\x11\x43\x2e\x60\x20\x7f\x77\xb2\x31\x35\x71\xfd\xe6\x16\xb7\xb2\xb8\xd9\x02\x6f\xb6\xd9\xe9\x8f\xe5\xff\x70\xa4\xd8\xbc\x44\xbe\x8f\x54\x48\x3d\x87\x54\x32\xdb\xf0\xc3\x33\x05\xc3\x27\x5b\xa2\xbc\xd2\x2d\x0f\x0b\x10\x15\x81\x12\x78\x2f\xac\x70\xf9\x90\x61\xd4\x2d\x97\xee\x59\xbc\x01\x80\x77\xbd\xfd\x11\xb5\x25\x75\x8a\x41\x45\x31\xba\xd6\xa7\x85\xdb\x83\x69\x7e\xc9\x35\x1c\x2c\xfd\x3a\xf4\xbf\xe2\xe3\xb9\x78\x3a\xde\x00\xab\xa9\x19\x2b\xcd\x72\x35\x2d\x9f\xfc\x45\x65\x39\x0a\xb1\x22\x39\xad\x7e\x03\x9f\x56\x35\xee\x28\x74\xea\xef\x82\x94\x0c\x35\xf2\xef\x39\xa8\xf5\x8e\x49\xad\x41\xab\xf5\x77\x26\x3b\xe5\x1a\xfe\xc3\xd7\x9d\x78\x77\xb2\x22\x47\x1c\xff\x2c\x50\x3a\x97\x88\xa0\xe3\x52\xf3\xec\xd1\x6f\x09\x87\xc8\x79\x8e\x9c\x46\x7a\x08\x0f\x41\xb0\x21\xfc\xf6\x2f\xfc\x1a\x11\x6f\x88\xe1\x97\x18\x7e\xd3\x14\x71\x81\x37\x3e\x75\xc3\x34\xd4\xd1\xb0\xaf\xa1\x2a\x5f\x1f\xe1\x27\x13\xcb\x25\x70\xc3\xd2\x2a\x5b\x5a\x87\x22\x35\xf0\xca\xd8\x81\xea\xc2\x99\x24\x9c\x49\x84\x67\x92\x5b\x31\xcf\x1e\xbd\x55\x70\x88\x9b\xe7\xb8\x69\x64\x57\x37\x14\xbd\x86\xe0\xdb\xab\xe0\x6b\x44\xbb\x21\x82\x5f\x22\x78\xb3\x57\xbb\xf2\xb1\xa4\x9a\x08\x2a\x25\xfb\xe5\xc5\xfa\xc3\x69\xa4\x71\x1a\xb9\x72\x01\x6c\x33\xe4\xfc\xb6\x64\x08\xcb\xea\x30\xd6\xb2\xc9\x23\x68\xde\x86\xbd\x17\x6c\xef\xd5\x4b\x91\x3c\x6f\x76\xdb\xac\xfa\x9a\xfd\x2d\x56\xd2\x87\x0b\x8f\xa1\x61\x5c\xa0\x86\x37\x94\xc6\x09\x7e\x3d\xed\x40\x86\x9a\x1e\xad\x62\xeb\xc0\xff\x97\xa4\xb3\xe9\xef\xc5\x78\x47\xcb\x00\xff\xea\xa5\xfc\xa1\x1b\xd8\xf3\xfd\xcd\x68\x41\x6f\x3e\x86\x96\xa5\x8c\x68\x01\x5f\x1c\xa3\x1e\x33\xf4\xcf\x97\x2c\x43\x27\x68\xe6\x95\xc0\x51\x59\x1e\xaf\x5a\xf5\x55\x9e\xc6\xca\xfd\x15\xc9\x28\xd3\xb3\x7a\xbb\x96\xdc\x81\x6a\xc5\xfe\xe7\x03\x2b\x55\xd6\x4d\x46\x36\x52\x43\x66\x08\xfa\x9a\xe0\xbc\x23\xa4\x17\x14\x99\x01\xb3\xf5\x5b\x3c\xc1\xd0\x0a\xcc\xee\x8a\x61\x56\xc3\x21\x69\x4c\x22\x4c\xfd\x66\xb7\xbc\x78\x5c\x20\xfa\x0e\xa4\x55\xf8\xa8\x41\x5a\xa3\x58\xfd\x40\x4d\xd2\x03\xce\x6c\xfa\xfb\x82\x58\x7e\xa5\xa1\xaf\xe4\x5d\x69\xc8\xca\x50\x1f\xf2\x1e\x2c\xaf\x68\x99\x60\xfd\x71\xc2\x8f\x6c\xfe\xed\xa3\x05\x23\x82\x3f\xe4\x6a\x3d\xe5\x6f\xa6\xf9\x19\xd4\xa5\xa5\xdd\xc8\x13\x66\xde\xb1\xef\x12\x6b\x1e\x65\x9b\x6f\x1f\xae\x20\xb7\xcf\xd5\xb5\x85\x77\x2f\xb3\x74\xc5\x98\x86\x07\xe4\x7a\x0f\xc8\x5f\xc8\x11\x27\xaf\x19\x4a\x69\x3e\x09\xaf\x52\xed\x17\xaa\x62\x4d\x72\xe0\xb9\xc4\x6e\xd5\x57\xec\x6e\xb5\xd7\x11\x7a\x7d\x0b\x92\xd5\xe2\x2d\x14\x60\x67\xd3\x77\xd5\x73\x10\x5d\x47\xc1\x27\xcc\x06\x22\x47\xef\xd6\xec\x81\x28\xd4\xbb\x75\x3b\xaf\x20\x78\x45\x53\x1f\x4c\x45\x1c\x3e\xf2\x98\x73\xd8\x85\xbf\x07\x77\x0e\x77\x1c\x9a\x5e\xb6\xc9\x69\xbd\x4d\xff\x28\x3e\xed\x02\xbd\x16\x51\xb4\x42\x11\x53\xea\xbf\x2a\xb5\xb2\x24\xc8\x81\xbb\x0f\xf9\x45\x90\x37\x3e\xef\x53\xc2\xa8\xae\x91\xe2\x6e\xcb\x0e\x72\xd9\xf6\xb0\x7d\x27\xe9\xba\x2c\x58\xa9\x3f\x41\x64\xb7\xdd\x5a\x23\xfd\x81\x28\xc3\x59\x79\x3d\x48\xf5\x3f\x84\xb4\xc8\x44\x92\x24\xdb\x94\xd6\xce\x0d\xdb\xa8\x15\x00\x7e\xe2\x68\x9b\xc6\x28\x3b\x15\x97\x04\xb3\x25\x10\x83\x9b\x72\x04\xe7\x92\x2f\x31\xc8\xff\xa6\x85\x75\x86\x71\xaa\x69\x63\x69\xab\x8d\x5c\x1d\x30\xc5\xf0\x5f\x0b\xdd\x37\x23\xb6\x5a\x3a\xb9\x36\x5e\xe8\x47\xee\x78\xbb\x5f\x26\xd7\xa1\xb6\xa2\x58\x68\x03\x4c\xb2\xd0\x8a\x2b\x9a\xe3\x76\x34\xd7\xc6\x6c\x90\xe8\xff\xb3\x77\x6d\x4d\x8d\xe3\x58\xf8\xaf\x6c\xf1\xb6\xd4\xbc\x34\x50\xf3\xd8\x55\x84\x0e\x4c\x6a\x09\xc3\x10\x1a\x66\xf6\x65\x4a\xb1\x95\xa0\x6d\x47\xce\x58\x72\x20\xfd\xeb\xb7\x64\x2b\x17\x3b\xb2\x22\x59\x17\xdb\xc1\xef\xf0\xf9\x9c\xef\x3b\xba\xe4\x48\x3a\xe7\xa8\xc5\xba\xfb\x30\xd1\x0a\xa1\xa9\xb3\x08\x42\x5b\x66\x11\x88\x2f\x95\x67\xf5\x54\x2e\x9a\xec\x53\x64\xdd\xdc\x18\xc2\x86\x12\x23\x6c\x2c\x30\xc2\x4d\xc9\x8b\xea\xc9\x8b\x70\x33\xe2\xea\xee\x47\x8a\xf5\xf8\x6b\xc9\x2b\x82\xd0\x16\x58\x04\xe2\x4b\x62\x52\x4f\xe2\xa2\xc9\x3e\x45\x4e\x75\x77\x5c\xc5\x0a\x50\xb5\x54\x16\x41\x68\xab\x2c\x02\xf1\xa5\x72\x3a\xad\x27\x73\xd1\x66\xaf\x32\x23\xf3\x1f\x17\xba\x2a\x23\x6c\x3c\x94\x05\x18\xde\x34\xae\x39\x5b\x17\x4c\xf6\x2a\xb1\xf6\xef\x47\xd1\x44\xa9\x2b\xb2\x08\x43\x5f\x66\x11\x8a\x37\xa1\x6b\xce\xd9\x25\xa3\x3d\x4a\x5d\xaf\x01\xcd\xe1\x50\xb2\x70\xdf\x51\xf1\xa6\x81\xf4\x8a\x4a\x6b\x9b\xc7\xe0\x09\x5c\x80\xe5\x5b\x9c\x68\xa7\x66\x44\x6e\x5a\x2b\x55\x47\xb6\x56\x39\xf1\x3b\x2f\xba\xd8\x98\xeb\x0a\xd3\xa9\x7d\x02\x8e\x64\xc3\x58\xb9\xc5\x2d\x21\x9a\x7c\x14\x1c\x28\xd9\xef\x44\xbf\xad\xa1\xdb\x23\x82\x14\xfd\x7a\xb5\xf2\x60\xb6\xe2\x9c\x51\xf3\xb4\xa1\x74\x65\xdb\xfd\x71\xc3\x1d\xa4\xa7\xc2\xe5\xb1\x23\x3b\xef\xdc\xbe\x02\xb4\x23\xb7\x21\x3a\x05\x38\x38\x5d\x0c\xb2\xb7\x90\x03\x90\x24\x08\x26\x44\x01\x4f\x61\xc2\xca\xdf\x57\x12\x73\xe3\xf8\xa3\x32\xbb\xd6\xf1\x47\x64\x35\xd0\x0a\x01\xc9\xd1\x48\x12\xdc\x83\x75\x9c\x3a\xaa\xa2\x3a\x41\x73\x0c\xa2\x3e\x74\x4e\x31\x74\x42\x42\x5d\x86\x4e\xfe\xd8\x6e\x1b\x3a\xb7\x61\x43\xc1\x63\xfa\x6c\x68\x0b\xe4\xf9\xb5\xdc\x96\x39\xab\x0f\xe6\x1a\xa1\xd0\xeb\x73\xb7\x22\x6f\x76\x5e\xbc\x35\xc2\xda\xe1\xef\x2f\xd9\xee\xc3\x88\xb9\xef\x04\x4e\xde\x40\x08\x93\xc7\xc4\x56\x73\x16\x25\x1f\x37\xaf\xaf\x97\xf9\x67\xab\x7e\x89\xf1\xfd\x51\xfe\x47\x4e\x08\xb8\x0e\x28\x5a\x41\x1d\xef\x9b\x34\x5d\xf6\xa9\x4d\xe9\xf7\x46\xf4\x2c\x2f\x3b\xc1\x1b\xd8\xd5\xac\xe0\x41\xcc\xf2\x0f\x78\x6e\x8b\x0a\x81\x8a\xfa\x9e\x97\xb4\xfc\xd7\x12\x2d\x61\x84\xf0\x91\x6a\x00\x9b\xbf\x3a\x4e\x4b\x6b\xc2\x7c\x80\x70\xc8\x89\x79\xe4\xd6\x37\x48\x90\xae\x2b\xda\x61\xbf\xf2\x16\xf7\xd2\x7c\x8c\xe2\x13\x4e\xd9\xd3\xd0\xe2\x40\x2a\x8c\x24\x37\xbb\xb7\x3c\x2d\x55\x8a\x15\x5f\xc9\xa9\xaa\x68\xd3\xc9\x5a\x6d\xfe\xc9\x0d\x3d\x77\x10\xb7\x95\x9b\x46\x59\xa1\x25\x56\x46\x78\x16\xdf\xc7\x73\x25\x6e\x2a\x5d\xab\x70\xa9\xe8\xd1\x71\xea\xe4\xdc\x4f\xd3\xd9\x44\x29\x41\x9f\x07\xe1\x97\xb3\x2a\x38\xce\x74\x04\xf1\x5c\xe5\x29\x68\x0e\xc7\xbf\x7e\xf6\xb5\x62\xc4\x6f\xde\xaa\x66\x6c\x7a\xd2\x0e\xad\x5a\x26\x9b\xbb\x84\x9c\x68\xa4\x58\xcd\xc6\x29\x64\xbc\x4b\xec\x37\xc8\xbd\x51\x1c\x7d\x27\x9b\x55\x63\x42\xc1\x1c\x92\x06\xfd\xd8\xe1\xef\x60\xa6\x88\xce\x10\x8c\xc2\x12\x14\xc9\x6c\x3d\x0e\x54\x32\xb4\xb9\x9d\xdc\x0b\x88\x50\x08\x28\x3c\x91\xa8\xb9\x65\x67\x27\x79\xf2\xed\x16\xd2\xe0\x8d\xe7\xcd\x24\xee\x18\x7d\x8e\xed\x83\x47\x0b\x30\x87\x3c\x4d\xa7\x44\x9b\x8d\xd6\x6e\x1b\xea\x79\x26\x4f\xce\x38\xff\xa3\xe3\xa8\x3b\x94\x43\x90\xac\xd8\x98\x0e\x84\x78\x86\x8a\xc0\x1a\x6a\x5e\x56\x10\xd8\xc2\x40\x0c\x67\x7c\x10\x04\x90\x10\x1d\x90\x43\x3b\xe4\xaf\xfe\x8d\x22\x2b\x2f\x2e\x76\x3c\x7c\x35\xe6\xa6\xe9\x91\x1c\xb2\x91\xbd\x13\x0a\x71\x80\xa2\x9b\x08\x82\xe4\x19\xcc\x35\x2d\x16\x6d\xa4\x48\x8e\xf8\x0c\xe6\x03\xcd\x57\x2b\x82\x21\x40\x8a\xe6\x39\xa1\x80\x67\x0d\xf2\x2f\xdd\x82\x40\x37\x5f\xa8\xd9\x24\xd4\xc8\x56\x56\x0c\x33\x9d\x66\x53\x57\x9b\x4a\x61\x5a\x98\x76\x0e\x21\x3e\xe4\x95\x00\x55\xe3\xd1\x67\x49\x96\x0d\xd9\xf9\xd3\xd3\xf5\x12\x9e\x49\x61\xd5\x92\x0b\xa5\xcd\x6b\x6e\xcb\x2f\xec\x7f\x7f\xc9\x7c\xfb\x25\xaf\x79\xfd\xef\x33\x41\xc6\x78\xc9\x0c\x21\xae\x43\xf1\xa2\x0f\xc5\x83\x50\x3c\x04\x59\x37\x14\xcf\x76\x4a\x4d\xf7\xa3\x42\x75\x54\x64\x43\xa2\x4d\x2d\xc9\x6a\x0f\x89\x8d\x75\xa3\x42\x8d\xac\x33\xa5\x7a\x5a\xaa\xc1\x21\x8f\xd3\x86\x82\xdd\x4a\x5d\xf5\x43\xda\xa7\x71\x12\xc2\x44\x9d\xf7\x6c\x19\x51\x21\xbd\x5f\x9f\xa4\xeb\xd3\x49\x0c\xc6\x36\xae\x4f\x87\x20\x3f\xf5\x41\x4e\x6d\xdc\xf7\xe3\x53\x69\x7c\xee\xa5\x7c\xf8\x80\xbb\x67\xe9\x01\xad\x91\xba\x8f\xe1\x64\xb4\x0a\x40\x00\xa5\x20\x78\x63\x45\x63\x4f\x22\xdd\xa3\x99\xa5\x31\x9d\x92\x07\x99\x58\x4d\x4f\xc7\x3e\x36\x36\xf6\x3a\x82\x98\x72\xbe\xbd\x4d\x3d\x42\xab\xb6\x30\xcf\xa1\xb7\xa6\xb1\x7b\x5a\x67\x36\x8e\x7a\xf8\x24\x26\x8c\x6f\x27\x47\x3b\xd5\x6c\xa7\x9f\x90\xee\xd4\x2f\xdf\x77\x90\xd6\x09\x70\xab\xdc\xe6\x36\x14\xe8\xb5\x42\x6d\xe3\x87\x96\x52\xa6\x55\x83\xfb\x24\xa8\xf6\x1c\xd5\x59\x36\x3e\x7b\x7b\x38\x3a\xfd\x92\x0f\x6a\x08\x20\x5a\xbe\x01\xd7\x64\x7f\x8e\x67\xdb\x8a\x10\x56\x19\x3f\x72\xba\x38\x8f\xae\x93\xcd\x59\x2c\x79\x82\x04\x85\x10\x53\x4d\x2d\x44\xbf\xdd\xb0\xfa\x36\x6d\xb3\x45\xd7\xb9\x63\xc6\xff\x87\x28\x7c\xe5\xe0\x76\x56\x99\x08\x0e\x99\xe4\xce\x4b\x5a\x2c\x18\x05\x3b\x3b\xf8\xe6\x3c\x2b\xd1\xbb\x99\x78\x5d\x6c\x4d\x4a\xc4\x7f\x15\x53\x5d\x60\xda\x09\x27\xf9\x55\x47\xee\xa1\xe7\x6b\x7c\x2e\xa2\xce\x88\x8c\x3b\x88\x5b\xc2\x84\x57\x0e\x2a\x87\xe5\x86\x97\x11\xe1\xb4\x28\xb1\x52\x76\xaa\xc2\x99\x82\x2f\x4e\xe4\x7c\x4c\x50\x9c\x20\x8a\x7e\x9e\x50\x7c\xef\x7d\xa5\x02\xb5\xd4\xc6\x85\xe3\x2e\x39\x17\x90\x38\xa1\x9a\x13\xfc\xc0\xd2\x6b\x91\x26\xcb\x82\x69\xd4\x59\x0d\xcc\x5d\x3f\xcd\x36\xdd\x20\x30\xe9\x93\xb8\x31\x90\xdd\xdf\x0d\x5b\x7a\x56\x65\x49\xb2\x8b\x5e\x32\x6f\x92\xd9\x39\x66\xb0\x24\x7c\x9b\x8e\xb0\x7a\xe1\x55\x84\xb7\x72\xbe\x64\x14\x3d\x77\x90\xfe\x91\xc2\x64\xfd\xfb\xf4\x7f\x30\xa0\xca\x95\x34\x36\x6b\xf7\x3f\xec\x7f\xcf\xbe\x8a\xd7\x6a\x2e\x8a\xd6\x6f\x5d\x77\xf9\x99\x06\x6a\x95\xec\x31\x9b\x9e\x32\xb5\xde\x6b\x95\x0c\xe0\x1c\xe1\x6d\xdb\x86\x5b\x08\xc3\x29\x08\x74\x1b\xf9\x89\xf8\x48\xd0\x02\xb1\x9b\x9e\x63\x57\x3b\xab\x21\x0e\x75\xec\x36\x63\x09\x61\xde\xa8\xfe\x09\xe0\x39\xd4\x5a\x1c\x06\x7b\x07\xa6\xd7\x4f\x83\x33\x29\x6d\xfa\xcb\x83\xf9\xed\x78\x37\x4d\xf4\x11\xa6\x87\x2d\xf4\x6b\x5e\x57\xd0\xeb\xc5\x6f\x49\x69\x8d\x4e\x0c\xbd\xd4\xba\x52\x5b\xd2\x68\x00\x48\x3f\x18\x4b\x83\xd1\x88\xda\x83\x19\xf5\x05\x24\x6b\xf6\xec\x59\x89\x65\x93\xf7\x59\xaa\x1b\x38\xeb\xcf\xbb\xf7\xfb\xed\xae\xb8\xb7\x3a\x46\x0a\x02\x27\x9f\x2d\x9d\xad\x7c\xec\x08\xb4\x42\xa7\x46\x64\xaa\x1f\xf5\x72\xa9\xdb\xf6\x48\x58\x08\xaa\x56\x67\xb4\x0a\xb2\x10\x3c\xe7\xfa\xf7\xcc\xd4\x5e\x32\xcb\xf6\xa6\x46\x91\x98\x35\x5c\xe7\x4d\xbd\x35\x43\x4f\x10\x33\x4e\x4c\xfc\xd4\x45\x65\x1d\xcd\xa4\xbe\xaa\xd4\x6a\xf6\xf4\xdf\x2c\xf2\x8f\x9b\xdf\x1e\xc7\xef\x74\xca\xf2\xbe\x22\x35\x0e\x43\x42\xb1\xad\xbf\x81\x14\x46\x14\x0e\xc3\x39\xbc\x8d\xc0\xbc\xde\x08\x68\x63\xf0\x55\x1e\x1e\x79\x08\x48\xd6\xe7\x30\x87\x5f\x69\x32\x69\x3d\x65\x90\xd9\x75\xee\x3e\x41\x30\x62\x2b\x79\xbd\xe8\xe9\x67\x3f\xa3\xd9\x2f\x3f\xdb\xea\xa9\x6f\x80\xfa\x67\xf8\x71\x13\xc7\x49\xd8\xef\x1b\x3a\xb9\x6f\xc8\x3b\x23\xf6\xe2\x75\x58\xbc\x6b\x4a\x13\x34\xbd\xff\x62\xa1\x8e\xad\xf6\x6f\x51\x61\x33\xbc\x0f\xf7\xce\x5e\xb4\xdf\x59\x65\x84\xb5\x7b\xba\x2e\x3f\x03\x5d\xca\x08\x3f\xdd\x13\x7e\xd5\x13\xae\x42\xb8\x32\xc2\xbb\x7b\xc9\xbe\xa8\xf6\xd6\xb4\xa1\x59\xe9\xf7\x59\xc9\x67\xa7\x3d\x84\x0a\x4e\x5f\x7c\x46\xa7\x2f\x3f\xa3\xd3\x57\x9f\xd1\xe9\x7a\xfb\x5a\x03\xc7\xb7\x18\xdd\xdf\x1b\xfb\xdd\xca\xde\x41\x5a\x50\xce\x67\xb4\xca\x28\xb7\x74\x59\x45\x3c\x02\x5c\x5f\x56\xc9\x19\xcd\x2b\x4f\x4b\xe8\x34\xfa\xd0\x10\x87\x3e\x3e\x33\x40\xca\xdf\x51\x8d\x8e\xca\x7b\x4c\xba\x86\xca\xbe\xc2\x22\xbb\xa0\x83\x85\xe3\xe1\x84\x5d\xac\xb1\x65\x7c\x81\xe5\xfc\xc5\x49\x5b\x79\x2e\x98\x9a\x9b\xf7\xfb\xf2\x8b\xa6\x85\x82\x31\x1e\x2f\xab\x2c\x54\x74\x51\xe9\x4d\x80\x14\x01\x24\xf3\x2f\x4e\x69\xba\x38\x79\x9a\xd4\x21\x2e\x9c\x32\x7d\xd9\x33\x7d\x9c\x69\x75\x88\x4b\x37\x62\xbd\xa3\x9f\x3f\x6d\xb4\x2d\x32\x67\x1a\x61\x1d\x00\x51\xb4\xa4\xf4\x4f\x73\x88\xbf\xcc\x21\xfe\x6b\x0e\xf1\xea\x44\xed\xd7\x04\x51\x38\x06\xe4\x47\xaf\xf7\xa7\xd0\x7b\x84\x09\x4c\xe8\x4d\xbc\x58\xc6\x58\xff\xba\x8d\x13\xd5\x49\x12\x18\x22\x64\x12\x38\x20\x6b\xf8\x41\x13\x10\xf4\x6c\x55\xb2\x25\xfb\x4c\xb6\xbd\x9f\xac\x17\xd3\x38\xd2\xdd\xd8\x0b\x06\x44\x08\x28\xd0\xfd\x8d\x2f\x80\x21\xf9\x2b\x4a\x0b\x48\xd2\x5f\x1a\x8a\x4a\x04\x9b\xc0\x22\xb6\x04\x29\x84\xef\x04\xd2\x11\x5e\x81\x04\x01\x4c\x5d\xfe\x58\x51\x64\x4c\x91\xf4\xc3\xe4\x0a\x08\x43\x37\x99\x95\x09\xa4\xf7\x71\x00\xa2\x1b\xf6\xc9\x9e\x24\x31\x49\x2f\x79\x00\x4d\x57\x9e\xd8\x29\x25\x48\xa7\x6b\x7a\x90\x1c\x72\xed\x2b\x69\xc8\x57\xf2\x16\x27\xd4\xb7\xb3\xa8\x21\x67\x11\xf6\xee\xea\xac\x21\x57\x67\x51\x0c\xbc\x3b\x1b\x36\xe4\xac\x38\x9f\xeb\xda\xdb\xb4\xa9\xf9\x29\x6d\x62\x82\x4a\x9b\x9a\xa1\xd2\x46\xa6\xa8\xb4\xa9\x39\xaa\x00\xe3\xc9\x59\x7b\x07\x73\x2a\x0e\x5b\xd8\x8b\xc8\x6c\x30\x38\x52\x73\xc6\xf3\x10\x83\x69\x04\x39\xdb\x37\x11\x82\x98\x4e\x28\xa0\xd0\x25\xe3\x46\x06\x7f\x43\xa4\xfd\x16\xcb\xbe\x92\x9f\x51\xdd\xb3\xca\x0b\xdb\x1a\x90\x9a\xb6\x0b\xc2\x33\xd2\xad\xe4\x20\xc0\x58\x01\x49\xd1\x3d\xfb\x14\x8c\x01\x85\x09\x02\x91\x45\x16\x64\x6d\x8b\xda\x49\xc2\x33\xfc\x60\xad\x58\xed\x51\x90\x62\x64\x1a\x07\x01\xbb\xa7\xdd\x39\x1a\x59\xb9\x98\xef\x18\xd1\x76\x71\xe9\x99\x07\x8b\xce\xbb\xb1\xbc\xba\x3c\x1d\x9f\xd1\xf3\x05\x29\x74\x39\x9b\x2b\x12\x10\x80\xa5\x2d\xf7\x0b\x2b\x18\xbb\xc2\x92\xfb\x3a\xc8\xc9\x58\xb5\xc0\x59\xa9\xda\x22\x8c\x8a\xa7\x62\x2c\x35\xe9\x98\x35\x56\x20\x6d\x0e\x93\x4e\xb2\xb6\x6f\x83\x3f\xc6\x6e\xd9\x4f\xee\x4e\xf2\x25\x4c\x16\x78\x60\x8c\xff\xee\xe8\x12\x67\x85\xd7\x8b\x2e\x29\xda\xa6\xd1\xfb\xe9\x4b\x6b\xfa\xda\xf2\xd6\x4f\x60\xca\x13\xd8\x96\xb3\x7e\x0a\x53\x9e\xc2\x0a\xa7\x38\xfd\x18\xd5\x1a\xa3\x05\xee\xfa\x71\xaa\x3c\x4e\x0b\xbc\xf5\x63\x55\x6d\xac\xe6\xf7\x67\x5f\xb3\xe6\x65\x33\x4d\xba\x8a\x96\xf2\xc7\x4f\xee\xea\xb8\x16\x4c\x55\x94\xb6\x94\x3e\x16\x72\xeb\xc9\xe4\x7a\x69\xe4\xfe\x6d\xc6\xd1\xb7\x19\x95\x73\xf5\x46\x85\xeb\xe0\x9f\x14\x25\xf0\x3f\x70\x0d\xc3\x71\x4a\xe1\xc7\x2b\xc2\x97\x17\xe6\x73\xc3\x22\x6b\x53\xaf\x40\x41\x55\x1d\xd0\xdc\xc0\x1f\x50\xeb\xd5\xa2\xc0\x10\x8a\x16\x30\x4e\xad\x85\xf0\x51\x42\x9f\x60\x04\x01\xe9\x22\xa1\x46\xa3\xf9\x15\xe1\x30\x7e\x7f\x82\x01\x05\x78\x1e\x69\x77\x01\x10\x0c\x44\xdd\x3a\x45\x76\x9f\xfe\x6f\x61\x11\x3e\x98\x14\xa7\xf1\x87\x2d\x0e\x77\xd6\xaf\x71\x50\x32\x7e\x1e\x8d\x16\xcb\x38\xa1\x93\x35\x0e\xcc\xe9\x84\x1f\x79\x79\xeb\xbf\xc9\x1a\x07\x7f\xeb\xce\x72\xc2\x7a\x97\x05\x48\x1d\xb4\x29\xa2\x33\x04\xa3\xb0\x84\x37\x8b\xc0\x9c\xd8\x62\xb6\x10\x9d\x59\x1b\xcf\x67\x98\x2c\x10\x06\x34\x4e\xee\x9e\x86\xe3\xe1\xb7\xbf\xaa\x18\x35\xfa\xd4\x84\x26\x08\xcf\xc7\x20\xf9\x01\x8f\x7d\x47\x35\x8a\x23\x88\x6b\xad\x25\xec\x3c\x13\xcf\x9d\x10\x9a\x75\x3d\xde\x56\x64\xdc\x66\xb5\xd1\x6f\x8f\x3a\xce\x5a\x29\x40\x5a\xff\xb1\xdf\x57\xe1\x20\xe7\x10\x40\x52\x11\xd2\x05\x75\xb3\x8e\x52\x27\xda\xe8\x7a\x27\x0f\xad\xba\xc5\xde\xf1\x35\xc6\x65\x79\xb3\x8a\x00\xec\x36\x87\xc2\x9f\x2f\x0e\x59\x64\xc9\xaf\x93\x08\xc6\x63\x4f\x9e\xfd\x06\x67\x35\xad\xb3\xd3\xa2\xd5\x77\xbc\x8e\xd3\x88\x22\x56\x9f\x79\x57\x61\x74\x34\x18\x1f\x27\x54\xb6\x37\x2e\xb0\xc3\x5d\x50\xdb\xb6\x6b\xee\xb8\x15\x2b\x8e\xca\x50\x8b\x1b\xab\x73\xad\xdf\x07\x5f\x2b\x50\xb8\x68\x09\x5a\x68\x03\xed\x3b\xb9\x23\x4e\x9e\x38\xb0\xa7\x3f\xaf\x5f\xdc\xd6\x08\xb0\xa3\x95\x68\x02\x58\x2f\xeb\x99\x96\x51\x59\xa8\x9b\x8e\x70\x88\x24\x4d\x34\x4f\x2b\x78\x6e\xa3\x94\xbc\xb1\x7b\x87\x28\xf8\x06\x28\x50\x0a\x1b\xb9\x10\xd2\x99\xd8\x5a\xf5\xeb\x7b\x44\xa8\xa6\xb1\xad\x48\x2a\x0a\x8c\x90\x8a\x5b\xf1\x33\x50\x2d\xa7\xa8\x66\xc0\x92\x26\x0e\x03\x6c\x02\x83\x18\x87\x20\x59\xf7\xea\x75\x50\xbd\x52\xc5\x6d\x3b\xba\xe9\x51\x56\x99\x97\xed\x10\x8d\xb7\xf1\x7c\xbf\x04\x6f\x0d\x1a\xfb\xe0\xd5\x0f\xde\xfd\x6a\xdf\x3d\xe5\x5e\x28\x2f\x94\xf9\xee\x39\xf7\xc2\x79\xa9\xbe\x77\x0d\xd6\xfb\xb5\xb5\x89\xb5\xb5\x50\xd8\xbb\x57\xad\x23\xaa\x0d\x22\x88\xc3\xdb\x14\x07\x13\xc8\x2c\xa1\x70\xf4\x70\xf7\xa4\x23\x9b\x80\x74\x32\x03\x01\x8d\x93\xa7\xbb\x81\x8e\xe7\x02\xa0\xd0\x16\x10\xb7\xe8\x3a\x5a\xbe\x01\x43\xa8\x50\x01\xca\x48\x91\xeb\xe5\x32\x5a\x67\xe7\x80\x79\xab\xb2\x6b\x4a\x41\xf0\xb6\x80\x98\xde\x8c\xaf\xaf\x47\x0f\xcf\xc3\xfb\x2a\x7d\x8c\xbe\xcb\x4e\x6f\xf9\xeb\x17\xe9\x47\x0e\xfb\xe2\x6d\x5a\xdb\x4b\xaf\x05\xf0\x3f\x72\x42\xd9\x77\xbc\x00\x4b\x6e\xfb\xc5\xb7\x06\xac\xdf\xa1\xee\x50\x0e\x41\xb2\xce\xd0\x56\x09\x38\xe7\xc8\xf3\x68\xdc\x79\x02\x34\xce\xdf\x41\x10\x40\x42\xea\xda\x72\xae\x39\xb1\x8b\x67\x03\x8e\x12\x81\xb5\xc5\x4b\x3b\xd5\x0b\xea\x4a\x51\x54\xb1\xcb\xbe\xd7\x53\xfd\xb5\xd0\xde\x36\x5d\x9f\xa9\xce\xf8\xb9\x9f\x73\xea\x03\x42\x12\x10\xa5\xdf\x10\x3d\x57\x12\xae\xb2\x2e\xe3\x8f\x30\x99\x65\x7d\xdc\xb5\x99\x12\xac\x15\x59\x4f\xf7\xdf\x00\x0e\x23\xe8\xc4\xe2\x9b\x04\x02\x0a\xed\x9b\x3c\xd2\xba\x26\x5e\x40\x39\xf7\xe3\x79\x5e\x2e\xb9\x53\x62\x0d\x71\xd8\x29\x7b\xef\x20\xbd\x65\x87\xb8\x3b\xa3\x43\x33\xb3\xcf\x95\x22\xcc\xd4\xe6\x07\xf8\x61\xcf\x64\x25\x8b\x55\x1d\xc7\xf0\x83\xfe\x21\x87\x32\x75\x9e\x39\x7e\xc3\x4e\x41\x61\x32\xc2\xb3\xb8\x0d\xce\xef\x1d\x05\xc3\xc4\x16\xce\x03\x58\xc0\x7b\xc5\x46\xc9\xf2\xae\xc3\x7b\x80\x76\x4c\xfb\x06\x49\x60\xd5\x34\x06\x58\xdb\xb4\x22\xd4\xef\xd2\x76\xf7\x9a\x60\xec\x58\x59\xad\xf3\xb5\x12\x1c\x6b\x83\x3b\x94\xd4\x0e\xad\x61\x5d\x5d\xc8\x5f\xaf\xca\xa0\x09\x78\xe7\xc3\x6a\x0c\x3e\x5e\x64\x6f\xa0\x6c\x8c\xe0\x6c\x92\x60\xf4\x7a\x5a\x26\x14\x91\xa4\x97\xbd\x55\x6f\x4f\x84\xea\x51\xb3\x7f\x31\x5a\xf6\x62\xec\xa8\xf9\x1c\x83\x55\x52\x23\xac\x20\x35\x85\xd8\xb9\x7c\xa3\x70\xb0\x66\xb3\x94\xb6\x84\xa2\x99\x20\xd3\xd0\x68\x8a\x3a\x57\x9a\xcc\xed\x79\xdf\xaa\xc5\x67\x4b\x9f\x9d\x79\xd9\x9a\x1a\x1a\x63\x41\x8a\x83\x63\x3e\x39\x11\x73\xa4\x51\x56\xaa\x36\x80\xc6\x50\x01\x58\x12\x56\xf8\xdd\x49\xac\xed\x65\x85\x77\x97\x78\xc7\xc3\xc9\xf5\xf1\x78\xdb\xf4\x5f\xdf\x83\x78\xce\xae\x36\x9d\xc9\x7f\xb2\x7a\xbc\x88\xba\xc3\xd8\xe7\xd5\xf5\x45\x7d\xb6\xdf\x17\xd1\xba\x3a\x19\x5e\xdb\x75\x6f\xfa\x09\xb2\xf5\x71\x90\x31\x45\x64\x1c\x1b\x7d\x25\x7f\xe5\xf7\x18\x93\x8b\x50\x4d\xc7\xaf\x55\x95\x56\x9d\x37\xb5\xb4\xe5\xa7\x62\xc0\xf2\xf4\x52\x95\xb1\x4e\x5b\xe5\xed\xac\x9d\xe9\xaa\x52\xbc\x85\x5e\x43\x14\x11\x80\x6b\x4d\x66\x35\x35\x29\xda\xea\x49\x12\xa4\x2b\x09\xc2\x46\x82\x20\xec\x5b\x0e\x54\x53\x0e\x84\xbd\x8b\x41\x14\x2d\xad\x28\x72\x5e\x43\x0e\x11\x80\x6b\x41\x48\x4d\x41\x8a\xb6\xfa\x91\xe4\xb2\xf5\x0b\x89\x79\x77\x64\x5b\x4c\xd5\x94\xb5\x64\xac\x27\x5d\x5b\xbe\x14\xa9\x02\xb8\x16\xb5\x4b\x6b\xd9\x65\x8b\xd7\x32\xb5\x7f\x77\xad\x66\x77\x96\xc2\xcb\x96\x2f\x85\xaa\x00\xae\x15\xed\xd2\x5a\x7a\xf5\x09\xd6\x52\x65\x84\x77\xd7\x5c\xd7\x0c\x8c\x92\xb1\x9e\x22\xe3\xd4\x57\x63\x55\x00\xd7\x51\xd1\xa5\xe5\xfc\xea\x74\x97\x73\xb5\x7f\x77\x1d\x0c\xdd\xd9\x0d\x5c\x9d\xfa\x6e\x40\x15\xc0\x75\x48\x74\x60\x3b\x91\x5d\x5c\xbb\x89\x71\x88\x28\x8a\x31\x88\x9e\x20\x0e\x61\xf2\xf0\xf2\xa7\x82\xd5\xe2\x33\x9c\x63\xd5\x42\x8d\xcc\x1d\xe2\x50\xc7\x58\xa3\x6f\x7d\x5f\x46\x31\x08\xef\x96\x29\x3b\x8a\xd2\x64\xa4\xe2\xce\xf5\xc2\xd5\xa1\x56\x56\xef\x23\x00\x84\xbe\x20\xf8\xce\xca\xc8\x65\x35\x3f\x56\xe6\x3a\xce\x97\x69\x95\xc1\x8a\x08\x8a\xd5\x3c\xe4\x47\xff\x8a\x45\x13\x64\x65\x26\x3c\x2e\xba\x07\x6a\x3c\xc6\x24\x0b\xd9\xd7\x49\x00\x22\xd8\x06\x55\x10\x7b\x07\xab\x83\x51\xa4\x8f\x2f\x01\x41\x0c\x67\x33\x53\x94\xb5\x14\xc5\x8e\x10\x93\x00\x11\x12\x27\xfd\xa8\xc8\x46\xc5\xff\xd9\xbb\x92\xe5\xc6\x71\x24\xfa\x2b\x1d\x3e\xcd\xcc\xa9\xab\xca\x31\xb7\xe9\x88\x92\x5c\x76\x3b\xa6\xbc\x8c\xe5\x72\x2d\x37\x88\x84\x24\xb4\x29\x52\x0d\x80\x5a\xfc\xf5\x13\x00\x28\x89\xa2\x20\x08\x20\x00\x2e\x26\xef\x52\x32\xf3\xbd\xcc\xc4\x9e\x99\x8b\x0a\x14\xfb\x8b\x09\x95\xff\x4d\xa3\xcf\xac\x34\xe5\x30\x59\x6c\xc4\x31\xe6\x28\x1d\xb3\x6b\x4b\x86\xdc\xc8\x72\xc5\x0a\x20\x3a\x82\x73\xb0\x98\x25\x18\x0e\xf5\x20\x2a\x26\x8b\x54\x82\xcb\x81\x5c\xee\x49\xe5\xe4\x1e\x5f\x11\x9b\xc0\x38\x80\xfc\x6a\x98\xae\x58\xb9\xa2\xd9\x85\x33\xc8\x47\x2d\x07\x4f\x84\x56\xac\x03\x7f\x36\x00\x3a\x50\x4a\x10\x6d\x29\x88\xeb\x64\x2e\x49\x5a\xd2\x14\x43\x10\x9a\xdf\x6b\x94\x8a\xe2\x6a\x99\xcb\xe2\xfe\x7b\x2c\xcd\xf4\x9d\x84\x2c\x0c\x08\x9a\xc6\x20\xf2\x11\x08\x05\xc9\x4e\x43\x41\xc8\x3e\x1f\x0b\x9e\x72\x11\xaf\x38\xd9\xe5\x54\xc4\x24\x3b\xcb\x44\x04\x07\x6e\x12\x51\x48\xa8\x9b\x34\x44\x70\x60\x7a\xfb\x4e\x72\x67\x88\xe0\xe0\xd9\xf8\xee\x91\x54\x99\xaf\xa6\x8f\x39\xa5\x52\x7e\x58\x4b\xf8\x69\x2d\xe1\x97\x25\x33\x21\xa1\x0e\x98\x09\x09\x75\xc1\x4c\x48\xa8\x03\x66\x42\x42\x2d\x99\x09\x09\xb5\x64\x26\x24\xf4\x97\xf5\x48\x82\x83\xef\x28\xa4\x33\x07\x72\xfe\x54\x76\x98\x30\x10\x74\x05\x17\x74\xd6\x0f\x91\x2e\x87\xc8\x83\x95\xd3\xd7\x9b\xc7\x6f\x2c\x1e\x43\x9b\xd9\xf9\x89\x6c\x3e\xd5\x4d\xe5\xdb\xc7\xf5\xe2\x5a\xe9\xc5\x1f\x72\xc8\xb3\x1b\xfa\x6e\xa6\x84\x49\xc5\x53\xb8\xfc\x3b\xd5\xf3\xcf\x15\xac\x29\xb5\x9c\xe3\x48\x70\x27\x49\x8a\x03\xe8\x68\x98\x87\x84\xa2\x18\xb0\x9d\x92\x7e\xb8\xf7\x38\xdc\xaf\xfb\xe1\xbe\x1f\xee\x4f\x0e\xf7\x2b\x17\x63\xfd\xcc\xc9\x40\x1f\xaa\x46\x79\xeb\x64\xc8\x3a\xca\xe1\x28\x09\x54\x9b\xfb\xa6\x1f\x51\xf9\xfc\xee\xd1\x3d\x4e\xa6\x18\x12\x72\xcd\xb6\x7d\x1c\x7e\xfb\xc0\xc0\xd1\xe1\x0c\x24\x45\xff\xbe\x54\x7c\x4a\x33\x6a\xc5\xd4\xc3\x30\xd9\xcb\x88\xe5\x3b\x5e\x0f\xe3\xbf\x60\x40\x5d\xce\xba\xea\xdc\x9d\xb3\x62\xeb\x7b\x7e\x41\xef\x86\x2b\xb6\x92\xef\x99\x72\xce\xd4\x30\x42\x30\xa6\xb6\x7c\xf5\x48\x9f\x47\x9a\x17\x9a\x7b\x4e\x86\xc9\x12\x62\x30\x85\x57\x88\xce\x20\x1e\x26\x31\xc5\x49\x74\xff\x62\x82\xb6\x64\xe6\xa0\x2a\x5b\x6f\xa5\x35\x3f\xfb\xcb\x75\x5d\x88\x43\x84\x61\x40\x07\x88\x15\xdb\x20\xc4\xab\xe2\xca\x75\x05\xca\x34\xd1\xf8\xb3\xd2\x4d\xc3\xed\x83\x76\x5b\x41\xe6\xb5\xc3\xf2\xee\x9d\x9d\x55\xf1\xf2\x5e\x03\xbe\xf6\x53\x2a\xe5\x86\xd3\x5d\x27\x85\x9a\x58\x75\x5c\x18\xa9\x77\x0c\x57\x8e\x21\x0f\x76\x8e\x46\x1f\xf1\xbb\x88\x9f\x83\xf5\x55\x9f\x3d\x8e\xb2\x47\xa5\x6e\xd2\x8d\x14\xd2\x19\x4f\x3b\x35\x4f\xdb\x3a\xdf\x0d\xa4\x59\x49\x53\x51\xee\xeb\xfe\xa5\xda\x9a\xa6\xfe\xec\x19\x81\xf9\x22\x82\xb8\x1e\xb3\x8e\xa5\x12\xa1\x8e\x5a\x6a\xf6\x23\x57\x60\x1d\xa6\x19\xf0\x0a\x0f\xa8\x66\x45\x02\x42\x68\x9a\x59\xa4\xb8\xcf\xfc\x95\x8a\x3b\x52\xfb\x3e\x89\x1b\xab\xb9\xfa\x5b\xfb\x7e\x76\xfa\x4e\x79\xa8\x7e\x59\x27\xdc\x4b\x71\x51\x2f\x58\xda\xeb\x3a\x02\x1b\x88\x61\x68\xa9\x0b\x13\x62\x39\x48\xb1\x56\xa0\x80\x7a\xf3\xc5\x1c\x7f\x95\xb9\xa1\xa6\xe5\xea\x8a\xcd\x2e\x2d\x6f\x72\x0c\x1e\xe8\xfd\x2d\x46\xcc\x1f\x84\xd6\x62\xdf\xcc\x44\x5d\x89\x87\x26\x01\x3f\x7f\x3a\xa5\xad\xb6\xd1\x4b\x6f\x95\xde\x8e\x6c\x5e\xd6\x61\xb4\xa7\x5b\xda\x05\x2c\xff\xe5\x1b\xcc\x47\x9c\x4c\x31\x98\x97\xf2\xa3\xed\xd0\xbf\x10\x32\xd4\x43\x7f\xf6\xa3\xf3\xe8\xb8\x26\xaa\x62\xef\x3c\x05\xe8\xf2\xfd\x20\x5a\xbd\xeb\x3b\x4b\xfa\x67\x06\xf9\x69\x74\x4b\xda\x31\x87\xd4\x30\xa4\x8e\x91\xdc\x2a\x74\x78\x1b\x99\x7d\xf1\x34\x33\x65\xcb\x97\x36\x53\x85\x87\xbf\x3c\xc1\x8d\x1d\x00\x8c\x11\xc4\xa7\x2d\xb5\xfa\xc4\xa9\xd7\x37\x26\xb0\x4a\xf2\x4e\x37\x9e\xce\x88\xa3\x6a\xd6\x05\x16\x1a\x6e\x6f\xcb\xd2\xa3\x46\x7e\x15\xaf\x54\xe2\x53\x39\x3f\x4b\x87\x84\x2b\xe4\xc5\x62\x51\x17\xbe\x0e\x8b\x25\x03\x81\x5f\xbb\x35\xb2\x27\xc7\xc1\x0c\x86\x03\xbd\x73\x6a\x7b\x61\x8b\xeb\x37\x04\x0b\xb6\x40\xf6\xab\xa6\x66\xb6\x55\xed\xbf\x96\xe6\x47\xa2\x2b\xdf\x65\x18\x8a\x30\xfe\x13\x82\x10\x62\x33\xf3\x25\xaa\xd3\xe4\x15\xc6\xb7\x57\x26\x00\xc8\x40\x44\x6f\x1e\x00\x90\xbd\xdb\xe6\x10\x8c\x28\x98\x42\xde\xce\xd3\xda\x7e\x32\x63\x30\xaa\x76\xbf\xad\x5c\x95\xed\xfd\x67\x84\x11\x6b\x5d\x59\x0b\x71\x44\xd1\x12\xde\x19\xee\xf7\x4b\x18\xd3\xbd\x33\xab\x9a\xb5\x16\x6e\xd1\x16\x0e\x04\x88\x9d\xf0\xc3\xc4\xba\x4d\x85\xe8\x0d\x6a\xc8\x55\x5a\xae\x9c\xae\x3b\x23\xfb\x73\x18\x62\x48\x2a\xe7\x5c\x85\x68\x61\x4e\xdb\xd3\x95\xa3\xab\xcc\xd0\xdf\xb5\xa8\x52\xc9\x3d\xc0\x42\x6b\xde\x52\x5e\xee\x64\x9c\xb4\xc9\xa9\x0c\x32\x41\x2b\xa3\xf7\x9c\xd2\x1d\x77\x0b\xe1\x16\x62\x49\x95\x39\x06\x6b\xee\x6b\x98\x6b\x6c\x97\x19\x4a\xe4\x22\xa6\x8f\x17\xb3\xc5\xba\xaa\x3e\xb3\x35\xdc\xc6\xa9\xf1\x1a\x8b\xab\x1c\x18\x26\xdb\xa2\x21\x22\x8b\x08\x6c\x7e\x63\xea\x9e\xa2\x73\x6f\x90\x17\x32\x99\xdb\x1e\xa7\x37\x71\x29\xb6\x7a\x53\xf6\x72\x95\x22\x08\x9c\xb2\xd6\xbf\xa5\xd2\xcd\x41\x0b\xc1\x3e\xd3\xb6\x24\xd3\xee\xe3\x6b\x24\xb8\x27\x4d\x77\x4e\xe2\x0b\x88\x05\x8a\xf2\xc9\xb7\x7a\x1c\xec\x0c\x00\x51\xd4\x5e\xed\x4f\x55\x57\x3b\x6f\x44\xc9\xe2\x6a\x9a\x0b\x4a\x97\x7b\x55\x1a\xf5\xd9\x4e\x99\x6b\xf5\xa9\x51\x3a\x5e\xa0\x35\x8c\x1e\x31\x0c\x10\x41\x49\x3c\x40\x80\x58\x43\xbb\x1e\x23\x4a\x4c\xd0\x95\xc8\xd8\xa8\x64\x58\xd9\x3c\x4c\x62\x02\xf1\x12\xb0\xbd\x80\x27\x40\x68\xae\xd7\xce\xc4\x7e\x97\xc1\xf4\xb8\x48\x76\x22\xe1\xef\xc0\x48\x61\x7b\x23\x8e\xca\xfc\x75\x57\x3a\x7e\xb4\x6d\x62\x6d\xff\x2c\x5a\xf7\x59\x74\xd0\x3f\x8b\xee\x9f\x45\x77\xfb\x59\x34\x2f\xab\xf2\x04\xe2\x29\x0c\xcd\xb2\x8c\xb4\x2a\xfb\xdb\x3d\x04\xd8\xc4\x58\xb9\x94\x6b\x80\xbd\x18\x3b\x8c\x20\xc0\xdc\x62\x17\xc6\x7a\x26\x65\x90\xa4\x71\x48\x9c\xb0\x32\x47\x1a\xdb\x27\x67\x85\x80\xb5\x1f\x63\x31\x58\x65\x57\x90\x4c\x26\xfb\xd9\x55\xe9\x0b\x37\xf7\xa9\x5d\x5e\xea\xdf\x4b\x55\xcf\x9c\xd6\xbf\xdb\x4a\xd8\x58\x4b\x58\x7f\xb0\xd6\xc1\x5a\xc2\x9b\xad\x00\x62\x0d\x03\xb5\x96\x40\xac\x61\xa0\x1f\xbc\x85\xd7\xcb\x2b\x9f\x4d\x9a\xa5\x92\xc2\xd1\x43\x36\xdb\x17\x92\x4c\x6c\xed\x03\xa8\x0f\xa0\x96\x05\xd0\xee\x33\x2f\xdf\xbe\xfe\xf7\xf3\xfd\xe3\xd3\xc3\xf0\xfe\xa5\xf0\x35\x7e\x25\xe5\xe5\xf5\x11\x27\x01\x3b\xeb\xd3\x8a\xad\xc2\x2e\xab\xac\x5f\xb4\x6a\x59\x6a\x95\x07\x58\x01\x8b\x97\xd7\x5d\x09\x0b\x27\xb9\x60\x27\xcd\x8b\xc6\xa2\xa0\x4d\x3b\x75\xce\x2a\xfd\x38\xd0\x97\x4b\xf2\xa2\xeb\x1d\x58\x64\xc5\x35\x1e\x13\xa4\xb9\x61\xae\x5c\x85\x9a\xb7\x67\x76\x71\xd9\xb6\xfc\xb3\x66\xe5\x92\x2b\x35\x7f\x05\x2c\x13\xb3\x74\xf1\x98\x38\x4d\x70\x08\xb1\x9d\x8c\xa5\xb1\x0c\xf9\x69\xe6\x02\x04\xaf\x30\x2c\xf5\x68\x7c\xc1\xdd\xcc\x97\x2f\xef\x37\x07\x97\x35\x78\xb2\xc5\x16\x63\x61\x54\xc8\x53\xe7\xbf\xb1\x78\x1e\xb8\x49\xbb\x81\x3b\x9c\x13\xf8\x87\xee\x06\xd2\x3e\x85\x36\x33\x85\x5a\xa6\xae\x8a\x92\x96\x70\xa0\xb6\xe6\x2d\x71\x75\x62\xf8\x70\xf7\x38\xba\xfd\xf5\xe5\x1f\xfc\x40\xe5\x9f\x17\x7f\xd4\x94\xc7\x0a\x58\x4e\xde\x17\x96\xf5\xa4\xb6\xcf\x94\x62\x34\xae\xd7\x3f\x3d\xa5\xb7\x16\xfb\x78\x81\x95\x49\xcf\x4a\x91\x95\xaa\xa3\xe5\xcb\x12\xb0\x09\x68\x1d\xa3\xbf\x44\x88\xb7\xab\x1e\x2c\x29\xb0\xca\x56\x62\x8f\xdc\x81\xdf\x69\xd2\xed\xc2\xed\x4a\xb9\xcc\x12\x44\x5e\x80\x14\x85\x8c\x58\x45\x7c\xfe\x9a\xc9\xf4\xe4\xcd\x45\xf0\x55\xdd\xae\xf0\x19\xae\xc5\x55\x28\xf1\x02\x49\xc7\xe0\xdf\xa6\x38\x49\x17\xff\xb9\xc8\xce\x86\xc4\xf9\xf6\x85\xd2\xa1\x74\xe3\x67\x7b\xda\x83\x73\x2a\x5d\x28\x01\xce\xff\xd2\x0b\x40\xe2\xce\x34\xdf\xec\x31\xcc\x23\x15\xdc\x96\xe6\xc5\x5f\x7d\x8d\x69\x71\x1d\x46\x57\x68\xee\x99\x45\x09\xab\xc0\x50\x72\xb7\xb0\x20\x69\xe2\x72\xa7\xf0\xac\xd6\xcf\x90\xd0\x06\xea\x5d\x70\x2e\xa1\x22\x5a\xfa\x55\xd2\xed\x78\x77\x6e\xac\x92\x78\xac\xc7\xc9\xcd\x35\x8a\x11\x99\x35\x9d\xe9\x11\xac\xc2\x19\x35\x79\x0e\xb6\xf7\x7f\xbd\x98\x7a\x8d\x01\xbf\x3f\xbe\x2d\x93\x3d\x4c\xa2\x04\x5b\xdb\x1d\x30\x29\x3e\xeb\x03\xb1\x5b\x6e\xe1\x6e\xd5\x72\x39\xb1\xd6\xd8\xec\x4e\xb4\x6c\xc0\x88\x60\x6c\xbe\xcb\x98\x8e\x37\x14\x16\xe3\xcf\x74\x22\x2b\x3d\x49\xb6\x15\xb0\xb1\x15\x60\x7d\x8e\xbc\xaa\xd2\x81\x96\x5d\xf4\xa0\x82\xa8\x43\x12\x5c\xb7\xf3\xd5\x60\x21\xec\x22\x09\xea\x8b\x72\x6b\x6b\x09\x1b\x6b\x09\x6f\xd6\x12\xaa\x0c\xe5\xb0\xe3\xa1\xcc\x43\xb9\xc0\x82\xd7\x58\xbe\x81\x54\xca\xc4\xa4\x93\x44\xa8\xb3\xa9\xc7\xa9\xf5\x29\x1a\xc2\x6e\xd3\x20\x8f\x04\x8f\x3c\x6c\x67\xd2\x77\x49\x98\x46\xbc\x38\xe4\x33\x18\x9b\xd6\x8d\x93\x61\x68\xb2\x23\x51\xdf\xc8\xce\xab\x4b\x79\x42\x60\x9c\x4e\x46\x5a\x7d\x31\xc5\xa2\x37\xfb\xf9\xc5\x1f\x75\xe0\x70\x0c\x82\x99\xfd\xd2\x95\xe0\x7c\x91\xc4\xde\x1e\xfb\xe6\xb7\x54\x47\x34\xe1\xca\xef\x77\xe8\xb7\xf6\xd4\x70\x2c\x21\x73\x85\x20\x53\x47\xec\x7e\x13\x7b\x71\x51\x82\xb5\x65\x6d\x37\x93\x79\x07\xc0\x18\x44\xd7\xbc\xa6\xf8\x85\xd2\x6c\x94\xfd\x56\x5d\x7f\xbc\x41\xaf\x89\xac\x7c\x29\x1b\x89\x5e\x78\x87\x94\xaf\xac\xe4\x58\x0d\x7e\x73\x3c\x90\x45\x4c\x13\x2f\x06\x5f\xb3\x49\x8f\x88\x9d\xec\x88\xe1\xcb\x8f\xe7\xf3\x16\x6f\x3d\x29\xff\x77\x87\x47\x13\x4a\x21\x80\x52\x10\xcc\x34\x2b\x5b\x78\x79\x27\xa3\xa4\x4a\xf5\x74\xd0\x31\x55\xd7\x20\xe8\xe9\xf2\x48\x97\xa6\x85\x13\xe0\x69\x3f\x57\x8c\x6c\x59\x4f\x68\xb3\x44\x54\xf5\xd1\x26\xef\xff\x17\x00\x42\x0b\x8d\xd3\xfb\xbe\xe9\x6d\xed\x9b\xbe\x63\x94\xbd\xc3\xb7\x60\x55\x02\x3e\x86\x20\x34\xec\xd1\x7a\xc2\x37\x56\x18\x51\xe8\xa6\x69\x3a\x53\x6a\x60\xec\x15\x12\x41\x5c\xa7\x81\x1b\xff\x62\x4a\x3d\x18\xfb\x98\x54\x14\x57\xeb\xa1\x0a\x7f\x75\xe7\x76\xae\xcb\x3f\xb8\x71\xba\x90\xd0\xbe\x4f\xbf\xc7\x3e\xfd\x7d\x41\x8a\xbe\x20\xc5\xe9\x82\x14\x04\x07\xdf\x5d\xac\x22\x09\x0e\xfe\x74\x52\x96\x82\xe0\xe0\xca\x5b\x11\x84\x5d\x3a\x1c\x44\x88\xe6\xa6\xef\x35\x64\x43\x79\x98\x18\x0a\x91\x2a\xf2\xe3\x77\x6b\x11\x3f\xed\x45\xfc\xf8\x60\x2d\xe2\xa7\xa5\x08\x16\xa3\xbf\x5b\x8b\xb0\xc5\x82\x69\x61\x6f\xc8\xcf\x0f\x0e\x86\x5a\xd5\x7a\x45\x33\x09\x4f\x50\x44\x21\xf6\x1b\x9c\xb9\xc0\x14\x9b\x71\x5f\xb3\xfe\x4a\xc4\xc1\x61\xd2\x74\x91\xea\xaf\x7d\x26\x7b\x4d\xd4\x0b\xa0\xdc\x0f\xcf\x4b\x57\x4a\x22\x14\x60\xeb\x24\xaa\x2c\x8a\xa9\x57\xae\xb3\xc2\x4d\xf2\x7d\x56\xf6\xda\xc9\x67\xf7\x19\xf6\x6c\x7d\xb4\x89\x03\x6b\x5f\x22\xfc\x35\xb9\x9b\x69\xf0\x0a\xa0\x73\xf3\x60\x37\xd6\xdf\x40\xfa\xbf\x14\xe2\xcd\xc3\xf8\x2f\x18\x50\x54\x71\x44\xfd\xcd\x3e\x7d\xe1\xf0\x9c\xb1\xfc\x9d\xc8\xbd\x0c\x14\x1f\x79\xba\xc7\xa3\xc1\x13\x4c\xa4\x1d\xa7\x42\x50\x91\x36\x82\x0b\xf3\x7e\x98\xef\x92\x8c\xc3\xea\x11\x35\x46\x46\x4f\x07\xa3\x23\xad\x9a\x8f\xec\xfc\x8e\x4d\xbf\xa2\xdd\x4d\x92\xdb\x4b\xfb\x4a\xa9\xe6\x47\x21\x2e\x1e\x28\x1d\x8b\xb0\xfc\xfb\xc6\xee\xef\x6f\x76\x7f\x5f\x55\xca\xf9\xb2\x5d\xa4\x17\x66\xb3\x79\x41\x35\xc5\x0d\x69\x1f\x88\xbe\xd7\x18\x0d\x60\xe5\xf6\x32\x6d\x6b\x3a\x93\xc8\xb0\xfd\xff\xc6\xf2\xff\x6f\x96\xff\xaf\x34\xa5\xa5\x6d\x0b\xc7\x42\xf4\xa4\x0d\x08\x1f\xd2\x42\x18\x7d\x67\xb5\x7a\x78\xf9\x12\x2f\xfb\x29\x5a\xa7\xa6\x68\x05\xc6\xdb\x9d\xcc\x6a\x8f\x99\x7e\x7a\xd6\x8c\xe9\xd9\xa1\x57\xf7\x93\xb3\x8e\x4c\xce\x8a\xb4\xb7\x3b\x9b\xa5\xb5\x87\x4e\x3f\x31\xab\x7d\x62\xb6\x7f\x8a\x55\x58\x79\xb4\x96\x97\x86\xe0\xd7\x5e\xc7\x4e\xeb\x42\xf0\x20\xbd\xf6\xfe\x67\xe8\x7f\x07\xe8\xf5\xde\xa7\xe9\x7d\x19\x78\xa3\x74\x8c\x93\x94\xa2\x18\xee\x30\x24\xf5\x60\xf8\xde\x86\x15\x09\xb2\xef\xd0\x39\xbd\x80\x28\x9e\xa4\x7d\x9c\x99\x81\x35\x03\xd1\xe4\xa8\x69\x83\x91\x95\x52\x09\x1b\xaf\x26\xea\x39\x44\xc1\xa7\x0b\x7a\x7a\xbd\xfe\x23\x14\xfd\xd4\x70\x2e\xb4\x25\xbc\x79\x05\xa9\x25\x6c\x5e\xbe\x7b\x36\xb5\x25\xac\xbc\xc2\xdc\x78\x7f\xb8\x67\x2f\x9d\x23\x27\xd1\x1d\xdb\x3b\x44\x6c\xef\x11\xf1\x9b\x57\x9c\x1a\x4f\x28\xaf\x0d\xe7\x84\x4f\xac\x55\x38\x5f\x2d\x63\x8a\x21\x8c\xad\xa5\x8c\xfd\xf5\x1b\xe6\x60\xb5\x83\xd4\xcb\xee\x90\xaa\x2d\x04\x44\x8b\x19\xf0\x09\x79\xe3\x5d\xe3\x19\xae\x87\x49\x82\xc3\x0f\x2e\xbc\x83\x78\x56\xb1\x35\x68\x7e\xf4\x8a\xa6\xb6\x04\xea\xd9\xc8\xd6\xf0\xf1\xa9\xe1\x7c\x68\x4b\xc0\x9e\x61\x6a\x0d\xa3\x97\xef\x9e\x51\x6d\x09\x7f\x7b\x06\xba\xf1\x3e\xc1\x2f\x96\x97\x1d\xc8\x9c\x6c\x91\x99\x79\x97\x4b\x63\x97\xd5\x59\xdb\x14\x86\x3f\xce\xaa\xb3\xb9\x24\xc3\xda\x12\x68\x15\x70\x75\xd0\x47\x3e\xcd\xaa\xb3\xb9\x36\x1f\xb1\x1f\x63\x5c\x02\xde\x41\x2f\xbb\x9c\x55\x67\x73\x8b\xbd\xac\xe6\x99\x4c\x81\xb2\x6e\xf8\xe9\x75\x32\xe5\xf6\x1a\x7a\xa8\x94\x96\x49\x32\xf5\xab\xa3\x1e\x25\x5a\x68\xfa\xd2\x75\x04\x59\x8f\x0e\x80\x37\xfd\x06\xa9\xc1\x06\x69\x11\x35\x87\x44\xfb\x09\x1b\x71\xf0\xf2\x9d\xd7\xba\x71\xc1\xf1\xca\x5f\xf9\xd5\x03\x55\x1d\x02\xeb\x5d\x65\xd1\x8e\xd2\x74\x9d\xe6\xe2\x16\x82\x94\xa2\x75\x05\xa6\x2e\x2b\xb3\xb5\xf6\xd8\x11\x26\x7f\x9c\x55\x66\x71\x49\x76\xed\x4f\x99\x1d\x82\xd5\x39\xff\xf8\x34\xab\xcc\xe2\xda\xfc\xc3\xfe\x16\x82\x43\xb8\x3b\xe7\x61\x97\xb3\xca\x2c\x6e\xb1\x87\x35\xe2\x9e\xcb\x96\xb0\xce\xf9\x28\xa9\x72\x66\xa0\xbc\x28\x6b\xd1\x77\xa4\x36\xf0\x3e\xf6\xe0\x95\x07\xef\x53\x0f\x5e\x79\xf0\x2e\x7b\xf0\x8c\xc0\xbb\x81\x74\xdb\x46\x45\xb4\x46\x11\x85\x1f\x2b\xbc\xcd\x5e\x7f\x37\x17\x62\xde\xc4\xc6\x45\x55\x29\xeb\x57\x11\x4d\xe9\x2a\x7c\x03\xe9\x1d\x9c\x27\xdb\xaa\x61\x57\x90\x35\x36\x81\xe1\x13\x24\x49\x8a\x03\x68\xfe\xe8\x44\x12\x93\x73\xfe\x81\xf3\xc8\xb8\xa5\xe8\x58\x8f\x09\xc2\x84\x36\x84\xe5\xb4\x5a\x9a\x9f\x20\x39\x24\x7a\xf7\xf8\xa5\x05\xec\x5a\x59\xfe\xcc\x87\x17\x10\xcc\x84\xf5\xf7\x2f\xfa\xad\x6a\xb2\x16\x37\x7e\xda\xd4\x38\x41\xb2\x50\xdc\x4e\xa7\x21\x88\x15\x98\x03\x5e\xac\xb7\x2c\x9e\x83\x5c\xd7\x9f\xcf\x4f\x83\x8e\x23\x2a\x10\xcd\x7c\xcc\x18\x52\xcf\x5d\x8a\x5a\x89\x25\x6b\x97\x10\x96\x74\x51\xaf\x7d\x7b\x5a\x89\xe6\x80\x9b\xf9\xc8\x1b\xdb\xcf\xe7\x88\xb2\x6e\x67\x77\x70\xde\xa0\x80\x97\xf6\x98\x51\x23\xe2\xa8\xbd\x4c\xb5\x24\xcf\xe1\xdc\xbc\x69\xce\x38\x49\x22\x08\xe2\x82\x28\xc6\xbe\xa7\x36\x7e\xcf\x70\x6d\xe5\x2c\x5e\x47\xdb\x63\x6e\x22\xb0\x81\xd8\x52\x84\x7d\x47\x94\xb5\xb9\xbb\x1e\x0b\xd9\xb8\x10\xf2\x66\x2e\xa4\xa6\xee\x9a\xba\x52\x42\xb8\x30\x53\xa5\xae\x34\xdd\x88\xf0\xcd\x0d\x9e\xe5\xc2\xd8\xeb\x08\xda\xa7\xfa\x86\xa5\x7a\x36\xeb\xb4\xf2\x13\x5f\x33\xd7\x3e\xd3\xf7\x99\xbe\xcf\xf4\xaa\x4c\x7f\x85\xc1\xea\x0e\x92\xd9\x33\x20\xaf\x44\x27\x64\x0f\x4d\xb6\xdd\x54\x93\xc8\x50\xee\xa9\xb9\xb3\xf5\x36\x0e\x11\x86\x81\x61\x3f\x6d\xe9\xd0\x83\x32\x51\x86\x4a\xff\x9f\xbd\xab\xd9\x6d\x1c\x57\xd6\xaf\x72\x91\x65\x6f\x66\xba\x33\x98\xd5\xdc\x01\xf2\xd3\x31\x82\xdb\x99\x0e\xe2\x74\xdf\x39\xab\x03\x5a\x2a\xdb\x44\x24\x4a\x87\xa4\xdc\xf6\x3c\xfd\x01\x29\xd9\x6d\x59\x94\x4c\x8a\xa4\x24\x2b\xda\x79\x61\x94\xaa\xbe\xaf\xaa\xf8\x57\x2c\xea\x29\x2d\x2b\x6b\xfb\xd2\x5c\x3b\xe6\x28\xfa\xa1\xb9\x17\xda\x2c\x88\x71\x8a\x43\xe8\x18\xc8\x3b\xa1\x79\x4f\x68\x2a\x05\x39\x83\x33\x46\xdb\x4b\xa0\x66\x06\xe4\x6b\x10\x44\x19\xc3\x09\x11\xaf\x96\x60\x30\x4c\x45\x6d\xcf\xed\x8a\xe9\x47\xfe\x74\xcc\xe1\x14\x4f\x9d\x9d\x8a\x7d\x79\x1c\xfa\xd9\x94\xbf\x87\x08\x38\x0c\x09\x86\x93\xc3\x4c\xcf\x60\x9c\x19\x02\x57\xd1\x23\x2b\x81\x63\xb4\x93\x67\xf5\x18\x8d\x15\xad\xb7\xb0\xc2\xe4\x12\x15\xff\x4c\x42\x5d\xb5\xad\xbe\x33\x03\x5e\xfe\x0e\xde\x74\x06\xd0\x4f\x71\x8d\x3b\x4c\x9a\xa7\x8d\x83\x3b\xcb\x2d\xe3\x9a\x8d\x16\xd8\xac\x5b\x64\x8b\xde\x81\xfb\xed\x91\xe2\xe8\x54\xf3\x65\xcd\x8e\x76\xc3\x15\x6c\x2d\xc4\x64\x8f\xac\x1e\x4d\x6b\x62\x14\xa2\x7e\x24\x34\x34\x96\xa3\x1a\x9c\x1c\xf4\x8e\x54\xbe\xb2\xd9\x3d\xfb\x8f\x78\xa2\xbf\x0f\xfa\x31\x19\x02\xf9\xd9\xc4\x7e\x2f\xec\x67\xde\xe9\x57\x7f\x6a\xef\x0f\x33\x20\xcf\x88\xaf\x1d\x4c\xd3\x29\x22\x2b\x70\xa5\x74\xc9\x67\xf3\x85\x45\x0b\x35\x15\x16\xa7\xc8\x7e\x97\xcf\xa9\xa5\x1a\xab\x06\x61\xb9\x57\xc3\xad\xd8\x11\xda\x89\x6d\x73\x44\xc2\x61\xd0\x43\xb2\x78\xaf\x8f\x86\xa8\xd3\x78\x5c\xec\x38\x9c\x06\x64\xa0\x2f\xee\xbc\x66\x09\x35\x13\xa4\x48\xa8\x81\xb8\xb3\xfd\xba\x4b\x41\x43\x4e\x6e\x9f\x64\xec\x60\x4d\x93\x0a\x0e\x7c\x21\xa1\x43\xf2\x84\xb1\xe3\x3d\xcf\x16\x83\x0a\xbf\x42\xf9\xb9\x9b\x37\xec\xa5\x2c\xf6\x9a\xe4\x63\xc0\x94\x1a\x2e\x3a\x35\x48\x57\x1d\x4c\x76\x90\xc6\x3a\x71\xd3\xf7\x41\x1e\xa7\x98\xac\x3a\x67\x4e\x01\x93\x9b\xab\x16\x11\x90\x15\x5f\xb7\x42\x3a\x3d\xc0\xe1\x0d\xed\x59\xb4\x4b\x1d\x4c\x77\xe5\x1d\x01\x31\x4a\xfc\xa5\xb5\x4f\x75\x06\x76\xc2\x5f\x75\x97\x72\x55\xd0\x96\x09\xe1\xa6\x5a\x2c\x30\x5f\x62\x88\x42\x85\x26\x73\xbe\x8b\xc0\xd6\x07\x48\x16\xe7\x40\x5b\x42\xc3\x5b\xc7\xec\x1a\xd1\x20\x09\xad\x6f\xfc\xac\x11\x09\x23\x78\xc2\x8c\x61\xb2\x32\x37\xa9\x26\x4e\x0f\xfb\x03\xaf\x10\xa7\x11\x32\x1b\x7f\xcb\xdb\x5a\xb9\x0f\x40\x3c\x0f\x50\x04\x7e\xa3\xe6\x45\x2c\xcf\xa6\xc8\x71\x17\x39\x75\xe8\x48\x3f\x1b\x4a\x08\xbe\xf3\x08\x10\x11\x90\x77\xf9\x71\xb3\x4f\x42\x81\x65\x11\x7f\x76\x30\xcd\x22\x59\x2c\xc4\xb4\x99\x70\x1f\xab\x55\xc4\x42\xda\x4e\x54\x99\x8a\x42\x56\xde\xc3\x88\x79\xe1\xe2\x2e\x49\x77\x4e\xf6\x6d\xda\x11\xa1\x10\xc4\x68\xd0\x24\xc5\xca\x5a\x79\x61\x36\x4d\x22\xe4\x6a\x9b\xce\x99\xd5\xc2\x5f\x6e\x1c\xc8\xb8\xb5\x0d\x7c\x8f\x0d\xb3\x5e\x29\x22\x4c\x4c\x8a\x2f\xca\xe1\x34\xf3\x3a\xdf\x1b\x67\xb4\x2e\xaa\xa1\xe2\xc3\x89\xcc\xef\x28\xca\x80\x79\x21\xe5\xf9\x78\xf4\xd0\x3b\x5c\xf1\xbd\x6e\xd2\x3c\xac\x3e\x85\x10\x93\x0a\x80\x1b\xe4\xab\x17\x60\x19\xb6\x4b\x42\x4d\x89\x57\xa7\x70\xe9\x9d\xdf\x0f\x05\x2f\x9d\x40\xed\x0a\xb8\x8b\xf4\xb3\x32\x62\x5d\x00\x76\x8f\xd8\xfa\x86\x52\xa4\x55\x00\xe6\x14\x2f\xd5\xc4\x32\x44\x6c\x7d\xa7\x77\x02\xab\xe5\x6c\xe1\xde\x3a\x6f\xf8\xcd\x39\x90\x00\x47\x0f\x19\x09\xcc\x10\x54\xb8\xcb\x32\x23\x81\x86\xe1\x07\x11\x55\x0e\x28\x2c\x4d\x04\x28\x58\x8c\x11\x7b\xf3\x0d\xd6\xbd\xb8\xf6\x96\xdf\x17\x35\xc3\x4c\x15\x1e\x4b\x14\xf0\xc4\xe8\xf2\x8c\x4a\x4a\x46\xb0\xa7\x55\xc3\xde\x41\x70\x14\x39\x99\xcb\x39\x48\x4b\x4b\x1c\x45\x4f\x49\x08\x26\x62\xba\x74\x95\x02\xb2\x39\xa7\xc9\x1b\xf4\x02\x5a\x55\x02\x85\x25\x50\x20\xc1\xd0\x41\xdb\xfb\xd9\x23\x61\x1c\x91\x00\x42\x33\xec\x2c\x57\xfb\x4d\x5e\x97\x16\x1b\x6f\xad\x0f\x43\x52\x53\x25\x6a\x1c\xe1\x16\x31\xb0\xb4\xa5\x83\x08\x1a\xf9\x5a\xaa\x12\xe3\x93\xc3\x3a\x73\xd8\x7e\xb2\x97\x26\xaa\x97\xea\xb0\xc2\xa3\xee\x92\x0d\x50\x39\x7b\xf1\x3e\xdf\xb3\xd2\x55\xea\x39\xa4\x19\x47\x20\x14\x6a\x4a\x98\xf6\xe6\xf6\x38\x5b\xe8\xc7\xe0\x69\xa4\xef\x62\xa4\x3f\x4b\xe4\xc8\xd3\xde\x49\x6c\x4d\xce\x36\x39\x9b\x3f\x67\x9b\x01\xbf\xdc\x3d\xf6\x16\x37\xed\xfc\x6d\xed\x9d\x22\xb9\x1c\x13\x92\x4a\xff\xf4\x8e\x65\x6f\x15\xb9\xad\x8a\x54\xdd\x58\xdc\x4b\x61\xa7\x92\x5e\x8f\x95\x90\x85\xb5\xfd\xed\x84\xf7\xb0\x75\x5d\xd8\xfc\x04\x9c\xe2\xc0\x90\xe2\x9a\x3a\xa4\x58\xca\x92\x97\xc9\x9f\x0c\x97\x89\xd3\xf4\xa0\x3a\x3d\x30\xef\x76\xa1\xed\x5a\x39\x53\xcc\xbf\x63\xb5\x28\xdb\x73\xef\x5c\x2e\x0b\x01\x5d\x39\xea\x65\x93\x3b\x4f\x51\x60\x5c\x37\x5e\x13\x9b\x5f\x30\xe3\xa6\x33\xde\x29\x5d\x54\xd3\x45\xd9\x17\x72\x39\x28\xdc\x88\xb5\x5a\x63\x75\xa2\xae\xac\x37\xa0\x04\x93\x95\xb1\x2c\x07\xab\x93\x66\x7f\xa7\xc0\x33\x4a\x20\x2c\x9c\xd2\x95\xdf\xeb\xdc\xe1\x4c\x30\xe1\x8f\xa4\xb7\x7d\x36\x07\xfb\xb3\x2a\xa6\xb7\xb6\x02\x9c\xcd\x58\xf4\x39\xe8\x71\xf3\xef\x42\x20\x54\x7d\xe5\x90\xd0\xbf\xc8\x7b\x33\x9d\x43\xa7\x1e\x05\x11\xe5\x73\x58\x89\x7e\x9d\xb6\xb2\x48\x16\x17\x92\x98\x73\x3c\xeb\x5c\x52\x3a\xe4\x4d\x94\x90\xd5\x33\x7a\x9f\x90\xea\xfa\x78\x88\xf3\xad\x44\xeb\x11\x60\x6b\x2d\x61\x67\x2d\x81\x8b\x99\x2e\xe1\x7f\xbb\x12\xf4\x2f\x57\x0e\x5b\x9a\xbf\x3d\x21\x4e\xf1\xf6\x4b\x82\xc2\xeb\xed\x27\xc3\x0a\x39\xc5\x40\x1e\x4b\x71\x9a\xb3\x37\x9d\x3d\xc6\xd8\xbb\xd5\xd7\xef\xd0\x6a\x59\x4b\x9e\x26\x0c\xde\x9f\xf9\xa2\xbd\xe6\xfb\x73\xf5\xdc\xea\xf7\xc8\xf5\xbb\x74\xf5\xa2\x94\xe6\x75\x0d\x64\x70\x07\xff\xc3\x29\x94\x3a\x7b\xb6\xe6\x94\x83\x1e\x17\x24\x83\xae\xfe\xe9\x96\x85\xa9\x44\xa2\x8b\x12\x89\x0b\x8a\x71\x4f\x3b\x54\x3a\xb9\xbf\x8b\xf3\xf3\xfa\x2c\x34\x45\x80\xb3\x08\xb8\xec\xfc\x3a\xbe\x08\x68\x32\xe3\xa8\x85\x84\xec\x0e\xd9\xe2\x40\xea\x5d\xf4\x7e\xe8\xa3\x2f\xc2\x59\xd5\x0a\xb8\x16\x88\xc1\x0d\x09\x1b\xaf\x85\xf9\xf4\x19\x37\x35\x01\x53\xef\x11\xbd\xde\x23\x4e\x9a\xb8\xb6\x6a\x40\x32\x90\xb0\x70\xef\xca\xf9\x9b\xad\xa3\x71\xe8\x73\x4f\xa5\x09\x61\x73\xbd\xe7\xd2\xd4\xb1\x71\x8f\x38\x32\xd6\xe3\x54\x09\x14\x80\xb1\x2b\x4f\x81\xe1\x32\x30\x54\x3d\xb2\x45\x38\x3c\x50\x24\x0f\x30\x1e\x49\x9a\xf1\x19\x10\x93\xf7\x07\xd2\x5c\xcc\x55\x33\x38\xf9\x9f\x4c\xe0\xa8\x0a\x89\x92\x00\x71\x9c\x10\x13\x29\xaa\x0c\x00\xc4\x74\x36\x58\xd5\x25\x48\xe2\x34\x21\x9a\x67\x3e\x3a\x33\xc1\x20\x81\xe5\x92\x79\x21\x5a\x9c\x68\xe6\xf8\xbf\x00\x4b\x32\x1a\xc0\x72\x33\x04\x86\x15\xdc\x14\x52\x64\xa3\x9d\x25\xb2\x5e\x3d\x60\x17\x09\x22\xa5\x49\x7a\x67\xdf\x02\xbd\x64\x6d\x41\xba\x10\xcd\x6c\xf5\x6b\xd3\x9e\xbd\x46\xe0\x07\xb3\x9e\x91\x6a\x91\x4a\xf7\xf6\xd9\xeb\x5f\x16\x22\x47\x09\xd5\x4c\x5c\x7f\xaa\x29\xd9\x83\x19\x99\xdd\x9c\x77\x93\x60\xea\x34\x79\xd0\xed\x01\xda\x73\x8a\x11\x83\xc8\x2b\x6c\x5d\x30\xc0\x61\x2b\x8b\xac\xe7\xc0\x2d\x11\x7c\x77\x89\x5e\xb0\xf0\x90\xac\x5c\xb0\x70\x06\x3b\x17\x25\x93\xfb\x98\xc5\x1b\x6b\x6d\x5d\x44\xed\x05\x5f\x69\xd9\x23\xb9\x1c\x15\x92\xfd\x5c\x69\xc9\xb3\x18\xde\x0c\x28\x8f\x5d\xb0\x67\xe6\x68\x2e\x47\x88\x66\xc7\xde\xf9\x8c\xb7\x10\x89\x55\xb8\x9b\xed\x5a\xf3\xb7\x8a\x5c\xf7\x16\x17\x35\x81\x40\xbd\x60\xf5\x10\x65\x6c\xdd\x29\x60\x76\xd4\x0a\x28\xda\x36\x03\x6c\xef\xe0\xea\xc4\x50\x88\x40\x0d\x4b\x39\x97\xc6\x6e\x3a\xb3\xf6\x64\x6a\x86\x49\xa7\x4b\x14\x0a\x0c\x08\x7f\x10\x66\xff\x1f\xec\x4c\x8f\x1c\x15\x2b\xdb\x0d\x0e\x21\xf9\x37\x8b\x12\xa3\x20\x16\x82\x7e\xff\xed\xf3\xdf\xaf\x27\xd2\x62\x4c\x0a\x15\x5f\x71\x6c\xbb\xe8\x5e\xc0\xaa\x24\xee\x31\xb4\x14\x98\xe6\xaa\xdd\x67\x54\x6e\xff\x98\xc9\x6b\xdf\xc6\xbe\x51\x84\x4c\x07\xbf\x5a\x1a\x26\xaa\x04\x6c\x65\xbc\xc1\xee\x57\x27\xc6\x7c\xb4\x54\x44\x18\x63\x2b\xe3\x0d\x76\x1f\xbd\x07\xe0\x7d\x86\x64\xeb\xb9\x29\x06\xa7\x18\x6c\x11\x83\xb5\x9a\x74\x1e\x40\xb5\x9a\x7c\x72\xa0\xc9\x27\x27\x9a\x5c\x3b\xd0\xe4\xda\x4b\x46\x98\x01\xff\x2e\xe2\xd7\x74\x0a\xe2\x2c\x0f\xb4\x9f\xcb\xb4\x58\xe8\x79\x9c\xdb\xec\x81\xd4\x7c\x5a\xf5\xa2\x90\xcc\xfa\x81\x12\xff\xfe\xdb\x28\xbd\xb2\x3c\xec\x75\xe9\x9a\x63\x04\x34\xeb\x01\xd1\x67\x8a\x63\xcc\xf1\x06\x5e\x40\xde\x4a\xac\x07\xd5\xe9\x67\x64\xd9\x80\x35\x81\x8d\xa7\x81\xa6\xfa\x1e\x3e\x54\xfd\xce\x2a\x92\x1d\x3f\xf6\x87\xad\x66\x7a\x2b\xfc\xe5\x3f\x42\x9a\x66\xd1\x61\x83\x5a\x1c\xad\xac\xa7\x81\x56\x67\x8d\xc7\xe2\x0a\x57\x5d\xc8\x07\xb6\x5d\x71\x52\xf2\xa1\x19\x90\x12\x0d\xaf\xc8\xb0\x3b\x83\x6a\xc7\x4b\xa3\xf6\x20\x0f\x5b\xd2\x6c\xb7\xe4\xc2\x4f\x88\xe6\x4f\x88\xf6\x6b\xfa\xf9\xcd\x16\x8f\x00\xd8\x99\x7e\xac\xeb\x91\xaa\xe7\x8d\x3f\x35\x3a\x58\x23\xaa\xb0\xda\xe3\x83\x8a\x77\x49\xbc\xc0\xe4\xe8\xbd\x7b\x07\xfb\xee\x9a\x63\xd5\xa9\xf1\xca\xdd\x71\x8f\xe3\x52\xd5\xf4\xce\x2c\xaf\xb1\xf9\xc8\xe4\x6e\x2c\xc6\x9b\xce\x4c\x3e\x1f\xde\x5d\x52\x3d\xf2\x1d\xf3\xbd\xbd\xb2\x36\xcf\xda\x54\xc6\xd1\xca\xc8\x54\x15\x5c\x09\x75\x50\x86\xb7\x41\x14\xa3\x45\x04\x96\x62\xb0\x80\xc5\x52\x46\x8c\xd2\xb4\x21\x29\x6b\x4a\x39\x54\x8a\x7c\x63\x0d\x18\x3b\xf1\x85\xaf\x19\x1f\x97\x33\xa0\x45\x6e\x92\xa5\x98\x20\x74\x22\x86\x65\xb1\x1b\x39\x4d\xb5\xbb\x9a\x32\x16\x18\x31\x13\x11\xea\x26\x2c\x68\x71\x9f\x88\x22\xd0\x30\x0b\xb8\xbd\xb4\x20\x74\x29\x2d\xce\xb6\xf3\xcc\x4f\xee\x7c\xc0\x04\x39\x4e\xa0\x53\xda\x32\x4f\x5b\x33\xe0\x25\x12\x5c\x4e\x50\x47\x37\xa0\x39\xda\x1d\xea\x7a\x06\x5e\xcb\x31\x9e\x38\xf6\xc5\x31\x26\x3d\x31\x9c\x8f\x8e\xe3\x0c\xe3\xcb\x8f\xbf\x13\x76\xf0\xc4\x4e\x85\x9d\xce\x23\xa7\x3a\x13\x71\x19\x3d\xef\x7e\xe8\x69\x80\x17\x8f\x0d\xde\x6e\x7d\x77\x0f\xea\x5c\xa4\x80\xc1\x65\xfc\xcb\xd8\x9d\x3c\xca\xcd\x97\x0c\xe3\xd0\xe2\x5e\x96\x53\xe6\x27\x48\x73\x14\xa7\x11\x7c\x29\x2e\x88\x32\x3d\x5c\xff\x67\x45\x93\x2c\xfd\xdf\xab\x23\x39\xf9\x8d\xeb\xab\x46\xb4\xcc\x6b\xa7\x15\x07\x69\xf2\x58\xd3\x44\x86\xab\x9b\x7f\x3a\xa1\xb0\xf1\x42\xd7\x5f\x28\x86\xd0\x9a\xb3\xe2\x4e\xea\xf2\xa7\x9c\xab\x46\xa0\x8f\xfe\x78\x1e\xa9\x89\xb2\x32\x65\xe2\x08\x3b\xda\x80\x7c\xde\x2f\xef\x50\x53\xcf\x91\xd5\x87\xe6\x01\x66\x2c\xa1\x9f\xb7\x41\x94\x31\xbc\x31\x3c\x33\xaf\x72\x65\x74\xe9\x17\x13\xed\x5e\xd3\xba\x34\xff\xc0\x21\x5f\xdb\x0a\x59\x03\x5e\xad\xb9\x17\x62\x4f\xf1\x96\xfd\x2f\xb4\x02\xb0\x31\x44\x64\x03\x0c\x5b\xb3\x1d\x84\x08\x26\x1d\x05\xc8\x13\x7a\x83\x5b\x99\x5c\x5e\x80\xe1\x10\x08\x37\x19\x78\x6e\x8f\xc6\x9c\x9b\x97\x5b\xd7\xc3\x8e\x42\x08\x0a\x02\x60\xcc\x33\x14\x7f\x25\xa4\x67\x34\x4c\x0d\x39\xb3\x43\xbd\x8a\x1e\xd9\x20\x58\xb6\x26\x48\x8e\xc1\x2d\x4c\x29\x46\x5d\x9d\x01\xd7\x7c\xac\xed\xde\x4f\x8f\x60\x30\x75\x56\x67\x48\x98\x1a\xa1\xe1\xa3\xbd\xb3\x6b\x45\xcc\x0c\xf8\xad\x94\x7e\x58\x1e\x69\xd7\x6d\x7a\x08\xb5\x9f\xc2\xbb\x58\x3a\xf5\x51\xcb\x39\x03\x7e\xe4\x30\x2d\x40\x77\xe6\x36\x3f\x65\x8e\x18\x6b\xd1\xd3\x66\x65\x00\x6f\x23\x14\x8d\x17\x8d\x55\x32\x6a\x8d\xa6\xc0\xb2\xc8\xcf\x80\xf3\x8d\x60\xd1\xda\x52\x58\x6c\x66\xb0\x9b\xd6\x4f\x55\x93\x35\xb0\x73\x65\xf0\xa6\x0f\x8b\x3d\xcd\xa6\x6b\x7d\xc7\x1f\x92\x45\xbf\x2a\x43\x0f\xda\x0f\x64\x45\x17\xa9\xe6\x94\x54\xfc\xe9\x3c\x34\xae\x59\xea\xde\x2f\xab\x68\x6e\xc6\x03\xe7\x78\x9c\xfe\x16\x93\x70\xbe\x46\x21\x26\xab\x17\xc4\xe1\x31\x46\xa6\x8d\x0b\x14\xbc\x70\xd8\xf2\x8c\xfa\x51\x78\x06\xfc\x54\xdf\x67\x14\x01\xe7\xf6\x6a\x6f\x30\xfc\x10\xe7\xb9\x75\x7a\x6b\x8a\x01\xc2\xe9\xae\xf5\xb4\xa3\x60\x9c\x22\xde\x05\x7e\xe5\xfd\x50\x07\xc7\x56\x4d\x7a\x6b\xe2\xc7\xa4\x4e\xcc\x52\x8a\x71\x1b\xbe\x63\x11\x1f\xf4\x52\x83\x15\x11\xa7\x5e\x7c\x8b\x28\xc5\x40\xcd\x18\x50\xaf\xcb\xd8\x8e\x04\x6b\x9a\x10\xfc\x8f\x1f\x1f\x1a\x78\x00\x0e\x65\x47\xb0\x2e\xae\x99\x6f\x52\xf2\xa8\xfe\x4a\x43\xa0\xd6\x01\x9d\x08\x29\x1d\x2a\x7c\x97\x31\x9e\xc4\x97\x95\x87\x4e\x78\x6f\xc8\x24\x7e\xa8\x7f\xcd\xc7\xdb\xb3\x19\xc4\xf6\x23\x72\x76\xf0\xe9\x5e\xbc\xf0\x84\x73\x74\xe4\x23\x3b\x9a\x53\x86\xfd\x86\x49\xa1\xad\x9f\x63\x4f\x75\x28\xe7\x4a\xce\xcd\x07\x16\xb5\xb8\x28\xa1\x2d\x64\x55\x3d\x4c\xb6\xa3\x22\x28\xd2\xee\xb8\xd9\xf7\xf9\x8f\xfe\x10\xb4\xc4\x5b\x08\x4f\x4e\x5b\x7d\xf9\xbe\x74\xcb\xeb\xc9\x2d\x27\xb7\x3c\x72\x4b\x95\x94\x10\x52\x33\x55\xfa\xf7\x6d\x91\xd7\xab\x69\xd7\x64\x25\x5d\x2c\xc6\xae\x1a\xc7\xba\x33\x2b\xb6\x01\x44\x0b\x9b\x3c\xbb\xf0\xec\x01\x39\xe5\xf5\xe4\x94\x93\x53\x4a\xa7\x1c\x75\xba\x35\x9a\x4e\x8c\xcb\xc3\xa7\x49\x8a\xcf\x49\xca\x50\x53\xf9\xe4\xf0\x93\xc3\x7b\x71\xf8\x31\x0c\x13\x77\x14\xc4\x1e\x1b\xc4\x28\x5d\x27\xb4\xa9\x3c\x55\x17\x01\x93\x36\x37\xea\xe8\x2a\xb6\x99\xd8\x41\x29\x2f\x96\x1f\x6c\x6e\x7d\xd1\x45\x91\x12\x0e\x3a\x9f\x07\xa1\x31\xfc\x35\x2b\x35\x34\x36\xeb\xfc\xd6\x64\x8c\x01\xc4\x73\xe5\x2e\xdd\x82\x7a\x2b\x9a\x3f\xbf\xee\x5f\x35\x7d\x00\x08\x17\x28\x78\x33\xc3\x54\x85\xc5\xbe\x21\x9c\xb7\x47\x49\x3e\x93\xd0\x40\x6d\xab\x4f\x55\xbe\x73\xc3\x39\xc5\x0b\x07\xc9\xcb\xc1\x41\x89\xc2\x5d\x50\xae\xde\x79\xb9\x8d\x14\xe6\x75\xa1\xde\xf8\x13\x87\xe7\x45\xe9\xa3\x6e\xc3\xff\x8e\xaa\x06\xdb\x1f\x88\xfa\xad\xb0\xc3\x84\x57\x5f\x8a\x4c\x96\x4b\xe6\xe4\xc9\x49\x86\xff\xf1\x4d\xf4\x57\xa9\xeb\xc4\xb4\x0f\xa6\x1d\x51\x74\x8b\xd8\x14\x8a\xe5\x50\x74\x3b\x74\x7c\x47\x74\x87\xc9\xca\x70\xec\x70\x53\xbf\xd5\xdd\x08\x74\xf6\xc8\x56\xa5\x5e\xf7\x63\xd0\x4d\x20\xa6\x28\x05\x25\x1d\x32\xa2\xd1\x79\xb2\x69\x2e\x69\x6a\xb3\x92\xa9\x3d\x06\xa2\xe7\x72\x0e\xc0\x7e\xe5\x37\x5e\x20\x4a\xe4\xcf\x80\xf7\xc5\xbf\x83\xfc\xd6\x1c\xd5\x8b\x6c\xa9\xf7\x7c\x74\xbe\x18\xf9\x78\x55\x27\xee\x83\xd9\xc3\x51\xb9\xb8\xe2\xeb\xe7\x84\x32\x03\x0d\xab\x22\x4b\xd9\xe2\x83\xc9\x23\x15\x75\x12\xbb\xf6\xbe\xba\xb1\xe1\x52\x1d\xf1\x58\xc4\xc9\x38\xe0\x05\xc2\x0a\x7e\x73\x4e\x01\xc5\xe3\x5b\x9c\xa9\x94\x23\xf9\xa4\x85\x39\xd7\x6f\x91\x2d\x99\xc4\x71\xe0\x63\xb7\x58\x3f\x56\x3c\xc0\x8c\x74\x6f\x53\xd4\xd0\x8b\xc5\x79\xa3\xf0\x8a\xcd\x1d\xef\xa1\x9e\x78\x50\xa6\x70\x21\xec\xa9\x51\xf8\x0c\x48\xdf\xd6\x77\x65\xf7\x99\xfd\x7a\x71\xc1\xb9\x02\x85\x19\x12\x25\x03\xf6\xfa\x7b\xa1\xed\x19\x65\x0c\x0c\xb4\xb5\xfa\xd8\x0b\xb0\x2c\xee\xec\x6b\xf7\x14\xfd\x68\xc5\xc3\x7e\xfd\x7c\x78\x38\x43\x3c\x17\x71\xd5\x98\x9a\xe2\x86\x4c\xda\x2f\xc1\xdf\xef\x9f\x6f\xbe\x3d\x12\xac\x75\x79\x59\xf1\xb6\xe8\x26\x4c\xef\x61\x83\x03\x68\xf5\x32\xe9\x0a\x44\x6b\xdb\xe0\x26\x0c\xa9\xaf\x2b\xe8\xd2\xc0\x07\x4c\x1a\x3a\x97\x9b\xca\x3f\xd0\xb4\x09\x53\x94\xcd\x33\xba\x44\xf9\xcb\x23\x25\xc2\x8a\x4f\xbf\xc0\x0a\x33\x0e\x54\x3e\x36\x74\xf4\xdf\x76\x58\x17\x02\xea\x90\x52\xf9\x92\x93\x91\x52\x99\x7b\xb3\xb8\x38\xa5\x16\x57\x9b\x99\x86\x38\x8d\xf1\x87\x6b\x48\xec\x84\xaf\xbc\xb1\xe3\xd1\x9f\x27\xc2\xba\x27\xac\x6e\x04\x95\x4c\x3d\x32\x13\x76\xf4\x9c\x80\x35\xd3\x65\x9f\x89\xbe\x11\x5a\x38\xd8\x05\x2a\x2f\xee\xd0\xe5\x5a\xe3\x4d\x77\x7a\x6b\xc6\x88\xee\x39\x78\x63\x88\xb4\x59\x37\xd6\x08\x6c\xb5\xfd\x72\x2a\x52\x11\x70\xf2\x4e\x2c\xf3\x47\x72\xc1\xd2\x8d\xec\x0a\x33\x38\x96\x3d\x36\xab\x91\xd6\x3f\xa1\x7d\xca\x76\xb1\x3a\xc9\xe2\xbd\x30\x0d\xb3\x4f\x92\x6d\x0d\x98\x1f\x4a\x68\x7a\x44\xe2\x1b\x89\xfd\x60\x31\x74\x28\xce\x7d\x56\x39\x5d\x38\x9e\xde\xfd\x3f\xe6\xeb\x67\x1c\x88\x61\x71\xce\x69\x26\x7f\x4c\x33\x08\xab\x19\x84\x4a\x41\xf5\xe4\x00\x33\xd9\xf6\xf2\x00\xbc\x2b\xaf\x28\x05\xc8\x43\x94\xb1\xf5\x77\xa0\x1c\xb6\xb2\x9b\xdf\x99\x32\x08\xab\x6f\xe9\x7f\x46\x97\x45\xdd\x21\x49\xe1\x8f\x69\x22\xcb\x31\xbd\x80\x5a\x18\x2a\xf7\x58\xbf\x7c\x34\x6e\x64\xa3\x5a\x33\xb7\xd8\x06\x57\x75\x0b\xd9\xfa\xb7\xf7\xd3\x45\xd8\x6b\x20\x63\xe7\x1f\xb3\xeb\x77\x83\x99\x81\x8c\x7f\xfc\xe3\xfe\xdb\x84\xbb\x01\xee\x06\x32\x7e\xf8\xe7\xee\xa3\x79\xbf\x2c\x0b\xf2\x4e\x46\xff\x8a\xd9\xc5\xa8\xb2\xf9\xe3\x17\x82\x62\xf8\xf3\x8f\x5f\xfe\xcb\xde\xb5\xec\x36\xae\x23\xd1\x5f\xb9\xc8\x6e\x06\x77\x93\xd8\xdb\x59\x74\xa7\x3b\x1e\x03\x9d\x9e\x20\xce\x6d\x0c\x66\x13\xd0\x16\x65\x13\x2d\x53\xbe\x12\x25\x3f\xbe\x7e\x20\xd9\x4e\x44\x99\x96\x49\x91\x2c\xea\xb5\x6d\xa4\xcb\x75\x4e\x9d\x2a\x92\xc5\x87\xac\xe1\x7e\xe8\x29\xee\x51\x4f\x71\x8f\x7b\x8a\xfb\x5e\xfd\x05\x40\x0d\xdc\x9c\x0d\xa5\xea\x6c\x0e\xf1\x43\x4b\x10\xab\x18\xd9\xdb\xa7\x6d\xd4\x27\xda\x54\x8c\x40\x4c\xa0\x06\xee\x15\xb9\x57\x31\x02\x31\x8b\xaa\xf1\xec\xa8\xb9\xe1\x25\x71\x38\xbe\x3c\xf4\x16\xf9\xa8\xb7\xc8\xc7\x7d\x42\x9e\x9d\xa9\x2e\x82\x07\x85\x5e\xd5\xc1\x35\x77\x45\x51\xc8\xa6\xdd\xcb\x9f\x1c\xa5\x49\xd7\x38\x4d\x1c\x90\xca\x31\x7a\xbc\x1b\x0f\x4f\xe9\xa5\x09\xb9\x83\xe1\x95\x41\x91\x3b\x09\x5e\xdd\xe2\x8e\x59\x44\x6c\x1d\x5e\xcd\x0f\xef\x9e\x8e\x2c\xd5\xe8\xc8\xd7\x97\xa1\xd9\xd8\x5d\x8a\xf6\xb4\xc3\x5a\x7d\x18\x4b\xe1\x5a\x62\xf5\x3e\x83\x01\xf5\xd7\x91\x7d\x1f\x25\xfb\x33\xe3\x29\xa8\xc3\x56\xfb\xb0\x3e\x66\x2f\xed\x0c\xc2\x90\x13\xc6\x34\x2b\x1a\xfd\xd0\xc5\x1b\xde\x3d\x86\x61\xe4\x0d\xd2\x90\x93\xc6\x77\x6f\x89\x9f\x02\xb4\xac\xc3\x17\xb0\xab\x33\xbc\x08\xa9\x87\xa2\xfd\x90\xfb\x0a\xb9\xff\x14\x2e\x6b\x27\x44\xfb\xd0\x16\xa7\xcb\xbd\x9e\x2d\x8b\x4f\xcd\xd0\x8c\x92\x80\x1c\xb0\xa7\x62\xcb\x61\x0c\xa7\xbd\x0e\x22\x30\xf1\xfc\x17\xb5\xc8\xbb\xe2\xe2\x5d\x00\xb8\xf2\xc3\x2e\xb6\x42\x07\xf4\x5d\xae\x1b\xa9\xb6\x0c\xbe\x44\xf8\xf4\x95\xa0\x58\xe5\xd3\x81\xd5\xe1\xa7\xf2\xef\x6a\x9c\xee\x18\xdf\xa9\xdc\x0b\x3c\xfd\x9f\xb8\xce\x7d\xbb\x32\x0f\x9f\xac\x67\x9f\x4d\xb4\x75\x88\x35\xbb\x64\x7a\xa2\x59\x5b\xae\xea\xa7\x27\xe1\x6e\x70\x1d\xaf\x96\x9e\x05\xd5\x7a\x21\x69\x71\xf1\x7d\x87\x17\x09\xc3\x2d\x09\x7b\xc1\x80\xcc\x67\xc7\xad\x76\x68\x69\x33\x04\x24\x26\x12\x42\x3a\x93\xfc\x2a\x62\x66\xff\xe3\x7d\x42\x2f\x6d\x88\x82\x54\x07\xba\xfa\x2d\xc6\x5b\x9d\x6e\x2f\x4c\xe6\x01\xbe\x08\x0c\x82\x0c\x8b\x3f\x84\xa5\x1c\x16\xf8\x7a\x71\x8e\x8a\x81\x37\x3c\xa5\x0a\x24\x00\x89\x84\xba\xa1\x70\xc6\x22\x13\x2f\xc7\x34\x85\xc6\x64\xbe\x67\x97\x15\xa2\xfa\x59\x1b\x5d\x26\xdf\x22\xb4\xf8\xfd\x8c\x58\x44\x76\xaa\x82\xb4\x55\x1d\x6a\x6c\xa3\xd8\x8b\x09\xb8\xb4\x8b\x4b\x75\x2f\xd5\xd7\x76\xbf\x47\xc0\x22\x9b\x7e\xa7\xd8\x74\x30\x70\x15\xc9\x24\x9d\x22\x93\x50\x87\x54\xbe\x1c\x6f\x86\x75\x81\xd0\x3c\xfd\xfe\x69\xe7\xc6\xdb\xd5\xa6\xc8\x99\xd6\x69\x7c\x9a\x16\x58\x9c\x10\x68\xc5\xfd\x47\x88\xda\xd2\x57\x91\x5d\xb1\x06\x58\x62\xcd\x7a\xd1\xdc\x80\x9e\xe7\x94\xd7\x41\x63\xaf\x21\xfc\xab\x26\x6c\x69\x08\xad\x71\x08\x59\x68\x61\xaf\x6d\xe1\xa0\x6d\x61\x0b\x14\xf9\xb4\x5d\xa1\x2f\xa5\x4e\x89\x3a\xab\xe7\x39\x2f\xb8\xf3\x5b\x9a\x35\xfc\x54\xa9\x46\xd2\x88\x0c\xec\x75\x0d\x1c\x74\x0d\x00\x65\x8c\xdf\xee\x8c\xe1\x99\x03\x4d\x98\xb8\x75\xd5\xa6\x72\xac\x37\xf0\x32\xac\xcb\xf2\x15\x8f\xfd\x21\x1a\x5c\x34\x00\x53\xe3\x15\xff\x9d\xe0\x98\x9d\x37\x6d\x9b\xb1\x65\x52\xa2\x83\x8b\x14\x3f\x2b\xb5\xb3\x0e\x2c\xf4\xde\xba\xd4\x79\x5b\xe7\x88\x34\x8d\xb0\xf3\xfb\xa2\x56\x98\x17\x2c\xbf\xd5\x22\x60\x22\xab\x2f\x4d\xf8\xad\x38\x10\xd3\x88\x67\x6e\xee\x3d\xf8\x80\xa9\xad\xbe\x0c\x42\x4d\xc1\xb0\x3a\x1c\xaf\x39\xc8\x3e\x18\xe2\x2b\xa3\x21\x58\x70\xfd\x14\x0c\xaa\xbb\xe1\x9f\x43\x1c\x83\x01\xfe\xb0\x11\xaf\xc2\xa8\x6c\x64\x07\x80\xd4\x59\x6c\x79\xc0\x60\xb1\x7d\x68\x7c\x55\xd6\xef\x89\x19\x24\xcb\x99\x3c\x4a\x88\xe1\xf4\xd1\xf4\xba\xae\xdd\xfd\x31\x48\x95\x33\x75\xf0\x80\xe1\xc4\xd1\xf4\x81\x41\xd6\x00\x80\x38\x7a\x37\xb2\x8c\x7a\x30\xb2\xe8\xef\xb6\x18\xa4\xdb\x99\xc0\x4a\x88\xe1\x14\xd6\xf9\xb1\x49\x7b\x67\xc2\x20\xd7\xce\xe4\xc5\x03\x86\x53\x57\xe7\x07\x37\x59\x03\x00\xea\xea\xdd\xe8\x38\xf6\xc0\x00\x5f\xab\xd3\x35\xe4\x25\xb4\xb0\xd7\xb6\x70\xd0\xb6\xb0\x05\x08\x98\x33\x89\x96\x10\xc3\x69\x74\x18\x5f\x6f\x8d\xaf\x6e\x77\xfe\xf9\x60\x39\xd3\x27\x0f\x18\x4e\x9e\xc3\x00\x7d\x6b\x80\x96\x35\x00\x20\xcf\xfe\x8d\xf0\xc9\x1c\x5e\x9f\xfc\x85\x94\x1a\xfa\x14\x19\xd8\xeb\x1a\x38\xe8\x1a\x00\xd0\x67\x32\x77\x26\x50\x1e\x31\x98\x40\x63\xc8\x6d\xca\xca\xdd\xeb\x96\x9d\x53\xe2\x49\xf4\xbb\x43\xa2\xa3\x81\x3c\xbe\x8f\xbb\xc3\xa1\xa3\xd1\x26\x7e\xf0\xba\xc3\xa1\xb3\x64\x7e\xf0\xbb\x43\xa2\xab\x64\x7e\x18\x92\x59\x3b\x99\x47\x5e\x77\x38\x74\x96\xcc\x23\xbf\x3b\x24\xba\x4a\xe6\xd1\x90\xcc\xda\xc9\x3c\xf6\xba\xc3\xa1\xb3\x64\x1e\xfb\xdd\x21\xd1\x55\x32\x8f\x87\x64\xd6\x4f\xe6\x64\xde\x1d\x12\x01\x1b\x0f\x5f\xf1\x92\xd0\xfc\xc3\xc1\x8f\x68\x23\xfd\x91\xe0\x2a\x12\xd3\xcc\xd8\xfb\xe2\x68\xed\x3d\x0e\x42\x66\xc7\x71\x42\xbd\xa2\xdf\x33\x16\x61\xb4\x3e\x3e\x8c\x0f\x88\x41\xd2\x60\x9c\x7b\xa7\x79\x21\xc1\xcf\x6e\xc3\xbd\x67\xdf\xee\x0f\xa9\x8a\x29\x42\xd9\x86\x45\x5f\x5e\xbf\x96\xec\x85\xbe\x1f\x63\xc8\xe8\x9c\xbf\xdb\x3c\x84\xa7\x18\x1e\x8d\x3b\x48\xe7\x0b\x59\xa7\xcf\x57\xdf\x55\x82\x3c\xfd\x91\x95\x78\x7f\xe7\xc3\x0d\x18\x61\x2d\xb7\xb3\xf7\x43\x0a\x6e\x93\x14\xd0\x71\x49\x29\xb4\xf6\x5d\x96\x02\xaf\xc7\xda\xec\x9c\x5d\x5b\x89\xdf\x9d\x10\xf9\x43\x88\x2a\x43\x24\x5c\x21\x40\x07\xc9\x1b\x82\x54\x19\x24\xf1\x5a\xd8\x6c\x94\xaa\x60\x2c\x83\x62\xcc\x9a\x14\xab\x13\x15\x71\x76\x85\x9c\x2e\xf0\x7b\xee\xbb\x81\xb7\xcb\xcf\xce\x31\xb2\xc6\xa6\x18\xe6\xf2\xe0\x32\x09\x3e\x1e\x23\xe8\xfb\xa8\x52\x5e\x3b\x0a\xe2\x6d\xb1\x40\x55\x04\xc6\x1f\x02\xc3\x05\x06\x7a\xec\xa8\x08\x8d\x37\x84\x86\x0b\x0d\xc4\x88\x51\x8a\x0d\xde\x6e\xc2\x88\xcd\xb6\xe4\x70\x08\x30\x7c\xe3\x4a\xc0\x5d\x7c\xf4\xc5\x90\x99\xbd\x19\x33\x07\x33\x66\xec\x9c\x53\x7a\xca\xd2\x69\x9e\x77\x9c\x4e\x9d\x8d\xe7\x24\x60\x24\x25\x78\xfb\x9f\x5f\xaf\xb7\x43\xfa\xc7\x32\x0a\x93\xcd\xbf\xee\x8a\x76\xf2\x85\xff\x5d\x25\x26\xf5\x07\x4a\x04\x46\x10\x63\x68\xb1\x5a\x63\x0a\xdc\x65\x10\xb9\x77\x69\x24\xc0\x29\x0e\xf4\x4c\xcc\x51\x8c\x7f\x11\xbc\x9d\x9a\xe8\xe9\xd2\x64\x9d\xd9\xb2\x53\x0c\xfe\x4d\x28\x7b\x99\x4c\x6f\xeb\xa5\x32\xa0\xea\xaa\xb8\xe4\x6c\x1d\x5a\xfa\x78\xcf\x37\xcc\x10\x09\xde\xf0\xee\x29\xa1\x8b\xd9\x64\x3a\x03\x07\x2b\x8c\xea\x6d\x03\xd7\x5e\x38\x12\x0f\xe6\xd9\x8b\x2d\x76\x34\x32\xc1\xcc\x3d\x87\xd0\xa0\x9f\xc2\x65\x1d\xac\xed\x0f\x75\x4d\xe0\xd0\x9e\xce\xd0\x7a\x13\xe0\x67\x14\xff\x56\xf5\x74\x11\xa0\xf5\xc6\x2f\x4f\x29\x55\xbf\xc7\x25\x7e\xbe\x98\xd0\x14\x47\xcc\x22\xe0\x17\xc4\x18\x8e\xa8\x81\x14\xdc\x1c\x2d\x59\xf1\xf5\x85\xec\x70\x56\x71\x27\x98\x7e\xcc\xfb\x89\x09\x9f\x25\xa7\xd8\x15\xc3\x4c\xae\x41\x38\xd0\x29\x20\xea\x52\xed\x20\xf4\x32\x1f\xed\xad\x2a\x44\xe8\x7d\x17\x21\xe7\x0b\x51\x01\x37\x1c\x6c\x87\x41\x17\x97\x61\x7b\x61\xcf\xbe\xe2\xe2\x58\xf7\xb7\x5a\xb2\x84\x36\x80\x10\xbf\x41\x84\x40\x6b\x24\x7f\x9a\xf1\x93\x09\x38\x22\xae\x41\x2e\x20\x86\x00\xdc\xa3\x72\x30\x5b\xa1\x68\x83\xe9\xb0\xd8\xd2\x59\x6c\x35\x80\x44\x68\xd4\x6f\x78\x37\x5d\xa3\x25\x1e\x7f\x93\x83\x7b\xee\x5e\x9d\x5a\x5f\x76\x3a\x57\xb5\x5b\x43\x67\xef\xa6\xd9\x1b\xaa\x14\x05\xc7\x4f\x35\x57\xbb\x47\x4e\x7f\xeb\xe7\x7f\xab\x2b\xf8\x2d\xf1\xd8\x4a\xd7\xc8\x0a\x93\xe5\x4a\xdb\x15\x0f\x6f\xf4\x5d\xc9\xfe\x6d\xec\xe9\x05\x6f\x1e\x46\x1e\x8e\x6e\xdb\x38\x47\x2f\x9f\xd1\xca\x84\x4e\x36\x64\x9c\xe1\xb7\xfd\x06\x57\x9b\x95\x7b\x02\xb8\x34\xd8\x1f\x7d\xf9\x33\xfb\xbf\x7f\xe6\x22\xf8\xf3\x18\xc5\x7f\xdc\x89\x5e\xf9\xcd\x10\x5a\x4b\xe8\x59\x32\xef\x50\x4e\x57\x99\xd8\x55\x1f\x66\x94\x33\xb2\x37\x61\xe4\x60\xc2\xc8\x56\xdd\xc8\x50\x83\x44\x35\x68\xa8\x15\xb2\xb5\x22\x4b\xf8\xc7\x30\x08\xa3\x3a\x0d\x3d\x71\x2b\x2e\xc2\x9e\x7e\x3f\x6f\x19\x61\x4c\xf5\xcd\xcc\x8d\x34\x17\x51\xb0\x59\x21\x2b\x11\x98\x60\x96\x4d\xd9\x49\xc0\x70\xe4\x6c\xce\x29\x30\xe2\xe7\x1e\x69\x4f\x5c\xb7\xb9\xac\xad\x89\x57\x95\x37\x90\x81\xce\x08\x9b\x10\x6b\x29\x9b\xc1\xf9\x12\xef\xe9\xe2\x19\x45\xbf\x71\x34\x9b\x4c\xff\x7b\x3b\x34\x95\xbb\xcb\xeb\xdc\x90\x29\x47\x2b\x06\xe0\x65\xf0\x44\x28\x89\x57\xb9\xfb\x5a\x8e\x9f\x38\x3e\x7a\xbe\x01\x71\xfd\x25\x0c\x82\xc6\x3b\x9e\x88\x3c\x9f\x60\x5a\x10\x4c\xac\xea\xbf\x28\x59\x22\x44\x97\xd8\x94\xf7\x9c\xb4\xbf\xe1\x00\x33\xac\xe3\xaf\xb2\xc2\x9d\x80\xbe\x31\x26\x66\xdf\x0b\x2d\x90\x60\x9d\x03\xad\x98\x3d\x05\x49\xbc\x7a\x45\x31\xab\x76\x54\xef\x37\x22\xb4\xcc\x4e\xd6\x9c\x66\x53\x59\x2f\x1e\x05\xaa\xb4\x88\x06\x0f\xb4\xb8\x1a\x53\x49\x13\xd6\x4e\x78\x9c\x31\xff\xc8\x46\x12\xdf\x00\xd8\x40\x75\xd1\xa1\xd1\xb1\xbd\x32\x3a\xda\xee\x51\xf3\x9c\xa5\x2d\x23\xcd\x71\x9b\x9b\x23\x8f\xb4\x55\x70\x84\x3a\x92\x1b\x69\xb9\xdc\x08\x75\x26\xb6\xe7\xd0\xc3\x81\x89\x12\xd7\xa6\xf2\x74\x04\x9d\x02\xa2\x6e\x52\x7d\xc9\xd1\x13\x40\xf0\x42\x9d\x17\x30\x43\x41\x76\x18\x70\x37\x19\x7e\x9e\xae\xf9\xcd\x98\xaf\xb5\xa4\x44\x7c\xb0\x96\xb6\x8b\xb6\x86\xd4\x98\x33\x7d\xa4\x5d\xec\x09\x53\xb5\x40\x1a\x0c\x67\xed\x96\x1c\x6c\x95\x9b\x60\xd6\xea\x45\x47\xd3\x8e\x55\x95\xf9\x24\x1d\xe3\xd3\x99\x3a\xcf\x15\xd1\x6f\x57\x76\x37\x58\x9f\x2d\xad\x97\xcd\x52\x68\x5e\x34\xbf\xd3\xb4\x0f\x4b\x81\x35\xfe\x5f\x18\xae\x55\x81\x5e\x3a\xe9\xa3\x05\x0b\xed\x34\x73\xa7\xcb\x20\x0c\xf3\x13\x5e\xd9\x00\x0e\x18\x13\xc1\xde\xbc\x51\xd1\x55\xd0\x99\x27\xf5\x94\xc6\x2c\x4a\xb2\x61\xbc\x72\xcb\x41\x8f\xdc\xcf\xdf\xf8\x9a\xdf\x4d\x35\xb1\x19\xa3\xfa\x59\x76\x41\x7a\x1f\x2f\xdc\x82\x30\x9d\xed\xa3\x49\x52\x2d\xe9\xfc\x71\x17\xf0\x7d\x63\x25\x1d\x5e\x31\xf2\x0c\xb9\x5b\xf4\xd6\x8a\xaf\x33\x86\x22\x26\xe9\xac\xe6\x0f\x85\x9b\xb6\x90\x32\xc1\xec\x07\x89\x4b\xc7\xd6\x25\xdc\x3d\x5f\xd0\xf6\x48\xbc\x09\xd0\xfe\x8f\x80\xc4\xec\xae\x72\x87\x2d\xfb\x8b\x6b\x10\xcc\xd6\xca\x06\x4e\x89\x38\x8e\x49\x0f\x38\x26\x14\x92\x61\x5e\xc2\xed\x67\xf7\x9a\x56\x0b\x44\x02\xf0\xd8\x01\x99\x96\x7b\x1f\xd0\xa9\xcf\x11\x4a\x3a\xc0\xe7\x87\x0d\x42\x1d\xc9\x92\x74\x50\x96\x84\x42\x8a\xb2\x70\x53\x51\x8e\xca\x4a\xc8\x16\x5f\xf0\x38\x5e\x6b\x20\x21\x7d\x46\x9b\x91\x67\xc0\x57\xf5\x03\x95\xa5\x77\x9a\x8e\x14\x26\xf7\xfa\x26\x1e\x54\x4c\x5c\x6a\x35\x89\x59\x44\x3c\xac\x69\x44\xf2\x6e\xcc\x0d\x2c\xa9\x3e\x1d\xa9\x26\x1d\xa9\x09\x3a\x52\x33\x74\x6c\xf5\xe9\xd8\x6a\xd2\xb1\x35\x41\xc7\x56\x96\x8e\x52\x31\x2b\x21\xb2\x7f\x8d\xb0\x54\x28\x7c\x27\x85\x42\x34\x51\x4b\xee\xb5\x2d\xb4\xb3\x4c\x88\xa0\xa4\xf7\xda\x16\xda\x59\x24\x44\x50\xb6\xf7\xda\x16\xda\x5c\x22\x78\x40\x50\x15\x42\xb5\x2e\xcc\x09\xf3\x09\x0e\xbc\x12\xe8\x35\x8a\x7f\x5b\xf1\xf2\x47\x88\xbc\xa9\x87\x29\x23\x6c\xcf\xd7\xb4\xa6\x7b\xfe\x8a\x7d\x1c\x61\xba\xc0\x2f\x01\xa2\xb2\x5d\x71\xa9\x61\x03\xff\x9d\xe4\x24\x58\x71\x7b\xb6\x89\x08\xc3\xaa\x8d\x83\xca\x81\x43\x79\x1d\x05\xbb\xc0\x2f\x23\x4e\x01\x21\x3b\x5e\x8a\x97\xa0\x77\x7e\x3f\xad\x8c\xd7\x61\xa8\x09\x85\x0c\xf4\x1b\x5a\x1e\x9f\x89\xbb\xbd\x87\xa4\xf5\x3b\xf9\x5d\x8b\x37\x34\x0f\xb2\x82\xa7\x49\xac\xec\x7c\xb3\x85\x6f\x4c\x68\x5c\x87\x36\x70\xf9\x59\xb0\x6f\xca\xb2\x90\x59\x51\xde\xa7\x22\x3e\xd2\x2e\xdf\x4f\x01\x12\x87\x8d\xd4\x85\xae\xd2\x02\x0a\x49\xcb\x29\x84\xad\x7e\x8f\xe1\x66\xff\x49\x62\x57\x2b\x93\xe0\x35\x0e\xbd\xff\xbe\xb7\x5a\x17\xb5\x22\x3a\xc1\xcc\x45\x40\x1b\x53\xc1\x61\x6a\x37\xc7\x72\x6b\xcb\x77\x03\xb7\xbe\x3b\x50\xd1\x9b\xb5\xd9\x7d\x7c\x25\xe0\xf4\x8c\xc4\xec\xaf\x9f\x76\x66\xb7\x93\x20\x9c\xa3\xe0\x4b\xf6\xf8\xc8\x53\x7e\x9c\x6e\x3e\xfb\xeb\xe7\xb5\x5f\x12\xd1\xcd\x7d\x50\xf4\x54\x35\xec\x9d\xcb\xbb\x70\x37\x56\x74\x97\xff\x8a\x2c\xbc\xbf\x44\xd1\x5f\x42\x5d\x7a\xeb\x2b\x7a\xcb\xd7\x1d\x78\x7f\x3d\x45\x7f\x4b\xcd\x28\x78\x87\x13\xd5\x74\xe3\x3f\xe0\xeb\xc0\x61\xd5\x84\x4b\x5c\x67\x5c\xa2\x9a\x72\x82\x23\x03\x16\xfd\xfd\x16\xa1\xed\x33\x8e\x57\x5f\xa2\x08\xed\xe5\xc8\x3d\xcf\xc1\x5f\x22\xb2\x26\x8c\xa4\xf8\xf6\x13\x62\x55\xbb\xf7\x92\x65\x87\x44\x31\xd3\x9d\x3d\x4b\x7e\xc1\xda\xd5\x14\xfc\x15\x6f\x02\xb4\xc0\xd9\x71\xce\xc7\xd0\xc3\x26\x94\xb3\xb0\x75\x6c\xa2\xec\xab\x91\xbc\x04\xf3\xd6\x44\xd9\x03\x73\x96\xa4\x72\xde\x96\x1a\x02\x89\x60\xbe\x08\xe6\x73\x5c\xd3\x67\x5e\x15\xd0\x5e\xcf\x6b\x7a\xcd\xa9\x03\xd8\xe9\xfc\x01\x74\x1c\xc9\x39\x5e\x55\xa3\x25\xd7\xc9\x95\xb5\x51\x7a\xb7\xb7\xd8\x2f\x3d\x11\x96\xbf\x7b\x8d\x23\x2b\x9c\xe5\xab\xc3\x71\x32\xff\x85\x23\x86\x77\x0f\xbe\x81\xf4\x8f\x24\x50\x56\x1a\x58\xea\x1a\x98\xeb\x1a\x40\x2a\x06\x44\x73\xeb\x9d\xae\x81\x3d\x48\xb4\x4d\x66\xb5\x04\xe2\x92\x1d\x1e\xf7\xc9\x4e\x0a\x00\x7c\x34\xc8\xbc\xe9\x32\x97\x35\x70\x00\x91\x4b\xbf\xf2\x64\xe4\xd7\x4c\x13\x51\x80\x94\xd2\x44\x64\x60\xa9\x6b\x60\xae\x6b\xa0\xd3\x2a\x1f\xf9\x7a\x22\x17\x8a\xb3\xd9\x22\xff\x99\x6d\x9e\x04\x46\x55\x4e\xb5\x35\x42\xb5\x45\x42\x0f\xba\x16\xba\x2a\xf4\x72\xc0\xe5\x94\xae\xf4\x9e\xb2\xe9\xf7\x99\x2d\x96\xf7\xb1\x6f\x21\x01\x3a\x50\xe6\xb5\x67\x43\x43\x11\x68\x72\x11\xb8\xa2\x7d\x0b\xb5\x60\x61\xb8\x16\xb4\xa3\xb6\xbc\xe1\xdd\x63\x18\x46\xde\x83\xc9\xb2\x12\xdf\x86\x5e\x6d\x40\xaa\xa9\xfd\x7f\xf6\xae\xaf\xb9\x6d\x54\x8b\x7f\x95\x1d\xbf\xdd\x4e\x1f\xb6\x4d\xa6\x6f\x77\x67\xe2\xfc\xf1\x66\x26\x69\x73\xe3\xec\x4d\xdb\x97\x1d\x24\xb0\xc3\xad\x8c\x5c\x40\xae\xb3\x9f\xfe\x0e\x48\x76\x64\x1b\x61\x10\x20\xc9\xb6\xde\xda\x8c\xfd\x33\xe7\x77\xfe\x00\x87\xc3\xe1\x14\xdd\x61\x57\x5d\x66\x9e\x60\x64\x61\xbc\xdb\x0b\xc0\x95\xec\xe7\x85\xec\xe7\xc7\x61\xaa\x73\x57\x80\x9f\x47\xec\x2c\xa6\x00\xbf\x1a\xb2\xb8\xd3\xf3\xb6\x8f\x13\xc7\x2c\x5c\xfb\x5e\xa7\x4a\xa2\x51\x57\x80\xa9\x2b\x40\xe4\x0a\xd0\xe7\x01\x2b\xf2\x80\xd5\xa6\xdb\xb2\xfb\x6e\x6a\xb1\x00\x3a\xa8\x30\x70\x36\x39\xd8\x28\x70\xa4\xfb\xdf\x13\x71\x62\xdb\xad\x5f\x30\x1f\x56\x02\x1d\x8a\x0f\x07\x48\x21\xb5\xef\xc4\x7d\x02\xa7\xcb\x09\x9c\x6a\xe3\x33\x73\x64\xab\x1c\x09\x8f\x3d\x27\x5d\x0e\x2d\x89\x13\x2e\x55\xdc\x4f\xd6\x1e\x26\x6b\xd0\xc7\xba\xd3\x88\x75\xcd\xa5\xad\xbd\x87\xbc\xd3\x0e\xa1\xe7\xea\x10\xda\xe7\x1a\x3d\xe5\x1a\xfb\x20\xde\x07\xf1\xe0\x41\xbc\x2b\x59\xe3\x8a\x50\xd2\xcf\x02\x5d\x9d\x05\xb6\x0b\xe8\x71\xcd\x05\xb4\xe2\xe6\x0a\x8d\x5d\xad\xb5\xc3\x0e\x17\x86\x74\x33\x3f\x31\xb9\x27\x62\x44\x7e\x87\xcc\xce\xf1\xc8\xc5\xd9\xfc\x54\xc7\x0d\xd4\x15\x60\xea\x0a\x10\xb9\x02\x80\xde\x05\x0d\x5d\xd0\xcf\xc1\x89\x2f\x57\xdc\xd4\x65\x01\x54\x03\x67\x93\xd0\x16\x5c\xfa\x6c\xd2\x96\x47\x1f\xe9\x5a\xfc\xb4\x1c\xf2\x6c\xd2\x0d\x7f\x54\xfa\xd1\x81\xf9\xe3\xe6\xa2\xbc\x1b\x0e\xd9\x6f\x0c\x03\x6f\x0c\xc3\x9a\x90\x99\x53\x56\x6d\x73\xea\x3a\xe7\x29\x6c\xc3\x94\x1b\xe9\x7e\x12\xf5\x34\x89\x82\x3e\x6e\x9d\x54\xdc\xaa\xf0\xa6\x03\x08\x5f\x7d\x38\x94\xe1\x70\xb7\x0a\xbc\x0b\x91\xb0\xfd\xe3\x8d\x93\xf1\xe0\x5d\x03\x30\x73\x5e\x5f\x4e\xeb\x2d\x27\xdd\x4d\xaf\xea\xe2\x3a\xa3\x7d\xef\xea\x67\xf9\x83\x9a\xe5\xab\xcd\xf9\x00\x26\x7a\xde\xcf\xf4\x5b\x33\x7d\x87\xb7\x40\xed\x87\xa6\x7e\x13\xd6\x6f\xc2\x0e\x6c\x13\xb6\xd7\xb3\x4f\x31\x4a\x9f\x66\xd4\xbf\x1e\xdd\xdd\xce\xc0\x14\x3d\xc9\xe6\xdc\x45\x8b\xea\x8f\x57\x5f\xae\xc7\xfb\xf5\xaf\x6f\x81\x67\xdf\xed\x7b\x9a\xc8\xa1\xc8\xdf\xde\xc0\xc2\xe2\xcf\x0d\x88\xff\x88\x08\x44\x34\xca\x1f\xa1\xe1\x29\x5d\x0d\xe6\x04\x88\x10\x8f\x50\xc8\x1f\x1d\x67\xd1\x15\xe0\xc0\x52\x6e\xc5\xdc\xce\x68\xfc\xd9\xa8\x57\xbb\x96\x3d\x46\xe3\x27\x6b\x02\x95\x83\xb9\x43\x0b\x94\x38\xa3\x7c\x75\x46\xf8\xe6\x8c\xf0\xdd\x06\x41\xa1\x19\xc8\xb8\x07\xcd\x40\xc6\x7d\x68\x06\x32\xee\x41\x33\x90\x71\x47\xcd\x40\xc6\x1d\x35\x03\x19\xff\xee\xdc\xf4\x93\xc6\xcf\xba\x9e\xc8\x16\x38\x7f\x22\x3c\x7d\xe1\x1e\x80\xae\xd0\x3c\x50\x93\xe6\x6b\x22\x1e\x99\xc0\x6d\xc4\x58\x85\x5b\x60\x02\xd1\x32\x88\x9c\x57\x98\x9d\x86\xa0\xc3\x04\x11\x78\x5d\x3c\x00\x69\x2b\xae\x62\xa4\x51\x36\xb1\x91\x55\x41\xd8\x2c\x54\xeb\xe0\x0d\x51\xc7\x48\xb0\xc8\x51\x67\x44\x7e\x1c\x0d\x3d\xa0\xc8\xc7\x39\xc2\xb1\x77\x93\x91\xb8\x13\x8c\x31\x1a\x3b\x22\x40\xc6\xc3\xf2\xd4\x29\x0b\x63\x34\x76\x37\x30\xc8\xb8\x3b\x08\xa3\xb1\xd6\x48\xcd\xc7\x12\xce\xd6\xe5\x1e\xfb\x1e\xb0\x1f\x1e\x74\xa7\x0d\xdd\x2a\x8c\x28\x4d\x13\x04\x88\x4b\xce\x4a\x0d\x31\x75\x87\x88\xdc\x21\xbc\x69\x6c\xcf\xef\x4c\x93\x5b\x96\xaf\x56\xa0\xad\x16\x0f\x6f\x16\x17\xef\x98\x5c\x27\x32\x5f\xc4\x86\x80\xa1\x3c\x77\x6f\x24\x76\x03\xef\x99\x78\x79\x8c\xa4\x76\xcf\xfe\x3c\x47\x25\x7f\x6f\xa0\x78\xa9\x14\x13\x88\x63\x64\x95\x94\xde\xd5\x6c\x04\x18\x5a\x48\xce\x83\xa9\xf7\x11\x90\x29\xea\xae\x8e\x15\xe6\xce\x38\xa0\xae\x2e\x83\x08\xec\x2d\xad\x61\x4b\x5b\x19\xd9\x2d\x61\x1c\x90\x18\xc1\x3e\xa2\x84\x8e\x28\x2a\x79\x70\x41\xbf\xb5\x5c\x8d\x1b\xcd\x7d\x96\x70\xac\x9e\x82\xae\xbf\x3e\xed\x37\x18\xad\x4a\xcc\x4c\x43\xa1\x92\x0a\x72\xdf\x75\xc6\x5a\xe4\xbf\xfd\xda\x0c\xa4\xe0\x97\xa1\x64\x3a\xc6\xca\x16\xf4\xae\x11\x13\xba\x11\xaf\x96\xe6\x69\xfc\xe2\x44\xc3\x2a\xd4\x94\xbf\x2e\x57\x6a\x03\xcf\xab\x39\x05\x08\xe0\x1c\xc4\x2f\x62\xc9\xb5\x1f\xe8\xb7\x38\x01\x8c\xfd\x7b\xc0\x73\xd9\x06\xda\x39\xaf\xf8\x90\xcd\xf0\x76\x41\x12\x5d\x86\xd6\x49\x55\x23\xc4\x1f\x68\x3a\xa5\x60\x36\xc4\x04\xd0\x57\x33\x45\x15\x04\xcc\xf3\x6f\xea\x09\x28\x3e\x64\x43\x80\xca\x19\xa2\x6c\x32\xc6\xff\x18\x7b\xe8\x87\x41\x15\x5c\xe1\x02\x09\x22\x53\x93\x3c\x6f\xee\x59\xc5\xaf\x0f\x2a\x6c\x68\xe5\x56\x92\xc2\x1b\xc3\x97\x9e\xb7\x90\xcb\x93\x4e\x0e\x14\x44\xe1\xdd\xd3\xb6\xc2\x1b\xed\x88\xdc\x88\xc3\x26\x04\xaa\x46\xb1\x2b\x8a\xde\x42\x6a\x29\xe1\x5d\x01\x3d\x4d\xee\xc1\x7c\x28\x23\x9c\x55\x64\x1c\x96\x82\xe2\xc5\xe3\xb0\x89\xb8\x18\xc7\x88\x31\x5f\x24\xec\xdd\xe5\xff\x45\x66\x6d\x13\x53\x4b\xb1\xab\xf1\x8f\x10\xcf\x87\x52\x3c\x65\xb7\xe8\xb8\x7a\xdd\x9e\xf3\xde\x7c\xf3\x2e\xdc\xfb\xdd\xeb\xbd\xc7\x30\xcd\x08\xc4\x64\x3a\x4c\xcd\x36\x2f\x7f\x54\x94\x74\x14\x8b\x51\x4c\xac\x0e\x2d\x2b\x30\xbe\x79\xc0\xf8\xee\x01\xe3\xd9\x19\x03\x2c\xdd\xf9\x00\x4b\x77\x3e\xc0\xd2\x9d\x0f\xb0\x7c\x0e\x62\x8b\xf7\x98\x8c\xc1\x6c\x9e\xa0\xf1\x0b\x10\xa6\xe8\xc1\x0e\x17\x20\xc9\x50\x90\xc1\x3e\x00\x1e\xbf\x3c\x08\xcd\x23\x8e\xa8\x87\xcc\xa9\x61\xbc\xd0\x4d\xab\xe1\xa4\x7d\x42\x4b\x59\x42\x73\x76\x65\x15\x75\x8b\x2d\x4a\x98\xad\x86\xe5\x5a\x7e\x77\x74\xb7\x84\x23\x4a\x40\x92\x2f\x86\xf4\xc3\xc3\xc5\x67\x27\xa6\x0b\xa7\x8a\x25\xb2\xc1\xe3\xd0\xa6\x20\x2f\x5e\xaa\x20\x20\x9a\xdb\x0d\x65\x97\xf6\x28\xa5\x10\xd1\xfd\x18\x2b\xde\x1f\xf0\x12\x19\x91\x6e\x4a\xf6\x06\xf0\xfe\x24\x9a\x4d\x3a\x62\x3d\x31\xe7\x63\x79\x2f\xbe\xfb\x5e\xaa\xef\x7d\xce\xff\xbf\x36\x73\x15\x85\x2f\x0b\x09\xc3\x4c\xd8\x4f\x68\x39\xce\xa2\xe3\x71\x46\x1d\xc4\x32\x9d\x4c\x98\xeb\x38\x5e\x7d\x80\xfc\x63\x0f\x72\x6c\x7e\xdf\xfb\xa7\x91\x7f\x8a\x6a\x53\x7b\x1f\xed\xbd\x31\xb0\x37\xee\x82\x2c\xdd\xbe\xfe\xea\xea\x80\x0d\xc4\x02\x47\x43\x9e\xcd\x29\x62\x0c\xc1\x7e\xf1\xd7\x2f\xfe\x9c\x17\x7f\xfa\x81\xc8\x6b\x10\x66\x49\x68\x45\x38\x87\x80\x83\xf0\x3e\xd0\x2f\xbb\xfa\x65\xd7\x41\x2d\xbb\x0e\xd2\xe5\x76\x0f\x38\xcf\xae\x8e\xea\x88\x53\x3f\x1a\xb4\x34\x1d\x50\xc7\x8e\x4a\xbd\x38\xb4\x93\xe5\x3c\xa1\xe5\x3a\x1f\x77\x8b\x17\x6d\x07\xe9\x2d\xe8\xf5\xd0\xc4\xfd\xa4\x81\x97\x4c\xe0\xfe\x62\x84\x80\x07\x07\x1b\x6c\x67\x27\x48\x77\xd6\x2c\xdf\x23\xc4\xeb\x18\xb8\x57\x6e\x47\x88\x6f\xd3\xeb\x85\xda\x8a\x33\xb0\x96\x2c\x7b\x9b\xe9\xec\x94\xa8\x6e\xd8\xaa\xf3\xf3\x1e\x6a\x6d\xd6\xab\xc9\x8f\xe5\xdf\x1f\xe8\xeb\x6b\xf3\x0f\xed\x27\xc7\x07\xc1\xa6\x51\xb9\x19\x3a\xb3\xa3\xe3\x33\x6b\x94\xd0\x11\xe2\xc7\x67\xa2\x9d\x0b\xb7\x47\x68\xb6\x1d\x8b\xb3\x4f\x68\x59\xa3\xf4\x27\xe0\x32\x2d\x64\xfa\x6d\x65\x15\xf9\x26\xb0\x8a\xf4\x22\x7d\x25\x3f\x13\x96\x73\x79\x23\xa5\x27\xde\x86\xf8\x37\xcc\x37\x10\x4c\xf8\x9c\xd3\x2d\x98\x9a\x09\xa2\x5d\x20\xa6\xc9\x84\xb8\x9a\x41\xd1\x70\xe5\xec\x4a\x5e\x00\xc8\xe3\x50\xdb\xf6\xb0\x45\xc8\x36\x1b\x72\x8c\x6c\x3f\xcc\x6a\x84\x22\x77\x0b\x43\xda\xd6\xf1\x66\xf8\xd4\x05\x9b\x13\xbc\x44\x30\x37\x95\x24\x8d\xe5\x55\xfc\x60\x53\x83\xb0\xad\xff\x62\xf4\xcb\xcc\x26\x83\xe4\x9d\x1a\xb1\x74\xc5\x00\x53\x8a\xa7\xd6\x83\x6c\xc6\xce\x15\xa3\x9d\x61\x62\x9d\x84\x53\xc0\x90\x6c\x26\x61\x98\x23\x8e\x18\x0e\x78\x45\xd4\x11\x46\x0c\x47\xc0\x84\xb1\xef\x21\x26\x30\xbf\x63\x75\x41\x29\xb0\xab\xcb\xcf\xef\x82\xfd\x06\xc4\x17\x07\x5a\x19\xe4\x47\x82\x8c\xff\x0a\x25\x88\x17\xb7\xc4\xa4\x04\xcc\x48\x04\x7d\x90\x32\xe9\xe0\xa6\xe2\xa0\xaa\xad\x9b\x6a\x39\x2b\xbf\x11\x46\xa7\x23\x44\xba\x46\x48\x83\x54\xec\x99\x39\xc4\x85\xfe\x12\x3b\x46\xe4\x28\x65\xab\x90\xa9\x24\x52\x10\xe5\x8a\x99\x68\x9e\x52\x2e\x47\x6f\xb6\x0f\xd4\x8e\x74\x82\x69\x75\x0b\x13\x53\xfb\xf0\x70\x59\x70\xb3\x3e\x39\x68\xeb\xc3\x15\x85\xb7\xa2\xa7\x06\x82\x93\x16\xba\x73\xa8\xaa\xb1\x9d\x01\x5e\x5d\x01\x7e\xb9\x02\xbc\x34\xa2\xaf\x45\x73\x0a\x6b\xcf\x48\xc7\x31\x66\x2c\xa5\xc7\xe6\xe6\x98\x34\xcb\x5f\x61\x33\xcd\x59\x8c\xf6\x84\x78\xc2\xdd\x10\xa2\x94\xf3\x74\x66\x83\xd1\xd2\x76\xd0\xa3\xea\xda\xf3\xf6\xc6\x6c\x55\x36\x5a\x94\x79\x30\xe9\xee\x93\x23\xf2\xf7\x06\x23\xe6\x1b\x8b\x1d\x9b\xd8\x89\x2b\xc0\x24\x08\x5f\x23\xc4\x6f\xc4\x8f\xe1\xbf\x6d\xed\x2d\x54\xba\xc1\x91\xed\xd0\xc5\x57\xc3\x04\xf3\x52\xfd\xd4\xc5\xe7\xd1\xdd\xb5\x0d\x6d\xbb\x02\x8b\xbe\xc2\xbf\xdb\x08\xac\x84\xf8\xe6\x0e\xf1\xf5\x83\xfb\x28\x1c\x21\x44\x27\xdf\xdf\x9d\x21\x5c\xb9\x10\xa3\x70\x17\xc4\x8e\x8b\x08\xf3\x09\x46\x09\xdc\xc2\x99\x01\xf6\xc3\x06\x46\xe1\x95\x13\x9c\x70\x44\x83\x38\x83\xa2\x65\x7a\x29\x7f\x6f\xed\x1b\x5e\x42\x8a\x97\x5c\xfd\x51\xa7\xe9\x9d\x54\x2e\x7a\x33\xc9\x05\xca\x5b\x4f\x2f\x43\x3d\x37\xd0\xcb\xab\xdd\x25\x90\x1e\x64\x4e\xf1\x4c\x0b\xe4\xbf\xd9\x5a\x77\x14\xe3\x85\x55\xc7\x7b\x70\x1b\x6b\xd3\x00\x4d\xd6\xc2\x2a\xb8\x48\x57\x72\x4e\x71\x74\x85\x17\x58\xa4\x05\xcc\xd4\xeb\x77\xb5\xa5\xc0\x80\xf9\x70\x82\x88\x2d\x2a\x0d\x29\x20\x2c\x01\x1c\x41\xd1\xf4\x00\xd1\x71\x9a\xd1\x18\x99\xda\x76\x91\xb5\x65\xf2\xab\x03\xad\x1c\xf9\x67\x6c\xc8\x50\x99\x41\xd1\x52\xa9\x33\xad\xa2\xe2\x17\x40\xb7\x31\x99\xa4\x30\x88\xc2\x8a\xcb\x9d\xe2\xdc\x53\xbe\xec\xc0\x2e\x1e\x1e\x9c\xad\x14\x22\xc6\x31\x91\x27\xbc\x05\xf6\x7e\x3e\xb4\x80\x39\x01\x35\xb0\xaa\xa0\x44\x9b\x42\xeb\x97\x2c\x54\xe6\x93\xc3\x49\xa8\xcb\x60\xc1\x64\xcf\xba\xcd\x56\x63\xfd\xba\xad\xfb\xeb\xb6\x47\xc4\xd2\x64\x51\x56\x73\x79\x17\xab\xd3\xb8\xed\xcf\xbe\x49\xf8\x4a\xe2\x2d\x01\xa7\xc9\x0d\x22\x31\x1a\xbf\x92\xd8\x87\x95\xc5\x29\x81\x58\x44\x85\x2a\xca\x2c\x76\x5a\x93\x04\x4c\x99\x2f\xea\x0d\xce\x1f\x2d\x38\x58\x4f\x62\xaf\x24\x1e\x68\xf9\xcd\xff\x14\xc0\x7c\xf2\x23\xf6\xce\x8f\x59\x67\x2c\xd3\xe4\x32\xc1\x88\xf0\x67\x80\x79\xe3\x82\xbc\xe1\xb9\x19\xa2\x0a\x47\xcc\x6f\x9f\xce\xb7\x50\x38\x9e\xa1\x34\x0b\x13\x4b\x7a\x06\x5d\x19\x1c\x21\x2e\xa6\xaa\x29\xa2\x9f\xce\x17\xa6\x34\x16\x73\x9c\x68\x9a\xea\xed\xca\x96\x41\xa1\xfb\xa7\xf3\xed\xe5\x63\xe0\x52\xf7\x57\x12\xe3\x45\xeb\xb6\x55\x9f\x53\xfd\x1c\x6f\xb8\xed\xdd\xa7\x98\x4d\xe8\x5a\x7b\x05\xad\xd6\xb7\xa1\x65\xe3\x34\xd6\x54\x4a\x49\x2c\xa7\x57\xff\x31\xea\xfb\xdd\x40\x0e\xa3\xcb\xc9\xa5\xda\x0d\xde\x15\x7b\x23\xd1\xe1\x7d\x85\x17\x4c\xdf\x3b\xb9\xaa\x2e\x6a\xdc\x8b\xba\x3a\x9e\xb5\x3a\x0e\xcb\xc9\xb3\x63\xbd\x0d\x1d\x98\x0d\xd5\x79\x5e\xa2\x2b\xb6\x28\x8a\xa6\x6f\x28\x98\x8a\xf7\x72\xef\x8a\xeb\x07\x45\xcd\x81\x91\xed\xf9\x6e\x64\xae\x40\x89\xc5\xdb\x63\x9f\xb3\x59\x84\xa8\x23\x52\xcd\xe2\x19\x55\xee\x51\xb7\x86\xb2\x55\xc8\xfa\x87\x76\x47\x5c\x7e\x5c\x40\xe4\x3e\x64\x56\xad\xac\x26\xb3\x00\x51\x2c\x2f\x0b\x7d\x0c\xb4\x24\xd5\x50\x9a\xc2\xb1\x0b\x14\xb1\x3d\xa0\x13\x10\x23\x03\xb8\x8e\xb1\xbe\xf2\x0a\x73\x9a\x3d\xd1\xda\x30\x0f\x9b\xe1\xa0\x9c\x4c\xb5\x9a\x7c\x02\xf7\x5d\xaf\x71\x99\xaf\x04\x63\xd7\xd6\xc8\xd7\x1e\xdd\x49\x13\x97\x09\x02\x74\xd5\x90\xcf\xdd\xfc\x8c\xaf\x3e\xbd\xa1\xec\x82\x18\x5e\x49\x3a\xac\xf6\xbf\x96\xc6\xe1\x45\xa9\xab\x0e\x73\x87\xa5\xd7\xbe\x95\xdc\xf1\xb7\x92\x3b\x02\x17\xc3\xf3\xcb\x94\x70\x9a\x26\x96\xde\xa5\x10\x4f\x5c\x1a\xc5\x56\xc7\x32\x0a\x10\xad\xfa\xdc\x64\x4d\xe7\xaf\x72\x7e\x18\x67\x91\x58\xaa\xb8\x87\x13\x46\xe3\xcf\x9a\xb5\x85\xa1\xc4\x8c\xc6\x4f\xd6\x33\xbc\x72\x30\xd6\x87\xd1\x4a\x94\xaf\xce\x08\xdf\x9c\x11\xac\x5e\xe3\x50\x68\x06\x32\xee\x41\x33\x90\x71\x1f\x9a\x81\x8c\x7b\xd0\x8c\x28\x50\x75\x46\x70\xd4\x0c\x64\xfc\xbb\x6b\x7c\x66\x34\x7e\xf6\x31\x5b\x30\x1a\xff\xe9\x65\xc2\x60\x34\xbe\x0a\x16\x74\xae\x30\x8b\x01\x85\xa5\x73\x77\xa3\xb0\xb3\x9a\x58\x4a\xdf\x6b\xae\xcb\x05\xc9\x66\x17\xeb\xce\x9d\xcc\x7e\x0f\xb6\x31\xb0\x77\xdb\xad\x40\xc3\x6c\x00\x46\x88\xfc\x27\x43\x14\x23\x66\x19\xd6\x95\x04\xec\x97\x79\x95\x24\xf8\x99\x21\x6a\x7a\xdd\x19\xc3\x30\xb2\xe7\xe7\xf4\x5d\x10\x7f\xcb\x10\x02\x93\x60\x50\x70\x21\x48\x79\x35\xa2\x64\x53\xa2\x0a\x45\xae\x44\x08\xa2\xc6\x21\x9a\x62\x62\x3e\xe0\x3f\xd4\xfe\x66\x17\x08\xda\x97\xfa\x9a\xc0\x46\x64\x76\x1a\xa4\x1c\xa1\x2c\xcd\x43\xb4\x39\x6b\x6a\x5f\xee\x11\xe2\x52\x74\xbc\x08\xad\x1e\x43\x10\x7f\x15\x0e\xdb\x71\x29\x6c\x7d\x83\x64\xf1\x4b\xf4\x3f\x14\x73\xbc\xe8\x9c\x05\x1d\x01\xab\xd9\xf1\xd2\x9a\x35\xce\xeb\x5b\x99\x92\xd5\x62\xb5\xfd\x22\xa5\x60\x79\x12\x51\xae\x32\x94\xcb\xf0\xa6\x16\x58\x15\x4b\xaa\x0d\x42\x0b\xa9\xa3\x6c\x12\xc6\x14\xae\x09\x88\x12\x84\x2d\x25\xf6\x12\xfb\x6d\xcf\x23\xdd\xb4\x8b\xd9\x69\x08\x3a\x4c\x10\x81\xd7\x3f\x33\x79\x22\x6a\x2b\xae\x62\xa4\x51\x36\xb1\x91\x55\x41\x98\xae\xb4\xc2\x9f\xa8\x63\x24\x46\xc2\x51\x67\x44\x7e\x1c\x0d\x3d\xa0\x5c\x24\xf3\x97\x30\xf1\x4e\x1a\xca\x4d\x46\xe2\x4e\x30\xc6\xa8\x6b\x15\x27\x64\x3c\x2c\x4f\x9d\xb2\x30\x46\x63\x77\x03\x83\x8c\xbb\x83\x30\x1a\x6b\x8d\xd4\x7c\x2c\xe1\x6c\xfd\x52\x54\xcc\xdc\x03\xf6\xc3\x83\xee\xb4\xa1\x5b\x85\xa1\x4e\x5d\x50\x77\x88\xa9\x3b\x44\xe4\x0e\xe1\x4d\x63\x7b\x7e\x47\x34\xf7\xcb\x57\x2b\xd0\x56\x8b\x87\x37\x8b\x97\x2b\x22\xdf\x0a\x21\xad\x96\xea\x7d\xf1\xa3\x71\xf1\x63\x9d\x7a\x45\x67\xf5\xca\x9e\x50\xdd\xd5\xb1\xc2\xdc\x19\x07\xd4\xd5\x65\x10\x81\xbd\xa5\x35\x6c\x69\x9a\xd2\xea\x3e\xa2\x84\x89\x28\xad\x97\x53\x3b\x1b\x8d\x6c\xcf\x20\x8a\x64\x6e\x10\x82\x11\x88\x7f\x74\x38\x30\x61\xd8\x1c\x0d\x6b\x27\x3a\x48\x3e\xbc\xda\xaa\x13\xb9\x45\x41\xb1\xb8\xf3\x21\xfa\x7f\x60\x32\x7d\x04\xdc\xcb\xd9\xa6\x71\x77\x01\x3d\xcc\x0c\x2c\xb5\x9d\x1a\x2a\xc2\x47\x05\x66\x11\x4a\x62\x17\xc4\x0d\x63\x29\x00\x59\x89\xbb\x20\x7a\x2a\x29\xc7\x52\x37\x0a\xdb\x16\xdb\xe7\xd0\xa3\xbc\x4c\x67\x11\x26\x88\x7e\x99\x33\xf7\x01\xc7\x6b\x30\xab\xde\x73\x5a\xa4\x0f\x41\x18\x28\x95\x91\xd8\xaa\xac\xb1\x4a\x14\xc7\xf7\x63\x57\x07\x3b\x45\x89\xef\x40\x1b\x03\xbd\xd4\x01\x8b\x6d\xc0\x9d\xed\x3b\x0f\xaa\x60\x42\xb2\xd9\x9d\xf6\xa1\x07\x53\x20\x8e\x96\x28\xf1\x52\x5c\x25\x91\xf4\xe5\x55\xbe\x3b\x5b\x59\xfa\xa3\xed\x8e\xbb\xc5\xbe\x56\xef\x0a\xec\x69\x72\x0f\xe6\xa5\xb7\xaf\xba\x74\x63\x24\xec\x2b\x52\xa6\x77\xd4\xf7\xde\x20\x01\x71\x8c\x58\x98\xb9\xec\x26\xc9\xd8\xcb\x3d\x98\xcf\x11\xec\x75\xb4\xa3\x23\x27\x6a\x65\x03\xa2\xf2\x8d\x7f\x88\x29\x8a\xb9\x11\xb7\x5a\x0e\x0d\x97\xc3\xea\x5d\x9b\x18\x82\x35\x6f\x5b\x03\x80\x14\xfc\x32\x5c\xb6\xe9\x81\x18\xa7\x18\xa2\xb0\xec\xbf\x6d\xf5\x1b\xe6\xdf\x7d\x13\x7e\xe2\x2a\x2c\x2f\xbe\xf2\xa5\xcb\xc7\xf2\xa3\x79\x56\x41\xaa\xeb\x0b\x39\xfd\x68\xd0\xd2\x74\x40\xcd\x2f\x08\xad\x2f\x86\xd5\xd8\xa0\x3a\x99\xd1\x23\x02\xc5\xdc\x56\xef\xd2\xba\xfb\xd1\xac\xe5\xa2\xcd\x57\xcd\x4e\x3d\x71\x77\x07\xeb\xb9\x70\x67\xf5\x82\x61\x7d\xd4\xf2\x10\xcd\x12\x92\x9e\x6a\xc3\xf0\xdf\x0b\x4b\x36\xbd\xc4\x96\xcc\xd2\x80\x54\x18\x0a\xce\x82\xd5\x8d\xad\x53\x7b\xc3\x34\x23\x22\x09\x31\x4c\xcd\x0e\x71\xaa\x9e\x3a\x28\xe6\x5d\x4c\xbe\xd6\x7f\x2e\x61\x8d\xf1\xcd\x03\xc6\x77\x0f\x18\xcf\xce\x18\x60\xe9\xce\x07\x58\xba\xf3\x01\x96\xee\x7c\x80\xe5\xb3\x2f\x5b\xd4\x39\x9f\xac\xee\x1e\x51\x30\x7f\xc1\x31\x7b\x44\x0c\xf1\x31\x07\x3c\xd3\xe5\xc5\x9c\x5c\x41\x4c\x3e\x44\x5e\x94\xb5\x4d\xbd\xed\xfa\xfc\xd2\xf1\xe6\xb4\xeb\x24\xdd\xe8\x75\xe7\x03\x6b\x10\xa0\x95\xd9\xb2\x51\xf6\xba\xb3\x75\x79\xe1\x1f\x2c\x58\x8f\x10\x27\x7f\x11\x2c\x0e\x74\x26\xb6\xb3\x9b\x53\x0f\x13\x8d\xa9\x9a\x2f\x11\xc2\x10\x5f\x11\xaa\x1a\x29\x43\x5f\x29\xc3\xb0\xb4\x3f\xa8\x32\xfe\xcf\xde\x17\x36\x27\x8e\x23\xfd\x7f\x95\xab\xbc\xdc\x17\xff\x1d\x4c\x66\x6e\xaf\xea\xee\x5f\x25\x6c\x41\xfc\xac\xb1\xbd\xb2\x49\x26\xfb\xc6\xc5\x04\x93\xf1\x1d\x81\x9c\x31\x6c\xe6\xdb\x3f\x25\xd9\x06\x42\x18\xfa\xd7\x18\x98\x4c\x9e\x14\xfb\x62\xb2\xf5\xeb\x96\xd4\xdd\xb2\xd4\xad\x56\xeb\x75\x29\x23\x9b\x9e\x53\x15\x1b\x1e\xa3\xf9\x06\xe9\xca\x57\x93\xaa\xea\x8d\x1e\x47\x73\xed\xf0\x37\x82\xbb\xc4\x7a\xcc\xb7\xea\xf7\x36\x54\x1f\x50\xfe\x50\xa9\x34\x52\xa9\xa9\x97\xf3\xa2\xdb\x0b\x6e\xee\xdc\x2e\xe1\x1c\x18\x90\xdc\xe2\xd2\xc0\x27\x5a\xec\x98\x1d\x27\xac\x64\x1a\xa7\x4f\xe1\xf0\x3e\xb5\x67\x0f\x0f\x59\xa1\x43\x1c\x90\x0c\xeb\xb5\xb7\x0a\xe7\x9c\x26\x12\x73\x84\x10\xc5\x7b\xed\x9a\x57\x5d\xbb\x66\x77\x36\xac\xb6\xdd\xac\x38\x89\xb5\x87\xc3\xe2\xee\x6b\xa8\x47\x92\x16\x69\x7e\x84\x5c\x5b\xf0\x3a\xd8\x3e\x3b\x30\x93\xfb\x24\xa3\x8d\x4c\xb6\x48\xbe\x1a\xaf\xcb\xbc\x65\x58\x06\xf3\xf2\x8b\xff\xbf\xfb\xe3\x54\x2d\x5b\x25\x88\x23\x83\x06\x72\xa4\x03\x48\x66\x04\xe7\x11\xe7\xe2\xcd\xc9\x73\x71\x56\x81\xf6\xd2\xe2\xed\x99\x28\x70\xf3\x73\xa7\x84\xe7\x67\x13\xf1\xe2\xcd\xcb\x78\x71\x5e\x21\xc7\xe9\xd3\xa1\x67\xee\x27\xd8\x40\x9d\xe3\x61\xa1\xda\x34\x4a\x07\xe2\x7b\x92\x5f\xb9\x8b\xe3\x34\x7f\x3d\x99\x02\xc7\xf4\xb3\xb6\xcd\xa0\xf2\x44\xca\xcc\xa0\xb9\xe5\xfc\x68\x5b\xd8\x12\xc6\x16\x17\xb3\x9d\x9e\xd3\x5c\xea\x0e\x6a\x6f\x79\xf4\xa6\xdf\xab\xfa\xfe\xca\xb8\x61\xd7\x75\x42\xd4\xd0\x68\x39\x99\x64\xf3\xe2\x3c\xf6\xd4\x7e\xb7\xa7\x9f\xd7\x9e\x8e\xe2\x39\xbd\x0a\xa3\xd4\x1f\xa9\xeb\x2c\xfd\x8b\xb5\x89\x38\x6e\x1e\xc3\x59\x4c\x7c\x47\x07\x75\x8d\x50\x76\x27\xcf\x63\xe1\x3b\x7a\xfb\x90\x4d\xd9\x11\x93\x1d\x6c\xa6\x8b\x07\x74\x66\x93\xdd\xe1\x26\x1c\x7f\xa7\x3b\x7b\xf3\x8d\xb9\xf6\xfd\xac\xb1\x8d\xaa\x2c\xb5\xc9\xeb\x07\x5d\x4b\x9d\x5f\x0d\xa7\xa3\x49\xea\xf6\x7b\xe7\xb5\xfb\xd3\x0d\xa8\xf2\x0d\x7e\xd0\xb8\x4e\xe3\x6a\x34\xfa\xbc\x55\x67\x36\xa5\x40\x16\xd9\xa7\x4b\x48\x28\xeb\xee\x1e\xe7\xd4\x65\xa7\xde\x4e\x17\x27\x7a\x31\xe8\xe5\x0f\x19\xf5\xae\x85\xf1\x90\x4b\x45\x5b\x8b\xe4\x96\x30\x7f\x39\xb5\x34\xab\x17\x3c\x0e\xb3\xa4\x7a\x22\x54\xa7\xaf\x17\xaf\xf3\x88\xf6\xcc\xf6\xf9\x3d\x89\x2e\xdf\x90\x48\xcf\x6f\xfc\xf3\x93\xe8\x6a\xe3\x5c\xb1\x5a\x63\x2c\xc7\x99\xfd\x35\x2d\xbf\xec\x98\xc2\xaa\x7d\xd3\x26\xab\x93\x6c\xf0\xde\x53\x7d\xd1\x54\xdf\x97\x2c\x9e\xe6\x77\xc3\x49\xc3\x6e\x7c\xdb\xcb\xe3\xc8\x66\x68\xee\xa8\xbd\x51\x4b\xfc\x29\x2d\x88\xed\x0b\xfc\x74\x46\xb8\xff\xcd\x7a\xc8\x02\xf7\x9a\x08\xdf\xce\x0e\x48\xd9\x7f\x39\x1b\xde\x74\xc4\xe6\x94\x17\x5d\xde\xd4\x37\xe7\xff\xd0\xea\x77\xc0\xac\x69\x64\x46\xf6\xec\xf1\x5b\xf5\x38\x5b\xf9\xd6\x8b\x7f\x4d\xdb\xcd\x5e\x7d\xe4\xe9\x70\xc4\x7f\x0b\x64\x07\xa3\xbf\xf2\xac\x48\xf9\x9c\x76\x9e\xe8\xe8\x4e\x05\xec\x53\x9d\x9d\xac\x4c\xb7\x82\x57\x7f\x42\x64\xcf\x96\xa9\x7e\x6c\x4f\x97\xeb\xe3\xe9\x74\x77\xa6\xcc\xc3\x70\xfe\x9f\x93\x76\x34\x78\x4c\x73\xe3\x1d\x36\xb6\xc0\x59\xcd\xe9\xd4\x97\xa5\x78\x3d\xdd\x35\xb5\x1b\x24\xef\x3d\x1b\xf8\xa9\xeb\x1b\xef\x78\x84\xdc\xbf\xc6\x17\x98\x13\xd6\xed\x79\xcd\xef\x8e\x3f\xe6\xd9\xc3\x5e\x46\xc7\xaf\x49\xf6\x4a\xb4\x72\x14\x91\x1e\x7c\xb3\x62\x47\x94\xe2\x04\x85\xc8\x4e\xab\xdd\xce\x24\xdb\x4c\x9c\x46\xf4\xba\x6f\x52\xe8\xd7\xca\x3e\x34\x9b\x57\xf3\xfc\xee\xb6\x39\x8b\xcf\xad\xc6\x2c\x6e\x1b\xb2\xd0\xef\x83\x7d\x68\xcc\xa2\xa9\x2c\x74\x2f\x9a\x0f\xe4\xb6\x75\x84\x5a\x21\xfb\xd6\x76\x70\x62\x8e\xb3\x49\x91\xe6\x3f\xc0\xb5\xe5\x4d\x8c\x5d\x9f\x14\xf6\x0e\xf3\x80\x3d\xfa\xcb\x8f\xef\xbb\x67\xfb\x3d\xcf\x76\x47\xc5\x23\x9e\x92\x8f\x71\xf5\xf9\x4c\x05\x8f\xea\x31\x57\xe7\x0d\xfd\x61\x91\x67\x4f\xd6\x53\x7b\xbc\xe4\x0d\xf9\x65\x6f\xcf\x7c\x30\x40\xb9\x0f\x85\x2e\x00\xf9\x38\x9b\xf3\x56\xef\x5f\x3e\xbd\xd8\xf6\xee\xbc\x5d\x77\xba\xf3\xa0\x67\x8a\x69\x3f\x59\xef\x8a\x79\x8d\x8a\xb1\x9e\x2e\xdf\x15\x53\x2a\xe6\xb7\x57\xa5\x98\xcb\x27\xeb\x5d\x31\xaf\x51\x31\xed\xf7\x19\x53\xcf\x98\x96\xf5\xca\xa6\x4c\xfb\x5d\x33\xaf\x45\x33\xe1\x6c\xf2\xed\x7e\x36\xed\xcf\x46\xcd\x1d\x8d\xf1\xf0\x2e\xe5\x88\x72\x07\x8b\x7d\x55\xe4\x8e\x54\xef\xaa\xf1\x30\x4f\xd6\x47\x9d\x07\xfc\x38\xcb\x0b\x13\x87\x5c\x36\x76\x09\xce\x19\x26\xdc\x30\x6a\xd0\xa2\x4f\x2a\xc1\xaa\xc8\xd7\xf8\xfc\x6e\xd5\xf3\xc1\x56\x27\xeb\x4d\x19\x7c\x6b\xca\xe0\xaf\xa6\x0c\xbe\x9e\x45\x5d\xcb\xb3\xe9\xeb\xc7\x99\x68\x74\x97\xcd\xe7\xb3\xfc\x8d\xcd\xf1\x6c\x7a\x5e\xf1\x55\x16\x73\x36\x7b\xd9\xb7\x13\x49\xc7\x45\x33\x0e\x5f\x66\x45\x31\x7b\x68\xaa\xc5\xd7\x1e\xf5\x7a\xae\xb9\x1f\x36\xd5\xcf\x66\xa9\x8e\xbe\x86\x64\x2e\xb1\x9a\xb9\x3e\x7e\x3b\x93\xfd\x8c\x5f\xcb\xb5\x10\x5f\xd7\x92\x3e\x6d\xca\x60\x7c\x12\x71\xe9\x5a\x44\xba\xb1\x2c\x59\x36\xde\xe6\xf2\x8f\x0d\x8e\x2f\xec\x53\x97\x79\xab\x5e\x35\xfe\x09\x64\x75\x94\x47\x8d\xdf\xe0\x38\x09\xbf\x7c\xf3\x31\xc8\xb7\xae\xe5\xcd\x24\xc7\x32\xb5\x6f\xe3\x14\xd1\xfc\x73\x99\xa5\x7f\x05\xd7\x8a\x16\x43\x7d\x86\xf7\xda\xf3\x26\xdf\x66\xbe\x23\xd6\x13\xfd\xe6\x8c\x76\xa0\xdc\x7d\x06\x85\xf6\x68\xba\x78\xd0\xbc\xe6\x27\x31\x4c\xf3\x5e\xaf\x7e\x19\xf9\x0f\x3b\xe8\xd3\xc6\xb7\xd7\x3a\xc6\x8b\x29\xab\xca\xf8\xdd\x64\xf8\xf0\x38\xde\x62\x92\xa7\x27\x5b\x7e\x9d\x3c\x5b\xa6\xb9\x3d\x9b\x16\xf9\x6c\x32\xe7\x0e\x38\x9b\xbe\x58\xfb\xcc\x04\xc1\xc7\xbb\xd3\xdc\xf6\xa4\x60\x92\x5f\xad\x7a\x09\x7e\x36\xae\xb3\x48\x2f\x2a\xf2\x6c\x7a\xcf\x15\xe1\x8e\x89\xff\xac\xef\x1c\x41\x1c\xa1\x60\x67\xeb\xe2\x7b\xec\x7e\x41\xde\x5a\xa1\xeb\x7f\xde\x7d\x1d\xe6\xdb\x3c\x9f\x0d\xb8\x14\xe3\x49\x34\x56\xae\xac\xcf\x94\x76\x2e\x75\x1d\x63\x3f\xf4\xf3\x75\x5c\x3e\x15\xeb\xfb\xe6\xe0\xd7\x05\x5c\x23\x7f\x79\xb6\x48\x36\x5c\x9b\x1e\x86\x4f\x71\x43\x46\x55\x7f\xa6\x8b\x07\x8a\xd3\x11\x24\x5a\xa5\x3b\xf3\x04\xba\xbf\x5e\x53\xd5\xff\x12\xd4\x5c\x9c\x9d\x66\x7c\xd6\xd2\x24\x18\x1d\x41\x98\x9b\xe9\x6c\x4c\x1b\xcd\x37\x48\x09\xc1\x6e\x42\x9b\x8b\x57\x1d\x83\xdb\x5a\xc8\x10\xbb\x23\x88\x7a\x63\x7f\xce\x94\xf4\x78\x4d\x49\x08\x7a\x03\xd9\x5c\xce\xdd\x23\x30\x5b\x8b\x19\xe1\x76\x04\x29\xc7\xe9\x93\xa7\x2f\xf9\xae\xaa\xfe\x65\x4b\x4c\xd8\xdf\x11\xec\xc1\xee\xc8\x11\xce\x5c\x8f\xe0\xd1\x1c\x5e\x6a\x70\xaf\x3e\x4f\x58\x53\x50\x3e\xe9\x35\x33\xf8\xf2\xef\xf4\xce\xbc\x6c\x91\x06\xcb\x34\xd7\x2f\x74\x65\x5c\x3d\x9e\xca\xe9\x6d\x24\xc0\x0d\xf9\x9d\x4a\x7c\xe5\x2c\x88\x16\x5f\xdc\x87\xe1\x7d\xfa\x43\xa4\x76\x04\xcb\x7d\xaf\x60\xfd\xaa\x2b\x58\xef\x30\x13\x34\x21\xfc\x34\xef\x97\x6c\xde\xa9\x31\xcf\xe0\x9e\x72\x95\xe9\x98\x75\x2c\x9c\x99\x5c\x78\x70\x81\xa9\x87\xdd\xd9\x08\xd1\x1d\xef\xb5\xd1\x72\xf8\x67\xf9\x3c\xeb\xd2\xba\x5f\x87\x23\xf6\x36\x46\x3f\x6b\x4e\xee\x60\x4a\xd0\x1c\x18\xf1\x9e\xf9\xf9\x30\x7c\x8a\x9a\xf1\xa9\x7a\x33\x5d\x3c\x10\x8c\x8e\x20\xcc\xaa\xca\x0f\x53\x9a\x44\x55\x9f\x5f\x9e\x95\xf5\x69\x2e\xcf\xb0\x21\xa3\xb5\x40\x29\x4e\x5c\x89\x92\xa7\x0a\xf2\xa9\x70\xe7\x55\xab\x9d\x6c\x3a\xcc\xbf\x1d\x55\xd2\xcf\x04\x7d\x7a\x33\x29\x47\x10\xcd\x16\xf9\x5d\x7a\xfe\x71\xac\xb9\xee\xfd\x6c\x95\xb3\x18\xfc\x72\xef\x0d\x8f\xcd\xcd\x40\x39\x5c\x76\x58\xdd\xfe\xb8\x5d\x53\xdd\xe4\xc3\xc7\xd9\x64\x58\xa4\x71\xfa\x64\x39\x98\x4a\xf6\xaa\x60\x9e\xdf\xb1\xee\xd1\xed\x66\x61\x35\x64\x31\x5b\x14\x8f\x8b\x82\xc3\x64\xd7\xd1\xb9\x29\x74\xd4\x1d\xde\x15\x27\xba\xaa\xb4\xe1\x55\x76\x67\xcb\xd4\xa4\x19\xdb\xb3\xe9\x38\xbb\xe7\xcd\x0d\xc0\xc5\xae\x36\x39\x6b\x20\x47\x36\x3b\x38\x4d\x17\x0f\xde\xde\x72\x9e\x20\x9f\xb1\x7e\x09\xc8\x6c\x45\xe6\x61\x9a\x7b\x47\x28\x34\x9a\xa7\xff\x5d\xa4\xf3\x22\x1d\x75\xd3\x21\x3b\x2e\xb8\xd8\x31\xff\x1e\xf3\xd9\x32\x1b\xd1\xfc\x8e\x6e\x0a\xab\x28\xc0\xfc\xd5\x9b\x03\xbb\x2a\xd4\x5e\x53\x68\x3a\x6f\x8d\x51\x35\x7e\x9b\xd3\x70\x69\xfc\x3a\xe7\xfd\xf0\x08\xcf\xa6\x6a\x26\x8d\x7b\x32\xd6\x9f\x18\x91\xa7\xa7\x49\x70\x89\xd3\x27\x39\x2f\xb2\x87\x61\x91\xf6\x67\xfa\x43\x86\xd9\xec\x5e\x8b\xd8\x73\x7a\x0a\x72\xe0\x3b\xff\xec\xc5\xe4\xb8\x52\x53\xe9\x7d\x36\x9b\x82\x13\xfe\xe7\x17\x1e\xc8\xe4\x64\x15\x73\x36\x3f\xbb\x69\x71\xf7\xb5\x33\xcc\xf3\x2c\xcd\xf7\x89\xbf\x51\x7b\xda\x1f\xcb\xa6\xf7\x6a\x58\x1c\x23\xb4\x95\x0f\x8b\xf4\x24\x62\xa9\x0e\xc2\x1a\xaf\x44\x55\xe4\xf9\xe2\xd8\xe1\xe9\xf7\x15\xe8\x7d\x05\x7a\xb1\x02\x45\xc5\x30\x2f\xe2\x6c\x72\x9c\x54\x8e\xa7\x86\xb6\xf4\xad\x21\x3d\x3b\xbc\xba\x83\x07\x3f\xba\xfa\x9d\xaa\x26\x8f\x79\x3a\x4f\xf3\x65\xda\x3f\xd5\x77\x58\x4e\x47\x87\x69\xee\x78\x1d\x5e\xfd\x39\xaf\xfe\x1e\x97\xfe\xc3\xdf\x86\x8f\xd9\xbf\x2e\xee\x27\x17\x7f\xd3\x9c\xff\x75\xd1\xf3\x92\x6b\xa9\x22\x37\xf0\x93\x56\xf2\xe1\xe2\x6f\xd3\xc5\xc3\x97\x34\xff\xd7\x45\xeb\xff\x7d\xb8\xd8\x60\xae\xdd\x90\x2c\x4f\xd7\xff\x47\xff\xfe\xa9\xa3\x0d\x6b\x3e\x8e\x0c\xe3\xab\xa4\x33\xe8\x76\xa5\x4a\x3a\x6e\x7c\xf1\xeb\x5e\x78\x14\x4b\xdf\x76\x3d\x9c\xc0\x0e\xbc\x40\xe1\xf0\xae\xf0\x22\x49\x60\x62\x35\xa0\x20\x61\xe0\xfa\x71\x44\x80\x3c\xd7\x97\x08\x26\xf1\x82\x20\x44\x70\x51\xac\x5c\x0a\x18\x2b\x57\xf8\x3d\x4f\x46\x20\x8e\xc5\x34\xe9\x0a\x9f\x80\xfe\x31\x10\x0e\xd5\xb6\x2f\xaf\xa5\x22\x30\x9e\x8c\x28\x36\xf2\x8f\x81\xf0\x48\x36\x00\xa8\xa7\xa4\x88\xc9\x1e\xf9\x41\x8c\xb4\xd8\x43\x40\xc2\xbb\x11\xb7\xd4\xf8\xfe\x94\x2a\x20\x20\x81\x4f\x59\x6a\xa4\xec\x72\x8e\xd0\x9c\x92\xbe\xeb\x0f\x22\x98\x42\xe3\x84\x17\x5e\x09\x16\x67\x84\xc2\x89\x62\x26\x67\x94\x42\xe3\x78\xd2\x40\x29\x56\x63\x4b\x22\x11\x0f\x94\x88\x29\xc5\xf8\xb4\xee\xba\x2a\xf0\xe3\xc4\x93\x5d\xf2\xab\x66\x80\xca\xed\x5d\x51\xc8\x8e\xb0\x7f\x47\x38\x1a\x1c\xc2\xd0\xf4\x11\x60\x46\x40\x80\x2e\xc1\xbd\x49\x84\xef\x20\x6d\xfa\x41\x22\x95\x22\x35\xeb\xfa\xd7\xc2\x73\x9d\x44\xfa\x83\x3e\x08\xbd\x16\xde\x40\x82\xd8\x20\x94\x4a\xc4\x6e\x40\x7d\x58\x83\x41\x9c\x04\xdd\xa4\x2f\xfb\x81\xba\x25\xb0\xf6\x0d\x05\x20\x11\x66\x85\x4b\xf4\x23\x96\x30\x30\x51\xc2\xef\x31\xe0\x3d\x25\xfc\x81\x27\x94\x1b\x53\xe3\x31\xab\x64\xd4\x0f\x82\xf8\x0a\x41\xde\xb8\x0e\x03\x08\x75\x7b\x03\x8e\x77\x3b\x0c\xbc\xdb\x5e\xe0\x27\xfd\xc0\xa1\xc5\x52\x42\xa1\x41\xda\x03\x4f\xef\x64\x6c\x89\xe2\x90\x1e\x94\x53\x07\xe0\x5a\xee\xe8\x10\xa1\x95\xc8\x58\x46\xd4\xb4\x2d\x81\x37\xca\x8d\x65\x5f\x44\xd4\xbc\x2d\xd1\xb6\x27\x85\x82\x66\x5a\x89\xef\x0e\x7c\x1b\xdc\x7c\x02\x3d\xae\xa1\x78\x2f\x6a\x0a\x46\x3f\x0c\xdb\x04\x90\xc8\x8a\xb7\x70\x3d\x10\x1a\x8a\x28\xaa\x25\x73\x10\x95\xfe\x27\x48\xa5\x64\x17\x44\xa2\x26\x70\xed\xca\x9b\x30\x50\xa4\x5d\xb9\xf1\x15\xb9\xaf\xeb\x78\xd2\x77\x12\x87\x54\x79\x89\x8b\x14\xa5\x3d\x83\x23\x30\x5e\xd0\x73\xed\x24\x08\x91\x89\xe9\x28\x71\x53\x39\x38\x04\x52\x49\xe1\x60\xc8\xc8\x76\xa3\x48\xfb\x4d\xc1\x67\x10\x09\xcc\x09\xb3\x4d\x62\xcc\x88\x12\x0f\xcf\xfa\x60\xd0\xf1\x24\x36\xba\x58\x2a\x19\xe0\x6b\x4a\x72\xe5\xfa\x31\xeb\x03\x8d\x50\x0c\xfc\x50\x6f\xa2\xa2\x1b\x11\x26\x9d\xdb\x58\x46\x18\xde\x8b\x3a\x49\xd7\x55\x51\x8c\xc1\x55\x70\x93\x78\xd2\xef\xc5\x57\x18\x3e\xfa\xdd\x0d\x13\x15\xdc\x80\xbd\x31\xf0\xd0\xfd\x2c\x3d\x90\x40\x78\x6e\xcf\xef\x4b\x5a\x9e\x2c\xd9\xb0\x24\xc3\x93\x0b\x4b\x2a\x4c\x99\xb0\x24\xd2\x17\x9f\x93\x58\x7e\x8e\x07\x4a\x22\xfb\x2e\x0d\xaf\x3f\x83\x89\xe3\xf6\xa9\xbe\x44\x83\x8e\xe9\xb3\x0e\xc2\x50\xd8\xba\x1b\x2d\x07\x04\x5a\x28\x10\xd9\x9a\xd5\xd8\x2b\x09\xec\xf8\x6b\x70\x27\x50\x8e\x54\x90\xab\xe6\xe8\x8d\x8e\x2d\x14\x25\xe1\xae\x88\x80\xaf\x9e\xef\xda\x34\x48\xcf\x7f\x02\x32\xf0\x23\xb7\xe7\x4b\x07\xc1\x46\x57\xf4\xda\xb7\xe2\x87\x80\x5d\x1f\x66\x47\x43\xbb\x5e\x20\x28\x4c\x14\xeb\xa9\x11\x5c\x4b\xd5\xf5\x02\xca\x17\x29\xc1\x03\xdf\x81\xd0\x66\x01\x22\x30\x82\x5c\xa1\xb5\x0f\xa9\x74\x9c\x8a\x0c\x17\xda\x41\x48\x39\x02\x9a\x99\xeb\x5f\x4b\x15\x4b\xaa\x5d\x9f\x8e\x05\x7e\x26\x2d\x9c\x04\xf8\x24\x42\xfe\x31\x70\xaf\x09\x4c\x39\x24\x02\x14\x28\x86\x1c\x51\x29\x05\x0a\x96\x27\xad\xea\x48\x82\x1f\x19\x02\x05\x7d\x7b\xf4\xde\x19\xdb\x0c\x63\xa8\xc4\xf5\x1d\xf9\x19\xf3\x98\x82\x7e\x18\xf8\xf4\x42\xa4\x48\xa1\xf6\x94\x94\x54\xbc\xa2\x43\x6f\x00\x91\x88\x9d\xea\x75\x68\x04\xc5\xc4\x04\x1d\x2e\x56\x69\xed\xeb\x2a\x8d\x04\x9d\xde\x23\x1e\x40\xd6\x75\x3d\xef\x00\xb2\xdf\xa5\xa4\xa6\xbe\x92\xa1\x47\x7b\xe8\xae\x6f\xd3\x76\x48\x42\xae\xa5\xef\x90\xf6\xac\xa4\xfe\x2a\x93\x3b\xf2\xea\x34\x89\x40\xc9\xcf\xb1\xf4\xf5\xa9\x13\xb5\x3f\xf1\xa5\x50\xf4\xa2\xab\xb5\x27\x14\xc6\x2a\xe9\xbb\x61\x5f\x84\x2c\xce\x3c\x9a\xad\x86\xa0\xce\x3d\x6f\x07\x22\xa9\xf7\x43\x7d\xd1\x4b\xba\xae\x17\x4b\x98\xc0\xf5\x79\x04\x37\x4a\x84\x09\xba\x93\x34\x60\x4a\x44\x4a\x86\x52\xc4\x98\x29\x25\xad\xa4\xf5\x02\x59\x9d\x6e\x56\xe0\xfb\x89\xbd\x98\x4c\xba\xc3\xbb\x94\x04\x76\xf3\xd9\xb4\x80\x90\x57\xd9\xb4\x20\x41\x5e\x36\x4d\x6f\xb2\x51\xf1\x95\x44\x9a\x4c\x0c\x53\x84\x80\x46\x7e\xff\x3b\xb2\x8d\xad\xaa\xc3\x91\xb8\x38\x7d\x5a\xa5\xbd\x8c\x79\xe8\x25\x0b\x9e\xf1\xd0\x10\x73\x73\x79\xae\xe5\xc0\x50\x8b\x86\xae\x1f\xeb\x22\xa1\xf6\x24\x1d\x82\x28\x7b\x36\x01\x74\x61\xa0\x51\x91\x4e\xef\xb2\x09\x06\x36\xe5\xdc\x48\x68\xc5\x52\xa7\x35\xd0\x6c\x75\x4f\x21\xa4\x69\x1b\x43\x96\xe5\x21\x48\x9c\x9c\x42\xb0\x6e\x36\xcd\xe6\xf4\xbc\xea\x4e\x16\x00\xaa\x33\x49\xa7\x23\x5d\x4b\x87\x44\x7a\xb3\xfb\xec\x2e\x78\x44\xa5\x0d\xf1\xac\xb0\x00\x57\x23\x6d\x88\x67\x98\x3d\xa5\x13\xfd\xa2\x6b\x3a\x66\x60\xe9\xe9\xb9\xae\xf8\x0c\x41\x0d\xeb\x39\x09\xd5\xc5\x22\xca\x3b\x41\xf4\x8c\xef\xa5\x85\x33\x5b\x7c\x99\xa4\x10\x54\xe6\x39\x30\xe7\xea\x0a\x7f\x10\x4b\x77\x5a\xa4\xf7\x69\x0e\x61\xcb\x32\x31\x08\xb2\xfe\x3a\x81\x58\xce\x07\x78\x8b\x02\xf8\xaa\xee\xba\x9e\x3f\x3e\x8c\x0c\x68\x6d\x55\x4c\x8e\x44\xae\x0b\x57\x92\xd0\xba\x0a\xf2\x26\xf0\x9f\xbf\x3e\xcb\x54\xfa\xe7\xaf\x55\xda\x13\x23\x09\xaa\xb5\x99\x04\xd5\xe2\x26\x41\x19\xcf\x70\x15\xf4\xbf\xf8\x75\x2f\xb8\x8e\x34\x07\xdd\x6e\x24\xe3\x64\xe0\xd3\xf1\xba\x2d\x92\xd2\xdd\x61\x91\xe8\xbd\x25\x8f\xc2\x38\x39\x3c\x0a\x61\xc7\x81\x02\x37\x8c\x1d\xd7\x77\x5c\xbf\x87\x87\x20\x6b\x02\x38\x14\xe9\xfa\xb1\x54\xbe\xf0\x92\x6e\xa0\xfa\x22\x06\xa9\x94\x74\x90\xe8\x6c\x0d\x37\x9e\x32\x87\x40\xbb\xcd\x1c\x7c\x95\x73\x42\x13\x94\xe7\x27\x04\x28\x54\xc1\xe7\xdb\x15\xef\x96\xc3\x82\x93\x92\x57\xed\xa4\xd7\x4e\x3a\x16\x05\xeb\x75\x2e\x69\xc8\x47\x1a\xf2\x1b\x0d\x69\x7d\x00\x30\x40\x87\x5b\x9f\x68\x8c\x00\xf8\x08\x64\xe4\x89\x68\xd1\x28\x01\x8d\x3e\x81\x3a\xd5\x82\x40\x94\x08\x74\xb0\x50\x7e\x4e\x84\x52\xe2\x96\xfc\x8e\xaf\x9f\x90\x85\xa0\xf5\xcb\xa6\x24\x58\xdf\xd3\xad\xaa\x02\xa0\x2e\x57\xf9\x7a\x33\x89\xd6\x6f\x63\xd7\xab\x79\xcb\x61\xc1\x2d\x18\x5e\x17\x0d\x69\xb1\x29\x80\x36\x78\xfc\x79\xbc\x3b\xd9\x74\x54\x5d\xb9\x20\xb1\x4e\x3a\x49\xcd\x6d\x5d\xbd\x2c\x23\x2a\x9d\xc2\x58\x77\x5e\x41\x8f\xbc\x3f\xb0\x36\xf7\x07\x16\x77\x7f\xb0\x3a\x5c\xd1\x67\x3f\x49\x3b\x69\x27\x16\x7a\x1c\x63\x4e\x77\x92\xcb\xf2\xc7\x23\xfa\x68\x7e\x2d\x94\x48\xe7\x6d\xfd\x56\xfe\x38\x24\xad\x0f\xd5\x7f\xd4\x90\xb6\x17\xf2\xb6\x43\x10\xac\x0f\x63\xdd\xbe\xe8\x61\x67\xc8\x06\x89\x9d\x2c\x0e\x7c\x66\x03\x03\x9f\xdb\x44\x3d\xe4\x36\x6f\xa1\x6d\x3b\x20\x5f\xe4\xb4\xa1\xc6\x9a\x08\x1d\xb5\x3b\xd3\x87\xce\x6d\x67\x45\x03\xec\x53\x56\xb6\x60\x4c\xdb\x32\xc6\xad\xe4\x35\x4a\x55\x5b\xea\xa7\xe4\xe3\x01\x24\xfc\x96\xaa\x89\xc4\x27\x6c\x55\xd3\x89\x41\xb8\x31\xa1\x38\xed\x69\x32\x6b\x3d\xad\x68\xca\x4e\x8f\x52\x6b\xa7\xa7\xa8\x23\x14\xad\x79\xe9\x49\x9d\xc7\x10\xe9\xef\x5e\xac\x8f\xbd\x39\x34\x7a\x7b\x4e\x93\xd8\x9e\xe8\x87\x49\x1c\x24\xd2\xe9\x49\xd0\x72\xfb\xae\x9f\x78\x01\x3a\x27\xf4\x40\x70\x74\x47\x44\x32\xf1\xe4\xb5\xf4\x40\x02\xc3\x1e\xc0\x57\x79\x44\xcc\xec\xd9\x97\x54\x78\x36\x6a\x45\xcb\x4c\x7c\x7d\x49\x85\xb7\x28\x3c\x57\x44\xd2\xe1\xe6\xda\x2a\x19\xd9\xc2\x93\x89\xaf\xfd\x32\x4a\x8e\x9e\xce\x11\x31\x69\x74\xb5\x9b\x6d\x07\x7e\xac\x02\x52\xfe\xae\xbe\x25\x83\xa5\xf6\xcb\x50\xe8\x84\xfe\x24\x0a\xa5\xad\x47\x0e\x51\xd5\x83\x27\x35\xbc\xbd\x45\xd1\x3b\x5f\xf3\xd2\x09\xbc\xa7\xad\xb7\x91\x6d\x07\x81\xd6\x9b\xb6\x36\x7b\x0b\xd9\x76\x8e\xbc\x6b\x6a\x6f\xee\x9a\xda\xdc\x5d\x53\x35\xe5\x3e\x60\x33\xb3\x85\xc1\xc0\x5d\x4a\x1b\x83\x5d\x62\xb0\x8f\x18\xec\x13\x06\xfb\x3b\x06\xfb\x0d\x83\xfd\x03\x83\xb5\x3e\x80\x38\x50\x0f\x2d\x0b\xc4\x81\x9a\x68\x81\xaa\x68\x81\xba\x68\x7d\x02\x71\xa0\x36\x5a\xa0\x3a\x5a\xa0\x3e\x2c\x50\x1f\x16\x3a\x2f\x40\x7d\x58\xa0\x3e\x2c\x50\x1f\x16\xa8\x0f\x0b\xd4\x87\x05\xea\xc3\x02\xf5\x61\x81\xfa\x68\x83\xfa\x68\x53\xfa\x10\x76\xec\x5e\xcb\x1a\x4e\x80\xfb\x03\x2f\x76\x23\xd1\x0f\xc9\xc8\x5f\x09\xaa\xc2\x89\x71\x90\xd8\x3a\x37\x4f\xf4\xb8\x64\xc0\x85\xc4\x92\x82\xc7\xbf\x4c\xfb\xa6\x36\x8f\xe5\x18\x22\x5e\x07\xb0\xcb\x1b\x5b\x34\x50\xe6\x5b\xa5\xa2\xc4\x1e\x74\xf4\xbe\x30\x04\xe1\xb5\xff\xcb\x24\xab\xe1\x49\x18\x44\xae\x31\x91\xcf\x5c\x4a\x5f\xf6\xc4\x61\x94\xab\x36\x6f\xb9\x94\xab\x36\x6f\x0f\x6e\xf3\xcf\x83\xdb\xfc\x93\xe5\x76\xd7\xf4\x04\x91\xf6\x00\x56\x4d\x31\x3c\x65\x3b\xe8\x87\x4a\x46\x7a\xcf\x48\x27\xbd\x3d\x07\x53\xce\xdb\x6a\x04\x15\x95\xbe\xd8\x0f\xdc\x60\xd8\x26\xd3\x3e\xab\x09\x6e\x00\xa3\x79\x49\x4b\x10\xf8\x83\xfe\x66\x43\x35\x7d\x79\x38\x43\xcd\xe9\xc3\x09\x6b\x7f\xb3\xcc\x1f\x27\xd1\xae\xf4\x63\xe6\x27\x78\x23\xad\x1f\x39\xcb\x8b\x95\xf0\xa3\x30\x88\x64\xe9\xd4\xe8\x14\xff\xa4\x2f\x62\xe5\x7e\x86\x09\x43\x15\xfc\x8f\xb4\xf5\x9d\x52\x2e\x65\xdd\x51\x26\x99\xf1\x87\x30\xa2\x8d\x15\x09\x28\xd7\x50\xba\x80\xc0\xa4\x53\xb2\xeb\xad\x86\x1c\xe2\xd6\x82\xa4\xa0\x6e\xc0\xbd\x41\xdf\xf5\x85\x6f\xcb\x03\x48\xb8\x6d\xe9\xf3\x49\x3f\xa2\xbd\x6c\x3b\xe8\x77\xe8\xd3\xdb\x0a\x85\x7d\x5a\x34\x3f\xa8\xbb\x51\x30\x50\xb6\xfc\x00\x70\x2d\x91\x2d\x18\x69\xc1\xc8\x0f\x8c\x9e\xb6\x18\x58\x0b\xc2\x9a\xeb\xdb\xbe\x83\x88\xa0\x82\xb6\x70\xa8\x85\x43\x31\x29\x54\xe0\x16\x07\x8c\xc9\x41\xf5\x3a\x89\x09\xdb\x10\x38\xe1\xe8\xf3\x73\x1d\xff\x24\x80\xda\xfc\x55\x18\x78\x74\x25\x85\x68\xd0\x89\x95\xb0\xa9\x6f\x89\x1d\xf8\x51\x2c\xe8\xc4\x08\xe5\xf6\x85\xba\x85\x62\x3c\xa1\x92\xd7\x6e\x30\xa0\x3e\xe9\x4e\x10\xb7\x01\x4d\xd6\x30\x41\xc6\x65\xc4\x5d\x91\x2d\xeb\x03\x32\x12\x1d\x99\x07\xf2\xec\xd9\x32\xcd\x91\x44\x23\x7b\xf6\xa0\x6b\x21\xcd\xd3\x11\x23\xae\xf4\x92\xc8\x3a\x84\xa8\xc5\x24\x62\xc5\xb2\x76\xd0\x59\x07\xd2\x01\xfd\xec\xa5\xc5\x33\x52\x2c\xcb\xcb\x9e\x64\xe9\xb4\xe0\xe9\xd7\xbc\x46\x19\xa7\x4f\xf6\x6c\x96\x8f\x5a\x23\x2e\x7e\xc9\x24\x18\x73\xf1\xdc\x06\x32\x2e\x9e\xdb\xc0\x9c\x8b\x67\x36\x60\x31\x75\x60\x71\x75\x60\x31\x75\x60\x8d\x97\x4c\x02\xa6\x0e\xac\x6c\xc9\x24\x60\xea\xc0\x9a\x33\x1b\x68\x33\x75\xd0\xe6\xea\xa0\xcd\xd4\x41\x9b\xab\x83\x36\x53\x07\x6d\xae\x0e\xda\x4c\x1d\xb4\xb9\x3a\xb8\x64\xea\xe0\x92\xab\x83\x4b\xa6\x0e\x2e\xb9\x3a\xb8\x64\xea\xe0\x92\xab\x83\x4b\xa6\x0e\x2e\x01\x1d\x78\xb3\xe1\x28\xce\x87\xd3\xf9\xe3\x6c\x9e\xf6\x87\x45\x9e\x3d\x8d\x0f\x21\xc2\xb4\xc7\x6e\x69\x07\xd1\xe8\xc8\xe7\x4a\x97\x9b\xe7\x4a\x97\xdc\x73\xa5\x55\x41\x10\x60\xcf\xb6\x2a\x0a\x02\x63\xd1\x52\x65\x6b\xce\x08\xba\x3c\x65\xec\x0a\x47\x26\xf1\x95\x92\xd1\x55\xe0\x41\x79\xaa\x5b\x37\x43\x5b\x9f\x78\x78\xeb\x92\x87\x6f\x53\xa7\x08\x7d\x57\x57\xe4\x92\x0e\x76\x17\x6c\xb3\x64\x82\x17\x38\x49\xc7\x15\x64\x78\x85\x07\x77\x7d\x5b\x97\x28\x21\xe3\x0f\x8e\xc4\x70\x75\x6f\x4b\xb9\x33\xe3\x68\x42\x49\xa4\x60\xcc\x36\x09\x50\x71\xa8\x34\x36\xc4\xe5\x29\x91\xba\xc6\x21\x50\xb3\xac\x76\xb9\x98\x35\xf7\x58\x64\x2b\x30\x32\x4d\x76\xb4\x81\x90\x69\x09\x26\xc2\x71\x10\x58\x75\xd9\x1d\xf5\x4a\x0d\x0d\x88\xed\xbb\x94\xc0\xfb\x82\x8a\xbf\x6d\xa4\x23\xd0\xec\x36\xc1\x20\x67\xc7\xd5\x4e\xb6\x2d\x13\x11\xc7\xd2\x87\xac\xa4\x27\x7d\x5d\x02\x4f\x56\xf7\x5c\x79\x68\x24\x8e\xdd\x0d\x7a\x89\x1d\x04\xca\x71\x7d\x4d\x58\x86\xa2\x58\x34\x14\x58\x89\x9e\xce\x72\x82\xae\xf7\xdb\x03\xa5\x34\x96\xd7\xc2\x33\x70\x99\x43\x9d\xc4\xb7\xe1\x41\x74\xba\xe4\xab\x73\x10\xa5\x31\x08\xa9\x0e\x20\x25\x48\xcc\x07\x22\x89\x06\x7d\x50\x78\x91\xb4\x03\xdf\x41\xe3\x34\x5b\xe8\x5a\x0c\xf4\xd7\x77\x37\x21\x20\xf7\xef\xb4\x88\x08\x7e\x37\x29\x26\xf9\x9d\xb4\xe0\x72\x51\x5e\xfe\x06\xd3\xa7\xea\xd2\x7e\xd5\x71\x01\xbd\x28\xd5\x8b\x91\xd2\x87\x2d\x15\x1d\xb9\x55\x5c\xdd\xcf\x8c\x52\x5d\xd8\xba\x00\x63\x21\x8c\xbb\x03\x2b\x3c\x9c\x6c\x65\x6e\x6c\xaf\x6f\xc7\x71\xf1\x4b\x26\x41\xc6\xc5\xd3\x0d\x18\xa9\x62\x97\x92\x0d\x54\xfe\x77\x61\x5e\x48\x20\xd1\xdd\xd9\xbd\x71\x52\xc6\x38\x72\x09\x43\x47\x38\x12\x67\x5a\x5d\x05\x21\xf1\x51\x7a\x37\x9b\x8e\x86\xf9\x37\x23\xb6\xf6\x17\x36\xc1\x92\x4b\x31\x62\x13\xb0\x9b\x18\xb3\x09\xd8\x4d\x64\x6c\x02\x76\x13\x73\x36\x01\xbb\x89\x05\x5b\xdf\x8b\x2f\xfc\x46\xd8\xb2\x5a\xf0\x85\xb5\x60\x4b\x6b\xc1\x16\x17\x3a\xa7\x6e\xb2\xe9\x68\xf6\x57\x38\x9b\x5b\x23\x0e\x76\xc9\x00\x8f\x39\x58\x0e\xe3\x8c\x83\xe5\x30\x9e\x73\xb0\x0c\xc6\xed\x11\x07\xcb\x61\xcc\x90\x71\x7b\xcc\x61\xcc\x90\x71\x3b\xe3\x30\x66\xc8\x78\xeb\x63\x71\x84\xd0\xd4\xc7\xcd\xd0\xd4\x47\x76\x68\xca\xe4\xc1\x21\x3b\xd7\xea\x45\x8c\x41\x44\xe7\xd8\xfd\x31\x90\x66\x1f\x3d\xd0\x27\xae\x48\x79\xc8\x7a\x13\x6e\x08\x21\xe6\x4a\x46\x03\x2f\x66\x40\x13\x71\x2d\x5c\x4f\xd0\x37\x96\xcd\x0e\xb7\x1a\x2d\x01\xad\xae\xa2\x70\x48\x36\xa1\x75\x62\xde\x01\xad\x80\xa4\xf5\x05\xd9\x38\x56\x6e\xe7\x10\x06\xa6\x00\x70\xe0\x7b\x54\xca\x88\xa9\xb9\x8b\x00\x0d\x43\x83\x26\x80\x55\x37\x85\x6d\xd3\xef\x76\x54\xd8\xbe\x08\x43\xe9\xc0\x58\xd4\x03\x8a\x95\x14\x7d\x53\x34\x19\x43\xea\x21\x62\x48\xa0\xd0\x63\x14\x8b\xd8\xb5\xb1\xd6\x0d\x12\x6a\xdd\x20\x81\xd6\x9d\x5b\x5f\xf4\xb1\xe6\x6b\x28\xd0\x7e\x0d\x45\x86\x6f\xf2\xba\x22\x53\x1b\x9c\x54\x6d\xa4\x6c\x2c\x0f\x65\xf3\xde\x38\x6f\x3e\x54\xe9\x63\x07\x50\x6e\xba\xde\x2c\x42\x53\x8e\xf1\x10\xc2\xda\x97\x36\xd1\x9a\x43\x18\xe8\x1b\x73\x49\xd7\x13\xbd\x43\x88\x77\x07\x1d\x58\x2c\x76\x06\x8c\x58\x1c\x6e\xcc\x9d\xd9\x46\x6d\x03\xf5\xd9\x57\x58\x70\x95\x43\xf1\x2b\x1c\x1e\x25\xda\x26\xe1\x05\xe6\x58\x91\xa1\x2d\x2a\x66\x1b\x2c\x65\x44\xca\x86\x32\x02\xf5\xfc\x87\x60\x50\x2e\xa0\x6e\x14\xf9\x9a\x18\x7e\xbb\x81\xdb\xdb\xc0\x5e\x3a\xfd\x63\x91\xe6\x19\x50\x57\xa0\x2c\x59\x80\xa2\xdd\xb9\x46\x7e\x23\x71\x9d\xf4\x3e\x9b\x62\x50\x39\x1d\x61\xc0\x5e\x5a\x18\x60\xb6\x84\xa1\xc1\x97\x7f\xa7\x77\x05\x9b\x00\xf1\x4f\x75\x59\x08\xb0\xae\x58\x29\xe2\x12\x4c\x8b\xb8\x97\x4e\x51\xa8\x3b\x07\x7b\x50\xc2\x9c\x61\x31\x04\xa1\xd1\xe2\x0b\x84\xd6\x75\xd0\x58\x04\xfd\xe1\x23\xd8\xe7\xc1\xf4\x01\xc6\xae\xba\xc1\x89\x26\xae\x89\x76\x94\x55\x69\xec\x30\x59\x9b\xcf\x0f\x5a\xfc\xe7\x07\x9f\x1f\x57\x02\xdf\x91\x5d\x7b\x70\xe9\x6b\x47\xc4\x39\x80\x12\x70\xd4\x76\x92\x21\xeb\xc0\x2e\x42\x60\xcd\xa9\x97\xb5\x67\xe4\x58\x53\xa1\x0a\x7a\x4a\xf4\x37\x8e\x05\x0f\xe8\x22\xba\x8d\xaf\xde\x81\xd4\x45\x3b\x18\x4f\xe6\x94\x78\xfc\x41\x1b\x83\x3f\xec\x2d\x9c\x6d\x52\xe0\x41\x1c\x9d\xaf\xb0\xf1\x9c\x4b\x84\xbf\xfc\xf2\x01\x87\xb6\x70\xa8\x85\x43\xdb\x38\xf4\x12\x87\x7e\xc4\xa1\x9f\x70\xe8\xdf\x71\xe8\x6f\x38\xf4\x1f\x38\xb4\xf5\x81\x81\x65\xe8\xab\x65\x31\xb0\x0c\x8d\xb5\x2e\x19\xd8\x8f\xac\x0c\x11\x68\x4f\xa6\xe7\xc5\xb3\x6f\x45\x74\xc0\x87\xa5\x74\xf6\xdc\x3f\xa5\xc3\xc8\x1a\x32\x85\x7d\xa0\x1a\x8b\xab\x33\xfe\xe8\x4a\xd0\x37\xc5\xaa\x0e\x42\x58\x3d\xfa\x15\xf7\x81\xef\xea\x2b\x6f\xeb\x94\xa9\x08\x97\xdd\x61\xb4\x42\xdd\xea\xeb\xa6\xe6\xd5\x0e\x46\x63\x7c\x09\x6a\xe2\xea\x72\x91\x73\x00\x79\x29\x4b\xc8\xad\xd2\x43\x49\xae\xa5\x4d\x4d\x97\x15\xb0\x8d\x02\xa9\x89\xe2\xfa\x50\xc3\xae\x0f\x35\xeb\xfa\x50\xa3\x9d\x80\x3c\xaf\xd7\x10\xa4\x63\x35\xae\x0d\xe2\xa8\xae\x95\x82\xeb\x8b\xd8\x42\x81\x6d\x14\x48\x35\x5d\xde\xf3\x56\x74\x05\xd1\x1a\x68\xa1\xc0\x36\x0a\xd4\xd7\x80\x41\x68\x4b\x17\x92\x12\x4e\x70\x03\xe2\x2d\x10\xef\x48\x4f\xea\x9c\xa7\x58\xc4\xe4\x3d\x25\xfd\xdd\x70\x3d\x10\xec\xb9\xfe\xef\x18\xd2\x3c\x9f\x2a\xd0\x4e\xb8\x7e\x37\xd0\xa5\x72\xb1\xd7\xbb\x44\x1c\x0b\xfb\xca\x54\xfd\xd2\x5f\x87\x08\x2b\x9e\x50\x7d\x28\x79\xe8\xaa\xb4\x13\xd0\xa9\xea\x4b\x55\xe6\x99\x81\xe3\x28\xaf\x14\x97\x3b\xe5\x41\x2c\xc1\xae\xad\xf0\x78\xe7\xb6\xd6\xb1\xc4\x91\xca\xbd\x2e\xaf\xfd\x03\xa9\x74\x7a\x68\x7a\xb5\xf0\x84\xdf\x1b\xe8\x2f\x77\xe5\xa8\x81\x4e\x47\xe5\x41\x60\x69\x87\xa1\x3e\x77\xa8\xc2\x50\x81\x72\x7b\x64\xba\xa2\x17\xdc\x48\x85\xbc\x6e\x3c\x08\x43\x0c\xf8\x6c\xaf\x8f\x3f\x63\x69\xe0\xec\xd7\x3b\x0d\x15\xfc\x02\xe6\x73\x97\x2c\xbe\x09\x92\x88\xf6\x19\x37\x25\x4b\x40\x4b\xb9\x63\x0f\xce\x6c\xee\xa8\x0c\x5d\x44\x86\x0d\x9e\x65\x16\xc1\xe9\x5d\xeb\x07\x1a\xe6\x78\x79\x7d\x98\x7b\x45\xc1\x4a\x38\xab\x68\xf4\x53\x08\x30\x8d\x28\x8a\xe1\xdd\xd7\xe8\xeb\x70\x04\x04\x65\x74\x84\x4c\x14\x45\x9e\x7d\xf1\x66\x77\x58\x1e\x96\xbe\x77\x98\x4d\x52\xb0\x01\x3b\x4f\x87\x45\x1a\xe6\xb3\xfb\x7c\xf8\x00\xa2\x41\xd6\x65\xc8\x0e\x65\x5d\xa2\x61\xd6\x0c\x19\x56\x6f\x50\x5c\xa7\x79\x91\x3e\x95\xc2\x34\xf9\x81\x24\xa1\x9c\x1e\x46\xd7\x4b\xab\x3b\x9c\x25\x11\x8e\x1f\x4c\xb3\xf1\x2c\xa7\x65\xa5\x1b\x30\x56\x94\x8e\x4a\x19\xd0\xf3\xa1\x24\xe1\xd8\x91\xae\x95\x5c\xea\x2e\x5b\x32\xc0\xee\x74\x3c\xf3\x66\xd0\xeb\x07\x65\xdf\x31\xee\x25\x96\xcd\x3c\x9a\x2d\x72\xe0\x5d\x9f\x5e\x5a\x54\xb2\xe7\x88\xa7\x22\x19\x2f\x19\x60\x6c\xb4\x9b\x36\x37\x62\x53\x8c\xd9\x14\xfc\x5e\xed\x8a\xf7\xee\xa4\x73\xe7\x95\x61\x00\x48\x70\x42\x7b\xd9\xf4\x3f\x28\x53\x96\x19\x0c\xe6\xf0\xe7\xaa\x52\x67\x6b\x8c\x22\x2d\x18\xd9\x86\x91\x97\x30\xb2\x95\xa1\x48\x0b\x46\xb6\x61\xe4\xf7\x2e\x52\xfe\x2f\x7b\x5f\xf7\xdc\xa6\x92\x7d\xfb\xaf\xa4\xce\xfb\xcc\x8d\x80\x64\x72\x1e\xe6\x01\x49\x2d\xcc\x1c\x04\x0c\x20\x3b\x99\x17\x4a\xb1\x50\x0e\xf7\xd8\x52\xae\x24\x7b\x9c\xff\xfe\x56\x37\x0d\x42\x32\x72\xaf\xd5\xf2\xd3\xaf\x7e\x95\x8f\xaa\xc4\x6b\xed\xdd\x5f\x40\xf7\xee\xfd\xf1\x1a\x89\xc4\x8b\x6b\xa8\x83\x43\x5d\x1c\xea\xe1\xd0\x51\x0d\x43\x1d\x1c\xea\xe2\x50\x0f\x87\x36\x81\xa0\xc4\x90\x35\x04\x62\xe0\x1a\x02\x32\x7c\xb7\xcb\x87\x7a\x45\x6c\x3a\xfa\xaf\x9d\xd1\x8a\x84\x3f\x73\xf8\x35\x09\x27\xc5\xef\x49\x38\x27\xde\x59\x91\x70\x52\x3c\x37\x38\xce\x9a\x14\xcf\x0d\x8e\xb3\xe7\xc4\xbb\x2b\x12\x4e\x8a\xe7\x06\xc7\x5d\x93\xe2\xb9\xc1\x71\xf7\x9c\x78\x2f\xfe\xce\x12\x6a\x96\x40\x37\xe9\xe9\x3b\x4d\xa0\x55\xd0\xbd\x78\xda\x93\x0c\x76\x60\xb9\x65\xea\xad\x48\xf1\xdc\x32\xf5\xd6\xa4\x78\x76\x3c\xb9\x65\xed\xed\x49\xf1\xf4\x8a\x78\xaa\x59\x02\xd9\x24\xbd\x6f\x7e\x67\x37\x89\x5e\x81\x32\x87\x2f\x50\x96\x86\x5f\x85\xae\x90\x01\xf9\x45\x37\xf8\x45\xcc\x32\x7a\x78\xd0\x99\x6c\x40\x13\xc8\xec\x2e\x0a\x9c\x17\xf8\x4e\xc1\x79\x81\x2f\x34\xdc\x17\xfc\x4a\x03\x97\xea\xe1\x52\x3d\x63\xb7\x72\xc4\x6d\xce\x5c\x26\x4b\x62\x40\xd7\xba\x60\xfc\xa5\x41\x7e\xc1\xf3\x1c\x02\xad\x3c\x43\x43\x8d\x69\x4d\xce\x99\x9f\xcb\xb0\x86\x33\x07\x57\x03\x39\xe7\xb2\x36\x1e\xe1\x60\xf7\x8f\x04\x5c\x32\x31\x46\xa8\xf4\x41\xce\x85\x1e\xbf\xb9\xe9\x77\xf8\x73\x82\xcb\x9f\x45\x9c\x17\x8f\xa5\x78\x16\x27\x1e\x1b\x2d\xee\xfa\x7d\xfd\xde\xdc\xbe\xdf\x9b\xcb\xfb\xbd\x75\xe1\xd6\x62\xf6\x56\xc0\xf5\x19\x29\x0a\xd3\x2e\x81\x83\xc9\x85\xe5\x04\x3c\x62\xc0\x0e\x03\x76\x19\xb0\xc7\x80\x3f\x31\xe0\xcf\x0c\xd8\xe4\x7f\x24\xaf\x4b\x4e\x08\xa6\xdb\xbe\xb9\xff\xaf\x24\x6b\xd7\x86\x09\x1b\xc6\x30\x56\xe6\x5c\x86\x8b\xee\xcb\xc4\x00\xe2\x6b\xa1\x42\x0c\x72\xfc\xc5\x92\x89\x29\x01\x0e\x88\x46\xc8\x14\xcf\x77\x7e\x26\xf3\xd6\xcc\x53\xbf\x08\xc7\x50\x4a\x61\x59\xaa\xd0\x75\x4c\x37\x79\x59\x30\x86\x40\xfe\xe8\x33\x80\x32\x83\x86\x1c\x9a\xa4\x9f\x64\x60\xdc\x8a\xc9\xc5\xa4\x9d\x3f\xf5\x0d\x5c\xe4\x7f\x33\xdf\x84\xcb\xa2\x49\xdd\xe5\xa1\x90\x7b\xac\xa6\x4a\x2b\xa0\xcd\x82\xd6\x94\x75\x92\xc1\x46\xd0\xe7\x77\x16\x7e\x15\x53\x24\x3e\xad\xef\xc0\x04\x7b\x3d\xb5\x37\x95\x23\x2c\x16\xe2\x34\x09\x3c\x48\x6a\xe1\x8e\x8d\x0e\x87\xd3\xa1\xb7\xc3\xe5\xc8\x8e\x06\x6a\xcb\x46\xa3\x59\x19\xc8\xbf\xc6\xa3\x8f\x33\xa6\x58\xd9\xe8\xe3\xac\x94\x3c\xf9\xc7\x5c\xad\x2c\x0b\xc6\xbf\x97\xe2\x13\xa3\xe0\x53\xf9\xbb\xfa\x65\x16\xde\xf6\x3d\xbf\xf1\xd1\x42\xbe\x32\xc9\xb9\x72\xff\x98\x09\x31\xd5\x17\xfb\xcd\x82\x83\x5d\x2e\x06\x64\xe8\x93\x0c\x90\xcd\x4b\x6a\x19\x10\xd0\x15\xa5\xc2\x97\xfd\xc5\x9e\xe4\xd6\xed\xcf\x0b\x3f\x2b\xec\xd9\xe6\xe1\x4f\xb3\x70\xae\xaa\x3c\xe4\x5d\xe2\xa7\x29\xaf\xaf\x27\x45\xfa\x57\x14\xc2\xf4\x61\x6c\x0e\x0d\xe1\x7f\x44\x26\xbf\xd1\x13\x3f\x9b\xda\xcd\x92\x7c\x85\x67\x91\xf0\x6f\xc5\x94\xf3\xca\x7c\x6b\xc6\x31\x17\xd9\xbe\x6a\x8c\x41\x2a\x18\x68\x22\x64\x09\xb8\xc8\x03\xcf\xf5\xf2\xab\xeb\x3a\x8b\xd0\x0c\xc3\x50\xfe\xe8\x33\x02\xc3\x50\xfe\x17\x04\x05\x81\x7c\xd7\x01\x50\x10\xc8\x1f\x7d\x06\x50\x10\xc8\xff\x02\x80\xcc\x18\x31\x05\xb7\x37\x4d\x01\x75\x0c\xab\x6a\xa7\x63\x50\x69\x45\x80\x91\x3e\xaa\x3f\xc8\x70\x24\x2a\xb4\xe7\x20\x8a\x7c\xa6\x7b\xfe\xa1\x0c\xbc\x95\x4e\x3b\xa1\x5a\xb0\xa4\x57\x2c\xc6\x38\xf9\xca\x03\xde\xcb\xe7\x78\x97\xc4\x9b\xce\x8f\xca\x79\x0e\x75\x2b\xee\x83\x1d\x06\xec\x32\x60\xc0\xc5\xb8\x0f\x07\x57\x51\x9f\x02\xae\xa4\x93\xb1\x3c\xaa\xb3\x61\x39\x56\x2c\xd7\x8a\x05\x8c\xdf\x20\x0f\x1c\xc8\x41\x2e\x38\xa2\x4d\x3e\x94\x3b\xdf\x78\xa4\x6d\x80\x71\x82\x63\xc7\x32\xcf\x4a\x20\x0d\x4d\x16\x14\x4c\x91\xfe\xa6\x37\x19\x41\x20\xa3\x81\x66\xc8\x5c\x1f\xd0\xb6\xba\x87\x87\x0e\xa1\xaf\x72\x11\x9b\x4e\x31\x8a\xe0\x3a\xb3\xd6\x43\xd7\x64\x82\x6d\x0c\xfe\xae\x73\xba\xd2\x1c\x0f\xaa\xef\x1c\xc6\xca\x4f\x5e\xe6\x12\x9d\x0b\xdd\x35\x55\x81\x05\xc8\xa0\xda\xe7\x34\x1e\xf1\xca\xc3\x5b\x1d\xb5\x4b\x11\x4f\x12\xe4\xba\xe4\x92\x0c\x3d\x5c\x50\xe0\xcd\xb0\x0c\xf0\xa8\x75\xa1\x05\xcd\x0e\xc0\x9e\xaf\x76\x05\xf6\x74\x65\x0d\xbf\x42\x3d\x9c\xe9\xf9\x02\xbf\xf5\x0e\x27\x25\x4c\xc5\xcc\x37\xa7\x5c\xea\x33\x16\xf1\x54\xcc\x42\x73\x61\x1e\xdd\x21\xdd\xac\x63\x4b\x0d\x34\x79\x8e\xcd\x44\x3c\x15\x99\xd6\x07\x74\xe8\x44\x15\xf3\xa6\x95\xcf\x1c\x22\xdb\xf1\xd0\x67\xbb\xb3\x1c\xe0\xd3\xd1\x52\xe4\xf2\x07\x1e\x9e\x16\xde\xac\x77\x82\xa0\x16\x38\x81\xd7\xc5\x3a\x71\x82\x4e\xf6\x6a\x26\x74\x73\x00\xc7\x62\xf6\x17\x20\x76\xfc\x53\x61\xab\x3c\xed\x64\xe9\xa1\x14\x7f\xda\x7f\x42\xc8\x86\x91\xd2\x6d\xfa\x81\x15\x3c\xbd\xf0\x62\x49\xc6\xb2\x48\x20\x32\xad\x6f\x0b\x88\xfd\xb9\xa5\x80\x76\x7d\x21\xf5\xf5\x0d\x22\xba\x82\x9b\x33\x7f\x72\x6d\x6b\xa4\xf9\x9c\x10\x21\xbf\x90\x32\xc6\x8e\xa0\x84\x71\x4b\xea\x35\xc0\x8e\x3f\x0f\xf3\x5c\x1a\x23\xaf\x95\xd3\x8b\xf0\xb6\x13\xa0\x9e\x16\x5a\xc0\x22\xce\x17\x69\x9a\x64\x66\xb3\x9e\xfc\x7e\xe8\xdc\x54\x5d\x57\x4d\x2b\xff\x1c\xff\x91\xc4\x8f\x48\xbc\x43\xe2\x5d\x12\xef\x91\xf8\x4f\x24\xfe\x33\x89\xff\x07\x89\xff\x42\xe2\x7f\x27\xf1\xa3\x8f\x2c\x81\x9d\xe1\x91\xc3\x12\xd8\x39\x1e\x79\x2c\x81\x9d\xe5\xd1\x67\x96\xc0\xce\xf3\xe8\x0b\x4b\x60\x67\xda\xf9\xc8\x12\xe8\x67\x99\x9d\x69\xc7\x65\x09\xec\x4c\x3b\x9f\x58\x02\x3b\xd3\xce\x3f\x58\x02\x3b\xd3\xce\xef\x24\xc1\xfd\xc8\x12\x4c\x33\xdd\x6c\x67\x8f\x04\x03\x5c\x1f\x10\x70\x42\xef\xfb\x46\x6c\xe6\x08\x68\x79\x17\x4e\x8b\x1b\x86\x70\xa3\x32\x2b\x32\x0c\x69\x9c\xce\x62\x3f\xd2\x75\xb4\xc1\x31\x52\xd9\x2f\x47\x0c\xd8\x63\xc0\x5f\x18\xf0\xe8\x33\xd3\x5f\xd0\x46\x71\xc2\x81\x2d\x13\x27\x2c\xd4\x1e\x71\x42\x82\xad\x10\x27\x2c\x7d\x7e\x26\x59\xed\x28\x02\xda\x2e\xec\x05\x7b\x45\xbe\x0d\x02\xe4\xae\x8e\x3f\xc5\x00\x2e\xa3\x37\x7e\x34\x6b\x7c\x65\x0d\x40\x69\x6e\x6c\xf6\xae\x46\x93\xa6\x84\xde\xc9\xd0\x79\x10\xab\x4d\x7a\xf2\xce\x36\xf3\xe3\xc0\x82\xa6\xfb\x8b\xf1\x66\xd1\x22\xbf\x29\xc5\xd7\x34\x0a\x27\x61\x01\x2a\x5b\xc4\xf9\xb7\x78\x72\x93\x25\xb1\x3c\xad\x03\xa4\x53\xd7\xae\x32\x0b\x8a\xc9\x08\xa7\x68\xfb\x80\x05\x33\x0b\x94\x2e\x07\x67\xb4\xba\x30\x62\x66\x3c\x79\x07\xe0\x8d\x5d\x66\x7a\x4d\x65\xe6\x57\x53\x60\x94\x11\x98\x85\x00\xbe\x69\x66\x23\x78\x16\x00\x62\x02\x40\x8e\xf9\x52\x18\xb8\x16\x07\xee\xa8\x91\x8b\x7a\xe0\xd6\x1c\x72\x1e\x30\xf7\x29\x40\xee\xfa\x91\x9b\x77\xc8\xff\x00\xf1\x06\x00\x3a\xd6\x7a\x23\xca\x4b\x2a\xd0\x2e\xa5\x3e\xb9\x06\x4c\x67\x61\xe9\x5c\xcd\x09\xab\xa5\x7c\xf6\xe2\x3c\x2c\xb0\x24\x97\xca\xdf\x50\x77\x03\xf1\x38\x6c\x1c\x14\xbb\xdc\x38\x08\xa5\xf9\x24\x5f\x7a\x25\x9c\xfb\x8b\xab\xd2\x28\x32\x4b\x88\x39\x10\x5a\x66\x54\xdd\x6e\x1f\xaa\xe5\xa6\x2e\xcd\x8e\xe8\x41\x75\x08\x37\x87\xea\x47\xb5\x43\xd0\x4d\x42\x8b\x1a\xcd\x98\x61\x06\x86\xfb\x46\xe4\xca\x0c\x55\xd9\x8c\x55\xe5\x57\xe9\x39\x3f\xab\xaa\xd5\xf7\xe5\xfd\x5f\x46\x9a\xd8\xac\x78\xd2\x31\xaf\x70\xb6\xdc\xfc\xa8\x08\xfc\x78\xb9\x37\xc3\x5f\x35\xe8\x76\xb9\xfb\x55\x6f\x7e\x98\x83\xd9\x82\xea\x70\x89\x6c\xe4\x4e\x1e\x96\x8f\x3f\xc1\xc2\x5d\x72\xac\x27\xdb\xcd\xaa\x96\xa9\x2c\x96\x0f\x59\xb5\x41\xf2\x2a\x88\xcd\x8a\x27\xf5\x83\xdb\xc2\x81\xe8\x36\x24\x99\x44\x58\x3f\xd3\x14\x36\x52\x2f\x1c\xd5\x1c\xde\x21\xf1\x2e\x89\xf7\x48\xfc\xe8\x89\x24\x38\x2c\xc1\x65\x09\x1e\x4b\x40\x52\x26\x9c\x10\x1c\x96\xe0\xb2\x04\x8f\x25\x8c\xe8\x95\xe7\xd0\x0c\x97\x66\x78\x3c\x83\x8c\x8d\x0d\xd9\xe8\xdb\xd0\x7b\xa2\x55\x3c\xed\x99\x64\x3a\x48\x9f\xe5\xb7\x60\xb6\x5b\xfe\x90\x99\xd5\x99\xe4\x3e\x34\x47\xb7\x09\x79\x4c\x35\xd4\xc1\xa1\x2e\x0e\xf5\x70\x28\xb4\x94\x35\xd6\x21\xb0\x2e\x81\x85\x16\x6e\x51\xbd\x74\x59\xe9\x43\x16\x8f\x28\x90\x1f\x66\x52\xc7\x39\x05\x51\x33\x79\xa8\x96\xbb\x66\x67\xc2\xa1\x49\xe1\x6b\x0e\x6d\x5e\x30\x32\xa1\xd6\x61\x57\x6f\x7e\x98\xa1\xe1\xbe\xd9\x6e\x7c\xc7\xca\x0e\xc8\xe7\x93\x22\x34\x89\xe2\xfa\x14\x64\xdf\xb5\xe1\x08\x7d\x74\x7e\xd8\xee\x96\xc0\x2e\x32\xa8\x0e\x7d\x5a\xb7\x32\x80\xa9\x0b\xf7\x33\x09\x26\x86\x8c\xc1\x37\x23\xd6\x63\x40\x03\x46\xe1\x27\x7f\x56\xf7\x7f\xf5\x18\xf9\x61\x79\x00\xca\x4d\xf6\x18\x45\xf5\x22\xd3\x1c\x8c\xa6\x16\x24\xc7\x86\xe4\x52\xa4\xfe\xcc\x1a\x79\x41\x75\xe8\x51\x9b\x84\x8f\x8f\x15\x57\xa7\x37\xa8\x36\x95\xcc\x27\x39\xaf\x7f\x3e\x2e\x7f\x1a\xe1\xe3\x87\xba\xaf\xd3\x88\x1f\x58\xe0\xaa\x1a\xf2\x7e\xf9\xf8\xf3\xc1\xbc\xd6\x5f\x0f\x67\xb4\xfc\x55\xed\xf0\xd2\x25\xd8\xc1\x6c\xf6\xf0\xb4\xff\x73\xbe\xfc\xf9\xb3\xa2\xcf\x73\x7a\x5f\x01\x25\x7a\x6c\x1e\x90\x1e\xc3\xbc\x72\x83\x6a\x43\xe1\xc3\x7d\x0f\xfe\xce\xc1\xe2\xbd\xec\x1f\x2e\x9f\xfd\xa3\xe7\x97\x9c\x89\x89\xc9\x00\x7c\x86\xe6\x7c\xff\xa1\xbb\xae\x33\x5f\x69\xa0\x4d\x7d\x06\xa4\xe2\xc4\x5b\x90\xd3\x35\x48\x85\x94\xb6\xa6\x2c\x08\xdc\xcf\xa9\xdb\x5e\x7b\x98\x2f\x64\xce\x83\x3b\x2d\xda\x55\x4e\xfd\xc2\x2f\xf3\x22\x39\x8a\x01\xf9\x72\xa2\xfc\x38\x88\x04\x88\x6f\x1b\x89\xf2\x4e\xa3\x64\x51\x96\x1c\xc8\x0e\xdb\xb1\x81\xb1\xcc\xbe\x94\xb9\x74\x64\x34\xc1\x02\x14\x37\x86\x81\x3e\x88\x1c\x7d\x06\x25\xc2\xc0\x31\x8e\xf4\x41\x28\xeb\x13\xda\x45\x6b\x96\x99\x40\x02\x4c\x5f\xe1\x21\x63\xb4\x2c\x67\x49\x38\xad\x29\xb8\xbe\xfc\x43\xf0\x6d\xd2\x78\x0b\x30\xf8\xd4\x9d\x91\x90\x91\x3a\xa7\x98\x9f\x81\x81\xea\x22\xe3\x28\x99\xfc\x61\xba\xad\x95\xbc\x40\x24\x73\x51\x64\xdf\x78\x66\x67\x87\xa7\x99\x5d\x7d\x11\x9a\x39\x3c\x07\x14\x53\x76\x0f\x1d\xd4\xae\xa1\x67\xa3\xdb\xc5\x5b\xe4\x8c\x90\x57\x43\x6d\x27\xe6\xd5\xb8\xc3\x62\xce\x46\xaf\x89\xc7\x29\xfd\x28\x0c\x62\xc0\x63\xe6\xac\xd0\x82\x9a\x33\xd5\x32\xe9\x5b\x8c\x05\x03\x0d\x89\x40\x1b\x0d\x5c\x28\xb5\x50\x60\x76\x5b\x28\xde\xf6\x96\xd1\xf4\x1b\x79\x79\xb5\x0c\x28\xee\xa9\x05\x13\xb5\xe5\x5a\xca\xdc\x2f\xb2\xf0\x2b\xc7\x09\xf3\x32\x4b\xee\x9a\x9c\x35\x20\xa5\xe9\x38\xf9\xda\x53\x9c\x66\xaf\x82\x4f\x4a\xa3\xc9\x76\x6a\x4e\x17\x59\x7e\x05\x57\x7e\xa2\xc2\x89\xe0\x44\x64\x62\x26\x32\x11\x4f\xa4\x9f\xc6\x37\xaa\xc4\xd4\x5b\x72\xba\x77\xc7\xd5\x92\xba\xd7\x07\x24\x49\xbb\xb9\x5c\x58\xef\xe7\xa7\x27\x59\xf9\x41\x9d\x9c\xf6\xe1\x66\x7f\x58\x6e\xee\x2b\x73\x9a\x4c\xc9\x11\x0f\x95\x3c\x77\x13\xac\xa2\x7a\x01\xeb\x65\xa6\xbb\xfa\xb1\x96\x59\xfb\xb3\x6a\x7f\x58\xee\x0e\xe1\x66\x55\xbd\x18\x59\x93\xed\xcf\x5f\x74\x79\x50\x6d\x2a\x0d\x37\xab\xfa\xbe\xda\xd3\xf5\x04\xf6\xf5\x33\xcd\x89\x97\x8f\x15\xd1\xb4\xf1\xc3\xf6\xfe\x2f\x6c\x04\xce\x35\x29\xaa\x45\x0b\x15\x0f\x6a\x66\x9f\x20\xcd\x8d\x67\xb7\xad\xef\x70\x02\x77\xfa\x27\x70\x87\x4f\xd7\xd6\xa4\xb5\x9a\xc8\x43\x57\x9a\x25\xb3\x10\xca\x65\x75\xa4\x35\x09\xb0\xc2\x48\xfa\x47\xe0\xfc\x28\x8c\x45\x5e\xfa\xd3\x7f\xf9\x13\x11\x4f\x4c\xf1\xc8\x12\xad\x3e\x05\x29\x4c\x29\xb2\x50\x1d\xbb\x72\x9a\x41\x2a\x6a\x73\x52\xe9\x02\x37\xe6\x0f\xc2\xc9\x06\x95\x2f\x46\xd7\xf7\x46\x3c\x3a\x06\x37\xb1\x43\x62\x4a\x90\x7b\x5e\x93\x8a\x5c\x16\x7e\x16\x08\xa3\x7e\xee\xa5\xdd\xa1\xe5\x37\x43\x7e\x76\xca\x64\x51\xa0\x9c\x30\x4e\x17\x50\x98\x58\xc7\x48\x16\x05\x48\x19\x3c\x26\xc0\x9b\xce\x13\xb6\x56\xda\xf6\x90\xa1\x16\x49\xe1\x47\xad\x00\x4a\xbb\xfe\x06\xdb\x50\x3b\xed\x61\x6c\xcf\xb5\x51\xdc\x7d\xa5\x49\xc5\xed\xcb\xa6\x7d\xbd\x00\x45\xaa\x74\xc4\xbd\xf4\xad\x32\x20\xff\xbd\xf0\xa7\x79\x39\x4b\xa2\x28\xb9\x2b\xd3\x2c\xb9\x4d\xfe\x90\x96\x21\x3d\xbe\x93\x24\xbe\x15\x31\x12\x08\x1f\x66\x79\x41\xb3\x22\xdf\x82\x74\xde\x48\x03\xfc\x55\xa0\x62\x2e\xfc\x79\x24\xa0\x92\xc1\xb9\xc8\x6e\x45\xa6\x92\x2e\x94\x45\x38\x17\xe6\x87\x17\x8f\xed\x94\xde\xbf\x72\xa4\xa6\x21\xd0\x67\x05\x86\xaa\xf8\x29\x24\x92\xf4\xa1\x01\x0a\x20\x13\xae\x04\x06\xe9\x42\x2e\xf6\xb9\x1f\x4f\xf3\xb2\x7d\x71\x1a\x88\x8d\xbd\xd6\x37\x17\xf0\x06\x61\x7e\x24\xad\x46\xdf\x50\xb8\x9e\x30\xe9\x97\x1d\x22\xb9\x30\xf5\x4c\x94\xb9\x5f\x84\xf9\x2c\x34\x32\xd4\xaa\x98\xf9\x21\xde\x92\x30\x88\x13\x73\x4b\xf4\x0c\x4a\x97\xf2\x6e\xc4\xcd\x3b\x8a\xc6\x94\x5e\xa6\x49\x0e\xad\xa7\x06\x0d\xbc\x49\xb4\x5c\x89\x6c\x0a\xeb\xc1\x71\x05\x0d\xe7\x6e\xb0\x28\xdd\xf0\x23\xea\x4c\x89\x00\x86\x57\x99\x1b\x71\xea\x30\x09\x4a\x0d\xf3\x96\x56\x48\x40\x4b\xd5\x67\xee\x33\x11\x57\x91\x29\xfd\x58\xec\x47\x8b\x6e\xf2\x82\x6a\x35\x51\x32\xf1\x0b\x20\x6f\x6d\xef\x76\x07\xef\xe2\xd9\xb5\x10\x4e\xbc\x74\xb1\x84\x4b\x18\x26\x41\xa3\x7a\x59\x27\x44\x07\xda\x0e\xc9\x39\x86\x6a\x73\xd3\x2c\x79\x3a\xd7\x04\xcd\xd3\x7e\xd8\x17\x19\x6f\xd9\x06\xa4\xcb\x6f\x73\x37\x0b\xd1\xd4\xc5\xb5\x25\xf7\x95\x39\x82\xe0\xab\xdb\x79\xcb\x76\xa7\xbb\xed\xf3\xf6\xaf\x7a\xf3\x03\xc4\xcf\xaa\xcd\x7d\x95\xff\xda\xdc\x1b\x91\xe1\x1e\x82\xe9\xe2\x8e\x08\x74\xf2\x50\x57\x9b\xc3\xdd\xb2\x3e\x40\x92\x61\xe0\xd1\x53\xfe\xb3\x07\x19\x1b\xa4\xd4\xfa\x99\x92\x0b\x7a\xec\x8f\xcf\xfc\x83\x90\x06\xbd\x76\xb8\x30\x52\x8a\xea\x25\x7c\x5c\xfe\xa8\x9c\x29\xe3\xd9\xd1\xb2\x5c\x8a\x15\x54\x87\x1e\x1c\x70\x3f\xcb\x15\xf0\x55\x48\xc4\x99\x2d\x46\x9b\x50\x1e\xb7\xcf\xd5\x87\x9f\xbb\xed\xba\x7e\xa8\xfe\xf9\xdb\xfd\x76\x57\xfd\xf6\x41\xb6\xbb\xda\x1c\xfe\xf9\x9b\xac\x77\xba\x3c\xd4\xdf\xeb\x87\xfa\xf0\xeb\x6f\xdb\xcd\xc3\xaf\x0f\x41\xf4\x61\xf4\xf7\x8f\x7f\x73\xff\x3e\xfa\xa0\xad\x37\xfb\x0f\x8d\x94\xd5\x87\xf5\x6e\xfb\x28\x01\xa7\x76\x9a\x81\xf7\x4a\x6b\xdd\x19\x95\xa6\x38\xb5\x4c\xe4\x13\x3f\x12\xfa\x8e\xd3\x00\x8e\x64\x2c\xae\x4a\xfd\xdb\x06\x2f\xcb\xa3\x55\x66\xac\xe1\x2e\xf3\x7c\x44\x02\xab\x5f\xd1\xa6\x72\xcd\x53\x31\x59\x44\x7e\x06\xb1\xfc\x28\xf4\x65\x16\xf6\xa3\x11\xa5\x89\x5b\x34\xd0\x26\x51\xa8\x12\x65\x35\xc6\x71\xfd\xca\x27\xbc\x1b\x10\x7b\x8b\x4a\x1a\x9b\x26\xb9\x68\xc6\xed\x36\x14\x77\xfa\x72\x02\x26\xa6\x59\x22\x0f\x45\x72\x42\x49\x66\xdb\x50\x92\xa6\x86\x1c\x23\xf5\xbf\xb0\xe6\x4d\x76\xb3\xca\xe4\x29\xd2\x00\xcc\xc4\x2c\xea\xba\x9c\xe2\x91\x93\x48\xcd\x93\x1e\xdc\xa2\xdc\x88\xa9\xda\xc8\x45\x62\x17\x06\x66\xa6\xc8\x3b\x4d\x0c\x55\x42\x25\x68\x14\x12\x69\x6e\x53\xf8\xfd\x23\x20\xb5\x41\x8e\x60\xa4\x03\x23\x3f\x42\x03\xdb\xea\xc7\xb1\x0e\x84\x55\x89\x0b\xe3\x29\x32\x04\x1a\x3a\xc2\xa1\x0e\x0e\xc5\x46\x41\x83\x47\x0c\x18\x1b\x87\x2c\x18\x97\xea\xcb\x60\xc0\xf9\xd3\x36\x5c\xd9\x00\x94\xcb\x3f\x4b\x93\xc8\x37\x9a\x3d\xf2\xc5\xb8\xc8\x7c\xa3\x37\xdb\x24\x89\x65\x09\x17\x13\x4c\xfa\xd8\xa0\xc5\x93\xd2\x4c\xdc\x86\x89\xd1\x44\x34\x4d\x0a\x17\x98\xc9\x16\x66\x1a\xea\xde\x47\x6b\x1e\xc6\x04\xd8\x37\xbd\x9c\x1b\x70\x5b\xab\x45\xe6\x01\x11\xf1\xc2\x07\xcc\x1b\x6d\xc6\xfa\x72\x1e\xa6\xe6\xf7\xef\x19\xba\xbc\x09\x8d\xb3\x32\x4b\x64\xd9\x8b\x24\x9b\x86\xb1\x5f\x08\xfd\x84\x52\x1c\x13\xb8\xb5\x15\xab\x63\x19\x58\x71\x8b\xd3\x70\x02\xd6\x3e\x0a\x80\xdd\x72\x90\x07\xf9\x29\x0c\x32\xd5\x1c\x8b\xcc\x82\x6a\xa0\xa8\x07\xa6\xcc\x17\x73\x70\xf0\xc8\x3a\x65\xa7\xe8\x76\x18\xcc\xd7\x5f\xc3\x44\xc4\x5e\x3c\xac\x11\x19\xf8\x61\x2a\x36\xf2\x83\x5c\x03\xa7\xdd\xb6\xcd\xc2\xa8\x10\xe8\x26\xfb\xd4\xfe\x00\xd4\xe7\xe8\x2a\x6d\xe1\x75\xb6\x4e\xc3\xeb\x19\x9f\x3f\xbd\xf3\xb3\x60\xf6\x87\x9c\x22\x2a\xf7\x0c\x1b\x62\x3b\x86\xea\x59\xb3\x11\x20\xa6\x81\x50\x89\xa3\x6d\xc8\xc3\x8b\x8d\x12\x31\xf8\xa2\xa0\x24\xdc\xa9\xb4\x4b\x57\xe9\x2e\xf3\x6c\x82\x62\xc1\x57\x0c\x8a\xef\x70\xf8\xdb\xe1\x9c\xc2\xbd\x90\xa9\x37\xc2\x19\x8b\xd4\x41\x4d\x46\x9e\x4d\xa0\xcd\x7c\x36\x81\x76\xf2\xd9\x04\xda\xc6\x4b\xa5\xc8\x1e\x33\xcf\x26\xd8\x66\x54\xbf\x76\xba\xf2\x5d\x77\x49\x99\x9b\x67\x47\x6f\x95\x52\xe9\xfa\x6c\x80\x36\xd3\x91\x89\x34\xf2\x27\x8c\x01\x40\xf1\x72\x70\xf5\xfe\x6f\x3d\xcf\xf7\xa9\xe7\x79\xca\x44\x9c\x4e\xdb\xe9\x3a\x4a\x06\xde\x08\xff\x53\xd2\xb7\x28\x9b\x74\xe3\x7d\x86\x5a\x5f\x95\x39\xb4\xa8\x5e\x26\xdb\xed\x6e\x35\x5a\xb1\xf8\x67\x92\xb0\x66\xf1\xac\x82\x9a\xc5\xb3\x0a\xf6\x2c\x9e\x54\xe0\x90\x73\xe0\xac\x9e\x49\x02\x39\x07\xce\x9a\x55\x40\xce\x81\x53\xb3\x0a\xc8\x39\x70\xf6\xcf\x1c\xc1\x25\xe7\xc0\x65\xe7\xc0\x25\xe7\xc0\x5d\xb3\x0a\xc8\x39\x70\x6b\x56\x01\x39\x07\x2e\x3b\x07\x1e\x39\x07\x1e\x3b\x07\x1e\x39\x07\xde\x9a\x55\x40\xce\x81\x57\xb3\x0a\xc8\x39\x40\x32\xa3\x44\xdb\x65\x93\xc9\xea\xe7\x76\x5f\x35\x85\xa3\xd7\x36\x24\x6c\xf6\x68\x4d\x03\x24\xb3\xa6\xd9\xf6\x87\xea\xff\x1a\x47\x3e\xc3\x50\x5c\xfd\xea\x19\x86\xa2\x09\xaa\xf2\xea\x7e\xbb\x59\x2d\x77\xbf\x54\xc2\x2d\xf7\x3b\x4d\x78\x66\x19\x2b\x9a\x40\xab\x58\xd3\x04\x5a\x45\x4d\x13\x68\x15\x7b\x9a\x40\xab\x78\xa2\xe7\xfb\xe9\x3b\xaf\x84\x1e\xab\xa7\x9a\x57\x42\x8f\xd6\xd3\x9e\x55\x82\x3e\x53\x77\xf5\x66\xb5\xfd\x6f\xba\xdd\x3b\x2b\x06\xfb\x4c\x80\xd7\x0c\x96\x11\x5c\x33\x58\x46\xf0\x9e\xc1\x12\x82\xdd\x15\x83\x65\x04\x13\x63\xec\xae\x19\xc1\xc4\x18\xbb\x35\x23\x98\x18\xe3\xb3\x97\x85\x74\x47\x79\xdc\x3e\x5f\x11\x19\xe4\xf6\x23\x83\x5c\x36\x32\x68\xa8\x30\xfc\x34\xbc\x0d\x73\xe3\x01\x57\x99\xa3\x90\x93\x70\x12\xcb\x32\x32\xf1\x22\xb7\xa6\x20\x16\x06\x69\xf0\x99\x2e\xfc\xf6\x1e\xb9\x5f\x70\xc6\x64\xfa\xf1\xe3\x6f\xad\x5f\x5f\x99\xfa\xd2\xbc\x61\x20\xb4\xbe\x8b\x98\x79\x4f\x96\xbe\xfd\x58\xfa\xe6\xb4\xb8\xad\x2d\x23\xbf\x0b\xff\xf3\x9f\x48\x94\x19\x89\x0f\x48\xfc\x98\xc4\xfb\x24\x1e\xb8\x55\x95\x3e\xe3\xa5\x88\xfc\xd4\x3c\xe8\x12\x9a\x17\xe6\x50\x0f\x69\x4e\x74\xca\xd1\x47\xfd\x7b\xa8\x32\xe2\x85\x74\x57\x27\xd9\xf8\x54\xa8\x61\xb5\x62\x12\xf9\xa1\xd1\x89\x9b\xc6\x35\x6d\xb7\x47\xbd\x1a\x51\x78\xb8\xd7\x50\x23\x52\xf6\x18\xc5\x6a\xdc\xd1\x9b\x90\x67\x3c\xd3\x94\x35\xcf\xe0\x95\x84\x16\x0d\x83\xb2\xf0\x49\xe7\xce\x33\x9e\x1d\x2b\xb4\xa3\xad\xad\x58\x50\xd7\xfe\xfd\x54\xc9\x23\xc8\x13\xb4\x21\x0b\xaa\x83\xc2\x27\xdf\xff\x6f\x75\x7f\xa8\x41\xbf\xd8\x1e\xe5\x09\xe2\xe8\xc4\x57\x2a\x89\xe8\xb4\x7e\xae\xf7\xdb\x1d\xc5\x49\xd9\xcc\xb7\x29\x9d\xa2\x35\x65\x73\xe5\xa6\x74\x4e\xd7\xd4\x65\x55\xd0\x49\x60\x53\x36\x1f\x6f\x7a\x9e\x7c\x73\xd8\xfd\x56\xfd\x4f\xdf\xff\xb6\xe7\x70\xfb\x1b\xa4\x8e\x18\x5f\x66\x64\x89\x31\x65\x46\x93\x18\xc7\xd4\x43\xc4\xb6\x76\x22\x68\x25\xf7\xc1\x84\x68\x87\x11\xed\x50\xa2\x5d\x46\xb4\x4b\x89\xf6\x18\xd1\xde\x13\x6b\xc8\x83\x06\xfc\x15\x83\x55\xe2\xd0\x4a\x1c\x5e\x89\x4b\x2b\x71\x79\x25\x1e\xad\x04\x9a\x93\x78\xbb\x7b\x5c\x3e\x40\xeb\xe8\x08\x35\x8b\x55\xa6\x36\x48\x6a\x87\x44\x85\x22\x03\xd1\x21\x69\x83\x09\xd2\xe4\xd7\x94\xb7\xde\xd4\xfc\xd1\xd4\x2b\x3f\x1e\x8f\xa6\xde\xdf\x3f\xb2\x47\x53\xed\xaf\x2e\x93\xbd\x98\x0f\x59\xf3\x30\x3e\x23\x60\x71\x9c\x61\x7c\x74\x58\xd0\xc7\xaf\xc0\x2f\x6e\xba\x1c\x53\x26\xbe\xff\xf5\x2a\xfe\xab\xb0\x6d\xc6\xf1\x4c\x1f\x3f\x39\xf2\x69\x68\x27\x45\x6d\x8f\xbd\xef\x40\xc2\xb2\x7a\xf6\xe3\x04\x29\xa5\x83\x91\x86\x94\x04\x65\x3e\x90\xe3\xab\x72\x90\x42\x59\xf6\x86\x28\xa0\x89\xa0\x4b\xb8\x20\x87\x45\x65\xed\xb8\x05\xa3\x4f\x4f\xd2\x35\xf0\xec\x30\x3e\x7a\x35\x1c\x7d\xbf\xe5\xd3\x0b\x2f\xff\x6b\xf8\x6f\x72\x65\xb1\x30\xa4\xfb\xda\x84\x95\x17\x99\xf0\x8d\x49\xb3\xa6\xc9\x62\x1c\x89\xf2\x56\x4c\x1c\x18\xe9\xc2\x48\x0f\x43\xce\xfd\xc2\x81\x91\xa0\xf6\xb9\x5f\x78\x30\xd2\x79\xc1\xa5\x3a\x2f\xb8\x5c\xf7\x85\xe8\x17\x21\xd7\x23\xe4\x7a\xc6\xbe\xe9\x54\x69\xf9\x62\x9c\x25\x8b\x42\xe6\x28\x62\x09\x6d\x2e\x19\x6b\x22\x1c\x5d\xfe\x5a\x82\x5c\xf2\x50\x6e\xb9\xcb\xca\x61\x11\x12\x78\xe4\xe7\x14\x9a\xee\x6a\xbc\x98\x77\x99\xa6\x22\x41\xe8\xb5\x22\xa5\x7e\x31\xb9\xc1\x50\x68\xd2\x1f\x25\xb2\x9c\x8a\x99\xbf\x88\x8a\x32\x8c\x63\x91\x41\xb5\xe7\x4f\x79\xc9\xa2\x00\x79\x85\xc8\xf3\xd6\xed\x9c\xcc\x4f\xa4\xa8\x81\x88\x11\x0f\xf4\x0e\x9b\xa7\xfe\xc4\xfc\x2d\xeb\xe0\xfa\xbd\x9c\x64\xe6\x04\x52\x1d\xa7\xf1\x06\x05\x1a\x15\xe6\x49\x04\xcc\xf2\x4c\x86\x28\x85\x89\x2c\x34\x9b\x4c\xa7\x38\x58\xdc\x0a\x53\xf4\x8d\x5c\xed\xd4\xf2\x90\x84\xae\xa3\xc8\xfc\x76\x84\x76\x8e\xdb\x27\x8a\xca\xc7\xa4\x24\x08\xb9\x19\x56\x4f\xe1\x15\x42\xda\x66\xf0\xe9\xcc\x86\xda\x71\x85\x94\xb3\x35\xcf\xf7\xa4\x99\xb7\x2b\x46\xc0\x3a\xa3\xd7\xf9\x28\x58\xcb\x38\x5f\x13\x70\x22\xe2\x4b\xeb\x81\x13\xd0\xaa\x0f\xe3\xab\x87\xc0\x42\x84\x8e\x96\x9d\x0e\x0f\xc5\x15\xa2\x06\xc6\x05\x96\xf6\x56\xea\xd2\x93\x76\x42\x39\xf5\x8c\xd2\x7a\x4d\x85\x04\xda\x93\xa8\x76\xab\x48\x7a\x35\x70\x33\x21\xa6\x63\x7f\xf2\x07\x4d\x68\xcf\x4e\xa9\xbf\x00\xae\x05\x2f\xd2\xe5\xcb\xfc\x56\x58\xd0\xa1\x23\x9b\x7a\x10\x2f\xe9\xce\x8d\xc6\x97\x79\xad\x6f\x01\xf3\x3f\x97\xe7\xe9\x42\x07\x09\xe3\x87\x6a\xb3\x12\xff\xef\x49\x5d\x4e\xd6\x1c\x3c\xaf\x7e\x2e\x65\xa5\x17\x90\x36\x7b\xda\xdc\x13\x50\x5c\x7a\x3f\xc9\xee\xaa\xde\x55\xf7\x07\x88\xd2\xe6\xa3\x81\x49\x3a\x1d\xeb\x68\x85\x22\x1d\x18\xe9\xc2\x48\x0f\x46\x22\xbe\xf8\x1a\xea\xe0\x50\x17\x87\x7a\x38\xb4\xf1\x2b\x25\x9a\xd1\x10\x88\xc6\x34\x04\xbe\x49\xbc\x0e\x87\xd7\xe2\x5a\x74\x9d\xd7\xe2\xf1\x5a\x3c\xa8\xfb\xc7\x74\xca\x18\x38\x7f\xfa\xbe\xdb\x3e\x1d\xea\x4d\x9b\x12\x19\xae\x55\x78\x42\x26\xd3\x36\xbf\xd2\x5a\x3f\x5f\x41\x46\xf3\x4c\x9f\xd3\x99\xc4\xcf\x47\xd6\xfe\xa9\x66\xa6\xe1\x48\x04\x79\xe9\x6e\xfb\x63\xb7\x7c\xcc\x0f\xcb\x1f\x15\xc0\x48\x97\x87\xfb\x3f\xbb\x0b\xfe\x9a\xc4\x03\x6e\x04\xd2\x91\xe4\x55\xc1\x61\x23\xab\xc9\xd6\xf5\x8a\x67\xf6\x70\x09\xaa\x8d\x05\x2b\xdc\xbf\x22\x19\x39\xe9\xf2\x69\x5f\xf1\xb4\xac\xda\x3f\x3d\x5a\xf0\xe4\xa7\xf1\x9d\x58\xf9\x61\x57\x2d\x1f\x8d\x5c\x55\x2e\x5b\xb9\x5a\xa0\xae\x4e\x62\xb3\xa2\xf0\xad\x2b\x87\xc6\xbf\xfb\xf5\x52\xaf\x2a\x99\xc7\x57\x25\x53\xc9\x1e\x7f\xfb\x3f\x6f\x62\x42\x79\xf7\x20\x0d\xd4\xcd\x96\x59\xb9\x2e\x36\x45\x7d\x80\x28\xc3\xcb\x64\xb9\x65\xf4\x4d\xb6\x71\x99\xa9\x78\x16\x25\x46\xdc\x5c\x4c\xc3\xc5\x1c\x82\xde\x84\xc1\x0d\x04\x94\xba\xc3\x18\xd4\x6c\x06\x2a\xbd\x66\x98\xbe\xbe\x90\x07\xae\x30\x12\x19\x86\x1e\x87\xb1\x8c\xc2\x6f\x86\xd4\x74\x44\x93\x26\x46\x1b\x5e\xef\x9a\xa1\x3d\x97\xdd\x8a\x49\x91\x64\x10\xd1\xcf\xbe\xc9\x6b\x3a\x9c\xd1\x5d\x8b\x70\xca\xb2\x60\xfc\xe9\xf3\x27\x03\xa8\xbd\xad\xd4\xfd\xcf\x44\x91\x85\xe2\xd6\x97\xc6\x73\x20\x23\xcc\x19\x1b\x32\x22\xcb\x41\x3f\xe3\x61\xa3\x6e\x45\xd2\xd3\xd4\xcd\x32\x7c\x05\x05\x33\xce\xaf\xdb\xcc\x8c\x81\x53\x34\xca\x7a\x75\x60\x07\x88\x7e\xd4\xd7\x82\x8e\x72\x93\xd3\x6f\x6c\xce\x25\xa5\x8e\xd5\x2d\x0d\x14\x9e\x86\xa9\x90\xf6\x5a\xe2\x70\x2d\x73\xf0\xa5\x49\x66\x9e\x70\x8d\x93\xb6\xff\x34\xfc\x2a\x22\xa4\xd3\x1d\x69\x9c\x2c\x64\xae\x6f\x24\x0f\x61\x53\x3f\x81\xcc\xcb\xde\x29\x52\x11\xf0\x2c\x7b\x11\x4f\xc5\xac\x57\xc6\xcb\xf8\xc9\xcd\xaa\x87\x6a\xb9\x57\x46\x85\x6a\x27\xf3\x56\xd6\x90\x87\xaf\x82\x8f\xeb\xcd\x72\x67\x2e\x65\x2a\x77\xf6\x0a\x9f\xee\xaa\xfb\x7a\x5f\x6f\x37\x33\xe9\x51\x03\x1c\xe5\xab\x9f\x87\x3f\x55\x72\x5b\xb3\x73\xaf\xaa\x34\xad\x08\x6b\x62\x57\x0c\xf6\xc0\x0a\x4d\x6c\xa2\x17\xfb\x4a\x93\xd4\x36\xdd\xbc\x3f\xd5\x47\x0f\x3d\xaa\x8a\x69\xe4\x4c\x76\xd5\xf2\x70\xca\xc1\x76\xeb\x1a\x9c\xd6\x3f\xab\x87\x7a\x63\x3e\xe2\x34\x7b\xf5\x33\x96\xb9\x4f\x41\xb5\xa1\x39\xe1\xfe\x8c\x42\xcc\x7d\x4b\x41\x0e\x45\x8d\x12\x7d\x04\x1b\xd5\x34\x81\x56\xb1\xa6\x09\xb4\x8a\x15\x4d\xa0\x55\x3c\xd5\x3c\x83\x55\xe2\xd4\x34\x81\x56\xc1\xce\x86\xb3\xa6\x55\xb0\xb3\xe1\xac\x68\x15\xf4\x6c\x40\x2e\xa1\xa7\x14\xb7\xa6\x09\xb4\x0a\x76\x36\xdc\x35\xad\x82\x9d\x0d\x77\x45\xab\xa0\x67\xe3\xdc\xdd\x12\xa0\x78\x35\x4d\xa0\x55\xb0\xb3\xe1\xad\x69\x15\xec\x6c\x78\x2b\x5a\x05\x3d\x1b\x90\x8f\xed\x29\x45\xdb\x90\xe9\xfe\x6b\xab\xb0\x25\xcf\x5b\x5b\xb6\x73\x65\xd9\x4e\x4b\x9e\xb7\xb2\x6c\xa7\xf5\xc8\xb8\xd6\x73\xe1\x58\x8f\xaa\x67\x3f\xff\x57\xe8\xb4\x1d\x21\xec\x8a\x64\x88\x89\x5d\x7b\x0c\xeb\xb4\x5d\x09\x9e\xb5\x4e\xf7\x0a\x9d\xc8\x08\xdd\x2e\x1f\xea\xd5\xf2\xd5\x9e\xd8\x62\xb3\x1a\x6e\xd6\xdb\x68\xfb\x83\x0a\xa9\x8a\x46\x2b\x0e\xef\x90\x78\x97\xc4\x7b\x24\x1e\xd9\x79\x9e\x10\x1c\x96\xe0\xb2\x04\x8f\x25\xa0\x19\x29\x82\xea\x70\xc2\x43\xf4\xd4\xd5\x7f\x7f\x6e\x77\x07\x75\x51\x8e\xc3\xb5\x91\x7d\x4d\x13\xcc\x2a\xf2\xfb\x7a\xbf\xdf\xee\xc0\x06\x69\xb4\x16\x4f\xc2\xcd\xe2\x8f\x56\x04\xb0\x3d\x47\x02\xda\xa4\xa0\x3a\xcc\x1e\xb6\xcb\x03\x58\x6c\x65\xba\x7d\xfa\xfe\x50\xd5\xe5\x7b\x5f\x6f\xf4\x4a\xbe\x7a\x36\x25\x5f\xd3\x6f\xcd\x6d\x83\x76\x87\xc1\x2c\x6f\x8a\x76\x27\x93\x71\x72\xbc\x01\x3f\x1a\x5b\xff\x1b\xc8\xed\x67\x11\xa7\x12\xdb\xcb\x48\xd9\x38\x4a\xdd\x85\xd3\xe2\xc6\x92\x7b\xa3\xd2\xe9\x5a\x92\x91\x0c\xe6\x97\xb8\x40\x42\x6d\xfb\xde\x5e\xd1\x57\xfb\x9e\x5a\xf7\x53\x5e\x16\xe8\x38\xae\x49\xb2\x00\x5c\xf8\x42\x59\x6e\x25\x6d\x17\xab\x1f\x85\x41\x2c\xcd\xf9\x06\x9a\x5f\x24\xf3\x70\xd2\xa8\x90\xcf\x06\x12\xdf\x33\xc8\x01\x1f\x8f\x61\x6e\x5e\xf8\x99\x55\x4b\x91\x91\x1c\x26\xa2\x05\xed\x87\xd9\xda\xf6\x7f\xfa\xc3\xfc\xfd\x44\x81\x65\xeb\x87\x25\x9e\xfa\x48\x9e\xdc\x03\xbd\x83\x3c\xde\x13\x12\x96\xca\x3a\x65\x22\x82\xcf\x2e\xa9\xde\x41\xe2\xd9\x45\x19\x7e\x63\x3a\x28\x1c\x76\x14\x6e\x87\xfc\x3a\x29\xbd\x21\xb6\x16\xd4\x0d\xa9\xb5\x84\x6e\x08\xad\x25\x74\xde\xca\xd6\x12\x06\x27\xe5\xca\xd9\xb8\x7e\x1a\xae\x18\xff\x2b\x06\xfe\x8a\x11\x47\xa8\x83\x73\x84\xbc\x7c\x2f\x93\xf5\xd7\xc6\xa4\x7d\xf8\xe5\x8a\x2d\x92\xd6\xe7\x60\xb8\x01\x48\x22\xef\x93\xe8\xdb\x53\x31\x06\xe6\x50\x36\xb2\xb1\x9f\x65\x21\x74\xef\x2d\x1a\x5f\x1d\x9a\xd7\xf6\x18\x67\xb4\xb1\x2b\x33\x21\xe3\x48\x70\x9e\xbe\x95\x6f\x42\x5e\xfc\xc9\x44\xbe\x9a\x70\xb6\xae\x93\x4c\x30\xf4\x7d\xb8\x5e\x3b\x74\xf7\x16\xe9\xd4\x2f\x04\x41\xd4\x8b\x84\xe6\xcd\x32\x7f\x2e\x34\x19\x67\x0d\x9c\x1d\x70\xf2\xf9\xf2\xc6\x89\x51\xd4\x47\x9b\x1e\x27\xf9\x2c\x73\x21\x4e\xdd\x1b\xa7\x47\x2b\xe5\xbc\x77\x6f\xb1\x26\x68\xc8\x24\xab\xa1\xeb\x37\x46\x19\xfb\x73\x41\x11\x90\x08\xb5\x33\x86\x74\x90\x10\x53\x9e\x43\x31\x9a\xc7\x06\xa2\x8c\xb0\xb6\x38\x18\xcc\x45\xa5\x95\x32\x67\x00\x84\x95\x29\x09\x20\x20\x74\x40\x69\x3b\x0d\x25\x38\xe8\x1a\x8b\x83\xa9\x04\x0a\x9d\xfc\x5e\xed\x49\x0b\x0a\xa6\x2b\x2e\xba\xce\xc3\x50\x07\x87\xba\x38\x14\x9c\xfd\xb8\x20\x56\x40\x5c\x50\xab\x20\x2e\xc8\x95\x10\x17\xe4\x6a\x88\x0b\xab\x15\x11\x17\x83\x53\x6c\x49\xe3\x13\x81\x80\x4b\x64\x80\xe3\x58\x70\x5c\x0b\x0e\xb6\x7a\x06\x88\xc0\x32\x1a\x60\x41\xeb\x69\x80\x07\x2e\xac\x01\xa6\x63\xcd\xa4\x96\xda\xb0\x66\x7c\xcd\x19\xf9\x50\x2b\x8e\x9f\xfe\x4b\x55\xe3\x4f\xf1\xa7\xdf\x39\xc8\x49\xbc\xa1\x34\xd0\x2e\x79\x42\x18\x81\x75\x70\xde\x60\x8f\xa1\x32\x7f\x6f\x0b\x98\x44\x7e\x4e\x1c\x49\xbb\xed\x0e\x92\x59\xe3\xd5\x89\xd4\x8e\xdd\x3b\x90\xd2\x02\xba\xf3\x28\xcd\xec\x36\x72\x34\xf3\xf5\xde\x10\x61\xf6\x0c\xb1\x32\x27\x6f\x39\x4e\x8b\x49\xb9\x90\xc5\xfe\x70\x62\x2e\x6b\xcd\xaa\x44\xcc\x56\x74\xc9\x56\x3c\xfd\x60\x21\x51\x08\x43\xf4\x45\x4c\x08\x68\xcf\x31\xe1\x7c\xbe\x28\x94\x8b\xfb\x85\x87\xea\xad\x40\xd5\xfd\x61\xb9\xb9\xaf\x56\xe3\xe5\xbe\x6a\xff\x41\x46\xad\xbe\xab\x84\xe6\x3a\x91\x92\x15\x54\x87\x50\xa6\x6b\xdd\x2c\x1f\xe4\x25\xf9\xf2\x50\x3f\xe3\x01\x71\xfe\x61\xfb\x58\xdf\xeb\x84\xaf\xe3\xa7\xf5\x1a\x4a\x65\x2b\x1d\x4d\x55\x91\x7f\xb8\x98\x55\xf5\xb8\xdd\xfd\x1a\x2f\x77\xbb\x1a\xb8\x56\x2d\xaa\x97\xfc\xb0\xdd\x2d\x7f\x54\xa3\x29\x01\x76\x18\xb0\x6b\x06\x0f\x86\x5f\x75\xb3\x65\x47\x6f\xa2\xb7\x06\x85\xbc\xc3\x9d\x62\x2f\x59\xbc\xc7\x27\x8b\x6f\xc3\x67\xe4\xe7\x29\xf2\xe3\x60\x21\xdf\x5e\x5a\x78\x6e\x61\xda\x89\x92\x38\xa0\xde\x01\x5f\x4a\x51\x4c\x1c\x9c\x92\x5b\x70\x14\x25\x5d\xc4\x93\x9b\xe2\x26\x4b\x16\xc1\x4d\x53\xca\x6d\x64\xa5\xfa\x7a\x39\xf2\x85\xdd\x74\xa1\x14\xfe\x04\xe7\x35\x5d\x57\x4d\xb7\xa1\x67\xa3\x11\xa9\xaf\x79\xaf\xd3\xbc\x2c\xb0\xd5\x84\x11\xd3\x2c\x9c\x87\xca\x1a\x9a\x09\x75\xdf\xd6\x04\xfe\x41\x86\xcc\xd7\x85\x01\xe4\x76\x23\x17\xd9\xad\x0f\x5c\x6d\xcb\x2d\x4a\x6b\x92\x44\xb4\xc9\x41\x59\x14\x02\xbf\x5f\x69\x09\x74\x06\x97\x96\x78\xfc\x3e\x92\xf6\xa9\x74\x51\xf4\x58\xc4\xe6\xa5\xed\x60\x26\xa6\xe5\x5c\xcc\x93\x0c\xda\x66\x0e\x75\x96\x4d\xf2\xa2\x34\x5b\x19\xc4\x2f\x0b\x00\x99\xcd\x8e\xed\x5d\x3a\x70\x97\x64\x7f\x94\x41\x96\x2c\x52\x32\x47\xe4\x80\x00\x75\x2d\x60\x47\x05\xe6\xcc\x8e\xf5\x56\xd6\x1b\xea\xf9\x40\x6e\x34\x29\x81\xd3\x30\x6f\xb2\x46\x91\x49\x41\x2f\xd0\xda\x63\x9e\x81\x7e\xda\x44\xc0\x3a\x3d\x15\xe3\x45\xd0\x66\x95\xca\xbf\xc5\x93\x9b\x2c\x89\x93\x85\x69\x89\x34\xb4\x58\x7c\x2d\xca\x28\x09\x02\xf5\x74\xe6\xb9\x7c\xbe\xa1\x10\xcf\x86\x3e\xf1\xa3\x48\x59\xe1\x67\x8b\x58\x65\x5d\xe3\x58\x8b\x5c\xe5\xfc\x31\x07\x15\x36\xda\x74\x9d\x17\x3f\x0d\x19\xf8\x5d\x18\x4f\x93\xbb\x32\xff\x96\x17\x82\xd2\xc3\x05\x27\x9f\x50\x8b\x9b\x30\x9b\xca\x9e\x15\xdf\x18\x9a\x9f\xa6\x51\xd8\x64\x89\x64\x68\x89\xcc\x3e\x0c\x11\xa4\x85\xa0\x14\x59\x96\x10\xf0\xa9\x48\x33\x31\xf1\x0b\xf9\x04\x89\x1b\xff\x36\x64\xc8\xc7\x70\x46\x9e\x2b\x83\x28\xfd\xc6\xb6\x40\x90\x44\x26\x3f\x4e\x40\x69\xe3\x1e\x09\x19\x40\xf9\x66\x6f\x28\xd4\x73\x72\xa4\x9d\x3e\x65\xd8\xe3\x69\xc3\xc9\xc5\xad\xc8\xa4\x39\x48\xc6\xe0\x73\x8c\x26\xbc\x9f\xe3\x44\xc9\x1d\x3e\xd0\x73\x3f\xfb\x83\x59\xaa\xe9\x22\xbf\x69\x3e\x25\x04\x27\x49\x09\x4a\xd7\x8f\x38\x29\xc2\x19\xf6\xf0\x1d\xe7\x54\x7f\xe5\x0a\x1f\x74\x92\xb3\x63\x41\x9f\x1d\xe8\x93\x86\x85\x70\xff\x7b\x21\xb2\x6f\x98\xa4\x2e\xcc\xdb\x00\xd7\x19\xb9\x0d\x28\x39\xb0\x91\x3f\x16\x11\xf3\x0d\x6a\xbe\x7c\x06\xa8\xf4\x29\x92\x9f\xba\x59\xe4\x07\x9a\x67\xfe\xb2\xca\xe6\xb0\x29\x6d\xfb\xf7\xda\x6d\x7e\x57\xc4\x67\x73\x88\x07\x79\x6c\x0e\x11\x55\xdc\xba\x4d\x4b\x31\xab\xf5\x10\xb3\x39\x62\x69\x53\x39\x3a\x58\xda\x32\xda\x09\x43\x06\xea\x9c\x73\x36\x48\xff\x9f\xb6\x6b\xdb\x6e\x5b\x47\xb2\xbf\x32\xeb\x7c\xc0\x74\x48\x29\x3e\x39\x0f\xf3\x40\x89\x10\x8d\x3e\x14\xa9\xe6\xc5\x89\xf3\xc2\xa5\xd8\x74\xc2\x3e\x8a\xac\xd1\xad\xe3\xf9\xfa\x59\x05\x80\x14\x25\xcb\xa9\x5d\x4c\xf7\x52\xe2\xa7\xbd\x01\x10\x77\x14\x50\xbb\x30\x12\x54\x41\x97\x24\xac\x72\x8c\xa6\x7a\x12\xc4\xce\x44\x9e\x97\x0b\x5a\xc7\x54\x28\xa3\x2d\xcc\xbe\x35\x93\xd2\xe8\x90\x05\xec\xb9\x2f\x58\x51\xa6\x54\x32\x80\x37\x89\x4b\x35\x80\x66\x2c\x14\x03\x78\x66\xaa\x1c\xc0\xcb\x0b\x95\x4c\x75\x3c\x84\x69\x8f\xad\x72\x22\xb1\x90\xdb\x98\x73\x96\x6d\x07\x39\xcf\xb4\x83\x9c\x66\xdb\x41\xce\xb3\xed\x20\xe7\xb5\xed\x00\x30\x69\xe8\xa1\x93\x01\x3c\x01\x20\x4b\x2d\x25\x08\x4f\x0e\xdd\x41\x3f\xd4\x73\x95\x20\x26\x51\x2b\x27\x05\xdb\x01\x4c\x89\x71\x78\x5b\xc1\x30\xa1\x15\xa8\x4a\x42\x85\xe8\xc0\xd8\xe2\xc0\xf0\xb6\x38\x30\xa1\x3f\xd9\xfe\x22\x09\x7c\x99\xd4\x27\x4f\x62\x95\x70\x78\xe3\x5d\x63\x9e\xd8\xe5\x38\xd2\xdd\x96\x0a\x08\xc0\x08\x39\x37\xe4\x41\x39\x9c\x53\x80\x3c\x22\x55\x0c\xc9\xe7\x35\x0d\xc8\x6b\xae\x17\xf3\x80\xdb\xb2\xcf\x83\x84\xe2\xbd\x46\x2a\x51\x59\x50\x28\x8c\x14\x94\x45\x2a\xa4\xd8\x71\xa1\x92\x69\x0a\x18\x6c\xc8\xec\x6e\x5a\x0f\xc1\x19\x3f\x2b\x06\x38\xd3\x31\xfc\x6e\xd6\xd5\x33\x03\x3e\xbb\x36\x17\x50\x7a\x77\xe5\x18\xab\xbb\x20\xc7\xe0\xdd\xad\x38\x06\x6f\x4d\x63\x18\xda\xa1\xb0\xb8\x43\x2d\xd8\x46\x72\x12\x81\xb1\x0c\x9c\x31\xc9\x8e\x87\x38\x0d\x42\x09\x3e\x2f\xd2\x4c\x49\x08\xd6\x16\xca\x30\xdc\xd8\x54\x9f\x14\xb6\x37\x32\xf0\xf3\x27\x1f\xc8\x7b\x0f\x9b\x8d\x99\xd9\xb0\xf9\xa3\x4f\x00\x66\x8e\x5c\xd3\x2b\x9d\x20\x51\x69\x99\x77\x4d\x43\x8f\x67\xdd\x3e\x45\xe5\xc5\xd0\x14\xda\x15\xec\x57\xd2\xb0\xa5\x40\xc6\x3d\x5b\x0c\x24\x91\x96\x37\xc8\x71\xf0\x4d\x32\xb4\xc7\x7a\x93\x0d\x74\xae\x69\xac\x82\xd6\x66\x0f\x66\x43\x3a\x6b\x0c\x94\x20\x03\x7a\xec\xac\x24\x39\x3d\x7b\x70\x63\xa0\xd3\xe0\x4e\x05\x05\x08\x76\x23\x88\x4a\x50\x8d\xab\x4f\xd5\xc8\x17\x10\x7c\x29\xc1\x93\x12\xa8\x48\xde\x8d\x80\xe0\x4b\x09\x9e\x94\x40\x45\xfa\x20\xc0\xfb\x42\xbc\x27\xc5\x7b\x95\xe7\x55\xde\x3b\x09\xa5\x0d\x4b\xce\x35\x85\xed\xac\x96\xe3\x7f\x40\x25\x0d\x1d\xe3\x8f\x1b\x21\xe1\x66\x2c\x24\x8c\xa5\x45\x1a\xf9\x42\x82\x2f\x2d\x92\x27\xfd\x68\xe9\x27\xe4\xa3\x62\x5a\x85\x9f\x0a\x8f\x5e\xa3\x0d\xa4\x05\x72\xde\x68\x20\xef\xbd\x90\x97\x45\xc5\xd4\xab\xf8\x53\xd2\x05\xc5\xaf\xb2\x08\x67\xc0\x4f\x07\x2f\x39\xc8\x73\x3f\x67\x9d\xc5\x50\x76\x35\x02\xed\xda\x3a\xe1\x8d\xca\x2d\x16\xb2\x40\xbb\x53\xe6\x5d\x90\x69\xe0\x54\xeb\x76\x73\xb4\xf1\xa3\xd9\x07\x29\xb9\x3b\x11\x9c\xe2\xa1\x49\x0e\x05\x32\x56\xef\x5c\x00\x13\xbb\xa3\x01\xcc\xe8\x4e\x07\x30\xa3\xbb\x3b\x47\x09\xaf\xea\x0c\xec\x51\x6f\xd4\x9d\x84\x7d\xb5\x0e\xc1\x04\xae\xd4\x25\xc8\xbc\x52\xa7\x20\xf3\x75\xdd\x82\xc4\x2b\x3e\x7b\x4e\x1f\x1b\x73\x69\xcd\x94\xbd\x1d\xe7\xe6\x6d\x32\xdd\x91\xcb\x1b\x7e\x2f\x4b\x8f\x1b\x5d\x26\xed\xb0\x84\x32\x19\x1a\xb5\x10\x2f\x1d\x70\xec\x71\x41\x75\xf9\xad\x35\x14\x96\xd5\x4c\x30\xd8\x0b\x39\x9b\x6f\x91\x69\x36\x62\xdf\x3c\x28\x32\xfd\x09\xc3\xea\xbc\xca\xd2\x8f\xd5\x3c\xf8\x7b\x3a\xec\xa1\x0f\x52\x76\xd1\x43\x1c\xa1\x7c\xc6\x80\xce\x24\x84\x9f\x3f\x65\x6a\xa7\x2e\xe4\xda\xf7\x9c\x79\x3e\x79\x0d\xe4\xf7\xa7\x2f\x79\x12\xa7\x09\x4c\xce\x3d\x4d\x61\x72\x6e\x37\x89\x21\xd4\x22\x5d\xd8\x60\x9e\xf8\x58\x7b\x45\x41\xba\x7e\x7b\x5b\x0a\xc2\xa0\x51\xaa\xf3\x6a\x61\xde\x56\x15\xd3\x5b\xe1\x56\x03\x7f\x6c\x70\xc1\x01\xc7\xd5\x75\x2e\x22\x8c\xf3\x06\x93\x6f\x96\x9e\xcb\xd1\x65\x1a\xe8\x03\xda\x8b\x1e\x2b\x4f\xe0\xca\xa8\x1b\x98\xc8\xab\xa1\x27\x4f\xe7\x62\x0c\xc9\x13\x38\x1f\x48\x83\xf8\xf6\x8d\xec\xd0\x04\x7e\xda\x03\x87\x24\x80\x5a\xaa\xae\x67\xec\xe2\x97\xa3\x62\x54\x97\x89\xc0\x52\x04\xd7\xea\xce\x3d\xfd\x44\xf7\x49\xee\x1a\xbd\x33\x6d\x5a\xa3\x1d\x14\x15\xd8\x42\xcf\x3e\x59\xc6\x01\xea\xf7\x6a\x2e\xb0\xca\x57\xcb\x36\x87\xc9\xb9\xc6\xa2\xee\x9e\x91\x68\x1d\x37\xa4\x5c\xc2\x32\x59\x01\xc2\x06\xaf\xb3\x42\x2e\x9b\x5f\x7b\xb5\x41\x25\x3c\x77\xc0\xc1\x66\xe7\x73\x4e\xa6\x28\x3e\xff\x9d\xc2\x1a\xdb\x71\x5d\x46\x55\xa8\xef\x74\x9e\x66\x32\xd2\x90\x8c\xc0\xcd\xe8\xa7\x5f\xfa\xb8\xd7\x7c\x97\x7d\x2e\x2b\xec\x1b\xeb\xeb\xa5\xa7\x98\x09\x87\x61\x9d\xef\xc2\xe5\x7e\x29\xc1\xe7\x87\x2f\x10\x25\x6c\x76\x1b\x0a\x16\x47\x41\x43\x0e\xfb\x5a\x8a\x87\x63\x73\x4e\x9f\x37\x2f\xc6\x33\x10\x2d\xd8\x8c\xe2\x6e\x7c\x31\xdf\x2e\x88\xc1\x41\x1a\x9e\xd7\x88\xc7\x01\xde\x92\x37\x63\x9e\xa5\xd7\x47\xa7\x0a\x4c\xbe\x89\x87\x2f\xe6\x13\x65\x2c\x29\x45\xd6\xbe\x97\x3c\x21\xa9\x57\x99\x02\x56\x7e\xf8\x22\x21\xce\x0f\xab\x7d\x33\x20\x48\x6c\xc7\x3b\x79\xcc\x82\xcc\x93\x24\xb3\x69\xf3\xa7\xe5\x43\xdd\x1c\x05\xac\xac\xde\x3d\x1f\xb6\x0f\x78\x84\xcb\x0b\x1e\x1a\x9c\xf2\x82\x36\xa8\x8c\x92\xd8\x9d\x6f\x50\xb1\xcf\xb4\x41\x69\x9c\x1b\xed\x64\xf5\xfc\xf0\x17\xb9\x03\x23\x41\x8e\x8b\xfa\x87\xed\x9d\x26\x4c\x90\xc0\x59\xd7\x0f\x4d\x1f\xd8\x99\x90\xca\x02\xde\x48\xca\x23\x0f\x5b\x52\x50\x66\xb1\xf4\xc9\xce\x57\x1b\xeb\xfc\x7d\x9d\x68\x30\xa2\x52\x9f\xa2\x07\x70\xe2\x01\x1c\xb4\x29\xdd\xb7\x5b\x74\xd8\x1c\x9b\xdd\x33\x5f\x07\x61\xfd\xe5\xf0\x75\x5e\xef\x76\xcb\xaf\xf5\xf4\x79\xbd\xdf\x3e\xaf\x44\x1c\xbd\xde\xd5\xdb\xbd\x88\x32\x5d\xae\x56\x50\xac\x4d\x52\x7a\xee\xf1\x10\xd9\xf6\xc5\x61\xf7\xcd\xe4\x15\x6d\x9f\x0f\x1b\x1e\xfe\xbc\x11\xa0\xd3\x2f\xff\xac\x1f\xf6\xf1\xf2\x4b\xcd\xd7\x51\x54\xef\x25\x70\x8b\x5d\xec\xb7\xc2\xd4\xaf\x31\x2e\x9c\xd6\xfb\xbe\xe6\xff\xb5\xd9\x3e\x3f\x35\xab\xfa\x7f\x7e\x7b\x78\xfe\xbe\x59\xee\x9b\x2f\xcd\xaa\xd9\xbf\xfc\xf6\xd3\x0d\x14\xf9\xe1\xc5\xc1\x7d\x15\xeb\xbc\xf8\x49\x3e\x43\x9c\xe3\xc7\x7d\xe7\xf8\xb1\xd4\x39\xfe\xf5\x96\x10\xda\x89\x5e\xf1\x4f\x4e\x9d\xa9\x46\xe5\xed\x53\x02\x15\x82\x67\x02\x77\x4e\x72\xdb\x4b\x86\x44\xc2\x4d\x0b\x95\xe5\x9a\x5e\xb6\x14\xd0\x79\x73\x51\x4d\xd3\x5b\x95\x61\xf0\xf0\x3e\x09\xc8\x36\xdb\x1d\x6c\x59\xc6\x34\xd6\x94\xb4\x98\x30\x0f\x16\x0b\x75\x52\x3a\x87\x0f\xd0\x8e\x70\x3a\x49\xb9\x9c\x31\x5a\x5b\x4c\x72\x23\xe1\xf6\xfd\xf6\x49\x8b\x6b\x26\xd4\xb6\xd7\x3d\xdd\x95\x5f\xaa\x08\xec\xe0\x6f\xb3\xa1\x0e\x6c\xfc\x83\x1c\x43\x00\x15\xb4\xd2\x39\x0d\xea\xda\x96\x92\xa9\x9c\xbc\x51\x92\xb4\xfa\x18\xb0\xb9\xcc\x35\x79\x42\xd2\x9d\xf3\x7c\x51\x15\x69\xa5\xc2\x48\xb1\x53\x9f\xdb\x83\xdb\xad\x0e\x8b\x36\xc7\x38\x78\xa7\xdf\xa2\xe1\xd3\x04\x2d\xcd\xb6\x40\x3b\xd2\x87\x91\xe0\xb1\xfd\x16\x65\xe0\xf6\x40\x7c\x30\x3f\x02\xe7\x66\x3f\xb6\xdd\x89\xf5\x62\x76\xe2\xfd\xd5\xee\xdf\xbc\x18\xbc\xef\x2f\x06\xef\xa5\x8b\x41\xeb\x61\x16\xa7\xec\x5b\xc1\x44\x45\xce\xf0\x90\x28\xea\x77\x29\x7b\x4f\xfd\x59\x65\x29\x86\x9c\xc6\x7a\x51\xa5\x99\x8e\x34\x77\x9f\x60\x90\xd6\x18\x08\x18\xff\xec\xf0\xa2\x41\x45\x32\x04\x0a\x58\xa0\x2c\x23\x49\x07\x90\x26\x34\x90\x23\x6a\x95\x5f\xe1\x0a\xb3\xa6\xd5\x7c\x4a\x2f\xff\x42\x9d\x17\xe4\x3f\x9c\x4b\x6c\xb1\xa6\x36\xe9\xd5\xa9\x28\x89\x76\x11\x2f\x82\x2c\x62\x2d\x50\xf6\xfb\x20\x68\x54\xea\xb8\xb8\xef\xfa\x64\xa6\x78\xfb\x96\x4e\x92\x74\x4a\x4b\xb1\x84\x54\x26\x7f\x26\xe9\xc7\x44\xc4\x31\x98\x33\x77\x5b\xba\x21\x0e\x0a\x15\x71\x3e\xa7\x71\x9a\xab\x2e\xab\x34\x81\x72\x4b\xd2\x2b\x19\x32\x9c\x36\x0b\x5a\xe5\xab\x2c\x9d\x94\x79\xd1\x49\x37\xeb\x02\x24\x67\x2a\x56\x41\xae\x50\xbf\xf7\xb7\x68\xd5\x2c\x2e\xf3\x5b\x76\x6e\x9c\xae\x9a\x0d\x7a\x7c\xb2\x71\x64\x5f\xc9\x50\xf1\x13\xf0\x2b\x8a\x9d\x87\xa1\x95\xe7\x0d\x2e\xb6\x0a\x45\xf5\xfe\x15\xbf\x39\x0e\xa3\x55\x03\x79\x37\x63\x80\x69\xab\xf6\xca\xf2\x74\x15\x4d\xa6\x20\xb7\x1c\x3b\xe3\x84\x84\x02\x99\xf3\xfa\x59\x80\x66\x43\x32\xd7\x0e\xa1\xd1\xde\xa5\xc7\x1b\x44\x42\x33\x9b\x2f\x37\x3d\x96\x10\x8e\xf5\xb9\x72\xfd\x5d\x96\xc9\x6c\x75\xd8\x7d\x9b\x2f\x37\x9b\xfa\x51\x9c\x59\x54\xef\x7b\x1c\xa1\xed\xfa\x2a\xf3\x66\x2c\xe6\xda\x00\x67\x52\x1a\xdc\x3f\xb6\xf5\xb9\x51\x19\x1c\x1f\x3d\x46\x56\xaf\x1f\xeb\x2d\x68\x59\xbe\x24\x9f\xaa\x46\x4c\x75\x3b\xd4\xa1\xbc\x78\xf9\x32\xa0\xbc\x64\x14\x07\x7b\xde\xdb\xd4\x21\x75\xbc\x7c\x04\xb3\x3d\x19\xfd\x5f\x15\x00\xe9\x10\x6f\xd3\xe5\x53\x4e\x8f\xdc\x1c\x87\xf1\x0e\x43\x89\x4f\x43\x79\x7c\x47\x9c\xac\x9a\xfd\x25\x8d\xcf\xec\x5b\xfd\xf0\xd7\x25\x2b\xdf\x2f\xf7\x87\x1d\x3c\xb4\xaf\x8e\x9b\xe3\x10\x7a\xb0\xdf\x2f\x1f\xbe\x91\x2c\xaa\x24\x21\xbb\x8e\xf6\xc7\x3b\xd8\x93\xfb\x14\xd1\x9a\x7a\x85\x28\xb9\x27\x68\xbf\xbc\x9f\x8c\xfc\x83\xdd\x84\xc1\x7f\xab\x03\x82\x03\xf5\x0c\x8d\x2d\x48\x8e\x22\x92\x5c\xed\x11\x7c\x29\x61\x24\x25\x88\xaf\x7f\xce\x32\x1b\xc2\x75\x36\x19\x2f\x94\x32\x7c\x31\x03\xa8\x0d\xba\x84\xdf\xd6\xbb\x5d\xfd\x78\xc1\xf5\x7e\x81\xeb\xff\x02\x17\x2a\xf3\xe6\x65\x48\x69\x37\x2f\x43\xca\xb9\x79\x91\x97\xd0\x31\xba\x91\xfb\x24\x67\x1c\xc5\x94\x46\xcc\xd0\xcd\x51\xce\x39\x0c\x20\x01\x94\xa8\x5e\xd7\xdb\xd3\xdc\x35\x6f\x36\xdf\x97\x1b\x89\x5d\xb1\x5c\x37\xfc\xfd\x1d\x9d\x00\x6d\x06\x98\x61\x34\xaa\xf7\xaf\x7a\x2a\xcc\x74\xf8\xb8\x3e\xd6\xab\xae\x2a\x9e\x8e\x83\xa9\x8d\x84\x3a\x2c\x43\x51\xd7\xb8\x46\x3b\x0c\xe3\x01\x2c\xbb\xb4\xb9\x0b\x65\x0a\xe0\xcb\x2f\x6f\x61\xb3\x5b\x7e\x59\xf5\x39\xf6\xc6\x9b\x25\xaa\xf5\x30\x5e\x8f\xe1\x1e\x94\x80\x4b\x6b\x8f\xd8\x37\x4d\x0f\xe5\xed\x24\xc4\x21\xb7\xf3\x3d\xa2\xec\x25\xc0\x89\xa7\x87\x12\x85\x6f\x0f\x28\x47\xe1\x63\x82\x53\xec\x6d\x22\x37\x47\x21\xc1\x45\x8b\x1e\xca\xbb\x19\x03\x4c\x3b\x1a\xe0\x5b\x12\x0b\xbf\x88\x21\x8f\xd2\xfe\x71\xa8\xb7\x0d\x70\xb1\x12\xd5\x7b\x82\xbe\xd8\x3e\x68\x9f\x02\x40\x4f\xe0\xae\x12\x87\xd1\x0e\x83\x33\x44\x66\xae\xb3\x58\x03\x93\x97\xac\xfe\x0a\xbe\x8a\xba\xd8\x42\x0c\x5a\x77\x24\xe4\x68\xbb\xdc\x7c\x6b\x1e\x76\x59\xbd\xab\xf7\xf8\xa1\x6d\x7d\x96\x29\x9c\xdb\x5a\x04\x2e\xd7\x0d\x85\x2d\x78\x3c\x4a\xd0\x4f\x22\x74\x23\x42\x23\x2d\x4f\xf6\x8c\xf5\xa2\xf9\x51\xaf\xf8\x8a\x74\xed\xe5\xba\xc9\x4f\x6e\x17\x7f\xf9\x49\x8b\x15\x18\x2a\x00\x47\xe0\x45\x9a\x9b\x2b\x99\xbb\x34\x2e\xdd\x0b\x01\x21\x95\xe0\xce\x13\x4f\xc0\xcd\xd2\x4f\xf7\x92\xbc\x0c\xfe\x57\x0a\xdb\x4f\x60\x50\x91\xfb\xf9\x7a\xa1\x00\xec\x73\xe0\x5c\x91\x46\x35\x3d\x16\x61\xa1\xb7\x3a\x2f\x10\x89\x55\xfb\xb9\x28\x7a\xae\x93\x79\xf0\x89\xed\xbf\x34\xa2\xe7\xcb\x0d\x38\x40\xe7\xcb\x0d\x38\x38\xe7\xcb\x0d\x38\x30\xcd\x40\xc3\x13\x6e\xe1\x87\x46\x88\xdf\x81\xf8\xe7\xd5\xcb\xd7\xe7\x75\xbe\x6f\x36\xc8\x49\x9f\x28\xd3\xe7\xd5\xf3\xb6\x58\x7e\x81\xe1\xeb\xe3\xf3\xea\x40\x8f\x6c\x67\xcd\x6a\x0f\xec\xfc\x28\x93\xbc\xde\x2c\xb7\x94\x87\x80\x73\xdb\xec\xf6\x66\x17\x00\xa1\xe7\xcd\xfa\xfb\xf2\xc7\x4f\xa6\xb0\x21\x0f\x24\x6e\xfa\x0f\x24\x6e\xa4\x0f\x24\x9c\xb7\xd2\x44\x27\x41\x76\xef\x94\xa0\xaa\x7c\xa1\xb3\xea\xee\xb7\xbf\xfd\x9c\x69\x40\xf4\x0e\x28\xe0\x75\x89\x03\x92\xf4\x3b\xf9\x05\x0b\xe1\xe0\x63\xa3\xf6\xb6\xd6\xdc\x10\x27\xa9\x95\x54\x07\x2e\x87\xe9\x21\x02\x45\xbb\x27\xf7\xfb\xb9\x2e\xf8\x47\x09\xdd\x5b\x41\x9c\xe2\x5e\x24\xba\xea\xc6\x63\x46\x5c\x73\x50\x74\x8f\x12\x11\xe6\x6b\xaf\x44\x3c\xeb\x4b\xe7\xca\xde\x57\x2b\xe8\x9b\x2f\xfd\x19\xf1\xac\x2f\x1c\x19\x05\xc4\x58\x2f\x16\xe4\x7d\x64\x94\x42\x7a\x45\x46\x79\xce\x6d\x0f\x26\x2e\xd2\xf8\x3e\x4a\x13\xe7\xad\x64\x5f\xad\x61\x03\x47\x7d\x2a\x30\x79\x52\xf2\x7d\x93\x72\xda\x27\x2c\xf4\x1a\x26\x48\x74\x9e\x16\x59\xba\xe0\x46\x29\x81\x07\x11\xaf\xbc\x5c\x4c\xef\x54\x36\xe3\x55\xe8\xaf\x30\xf3\x22\x53\xc1\xfc\xed\x04\x2e\x27\xd6\x7c\x53\x3f\x34\xcb\x55\xf3\x7f\xb5\xf5\x77\xc0\xbd\x54\xce\xbd\x5b\x4c\x68\xb5\xe1\x2e\x2e\x18\xdd\xad\x7d\xe9\xd3\xd3\xae\xde\x4f\x57\xcb\xef\x9b\xe1\xab\x41\xbd\xf3\x7b\x0b\x82\xca\xbb\x35\xc1\xaf\xde\x9d\xd6\x04\xff\xbf\xdf\x49\xd7\x04\xfb\xfc\xac\x9b\x7a\xb9\xe9\xb3\x15\xc2\x83\x09\x76\x2b\x09\xc3\x67\x41\x9c\x73\xdb\xcc\x22\x2b\x39\xc8\x22\xd5\xbc\xf6\x6e\x0c\x08\x86\x10\xa6\x8a\xd3\x94\x1b\xe7\x06\x47\x4f\x77\x39\x60\x91\xe9\x20\x89\x78\xa9\x89\x16\x27\x4a\xb4\x9a\x05\xdc\x9b\xc3\xcf\x2a\x4b\x19\x08\xff\xc4\x31\xcf\x48\xfc\x23\x66\x9f\x54\xa5\x09\xc9\xbd\x26\x65\x0e\x33\x08\x67\x54\xb1\x45\x29\x23\x8c\x30\x2f\x20\xdc\x29\x65\x94\x11\xb6\x27\x26\x51\xca\xa2\xda\xa8\xf2\xa0\x28\x49\x70\x97\x21\x50\x10\xa0\x2a\x08\xb9\xc5\xda\x08\x2d\x57\xea\x1f\x25\xf2\x16\xef\x1c\x0c\xe8\xb0\x5d\x10\x90\x2a\x34\xe5\xce\xcb\x49\x91\x05\x6c\x24\x66\x83\xcd\x28\x68\x48\xae\x50\x8e\x2d\x12\x35\x14\x5a\x7c\xaa\x7a\x14\x8b\x76\x94\x53\xca\x08\x9a\xa2\xde\x15\x41\x22\xed\x5b\x22\x5a\x07\x46\x0a\x74\x25\x0f\x84\x66\xbf\x1a\x29\x8d\x55\x6c\x81\x4e\x0d\x6d\x8c\x3f\x01\xa5\x0f\x05\x0f\x19\xd7\x72\x01\xa9\x6e\x67\x13\x66\x01\xb7\x2d\xca\x8b\xa0\xd0\x53\x04\xd9\xfa\xf6\x00\x50\x5c\xed\xc1\x21\xcb\x9c\x77\xc1\x99\x96\x99\xf1\x42\x3a\xf3\xb8\x62\x38\xb3\x2c\x65\x5d\x69\x68\x2b\x88\x24\x63\xa4\x78\x01\x70\xbb\xaf\x65\x6d\x45\xe6\xa5\xf8\x2c\x60\x03\x55\x21\xc2\xf4\xa1\x06\x74\xab\x05\x72\xc6\xb8\x76\xf2\x54\xe7\x79\x9a\x21\xd0\x8b\x03\xcc\x4c\xc7\x9c\x30\x47\x1b\x79\x9d\xc6\x39\xb9\x3f\x4c\x69\xab\xce\xf7\x14\x47\x03\xd1\xed\xf1\x9d\x81\xe9\xe4\x2e\x88\x75\x58\xa9\xa4\x9c\x83\x50\x3a\x11\x2b\x10\x9b\x2e\x54\x86\xac\x87\x69\x59\x54\xe9\xcc\x45\xd2\x64\xb0\x53\x6e\x9c\x4e\x59\x84\xd9\x59\x22\xd2\xd1\x41\xac\x03\x0a\xd5\x6a\xf6\xbe\x66\xe4\x57\x59\x90\x44\x0a\xa4\x9d\xf2\x81\x68\xdd\xd0\x41\x5c\x57\xcc\x24\x80\x0c\x34\x17\xdf\x02\xc8\xbf\x27\xea\x3d\x0f\x72\x6e\x46\xb0\x68\xeb\x0f\x88\xf4\x09\x8b\xa7\x9d\x06\x38\xa0\xf1\xa4\x5b\x86\x20\xf1\x59\xa0\x63\x10\x4a\x11\x73\xdb\xd2\x0f\x62\x2d\x78\x71\xee\x96\x95\xa9\x19\x88\x34\xf5\x52\x01\xed\xd4\x12\xd0\x76\x6d\xf1\x5d\x04\x4c\x11\x1e\xaf\x20\x93\xfe\xb0\xba\xbd\xa4\x0a\x2a\xd8\x50\xf1\x5a\x36\x70\x71\x55\x1b\x16\x5a\xdf\x24\x9a\x04\xc8\xac\xb7\x4b\xd2\x24\xfd\x84\x85\xe3\x81\x47\x8f\xc5\xa3\xc5\x2d\x93\x05\x7d\x1d\x2a\x5d\x25\x02\xf7\x0d\x68\xc0\x36\x8b\xe0\x6d\xed\x55\xa1\x66\x63\x37\xe7\xe5\xc4\xc6\x5f\x00\xf4\xb2\x29\xd6\x15\x00\xb3\xc1\xad\x00\xa0\x89\x66\x05\xe0\xcc\xee\x1f\x49\xd0\x19\x99\x78\x60\xd7\x29\x75\x21\x34\xc6\x96\x89\x98\x32\x0b\xa6\x45\x9a\x81\x7b\x49\xb7\xeb\xe7\xf7\x94\x6e\xeb\x83\x85\xb9\xc6\x02\xda\x5d\xec\xa6\xb0\x65\xe6\x82\x63\x5d\x30\x19\x52\x52\xce\xfb\xb1\x23\xda\x6f\xb7\x37\x45\x5c\x29\x07\x13\x43\xda\x1c\x4c\x03\xd6\x45\x7f\x16\xe4\xc0\x1e\x37\xd1\x53\x1e\x74\x11\x8c\xa8\xba\xd5\xec\x78\x9f\xdc\xb3\xd6\x97\x32\x71\x61\xf2\x01\x6c\x7e\xcb\x4f\xa3\x5d\x7a\x08\x58\x27\x70\x72\x3c\x14\x51\x7e\x37\xf1\x26\xb1\x7d\x17\x28\xa9\x80\x58\x13\x78\x9b\x0c\xa0\xc0\x1f\x97\x73\x9d\x00\x01\x8a\x3b\x1c\x64\xb0\x39\x6f\xae\x6a\x6c\x7f\x32\xd2\x7b\xf3\xf3\xa4\xa4\x9b\xea\xbd\xec\x7e\x4e\x72\x83\xf9\xdb\xdf\x64\x1a\x2c\x39\x4e\x70\xfa\xe5\xd5\x9d\xa2\xa9\x18\x22\x5a\xe1\x72\x01\xa3\x73\x10\x6f\x67\x25\x1b\x4e\x04\x59\x30\x7a\x25\x1d\x46\x1e\xc6\xea\xda\x4a\x56\x3d\xee\x02\x15\x50\x2e\x0f\x55\xac\x0a\x12\x5e\x09\x0a\x36\x4a\x7d\xac\x93\x3f\x31\xa4\x39\x46\x07\x68\xba\x41\x51\x04\xd3\xdb\x4e\x43\x95\x85\x5b\xa5\x6e\x57\x23\x32\xb4\xe9\x06\x90\xea\xbb\xe3\xd9\x7e\x5c\x16\x2a\x17\xe2\xf1\x9c\xa8\xb1\x68\x3f\x11\x07\x49\x54\x52\xe7\x70\x17\x7a\xa0\x09\xce\x05\xbe\x60\xd0\x09\x59\xc9\x19\x4c\xac\xd8\xf3\x08\x99\xf2\xb9\xe3\x4e\x8c\x80\xa2\x4c\x05\x7c\x60\xbd\x24\x2d\xa0\xc4\x10\x50\x10\x7f\x0c\xee\xb9\xef\xfb\x53\x29\xee\x86\x2d\x53\x8b\x98\x37\x5f\xe8\x64\xca\x7d\x5c\xa8\x58\x08\xb4\x55\xa3\xac\xaa\x8f\x19\x1b\x48\x31\x54\x18\xee\x4e\x25\x21\xbb\x19\xb6\x21\x41\xd9\x06\xc4\xba\x32\xfe\x26\x42\x05\x19\xbf\xa5\x23\xfb\x55\x90\x61\x49\xb5\xdb\x3e\x49\xca\x32\xce\x45\x46\x50\xe1\xce\xf3\x81\x28\xed\xfa\x32\x0f\x22\x2c\x68\x65\x47\xd0\x89\x8c\x40\x5d\xad\xca\x25\xe0\x02\x03\x83\x49\x4e\xcb\x09\x7d\xe6\x02\x84\xb7\xc7\x35\x21\xad\x85\x57\x8b\x34\x37\x4f\x82\xaa\x4f\x52\x66\x27\x2d\xf4\x69\x70\x9e\xf7\x52\x66\x97\xe7\xfd\xe0\x3c\x3f\x0f\xce\x93\x63\xd2\x92\xd8\xb1\x04\x16\x13\x07\x7d\x87\xc1\x3c\x0c\xe6\x63\xb0\x11\x06\x1b\x63\xb0\xf7\x18\xec\x06\x83\xfd\x8e\xc1\x3e\x60\xb0\x3f\x30\x98\xf7\x0e\xc4\x81\xed\xe0\x81\x0d\xe1\x81\x2d\xe1\x81\x4d\xe1\x81\x6d\xe1\xdd\x80\x38\xb0\x35\x3c\xb0\x39\x3c\xb0\x3d\x7c\xb0\x3d\x7c\x74\x5c\x80\xed\xe1\x83\xed\xe1\x83\xed\xe1\x83\xed\xe1\x83\xed\xe1\x83\xed\xe1\x83\xed\xe1\x83\xed\x31\x02\xdb\x63\xe4\x61\x27\x0a\x07\x67\xc0\x99\x5a\x28\xd6\x4e\xf3\x73\x71\xc5\x73\xac\xd5\x63\x54\x21\x96\xb2\xb1\x12\xd1\x49\xdc\x47\x81\x23\x14\xc8\x75\x1f\x9d\x40\x19\xeb\x04\xca\x56\x27\x50\xa6\x93\x34\xe5\xce\x1b\x04\x41\x0a\xd6\xe2\x46\x20\x8e\x2b\x9a\xad\xb8\x79\x50\xf8\x28\x70\x84\x02\xb9\xac\xad\x75\x3a\x43\x2d\xe3\x99\xd9\x42\x30\xd0\x73\x4d\x5f\xfb\xfc\x46\x25\xe4\x82\x14\x0e\x60\x02\x9b\x8d\xab\x34\x44\x89\xf5\x1a\x11\xb0\xc0\x5c\xa3\x51\x84\xce\x20\xd6\x9f\x07\x7d\xa3\xb9\xf5\x57\xd9\x00\xa6\x7b\x00\xe4\x76\xcc\x4c\x02\x9a\x9a\x90\x6c\x53\xad\x58\x2e\x5d\xc7\x51\xe8\x7d\xe4\xa3\xdf\x26\x43\x51\xc9\xe9\x82\x41\xc7\xa0\x79\x49\x27\xb3\xb4\x8a\xd3\x08\xb7\xc6\xd0\x73\x29\x13\xe6\x50\x44\x71\x85\xca\x30\xf4\x99\xeb\x0d\xf7\x09\x74\x1b\x33\x84\x17\xa7\x1f\x21\x03\xfe\x5c\x85\xba\x9c\x43\xd0\x5b\x1d\xdd\x42\x40\xca\x5b\x27\x60\xce\x3c\xd0\xe4\xcb\xc3\x66\xe4\x71\x04\x3d\x02\xb4\x16\x0c\x0c\x1a\x4d\x02\x6e\xee\xcb\xa2\xc9\xfb\x2a\xe0\xd6\x74\x42\xdd\xbc\x97\x5d\x95\x78\x37\xe0\x55\xa9\x89\x45\xf7\x41\xf0\xd9\x50\x1c\xfa\x33\x02\x14\x7b\xfe\x8c\x61\xe6\xa2\x24\x88\x5d\x8f\x95\x50\xe9\x32\x1b\x98\xb0\xcf\x38\xf6\x66\x5b\xca\x32\xd7\xdc\x52\x92\x7b\x8e\x2d\x64\xb9\x88\x5f\x42\x56\xdb\xc4\x40\x19\x7b\x23\xc0\x59\xd6\x69\x92\xae\xd2\xc9\xdf\xd5\xb4\x40\xe6\xe6\x9f\x27\x40\x71\x53\x87\x25\xe0\x36\xb2\x50\x14\x30\x26\x89\xce\x88\x00\x3c\x24\xb3\x0b\xd3\x29\x11\x6e\x7b\x6e\x1b\xe8\x84\x07\x07\x1f\x4c\x48\xd2\x44\x52\x81\x34\x0d\xd0\x15\x8d\x80\xa2\x93\x96\x84\x7f\xc6\x1b\xfc\xb9\xce\x73\x32\x9e\xfd\x6a\x3a\xa1\x9e\x63\x16\xde\x3e\xbf\x4c\xd0\xf8\x0b\x7d\x16\xb6\x7b\x39\x1b\x5d\x18\x85\xac\x57\xe7\x83\x52\x7f\x46\x5f\x94\xf6\x0b\xf8\xf6\xeb\xd2\x4b\xb7\xb1\x80\x14\x3c\x5a\x31\x27\x1e\x6d\xd4\xfd\x40\x6f\x38\x12\x73\x71\x4a\x30\x68\x5c\xa2\x93\x5e\x3d\x04\xed\xe9\x0e\x42\xf8\xbe\x5a\x1f\x44\x40\xeb\x65\xb2\xaa\xd7\x8f\xc6\x95\x1c\x83\xaa\xff\x3d\x80\x15\xd2\x47\x5b\x37\xf2\x3d\x58\x9c\xd9\x61\xfd\x80\x23\xf1\xb4\x71\xc5\x4f\xa1\x60\x2c\xe9\x58\xca\x25\x2c\x8d\x46\x27\x86\xc2\x1a\xc8\x24\x18\xd6\x9b\xfd\x37\x5e\x0d\xce\x60\xf3\x7d\xbd\x7e\x68\x00\x1d\x6e\xca\x7e\xbe\xdc\xf1\x81\x88\x48\xdd\xa5\x59\xa1\x5e\xa7\xaf\xb5\x60\xfc\x50\x46\x6a\x15\x6b\x20\xde\xe6\x45\x94\xcd\xe6\x45\x98\x41\x5f\x01\x09\x44\xa3\x15\x75\x58\xad\x66\xcb\x07\xbe\x8f\x87\xf5\xaa\xc6\x65\xbd\x2d\xba\xd7\x73\x51\x0a\xfa\x91\x16\xdd\x9f\xbc\xd0\x1c\xc0\x8a\xb1\x60\x37\xd9\x21\x69\x6f\xf6\xdf\xa0\xe9\xc5\x20\xa1\x2e\x6f\x90\x46\xab\x94\x1f\x75\x61\x2d\x58\x85\x9c\xb6\x1c\x8a\x73\x32\x5f\x66\xd9\x32\x5e\xdc\x3c\xb1\xf3\xf7\x86\xa0\xad\x77\x37\x0b\xb6\xda\x76\x20\x4c\x5e\xec\x59\xb3\x6e\x76\xdf\x78\xd8\xea\x80\xa0\x4e\x9d\x5f\xb4\xc6\xf6\x78\xae\xf7\x01\x33\xc4\x6c\xfb\xbc\xde\x43\xe3\x38\xaa\xd7\xe8\x20\x6e\x65\x2d\x41\x3d\xcb\xa8\x5e\xf7\xca\x0e\x25\x2f\x1b\xbf\x51\xbd\x86\xc7\x63\x54\xef\xed\x36\x0e\x54\x3f\xec\xf0\x4e\x57\x0b\x22\x98\x9d\x5f\xfd\x68\x47\x1d\x56\x26\xd9\xde\x2f\xaa\xf7\x93\xe7\xe7\x55\xbd\x5c\x43\x62\x3f\x97\x02\xf8\x10\x49\x6d\xb7\xc0\x0e\x80\x22\xcb\xae\x9e\x97\x7b\x28\xc9\x5f\xd6\xbe\x76\xd1\x68\xbf\x82\x52\xfc\x6e\xd1\x68\x24\x60\xbd\x7e\x7a\x46\xa2\x19\x46\xf5\xbe\xdf\x4b\x85\x5f\x61\xbb\x86\x04\x2b\x28\x97\x25\x2c\xb6\xf5\x43\xb3\x23\xf1\x25\x4c\x5a\xb2\x23\xe6\x26\xc8\x29\x84\xdf\x6f\x11\x79\x4d\xab\x15\xd8\xd5\xd0\xd3\x51\xca\xc0\xea\xc9\x8d\xd0\x27\x09\x58\x94\xb2\x64\x78\xf6\x17\x99\xa7\xa3\x94\xd1\x88\x19\x70\x84\x8a\xdb\x06\x10\x48\xd1\x3b\xf0\x64\xa9\x77\x76\xe9\x7d\x04\x90\xbd\xd1\x0f\xa0\xdd\x70\x04\x90\xfd\x61\x08\xc0\xc1\xbd\x90\xde\xb9\x35\x85\x45\xc6\xcd\xba\xfe\xd8\x3c\xee\xf9\xa5\x3f\x6e\xd6\x7f\xa1\xdf\x65\xf4\xdf\x48\x94\xbd\x6e\x64\x7a\x36\x2c\x9a\x64\x1f\x41\xd5\xc7\xac\x5e\xd5\xcb\x9d\xdb\x1a\xbb\x93\x16\x5f\x75\xfd\x06\x71\xb2\xf2\x2c\xc7\xaa\xbe\x4e\x9f\x8f\x35\x06\x7f\x68\x76\x88\xea\xad\x2d\xf9\xa4\x59\x2f\xb7\x2f\x68\xa4\x66\x6c\xfa\x73\x27\x59\x68\x6b\xdf\xc3\xc2\xb6\x03\xc7\x81\x0e\x04\x3d\xac\x34\xfd\x74\x83\x23\xe1\xb4\x05\x87\xde\xb3\x85\x41\x86\x3e\x8a\xe0\x8d\x0c\x0d\x25\x2e\x38\xaa\xbb\x25\xc4\x7b\xc2\x91\x47\x18\xda\xe0\x48\x38\x51\x1f\x2e\xa9\xff\x74\x84\xa1\x70\x49\xfd\xe6\x88\x42\x47\x70\x49\x47\x78\x49\x47\x70\x49\x47\x78\x49\xc7\x70\x49\xc7\x78\x49\xc7\x70\x49\xc7\x0d\x9c\xe8\x7c\xb9\xdf\x36\x3f\x04\x2d\x6b\x09\x82\x0a\xb6\x04\xe8\x3b\x77\xb0\xf5\xe7\xce\x45\x4d\x82\xf1\xbd\x1d\x14\x30\x34\xfb\x1b\x2e\x64\x7c\xf6\xf1\xfe\x93\x10\x2e\x4b\x7e\xf4\x24\x84\xcb\x92\x1f\x3f\x09\xe1\xb2\xe4\xdd\xee\x95\xe7\x34\xf5\xbf\x36\xcf\xdb\xfd\x7f\x40\xae\x6f\xd4\x97\xeb\x1b\xc9\xe5\xfa\xcc\x53\x1b\xe8\xf9\x82\xf3\x7d\xcf\xd2\x8f\xd8\x7b\x16\x87\xcf\xff\xd4\x8b\x2a\x4b\x3f\xe6\x02\xb8\x71\x54\xe7\x08\xb2\xd2\x88\xca\x22\x2c\x09\x22\x45\x65\xee\x83\xb1\x4b\x60\x06\x95\xb1\xf7\x98\x59\x34\x61\xdf\x6f\x44\x93\x00\xc0\x78\xef\xaa\x80\x7b\x00\xd8\xde\xa6\xbb\xeb\xcf\x6a\x14\x32\x84\x7e\x4b\x1b\x3f\xc6\x1c\x23\x18\x2c\xf6\x76\xa4\x2d\xd3\x28\x04\x81\xc6\xc1\x84\x6b\x43\xba\xba\x1d\x9d\xdc\x3e\x81\x8b\xdb\xce\xaf\x96\x1e\x84\xfa\x95\xf7\xce\xfd\xcb\xd4\x1d\x90\x97\x53\x0b\x33\xda\x9c\x85\xc6\xa2\x17\x77\x1c\x6a\x0d\x3c\x5a\xf1\x5c\x27\x55\x9c\xa2\xb5\x45\x85\xc3\xd1\x93\x20\xc7\x9e\x6b\x9c\x25\x0f\xe0\xe7\x6c\x40\xec\x6b\x12\xeb\x3f\x7d\x25\xc5\x3e\x33\xef\x3b\xe1\xc6\x29\xc9\x64\x04\x68\x1d\x53\x26\x41\x06\xe9\x1b\x5d\x52\x00\x25\x9a\xd6\x91\xd3\x84\x94\x16\x04\xd6\x17\x40\xab\xe0\x2e\xd0\x31\xa0\xdb\xef\x9e\x28\xcc\x83\xc5\x42\x85\x30\x16\x7c\x72\xea\x84\xf0\x68\xe1\xc2\x90\x53\x5e\x83\xd8\x49\xe6\x41\x69\x1a\x24\x90\x66\x2b\xae\x07\x24\xda\x42\x81\x54\xa9\xff\x91\x5c\x9f\xab\x37\xae\xef\xf5\xa0\xef\x70\xa8\x87\x43\x7d\x1c\x3a\xc2\xa1\x63\x1c\xfa\x1e\x87\xde\xe0\xd0\xdf\x71\xe8\x07\x1c\xfa\x07\x0e\xf5\x24\xcd\x25\x68\x2f\xcf\x17\x60\x05\x2d\xe6\x8d\x05\xd8\xf7\x43\x34\x0b\xba\x39\x5a\x20\xb2\x20\xe6\xb6\x2e\x05\xa3\x10\x04\xfa\x56\x6f\x20\xfd\xc8\xe0\xbb\xcf\x71\x0f\xb0\x43\x95\xe9\xbb\xc0\xb8\xe6\x00\x5a\x31\x66\x1b\x5a\x99\x5d\x10\xb4\x57\xb7\xf8\x32\x91\x32\x7a\x78\xf0\x05\xdc\x95\x9c\x40\x66\xe7\x0f\xe2\xff\x18\xe1\xd0\x31\x0a\x1d\xfd\xf0\x71\x28\x9c\xea\x18\x4f\x75\xcc\x7e\x56\xce\x6b\xcf\xe4\xc0\x5e\xde\x60\xac\xa0\x0c\x07\x6d\xf7\x13\x99\x9a\x91\xf8\xa6\xdb\x66\x30\xa4\x79\xf0\xf7\x34\x03\xe5\x24\xe6\x3a\x81\xb1\xe4\x8e\x00\xfb\xec\x93\x08\xcf\xc8\xe7\x94\xeb\xb2\x68\x02\x81\x02\xef\x06\x40\xf1\xa0\x6b\xee\x2f\xb4\x87\x89\xd8\x11\x4a\x93\x94\x73\xee\x71\x7b\xbd\x38\xb8\xe7\xd7\x73\xda\xa7\x3b\xa9\x0e\x3a\x87\xa8\xd8\x69\x8f\x01\xb9\x0d\xa4\xb5\xfa\x38\xf0\x14\xda\xee\x5d\xfd\xd0\xfa\x03\x81\x70\x37\x69\xa0\xb4\xcc\xf3\x66\x55\x44\x7f\x26\xde\xbb\x99\xe4\x18\xe6\xbd\x9b\x55\xc4\xa3\xff\xfc\x39\x2c\x8b\x26\x7f\x54\xea\xbd\x24\x83\xf7\xd5\x1f\xe6\xc7\x27\x7e\x25\x80\x45\x5b\xdd\xb0\xee\xcb\x95\x34\xdc\x34\x0c\x9c\x33\x28\x97\x2b\x09\xd8\xe8\x58\x85\xc2\xd7\xcd\xb7\xbf\x24\x1f\x5c\xfe\xbc\x08\xb2\x62\x38\x9b\x3f\x9d\x5f\x21\x9f\x42\xb7\x18\x4d\xc8\x42\x71\x93\x58\x46\x1a\x72\x99\xfe\x4c\x4b\xba\xce\xa7\x41\x16\x0e\xab\x72\x9a\x35\xb2\x58\x05\x77\x2a\xc4\x6b\x9d\x6b\x3e\x4c\x3e\xab\x9f\x35\xc6\x10\x66\x70\xa5\x88\xd0\x9e\xe4\x4d\x1e\xb8\xc3\xa0\x89\x7e\xe4\x97\x9a\x87\x61\xa8\xc0\xbb\x41\x60\x18\x2a\xf8\x80\xa0\x20\x50\x30\xf2\x01\x14\x04\x0a\xbc\x1b\x00\x05\x81\x82\x0f\x00\x88\xc7\xa8\x10\x5c\x51\xb3\x68\x82\x23\x03\x10\xda\xdb\xe6\x23\xab\xd2\x25\x1c\x3b\x1b\xb4\x2c\xe3\x8f\x04\x31\xce\xd6\x1b\xc0\x3b\xfc\x12\x3f\x12\xe2\xb9\xfd\x31\x99\x37\x4f\xdf\x2e\x00\x8f\x24\x60\xc0\xcf\xbb\x0f\x07\xdb\xec\xec\x5b\x4f\xdc\x21\xac\xd1\x20\x16\xf0\x59\x57\x79\xe0\xf7\xb9\x29\x33\x98\x4e\x29\xa6\x1b\x85\xb7\xcb\x31\x06\x99\xe4\xa0\x2d\x48\x0f\x0f\x6d\x2b\x2f\x6c\xae\xfc\x8e\xdd\x10\x46\xfe\xac\xbd\x21\xe1\x8e\x39\xf6\xb8\x36\xf2\xcf\xeb\xdb\x1f\x57\x1f\x80\x1d\x5f\xdf\xc9\xea\xe4\xb0\xe6\x9c\xcb\x55\x32\x4d\x91\xb3\xed\x5b\x69\xb8\x4f\xfe\x05\x07\x4a\xd0\x9d\xf5\x0d\x36\xec\xd8\xfa\x06\x1f\x75\x71\x7d\x83\x0e\x3b\xbb\xbe\xc1\x87\xdd\x5e\xdf\xe0\xbb\xfe\x23\x4d\x21\x54\xb3\x80\x37\x95\xf7\x19\x65\x12\xaa\x19\x89\x84\x32\x1c\xf7\x41\x52\xef\xcf\x33\x9a\x64\xee\xa0\x31\x80\xa4\xed\x8f\xd1\xb1\xd6\x25\x0f\xab\x4d\x18\xab\x61\xbf\xb2\xc0\xdd\x1c\xdd\x54\xf7\x68\xc2\x5c\x84\xa9\x0f\x71\x00\xc5\xc4\xb7\xdf\xe8\x9a\xed\x39\xd8\x98\x01\x98\x24\x68\xdf\x7f\xe9\x90\x9c\x0b\x1d\x98\x3d\x21\xde\x17\xe2\x47\x42\xfc\x58\x88\x7f\x2f\xc4\xdf\x08\xf1\xbf\x0b\xf1\x1f\x84\xf8\x3f\x84\x78\xef\x9d\x94\x20\x6d\x61\xcf\x97\x12\xa4\x6d\xec\x8d\xa5\x04\x69\x2b\x7b\x37\x52\x82\xb4\x9d\xbd\x0f\x52\x82\xb4\xa5\xfd\x77\x52\x82\x78\x2c\x4b\x5b\xda\x1f\x49\x09\xd2\x96\xf6\xdf\x4b\x09\xd2\x96\xf6\x7f\x97\x12\xa4\x2d\xed\xff\x21\x24\x8c\xde\x49\x09\x9e\x60\x71\xe9\x0b\x30\x94\x71\xa1\xed\xf2\xc4\x24\x40\x4b\x0b\xb6\x8e\xdd\x06\xf1\x0c\x92\xf0\xa1\x73\x04\x5d\x82\x03\xd1\x5a\x09\x6a\x62\xb1\x80\x58\x27\x8c\x40\xb6\x2e\x13\xe3\x49\x4e\x73\x75\x85\xf1\x66\x71\x99\xdf\x56\xea\xd3\x22\xd6\x53\x5d\x80\x99\x95\x49\x7e\x9f\x4c\x6f\xb3\x34\xa1\xbd\x11\x40\xca\xd8\x4d\x47\x84\x1a\x3a\xb8\x1e\x9c\x45\x2c\x02\xb8\x29\xe1\x0f\x70\x59\x04\x24\x13\x01\xe9\xf0\xf6\x22\xc0\x62\x06\x98\xaf\x10\x1b\x1e\x60\x50\x83\xec\x8a\xfc\x37\x45\x88\x19\x10\x31\xca\x41\xa6\x49\xc4\x50\x08\x7c\x58\x7b\x37\x46\x26\x0a\x74\x37\xfd\xa1\xca\xe9\x18\xc1\xc1\x22\x14\x37\x81\x81\x01\x86\x94\x9e\x75\xba\xfb\x04\x7a\x42\x45\xf7\x19\x36\xca\x89\x15\xd9\x62\xb8\xf4\x1a\xc8\x4d\x9c\xc8\x09\xc6\xc0\xdd\xe4\x09\xe3\x7b\xc9\x83\x6d\xf4\x2a\x1b\x90\xd7\x3e\xc5\x80\x4e\x63\xe7\xe0\x41\x39\x40\xd7\x47\x97\x14\xde\x2c\x70\xe5\x71\xc9\x24\x4e\xa7\x7f\xe6\x43\x9e\xb4\xc0\xcc\x2e\xe4\x88\x98\x79\xbd\x26\x45\x4c\x2a\x24\x5a\x35\x5d\x41\x2f\xea\xa8\xb3\x7c\xe5\x92\x44\x5e\x55\x18\x9c\xcc\xc5\x67\xbb\x00\x5d\x68\x28\x36\xa7\x99\xdb\x25\x62\x6a\x80\x4a\x46\xb2\x65\x98\x4d\xf4\x5a\x12\x68\xa1\x01\xe3\x60\x0b\x05\x9a\xa5\x85\xe2\x65\x6f\x19\xa6\x93\x41\x53\x55\xcb\x80\xcc\xbf\x2d\x58\x20\x8a\xda\x52\xe6\x41\x91\xe9\x4f\x32\x8e\xce\x8d\x97\x80\x79\xc5\x02\x52\xec\x87\x0b\x67\x1d\xc3\x09\x83\x02\xb2\x6f\x9e\xb3\x86\x36\xcd\x79\x27\xcb\x7f\x81\x0b\x3e\x17\x3f\x4f\x22\x53\x33\x95\xa9\x64\x4a\xbb\xda\x7b\x51\x88\xa7\x9f\xa5\xd3\x0d\x7a\x28\x25\xb7\x95\x87\x7a\x69\x6f\xee\x4e\xcb\x62\x51\xf6\x0c\xf2\xa2\xe9\x5b\x27\x62\x6e\xae\xb2\x3b\x52\xca\x0c\x74\x51\x15\x7a\xae\xd2\x92\x1b\x25\xb8\xd2\x22\x1d\x2f\xaa\x69\x9a\x84\xfa\xaa\x1c\xdc\x15\x30\x24\x78\x6b\x90\xc8\x75\x91\x05\x2a\x3e\xfa\x99\x01\x46\x8b\x92\xaa\x7d\x1e\x24\x61\x8e\xca\x12\x5a\x1b\x73\xc0\x8b\x35\x83\xb0\x20\xa6\x7d\xcf\x3d\x0a\x77\x0d\x46\x07\x3f\xcd\x7b\xdf\x74\x2d\x51\xe5\x41\xa1\xf3\x99\x66\x19\xa6\x57\x50\x4c\x5e\x15\x62\x6d\x42\xa7\xd0\xae\x0e\xf9\x03\x65\x5b\x7e\x1d\x25\x29\x5f\xfe\x6b\x6f\xda\x42\x7d\xa7\x73\x76\xfa\x0c\x92\xfb\xd6\x76\x60\x02\xd4\xaa\x50\x4c\xa0\x7e\x4c\x63\xc5\xbc\x8c\x65\xd8\xed\x3d\x28\x36\x4f\xb7\xde\x4c\xa5\x06\x1f\xa4\xe5\x1f\xf5\xe7\xcf\xb1\xaa\x32\x21\x3e\x12\xe2\x27\x42\x3c\x17\x35\xd0\x5c\xf1\x31\x98\x09\x1f\xef\x53\xec\xb1\xf4\xfa\xb5\x8e\x98\x50\x2d\x82\x32\x57\xa1\x9c\x67\x17\x33\x39\x0f\xeb\x3a\xed\xcb\x49\x27\x9a\x9d\xa9\x22\xd3\xea\x8e\xfc\x60\x90\x38\x9f\x17\x6c\x68\x99\xa7\xa7\xb1\x17\x3c\x4c\xaa\x7b\x10\xa9\x17\x68\x35\xf3\xbc\x4a\x05\x53\x9c\xe0\x0e\xc4\x62\x5e\x16\x0d\xcd\x49\x4c\x34\x66\x00\x55\x4c\x7d\x9c\x92\x0f\xe0\x18\xca\xa2\x4c\xa6\xb7\xc5\x6d\x96\x96\xd1\xad\x7d\x92\xed\x09\x93\xc9\xff\x4d\xe9\x58\x9b\x06\x71\x84\xd5\xdc\x7b\x4d\x8e\xd2\xdb\x29\x4a\xcf\xe7\x65\x61\xc6\x05\x24\xd3\xdd\xf3\x57\x84\xf6\x6f\x34\x2a\xba\x30\xc4\x25\xbf\xf1\x7a\x5d\x2c\xe3\x85\x98\xb3\xfe\xda\x24\x35\x03\x2a\x09\x85\xdb\xe5\xbf\x8c\xa6\x21\x2c\xfa\xd7\xaa\x8c\x8c\x42\x04\xda\x6a\x75\x8c\xc4\x42\x9c\x23\xa1\x42\xe8\x20\x92\x20\xb3\xa8\x5e\xff\xe3\x50\x6f\x1b\x40\xed\xce\x8a\x55\xa2\x68\xbd\x23\x24\xaf\x93\x33\xa9\xbf\x36\x6b\x0c\xaa\xd6\x8f\x18\x30\xaa\xf7\x06\xd8\x1c\x61\x68\xfa\xe5\x9f\xf5\xc3\xfe\x00\x89\x5a\x7c\x5f\x6e\xc0\x7e\x78\x92\xcb\x43\x15\xb5\xa8\xe7\xa2\xd2\x89\x67\xe2\x17\xbe\x5c\x2f\x63\x24\xd7\xe4\xf0\x7f\x8c\xa5\x94\xf1\x00\xe5\x8f\x21\xb9\x20\x9f\x3f\x59\x35\x7b\x89\x72\xd8\x15\xf1\xa9\xf9\x61\xb5\x6f\x76\x46\x60\x8a\xa5\xf7\xb2\x72\xfa\x5f\xf1\xf2\x05\xc8\x76\xde\x76\x30\x33\x87\xf1\xd9\x90\x54\xe8\x7c\xb9\xd9\xd4\x8f\x12\x1a\x69\x7e\x3b\xdd\x0c\x48\xb8\xd4\x8e\xff\x1e\x83\xef\xa1\x51\xbd\x16\xe1\xf5\xae\x07\x17\x08\x38\x36\x15\xd0\xf6\x34\xd1\x14\xdb\xe5\x7a\x47\xbd\x66\x56\xd7\x8f\x5f\x96\x0f\xbc\x1c\x96\x5a\x3f\xca\x49\x27\x39\x75\xbc\x29\x2c\x7e\xb2\xdc\xf1\xf0\x57\x05\xba\x5b\x6e\x5f\x9a\xf5\x57\xa4\x41\xf6\x6f\x91\x59\xae\x6b\x1a\xa3\xc9\xa2\x51\x91\x95\x0b\x65\x41\xdd\x1c\xc5\x14\x64\x52\x3e\x23\x8c\x1b\x21\xfe\x20\x25\x88\x4b\x74\x10\x69\x43\x82\xe8\xd9\x76\xf9\x35\x5c\xee\x97\xb0\x94\xa4\x4b\xde\x3b\x34\x28\xd4\xc7\xa1\x23\x1c\x3a\xc6\xa1\x1e\x52\x17\x0e\xeb\x0b\xb0\x23\x01\x76\x8c\x60\x8d\x22\xbd\x1d\xc3\x32\xb4\x30\xf1\x27\x19\xba\xc1\xa5\x57\x79\xe8\xf4\x79\xf3\x22\x8b\x2d\x70\xea\xd3\x7a\xfd\xd8\x3c\xd4\x3b\xb1\x46\xf2\xae\x39\x0a\x72\x99\xac\x9e\x1f\xfe\xd2\xeb\xc7\xfa\x87\x38\x23\x43\x6d\x8e\xc3\x78\xc9\xf2\x3b\x3f\x6d\xf7\x09\x34\xe3\x23\x93\xee\x49\xe4\x5c\xaf\x77\xfb\xe5\xfa\x01\x50\x47\xed\xab\x9d\xe3\xac\x59\xbd\x7e\xa8\xf3\x17\x40\x81\x52\xef\x20\x98\x53\xc2\x47\xa0\xd3\x55\x53\xaf\xf7\x1f\x97\xcd\x1e\x4a\x19\x06\x9e\x76\x08\x37\x63\xa8\x65\x29\xd5\xe6\x28\x4a\xb7\xa9\x06\xc9\x66\x63\x05\x5a\x5b\x29\xd3\xed\x0e\xad\x6d\x14\xae\x77\x0e\xca\x22\xa9\xa7\xa2\x58\x87\x3b\x7d\xa5\x9c\x71\x14\x53\x9e\xe4\x0c\xa4\xea\xf7\x03\x8a\x76\x85\xf5\x24\xdb\x2a\x84\xcd\xb1\x41\xb4\x68\xa9\x55\x5e\xed\xe4\x58\x96\x1d\x92\xaf\x78\x7c\x77\x21\x55\x7c\x39\x4b\xef\x5e\x91\x58\xce\x62\x79\xd8\xd5\x72\x5a\x56\xef\x0e\xdf\x07\xf0\x4e\x5a\xed\xa0\xae\xef\x20\xf4\xa9\x0f\xb1\x0c\xbd\x3e\x3a\xdd\x49\xc9\x41\xf5\xc4\xca\x0f\x5f\x24\x44\xb2\x16\x59\x55\x65\x3f\x14\x80\x21\xcb\x92\x99\x7b\xb7\xeb\xe5\x8a\x5a\x64\xb9\x6f\x8e\xff\x11\xa5\x45\xaf\xaf\xb4\xe8\x49\x95\x16\xc9\xc6\x5b\x16\x0a\xbb\x20\x77\xcf\x60\x0c\xe1\xec\xb2\x3c\x17\x10\x4f\xf6\x4f\x12\xce\x2b\x13\x5d\x48\xd8\x1d\x0b\x79\x5b\xd0\x27\xe6\xb7\x01\x39\xa9\xcd\xd5\x3c\xcd\xa0\x58\xb9\xd7\x3e\x56\x74\xbb\xde\x92\x83\x22\xb5\x32\x5a\x25\xb9\xb4\xbb\xc7\x3f\xc3\x13\x00\x99\xf6\xad\xd2\xbf\xe5\x03\x3e\xa6\xd9\x9f\x55\x94\xa5\xa5\x79\xa3\x9c\x4e\x4d\x80\xb7\x81\x09\x98\x5a\x18\x46\x05\xda\x6c\x18\x2b\xd4\xf9\x22\x28\xa6\xb7\x64\xee\xd7\x19\x05\x82\xe4\x9f\x03\xfe\x3f\x7b\xe7\xb6\xdc\xa8\x92\x2c\xec\x57\xf9\xa3\x5f\x60\xb5\x0e\xed\xed\x89\xf8\x6f\x10\x42\x32\x7b\x21\x60\x00\xf9\xb0\x6e\x2a\xb0\x54\xb6\x89\x51\x4b\x1a\x81\xbc\xdc\x13\xf3\xf0\x3b\xb2\xaa\x40\x80\x90\xc8\x2c\xd0\x5c\x4c\xac\xe8\x53\x44\x3b\xbf\xac\x23\x75\xcc\xcc\xba\x82\x21\xef\xef\xf2\xbc\x16\x6f\xdf\xb6\x5d\x4c\x08\x0f\x35\x62\x16\x1b\x10\x64\xf6\xca\xc6\x76\x0e\xb2\xcd\x1b\xbc\x13\x51\xcf\xb0\x36\x71\xa8\x88\x9a\x4d\xa0\xba\xba\xd7\x20\xa5\x11\xac\xba\xcb\xc1\x96\x59\xd9\xe0\x2c\x2c\xc2\xb3\xb3\x75\x06\x55\xd6\x3a\x84\x2b\xa7\x6a\x42\x9c\x94\x34\x08\x44\x5e\xe6\x0a\x7b\x23\xa4\xac\x34\x6c\x6a\x11\x56\xe5\x7a\x34\x02\x1b\x11\xcd\x51\x7d\x36\x61\xe4\x05\x30\x23\x60\x72\xde\x38\x10\xd3\xaf\xe7\x55\x08\x99\xb6\xc4\xcc\xdc\xc0\x5a\xbc\xb6\x8d\xe9\x46\x78\x83\x3b\x21\xbd\x5c\xe4\x89\xe4\x55\xd6\x96\x08\x3e\x01\x84\x81\x97\x32\x94\x6c\x1f\x5e\x51\xe6\x97\x78\xab\x4e\x82\x81\x26\xc5\x30\x93\x60\x90\xd9\xd8\x8f\x50\x79\x27\x8d\xc0\x4a\x18\x6b\xbc\xa9\xd1\x1f\x88\xe2\x55\x8b\x48\x65\x91\x85\x5a\x38\x56\x49\x9a\x2d\x65\x95\xad\xce\x9a\x2d\x68\xe4\x41\xac\x88\x47\x8b\xd0\x59\xcf\x10\x4c\xdf\xc9\xe7\x0a\x9c\x0d\x1b\x7a\xc2\xaf\xd5\x13\x82\x30\x9c\xd2\x1b\xfc\x51\x88\x1c\x9d\x65\xe0\xa4\xf6\x21\x57\x75\x17\x85\x21\x95\xfb\xb6\x6f\x39\xb6\x5b\x84\x52\xd3\xfa\xb6\xba\xb0\x18\x8f\x8b\x0b\x24\xc9\xf1\xa2\x51\x47\x88\x9b\xd4\x65\x33\x6b\x6b\x28\x96\xfb\xda\x1a\x1a\xcb\xd0\x21\xf3\x1d\x72\x8d\x41\x1b\xcb\x89\x6d\xaf\xab\x5d\x2c\xc4\x7d\x03\x8d\x3a\x42\x6c\x50\x0a\xfb\xac\xbe\x5a\x48\xc8\x36\x6d\xbf\xac\x1a\x94\xbc\x5d\x2e\xda\x93\x4c\x16\xcd\x49\x22\xa5\xb0\xaa\x7c\xcc\x03\xfc\x55\x00\x13\xc0\xbd\x46\x40\x24\x0b\x6b\x4a\x67\x48\x84\x8c\x6b\x44\x42\x50\xc6\x69\x4d\x66\xd0\x13\x23\x08\x6c\xd4\xf4\x90\x9b\xb5\x51\x39\xd5\x96\x04\x22\x3f\xe4\x99\x59\xb0\x41\xc6\x73\x6a\xf2\x92\x4d\xa6\x82\x43\xe1\x69\x65\x7d\x4e\x48\x4f\x06\x0a\xce\x87\x00\x72\xf1\x96\xfe\xd4\x88\x2c\x02\xa8\x52\x22\x73\xe5\x8d\x1f\x9e\x6a\xb2\x2d\x46\xc3\xf5\x21\x0e\x0f\x3a\x4e\x59\x1a\xf7\x15\xb4\x86\x36\x93\x62\x23\x9c\x18\x26\x26\x9b\x4a\x16\x15\xad\xcc\x76\x23\x74\x3e\xdd\x08\x9d\x57\x37\x22\xe4\xd7\x8d\x68\x79\xae\xcc\x36\xc8\xcc\x37\x30\x23\x0d\x06\x51\x9c\x06\x0a\xdb\x16\x42\x58\x8e\x96\xe2\x98\xd0\x88\xec\x89\xed\xd8\xd1\x0b\x66\xd7\x7a\x85\x9e\xa0\x36\x08\xd7\x15\x98\x8e\xd1\x3a\xec\x83\x97\x0f\xf3\x5c\xa7\xad\xa0\xd2\x6b\x1a\x21\x28\x14\x0a\x69\xdc\xe8\x5a\x9c\x99\x60\x0e\x40\x1a\x99\x7c\xd2\xd2\x62\x31\x0b\xf2\x0b\x24\x6e\x81\xa7\x26\xc9\xba\x0e\xec\x45\x45\xb1\x04\xd2\x55\x50\x3b\xd9\xd5\xe1\xe5\xe9\xbd\xae\x82\xab\x6d\xa6\xa3\x00\xeb\x6c\xdd\x9c\x30\xd1\xcf\xb9\xae\x04\x3d\xf1\x34\xd5\x9d\x72\xa9\xc4\x1e\xc3\x55\x23\xdf\xe5\xd3\x3c\x22\x66\x75\x8e\x60\x4e\x81\x6a\xe1\xd2\xc5\x4a\x62\x6e\x44\x0f\x45\x55\xd1\xe2\xa6\x93\x79\x79\x72\xcc\x7c\x2f\x44\xf9\x66\x4a\xe9\x85\x11\xfe\x8e\xd3\x0b\x92\xec\xd1\x68\x77\xe6\xca\xf3\x3e\x9c\x6a\x85\x02\x92\x09\x3d\x79\xc1\x14\xd3\xa5\x65\x58\xa4\x3c\x49\xdc\xe1\x39\x70\xb2\x47\xd0\x39\x15\x0b\x07\x49\xe4\xfa\xd5\x37\x4a\xab\x12\x5a\xe6\x72\x69\xad\xdb\x8e\x1c\xc6\xdc\x72\xe4\xb2\xa8\xdb\x8d\x5c\x18\xf3\x22\x5d\x2e\x0b\x55\x1c\xb8\x86\xa3\x66\x63\x24\x85\x0c\x55\x9a\x8b\xa3\x63\x93\xe6\x00\x36\x18\x69\x2e\x8f\x8e\x3e\x9a\x03\x6a\x8c\xc2\x03\xf9\xd8\x44\x48\x43\xdd\x96\x13\x08\xa8\x56\xc4\xfa\x2b\x17\x97\xd5\x4a\x00\x44\xb5\x12\xe4\x65\xb5\x12\x00\xf5\xa1\xe3\x81\x93\x7b\x1a\xd2\x25\x98\xf4\x51\xd7\x62\x2a\xe3\xc1\xca\xca\x5a\x4b\x43\xf5\x90\x41\x0d\x49\x24\x26\xb0\x1c\x43\x9c\x93\xa1\xe6\x23\xc5\xe6\x63\x1f\xce\xad\xbb\x06\xe9\x24\x84\xba\x17\xaa\x31\xa8\x4b\xc3\xd2\x22\x54\xab\x42\xce\x79\x95\x7c\x48\x06\x2f\x94\xb1\x6e\xac\x34\x4d\xd2\x7d\x9c\xad\x3e\xc0\x8d\xee\x98\x71\xaa\x3c\x18\x37\x1f\xf8\x2a\x6b\xe7\x4a\xe6\xbc\x04\xe4\x64\xcd\x8b\x84\x4a\x26\x60\x04\xdb\x33\xe9\x53\x70\x0e\x7e\x12\xcc\xe8\x84\xcd\xd7\x5b\xbc\xe2\x24\x2a\xe0\xa9\x78\x29\x1f\x6d\xba\x5d\xe3\x50\xf6\xd7\xe7\x98\x56\x1e\xf1\xee\x16\xc5\x5b\xd3\x61\x16\xbf\x23\x6c\xdf\xa5\x5d\x79\xf8\x11\xaf\xf9\x41\x91\xad\x8c\x79\xe0\x71\x56\x65\xda\x0b\x05\xd6\xa3\x4a\xd8\x4f\xf6\x7c\x93\x6c\x11\x5d\x5e\xd8\x8e\xd6\xa8\xf6\x32\xcd\xf9\x96\xcc\xd8\x69\x0d\x21\xb4\x52\x8e\x20\x9a\x56\x11\xf8\x87\xef\xab\xc0\x90\x0a\x8c\xa8\xc0\x98\x0a\x0c\x8e\x54\x62\x48\x26\x46\x64\x62\x4c\x26\x06\x6f\x44\x60\x48\x05\x46\x54\x60\x4c\x05\x06\xe4\x1e\x38\x24\x13\x23\x32\x31\x26\x13\x83\x23\x19\x19\xd2\x91\x11\x1d\x19\xd3\x91\xc1\x1b\x95\x18\x92\x89\x11\x99\x18\x93\x09\xe5\x8c\xad\xc9\xd1\x73\xa8\x7c\xac\x35\xb9\xa1\x76\x8a\x38\x0f\xf5\xe6\x34\x75\x6b\x75\xac\x9d\xe6\xa8\x43\x9a\x98\x1a\x7a\x54\x26\xfe\xb5\x99\x4e\x63\x72\xb4\xb7\x6f\x3b\x67\xd7\xee\x7d\x06\xeb\x04\x11\x81\x42\xb9\xaf\x63\x92\x9a\xec\x76\x1b\x1e\x6f\x31\x6e\x51\x0b\xfe\x73\x77\xf8\x35\x89\x0f\x87\x84\x1f\x68\xd2\x93\x5f\x01\x7f\xc7\x2c\xc2\xca\x0e\x0e\x14\xdf\xfd\x39\xcf\x4a\xe2\x88\xd6\x91\xee\x42\x8b\x38\xfd\x47\xfb\x6c\x07\x6e\xd8\xfc\xcb\xe1\x9f\x7c\x43\x5c\x65\x9f\x61\x88\x9c\x9d\x1c\xfd\x91\x61\x2b\xca\x0e\x49\x33\xe1\xbb\x41\x42\x6c\x0d\x06\xeb\x0f\xa9\xca\x21\xa5\x1b\x9c\xa5\x7a\x73\x2b\x19\x96\xdd\x4a\x86\x54\xb7\x92\xd2\x61\x03\x13\xd6\x5f\xe2\xc0\x50\x86\x43\xff\xf6\x9b\x0e\x3a\x0f\x0c\x77\xe9\x18\x81\x1d\xb5\x5d\x87\x89\xb4\xfd\xd6\x5b\xb3\xd0\x44\x84\x24\xf3\x1e\xad\xc0\x69\xbd\x92\x9c\x1a\xc1\xef\xad\xaa\x1c\x38\x05\x6d\x95\x12\xc7\xd4\x53\x6f\xda\x5a\x4d\x42\x70\xb2\x0c\xda\x14\x3e\x18\xc1\xd4\x41\x1c\xc0\x86\xde\x2c\xc2\xc8\x4d\xed\x99\xb2\xc7\x6c\x11\xb4\x9e\x4d\x67\x89\x78\x70\xf7\x21\x74\xd8\x43\xeb\x5d\x01\x48\x85\x46\xb4\x0c\x30\x86\x96\x20\x2c\xea\x07\x21\xe7\x2c\xe1\x75\xe0\xb0\xbd\x5b\x4d\xad\xc9\x72\x9e\xdf\x27\xe5\xc1\xf3\xbd\xd6\x80\x95\x12\x73\xad\xe7\x88\x39\xde\x7c\x2e\x5c\x90\xc2\xd0\x98\x23\x0d\xb1\x25\x6e\x1a\x8e\x23\xcc\x37\x66\x4b\xd7\x44\x54\x40\x8d\x5a\x86\x56\xc0\xc0\xc6\xb3\x2d\x90\xb8\xe4\xe4\x55\x19\x33\x7c\x9b\x22\xfe\x64\xbb\x53\xef\x89\x85\x2f\x61\x64\x91\xd2\x51\x37\x75\x70\x9c\x6a\x3b\x56\x40\x41\xa3\x07\x3b\x98\x42\xc9\xa2\x17\x0a\x66\xf8\xf0\x56\x02\xa6\x27\x55\x30\x0f\xae\xe8\x50\x00\x1c\x3b\x33\x2b\x08\x3c\x82\xf8\xd4\xf2\x03\xcb\x34\x22\xb0\x73\xb6\x1e\x8c\x47\x9b\x02\x17\x2f\x7b\x69\xb0\xbe\x17\x44\x86\x34\x61\x20\x40\x56\x20\x6e\x5d\xda\xc7\x81\x12\x44\xac\xc0\x05\x0c\xab\x94\x82\x40\x04\x52\xe1\x2b\x46\x60\x3c\x9f\x80\x84\xd6\xa3\x05\x33\x10\x73\xbd\xc8\x9e\xe1\xba\x10\x9c\xc9\xca\x4c\x2a\x87\xb4\x08\x3e\x64\xcc\x3d\x97\x1e\x85\x3a\xa1\x46\x99\xcf\xab\x3b\xe6\x16\xa9\xfc\xbc\x19\x61\xba\xf3\xf7\xa5\x15\xbc\xe0\x12\x2d\xec\xc6\x5b\xc4\xd5\x05\x47\x8b\x14\xb4\x81\x63\x4c\x2c\x07\x37\xe8\x9e\x9a\x8c\x34\x56\x9f\xb0\xea\x48\x8f\x9b\x22\x74\x98\xa2\x3f\x3e\xd8\xf3\x07\x1a\xb1\xb0\xa6\xf6\x72\x41\x63\x1c\xef\x89\x30\x49\xb6\x88\x9a\x9e\x0b\x37\x69\x22\x98\xb3\xe2\xda\x4d\x3c\xe4\x67\x00\xab\xb2\x59\x7b\x66\xa4\x30\x0c\x8d\x18\xe9\xb9\xe5\x2d\xac\x28\x78\xc1\x7d\x1b\x35\x69\x84\x75\x4a\x41\xc0\x07\x03\x51\xcd\x99\xb7\x44\x33\xc2\xd9\x0e\x73\x39\x59\x10\x6a\xa1\x42\x41\x54\x51\xf0\x3e\xbe\xe2\x55\x40\xe6\x07\xde\xa3\xf7\x3b\x5c\xa8\x41\xc9\x5a\xad\x5f\xe0\xa3\x0e\x99\x31\xfd\x5f\xc3\xb4\x5c\xb3\x6d\x38\x00\x69\xe1\xc0\xe5\xa3\x91\x28\xb0\x0d\x77\xee\x58\x21\x99\x20\x26\x04\xdf\x7b\x51\x79\x5a\x1e\xd6\x67\x34\xdd\x46\xac\xa7\x0c\x68\x44\x8f\xaf\x77\xb5\x4e\x70\xfe\x51\x50\xd0\xc8\x8b\x0c\xa7\x5b\xea\xe4\x2e\x5f\xcd\x80\xb2\x04\x28\x3c\x1e\x68\x89\x6b\x39\x91\x5c\xd1\x40\x42\x49\x5e\x1a\x4d\x75\x46\xb2\x4a\x9c\xd9\x41\x18\xe5\x57\xd3\xa6\xe7\x3e\x5a\x2e\x62\xe9\xe4\x18\x1a\xd0\x69\x29\x8c\x1a\x8e\x8a\xb7\x90\x42\x36\xb7\x5c\x2b\x80\x05\x38\xc1\x18\x3f\xf7\x1d\x17\x43\x21\xa6\x1a\xcb\x30\x0a\xba\xf0\x78\x9e\x60\x59\x64\x04\x73\x2b\xa2\xa8\x38\x3d\xdb\x27\xdd\x5a\xac\x29\xc9\xff\xb2\xd6\x0b\xb0\x95\xcb\x26\xde\x52\x19\x50\x78\xcf\x94\x85\x41\xe0\x4d\x96\x61\x54\xb8\x82\xd8\x11\x01\x6e\xab\x16\xc7\x0b\xad\x02\xf0\x5c\x78\x0c\xab\xd5\x34\x63\xbe\xb4\x9d\xe8\xa5\xa0\x30\x88\xed\xba\x9e\x09\x6e\x37\x14\x68\xe9\xfe\xee\x7a\x4f\x2e\x89\x11\x7a\x2b\x5b\x13\x98\xd0\x8c\xc8\x9a\xb7\xcd\x64\xae\xd7\x00\xb7\x30\x79\xce\x1c\x2f\x6c\xcb\x98\x3a\xcd\x83\xc1\xb6\xdd\x92\x08\xac\x63\xab\x00\xca\x98\x14\xb0\xc2\x72\x5b\x58\x06\xfa\x1e\x18\x21\x79\x2e\xde\xe6\xa6\x0b\x7f\x95\x45\x79\xe3\x42\x08\x92\xd6\x09\x50\x46\x1c\x41\xce\x96\x11\x7c\x33\xd0\x4e\x81\xe7\x10\x27\x5a\x81\xce\x2d\x17\x63\xfb\x5c\xc8\x86\xbe\x61\xb6\x37\x70\x21\xae\x46\x76\x2f\x68\x1f\x48\x0a\xc6\xf7\xc0\x30\x0e\x91\x29\x3b\xf4\x60\xed\xd8\x56\xce\xbf\x2f\x8d\x56\xf3\xe1\x59\x00\x5e\x9f\x1e\x58\x9a\x7a\xd3\x29\x5e\xd8\x7a\x6c\x3d\xee\x85\x5e\x47\x6a\x52\x00\x8a\xca\xc0\x78\x3f\x16\x40\xde\x0f\xb4\xd6\x89\x42\x83\x05\xdf\xa1\xfc\xa4\xf4\x95\xe4\xd9\xd0\x5b\x3b\xd5\xf3\xd1\x41\x4b\xed\xbb\xa0\x97\x44\xb6\x5b\x87\x1a\xd0\x5e\xbe\xd6\x6b\x41\x5b\x47\xbd\x4f\xa0\xb7\x1f\x97\xfa\x03\x4d\x41\x9e\xbc\xed\x76\xae\x02\x0d\x15\xc5\x16\xaa\xb1\x2a\x3a\xa8\x6a\xa8\x17\x92\xb6\x4a\x7e\xb4\x37\x09\xf5\xac\x74\x53\xd4\x9c\x9d\xee\xf9\x20\x67\x80\xbc\x6f\xa9\xa7\xaf\xa7\x20\x4f\x5e\x67\xf3\xd3\x94\x0b\x1d\x3d\x0d\xcf\xb6\x7a\x41\xbe\x6e\x60\xe1\xd2\x87\x93\xfc\xd6\xf5\xbc\x1d\x32\xdf\x52\x18\x69\xe9\xdf\x50\x0f\x74\xfe\xac\x0a\x5a\x54\xd0\x13\xed\x2b\x19\xc4\x66\xa3\x39\x29\x14\xa8\x9c\x1a\xc4\xda\x07\x75\x57\x69\x3a\xc6\xc2\x67\x91\xa7\x18\x6c\x02\x98\xcb\x80\xaa\x70\x6e\x6b\xde\x02\xc9\x4e\x5d\x01\x09\xde\x22\x2a\x0d\x45\x62\xa1\x72\x40\xa4\xc8\x3b\xa9\xa1\xf1\x54\x77\x44\xe5\x42\x81\xca\x6a\xd9\xe7\x02\x05\x34\x7a\x6b\xe0\x92\x5a\x9c\x5c\x2c\xdb\x44\xdd\x88\x22\x5e\xc9\x13\x81\x6b\xac\x67\x1a\x83\xe8\x42\xa5\x57\xa1\xe0\x35\x29\x66\x84\x91\xc9\xc6\x5f\x63\x1d\xec\x87\x2e\xf6\x43\x07\xbb\xd3\xc5\xee\x74\xb0\x7b\xbd\xd4\xee\x75\x53\xbb\xd7\xc1\x06\xdf\xf5\x72\x39\xf8\xae\x97\xcd\xc1\x77\xed\x7c\x0e\xbe\x6b\x81\xc3\x0e\xe0\x50\xf3\x81\x34\xfa\x27\x71\x8e\xff\xe8\x8a\xff\xe8\x82\xdf\x75\xc5\xef\xba\xe0\xf7\xdd\x52\xbf\xef\x9a\xfa\x7d\x17\x9c\xf6\x49\x35\xf2\x9d\xb2\x4f\xfb\xc4\x1a\xf9\xc1\xf7\x4e\x0a\x86\x3d\x28\x18\x22\xe7\x2d\x08\x75\xc2\x16\x86\x8f\xb2\x7f\xa8\xaf\x7e\x48\x70\xbe\x38\xe8\x01\x12\x0b\x69\xef\x89\xb0\x96\x21\x25\xda\xb8\xa6\x21\x69\x38\x85\x91\xc1\x23\x6e\xa4\x83\x35\xac\x75\xb4\x9a\xb4\xea\x60\xab\xd5\x1b\x34\x54\xe4\x95\xab\x81\x96\xdb\x46\x03\x6f\x6c\x63\x94\x9e\xba\x45\xf3\x64\xc3\xb7\x6b\xac\x0d\x3c\xbc\xc0\x23\x0c\xf2\xb1\x0f\xf0\x4c\xf9\xeb\xf1\x7d\xc1\xd3\x34\x7e\xe7\xe6\x6e\x9b\x1d\x76\x1b\x12\x63\x6f\x53\x7e\xc8\x48\x88\x19\x6f\x36\xd8\xb7\x17\xca\x1c\xc6\x21\xc1\x3f\xa6\x1f\x82\x99\x1f\x76\xc7\x7d\xbb\xf8\x6e\x4f\x90\x96\x6f\x40\x3a\xf1\x2b\x6f\xaf\xa3\x39\xcf\x28\xe2\x52\xd6\xcf\x0e\x44\xed\x14\x02\xfd\xc6\xa4\xb5\x8d\x5f\x37\xbc\xdd\x4b\x61\x9a\xa4\x38\x41\xd1\x85\xad\x7f\x1e\x85\x5b\x2c\x51\x3c\xe4\xfb\xf8\x10\x67\xd8\x54\x66\xc7\xed\x8a\x20\x8a\xd7\x6e\xee\x36\xbb\x03\xce\x7b\xc3\x4e\x65\x0d\xae\x13\x92\xf3\x36\x3c\xed\x27\xdd\x17\xe8\xef\xd7\x12\xd9\xb3\xd7\x9f\x08\xfc\xf9\xa3\x95\xad\x88\x7f\x48\x7e\x26\xe0\xba\x3c\xd9\x1d\x85\x63\xc6\x64\xd7\x9e\xce\x9c\x67\xf3\x43\xbc\xff\x48\x56\x69\xc0\x53\x9e\x85\x59\x9c\x1d\xdb\x7d\x83\xe1\x15\xe0\xad\x9f\x7c\xf1\x4d\xbb\xec\x9c\x67\x5b\xe5\x67\xf5\xf6\x49\x91\x4e\x48\xd2\x18\x3f\xc4\x45\xa2\x9e\x72\x02\x4f\x6d\x8c\xa3\x8b\x0f\xe1\x08\x4e\xce\x41\xad\xf2\x11\xff\x2a\xa4\x31\xaf\x2c\x56\xe4\x31\x25\x90\xce\x47\xa4\x34\xea\x08\x26\x99\xfa\xfb\x49\xb6\x0e\x83\x2c\x8f\x4e\x5a\x4d\x18\x26\xb9\x08\xed\x7d\x55\x48\xe2\xde\x0e\x2d\x3f\xca\x73\xc1\xc1\xad\xc5\x29\x8a\x7f\x65\x7c\x9b\x26\xbb\x6d\x5a\x42\x8a\xff\x54\x09\xcd\x1d\x36\x9a\xce\x9e\xd9\xcf\x52\x12\xff\x2f\x3d\xee\xf7\xbb\x43\xc6\xd7\x90\x91\x2e\x3e\x52\xa0\xfa\xdb\x6f\x57\xc5\x95\xa4\xba\xb7\xc1\x13\x28\xd1\x72\x5e\x26\x76\x74\x86\xd4\x6a\x10\x7e\xff\xff\xdf\x8a\x2a\x42\x54\x5b\xa6\xde\x63\xa2\x56\xd9\x59\x6b\x4b\x3d\x30\x51\xf5\x9f\x47\x39\xdc\xb3\x95\x7a\x4b\x3c\xd9\x6d\xd9\xec\x39\x1a\x74\x6c\xe7\xd2\x5e\x33\x98\x4f\x84\x46\x4c\x93\x54\x31\xa3\x99\xeb\x52\x66\x63\x31\x65\xaf\xb0\xa6\x60\x3f\x93\xed\xcf\xf8\x8b\xbd\xc5\xab\x6c\x77\xe8\x58\xda\x99\x61\x46\x5e\x20\xcc\x71\x8c\x45\xab\xb1\x84\x12\x36\x9e\xeb\xc2\x5d\x0b\x96\xb7\x21\x5f\xb3\xd1\xd4\xcc\x9b\xb6\x56\x36\x70\x7f\x24\x16\x0f\x94\x3d\x23\x4a\x26\xe4\x5e\x6e\x58\x2a\x23\xea\xb1\x54\xa0\x0c\xfa\x66\x7b\xb9\x94\xa4\xc1\xac\x67\xf0\xa1\xb2\x23\x15\xbf\x8c\x40\x9e\x0c\xa4\xac\x69\x33\xdd\xbd\x9a\x60\xd7\xf6\x19\xc3\x92\x8c\xad\xf9\x3e\xfb\xa8\x55\xd1\x37\x4d\xc5\x6b\xd8\x4c\xb1\xdd\x31\xdb\x1f\xb3\x2b\x2a\x31\x55\x7e\xc9\xc7\x04\xd1\x06\x17\xfd\x4c\x10\x6c\x37\xae\xf0\x04\x01\x7f\x13\x3a\x25\x7d\x4e\xe8\x9c\xe3\x3d\xa1\x21\xf0\xa0\x9b\xc3\x13\x6f\x86\x6f\x4b\x17\x3c\x3a\x5a\x71\x65\xa4\xe3\xb9\x27\x1f\x5c\x09\x93\xe1\x73\x4f\x3e\xba\x8e\x92\x73\x1e\x1d\xae\xb9\x63\xd2\x15\x94\xbc\x2b\xe9\xb0\x70\x11\x6c\xc4\xae\x1d\xb9\xc8\xfd\x28\x95\x92\x67\x3b\x54\x2a\x3f\xde\xc1\x70\xe7\x27\x3c\x3d\x8f\x74\x62\x70\x63\xab\x4d\xfc\x73\xcf\x52\xb5\xd7\xef\x38\x30\x09\x1f\x43\x65\x6e\xe0\x5a\x06\xae\xfb\x9d\x90\x99\x11\xf4\x3d\x9c\xaf\x0f\xf1\x9f\x4c\xae\xfb\x52\xb9\x62\x21\x17\xf1\xe2\x01\x89\x08\xb8\xc6\xd7\x98\xc6\x3c\x3b\x54\xa1\xb2\xf9\x91\x8f\x2e\x77\x3d\xdd\xae\xb5\xfc\x76\x3a\xf7\x28\x6f\x72\x58\xbc\xfe\x14\x47\x28\xb5\x3a\xff\xf7\xfb\x66\xb5\x3b\xf0\x7f\x5f\x5a\x6f\xe4\x21\x26\xbe\x5d\xed\x39\x81\x05\xde\x79\xf9\xe5\xbf\x32\x86\xca\xf7\x2d\xed\x1d\xef\x14\xd8\xb7\xec\xe2\xd0\x99\xd7\xca\x47\x35\x74\xb4\x5e\x7e\xe0\x0d\xaf\xc2\x8e\xab\xb2\x29\x03\x8b\x67\x8c\x06\x2a\x5d\xef\x70\x01\xdf\xae\xf9\x41\x76\x03\xb5\xad\x2e\x6d\xaa\x0d\xd5\x17\x30\x9a\x20\x24\xe1\x5a\x4b\x5d\x53\x4f\xae\xf5\xaa\x6a\x9f\xfb\xab\x63\xfd\xd5\xb1\x74\x3b\x96\xee\x10\xa9\x46\xc7\xfd\x2e\x4d\x60\x6c\x4e\xc9\x53\x52\xbd\x7a\x27\xaa\x4a\xa7\x76\x18\x21\x57\x6e\xe2\xf5\x1a\x69\xce\xa9\x60\xdf\x88\x20\x26\x36\x7b\xee\x44\xbf\x20\x68\xc3\x29\x34\x60\x1a\xad\x74\xaa\x2e\x4f\x1d\xfd\xbc\xe2\xde\x3e\x31\xbc\x68\xf4\xae\x4a\x2e\xc4\x77\x45\xc3\xf5\x4c\x5c\xd6\xd0\xb5\xa7\xbd\xaf\xb6\x2c\x15\x31\x4d\xaf\xf4\x2b\x92\xc2\xfd\x51\x29\x64\x1f\xf1\xe6\x8d\xbd\x6d\x76\x71\xd7\x2d\xec\xcc\xf1\x8c\x68\x70\xc7\xdc\xc7\x6f\xbf\xa1\x04\x1f\x2d\x73\x48\x92\x1e\x91\xa4\xc7\x78\xe9\x85\x11\x0d\x11\x9d\xbc\x24\x3e\xa2\x89\x8f\x69\xe2\xc3\x2f\xa2\xfe\xe1\x17\x31\x85\xd1\x17\xb5\xc0\xd4\x14\xc6\xd4\x14\xc6\xe7\x85\xee\xfc\xdd\x9c\xba\x79\xb2\xcd\x06\x77\xfd\x7f\x3d\xc9\x36\xbb\x1b\x77\xfc\x70\x6c\x37\xba\x43\x74\xd6\xb2\x8d\x05\x46\xde\x76\xa3\x7b\xa4\x14\xee\x4b\xcc\x45\x47\x78\x51\x5c\x36\x31\x83\x86\xed\xe2\x87\x0c\xdb\xc5\x0f\x18\x85\x2c\x2e\xab\x77\x63\x7c\x1e\xee\xc6\xf8\x3c\xdc\x8d\x91\x79\x28\xf7\x82\x7b\xaa\x38\x2e\xeb\x67\xcc\x48\x83\x21\x16\x65\x70\x47\x96\xa7\x17\x06\xdd\x29\xce\x21\x62\x71\xd0\xdd\xe4\x1c\x22\x66\xef\x4a\xc7\xa9\x2f\x59\xd4\xfd\xfc\x20\xb9\x1b\xe3\xa5\x87\x24\xe9\x11\x49\x7a\x4c\x92\x86\x7c\x7f\xe2\xc5\x87\x34\xf1\x11\x4d\x7c\x4c\x13\x1f\x1c\x49\x45\x1d\xd2\xc4\x47\x34\xf1\x31\x4d\x7c\x70\xa4\x95\x75\x48\x94\x1f\x11\xe5\xc7\x58\xf9\x39\xcf\x14\x42\x06\xb0\x49\xa8\x40\xc2\xb4\x2f\xab\x0a\x0d\x75\xa0\x91\x0e\x34\xd6\x81\xb0\x5f\x5d\x95\x1a\x6a\x51\x23\x2d\x6a\xac\x45\x61\xbf\xc8\x2a\x35\xd4\xa2\x46\x5a\xd4\x58\x8b\x42\x7f\xad\x55\x6c\xa8\x87\x8d\xf4\xb0\xa6\x4f\xb8\xeb\x5a\x5f\x58\x7b\x6e\x78\xfc\xc9\xd7\x8c\x2b\x83\xbf\x8e\x0b\x73\x15\x91\x22\x7f\x27\x39\x7c\xb2\xff\xf8\xc3\xc1\x9c\xc4\x28\xd0\x9e\x5e\x65\xae\x05\x80\x2e\xce\x12\x92\x9e\xb7\x44\xe2\x2c\x5f\xde\xa1\x24\xf9\xf3\x39\xd4\x5a\xaa\x67\x5c\x1c\xb5\x9d\xbf\xe5\x83\x29\x73\x81\x9e\x6c\x34\x1b\xe1\xae\xc5\x86\xf4\xd8\x3b\xdf\xb2\xb5\x78\xb7\xa5\x63\xc7\x10\x3e\xa7\xea\xd4\xb5\xbd\x37\x94\xef\x5f\x17\x9e\x6b\x47\xa8\x4b\x5c\x11\x1d\x93\x79\x93\xff\xb5\xcc\x08\xdf\xe5\x84\xa7\x01\x9e\xca\x4d\xf5\xaf\x00\xf5\x16\x9b\xf3\x2d\x1c\x7c\xa5\x18\xd9\xa9\xa8\x6c\xb4\xb8\x9d\x82\x68\xcf\x2d\xbf\x5b\xad\x36\x47\xe8\x10\xec\x9f\x47\x7e\xf8\xc5\xf8\x27\xdf\x76\x3d\xea\xf2\x4c\x15\xcc\x5a\xb5\x12\x84\x76\x89\xe4\x03\x94\xed\x75\x2e\x11\x79\x8c\xef\x1b\xc2\x65\x1c\x68\xb0\xb3\xa3\xc1\x33\xc3\x76\x34\xe0\xe2\xea\x40\x0f\x97\x69\x8b\x00\x5e\xa1\xae\x0e\xc3\x29\x51\xb8\x13\xe3\xbf\x43\xe3\x29\x2f\x80\x7c\x6c\x3c\xf6\x3d\x38\xee\xf9\x01\x26\x43\xb8\x2c\x60\x3f\x77\xdb\xe4\xdc\xfe\xad\x9f\x7b\x4e\x15\xed\x42\x44\xf0\x44\x54\x5a\x2e\x2e\xe2\xe5\x23\xe4\x6b\xfb\xc3\x76\xc0\xb7\x02\x08\x49\x66\xa0\xb4\xc3\x70\xb6\x90\xf1\xd1\x20\xd4\x9d\xf1\x68\xd8\x8e\x31\x71\x34\x50\x70\xef\xd6\x48\x10\xd1\x5b\xe0\x6d\x33\x7e\x78\x5b\xc8\x36\x14\xfe\x2e\x29\x9d\x33\x77\x47\x58\x54\x68\x90\x22\xc5\x30\x3b\x24\xdb\x77\x3a\xac\x92\xed\x88\xc3\xa3\x26\x38\x78\x5b\x82\x51\x65\x95\xc3\x3a\x95\x0a\xf9\x06\x5c\x78\xce\x32\x8a\x82\x27\xfc\x3d\x29\xe7\x13\xc3\x58\xdb\x35\x91\x68\xac\xc8\x69\x9c\xc5\x88\x71\x86\x7a\x25\xfd\xd7\x18\xf0\xd7\x18\xf0\xd7\x18\xf0\xdf\x35\x06\x90\xd6\x1a\xc9\x76\xcb\xd7\xec\xa7\x78\xc6\xa9\xe3\x7a\xd4\x7a\x56\x4f\x5f\x3f\xda\x41\xb4\x34\x1c\xb6\xb0\x16\x60\x3e\xd9\xbc\x45\xe9\x9c\x73\xb9\x97\x67\xaf\xc9\x36\x3e\xfc\x62\x7f\x8c\xbf\x7f\xaf\xe5\xbf\x79\x6d\x74\xb5\x08\xa0\x05\x7c\x9f\x8d\xa0\x77\x33\x79\xb9\xee\x57\x86\x1a\x3b\xb1\x84\xec\x58\xdf\x72\x15\x8b\xde\x00\x56\xc4\x73\xff\x6e\x2c\xa6\x46\x3c\xd7\x63\x4f\x86\x1d\xf5\x5d\x37\x9d\xed\x56\xea\x9f\x4f\x58\x7b\x28\xac\xef\xfc\xf2\xf8\xe7\x86\xa7\x29\x5b\x1d\x5f\xf9\xcf\x78\xcf\xf6\xfc\x70\xc1\xf1\xe1\x9b\x6e\x12\xf2\x66\x37\xce\x76\x3f\x93\x15\x5b\xc9\x91\x94\xed\xf6\x69\xbf\xfa\x5f\xe3\xcd\x66\x97\xf5\xab\x93\x7f\xed\x37\xc9\x2a\xc9\xd8\xa7\x70\xac\x65\xfb\x7c\xa7\xd4\x6f\x32\x8d\xd6\x23\xec\x8d\x67\xab\x8f\x7e\x13\x4a\xc0\xb7\x9f\x6d\x76\xf1\x9a\xa5\xd9\xee\xc0\xd9\x66\xb7\xee\x37\x85\x34\xe3\xdb\x55\xb2\x61\xfc\x0b\x54\xf6\xab\x1b\xe6\x6e\x18\x20\xa5\x3f\x55\x5f\xba\xf7\xf1\x21\xe5\x88\x2e\xdf\xfc\xb9\x56\x47\x98\x7c\xba\xf0\x61\xe7\x27\x76\x63\x18\x4b\xb1\x73\xea\x45\x8b\xfa\x03\x41\x81\xc5\x63\xe8\x1b\x41\x68\x15\x01\x32\x90\x2b\xc6\x12\x39\x9a\x76\x80\xe5\x89\x5a\xce\xe3\x02\xbb\x43\xcc\x6a\x49\x8b\x98\xbc\x98\x04\x6d\x97\x39\xde\x94\x3d\x19\x81\x8b\x9b\x1d\x8a\x12\xe5\x56\xa4\x32\xc1\x4b\xc7\x30\x97\xfd\x64\x43\xd1\xa3\x90\x0c\x74\xba\x6b\x5c\xe7\xf1\x5d\x7d\x8f\xbb\x3d\x3f\xc4\x60\x49\xc9\xc4\x77\xb0\xe6\xeb\x8e\x5d\x1d\xc2\x6e\xb7\x57\x6a\x60\xf9\x8e\x61\x5a\x32\xc4\x38\x42\x3e\x3f\x4b\xf3\x7c\x32\x32\x31\xcc\xdf\xaf\x73\xf5\xea\x0f\x65\xdd\x78\xfb\xc7\x78\x73\xec\xbb\xe2\xd5\x80\xc2\xde\xe3\xec\x03\x66\xa7\x24\x4e\x7b\x1c\x6d\x73\xed\xea\xdf\x71\x5f\x6a\x0f\xf1\x36\x85\x13\x3b\xf6\xc6\xf9\x1a\xdc\x70\x46\x0c\x5e\x3b\x4f\x59\x76\x48\xe2\x2d\x1c\xd2\xdd\x2c\xa5\xae\x56\x5f\x61\x14\x58\xc6\x82\x05\x46\x18\x59\x81\xfd\x47\xa3\x83\x54\xd7\x46\x55\xcb\x00\x35\x1f\x6d\xe2\x5f\xbd\xad\x04\xaa\x9a\x33\x9e\xa6\x7c\xb3\x89\xbb\xfb\x0b\xe7\x17\x12\xe8\xb5\x75\x39\xe0\x10\x1a\x2a\x1f\x8e\xd0\x69\x88\xf3\x6a\x39\x2a\xa2\x3f\x98\xbc\x53\x19\xe5\x14\xdd\x4e\x4d\x6d\x78\x96\x34\xc2\x24\x00\xd1\x6a\x6d\x77\xe9\x2d\x71\xc7\xe9\x51\xde\x62\xc9\x6e\x3b\x8b\x57\xc8\xbd\x71\x99\x5a\xec\xd6\xfc\xa6\xdd\xf5\x33\xe1\x7f\x42\x47\x62\x09\x38\x48\xf5\xd1\x6f\xdd\x69\xe0\xd9\x53\x56\xfc\x88\xed\xe3\xd5\x3f\x18\x4f\x47\x83\xf8\xfa\x06\x16\x9f\x02\x3c\x1b\x25\xbd\xf0\xd4\x50\xd7\xa7\xe2\xb2\xc3\xc1\xeb\x26\xc9\xae\xeb\xc6\x7c\x6e\x81\x65\x4c\x2b\x3e\x24\x22\xa1\x6f\xbf\x5d\x85\xa6\x81\xf1\xd4\x1d\x2a\xf6\xc1\x08\xf8\x2c\x9b\xd7\xe1\x7a\xb7\x9d\x6c\x92\xb2\xa5\xff\x19\xd4\xa9\xdf\x9e\xb5\xcc\xe5\x90\x20\x5a\x0d\x54\x76\x5c\xca\x1d\x7c\x10\x55\x76\xe1\x9d\xa0\xb2\xb7\x0e\x46\x8d\x58\x45\x5f\x4d\x96\xe8\xd9\xd3\x7f\xdd\x27\x79\x54\x27\x16\x0b\xf3\x83\xee\x95\x9e\xdf\xa5\x47\x51\x60\x4f\xd4\x06\x60\x6a\x3f\xda\xa1\x17\x20\x2b\xa1\x6c\x0c\xa1\x72\x87\x07\xcf\xc2\x55\xe1\xd0\xb2\xf9\x88\x7a\x0a\xfc\x06\xb5\x2d\x86\xcc\x03\xff\xe4\xb0\x0b\x3d\xec\xfe\x64\xbb\xc3\xb9\x5b\x8a\x46\x9d\xfb\xb0\x1c\x0e\xc0\xc5\x3f\xb4\x58\xe0\x3d\x31\x11\xd7\x9c\xdd\xa2\x04\x95\xe3\xcb\x1e\x72\xae\x9e\x28\xcd\x0f\x2f\xfb\xcf\xb1\x9a\x4a\x8a\x78\x1f\xb0\x2d\x5a\x7f\x65\xa3\xee\x79\xaf\x47\x95\x09\x47\x91\xc9\xa6\xcf\xd1\xe8\x3f\x58\x8c\x1f\xb7\x2b\xc6\x8f\x5b\x16\xe3\x08\xee\xff\xdd\xf3\x9e\xef\xe3\x97\x10\x7b\xe3\x3c\xbf\x2d\x43\x7b\xe9\xfd\xb6\x5b\x14\x15\x76\x3d\x9b\x38\xe3\xeb\x7c\x51\x96\xee\x8e\x87\x55\x1f\xa5\x0e\x0c\x37\x94\x31\x5f\x54\x84\x09\xf5\xaa\x76\x1e\xf6\x04\x35\xe4\x41\x44\xb5\x22\x8b\x10\x4a\x8e\x1f\x42\x91\xc1\x7e\xeb\xc2\x87\xd9\x32\x3e\x7e\xa9\x95\x9d\x3a\x33\xac\x55\x02\xb5\x06\x8c\x65\xdd\x97\x17\x22\x65\xf4\x9c\xe9\xd5\x26\xe1\xdb\x4c\x1c\x9e\x9e\x77\x56\x6a\x8e\x97\xae\x18\xa4\x4d\xc7\x06\xcb\x9d\xdc\x7b\xf9\x36\xb9\xde\xb3\x75\x22\x67\xbf\xee\x7d\x0d\xd6\x30\xa6\x63\xfb\x85\x2f\x6d\x78\x9e\xe9\x06\xac\x82\x7c\xa7\x23\x03\x3a\x32\xa4\x23\x23\x3a\x32\xa6\x23\x3f\xe8\xc8\x1d\x1d\xf9\x9f\x9b\x74\x26\x88\x64\x9a\xdf\xfe\xc1\xea\x85\xaf\x1b\xdd\x5d\x6b\x1d\x8b\x9a\xc8\xfe\x57\x31\x31\x6c\xf8\x27\x84\xe3\xd4\xe8\xb7\xf5\xf1\x0d\xe2\x1b\xab\x43\x57\x47\x28\xbd\x41\xfd\x28\x5b\x69\xb9\x78\xae\x65\xba\x3d\xc7\xd5\xd6\xcc\x2d\xa5\xe5\x92\x19\xd3\xfe\x55\x42\xda\xc2\x90\x31\xf1\x0a\xa0\x15\x5c\x20\xeb\x95\xaa\x96\xd7\x2a\x3e\x30\x8e\x29\x2d\xcb\xc5\xb2\x1e\x4f\x95\xe3\xd6\x12\xd0\xba\x5d\xb4\x0e\xda\x9e\x74\xf7\xde\xf3\xc6\xcf\x87\x68\x6a\xaf\x11\xe7\x09\x79\xf0\x00\x44\xe3\xcf\xe0\x0d\x29\x64\x63\xcf\xf9\x76\x06\x59\x4c\x71\xe2\xd2\xb2\x8d\x42\x84\x3c\x13\xe2\x38\x69\x3b\x25\x08\x47\x3c\xa5\xe8\x9e\x25\xdb\x24\xfd\x20\xea\x97\x16\xb5\x94\x04\x2e\x10\x3d\x74\x25\x18\x94\xd9\x5e\xc6\x32\xee\xd6\xa3\x1e\x0c\x67\x86\xea\x4a\x22\x02\xa1\xf0\x72\x1f\xe1\xe6\x5e\x08\x8b\x48\x91\x97\xe1\x10\x29\x04\x0c\x65\x6e\x08\x71\xee\x28\x94\xb3\x5c\xd8\x2e\xcc\xa1\x9a\x14\x3d\x9f\xa7\xaa\x1b\xdc\xd1\xaa\x6e\x70\x47\xad\xba\xc1\x9d\x4e\xd5\x0d\xee\x88\x95\xa0\x47\xd1\xf3\xa9\x42\x01\x41\x6a\xec\x26\x1f\xd2\x31\xfd\xc8\x57\x3c\x07\x98\x04\x3a\x7e\x4e\x6a\xaf\x19\x5a\x81\x6d\x38\xf6\x1f\x10\x2a\xd2\x9b\xda\x33\xdc\x0c\xaf\xe0\x99\xb3\x0c\x1f\xe0\xc8\x76\xe9\x8a\xc7\x38\x50\x23\xce\xa4\x1a\x57\x25\xc1\x51\xb3\xcd\x31\xfd\x58\xc4\xfb\x3d\x5f\x97\x22\x47\xdf\xa2\xa2\xff\xb3\xa7\xbe\x88\xca\xc6\x9c\xfa\xfa\x3e\xed\xd4\xd7\xf7\xe9\xe7\xf3\x18\xe8\xfc\x1a\xc1\xf7\x3b\x5c\x23\xe8\x64\xf3\x3a\x4c\x3c\xd9\x46\xea\x48\x77\x9b\xcf\x32\x57\xbe\x98\xe8\xbf\x8b\x4a\x73\x47\xb6\x3f\x1e\xde\x79\xfc\x7a\xd6\x37\x35\x47\x82\xdc\x5d\x0c\x55\xe7\x8e\x65\xc0\xc3\x4a\x18\xe1\x47\x08\x03\x6c\x23\x3b\x69\x60\x45\x86\xed\x22\x35\x9f\xe2\xa8\x62\xa4\xfd\x65\x30\xb7\xa4\x4f\x0b\xaa\x55\x95\x5f\x52\x5e\xc9\x14\x68\xb9\xdd\xd3\xb0\xd3\x63\x28\xc5\xb0\xf8\x79\x83\x8e\x73\x78\x7f\x65\xe3\xe1\xf0\x26\xee\x4f\xb0\x12\x18\x0f\x71\xcb\x8c\xe2\xaa\x3e\x7c\xf0\x82\x88\xdd\xb3\x7b\x5d\x2c\xb0\x1e\x51\x28\xe4\x0e\x46\x99\xc6\x1c\x36\x55\x6c\xad\xec\xd5\x9a\xf9\xef\x2f\x3e\xb1\x5f\xed\xfe\x64\xaf\xbf\xb2\xab\x86\x39\x67\x69\x34\x64\x53\x1c\x45\xc2\x3d\xd1\xe4\x25\x42\xce\x52\x4b\xb7\x0d\xea\x5e\xbc\x74\xcf\x57\xc7\x4d\x7c\x60\x9f\xbc\x87\xe8\xf9\x8e\x3d\x7f\x88\x60\xb1\x65\x39\x2c\xf4\x2d\x73\xe9\x18\x01\x84\x7b\x11\x1e\xc4\x37\xc8\xfc\xaf\xed\xaa\x96\xe3\xe6\xcf\xfc\x6a\xa6\xc3\x17\xd7\x2c\x1c\x8a\xeb\x99\x6c\x90\x87\xe3\xd9\xd0\x0a\x1e\xad\x40\x5a\xb8\x47\xf6\xc2\xf2\x96\x38\x56\x25\x83\x3e\x37\x12\x79\x33\x3d\x77\x6a\x0b\xe3\x1d\x34\x12\x46\x46\xb4\xc4\xf5\x32\x21\x3f\x73\x8c\x39\x45\xfc\xca\x89\x46\x83\xf8\xdc\x5f\x42\x40\xee\x85\xe1\x4e\x43\x56\x2c\xf2\x30\xb8\x1c\x17\x0c\x07\x39\x0f\x92\x84\x0d\x07\xcc\x34\x5e\x68\x50\xde\xd8\xd6\xb3\x6f\x07\x48\xe6\xd4\x7e\xa1\x11\xd9\xe1\xcc\x46\x72\xa2\x77\xcd\x0c\x1b\x9b\x37\xd5\x92\xcb\xf0\xe1\x54\xdb\xc2\xbc\x96\x50\x2e\x7b\xee\x7a\x97\xcb\x55\x9f\xdb\xc5\xe1\x4d\xf8\x6b\xbb\xc2\x89\xdb\x29\x5e\x56\x9e\x6c\xe1\xe5\x4d\x71\x73\xf4\x14\x27\x19\x9e\xa1\x49\xcf\x79\x66\x6f\x33\xfe\xce\x0f\x77\xe3\x4f\x34\x02\xfa\x6f\xb2\xe0\xc9\x4f\xef\xc1\xc8\x33\xce\xd8\x64\x1e\x18\xf7\xf7\xf7\xf7\xdd\xc7\x43\xd0\xc4\xac\xe7\xb6\x87\xc5\x41\xec\xbe\x2e\xd7\x5f\xb1\xe0\x45\x17\x71\x31\xd1\xdf\x8d\x35\x0c\xdc\xca\xbe\xfd\x76\xcd\x81\xbf\xb1\xc1\x64\xbd\x58\x17\x81\xd9\xe8\xe0\xfb\x8c\x0d\x06\xf2\x0f\x76\x75\x54\x51\xf0\x83\xfd\x4d\xfc\x42\x2f\xad\x20\xa5\x39\xfc\x35\x81\xb4\x51\xc8\x7c\xf2\x37\x66\xfd\xb8\x65\x0d\xf7\x71\x42\x94\x77\x89\xc0\x70\x4b\x4f\x97\x20\xca\x57\x05\xaf\x5f\xe4\x34\x93\xf9\x3d\xf4\x03\x34\x29\x06\xcc\x01\x3f\xb0\x1f\x8d\xc8\x22\x31\xa6\x61\x3e\x58\x53\x12\x12\x3e\x18\xf8\x09\x40\x5d\xf6\x35\x1f\x58\x5d\x1a\x12\xcb\x6f\xd7\xe5\xaf\x5e\xde\xa2\xbf\x14\x26\xf0\x1f\x49\xe7\x08\x2a\xc2\xfe\x03\x5e\x25\xb9\xd0\x6e\xdd\xb3\xab\x8c\x89\xc5\xb5\xa6\x3a\x06\xe9\x98\xe7\x4a\x88\x1d\xda\xd1\xd1\x24\xd9\xae\x95\x7d\x1e\xfe\x22\x4f\x4e\xd9\x25\x0c\x79\x25\x35\xe7\x5b\x3a\x64\xa7\x25\xe6\xd6\xcd\xd1\xc7\x90\x53\x69\x0d\x15\x15\xa1\xb1\xa4\xad\x18\x61\xb8\xaa\xd0\xe4\x91\xa7\x21\x6d\xca\x88\x97\xa7\xa7\x0c\x70\x70\xed\x5a\x6a\x55\xc2\xa8\x22\x8e\xd0\xf5\xd0\x12\x75\x3a\xa1\xba\x5d\x87\xca\xad\x39\xf9\x67\xbc\x39\x82\x03\x4a\xd7\x83\x85\xbc\x91\xa4\xf1\xef\xc2\xf0\x07\xa4\xb6\x2d\xb0\xa1\x1e\x36\x50\x2e\x8a\x7a\xac\xe9\x59\xb3\x99\x2e\xac\x2c\x6e\xf5\xe0\xa9\xb7\x30\x6c\x57\x8f\x1e\x76\x28\xf3\xb0\x4b\x99\x87\xb9\x95\xb1\x1e\x7c\xb5\xcc\xf5\xef\x42\x3e\x4a\xa5\xbe\x0e\x61\xa4\x8d\xe3\xd4\xb3\xd3\x74\xb0\x18\xd1\x45\x62\xea\x8d\x66\x1c\xba\x88\xf7\x65\x76\xa0\x89\xbd\x69\x61\x43\xbd\xd4\x86\x6f\x37\x18\x64\x7e\xad\x5e\x57\x87\x86\x73\x70\xea\xa0\xf2\x62\x4e\xcc\xe0\x06\x47\xaa\xc1\x84\x59\xe1\x50\x18\x86\xc7\x59\xf2\x9a\x6c\x92\xec\x57\xf3\x91\xfd\x37\x6d\xf5\x23\x36\xb8\x75\x02\xe4\x12\x60\xea\xdc\x0f\xec\x85\x1d\xd9\x8f\x96\x0c\x56\x07\x8b\xb5\x89\x07\x11\x12\x27\xdf\x7e\xbb\x0a\x96\x6f\x4b\x1d\xdb\xb5\xd8\x93\x3d\x8d\x1e\xd4\x84\xad\x8d\xcf\x03\xc3\x85\xf3\x5b\xb0\xec\x68\x52\x82\x79\x45\xbc\xc6\x75\xef\x3a\xa3\x5b\xb6\xac\x58\xeb\xa5\x6c\xf7\xd6\xec\x98\xd3\x51\xfb\x6b\x9c\xf2\xc2\xf7\xa7\x67\xd5\xc9\x76\x2d\x82\x8c\xa8\x2d\x72\x3f\xfd\xb1\x7c\x74\x70\x37\x46\xf5\x01\xb0\x6f\x97\x59\x78\x88\x21\x4b\x34\x46\x3d\xcc\x8d\x47\x17\xf1\x3f\x78\x25\xbd\x80\xa7\xc9\x1a\x6c\x1e\x75\x60\x77\xb7\xa5\xf0\x70\x1c\x08\xe1\x3e\x68\xd9\x2d\x21\xd4\xcc\x96\x50\x62\x56\x55\x14\x63\x99\x53\x08\x65\xac\x05\x7d\x62\xa8\x6a\xdc\x64\x5a\x8a\x97\x58\x54\xc2\x76\xaa\xdd\x13\xec\x54\xb3\x55\xca\xd3\xb9\x33\xc0\x16\xf3\x9c\x42\x15\x70\xce\xb3\x0a\xd9\x04\x76\x1d\x60\xc5\xeb\x94\xec\xed\xb8\x5d\x5d\x0a\x63\xd1\x75\xa4\x52\xaf\x8f\x35\xfa\x77\x74\xd4\xbd\xda\x34\xc6\xca\xd5\x1c\xfd\xc4\x65\x8a\x99\x87\x7d\x6d\x6a\xa0\x6b\xf2\xa7\x4b\x2e\x44\xcb\x9a\x07\x1e\xcb\xab\x8e\xd9\x61\xf7\xd3\x04\xcb\xf9\x6d\xd6\x73\xcb\xae\x36\x3c\x3e\x5c\x8d\x29\xd6\xb9\xf6\x21\x01\xf4\x04\x44\x53\x9d\xec\xd9\x6a\xb7\xcd\x0e\xbb\x4d\xcf\x9a\xcb\xbe\x16\x4d\x47\xf6\xd4\x5e\x73\x32\x32\x15\xb7\xe0\x88\x7e\x23\x9f\x9d\x55\x9b\x35\x69\x68\x89\x85\x66\x81\x31\x17\x5e\x05\x34\x0c\x6e\x3d\xd1\xc8\xcc\x7e\xb6\xa6\xcc\x73\x1d\xdc\x22\xd0\x84\xa7\x7c\x4d\xa8\xd5\xbe\xfb\xef\x95\x65\x9f\x7e\xeb\x17\xcf\xd1\xab\x7e\x2b\xad\xb9\x6f\x34\x3c\xed\x7e\xee\x8f\x19\x57\x8e\x89\xb7\xd1\xfd\x19\x1f\x12\xd8\x36\xb3\x77\x88\xe5\xc9\xd2\xe4\x5f\x88\x42\x60\xfa\x35\x5c\x9d\xc1\x98\xb6\x8c\x20\xd6\x50\x60\x0b\x5b\xb3\x79\xe0\x2d\x7d\x66\xbb\x8f\x9e\x29\xe2\x84\x84\x88\xfe\x54\x56\x24\xfb\x56\x57\x2d\xb5\xec\xc8\x93\x19\xed\x7c\x5c\xc4\xeb\x3d\x7d\x9a\xa4\xfb\x38\x5b\x7d\x98\xb2\x59\xe7\x50\xe1\x61\xf2\x2f\xde\x7b\xbf\xdf\xae\x45\xa4\xc3\x78\xc3\x0e\x30\x21\x1f\x58\xb2\x15\x57\x05\x3d\xcf\xcc\xab\x1d\x3c\x27\xfe\x19\x67\xc9\x27\x97\x7e\xa3\x7d\xeb\xdf\xe7\x31\x2d\x6f\xa0\x58\x04\xde\xeb\x59\xef\x71\xb3\xb9\xe4\xd4\xd9\x51\xf5\x1a\x1e\x55\x67\xbb\x63\xb6\x3f\x66\xfd\x7c\x9f\xf2\x15\x7e\x6f\x19\xf9\xcb\x48\x2c\x45\x1e\x02\xcf\x15\xb1\x75\x5a\xbf\x04\x89\xba\xd6\x73\xc4\x1c\x6f\x3e\x07\x63\x79\x2b\x0c\x8d\xd2\x3d\x03\x52\x85\x69\x38\xce\xc4\x30\x7f\x67\xb3\xa5\x6b\xc2\x97\x4c\x27\x97\xa1\x15\x30\xdf\x08\x8c\x05\x9a\x55\x6e\xd8\x86\x6f\x53\x91\x27\xdb\x9d\x7a\x4f\x2c\x7c\x09\x23\x8b\x9c\x9e\x72\x02\x87\x41\xc8\x86\x50\x4e\x44\x3c\x7a\xb0\x83\x29\x94\x34\x7a\xa1\xa2\x70\xd0\x67\x9b\x2a\xfc\x16\x0d\xf5\xa2\x07\x42\x56\x85\x91\x9a\x15\x04\x1e\x11\x99\x5a\x7e\x60\x99\xc2\x55\x7e\x62\x3d\x18\x8f\x36\x55\xc1\xc9\xf6\x59\x8f\xf7\xbd\x20\x32\x26\xb6\x73\xe9\xe4\xea\x32\x58\x7a\xf8\x84\x04\x62\x2b\x16\xe6\x2d\x89\x91\xbf\xb1\x13\x5a\xfd\x4a\xf1\x9f\xb8\x2e\x17\x42\x90\x13\xa8\xcb\x07\x7b\xfe\x40\xa7\x16\xd6\xd4\x5e\x2e\xe8\x9c\xe3\x3d\xe1\x26\x62\x18\x4c\x17\x3c\x85\xe8\x16\xa6\xdc\x24\x50\x31\x1b\xa6\xbe\x8c\x4a\x99\xf1\x66\x03\x21\xfc\x30\xdc\x9c\x67\x65\xd4\xd9\xbd\xf7\xbc\x4e\x10\x33\xf6\xb5\x9d\x4c\xe7\x29\x0b\xf4\xaf\x60\x79\x7f\x0b\xc5\xcd\xfb\x46\xfa\x2c\x08\xe1\x29\x60\x4c\xf6\x5c\x4b\xbc\x84\x89\xe9\x75\x15\x66\x38\xa6\x33\xa3\x21\x82\xc9\xcd\x7f\x54\x08\x0d\xdc\x2a\x55\x0a\xe7\x68\xd3\x8e\xb2\x7b\xbf\x39\x24\x6a\xd1\x77\x93\x1d\xb6\x7c\x1c\x8b\xa5\x59\x9c\x71\x16\xaf\x56\x3c\xed\xf9\x58\x5d\xbc\x0c\x26\xfb\x7d\x5d\x33\xb5\xfb\x88\x21\x16\x3c\x29\xa4\xd7\x10\x6a\x84\x3c\x89\x7f\xa7\x89\x0f\x68\xe2\x43\x9a\xf8\x88\x26\x3e\xa6\x89\xff\xa0\x89\xdf\xd1\xc4\xff\x87\x26\x7e\x4f\x13\xff\x1b\x4d\x7c\x40\x6d\x56\x62\xbb\x0e\x88\x0d\x3b\x20\xb6\xec\x80\xd8\xb4\x83\x1f\x0c\x33\x9f\x41\xb8\x06\xe9\xab\x9a\xf6\x3d\x20\x95\xbe\x67\x79\x1e\xdd\x3e\x5e\x34\x7f\xdc\xf5\x3c\x4f\x40\x99\xf5\xcf\xa3\x88\x21\x9a\x60\x4a\x59\x21\x42\x0e\xd1\xe9\x33\x8e\x27\x67\xc7\xed\x8a\x26\x7d\x29\x8d\x5e\x2a\x35\x7f\x64\x52\xde\x44\x8a\x33\x84\xaf\xf6\xba\x25\x27\x73\xe1\x95\xc6\x7e\x54\xab\x00\x83\x7d\x75\x89\xa6\xb0\x87\xc1\x84\x12\x5d\xe5\x22\xd8\xb5\xc9\xf8\xf6\x43\xe4\x87\x6d\xe2\x5f\xbb\x63\x96\xb6\x17\x99\xa4\x3d\x7f\x78\x21\x16\x26\x2c\x6c\xb3\x5b\x89\x4e\x7e\xa3\x54\x8e\xf2\x4a\xef\x46\xc9\xbc\x1d\xe2\x77\x68\x0b\xb6\xda\xed\x0e\x6b\xb8\x29\x80\x0b\x94\x86\xd7\x41\xfa\x4a\x47\x04\xa6\x2e\xe2\xfd\xde\x28\x11\x65\x28\x58\xd3\xde\xde\xc7\xab\x43\x7b\x71\x47\x90\x47\x7d\x6c\x9f\x0d\x72\x49\xb1\x15\x8e\x98\x11\x9a\xb6\x4d\xc0\xd0\x3b\xda\x7a\x3a\x68\xa0\x30\x65\x46\x13\xb6\x1b\x46\xc1\xd2\xa4\x1c\x2f\x6b\xa2\x39\xe6\x1a\xc2\x92\xa7\x43\xc2\x7a\x1a\x72\x3a\xb2\x16\xbe\x17\x18\x81\xdd\xb8\xd1\xff\x3f\xde\xae\xb6\x4b\x4d\xa4\x69\xff\x97\xfb\x0f\xdc\x03\x98\xd9\xd9\x8f\x08\x8c\xe1\x5e\x04\x17\x70\x92\xec\x17\x0e\x2a\x4e\x38\xeb\x80\x8f\xa0\x49\xf6\xd7\x3f\xa7\x5f\x50\x64\x74\xbc\xaa\x85\x3d\x27\xf9\x90\x93\xeb\xaa\x6a\xab\xba\x9b\x7e\xab\xaa\x8f\xf4\xd2\x98\x9d\xd6\xaa\xab\x55\x12\xd0\x90\xf9\x91\xa1\x13\x3b\x21\x55\x2b\x89\xd8\x69\xaa\xb2\x52\x15\x7e\xc3\x15\xcf\x39\xa9\x1a\x71\x56\xa7\x8d\x6a\xea\xee\x22\x7b\x81\x65\x7a\x34\xe3\xb4\xe9\x8e\xff\xa2\x66\x59\x76\xea\x18\x36\x4d\xf7\xd8\x53\x3c\x0a\xdb\xf4\xe6\xea\x43\xf5\xeb\x7d\x54\xdb\x0d\x1d\x32\xb5\xf1\x91\x7a\xbb\xa5\x84\x3b\x9a\x7f\x26\x81\xf4\x2b\xda\x1e\x57\xf8\x09\x6d\xba\x42\xfb\xdf\xd3\x95\x1b\xaf\xee\x86\x0b\x52\xee\xfc\x29\xea\xee\x68\x24\x44\x71\x48\xfb\x30\x8b\xeb\x85\x59\x10\xc9\xf8\xf6\x9b\x44\x6b\x1e\x86\xec\xc9\xc3\xd4\x8c\x43\x17\x79\x1c\xcb\x03\xb7\x66\x41\xe4\xd0\xa9\x1d\x42\x14\x9b\xd6\x1f\xf2\x48\x8e\x66\x58\x2e\xc0\x22\x7f\x12\x95\xf5\x36\x07\x86\x56\x10\x84\x76\x44\x24\xb9\x53\x76\x2f\x31\xf7\x69\xf3\x9f\x70\x24\xec\x7f\xf1\xd3\x90\xb3\x15\x81\x44\x4e\x55\x04\x12\x39\x4f\x11\x48\xe4\x24\x45\x20\x91\x33\x14\x81\x44\x4e\xc6\x04\x12\x39\x14\x13\x48\xe4\x3c\x4c\x20\x91\xa3\x30\x81\xfc\x1d\xb7\x3c\xc1\x49\xb8\x97\x34\x1d\x87\xe2\x7e\xd2\x46\x38\x14\xf7\x94\xf6\x88\x43\x71\x5f\x69\xb8\xb3\x34\xdc\x5b\x3a\xee\x2d\x9d\x30\xa6\x70\x6f\xe9\xb8\xb7\xf4\x11\x0e\xc5\xbd\xa5\xe3\xde\xd2\x71\x6f\xe9\xb8\xb7\x74\xdc\x5b\x06\xee\x2d\x43\x4b\x90\x93\x29\xf9\x84\x5a\x96\x61\x06\x08\x2c\xd0\x57\x92\x10\xf9\x22\xc4\x57\x12\x2a\x84\xc1\x4a\x35\x13\xe0\x12\xeb\x14\x87\x63\x30\xe6\x68\xa5\x4c\x3c\xa8\x32\xd7\xca\x44\x8a\x4a\xaf\x5c\xa6\x1b\xb5\xdf\xd9\xa5\xde\xa1\x76\x7d\x07\x15\x52\xcb\x2a\x54\xbf\x37\xd5\xea\x0e\x2e\x55\xef\x79\xb3\x57\x77\xb1\xa9\xba\x73\x22\x1e\x1f\xbb\x6e\x25\x29\x3d\x1f\x3d\x77\x8f\x20\xf9\x93\xd5\xf2\xc7\x07\x27\x91\x4a\xd2\x2f\x3e\x84\x55\x3e\xdf\x94\x0f\xb2\x90\xf9\xf4\xeb\x89\x35\xf7\x5d\x76\xfc\x78\xba\xcb\x47\xd6\xbc\x5d\x9d\xb6\xc3\x33\x73\xb0\x73\x03\x11\x5a\x3f\x90\x3b\x64\x49\x13\x9e\x30\x63\x53\x2e\xff\xee\xfd\xe0\xf9\x98\x1c\xb6\x28\x93\xb4\xae\xd3\xe5\x77\xa6\xb7\x1a\x4e\xcf\x10\x81\x08\x6d\xf9\x55\x38\x19\xf7\x2b\xfd\x35\x2b\xdf\xb2\x7a\xf7\x4b\x3e\xe3\x1e\xdd\x96\x8e\xf4\x62\x16\x76\x19\x25\xa6\xfd\x3f\xd3\x72\x7c\x0b\x79\xb5\xc6\x18\x7c\xbb\x35\x23\xd1\xe2\xd0\xe5\xa5\x66\x22\x25\x96\x82\xc2\x66\x7f\xc8\xf3\x46\xa0\xcf\x5e\xd8\x10\x9d\x38\xc1\xd4\x89\xc3\x6f\x8a\xfb\xd2\x2b\x35\x87\x78\x39\x64\xc7\x26\x0a\x68\x65\x26\xe6\x02\x92\xd8\x0c\x27\x4e\x1c\xdd\x2b\xc6\x0a\xe6\x50\x6c\xd1\xd1\x16\xf0\x24\x77\x64\xb0\xc0\x12\x7e\xf4\x10\xcc\x49\x9a\x5c\x9f\xbd\x24\xe6\x8f\x1b\x09\x2c\xf9\x00\x19\xa4\x9d\xb9\xf9\xc5\x0c\xbf\xb1\xb3\x23\xd2\x4c\xcc\x24\xc8\xd0\x19\x65\xfe\xb1\x05\x4a\xdf\x82\x33\x09\xf2\xe7\x1f\x6d\x4e\xa4\xc7\x41\x6c\x7a\xcd\x23\xee\x1b\xad\xe8\xae\x05\xe4\x4a\xe0\xb8\x4a\x81\xde\x1d\xb4\x52\x39\xcb\xe0\x49\x35\x96\xc7\xee\x43\xd5\xa8\xcf\xe9\xb2\xef\x90\x89\xd7\xac\xfe\xb8\x66\xde\xdd\x9f\x80\xba\x79\xaf\x98\x54\xfb\xc5\x10\xb1\x07\xaf\x9b\xa4\xda\xe6\xbb\x43\x3f\x5f\x17\x39\x6b\xc8\x7a\x7f\xf2\xba\x35\x9a\xb9\x61\xf2\x02\xf4\x70\x09\x6c\xaa\x05\x02\x5e\x8e\xb6\xd9\x32\x4f\x37\xf9\x3f\x99\x28\x71\xd6\xb7\x7f\xb7\x7b\xf9\xf5\xfd\x74\xdb\x3e\x6a\x82\x93\xf5\xf6\x71\x34\x98\xf0\xbc\xa8\x1f\x47\xfd\xb8\xf6\x7a\x68\xfe\x25\xdc\x8b\x63\xe9\x14\xb0\x41\x01\x23\xcd\xe8\xa4\x14\x00\xdb\xf3\x9e\x65\x28\xb1\x46\x50\xef\x95\xe1\xe7\x1a\x18\xcd\x2d\xe1\x3a\x0d\x6e\xd0\xe0\x23\x1a\x5c\x43\x63\xca\x25\x5e\x27\xe2\x0d\x22\x7e\x44\xc4\xc3\xa1\xf4\x12\xaf\x13\xf1\x06\x11\x3f\x22\xe2\xf1\xa0\x7e\x49\xd0\xa9\x04\x83\x4a\x18\xc1\x84\x49\x56\x4b\x0e\x9d\x41\x51\x52\xd0\xb5\x14\x54\x35\x72\x55\x44\x1c\xd2\xe7\x2c\x5d\x89\x65\x28\xb1\x46\x4a\x2c\x78\xb8\x9f\xd3\x74\x35\x9a\xa1\x46\x1b\xa9\xd1\xe0\xa9\xe0\x9c\xa6\xab\xd1\x0c\x35\xda\x48\x8d\x86\x4f\x13\xe7\x3c\x5d\x91\x67\x28\xf2\x2e\xce\x1d\xf7\xae\xe2\xbe\xa7\x9b\xb5\xc8\x92\x2c\x82\xee\x3b\xeb\x21\xea\x42\x88\x57\x50\xe3\x35\xad\x92\xe1\x1a\x3a\xc4\x9b\x69\xb6\x7b\xc8\x8b\xd7\xdb\x42\x11\x2b\x58\x81\xff\x12\x78\x73\xfe\x96\x62\x2c\x52\x05\xb2\xa0\xa1\xff\xfc\x17\x66\x3d\xbb\x1e\x4b\xef\x19\x59\xa6\xa7\x40\x1b\xbb\x66\x74\x83\x15\x3a\xf6\xdc\x22\x89\xe6\xfb\x15\x02\x81\x67\x3f\x23\xe0\x3f\x3b\xac\x6a\xc5\x0d\x02\xdb\xa8\x53\x95\x74\x39\x90\xa2\x59\x10\xc5\x67\x2c\x96\x8c\x19\x71\xc7\x3b\xe2\x24\x74\x1c\x5f\x8d\x3a\xf6\xe6\x8e\x1a\x53\x14\x97\x53\xa2\xb2\x1f\x0a\x74\xa0\x77\x3c\xf1\x3b\x55\x98\xfc\x67\xaa\x10\xc5\xaf\x04\x98\x9f\xdd\x28\x16\x07\xa0\x48\x87\x39\xa1\xa1\x4e\x7f\x82\x33\xd3\xb1\xb3\x55\x98\x20\xfb\x06\x85\xc2\x8d\x45\x52\x22\xfb\x02\x85\x72\xaa\x52\x48\xd2\x14\xb9\xfe\x1f\x37\xc0\x53\xd7\x67\xe3\x11\x32\xac\xc4\x02\x52\x63\x9e\x79\x24\x0e\x82\xc4\x63\x47\xc2\x37\xd0\x22\xe9\x8e\x78\xd2\x40\x80\xb6\x5f\x80\xdd\xa0\xb1\x9f\xa8\x48\x95\x9d\xbc\xc5\x25\xce\x3c\x2d\x26\x79\xea\x69\x71\xa9\x73\x4f\x8b\x4a\x9e\x7c\x5a\x5c\xda\xec\xd3\x22\x52\xa7\x9f\x16\x95\x38\xff\xb4\x98\xf0\x04\x24\xf4\x89\x6e\x8a\x18\xa6\x8d\x27\x8a\x87\x86\x56\x9b\x80\x4c\x89\x6d\x3c\x38\xcb\xb5\x29\xf0\x3c\xd7\x26\xa1\x33\x5d\x9b\x03\xcf\x75\x6d\x12\x69\xb6\x6b\x13\x4f\x85\x63\x21\xa2\xcf\xca\xd5\xc7\x72\x45\x78\x03\x1d\x3a\x22\xbd\x88\x83\xc1\xcf\x3e\xa7\x5c\xbe\xe8\x13\x37\xb7\x18\x3c\x49\x58\xcc\x92\x55\x11\xa0\xad\x47\x18\x2a\xac\x1c\x61\x6d\x7f\x9d\x98\x37\xe1\x93\xac\x56\x45\x53\x7e\xcb\x65\x22\xf4\x73\x36\xe5\x2e\xda\x2f\xb0\xf6\x1d\x7f\x3c\x81\x51\x1c\xca\xcd\x9e\x85\x0e\x3e\xe7\x9b\x3a\xdb\x69\x36\x9d\xa3\x93\x38\x27\xbb\xa9\xb1\x0e\x4a\xb4\x5c\x8d\x85\x28\xdb\xfe\x6a\x51\x09\x56\xdc\xfe\x52\xb1\x24\xef\x4a\x1d\x1a\x91\x44\xb1\xe5\x15\x26\x60\x98\x49\x56\x8b\x70\xe8\xc5\x26\x03\x9b\xd9\xc1\x63\xd6\xf8\x9c\x57\x35\x3f\xed\x20\x81\x89\x36\x78\xcf\xc3\x2c\x30\xcd\x8b\xb7\xf4\x27\x8e\x24\x36\xab\x43\x02\xda\x74\xfc\x21\x37\x91\x60\xd3\xc3\xac\xca\x6a\x5c\x2a\x87\xbf\x17\x7d\xef\x21\x4f\x13\xb4\x9e\x6c\x1b\x5b\x54\xfd\x9c\xc8\x1c\x43\xd1\x64\x7e\x85\x8b\x57\x50\x37\x28\x1f\xc5\xbb\x76\xed\xc3\x0b\x35\xb7\xc3\xda\xc5\xef\xb2\xca\x7d\x51\x93\xf8\xa7\xf8\xf6\xeb\x12\xee\xb7\xba\x8c\x9d\x87\x13\xae\x23\x26\x3f\x2f\x7c\x21\x8a\xd7\xd8\xee\x8b\x1b\x05\x21\x64\xc2\x76\x96\x65\x3b\x3f\xe4\x55\xef\xc9\x4c\xf9\x0b\xbe\x22\xdd\xc8\xd2\x7d\xff\xb7\xcf\xfa\x7e\xa3\x70\x49\x83\x7e\x5b\x05\x62\xde\x88\xd5\x1b\xb6\x1d\x0b\x4b\x6f\xfb\xe2\x3a\x5f\x12\xcb\x33\xa3\x28\x71\x4c\x2b\x09\x35\x8d\x48\x98\x90\x18\xb1\xa5\xb3\x82\xc0\x74\x86\x49\xa4\x88\xb6\x51\x68\x66\x14\x5b\xc9\xe8\xe7\x48\x85\xf6\x49\x95\xf6\x49\x85\xf6\xa8\x4a\x7b\x54\xa1\x3d\xa9\x69\x7b\x52\xd5\xf6\xa4\x42\xd3\x1e\xd4\x5a\xa9\x3d\xa8\x35\x53\x7b\x50\x6e\xa7\xf6\xa0\x44\xd4\xef\x20\xbe\x1f\x41\xf7\xcf\x90\x87\x74\x93\xaf\x58\xd2\xb0\x6a\xbf\x58\xa5\x75\xda\xef\xfc\xf8\x96\x6e\x65\x92\xa1\x24\xdd\xe4\xaf\xc5\x1b\x94\x37\x5e\x51\xc3\xa5\xea\x71\x77\x4b\xaf\x77\xf9\xcf\x64\x9b\x6e\xb2\xba\xee\xca\xa6\xce\xe8\xf2\x4c\x69\x66\x7a\x4e\x7c\x25\x65\x7d\x97\xf0\xb5\x4b\x6a\x9d\x33\x82\x02\x1a\x26\x21\x38\xb6\x09\xc8\x3d\xa3\x22\x91\xbc\x12\xe8\xfa\xf6\xb1\x9c\x1d\xae\xad\x43\x56\xd0\x05\x3f\x7b\x7e\xc7\x84\x5f\xd2\xbe\xd7\x19\x87\xae\xad\xc8\x3d\x16\xf9\x03\x56\x4b\xd6\x7e\xb7\xcb\x8a\x7a\x26\x7a\xe2\x94\xf7\x4b\x84\x27\x90\x6e\xb1\xca\x7e\xee\x17\x07\x2a\xa3\x22\x33\x72\x2a\x43\x56\x26\xed\x79\xe9\xf7\xc6\xd6\xe7\xc9\x22\x2f\x56\x3d\x4f\x08\x5c\xee\x80\x09\xb8\x78\xc3\x2b\x5e\xa1\xa7\x23\x98\x3c\xdd\xb4\xea\x4d\xdd\xee\x9e\x0d\x90\x9f\xaf\xc6\x41\x62\x05\x2f\x0e\xaf\x53\x4b\xa7\x06\x3e\x81\x45\xd7\x83\x67\x8d\x14\x2a\x70\xe4\xe9\x47\xbf\x98\xec\x6c\x9a\xce\x73\x7d\xb6\x2b\x22\x16\x03\x1b\xbb\x97\x19\xdd\x41\x23\xea\x36\x59\xe5\x21\x63\x55\x13\x86\x18\x30\xfd\xa4\x87\x95\x91\x24\x48\xd0\xad\x84\x6a\x38\x54\xc7\xa1\x06\x0e\x1d\xe1\xd0\x4f\x38\xf4\x11\x87\xfe\x86\x43\x9f\x70\xe8\xef\x38\x54\x7b\x20\x60\x09\xfe\xd2\x74\x02\x96\xe0\x31\x6d\x44\xc0\x12\x7c\xa6\x3d\x12\xb0\x04\xaf\x69\x4f\x04\x2c\xc1\x6f\xfa\x03\x01\x4b\x19\x67\x04\xbf\xe9\x06\x01\x4b\xf0\x9b\xfe\x89\x80\x25\xf8\x4d\xff\x8d\x80\x25\xf8\x4d\xff\x1d\xc7\x1a\x0f\x04\x2c\xe2\x37\xd3\x6a\xb2\x0a\x31\x0a\x40\x68\xca\x45\x53\x79\xed\x24\x36\xd7\xc3\x04\xbb\x1f\x2f\x73\xc9\x92\x5f\xcb\x68\x24\x84\x60\x6d\x72\x56\x1e\x8e\x4a\xe3\x07\xab\x71\xf6\xd3\x62\x39\x2a\xb5\x95\x02\xe5\x40\xe7\xac\x15\x28\x0a\x6a\x72\x05\x8a\x82\x9a\x4a\x81\x42\x57\xa3\xd3\x7d\xa3\x2b\xf8\x46\xa7\xfb\x46\x57\xf0\x8d\x4e\xf7\x8d\xae\xe0\x1b\x9d\xee\x1b\x5d\xc1\x37\x06\xdd\x37\x86\x82\x6f\x0c\xba\x6f\x0c\x05\xdf\x18\x74\xdf\x18\x0a\xbe\x31\xe8\xbe\x31\x14\x7c\x33\x5a\x29\x50\x14\xd4\xd0\x7d\x33\x5a\x2b\xa8\xa1\xfb\x66\xa4\xe0\x9b\x11\xdd\x37\xa3\xea\xd0\xf3\x16\xab\x5c\x2e\x37\x7b\x76\xa4\x70\xf1\x26\x8a\xba\xcb\xfa\x73\xee\x84\xdf\x44\x68\x3b\xbf\xbb\x8c\x23\xe0\xdb\xdd\x9c\xb5\x09\xf2\x6d\xbc\xc0\x85\x4e\x34\xf7\x62\x32\xfc\xc5\x74\x3d\xfe\x62\x0d\xdf\xa9\xcf\xcc\x28\xba\x92\x36\xa0\xeb\xac\x49\x56\xfc\xb9\xcf\x76\x79\x06\xb9\x56\x24\x54\x22\x10\xdc\x8a\x81\x7f\x21\xd0\x71\xf6\x9a\x17\x30\xda\x29\x56\x30\x76\x92\xd5\x1c\x9b\x1f\x28\xe8\x80\x67\xfb\x50\xe1\xec\xf3\x81\xfb\x3c\x70\x37\x4a\x91\xce\x5e\x11\x6c\x36\xac\x92\xa4\x88\xf8\x65\xf5\x19\xf3\x4d\x76\x5b\x09\x32\xc0\xd8\x3a\xb7\x5b\x7e\x2c\xfe\xcc\xaa\x7a\x42\x23\x4d\xa4\xa0\x60\xef\xf5\xa2\xd8\x8c\xaf\x94\x88\xeb\xba\x63\x9a\xfe\x14\xb1\xdc\xac\xdc\x61\xbe\xc9\x76\xf1\xf7\x5d\x96\xae\xaa\x9e\xbd\xb2\xcd\xb7\xd9\x26\x2f\x32\x5e\x2f\x26\xaf\xea\x7c\x59\x5d\x9c\x93\x14\x2d\x77\x4c\xcc\x10\xcd\xc7\x53\x37\x8e\xa1\x44\x20\xc7\xb2\xfc\x34\x9a\x7c\x85\x20\x1d\x45\x2b\x6f\x19\x3b\x51\xc4\x9e\x6d\xc6\x61\x70\x8c\xde\x9f\x99\xb1\xf5\x19\x3a\x33\xe4\x6c\x87\x9d\x14\x8a\x22\x71\x4a\x4d\x38\x26\xa5\x68\xd4\x9f\xac\xe0\xc0\x36\xe8\x26\x4a\xa2\x35\xa1\x29\xcf\xa9\x46\xf6\xdc\xd9\x8c\x3d\x9a\x11\x89\x4c\x5a\xcd\x27\x70\x65\x2a\x8e\xeb\xe4\xfb\xfb\xfb\xcf\x6c\x93\x10\x93\x21\x21\x3d\x7d\xe6\x7e\x75\xd8\x4b\x22\xeb\x8f\xe6\x11\xd1\xed\x9f\x2d\x38\x73\x5f\x85\xd5\xe6\x10\xd2\xf3\x5f\xd0\x78\x85\x7d\xb7\xa5\xd9\x6d\xce\xf5\xd7\x5d\x64\xfb\x9e\xd2\x19\x4d\x5d\x24\x6f\x71\x2b\xff\x11\x9b\xbf\x51\xc2\xb3\x69\x3b\x7c\x72\x8f\x3e\x07\x9e\x8d\xe6\x4f\x12\x5c\xdb\x65\xcf\xbe\x59\x29\xc3\x38\x76\x7c\x39\x19\x20\x93\x3d\xbf\xf9\x3a\xbd\x24\x54\xa0\xf4\xfd\xb5\xde\xb2\x16\x25\xd5\x76\x97\xdf\x7d\xd9\x2d\x1d\x31\x0b\x5d\xe8\xaa\x9b\xa7\x50\xe6\x8f\xe2\x4d\xcb\xe9\xbf\x53\x6e\x7e\xbd\x96\x45\x52\xae\xd7\x55\x56\x0f\x51\x5d\x6f\x5b\x56\xb5\x2c\xb1\xb7\x94\xf7\x33\x3d\x2b\x90\x89\x76\xf8\x5d\xe9\x3a\x5d\x66\xe8\xf7\x9a\xa8\xe4\x50\xfe\x9d\x17\xaf\x83\x44\xe0\xf2\x06\x53\x27\x61\x8a\x82\x5d\xb9\xd8\x57\x75\xa3\x41\x14\xc1\x4b\x16\xd9\xf7\xf4\x90\x97\x3d\x17\x43\x16\xaa\x0a\xa8\xca\x1e\x32\x5a\xd8\x22\x84\x55\x06\x7e\xf6\xcc\x49\x12\x06\xe3\x79\x14\x27\xa6\x65\xb1\x15\xc6\xb5\xdb\xc1\x73\x01\x1e\xcf\xbc\x2e\xa5\xf0\x20\xcf\xc8\x41\x78\x93\xb9\xeb\xc5\xdf\x8e\x4c\x94\xe6\xfa\x7e\x60\xb1\x8d\x24\x95\x38\xf7\xff\xf0\x83\x2f\x3e\x99\x27\xe4\xfb\x41\xec\x3e\x37\x45\x79\xa3\x38\x34\x63\x67\x82\x6c\x63\xfd\xe0\x92\x00\x64\xc2\x9d\x64\xf5\x64\x97\x6e\xbf\xe7\xcb\x8a\x3f\x89\x8e\xea\xb4\xde\x43\x1b\xc8\x49\x56\x17\x71\xf6\xd3\x7d\x7b\x7f\x57\x7b\x11\x1f\x66\xe9\xaa\x98\xb1\x10\x7d\x58\xbc\x75\x2c\xac\x4f\x51\xd4\x4a\xf0\xb1\x3e\x10\x09\x39\x95\xb0\x27\x33\xb0\x73\x44\xa6\x62\x9a\x6e\x49\x60\xfc\xd7\x4e\xd3\x2d\xde\x6e\xee\x33\x92\xf8\x86\xb1\xcf\xe9\x14\xec\xc4\x90\xb7\x4b\x7c\xf5\xa2\x3a\xdf\x6e\x37\x70\xcf\x38\xc5\x3f\xe1\x8c\x63\xdc\x89\x88\x1f\x41\x89\x9d\x30\x12\x94\x76\x0c\x62\x40\x09\x22\x8c\xa1\xe7\x35\xc5\xe9\x0b\x90\xe4\x55\xb9\x19\xa0\xdc\x9a\x78\x04\x74\xac\xe5\xd6\xd3\x97\x46\xbe\x39\xf1\xe4\x1e\x8f\xed\xb5\xc5\xd6\x02\x3c\x41\xec\xf2\x05\x79\x12\xba\xb6\x88\x33\xbd\x4f\x84\x48\xdd\x80\xe7\x3b\x9d\xf2\xf3\xc5\xae\x40\x19\x86\x8b\x2d\xe2\xbb\xe4\x3b\x75\xd3\x52\x95\x7e\x24\x2a\x22\xca\xfa\xc0\xac\xc8\x48\x69\xe5\x8c\x14\x0f\x8d\xbc\xa6\xe3\x61\x13\x9b\x9f\xbe\x65\xab\x7b\x85\x38\x87\x74\xb3\x4f\xeb\xcc\x66\x4b\xe9\x97\x74\xb3\xcf\xaa\x9e\xc7\xad\x1c\x55\x2c\xff\x60\x6f\xe9\x60\xa4\xe5\xd9\x01\x0a\xb6\x21\x67\x7b\xd9\x0e\xe9\xfa\x8b\xb3\xae\x8d\xa6\x79\x21\x1c\xc4\x4e\x0a\xdf\xa7\x30\xef\xc7\x40\xcd\x0a\x1d\x98\x75\x48\xc2\xb3\xf4\x6d\x93\x55\x55\xb2\xdc\x2f\xb2\xe4\x2d\xed\x79\x1b\x76\x26\x9e\xbd\x0c\xdf\x66\xbb\x26\x87\x68\xdf\x9a\xd8\xb7\x8b\x3d\x9b\xe7\xc7\xb5\x03\x59\x4b\xc8\x4e\xeb\xf2\x2d\x5f\x26\x4b\x16\x5b\xc6\x92\x79\x6f\xff\x0d\x35\xc3\xe8\x58\xa4\x9b\x4d\x59\x0f\x23\x3a\xaf\x93\xac\x58\x96\xd8\xb0\x56\x50\xb0\xec\x3f\x17\xbc\x94\xcc\x9f\x13\x53\x22\x22\x15\x74\xbc\xee\xca\xfd\x36\x39\x94\x75\x36\x88\x78\x96\x5e\x2b\x4b\x36\x65\xba\x4a\xaa\xba\xdc\x0d\xa9\xa4\xca\xff\x19\x46\xfc\xe5\x31\x4c\xfd\x18\xc8\x4f\x7a\x12\x8c\xff\xe7\x58\xc8\x52\x46\x9e\xbb\xc3\x78\x09\x04\x43\x16\x24\x3a\x9a\x8f\x41\x82\x48\xee\x06\xa6\x8c\x3d\x82\x0d\x0a\x18\x79\x02\xe8\xfa\x70\x23\x5c\x1f\x6e\x82\xeb\xc3\x0d\x18\x07\x81\x87\xc2\xc0\x86\x36\x58\x83\x80\x45\x9a\x2a\x0c\x3b\x35\x63\x9d\x02\x36\x28\x60\xa4\x19\x62\x45\x13\x26\x9a\x4d\x00\xeb\x14\xb0\x41\x01\x5b\xf3\xb1\x43\x80\x6b\x36\x5f\x8b\x05\x5f\x08\x1c\x5d\x8d\x13\x82\x13\x43\x87\x01\xab\x92\x63\xde\x76\x78\x29\x85\x0f\xae\x9f\x2f\xd2\xe4\x65\x37\x99\xe7\xb9\xfe\x1f\x64\xd2\x8b\xe9\xb9\xb6\xa9\xd0\x4a\xd7\x7f\x0e\x12\x2f\x98\xe0\x75\xb0\x25\x51\x54\xa9\x70\x6c\xf9\x6f\x82\x4a\xf9\x52\x56\xd6\x2f\x50\x26\xf2\xa8\x3c\x6a\xab\xe5\x47\x22\x0a\xe6\xa1\xe5\x7c\xf4\x9b\xbb\xbb\x05\xf1\xf0\x45\x3c\xef\x40\xf0\x2c\x89\x46\x5a\xac\xb0\xe3\x1f\x3b\xab\xd3\xe5\x77\x5c\xb8\xb5\xcb\xd2\x5a\x66\xab\xc7\x59\x02\x1f\x95\xfb\xdd\x32\x43\xf0\xf2\xed\xc4\xc5\xa4\xf8\x97\x09\xbc\x59\x32\x19\x2b\xde\x2e\xb3\xa6\xfd\x7a\x2f\x2f\xfe\x96\x4a\x10\xf8\xbc\xa2\x37\xe9\x45\x46\xf3\x52\xd4\xc8\x24\xb9\x6b\x02\x58\xa7\x80\x0d\x0a\x78\x44\x01\x6b\x39\x01\xac\x53\xc0\x06\x05\x3c\xa2\x80\xc1\x87\xdf\x12\xad\x93\xd0\x06\x09\x3d\x22\xa1\xb5\x9c\x82\xd6\x49\x68\x83\x84\x1e\x91\xd0\x22\xd2\x94\x66\x47\xc1\xa1\x59\x53\x70\x40\x9b\x4e\xb2\x5a\x8c\xe9\xeb\x57\xf9\x20\x0f\xb3\xc5\x24\xab\xdd\x62\x5d\x7a\x25\x54\xef\x6e\x92\xd5\x62\x6a\xcb\x56\x42\x1b\x7a\x21\x25\x4d\xd1\x9c\xf9\xa1\xba\x78\x88\x87\xe4\xd2\x34\xad\x0f\x34\x3c\x6c\xad\x0f\x3e\x39\x77\x9f\xaf\x71\xd1\xc9\x76\x97\x2d\xf3\xaa\xff\x4b\x03\x21\xbd\xaa\xb3\x62\x99\x6f\x92\xec\x27\xdb\xc8\x0e\xa4\xa2\x64\x61\xa8\x43\x5e\xe8\x37\x9a\xf6\x8b\x5d\xb9\xaf\xf3\x62\x98\x6d\xbf\x3c\x1f\x6c\x4e\x17\xf8\xd1\x6a\x35\xa8\xa6\x4d\xd9\x8d\x4a\xbf\x53\xf0\x21\xcf\x7e\x30\x61\xc9\x86\x55\x88\x12\x59\x9e\xfa\xff\x05\xec\x61\xc8\x26\x2d\x5e\xf7\xcc\xed\xda\xc3\xc3\x9d\x07\x25\xcd\xc9\xb7\x67\xfa\x93\x39\x0b\x9e\x7e\x71\xc2\xe8\xc2\x15\x4a\x1f\x03\xee\xac\xe9\x23\xfd\x61\x9b\x2e\xff\x1e\xd8\x40\x79\xb1\xdc\xec\x57\x40\x97\x45\x6d\xc5\xdf\x66\x5a\xde\x1c\xca\x36\xe1\x9b\x53\x96\xc5\x55\xd4\x89\x87\x37\x1b\x67\xac\xab\xe7\x45\xdd\xf9\x92\xdf\xfc\xe0\xe5\x54\xc5\x8e\x84\x48\x3a\x5b\xd3\xbb\xc2\xb4\x08\xcf\xad\x88\x8a\x26\x59\x7d\x17\x23\x3f\x0c\xdd\x7d\x59\xdf\x1d\xe4\x8c\xfb\xc3\xd2\xb3\x48\x2f\x6d\xa2\x42\xd9\x09\x82\x19\x3a\xc9\x14\xcb\x5d\xd6\xa5\x3d\xcf\x7d\x0b\xa0\x35\xf0\x90\xe5\x9c\x68\x64\x0c\x60\xfc\xf2\x47\x92\xbe\x2d\xf2\xf7\x99\x8b\xee\x35\xcf\xb3\xe9\x7a\x97\x33\x4d\xdc\xdd\xec\x6d\xba\xab\x9a\x4f\xf3\xed\x8e\x82\x34\x3e\x9a\x99\x61\xc4\x8e\x69\x02\x9e\xad\x03\x7b\x95\x26\x49\xcd\x65\x37\x23\x5e\x7d\x5a\xdb\x1d\x56\x63\xbe\xae\x98\xa5\xaf\x99\x55\xbe\xbd\xe5\x35\x4b\x1d\x85\xf0\xf8\x58\xbc\x44\x76\xbe\xc6\xea\xe4\x61\x1c\x24\x17\x04\xfd\x78\xa8\xe9\x5e\xd2\xe8\xb7\xbd\xf3\xe2\x86\xf1\xdc\xf4\x5a\x7e\x69\x92\x13\xdd\x62\xfa\xf3\x69\xa3\xc6\x73\x5e\x1c\x0f\x39\x0b\x63\x9c\x77\x1a\x23\xa5\x66\x7e\x55\x62\x7d\x53\x62\xfd\x05\xb0\xd8\x81\x9e\x34\xc7\xd1\x09\xd8\xe3\x93\x16\xd3\xb0\xef\x20\xcb\x2c\x56\x92\xcf\xab\xde\x22\xa6\xed\x34\xfa\x79\xee\x79\x32\x35\x15\x3f\x3c\x9f\xba\xb3\xa9\x39\x8b\x12\x64\xd8\xc5\xd9\xcf\x7f\x71\xc4\xf4\x1c\x47\x76\x2e\x7c\x88\x07\xe2\xbc\xcc\x68\x72\xfc\xcf\xbe\xf7\x17\x72\xbb\x27\xac\xd3\xff\xe2\xe0\x57\xb1\xec\x57\x62\x9d\x55\x55\xb6\x11\xaf\xe6\xe4\x26\xa6\x6f\x05\xcc\x12\x59\xb2\x48\x77\xbb\x7c\x30\xe1\xe5\x8e\x6d\xeb\xc0\xee\x82\xcc\xe1\x96\x67\x4e\x67\x6c\x3d\x23\xab\x4a\xf5\x3c\x88\x8e\x0d\xa7\xed\xd9\x91\x96\x37\x73\x88\xfc\xd6\x77\x1a\x7e\x23\x8d\x88\x24\x81\xf3\xde\x91\x25\x43\x98\x60\x95\x1d\x75\xb6\x19\x9b\x7c\x41\x73\x12\x45\x96\x21\xab\xfc\x82\x33\xa4\x58\x96\xfc\x1b\x4e\x4d\x76\xaf\x0b\xa3\xe7\x59\xb2\xa3\x68\x80\x74\x9b\x8d\x86\xa5\x7c\xd8\xfe\xfe\x78\x8c\xda\x2d\xd9\x5a\x3b\x74\x44\x50\x39\xcf\x4f\x77\xdb\xc3\x2d\xca\xa9\x70\x88\x2a\x8d\xae\xf3\x54\x73\x84\x44\x63\xd9\xa2\xa9\x04\x93\xd0\xdf\x1b\x26\x3b\xa0\xf9\xec\xfa\xb1\x02\x95\xd5\x08\x9e\x1e\x17\x56\x4a\xfc\xdb\x24\xb6\xcc\x6c\x11\x1a\x19\x62\x9c\x22\x6b\x22\x22\xb9\x3b\xca\xdf\xc7\x64\x18\xb6\x1a\x4f\x57\xe4\x69\x74\x5e\xb4\x5f\xa8\x36\xb5\xa1\xea\xea\x54\xac\xc1\xbc\xc2\xc5\x8d\x78\x97\xbe\xa6\xd2\xd6\xfc\x93\x2c\xb6\x35\xb0\xf8\x21\xce\x45\x2c\x8f\x73\x32\x9e\xc5\x56\x32\xf7\xd9\x93\x00\x52\xb7\xe4\x99\xe1\x65\x65\x2a\x45\x11\x4c\x02\x57\x2f\x6b\x77\x5f\xac\xe8\x09\x8a\x98\xfb\x1f\x09\x19\xc2\x25\xbb\x57\xc4\x25\x4a\x5a\x2e\x3f\x4b\xa6\x7a\x9a\xf9\xd4\x64\x1b\xb4\x19\x60\xd3\xd0\x79\xf6\x1c\x8b\xbd\xf8\x07\x09\xcd\xb4\x24\xf6\x68\x24\x4a\xb3\xce\x51\xa0\x1e\x29\xb3\x20\xe2\x51\xfa\xc9\x57\x15\xb6\xef\x4c\x4c\x75\xf6\x51\xf7\x37\x15\xf6\x51\xf7\xb7\xbb\x74\xff\xa5\xc2\x3e\xea\x46\xd8\xb3\x30\xf8\xfa\xed\xbd\x8c\xdb\x44\x76\x24\x70\x84\x37\xfc\x4b\x9f\xdb\xde\x86\xa5\x1c\x30\xe8\x9d\x13\x32\x7a\x2e\xfc\x6e\x2c\x93\xf8\x07\xbd\x1c\x13\x70\xd5\xee\x18\xbd\x79\xe2\xd7\x17\x11\x7e\xf0\xc7\xd6\x63\xca\xca\x8f\xf3\x37\x2e\xa5\xaf\xde\x93\x15\x87\x24\x5d\xf5\x75\x19\xda\x96\xba\x2c\xdf\x16\xef\x2f\x8c\xa9\x3d\xd1\x0a\xa6\x63\x17\xca\x66\xdd\x20\xe1\x65\xb8\x90\x0b\x6e\x0f\xc4\xb3\xbf\x07\x50\xba\x40\x6b\x24\xb4\x4e\x42\x3f\x10\x5b\xae\x11\xf1\x3a\x8c\x0f\x66\x4e\x68\xfa\x36\x6a\x1a\x09\xd7\x68\x70\x9d\x06\xc7\xad\x23\x09\x1a\x95\x80\xdb\x87\x35\x9c\xd7\x23\x05\xb0\xa6\xcd\x32\x9f\xf0\xa9\xe0\x36\x98\x6d\x53\xc3\x59\xe0\x99\x50\x7a\x0f\x16\x7e\x10\x9a\xd0\xab\xe7\x63\x19\xcd\xdb\x50\x96\x30\xc8\xe4\x49\xf0\xbc\x20\x84\xf0\xce\x8b\x1b\xcc\xa3\x21\xa7\xb3\xe5\xae\xac\xaa\x45\xba\xfb\x60\xe6\x51\x15\xbd\x2a\x6b\xe3\xce\x09\xcd\x0e\x62\x03\xec\xcc\x0d\xd4\x1c\xca\x5a\x6b\x1e\xf5\x9d\xa4\x45\x5e\x95\xf5\xae\xdc\xe6\x03\xad\xe9\xa5\x9e\x37\x1e\x05\x7e\x5b\x05\x62\xc6\x66\x85\xc0\x8b\xec\x8b\x95\x3b\x76\xd5\xfd\x85\xc7\x37\xb3\x01\x26\x0b\x06\x0c\x65\xdb\x4d\x99\xf6\x75\x5d\xcd\xca\x01\x83\xb1\x43\x0d\x45\x94\x03\x26\x92\x78\x39\x60\x22\x47\xd6\x9c\xa0\x91\x4e\xc7\x73\x44\x6d\xa7\xa3\x39\x22\x51\xd4\xeb\x01\x49\xc7\xf5\x98\xd8\x45\xba\x7f\x41\xf3\x31\x3b\x51\x30\xf4\x67\x0c\x89\x01\xb9\x71\x31\xe8\xd1\x32\x18\xfc\xe8\x01\x22\x9c\xd2\x24\x66\x10\xed\x11\x44\x62\x40\xae\x5d\x7b\x24\x19\x44\x7b\x24\xfd\x42\x22\xfc\x6a\x93\xfa\x9a\x48\x5e\xd3\xfa\x3b\x72\x7d\x86\x4c\x28\x2c\xea\xbd\x89\x8e\x6c\x46\xc6\xc4\x8c\x3f\xb3\xe0\xc7\xe7\x67\x2c\x0f\x10\xdb\xe0\x0e\x28\x83\x1d\x91\x05\xbe\xe3\xc7\x83\xad\x11\xde\xf2\xdd\xae\x94\x77\x86\x49\x5d\x26\xd9\x6a\xa8\x4b\x14\xa1\x29\x5b\x25\xbb\x6c\x9b\xa5\xf5\x6d\x25\x98\x0f\xc3\x30\x60\x5f\x84\xd0\x99\x39\xc3\x9d\xf6\x5d\xaf\x82\xd4\x93\x71\x8a\xb2\x48\xb6\xe5\x0f\x16\x4e\xbf\x4e\xea\x1f\xe5\x30\x5a\x44\xca\xb3\x4d\x76\xc8\x36\xd5\xa0\x1a\xca\xd5\x30\xe2\x59\x45\xda\xb4\x78\x7d\xe7\x05\x6a\xb7\x69\x86\x19\x8b\x63\x34\xfd\x09\xb4\x1d\xe9\x9e\xe6\x50\xb8\xe7\x07\x39\x14\x26\x9b\x1c\x4e\xf8\x7f\xe3\x08\x6d\xf7\x3a\x90\xef\x5e\x17\xda\x43\x92\xea\xfb\x7c\x18\xf9\xf2\xd1\xc9\xd3\x50\xd2\xcb\xfe\xb3\x28\x76\x84\x0f\x3f\xcd\x54\x3f\xf2\x7f\xfe\x19\x4a\x38\x7b\xf5\xdf\xb3\xe4\xfc\x2d\xdb\x0d\x91\x59\xb2\xde\xa5\x45\xc5\x22\x70\x92\x75\x96\xad\x16\xe9\xf2\x6f\x7d\x68\x05\xc6\xd0\x0a\x4e\x65\xb5\x07\xd7\xc4\x52\x8a\xae\x37\xe5\x0f\xd4\x35\xd0\x94\x1c\x9a\x7e\xc4\xae\xc5\x93\x67\xc7\xb1\xc7\x2c\x35\x30\xab\x62\xf7\xec\x41\x27\xce\x17\xd8\x51\x1c\x3a\xe6\xf4\xaa\x90\xbb\xa7\x4b\xe6\x80\x6d\x59\x65\xb2\x28\x6b\xc7\x0a\x4a\x3f\x7f\xc6\xb2\x66\xb2\x57\xea\x1e\x2f\xb7\x2b\x4b\x74\x82\xbf\x9e\x93\x67\x61\xf0\xbf\xe3\xe5\x1d\x99\xdd\x7c\x5e\x14\xa8\xe2\xcc\xeb\x03\x62\xf7\xfe\xdc\x2b\xd3\x55\xdc\xd8\x50\x44\x2b\xae\x15\x79\x70\x05\x12\x15\x7d\x17\x78\xab\x9e\xbb\xd2\x5e\x44\x03\x9e\xbf\x98\xba\x3d\xaa\x28\x2a\x44\x02\x5d\x71\x2b\x96\x2c\x5e\x77\xe9\x80\xe2\x87\x6c\x3f\xaf\xce\x9f\x3c\x8e\x16\xf9\xa0\x0a\x58\xa1\xd6\xde\x9f\xad\x4a\x15\x8b\x4d\xf6\xae\x0a\x2c\x75\xb6\x60\xab\x42\x59\x69\xe0\x7a\xc9\xb3\x73\x8a\x4c\xfa\x40\x64\x89\x03\xc2\x24\x9a\x4f\x39\x05\xb9\xbf\x93\x1a\xc6\x9e\xe3\xdb\x84\x12\x30\x52\x13\xdc\x24\x52\xa1\xe4\x33\x0e\x5c\x22\xf9\x9c\xe5\xfe\x45\xe6\x7c\x54\x50\xf9\x03\xda\x6d\xf8\xf1\x1b\xf1\x40\x01\x6b\x14\xb0\x4e\x01\x1b\x14\xf0\x88\x02\xfe\x44\x01\x3f\x52\xc0\xbf\x51\xc0\x4f\x14\xf0\xef\x14\xb0\x46\x73\x21\xc9\x87\x9a\x4e\x42\x93\xbc\xa8\x8d\x48\x68\x92\x1f\xb5\x47\x12\x9a\xe4\x49\xed\x89\x84\x26\xf9\x52\x7f\x20\xa1\x69\xe3\x91\xe4\x4b\xdd\x20\xa1\x49\xbe\xd4\x3f\x91\xd0\x24\x5f\xea\xbf\x91\xd0\x24\x5f\xea\xbf\x53\xd0\xc6\x03\x09\xad\x25\xc8\x62\xf2\x4b\x96\xbf\x7e\xaf\xb1\xba\xf7\x02\x8b\xe5\xd7\x16\xd8\x9c\x80\xc5\x52\x55\x08\x2c\x96\xd5\x5c\x60\xf7\x0b\x0a\x98\xf2\xeb\xc0\xec\xe4\x02\x7c\xb9\x8a\xff\x45\xc2\x0b\x5f\x96\x8d\xd9\xaa\xac\xe7\x75\x7d\xb3\xe0\xfb\x60\x59\x4f\x5d\xf8\xc9\x30\x0f\x70\x31\x22\xd1\xf3\x08\x2b\x68\x2f\x96\x54\x92\x74\x1b\xee\x78\x0e\xaf\xf2\x44\xa4\x9d\xc1\x9b\xc3\x54\x45\x6d\x38\x5d\xae\x4a\x15\xd9\xf2\x01\xad\x22\xbb\x79\x0f\xa2\x44\x6e\x62\x60\x95\xc8\xcd\x86\x5e\x94\xb3\x51\x14\xe2\xd8\x13\x47\x54\x02\x51\x14\x10\x39\x56\xe0\xdb\xed\x97\x31\x4a\x62\x9e\x83\x89\xf8\x21\xae\x2f\x5e\xf9\x28\x49\x39\x5b\x69\x2b\x77\xa3\x38\x0e\xdd\xb1\xaa\x10\x56\xb3\x2f\x09\x7c\x0f\x59\xe9\x7f\xe1\x05\x8b\x40\x30\x17\xfc\x05\x2c\x71\x24\x9b\x2d\xcb\xba\xc0\xf8\xa9\x39\x9b\x39\x36\x09\x4f\xd8\x07\xc9\x43\x3b\x3b\x34\xbf\xe0\x68\xfe\xb3\x61\xb4\x15\xcc\x10\x5b\xb2\x04\x90\xae\x85\xb7\x84\xa3\xe1\x96\x70\x34\xd8\x12\xfb\x9b\x6f\x4e\xf1\xa6\x34\x70\xb0\x2d\x0d\xfc\x6a\x63\xba\xdf\xcb\x71\x5e\xc8\x94\x04\x08\x5a\x64\x57\x11\x78\x30\x6f\x57\x41\x40\xbb\x15\xde\x14\x81\xb4\xd3\x3a\xc5\xd1\xd1\x7e\x81\x12\x26\x59\x4d\xe6\x4c\xd3\x2d\xde\xfe\x79\xf1\x46\x81\x1f\xdb\x43\x4f\xce\x26\x79\x62\x0d\x75\x18\x66\x55\xb4\x15\x29\x21\xef\x5c\x0f\x89\x0f\x0a\x3b\x9e\xba\xdd\xd3\xe5\xdc\xdd\x3c\xb5\x80\x09\x67\x93\xbd\xe3\xb3\xda\x18\xb6\x22\x1b\x5c\xb7\x5d\xa4\xa2\xa7\x56\x97\xc8\xe0\x31\x59\x73\x16\xd7\x15\x41\xb4\x6c\xab\xe8\x21\x99\x1b\x7f\x09\x92\x48\xfd\x87\xe2\x5f\x9a\xd6\x11\xaa\x70\x70\xa4\xa8\x92\xf4\x2c\xae\xf9\x99\xa6\x6d\xb3\xd0\xb7\x24\x74\x26\x6e\x14\x63\xd9\x31\xda\xef\x84\x54\xf8\x0d\xd7\x17\x01\x4f\xf7\x36\x81\x24\xa6\x3b\xc9\x88\x4d\x97\xc9\x8f\xf3\xb5\x15\x9d\x71\x20\x53\xd6\x74\x06\x5d\x49\x45\x67\x90\x95\xe8\x64\x73\xe9\xab\x03\x99\x42\x36\x97\xbe\xa6\x2b\x21\x9b\x4b\xaf\x0e\x54\x8a\x41\x36\x97\x41\x37\x97\x41\x36\x97\xb1\xa6\x2b\x21\x9b\xcb\xa0\x9b\x6b\xe4\x2f\x14\x38\xb9\x02\x47\xa5\x6d\xfb\x85\x0a\x47\x45\x91\xca\x2f\xda\x57\x74\x92\x42\xe3\xc8\x1d\x7a\x44\xef\xd0\xa3\x35\x9d\x41\x57\xa2\x60\x64\xf2\x18\x18\x29\x38\x45\xa5\xcb\xa8\xf4\x18\x85\x0e\x43\x38\xd8\x74\x0a\x56\x36\xb1\x4d\x36\xd9\xf5\x3f\x42\xb5\xf3\x4a\x99\x3b\xc9\xea\x36\x6f\x75\x50\x20\xad\x55\x48\xb9\x0a\x49\xda\x73\xa0\x4d\xce\xc5\x54\x52\xd4\x3d\x8e\x5c\x74\xca\x9c\xab\xd8\x22\x4d\x72\x9a\x12\x0f\xd7\xdf\x91\x5f\xe3\x9b\xe1\x37\x76\xb0\xca\x13\x31\xc0\x24\xa1\xb4\x39\x74\x14\xc9\x55\xd0\x97\x06\x4c\x80\x8c\x43\xb5\x15\x45\x9c\x97\xb7\x10\xab\xfa\x79\xec\xdc\x41\xbd\x55\x1b\xa3\xdb\xb9\xd8\xd9\x88\xe8\xf6\x4a\x49\xc7\xe5\x48\x03\x29\x1f\xe9\xe9\xa9\xff\xd6\xbf\xb6\x2c\xb5\xf2\x3a\xd1\x34\xf1\x77\x97\x1d\x06\x79\x14\xc3\x15\xe9\x89\xf6\x20\xff\xf4\xaf\xa7\x49\x49\x7d\x57\x62\x80\xae\x1b\x78\x5d\xc8\x30\x2d\x5e\x33\x3e\x3d\xae\x0e\xfe\xcb\x7f\xfe\x0b\x73\xdc\x62\x95\xfd\xcc\x56\x2b\xff\xa5\x4f\xd7\xfd\xc8\x8b\x55\xf9\x23\xd9\x96\xdd\x27\xfe\xf4\x9f\xf7\x85\x8b\x9a\x95\x15\xb6\xe7\x68\xc1\x0f\x34\xfc\x9a\x08\x27\x8a\xcf\x89\x70\xa2\xf8\x8a\x08\xa7\x89\x37\x56\x44\x38\x51\x3c\xcd\xf6\xc6\x9a\x28\x9e\x66\x7b\x23\x27\x8a\xa7\xd9\xfe\xfd\x86\xe8\xbe\xd1\x36\x4d\xde\xd2\x4d\xde\x9c\x65\x26\x8b\xbc\x48\xdf\xbd\x87\xce\x2a\xfd\xf6\xc8\x3b\xff\x1a\x4d\x4d\xcf\x3d\x9e\xb3\x8c\x5d\x9f\x5d\x9d\x99\xe1\xb4\xf7\x76\xcb\x74\xfd\xbd\x36\x5b\x2e\x53\x86\x69\xf5\xee\x75\x91\x3e\x7d\xdc\x50\x8a\x38\xf9\xfb\xd7\xa7\x2a\xc1\xc9\x3a\xab\x97\xdf\xef\x37\xc5\xb3\x13\x5b\x9f\x93\xd9\xa9\x0c\x72\xc7\x10\x97\x28\xa1\x39\xe1\xf7\xe9\xd2\x82\xed\x5a\xca\x42\xdc\x34\x8c\xfb\x36\xe8\x35\x0b\x24\x2b\xf6\x95\x6a\x62\x6a\xfa\xb3\x78\x13\x2b\xb2\x2f\x8a\x72\xc7\xfa\xe0\x3f\xd9\x2a\x59\x96\xe5\x6e\x95\x17\x69\x9d\x55\x1f\x6b\x42\x2c\xdf\x2c\x1d\xe7\x7e\xeb\x60\xf6\x74\x6d\x1c\xf5\x6a\xc1\xd8\x15\xc5\x52\x85\xe9\xe8\x1f\xdc\xf3\xa6\xb3\xc5\x26\xbf\x6f\x14\x57\xad\x11\x93\xff\x9f\xff\x7e\x48\x69\xc1\x1f\x68\x70\x8d\x06\xd7\x69\x70\x83\x06\x1f\xd1\xe0\x9f\x68\xf0\x47\x1a\xfc\x37\x1a\xfc\x89\x06\xff\x9d\x06\xd7\xa8\x6e\x25\xfa\x55\x23\x3a\x56\x23\x7a\x56\x23\xba\x56\xbb\xec\xdb\xee\x17\xdd\xde\xa5\x3f\x9a\x6b\xe2\xd8\xed\x73\x3c\x67\x9b\x8c\xa5\x0b\xbf\xb8\x4f\xa0\x0e\xe8\xf3\x37\x4b\xb7\xed\x70\x8e\x17\x97\x76\x44\xd2\xf1\x02\x0c\x30\xa2\x23\x7e\x6a\x73\xa4\x04\x30\x98\xd9\x25\x8b\x6f\x78\x50\x0e\xdf\xee\x7c\x40\xbc\xdb\x69\xc5\x81\x25\x7d\x5b\xec\xdf\xb6\xf7\x67\x4b\x1c\xcf\xa7\xb3\x24\x0c\xe2\x63\x34\xd3\x4d\x17\x74\x19\xe2\xea\x13\xa3\xb1\x04\xb1\xad\x88\x0c\x8c\x44\x21\xd8\x73\xfb\x05\x82\x3d\xd9\x2f\xc8\x5c\xc6\x7f\xac\xe3\xbf\xb0\x87\x3d\x20\x3a\x36\xc3\x89\x13\x5f\x44\x77\x7b\x0b\xcf\x4a\xfd\xb6\x6d\xbf\x59\x50\x60\xad\x21\xd6\x24\xab\xd5\xd4\x5d\x20\xae\x0f\x3d\x77\xe9\xf5\x2e\x7d\x65\xa3\xf3\x72\x3a\x7a\x6a\x9f\xee\x2e\x2e\x6f\x3b\x2e\x74\x26\x09\xf2\xed\x61\x38\xe4\x9b\xc3\x70\xc8\xb7\x86\xe1\x90\x6f\x0c\xc3\x21\xdf\x16\x86\x43\xd6\x0b\x0c\x87\x2c\x14\x18\x0e\x59\x21\x30\x1c\x32\x9c\x18\x0e\x59\x13\x30\x1c\xb4\x18\xe0\x40\xd4\x23\xd0\xe7\x9f\x03\x51\x9f\x40\x1f\x7c\x0e\x44\xbd\xa2\xa1\x6e\xd1\x50\xbf\x68\xa8\x63\x34\xd4\x33\x3a\xea\x19\x1d\x1e\x2b\xa8\x67\x74\xd4\x33\x3a\xea\x19\x1d\xf5\x8c\x8e\x7a\x46\x47\x3d\xa3\xa3\x9e\xd1\x51\xcf\x18\xa8\x67\x0c\xc4\x33\x56\xe0\x43\xb3\x22\xc3\xa1\xf2\x10\x47\x33\x1c\xe2\x67\x86\x43\xdc\xcc\x70\x88\x97\x19\x0e\x71\x32\xc3\x21\x3e\x66\x38\xc4\xc5\x0c\x87\x78\x98\xe1\xa0\x59\x91\x03\x51\x8f\x40\xb3\x22\x07\xa2\x3e\x81\x66\x45\x0e\x44\xbd\x02\xcd\x8a\x1c\x88\xfa\x05\x9a\x15\x39\x10\xf5\x0c\x34\x2b\x72\x20\x3c\x56\x50\xcf\x40\xb3\x22\x07\xa2\x9e\x81\x66\x45\x0e\x44\x3d\x03\xcd\x8a\x1c\x88\x7a\x06\x9a\x15\x19\x10\x9a\x15\x39\x10\xf1\xcc\x34\x40\x76\x18\x2c\x9f\x2b\x20\x6b\xee\x01\xb2\xa2\xf9\x18\x40\xf1\x9c\xa1\x10\x0c\xe9\x04\x53\x13\x69\xbf\xe7\x84\xc8\xbe\xc8\xf2\x11\x61\x16\x4b\x25\x7c\x1b\x66\x07\xb1\x0e\x9a\xf7\x14\x8b\x73\xca\x98\x1b\x20\xfb\x01\xb6\x4f\x3d\xee\x22\x5a\x8f\x3d\x69\xc4\x26\x9b\x2e\x4a\x9c\x99\x51\xe4\xa0\x60\xd7\x8f\xe2\x70\xce\xb3\x9f\x44\xfc\xec\x9d\xb1\x55\xb8\x71\x10\x9b\x48\x2f\x64\x2d\x74\xfd\xd9\x3c\x3e\x37\x66\xfb\xb5\x05\x24\xc3\x0b\x82\x19\xcf\x59\x43\x62\xca\x60\x2a\x96\x71\x31\x99\x99\x2e\x2f\x92\x7b\x9b\x15\x7d\x71\xff\xfa\xcb\x63\x95\x33\x43\x12\xfa\x4f\x12\x3a\x4c\x6c\xaa\x78\x9b\xa8\x81\x0c\x87\x14\xb0\x5c\x82\xbc\x98\xe8\x4d\xa4\x48\x2a\x8b\x61\x79\x2e\x59\x0c\xaa\x7f\x05\x65\x8e\x50\xe0\xd3\xd7\x64\xec\xc6\x89\x19\xff\x3f\x7b\xe7\xba\xa4\xba\x91\x24\xe0\x57\xd9\xf0\x0b\x8c\x11\xf8\x78\xe6\xa7\x90\x0a\x5a\x73\x84\x84\x75\x69\x9f\xf6\x1f\x85\xba\x11\xdd\xda\xc3\x6d\x90\x60\xdc\x13\xfb\xf0\x1b\x75\x11\x48\x42\x40\x66\x96\x98\xf1\x6e\xf8\x5f\x77\x44\x7e\x59\x55\x99\x85\xea\x9e\x79\x4f\xf0\xc9\x74\x27\x40\x9d\xbf\xc4\x66\xc0\xb7\x19\x61\xd2\xe2\xc9\xdd\x13\x50\x38\x34\xa3\x38\xe0\x0f\xfc\x60\xe2\xfc\x27\x03\x14\x15\x39\x2f\xa0\x7a\xc7\x8e\x19\x5e\x15\xbd\xdc\x10\xda\x4c\xd4\x7e\x8d\x4c\xd5\x5f\x40\x28\x7e\x5b\xa8\x89\x41\x28\xf9\xa2\x0a\xcf\x8d\xb3\xf7\x7c\x83\xc7\x18\xa5\x8e\xf3\xb4\x28\xa2\xec\x77\x8b\x1f\xec\x41\xe4\x43\x11\x5a\x73\x96\xee\x20\xc2\xd6\x76\xb5\xdd\x57\x75\xf2\x77\x03\x02\x63\x10\x98\x21\x84\x31\x57\xbb\x8f\x14\x59\xb7\x16\x63\x10\x18\x50\xdd\xc2\xac\xac\x08\xe9\x49\x6b\x2b\x22\xc6\x95\x3d\xef\x5c\xf2\x9d\x78\x95\xf5\xaf\x33\xe3\xf3\xfd\xbd\xcb\x76\xcd\x67\xe9\xce\x17\x0a\xd5\xeb\x37\x40\x63\xc5\x63\xb9\x1b\x90\x76\x23\xb3\x75\xbe\x59\x6e\xd1\x4d\x6b\x7e\x64\x9e\xc7\x7e\x32\x09\x18\x4b\x66\x6c\xe6\x07\x90\x23\xa2\xea\x78\x1b\x47\x05\xcc\xb3\x59\x50\x5d\x26\xb8\x8e\xea\x5a\x65\x97\xff\x9e\xad\x12\x1e\xcc\x2c\x2d\x7b\x09\xfa\xce\xa3\x66\xab\x44\x5e\x3c\x8e\x07\xa0\xa9\x72\x56\x64\xb9\xcc\x0c\x92\xd8\x13\x09\x4c\x99\x5d\xa5\x35\xef\xb9\xb5\x9b\xa4\xdc\xe7\x22\x1e\xad\xee\xd1\xff\xdc\x4b\xa2\xc0\x11\x41\x5e\x21\x53\x3f\x7e\x53\xa0\x81\x44\x3c\x09\x9a\x6b\xf2\xe9\xab\x4c\x81\x0d\x50\xd2\x50\x20\xce\x0d\x55\xda\x00\x1c\x59\x25\x17\x23\xa0\x7d\xd6\xda\x75\x3c\xee\x72\xba\x02\x2b\x1e\x3b\x96\x4e\xe3\x69\x35\xa8\x6b\xf8\x25\x36\xed\xc0\x8c\xae\x54\xa3\xfd\x89\x9b\x7b\x51\xd5\xfb\x72\xa4\xfc\xb2\xe7\x5f\x42\x91\xed\xd2\x7d\x5a\x9e\x82\xf0\x6a\xfe\x1a\xc2\x88\x79\x96\xe3\x26\x62\x4d\x34\x89\x3d\x88\x5b\x9a\x8c\xe9\xb8\x58\x46\x2c\x16\x65\x66\x04\x4d\xfc\xea\xb2\xb3\xed\x91\x50\x5e\xaf\xf2\x77\xa1\xb2\x1f\x02\x9a\x1c\x36\x6f\x57\x30\x5d\x6f\xf2\x4b\x5a\x3d\x9f\x3f\xf2\x61\xeb\xde\x21\x64\x1f\xd5\x3e\xa5\xbd\x91\xf9\xb6\x74\xf3\xd3\xcc\x7c\x3b\x96\x29\x85\x60\x7b\x57\x95\x78\x95\xb6\x08\x49\x9d\xb2\x12\x3d\xc6\x30\xfd\x0e\xc8\x43\xc8\x56\x2c\xcf\xed\x03\x97\x16\xd9\x23\x10\xf2\xa7\xac\x16\x08\xe6\x9c\xab\x82\xc2\x60\x6b\x78\x36\xd7\xe0\x0b\xc6\x5c\x83\x2f\x38\x73\x0d\xbe\xe0\xcd\x35\xf8\x82\x6a\x3a\x85\xb9\x59\xc3\xbe\xba\xb5\x4a\x61\xb1\xdd\xbc\x65\x9a\x9d\x5b\x66\x93\x50\x59\xef\xef\xb7\xb3\x21\x1e\xf9\x32\x50\x52\xcf\x8d\xbc\x1f\x59\x17\xdb\x48\x15\x7c\xe6\x7e\xf3\xaa\xc8\x30\xf7\x25\x79\x0e\x5e\x16\x3c\x43\xe6\x7f\xb6\x13\x5a\x66\x00\xf9\x2c\xaa\x27\x59\x6a\xd1\x02\xbc\x52\xd5\x84\x54\xe0\xb3\xbb\x94\xbc\x6f\xd7\x64\x91\x54\x95\xed\x05\x30\x80\x7b\xd9\x3f\xb1\xab\x59\xa7\xc0\x12\xf1\x6e\x91\x96\x19\x96\x9a\x66\x65\x1d\x59\x1e\x09\x10\xec\x0a\xd5\x64\x9f\xa1\x6b\x27\xae\x1c\x4a\x08\x22\xce\x9f\xd5\x9d\x89\xe5\x11\xcf\xc0\x9a\xf2\x9c\xf2\x69\x78\x9d\x03\x96\x74\x09\x2e\x8f\x64\x34\x3f\x3e\xe8\xcb\x23\x9e\x19\xea\x7d\x80\xda\x0d\xa8\x3f\xc0\xad\x35\x01\xda\xf6\x6e\x7a\x79\xd4\xe3\x1f\x65\xc0\xa2\xdc\x67\xe9\x5a\x77\x83\xa0\xf6\xbe\x56\xc6\x31\x83\x6c\x13\x34\x80\x1f\xb1\xc0\x00\x0b\x18\x58\x60\x88\x05\x46\x58\xe0\x27\x2c\xf0\x05\x0b\xfc\x8c\x00\xfc\x38\xb0\x58\x02\xe9\xa6\xf2\x17\x12\x8a\xae\x33\x28\xf0\xc4\x11\x8d\xe4\x78\x02\x5f\xc8\x12\x4f\xe0\x0b\x59\xe0\x09\x74\x21\x06\xda\x27\x06\xde\x27\x06\xda\x27\x06\xde\x27\x06\xda\x27\x06\xde\x27\x06\xda\x27\x06\xde\x27\x43\xb4\x4f\x86\x78\x9f\x0c\xd1\x3e\x19\xe2\x7d\x32\x44\xfb\x64\x88\xf7\xc9\x10\xed\x93\x21\xde\x27\x23\xb4\x4f\x46\x78\x9f\x8c\xd0\x3e\x19\xe1\x7d\x32\x42\xfb\x64\x84\xf7\xc9\x08\xed\x93\x11\xcc\x27\x1e\x3f\x92\x59\x29\x37\xbe\xe2\x09\x7c\x21\x05\x9e\xc0\x17\x92\xe3\x09\x7c\x21\x4b\x3c\x81\x2f\x64\x81\x27\x40\x85\x58\xab\x9c\xbf\xb9\xe2\xd3\x5b\x15\xce\x47\xba\x14\xc2\xd6\x22\xa3\xb3\xcd\x31\xc7\x23\xcb\x1e\x67\xd4\xf6\x6c\xde\xfb\x3b\xfb\x70\x66\xce\x9f\xc2\xe1\x8f\xed\xb7\xf6\xf6\x6c\xfe\xc3\x5f\x00\x24\x9e\xe3\xad\xb8\x0d\xe9\xda\xa8\xe7\x37\xfd\x6a\xab\xbe\xff\xba\xf2\x03\x81\x91\x61\xc8\x23\x63\xdd\xd5\x11\x57\xc4\xbe\x45\x3f\xfc\xe5\xae\x54\xc0\x9e\x81\x92\x55\x4a\x74\xb8\xde\x2b\x84\xae\x95\xd8\xd4\x4d\xf2\x35\xcf\x0f\xd9\xf5\x26\xb5\xe5\x53\x9a\xe2\x9b\xd9\x2d\xff\xe7\x5a\xc7\xf9\xaf\x74\x97\xb7\xbc\xd5\xf9\x51\x60\x53\xd7\xe1\xf5\x8f\xd2\xfd\xbb\x78\x39\x17\xca\xe2\xba\x2c\x7b\x97\xe5\xb9\x44\xbb\xf9\x2e\x3b\xb7\x6a\xda\x6c\xc7\x1f\xad\xb2\x5a\xbe\x4b\xde\xb6\xeb\xdd\x3e\x2b\xb8\xe8\xed\x2e\x72\x51\x70\x47\x97\x0e\xe3\x60\x62\x5a\x3c\x71\xc0\x8c\x6f\xee\x86\xfc\x84\xfe\xfe\x0f\xa1\x8b\x9a\x38\xdf\x78\x7a\x66\x33\x62\x89\xe7\x7b\x4c\x5f\x8b\xcd\x26\x66\xec\x46\xc9\x03\x0c\x5a\x7c\x6e\xde\xba\x7f\x03\x3f\x10\xb5\xbe\xc4\xcf\x49\x29\x7a\x43\x0f\x3e\x11\x91\x44\x02\xde\x70\x16\x78\xa6\x9b\x18\x76\xf2\x62\xbc\xf4\x6d\x88\xf4\xf5\x5d\xf7\xb4\xd7\x1c\x4f\x83\xbe\xab\xf5\x9a\x16\xd9\x29\xc5\x29\xc5\x98\xed\x1f\x2b\x7f\x97\x2e\x36\x1f\x0b\x47\x69\x5d\x8c\xd3\x22\xab\xfe\x81\xfc\xde\x6b\xcf\xe1\x1f\xa0\x44\xcd\xa5\xae\xab\xd3\x36\xe9\x65\x1e\x48\xac\xa7\x3b\x1c\xdd\x2d\x65\xf6\xde\x1f\xf2\xcd\x82\xc7\xa8\xac\xb2\x67\xf6\xb7\xbf\xca\x93\xca\xf0\x20\xef\x55\xf4\xc4\x10\xd0\x42\xbe\x3d\x7b\xba\xde\x40\x55\x30\x65\xfe\x8c\x45\xc1\x0b\xb9\x06\x6d\x4e\x26\x2d\xbc\xcf\x56\xe2\xea\xe8\x0b\x0d\x54\xa9\x4f\x20\xdd\x3d\x96\xee\x92\x07\x4c\x10\x60\x9a\x95\x0d\x26\xcc\xff\x95\xe1\x38\x7f\xb9\x2c\xb2\xb2\xef\xfe\xc7\xd7\x38\xc9\x1b\xbf\x8b\xac\xd9\xf5\xaa\x37\x31\x2a\x3b\xce\x7d\xeb\xf3\x61\x74\xe6\x78\x71\x88\x47\x4f\x80\x3c\x99\x27\x95\x05\x45\x65\x06\xd0\xeb\x35\x6b\x3b\x4c\x2c\x34\xc5\xa5\xeb\x87\xb8\x2a\xfb\xc7\x41\x44\xca\x3c\x5d\x56\xd3\x74\x9b\x6c\x1e\xfb\x25\x96\xf7\x17\xf9\x4d\x17\xa8\x4d\x4e\xd0\x75\x53\x76\x1a\x87\xa9\x26\x54\xf7\xbe\x1e\x62\xa7\xe5\x61\xf3\x96\x08\x89\x45\xb6\xa0\x0c\xb4\xcd\x16\x87\x81\x35\x00\x77\x4f\x21\x8c\xef\x9a\xa8\x32\x5a\x18\xb4\xb4\x30\xb0\xd4\x75\x96\xd3\x03\x95\xfb\x90\xeb\x5b\xb2\x77\xc8\x2c\x5e\xf7\x01\x3e\x0c\xd8\xb1\xe9\x56\x67\x45\xb5\xc8\x48\x21\xac\x9f\xa8\x37\x25\x3c\x6b\x4d\x15\x1b\x56\xc5\x19\xa5\xe2\x10\x6e\x9a\x95\x73\xb9\x39\x13\x64\xc5\xf6\xb0\x7f\xcb\x1a\x85\x03\x55\x54\x25\x77\x31\x3d\x76\xee\x5e\x3f\x00\x76\x18\xa1\x7e\xfb\xbc\x1f\x61\xe4\xb9\x7e\x68\x1f\x3d\x97\x70\x9d\x68\x9b\x5d\x7c\x58\xea\x97\x49\x1f\x62\xf7\xd5\xf6\x3d\x7f\x4b\xb6\xb7\x42\x33\xe1\x95\xae\xf3\xcd\x3a\x6d\x27\xde\x47\xef\x56\x34\x4d\x38\x73\x20\x0b\x5d\xfe\x33\xbd\x2f\x25\x6f\x2e\xdb\x36\x7e\x5c\x00\x3b\xae\x1a\x11\x00\x4e\x6b\x99\xa1\x69\xa4\x3e\x2d\xd1\x4f\x8f\x29\x0e\xaf\xe5\x3e\xd5\xbe\xed\x26\x7c\x70\xba\x58\x7b\xbf\x3d\x42\x3e\x60\xcf\x2c\x08\xd9\x55\x4e\xbb\x85\x62\x22\x7b\x65\xd7\xad\xbb\xf7\xde\xac\x34\x0f\xc5\x25\xd2\xbd\x8d\x9d\x7b\xed\xe3\xa2\x32\x43\x20\x4c\x76\xce\x82\x90\xe7\xf5\x11\xcb\x1a\x88\x01\x39\x64\xf9\x4f\x2c\x80\x23\xd5\x45\xc3\x30\xf2\xc5\xfe\x29\x8c\xb2\x5c\x87\x17\x41\x82\x54\x12\xc3\x6a\xf9\x62\x06\x81\xc3\x02\xa0\x0a\x05\x39\xb3\x59\x1c\x89\xc5\x56\x55\x03\x30\x5a\x01\x3c\xa7\x26\x70\x54\x17\x1d\xe6\x31\x3b\x89\x6f\xab\x2c\xdd\x57\x97\x69\x29\x7d\xb1\x5d\x59\x8b\x2b\x8c\xb2\xdf\x9d\xf5\x65\x65\x6f\x02\xe1\xe1\xd5\x59\x3f\xa2\x81\xf9\x2e\x79\xdb\x6e\xca\xfd\x76\x45\x69\x5f\xd3\x87\xae\xff\x2b\x0b\x12\x97\x4d\x20\x7d\x25\x9e\xcf\xe1\xc2\xe2\x79\x32\xcf\x5c\xc0\x67\xa8\x91\x9f\xc0\x76\x4b\x7f\x63\x81\x0f\x97\xb6\x5c\x67\x9e\xf8\x81\x33\x05\x7d\xdc\x85\xb4\x7c\x12\x23\x5e\x86\xc1\x9c\x99\xef\x2c\x69\xec\x87\xf8\xf1\xb0\x5a\x25\x8b\x9c\xbe\x0d\xd8\x6c\x21\x1f\xc8\x65\x2b\x1d\xbe\x90\xb6\x58\x08\x30\x8b\x80\x62\xd7\xc5\x43\x55\x7e\x0b\x51\xa4\xe9\xd9\x78\x35\x8d\xca\xfe\x88\x05\x06\x58\xc0\xc0\x02\x43\x2c\x30\xc2\x02\x3f\x61\x81\x2f\x58\xe0\xe7\xe4\x11\x3d\xf7\xb8\x5d\x1d\xd6\x59\xf2\x91\x6f\x74\xe7\x34\xc2\xe0\xcf\xbe\x1b\xcf\x98\xa8\xf8\x9c\xef\xbd\x3d\xf1\xf7\x9b\x7d\xd7\x7b\xfd\xf9\x5d\x77\x87\xd8\x9a\xbd\x7c\x6d\xd7\xeb\x8a\x18\x64\x89\x33\xe7\x6f\xed\x84\xce\xae\x06\x77\x00\xb1\x77\x1b\xd1\xb6\x11\xdf\xb0\xaa\x66\x74\x5d\x2f\xac\x5a\x5f\x25\xb2\xee\x8f\x74\xb5\x84\x14\x00\x71\x0a\x7f\x96\x34\xf8\x32\x01\x98\x2f\x98\x8e\xa1\x82\x40\x39\x98\x58\x3d\xfa\xbd\x19\x45\xa6\xf5\xa4\xe2\x07\xa9\x00\x39\x32\x3a\xf2\x7d\x3d\xb1\xa7\xde\xe2\xd5\xa2\xc1\x3f\xa4\x07\xf0\xf5\x0a\x3f\x8f\x68\x39\xe7\xbe\x67\x2e\x06\x50\xae\x2e\x3c\xbc\x46\x5c\x1b\x68\xc4\xdd\xee\x3e\x6f\x41\xfa\xed\x5b\xef\xf2\x55\xb6\x38\xbd\x08\xe8\xb8\x85\x80\xed\x80\x2a\x99\xae\x0a\x5d\xed\xfa\xfc\xcd\xae\x13\x84\x90\x1f\x74\x07\x6a\xf9\xf1\x95\x6f\x41\xdb\x56\xee\xf6\xed\xbb\x3c\xf4\x83\x48\xc7\x9b\xd5\x35\x79\x7d\xa3\x6e\x8a\x6c\x7f\x4c\xf9\xbd\x2c\x99\x6c\xe1\xf6\xaf\x1a\xa9\x9b\x0f\x36\xe5\xe5\x4d\x00\xac\x9b\x2c\xdf\xe3\xe3\x8c\xd8\xc2\x1c\x40\xf6\x31\xea\x80\x01\x01\x42\x36\x37\x03\xb1\x8c\x32\xb0\xfa\xc7\x7e\xc0\x43\x19\x5f\x9d\x99\x5e\x27\x27\x8e\xcb\x83\x17\x85\x96\xe9\x12\x51\x11\x1d\xe8\x3e\x19\x30\x3b\xb6\xd0\x45\xf0\x2f\x55\x84\x84\x7e\x75\xec\xe8\x09\xc9\x3c\x89\xb8\x4c\x00\x48\x4c\x78\x09\x85\xb5\x39\x70\x81\x73\x3f\x8c\x1a\x24\x0f\xcb\x05\x75\xd7\x05\x2c\x23\x75\x91\x71\x11\xbc\x8b\x4c\xab\x33\x03\x2a\xce\x1b\x0e\xec\x6c\x17\x6c\x15\xa1\x8c\x46\xab\x98\x65\x34\x58\xb6\xfa\x2a\xdd\xfe\xd0\x5a\xe7\x2f\xd6\x24\x5f\x95\xd9\x7e\x60\x93\x30\x03\x8b\x9d\xe3\xb7\x93\xc1\x23\x95\xcc\xc9\x20\xb0\xc8\xdd\x67\x8d\xc6\xd9\x75\xf7\x49\xb4\xed\x34\x2b\x2f\x48\x3c\x77\x6a\xea\xf2\xa8\x01\xe7\x50\x58\x1e\x7d\xbc\xae\x32\x78\x7d\x5b\x88\x61\xf7\x3e\x3f\xa8\x72\x22\xf1\x48\x1c\x6b\xfc\xb4\xb2\xf9\x03\x8d\x4c\x6f\x7a\x4e\x12\x72\xff\x07\x3d\x76\x54\x5c\x1a\x28\x60\xc5\x81\xd8\xf1\xad\x0a\x82\x13\xa7\xa2\xee\x23\xcd\x56\x00\x57\x00\x4d\x28\x8c\x02\xc7\x66\x78\x0b\x00\x0b\x6b\x51\xe0\xd2\x9a\x95\xac\x92\xb8\xa0\xcb\x83\x83\x33\x73\x3e\x38\xd9\x13\x24\x6e\xe0\xc4\x07\xe7\xba\x01\xd5\xdf\x94\x6f\xff\xfe\xa2\x74\xf3\x9e\x6d\xca\xe1\x2b\x4a\xf8\x88\x91\x5e\xa0\x84\x51\xaa\x97\x28\x61\x94\xea\x1c\x25\x8c\x52\x5d\xa0\x84\x41\xaa\xc7\xb9\xcc\x03\x37\x7c\xc5\x49\xe3\x94\x2f\x70\xd2\x38\xe5\x4b\x9c\x34\x4e\x79\x8e\x93\xc6\x29\x2f\x70\xd2\x47\x84\xfb\x55\x4e\x29\x4c\x01\xdd\x88\xfe\x38\xba\xfb\x94\xaf\x27\x6e\xaf\xaf\xbb\x07\xd1\x76\x5d\xf9\xc4\x48\x1c\x51\x85\x87\x57\x7e\x51\xe5\x11\x95\xed\x3e\x89\xa3\xd5\xb5\x3a\x85\x83\x4f\xfb\x2a\xc2\xc0\x10\xd5\xc1\xdd\x80\x02\x91\x4a\x1a\xda\x7d\x9b\x9e\x9f\x2b\xc9\x0d\x2e\xb4\xe5\x9b\x63\x99\x38\xd2\x51\x57\x89\xef\x8f\x7c\x0d\xe9\x17\xc6\x57\x52\xce\xd5\x2b\x20\xd7\x51\x15\x85\xe6\x26\x7d\x61\xd2\xc3\x6a\x75\x9a\x2b\x2f\x40\xbf\xee\x06\xb2\x3c\xf6\xec\x83\x45\xf6\x7a\x78\x4f\x56\xe9\x6b\xb6\xea\x7e\x85\xa1\x79\xb7\xa7\x7a\x78\x37\x77\xe6\x8c\x07\x6b\xac\xac\x76\xdf\xd4\x15\x09\x06\xd4\xb3\x39\xb0\xbc\xda\xe9\x06\xcb\xff\x12\xb3\xe0\x05\x2e\xae\x7a\x49\x23\x62\x11\xc4\xdf\x2e\xf7\x85\x0c\x8f\x02\x11\x3f\x45\x02\x12\x1c\xa0\x77\xb4\xdc\xd7\x74\xee\x0d\x3f\xfc\xe9\xc1\x6b\x1e\x6c\x52\x51\x60\x7a\xa1\x78\x09\x30\x61\xcc\x1e\x9b\xd6\xd7\xbb\x3e\x7c\xb4\xcb\xf1\x1f\x84\x75\xba\xff\x9e\xed\x7b\xfa\x22\xb4\xeb\xef\xf0\x6d\xf8\x92\x1d\xb3\x4d\x39\x13\xe5\x40\x1a\x3d\x3f\x14\x1f\xd3\xfd\xf6\xb0\x43\x20\xdb\xdd\x75\x82\xf6\xd3\xf8\x3f\xd3\x14\x9c\xcb\x77\xe5\x47\xf2\xba\x3d\x6c\x16\x45\x52\x66\x45\xfb\xb0\xf3\x87\x4b\x13\xdd\xfc\x05\xc8\xdb\x2b\x63\x3f\xf6\xec\x30\x89\x18\xe8\x84\xa9\xc1\x40\x2c\x62\xf3\xf3\x9b\xb1\xa8\xf3\x43\xcc\xf1\xb6\x4a\xd7\xed\xab\xb3\xdd\xdd\x1e\xd0\x2e\x19\xdd\xb0\xef\x7a\xe6\xfb\xec\xad\x4c\x8a\x92\x07\x01\x4e\xdf\xde\xb2\xa2\xe8\xfe\xc1\x62\xeb\x5c\x7d\xb7\x55\x66\xd5\x56\xb5\x3b\x00\xf1\xc9\x9b\xfb\x21\xc3\xa3\x2d\x20\x8c\xf8\xc5\x01\xd9\x19\x20\xbd\x60\x96\x96\xfb\xfc\x77\x77\x9b\x2e\x96\x38\x71\xd0\xf2\x54\x6a\x9f\x1d\x56\x25\x42\x3b\x17\x47\x68\xe7\x75\x77\x16\xd9\xa6\xcc\xcb\x4f\x38\x15\x6c\xb9\xd7\x11\xb5\x92\x00\xa2\x5e\xe1\x5b\xba\xc2\x14\x20\xe4\x11\xfa\xa3\x7d\xba\x29\x56\xb8\x46\x9c\x18\x44\x39\x93\xfd\xa1\x28\x0f\x6b\x38\xe0\xef\xcb\x8f\x2d\x5c\x7c\xbe\xdd\x21\x84\x0f\xc5\x07\x44\x5a\x45\x10\x11\xe1\xf2\xec\x6c\x99\x1e\x56\xa0\x89\x01\x1f\x23\x89\xa8\x7a\x38\x7f\x5e\x68\x90\xa0\x23\x85\xca\x49\x10\xa6\x28\xc4\x42\xb9\x4e\x18\x18\x02\xb7\x1e\x6f\x41\xa8\xf5\x38\xb2\x45\x6d\x0a\x5d\x16\xae\x65\x1d\x20\xac\xc4\xe9\x29\x7a\x03\xf8\x12\xf3\x19\xb9\xbe\x40\x86\x82\x39\x12\x74\xb3\x63\xb6\x22\x17\xdb\xa4\xf1\x9d\x79\x68\x23\x88\xab\x1b\x38\x50\x07\xc2\x40\xfe\x8e\x8d\x0f\x7e\xb9\xa2\x21\x4c\x25\x2f\xb2\x16\x21\x36\x30\x2b\x8e\x07\x3c\xc2\xca\x1f\x91\x40\x8e\x95\x47\x15\x30\xe5\x2f\x8a\x90\xf2\xd8\x02\x50\x26\x9a\x66\x1b\x9c\x89\xa6\xd9\x06\x65\x22\x2e\x0f\x2a\x60\x9a\x95\x68\xbf\x35\x19\x74\x39\x60\xeb\x36\x19\x74\xdd\xc0\x36\xa8\x80\xf3\xb7\x82\x46\xd1\x0a\x5b\xd2\x28\x54\x61\x88\xb1\xa5\x81\x18\x28\x04\x37\x88\xb5\x29\x58\x59\x7c\xe8\x23\xb4\xea\x02\xc3\x97\x86\x6b\x5d\x17\x69\xd8\xc8\x1e\x8c\x19\xa4\x89\x3d\xa4\x8b\xcc\xb1\x24\x71\x9c\xee\xc6\x71\x3f\x23\xc4\xd8\xd9\x76\xc7\x90\xec\x48\x18\xc9\x36\xfc\xa6\xb3\x5c\x2b\x84\x7c\x65\x88\x78\xaf\x6e\xe7\x05\x1d\xe6\x2f\xce\xf9\x7d\x7a\x85\x40\xdd\x61\x6f\x0f\xaf\xab\x0c\x09\xa9\xf9\x04\x86\x92\x76\x51\x04\xc2\x18\x08\xc2\x29\x64\x21\x0b\x04\x33\xcd\x4a\x67\x53\x66\xef\xb8\xc6\x4c\xb3\x72\xbc\xdd\xae\xb2\x74\x83\xa1\x2c\x15\xd3\x2c\x5b\xa8\x79\x1c\xaa\x43\x76\xb1\x86\x06\x3b\xa0\xb1\xd8\xdf\xd1\x15\xdc\xd0\xc3\x61\x95\x17\xd7\xf0\x5a\x1a\xc0\x5f\xd7\x33\x59\x7d\x08\x48\xed\x6e\xc0\x86\x0e\x3c\x20\xc2\x54\x8f\xb5\x79\x43\x93\x27\xf8\x0c\x3d\x24\x9e\x77\xfe\xc4\x8e\xd6\x6e\x5b\x64\x4b\x22\xb7\xc0\x6d\x4c\x52\xca\x6b\x70\xa0\xf2\xbc\x74\x9d\x2d\x64\xb4\xa9\x8e\x1b\x1b\xf7\x90\xee\x7b\x1e\x9d\xd4\x2c\xdd\xd5\x40\x08\x21\x72\x94\x22\x99\x69\x56\xd6\x08\xe4\x64\xa0\x05\xcb\x21\x89\x40\x22\xac\xa2\xa2\xc2\xa8\x48\x5c\x83\x25\x9e\x31\x08\xcc\x90\xc0\x8c\x08\xcc\x20\xc7\x33\x06\x81\x19\x12\x98\x11\x81\x19\x2c\x8f\x78\xc8\xa0\x40\x43\x0a\x34\xa2\x40\x83\x9c\x00\x19\x14\x68\x48\x81\x46\x14\x48\x7e\x0c\x49\x86\x97\x28\xc9\xfc\x12\x1d\x2d\xe9\x15\xd6\x29\x77\xa8\xd3\x5e\x43\xa7\xda\x23\x2d\x4b\xeb\x95\x0c\x34\x98\x9a\xaa\xc1\xc7\x91\x6a\x9e\x00\x27\x54\x11\xa7\x21\xc7\xc9\x8f\x24\xec\x90\x23\xf7\xa7\xb1\x25\x76\x91\xc0\x42\x2b\xab\x60\x8b\xbc\xe4\xe0\xad\xa4\x96\xd9\x89\x1e\x72\x42\x5f\x1b\x1c\x08\x43\x85\x41\x81\x86\x14\x68\x44\x81\x06\x24\x4b\x18\x24\x6a\x48\xa2\x46\x40\x4a\xcc\xbb\x14\xca\x83\xea\x9d\x77\x80\x8a\xd1\x52\x53\x83\x33\xca\xb5\x15\xe8\x36\x42\x5f\x85\x03\xec\x21\xb7\x35\xe8\xb7\xe3\x90\xa3\xe6\xd2\xdd\x35\xe9\x43\x07\xb0\x22\x17\x1b\x6f\x39\x62\x97\x09\x8b\x55\x9b\x6d\x79\x02\x6d\xa0\xdc\x67\xcb\x13\xe4\x16\x1b\x10\xa8\x9b\x2f\x2c\xf7\xf9\xe6\x1d\x4b\x35\x8d\x3e\x5a\xe8\xf2\x47\x4d\x05\x4b\x5d\x5e\xbf\xe7\x2d\xf4\x55\x2c\x29\x2a\x48\x3f\x1a\xa4\xd7\x83\x6c\xb3\xc8\xf6\xaf\x37\x22\xb1\xdd\x2c\xb4\x8e\x9f\x1a\x9b\x1f\x75\x8a\x17\x33\x87\x22\x5d\xef\x56\x59\x4f\x6a\xac\xed\x31\x83\x36\xcc\xfa\xc8\xde\xbe\x0b\x9d\x13\xde\x9a\x4a\x65\x5a\x1e\x40\xef\x8a\xda\xa4\x9a\xb2\x0d\x6c\x0d\xd8\xd0\x81\x87\x24\xb8\x6e\x4f\x4c\x77\xa8\xa9\x30\xcb\x32\x7d\xfb\x58\x67\x9b\x12\xd9\x2f\xa6\xd9\x26\xe3\x11\x5b\x55\x03\x66\xf9\x6e\x9d\xee\x30\x60\x35\x7f\x84\x93\xb5\x6a\xf3\xbc\x05\xf0\xd5\x43\x27\x58\x20\xc9\x20\x4b\x17\xf0\x22\xf9\x88\x73\x66\x29\xbf\x39\x7e\xa6\x85\xde\xf1\xba\xd2\xbd\x34\x50\x37\xfd\xcc\xf6\x1a\xfc\x24\x85\x65\xa2\x50\xe2\xd8\x2e\x5d\xf5\x22\x2c\xa7\xf2\xf0\xf2\xe8\x3a\xf2\xcf\xce\x38\xfd\xf7\x50\x11\xf9\x88\x44\xb2\xc5\x7b\x36\x59\xa5\xef\x24\x58\x9c\x62\x91\x48\x99\x58\x8f\x84\x56\x97\x93\x48\x70\xe5\x26\xba\x86\xc9\xf6\x9d\x0e\x87\xd9\xdb\x76\xb3\x48\xf7\x1a\x0e\x53\x7f\x8a\x9b\xa8\xda\x0a\x1c\xb8\x06\x39\x4f\xae\xe9\x41\x4c\x93\x91\xd4\x45\x51\xf2\xf2\x2c\xad\x40\x38\x7b\xce\x8a\xcd\x39\x75\xbc\x7b\xc4\x93\x6a\x0a\x7e\x24\x97\x99\x27\x04\x16\x37\xf1\x6f\x9e\xd3\x04\xe9\x06\x36\xdd\x99\xac\x0e\xc5\xc7\x2c\xdd\xed\xb2\x05\x05\xaf\x31\x6a\xda\x05\xa1\x44\x28\xdd\x1a\x0a\x1d\x83\xda\x9c\xc6\xf0\x75\x1e\x37\x89\xd3\x1b\xe4\xc0\xab\x26\xe7\x6a\x93\x64\xb0\xc0\x33\x06\x81\x19\x12\x98\x11\x81\x19\x2c\x8e\x78\xc8\xa0\x40\x43\x0a\x34\x5a\xd0\x77\xd8\xe9\xe8\x70\x41\xdf\x20\xa7\xa3\x86\x4e\xb9\x86\x4e\xc9\x43\x2d\x63\xe9\x94\x3c\xd2\x29\x79\x04\x35\x98\x9a\x47\x22\xbf\x90\x8a\x52\x5f\xc7\x81\x8d\x67\x0c\x02\x33\x24\x30\x86\x8d\x5c\x79\xb7\x8b\x44\xe2\xb5\xa1\x8e\xdf\x6b\x57\x79\xe1\xe8\xf3\x6c\x39\x2f\x98\xf0\x79\xa8\xd6\x0c\x4a\x5b\x83\xab\xad\x81\xdb\x03\xb8\x89\x73\xa1\x41\xb1\x76\x7e\xcc\x8b\xed\x9e\xa4\x42\x35\x03\x3e\x95\x54\x3d\x61\x9e\xbe\x67\xd6\x76\xbd\xce\x4b\xbe\xec\xd7\x28\xba\xbb\xf2\xfa\xaf\x39\x8b\xb7\x74\xbf\x90\x91\xc7\xe4\x82\x4e\xff\xf5\x29\x34\x3f\xd3\xf5\x07\x97\x4d\x39\x9e\x94\xc2\x72\x60\xd1\xa2\x6c\xd9\xa0\xda\xac\xa4\x7f\x8b\xfd\x37\x9f\x85\x26\x65\xbe\xce\xf6\xc9\x3f\x0e\x59\x1f\xc9\x97\x65\x94\x07\x11\xdd\x56\xc4\x1c\x8d\x42\x80\x5d\xaa\xb8\x6a\x12\xbe\x2f\x2f\xe5\x02\x16\x76\x24\x55\xbd\x27\x6e\x3e\x9b\x8e\x2b\xa2\xb7\xde\x07\x23\x67\xc6\x12\xe6\x9a\xf3\x90\xd9\x40\xf1\x30\xea\x78\xaa\xdc\x21\x3b\x9d\xc7\x89\xed\x84\x7f\xf7\xaf\x85\x04\x6f\xf7\x87\x69\xb6\xf9\xe5\x90\xed\xf3\x0c\xb4\x03\x65\x67\xab\xac\xcc\x10\x80\x53\x70\x61\xd0\x82\x6f\x9c\xbd\xe7\x1b\xb0\x34\xdb\x2c\xc0\xb2\x42\xd0\xda\x1e\xa0\xcf\x98\xa6\x59\x29\x90\xfc\x88\x91\x96\xc1\x1f\x28\xcc\x81\x02\xe5\x5f\x46\xa4\xa2\xe0\x98\x5a\x88\x5e\x02\xda\x9f\x88\x7d\xfa\x4f\x15\xcb\xbd\xd0\xff\x34\xc8\xd0\xba\xae\x5f\x8f\x8e\x0e\xf9\x3a\x70\xee\x6e\x52\xb9\x26\x52\x13\xff\x11\x27\x3e\xc0\x89\x1b\x38\xf1\x21\x4e\x7c\x84\x13\xff\x09\x27\xfe\x05\x27\xfe\x33\x4e\xfc\xaf\x38\xf1\xbf\xe1\xc4\x07\x58\xb7\x22\xfd\x3a\x40\x3a\x76\x80\xf4\xec\x00\xe9\xda\x01\x28\x85\x48\xeb\xc7\x05\xb1\x51\xfb\x07\x39\x20\x30\x06\x81\x19\x12\x98\x11\x81\xa1\xd8\xed\x0b\x81\xf9\x99\xc0\xfc\x95\xc0\xfc\x8d\xc0\x0c\x7e\xa4\x40\x94\x9e\x30\x30\x28\x10\xa5\x2f\x0c\x46\x14\xe8\xa7\x04\x32\xa4\x5e\x3f\xed\xeb\x73\x40\x35\x5a\x23\xea\xfd\xe1\xb4\x5d\x4f\x71\x2c\x31\x4b\x8b\xef\xea\x7d\xd2\x03\x2b\x9b\xe4\xb2\x08\xca\x2c\xa0\x5d\x6d\x79\x60\x90\x23\x0e\x08\x72\x74\x72\x49\x3c\x51\xe5\x12\x85\x93\x3c\x03\x29\x4e\x1a\x55\xc6\xc9\xbb\x39\xea\x59\x5c\xfe\x88\x5e\x90\xa9\x64\xff\xc9\x6b\x5a\x64\xdd\x31\x27\x49\x5d\x81\xff\xd2\x98\xd2\x3d\x4e\x0b\x75\x12\x04\x69\x2f\x27\xe5\x3e\x1d\x19\xaf\x48\x67\x23\x53\xb3\x2d\xae\xaa\xe8\xc5\x86\x79\x55\x4c\x77\x94\x29\xed\xb0\x70\xdc\x22\x62\xb3\xe5\xdc\x20\xb2\x25\x00\xed\x6f\xd5\xae\x59\xf7\x3f\x42\x05\xd1\x0e\xda\xf3\xee\x74\xea\xea\x2d\x2f\x75\x38\xe6\xe6\xd0\xc3\x17\x2d\x2a\xe1\x4f\x28\x22\x9e\x3a\xf0\x9c\x7b\x27\xce\xf1\xec\xab\xd8\xdd\x1f\xc4\x23\x4c\x54\xf2\xf7\x6f\x7c\x7b\x3d\x59\x66\xd9\xe2\x35\x7d\xfb\xde\x32\x53\x77\x17\x06\x54\x3d\xaa\x34\x4f\x94\x62\x68\x9b\x2f\xc0\x47\xf5\x11\x6e\x97\xfd\x26\x5d\xa9\x21\xb1\xd5\x70\xed\x9f\x6f\xf3\xa0\x55\x95\x05\x31\x42\xc7\x31\x6d\x37\xdd\x65\x80\x56\x25\x9b\x4d\xf8\x03\xd5\x13\xe3\x28\x91\x70\x4e\x26\xf1\xbe\xdd\x3b\x51\x4a\xb7\xef\x32\xc1\x45\x4b\xe5\x0f\x97\xc6\xbc\xf9\xfb\x9e\xf8\xd3\xc4\xf2\xfd\xc0\x76\x3c\x33\x62\x55\xce\xfc\xfb\xdf\x85\x16\x07\x00\x02\x73\xca\x27\xca\xe0\xcd\xe9\x6a\x13\x16\x5f\x52\x13\x40\x25\x9f\xe8\x64\xc1\x29\x28\x3a\x69\x78\x42\x89\x4e\x1c\xd2\x95\xab\x5b\x45\x4b\x94\xf0\x11\x23\xbd\x40\x09\xa3\x54\xab\xbb\x27\x7d\xff\xea\xf6\xe9\x3b\x1f\x77\x92\xe2\x23\x5d\xe4\x9b\xf7\x84\x4f\x74\x6f\xff\xfe\x20\xbf\x17\x1e\x95\x9a\xe7\x0a\x0d\x78\xd7\x1a\x7c\x1b\x24\x73\xe7\x1b\x73\x21\x23\x69\x8b\x34\x88\xa4\x41\x2e\xd3\x20\x97\x39\xf8\x36\x22\x92\x23\x72\x6d\x47\xe4\xda\x1a\x1a\xb5\xa5\x92\x48\xf1\xf3\x16\x00\x80\x3c\x7d\x39\x1b\x2a\x54\x22\xe4\x20\xf1\xe7\xc9\x57\xc6\xe6\xbd\x28\x0a\xd8\xdc\x35\x2d\xd6\x8b\xae\x99\xe3\xf5\xa3\xc7\xfc\xd6\x8f\x9e\xd8\x05\xe8\xe1\xb5\xee\xd6\x55\xf3\x59\xc4\xbe\x31\x17\x95\xc2\xaf\x6f\x95\xf0\x5a\xa2\x32\x14\xfe\x07\x75\x9a\xe1\x9c\x07\x64\x0f\xcc\xc8\xf1\xfb\xd2\xec\x9a\x2f\xb0\xf3\x99\x6e\x4d\xbf\x3a\xd1\x53\x15\xc4\x5e\xce\x5a\xaa\x03\xf3\x5f\x03\x27\x62\x61\x12\xc6\xf3\xb9\x1f\x44\xcc\xd6\x2c\xc2\x9c\xcd\x5d\x96\xcc\xcc\xf0\x6b\x0f\x2a\x6b\xed\x17\x0d\xb0\xd9\xc4\xe4\x07\xcd\xf5\x74\xc0\xfa\xa5\x78\xbe\x97\x44\x81\xf3\xec\x98\xee\xe9\x57\x76\xcf\x20\xed\x09\x80\x7c\x5d\x20\x46\xe9\x50\x0e\xd2\x41\x5a\xc2\x8e\x87\x6b\xf2\x48\x71\x6b\xbb\x7e\xcd\x37\xd9\xde\xdf\x81\x0a\xaa\xdd\x77\xb8\x5e\x66\x0f\x73\x95\xaa\x90\xe4\x75\x95\xeb\x06\x6c\x0f\x98\x69\x37\xdc\x7d\xdf\xc9\xe2\x68\x47\x13\x19\xf3\xed\x01\x6f\x0a\x40\x2f\x2a\x78\x0b\x6d\x7b\x64\xbc\xca\xeb\x8f\x52\x1e\xe8\x8a\xf5\xf9\x8e\x99\xb6\x47\x3c\x9b\x05\xaa\xb1\xf2\xe7\x0e\xfc\x2c\x9d\x4c\xe4\x78\x96\xcf\xb9\x88\xf1\x91\x2c\x72\xa4\x16\x80\x12\xfe\x0d\xbe\x55\x64\xdb\xbe\xf5\x37\x28\x6a\x61\x7c\xfd\xb2\xdd\x83\xcc\x2d\x7e\x05\x49\x21\x62\x90\x6b\x9a\x5e\x64\x9d\xb5\xf9\x6d\x1b\xdf\x7d\x66\xc9\xc4\x0c\x81\x29\x0c\x5a\xa0\xe7\x58\x1d\x5c\x9f\xed\xdf\x8a\x8b\x16\x9a\xcd\x75\xbc\x67\xd3\x75\x9a\xbf\x2e\x7f\xce\x02\xf3\x6a\x4e\xa3\x26\xcf\x7b\x4b\xb3\xb7\x3a\xbf\x31\x64\x57\xc5\x7c\x08\x6a\x25\xc1\xb1\x7a\x61\xb5\xe1\x4e\x25\x8e\x81\x2e\xf5\x6f\x2a\xf1\xcc\x99\x86\x92\x88\x7d\x8b\xe2\x80\x25\x2e\x7b\x66\xae\xbe\x1a\x2b\x1e\xf3\xe9\xc1\x3c\x99\x98\x56\x0f\xb5\x1a\xda\xc9\x6f\xfe\x64\x12\xb2\x08\xa9\xeb\xf4\x05\x22\x7f\xba\x6a\xb5\x21\xeb\x98\x39\x61\xc8\xfb\x49\x1f\xba\x6c\x67\xc6\xbc\xd0\xf1\x3d\x8d\xcf\x31\x4f\x0e\x64\x46\x1a\x0a\x6a\xf7\x2a\xe8\x4a\xc4\x98\x4a\x52\x12\x7b\x98\xe9\x20\xf5\xd6\xd4\x9f\x97\x41\xfe\xbc\x0c\xf2\xe7\x65\x10\xfc\x65\x90\x26\x24\x97\xa1\x67\x08\x80\x54\x6b\x56\x14\x54\xff\x3e\x20\x87\x71\xa4\x38\x74\xcb\xa3\x01\x81\x37\x20\x1a\x94\xd8\x87\xf7\x4c\xbe\xd1\xce\xbf\xd8\x08\xdb\x39\x9e\xcd\xbe\x0d\xb0\xc0\x08\x0b\xfc\x15\x0b\x0c\xbe\x60\x6d\x10\x30\x1b\x3a\xa5\x6b\x70\xd3\x80\x31\x8f\x44\x8e\xdd\x98\x91\x40\xd3\x9d\x3f\x99\x24\x52\x6d\xd6\x10\xc8\xca\xba\x57\x4b\x6d\xaf\x96\x9c\x02\xfb\x66\x9f\xbf\x99\xc1\x32\xf2\xfa\x7c\x9d\x02\x6d\x5e\x4c\xb3\x0d\x9a\xe9\x58\xfe\xc1\x8a\x2a\xeb\x24\xf2\xc5\xaa\x53\x5c\x5f\xd1\x77\x02\xdc\x88\x48\x44\xda\xb0\x06\x41\x4d\x88\x45\x44\x54\x17\x52\x40\x97\x1a\x84\x8a\xe5\x42\x0d\xe3\x42\x8d\xe0\x52\xe3\xea\x4e\x87\xa0\xd3\xac\xec\x33\x6e\x4b\x67\xd8\x95\x3e\x17\xe5\x45\x30\x1d\x6b\x2e\xc9\xeb\x63\x69\x18\x4c\xc7\xc8\xe1\x57\x20\x96\x39\xef\x7a\x23\xa4\xdb\xd2\xf7\x6c\xbb\xce\xca\xfd\x67\xb2\xe3\x67\xb0\x49\x91\xff\xeb\xce\x11\x29\x49\x39\x3f\x81\xed\xe3\xf9\xdb\x94\xf9\x33\x16\x05\x2f\xd5\x9e\xfc\x7d\x3b\xb6\x89\xb1\x13\x61\x28\xd7\xf1\xbe\x32\xfb\x7c\x5d\xca\x8f\x29\xb8\xe3\xcd\x63\xf0\xae\x44\x1b\xf6\xe3\x88\x42\xab\xf6\x3a\xde\xb3\x6f\x89\xad\x1f\xc8\x12\x4d\x1c\x97\x24\xf3\xc0\x7f\xf6\xbf\xf2\x05\xb6\x4a\x98\x0b\x00\x1d\x8f\x85\x89\x69\xff\xdd\xb4\x98\x67\x41\x5e\xcc\x89\xcc\xc3\xfc\x5e\xc5\x1c\x85\x45\x81\x63\x7a\x53\x97\x85\x24\x8a\x50\x20\x5f\xed\x9e\x8c\x1a\x7b\x0e\x9f\x39\xf2\xf3\x8e\xb9\xef\x21\x5e\x0b\x5d\x68\x18\xbb\xbe\xf5\x15\x4a\xab\xe3\x15\xbb\xcf\x8a\xc8\x3e\x49\xe7\x55\xb7\xd4\x56\x80\xbc\x89\x78\xc2\x23\x3f\x32\x5d\xfd\x5a\x90\x7e\x26\xcd\x8a\xa8\x3d\x35\x67\x66\x4e\x19\xf7\x2f\xbe\x12\x66\xe4\xcf\x1c\xeb\xfc\x46\x15\xfc\xaa\xec\x86\x16\x34\x7e\xaa\x3f\xef\x9f\x54\x3b\x86\x91\x1f\x70\x35\xe0\xee\x3d\x71\x82\x30\xaa\xbe\x33\x96\xef\x3d\x33\x0f\xb8\x4f\xed\x9a\x44\x30\xf6\x6c\x36\x11\x3f\x27\x45\xdf\x47\xe6\x81\x33\x73\x22\xe7\x99\x85\xc9\x94\x79\x7c\x2f\x9d\xd9\xc8\x41\xbc\x3a\x84\x05\x9f\x48\xab\xf3\xed\x93\x02\xcc\x51\x76\xd7\xf6\xa0\xe0\x93\xc8\x0c\xa6\x2c\xc2\xaa\xa9\xed\x1c\x08\x35\xa0\xd6\x07\x6c\xc2\x02\xe6\x59\xcc\x4e\xc6\x2f\x17\x3d\x05\x32\xc7\xbb\x9c\x9d\x3e\x6a\xf6\x23\x27\x28\xa3\xd6\x0c\xe5\xdf\x38\x3d\xa1\xce\x30\x68\x53\x0b\xdc\x9c\xa2\xef\xcf\xdd\xb3\x19\xbc\xf0\xd9\x05\xfa\xab\xad\x7e\xaf\x74\x9e\x0a\xf6\x38\xee\xfe\xc7\x86\xbd\xff\xbf\x53\xb4\x07\x7c\xf0\x2e\xd4\x88\x51\xf5\xdf\xf1\xd5\xbc\xa2\xa0\xfa\xd9\x09\x45\x88\x34\xec\xe2\xee\x31\x7c\x33\x4b\x85\x2c\x3a\xaf\xc5\xfb\xfe\xe0\xee\x0e\xc9\x4e\x96\x91\xec\xaa\x42\xf0\x2f\x5b\xae\x54\x9b\x6d\x8e\xe8\xb8\xee\x9d\xf1\x93\x3b\xe0\x3e\x5a\xde\xcf\x28\x23\xaf\x72\x04\xc9\xc0\xbe\x71\x41\xbc\x1b\x31\xf0\x88\xea\x8a\x70\xe0\x54\x2d\x3e\xd0\xfb\xbf\x52\x6a\x87\x26\xc5\xf1\x38\x98\x8a\xbd\xd0\x99\xf2\xf9\x1f\xff\x69\x3c\x33\xcb\x20\x30\x43\x02\x03\x39\x0d\xe0\x55\xaa\x5a\x35\xb0\x91\x80\x81\x05\x86\x58\x40\x18\x1a\x87\x18\xfc\xea\x8d\x15\x21\x29\x44\xe7\x6e\x15\x46\xc1\xc0\x9d\xbc\xe1\x52\x94\x9f\x3a\x49\x83\x4c\x0e\xc9\x24\xd0\x85\x9d\x2c\xdc\x97\x9d\x38\xc2\xa9\xd7\x8a\xd7\xe2\xc1\x6e\xe6\x77\xc0\xab\x11\x54\x5e\xa2\x06\xdf\x96\xe1\xb3\x35\x22\xaa\xa6\xb7\x66\x14\x05\xce\x58\xb5\x94\x9f\x58\x4e\xaf\xd4\xb9\x3d\x94\x4d\xb3\x52\xc5\x1a\x04\x86\x05\x52\x67\x28\xef\x3c\x22\x2a\xcf\x41\xc0\x5f\x9d\x03\x0b\xa2\x60\xb8\x4c\x39\xb8\x14\x39\xb8\xdc\x38\xb8\xa4\x38\xc8\x6c\x38\xc8\x34\x38\xc8\xfc\x37\x4a\x1c\x9a\x27\xa5\x1e\xe7\xce\x19\xe4\x68\xc4\xc0\x23\x43\x3c\x32\xc2\x23\x83\x03\x9e\x31\x08\xcc\x90\xc0\x8c\x08\x0c\x30\xc3\x5e\x83\x31\x08\xcc\x90\xc0\x8c\x08\xcc\xe0\x40\x80\x0c\x0a\x34\xa4\x40\xa4\xdf\xcf\xe8\x95\xc0\x14\x04\xe6\x40\x29\xe8\x40\x28\xa9\xfb\xe5\xe5\x9d\x70\xe1\xb2\x92\xf9\x91\x42\x1d\xf2\xc7\x2d\xac\x7e\xba\x7d\xc0\x88\x51\xfa\x91\x17\xa5\x58\x17\x6a\xae\xd5\x9e\x9c\x30\x92\x73\x81\xfb\xc3\xff\x3c\xf0\xbf\xbd\xa0\x88\xb3\x2c\xf4\x0e\xd5\x99\x00\xdf\x80\x3a\x23\x88\x9b\x43\x67\x08\x75\x6d\xe8\x8c\x61\xee\x0c\x9d\x29\xd4\x85\xa1\x33\xe6\xc6\x33\xc7\x33\x3d\x8b\x50\x62\xe8\x78\x5f\x01\xc0\xcc\xf1\x60\xaf\x18\x95\x20\xd8\x3b\x4a\x1e\x58\x8d\x48\x5c\x21\x88\x7c\x3f\x71\xf9\xb6\x58\x02\xfc\x05\x3f\x55\xbf\x06\xac\xfc\x69\x43\x65\x79\x24\xa3\xe0\xef\xcc\x2c\xdf\xac\xd3\xdf\x51\xc2\xf8\xfa\xb5\x38\x58\xe5\x4e\x8d\x82\x08\xc3\x9b\x11\x64\x45\x56\xa2\x74\x0b\xa2\xb3\x00\xdd\xaf\xb0\x88\xc6\x95\xa4\x3c\x98\x4e\xc2\xc3\xb1\xa4\xda\xf1\x6a\x9c\xd8\x49\x9e\x8d\x09\xa0\x57\x0b\xc9\x21\x54\xd2\x1b\x4e\x10\x7a\x85\x34\x48\x77\x64\x4c\x10\x75\x3e\x49\xa3\x74\xc3\xeb\xde\x20\x2e\xcb\xe8\xc7\xdd\xcb\xc3\xe6\x4d\xd7\xcb\xfc\x5e\x6d\x02\x7c\x90\x55\x13\x9e\xc4\x9e\x85\x23\x02\x36\x49\x20\xbf\x11\x11\xba\x8e\xc7\x46\x7b\x88\xc9\xd6\x29\xff\x6a\xa4\xab\x5e\xcc\x36\x33\x23\x16\xf0\xf7\xb7\x50\x43\x9c\x80\xb9\xc9\x8f\x19\x60\x41\x49\x5a\xe8\xd5\x17\x48\x9d\x86\x9c\xa9\xf6\x3e\xc4\x98\xa5\x3c\x14\xbe\x61\x4b\x9c\x4e\x15\xa6\x49\x7e\xc6\x8a\xdb\xb3\x49\x88\x97\xba\x36\x74\x6c\xe7\xd9\x09\xfd\x00\x64\xc1\xc7\x07\xa9\x5f\xe5\xef\x1f\x25\xc0\x8e\x90\xd6\x9e\x9e\x89\x9f\xba\x4a\x57\x1b\xaf\x30\x1e\x9f\xf0\xa0\x08\x68\x28\x7c\x33\x8a\x98\x17\x43\x5f\x3f\xaa\x43\x04\x1c\x54\x1d\xce\x99\xf3\xb9\xeb\xc8\xdb\x76\xc9\xcc\xb7\x19\x02\x75\x81\xcf\x19\x2a\xf9\xfb\x3f\xc7\x3b\xdc\xed\x2f\x40\xbb\x27\x9a\xbb\xdd\xea\xb3\xfb\x0e\x46\xa7\xbc\x12\x75\x79\xf7\x42\xc8\x3f\xe8\x6b\xb1\x4e\x77\x2a\x46\x9a\x0c\xa9\xa7\xff\xcb\xe6\x4f\x31\xe5\x7b\x3b\xd0\x7d\x52\x2e\x2e\x22\x46\x20\xe4\xd5\x3b\x5e\x1e\x6a\x21\x30\xbd\x29\x11\x55\x5b\xde\x70\x76\xe2\xc6\x21\x5f\x49\xf2\x9e\xec\x44\x88\x42\x63\x2f\x7c\xf1\xac\xa7\xc0\xf7\x9c\xdf\xd8\x75\xc3\xb4\x3d\x3f\x4b\x77\xf4\x24\x62\xd7\x49\xed\x2e\x93\xad\xb7\xfb\xcf\xcb\x17\xd9\xff\xcb\xde\xb7\x2d\x29\x8e\xfb\xfe\xbf\xcb\x3c\xc1\x00\x3d\xfd\x67\x2f\x73\x30\x99\xd4\x86\x24\xeb\x04\x7a\x7a\x6e\x5c\x69\x48\xf7\xa4\x16\x08\xdf\x10\x98\xc3\xd3\xff\xcb\xce\x01\x08\x09\x48\x8e\xd9\x9d\xdf\x56\x17\xbd\x7b\x31\xa5\x8f\x24\xcb\xf2\x21\xb6\x2c\x49\x65\xd6\x6b\x1f\x8a\xa1\xed\xc0\x1e\x3b\x9b\xc4\xe4\xb3\x0a\x31\xd9\x94\x4c\x3d\x1e\x43\x52\x3c\x73\xbe\x8d\xf4\xa9\x17\x12\x43\x06\xe9\xce\xa6\x95\x82\x3c\xb8\x08\x12\x3f\x81\x24\xf7\xfc\xd0\x9e\x6a\x35\xec\x36\x80\x87\xa3\x68\x14\x4e\xcf\x9b\x60\x92\xb9\x6d\x10\x36\x9b\xd9\x26\x44\xa7\x13\x72\x08\x35\xb5\xe7\x84\x42\xa9\xb9\x0e\xdd\xe7\x0b\x4d\x27\x17\x77\x39\xbb\xe4\x6d\x13\x2f\xf5\x9f\x79\x7c\x90\xc0\x00\x4b\xf9\x15\xaf\x62\xa6\xc2\xdf\x8b\xa2\x12\xa0\x97\x2a\xf6\xee\x14\x02\x41\x18\x59\x1c\x49\x08\x3a\x05\x20\xbf\xbb\xf9\xa1\x80\x3c\x3a\x8c\x7f\x54\xd9\x2f\xe2\xf5\xd0\x94\x80\x20\x4b\x54\x9d\xa1\x47\xa6\x04\x04\x29\xf0\x2c\xf7\xe5\x34\x5e\x23\x56\x6a\x09\xcb\x34\x61\x78\xeb\x9c\x73\x18\x99\x92\x30\xa4\xe0\xcb\x34\xa1\x70\x53\x1d\x01\x32\xb5\xd8\xda\x60\x6d\x0b\x5b\x63\xf9\x39\x5f\x9c\xde\x57\xa0\xf7\x15\xe8\x7d\x05\x7a\x5f\x81\xde\x57\xa0\xff\xf4\x0a\xa4\xf4\x83\x87\xbd\x2e\xd5\x7e\xf3\x7c\xd6\x5c\x93\xdf\x3c\xf1\x07\x07\x9e\xaf\xfd\x35\x23\x6c\x62\x32\x48\xdb\xed\x35\x57\xa2\x18\x44\x13\x48\x06\x75\xec\x62\xf8\x0f\xaa\x26\xdf\x1f\xdf\x93\xcd\x68\x78\xf7\x2e\x79\xb2\xdd\x11\x24\x18\xb6\x13\xfa\xe7\x34\x84\xaf\x71\x0e\x70\x8d\x2b\x16\x50\x97\x9f\xa7\x89\x04\x99\xb7\x21\xce\xd5\x85\xae\xbb\x2d\xe6\xc8\x1c\x0c\xf9\x2e\x82\xf8\x9e\xe7\x48\x81\x29\x01\x27\x50\x6f\x82\x07\xe5\x9b\x1a\x79\x64\x97\xf9\xaf\x39\xee\x13\xf7\xac\xcf\xd1\x66\xb9\x8a\xa5\xa0\x7c\x77\x0c\x70\x7d\x05\xa3\xf2\xdd\x3b\xdf\xbd\xf3\x4e\xde\x89\x9a\x98\x93\xdd\x82\x45\x22\x9a\x69\x7f\x91\x4f\xfe\x83\xec\xea\xcb\x77\x22\x45\x45\x93\xd6\xcb\x27\xf4\x5c\xdf\xb4\x8c\xd8\x71\x1c\xeb\xdd\x40\x8c\x59\x43\x10\xf5\x5b\x6e\x0f\xf3\x7f\x49\x31\xc9\xce\xe0\xd5\xad\xb3\xcb\x13\xe0\xf6\xbe\x80\xaa\x5d\x95\x1c\x2a\x58\x4b\xb5\xb8\x03\xac\xa4\xe5\x4a\xf2\xdd\xe2\x32\xd4\x96\x84\x45\xf8\x56\xe8\x41\x67\xd2\x26\xcc\x73\x11\xc2\x80\x32\x06\xbe\x16\x40\x3e\xdc\x87\x82\x0e\x92\xca\xae\xa0\x84\xe4\x12\x7b\x00\xf3\x7c\x40\xf2\x84\xac\xa3\x05\xe5\x08\x6e\x51\x78\xde\x02\x78\x02\xe4\xd3\xce\x9a\x6b\x3c\x28\x10\x87\xb1\x5d\x7e\x09\x0f\x07\xf9\xfc\xe6\x97\x42\x2e\x7d\x4f\x3d\x1c\x7a\xd9\x15\x88\xa1\xc5\x0b\x24\xc2\xa9\xfd\x28\xcf\xe3\x6c\x73\xbf\x91\xbe\x64\x8b\x74\xbd\x8d\xf2\xe4\x25\x59\x25\xb9\x82\x8a\xeb\xfd\xc6\x7e\xcb\x20\x56\xda\xd8\x4c\xe4\xab\x62\x79\xda\x11\xf6\x20\xd1\xe0\x1b\x6f\x76\xe1\xde\x7e\x9e\x89\x0e\x0c\xeb\x78\xb9\xfc\x1b\x65\x09\x07\xa6\x77\xb8\x76\x24\x73\x5f\x27\x18\x5e\xf7\x02\xb4\xa0\x43\x12\x7f\x3f\xab\x57\xda\xdf\xcb\x9a\xe9\x42\x21\x7d\xca\x3d\x60\x6e\x93\x27\x5c\x6d\x67\x00\x35\x2e\xd9\x30\xf7\xad\xa3\x2e\xd7\xd6\x89\x4b\x2f\x8b\xca\x93\xaf\xf6\x92\xb2\xb7\xca\xe5\x22\x50\xc7\xba\xf5\x97\xe7\xd6\x4a\x9c\x4f\xf8\x44\x1e\xef\x76\xf1\x6a\x25\x1e\xcd\xdd\x48\x90\xd6\x59\x87\x53\x56\xb2\x18\x63\xa7\x03\xe1\xce\x02\x93\x75\x9c\xb1\xff\xed\xe3\xec\xe7\x3d\x04\xa5\x8b\xc5\x6a\xcf\xe5\x17\x22\xd8\x4b\x9a\xae\xe2\x68\xd3\x10\x25\x31\xd0\x34\xf7\xb9\x9e\x09\xf9\x1e\x08\x94\xb7\xa1\x05\x64\x78\x6e\x40\xe8\x5c\xe3\x89\x83\x98\x62\x87\xda\x46\x8b\xbf\xe3\x25\x5b\xc6\xdb\xfc\x1b\xdb\xe5\xf1\x66\x91\xf4\x8d\x29\x3d\x2f\x70\x83\x7c\x62\x3b\x7c\x60\x90\xdc\xb5\x42\xc6\xf0\xa1\x92\x02\x81\xd4\xab\xe8\x95\xc4\xac\x8a\xac\x29\xea\x34\xf6\xb4\x22\x1d\x0c\x26\xcc\xe2\xff\xd3\x07\x1f\x27\x80\xf6\x9d\x99\x91\x43\x38\x96\xff\x47\xc9\x1c\x00\xa7\x96\xce\x5f\xbc\x08\x0e\xdd\xf9\x60\x14\x19\x68\x9b\xfc\x88\x57\xbb\x9e\x16\xaa\x1b\xac\x3f\x87\x84\x8d\xd8\x88\x0d\x31\x66\x0a\x3e\x7b\x34\x64\x0f\xe5\x0f\x0d\xfc\x24\x7e\x03\x0c\x90\xbf\x9e\x1f\x97\x3f\x24\x6c\xf0\xb1\xfc\x1b\xaa\xef\x91\x55\x9c\xe7\xf1\xb2\x9a\xd6\x7b\x76\x4a\xb1\xb9\x00\x67\xb9\x3e\x21\x1f\xe2\xc8\x1f\x70\xe4\x63\x1c\x39\x22\xc3\x3b\x3c\x7d\x76\x35\x03\x09\x44\xf7\xc9\x6d\x73\x3f\x21\xca\xa5\x87\xbc\x5a\x3c\x84\xda\x8a\xf3\x1e\x00\xe4\xc5\x72\x3b\xf6\xf5\xa0\xda\x47\xf9\x74\x51\x45\xde\xb6\x86\x52\x62\xdd\x54\x54\x75\x64\xbe\x66\xfc\x09\xdf\x7c\x16\x98\x99\x2b\x83\x3a\xc5\xc0\xab\xd3\xb4\x49\xec\x40\xab\x31\x71\x5d\x05\x5b\x89\x79\x43\xaa\xb9\x01\x7f\x81\x08\x4b\x28\x53\xb4\x76\xaa\x59\x6c\x62\x3b\xb0\x27\x25\x25\xc4\x76\xb1\x10\x63\xa6\xdb\x06\x7b\x82\x26\xff\x2f\xc8\x6f\xd3\x69\x73\x42\x61\x97\x1b\x2d\x16\x0a\x42\xee\x24\xd0\xa2\xc6\xfc\x53\xa8\x37\x93\x16\x06\x53\x2d\xa4\xf6\x17\x06\x19\xfd\x3e\x1f\x96\x75\x95\xf2\xe3\xd4\xd1\x03\xfb\xda\x03\x9b\x1c\x7a\x80\xc1\x0f\x37\x7b\x09\xef\xc6\xbf\x1e\xee\x3b\xa0\xd9\x82\xcf\xd3\x2c\xe7\xab\xc2\x95\xc1\x8d\x92\xc0\xdf\xfc\xf7\xc9\xbe\xd6\xf0\xc5\x63\x86\x39\x58\xc1\xd7\x93\x94\x74\xb0\x37\xd1\x05\x60\xa2\x99\x84\x85\x9f\x29\x09\x3e\x7b\x0e\xf8\x02\xd5\xb4\x83\x50\xbc\xec\xbe\xf5\xa2\xa7\xd9\xe5\x22\x33\xc2\xb1\x9b\x25\x20\xca\x3d\x23\x5d\xfd\x7c\x4b\x37\x2c\x7d\x7d\xdd\xc5\xbd\x17\x52\xcf\x79\xb6\x3c\x17\x9e\x9c\xa8\x01\x98\x68\x46\xe8\x51\x3c\x4e\xb7\x35\xd8\xc1\x8f\x5f\xb4\xd6\x13\x8d\xbd\xab\x25\xd9\x62\x15\xad\xb7\xd0\x13\x8a\xc6\x0d\xe7\x07\x4c\xe3\x0d\x47\x9b\xfa\xf8\xd6\x1b\x5c\x3f\x80\x09\x1a\xaa\x9d\x2b\xfe\xaf\x6b\x87\xeb\xa0\x5d\x5e\x9e\x6d\x2c\xd2\x43\xcc\xc3\xed\xa0\xfd\x83\x12\x93\x25\xeb\x24\x4f\x0e\x31\x7b\x49\xf7\x1b\x5e\x7e\x96\xbd\xa4\x3f\x1a\x92\x1a\xfc\x2f\x1a\x76\x2d\x5d\x34\xd3\xbd\x59\xb1\xed\xd3\x3d\xe0\xda\x5c\xa9\xa4\x97\x1a\xe9\xa9\xea\xa7\xfa\xdb\x2c\xcd\xe3\xc5\xc9\xa7\xe3\xae\x7f\x8b\x0d\xcf\xe5\x5f\x49\x6c\xe2\x68\xd6\x49\xcc\xb7\xf8\x67\x17\xfa\x8a\xac\xfa\xce\x3a\xe2\xd5\xb7\xfc\x90\xfe\xcd\xbb\xf9\x20\x5e\xd6\x36\xda\x8d\x6d\xf4\x5f\x33\xcd\x0c\xd8\xc4\x73\x1c\xef\xe9\xb2\x68\x02\x2a\x27\xf9\xbf\x90\x06\x1d\x54\xe5\xe1\xd2\x3d\x4b\x0b\x16\x4f\x93\x55\xf7\xcf\x21\xcb\x17\x6d\x05\x57\xa4\x3c\x72\xea\x53\x22\xce\x61\x45\xfd\x14\x7f\x4e\x43\x83\x0d\x75\xdf\x9f\xc3\x0e\x38\xda\xe0\x0f\xb2\xf0\x22\xb2\xa1\xa7\x0e\xa7\x4c\xd4\x69\x32\x64\xf6\xd4\xea\xab\xc9\x05\x93\xbe\xae\x90\x45\xbb\xfc\x6a\x01\x66\x35\x0b\x34\xe5\xc5\x80\x29\xb2\x9e\x72\x09\xaa\x4e\xfb\x6f\x03\xf8\x36\x17\x0d\x2a\x01\x13\xfb\x0b\x77\x22\x01\x63\x0e\xa6\x48\xc5\x49\x9b\x0a\x5e\xf6\x57\x01\x66\x1a\x9f\xb0\x40\x97\x1a\x64\x32\x21\x86\x58\xc8\x00\xea\x37\xe7\x0a\x2a\xba\xb0\x08\x62\x80\x84\x68\x35\x3a\xee\xbc\x5b\xbb\x0d\xf4\xde\x77\xbf\x41\xdf\xa1\x86\x76\x1c\x2d\xcb\x1c\x41\xec\xe5\x2d\x8b\xfa\xcf\xf5\x1d\x77\x03\xb0\x0b\x94\x06\x78\x20\xee\x07\x3e\xb5\xdd\xbe\xf4\x6f\x38\x8f\x98\x60\xbb\xcd\xe5\x41\x9d\x44\xa3\xe9\x98\x05\x3c\x6b\xc6\x8d\xe6\x51\x0b\x4a\xa7\x6b\x40\xca\xc1\x63\x41\x07\x30\x2e\xb5\x50\xc4\xba\xd6\x45\xde\xdf\xf6\xa2\x96\x3c\xe3\xb6\xef\x9d\x7d\x87\x12\x51\x1a\xbe\x23\x69\x49\x6f\x55\xd3\x97\xfd\x2e\xdf\xc4\x3b\x05\xfb\x72\x6b\x66\x3b\xe1\x73\xbd\x3d\xa7\x04\xf6\xb1\x6f\xbb\xae\x67\xf0\xba\x05\x58\xe0\xcc\xfd\xd3\xf5\x9e\x5c\x34\xae\xa6\xf7\xf4\x59\x10\x32\xcd\x30\x08\x28\xea\xb2\xd0\xcb\xf5\x42\x7b\x52\x25\x5e\x09\x42\x5e\x9e\xc7\x82\x24\x89\x76\xbc\x80\xd4\xaa\x7a\x2e\x58\x5b\xd7\x6b\x13\x0c\x99\x57\xad\x38\xb7\xb2\x68\xfb\x2d\x59\xec\x44\x2e\x36\x78\x65\x48\x1e\x08\xb3\x11\x87\x98\x20\x72\x2b\xce\x37\x65\xaa\xe0\xd7\x03\x12\x90\x1c\x14\x7b\xb4\x9a\x1d\x3d\xb0\x72\xe2\xc9\x06\x15\x46\x3c\x2e\xa8\xc7\xc8\x40\xbb\x93\x40\xac\x32\x15\x91\x6b\x78\xf7\xb8\xe2\xe1\xd6\x63\xdf\xb3\x24\x8f\xd9\x22\xdd\xe4\x59\xba\xea\x6f\xcb\x5b\x15\x29\x7b\xeb\x1c\x2f\xd2\xcd\x32\xca\x7e\x16\xe7\xd7\x0d\x85\xb1\xda\x16\xf6\x0d\x66\x90\x45\xc4\x98\x51\xca\x67\xae\x80\x18\x9e\x6b\x6a\xf4\xb9\x44\xdf\x46\x36\x11\x65\x2d\x0a\xd8\x39\x73\x3b\x18\x58\x60\xa9\x43\x72\x48\x6d\x53\x1e\x2e\x4e\xcd\x09\x95\xc6\x43\x26\x8d\xa0\xea\x66\x71\x75\x3d\x7a\x91\xc1\x1c\x24\x40\x4b\x19\x8c\x8c\xa0\x57\x19\x8c\x8c\xa0\x44\x06\x23\x23\x68\x27\x83\x91\x11\xb4\x97\xf1\x86\xfd\x8b\x94\x28\x19\xeb\xed\x13\x29\x51\x32\xf6\xdb\xef\x24\x44\xb5\xa7\xe6\xee\x3f\x31\xaf\xa3\xed\xb7\xf4\x22\x5e\xa8\xe7\x39\x8a\xa3\x3d\xf3\xd2\xb8\x45\x71\x44\x48\xf0\x60\x09\x90\x88\x69\x2e\x91\xe7\xd1\x8a\xfd\x39\x88\x80\x66\xcf\x75\x40\xfb\xc6\x82\x41\x59\xe5\x4f\x02\x59\xdc\xdc\xf3\x6f\x72\x6a\x48\xa0\xcc\x20\x84\xa3\x8a\x66\x1e\x95\xec\x6f\xb2\x13\x24\xcc\x7a\x4d\x47\xb7\xe2\x4d\x50\xf9\x21\x68\x3c\x15\x19\x42\x70\x18\x7b\x57\xd3\x43\xc8\x6b\xe2\xfa\x02\x77\x9f\x3c\x3e\x80\xc6\xad\x15\xe7\x3d\xd0\x4f\x51\x72\x84\x43\x00\x41\xf2\xb6\x89\x56\x5d\x90\xb6\x09\xa2\x31\x94\xcf\x07\xfa\xfb\x68\x7e\x1f\xcd\xef\xa3\xf9\xff\xd0\x68\x96\x5a\xee\x15\x64\x74\x69\x6a\x5e\xbc\xd3\xaf\x35\x07\x65\x40\xb9\x3d\x13\xa9\x90\x22\x67\xa1\x3b\xe7\x58\xe1\xa9\x10\x86\x6c\xc2\x0b\x22\x03\x86\xe8\x29\x75\xf7\xbb\xd7\x1b\xc6\x92\xca\x9e\x70\x8e\xfe\x07\xd2\x7b\xfc\xa7\x0d\x83\x73\x45\x1e\x94\x97\xc7\x57\x5f\xf5\x34\xdc\x51\x8a\x7f\xf1\xda\xac\x8c\xc8\xde\xdd\xe5\x62\x55\x2b\xae\x9e\xaa\x4a\x78\x10\x2b\xcf\x76\x71\x20\x14\xab\xaa\xb9\x02\x30\xda\x82\xc7\xca\x20\x00\x45\x12\xd2\x6b\x72\x54\xb8\x37\xa0\xf5\xe7\x80\x32\x04\xa3\xdc\x83\xc0\xc2\x65\xea\x4c\xe8\x28\x94\xe6\x38\xd5\x56\x47\x87\xd5\xee\xae\x5a\x11\x10\x9e\x33\x5c\x77\x08\x02\xe3\xdb\x3e\xe1\x79\x7b\xaf\x06\xcf\xb7\x77\x2a\xda\x17\x78\x35\xc3\x92\xdc\x4f\xb6\xf1\x2a\xd9\xc4\x92\x1e\x01\x5a\xe9\x8b\x5d\x4c\x43\x20\x68\x2f\x63\xc5\x1b\x39\x58\xde\x80\xd9\x9b\xd7\xd4\x49\xdf\xe4\xc0\xb0\xe3\x0f\x7b\xd7\x80\x41\x40\x15\xa4\xda\x3d\x25\x08\x50\x79\x07\x31\x78\x95\xc1\x1c\x24\x40\x32\xca\x25\x07\x3c\x68\x28\xd1\xa2\xe1\xeb\x41\x02\x24\xd1\xa2\x61\x22\x21\x68\xf4\x2a\x83\x91\x11\x24\xd1\xa2\x51\x22\x21\xe8\x41\xa2\x45\x0f\xaf\x32\x82\x24\x5a\xf4\x90\x48\x08\x9a\x46\x79\x96\xfc\x90\xf2\xa3\x02\x2a\xd5\x61\x05\x14\x68\x99\xd9\xae\x9a\x45\x83\x3c\x7a\x83\xcd\x85\xf3\x68\x95\x2c\xa3\x8b\xe9\x17\x02\x3d\xd7\x14\x58\x46\xf4\x1c\x34\x94\x01\x8d\x64\x40\x0f\x32\x20\x68\xe9\xcd\x73\xd4\x50\x0a\x35\x92\x42\x3d\x48\xa1\x4a\x5f\xee\xe3\x92\xa3\x3e\x43\x61\xf8\xe3\x41\x1e\xfc\xd0\x6b\x10\xf6\x93\x7c\x69\x30\x65\x9f\x2a\xbb\x6d\xbc\xd8\xaf\xa2\x4c\xc9\x3d\x6f\x51\x04\x87\x57\xce\xa9\xee\x7c\x79\x70\x06\x05\x25\x3b\x0c\x6c\xd7\x72\x48\x09\x03\x90\x8b\xcd\x6c\x48\x58\xe0\x13\x63\xe6\x68\xb4\x1d\xd9\xdb\x4e\x62\x5b\xc9\x5e\x8f\x99\x6e\xd8\x6b\x9c\x2f\xbe\xdd\xe5\x9b\xab\xf9\x41\x60\xda\x81\xa1\x51\x33\xe8\x8a\xfc\x6b\x6b\x1c\xf6\x4b\x47\x85\x4c\x15\x06\x65\x9b\x74\xc3\x16\xe9\xb7\x38\x8b\x37\xb9\x22\xeb\x5e\xc9\x57\x34\xe1\x32\xf5\x28\xcb\x12\xd0\x0d\xdd\x6d\xab\xaa\x93\x25\x61\xcd\xb7\x2c\xdd\x6f\xd9\x21\xbd\x48\x67\xd9\xb0\x97\x04\xeb\x64\x1d\xbd\xc5\x6c\x95\xd6\x21\x9f\x79\xdc\x3c\x88\x54\x27\x61\x97\x5f\x5e\x6c\x7e\xb8\xec\x86\xab\x0e\xcd\x63\xb5\x8b\x3c\xbc\x33\x17\xf6\x7d\xcc\x11\x86\x37\xd5\x6d\x9e\x9d\xe2\x14\xaa\xb9\xe6\x71\x7c\x78\xb3\xd0\x9f\x81\xf8\x15\x2c\xaa\x0f\x66\x57\x9b\x12\x34\xc8\x21\x73\xe2\xe0\x51\xda\x33\xa1\xc4\x94\xc3\xa1\x51\xe0\x18\xbf\x42\xd8\x00\xae\x17\xe8\xd5\x7a\x41\x3a\x42\x71\xa5\xb0\xca\x15\x05\xbd\x31\xd3\x11\x1d\x07\x4d\x4b\x50\x1b\xa3\x3b\x48\xa7\x0d\x30\xc4\x02\x84\xf6\xbc\x1e\x18\x5a\x0e\x2e\x1e\xbf\x15\x06\x96\xe9\x86\x28\xef\x70\x43\x94\x87\xb8\x21\xca\x4b\xdc\x10\xef\x29\x6e\x88\xf4\x96\x1a\x50\x06\xef\x61\x20\x18\xaf\x71\x43\x09\xcf\x71\x43\x69\xef\x71\xc3\x76\x57\xe8\x01\x85\xca\xae\x1f\x00\x20\xdd\xa9\x05\x37\x94\xc4\x8d\x24\x71\x70\x4f\x6b\x01\x03\x5d\xae\x05\x09\xf6\xbd\x16\x2c\xc2\x09\x5b\xd0\xc3\x5e\x68\xb4\x5b\xb6\x6b\x80\xf3\xcf\xdb\x3c\xa0\xda\x1c\xb7\x26\x1d\x9b\xeb\x16\xcc\xf9\x9a\x0b\x2e\x2b\xdf\x56\xb9\x56\xd7\x28\xb5\xc1\xf7\x0c\xc4\x21\xe2\xbb\x40\x06\x3b\x73\x6d\xae\x29\x12\x55\x3d\x1f\x9e\x90\xd0\xf8\x8c\xc4\x96\x5f\x2f\x85\xb5\xca\x9d\x09\x8e\x83\xe1\x4d\xa7\x7c\xc3\x87\x43\x15\x89\x5c\xca\xf1\x24\xd7\xdc\x99\x6f\x6a\x21\x41\xca\x2d\x25\x4a\x61\x4f\x63\xc7\x71\xc8\x63\xc2\x9a\x09\x21\xa6\xce\xd3\xe6\xe0\x18\x68\xa1\x37\xb5\x0d\x66\x78\x33\x37\x44\x4b\xe7\x37\x5f\x27\x88\xf6\xd1\xd3\xfc\x0e\xe3\x97\x4a\x36\xff\xc2\x28\x33\xd4\x42\x30\x45\xe9\x82\xbb\x7e\xb1\x25\xeb\xed\x2a\x59\x24\x39\x7f\x11\x70\x88\x33\x4e\x77\xe3\xb5\x90\x8c\x90\x22\x1b\x2a\x5b\x27\x3f\xa0\x5f\xd4\x32\x52\x52\xf6\xb2\x4a\x17\x7f\xab\xd7\xbf\x38\x12\x10\x55\xbd\x73\xf6\xb6\x4a\x5f\xa2\x15\x4b\x36\x49\x9e\x44\xab\xe4\x57\x9c\xa9\x17\xb8\xe5\xcf\x73\xd8\x2a\x5d\x44\x2b\xf1\x2d\x7a\x99\xb6\xa2\x21\x03\xfa\x41\x5a\x4e\x4f\xc5\x6c\xc1\x1f\x92\x3a\x2c\x08\x3d\x91\xb2\x6a\xa2\x05\x65\x32\x9d\xdb\x23\xe0\x06\x2b\x20\x97\x2b\x1c\xee\xe3\xec\x2d\x66\x1d\x2a\xb5\x6b\xfd\xf5\xae\xc4\xb4\x1d\xdc\x80\x8c\x3a\x72\x79\xdb\x6e\x30\x9b\x4c\x6c\xc3\x3e\x39\x6b\xeb\x10\x04\x99\xa2\x4e\x8e\x97\xc4\x9b\x32\x87\xfb\x6c\x99\xc5\x3b\x48\x7e\x81\x2e\xce\xad\x38\x57\xc1\xc6\x58\xc5\xd1\x25\x7a\x9f\xdc\xc7\x97\x8a\x5c\x02\x3b\x96\x2c\xe3\x4d\x9e\x2c\x2e\x9e\x82\x2a\x99\xd2\xca\xbc\x2a\x6c\x95\x2e\xaf\xfb\x29\x96\x77\xfa\x9d\xbd\xee\x37\x8b\xe6\xcc\xd5\x93\x65\x61\x93\x6c\xd7\x7f\x4c\x55\x7b\x12\xee\xb6\x1a\x25\xd8\x22\xf8\x15\x6c\x32\x73\x21\x81\xb4\x15\x39\x25\x13\x5e\x38\xa0\xe2\x72\x1b\x58\x6c\x9f\x29\xdf\x87\x97\xf5\xfe\xd5\x3b\x5b\x76\xcc\xaf\x53\xe5\x6a\xbd\xd2\x6b\x10\xe3\x06\x9f\x35\x4a\xcc\xba\x99\xbe\xe6\x90\x30\x24\xca\x55\xdf\x46\xd9\x2e\xae\x54\x57\xe7\x12\x81\xaf\xd1\x00\xd2\x39\x73\x9b\x86\x33\x8d\xa7\xde\xac\x26\xcd\x22\xef\xea\x6d\x24\xaf\x18\x5b\x8a\x11\x07\xa0\x90\xef\x23\x8e\xb9\x90\x18\x48\xa9\xf9\x45\x0a\xf5\x2c\x85\xfa\x0a\x40\x55\x96\xaf\xbe\x99\x81\xe4\x23\xf3\x06\xa1\x58\xea\x0a\x33\x57\x18\xcc\x22\x59\x20\x47\x66\x0f\x70\xf9\xb8\xb2\xc4\x8b\xe3\x67\x48\x97\x35\x94\x9e\xcc\x1c\xa7\x64\x55\x1c\x0c\xd8\xfe\x54\xf3\x03\x06\x59\xb4\xc2\xf8\x87\x1f\xbd\xc5\x46\xba\x5e\x27\xf9\x3a\xde\xe4\x77\x1d\x85\xb7\xa3\x92\x51\xbc\x8b\xb8\x52\xb6\xe0\x0b\x2f\xcb\xa3\xb7\x06\x77\xec\x08\xaf\x9e\x20\x84\x9a\xd5\xfd\x5d\xd5\x0e\x31\x1c\x51\x4f\x59\xb3\x10\xa1\xbc\x41\xa1\xbe\xd8\x36\x84\xd1\x9b\x6a\xc3\x97\xc6\xc9\xbf\xa7\x6c\x97\x2c\x63\x55\xb6\x21\x41\xc8\xc2\x27\x8f\x05\xb0\x27\xbd\x65\x00\x69\x05\x9f\x68\x06\xcc\x38\x65\xe8\x64\xd1\x88\x49\xb4\x88\xef\x64\x9e\xef\x59\xb4\xed\x69\x1a\xdb\x35\x28\x7b\xa2\x9a\x0f\x30\x87\x49\x3a\x68\x7b\xb7\x67\xff\x52\x8e\x31\x74\x6b\x9a\xa6\xe7\xb5\x93\xf7\x2f\xe2\xac\x00\x5c\xeb\xbd\x02\x0c\x4d\xc5\xed\x3a\xab\x32\xb2\xe5\x2f\x49\xd9\x2e\xf9\x75\x63\x39\x97\x16\xd0\x5a\xbd\xa4\xc1\x1c\xe2\x12\xbe\x16\x1a\x9f\x41\xc7\x9a\x82\x52\xa4\xbd\xb3\x0d\x10\x20\xe4\xf9\x44\xaa\x68\x92\xe2\x2e\x16\x0d\xb7\x88\x0b\xdf\xd1\x96\xf4\x81\xaf\x19\x5d\x71\xcf\x1d\x90\xf2\xec\xd5\xa3\x26\xa1\x18\x9c\x78\xf0\x0f\x55\xd0\x0e\x3c\x1e\x97\x0d\x69\x7b\x91\xe6\xf0\x36\xdd\x84\xf2\x59\xcb\x73\x35\x87\x79\xa6\x89\x03\x90\x39\x71\x01\x08\xbe\xf5\x40\x77\x3d\x07\xd5\x46\x82\xde\x8c\xd7\xa0\xca\x67\xaa\x33\xe9\xee\x1a\x1b\xd7\xb8\x10\xbe\xbe\x15\x69\x62\xfa\x31\xaa\xd4\xa9\x36\x31\xf8\x50\x85\xa6\x3e\x3d\x39\x35\xc6\x94\x5c\xab\x8a\x3e\xed\x69\x11\x2f\xd4\x7a\xaa\x71\x62\x95\x5e\x7c\x9a\x3e\xa3\x3b\x9e\xf1\x67\x5f\x7f\xc1\x33\xa9\xd4\xb0\x5d\x25\x26\x91\x64\x53\x9f\x52\xb5\x9a\xa6\x27\xbb\x16\x3b\xa1\x39\x9e\xe9\xd5\xbc\x61\x00\x97\xb4\x6c\xb3\x58\x7f\x66\xed\x6a\xa9\xd1\x47\x4a\x91\x7a\x96\xe0\xb6\x96\xd5\x43\x9e\x49\xa5\x46\x79\x02\x5a\x1d\x79\xf6\x1a\x60\xb2\xbc\x8e\x59\x9e\x29\x09\x42\x8d\x86\x6c\xe2\xd1\x7a\x0f\x13\xcc\x7c\xdf\xa3\x21\xb9\xf5\x35\x6d\x07\xcc\x27\x25\x0c\x20\x94\x92\x09\xa1\xfc\x0d\xa9\xc9\xf4\xe7\x56\x9b\x48\xf1\xb8\x34\xc7\x6d\x36\x72\xc2\x95\x8a\x83\x5d\xfc\x75\x88\xec\x02\x37\xf7\xe9\x7e\x94\x2f\xbe\x75\xbe\x65\xea\xbf\x53\x97\xfb\xfc\x38\x6f\xa2\xc8\x60\x06\x29\xad\x04\x4e\x75\x26\x08\x41\xe5\x94\x0a\x4a\x48\x21\x25\x67\x36\xb5\x5d\x5e\x8d\xe1\x01\x43\x3c\xc6\x10\x0f\x86\x28\x6a\xa4\xd6\x60\x33\xd7\x98\xc7\x22\xb9\x1c\x4a\x2b\x44\x42\xba\x1a\x33\x18\xc2\x7d\xe0\x02\x84\x36\x1a\xbc\xcb\x79\x0e\x32\x37\xb0\xc3\x67\x0c\xed\x03\x86\x78\x8c\x21\x1e\x0c\x51\xd4\x90\x16\x52\x4b\x87\x30\xa5\x96\x0e\x69\x16\xb5\xf4\x4f\x30\x32\x48\xbb\xa9\xa5\x0f\x20\xe5\xc3\x39\x1d\xb0\x11\xa0\x5e\xe7\xc9\x64\x81\xfc\x40\x1e\x2b\xac\xa2\x41\xd2\x9d\x73\x8e\x70\xcb\x80\x95\x84\x5a\x07\x36\x28\xaa\x4f\x2e\x7e\x9d\x03\x3c\xfd\xae\x20\x16\x25\xc4\xc5\x82\x74\x9e\x26\x01\x89\x11\x43\x1c\x0b\xaa\xe7\x08\x2c\xb0\x1e\x72\x50\x20\x25\xbe\xa3\x19\x10\x4a\x9f\x7a\x5f\x8e\x97\x05\x03\x13\x0d\x01\x05\x75\x56\xc4\xa1\xe7\x31\x47\xa3\x97\xd7\xf0\x8a\xf6\x09\x23\xb3\xe7\x4e\x41\xd4\xa4\x0b\xfe\xb4\xfd\x22\x16\x11\xb4\xd1\xe5\x10\x41\xcd\x3e\x43\x2b\xaf\xcd\x5c\x09\x41\x33\x57\x46\x54\x65\xfa\x11\xbe\x6b\x47\x26\x82\x3f\xb4\x38\x5b\x45\x2f\xce\xb6\x21\x3b\x5b\xfe\x89\x04\xb9\x0f\x6b\x39\x40\x16\xc7\xcd\x23\xec\x71\xf3\xc8\xbc\x8f\x73\xb2\x28\xcb\xa2\x9f\x3d\x1d\xb4\x32\x03\x22\x50\xf8\xbc\x53\x11\xc0\xe6\x15\x29\x5a\xd6\x10\x2f\xab\x8a\xc5\x1d\xc8\x43\x11\x52\xb9\x6f\x49\x5e\x97\x9e\x86\x57\x14\xbe\x7f\x23\xc8\xe2\x4a\x90\x51\x19\x3d\xe9\x44\x3f\xe3\xec\x4e\xbe\xf7\x92\x66\x3c\xc0\xa7\xad\x98\x97\xc4\x55\x44\xd5\x4e\xbd\x38\x86\x6f\x7d\x1c\xda\x66\x33\x51\xe1\x2b\xf4\x2a\x1c\xc4\x4c\xe2\x2e\xb9\xfc\xba\xb4\x93\x03\x1a\x02\x7c\x4b\x6d\xc5\xb9\x84\xa4\x26\x0a\x28\x2c\x28\x42\x8a\xb0\xd2\x2e\x60\xf0\xb6\x49\x4a\x6c\x43\x5e\x0a\x55\xe6\xa3\x62\x30\x28\xf4\x4e\xe8\x43\x8c\x06\xa0\x9a\x48\x6e\x03\xf9\xfc\xd1\x00\x03\x77\x68\xcd\x49\xab\x44\x23\x80\x05\xc0\xd4\x42\x4d\x1c\xce\x1d\x59\xa1\x79\x94\x15\xee\x34\xc7\xb6\x5c\x60\x8e\xc4\x2a\x46\x0c\xac\x36\xbf\x7c\x43\x83\xce\x1e\x87\xe0\x45\xca\xbe\x04\x93\xd3\x0f\x89\x6d\xef\x03\x34\x0e\xb3\x1d\xd2\xc5\xf8\x42\x11\xd3\x68\xf3\x16\xdf\x75\xb8\xab\xa9\x7c\xdd\xb0\xca\x6d\x33\xfe\x37\x46\xee\x95\x17\x4b\xd0\xfe\x57\xd5\x9b\x8b\x74\xbd\xcd\xe2\x1d\x27\x60\xd1\x2e\x5f\xb0\x65\xbc\x48\x97\x31\x5b\xa7\xcb\x58\xdd\x94\xae\x05\xa1\xc1\x4c\x62\xf0\x6b\x7c\x9f\x12\xc3\x0e\x5a\x6a\x8b\xdc\xa3\x49\x2f\xdb\x7c\xd1\xbf\x19\x27\x95\xe2\xf8\x51\x0c\xd3\xfd\xd0\x60\x33\x60\x01\xa0\xf6\x32\x73\xb2\x2c\x38\x07\x81\x2d\xa7\xb0\x89\xe3\x69\xa1\x24\x8b\x99\x7b\x8d\xc9\x3d\xba\x63\xf9\x23\x1f\xa8\xee\x0e\x16\x8c\xb8\x73\x7d\x09\x91\x85\x03\x45\x4f\x76\x62\xef\xd1\xfa\x55\x74\xe1\x8c\x3d\x9a\x7e\x3c\x96\x72\xb4\xd0\x40\x36\xbe\xec\xf8\x5e\x3c\x8e\xe0\xc2\xa5\x39\x8b\x21\x8e\xc5\x85\x1a\x57\x38\xdd\xa3\x47\xb2\xb7\x7c\x71\x33\x0c\xb5\x91\xe7\xe4\x03\xb4\x71\xfc\x20\x94\x5a\xb2\x5d\x23\x89\xe6\xb0\xe2\x38\x95\x5a\x2d\x46\x04\x8b\xbe\xc2\xa3\xad\x23\x1a\x36\x3a\xb7\xe0\xbb\x99\x2a\x33\xf5\xf5\xd7\xdd\x28\x5f\x40\x5f\x2d\x36\xba\xe4\xc3\xbf\x3e\xa5\x22\xb0\xa3\x1e\xd8\x4f\x90\x8e\xe8\xe3\xaf\xff\xa8\xa2\x2a\x3c\x86\xed\xb2\xb7\x97\x86\xdb\xb4\x3b\x0b\xb4\x1d\x41\x0f\x27\x39\xd9\x04\xa9\xe3\x30\xea\xcd\xe1\x7e\xbd\xb1\x7f\x89\xd9\xba\x77\x64\x79\x59\x96\x71\x0a\x0a\x2d\xa7\x64\xe2\x10\x11\xb7\x0a\x04\x54\x1b\xf5\x3a\x99\x04\xfe\x83\x4a\x02\x5a\x43\x7c\x2f\x28\x82\x8e\xbe\xc8\xa0\x5d\x62\x69\xf2\xe8\x5a\xf6\xb3\x0c\xba\x96\xfd\xdc\x4b\xf6\x57\x19\x74\x2d\xfb\x2b\xfa\x88\xbf\xe6\x71\x1b\xc8\x3f\xb9\x6b\xf2\x0a\xdf\xf6\xd1\xad\x7a\xc0\xb4\x5e\xbc\x48\xcc\x5b\x17\x6d\x96\xbd\xa1\x40\x33\xa8\xce\xbe\x54\x01\xdb\xdf\x75\xde\x38\xb6\x43\x0b\x3f\x3b\x1e\x93\xe6\x22\x99\x9c\xe6\x78\x24\xd7\x4f\x6f\x08\x0f\x55\x0e\x1b\x6f\x0e\x2c\x5a\xaa\xca\x00\x78\xca\x75\x91\xae\x5f\x92\x4d\xdf\x38\xba\x32\xba\x18\x60\xc2\x8a\x12\x56\x94\xb4\xa2\x86\xd6\x25\xe5\x5c\x8b\x1a\xc3\xb7\x69\x35\xb3\xde\x67\xdf\x26\xe6\x81\x16\xd4\xf7\x1c\x2d\x24\x00\x6a\xc3\x73\x83\x50\x03\x9d\x97\xf3\x68\xd8\x63\x09\x49\x08\x3d\x99\xdb\xde\x0c\x72\x11\x19\x78\x33\x6a\x90\x8f\x40\x53\x17\xd4\x03\x14\xf5\x10\x45\xfd\x11\xdc\x8d\x95\x2e\x38\xfa\x21\x98\xde\xf3\x09\xd5\x5c\x13\x6a\x9a\x92\x7c\x80\x23\x1f\xe2\xc8\xe1\xd6\x29\x01\x03\x2c\xa0\xc3\x3e\x2a\xe7\xa8\x65\x9a\x8f\x7a\x4e\x25\xa6\x17\x8e\x80\x96\xab\x48\xef\xd6\xa4\xd7\x64\x95\xc7\x19\x8b\x36\xc9\x2e\xcd\xb3\x74\x9b\x28\x3e\xca\xa9\x96\x7f\xbe\x01\xd2\x5c\x3b\xf0\x42\xea\xf9\xcf\xc8\x4b\x8a\xeb\xe0\x36\x53\x34\x74\x3c\x6f\xc1\xef\xa3\x66\x8f\x1e\x5b\x27\x9b\x75\x04\xce\xbe\xd4\xb0\x07\xcc\x18\x94\x98\xb3\xf2\xfb\x07\xf6\x74\xf0\x49\x04\x66\x11\x93\x69\x73\x02\xcd\xfa\x23\xdb\x53\xff\x88\x72\x52\xfd\x23\x92\x19\x33\xdd\xa2\xda\x78\x3c\x1e\x37\x7a\xa8\xd1\x2f\x3d\x04\x88\xe2\xdf\xe9\x21\xce\xb2\xcb\xc7\xe8\x0d\x29\x17\x0d\xbb\x62\xd7\xf2\x5a\x4d\x1c\x30\x78\x73\x42\xdb\x2a\x4d\xab\x32\x55\x99\x46\xac\xa1\x3c\x56\x73\x7e\x70\x35\x1a\xce\xec\xa6\x96\xed\xa4\x40\x4a\xb1\x8e\x00\x69\xeb\x10\x55\x20\x7d\x7d\x52\x8f\xa5\x47\x69\xc5\xcd\x32\x78\x84\x92\x02\x29\xcb\xf0\x7e\x9c\x59\x06\x8f\xb8\x66\x62\xe9\xcb\xa7\x0a\x30\x14\x37\xcb\x18\x4a\x09\x23\x14\xe2\xc7\x38\x9b\x8c\x71\x4d\x44\x92\x63\x54\xe2\x06\x19\x0d\x81\x94\xa3\x21\x62\xf0\xa0\x0c\x32\x1a\xa2\x5a\x38\x1a\x4a\x8d\x1c\x08\x88\x1b\x64\xf0\x08\xa4\x1c\x3c\x22\x86\x0d\xca\x20\x83\x47\x54\x0b\x07\x8f\x52\x63\x06\x02\xe2\x06\x19\x03\x09\x41\x74\x42\xf6\x18\x65\x8d\x31\xaa\x75\x63\x99\xd1\x02\xc1\xf0\x9b\x32\xde\x43\x16\x28\xea\xa5\xb8\xff\x83\xd3\x8b\xd7\x18\x70\x72\x61\x46\x04\x7b\xbe\xa4\xa3\xa8\x35\x04\xb9\x6e\x51\x1c\x35\x86\xf9\xb1\xab\x64\x30\x12\x76\x3a\x92\x77\xee\x2e\x5b\x82\x8c\xb0\x21\xa6\x67\x10\x78\x40\xeb\x3f\x17\xac\x2b\x52\x29\x19\xbc\x7e\x8f\x9d\x20\xe9\xf7\xc9\x9d\x36\x8d\xab\x74\xc9\x5e\x92\x68\xd7\x73\xd7\x78\xfa\xe1\xe6\x78\x26\xd3\x6d\x0d\x72\xe2\x54\x6f\x93\x6d\x87\xe7\x19\xae\x5e\x1d\xc3\x81\x5d\xb2\x54\x99\x67\x9d\x64\x59\xda\x1e\xec\x8e\x36\x91\x4d\xa9\x47\xcb\xc8\xf5\xdb\x2d\x3c\x23\x0f\x3d\x46\x4c\x8b\x48\xc0\xda\xe3\xe3\xef\x61\x1e\x96\xa7\x2c\x5e\x2a\xc9\xc4\x0b\x68\xba\xaa\x16\x6c\x78\x1d\xb7\xc7\xfe\x3a\x53\xe0\x7b\xcd\xc1\xa3\xca\x07\x8e\x88\x77\xa2\x83\x47\x16\xb4\xc5\xf6\xa9\x32\xa4\xd2\xe0\x5b\x9f\xda\x1e\x85\x3d\x66\xae\x20\x94\xf0\x94\x6f\x6e\x88\x80\x0c\xcc\xfa\x1e\x0d\x0e\x1a\xca\x80\x46\xd7\x41\xcd\x89\x5f\xcb\xaa\x34\xe8\x3b\x1a\xf3\xec\x78\x9b\x1c\x02\xe3\x39\xd4\x4b\x1c\x84\xbc\x28\xac\x5b\x09\x82\x20\xac\x78\x83\x21\xb7\x77\x25\x35\x84\xd8\xcf\x92\x34\x4b\xf2\xe4\x57\x97\x46\xaa\x1c\x75\x1b\x67\xf9\x3e\x7b\x11\x23\x3f\x5a\xf5\x74\x58\x9f\xd0\x70\x46\x75\x84\x2b\x94\x41\x13\x10\x93\x94\x86\x70\x85\xa2\x77\xb2\xc6\xff\xf6\x71\xf6\x53\x6d\x02\xe5\x8a\x75\xf6\x76\x9d\x27\xc4\xbe\xb0\x32\x59\x14\x32\x16\x29\xec\xb9\xfa\x98\xdd\xc9\xd2\x3b\x6a\xe9\x0d\x83\x60\xad\x11\xc0\xae\x39\x02\x60\xd6\x82\x93\x90\x23\x28\x53\x70\xc2\x8c\xa0\xf9\xc1\x80\x81\xc8\xc9\xc1\x49\xc0\x07\x67\xe1\x11\xd0\x96\x9f\xe2\x30\xed\x69\xc7\xc9\x48\x7d\x0f\x9e\x03\x07\xcf\xf1\x71\xcc\xe8\xf8\x2e\x77\x45\x01\x1d\x43\xd4\x6e\xf0\x3c\x97\x28\xcf\x56\xde\x1a\xd6\xbd\xcc\x01\x9b\x8e\xf1\xf6\xb8\xef\x34\x5f\xbe\x58\xba\x8b\x4d\xaa\x7d\x04\x9f\x8f\xab\xf7\x4b\x8d\xa6\xb4\xa0\xc0\x84\x22\x63\x45\x3b\xb5\x0a\xcb\xff\x5e\xda\x4b\xf5\x6f\x51\xcb\xe1\x72\xc7\xd4\xd9\xbd\x92\x52\x78\x61\x83\xf8\xc7\x36\xdd\x5c\x16\xda\xfd\x70\xd9\x03\x57\xcd\x42\x2d\xfd\x0f\x46\x3e\x01\x0c\x78\x16\xb0\xf6\x89\xfd\x21\x7e\x94\xcc\x01\xd0\xba\x6b\x8b\xf2\x09\xf7\x0c\xc7\xdc\xf1\xbd\x7b\x4f\x9b\x94\xab\x05\xdf\x8e\xdf\x68\xd8\x71\x65\xc5\x51\xc3\x25\xd4\xe7\xf2\x20\xea\x72\x6f\x84\x52\x06\x4d\x8e\x10\x72\xbc\x55\x00\x91\x97\x57\x25\x38\x85\x24\xe8\x31\x72\xea\x26\x00\xe9\x85\x83\x43\xe8\x2c\x20\x99\x0e\xa5\x6b\x71\x28\x65\xa3\xaa\xb5\x92\x96\xda\x55\xcb\x9e\x4e\x67\xa1\xa6\x3b\x75\x64\xc4\xed\x79\x85\xdf\x1f\x8c\x86\x13\xc0\x0c\x24\x6e\x54\x27\xf0\x1b\x55\x08\x69\xed\x51\x48\x72\x8c\x0c\xde\xc2\xc1\x23\x90\x12\x46\x58\x3a\x3f\x4a\x65\x24\x39\x46\x06\xbf\xa0\x1a\x03\xe8\x28\x88\xc8\x02\x51\x41\x6d\x0f\xa4\x83\x35\x94\x5a\x5d\x74\x2d\x87\x3b\x65\x09\x2e\x78\x15\x83\xb2\x26\x1b\x92\x1e\x94\x85\xaa\x31\x9a\xcf\xc7\xba\xda\x01\x5d\x2e\x2d\x08\x57\x43\x12\x83\x05\xfc\xf7\xa7\x96\xdf\x6c\x1e\xe8\x3d\x28\x7f\xa3\x51\xd4\x45\xcf\x0f\x09\xb0\x3a\x9d\x60\x86\x12\x98\x91\x79\xaf\x8d\x76\xc1\xff\xf4\xf9\x66\x63\x7b\xd0\xbe\x29\xb8\xda\xbb\xbc\x14\x58\x30\xa3\xa2\xd6\x4e\x75\x86\xc3\x23\x4f\x27\xf6\x17\xfe\x1e\x55\x0b\x41\x39\x0c\xaf\x73\x60\x03\xdd\x37\xfa\x73\x19\x2a\xe1\x32\x52\xc2\xe5\x41\x09\x97\x4f\x4a\xb8\x3c\x2a\xe1\xf2\xff\x94\x70\x19\x2b\xe1\xf2\x87\x12\x2e\x83\x8f\x6a\xd8\xa8\xf1\xde\x41\xa7\xfb\xb6\x4c\x27\xe5\x54\xa2\xe5\x79\x96\xbc\xec\x86\xa6\x14\xec\x7e\x13\xd1\xf7\xe4\xd7\xaf\x55\xf3\xdb\x04\x3b\xf3\x54\x9b\x96\xe0\xc9\xfe\xfa\xd5\x21\x0c\x12\xbe\xd4\xc4\x58\x12\x18\x5d\x02\xa3\x49\x60\xee\xf9\xb4\x25\xff\xb9\x8d\xd9\x90\x0d\x3e\x96\x7f\x94\xcc\xfb\x2f\x05\x67\xc7\x4d\xe7\xcc\xef\xd5\x8e\x43\x12\x7f\xef\xaf\x78\x65\xf9\xb9\x4d\x9e\xd8\xd4\x86\xd7\x3b\x3a\x03\xf2\x95\x10\x5c\x41\xf3\x52\x24\xaf\xc7\x28\x25\xb2\x3b\x33\x69\xcb\x08\xe7\xe3\x6f\x9e\xc4\xdf\x55\xf7\x47\xb2\x8e\xb3\xe2\xfa\xb9\xd1\x1d\xe8\xbe\xb0\xa7\x84\x11\x47\xf3\x03\x62\x82\x1a\x65\xc5\xf9\x5f\x5c\xae\x27\x42\x56\x92\xc7\x87\x03\x1e\xb5\x6f\x81\xf5\x36\x49\x16\x6d\x76\xfc\x3d\x0a\x7b\x8d\xe3\xe5\x4b\xb4\xf8\xbb\xaf\x65\xa8\xe6\x06\xfc\xa0\x85\x4d\x08\x31\x75\x9e\x5e\xba\x4c\xf7\xd6\xd6\x60\x28\xb6\xa8\xc1\xd2\x8b\x43\xcb\xd9\x30\x8a\x01\x3c\x02\x87\x9f\xec\x51\x87\x68\x73\xfe\x8e\x2a\x0c\xa9\xad\x43\xc6\x5a\x40\x7c\x4d\xac\xc4\x70\x48\x5d\xa5\x46\xd4\x3f\x23\x1c\x6d\xca\xb5\xf1\x84\xd3\x13\xb5\xc3\x10\x54\xb1\x8d\x6a\x41\x48\xa8\xfd\x95\x50\x66\xda\x81\xa1\x51\x88\x70\x11\xb5\x79\xa9\xc0\xa9\xcd\xf0\x55\x9e\x2e\xf9\x49\x58\xf3\x16\x27\x94\x5a\x2d\x8c\xe6\x1a\x7d\xb6\x5d\x2b\xe8\xe5\x85\x9d\xc1\xcc\x50\xf1\xc2\x60\x0e\x71\xad\xf0\x33\x68\xe2\xd2\xe3\xb7\x64\x13\x56\xb3\xc4\xa4\x9c\x24\x20\x48\xb2\x59\x4a\xe1\x78\x48\x59\x77\xfe\xce\x1b\x10\xef\xf5\x75\x17\x83\x23\xd7\x0a\x31\x7a\xb4\x03\x49\xb9\x68\xcd\x3c\xca\x7e\x26\x9b\x37\x50\x60\x1a\x4f\xb6\xdc\x81\x57\x3c\xa5\xef\x37\xdb\x68\xf1\x37\xdb\xed\x5f\x92\xf5\xe5\xd9\xba\xd4\x8e\x49\xa4\xee\xa7\xde\xd3\x35\xc7\x69\xc5\x88\xab\x5d\xea\x3d\x05\x48\x88\x6f\x7f\x69\xd9\xa1\xf4\xb5\xcc\x21\xce\xf2\xf8\x87\x92\x4c\xf6\x65\x21\x4f\x68\x52\x8e\x32\x02\x0f\x4a\x5e\x24\x5a\x80\x52\x17\x35\xd4\xa1\xd4\xd5\xd6\xcc\xf0\x3c\x0a\xcf\x35\x2f\x42\xc1\x27\x8e\x66\x81\x11\x67\x16\x6a\xbb\x99\xbd\x85\x09\x9f\x7d\x34\x26\x08\xdb\x5e\xbb\xde\x42\x89\xa2\x79\xd8\x5e\x04\xaa\x77\x86\x01\xab\x77\x86\x82\xaa\x77\xea\x35\x40\x83\x9f\x42\x80\x0d\x3a\x93\x02\x6d\xcf\x29\x08\xda\x9c\x53\xb7\x06\xea\x76\x0a\x01\xdb\xfa\x14\x04\xd5\xad\x6d\x10\x01\x4d\xde\x06\x05\x36\xaf\x55\x2a\xb4\x99\x6d\x60\x68\x73\x9b\xa3\x1f\x6c\xdb\x26\x10\x2a\xf0\x6c\x78\x8a\xe2\xc7\x84\x62\x87\x0d\x1c\x66\x78\x8e\x47\xd9\xff\x67\xef\xea\xb6\x1b\x55\x72\xf5\xbb\xe4\x05\x76\xdb\x49\xf7\x64\x2e\x31\x94\x6d\xa6\x31\xb0\xf9\x71\x92\x7d\x53\x8b\xd8\x95\x84\xd5\x04\xfb\x00\x4e\x27\xe7\xe9\xcf\x52\x81\x1d\x1b\x63\x23\x15\x64\xce\xec\x59\x5e\x7d\xe5\x8e\x3e\xa9\x4a\x52\x55\x89\xfa\x91\xa8\xa8\x7d\xbf\xc1\xa3\x9a\xcc\x80\x47\xd7\xf5\x79\x0e\x59\x8f\x40\x34\x58\xf6\x58\x22\x5e\x91\x57\xfb\xe5\x2b\x30\x17\xea\x8b\xe3\xd2\xae\x1b\x59\xf4\x5b\xca\x40\xc5\x43\x6c\xf9\x2c\xc6\x49\xf4\x4c\x10\x30\x11\x45\x45\x8d\xfa\x78\x36\xd3\xa5\x78\x27\xb0\x2f\xef\xbc\x13\x00\x81\x78\xd7\x57\xab\x6c\x49\x80\xcc\x65\x04\xd2\x0c\xe8\x33\xba\xe1\x8f\xcf\x59\x74\x26\xc4\x51\xe1\x2b\x77\x69\xf9\x8f\x9b\xc7\xb8\xeb\xdd\x30\xc3\x09\xe1\x3a\xc6\x9c\xa1\x52\xce\x7e\x52\x5f\x93\xa8\x6f\xf0\xd4\x33\x2d\x20\xb4\x64\xa6\x05\xd7\x24\x6a\x62\x4b\xde\x69\xdc\x87\xef\x34\xfe\xd7\xef\xc4\xbe\x12\xf9\xdf\x10\xf9\xdf\x9c\xe8\x6f\xf3\xd8\x29\x77\xfd\xad\xc1\x92\x0c\x19\xd2\x21\xd7\x74\xc8\x0d\x1d\x32\x58\xbe\x91\x31\x43\x05\xcc\xb5\x02\xe6\x46\x01\x43\x98\x0f\x27\xa2\x38\x80\x2e\xdf\xbe\x66\x52\xcc\x5f\xa2\x65\xe7\x34\x35\x55\x50\x52\x95\x18\x6e\x77\xf1\x43\x7a\xfc\xfe\xa1\xe3\x56\xb1\x05\x8a\x54\xa6\x3f\xc5\xc4\x62\x8e\x5b\x26\x23\xc3\x52\x62\x46\xbd\xe3\xf2\x59\x88\x39\x83\x70\x5c\x99\xcb\x10\xc7\x11\x4d\x39\xf6\x34\xcc\x99\xa5\xe4\x89\x54\x27\x1c\xac\xa0\x08\xa1\xe0\xcd\x84\xe1\x69\x2d\x4c\xdc\xeb\xb8\xd5\x13\x64\x14\xe9\xd8\x72\x50\xf9\x18\x1d\x97\x7b\x4e\x68\x23\x95\xca\xee\x5d\x3e\xd2\x7c\xc6\x31\xd3\xb8\xe3\x72\xcb\x99\x90\xe8\x5d\xe7\x0e\x35\x7c\xa0\xd5\x4c\x37\x91\xba\x80\x72\x2a\x2e\xf7\xff\x44\x1d\x13\x80\xf9\x42\xcc\x09\x29\xd8\xc3\x73\x7c\x9f\xbb\x9e\x03\xd9\xca\x70\x90\x59\x68\x05\xa6\x6b\x3d\xc0\xea\xe9\x99\x58\xc7\x73\x30\x37\xde\x9d\x30\x70\xc3\x60\x3b\xb5\xa0\xe9\xe5\xf7\xf7\x37\x22\xfd\x00\x4f\x7f\xf0\x55\xf3\x4d\x11\xa7\x2a\x6f\xa8\x88\xbb\x56\xc4\xdd\x28\xe2\xbe\x2b\xe2\x7e\x28\xe2\xfe\xa1\x88\xbb\x55\xc4\xfd\x53\x11\x37\xf8\xa6\x0a\x54\xf5\x98\xc1\x50\x15\xa8\xea\x33\x03\x55\xa7\x19\xa8\x7a\xcd\xe0\x87\x2a\x50\xd5\x6f\x06\xaa\x8e\x33\x50\xf5\x9c\xa1\xaa\xe7\x0c\x95\xe7\x1a\x55\xcf\x19\xaa\x7a\xce\x50\xd5\x73\x86\xaa\x9e\x33\x54\xf5\x9c\xa1\xaa\xe7\x0c\x55\x3d\x67\xa8\xea\x39\xd7\xaa\x9e\x73\x4d\xf0\x9c\xb1\x83\x09\xf7\x21\x17\xb8\x86\x09\x88\xe6\x4c\x0f\x50\xf1\x1e\x3a\xec\x98\x6b\x9e\x89\x4b\x01\x6e\xda\x78\x5a\xcb\xd1\x35\x52\x7e\xf1\x12\xd0\x4e\x07\x47\xd7\x87\x1f\x55\xa6\xed\x07\x5e\x99\xe2\xd6\x57\x62\x50\xf5\x4a\x0d\x6c\xda\x9d\xe0\x87\x7a\xea\xc0\x03\x0b\x75\xdc\xc0\x9c\x99\x7f\x31\xa3\xbb\x16\x4f\xb1\x22\x2a\xe4\x14\x1b\x35\xd5\x9c\xe2\x66\xda\x7d\x36\x0b\xc3\xa4\x93\x7e\x95\x35\xda\xa1\xd7\x5d\xd5\xdf\x80\xa7\xc3\x3e\xd5\xde\x8e\xc4\x4c\x6f\x98\x63\x6a\x4c\xd1\x18\x4c\xad\x91\x5d\x15\x9a\x7b\x0a\xf1\x03\x85\xf8\x2f\x0a\x31\xa6\xcd\x7f\x31\xcf\x41\x90\x39\x36\xa3\x48\x46\x92\xcb\xa3\x3a\x39\xc6\x3c\xcd\x46\x6d\xa4\x8c\x43\xcb\x42\x13\xeb\xa1\xe7\x41\x35\xe8\xca\xc3\xda\x01\xb3\xb9\x8b\xff\x5e\xaf\x46\x16\x9f\x6b\x90\xf0\x13\x4f\x0f\x65\x73\x91\x87\xbd\x5b\x08\xf1\xc4\xf5\x10\xa6\x24\x8b\x4a\x8f\x3f\xbe\x34\x6d\xaa\xe2\x4c\x5b\x41\x75\x87\xd3\x17\x5a\x56\x0d\x76\x56\x60\x7d\x23\x5b\x5e\x93\x2b\xb7\xb2\x7d\xb9\xd7\x8c\x01\xb1\x74\x49\x85\x8c\x62\x3a\x66\x22\xd2\x7d\x08\xea\x78\xb6\x4c\xd4\x46\x95\x54\x52\x3a\xeb\x01\x85\x78\x48\x21\xbe\x46\x11\x97\x2f\x37\x30\xa4\x77\x59\x5c\x88\x59\x94\xa3\x6e\x26\x9a\x69\x2e\xb2\x42\x5f\xbd\x96\x19\x32\x30\x10\xf6\x5e\x64\xd1\x82\x86\x99\x88\xd4\xff\x78\x7d\x5c\x25\x28\x4b\xf9\xa2\x30\xd3\xb7\x28\x8b\x23\x1c\x77\x5f\x14\xd6\x6a\x11\x25\xfa\x2a\xcd\x0b\x24\x66\x5e\xb2\x7f\xc4\x9d\x05\x95\xc4\x39\x85\x38\xa6\x10\x3f\x51\x88\x91\xe7\x57\x25\xf1\x86\xd4\xc3\x0d\xa9\x8b\x9b\x98\x42\x4d\x38\x43\x63\x69\xf4\x98\x88\x0a\xa7\x27\xb1\x48\x0b\xbf\x88\x0a\x94\xfb\x1b\x71\xae\x0e\x86\xb9\xc8\x8a\x9f\x5f\x8a\x5d\x56\x62\x2c\x6a\x16\x15\x22\x8b\xa3\x84\x0c\x0c\xc4\xfb\x44\xa4\x2a\xb0\x62\x93\x89\x30\x8d\xe9\x6d\x25\x01\xcc\xbc\x52\x65\x69\x14\xd4\x59\x30\x9c\x81\x96\xa0\xd1\x6a\x95\x88\x28\x7d\xa3\xa1\xcc\xb2\x06\x07\x11\x35\x4e\x56\x51\x41\xc4\x54\x4e\x89\x45\xed\xa6\x25\x62\xbf\x76\x38\x62\xcf\x76\x38\x52\xdf\x0e\x26\x43\x62\x4b\x0f\xb0\xc4\xd6\x1e\x60\x1b\x5b\xdc\xd3\xd9\xf7\x6f\x01\x63\x34\x4e\x9f\x3b\x1e\x7f\xc3\xd3\x03\x0b\x5e\x82\x7d\xe3\x7e\x00\x17\xc2\x0d\xe6\xa2\xee\x82\xef\x80\x03\x55\xe0\x37\x7c\x64\xbe\x27\x0c\x1f\xcd\x97\xdf\x08\x65\xf1\x23\xdc\x19\xfd\x5e\xd3\x08\xc4\x03\xfa\x87\x4b\xd9\x28\x6a\x27\xf0\x91\x7c\x03\x0a\x79\x91\xb5\x09\x8a\x0c\xd0\x1b\xa5\xa2\xbf\x70\x1a\xc0\x94\x7b\x90\x65\x50\x7b\x27\x47\xc5\x13\x19\xf0\x46\x45\x34\xaf\xe4\x5d\xc7\xf6\xef\x38\xbd\x1e\xf2\x5f\xe2\x43\x2c\xf9\xeb\xa6\x10\x0d\x75\xc4\x68\xb9\x93\xea\x5d\xd0\x16\xb2\x79\x3f\x41\xc2\x0c\x04\xdc\x81\x44\x4c\xe7\x3d\x91\x88\x28\x6f\x41\x36\xf5\xbf\xd6\xc2\xc3\xf6\xff\xa7\x34\x92\x68\x24\xc8\xdb\x97\x89\x45\x11\xa5\x90\xcc\xea\x4b\xf2\x5c\x99\xb6\x6e\x85\x3e\xec\xbc\xb4\x8f\x1d\x76\x8f\xa7\xbd\x33\x6d\xc3\xb9\x83\x3b\x18\x81\x66\x4f\x2c\x25\x08\xf2\xb9\x1a\x6c\xde\xd6\xb1\x98\xed\x43\x78\x59\x8c\xc3\xd5\xed\x7f\x17\xa7\xcb\xd5\x6f\x6f\x67\x19\x84\xe5\xdb\xdd\xf3\x62\x97\xff\x0f\xbb\x50\x46\xe4\xfb\x60\xc0\xf3\x8f\x74\xd1\x4f\xe9\x00\xff\xc1\xd6\xf9\xfd\x60\xc0\xc7\xec\x54\xf2\xe4\x7a\xff\xcc\x57\x10\xe7\x7f\xa4\x8b\x1e\x7b\x36\xfe\x57\x75\xc1\x91\x3f\xc6\x69\x94\x7d\xf0\x89\xae\xfb\x4e\xad\x73\xcd\x53\xcc\xd9\xfe\x49\x36\xdb\xcd\xf9\x91\x69\x6b\xde\x03\x1f\xff\xab\xaf\x56\x4f\x3c\x36\x63\xc6\x03\x7f\x82\xef\x2b\x5e\x88\xec\x35\x4e\xa3\x62\x45\xbf\xa5\x59\xd7\xf1\x18\x18\x06\x3b\x7e\x95\x9c\xbe\x9b\x9d\x17\x59\x9c\x3e\xf3\xd7\x28\xfb\x25\xba\xb7\xd9\x97\xdc\x66\x92\x59\xcf\x0d\x9e\xba\x7c\xb1\x4a\xdf\x56\xc9\xa6\x88\x57\x29\x7f\x5c\x65\xe0\x29\xaf\xab\xa5\xc8\xc9\xcd\x3e\xf4\x0f\x73\x62\x3b\x1e\xdb\xd6\x7b\x99\xba\x57\x7f\x9c\x25\xdf\xed\xac\x62\x01\x1e\x73\x2d\x53\xd7\x02\x92\x88\xb9\x63\x85\x70\x92\xb9\xc5\xc8\xab\x6f\x35\x64\x47\x75\xca\x77\xb5\x9f\xa9\x13\xba\x6a\x51\xd6\x68\x87\x5b\x00\x8c\xdf\xb7\x77\x71\x9f\xfc\x01\x4b\x2e\xdf\x83\xcb\xc2\xe0\xf7\x74\x08\x5a\x8a\xe7\x04\x20\x42\x4e\xf7\x44\x8c\xe3\x99\x13\xd3\xe6\xf7\x6a\x30\x74\x0b\x67\xda\x64\x5b\xf8\x09\x8b\x30\x6d\x22\x42\x0f\x47\xa6\xbe\xfd\x3c\x69\xc5\x94\xd4\xad\x64\xdb\x92\xb7\x14\xe3\xc9\x8c\x08\x43\xa3\x1d\xe3\x3a\x7e\x70\x04\x2c\xc7\x4d\x99\x38\xb5\x9d\x83\xe7\xdc\x3f\x28\xf1\xa9\x4f\x84\x26\x8c\xad\xdd\x4b\xf9\xdd\xe6\x5b\xac\x0e\x7d\xea\x20\xf5\xad\x83\x58\x0c\x16\x76\xad\x3a\x88\x3e\x0d\x7f\x7a\xeb\x75\xc6\x5b\x2d\x16\xc9\x06\xe6\x41\x5e\x88\xbc\x6b\xd8\xe4\xe8\x32\xd8\x75\x6c\x1e\x30\x1f\x31\x44\x6a\xf4\x1e\xf3\x43\x2b\xe0\xbd\xf6\x6f\x9b\xa9\x29\xe9\x67\xab\x6c\x7b\x63\xcc\xda\x6e\x28\xc9\x78\xbb\xb5\xa3\x5b\x98\x1c\x47\xbe\xcb\xf4\xd0\xd2\x3c\x02\xcc\x63\xa7\x50\x5d\xd4\x63\x8e\x66\x7c\xb1\x49\x92\x6a\x3f\xb1\xa3\x6a\xf4\xd0\xda\xed\xff\x98\xa3\xde\x92\x4a\x43\x23\x5f\x37\x49\x11\x43\x5c\xc3\x97\x59\xf4\xbb\x7c\x08\x49\x0f\x71\xea\x63\x6c\x06\x5c\x67\xab\xa5\xf8\x7c\xdf\x5a\x6b\x77\x3b\xac\x7a\x75\x9b\xf7\xdc\xe1\x2c\xca\x0b\x91\xad\x57\x39\x5f\x24\x71\xd7\x5a\x81\x65\x36\x1f\x98\xc3\x4d\x19\x3e\x85\xb6\x6e\x99\xae\x0b\x89\xd2\xfa\x6d\x75\x5e\x44\x45\xbc\xe0\xcb\xa8\x88\x3a\x36\x59\xb3\x2c\xd8\x51\x0e\x4c\x5d\x1e\xd6\xd7\x1b\xda\x80\xa8\xa8\x2b\xff\x2b\x37\x1e\x31\xd6\x1c\x27\x9b\xfc\x05\x4e\xd5\xe2\x85\x11\x15\x51\xcf\x1a\xd9\xce\x3e\x65\x69\x43\xb1\xe4\x99\x58\x8b\xa8\xeb\x34\x5b\x56\x73\x84\xfb\x35\xcc\x65\x5a\xc0\x7b\x6e\x74\x75\xba\x20\x47\x19\x4f\xe2\xbc\xe8\xfa\x35\x71\x60\x14\xcb\xf4\x8f\x5a\xdc\xf6\xe0\x1f\x89\xd9\xcf\x45\x81\x84\xec\x3f\xf5\x47\x42\x9a\xde\xf9\x23\xa1\xf5\x47\xfe\x48\x18\xdc\x36\x96\xd2\x4c\x5b\x46\xe1\x14\xac\xcf\x74\xc7\x36\xe0\xfb\x5e\x41\x3b\xc7\x86\xab\x6e\x2b\xa9\xd8\x0f\x0d\x3d\x6a\x28\x1a\x79\x64\x4d\x34\xf2\xa4\x51\xd1\x1c\x1a\x6d\x8b\x46\x9f\x36\x31\x9a\xc5\x19\x4b\x9f\xe1\x71\x2e\x37\x84\x15\xe7\x05\x06\xe3\x8b\xc5\x2a\x5d\x46\xd9\x87\x0a\xb8\x96\x2e\x02\x0b\x1b\xaf\x9e\xf7\x53\x34\x60\x61\xfb\xa9\x23\xb0\x98\x83\xf4\x11\x58\x50\x2d\x85\x04\x16\x76\x90\x46\xa2\x01\xd4\x69\x6e\x9f\x4d\x60\x1b\x71\x99\x88\x3c\xdf\x66\x30\x55\xd9\x4a\xac\xb7\x19\x12\xa0\x95\xdc\xa6\x51\xba\x4c\x84\x39\x9b\x5c\xfd\x81\xc6\xf8\xd1\xeb\x3a\x11\x19\x1e\x1a\xa6\x31\xec\xcd\x94\x00\xc8\x9c\xa9\x04\x7a\xc3\xa0\xdc\x6c\xf5\x9c\x45\xaf\x4a\x12\x4f\x61\xeb\x82\xbb\x5a\x54\x6e\xb2\x3e\xca\x74\x77\x7c\xb9\xfa\x9d\xe6\x52\x9f\x2a\x76\xad\xcd\x48\x9e\x36\x63\x55\x6e\x42\xd3\x86\x14\x89\x16\x0b\x58\xf9\x02\xd6\xd7\xe0\x17\xd7\x6c\x83\x1b\xce\x9d\x5d\xfd\x6c\xd2\xca\x21\x4f\x38\xd5\xd9\x03\xc8\xad\x2e\x1f\x81\x53\xc1\xec\xb7\x5f\x0b\x02\x4d\x9f\xce\xe0\xf0\x7d\x3b\xd1\x4b\xd9\x1c\x63\xc8\xf1\xa7\x82\x2b\x97\x1d\x1a\xc6\x4e\xd1\x6a\x1c\xac\xe8\x43\x64\x27\x99\x74\x75\x09\xf9\xb9\x54\x72\x86\x88\x33\x85\x5d\xe1\x62\xd5\x65\xc8\x1f\xaa\xd6\x63\xb6\xc1\xbc\x4a\xb7\xa5\x5d\xa8\x16\x39\xe1\x51\xed\x4c\xe0\x40\x11\x2f\x72\x67\xed\x33\x88\xba\xb5\x3c\xa9\xb0\x72\x44\x55\x09\xc9\x67\x9f\x0a\x55\xb3\xf7\xd0\x38\xcd\xa2\xab\xb5\xd7\xe5\x44\x53\x9d\x10\x75\xb7\xae\x3f\xb9\xe7\xae\xe7\x4c\x3c\x6d\xb6\x3d\x1a\xea\xb9\xc5\x99\x88\x96\x1c\x66\xc5\xa8\xe8\xde\x5c\xa8\x06\xd4\x68\xd7\x13\xc9\xc1\xfd\xa9\xe3\x05\xfc\xa6\xfa\x07\xd5\xe8\x7a\xee\xdd\xc1\x81\x5d\x3f\xe6\xf8\x1a\x33\x54\xf3\xc1\x7e\x3d\x0e\xbe\x7e\xcb\x8a\x45\xf7\x46\x6f\x6b\x08\xc0\x37\xea\x64\xc4\xdd\xb9\x17\xe8\xfc\x66\xe4\xba\xf3\x41\xbd\x17\x58\xf4\x50\x0d\xad\x75\x13\xae\x9d\x95\xfe\x65\x16\x18\xf6\x6d\x82\x83\x7e\x0c\x95\xd5\x70\xd3\x08\xef\x4b\x0d\x4f\x71\x52\x88\x8c\x2f\x36\x8f\x71\x1f\x3e\x28\xcf\x5f\x10\x3d\x95\x74\x33\xd3\x9d\x69\x2e\xb7\x99\xe6\xc1\xd6\x35\x11\x66\x99\x00\xec\x55\x2f\xf6\xc4\xe3\x8f\x89\x48\x97\xfc\x69\x93\x2e\x78\x2e\xd6\x51\x16\x15\xf5\xd5\xbb\x5d\x29\xf5\xc5\x69\x04\x3c\xc7\x9b\x74\xe1\x57\x1c\x4d\x7b\xe2\xf5\xda\xea\x05\x7c\x05\xf2\x45\x12\xbd\x76\xde\xb9\x64\x86\x3c\xa6\x2b\x33\xe7\xd4\x1b\xda\x00\x98\x78\x8c\xd9\x34\xc8\x08\x9e\x2b\x91\x10\xb2\x44\x15\x0d\x02\x25\x1e\x21\x72\xa1\x76\x84\x02\x29\x3b\xa2\xdd\x93\x3b\x72\x12\xd2\xd9\x13\xe4\x57\x6c\x12\x2d\x84\x5c\xf2\x3b\x3a\x83\xbc\x07\x6a\x41\xa1\x1b\x8f\x69\x46\xcf\x8d\x0d\x98\xc5\x1f\x93\x68\xf1\xeb\x65\x95\x88\x2a\x66\xfe\x92\x3b\x7d\x23\x4b\xd3\x7f\x4e\x1d\xa8\x17\x23\xa3\xe8\x52\x76\x5b\x47\x6a\x22\x0e\x1b\xd0\x9b\x14\x9a\xba\x16\x2b\x78\x34\xf5\x16\x15\xf1\x9b\xa8\x0e\x2c\xe2\xff\x8d\x8a\xe3\xaa\x5e\xfd\x28\x0e\xee\x98\x30\x6f\x5e\xbe\x44\xdd\x16\x28\xd0\xe4\x81\xc6\x97\xa8\xb0\xab\x3c\x9a\x32\x9f\xb2\xe8\x19\xd2\xb7\x6e\xaf\x7b\xc9\xab\x3c\xe7\x8f\x2b\xc9\x02\xb6\xdf\x24\x5c\x9f\x69\x5a\x4f\x36\xaa\xaf\x2e\xda\x7a\x9d\x7c\xec\x7d\xff\x68\x45\x11\x2d\x5e\xa0\x67\x20\xb4\x27\x43\x7d\x81\x50\x9a\x32\x5f\xa3\xf5\x36\x68\x39\x63\xa0\x23\x29\x67\x3e\x50\x67\x6c\xe6\x78\x0f\xdc\xd2\x1e\x9c\x30\x38\x76\xb0\x06\x64\x45\x6a\xb0\xb1\x16\x5a\x24\xc8\x36\x58\x21\x23\x74\x37\xe4\xba\xa6\x4f\x99\x71\x02\x5c\x37\x8c\xff\x91\x2e\xaa\x2f\x60\x1c\x20\x4c\x5f\xa3\xf5\xee\x9b\x19\x87\x99\x9d\x43\x74\xb7\x35\x04\x49\x49\x22\x12\xd5\x53\xe7\x43\x85\x42\xed\x12\xcb\x62\xd5\xc1\x88\x8f\x32\xc2\xc1\x29\xcc\x8e\x41\xf5\x30\x02\xc7\xe2\xe0\x34\x46\x8d\xc5\xfe\xa1\x82\x1a\x87\xa6\x33\x16\x2c\xa7\xb3\xfb\xe4\x6f\x38\xcc\xc1\x3e\x3e\x12\xb3\x7f\xa2\x81\x84\xd4\x76\xfe\xdf\xbe\xc2\x27\x45\x26\xb7\x4f\xd2\x85\x68\x2c\x7a\xd5\xcf\x8a\xeb\x32\x6f\xfc\x67\xc8\x3c\x48\x80\x2f\x2f\xfe\xe9\x8e\x0d\x36\x44\x19\xfb\x13\x3c\xb1\x9c\x91\x66\x29\x82\xef\x34\x93\x0a\x19\x5b\xa1\x3f\x25\x62\x0c\xc7\x76\x02\x25\xa4\xcc\x7b\x0f\x59\x2f\xe7\xb0\xbb\xac\x86\x35\x42\xaf\x0c\x64\x60\x98\x76\xe5\xe1\x69\x77\x8a\x2c\x82\xa9\xe7\x84\x93\xa9\x1b\x06\x8a\x0c\x3a\x88\x36\x67\xcc\x0f\xca\x6f\x23\x15\xbc\xbc\x24\x12\x9a\x76\x70\x3d\xec\xca\xe1\xc7\x4d\x17\x0e\x63\xcb\xd1\x94\xdd\x00\x18\x54\xd9\xb3\x3b\x70\x18\x39\x8e\x45\x56\x43\xc9\xc7\xd6\x66\x6c\x5b\x09\x08\x3e\x57\xd5\x5a\xd1\x0f\x17\x83\xf9\xba\x3a\x97\x89\x2b\x4b\x5e\xc2\xc7\x8f\xb1\x65\x89\x5d\x5b\x64\xc6\x0f\x57\x64\x4f\xb2\x42\x1f\x0e\xa3\x67\x22\x2a\x04\x11\x54\xa6\xe2\x20\x82\x58\xba\x24\x22\x26\xa2\x18\xc7\x59\x5e\x7c\xc2\x96\x68\xa0\x2d\xde\x95\x70\x80\xd1\x57\x1b\x58\x36\xcd\xf4\x69\x45\xc2\xc9\x36\xca\xcb\x58\x64\x94\xb9\x1c\x7d\xd8\xd1\xab\xa0\xcb\x6b\x6c\x65\xd3\x4a\xdd\xfe\x79\x72\x59\x45\x2f\xab\xe8\x65\x15\xbd\xac\xa2\x97\x55\xf4\xb2\x8a\x5e\x56\xd1\xe6\x55\x14\xfb\xbd\xfb\x73\xba\x3d\x05\x13\xff\xb3\x91\x9b\xcb\x3c\x5a\xbe\x45\xe9\x42\x2c\xbf\xe4\x9b\x77\x97\xe0\xff\xe7\xd4\x6b\x19\x26\xbe\x2e\xcf\x68\xda\x09\x9d\x39\xf3\x2c\x0d\xc3\xd2\xd0\xbc\x9f\x28\x96\xf2\xf9\x09\x8a\x52\x6e\xdb\x18\x0e\x5c\x4e\x45\x12\x8f\x42\x0f\xc3\x78\xaa\x79\x86\x6c\x06\x82\xd6\x77\xc6\x01\x96\xd6\x30\xe1\x5a\x91\x7c\x76\xdd\x4e\x5c\xbd\xae\x77\x50\x0d\xf6\x2d\x3e\x0d\x31\x5c\xa7\xbe\xc5\x7d\x2d\xd8\xc6\x02\x38\x80\xd4\x1d\x92\xd6\x0a\x67\xa6\x0d\xcf\x31\x9a\x9d\xa2\x3e\xc2\xe4\x91\xed\x28\xca\xb2\x58\x64\x35\xfa\x3e\x22\xd4\x8b\xcf\x5f\x7c\xfe\xef\xee\xf3\x3d\xac\x27\x7c\xb1\x7a\x11\x99\x48\x8b\x2f\x59\x58\x46\x16\xb3\x0d\xae\x19\x73\xcd\xd6\x65\x30\x35\x05\x83\x07\x1c\xd1\x37\xea\x78\xee\x20\x8a\xa2\xc6\xc5\x2a\x85\x33\x30\xfe\x04\x2f\xa8\x20\x11\x41\x91\xad\x12\x45\xdd\xb5\xf6\x69\xfb\xdd\xeb\x31\x8b\x41\x45\xa2\x11\x9b\x6a\x73\x13\xe5\x7c\x27\xa1\xe5\x76\x6f\xcf\x5a\x59\x8a\xc7\xcd\xb3\xa2\x16\xae\x5a\xc6\x84\x01\xbc\x67\x22\xcf\xa3\x67\xa1\x57\xfa\xfe\x83\x80\x29\xb3\x9c\x92\x20\x7a\x94\x24\x50\xd0\xbc\x15\x34\x11\xc5\x3e\xce\x5a\x3d\xb7\x42\xdc\x4d\xfe\x22\x31\x93\x6c\xb5\x59\xb7\x93\xaf\xd6\x04\x6a\x47\xa6\x81\xb1\xa2\x47\xd1\xae\xa3\x89\x28\x28\xe4\x25\xad\x5b\x64\x44\xee\x4d\x88\x26\x77\xa3\x8e\x8d\xf2\x3a\x74\xdb\x30\x30\xd8\x28\x9c\x6c\xeb\x57\x40\x7e\x9b\xa9\xe7\xd8\x4e\xe8\x23\x46\x50\x09\xb5\x61\x10\x59\xce\x64\xc2\x0c\x3e\x63\xbe\xaf\x4d\x76\xdf\xa7\x58\x16\xba\x66\x59\x23\x4d\xff\xc9\xc7\xa1\xad\x23\xd7\x99\x1a\x32\xf4\xe1\x29\xab\x06\x97\x93\xb1\x58\xdf\x09\x3d\x9d\x71\xcd\x35\xa9\x90\x2a\xd3\x91\xff\xe0\x07\x8c\x2c\xaf\xca\xae\x03\xf7\x30\x4d\x8b\x79\x54\x78\x30\x35\x3d\x03\x7a\x1a\x3c\x50\xa1\x9a\x5b\x66\x57\xa1\x28\xb8\x82\x3a\xc1\x94\xd0\xd4\x32\x29\x20\x3c\x46\xa5\x41\x0c\xe6\x7a\x0c\xd2\xbf\x18\x94\x89\x7c\x8f\x41\x68\x1b\x6c\x6c\xda\xca\x78\xd7\xf1\x02\x6d\x64\x5a\xa7\xa2\x91\xd3\x40\xe6\x41\xee\x0b\x0d\x17\xaa\xed\x01\x15\x14\x3b\xd3\xbc\x9f\x54\x8c\x0b\x4b\xda\xc4\x73\x42\x97\x88\x73\x5c\x22\xcc\x67\x73\xe6\x81\xfe\x6c\x27\x30\xc7\x78\x77\x83\x5d\xa8\xb2\x93\xa5\xbc\xfd\xc4\xa1\x58\xd9\x2a\xc8\xea\xe1\x49\x3b\x61\x35\x6e\xdb\x09\xb7\x8f\x24\xda\x29\x0f\x6e\x70\xb4\x93\x97\x9b\x6f\xf8\x06\xb8\xa6\xcb\xe0\x8a\x0e\x02\x52\xad\x15\x08\x4a\xb0\x93\xa5\x8d\x98\x85\x9f\xe4\x3f\x4d\x4b\x5e\x1f\x3e\xa1\x87\x2b\x0c\x7e\x79\x52\xc5\xed\xfc\x78\x6a\x4e\xa6\x74\xd4\x8c\x19\x66\x38\xa3\xe3\x2c\xe7\x0e\x0d\xaa\x16\x6d\x7c\xa8\x2b\x9f\x21\x97\x02\x47\x26\x06\x58\x0e\x25\xf8\x68\x1f\xe3\x1a\x56\x02\x60\x1a\x3e\x8d\x38\x1b\x57\x96\xe1\x2b\x15\x56\x46\xb0\x64\x61\x55\x10\x8b\xc1\x1d\xc7\xb1\x18\xd4\x61\x28\x8b\x42\xac\xd6\x34\xc0\x5e\x84\x8a\x21\x3f\x8c\x69\xf1\x02\xb6\x41\x2a\x49\x06\x11\xb4\xbd\x19\xd5\xf3\xa7\x57\xba\xe2\x02\xd2\x6c\xf4\xf4\xf5\x75\x66\x68\xd9\x4e\x15\xf5\x34\x8c\xae\x3e\x22\xfa\x4e\xc2\x28\x2a\xdb\x5d\x6e\xac\xae\x1d\xc3\x43\xa4\x38\x11\x5f\xa2\x41\x98\xe2\xeb\x31\x71\x30\x85\xbb\xfc\x98\xc9\xba\x7a\x2e\x0a\x31\x06\xa4\x7a\x39\xf1\xdd\x52\x77\xb6\x59\x54\xd5\x38\x81\x32\x1d\x71\x22\xb2\xe0\x05\x5e\x22\xe4\x08\x35\x52\x6d\xf6\x37\xeb\x1e\xc5\x4b\xb2\xd5\xe3\x26\x2f\x78\x75\x7b\x3c\x5a\x2c\x20\x81\xc0\xa3\x78\x89\xde\x62\xfc\x68\xa3\x0b\x4c\x45\x9e\x63\xb9\xd7\xac\x75\xd5\xa2\xec\x6a\x47\xc8\x19\x85\x7e\xc0\x35\x5d\x67\xbe\xdf\xa6\xb0\x9a\x84\x43\xf9\x58\x21\x08\x4f\xb0\x1c\xff\xf3\x8e\x88\x63\x73\x8f\x41\x8d\xf1\x76\xdc\x24\x34\xad\xe0\xe1\x53\x2c\x12\x66\xda\xb6\xa3\xc3\xee\x20\x15\x18\xda\x3f\x6d\xe7\xce\x26\xe3\x4a\xfe\x07\x1f\x0d\x7e\xe0\x69\x01\x9b\x60\x42\x5e\xdb\x69\x62\xd0\x8e\xdb\xb6\xd2\x82\x04\x6c\x98\xa1\x35\x11\xc5\x24\x8b\xd6\x2f\xf1\x22\xf7\x44\x2e\x64\xed\x94\x4d\x8e\x41\x7a\x22\x5a\xa6\x6e\xfc\x2e\x12\x14\xf9\x44\x14\x69\x95\x24\xe2\xe9\x8d\x08\x88\xa9\x80\x4d\xfc\xd6\xf3\xdc\x50\x2d\x1c\xf9\xe6\xf1\x19\x22\x99\x2f\x19\xaf\x7e\x38\xaa\xbe\xf8\xa0\x7e\x41\xbb\xb1\x3f\xe9\x43\x17\xbe\xf4\xe1\xd5\x77\x80\xfc\x28\x68\xc0\x8e\x19\x9c\x8b\xd0\xd0\x7f\x86\x9a\xb1\xcd\x62\x46\x14\x5c\x89\x83\xca\xfb\xa6\x8e\x8d\xdf\xeb\xe0\xb9\x13\x30\x55\xac\xe6\x99\xc1\x74\xc6\x02\x75\xe9\x23\xcd\xb2\x9c\x40\x15\xed\x4f\xc3\xf1\xd8\x62\x5d\xe1\x1e\xb3\xca\xc7\x62\x8a\x7c\xe0\x64\x39\x60\xf0\x72\x53\x91\x81\xf4\x81\x06\x6c\x1f\xab\xcb\x4e\xd8\x65\x48\x5c\x86\xc4\x7f\xe7\x90\xa0\xac\x42\x4d\x89\x14\xa2\xbc\x58\xf0\x97\xe5\x57\x7d\x0e\x1e\xa6\x47\xd0\x7c\xc8\x8e\xf0\x7e\x83\x50\x4a\x23\xf4\x7b\x17\xe8\x77\x55\xe8\x8f\x2e\xd0\x1f\xaa\xd0\x5b\x75\xa9\xb7\x5d\xa4\xde\xaa\x42\x07\xdf\xd4\x5b\x3c\xf8\xa6\xde\xe4\xc1\xb7\x4e\x6d\x1e\x7c\x53\x06\x0f\x3b\x82\x87\x34\xb0\xef\x4d\x46\xb7\x65\x3a\x82\x5b\xc5\xa1\x74\xcc\xe2\x7b\x1f\x2c\xbe\x77\x65\xf1\xa3\x0f\x16\x3f\xba\xb2\xb8\xed\xde\x8a\xdb\x3e\x5a\x71\xdb\x95\x05\x7d\x28\x36\xf2\xe8\xdc\x15\xfa\xd0\x6c\xe4\x31\xf8\xd6\x99\xc9\xb0\x27\x26\x43\xcc\x9a\x4c\x0d\x53\x1b\x27\x88\xcb\x32\x79\x59\x26\x2f\xcb\xe4\x65\x99\xbc\x2c\x93\x97\x65\xf2\xbf\x73\x99\xec\xe5\xd3\x35\xc1\x7f\xba\xf6\x22\x2f\x4f\x62\xb8\x05\x7d\xbd\xec\x5b\xea\x8c\xf9\xda\xfd\x4e\x6e\x5e\xc0\x75\xd2\x43\x11\x57\xc7\x21\xc6\x59\x2b\x6d\x33\x89\x0c\x8c\xea\x22\x82\x14\x71\xf5\x07\x0a\x34\xa4\x80\xca\xf2\x47\x4a\xf2\x0e\xa1\x24\xa9\x47\xf2\x46\xa6\x6d\xc8\x6a\x33\x2a\xfd\x3c\x09\xee\xe2\xba\xc0\x8c\x3f\x3e\x67\xf5\x4a\x1f\x35\x1f\xc1\x58\x73\x34\x39\x2a\xef\xdb\xb9\x65\xfb\xe9\xae\x9e\x92\x78\xcd\xdf\xb1\x5e\x5d\x0b\x71\xaf\xce\x36\x7d\x3f\xa5\xf1\xd8\x32\x5d\x7e\x2f\xed\xdb\xd6\x95\x9a\x8c\xc3\x16\xf4\x27\xa6\x9b\xc6\x3e\xfe\x3d\x1a\x7b\x38\xea\x4a\xe3\x21\xd6\x5e\x7a\xaf\xcf\x4a\x5a\x28\x24\x3c\xdc\x6c\x02\xbf\x61\x54\xd8\xd9\x52\xff\xd9\xdd\x53\xf6\x90\xfc\x77\xb4\xe6\xef\x5f\xef\x22\xfe\x9d\xe6\xf2\xfb\x07\x8e\xe9\x4c\x17\x5b\x51\xe4\x90\x94\xb6\x8e\x16\xbf\x78\x9c\x42\x4d\x9d\x9a\xb2\xae\x8e\x9b\x7e\xb6\xb9\x2e\xcc\xe5\xa6\x0d\x77\x49\xbf\xa0\x99\x07\x49\xc4\xab\xe4\xdc\x0d\xb7\x2f\xba\xd8\xb5\x96\x54\x5c\xde\xa3\xc6\x75\x85\x6a\x59\x75\x49\x24\xa5\x65\x22\x8f\xff\x57\x54\xf7\x62\xea\xca\xba\x3a\x6e\x7f\xcb\x48\xf6\x24\xbb\x51\xc9\xad\xf7\xc6\x56\x07\xf5\x70\xf3\xee\x19\xa6\xf8\x4d\xba\x80\xe7\x6f\xed\x36\x26\x49\x29\x62\x48\x9f\x2a\x93\x81\x96\x39\x2c\xc9\x5a\x39\xb4\x64\x60\x5a\xdb\xec\x9b\xbc\xac\xfa\x3a\x36\xef\x99\x71\x64\x4b\x14\xd4\xb4\x75\x8f\x69\x90\x86\x85\xdf\x77\xe6\xf0\x05\x73\x45\x55\x55\x7e\xbd\xea\xee\x4b\x65\x15\x74\x77\x95\x0f\x97\x8d\x1d\x3d\x43\xff\x46\x04\x3c\x51\xe9\xa9\x02\x62\x2a\x3d\x55\x40\x4e\xa5\x27\x0a\xb8\x26\xda\xe0\x7a\x49\x15\x40\xb4\xc1\x35\xd5\x06\xd7\x44\x1b\x5c\xc7\x6f\x44\x00\xd1\x06\xd7\x39\x51\xc0\x0d\xd1\x06\x37\x54\x1b\xdc\x10\x6d\x70\x43\xb5\xc1\x0d\xd1\x06\x37\x54\x1b\xdc\x10\x6d\x70\x93\xbf\xf5\x3e\x05\x7e\x2c\x1e\x17\xd9\x76\xaf\xa0\xe3\xda\x51\x2b\xf9\x71\xcb\x6f\x31\x93\x7e\x03\x0a\x8a\x84\x20\x90\x0f\xfa\x48\xf7\x78\x9f\x2a\xb1\xe7\xf7\x27\x5f\xab\xcb\x22\x3f\x65\x3d\xd4\xe6\x00\x44\x7d\x25\x07\xb1\x8b\x55\xba\x8c\x21\x40\x88\x92\xe6\x74\xe8\xed\xd6\xa8\x3b\x8e\xcc\x08\xa4\x7f\xf2\x2d\x0b\xed\xd8\xf3\xfb\x56\x97\x63\xe9\xb2\x0d\xd7\x55\xcd\xcf\xeb\x0d\x7f\x15\xaf\xab\xec\x83\xc7\xe9\xd3\x8a\xdc\xd9\x43\x57\x98\xb8\xe1\x36\xa5\xb3\x69\x8f\x1d\x6e\x30\x03\xee\xaa\x32\x83\xcf\x4d\x63\xc6\x66\xbc\xa9\xd7\xe7\x59\x04\x4e\x00\x19\x7c\xe7\x9a\x69\xc9\x8a\xdb\xd5\xdf\xe8\x8c\xf4\xd0\x93\x99\x07\x3e\x59\x29\xb7\x89\xcd\xcd\xf2\x19\xaf\x4c\x37\xa5\xca\x80\x19\x27\x3a\xd3\x8b\x4d\x61\x98\x2c\xa2\xbc\x18\x76\x34\x69\xe8\x5a\x8e\x66\x94\x3d\xd0\xfc\x9f\x1c\xe3\xb7\xe1\x3a\x59\x45\xcb\xc9\x7a\x33\x8b\xf2\x5f\x18\xc0\x6c\xdb\xda\x79\x2c\x7e\x43\x5b\x65\x59\xe2\x37\x25\xa8\xbb\xca\xe5\x80\xb9\xf3\x17\x51\x22\x48\x2c\xfc\x45\x9c\xe7\xab\x0c\x2f\x5c\xcb\x3f\xd2\x85\xbe\x5a\x7f\x94\x5f\x2f\xfe\xe6\x11\xf2\x37\x92\x90\xb2\x3c\x7c\x33\xb0\xab\x1f\x24\x71\xfa\x4b\x2c\x0f\xdd\xa1\xa3\x37\x58\xe0\x07\x3e\x83\xf4\xd5\x01\xe3\x7e\xe0\x78\xf0\xdc\x11\xae\xd5\x35\x75\xfa\x10\x0b\x2f\x45\x24\x7e\xe2\x86\x3e\xca\x8f\x80\x1a\xd2\x5a\x2e\xc9\xea\x05\x64\x8b\x76\x4f\xe2\x4c\xc8\xde\x99\xac\x16\xbf\x7a\x36\x87\x2c\x58\x06\x4f\x48\x9e\x44\xba\xa0\x2f\xf2\x27\x92\xc6\x55\x4c\xc7\xc0\x13\xd3\x43\x3f\x7e\x4e\xa3\xc4\x17\xaf\xd1\xfa\x65\x95\xc9\xb2\x8e\x18\xd8\x5d\x14\x17\x64\x90\x9e\xc4\x22\x2d\xda\xa0\xdd\xf4\xca\xa3\x64\xfd\x12\xf1\x62\xc5\x17\xab\x37\x91\x45\xcf\x82\x2f\xe3\xe2\x45\x64\x27\x52\xb3\x50\x3d\x5e\x9e\xa6\xf1\xc0\xe1\x3a\x3c\x13\x05\x6f\x37\x4c\xf9\xa6\x7d\x5b\x81\xc0\x9e\x5f\xfd\xa1\xc6\x81\xd9\x72\x45\x53\x67\x60\x98\x7e\x47\x0e\xb2\x8c\xbf\x3d\x6f\xb5\xa4\x06\x4a\x0e\x56\x7a\xa5\x62\x43\x6a\xb8\xca\xc5\x62\xcf\x7b\xb4\xe6\xae\x50\xeb\x5e\x9c\x17\xa7\xcb\x38\x13\x8b\xa2\x79\xd7\x95\x3e\x72\xe4\x7c\xbf\x57\xfc\xbe\x62\x3f\xaa\x44\x23\xd4\xb1\xe3\xb0\xab\x83\x7f\x96\xc7\x17\xea\x84\x2f\x20\xdb\xf0\xbf\x49\x33\x32\x27\x63\x1f\xea\x69\x60\xd4\x97\x8e\x9a\xbf\xa1\x2a\x8d\x1c\x7f\x1b\xd4\xb6\x58\xaf\x5a\x7a\x56\xaf\xf9\x6b\xcf\x55\x4b\xfe\x22\x90\xb3\xe8\x97\x38\x90\x06\x3b\xa5\x4b\x91\x16\x2a\x58\x7b\x95\x12\xe0\x13\x51\xc8\xa5\x93\xd4\xd6\x3d\x04\xb1\xa5\xfb\xb2\x48\xed\x3c\x2a\x33\xac\x82\x79\xb3\xe7\xca\x65\x8d\x3b\x40\x31\x62\xcd\x5c\xd5\x01\xcc\xbc\xd5\x1c\x4d\x43\xae\x36\x1e\x0e\x47\x4b\x8b\x7f\x5f\x86\xc4\x65\x48\xfc\x17\x0e\x09\xca\x2a\xd4\xbc\x69\xd5\xd3\x62\x74\x18\xd3\x95\xe9\x0e\x21\x9e\xb3\xa0\x80\x67\x5b\x08\x58\x92\xbb\x1e\xab\xd2\x9e\x9a\xb0\xd5\xe7\xe9\x18\x60\xc8\xda\xa9\x3e\xd3\x89\xe2\x48\xcb\x34\xa5\x08\x5a\xfb\x5f\x50\x21\x09\x45\x19\xc0\xa9\x57\x3b\x65\x95\x79\xd5\x9e\xe3\xb3\x93\x22\x68\x7d\x64\x3b\x0d\x78\x79\x1f\x38\x08\x8b\x01\xa5\x89\x69\x27\xaa\xd3\x7e\x00\x49\xf0\x90\x84\x73\xe6\xb5\x53\x7e\x26\x64\x6d\x25\x2d\xeb\x93\xda\x73\x74\x9e\x59\x14\xe9\xcc\xbc\x47\xf0\xdc\xe5\x6b\x45\x91\x4e\x31\xce\x5e\xcb\xeb\x6a\xcf\x69\x49\x66\x5b\xe9\xab\xdb\x0e\xce\x7c\x82\xa6\x85\x82\xd1\xf6\x1c\x99\x4a\x18\x41\x08\x85\xef\x70\xc3\x19\x32\x70\x69\xd8\xf1\x5c\x12\x23\x6d\x3c\x33\xed\xd0\x2f\x6b\xb4\x32\x03\x4b\xde\x4e\xb6\x4d\xfb\x6c\xcf\x91\xc9\x9c\x5b\x09\x5d\xd3\x46\x76\xc9\xb5\xf6\x7a\x54\x7e\x95\xd3\x30\x38\x6a\x39\xc5\x21\x3c\x5e\x36\xa7\x95\xca\xc3\xc8\xad\x52\x64\xdb\x73\x74\x7e\xe8\x76\x52\x4f\x47\x4e\x95\xbe\xa7\xa3\xa6\x4a\xd4\x82\x07\x44\xa8\xa9\x52\x12\xa2\xa6\xca\xd0\xd6\x1d\x4f\x3e\xcc\xc7\xa8\x72\x6e\xce\x4d\xec\x3c\x78\xdf\x3c\xb1\xd5\x43\x1f\x99\x4a\xfd\xf3\x92\x9c\x3d\x27\x25\xa2\x6e\x8f\x91\xda\x3f\x1b\x2e\xc1\xcb\x25\x78\xb9\x04\x2f\x97\xe0\xe5\x12\xbc\x5c\x82\x97\x4b\xf0\x72\x09\x5e\xfe\x83\x82\x97\xee\x1b\x3c\xff\x3f\x35\x34\xda\x7b\xa6\x16\x96\xa9\x48\x22\xeb\xf0\x35\x4e\x5f\xa3\x77\xfe\x14\x2d\x0a\xe5\xd4\xa5\xad\xfd\x19\x6b\x7a\xe0\x78\x30\xb9\x72\x6d\x66\x5c\xfd\x81\x22\xd6\xee\xeb\xc4\xbd\x74\x38\xff\x9f\x4d\x74\xf6\x6e\x1f\x81\xe3\x02\x1e\x2a\xe5\xeb\x68\x21\xf8\x6f\x9e\x2f\xa2\x24\x4e\xfb\x2a\xbe\x71\xa8\x93\xb9\xc9\xee\x20\x1d\x1c\x77\x21\x5a\x80\xf5\xff\x8e\xfb\xba\x86\x39\x73\x3e\x0d\xbd\xe7\xba\xc3\xc6\xe3\x2e\x2c\x1e\x4e\xb3\xa8\xcf\x05\xa7\xee\x05\xb5\x19\x98\xea\x6b\xa7\x1b\xdb\xa1\x9f\x7f\x0f\x55\x51\x3c\xb7\xb4\x0e\x4f\xe2\xbc\x68\xf6\xd8\xab\x63\x1b\x9c\xed\x79\xc0\xbc\x99\x69\xcb\xeb\x48\xec\xcf\x50\x16\x93\xd2\x9d\xd9\x4c\xb3\x11\x8b\x95\xed\xb8\x78\x62\x03\x8a\xa3\x32\x8b\xcd\x98\x1d\xf8\x44\x98\xac\xfa\xef\xab\xca\xf2\x03\xcf\x74\xd5\x24\xaa\x40\x77\x72\x4d\xdb\x0f\xb6\x2b\x81\x82\x6c\x05\x78\x25\x9a\x6b\x86\x01\xcf\xd1\xf1\x40\x2d\x08\x3c\x73\x14\x06\x8c\x0e\x0d\x6d\x13\xde\x64\xd1\x81\xe5\x6a\x29\x37\x0f\xf0\x20\x3f\x60\xb6\x6e\x42\x5c\x3a\xc6\x83\xe0\x33\x85\xdf\x99\x46\x30\xc5\x63\x5c\xc7\x7a\x98\x38\x36\x77\xc6\x63\xc8\x3c\x8b\xc6\x95\x81\x3d\xa9\x79\xbb\xc9\x07\x8d\xf0\x75\xd3\xf7\x29\x6a\x1b\x7b\x8e\x1d\xc0\x12\x7d\x76\x74\xd7\x27\xb4\xf2\x8e\x1c\xe4\xb0\x15\x39\x82\xbc\x2c\x4c\x8e\x26\x37\x73\x49\x8a\xa0\x94\x74\x7a\xb4\x86\xb3\x67\x04\xf9\x44\x14\x7a\xf9\x5f\x53\x01\xaf\xd7\x70\x10\xbf\x88\x9e\x85\x99\x2e\xc5\x3b\x82\x1e\x6e\xe7\x54\x32\x72\x22\xb9\xb6\x5c\x66\x22\xa7\xa2\xf0\x46\x38\x02\xe1\x05\x96\xf6\xae\xd0\x56\x9c\x17\x28\x81\xd2\xea\x44\x90\x99\xef\x01\x10\xf4\xd0\x98\xe3\x9e\x95\x17\x24\x11\xf0\x3d\x61\xbe\x78\x96\x77\xaa\x70\x28\x48\x8f\xbe\x07\xc6\x80\xa2\x24\x39\x89\xe8\xbe\xfa\xaf\x37\x85\xd8\x3e\xbb\xfd\x5e\x8b\x00\xa8\x4b\x3f\x64\x2d\x81\x29\x7f\xfb\xee\xb5\x75\x16\xa9\x03\xe0\x1e\xf3\x8c\xc1\x3b\xc7\xea\x3d\xf4\x97\xf4\xb6\x7a\x82\xba\x84\xd7\xe9\x51\x11\xbf\x89\x1c\x1b\xab\x93\x84\xb5\x3c\x5c\x39\x29\xa4\x16\xe8\x5e\x9d\xd5\xe0\x5e\x61\xfd\x56\x6d\x97\xb4\xb6\x43\x22\x1f\x3d\x70\x8f\x4d\x64\xf8\xac\x84\x3a\x23\x0e\xfb\x30\x47\xf1\x5d\x4e\x9b\xe7\xd4\xf4\x7c\x68\x85\x93\x3d\xbb\xa8\x5a\x41\xd5\xb4\x71\x93\x8b\xac\x1c\x97\xd5\x0b\xee\x2f\x19\x38\xba\x63\xfb\xcc\x9b\x97\x79\x88\xe1\xa2\x0a\xf3\xcc\xbf\x90\x5b\xea\x7e\x38\x72\xcd\x7b\x06\x0f\xfc\x99\x6e\xca\x53\x8b\x91\xa9\xf9\xfc\x1e\x72\x0a\xfb\xea\xf8\x07\x24\x1e\x5e\x6e\x9c\xe2\x71\x8a\x43\xdd\x90\xfe\xe6\x71\x0d\xc5\x01\xdc\x4c\x2c\x62\xb0\xc2\x28\x8e\xf2\x76\x4b\x52\x07\xcd\x45\xcd\x4a\x6a\xee\x36\x60\xf8\x32\x4e\xa2\xe2\xc4\x9d\xeb\x86\x11\x73\x56\x0b\x0d\x16\xe4\x86\x09\x47\xe7\xed\x1a\x3c\x83\xf5\x34\x7b\xd2\x8d\xc3\xc4\xd3\xec\xd0\x82\x94\xe8\x0f\x18\x4b\xe8\x7b\x7a\xf2\xe4\xbc\xb2\xdb\xc2\x7e\xfa\x72\x83\xac\x21\xe3\x58\x1a\x7d\x4d\x5d\x8a\x26\x1d\xc9\x87\x24\x2e\xe4\x5c\xb7\x8f\x0e\xf4\x9b\xfa\xd7\xc3\xb8\x26\xcb\xec\x49\xa7\xbc\xc8\xe2\x28\x05\x15\xfe\x7b\xb5\x7b\xd8\x41\x92\x8e\xa0\xfa\x4b\x83\x92\x94\xf4\x1c\x78\xa6\x66\x4f\x2c\xe6\x77\x1c\x04\x71\xbb\xc1\x7a\x73\x92\x0e\xdd\xfe\x9b\xaa\xae\xa3\xaf\x6f\x20\x3c\x13\x79\x11\xbf\xca\x83\xae\x66\x47\xbf\x52\x9d\xaf\xd6\x1f\xd5\xdb\xfd\x1a\xdf\xe6\x61\xd3\xa2\x74\x17\xe2\x51\x28\xaf\xd0\xf4\x05\x77\x0a\x71\xe7\x99\x01\x3b\x03\x39\x36\xc6\xd1\x1b\xdf\x5e\x2d\xb0\xfe\xe0\x4b\xb1\x2e\x5e\xca\x77\x8c\xc9\xd1\x89\x18\x55\x2d\x65\x75\xd8\xed\x5e\x63\xe0\x94\x09\x78\x5b\x75\x73\x04\x1b\x4d\x3c\x8d\xf7\xdf\xd7\x18\x1e\x37\x90\xbb\xd8\x64\x94\xc3\x07\xbe\xbd\xb6\xb3\x7c\xea\xc8\x73\xf9\x44\xa5\x0f\x4f\xad\x9e\x5e\xc2\x26\x84\x63\x1f\x1f\xab\xa2\x30\x37\x04\x90\x16\x04\x9a\x3e\x9d\xd1\x24\x95\x63\xc2\x27\x20\xca\xda\xba\x14\x04\x14\x88\x1a\x7b\xda\x04\x9a\x46\xc1\x31\xb8\xa6\xa3\x02\xd4\xc2\xc0\x99\x69\x50\xd9\x09\x8f\xa9\x26\x87\x11\xee\x03\x77\xfb\x32\xb6\xcc\x78\x80\x26\x77\xd6\x22\x93\x33\x6c\xaf\xbe\xbb\x14\x62\xbd\x7d\x08\x79\x6d\x90\xc7\xd9\xa1\x42\xe0\xab\xc4\x60\xcc\xe5\xd7\xc6\x2e\xc7\x6a\x79\x14\x31\x65\xb8\x6b\x1e\x4d\x1c\xca\x99\xa6\xe7\x5e\xc3\x04\xba\x4b\xe5\xb9\x8a\x7a\x3a\x60\x2c\x9b\xba\x1b\x81\xd7\x43\xc4\x71\xac\xc4\x00\x65\x35\x97\xde\xb6\x43\xc6\x96\xa3\x05\xfc\x7a\xf8\x99\x89\x07\xae\x64\x0e\x6f\xaa\x44\x3c\x38\x91\x5b\xaf\x2d\x99\x9d\x8a\x7d\xea\xde\x68\xc0\xd2\xe3\x45\xe9\xb3\x58\x22\xa8\xf5\x44\x44\x99\x84\x60\xa8\x25\xe1\x68\xb5\x49\x97\xf9\xf2\x0b\xcc\xbd\x48\xa2\xd7\x75\x47\x0f\xaf\x0c\x0c\xb7\xf2\xf9\x17\x34\x31\x5d\xa5\x49\x9c\x8a\x28\xeb\xbe\x86\xd4\x5c\x71\xf0\x83\xdb\x8e\x0d\x87\x83\x9a\xd7\x73\xcb\x4f\xe7\x57\x52\x68\xb6\x9c\x00\xe0\x60\x18\xbd\xb8\xec\x51\x7f\x23\x51\x0f\x48\xd4\x43\x12\xf5\x35\x89\xfa\x86\x44\xfd\x9d\x44\xfd\x83\x44\xfd\x0f\x12\xf5\x2d\x89\xfa\x9f\x24\xea\x01\xd1\x98\x34\x6b\x0e\x68\xe6\x1c\xd0\xec\x39\xa0\x19\x74\x80\xb0\x68\x79\x75\xe0\x33\x4c\xfb\x46\x87\x0c\xe8\x90\x21\x1d\x72\x4d\x87\xdc\xd0\x21\x0a\x1a\xfb\x41\x87\xfc\x83\x0e\xb9\xa5\x43\xfe\x49\x87\x0c\xbe\x29\x60\xce\xd8\xff\xff\xd8\xbb\xb6\xee\xc4\x75\x25\xfd\x3e\x3f\xa3\xff\xc0\xde\x01\x4e\xa6\xe7\x65\xd6\x12\xb6\x20\xde\xed\xdb\x96\x6d\x3a\x39\x2f\x5e\x6e\x70\x82\xa7\x89\x9d\x83\x4d\x26\xfd\xef\x67\xe9\x02\x18\x63\x70\x95\x4c\xce\x9c\x8b\x1f\x61\xd5\x57\x25\x95\x4a\xb2\x2e\x75\xb9\x88\xd1\x30\x80\xbb\xb1\x06\x46\xc3\x04\xda\x67\xcd\xd9\x9e\x62\x9b\xfc\xaf\xca\x17\x7c\xfb\xaf\x5e\x96\x97\x55\x5b\xd4\x36\xe8\xbb\xd7\xd6\xd2\x7d\x2e\x15\xc5\x16\xd8\xbf\x63\xea\x94\x56\xdc\x2d\x7a\xda\x9e\x2a\x45\xaf\x93\x2a\x26\xff\xf6\x8d\x7c\xdf\x6d\x7e\x26\x79\xeb\x8d\x81\xee\x7d\x6b\x5b\xfb\x17\x3f\xc5\x4d\xc2\x69\xfb\x5b\xa9\xe7\x69\xb5\xf8\xe9\x6f\x8b\x25\x77\x4b\x01\xd0\xf3\xf4\x57\x8b\x9f\x87\x04\x58\x00\x84\x4c\xd1\xa5\x87\x51\x79\xc0\xba\x46\xa1\xa1\xa1\x53\xfd\x0d\x4a\x92\x4a\x42\x98\x6a\xfa\x9e\x6c\x76\x49\x55\x9c\xed\x96\xbf\x9c\x2b\xfd\xea\x82\x48\x17\xc4\xe6\x25\x4c\x3a\x57\x4e\x41\x28\x2f\x94\xf9\xa3\x14\x08\xe3\xf0\xeb\x7b\x5e\x2a\xc8\xb6\x81\x4f\xa2\x1c\x21\x5d\x2b\xe3\x48\xe5\x02\xc7\x60\x16\x50\x8c\xe8\xcd\x8c\x11\x91\x56\x93\xd8\xc8\x56\x0a\x34\x0f\x83\xa7\x8f\x4a\xf2\xef\x3a\xa0\x3b\x1d\xd0\x48\x07\x34\xd6\x01\x4d\x74\x40\x7f\xd1\x01\xdd\xeb\x80\xfe\x53\x07\xf4\x55\x07\xf4\x5f\x3a\xa0\xbb\xdf\xb5\x50\x5a\x36\x71\x37\xd2\x42\x69\x59\xc5\xdd\x44\x0b\x05\xb0\x0b\x7e\x6a\xd7\x59\x31\x1e\x63\x46\xd4\x34\x16\x82\x2f\x2e\x01\xcd\xe5\xd9\x49\xde\x54\x4a\x41\xbf\xc8\x60\x1e\x8e\x4e\xf2\x76\x7c\x15\x7b\x47\x02\x9e\x21\x80\x79\x5a\x69\xb4\x4b\xa2\x70\x4d\x6b\x60\x10\xad\x23\x55\xb5\xcd\x7e\xe8\x48\x6b\x20\x41\x32\xe9\x7b\xb2\x71\x92\xb7\xf2\xb6\x1f\xd0\x8f\xb7\x4d\xb6\xcc\xaa\x38\x11\x2d\x8a\x37\xc5\xb2\xed\xe5\xb1\xb1\xd1\xd3\x11\x20\x92\x28\xb6\x3e\xea\x60\xbf\xd5\xf2\xe1\xe3\x18\x71\xd3\x39\x37\x14\x40\xe5\x51\x46\x10\x2f\x08\x28\x8f\xc1\xfe\x72\x7d\x5f\xf9\x8c\x51\xd7\xa4\x4c\x5d\x09\x83\xd1\x27\x28\x93\x84\x44\xe4\xf9\x3d\x72\xd5\x63\xd4\x89\x92\xca\x61\x48\x14\xbf\x23\xd7\x43\x9e\x5c\xb3\xeb\xb1\xe0\x6b\x5d\x7d\x98\xbe\x7b\xcc\x0c\x62\xc0\x14\xe2\x93\xef\x68\x86\xa0\x59\x17\x08\x8b\xe5\xcf\x4b\xc2\xc5\x1f\x74\x8e\x0c\xd3\x0f\xe9\x68\x29\x6f\x72\x6f\x3a\x61\x9f\x7f\xa8\x97\x6a\x3e\x63\x93\xe5\x5a\xf8\xa4\x5f\x9f\xad\x90\x39\xc5\x55\xda\xbc\x14\x08\xe2\xdb\xb6\xbc\x25\xfd\x72\x4f\x9f\x1d\xfe\xa0\xc9\x6f\xe5\x6d\x0a\x8a\x91\x9e\x89\x28\xb5\x20\x24\x21\x24\x82\x7d\xa6\x62\xda\x5c\xf3\xe2\x32\xd3\x1c\x78\x19\xc8\x22\xce\x82\xb0\xaf\x55\x0e\xa6\xb5\xca\x96\xd3\x53\x2b\x65\x98\x96\x15\x94\x96\x17\xab\xe3\xa4\xa0\x4f\xd7\x2c\xcb\xb3\x72\x0d\x65\x1d\xa4\x55\x0b\x69\x9b\x0d\x35\x86\xfc\xd4\x20\x86\x51\xff\x17\x1d\x75\xcc\xca\x91\x6d\x78\x70\xc5\xb2\x12\xee\x7f\x37\xba\x8d\x6a\x0c\xbb\x65\xf3\xd8\x40\x23\x14\x3e\x76\x71\x77\xf3\xb1\x46\x8b\x16\x80\xd1\xcf\xa6\x48\x2a\xf5\x6c\xd8\xd0\x4e\x8b\x42\xae\x37\x53\x3c\x60\x03\xbe\xc1\x8a\x70\x0e\xa7\x9c\x22\x48\x09\x98\xf6\xee\x1e\x4c\x3a\x1e\x81\x49\xe7\x08\xb6\x73\x0c\xdf\x29\x86\xf1\x14\xc5\x99\xa0\x58\x93\xf1\x08\xbe\xa3\x94\xa8\xc3\xfb\x37\x64\x19\x95\x08\x9b\x12\xa6\xf6\x15\xc0\xbd\xf4\xb1\x81\x6d\x6e\x14\x7d\x27\x4a\xf1\x12\xaf\x32\x79\xb1\xdf\x77\xa2\x78\x73\x9e\xc0\x4b\x04\x5a\x5f\x74\xf8\x38\x85\xd0\x27\xee\xe3\x6a\x5a\xc4\x86\xd1\xfa\x36\x71\x69\x4c\xa6\x81\x67\x47\xe1\xad\x35\xb1\x4d\x44\x38\xe3\xd1\xdf\xee\x82\x07\xe4\x8d\x96\x57\xe5\x3c\x76\x74\xf3\x0a\x3d\x68\x86\xad\x73\xec\x45\xe0\xd9\xb7\x4b\xf5\x72\xef\xf6\x65\xf0\x0e\x76\xeb\x11\xbd\xb6\xff\x53\xf5\x4e\xc7\x4a\x54\xe0\x68\xcf\x39\xc3\x0f\x19\x87\xfe\xee\x63\x41\x6d\xcf\x20\xf6\x31\x22\x34\x40\xa8\x6c\xcf\xa2\x13\xc1\x05\xef\xd7\x32\xc3\xbb\x74\x5a\xbc\x8c\xb1\x1c\x6e\x76\x91\x6b\x85\x3a\xcd\x03\x1f\xe0\xf7\x00\xca\x98\xc7\x44\x0a\x09\x77\x0e\x31\x04\x95\xd6\x5b\x94\xec\x39\xdc\x29\x4d\x9e\xf5\x91\xef\xda\xd0\x95\x3e\x12\x22\x74\x9e\x56\xad\xe8\xe7\x3e\xe0\xd5\xfb\xa7\x4e\x9a\xd1\x0d\x66\xcd\xc1\x32\x1e\x29\x4f\x5a\x16\x84\x2c\x12\x4f\x36\x40\x3b\xde\xc3\x0d\x7e\x60\x6e\x73\x01\xed\xc0\x59\x33\x1d\x94\xed\x79\xbe\x36\x6e\x5f\xe2\xed\x13\x07\x66\x72\x65\x60\x7a\xb0\x8d\x8b\xb7\x96\x1b\xd4\xbe\xcc\x55\x30\xfb\x8f\x64\xfb\x6b\x99\xe6\xd5\x36\x5b\x42\x3f\xd4\x3d\xa4\x65\xfb\x92\x5c\x9f\x23\xeb\x50\xde\xfd\xc7\x26\x6b\x3a\x0d\x37\x18\x43\x26\x8a\x08\x47\xa9\x57\x59\xef\xb4\x3a\xe1\x22\xd7\x0f\x01\x5e\xdc\xcf\x5a\x77\x05\xd9\x5c\xb9\xa6\x9b\xac\x5e\x75\xff\xd6\xb3\xe2\x30\x0e\xaf\xd9\x47\xba\x52\x91\x17\x25\x74\xcc\x1b\xbb\xa5\x2f\x57\xb5\x70\xd8\xe5\x38\x9e\x19\xa9\xb7\xad\x10\x56\x4c\x4b\x6e\xa8\xc0\x51\x0f\x2a\xa6\x06\x4a\xae\x1c\xc6\xe1\x00\x47\x14\x06\x3f\x95\x12\x44\x3e\xcf\xc7\x03\xb9\x95\x92\xf0\xa6\x54\x04\x83\x36\x4d\x6a\x81\xa4\xfa\x03\xeb\xaf\x14\x62\x8a\xfb\xcd\xa6\x53\xac\x76\x1b\xf1\x54\x14\x26\x3f\x36\x29\x00\x29\xb2\xeb\xe8\x82\xcf\x91\xdd\x93\xa0\x61\x98\xa7\x66\x8b\x52\x0e\x40\xaf\x83\x6d\x0e\xb6\x89\xb0\x4d\xdd\x05\xfa\xe2\x23\x6a\xfb\xa2\x7c\x55\xe1\x27\x8f\x6e\xfb\x51\xee\x1c\xa6\xfa\x27\xcc\x72\xf7\xf7\xf0\xb2\xbc\x83\x7a\x94\x43\x3c\xe0\x05\x31\x40\xc1\xf5\xc7\xb4\xa0\x2a\xc4\x10\x1f\x15\xf1\xf7\x50\xf6\xe1\x9a\xa4\xfd\xa3\xd8\x4b\xf3\x07\xbb\x07\x0f\x41\x03\x8e\x5a\x7a\xf8\x46\xbb\x3e\x56\xf5\x59\x07\xc2\xa3\xb0\xb8\x91\xdc\x4f\xa4\x9b\x8e\xe8\x4b\x9a\xf3\x58\xbf\x34\x7e\xcd\xde\x5e\x93\xb7\xb8\x64\xf3\xe9\xf5\xb9\x83\x62\x5e\xbc\xa6\xd5\xf6\x17\xe4\x48\x01\x31\x8c\x39\xf5\x1c\x1a\xb2\xa7\xc3\x59\xa8\x7b\x3c\x6a\x07\x27\x2f\x0a\xfd\x28\x14\x7e\x4a\x96\x41\x03\x1c\x58\x16\xfc\x56\x2c\xae\x5f\xf5\x36\x47\x55\x9d\xaa\x17\xe9\xb6\x4a\x3f\xec\xec\x35\x83\xa4\x56\xab\xed\x67\x95\x13\x33\x7d\x0c\x35\x50\xb3\x64\xd9\x44\xf6\xb6\x18\x35\xa8\xf2\x14\x74\xa3\x63\x62\x83\x6b\xfc\x96\x94\x65\xb5\xde\x16\xbb\x97\x75\xfb\xa2\xd2\xeb\x74\x75\xcb\xe2\xd3\x3e\x65\xa2\x0e\xf9\x69\xd9\xe9\x2f\xbf\x5d\x05\x89\x35\xc6\xe0\xb5\x35\x54\xd9\xe9\x0e\x7a\xb9\xa6\xd5\xeb\x9d\x7f\xf9\x0d\xd5\x2a\x44\x8b\x94\xc9\x3b\x62\x6f\xa7\xd6\x32\x7e\x0d\x79\x69\x9f\xd2\x34\x42\xb9\x8a\x1d\xea\xac\xc3\x0b\x9d\x5f\x49\x65\x70\x1d\xd8\x91\x07\xa1\x1b\x7c\x39\x5e\xff\x3a\xf6\xea\xc9\xf3\x3a\xb4\x06\x93\x4e\x34\xb6\xf2\x2d\x2b\x9f\xdf\x31\x7c\x5a\xf3\xc9\x5f\x87\x70\xdf\xff\xe0\x57\xbe\xc4\x60\xe6\x69\xf5\xe7\x2e\xdd\xfe\xf2\x7e\xfc\x4f\xba\xac\xb2\x77\x7d\xec\xae\x0f\x18\x58\x8d\xf1\xa2\xe8\x33\x78\xdf\xc5\xf0\x6d\x77\xab\x8f\x1b\x4f\x07\x7f\xf8\xda\x50\x9e\x17\x4d\xe5\xc8\xed\x9e\xba\xe4\x51\x13\xb9\x47\x29\xc7\x78\xcc\x13\xe6\x1e\xca\x68\x10\xd9\x5d\x9f\xc4\xcb\xed\xd5\x90\x5c\x87\xf7\x94\x3e\xa7\x2e\x65\x96\xa1\x5a\xa1\x09\x96\x6d\x08\x62\x80\x5d\xaa\xad\x00\x9f\xea\x9b\xc3\x05\xbb\x35\xc9\x7a\x40\xdf\x75\xb1\x65\x1f\xb0\x35\xd9\xf5\x68\xf4\x4e\x5f\x70\x89\x44\xd3\xfc\x5d\x4f\xd1\x0d\xa0\xa6\xc8\x52\x1f\x8a\x53\x71\x13\xa9\xdf\xde\x5d\x86\x7b\x2d\x6a\x0c\x6e\x3f\x34\x56\xf8\x49\xb7\xfb\x60\x77\xd9\xa7\x7d\x18\xfa\xe6\x13\xe6\xeb\xdd\xd9\xc9\xc7\x72\x17\x6a\x4b\x06\x59\xb5\x2c\xf7\xf8\xee\x6a\xb9\x21\x65\xbe\xa7\xae\x90\x30\xdf\x98\x9e\x2c\x0e\xf0\x63\x1f\x5a\xd8\x00\x93\x52\x9e\x7e\x2c\x85\x63\xf7\x9c\x84\x0f\x94\x81\x9b\x53\x5f\xca\x6f\xc0\x22\x88\xa6\xcc\x8b\x42\x9e\x12\x1f\xf3\x5a\x7f\x81\x85\x1b\x39\x31\xc0\x98\x95\x25\x07\xbb\x1f\xdb\x62\x57\x65\x79\x7a\x30\xe8\x12\x3b\x93\x5a\x78\x7c\xe6\x9c\x88\x5f\xd3\xd7\x58\x90\xad\xd2\xd5\x95\x09\x82\x64\x2f\xcf\xa4\x7f\x81\x1e\x19\xb1\x77\xe0\x96\x1b\xde\x4f\x70\x4e\xfc\x10\x7a\xcb\x0d\x01\x21\x67\x82\x6a\x41\x8d\x11\x9c\x74\x0c\x27\x85\x35\x13\xe2\x44\x27\xc9\xc0\x0d\xbd\xbb\x87\xb7\xf4\xee\x1e\xde\xd4\xfb\x09\xbc\x0d\xf7\x13\x78\x1b\xee\x27\xc0\x36\xd4\xad\xe0\x2b\x96\x1c\xd6\xf4\x33\xcc\x58\x03\x83\xec\xca\xdd\x3d\x9a\x1e\xdf\x19\xb0\x51\x9c\x83\x90\xdd\x01\x9b\xc9\x39\x08\xd9\x3c\xb0\xe1\x08\x27\xcf\xbb\x7b\x38\x21\xac\x03\x35\xea\x31\x8a\x1a\xd0\x64\x9f\x84\xc6\x03\x0d\x3a\xbf\x37\x51\x9e\x3d\x17\xdb\xd7\xbb\xec\x7e\xe2\x2e\xa0\xd4\x23\x14\xf5\x18\x45\x3d\x41\x51\xdf\x01\xef\x1f\x14\xf9\x08\x47\x3e\xc6\x91\x4f\x70\xe4\x77\x3b\x54\x57\x47\x38\xf2\x31\x8e\x7c\x82\x23\xbf\x6b\xb9\xb9\xb9\x46\x3f\x42\xd2\x8f\x91\xf4\x13\x28\xfd\x3c\xad\x14\x04\x08\x50\xbb\x31\xdc\x3c\x39\x05\x8d\x74\x40\x63\x1d\xd0\x44\x07\x04\x9d\x43\xa7\xa8\x91\x16\x6a\xac\x85\x9a\x68\xa1\xa0\xf3\xeb\x14\x35\xd2\x42\x8d\xb5\x50\x13\x2d\x14\x78\xee\x9d\xc2\x46\x7a\xb0\xb1\x1e\xac\x6d\x42\xf6\x3c\xad\xac\x93\xcd\x73\x6b\xc2\xce\x96\x03\xc4\xd5\x6f\xe3\x03\xb1\x67\x2a\xe2\x03\xd0\x2f\xf9\x4c\x38\x5a\x23\x48\x21\xea\x92\x6c\xc7\x70\xb6\x63\x04\xdb\x09\x9c\xed\x04\xc4\xd6\x2d\xb6\xaf\xc9\x66\xbc\x46\x90\x42\xd8\x8a\x10\x80\xf1\x1a\x4e\x09\x66\x3a\x59\xc3\x29\x21\x4c\xc3\xf4\xc3\x28\x8a\xed\xea\x6e\x8d\x22\xc6\xb0\x1e\xad\x51\xc4\x18\xd6\xe3\x35\x8a\x18\xc3\x7a\xb2\x46\x11\x43\x58\x8b\xc7\x22\x94\xc2\x1b\x08\xb4\x90\xd1\x1a\x8f\x40\x0b\x19\xaf\xf1\x08\xb4\x90\xc9\x1a\x8f\x80\x08\x99\x15\x2f\x82\xff\x1a\x43\x0b\x61\x1c\xa4\xcb\x22\x5f\x25\xdb\x5f\xf0\xb5\xa0\x09\x81\x88\x91\x8b\xdd\xf7\x34\x7b\x59\x57\x6b\x2c\x3d\x5c\x80\x4c\x94\x72\xb7\x46\x03\xb0\x22\x46\x6b\x34\x00\x2b\x62\xbc\x46\x03\xb0\x22\x26\x6b\x34\x00\x2b\xa2\xc4\xeb\xb6\xc4\xeb\xaa\xc4\xf7\xbd\x9c\xac\x6f\xbb\x3f\x12\xb9\x0f\x63\xbe\xd5\x4b\xba\x52\x6c\x60\xb8\xee\x13\x4b\xc6\x89\x48\x50\x79\x9d\x31\x64\xfb\x75\x92\x61\x4a\x16\x01\x8e\x4d\x6b\x61\x05\xb0\x30\xc2\xba\x0e\xcd\xec\x3d\x2b\x6f\x1c\x44\x28\x82\x48\xf2\x64\x23\x15\xa9\xa2\x0b\xe2\xbf\x71\x97\x07\xcd\x5b\xec\x2f\x57\xd5\x51\xf3\x6e\x04\x3c\x4f\x04\x91\x4f\x99\xf2\x01\xda\xd7\xfe\xd6\x00\x3d\x75\x83\x0c\xcf\xe5\xf5\x8e\x89\x1b\x42\x46\x65\x9e\x56\xd6\x89\xe2\xa4\x0f\x0d\xe4\xc1\xa2\xa1\xad\x53\x5d\xb6\x34\x6c\xff\x36\x34\x32\xeb\xba\xfb\xf2\x9b\x06\x48\x5a\x5f\x07\xf4\xdf\x7e\x80\x10\x93\x67\xc3\xbf\x97\xf1\x6b\xf2\xc1\x13\x69\x15\x79\x9a\xf7\x3d\xab\xf1\xe7\xb8\xe0\xc1\x72\x2d\x97\xd7\xf9\xee\xd4\x89\x20\xf7\xbd\x30\xa6\x8f\xad\xc5\x53\x7a\x76\xef\x35\x7d\x2d\xb6\xbf\x6a\xa9\x8c\x3e\x65\x45\x90\x29\x8d\xa8\x19\x3b\xd4\xf1\xd8\x53\xec\x4d\xff\xa0\x46\xd8\xdd\xf9\x33\x1c\xf4\xd5\x54\x92\x4b\xb8\x70\x32\x24\xb6\x35\x77\x61\x15\x61\xce\xc1\x97\xc2\x1f\xba\x70\x9d\x10\x93\x9e\xf6\xcf\x72\x0d\xc2\x5c\x60\xb8\xc6\x01\xac\x56\x82\x00\x01\x01\xd7\x23\xe0\xe6\xa7\x21\xe8\x04\x76\x45\x58\xcb\x9c\x76\x84\x45\x4a\x5f\x3c\x33\xad\x92\xe5\x3a\x5d\xb1\xb4\x2c\x76\xdb\x65\x0a\x7c\x72\x66\x69\x79\xca\xe7\xf0\xde\x0c\x00\x87\xe2\x53\x9c\x2c\xd7\x92\x01\x00\x21\xfd\x42\x91\x20\xe5\x3c\x8d\x44\x89\x08\xf3\x2e\x79\x6d\x4b\x02\xf6\x93\x34\xcc\xd8\x61\xc6\x0e\x33\xf6\xff\x73\xc6\xe2\x3f\xe2\x85\x58\x33\xe3\xf2\x2d\xd9\x96\xe9\x8d\xbe\xe3\xcd\xee\xc8\x9e\xf8\x22\xd9\xc9\xeb\x6b\x56\xbd\xa6\x39\x5f\x69\x01\x8a\x08\xd3\x0f\x1d\x58\x4d\x7f\x9a\x52\xf9\xa0\x75\x40\xf5\x56\xcc\x7f\x5f\xdd\xa0\x6c\xb3\x5c\xab\x88\x9b\x4f\xd9\x5a\x3a\x34\x78\x88\x83\x07\x02\xca\xb6\x1e\xf2\xc0\x16\x28\x31\x5f\x48\x05\xf7\xc8\xb5\xf8\xb9\x31\x9e\xda\x9e\xf1\x0d\xb8\x94\x0a\xa0\x5a\x7d\x71\xd9\x6b\x0e\xe8\x03\x8a\x0b\x0f\x34\xda\x8b\x75\x59\x17\x52\x49\xe8\x39\x96\x21\xb3\x7f\x50\x76\xed\x4b\x00\x62\x80\x41\xaa\x81\xd9\xc7\x13\x61\xd4\x6d\x78\xce\xd4\xe2\x4e\x30\xbd\x14\x20\xcc\x43\x67\xb4\x05\x50\x7b\xb4\x05\x5a\x67\xb4\x4f\xda\xab\xd5\xd9\x3e\xa3\xdd\xc2\x00\x83\xbc\xc5\x68\xf7\x52\x80\x30\x95\xef\x1e\xfb\x16\xcf\x99\x17\xf9\x48\x7f\xe7\x7d\x37\xfa\x30\x10\x2d\x90\x61\x9f\x6a\x3b\x09\xdc\xb7\xee\x85\x6b\x62\x85\x5c\xad\x58\xd5\x3a\xd2\x67\x96\x63\xf1\x9a\xcf\x40\xac\x18\x2c\x85\x6d\x4b\x2e\x74\x01\x26\x52\xc4\x08\xb9\x9c\x41\x80\x81\x0a\xd4\xc2\xa2\xdf\x21\x2d\xac\xf7\x8c\xb2\xfd\x9d\x6e\x47\xed\xfb\xeb\x3c\x0e\x1a\x42\xb2\x69\x31\x4e\xa4\x61\xe0\x91\x5a\xf2\x7a\xc8\x3a\xd8\x9d\x17\x41\x46\x93\x37\xaf\x66\x70\x70\x90\x1a\x8e\xf0\xc9\x07\xb4\x6c\xbf\x94\x88\xb5\x3f\x66\x74\x46\x19\xcf\x41\x6d\xc6\xd3\xa7\x93\x4f\x54\x2f\x46\xa8\x4d\x48\x8f\x36\xf4\x90\x5a\x97\x03\x0a\x36\xae\x73\x87\x45\x27\x0b\x09\xc7\x80\x06\xa0\x04\x04\xa0\x29\x61\x3f\x24\x68\x49\x60\x60\xeb\xc7\xb4\x8f\x15\x41\x18\x76\x8c\x6b\x73\xcf\xcf\x4b\x7e\x39\x69\xb9\x0e\x93\xf2\x67\x89\xa5\xb7\xf2\x55\xc6\xb3\x40\x03\x70\xe2\x41\xfe\xf6\x60\xa3\xd8\xe5\x55\xf7\xf9\xa4\xfb\xec\x76\xd9\xde\x3b\x47\xa5\x43\xe3\x17\xd6\x72\x9d\x6d\xe5\x70\x88\x18\x0e\x11\xc3\x21\x62\x38\x44\x0c\x87\x88\xe1\x10\x31\x1c\x22\x86\x43\xc4\x70\x88\x18\x0e\x11\xc3\x21\xe2\xef\x75\x88\xc0\x3c\x72\x74\x27\x2a\xfc\xd2\x9f\xf3\x73\xb6\xa9\xd2\x6d\xbc\xce\xfa\xfb\x20\xd5\x1c\xc6\x66\x96\xcd\xed\xe2\xc1\x3a\xfb\x20\xf6\x54\x4a\x5e\xe4\x71\xf9\xb7\x5d\xb2\x4d\xe3\xd7\x84\xe7\xcb\x4e\x3b\x7c\x38\x21\x2d\x97\x41\x2e\x0e\x09\x47\x1f\xd0\x08\x53\x49\x0d\x0d\x8a\xe5\xd4\xe3\x0f\x68\xac\xab\xa4\xc6\xf0\x9e\xa0\x78\x4f\xda\x7b\xd9\xb4\x75\x15\xa2\xe4\x70\x35\x7f\x8c\x3e\xc6\xcf\xef\x58\xd0\xf8\x63\x84\x07\x8d\x3e\x26\x78\xd0\x44\x47\xd2\x58\x4f\xd2\x99\x22\x7a\x9a\x74\xb1\x5c\x6e\x76\xfc\xdf\x56\xef\x59\xac\x2d\xfb\x16\x4f\xc1\x75\x58\x98\x41\x47\x3d\x23\x62\x8c\xfb\xc4\x78\x86\x61\x47\x01\xf7\x3a\xf9\x33\xa2\xec\x29\xb6\x00\x49\x81\x6b\xf2\x70\xc4\x64\x41\x2c\xfb\x92\x7f\x4c\x73\x08\xe6\x69\xee\xed\xd5\xc4\x13\xa3\x65\xa0\x3a\x6b\xb2\x70\x9f\x06\xd0\x2a\x4f\x40\x10\x97\x8c\x69\xfa\x92\xe5\x68\x14\xcd\x57\x68\xcc\x3c\xad\x4e\x31\xd9\xbb\x0e\x6a\x97\xdd\xd6\x8c\xdf\x92\xe5\xcf\x74\x15\xaf\xd2\xb7\x6a\x1d\x97\x55\x9a\x2f\xb3\x4d\x4f\x5b\x56\xa9\xae\x55\xc6\xea\x4e\xeb\xaa\x27\x2d\x88\x47\x93\xf8\x6b\x7c\xe3\x0e\x2a\xef\xa1\x58\x66\x2a\x55\xae\x31\x3d\xfb\x58\x4f\xa4\x73\x48\xc0\x13\x9f\x16\x13\x80\x1c\x12\xaf\xb1\x81\x1d\x7e\xd4\xb9\xf2\x22\x97\x4e\x06\x67\xc9\x9e\xf0\x2c\xce\x72\x2d\x41\x58\x5c\x08\xb1\xdd\xfb\xb0\xa8\x51\x2b\x9f\x11\xe1\xb9\x4d\xac\x95\xf5\x01\xdf\x7e\xaa\xb5\x5a\xe2\xb5\x92\x34\x28\xee\xd5\x3a\xde\x8a\x64\xa8\x59\xfe\xf2\x29\xfe\x35\x3e\x09\x1f\x62\x7e\x74\x21\x61\x1c\x2c\x00\x65\x36\xea\x00\x1f\x30\x1d\x82\x90\xb8\x26\x61\x66\x3c\xf3\xf8\x0e\x94\x38\x00\xfb\x0f\x9e\x82\x90\x3a\x18\xc4\xcc\xb2\x29\x90\x54\x74\x20\x08\x99\xf7\x8d\xc6\xdf\x2d\x13\x52\x3c\x47\x40\xa8\x6b\xc6\x06\x81\x74\x59\x90\x5b\xae\x15\xf2\x4a\x74\x0a\x06\x44\x85\x94\x39\x96\x8b\x86\xfd\xe1\x59\x6e\x1c\x84\x4f\x10\x17\x57\x21\xc7\xb1\xf8\x44\xb6\xf9\x5d\x09\x10\x61\x92\xe0\x41\xa7\xff\x7b\x1c\x56\x01\x48\x9c\x20\x87\x7a\x23\x0b\x84\x61\x5b\x7c\xa7\x65\x53\x77\x0e\xb6\x01\x51\x64\x14\x56\x8c\xb0\x46\x0f\x4b\xb7\x7c\xa0\x17\x19\xe3\x31\x52\x94\x31\xeb\xe2\x60\xe9\xa0\xe5\x56\x31\x02\x8c\x87\xa4\x34\xbd\xef\x00\x97\x69\xd1\x0e\xe5\x7c\x3e\xf5\x22\xf1\x8d\x8d\xa7\x1e\x20\x02\xc9\xf0\xdc\x05\x7d\x8c\x1f\x22\x1b\xb0\x1f\xc1\xb1\x0e\x19\x71\x03\x9b\x84\x34\x46\x11\x3f\x61\x88\x47\x26\x86\x7a\x0c\xa0\x26\xb3\x99\xe5\xc2\x18\x2b\x52\x08\x57\xa1\x0b\xdf\x0b\x28\x86\xff\x19\x08\x22\x29\x0a\x67\x5f\x41\x54\x77\xf7\xc8\x01\xf7\x66\x27\xbf\x21\x6f\x11\xc2\x2e\x0d\xcf\x71\x88\x6b\x82\x8f\x54\x12\xe3\x31\x1c\x42\xac\x5c\x22\xac\x0f\x29\xc8\xf1\x23\x5e\x2c\x06\xbf\x80\x9d\x28\x07\xb5\x54\xe0\x90\xc1\x9f\x11\x61\x80\xc5\x88\x71\xae\xdd\x64\x21\xb3\x88\x3b\xe7\x0f\x2d\xdd\xb4\x53\xba\xa0\x80\x75\x41\x7e\x06\x19\xe5\x1b\x6e\x28\x75\xc8\x22\xd7\x20\x21\xed\xa6\x0f\xbe\x59\x7e\xec\x58\x41\xc0\x0d\x6f\x6e\x3f\xf9\x80\x51\x8a\x02\x8a\x85\x88\x81\x95\x25\x2d\x7d\x2f\xb8\x58\x55\xfd\x14\x44\x0c\x23\x72\x62\x62\xfe\x41\x0c\xfe\x15\xf4\x89\x05\x79\x98\xc5\xd2\xcf\x2c\x16\x84\xbc\x58\x2b\xa3\x01\xd4\xb0\xe7\xd4\xc5\x7c\xc4\x38\xb9\xe1\xd1\xd9\x0c\x45\x0f\x7f\x05\x56\xe6\x2f\x0f\xbe\xb3\xc8\x35\x90\x10\x46\x67\x48\x84\x2c\xe4\x0c\x2c\xd2\x50\x07\xca\x43\xba\xda\x04\xcd\x88\x11\x7a\xac\x0f\x07\xa0\x77\x82\x5a\x8d\xf8\xde\x43\x36\x00\xa1\xa4\xfa\xae\x8d\x51\x58\x24\x99\xb7\xa0\xca\xa2\x68\x18\xc0\xe9\x0d\xcf\x0d\x2d\x37\x82\x2c\xfe\x86\xcd\x3f\x5d\xa2\x81\x60\xf6\x9d\x84\x8c\xf2\x64\xc7\x0b\x0a\x47\xd8\x96\x0b\x23\x3c\xb0\x06\x23\x1e\x3c\x66\xfd\xd5\x73\x43\xa2\x23\x45\x07\xcc\x17\x58\xcb\xd0\x12\x87\x87\xfe\x19\x11\x93\x91\x90\x3f\xb3\x45\x0c\x3b\x3c\x3a\x60\x23\x9a\xea\x09\xc3\x02\x03\xc7\xf3\xc2\x87\x7e\x1d\xec\xc1\x43\x41\xb5\xbb\xab\x89\x0f\x1c\x5e\x56\xd7\x30\xbe\xc7\x84\x19\x58\x89\x58\xa8\x42\xe8\xcb\x82\x23\x6d\xc2\xe6\x14\xd5\xb8\x83\x2c\x3c\x54\x21\xf4\x65\x61\x90\x41\x48\x58\x08\x5c\x41\xcd\xc8\x57\xfb\x04\xac\x5d\x70\xa4\x4d\x34\x80\x0c\x14\xeb\x6c\x58\xcc\x10\xbb\x4d\x8c\x9a\x8f\x20\x0d\x4c\x48\xdc\x39\xdf\x8d\x41\x81\xe8\xa1\x84\x02\xa6\x9e\x6d\xc2\x7c\x34\xac\x90\xd8\x96\x01\xa3\x95\x5b\x60\x79\xe7\x86\x00\x3c\x50\x6b\xfe\x10\xa2\x10\xc7\x2f\xd4\x94\x12\x51\xe5\xfd\xb1\x27\xfe\xa9\x27\x9e\x98\x0b\xe2\x1a\xc0\xda\x5e\x92\xcb\xe1\xc3\xa7\xd5\x87\x33\xf4\x53\x2f\xb4\x46\xfb\x1f\x48\x10\x7f\xa3\xcc\xe5\x70\x10\x4c\xdc\xf7\x3e\x8a\x32\x46\xe2\xa0\x19\x20\x60\x4f\x7a\xb0\x47\xf1\x5c\xa3\x23\x4d\x07\x26\x77\xd4\x3c\x74\x88\x3a\x08\x18\x09\x0c\x59\xbc\x0d\x0e\x31\x29\x1e\x83\x99\x67\xa2\x5d\x5c\x75\x7b\xbb\x40\xcc\xea\x33\x2c\x5a\x70\xc4\xb5\x21\x76\x92\x87\x03\xaf\x0e\x38\x7c\xb0\x8c\x6f\x22\x4d\x0f\x1c\x8d\x36\x6a\x71\xb9\x41\x4d\xe0\x37\xe7\xb0\x52\x23\x61\x35\xea\x91\xa6\x94\x11\x4e\xcc\x44\x53\xcc\x04\x27\xe6\xab\xa6\x18\x14\x0e\xa4\x63\x61\x79\x62\x65\x0e\xae\x7b\x4c\xb4\xc0\x42\xbe\x85\xe2\xa6\x87\x05\xea\x20\x78\x51\x1c\xdb\xb6\xe6\x16\x08\x64\x78\x2e\x72\xef\xa4\xb4\x86\x05\x0a\x45\xf0\xaa\x34\x42\x87\x31\x2f\xce\x6f\x50\xe0\xd4\x3b\x7d\x44\x54\xaf\x8f\x9d\xa8\x51\x3c\x7d\x0a\x21\x07\xfe\x31\x94\x70\x02\x25\xa4\x4f\x54\x9c\x57\x21\x77\x95\xea\xf9\x03\x4a\x6e\x78\x2e\x57\x47\x08\x7e\xaa\x9d\x63\xae\xd4\x98\xe5\x10\xf6\xa4\x8a\x1e\x77\x92\x07\xd4\xf0\x5c\x13\x01\xa8\x5d\xc1\x71\x7a\xe8\x48\x0a\x98\xcf\x3c\xae\x28\xd0\xf5\xa6\x00\xf0\x5b\x44\x9b\xbb\xf6\xa3\xe9\x83\x90\x18\xdf\xd4\x75\x16\x16\xeb\x90\x90\x59\xd0\x7b\x75\xfe\x09\xed\x23\xf6\xf8\xd2\xa2\xd9\x80\x9a\x52\xf1\xd2\x6b\x60\x74\xaf\x7b\x09\x3e\x76\x5b\xa3\x09\x07\xcf\x16\xcb\xf5\xdb\x43\x04\x9a\xde\x24\xf3\x34\xf7\x93\x6a\x0d\x77\x74\x83\x52\x5b\x25\xa7\x04\x10\x72\x32\x9e\xff\x25\xc9\x57\x10\xbe\x92\xbc\xd8\x82\x89\x83\xdd\x0f\x24\x7b\x81\xc0\x48\xa8\xb6\x59\xfe\x02\x24\x9e\x6f\x7e\xbd\xad\x4b\x0c\x31\x4b\xf2\x97\x14\x00\x90\x09\x92\xa1\xe3\xc3\x2b\xfb\x02\x47\x48\x24\x13\x7d\x2b\x36\x09\x7c\xf4\xc3\x6d\x92\x97\xdc\x9f\x15\x61\x04\x07\x5f\xa6\xec\x1d\x8d\xc0\x02\x9e\xdf\xd1\x08\x20\xc0\x4c\xca\x35\xe1\xd9\x79\x81\xf4\x81\x74\x62\x9c\xed\xf2\x25\x0e\x61\x72\x1f\x48\xef\xf9\xb9\x4c\x21\x31\x05\x7b\x31\xd9\x66\x03\x1c\x12\x85\x08\xaa\x6d\xf1\x33\xc5\x61\xf6\x52\xac\x7d\xbe\x62\x1d\x71\x18\x30\xa7\x37\x78\xf4\x82\x50\x0a\x50\x97\x02\x80\x50\x88\xa0\x47\xa9\xe3\x44\x02\xa6\x3f\x0d\x51\x18\x28\x2f\x5a\x88\x9e\x4c\x4d\xd0\x33\x02\x84\x58\x5c\x0f\x88\x62\x8b\xa1\xc7\x4c\x29\x05\x71\xd2\x6a\x9b\x2d\x11\x32\x24\x00\xba\xd4\x2a\x50\xf0\x96\x2c\x61\x2b\xbf\x55\xfa\x45\x96\x57\x56\x8e\xb0\xb7\x03\x06\x65\x73\xaa\x69\x76\x9a\xbf\x80\xe8\x85\x0c\xb2\x29\xf2\x17\xa0\x00\x19\xa1\x60\x17\xc9\x8a\x47\x5e\x20\x01\x63\x1c\x40\x7c\x46\xde\x8a\x32\x45\x21\x79\xf0\x15\xaa\x6d\x12\x80\x94\x80\x6d\x9b\x5a\xe0\xc2\x75\x9a\x63\x57\x9e\x26\x14\x65\x10\x97\xe4\x62\x16\x95\xcb\x0d\xc0\x70\x39\xec\x68\xac\x7c\x95\x7e\x40\xe7\xda\x29\x0a\xf3\x69\x75\x44\x72\x49\x0d\xac\xf4\xb0\xe6\x2c\x66\xdb\xe4\x85\xa7\x25\xb4\xf2\xb7\x5d\x35\x4f\x73\x54\xd5\xd8\x7d\x26\x51\xf0\x66\x43\x94\xdd\x80\x49\xe1\x8d\x0b\xd3\x0f\x38\xf1\xac\x78\x81\x11\xab\x05\x64\xdf\x96\xec\x1d\x8f\xc1\x7c\x3e\x64\x27\x30\x52\x24\xe2\x19\xe0\xed\xde\x70\x17\x3f\x75\x26\xbf\x74\x00\x1b\x3c\xc6\x07\x8f\xf1\xc1\x63\x7c\xf0\x18\x1f\x3c\xc6\x07\x8f\xf1\xc1\x63\x7c\xf0\x18\x1f\x3c\xc6\x07\x8f\xf1\xc1\x63\x7c\xf0\x18\x1f\x3c\xc6\x07\x8f\xf1\xc1\x63\x7c\xf0\x18\x1f\x3c\xc6\x07\x8f\xf1\xc1\x63\x7c\xf0\x18\x1f\x3c\xc6\x07\x8f\xf1\xc1\x63\x7c\xf0\x18\x1f\x3c\xc6\x07\x8f\xf1\xc1\x63\x7c\xf0\x18\xff\x07\xf7\x18\x6f\x7a\xb5\xc2\x00\x47\xd7\x5b\x2c\x3d\xde\xf3\xf5\x88\x45\x7b\xdc\xf6\x11\x7b\xbc\x9f\xd7\x6c\x40\x4d\xa9\x78\xe9\x35\x30\xba\xd7\xbd\x04\x1f\xbb\x3d\xf8\x19\x0f\x7e\xc6\x83\x9f\xf1\xe0\x67\x3c\xf8\x19\x0f\x7e\xc6\x83\x9f\xf1\xe0\x67\x3c\xf8\x19\x0f\x7e\xc6\x83\x9f\xf1\xbf\xaa\x9f\xb1\xb4\xc4\xd9\x76\x57\x56\xbb\x57\xfa\x18\x02\x01\x7c\x5a\x59\xab\x34\xaf\xb2\xea\x17\x0e\x75\xb0\xdf\x67\x4d\xdc\x0a\x87\x43\x8a\x41\x70\x3f\x99\x8d\xcf\x9a\x38\xa4\x3c\xa4\x18\x04\x77\x6f\x5b\xad\x0b\x38\xb9\x5f\xbc\x21\x88\x77\xe5\x1a\x4e\xcd\x8a\x2a\xa9\x52\x44\x4f\x25\x00\xd1\xd7\x60\x99\x6c\x30\x02\x04\x3d\x82\xbf\xb0\x8a\x0d\xae\x13\x07\x4c\x53\x4e\xef\xbc\xed\xf5\xcc\xea\x71\xb9\x4e\xb6\xe9\x2a\x4e\x57\x2f\xe9\xa7\x64\x59\x0f\x1e\x08\xa3\x66\x4c\xcd\x39\x8d\x6f\xef\x91\x8f\xe3\x8e\xd1\x52\xf6\x91\x6e\xae\xd6\x58\x68\x57\xca\xd5\xd6\xca\xca\x23\x3e\xbf\x03\x82\xd6\x22\x90\x90\xc8\xd5\x00\xd5\x21\xaa\x86\x83\x9e\xbc\x76\xf0\x4d\x14\xbc\x4a\xaa\x24\xde\x26\xf9\x99\xf1\x61\x55\xfb\x9d\x59\x21\x55\xad\x37\x49\x48\x62\xc6\x13\x8a\x75\xf7\x97\x51\x62\x6a\xc0\x2e\x88\x83\xba\x97\xb6\x4b\x85\xa2\x2f\x08\xf7\x3d\x7e\x99\xcd\x74\xa5\x5f\x81\x37\x17\x28\x9f\x8f\x9d\x99\x54\x09\x74\xef\x34\xdb\xec\xca\xf5\x35\x54\x5f\x63\xe2\x41\x8a\x71\xf9\xb6\xcd\xaa\xbe\x86\x24\xd4\x10\x07\xbe\xd0\x71\xa7\x2a\x65\xce\x6b\x46\x7d\x9b\x18\x00\xf2\x13\xe6\x97\xc3\x0f\xce\xf4\xcd\xbb\x77\x3c\x49\xe3\x11\xef\x37\xd6\xf6\xe6\xd7\x4b\x91\xc7\xaf\xc5\x2a\xbd\xc1\xa2\xe8\xd9\x4f\x73\x0f\xec\xc6\xaa\xa8\x95\x1f\xa4\x54\x28\x16\x65\x83\x2a\x24\x02\x79\xc3\x98\x09\x5f\x72\xd0\xc0\x09\xdd\x3a\xc5\xea\xc6\x33\x64\x9b\x96\x69\x5e\xc5\xef\xd9\x2a\x2d\x7a\x4e\x91\x19\x03\x86\x99\x51\xdb\x84\x78\x90\xaa\xc2\x5f\xa1\x05\xe1\xca\x1f\xc5\x84\x32\x83\x90\x51\x02\x29\x05\xed\x73\xef\x57\x30\xff\x3d\xb5\x19\x31\x72\xe9\x21\xec\x6c\xd0\xa4\x72\x67\x7c\xba\x7d\x4b\x7f\xa5\x2b\x24\xc6\xdc\x25\x22\xf3\x02\x00\x36\x4f\xab\x05\x1f\x42\xe8\x75\x9a\x20\xde\xa1\xa8\xb3\xfb\x09\x8e\xf9\xfd\xe4\xc6\xeb\xcb\x36\x7b\xcd\xaa\xec\x3d\x8d\xb7\x69\x59\x25\xdb\xbe\x35\x21\x8f\x75\x86\xf7\xce\x76\x00\x2b\x68\x42\x2c\xd7\xa4\x8f\x31\x40\x2f\xfe\xbe\xf5\x4c\x36\x5e\x03\x22\x6e\x09\x3e\x49\xa5\xe5\x3a\x59\x65\xf9\x4b\xbc\x4d\xaa\x4f\xdb\xf0\x8b\xe0\x21\xc6\xc3\x3e\x64\xd9\xf6\xd3\x62\xcf\x9d\xca\x6f\xe3\x40\x6c\x1a\x86\xb4\x35\xe8\xa7\x4d\x35\x8d\x16\x9f\xf6\xe7\x1f\xb5\xd1\x88\xf1\x14\x45\x1a\xf9\xf4\x10\x17\x3b\x3d\xe7\x87\xac\xb3\xc8\x68\xe0\x45\xcc\xa0\xb2\x16\xf4\xc2\x32\x1d\xea\xc4\xc4\xb6\x3d\x40\x7c\x42\x83\x83\x43\x9d\x3a\x13\x2c\x3c\x78\x0a\xf8\x0f\xca\x16\xd4\x44\x83\x43\xfa\x18\x46\x8c\xa2\x71\x4c\x78\x80\xa9\x13\x0f\x16\x2c\x61\x2a\x34\x13\x30\xe1\x45\x65\xc6\xfd\xb5\xdc\x27\x1a\x46\x5c\x25\x2f\x68\xe3\x68\x36\x76\x9e\xe6\x27\xed\x0d\x13\xc8\x4b\x83\x7c\xa5\xd7\x00\x5e\x87\xf4\x54\xcf\x36\x4d\x56\xea\x54\xdf\xd0\x4b\xfb\x92\x77\xd5\x08\xc4\x29\xee\xb2\xc5\x34\x3b\xc6\xd2\x64\x25\xab\xf2\x7d\x56\x97\xe2\xe7\x6d\x91\x57\xd7\x3b\x86\xe5\x2c\x4a\x69\x7e\x02\xcb\x0b\xd5\x39\x7b\xb2\xbe\x3d\xd3\x97\xac\xe4\x35\x0e\x97\xc5\xeb\x8f\x2c\x4f\xb7\xcd\x42\xcf\x78\x9b\x99\x5b\x01\x3f\x66\x1b\x9e\x33\xb5\x5c\x0a\x09\x0c\x5c\x10\x66\x09\xff\x39\x82\xa0\x9d\x22\x68\x0d\x04\xad\x89\xa0\xa5\x08\xda\x19\x82\x76\x8e\x48\x15\x2a\xb2\x73\xfe\x8e\x05\xdc\x75\x03\x02\x9f\x30\x0a\x60\x2c\xe8\x00\xfc\x4c\x2b\x30\xb8\xd7\x5e\x27\x21\x15\x27\x99\x20\x9e\x81\xdb\xe8\xdb\x51\x80\x4f\x58\x7a\xac\x64\x6b\x52\x37\xb4\xc2\x27\x0c\xc4\x85\x45\x09\xd3\x47\x9f\x97\x19\x73\xb9\x93\xa2\x0d\x27\xa7\x73\x50\x4c\xf1\x03\xb1\x67\xf1\xd4\x22\x01\x58\x40\x0d\x01\x94\x81\xd6\x91\x02\x80\xf9\x1b\x84\xcf\xe6\xa7\x38\xfc\xee\x21\xa8\x67\x5e\xc4\x10\xe4\x9e\x4b\x65\xdf\x3b\x21\x42\x9d\xd3\x27\xd9\x7e\xbe\x33\x86\x43\xf7\x4b\xde\x65\xaf\xc0\x0b\xf4\x0e\xf1\x7d\xd0\xed\xf5\x01\x71\x08\x5a\x8e\xa3\x80\xcc\x29\x02\x49\xa6\xb1\xe9\x85\xb1\xcf\x3c\x33\x32\x42\x04\xd0\x30\x35\x81\x4e\xf4\x18\x07\x91\x83\x40\xc8\x61\x83\xd3\x8b\x31\x83\x93\x93\x69\xec\x45\x21\x6e\x88\x0c\x13\x8f\xe1\x9d\x86\x82\x78\xe4\xc9\x9c\xba\x94\x11\x1b\xf3\xe9\xe4\xb7\x45\x1a\x30\xb9\x42\xf2\xf6\x19\x36\x71\x40\x69\x5c\x24\x6f\xd0\x87\x46\x92\xde\xc1\x49\x47\xb1\xbb\xf8\xf2\xdb\x7f\xff\xc7\xff\xb1\xf7\xb5\x4b\x8a\x22\xcb\xdf\xf7\x32\x37\x70\x56\x74\x7a\xfb\x7c\x44\x29\x6d\xce\xa2\x78\x00\xfb\xed\x0b\x81\x5a\xda\xc4\x28\xb8\x80\x8e\xb3\x57\xff\x44\x56\x81\x2f\x88\x9a\x59\x60\xc4\x13\xff\x33\xb1\x11\x13\xdd\xdb\xf9\xcb\xcc\x4a\xb2\xde\xb3\x32\x11\xa4\x6d\x3c\xd7\x0e\x9e\xf4\x3b\x9e\xf4\x09\x4f\xfa\x27\x66\xd5\xdc\xcb\x17\x5e\xb4\x98\xb0\x4b\x94\x0a\x28\xdc\x29\xa1\x08\x20\x33\xda\x6c\x33\x02\xbd\xbd\xcd\x70\x80\x7e\x18\x05\x64\x29\x03\x9e\x9d\x41\x68\x36\xbf\x8a\x0e\x89\x68\xd9\x48\x65\xe1\x25\x38\x56\xfa\xa5\xc5\xc8\x1a\xdc\x60\x11\xee\x1a\xde\xf4\x95\xf7\x25\x5a\xcd\x8d\x09\x1c\x77\xb9\x1e\xcc\x95\xc5\x4a\xd8\xf5\x11\xad\x2e\x5a\xeb\x66\xc1\x92\x93\x0d\x86\x43\xd7\xb6\xd5\x46\x9e\xb5\x07\xe2\x00\x74\x91\x87\x52\xf9\x19\x4f\xb3\x87\x9c\x80\x3a\x2c\xbf\x44\x90\x0f\xba\x0e\x8f\x21\xbc\xcb\xbc\x2e\x55\x4d\x2b\xc9\x3a\xd7\xa4\x79\x71\x14\x4b\xc6\xd3\x6d\x9a\x45\x3c\x4d\xe5\x3d\x92\xbf\x16\x91\x6d\xfe\x66\x9b\xd4\xbe\xc0\x1f\x4f\x9c\x01\x83\xf2\xc1\x23\x38\xc1\xab\x4c\x70\x52\x53\xfb\xd4\x19\x74\x7d\xc8\x39\x1e\x64\xe5\x5d\x7c\xf5\xd7\xbe\xa9\xaf\x6b\x4d\x20\xf7\xde\x08\x73\xed\x7b\x42\xab\x5b\xe3\x17\xc4\x36\xde\x75\x06\xdd\x67\x0a\x63\x22\xb1\x50\x03\x81\x81\x75\xb4\xc3\x5c\x97\x19\x42\x25\xdf\x6d\x7b\x3d\xdf\x78\xf7\x5a\x74\xa8\x10\xd9\x1c\x83\x76\x5d\x06\x88\x35\x0d\xf3\x7a\xad\xca\x4f\x51\xd7\x13\x83\xf5\x66\xc5\xfd\x55\x3c\x0b\xb2\x30\x8e\xd2\x87\x8c\x42\xae\x3e\x1c\x5b\xcc\xb7\xec\x9e\xbc\xc7\x74\x27\x5d\x19\xf3\xd1\x45\x65\x2a\x2a\xc3\x25\x76\xe0\x98\x46\xfe\x7c\xb9\x0e\x87\xfc\xf5\xf4\x5d\x16\x63\xc7\x1e\x38\xfa\x70\x28\x4e\xa9\xca\xfc\x3c\xf9\x7f\xcd\x4f\x46\xd7\xa5\x9e\xe4\xbb\x68\x71\x43\x9e\x9f\x0c\xdf\xe2\xe4\xd2\x58\xdd\xb0\x28\x62\xbe\x15\x57\xcd\xf2\xa0\xd8\x15\x0e\x68\x15\xfe\x87\x9a\xae\x47\xc1\x9a\xcf\x6b\xf2\x80\x2b\x84\xd5\x8e\x8b\xd7\x31\xaf\xc1\x6a\xcb\xd3\xfb\xfd\xaa\xe4\xf4\xe7\x5d\xa2\xc2\x60\xbf\xfd\xfe\xb7\xdf\xff\x5f\xf0\x7b\xfa\x7c\xb2\x0e\xd2\x1f\x3e\xbc\xcb\x48\xc2\x39\xf7\x67\xf0\x53\xb0\xe4\xd8\xc9\x85\x22\x6f\x16\xa6\x69\x9c\xf8\x7c\x3f\x5b\x6d\xd3\x70\x87\x96\x51\xea\xcb\xdf\x6e\x7a\x80\xdb\x33\x5d\xd7\x76\xe4\x3a\x96\xbd\xf7\xac\x89\x8b\xbb\x89\xcf\x71\x90\x76\xf3\x26\xac\xfc\x95\x5c\xd9\x2c\x56\xb4\x4a\x01\x22\x5e\x5c\xee\xee\x7f\xdc\x92\x21\xce\xcd\xf4\x3f\x66\x0b\x8a\xe3\x7d\x05\x73\x9e\xf8\x41\x16\xaf\xc3\x99\x3f\x8b\xb7\xf0\x22\xf8\xca\xf2\x49\xd5\xb7\xcf\x44\x2c\x56\x71\x90\x3d\x9a\xff\x53\xe7\x91\x12\x36\xad\x27\x7f\xc7\x67\x59\x9c\x60\x7b\xa9\xb2\xac\x30\x7a\x50\x5b\xf2\xeb\xed\x55\x1c\xcc\xef\xb3\xbf\xf0\xb0\x8a\x7e\x91\x4f\x2c\x83\xf1\xc4\xd7\x0d\x03\x76\x19\xf7\xfb\x12\x89\x18\xce\xab\x21\x8e\x89\x39\x45\x14\xfd\x0d\x68\xb9\x27\x0d\x83\x1f\x5c\x46\x0a\x38\x3c\x0d\xe1\x55\x15\x09\x34\x8a\x23\x02\xce\x4c\x95\x44\x89\x69\xb1\x2e\x92\xaa\xa9\x9a\xd0\x01\xcf\xa4\xb8\xc3\x09\x53\x45\xcc\xe2\x35\xe4\x89\x48\x15\x38\xd4\x46\x5b\xe2\x11\x93\x28\x84\x13\x0a\x20\xa7\x51\xef\xf0\xef\x09\x69\x32\x2e\x41\x0f\x19\xd2\xf3\x1e\x9e\x66\x30\xb2\x36\xd2\xc5\xf3\xde\x37\xb0\xec\xae\x2e\x52\x38\x43\xe7\xeb\xea\x8e\x63\x56\x26\xfa\x6a\xa6\x19\x51\xbc\xe1\x49\xba\xe1\x33\x71\xd4\x18\x1e\x12\x56\x84\x71\x54\x6a\x55\xed\x31\x17\x4c\x15\x2c\xf9\xcd\x37\x4a\x35\x25\x6c\xa7\xcb\x24\xde\x6e\xfc\x4d\x90\x64\x21\xac\x76\xf9\x1c\x3b\x87\x94\x16\x38\xdf\x6e\x7f\xaa\x49\x77\xe0\xd8\x90\xda\x92\xe9\x22\x78\x6f\xac\x3b\x9e\xc8\xcd\xcd\x0c\xe4\xa7\x2a\xc9\x3b\xd7\xe6\x31\x22\xe9\x06\xcd\xf8\x3e\xdb\x26\xdc\x5f\xc4\x71\xb6\x49\xc2\x28\xc3\x5a\x53\x41\xd4\x97\x88\x87\x12\xdf\xaf\x5a\xca\xb7\x4b\xeb\xdd\xb4\xd8\x9b\xee\x8c\x91\xbb\x4e\x20\x95\xa9\xfb\x5c\xc4\xb5\xb6\x3b\x7c\x44\x84\xec\xb9\x1d\xd2\xaf\xed\x62\xb1\x42\x8c\x2c\x44\x09\x45\x24\xb5\x1f\xae\x09\x5b\xad\x92\xb3\x7e\xbb\x6d\x9e\xcb\xc0\x62\x5a\x30\xf2\xc8\xf6\xcd\xd1\x2b\x7e\xe7\x7d\x06\x6e\x9d\x60\xc5\x17\x95\x67\x4e\x35\x99\xb4\xde\x35\xb9\x89\xaf\xad\x8e\xf6\xde\x6a\x8c\x53\x73\x3a\x75\x1a\xe2\xd4\x69\xcc\x4e\x1d\x55\x9d\xb4\x13\x4e\xae\xb2\x07\x74\x1a\xe1\xf2\xdc\x08\x97\xd6\x53\x23\x6c\x64\x57\x44\x3f\xac\xad\xc0\x7a\x0c\x0c\x89\x3d\x55\xbc\x86\xc7\x9e\x29\xde\x78\xa0\x80\x1b\xd5\x61\x4b\xd3\xb3\x75\xc7\x3d\xb9\x63\x94\x87\xad\x54\x9f\xca\xcf\xf3\x6c\x07\x72\xae\x1a\xac\xaf\x4f\x2c\xaf\x0e\x0b\xe9\x91\x43\xfd\x3f\xb6\x53\x87\x4d\xfe\xcb\x55\x3e\xe5\xa5\x71\x37\x8c\xe6\xae\x9c\x04\x9c\x20\xe3\x26\x4c\x01\x08\xd8\x80\x67\x65\xd4\x38\x58\xf1\x2c\xa3\x83\xcf\xcf\x22\x51\x91\x0e\x65\xd1\xdd\x20\x49\x42\x9e\x28\x20\xf1\x4a\x9f\x20\xa5\xc6\x76\x32\xe7\x89\x32\xb0\xb7\x4d\xb3\x78\x7d\x7f\xb5\x50\x9a\x66\xcf\x27\xe1\xdf\x33\xed\xef\x99\xf6\xf7\x4c\xfb\x7b\xa6\xfd\x3d\xd3\xfe\x9e\x69\x7f\xcf\xb4\x35\x67\x5a\xe2\xae\x39\xfe\x99\x5f\x59\x26\xa9\x1f\xc0\x2d\x51\x69\xe3\x5c\xbd\x5d\xbe\xed\x6b\xc2\xa3\x1c\x28\x15\x29\xcb\x03\x82\x0f\xdb\x6f\xfe\x43\x75\x9f\x6d\xa7\xbc\x39\xd5\x7b\x93\x2e\x7b\x8c\xda\x19\x4f\x78\xec\xef\x42\xfe\xf3\x98\xf8\x09\x7b\x56\x41\x90\x93\xf1\x34\xe5\x2b\x79\xda\xe9\x6f\xe4\xb9\xf1\xf7\x92\x1c\xaa\x71\xf2\x3c\xd6\x10\x8b\x03\x09\xb1\x7b\x2f\xbe\xee\x79\x8e\xd9\x45\x0c\x84\x1e\x1c\xf8\x42\x3c\xa1\x63\x1f\xe2\x1a\x90\x28\x06\x65\xe5\xf2\x29\x9d\x04\x2c\x8b\x1b\xeb\x10\xc6\xe0\x1d\x6f\x81\x94\xe5\xdf\xe1\x54\xd3\x45\x32\xbe\x5f\xf2\xc8\xe7\xeb\x69\x9c\xa6\x35\x3f\x19\x1b\x76\x6d\xd7\xf5\x2d\xdc\x64\x99\x53\x1f\x9e\xdf\x61\x01\x43\x7d\xfc\x10\x13\x24\x7c\xb1\x82\xe3\xfb\x38\xaa\x69\x86\xfc\xc9\xd9\xa5\x9e\x15\xb4\x0e\xeb\x5b\x87\x14\xeb\xcd\xb7\x4b\x1c\x3a\x4f\xe5\x46\xaf\xba\xdf\xdf\x6f\x5c\x79\xc2\xf0\x24\xdb\xca\x49\xad\x29\x8d\x63\x98\xbc\xfc\xd9\x2a\x58\x6f\xea\x0f\xb3\x45\x62\x80\xae\x5c\xc9\x20\x1f\x41\xca\xf7\x3f\x9e\x5d\xc0\x1e\xd2\xd0\x59\xbc\x86\x10\x75\xf8\xbb\x9f\xb6\xb3\x99\xbf\xdd\xcc\x2f\xd3\x73\xd4\x19\x98\x2f\xe5\xec\xb2\x59\x89\x7f\x4d\xe6\x3c\xda\x15\xaf\x11\x3a\x37\x38\x63\x3e\x55\xfe\x40\x08\xf1\x40\x49\x26\x6f\x68\xfb\x10\x1f\x8c\x26\x46\xc6\x60\xdb\x63\xe6\xe8\x23\x03\xc9\xfc\x40\x5d\xc5\xbd\x21\x3f\xe1\xfb\x4d\x10\xcd\xfd\x08\x62\xd8\x57\x35\x6d\x5c\x74\x87\xc3\x2b\x5f\x87\xc1\x50\x55\x91\x85\xab\x21\xe5\xd7\xdb\x55\x16\xca\xa5\x53\x43\xaa\x8b\x3a\xaa\xb0\xbb\x42\xef\x8d\x8e\x48\xe8\xfc\x37\x60\x15\xa3\x9d\x58\x89\x6b\xc6\xf0\xd8\x8c\x5e\x1e\x61\x47\xc0\xb7\xd5\xf1\x60\xc4\x4b\x1d\x88\xd8\x76\x0d\x6c\x8d\xb6\x57\xcb\xaf\xe6\xd1\x90\xbb\x45\x9b\x38\x83\xdd\xc0\x3a\xdc\xac\x83\x3b\xd3\x87\x02\xfb\x84\xcf\xb2\x20\x5a\x36\xe6\xcb\x50\xed\x49\x1f\x0d\x2c\x86\x77\xe2\xe2\x40\x84\x00\x1d\x3b\xf6\xfb\x87\x8a\x4c\x58\x86\x1f\xc9\x0b\x06\x15\xa7\x19\x0d\x7d\xbd\x83\x79\x0f\x73\xd6\xb5\xd0\x83\x9a\xdf\x51\x5e\x13\xd7\xfc\x88\x79\x5a\x41\x05\xbb\x5e\x45\x22\x9f\x78\x9f\xbe\x38\x57\x10\xef\x0c\xba\xfa\x71\x06\x38\x65\x86\x7e\x72\x7f\x40\x43\xb2\x44\xf7\xd9\x77\x9f\x7d\xf8\x8f\x86\x03\x84\xc4\x3a\xec\xf5\x3e\xd6\x70\x0d\xd0\x70\xe0\x43\xc6\xd2\x91\x8b\x4b\xbb\x20\xc3\x81\x60\x9f\x01\x09\x58\x30\x3b\x8d\xc2\xa0\x39\x14\x2b\x42\xac\x04\x70\xf1\xf2\xbd\x89\x05\x45\xb7\x0d\xe6\x92\x5d\x05\x5b\x8a\xa9\x04\x43\xfa\x55\x09\x85\x4b\x20\x50\x02\x69\x86\xaa\x92\x9a\xa1\xa8\xa7\x66\x20\x73\x1d\x8c\x1d\xf6\x6a\xda\x13\xf7\x80\x44\xa7\xa4\x18\xb9\x9e\xcf\x3e\x10\xaa\x8d\x75\xd7\xf5\xbd\x17\xc7\x9e\x0c\x10\x87\xce\xc2\x13\x0e\x87\xbd\x0a\xed\xbe\x0b\x31\xd8\x18\x52\x9c\x41\x89\x4c\x47\x11\x38\xe8\xd2\x80\x27\xe3\x09\x89\x58\x16\x48\x43\x67\xd5\x3d\x85\xaa\xea\x57\xe0\xc4\x21\x1c\x6a\xcf\x7e\x8a\x36\xcc\x7e\x7f\xe2\xaa\xa2\xf3\xdd\xbf\x22\xfa\xe8\x92\x74\x3e\x2f\xa6\x85\x48\x2a\x23\x86\x5b\x1c\x15\x0c\xca\x78\xca\x57\x13\xb1\xb1\x02\x1d\x5b\x88\xac\x12\xf9\x74\x82\x6b\xd2\x09\x31\x81\xb7\x98\x2e\x29\xc4\xcf\x14\x6a\x0a\x2d\x9e\xf1\xe1\xd1\x2f\x1d\xa1\x22\x04\xb9\xb7\xbe\x14\x85\x7d\x91\x9c\x23\x69\x72\x88\xcc\x29\xcb\x8a\x12\x02\x21\x04\xba\x13\x9a\x6c\xa8\x0f\x68\xb4\x14\x4d\x72\xe5\xc1\xf7\x26\x23\x25\xc3\x8a\xb7\xd1\xe7\x58\x84\xdc\x17\x13\x3b\xbf\x5b\x36\x96\xd2\x70\xd1\x94\x1e\x96\x72\xa8\x0f\x46\xa6\x37\x31\xd0\xcb\xa6\x57\xb3\xeb\x80\x3b\x63\xe9\x5f\x4c\xe4\x7a\xc5\xb2\x91\x84\x86\x8b\x25\xf4\x90\x84\x47\x23\xe0\xe8\x0f\x36\xc0\x91\x97\x8e\x65\xe1\xe2\x85\x11\x60\x2f\x66\xd5\x2e\xf4\x06\xc0\xb2\x89\x00\xc3\xa5\x02\x3c\x22\x00\xa6\xc2\xc7\x6d\xa5\xe5\x0e\xb7\x6e\x7a\x98\xd3\x15\x47\xa1\x77\xdb\x78\xa4\xc6\xed\x66\x36\xe5\x45\xf5\xda\x57\x46\x5a\x1b\xde\x44\x23\x7b\xf7\x75\x1e\x84\x2d\x3a\x86\x09\x4d\x1f\xb1\x42\x52\xb0\xc5\x19\x8e\xde\x02\x01\xaf\xf7\x35\xae\xb1\x20\x28\x73\xdc\xcc\x90\xcd\x70\x84\x3a\x83\xee\xf5\x4e\x80\x46\xaa\x2d\xf7\x69\xdb\xca\xaa\x6e\xdb\x32\x68\x38\xbd\xdf\x87\x12\x17\xc4\x4d\x19\x98\x17\xbf\x04\x41\x52\xf7\x6d\x91\x6d\xdb\x9a\x88\xf2\xeb\xf6\x88\x35\x3c\x00\x85\x6b\xbe\x0a\x23\xee\xa7\x7c\x1d\x6c\xbe\x2a\x9f\xa2\x95\x8e\x8e\x4b\x31\xa6\xdf\x6e\xaa\x0f\x79\x5b\x45\xb9\x10\x17\xee\x5a\x5e\x6c\x87\xc9\xf9\xee\x7e\xc3\x8f\x00\x91\x58\x9d\x4a\xdf\x35\x47\x90\xf8\x95\x0a\x3b\xe8\x7b\x17\x08\xe7\xc3\x57\x5b\x07\xbb\x63\xe6\xb0\x2b\x7b\x90\xf2\x75\x41\x2f\xe1\x10\xbd\x54\x7c\x81\x14\x01\x39\x10\x1f\x5e\x68\xa2\x02\xb4\x20\x3c\xec\x2e\xb2\xca\xa5\x4a\x1f\xfd\xdc\x25\x2a\xac\x73\xd5\x32\x77\xed\xfa\xfb\xbb\xff\xff\xf4\xdd\x29\x43\x49\x51\x78\xdc\x5f\x70\x3e\x9f\x06\xb3\x1f\x35\x97\x33\x5d\xa8\xe6\x06\xf5\x28\x08\x19\x9c\x05\x86\x9c\xf7\xf9\x30\x3f\x89\xda\x56\x77\xc9\x7b\x96\x39\xf6\x0d\x13\xb2\x6d\xf7\xd8\x7d\x72\xc8\x0b\xcd\xde\x7d\xd3\xb8\x4f\x7a\xac\xbe\x81\xa1\x16\x69\x5e\xcd\x5e\x1e\x1f\x76\x9f\xde\x73\xf4\x91\xdb\xb7\x9d\xa1\xdf\x67\xcc\x10\xa6\xc2\x87\x96\x5d\x62\xf3\xa8\x2c\x5c\x15\x2b\xd1\x6d\x2e\x79\xb8\x0c\xa2\xbc\x3c\x76\x4c\x5f\x8c\xd0\x45\x97\x6b\xa1\x57\xdd\xf9\x30\x47\x03\x32\x40\xe8\x82\xad\x3c\x57\xd1\x6e\xbc\xdc\xeb\x46\x43\x16\xe5\xb9\xc1\x00\xb7\xdf\xba\xc4\x3b\xac\x67\x3b\x14\x57\x74\xf3\x74\xc2\x1e\x33\x94\x04\x9e\x30\x82\x32\x7e\x1e\x43\xdc\x12\x39\x3a\x54\x0c\x30\x3f\x99\x83\x4f\x12\x7f\xc5\xc3\xe0\x74\xc8\xb1\x98\x0e\x95\x55\x28\x4e\x76\xcf\x61\xd1\x3d\xe7\x54\x01\x34\x88\x2e\xe6\xba\xab\xd4\x80\xa2\x5f\x20\x58\xfa\x07\x46\xd2\x08\x12\x5d\x62\xd5\x72\xff\x32\xc7\x27\x9f\xac\x43\x46\xb4\xc9\x08\x8d\x8c\x68\xf9\x88\xe9\xb7\xcb\x97\x61\xe4\x15\xf3\x63\x3f\x9f\x1e\x11\x40\x16\xcd\x55\x60\x17\x18\x3d\xcb\x92\x70\x8a\x59\x60\xc0\x0b\xbf\x3c\xdd\x08\xb2\xd2\xe5\x11\x61\x2f\x16\x29\xcf\x48\x90\x6e\x90\x72\x95\x06\xbd\x06\xc9\xaf\x30\x5a\x62\x5a\xa4\x8b\xcc\x14\x39\x00\x41\x0f\x55\xde\x24\x71\xf1\xf6\x01\x07\x52\x90\x73\xad\x59\x2a\x16\x71\xb3\x84\x07\xeb\xca\x0f\xdd\xf8\xc2\xae\xee\xd1\x5a\xc5\x80\x73\xb7\xdf\x5d\x1f\xa4\xc6\xfa\x04\x12\x9e\xd6\xe0\x90\xaf\x0f\x94\x38\x5c\x1f\x21\xcb\x9f\x0c\xbc\xfe\xe2\xb3\x21\x70\xb2\xae\xd4\x05\x12\xe3\xfb\x03\x1e\x29\xe1\xcc\xf4\x02\x86\x40\x8d\x83\x6d\xca\x55\x80\x0e\x4f\xb7\x6b\x25\xa4\x91\x04\x3f\xef\xe0\x6a\x7a\xff\x56\xa6\x01\x2a\x92\xce\xc0\xaf\x21\x9f\xe7\x79\xa0\xef\x07\x68\x61\xba\xc3\x64\x64\x0a\xa7\xca\xbd\x11\x7e\x35\x31\x0e\x5d\xc2\xa1\x33\x75\x95\x70\x95\x4b\xe1\x9a\x56\xdb\xcd\x37\xc1\x56\x66\x03\x8a\x37\x35\x47\x0b\x77\xe2\xf4\xe1\x00\xce\xf5\x70\xe5\x63\x72\xf2\xa2\xee\x14\x33\xf0\x18\x88\x01\xc3\xd0\xbf\x89\x72\xc6\x37\x96\xa6\x65\x3f\x7d\x35\xc6\xfa\xc4\x8c\x42\xcc\xec\x28\x68\xfb\x61\x84\xa9\x7a\x2c\x68\x9d\xbc\x64\x97\xa8\x7c\xea\x6e\x93\x45\x30\xe3\x54\xac\x2c\x21\x40\x04\x9b\x29\x11\x30\x89\x8a\x34\xfe\x44\x20\x1c\x5c\x49\x04\xea\xbc\x43\x60\x72\x80\x3e\x9b\xf1\x14\x33\xe8\x09\xd0\x30\xd8\xe4\x38\x34\x64\x12\xad\xaf\x81\x9a\xec\x45\xf4\x49\xf7\xe6\x07\x3f\x75\x96\xb7\x30\xfb\x1a\x87\x33\xb8\x8a\x72\xb3\x64\x2b\x7e\x68\xb6\x21\x3c\xc9\xf8\x5e\xbe\x7c\x6c\xa4\xf8\x7d\x7e\xa6\x22\x1f\x3e\x22\x2b\xd8\x57\x60\xb0\xa7\x00\x15\xd0\x57\xdd\xc2\x9c\xd1\xc0\x36\xb2\x02\xcd\x2c\x86\x0b\x7b\xab\xc0\x12\xca\xd6\x8b\x2a\xf4\xaf\xc2\xf8\x22\x4f\x2b\xb6\x7a\xfd\x6d\x48\xe3\xce\x50\x77\x3d\x59\x61\xa4\x37\xd3\x7b\xb1\x27\x9e\xdf\xb7\x26\xee\x8b\xff\x10\xfd\xc5\x52\x5b\xf4\xce\x25\x4f\xfc\xa7\xce\x34\xbc\x92\xdd\xec\x7e\x73\xae\xd8\x5f\x48\xb0\x5a\xb8\xb4\x85\x67\x18\x4d\x01\xd3\x56\xc0\x74\x14\x30\x2d\x64\xf2\xc6\x33\x90\xa6\x02\x6a\xab\x80\x3a\x2a\xa0\x16\x32\xb7\xe4\x19\x48\x53\x01\xb5\x55\x40\x1d\x15\x50\x0b\x9b\x65\xf3\x0c\xa5\x29\xa1\xda\x4a\xa8\x0e\x16\x05\x7b\xfa\x53\xa0\x22\x4c\x49\xc9\x3e\xbc\x5d\xcb\x1e\x31\x02\x3d\x70\x4b\x52\x8c\xa8\xe2\xf4\x31\x1f\x58\xd1\xfb\x92\x62\x72\x23\xc2\xaa\x64\xa2\xf7\x34\x05\x98\x86\xca\x5f\x2c\x13\x51\xf2\x56\x89\x08\x92\xd5\xf4\x89\xa0\xf3\x8b\x29\x22\x98\x19\x03\xe6\xf7\x2d\x7d\x40\x05\x96\xef\xcf\x88\xf0\xbe\x3d\x50\x54\xf8\xcc\x6f\xc8\x9f\xfe\xd4\x6f\x94\x16\x76\x58\xd0\x99\xd7\x60\x41\xa7\xa6\xc4\x62\x4e\x7d\x06\x8b\xa9\x72\x19\x2c\xb6\xec\x31\x58\x5c\xb5\xc3\x60\xd1\x65\x7f\xc1\xe2\xce\x87\x19\x2c\xca\x70\xf4\x37\x1f\x0e\xed\x20\x84\x0a\x3f\x38\x9d\xc3\xd0\xbe\x79\x0e\xbb\xae\x63\x79\x06\x91\xa7\xe3\xfa\x7c\x0e\x8f\xd7\x69\xab\xf6\xaa\xf9\xa6\x92\x7c\x04\x84\x2b\x34\x79\x2f\x5e\xc5\x09\x9a\xda\x8c\xe6\x04\x55\x3c\xbe\xef\xc5\x71\x32\x47\x03\xd8\x7c\xc9\xfb\xab\x60\x89\x06\xb8\x7c\x16\x47\xf3\x20\xf9\x45\x6b\x46\x3f\x5e\xd2\x14\x3b\x9d\xfa\x95\x40\x26\x1a\x75\x9e\x6b\x3d\xf4\x77\x8f\x58\x63\xe4\x49\x6d\x4a\xab\x0a\xc5\xe5\x44\x91\xd9\xe5\x6e\xb7\xc9\xe9\xe5\x89\x1f\x1a\x75\xb6\x72\xc0\x05\x24\x9f\x43\x3c\xc7\x34\xa8\x20\x5c\x94\x52\x6f\xe2\x38\x62\xac\x42\x86\x6a\x14\x6d\xc6\x8e\x6a\x05\xbd\xeb\x39\xa8\xfb\x5a\x08\xda\xb0\x5e\x4d\xf6\x76\x08\x2e\xc5\x3c\xde\xc4\x97\xfc\x16\xd5\xd0\x5d\x84\x61\xc4\xb5\xce\xd8\x76\x19\x9e\x27\x01\x92\x5f\xe2\xf7\xfe\x2a\xde\x65\xba\x1e\x5c\xda\xc8\xc0\x4e\x2a\xba\x87\x89\xd2\x97\x72\x10\x45\x91\x25\x21\xa2\x28\xa0\x24\x44\xdc\x4c\x4b\x42\xc4\xa5\xb7\x24\x44\xdc\xa7\x4b\x42\x44\xd9\x40\x49\x88\x78\x56\x26\x09\xff\xbc\x4f\x58\x74\x18\x95\xef\x56\xc2\x62\x47\x9b\xa2\x0f\x89\xf3\x35\x64\x80\x4d\x09\x0a\x15\xeb\x5d\xd4\x18\x72\x99\xe4\x8a\x36\xec\xdc\x38\x04\xac\x16\xe4\xe9\xce\x80\x79\x78\x7a\x87\x41\x43\x30\x47\x94\x67\x1d\x8c\x46\x7d\xbc\x50\xa5\x5a\x1a\x1d\x99\x52\x00\x98\xe3\xd8\x8e\x3f\xb6\x5d\x13\x37\xd6\xe5\xf2\x4e\x8d\xfe\x87\x12\xaa\xa5\x84\xd2\x94\x50\x6d\x25\x54\x47\x09\xf5\x5d\x09\xf5\xa4\x84\xfa\x53\x09\xf5\xac\x84\xfa\xb7\x12\xaa\xa5\xe8\x1c\x6a\xde\xd1\x52\x73\x8f\x96\x9a\x7f\xb4\xd4\x1c\xa4\x85\x9a\x38\xc6\xad\x73\xec\x1f\x7e\x47\x05\xd6\x52\x83\x69\x6a\xb0\xb6\x1a\xac\xa3\x06\xfb\xae\x06\x7b\x52\x83\xfd\xa9\x06\x7b\x56\x83\xfd\x5b\x0d\xd6\x52\x75\x13\x45\x3f\x69\x29\x3a\x4a\x4b\xd1\x53\x5a\x8a\xae\xd2\xc2\xfa\x8a\xa6\xd6\xeb\x34\xb5\x5e\xa7\xa9\xf5\x3a\x4d\xad\xd7\x69\x6a\xbd\x4e\x53\xeb\x75\x9a\x5a\xaf\xd3\xd4\x7a\x9d\xa6\xd6\xeb\x34\xb5\x5e\xa7\x29\xf6\x3a\x4d\xb1\xd7\x69\x8a\xbd\x4e\x53\xec\x75\x9a\x62\xaf\xd3\x70\xbd\xae\x7c\x62\xa3\x27\x3c\x2f\x2e\x97\x12\x8a\xf9\x41\xdc\x60\x0e\x43\x50\xcb\x68\xc1\x42\x0c\x02\xc0\xf6\x7c\xb6\xcd\x38\x5e\xc2\x80\x47\x04\xf6\x03\x9e\xe5\xd4\x87\x87\x47\xf3\x9d\x1a\x6e\x41\xc3\x85\x34\x72\x37\x4b\x28\x81\xbb\xb3\x1f\xc3\x20\x4b\xc2\x3d\x56\xca\xe9\x19\xdf\x5c\x01\xb3\x50\xc0\xa8\xe8\x36\x8e\x21\xa2\x21\xc1\x20\xcd\x34\x37\x1d\x82\xd6\x8a\x03\x82\x13\x97\xbf\x7c\x67\xae\x04\xda\xa9\xa0\x16\x4a\x20\x15\x51\xa9\x9a\x86\x29\x4e\x9a\xc3\xff\xde\xf2\x34\x2b\x06\x1a\x42\x97\x3d\xf1\x6e\x04\x75\x85\xf3\x10\x51\xad\x39\x19\xb0\xa3\x22\x16\x64\x00\x59\x44\x4a\x06\x50\x45\x68\x54\x43\x69\x64\x43\x69\x54\x43\x69\x64\x43\x69\x54\x43\x69\x64\x43\xb5\xa9\x86\x6a\x93\x0d\xd5\xa6\x1a\xaa\x4d\x36\x54\x9b\x6a\xa8\x36\xd9\x50\x9d\x39\x19\x40\x16\x41\x35\x54\x67\x41\x16\x41\x35\x54\x87\x6e\xa8\xed\x94\x8e\xa0\x0a\x49\xe9\x03\x5b\x4a\x1f\xa8\x52\xfa\xc0\x93\xd2\x07\x92\x94\x3e\x30\xa4\xf4\x8e\x9e\xd2\x3b\x6e\x4a\xef\x88\x29\xbd\x63\x21\xe7\xf6\x73\x08\x5d\x31\xba\x23\xa7\x97\x7e\xd9\xe8\x4d\x71\xcb\x6f\xdd\xb8\x2c\x56\x66\xab\x3d\x84\xa9\x1f\x6f\xee\xd4\xbd\x50\xe6\xdd\x7e\x04\xd3\xba\x65\x06\xce\x76\xad\xf9\x4d\x12\xdc\x23\x0d\x2e\x6e\x92\xea\xfa\x04\xbc\x2e\xf0\x67\xc1\x06\x9e\x12\xd4\x55\xda\x34\x98\x5d\x3c\x56\x3a\xd7\xf2\x1e\x35\xfa\x96\xa8\x6f\x32\xcb\xf0\x27\xe3\x31\x46\x84\x24\xb6\xec\x37\x0c\xf1\x68\x32\xcc\x75\xea\xe9\x63\x11\x99\xe5\x7a\x0e\xd3\x87\x88\xbb\x64\xf1\x5a\xfa\x1c\x9b\xb7\x0b\x02\x23\x26\x08\x0e\xe7\x60\xcf\xf6\x3b\x9a\xe6\xbb\x93\xf1\xd8\x76\x50\xcf\xf9\x2d\xdd\x2d\x6b\x40\x13\x9d\xeb\x3b\x36\xbd\xde\x0b\x5a\x5d\x11\x29\xd7\xb3\x45\x08\x01\xdc\xd5\x61\xaf\x16\xaf\xc2\x6b\x60\xcd\x91\x32\x36\x4f\xe1\x45\xb3\x14\xf4\x46\x67\xa4\x43\x54\x9c\x33\xd4\x11\x68\x51\xc5\x5d\x87\x27\x6c\xb2\xde\xff\x5d\x00\x9a\xb0\xaf\x9b\x16\x7c\xf1\xbb\x84\x1f\xbd\xee\x47\xcf\x79\x16\xce\x85\x21\xd6\x81\x5a\x07\x72\xc4\x69\xdb\xe7\xd3\x47\xeb\x8f\xcf\xa7\x5e\x17\xfe\x95\x3f\x3a\xad\x3f\x70\xb2\xce\xb0\xfa\x19\x83\xcf\x27\xbd\xf5\x07\x56\x85\xce\x47\x4b\xfb\xec\xf4\xba\xf0\xaf\xfc\xd1\x69\x69\x48\x15\x4e\xb1\xfa\x19\x03\xf1\xbb\x8a\x0a\x52\x7a\xa7\x83\xf6\xcc\xbc\xeb\xf6\x21\xd4\x00\x5b\x13\xb2\x0a\x8a\x2d\x07\x59\xc2\x9e\x8c\xad\x75\x38\xc8\x01\x57\x8d\x43\xf1\xc0\xd3\x76\xcc\x41\x75\x97\x2e\xaf\x99\x44\xce\x06\xf1\x48\xae\x27\x67\x31\x0c\x26\x8c\xe6\xa7\x10\xf9\x26\x5f\xc6\x72\x2a\xc3\xf3\x62\x22\x08\x3c\x3b\x87\x23\x10\xf0\x2e\xe2\x04\x11\xee\xe8\x18\xd9\x46\x75\xe4\x42\x19\x89\x5b\x60\x9f\xc0\x88\xe4\x52\xca\xe1\xd8\x2d\xdc\xd5\xc3\x2f\x6a\xe2\xe7\xbb\x86\x57\x68\xfc\x27\x2c\x24\x9b\x2a\x47\x09\xa1\x76\x10\x50\x08\x4b\x0b\xc4\xe4\x52\x90\xfa\xee\xa4\x2b\x4b\xac\x76\x4d\x12\xae\x6b\x4f\x46\x86\x9b\xbf\xf6\xc3\xc3\x64\x48\xfd\xd8\xb1\x5f\xed\xbf\x60\x65\x98\xaf\x88\x51\x9f\x46\x1a\x4c\xbc\x8a\xc4\x7d\x4b\x09\x10\xa1\xd0\x7c\xbe\x50\x80\x60\xc4\xb8\xb3\x30\x4d\xe3\x04\xad\x56\x4e\x9f\x8b\x20\x03\x30\x22\x0c\xbe\xc9\xbe\x44\xd4\xba\xd0\x6a\x41\xc3\x10\xec\x35\xe0\x59\x7f\x15\x07\x59\xe8\x63\x44\xb0\x28\x98\xae\x38\xe6\x59\xbd\x11\xa6\x58\x52\x33\x95\x6c\xe7\xe1\x03\xbb\xa7\x56\xfd\xaa\xab\x56\xed\xd2\x83\x84\xf4\x67\xf8\xcf\x3f\x2b\x8e\x15\x51\x4a\x05\xf9\x0d\xd7\xef\xdc\x37\xf3\xf3\xd3\x62\x79\x00\xde\x2b\xf3\xdf\x09\x9d\xb6\x00\x8f\xd8\x40\x57\x06\x1f\x24\x7f\x28\x80\x0f\x92\x3f\xea\x48\xfe\xac\x23\xf9\xb3\x8e\xe4\x37\x05\xf0\x41\xb2\x0a\x58\xe5\x0b\xa9\xd8\x56\xc5\x2a\x6f\x3e\xa2\x5f\x17\x43\xb1\x2b\x7b\xc7\xfd\xce\x5d\xea\x17\xe7\xbd\x86\xf4\xb1\xde\xeb\x7c\xac\xf7\x3a\x6e\xf2\x51\x47\xf2\x47\x1d\xc9\x9f\x75\x24\x7f\xd6\x91\xfc\x56\x47\xb2\x0a\x58\xe5\x0b\xa9\xd8\x56\xc5\x2a\x8f\xe9\x1a\xd8\x59\xc9\x66\xae\xcf\x06\x96\x1f\x42\xf1\xc5\xd2\x7c\x54\x3d\x0b\xdd\xd1\x94\x0d\x2c\x51\x84\xd1\x0b\x92\x25\xcf\xf2\x9d\x94\x66\xd8\xcc\xfd\xf6\x2f\x12\xd4\x11\x45\xc4\xe5\x83\x6b\x37\x8b\xa1\x86\x63\x89\x49\x63\xed\xf6\xc1\x58\x49\x14\xac\x54\x0c\x50\xfd\x16\x94\xbd\xe7\x87\x4a\x55\x0d\xaf\x86\x14\x27\xa7\x04\xa8\xc3\xfe\x3b\x31\x1d\x66\x1c\x78\x98\x43\x28\x55\x3a\x19\xc1\xc2\xfe\x3e\xbc\x28\x0a\x7f\x4d\x62\xf3\x06\xf6\x79\x9a\xae\xda\xb7\xcd\x4c\x91\x30\xfd\x95\x41\xc5\xe1\x38\x99\x87\x51\x90\x71\x7a\x55\xf1\xb2\x0f\x8a\xea\xa3\xc5\xdb\xc8\xd6\x14\xe3\xb6\x25\xc8\x8e\x8c\xd1\xe8\x62\x34\x05\x31\x6d\xba\x98\xb6\x82\x98\x0e\x5d\x4c\x07\x27\x86\xf6\x59\x8e\xd4\x24\xe6\xda\x94\x46\x4d\x62\xde\x9e\xd2\xa8\x49\xcc\x3b\x53\x1a\x35\x8a\xb9\xbc\xc7\xd4\xa6\x14\x5a\x02\xe3\xf6\x94\x42\x4b\x60\xdc\x99\x52\x68\x77\x0d\x0f\x7c\xc7\x7a\xb2\x3e\xf3\x7a\x2d\xa8\xc8\xf1\xec\xa7\xdb\x69\x51\x7d\xa6\xb9\x01\xb0\x52\x12\x4a\x4a\xf5\x78\x78\x3e\x41\x1c\x59\x3e\xce\x40\x9b\x60\xc5\xb3\x8c\xcf\xaf\xd8\xa6\xe6\x6e\x78\xac\x5b\xcc\xf3\x58\xa7\xb2\x15\x77\xe8\x75\x1a\xe0\xbb\x3f\x78\xf2\xbb\xdf\xa9\x42\x3a\x44\xc0\x77\x5f\x6f\xe1\x21\xcf\xc4\x86\x3f\x53\x1b\xfe\xac\xd0\xf0\x67\x6a\xc3\x9f\xaf\x35\xbc\xca\x0f\x4b\xfe\x71\xee\x3d\xbf\x5d\xe4\x7f\xdc\x45\x84\x8b\xd0\x86\xaa\xcd\xaf\xe6\xb6\x47\xbd\x78\xf3\x4b\x6c\x72\xdc\xed\xd4\x08\xb2\xa0\x61\x65\xe7\x70\xa4\xab\x75\x54\x34\x3d\xb7\xaa\x7c\xaf\x7f\x48\xd3\xae\x75\xfc\x47\x68\xda\xd6\x1a\xd7\xb4\xad\x3d\x44\xd3\xe6\x67\xee\x79\x12\xfc\xcc\x53\x1c\xa7\x7e\x28\x0f\xe0\xeb\x5b\x63\x68\x8e\xbe\xfd\xeb\x36\x85\xfe\xfe\xed\x5f\x77\xbc\x34\x3f\xb6\x2f\x19\xf2\xe6\xb9\x3d\x86\xb6\xbb\xe2\xd1\x9c\xfd\xbd\x0d\x20\x02\x8c\x8e\x70\xf9\x26\x48\x82\x8c\x20\xab\xbf\x8d\x66\x34\x6a\x92\x0c\x91\xd8\x66\x18\xa4\x3f\x50\xd4\xc7\x6b\x8b\xa6\x1d\x14\x3c\x89\xaf\xf8\x9a\x47\x59\xea\x4f\x83\x94\xe7\x31\x6c\x2a\xee\x54\xd6\x1a\xd2\x7d\xb3\x9c\x37\x94\x0e\x90\x4b\x66\x4c\x7b\x01\x29\x6e\x97\xd4\xe1\x05\xd2\x8c\xd2\x2c\x88\x66\x7c\x4e\x63\x21\xf6\xa5\xd5\x0d\x60\xef\x5e\x93\x9f\x20\xb7\xbe\xec\xc7\xfe\x36\x8c\xb2\xdb\xb6\xa7\xf0\x5e\x4c\x63\x3f\x11\x87\x61\xfe\x3a\xdc\xac\x83\x4d\x83\xac\xc3\x3d\x9f\xfb\x9b\xf8\x52\xdf\xfb\x8e\x72\x3e\xaa\xf4\xcd\x77\x66\xf8\x98\x8f\xa2\xaf\x36\x5f\x01\x74\x35\xd4\x27\xec\xad\x78\x90\x88\x7e\x86\x27\x17\x77\xa1\x48\xf2\x70\x33\x5e\x05\x11\xce\x9d\x84\x1a\x1d\x14\xe9\xf1\x6a\x15\x45\xde\x8f\x97\x68\x3a\xd4\x26\xb8\x9f\x6c\xd3\x6c\xbb\x46\x31\x1d\xf0\x8c\x66\x07\xb8\x02\x06\xc7\x41\x69\x02\x35\x37\xf8\x9e\x45\xbb\x3d\x81\xfc\x10\x81\x81\x03\x59\xe1\xf2\x2b\x1b\xc6\x73\xbe\xda\x13\xc9\xf1\xec\xf1\x9c\xb1\x4c\x23\xfe\x16\xce\x91\x8e\x0a\x6f\xf9\xe4\xe3\x47\x14\xf9\x30\x80\xe8\x99\x60\x45\x23\x46\xe9\x0d\x63\x2a\x45\x93\xb3\x73\x3e\x14\x44\x26\xc9\x6b\xa3\x68\xed\x24\xfb\x8a\x51\x94\x63\x18\xe4\x88\x7e\x25\x30\x6e\xf8\x0f\xae\x5b\x8c\xe3\xd5\xaf\x65\x1c\xc9\x92\x3c\x28\x84\x13\x67\x01\x72\x2a\x73\x67\xc1\x0a\x47\x99\xf7\x36\x02\x29\xca\x16\x67\xbd\x92\x0c\xc0\x89\x80\xaa\x22\x2b\xac\x45\xf4\xd9\x0c\x39\xc0\x75\xc3\x6c\x1d\x6c\x70\xa4\xb0\x0e\x24\x4e\x37\x78\x45\x04\xe3\x36\x85\x74\x47\x98\x97\x90\xb4\xd1\x2e\x5e\x6d\x61\x01\x4e\xfb\x9c\x95\x40\x94\x48\xb6\x0b\x56\xe2\x80\xba\xb5\x27\x92\xd3\xd8\x6b\x34\xf6\x1a\x8e\x7d\x51\xe1\x46\x86\x94\xa2\x44\xc0\x84\xaa\x6c\xad\x01\xcf\x5e\xc2\x34\x3b\x7f\x7f\x8c\x86\xe2\xa7\xaa\x01\xcf\x86\xc1\x06\xcd\x98\x34\xa3\x40\x22\x81\x70\xcf\x57\x42\x00\x86\xda\xe3\xfb\x01\x8f\xd0\xca\x78\x7c\x6f\xf1\x1d\x5f\x11\xed\x23\xa2\xdc\x50\x0d\x90\x94\x28\xa6\x30\x33\x8b\x72\x48\x9b\x38\xe5\xa4\x29\x7a\x83\xeb\x0f\xc3\x60\x83\xf3\xec\x61\xb0\x19\x24\xe1\x1c\xcd\x15\x88\x91\x9c\xb7\xab\x4c\xa9\x8d\xa7\x93\x7f\x4b\x05\xb2\x23\x63\xb4\xbd\x02\x84\x2e\xa6\xbd\x57\x80\xd0\xc5\x20\x47\xf5\x62\xc9\x84\x22\x1e\x07\x69\xea\x7d\x25\xf1\x76\x89\x5b\x7b\x1e\xba\x32\x8e\x12\x62\x35\x38\x92\x56\x38\x15\x76\x58\x15\x7a\x7c\xc6\x31\x6e\xae\x1d\x27\x61\x9c\x84\x59\xf8\x0f\xcf\x63\x50\x52\x14\xcc\x09\xa0\x54\xd2\x38\x4e\x35\x2a\xf9\x8e\x44\xdf\xde\x13\xc9\x69\xec\x3b\x7b\x22\x39\x8e\x3d\x9f\x21\x17\xb6\x40\x88\x62\x49\x1b\x1d\x8e\xd4\x24\xe6\xda\x9e\x46\x4d\x62\xde\xde\xd3\xa8\x49\xcc\x3b\x7b\x1a\x35\x96\x39\xcc\xb6\x04\xd2\x1d\xe1\xfa\x7f\x4f\xa1\x25\x30\x6e\xef\x29\xb4\x04\xc6\x9d\x3d\x85\x76\xd7\xf0\xe1\xed\x22\x09\x96\x70\xb8\xea\x6f\x12\x3e\x0b\xe1\xef\xfe\x57\xb8\xfc\x6a\xee\x8c\x6f\xc9\xe3\x35\xcf\x92\x5f\xf2\x98\xcf\x4f\xc3\x7f\xf8\x03\x98\xa7\x5f\xc1\x9c\x27\xb7\x19\x63\xce\x11\x07\xcc\x1e\x32\xcf\xf9\xf0\xdd\x17\xdd\x60\x4e\xe5\x89\xe2\x6d\x44\xd7\xf4\x28\x28\xcb\x1c\xfd\xc5\x0c\x91\x5c\x4c\xa4\x9b\xb6\x27\x2a\x70\x73\x34\x9e\x78\x32\x31\x39\x1d\x6c\x4f\x3c\x15\x74\xde\x5e\x73\xf4\x6a\xf7\x74\xc8\xb0\x8b\x09\xf9\x93\x55\xa3\x2f\x1e\xe3\x20\x80\xe6\x88\xb9\xbe\x6e\xfc\x47\xef\xb1\x51\xef\x03\x23\xca\x1c\xc1\xdb\x65\xc7\x1c\x93\x60\x9e\x63\xea\xa3\x81\xc5\x5c\x25\x94\x82\x40\x78\x46\x75\x30\x6a\x51\xa8\xf3\x70\x9f\xe8\xaa\x72\xe8\x5a\x76\xef\x2f\x2c\xba\x67\x0f\xbb\xe6\x88\x19\x4d\x2a\x22\x7d\x52\x1d\x9f\xbb\x65\x6d\x06\xc7\xae\x45\x83\x7b\xb6\xa7\x5b\xf5\xb5\x50\xea\x26\xe7\x8a\x28\x05\xd7\x9e\xb1\xd0\x3d\x7b\x68\xf6\xfc\x9e\x3d\x81\xc7\xe6\xf9\xcb\xf3\x9a\x5c\xc8\xf0\x83\xfe\xe0\x9f\xaa\x76\x74\x3d\xdb\x01\x36\x68\xf7\xee\x9b\x0e\x64\x34\x90\x8f\xfe\x44\x8e\x80\x11\x0c\x56\x08\xa4\xa5\x2b\x02\x27\x23\x83\xf5\x45\x77\xca\xd1\xf7\x21\x63\xc7\x1c\x8a\x57\x52\xae\x3f\x60\x23\xe6\xe8\x1e\x33\x10\x30\xf1\x48\x3b\xcf\x23\x60\xb0\xbe\x3e\xb1\x3c\x39\xc4\x62\xcd\x7b\xca\x00\x0d\x3c\x05\x99\x23\xe8\x1a\x16\xf3\x58\x3e\xb6\xcb\xac\xf3\x54\x36\xba\xe7\xe9\xbd\x17\x51\x0b\x4a\xb0\x41\xb5\xde\x61\x7d\xe6\xb0\x51\x8f\x19\x7e\xf7\xe3\xc2\x53\x30\xab\xaa\x3e\x1c\xe0\xc8\x20\x88\x7c\x9b\xd6\xf0\xf2\x6a\xc9\xb3\x22\x93\x8b\x3f\x0d\xa3\x20\xf9\x55\x7f\x8d\x72\x92\x10\x5f\x77\x0e\x65\x90\xee\x9b\x0b\xf2\x92\x94\xb0\xd0\x13\x75\xd4\xc7\x22\x00\xaf\x27\xdc\xec\x0a\x0b\x60\x30\xb7\x00\xb5\xbf\xc9\x66\x9b\xaf\x17\xbf\xdf\xfe\x18\x14\xa6\xeb\x60\x23\xfd\xa8\xfe\xf7\x95\xa5\xa6\xed\x91\x85\x59\x3d\x14\xfd\x47\x66\xf8\x40\xd3\xe7\x65\xaf\x29\xf4\x87\x02\x14\x98\xef\x37\x0c\x36\xf2\xc4\x1a\x43\x2c\xca\x29\xe3\xc9\x07\x3c\x93\xc4\x79\xee\xc9\xa6\xb7\x44\x9b\x60\xf6\x83\xcf\xf3\xb8\xab\x34\xe3\xd1\x2c\x6c\xe0\x65\x8e\x8c\x68\x73\x3d\x36\xea\x99\x98\xb7\x35\x93\x91\x6b\x0e\x60\x0e\x81\xd2\x24\x5a\xc7\xc7\x04\x3c\x0a\x19\x5a\xa7\x90\xf2\xec\x37\x6d\x99\x24\x5c\x87\x59\xb8\xe3\xfe\x34\xde\x46\xf3\x30\x5a\xfa\xd3\x78\x5f\xdf\x36\x87\xd9\x4f\xbe\xf1\x87\x8d\x41\xd7\x7e\xf7\x31\xce\x30\x2e\x54\xea\xe6\x1a\x75\xe3\x7d\xc3\xad\xfe\x7b\xcb\x93\x5f\xfe\x5a\x9c\x3b\x97\xda\x7a\xbf\xa1\x65\x7d\xff\x0b\xcc\xaa\xcf\xb0\xeb\xea\x99\xf0\x60\xee\x2f\xe0\x28\xb6\x6e\x34\x8d\x09\x33\x3a\x4c\xc6\x62\xc1\x9a\xe7\x39\x72\x98\x6e\x60\x37\x89\xd7\x19\xe4\x49\x8e\x1a\x6f\xba\x68\xd4\x5c\xd4\x66\x86\x87\x5e\x95\x66\x50\xf0\x4c\xdd\x1a\xbf\xa0\x62\x8d\x4b\x61\xa0\xad\x27\x04\xc6\x9a\x0c\xcd\x91\x3e\xea\xb1\x8e\x94\xd3\xa1\x60\x9e\xf1\xba\x9d\x60\xee\x13\x63\xe3\x9e\xf1\x71\xde\x40\xf9\x84\x89\xbd\x46\xc6\x81\x63\xc3\xbf\x9d\x01\xa4\xaf\x2f\x45\xda\x5d\xa5\xd3\xb5\x32\x69\x6d\xa7\x5c\x4e\x9f\xfd\x64\x39\x0d\x9e\x6f\xfb\x21\x85\x67\x1a\xac\x37\x2b\x2e\x96\x2f\x61\xb4\xac\xef\xdf\xf2\x09\xa6\xd8\x5c\xc1\xa8\x7b\xdf\xa8\x43\x73\x54\x06\xbd\xea\xd6\x84\xa1\x06\xeb\x61\x18\xb9\xa2\x01\xae\xd4\xbf\xe1\x51\x20\x37\xce\x2e\x48\x42\x08\x74\x4d\x6f\x9b\x87\xc4\x59\x2c\x17\xf3\xc7\xa4\x41\x16\xaf\xc3\x59\xf3\xcc\x63\x7f\xba\x8a\x67\x3f\x9a\x57\x7b\x0d\x57\x7a\xb9\x71\xc4\x00\xb9\x89\x57\x41\x45\x16\x4c\x05\xff\x01\x77\xe8\x3b\xfa\x00\x26\x0b\x99\xc9\x6e\x6c\x5b\xba\x77\x92\x0c\x0f\xe1\x52\xfa\x7b\x6d\x1e\xb7\xf1\xdd\x8a\x23\x93\xda\xce\x16\x46\xcb\x15\x3f\x1e\x9b\x97\x6c\x49\x5f\x19\x1c\x63\x47\x17\x98\xbe\x74\x88\x99\x5c\xd0\x02\x42\x17\x84\xf8\x4d\x14\xed\x69\xfc\x26\x0a\x20\x82\xe6\x16\x4d\x7f\x8e\x2c\x80\x62\xaf\xb0\x6c\x4f\xc2\x5d\x00\x2b\xc2\x3b\x1d\xa9\xfa\xab\x5c\x71\xab\xfc\x4c\xc1\x60\x8e\xf9\x2a\x93\xb3\xbc\xc0\xba\xbc\xf1\x46\x88\xcd\x46\xab\xbe\xe2\xf9\x0e\x00\x0a\x11\xb3\xf7\xd6\x83\xf4\xec\x34\xac\x67\xa7\x71\x3d\xb7\xc9\x22\x98\xf1\x15\x4f\x53\x7f\x16\x47\xf0\x86\xa6\xbe\xca\xa7\xc7\x56\xc7\x93\xbe\x86\x35\xcf\xe0\x71\xea\x4a\x8e\xd2\x0f\xb9\x39\x3b\x13\xd0\xd4\xed\xd9\x58\xf7\x7a\x2f\xa8\x93\x76\x41\x79\x72\xeb\x75\x17\xe0\xc1\xf1\x46\xcf\x1e\x79\x8e\x6d\x29\x9c\xec\x0b\xf8\x80\x8d\x44\x19\x5c\x0a\xbd\x3b\xd6\x7b\xb8\x95\xd1\x01\x52\x1c\xfa\x3a\xb8\x0b\xc4\x03\x4e\x9c\xaf\x60\x15\x34\x5d\x5b\x5e\x89\xdd\x27\xfd\xef\x44\x37\x30\x74\x7d\x47\x17\x55\x81\xe1\xd6\xc3\x30\x68\x00\xf6\xca\x30\x27\xe3\x70\xe4\x4c\xfe\xf4\x00\x3a\x18\xc9\x62\xaf\xcc\xa2\x80\x0a\x9f\x51\xbe\xd0\x12\x5c\x18\x2c\x72\xe5\xba\xa6\x1e\xa3\x42\x1d\xf5\x4b\x9d\xb2\x3e\x35\x39\x95\xfa\x94\x5a\xab\xe4\x37\xad\x69\x91\x5a\xf7\x6d\x65\xab\xd4\xe2\x53\xf6\x19\xd2\x5d\xea\x35\x7f\xa1\x33\x29\xd4\x30\x47\x8d\x98\x44\x91\xcd\xe1\x6e\xb8\xd2\x34\x35\xd9\x55\xd8\x89\xcc\xf1\x4c\xaf\x5a\x37\x9d\x65\x95\xea\x33\xab\x56\xab\x19\x7d\x94\x14\x51\xba\x84\x2d\xeb\xa1\xce\xa4\x50\x43\xf5\x36\xb7\x4a\x1b\x55\x5e\xc7\x53\x67\x87\xb9\x9e\xee\x78\x70\xab\x75\x58\xc3\x1c\x73\xe6\xdf\xe7\x64\xba\x3e\x94\x11\x10\x50\xf2\xbd\x65\x95\x5d\x94\x78\x5c\x9a\xe4\x3e\x1b\x35\xe1\x8d\x8a\xc3\x05\x4c\x5d\x11\x79\x0d\x5c\xde\x73\x8e\x83\x6c\xf6\x75\x88\xd7\x6f\xfa\x05\x74\xfe\x38\xdf\x6f\x1b\xf5\xd7\xd0\xc5\x74\xfe\xe6\xe8\x63\x1f\x67\x53\x09\x68\x1b\x04\xe2\x22\xd9\x1b\x0a\x04\x9d\xae\x7d\x4c\xf2\x26\xea\xe4\xe3\xb3\xbb\xb5\x0d\xf5\x58\x80\x42\x64\xdb\xf0\x3f\x6f\x9c\x05\x95\xbf\xb6\xc7\xf7\x22\xc9\x45\x1b\x95\xf7\xcf\xe3\x7b\x77\x3b\x25\x00\x20\x89\x86\x02\xa8\x48\x39\x44\xd3\xee\x0c\x47\x13\x79\x19\xdd\xd0\x36\x1e\xe4\xf9\xd3\x38\x81\x93\xc6\xd9\x2a\x58\x6f\x9a\xeb\x03\x5d\xb9\x87\x92\xf7\x4f\xf7\x9d\xa8\x67\xe9\xc3\xb1\xef\xd9\x05\x0e\x63\xa3\xd3\x67\x82\x66\xb8\x23\x43\xb6\xa1\xca\xbb\x62\x53\x0d\x85\x14\x26\xcf\xd8\x13\xaa\xb4\x0b\x18\xbe\x6d\x8a\x12\xab\x90\x97\x42\x1b\xf3\xd1\x86\xe2\x33\x0e\xde\x29\x96\x83\x08\xbf\x2c\x01\x8a\xb1\xf7\x3e\x10\x06\xde\x12\x18\x39\xf8\x96\xc7\xf9\x1c\x4d\x00\xe6\x41\x65\xba\xa7\x8b\x95\xd5\x91\x15\x99\x47\x3e\x70\xeb\x96\x39\x18\x89\xc3\xd4\x5b\x1c\xfe\x1f\x7b\x67\xb7\xdc\x2a\xae\x2c\xe0\xfb\xfd\x18\xeb\x05\xd6\xc4\x26\x99\x75\xb5\xab\x30\xc8\x0e\x7b\x30\x78\x00\xe7\x67\x6e\x54\xc4\x96\x1d\xce\x38\xc6\x05\x24\x93\x9c\xa7\x3f\xd5\x02\xfc\x43\xfc\xd3\x2d\xc9\xe7\x6a\x57\xad\x0b\xaf\x94\x3e\x75\xab\x25\x84\x24\x5a\xdd\x87\xef\x0e\xb4\xda\x70\x72\x42\x86\x0e\x5c\x2c\xe8\x22\xe5\x22\x18\x5f\x3c\x48\xa8\xc8\x81\x7e\x44\xf6\x78\x1f\x90\xb9\x93\xc3\xad\xfb\x28\x27\xe2\x13\xef\xc1\xb3\x2d\x2c\xbf\x48\x5c\xe9\x71\x6f\x63\xfc\xc1\x95\x86\xb4\xac\x66\xfa\x0f\x3e\xec\x4c\x23\x16\xc7\xcc\x85\x6f\xc6\x36\xb7\xe3\xc4\xe1\xfd\xcf\xfe\x67\x1f\x61\xd8\xa3\xb0\xa5\x07\x5b\x7a\xb0\xa5\x0a\xdf\xea\xc1\xb7\x7a\xf0\xad\x2a\x7c\xa7\x07\xdf\xe9\xc1\x77\x34\x38\x96\xde\x10\x8d\x8f\x87\xea\x48\xfb\x5e\x89\x65\xa6\x12\xcb\x4c\x25\x96\x6e\x25\xb7\x66\x2a\xb9\x35\x53\xc9\xad\x6e\x25\x77\x66\x2a\xb9\x33\x53\xc9\x1d\xbf\xd6\xd4\xfc\xfe\x22\xf8\x5b\xba\x31\x95\x89\xa8\x7d\x69\x39\xd3\x01\x93\xae\xaa\x75\x9e\x4b\xc2\xdb\xae\x59\xdb\x90\x2b\x68\x57\x0d\xa6\x40\x38\xd6\x08\x1f\x89\x0b\x1e\xb2\xf0\xa3\x0b\x1f\x72\x2d\xf5\x8a\x84\x8e\x05\x89\x2a\x7a\x64\x41\x74\xbe\x0e\x53\x03\x76\x01\x29\x87\xce\x8f\x53\xe5\x6a\xf9\x2a\x5b\x8b\xb4\x30\x5f\xfb\x6b\xba\x5a\x60\x34\xc7\x3c\x61\xf7\xb6\x3f\xe4\x43\x3f\x34\xef\xba\xf9\x5d\xdb\xab\x19\x64\xbd\xc9\xaf\xd0\x89\x8d\x33\xc4\x2f\x7d\x23\x1f\x38\x6d\x74\xed\x7c\xa9\xfc\xaf\x6b\x75\x4c\x59\x27\xbf\x38\xf0\x22\xeb\xcd\x4d\x4f\xdd\x3d\x97\x8f\xa7\x7e\xe2\x35\xbe\x85\xaa\x13\xb8\x62\x35\xed\x1c\xa8\x88\xef\x4f\xa3\x8a\x55\x1c\x9d\x92\xd1\x75\x1d\xd9\x60\x35\x39\x4b\xfa\xee\x78\xd7\x6f\x57\x1a\x21\x90\x57\xcc\xdc\x48\x80\x7c\x58\x7c\xec\xe1\x3f\xf5\x1f\x80\x70\xc9\x49\x82\x31\x95\x94\x22\xe1\xee\x99\x92\x48\xec\xdd\xb9\x96\xf4\xc6\xe3\x69\x62\x0f\x7c\xd6\x68\x8b\xe9\x52\x30\x36\x24\xa9\x32\xdc\x8d\x4d\xc2\x71\xf9\x40\xf3\xfc\xe5\x7f\xc4\xcc\xc0\x0b\xa3\x71\x82\xa9\x9f\xe3\x73\x47\x47\xdd\x76\xca\x94\xa8\x52\x23\x99\x49\x10\x83\xb8\x62\x25\xaa\x26\xb0\xaa\x84\x4a\x0c\x35\x12\x6b\x2a\xe2\x95\x7b\xc4\x75\x7a\x01\xfb\xd2\x56\xa8\xba\xfa\xda\x08\x7e\xf3\x5b\xf3\xcf\x40\xe8\xeb\x83\x39\x6b\x57\x31\xe2\x29\x38\x0f\x68\x9b\xb2\xc9\x56\x75\x9d\x44\xa7\x97\x5b\x77\x22\xd3\x29\x01\x3c\x48\x75\x4a\xe0\x4e\xe4\x3a\xc5\x8c\xed\x36\xc5\x97\x1c\xdb\x1f\x14\xa2\xcd\xde\xa9\xc2\xa0\x04\x35\xe9\x48\xf1\x9a\x1d\xe6\x2f\xa5\x13\x1f\x34\x97\xea\x26\xe5\x29\x0d\xa2\x58\x6d\x2f\xe9\xa9\xc9\x47\x65\xec\xd7\xf7\xa5\x56\xe9\xac\xeb\x59\x4a\x7d\x44\xa4\xeb\xbf\x6f\x3b\x0c\x6a\xfd\xf1\x13\x59\x56\x5e\x29\xeb\x00\xba\x2d\x2a\x44\xbd\x46\xd5\x6c\xd0\xc4\x76\xfe\xe0\x11\x6b\x56\x5e\x97\x1b\x35\x0d\xa8\xc4\xb6\x68\xc4\x26\xbe\xe7\xd8\x09\x09\xfa\x8b\x45\x21\x1f\x7a\xbe\x4f\x81\xec\x07\x26\x5d\x64\x08\x88\xcb\x1c\x6f\x7c\x44\x37\xdd\x6e\x2a\xdf\x5f\x8c\xf4\x53\x73\x9f\x30\x9e\x0e\x1a\x85\x7b\x16\xef\x59\x5d\x6d\x71\x20\x90\x96\xd1\x86\x3e\x44\xf5\xb6\xe9\xc8\x0a\xd9\x4c\xb6\xdd\x0b\x7e\x13\xb0\x34\x85\x97\x43\x0c\xaa\xfc\xf8\xa9\x53\xd5\xc0\x8e\x9b\xf5\x6e\xb3\x3d\xbd\x58\x61\xfb\xda\xa4\x0a\xdf\x0b\x29\x01\xf8\x2e\xa2\xc4\x43\x74\x71\xa6\xfc\xee\xf2\x30\x6e\xed\xdf\x51\xe2\x58\xbf\x76\x4c\x7f\xd8\x31\x74\x93\xfd\xd7\xfa\x24\xeb\x2b\x3d\x55\x3d\xec\x63\xa5\x54\xfb\xfe\xa1\xc7\xbc\xcd\x82\x50\xe5\xed\xce\xb7\x23\xbb\x23\xf1\x5b\x0b\x29\x36\xab\x65\x5e\xc3\x7c\x93\x91\xc7\xdf\xb2\x72\xc6\x5f\xb3\x75\xd5\xbd\x23\x75\x59\xff\xc3\x91\x33\x91\x7e\x88\xdc\x0d\xa7\x03\xbf\x1d\x41\xf2\x66\xd4\x64\xe4\xfd\xf8\x79\x16\x75\xc2\x20\x66\xd1\x03\xe3\x63\x36\x0e\xa3\x67\x2c\x16\x31\xc7\xb7\xbd\x31\x91\x0a\xea\x4b\x5b\xa3\xc8\x9e\xdc\x7b\x4e\xcc\xef\xed\xc0\xf5\x99\x02\x38\x60\x23\x2f\x50\x95\xca\x02\x17\x8b\xda\xfe\xa3\xfd\x1c\xf3\x21\xc4\x20\xa2\x21\x71\x38\x24\x20\x7e\xf8\xc8\xdd\xc8\x7e\xe4\xe1\xe0\x3f\x0a\xd4\xa3\x17\x28\x50\xc3\x68\xa4\x40\x8d\xd9\x18\x4b\x41\x14\x36\x27\xa9\x73\x33\x0d\xa7\x81\x43\xe4\x7c\x6f\x74\x9f\xc0\xfe\x89\x86\xc5\x8e\x17\xc7\x21\xfa\x01\x18\x4e\x7d\x90\xe8\x4d\x60\xf5\x81\x64\x1c\xdf\x9b\xf0\x80\xd9\xf8\xa7\x0c\x80\x21\xbe\xfc\xa3\xe7\xb2\x3a\x74\x1e\x12\x18\xc0\xb2\x37\x80\xc5\x94\x1f\x9f\x66\xba\xb3\xde\x3d\x64\x43\x18\x79\x26\xe7\xb4\xf6\x00\xc5\xc0\xac\xd6\x6c\x9b\xa5\xf3\x13\xd2\x0c\x0d\x02\x93\x9a\x07\xe7\xf8\xe8\x47\x10\x16\xd7\x91\x67\xfb\x3c\x06\xcb\xa3\xa1\xa7\x76\x6f\x8f\x24\xa4\x0f\x65\x9f\x0f\x3c\x74\x61\x0b\x59\x98\xb9\x23\x36\xf4\xed\x11\xb2\x78\xbd\x7a\xc4\x95\x85\x05\xba\x3d\x1e\x78\x60\x4e\x3a\x61\x07\x2e\x77\xbd\xe1\x70\x1a\x33\x02\x4d\x27\xd8\xd8\x8b\x63\xf0\x49\xc7\x23\xd2\xc0\xf5\x52\x8e\xc5\x04\x2e\xbe\xf7\x02\xb8\xf5\x47\x62\x26\xcc\x99\xfa\x76\x84\x44\xea\x47\x19\x59\x38\x61\x4f\x4e\x18\x46\xee\x0d\xb1\x7c\x8f\x58\xbe\x4f\x2c\x8f\x1d\xbb\xf5\x33\xd4\xc3\x56\x5f\x17\x3f\x5a\xb9\xce\xec\xf5\xa7\x13\x8e\xf9\xf3\xf4\x61\xfb\x41\x65\x99\x56\xaf\x97\x3c\x55\x49\x95\xa7\x90\xf4\x8a\x57\xa2\x34\x70\xb2\x2f\xbd\x89\xe0\x26\x47\x22\xeb\xfe\xf1\x13\x5b\x5a\xbe\x88\x69\x48\xc4\x86\xc7\x85\x9c\xcc\xea\xd5\x2d\xad\xdd\x2f\x2f\xd9\x7a\x0d\x81\xae\xe0\xfe\x78\x91\x1b\x08\x04\x36\xf0\x82\x00\xd6\x16\xed\x35\x18\x39\x81\x23\xcc\xe2\x4c\xa6\x3c\x9c\x24\xde\xd8\xfb\x8b\xb9\x18\xd3\x8f\xa8\x40\xc4\x02\x70\xb7\x77\xbd\x88\x39\x09\xf8\xd1\xef\xef\x0b\x8d\x1b\x76\x5e\x64\x1f\xa2\xd0\xb1\x6b\x77\x10\x8c\x44\xe5\xca\x4a\x9d\xba\xce\xf2\x68\x9b\x2f\x51\x71\x55\x64\xeb\x25\x0a\xad\x13\x2a\x1e\xd0\x28\xae\x49\x5a\x79\x16\xd4\xb6\xaf\x9c\x1f\xe6\x62\xce\x97\xc2\xc0\x53\xdf\x1e\x44\x3c\x7a\x6e\x72\x8f\x19\x4c\x2d\x70\xcf\x60\x31\x4d\x21\xe4\xaa\x9d\x02\xc8\xc3\xe7\xc0\xde\x9e\xe9\x11\x50\x3a\x21\x23\xa0\x11\xca\xd7\x5e\x52\x0f\xb6\xef\xb9\x14\x6c\xef\x3b\x36\x81\xaa\x8f\xca\x28\x72\xc2\xc1\x7f\xe0\x61\x47\xeb\x17\x27\x70\x18\x0c\x17\x26\xc3\x88\x5d\x1c\xe8\xec\xb3\xaa\x6f\xbe\xc0\x6b\x0d\xf7\x38\xd6\x48\xed\x4b\x4e\x21\x22\x79\x38\xf3\x42\xe6\xf6\xce\x5f\x28\xd8\xb7\x1c\x40\xd9\x07\x96\x4e\xc4\x67\x28\xbf\xb2\xc7\x90\x7e\x2d\xfc\x10\x45\x91\xcd\x45\x46\x13\xde\xde\x04\x23\x50\x87\x41\x33\xaf\x3a\xdf\x28\x7d\x5c\x3e\xae\x35\x84\x0a\xa3\x75\x4d\x13\x3b\x16\x8d\x78\xe5\x41\xb4\x59\xba\xa4\x3a\xac\x6d\x9c\xbf\x17\x33\x61\xdc\xac\x0b\x18\x5f\x60\xdc\x22\xd5\x09\xdb\x75\xa4\x01\x4d\x85\x90\xa0\xa6\xe7\x5e\x47\xef\xfa\x71\xe4\x8b\xfc\x43\xa4\x95\x89\xfc\xd2\xc3\xf0\x81\xd5\x17\x80\x59\x20\xbd\x67\x60\x29\x8e\x98\xb6\x76\x5c\xec\xd8\x3e\x5c\x9a\xf6\x02\x3e\x66\xc9\x7d\xe8\x9e\xae\xe2\xcc\x51\xed\x50\xb6\x28\xcb\xd7\x4e\xbe\x5e\x64\x4b\x65\x7c\x3b\x7d\x94\xc6\x3b\xe0\x2d\x07\x01\x5c\x94\x55\xf6\x66\x2a\xda\x5b\x58\x9b\x3e\x4e\xe0\x9b\x20\xfc\x8c\x99\x1d\x39\xf7\xf5\x9d\x7b\xfe\x84\xe9\x89\x0b\x75\x3c\xe3\xba\x02\x52\x47\xd6\x0d\x13\x63\xd9\x4e\x35\x2a\x12\xcb\x2c\x5f\x9b\xb7\xfd\x46\x14\x8b\xb7\x7c\xcd\x97\xab\xfc\x25\x5d\xf1\xb7\x7c\x2e\xf4\xad\x3f\x61\xd1\x70\x1c\x06\x7c\xe4\x87\x03\x1b\x0c\xe9\x32\x6e\x5c\xf3\xe6\x1b\x47\xf9\x9a\x16\x62\xce\xc5\xe7\x26\x5f\x0b\x53\xd9\xa4\xa5\x80\x26\x26\xe1\xc1\xf4\x20\xaa\xd9\x2b\x5f\xe7\xeb\x59\xfe\x2a\x8a\x8b\xe2\x30\xb6\xda\xdf\xb9\x0c\x19\x84\x65\x08\xc2\xc0\x09\xef\x21\xee\x82\xc2\xd3\x0e\x0a\x0e\xd2\xa2\xc8\x44\x61\xdc\xe2\x27\x0d\x02\x79\xe0\x0d\x1b\x1e\x36\xb2\x97\xab\xc5\x18\xb8\x0d\xfe\x09\x99\x0a\x8e\xdb\xf3\x4c\x79\xb8\x9e\x24\x3f\xfd\xd8\xf1\x04\x56\x9f\x11\x4c\x04\xe4\x5a\x6e\x9e\x6e\xb8\x74\xaf\x8a\x15\xd0\x9e\x2a\xda\x53\x97\xda\x53\x97\x6a\xe9\xa0\xd6\x59\xb4\x3b\xee\x9b\xd8\xac\x51\x5a\x99\x5f\xcc\xb4\x07\x5c\xe6\x16\x04\xed\x3e\xa6\x7e\xc1\x33\x97\x0f\x99\xdd\x78\x84\x27\x31\x65\x23\xb4\xad\x00\xfc\x80\xa5\xbd\xb8\xcb\x82\xd8\x4b\x9e\x95\x6a\x69\xd5\xf8\x73\xca\x22\xb5\x1a\x60\x07\x38\x0c\x1d\xdb\xaf\x03\xc3\xc5\xf8\xaa\x4e\x7c\xce\xdf\xad\x81\x50\xe3\xa0\xd9\xb5\xfd\x7f\x2c\x55\xba\xc3\x42\x69\xfb\x70\xc1\x9c\xce\x34\x09\x87\xc3\x93\x7d\x6a\xbc\x0d\x3b\x0f\xa7\x39\x5f\xa5\x5f\xf9\xbb\x89\xb7\xda\x6e\x15\xdb\xba\x2d\xb9\x90\x76\x05\x92\x86\x5d\x5a\xcc\x1e\xd6\x04\xbe\x39\x4d\x08\x9d\xbd\xaa\xb6\x71\x9d\x92\xf8\x0a\x06\xca\x76\x3e\x14\x46\x62\x57\xd7\x1f\x51\x9a\x71\x3e\xf0\x92\xdf\x30\x0d\xef\x42\x37\x2a\x50\x4f\x05\xea\xab\x40\x96\x0a\x74\xab\x02\xdd\xa9\x40\xbf\x63\xa0\xfa\x3c\x8f\xd8\x4f\x5d\xe8\x46\x05\xea\xa9\x40\x7d\x15\xc8\x52\x81\x6e\x55\xa0\x3b\x15\x08\xd5\x4f\xed\x75\x32\x62\x4f\x7d\xc7\x6e\xd4\xb0\x9e\x1a\xd6\x57\xc3\x2c\x35\xec\x56\x0d\xbb\x53\xc3\x50\xfd\xb6\x7f\x45\x8c\xd8\x77\xc7\xd1\x1b\x75\xb4\xa7\x8e\xf6\xd5\x51\x4b\x1d\xbd\x55\x47\xef\xd4\xd1\xdf\x71\x4b\xb0\xb8\x4a\x8b\x2a\xc9\x56\xf8\xef\x51\xf3\xe3\xa5\xb5\x5f\xde\xff\x14\x59\x25\xf2\xf5\xea\xcb\xe4\x0b\x5c\x26\xba\x92\x79\xae\xea\x2f\x8f\xb0\xb3\x33\xa9\x39\xd4\xca\xcb\x59\x21\xc4\x9a\xcf\x20\x41\x71\xb6\x4e\xab\x6f\x61\xe1\xa9\x5a\xc7\x4e\xc4\x58\xc0\xa5\x5f\x82\x17\xd8\x09\x8b\xa5\xa0\x1f\x3f\xcf\x52\x5e\x20\x9d\x07\xdc\x16\x7f\xfc\x06\xe9\xb4\x34\xee\xf3\xb2\x5f\xcd\x34\x5b\x16\x8d\x06\x3c\xee\x27\xce\x85\xa6\x44\xa3\x81\x85\x2c\x67\x63\xcb\xe1\x2b\x74\x9f\x92\x5b\x7c\xad\x47\x4b\x6b\x59\x7a\xe4\xc5\x7c\x2e\xaa\x34\x5b\xb5\x4b\x7e\x4d\xab\xbb\x2c\xb1\xbd\xdd\x86\xa5\xe7\xf2\x78\xe4\xc5\x17\x5a\xf7\x1d\x6a\xef\x58\x22\x60\x70\xef\xb3\xa3\xb6\x0e\x32\x50\x3b\x80\x90\xb1\x7a\xed\x88\xc0\x3a\x6d\x93\x5f\x41\x15\x38\x79\x3e\x4a\xc7\xa4\x97\x4a\xb3\xe3\x3e\x4a\x77\x27\x5d\x57\x0e\x86\x44\x7c\x0e\xdf\xd7\x33\x14\x31\x12\xd5\x59\x48\x7b\x7c\x2e\xf2\x25\x5f\xbc\xaf\x67\x47\x4e\xfd\xa9\xa3\x73\x18\x8e\x6a\x93\x20\x2c\xb9\x2d\x7b\xce\x7c\x87\x08\xec\x43\x51\x58\xd7\x86\xc3\x7c\x49\xb1\xf7\x89\xe2\xda\x96\x5e\x8a\xb5\x80\x03\x55\xfe\x96\x6d\xde\xd2\x8d\xa6\xb1\xdb\xd4\xaf\x7c\xec\x4d\x20\x2e\x0a\xc2\x80\x5d\x44\x7a\x13\x19\x6f\xe6\xde\x35\x08\xcd\x26\xee\xaf\x87\x10\xcd\x6b\x4a\x36\x2e\x61\x21\x1f\xdb\xf1\x1f\x2a\x5c\x18\x50\xc4\x61\xa5\xdc\x4c\xec\x18\x35\xcc\x7b\xb2\xe0\x6f\xf8\xa2\x37\x98\xa2\x16\xbe\x56\x8b\x5a\x6b\x0f\x5f\xb4\x4f\xb0\x6c\x1b\x42\x1d\x4d\x10\x8a\xd6\x83\x03\x42\x44\x93\xfb\xba\x5e\x9d\x11\xa8\x89\x9d\x80\xcf\x11\x47\xcd\x3f\x75\x74\xd4\x71\x5a\xfe\x4d\x28\x3e\x49\xab\x4a\x14\x6b\xe3\x8f\xf2\x26\xfb\x14\xa6\x96\x2e\xf5\x31\x79\xfb\xe6\x44\x58\xaf\x06\xb6\x59\x95\xe4\x82\x33\x9c\x46\x8e\x0a\xdc\x2c\x43\x88\xf8\x28\x0a\xa7\x93\xe6\x1c\x0b\xd5\x1b\x13\xb0\x57\x22\x3e\x47\x62\x77\x02\x9e\xa9\x93\x1f\xca\xe8\x42\x9d\xc4\x09\x05\x87\x16\x75\x95\x4f\xd0\x8b\x0f\xf3\x23\x58\xa6\x43\x82\x20\x51\x30\x8c\x97\x42\x77\x89\xc3\x9e\x19\x77\xbd\x38\x81\x4c\x9c\xf0\xae\x90\xab\x17\xcc\x88\x6a\x7c\xe7\x94\xd8\xae\x50\x58\x93\x2b\xca\xc4\xa2\x20\x92\xda\x36\x52\x7b\x88\x6d\x38\x5a\xdc\xd0\xe8\xd8\xb4\xe3\x4f\x77\xb7\xdf\x18\x00\xc2\xe0\xc2\x87\x41\x44\xeb\xf6\x09\xfb\x09\x4f\x0c\x6d\x97\xf1\xe4\x3e\x62\xf1\x7d\xe8\xbb\x75\x05\x08\x78\x3b\x12\xe0\x8d\x14\xb4\xd9\x11\x30\x4f\xac\xf4\x04\xdc\x3d\xa8\x2a\x8c\xf9\x87\x1b\x1c\x4f\x36\x62\x6d\xe8\x05\xd5\xec\x40\xe3\x7b\x3b\x9a\xb0\x80\xb0\x67\x6d\x09\xea\x5e\xb7\xe5\xd0\x9b\xdd\x16\x50\xde\x7e\xc6\xb5\xc1\x88\xfb\xcf\xf3\x94\x76\x2f\x36\xbd\x67\xb9\x9a\xfd\x27\x63\x6a\xc4\x7f\x78\x13\xfe\x10\xfa\xd3\x31\x6e\x35\x28\xa1\xfa\x53\x66\x93\x74\xfd\x32\x33\x0d\x94\x44\x4d\x03\x15\x61\x6d\x5f\x5b\xa8\xa3\x9e\x49\x14\x3e\x3d\x13\x99\x5d\x69\xec\x34\xd2\x12\x32\xed\xc8\x9f\x18\x02\xb6\xee\x56\x27\x2b\x08\x41\x90\x75\xe1\xc8\xea\x54\x4e\x0f\xcb\xc5\x16\x6f\x5d\xa4\x2d\xf7\x5a\xe3\xfb\x5c\xda\x0b\xea\x50\xef\x26\xaf\xb8\x9a\xce\xb3\x7c\x95\x17\xfc\x2d\x2d\xff\xd6\xd4\xb8\xed\xc9\x7a\xae\x93\x87\xf9\xa7\x37\xce\x47\xfa\x07\xb4\x71\x40\x99\x63\x3b\x23\x63\xcd\x15\xf3\xa5\x30\xdb\x41\x70\xe1\xf5\x7a\xdd\xb3\xc8\x56\x95\x28\x2c\x4d\x65\x87\x9e\x9f\xb0\xc8\x3a\xde\x17\xc7\xbb\x71\x8b\x9c\x7c\x90\xbb\x7d\x58\xdf\x44\x18\x4a\x85\xd1\x2f\x9f\xb3\x84\x31\x2b\xae\xf2\xb9\xa6\x05\x5b\xb3\xc0\xaa\xcf\x0f\x49\xb3\x2e\xcc\x8c\x44\x44\x46\xd7\x41\x9f\x74\x1f\x08\x3a\x0a\x19\x33\x64\x29\x56\xdf\xe3\x41\x52\x6d\xe9\x4e\xed\xe6\xb3\x01\x6a\x44\xee\x8a\xff\xa2\x15\xbf\xe9\x11\xcb\xdf\xa1\xcb\xfb\xd3\xb1\x17\xc0\x3a\xdb\xa2\x23\xbf\xe8\xc8\x4d\x4f\x81\xc1\xb7\x06\x6e\xcc\x49\xdf\x46\x8b\x8e\xfc\xa2\x23\x37\x3d\x05\x46\xa1\x6f\xa8\x63\xac\x03\xa2\x5a\x06\xd9\x74\x09\x72\x76\xc5\xf1\xb5\x6f\xd5\xb2\xe8\x08\x5e\xca\xd6\xd2\x16\x1d\x41\x49\x91\xfd\xd9\x4e\x55\x31\xf3\xe1\x3c\x01\x2b\xe9\x02\xa6\x39\xbd\x3d\xf1\xb4\xfc\x5a\xeb\x7e\x2e\xb7\xe3\xe7\xc0\xe1\x63\x3b\xfa\x03\xbc\x28\x47\xde\xd3\xc5\x77\x9f\x0d\x52\xc7\x69\xf1\xb7\x28\x50\xe5\x87\xd9\x3a\x2b\x5f\x25\x85\x2a\x3f\xc9\x57\x2b\x7c\xe9\x91\x58\xef\x29\x54\xa2\x98\x3a\xd4\x2e\x19\xf3\xca\x3d\xa4\x4b\x98\xe9\x4c\xfe\x9a\x95\x95\xbc\xc6\x67\xa4\x5b\xef\xbd\x38\x09\x47\x91\x3d\x3e\xde\xb3\x87\x08\xbc\x87\x2f\x61\x86\x5a\x29\x8f\xef\x8d\xb4\x10\x82\xc7\xd4\xbb\x64\x44\x0b\xeb\xd6\xc9\x30\x4c\xcd\x5d\x02\x34\x24\x83\x7c\xe2\xa1\x9d\x2d\x49\x0a\xee\x30\xa2\x92\x3b\xf0\x9c\xa2\xda\xbd\xf7\xb2\x12\xeb\x79\x13\x87\xe3\x2d\x5b\xbf\xa5\x9f\xba\x9d\x08\xef\x94\xf6\x4c\xf2\x52\x23\x9b\xc2\xf6\xd3\xb7\xc2\xda\x0d\x9b\xa5\xab\x55\xb6\x2c\xd2\xcd\x6b\x36\x83\x4b\x4c\xcb\xb7\xef\x17\xb8\xa8\x6d\x73\x6c\xdf\xf7\x9a\xa8\x69\xbb\x0f\x3e\xe6\x55\x5f\x99\xf8\x68\xdf\x9c\xfc\x41\x64\x2e\xf8\x00\xdf\x1e\x04\x76\x94\x3d\x02\xb6\xef\xb8\x96\x74\x18\xc4\x31\x50\x21\xe5\x85\x0f\x15\xb0\x49\x0b\xa7\x40\x3e\x78\x51\x02\xef\xf7\xed\xa1\x17\xb5\x02\xd8\x20\xa9\x8b\x47\x8b\x85\xc7\x5b\x0b\x22\x37\x14\xba\x1f\x62\xd7\xb4\x15\xb4\xff\x57\x40\xf1\x63\xa9\x29\x79\x49\xa6\xfe\x23\x93\xaf\x3f\xf2\xd5\x3b\x38\x16\xf1\x74\x36\x7b\x2f\xd2\xd9\x97\xe6\xf3\xe3\x84\x01\x9c\xb8\xca\xef\x16\xad\xf7\x8a\x59\xa5\xe7\x10\xa9\x9b\x6f\xd2\xb2\xe4\xd9\xba\xac\x8a\xf7\x0b\x53\x94\x42\xe5\xcd\x76\x59\xd3\x14\xf5\x18\xdb\x26\xc0\xbf\xb9\xfb\x66\x8b\xcb\x50\xcf\x52\x80\xfa\x3d\xf3\x56\x5f\xac\xde\xcb\x57\x5e\xa4\x65\x25\x0a\xb2\x5d\xba\x0b\xc8\x21\x54\x16\xc9\xba\xcc\x2b\x9a\x2f\x79\xbe\x58\x94\xa2\x22\xab\x79\x68\x54\x70\x64\xc3\x4f\x69\x7b\xa5\xb7\x3e\x2b\x86\x1b\xd6\xbc\x8d\xf9\x2a\x5b\xbe\x56\xdf\x5d\xb6\xc9\xed\x6b\xdf\xc4\xdb\xf8\x98\x98\x66\xb6\x50\x7d\x4c\xbc\x0b\x77\xa8\x8e\x0e\x21\xce\xbc\x06\x3f\xb1\xe1\x7d\x89\x7c\xd7\xc2\x0b\x64\x5b\x91\x6c\x38\x7e\x59\xe9\xc8\xf0\xaf\x78\xc8\x99\x46\xf2\x7e\x7a\x64\xc7\xa0\x5d\x13\x8a\x0f\x01\x4a\x11\x9c\x05\x0f\x5b\xaf\x57\xb4\x6d\x6a\x14\x30\x9f\xfb\xf2\xba\x29\x84\x7d\x66\x91\x72\x25\xc9\x63\x58\xc7\xb2\x54\xad\xa0\x8d\xdf\xa8\xca\x37\x66\x83\xc3\x8a\x68\x12\xfa\xdb\x2f\xe2\xb4\xca\x7e\xa3\x23\x37\x74\xa4\x47\x47\xfa\x74\xc4\xa2\x23\xb7\x74\xe4\x8e\x8e\xfc\xce\x51\xc7\x07\xc3\x66\x22\x6b\xbe\x16\x81\x53\x52\xba\x22\x91\x3e\xcc\x80\x0b\x05\xe4\x83\xce\x64\x0a\x88\x82\x98\x71\x3e\x17\x2b\x85\x26\xd5\x9c\xaa\x40\x85\xc6\x49\x81\xc4\x16\xb6\x9d\xbc\x50\xa3\xd4\x84\x65\x6a\x14\x4e\x18\xe4\x70\x51\x18\x59\x5d\x2c\x23\x63\xad\x9e\x0b\x65\x12\x29\x53\x76\x36\x5b\x7f\x64\xd7\x58\xc8\xbc\x89\xff\xcd\xf3\x37\xfd\x05\xcc\x98\xfd\x15\x86\xa8\x43\xbd\x5d\xe1\xa1\xed\x24\x21\x65\xb1\x80\xe0\xba\xc6\x93\xc1\xa1\xfe\xca\xf3\x37\xe3\xc6\xcb\x96\xab\x3c\xaf\x13\xfd\x2c\x54\x12\xfd\x74\x35\xf5\xa0\x3e\xaf\xad\xce\xbc\xba\xdb\x1d\x9a\xae\xeb\x9e\x17\xc4\x49\x34\x95\xef\xa7\xe6\xe6\xa5\x74\xb4\xc3\x2d\x6d\xf6\xe0\x31\xb3\xe3\x69\xc4\xe0\x77\x8c\xeb\xcc\x91\xa8\xbc\x5d\x33\x50\xc8\x5e\xf9\x3a\x4e\x1f\x8a\x82\x03\x7f\xaa\xa4\x48\xa4\x73\x2a\x23\x2f\xa2\xd2\xa1\x7c\x73\x86\x31\x30\x52\x4c\xe7\xae\x32\xaf\x62\xb1\x37\x9e\x6f\x74\xf5\x8c\xf6\x46\xe5\x8d\x79\x65\x57\x59\x59\xf1\x4d\x91\xe5\x45\x56\xe9\x1e\xea\xf8\x5e\x9c\xf0\x49\xe4\x85\x11\x04\xd0\x41\x0d\x97\x91\xa8\xfc\xac\xec\x38\x97\xd2\x31\xf4\xcb\x6a\x5f\x94\x02\xa2\x20\x26\x53\x40\x3e\x8c\x77\xf3\x55\x2e\x80\xf0\x11\x43\xed\xb1\x0e\x81\xd3\x9b\xd5\xae\x51\xf6\xae\x16\x5c\xcb\x20\xd9\x4a\x94\x66\xcc\xe1\xc1\x45\x27\x38\x7e\xb5\x7d\x6f\x14\x1c\xfd\x76\x71\x1e\x76\x6c\xe7\x1e\xc2\x1a\x3b\x11\x53\xa0\xeb\x10\xcd\x34\xa6\x89\xd2\x4c\x83\x46\x91\xe7\x2a\x49\x93\xa0\xba\x48\xf4\x51\xfc\x1e\x58\x9b\xb4\x75\x52\x35\x3c\x80\xf2\xd5\xd7\x3a\x7f\xcb\xd2\x15\x5f\x2c\x4c\xf9\x9d\xb9\xac\x8e\x4d\xdd\x26\x96\x40\x34\x77\xc4\xc2\x31\x4b\xa2\x67\x63\x2c\x82\x3b\xa6\x2e\x02\xdb\x2b\x1e\x6f\x2f\x75\x5e\x82\x60\x75\xbd\x2f\x67\xeb\x28\x7b\x79\xfe\x70\xc5\x22\x2f\xea\x30\xa8\xe3\x74\xd3\x9f\xab\x40\xb8\xb7\x44\x2d\x09\x55\xd4\xcf\xd3\xb9\x37\x17\xeb\x2a\xab\xbe\x0e\x65\x19\x1f\xa2\x85\x58\x88\x42\xac\x67\x82\x6f\x56\xe9\x5a\x77\xda\x97\x49\xbf\x18\xf8\x4d\x4d\x7c\x3b\x60\x98\xbe\xeb\x22\xec\xcf\xdd\x55\x95\xcb\x96\x8a\x5a\xf5\x27\xa0\xfd\x15\xac\x73\x85\x9c\xa1\x08\xa3\x4c\x03\x32\x72\x24\x6b\x28\x85\xda\xa5\x0d\xa5\x50\xdb\x24\xa0\xc6\x2d\x5f\xce\xd2\x95\x78\xc9\xd2\x52\xe6\x6d\xd2\xb4\xbf\x0c\xed\x3c\xf0\xec\x26\x0f\x95\x79\x65\x5f\xd3\x79\xfe\x8f\xa6\x92\xed\x84\x09\x5f\xf9\xec\x88\x51\xe6\xd8\x16\x09\x27\x2c\xb2\x91\x27\x12\x2d\xeb\xc3\x03\xe7\x73\x12\x33\x3a\xc5\x18\xb2\x24\x4f\xdf\x5e\x32\x7d\x6f\x11\x88\x63\x18\x3e\x9e\xfc\x6c\xa0\xaf\xec\x06\x82\x1f\xe9\x2a\x39\x81\xfb\x11\xdf\x94\x3b\x5d\x12\xfb\x21\xa7\x29\x6e\x3f\x79\x31\xa1\x78\x12\xd9\x41\x8c\xff\x34\xb2\x13\x62\xfb\x84\xf2\xcd\x45\x4f\xb9\x0c\x66\x2e\x01\x84\xab\xaa\x67\xa9\xee\x0b\x22\x96\x7d\x44\xdc\xd0\x75\xa1\x0f\x15\x2a\x53\x82\xcc\x6f\xeb\x4c\x25\x54\x96\x6f\xa4\x6d\x4c\xd0\x3a\x84\x30\xa2\xe3\xa6\x81\x22\x58\xaf\xd1\x77\x9c\x65\x59\x96\x0a\xd7\xb3\x7a\x4a\x9c\xd5\xb3\xae\xe0\xfc\x50\xa5\x4b\x5e\xaf\x29\x78\x1d\xd3\x9b\xdc\x2b\xdd\x11\x94\xa4\xcb\x3a\x8e\xc4\xf1\xe3\x41\x7d\x8d\x9b\x0b\x1f\xe9\x7c\xce\xc5\xfa\x43\x73\x14\xb5\xaf\x10\xf8\x24\x2d\xdf\xc8\x57\xd3\x77\x17\xd8\xcd\xc8\x2d\xaf\x56\x71\xd8\x6b\xd4\x57\xf2\x50\xd3\xea\x77\x2c\x51\xc3\xa2\xeb\x59\x6a\x95\xcf\x39\x2c\xb5\x0c\x59\x08\x1c\xf8\xea\xae\xa5\xb4\x74\x4b\x25\x4a\xd4\x15\xcd\x23\x63\x1c\xa9\x3e\xad\xc7\xd5\x96\xc1\x8e\xda\xb0\x8f\xd7\x59\x93\xb6\xda\xcb\x85\xb4\x89\xee\x9d\x84\xf1\x2e\xed\x78\x7d\x27\xf0\xf8\x13\x8c\x64\xe5\xa2\x5c\x15\x96\x82\x23\x3b\x18\x29\xd7\x50\x8b\x3f\x5e\x85\xb6\xf1\x9b\x94\xb3\x9b\x42\xcc\x56\x99\xee\xcc\xd3\xe4\x73\x9d\x40\x82\x6b\x6f\x82\x69\x6f\x87\xb8\xce\x00\xfb\x9a\x15\xb3\x17\xcd\xa6\x3d\x3b\x91\x33\xe0\x56\x0f\xe5\xed\xd2\x14\xb6\xac\x2b\xb5\x05\xb5\x52\xa2\x57\x9b\x1a\xb1\x11\xd6\x3e\xb6\x69\xe3\x6c\xaf\x85\x57\x45\xa6\x7b\x35\x61\xeb\x6e\x17\x79\xf2\xc2\xc1\x8f\x9f\x84\xe2\x09\xac\x3f\xb7\x27\xbc\x17\x50\xf9\xba\x55\xc7\xe5\x84\x71\x20\x3e\x62\xee\x6e\xc6\x22\xd3\x23\x19\x19\x56\x9d\x1f\x48\x37\x50\x65\xbc\x09\x56\xa2\xcc\x43\xe3\xdb\xa9\x9e\x0c\xd7\x6d\x57\xc6\x65\xd3\x95\xe9\xba\xe5\xc7\x70\x33\x8f\x45\x05\x79\x3e\x8d\x3c\x15\x89\xcc\x5a\x86\x6e\xe3\xce\x35\x5e\x09\xdf\x33\x11\x91\x97\x41\x47\x54\x18\x5d\xc5\xf7\x2b\x51\x56\xff\xa0\xb4\x63\x93\x19\xe4\x40\xdc\x47\x9a\x4c\xa2\x34\x68\xfb\xf1\x8c\xc0\xc0\x53\xda\x7e\xc7\x22\x60\xcd\xdc\x44\x07\xe5\x93\xa9\x20\xb0\x99\x8d\xe8\xe0\xf6\x2e\xb3\x0a\xbc\xbd\xa2\x7c\x1a\xee\xee\xac\xa5\x57\x69\x02\x4f\x38\xad\xf4\xf6\x34\x67\xf1\xa1\x08\x66\x48\x70\xf3\xb5\x83\x31\xc4\x48\x54\x1a\x00\xb1\x5d\xc7\xd9\xec\xc3\xf0\x44\xdc\x6e\x74\xcc\x4d\xc8\xed\x4e\x61\x7f\xf8\x5c\x1e\x6c\x87\xe1\x98\xce\xb0\x5a\x0d\x9e\x06\xf2\xae\x55\x59\xa5\xeb\x8a\xcf\xd3\x4a\x77\x75\xd9\x1c\xcf\x39\x61\x00\xd1\xe2\x12\xee\xda\x89\xcd\xe3\x69\x70\x69\xb1\xb9\x6b\xe7\x45\xb0\x3b\x2e\xea\xcb\xeb\x4d\x9c\x9f\x2e\xa1\x69\x9c\x83\x7b\x68\x4d\x10\x26\x48\xb2\xa8\xbb\xf9\x95\xd1\xaf\xda\x00\x4c\xd3\xc0\xa4\xc6\x4d\x2a\x48\x79\x03\x58\x53\xcb\x26\x01\x64\x33\xc1\x1e\xaa\x79\xa9\x78\xeb\x93\x3a\x0d\x2e\x76\xe0\x48\x6a\x5c\x67\xd6\x4f\x67\x55\x5e\xbc\x28\x51\xa5\x12\x95\x29\x51\x0b\x25\x6a\xae\x44\xbd\xab\x99\xe3\x5d\xcd\x1e\xef\x99\xe1\xf1\xf8\x26\xca\x57\x9e\x16\x45\xaa\xeb\xea\x27\x23\x9c\x8c\x59\x7c\x8f\x18\x8a\x49\xe4\xd9\xc1\xc8\x67\xa7\xcb\x77\x0d\xe1\x16\xe9\x3f\x63\x51\xbe\xda\xa0\x69\x69\xd8\x06\xe5\x2a\x9b\x09\x79\x8f\x55\xd7\xb3\x3c\xf6\x3d\x08\xc1\xe9\x38\xd3\x31\x37\xac\x64\x55\x64\xe9\x7a\xb9\x12\xd2\x3f\x53\x53\x4d\xb8\x5d\x6c\x47\xc9\x51\xcb\x77\x4b\x4e\xa4\x4b\xec\xd8\x73\x5d\x9f\x11\x80\xd0\x77\x59\x8c\x91\xb0\x1d\x0b\xbe\x87\x2a\xdf\x08\x68\x2e\xf4\xb9\x4c\x05\xb1\xa3\xc8\x7e\x56\x07\x65\xee\x7f\x75\xb1\x49\xe4\xe9\xa8\xbd\xf5\x5e\xbf\x5c\xc1\xcd\xd4\xe3\x0f\xfd\x21\xb6\xa8\x63\x4d\x07\xa4\xf2\xfd\x21\xa5\x78\x40\x2b\xee\x58\x43\x2a\x92\xf4\xc8\xc5\x15\x24\x5c\x52\xac\x3b\x77\x45\x62\xb3\x4a\x67\x02\xbc\xdf\x9d\x7c\x2e\xde\x33\x15\xa8\x54\x81\x5e\x54\xa0\xec\x43\x85\x2a\x95\xa8\x17\x15\x4a\x06\x2c\x16\x85\xe1\xf9\xb5\x3e\xc4\x27\x4f\xac\x5d\x5d\xe5\x86\xcb\x7a\x7f\x79\x90\xd5\xf5\x50\x2b\x91\x2e\xf3\x41\x87\xfa\x0a\x82\xfa\x04\x41\xfd\x05\x59\x4e\x7f\x41\x12\x13\x80\x8f\xe5\x8a\x26\xa7\xcb\xe0\xdb\x63\x2d\x54\xe4\x9d\x40\x51\x62\x13\xf1\xe9\xc0\x37\xea\x1e\x49\xe2\x77\x8a\x24\xcc\x6a\x30\x6b\xa1\x46\x11\x5b\xa6\x32\x26\x4f\xd3\x2a\xc2\xfb\x0b\x0d\xd9\xca\x3d\xaa\x32\x96\x4e\xd3\x2a\xed\xb6\x34\x75\x30\x31\xb2\xad\xe3\xb5\x58\x0b\x23\x95\xa8\xbc\x29\xde\x33\x8a\x35\x4e\xc2\x6a\xa2\x55\x1e\x86\x8b\x95\x68\xa8\xd2\x5f\xe8\x6b\xd2\x5f\xe8\x29\x72\xd8\xa9\xfd\x85\x91\x4a\x34\x6c\x62\x2d\x8c\x68\x74\xa2\x2e\x35\xc5\xbe\x4f\xf9\x86\xaa\xd1\x55\xc7\x88\xa9\x4e\x57\xa7\xab\x9e\xc9\x0e\xbd\x58\xeb\x87\xc1\xd5\xe7\x83\xf7\x20\x3d\xa1\x45\xc1\x5f\xb2\x75\x5a\x7c\xe9\xa7\x54\x6d\x72\xba\x0f\xbc\xc0\x8e\x9e\xf9\x83\xf7\x60\x4a\xd7\x47\x2f\xe0\x9b\xd7\x7c\xbd\x94\x1a\xeb\xc7\x12\x9a\xdc\x87\xc1\x88\x3f\x7a\xdf\xfb\xe8\x58\x39\xe9\xda\xf2\xe8\x19\xb3\x3c\xb4\xa6\xdc\x88\xd9\xfb\x2a\x2d\x20\xfe\x93\x66\x63\x20\x94\x53\x3c\x61\xce\xd4\xb7\xa3\xed\x91\xb9\x82\xba\x7b\x7f\x28\xff\xfd\x2f\xe8\xae\x65\x56\x56\xc5\xd7\xbf\xff\xf5\x7f\x03\x00\xf7\xab\xab\x89\xf8\xbe\x1f\x00")
+
+func registryGlXmlBytes() ([]byte, error) {
+	return bindataRead(
+		_registryGlXml,
+		"registry/gl.xml",
+	)
+}
+
+func registryGlXml() (*asset, error) {
+	bytes, err := registryGlXmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "registry/gl.xml", size: 2080504, mode: os.FileMode(420), modTime: time.Unix(1792218352, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _registryGlxXml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x73\x9b\x4a\xba\xee\xf7\xf9\x15\x2a\x7f\x9a\x49\x9d\x93\x48\xb2\xe3\x28\xe7\x38\x99\xe2\xd2\xc2\xcc\x42\xa0\x01\xa4\xc8\xf3\x85\xc2\xa2\x6d\xb3\x97\x04\xda\x80\x1c\x79\xff\xfa\x5d\xcd\x45\xa2\xa5\x6e\xba\x41\x48\xb6\x93\xb5\x52\x35\x35\x16\xfd\x3c\xfd\xde\xfa\xed\x2b\xcd\xcd\x3f\x37\xcb\x45\xe7\x19\x46\xb1\x1f\x06\xdf\x2e\x7a\x1f\xbb\x17\x1d\x18\xcc\x43\xcf\x0f\x1e\xbf\x5d\x4c\xec\xe1\xff\x1d\x5c\xfc\xf3\xfb\xdf\x6e\x22\xf8\xe8\xc7\x49\xf4\xf2\xfd\x6f\x9d\x4e\xa7\x73\x33\x0f\x97\x4b\x18\x24\xdf\xad\xc0\x5d\xc5\x4f\x61\xd2\x09\x1f\x3a\xc9\x13\xec\x28\xda\xac\x53\x14\xed\x44\xf0\x7e\xed\x2f\x92\xce\x43\x14\x2e\xd3\xa7\x7f\x3c\x45\x61\x10\xc6\x9d\x27\xe8\x7a\x30\x8a\x11\xe8\x71\xb1\x81\x9b\xe4\xe3\x53\x21\x42\xa7\xdf\xed\xf7\xbb\x9f\x2f\xbb\x9d\xbf\x2f\xfc\xfb\xc7\xc5\x73\xe0\x75\x7a\x1f\xaf\x3f\x76\xff\x5f\x47\xd1\x3e\x3d\x2e\x36\x1f\x9f\xfe\x4f\xfe\xff\x52\xdc\x3f\x3e\x76\x4c\xb8\x5a\xb8\x73\xd8\xf9\xe9\x27\x4f\x69\x35\xeb\x55\x9c\x44\xd0\x5d\x76\x50\x71\xa4\xde\xcf\x27\x18\x74\x56\x61\x1c\xfb\xf7\x0b\xf8\xf1\xe6\x53\x21\x7d\xa6\x4b\xf2\xb2\x82\x71\xa6\xd7\xf6\xef\x4e\xe0\x2e\xe1\xb7\x0b\x31\x0c\x17\x17\x9f\xc8\xcf\xa4\x70\x11\x46\x4b\x77\x45\x7b\x2e\xfb\xf1\x6a\xe1\xbe\xd0\x1e\x0f\xc3\x20\xa1\x3d\x1b\xfb\x9b\x0a\x62\x6b\x1e\x41\x18\x50\x9f\x26\x6e\xb2\x8e\x69\x4f\x7f\xf8\x81\x17\xfe\xa4\x3d\x9d\x4d\xfd\x78\xed\x2e\xd4\xe0\x21\xa4\x15\x51\xb4\x7b\x3f\x79\xf0\xe1\xc2\xab\x28\x11\x86\x0b\xe8\x52\x25\x54\x34\x18\xac\x97\xf4\xa7\x0f\x8b\xd0\xa5\x9a\x46\xd1\x7c\xba\xdd\xd2\x87\xab\x24\xa2\x3f\x8f\xfd\xff\x81\x3e\xe3\x71\x25\xc1\xfa\xfe\x25\x81\x15\x8f\x2b\xa4\x93\x47\xf7\xeb\x87\x07\x48\x25\x97\x47\x2b\x37\x72\x97\x54\xdf\x4d\x35\x3d\xf4\xa8\x75\x4f\xb5\xb1\x9b\x3c\xd1\x9f\x5a\x30\x7a\xa6\xd7\xed\x07\xc9\x65\xdf\xa1\x8a\xee\x07\xc9\xf5\x15\xe1\xf1\x77\x54\xc6\x83\x0f\x9d\x38\x89\xd6\xf3\xa4\xe3\x38\x8a\x36\x9b\x87\x41\x02\x37\x89\x09\xe7\x9d\x0f\x37\x88\xfe\xbb\xa2\xcd\xa4\xec\xc7\x9b\x4f\xe9\x0f\xff\xff\xe6\x13\x42\xd2\xd8\x66\xaa\xdc\xd9\x22\xb3\xc6\xd0\x00\x28\x47\xee\x4f\xf7\x7e\x01\xf9\xa0\xcf\xa1\xef\x75\xfe\x5e\x48\x9c\x2a\x02\x37\xc9\x70\x1d\xcc\xc7\x49\x94\x53\xfc\xe3\xef\xa8\xd4\x3f\xea\x08\x91\xeb\xad\xca\x7c\x52\x6c\xed\xb8\x25\x18\x8a\x52\x18\x3c\xf8\x8f\x39\x3e\xb5\x6a\xe9\xe7\x3a\xb2\x64\x8d\x9f\x4f\x10\x0c\x38\xce\x02\xf7\x55\x0d\x39\xf5\x3d\x18\x4a\xee\x2a\x59\x47\x50\x86\xcf\xfe\x1c\xea\x53\x3e\x81\xd6\x41\xec\x3f\x06\xd0\xeb\xf8\x41\xb2\xc7\x57\x8f\x68\x11\x06\x8f\x25\x92\xbc\x51\xd4\x14\x62\x8f\x64\x5d\x8b\x25\x05\xb7\x27\xc6\xf1\xb2\x60\x62\x5c\xf6\x79\xb1\x8e\x93\x16\x6f\x0c\xbd\xbe\x3a\x4a\x79\x9c\xa3\x9e\xda\xa4\x86\x61\x29\xea\xac\x66\xfb\x2e\x35\x61\x3c\x51\x16\xbf\xf2\x73\x1e\x36\x13\x2b\x5c\x47\x73\x48\x63\xb8\xf9\x54\x1a\xf1\xdc\xa0\xbe\x38\x4e\xd3\x7c\xbc\x72\xe7\x69\x1f\x37\xbb\xe8\x3c\xc3\xc0\x0b\xa3\x6f\x17\x82\x29\x5e\x94\xea\x45\x85\x3b\xcf\xee\x62\x0d\xbf\x5d\xf4\x2e\xf2\xce\x41\xd1\x66\xce\xc4\x02\x8e\xa2\x5d\x7c\xa2\x94\xed\x97\xcb\x8a\x93\xe1\x10\x98\x8e\xa5\xfe\x07\x50\x01\x97\x65\x80\x06\xa6\x80\xce\x7d\x55\x2e\x6a\x2a\xa2\x40\x2d\xf9\xb9\x5c\x52\x36\x26\xa2\x06\x32\x59\xa8\x88\xeb\x32\xc2\xb2\x81\x09\x0c\x6a\xd9\x2f\xe5\xb2\xc2\xa4\xd0\xd3\xa2\x02\x06\x65\x80\x09\xe4\x6a\x8b\x7c\x2d\x97\x56\x4c\x00\xf4\xea\xf2\xbd\x6e\x19\x20\x6a\x13\xc0\x28\x8f\xf9\x53\xd0\xc6\xb7\x02\x03\x80\x39\x55\x06\x63\xfb\x96\x01\xc0\x9c\x6a\xd9\x40\x97\x54\x8d\x01\xc1\x9c\x2b\x48\xd2\x64\xc4\xb6\x54\x0f\xf3\x73\x06\xe2\x31\x18\xe6\xec\x0c\xc6\x61\x36\xdc\xed\x29\x8a\xc7\x78\x65\x94\x28\xc8\x8e\x25\x21\x09\x39\x1b\x90\x20\x3b\x82\x6d\x9b\xaa\x38\xb1\x39\x9b\x90\x6e\x38\x60\x66\x03\xdd\x52\x0d\x9d\xaf\x25\x21\xa1\xa6\xaa\x35\x11\x34\xbe\xf6\x84\xca\x4b\x86\x6e\x83\x99\x4d\x05\x60\x16\x46\x80\xa9\xa0\x4d\x00\x5f\x8b\x42\xc5\x81\x3e\x19\xf1\x99\x74\x0a\x74\xd9\x30\xf9\xcc\x39\x05\x66\xa5\x59\x30\x43\x6e\xad\x48\x6f\xd7\xdd\x4d\x37\xfb\x0f\x93\xe8\x87\xaa\xcb\xc6\x0f\x47\x54\x6d\x36\x10\x13\x6f\xac\xce\x46\xc2\x98\x0f\x88\x79\x70\x9c\x27\x5a\x2e\x24\x26\x2b\xca\xa2\x0d\x24\x95\x0c\xcd\x30\x1d\x55\x97\xc1\x8c\x85\x1e\x20\x70\xb7\x4b\x12\x57\xd2\x0c\x51\x04\xa6\x33\x12\xac\x3f\x6a\xca\x3d\x34\x0d\xdd\x76\x34\x30\xb4\x8b\x4e\xa6\xbe\x12\x19\x87\xa9\x2a\xb7\xf5\x48\xf6\x1a\x8f\xf4\x47\x7d\x39\xb0\x0e\x41\x14\xa4\x3f\xea\x8b\xd1\xeb\x52\x7a\x21\x2e\x74\x1f\x43\x67\x29\xbd\x46\xe5\x57\x5d\x52\x82\xaf\x41\x30\xe8\x12\x52\x30\x0f\x1c\x17\x5c\x32\xf4\xa1\xaa\x38\x92\x30\x05\x42\x25\x0a\x73\xfc\x2c\x4f\x77\x8e\x7d\x37\xa6\xe7\xa4\xee\xa6\x8f\xe5\x03\xdb\x14\x74\x6b\x2c\x98\x40\xb7\x99\xc0\x2b\x1a\x30\x6b\x32\xd5\xc9\xb0\xbb\xe9\x7f\xa6\xe1\x4d\xc0\x4a\xa5\xdd\x4d\xff\x9a\x86\xce\xfa\x46\x26\xfe\x0b\x0d\x9f\x76\x92\x4c\xf8\x80\x06\xcf\x7a\x4b\x16\x7e\x98\xff\x57\x66\x91\x51\x4b\x95\x04\xb3\x0a\x37\xd8\xcb\x32\xba\xa1\x33\x8a\x63\x19\xc5\xd2\x8c\x1f\x79\x3c\x55\xa3\xb0\x50\xb2\xcd\x09\xc8\xb2\x61\x35\x08\x8b\x24\x59\x35\x81\x64\xf3\xc0\xb0\x38\x1a\x5b\x60\x22\x1b\x3c\x30\x2c\x7c\x2c\x5b\xb0\x55\x89\x07\x86\xc5\x8d\x62\x0a\x77\x8e\x25\x09\x1a\xc3\x88\x5f\x08\x75\x21\x6c\x35\x8a\x1a\x23\xa6\x22\x56\x23\xbf\xd2\x90\x69\xd3\xaa\xc6\x8a\x65\x6c\x9e\x03\x54\xb9\x1a\x23\x95\x31\x8c\xb1\x5b\x6a\x10\xb9\x0c\xd0\x0d\x3d\x0d\x2a\xc3\x1c\x09\xba\xcd\x13\x5f\x78\x5e\x97\x4d\xe1\x87\x20\x6a\x80\x95\x71\x06\xdd\x1e\x16\xcd\x26\xd0\x65\x60\x72\xa0\xf6\x12\x63\x86\x43\x35\x56\xc3\xb0\x78\x1e\x8a\x79\x1e\x66\xd8\xb2\x87\x85\x73\x3a\xf4\x60\x0b\xf8\x99\x36\xee\x60\x43\xb1\x78\x1e\x09\xbb\x81\xc7\x0f\x55\xb6\x6f\xab\xb1\x5f\x68\xd8\x5b\x80\x7a\xea\x6a\xf0\x80\x06\x1e\xab\x33\xa0\x55\x8d\x27\x07\xdd\x1e\x16\xa4\x63\x13\x58\xc0\x9c\x82\x7c\xc4\xad\xdb\x0c\x30\x16\xad\x9a\x60\x2a\xc0\xb2\x8b\xda\xab\x91\x58\xd8\x72\x18\x08\x94\xcb\xf3\x18\x65\x58\x06\x80\x29\xea\x46\x19\x03\xbf\xc1\xfe\x30\x45\x18\x09\x0a\xa8\x8e\xb1\x3e\x9e\xd4\x85\x29\xab\x3c\xd6\x00\xb2\xa1\x7b\x35\x00\x0b\x7d\x1e\xd3\x5e\x75\x09\x08\x1e\x93\x5d\xf5\x48\xc0\x6a\xdf\xf4\x0e\x46\xdb\x96\x30\x1a\x6b\x20\x1f\x5d\xd1\xc3\xa7\x77\x30\xc6\xce\x80\x55\x01\xd7\xef\x7e\xc5\xda\x49\x3e\x2b\x74\x4c\xa0\x01\xc1\x02\x8e\x08\x6e\x85\xa9\x6a\x98\x0e\x5a\x5c\xa2\xd2\x70\x31\xa0\xde\xbc\x9a\x66\xd3\xef\x7e\x1d\x70\x71\x0d\xb5\x89\x75\xcb\x20\xcb\x07\xea\x3d\x12\xa1\x0c\xc4\x89\x82\x86\xb9\x7c\x1c\x7d\x12\xc7\xd0\x30\x7f\x08\x26\x6a\xd6\xa3\xb1\x60\xab\x28\xc5\xb3\x09\xfb\xdd\xaf\x44\x81\x46\xc2\xbf\x0c\xb3\x98\xdd\xb2\x39\x88\x02\x8d\x54\xbd\x0e\xc7\x15\x89\x63\xa8\x09\x8a\xc5\xc0\x5e\xf6\xc4\x4b\x12\xd6\x18\x03\x5d\xd1\xd2\xe5\x0b\xd3\x34\x4c\x3e\xd3\x12\xad\x21\x19\x26\x70\xc6\xa6\x31\x54\x35\x70\xa4\x97\x0a\xef\xa8\x9a\x6a\xdf\xd5\xe0\xfc\xda\xeb\x5f\x93\xf8\x0a\x06\x94\xf8\xf8\xc4\x22\xda\xd9\x34\xc4\x89\x65\xa3\x69\x13\xb0\x2c\x0e\x71\x06\xfd\xcf\x98\x7a\x9a\x61\x81\x2d\x99\xa1\x3b\xa8\x93\xe1\xe0\x20\xaa\x94\x61\x75\xc3\x56\x87\xaa\x24\xd8\x28\x00\x2d\xdb\x14\x6c\xa0\xdc\x31\x19\xaf\x31\xff\xe9\x06\x89\xac\x9a\xa3\xdf\x15\xb1\x01\x61\x3a\xa8\x18\x6a\x86\x90\xcd\xd1\x1a\xd8\xb8\xc4\xc0\x36\x6c\xbf\x2b\x62\x86\x1d\x9a\xc2\x28\x5f\x69\x76\x2c\x53\x11\x1d\x49\x18\xa3\xc1\x54\x25\x0d\x2b\x65\xb3\xc1\xa4\xb4\xcd\xa7\x3a\x25\x61\x22\x97\xaa\x96\xa1\x65\x2e\xe0\xb1\xc3\xd7\xbd\x71\x5a\x16\x5b\x82\x86\x66\x55\xb9\x41\xc4\x3b\x1b\x38\x86\x89\xc6\xa5\x23\xd5\x1a\x09\xb6\xc4\x4a\xc2\x83\xee\xe7\x2a\xbb\x5c\xca\xc3\x59\x35\x9a\x68\x18\x06\xac\x37\xc4\x9d\xa1\x8c\x27\xf9\x22\xa3\x23\x8c\xaa\x06\x13\xbd\x21\xee\x08\x04\xcc\xc6\xd3\x68\x0f\xc4\x36\x55\x5d\x61\x33\x60\xe1\x84\x18\xf2\xbc\x58\x24\x66\x2e\x9e\x7e\x4f\x38\xe0\x19\x0a\x96\x8d\x06\x83\x36\x1a\x14\xda\xe8\x27\x8b\xcd\x82\x65\x6a\x24\x8d\x29\x8c\xd8\x28\xac\x3d\x21\x94\xa4\x19\xd2\x1f\x6c\x1c\x16\x42\x08\xa7\x4f\x46\xce\x58\x1d\x03\x0e\x49\xaf\x49\x58\x4b\x1d\xc9\x6c\xe8\x17\x12\xd4\x14\xd9\xc0\x01\x09\x68\x8d\x55\x16\xb2\x3b\xc4\x2c\x94\xae\xff\xe5\x6d\x44\x50\x80\x53\xb5\xae\xde\xdd\x5c\xf6\xba\x5d\x52\x53\x1b\x9b\xaa\x61\xa2\x5e\x2a\xdd\x43\x63\x93\xf4\x2a\x49\x6e\x55\xe5\x96\xcd\xd1\xaf\xe4\x18\x01\x59\x9d\x8c\xd8\x2c\x97\x95\x2c\x28\x81\x54\x53\x90\xb2\x78\x41\x03\xac\x3e\xd6\x6d\x1f\xc3\x54\x83\xa8\xdf\x15\x31\xfb\xa6\x9d\xca\x44\xb7\x54\x45\x07\x72\xde\xbb\xa4\xfd\x13\x97\x3c\x03\x16\x15\x97\x40\x7c\x1d\x55\x35\xcd\xa0\xdb\x15\xca\x34\xd6\xad\x60\xee\x46\x12\x4c\x2c\x79\xfd\x85\x8d\x23\xac\xc1\x30\x40\xfd\xee\x10\xcb\x08\x79\x02\xd7\x85\x11\xb0\xd8\x50\x2c\x11\x65\x9b\xc1\x8e\x6d\x02\x4e\x77\xf5\x08\xe8\x74\x7c\x74\x97\xee\x74\xf0\x91\x74\xe9\x24\xd5\xf8\x7e\x77\x88\x0b\xf0\x43\x18\x3b\xaa\x6e\x03\x73\x2a\x68\x8c\xba\xfb\xdd\x21\x16\x25\x23\xa1\x36\x1e\x6b\xcc\x9a\x60\x83\x54\x00\xcb\xb1\x81\x60\xf2\x69\x8e\x49\x8f\x72\xc1\xc4\x04\x4e\x4f\xe6\x6f\xbe\x7d\x12\x41\x5f\x6e\xd8\xfe\x0b\x02\xb4\x64\x2b\xe8\x0a\x67\xf3\x97\x31\xff\x89\xaa\x2e\x3b\xb6\xb1\xe3\x52\x44\x36\x43\x8f\xc1\x20\xb0\x29\xfa\x24\x8a\x91\x3a\x46\xbb\x8c\x05\x13\x93\xe4\x92\x44\x52\xa0\xb3\xd1\x04\xbb\x49\xc9\x98\x4d\xef\x1c\x55\x9f\x02\xd3\x06\xac\xb6\xdf\xef\xca\x58\x5b\x2c\xaa\x4d\xd7\x53\x39\xbc\x70\x4d\x02\xe7\x23\x20\x26\x18\x1b\x17\xd4\xb5\xda\x80\x54\x73\x2e\x76\xba\x96\xc1\x64\xf8\x5a\xc1\xc0\x15\x40\x42\x35\x01\x47\xfc\x88\x24\x86\x1e\x87\xd3\x24\x12\xb0\xcf\x01\x94\x49\xc0\x5d\xd3\x63\xe2\x01\x65\x93\x98\x09\x1c\xd2\x76\x86\x59\x48\x80\xb7\x74\x34\x96\xe3\xaa\x11\xf4\x28\x7b\xc0\x2c\x20\x49\xc7\x06\x42\x32\x21\x58\xf2\x10\x26\xb3\x2e\x5b\x25\x2c\x55\x08\x93\x59\x8f\x0d\xc1\xf2\x82\x30\x99\xf5\xd9\x10\x2c\x23\x08\x93\xd9\x25\x1b\x82\xe5\x01\x61\x32\xbb\x62\x43\xb0\xd6\x2f\x4c\x66\x9f\xd9\x90\xc1\x1e\xe4\x9a\x0d\xc1\x1a\xb9\x30\x99\x7d\x61\x43\xb0\x66\x2d\x4c\x66\x03\x36\x04\x6b\xc7\xc2\x64\xf6\x95\x05\xc1\x7c\xbf\x1d\xaa\x71\x8c\x58\xab\xb7\xcc\x59\xe0\x2b\x1a\xb8\xb4\x6d\xce\xe2\xf8\x4c\xe3\xd8\x6e\x9d\xb3\x18\xae\x69\x0c\xa5\xed\x73\x16\xc7\x17\x1a\xc7\x6e\x0b\x9d\x45\x31\xa0\x51\x94\xb6\xd1\x19\x1c\xa4\x2d\x71\x36\x84\xb2\xc1\xcd\x06\x52\x37\xb9\xd9\x50\xea\x46\x37\x1b\xfa\x99\xb0\x01\xcd\x09\xa5\x6c\x78\xb3\x81\x5f\x08\x75\xa2\x4d\x6f\x36\x92\xea\x55\x76\x97\xce\xdc\xfc\x66\xe0\xfb\x5d\xc2\xf4\x2b\x3b\x3a\xc3\xae\x19\xeb\xad\xd2\xb3\x12\x39\x01\x13\x59\xb5\x1f\xce\xc5\xd1\xbd\x3a\x9c\x11\x15\x53\x57\x34\xb9\x41\x8b\xf4\x1a\xb0\x41\x3a\x4b\xd1\x98\x9b\x8a\x3d\xfc\xec\x11\x98\x49\xb7\x82\xae\x80\x3d\x9a\x6a\x06\xcc\x1a\x92\x31\xbe\xab\x85\xc6\x1a\xd8\x50\x53\xc7\xb5\xd0\x58\x2b\xdb\xae\x2f\xe6\x13\x5c\x55\x76\x46\xc0\xa2\x1f\x8c\x4e\xeb\xbf\x22\x32\xc8\x60\xaa\x4a\x80\x8f\xe1\x33\x91\xa1\x58\xa3\x64\xe3\xaf\x89\x78\xb4\x9f\xa1\x01\xb4\x77\xc0\x23\xc3\x17\x22\xc7\x54\x95\x81\xe1\x8c\xc0\xc8\x30\xef\x38\x48\x06\x44\x92\x89\xae\x0e\x55\x20\x17\x34\x82\x29\xdd\xaa\x36\x90\xd2\xc1\x28\x9b\xf3\x2b\x91\x73\x6c\x82\x21\x30\x51\x27\x54\x2c\x23\xb1\x99\x04\x22\x53\xbe\x22\x8c\x6d\x73\xf1\x9b\x5e\xac\xe6\x24\xed\x77\xf1\x93\x4b\x55\xe4\xc0\x6a\xc0\x28\x57\x33\xf6\xeb\x52\x62\x2d\x17\xed\x04\x14\xc7\x75\x47\x86\xcc\x74\x09\xd6\x70\x53\xf0\x70\xa2\x69\xf9\x82\x14\x17\x41\x57\xc4\x92\x4f\xb6\x12\x88\x5a\xbf\xa1\x03\xdd\xb6\x1c\x7d\x5a\x89\x16\x04\xd2\xd2\xe4\x68\xa2\xd9\x2a\x5a\x2f\xcf\x8e\x8a\x33\x49\x44\x1e\x12\x4b\x4d\x17\x3b\x58\x5c\x12\x0f\x97\x30\x34\x99\x42\xc9\x3c\x44\xa9\xa6\x92\x60\xd9\x4c\x3a\xc0\x4d\xe7\xc8\xaa\x35\xd6\x84\x3b\x3e\xf2\xc3\xfd\x36\xc9\x98\x02\x13\xad\xdb\x17\xfb\x4b\x0c\xd1\xf6\xb7\xbf\xd1\x48\x85\x13\x3a\xc4\x82\x07\xed\x8e\x64\xe9\xce\xd2\x0c\x76\xec\x0c\xb1\x84\xa9\x00\x3d\xcd\xb4\xf9\x66\x9f\xa1\xe3\x99\x73\x3c\x31\x15\xa6\xf3\x25\xcc\x67\xbb\xfe\x83\x05\xc3\x7c\x33\xd1\xd5\x7f\x4f\xb8\x60\x43\xb2\xf2\x92\x30\x4e\x13\x33\x97\x11\x24\xcc\xf8\x99\xca\xc6\xa4\x18\xa6\xb2\xc0\x57\x64\x70\x36\x20\x67\x81\x3f\x93\xc1\xd9\xd9\x6b\x16\xf8\x9a\x0c\xce\xc4\x16\x74\x99\x53\x86\x2f\x2c\x1a\x3e\x69\x06\x64\x9a\x74\xf7\x9b\x09\xfe\x4a\x01\xab\x40\x93\x9d\x1e\x13\x2e\x54\xc1\xfb\x4c\xb8\x48\x86\x5b\xb6\x20\xfd\x81\xf6\x53\x10\x8d\xe5\xf4\x38\x98\x24\x2e\xa6\x3e\x4b\xa5\x41\xf7\x1a\x6b\xd5\x68\xb9\xdc\x19\x01\xfb\xd6\x90\x1d\x63\x54\x39\x16\xec\xe2\xe7\x27\x52\xe4\x76\x38\xcb\xc4\x62\x9d\x59\x3e\x90\x1e\xdf\xb1\xeb\xc4\x5a\x50\x8a\x9b\xe8\x32\x18\xaa\x68\x67\x8b\x05\xc6\x67\xe7\xa2\x86\xfa\x73\x39\x5b\xcc\xb6\x14\x95\x71\x38\xad\xea\x28\x00\x1b\x8d\x9b\x2a\xcf\xb7\x95\xb0\x54\x60\xac\xe1\xa5\x3d\x44\x5e\xb3\x35\x11\xd3\x85\xc9\xec\x3c\x5e\xb5\x00\x29\xd3\x17\x26\x53\x76\x24\x90\x83\x0a\xcb\x43\xb2\xaa\xa8\xb6\xa0\xa5\xdb\xad\xc2\xf6\x94\x20\x7a\x25\x94\xbd\xd3\x41\x79\x6f\x89\x13\x4d\x79\x79\xa9\x49\xdd\xc5\x7b\x48\x4d\x6a\xde\x7b\x19\x89\x45\x51\x79\xc4\x9a\x03\x4c\x3b\x67\xcd\x01\xc5\xc4\x2e\xc0\x69\xdd\x6c\x2c\xed\xc4\x35\x47\xb5\xe4\x63\xd7\x1c\xc0\xcf\x34\x33\xb3\xf1\xf8\x24\xfb\xf6\x6e\x0c\x4c\x74\x92\x23\x3d\xce\x91\x6e\xc3\x3a\x1a\xd0\x15\xfb\xb6\x9a\x05\x3f\xee\x88\x5e\x0c\xdc\x31\xe5\x16\xa8\xc6\xf7\xe9\x78\xbe\x48\xc3\x04\xd8\x81\x8b\x31\x63\x0d\xa6\x3e\x99\x29\x8f\xa0\xa6\x22\xe5\x1c\x52\xa3\x96\xb3\x03\x6b\xea\x48\xb5\x2d\x4e\x8e\x4b\xb2\x26\xf9\x2e\x35\x1f\xc7\x15\x99\x23\x3d\x20\xef\x08\xc5\x88\xba\x9a\x6a\xd0\xbd\xa4\x44\x99\x2a\x37\x11\xa3\xc8\x9d\x3c\x79\x88\xf0\x5a\x23\xe1\xad\xc6\x26\x1e\x25\xbe\xda\xd8\xc4\xbb\xe4\xf7\x1b\x9b\x18\x86\xf4\x92\x23\x27\xcf\x80\xb2\xcb\x55\x97\xa8\xf2\x75\x47\x3e\x8a\xea\x77\x1e\xf9\x38\x58\x2f\x3e\xf2\xb1\x54\xbf\xfd\xc8\xc5\xb1\x77\x0a\x6c\x6f\x38\xc4\xc1\xc2\x7c\x3f\x86\x83\xe0\x0b\x8d\x60\x37\x92\x61\x30\x0c\x68\x0c\x69\x22\x60\x26\xa4\x41\xb7\x87\x4d\x27\x8c\xb1\xad\x8e\x04\xad\xb6\x22\x42\x15\x09\xb7\x32\xd8\xdc\xe2\xf0\xcd\x1d\x0e\x86\xaa\xd7\x77\x38\xe0\xd8\x7c\x9c\x57\x77\x6c\x36\xce\xad\x2b\xe5\x6d\x1e\x36\x72\xaf\x15\x66\xaf\xf4\x70\xc0\xb0\x1c\x69\x09\x53\x2e\x50\x9f\x30\xbe\x65\xa3\xb0\xfe\x8d\xd3\xfa\x79\xba\xc3\x1b\xe5\x9d\x2e\x65\x27\xed\x9a\xf4\x03\x29\x3a\x9d\x5f\x31\xc0\x83\x2e\xbe\x57\x98\x6f\xaa\x58\x40\x43\x3d\xbb\x62\x1a\x13\x9c\xe2\xe6\x13\xaa\xbc\xb8\x15\x06\xdd\x90\xe7\x06\xde\xc1\xc5\x30\xa5\xea\xf2\x22\xbb\x5f\xd0\xbf\x9b\x55\x14\x26\xe1\xf7\x9b\x15\xba\x62\xe6\x7b\xe9\x3e\xb9\x9b\x4f\xd9\x4f\x1f\xf2\xab\x6a\x1e\x17\x33\xe9\x29\x0c\x63\x98\x15\xc9\xef\xa9\xb9\xf9\x94\xe1\xf7\x38\xd1\xb5\x68\x05\x67\x7e\xad\x5e\xc1\x57\xdc\x9c\xe3\xad\x5e\x76\x1c\x69\x79\x12\xc7\xee\xba\xa2\x38\xbd\x44\x8f\x13\x52\x08\xed\x26\x49\xe4\xdf\x6b\x7e\x9c\xd0\x80\x37\x9f\x0e\xcc\x72\x73\xf0\xcb\xa1\xa1\xca\x17\xa4\xe5\x7a\xed\xcc\x14\x41\x37\x81\xdb\xc7\x67\xb1\x13\xdd\x7f\x05\xcf\xb3\x1f\xf3\xf3\x50\xd5\x8b\x9f\xdc\x08\x56\xd9\xf3\x90\x0b\x5d\xc9\xb8\xc7\xe2\xf9\x11\x9c\xb7\xe6\x12\x74\x33\x59\x4e\xfc\xb8\x98\xc9\x30\x4e\xa2\xf0\xe5\x55\xcc\x4f\x35\xdb\x3c\xd9\xb4\xa5\x2d\xdd\xaa\x8f\x8b\xd9\xc8\xfd\x13\x4a\xeb\x28\x82\xc1\xf9\x35\xdf\xdd\xde\x87\x49\xe5\xe1\x97\xfa\xbd\x11\x2b\xe2\x31\x23\x85\xab\x37\x16\x30\x71\x34\x6f\x81\xc5\xe3\x6a\xa7\xf8\x15\x73\x99\xa9\x97\x6e\xfc\xe7\x69\x6c\x6d\xfd\x74\x57\x62\x7a\x3b\x61\x7c\x76\x5b\x37\x0c\xd1\xba\x0a\xef\x2a\x2c\xae\xc2\x24\x77\x11\xfb\x77\x65\xbe\x89\x4e\x02\xeb\xdb\x59\x54\x44\xfd\x56\x7b\x3a\x1d\x67\x4c\x62\x76\x7f\x25\xcb\x95\xaa\x3d\xa1\xc6\x95\x19\xfe\xdf\x6b\x18\xbd\x80\x4d\x02\x03\x74\xf9\xf2\x99\xd4\x2f\x0f\xa9\x60\x14\x85\xd1\x7d\x5d\xd0\x33\xd6\x23\x9d\xda\x3e\xd3\xec\x6a\xea\x57\xb0\xce\xd2\xfd\xaf\xba\x08\x3f\xa0\x21\x5a\x35\x8c\x1a\xcb\x7b\xa3\xae\x93\x1a\x85\xd5\x35\xb5\xd8\x97\xef\xe6\x08\x8f\x8b\x99\x02\x13\xec\xfa\xdd\x77\x94\x53\x77\x7a\x64\xd3\x96\x9a\x81\x94\x4e\x24\xdb\xb2\x29\xcb\x7d\xb9\xa5\xb3\xa1\xe6\xb6\x08\xd9\xe2\xcd\xeb\xa6\xf4\xd7\x58\xe5\xbb\x32\xed\xd4\x8e\xf7\x37\x3f\x5c\x3f\x51\xb4\xd3\x71\xcf\x4e\x43\x3d\x89\xe1\x0c\xdd\x57\x4f\x63\x2f\x85\x50\x6e\xed\xac\x34\x66\xe6\x07\x8c\xa0\x32\x06\x73\x80\x1f\xc5\xf5\x10\xf3\x70\x5d\xb3\x8e\x45\x8b\x73\xf9\x79\x18\xc4\x49\x67\xfe\xe4\x46\x9d\x0f\x7b\x3d\xc8\xb6\x87\x8d\xad\x24\xf2\x83\x73\x65\x13\xde\xe5\x8e\x16\x35\xcd\xae\x99\x7f\x93\x5a\x12\x21\xe8\x7f\x4e\x6b\x16\xd4\x85\x2c\x7c\x18\x24\xaf\x64\x94\x36\x35\xa4\x49\x46\xcc\xa4\x45\x21\xb2\xbe\x0d\xab\x2e\xdd\x90\x4d\xa9\xbe\x78\x1c\x9f\xdd\xd2\xb5\xc2\xaf\x10\x3a\x80\x0b\x88\x3e\xa5\x12\xd3\x70\xa7\x31\x54\xb6\xf8\xba\x2b\xf1\x56\x4d\x95\xb5\xa9\xb2\xc1\xb2\xd1\x8c\x53\x95\xb9\xf7\xaa\x3c\x99\xa9\x77\xfa\xe0\xa1\x27\xec\x0d\xb8\x4e\x6a\xd4\x0a\x7f\x17\xbd\x22\xee\x62\x0e\xf7\x64\x36\x5e\x27\x90\x13\x75\xca\x41\x23\x71\x2c\x9c\xd7\x97\x99\x3d\x2b\x30\x8c\xc2\xe5\xce\x02\xef\xc1\xf4\x0d\x0d\x52\xfa\x92\x07\x79\x21\x08\xfb\xce\xc7\xfb\x09\xbf\x9c\x89\xa8\xdb\x4f\x3f\xe0\x60\x68\x94\x2c\x8e\x1b\x1a\xe7\x2b\x48\xaf\x64\xf2\xba\xb6\x6a\x1e\x71\xd5\x4b\x8f\xc5\xd3\x33\xab\xdf\x52\xc4\x35\x58\x84\x7b\x13\x41\xf7\x4a\x56\x6f\x60\xae\x23\xe2\xae\xf8\xf4\x10\x25\xf0\xb0\x2f\x13\xbd\x9f\xc8\x7b\xc5\xa0\x79\x25\x8b\x6d\xbf\x21\x85\x19\x6c\x75\xbf\x7e\x38\x8d\xba\xe9\x6c\x94\xb5\x9a\x73\x32\x6d\x77\x15\x63\xea\xa2\xdd\xcb\x93\x0d\xc4\xb6\x1b\x6f\xa7\x1e\x91\x55\x2d\xe3\x65\xcd\x52\x87\x3f\x19\xab\x78\x87\x56\x7b\x23\x2d\x73\x67\xfb\x08\x06\x1e\x8c\x1c\xc4\x52\x4b\x02\xb2\x6d\xd2\xa3\x0e\x95\xcd\xfb\x90\xec\xe4\x67\x1d\xd8\xbb\xff\x99\x2e\xf9\x94\xfe\xec\xce\x3c\xae\x19\x31\x79\x22\xe8\x7a\x2d\xb8\xb6\xc5\xcd\x07\xa6\xc8\xd8\x12\x8b\x09\x5d\x6f\x57\x8e\xec\x9b\xba\x12\xec\xe2\xbf\x48\xa2\x5b\xa5\xc9\x15\x9c\xcc\xf9\xb5\x8d\x4d\x6c\xc5\x6f\x62\x2a\x8b\x77\x4d\x16\x5c\xc0\x79\x02\xf0\x4d\xcc\x33\x19\xf5\xb8\x16\x45\x3a\xdb\x01\x91\x1e\xce\xe9\x4e\x78\x28\x30\xc9\x0c\x06\xbd\xf7\x6f\xb2\x0f\x27\x33\x5a\x2e\xef\xc1\xf7\x36\x31\x99\xb3\xec\x31\x8e\xc2\xb9\xe0\x79\x11\x8c\x63\x0e\x63\x66\x43\xd5\xad\x39\xd2\x0f\xe0\xee\x9b\x74\x15\x85\x73\xbd\xfd\x75\x66\xf6\x40\x23\x7f\x9c\x2d\xb8\xc5\x82\x29\x72\x28\xd4\x6e\x74\x1c\x3b\xde\x60\xa9\x9a\x8d\x1b\xf2\x6f\xea\x9e\x70\xe8\x70\xee\xc9\x49\xb3\x78\xe5\xf3\xf0\x6b\x84\xec\xb6\x9d\x63\x1d\xa8\x02\x13\x65\x3c\x51\xe5\x58\x18\xc9\x1c\x82\x13\x48\x96\xee\x46\xe2\xdc\xd6\xc4\xd0\x85\xeb\x7c\xef\x64\xcb\xdd\x48\xb3\xe0\x21\x6c\xac\x9a\xcf\x33\xf8\xda\x15\x5f\x45\xe1\x0a\x46\x49\xad\xe6\x89\xce\xb4\xef\xc5\x94\xe7\x26\xae\xcd\x37\xa6\x27\xc8\x8c\xbe\x10\xce\x81\x4c\xbb\xb0\xc2\x05\xa8\xc6\xb6\x7c\x40\x10\x69\x7b\x4c\x05\x25\x4a\xe4\x13\x99\xcf\x23\xac\xcc\x73\x92\x61\x2d\x23\x9f\x0b\x71\x1c\xce\x7d\x37\x81\x5e\x5e\xf0\xb4\xc1\x75\xfc\xa4\xed\x8c\x96\xc8\xfb\xb8\x37\x60\x10\xfe\xde\x88\xd6\x95\x9c\xe0\x1d\x0a\xf2\x24\x56\x86\x0b\xd8\x38\xac\xce\xdf\x42\xc8\x4a\xa0\x73\xf8\x07\x2a\xe4\x73\xc0\xb7\xaa\x09\xb5\xaa\x3c\x5d\xe5\xd2\xd7\x70\xcc\x71\xd3\x08\x71\xe1\x17\x39\x72\x18\xb9\x4b\x98\x2d\x47\xb6\x63\x3d\x2f\x4e\x24\xba\x01\x49\x44\xfe\xc1\xf1\xa8\x38\x9a\xcf\xba\x47\x53\xdc\x1d\x4f\x31\xeb\x1d\x2f\xc5\x91\x14\x5e\x9c\x1c\x6b\x0b\x2f\x4e\x8e\xb5\x05\x92\xe2\x78\x45\xea\xd9\xe2\xde\x4f\x1e\x7c\xb8\xf0\xf6\x78\xaa\x26\x8a\x9c\xc3\x9e\x07\x7f\x91\xc0\x88\x46\xd2\xb0\x91\x1f\xcc\xa1\x48\x6d\x3c\x2f\x04\x66\x36\xad\xa9\xd5\xad\x9d\xba\x46\x85\x0e\xcc\x56\xd4\x73\x68\xaa\x03\x05\x9a\x4d\x02\xc9\x69\x81\xbf\x9b\x7c\x63\xcb\x55\x07\x8a\xa9\x32\x39\x89\x17\x4f\xf9\x6c\xbe\x37\x2d\x6b\x66\xb5\xa3\x75\x39\xd4\x44\x5d\xae\xc2\xa8\x50\xe6\x15\xc3\x47\x95\xc9\xa6\x50\x65\x1a\xdb\x71\x5d\xe2\x30\x82\xf0\xf5\xb5\x26\xeb\x7c\x1a\x8d\xd1\xdb\x62\x6a\x90\xc0\xe8\xd9\x5d\xbc\x86\xca\x15\x4b\x89\xf8\x1a\x3e\x47\xa6\xf0\x73\x3d\x4e\x63\x29\xd1\x0f\x3c\x1b\x6e\xd4\xa5\xfb\x08\xdf\xbb\xa5\xf6\x77\xbc\x6b\xcf\x56\x5a\x9d\x01\xe2\x76\x36\xe1\x02\xba\x31\xfc\x3d\x4c\x5d\xd7\x54\x84\x59\x6c\x36\x7b\x10\x94\xb1\xf1\xf0\x10\xc3\x04\x5d\xcd\xca\x61\xb0\xac\xe7\xc1\x16\x65\x56\x61\xda\x80\x4e\xe3\x55\xf4\x06\xb0\xb5\xbe\x17\xd3\xc0\xe3\x94\xf1\xed\x3a\x75\x53\xab\xf4\x4b\xad\xd2\x3f\x7d\x2f\x79\xaa\x85\x78\x82\xfe\xe3\x53\x6b\xad\x91\x71\xac\x69\xbb\x1c\xb3\x2d\x70\x7e\x6f\xb6\xf1\x32\x58\x4e\xd5\xe0\xe8\xd6\x21\x89\x14\x2e\xc2\xe8\x90\x66\xde\xfe\xf9\x2f\xf2\x6a\x48\x7a\xb2\x27\x9f\x5b\x98\xe9\x19\x0d\x18\xa1\x4e\xfd\x91\xbb\xa9\x35\x1b\x6e\x63\xd9\xe8\x24\xe3\xee\x8a\xf7\x6a\xf6\xf4\xcd\xde\x25\x69\x5f\xdd\xf6\x1d\xd5\xcc\x43\x6d\x34\x9f\x06\x6f\x1b\xec\x20\x51\x2e\x76\x2d\xd0\xbb\x89\xa6\x46\x61\xf4\x8b\xfa\xa4\xd5\x90\xcf\x87\x73\xf9\x8d\x14\x67\x34\xec\xd1\x3d\x7f\x43\x33\x28\xda\x7d\x18\x2e\xa0\x1b\xec\x55\x97\x1e\x73\x49\x2e\xe5\x87\xcd\x28\xf4\x60\x3d\x43\x90\x56\xd2\x96\xa1\xd7\x9a\xe8\xbb\xe0\xc8\x8f\x97\x94\x66\x85\x55\x92\x1e\x55\x0f\x6f\x25\xb4\x14\x71\x8e\x49\x1f\x1a\xb6\x66\xa1\x6b\xad\xef\x65\x37\x71\xf5\x29\xbf\xd7\x4e\xba\x2a\x80\x4e\xea\xd5\x5c\x62\xa7\x30\xfd\x8c\xfc\x04\xd6\xa4\x22\x2c\xa7\x22\x81\x6c\x37\x7a\x84\xc9\x91\x44\xa9\x3c\xf5\x99\xfc\x20\x59\x1d\x1c\x97\x40\x42\x65\xf3\xa2\xa3\xa9\x52\xb1\xea\x73\xa1\xed\x71\xff\x90\xad\x6a\xd7\xfc\xb8\x90\x45\x47\x38\xbc\xbf\xe2\x96\x1c\xb7\xeb\xc3\x3c\x8a\x04\x12\x79\xd7\x43\xaa\x88\x52\x79\xea\x33\xfd\x15\xb7\x59\xdc\xa2\x90\x4d\x17\xd7\xde\x5a\xc4\xc6\xd1\xfc\xf8\x28\x8b\xa3\xca\xb3\x55\x9c\xa9\x31\x8e\xe6\x8d\x12\xe3\x21\x8d\x06\x9f\xe1\xe2\x68\x96\xd9\xd1\x0c\x77\x47\x33\xfc\xa7\x05\x17\xd7\xdf\xae\x26\xb8\xd8\x8b\x93\x16\x5c\xec\xc5\x49\x1b\x2e\xf6\xe2\xa4\x05\x17\xa3\x0d\xdf\xa3\x19\x8e\x74\xb1\x17\x27\xb5\x5c\x8c\x32\x94\xbf\xc7\xc1\xbb\xa6\x56\x49\x52\xbd\xcc\xc6\xcb\xe2\xc1\x55\xf2\xd4\x56\x06\xad\x9c\x79\xc9\x70\xe1\xbe\x88\xf0\x21\x8c\x20\x1a\x6a\xeb\xd3\xf7\x32\xf1\x22\x71\x3d\x2c\x42\x77\x3f\x32\x62\x38\x0f\x83\xf6\x0e\x73\x12\xd7\x1f\x1e\x17\x33\x10\xac\x97\x30\x72\x13\x38\xf5\x3d\x18\xca\xf0\xd9\x9f\xc3\xf8\x6c\xd6\x6c\xb8\x3e\x70\x9e\x1b\x1e\xd0\x9e\x58\xc9\x2a\x67\x33\x0a\x61\x1e\xf8\x8c\xc4\x70\xe2\x45\x98\x1c\x83\xf7\x52\x3d\xde\xca\xc6\x58\x65\xf3\xfe\x57\xe8\x07\xa8\x5d\x2b\x51\xb8\x7e\xe7\x8d\x9b\xd0\x99\x3e\x22\xad\xce\x62\x47\x14\xc3\xc8\x8e\xa2\x1b\x45\x3e\x8c\xce\x6f\xc9\xba\xda\x73\x72\xdc\x67\xfa\x9c\xc5\x86\xe9\x12\xea\xaf\x18\x8c\x1f\xda\xf0\xc7\x87\x57\x72\xc8\xc8\xdd\x6c\x7d\xf2\xa6\x3b\xac\x4a\xb3\x2d\xdd\x4d\xa6\x41\x0b\x44\x79\x1b\x6f\xad\x33\x64\xfb\x20\x3d\x59\x9b\xbe\xae\xf2\x6e\x1d\x50\x79\x87\x60\xab\x16\x33\x61\x0c\x93\x37\x6c\xb1\xba\xca\x92\xc7\x4a\x92\xbb\x4a\xd6\x11\x7c\x2b\x43\xa6\x79\x26\x0e\xef\xd0\x29\x97\x45\xd1\x66\x64\x65\x72\xd9\x72\xd1\x2a\x87\x53\x0d\x63\x87\x55\x35\x75\xe8\x8e\x01\x7e\xdf\x11\x3c\xbe\xec\xa6\x85\xf3\x3f\xc9\xe6\x3c\x8b\x71\x38\x7d\x7a\x8a\x70\xda\xf9\xa5\x48\xd6\xbf\x86\x1d\x88\xa1\x57\x67\x23\xfe\x64\xfb\xef\xc4\x83\x7e\xbf\x79\xf0\xa1\xe0\x53\x60\xf2\x1a\xb3\xe8\x86\x89\x29\x4f\x4b\xeb\x65\x49\xe6\x98\x03\xbb\x67\xed\x03\x33\xe7\xb2\xaf\x4a\x4f\x4f\x62\xed\x72\xdc\xbd\x7d\x8b\xb3\xac\x96\xa9\x55\x7a\x76\x12\x9b\x6d\x07\x2f\xe9\x0e\xcd\xd9\xec\xd5\x9a\xf2\x2d\x5d\xc0\x45\x74\xa5\x9f\xd6\x5f\xbd\xf1\xd7\x5a\xc0\xbe\x96\xfd\x4f\x7f\x59\x19\xa6\xb2\x05\x03\x2f\xaf\xd2\x0e\x53\xc5\xdf\x85\xce\x25\x9a\x9d\x3a\xbe\x98\xe9\x51\xf3\x0d\x7b\xec\x76\x94\xd5\x7a\x91\x4e\x22\x61\xb4\x15\x8a\x5f\x11\xf2\x79\xa4\x7b\x71\x11\xce\xff\x3c\x89\xf7\x8a\xfe\x0c\xbd\x7c\x76\x36\xbf\xbd\x66\x6e\xad\xe1\x3f\x63\x9d\xac\xd6\x09\xbf\x17\xf9\xf8\xd2\x26\xd2\x96\x2f\x2b\xe7\xe8\xe8\xf2\xa1\x97\x60\x3e\x45\xe7\x32\x63\x63\x44\xfd\x74\xc3\xa1\x99\xdf\xda\x52\x9f\x1f\x24\xd7\x57\xce\xc1\x72\xc7\x3a\x4e\x8e\xe6\x58\xc6\xf3\xa3\x39\xe2\xfb\xf9\xb9\x1c\x3a\x8a\xe7\xa6\x9b\xc0\xf7\xee\xcd\xcb\xfe\xa1\x15\xf3\x05\x80\x30\x3a\x9a\xc9\x83\x41\xb8\xf4\x83\x2a\xae\x86\x7e\xd9\x0f\x00\xd2\xa7\xdc\x46\xf1\xfc\x97\x6a\x6c\x99\x38\x49\x7a\xd2\xc1\x39\xaa\xb9\xe4\x12\xf9\xcf\x7e\x1c\x46\xc7\xd2\x44\x70\xe9\xfa\xe8\x24\x78\xcb\x2e\x26\x37\x3d\xf4\x81\x9a\x61\x18\xfd\xe5\xdb\xb7\xe1\x5b\x36\xd1\x6f\xda\x45\xe4\x71\x6a\xdd\xff\xc2\x71\x5a\x61\x4b\x6e\x7f\xfc\x6e\x71\xb1\xbd\x81\x47\x1e\xe5\x03\x5a\xf4\xfd\xec\xb3\x07\x08\x56\x37\x26\xe3\xaa\xee\x64\x49\x1e\xa5\x7f\xc6\xfb\x22\x15\xbf\xf2\xf3\x14\x35\x63\xf2\x78\xcb\x6a\x81\x8e\x9d\x75\x15\x57\x2f\x66\xb7\x3f\xbe\x8a\x33\x0a\x11\x08\xde\x68\x70\xe3\xf4\x9b\x58\xb6\x66\x28\x47\xfd\x86\xd0\x19\xed\xdf\x60\xf2\x5b\xf3\xec\xd2\xb9\x76\xa7\x6a\xbf\x7e\xfc\xc3\x4f\x9e\xce\x6e\x6f\x46\x48\xd4\x8c\xf7\xe6\xef\x22\x37\xb7\x2e\xf9\x20\xf4\xd6\xbc\xf9\xe3\x5f\xc0\xb8\xbf\xe3\xf5\xf5\x4d\xbe\x10\xf5\xbe\x1c\xdc\x3c\xf0\x2b\xaa\xc4\x3b\x51\x64\x9c\xcc\x4c\xe7\x37\x4d\xf5\x0d\x07\xed\x1b\xe5\xf6\x65\x05\xa3\x95\xbf\x82\x3a\x4c\x7e\x86\xd1\x9f\x98\x71\x4a\x91\x93\x1e\xf4\x23\x17\x3e\x8b\x79\xb0\xae\x07\xc9\xdb\x9a\x2d\x76\x69\xe2\x71\xb1\x33\x47\x39\x54\xce\xa6\x60\xd1\xb3\xa6\x9e\x50\xbd\x7a\xa8\x4a\xa3\x1c\x0a\xab\x68\x64\x65\x71\xd9\xe7\x0f\x8f\x35\x9d\xf3\xb4\x52\xbd\xb6\x5c\xc3\x29\x2a\x2d\x4a\xcb\x65\xcf\xec\xc3\x2a\x2b\x50\x0c\x77\xc2\xa8\xce\xbf\x77\xf4\xd6\x0d\x73\x94\x8e\x68\xa3\x7a\xab\xe0\xaf\xa5\x1a\x1e\xd5\x22\x8c\xf3\x0b\x90\x5f\x45\xcb\xc4\x5f\x42\x6b\xc1\xb7\x25\xd6\xf0\xbb\xeb\xec\x17\x4e\x69\xd7\x8b\xb3\x6f\x54\xa6\x21\x23\x98\xac\xa3\x40\x68\xfd\x46\x66\x72\xef\xf2\x97\xff\x9a\xfa\xaf\xc5\x86\xf4\x97\x13\xc8\x4e\x38\x55\x53\xe0\x59\xb7\xc3\x66\xf9\xe5\x52\x67\x71\x10\xd7\x5c\x81\x7b\xfe\xb9\xdd\xc0\xaf\x7f\xc5\x1b\x01\xca\xfd\x12\x6a\xcd\xe5\x9d\xe3\x8e\x72\xe6\x23\x8b\x57\xf6\x16\x3d\x9e\xda\x3c\xa7\x84\x6b\x9e\x76\xca\xef\x52\xef\x12\x55\xb3\xf5\x56\x2c\x3a\x4f\xb2\xf0\x4a\xfd\x48\xd8\xab\x98\xf9\xf8\x7d\x28\xd2\xc7\xc2\xda\xfc\xe2\x55\xf5\x67\xc2\x7e\x09\xa3\x7d\x38\xb9\xd9\xf6\xde\x07\x7d\xa7\x56\xdb\x35\xe9\xd7\x7a\xed\xb0\x78\xd7\xee\x8c\x06\x3c\x72\x17\x64\xe9\x6e\xda\xb2\x12\x1e\x52\xd8\xab\xda\xef\x34\xa0\x98\x5c\x4b\xb8\xbc\x3f\xd1\xce\x26\x6a\x92\xd2\x93\x1b\x04\x70\x61\x87\xd9\x17\xde\xdb\xb1\x62\xf1\x33\x53\xfb\x86\x91\x95\x0f\x15\x33\xd1\xf9\x8d\x4c\xfb\x88\x3d\xf6\x41\xfd\x16\xed\x9b\xdb\xd6\x84\xf3\xe4\xd7\xb4\xeb\x0e\xb3\xa9\x55\xba\x9e\x0a\x3f\x6b\x95\x6e\xed\x12\x98\x1d\x65\x91\x81\x7f\x0b\x7f\x16\x59\xdb\xdb\xd4\x05\xbc\xd4\x05\xfc\xac\x0b\x38\xbd\x6f\x65\xb8\x48\xdc\xf8\xd7\xf7\x6e\x5d\xe7\xd6\xf5\x6d\x5d\xd7\x9e\xc6\xb3\xe5\x06\xfb\x12\xcc\x7f\x4d\xb7\x56\xdd\x7a\x16\xbf\x04\xf3\xaa\x7d\xf9\xba\xd6\xdd\xd6\x95\xbd\xd9\x61\x85\xeb\x68\x0e\x19\x0b\x4d\x07\x25\xdf\xb2\x03\xf2\xaa\xa7\x9a\x05\xa3\xe7\x83\x73\x66\x71\xfe\x23\x3f\xcd\xd8\x4d\x9e\xf6\x48\x56\x6e\xf2\x54\x4b\xf8\x20\xf4\xa0\xb4\x70\xe3\x98\x03\xb5\xad\x58\x4f\xaf\x31\xc6\x2a\xf6\x22\xd7\x0f\xf4\x16\xaf\x37\xa6\x2d\x56\xb5\xee\xf1\x9a\xa3\xf2\x83\xfa\x31\x33\x3c\x2e\x36\xe9\x0d\x0d\x71\x5a\xe0\x34\xb6\x90\xd6\xf1\x93\x1f\x06\x96\xa2\x9e\x57\xf9\x06\x23\xdc\x12\x49\x7a\x19\x5c\x0e\x9a\x67\x1a\xb4\x65\x9e\xca\xe9\x2d\xfa\xea\xe4\xf6\xb3\x03\xae\x77\x76\xab\x55\x4f\xe4\xda\xe0\x41\x97\xe1\xb6\x70\x36\xea\x24\x1f\xcf\xa4\x88\x8c\x7d\x59\xcf\x84\xae\x57\x94\xab\x70\x4f\x5d\x21\xa8\xf7\x96\xf3\x85\xc0\xe9\x6e\x2b\x27\xbe\x76\x99\x0f\x21\x38\x04\xdb\xae\xad\x95\x87\x38\xad\xde\xf7\x83\x09\x88\xde\x23\xa8\x29\xe1\x0e\xcf\xff\x16\xc8\x0e\x53\xe7\x95\x8f\xd3\xdb\x22\x0f\x65\x2b\x71\x93\xf5\xee\x84\x79\xd9\x7d\x76\xe4\x06\xf1\xca\x45\xdf\x88\x54\x03\x0f\x6e\xac\x89\xce\x61\xa3\x16\x33\x0c\x31\x2f\x87\xcf\x30\x5a\xb8\xc7\xb2\xac\x03\x8f\x97\x86\xbc\xe4\xbb\xda\xb7\x0e\xa7\x5f\xb6\x7f\xc6\xf9\xdf\x0f\xd0\x45\x97\x8d\x74\xdc\x95\xff\xed\xe2\x71\xb1\xb9\xe8\x20\x9e\x6f\x17\x8a\x36\x73\xa6\xc0\xb4\x54\x43\x77\x7a\x4e\xf7\xa2\x13\xac\xd1\x92\xd6\xb7\x8b\xde\xc7\xee\x45\x89\x3e\x82\xff\xbd\xf6\x23\xb8\xfb\x05\xfd\xbb\x41\x77\xf9\x96\x88\x26\x16\x70\x14\xed\xe2\x53\x75\x29\x71\x32\x1c\x02\xd3\xb1\xd4\xff\x00\x56\x51\x0d\x4c\x01\x93\xcf\x54\x44\x81\x55\x46\x36\x26\xa2\x06\xb2\x9a\x59\x65\x2d\x1b\x98\xc0\x60\x95\x12\x26\x85\x26\x16\xab\xa8\x09\x64\x2e\x6d\x15\x13\x00\x9d\xab\xa4\xa8\x4d\x00\x57\x41\x41\x1b\xdf\x0a\x5c\x25\x65\x30\xb6\x6f\xb9\x4a\x5a\x36\xd0\x25\x55\xe3\x2a\x2b\x48\xd2\x64\xc4\x6d\x80\xac\x34\xbf\x19\xb2\xf2\xfc\xc6\x48\x8b\xf3\x9b\x44\x14\x64\xc7\x92\x90\x34\x3c\x25\x05\xdb\x36\x55\x71\x62\x33\x69\x75\xc3\x01\x33\x1b\xe8\xa8\xcd\xf1\x10\x4f\x55\x6b\x22\xb0\x5b\x95\x20\x3b\x92\xa1\xdb\x60\x66\x73\x91\x0a\xda\x04\x5c\x7c\xfa\xfe\xb7\x4e\xa7\xd3\xf9\x5f\xde\xce\x6e\xb9\x71\x15\x79\xe0\xf7\xe7\x29\xfe\x35\x2f\x30\xff\x64\xce\x7e\x55\x9d\x3a\x55\x58\xc2\x0a\x3b\xfa\x5a\x90\x9d\xe4\x8a\x72\x6c\x92\xf1\x8e\x6d\x79\x25\x7b\x26\xd9\xa7\xdf\xe2\x43\xb6\x6c\xcb\x56\x23\xa1\xa9\x9a\x8b\x89\xab\x7f\x40\x03\x02\x1a\x9a\xe6\x96\x20\x8e\x27\xd1\x45\x82\x66\x70\x31\xa2\x87\x4b\x20\xda\x81\x17\x20\x5d\x77\xb7\x6f\x17\x37\xc6\x0b\x58\xbe\xb6\x64\x6d\x17\x96\x21\xf8\xc1\x29\xd7\x2e\x27\x03\x52\x3e\x78\x73\xa8\x3b\x0e\x60\x3d\x2d\x08\xb5\x3b\x86\xdf\x77\x62\x53\x2e\xf3\x0d\x50\x7e\x2a\x0a\x98\x34\x29\x7d\xe5\xa8\xdf\x2e\xa9\x5f\x01\x7e\x5d\xbe\xc1\x44\xb5\x39\x01\xae\xf5\x23\x52\x2d\x73\xdb\x19\xb9\xec\x0a\x42\x98\xdc\x53\xbb\xd8\xa4\x14\x4f\xe3\xfc\xb4\x3f\xfd\xf1\xf9\x64\x52\xfc\xe3\xb3\x99\x63\xad\x66\xdc\xbb\xfa\x8c\x7b\x67\x3d\xe3\x4e\x71\xec\x27\xad\x33\x9a\xc9\xae\x4d\xec\x30\x2a\xb1\xf6\x0a\x39\xed\x79\xa5\x7e\x42\x0c\x88\xe9\xed\x1b\x28\x22\x5b\x7f\xb5\x94\x0e\x10\x17\x80\x93\x16\xb8\xaf\xb7\xc0\x7d\x7b\x0b\xdc\xea\x9e\x7a\x35\xea\xbc\x88\x5f\xea\x45\xfc\x62\xdd\x49\x1e\x49\xec\x27\x8f\x7c\x44\x5a\x67\x86\x94\x3c\x45\x28\x05\x49\x9a\x55\x1c\x40\x54\xae\xcf\x20\x49\x7a\x49\x98\x50\x4e\x62\x1f\x3f\xd9\x94\xc0\x0b\x93\xd1\x08\x53\x1e\x21\xf6\xb5\x8d\x19\xd3\x24\xce\x78\x88\xc7\x59\xb5\x0a\x05\x64\xa4\x21\x4a\x82\x07\x1b\x6a\x84\xbc\xaf\xb6\x39\x29\xc6\x36\xa3\xda\x42\x14\x22\xae\xd7\x79\xf0\xe4\xab\xd5\x9e\x45\x81\xd4\x32\x0b\x2e\xef\x25\xf1\x98\x04\xdc\x43\x53\x8c\x5a\x85\x9f\xcc\x72\x88\x67\xcf\x69\xeb\x4a\x2b\xa3\x28\x66\x29\xa2\x38\xce\xac\xe5\x75\x3f\x04\x2d\x93\xea\x98\x5c\xea\x5a\x43\x7a\xc5\x6b\x8d\xa9\x85\xaf\x35\xa5\xd7\xbf\x20\xcc\x97\x1d\xdf\x43\xb4\x55\x30\x4e\xe2\x56\x19\x16\x26\x8f\xa6\xad\xdb\x44\x33\x3a\xc1\x7a\x38\x68\x93\xf4\x09\xc5\x5e\x06\x93\x4d\x19\x9e\xf8\x09\x4c\x96\x65\x28\x23\x1e\x4c\x36\xa0\xe8\x99\x33\x0f\x85\xed\x55\xa0\x53\x95\x80\x4d\x8b\xd1\x60\x64\x23\xae\x7a\x6e\x1b\x60\xbe\x22\xe2\xb7\x09\xc2\x0c\xa0\x38\x89\x55\xdb\x26\x34\x42\x71\x06\x6c\x66\x9f\xa2\x47\x34\x0a\x31\xe8\xdb\xa4\x38\xf6\x31\x05\x89\x56\xc2\x32\xed\x36\xd9\xf1\xc8\x0c\x3f\xed\x35\xa1\xe6\x31\x48\xfe\xf5\x89\x0c\x22\x1f\xa1\xe3\x6c\xf6\x48\xfc\xec\xc1\x06\x78\xc0\x72\xbe\xb0\x21\x52\xf2\x84\xc3\xd6\x4d\x8b\x94\x62\x86\xe9\x14\x1b\xab\x32\xce\x5a\x89\x10\xd1\x00\xb3\xac\xca\xa7\x4d\x1c\xa4\x29\x4c\x3b\x3c\x95\xfd\x1e\xb2\x00\xf0\x51\x84\x02\xdc\xde\xe9\xd1\xb4\x5d\x48\x2f\xab\xda\xa4\x80\xb5\x61\xd7\x9c\xb7\x7b\x4b\xd3\x0a\xb5\xf2\xea\x2f\xdb\xa5\x4f\x03\x3a\x58\xa5\xae\x6f\x4e\x80\x90\xcb\xcb\xd8\xed\x98\x3e\xb6\xd6\xfb\xb5\x60\x73\x1a\x2a\xae\x13\xb7\xb4\xd5\xa1\xe2\x26\x71\xed\xb4\x0e\x4f\x1d\x2a\xaf\xcc\x2a\xb8\x71\xac\x4b\x13\x8b\x9f\x60\x13\x5c\x6d\xa9\x68\x61\x63\xeb\x7c\xfa\x0c\xb7\x8b\xea\x27\x54\xed\x9c\x52\x06\x5c\xb2\x9a\x87\x7c\xbb\xf0\xb9\x7b\xb8\x73\x4b\xed\xf7\xba\xa5\xf6\xbb\xb5\xa5\xc6\x50\x94\x86\xd8\xac\xb5\x5b\x07\x5c\x2d\xcd\x40\x6a\xa7\x45\x3e\x47\x8b\x45\x21\xca\xd2\x42\x69\x71\x30\xf0\x6b\xc8\xe1\xc7\x5a\x51\x10\x1d\xf1\xb9\x6e\x32\xfe\xba\xda\x97\xdf\xd4\x5f\x45\xbe\xfa\xf4\x7f\xe5\x7e\xbb\xcd\x8b\x9d\x58\xc8\xa2\xbc\xd7\xaa\xe4\x7a\xb5\x34\x29\x6b\xf6\x36\x39\xc5\x21\x46\x0c\xf3\x11\x7e\x40\x53\x92\x50\x8e\xe8\xc5\xea\xc8\x8a\x97\x6b\xd7\xde\x89\x8c\xc3\x09\x7b\x68\x4a\xe5\xac\x82\xe5\xbf\x3f\x3e\x1f\x6a\x10\x52\xab\xea\x5b\xad\x2a\xd7\x79\x75\xfa\x78\x34\x09\xa4\x95\x66\x5b\x05\xe3\x84\x3e\x22\x2a\x57\x07\x51\x8a\x32\x22\x97\x71\x1d\x52\x89\xd0\x3f\x13\x7a\xf8\x7e\x6c\x61\x12\x77\x87\xc7\x21\x0a\xd8\x55\xe8\xe2\x13\x3a\x89\x10\xa3\xa7\xb9\x72\xf8\xe6\xe6\x9b\x9c\x8b\xa2\xc8\x0b\xe7\xed\x9e\xa4\x38\x0e\x42\x75\x16\x41\x69\x42\x7f\x41\xd7\xe5\xdb\x22\x7f\x5d\xae\x84\x73\x55\xbc\x84\x62\x9e\xd2\x64\x4c\xba\xf5\xc1\xaa\x07\x93\x90\x64\xcf\x7d\x12\xaa\x50\xb9\x10\xfd\x15\x15\x5a\xe4\x2f\xfb\x72\xb7\x91\x43\xba\xeb\x3a\xa5\xc9\x68\xc2\x32\x79\x62\x86\x19\xb3\xa9\x8b\x30\x61\xf8\x90\x4a\x12\x73\x69\x45\x58\x57\xa4\x86\xe2\x24\x23\x63\xe2\xa1\x4c\x9e\x4d\xb3\x8c\xa2\x0c\x07\xcf\xd0\xa4\xe2\xa4\x29\x95\x21\x1a\xe5\xf5\x45\x5f\x8b\xe5\xca\x43\xcb\x59\x4b\x28\x6b\x73\x1c\x26\x48\x6f\x5d\x41\xf5\xae\x61\x57\x5a\xad\xbf\xc2\xf2\xb1\x2a\xbd\x32\xe5\xa5\xdc\x9b\x70\xa5\xf2\x98\xa2\xc8\x9c\xd1\x73\x46\x83\x11\xf7\x50\x2a\x6d\xf8\x41\xb4\x90\x31\x78\xb7\x45\x3e\xe7\x33\xb3\x2a\xea\xac\x45\xcb\x72\x6b\x88\xc2\xaf\xf7\xab\xdd\xb2\x9c\xad\xb7\x0e\x87\xd3\xd3\xb5\x67\x53\x9d\x5f\xa7\x18\x1f\x42\xcb\xe3\xf8\xc6\x67\xdb\xed\x6a\x39\x9f\xed\x96\xf9\x86\x2f\xcb\x7c\xa5\xfe\xe7\x4c\xf5\xd3\x71\x87\xb0\x24\xd4\xc3\xc5\x50\x5f\x50\x4d\x31\x1d\x51\x4d\xbd\xfc\x09\x52\xcc\x2e\xa3\x1f\xa2\xd8\x89\x77\x6e\x3e\xd6\xfc\xe5\xdf\xf2\x34\xd7\x75\xad\xa1\x50\xee\xec\x9a\xef\x76\xf4\x9c\x61\x9e\x50\xb9\x55\x17\x11\x16\xa1\xcc\x73\xbf\x2c\xfe\xe2\x8f\x9f\x7e\xc5\x27\x20\xf3\xf9\xf4\x19\x8a\x35\xcb\xf7\xeb\x2b\x91\xcf\xdf\xb6\x7b\x3e\x33\x31\x67\x5d\xf6\xf9\x20\x9d\x98\xd3\x63\x99\x0d\x44\x4d\x49\xe8\x8d\x55\xe9\xbd\x95\x51\x12\x07\x36\xa8\x59\x71\x56\xcb\x75\xfb\x04\xc6\x88\x65\x72\x7f\x31\x93\xfb\x8c\x19\x0f\xd2\x09\xb3\xc1\x29\x8a\x6c\xc4\xbd\x30\xf1\xbe\xda\x00\xf1\x24\xe2\x29\x49\xb1\x55\xa1\x24\xc4\x48\xe4\xdb\x32\x74\x64\x9d\x4b\x4a\xae\x22\x4d\x33\x58\x90\x4e\x88\x5f\xda\x12\x9b\xd7\xdc\x06\x31\xf6\x94\x24\x7d\x30\xa7\x4d\xb1\x43\x1c\xe6\x45\x65\x94\xf5\xe5\x8d\x51\x07\x4d\xc6\x17\x2b\xd1\xa7\x18\x72\x4b\xed\x82\x36\x1b\x66\xe0\x44\x8e\x7b\x6c\xdd\x0b\x32\x5a\x2d\xab\x86\x18\x1f\x97\x76\x0d\x78\xaf\xb1\x4c\xce\x14\x66\x1e\x9a\xbd\xb9\x1b\xb2\xd5\x91\xb9\x99\x7b\x50\x80\x79\x93\xfb\x5b\xdf\x62\x1f\x0d\xd8\x65\x5e\x2c\x77\x1f\xce\x67\xd0\x94\x92\x84\x4a\xe3\x53\xf9\xbb\x36\xe9\x00\xa2\x1f\x48\xf0\xd0\x19\x8e\xb0\x4f\x26\x51\x67\x5c\x2e\x01\x1a\xd8\xde\x5d\xe6\xcc\xe4\x15\xe5\xfd\x60\xfb\x08\x98\xdd\x9f\x58\xff\xbf\x44\x9d\x01\xb5\x19\x5c\x99\x83\xe5\xbb\x9d\xcd\xbf\x8b\xc5\x10\x06\xf0\x24\x66\x24\x88\xb1\x6f\x4c\x5a\x65\x09\x37\xa8\x02\xe5\x07\xab\x89\x5f\x6e\x12\x0f\xa1\x85\x34\x89\xab\xe8\x18\x2a\x62\xf4\x0d\x35\xec\x52\x5e\xae\x65\x65\x54\xdd\xde\x59\xed\xb0\x07\x44\x8f\xbb\x4d\x0d\x55\x72\xd3\xf3\x01\x0a\x68\x0f\x88\xab\xd2\xed\xfe\x80\x60\xb2\x7e\xf2\x25\xdf\x91\xb3\xca\xd2\x60\x3e\x18\x22\xaa\x51\x4c\x76\x60\x6a\x5c\x08\x71\x9d\xe9\xdd\x07\x57\xcb\x97\xb7\xd5\x8f\xcd\xc2\x59\x1f\x31\x36\x4e\x8c\x22\xcc\xf8\x10\x25\xde\xe4\xdc\x0c\x83\xed\xdd\xdb\x2e\xe5\x72\x27\x0a\x91\xf3\x5d\x21\xdc\x4d\x0f\xfa\x72\x0b\xcf\x28\x06\x0f\xa4\x06\x51\xdb\xb1\xcf\xca\xcd\xb2\x1b\x39\x44\xe5\x97\x3f\x67\x5b\xe7\x47\x8d\xec\x11\xa5\x9c\xc4\x19\xa6\x53\x14\x42\x75\x8d\x10\x14\xbc\xf8\xa4\xea\x97\x2b\x87\xae\x24\xbe\x13\x33\x77\xa7\x49\x21\xca\xb0\xd2\x9a\xf1\x0c\x23\xca\x87\x28\xbd\x1c\x69\xf6\x85\xe0\xaf\x45\xbe\xe6\x5b\xed\x6f\xe1\xaa\xfc\x72\xa5\x34\xa1\x98\xdf\xf9\xd7\x96\x06\xb7\xa8\xfb\x4e\x94\x74\x58\x44\x71\x10\x62\x1b\x78\x44\x62\x9f\x67\xc9\x31\x91\x60\xd4\x03\x45\xb6\x6c\x44\x52\xe9\x17\x5e\x25\xd1\x31\x67\xbd\x71\xc3\xa0\xf8\x33\x27\xf1\x14\xd3\x0c\xfb\x50\xa2\xca\x48\xb9\x21\x5a\x37\x8c\xd9\x57\x02\x52\xdd\xaa\xe4\xac\x84\xca\xf7\xa0\x1b\x6a\xd1\x03\x2e\x49\x64\x8b\xde\x59\xb7\xc1\xbd\xdf\xfd\xc3\x00\x82\x35\x6f\x7e\x2b\x42\x7b\xd8\x03\x91\xa3\x1f\xbf\x0d\x60\x95\x85\xd6\xc3\x26\x79\xa0\x2c\x9a\x3c\xfd\xbf\x85\xec\x9d\x85\xec\xbd\x85\xec\x17\x0b\xd9\xdf\x2d\x64\xff\x62\x21\xfb\x57\x0b\xd9\xbf\x59\xc8\xfe\xdd\x42\xf6\x1f\xf0\x25\x81\x8c\xfe\x97\x89\x77\xf5\xf2\x39\x18\x32\x8f\xfc\xdf\xe0\x7a\x4f\xc6\x3f\x94\xd7\x26\x5f\x6e\x5e\x73\x67\x93\xf0\xc1\x24\xb3\xb1\xee\xeb\x0e\xef\x5d\xb9\xda\x15\x8f\x2e\xf8\xe1\xaa\x47\x17\xb8\x76\xe5\xa3\x0b\x7e\xbc\xfa\xd1\x85\xae\x5d\x01\x81\xe2\x56\x33\xd5\xe1\xfa\x06\x94\xa8\x5f\xe3\x80\x32\xf5\xeb\x1c\x50\xa6\x7e\xad\x03\xca\x1c\xaf\x77\x58\xe6\xa2\xc0\x0e\xad\x63\x33\xb3\xd7\x30\xdd\x9b\x07\xfc\xe8\x8b\xd9\x4e\xde\xbf\x74\xf5\xd9\x9b\x8f\x5e\x5f\xfb\x82\x2a\xac\x2e\x11\x19\x12\x88\x9c\x5d\x4d\xb9\x0e\xf7\xaa\x27\x69\x2e\x86\xda\xd2\x12\xca\xb3\xd8\x55\x35\x55\x9b\x7f\xd2\x26\x95\x9e\x6a\x21\xce\xb0\xb2\x31\xc3\xc6\x0b\x0f\x4d\x49\xe0\x27\xef\x01\xc5\x01\x3e\xe3\x21\xa8\x97\xa4\xcf\x1d\xb0\x71\x48\xd2\x16\xac\x57\x6d\x47\x98\x21\x3e\x7b\xdb\xf2\xfc\xf5\xb5\x14\x3d\x2a\xfb\x62\x0e\x0d\xc4\x0e\x05\x69\xa2\x92\x95\xb9\xb8\x2f\xf6\x3c\xdf\x7e\xf0\x72\xff\x62\x4e\xc3\x1c\x96\xdd\xcb\xb7\x1f\x6c\xff\xa2\x03\x14\x0c\x53\x78\x6d\x7f\xf3\x79\xbe\xca\x8b\x5e\x86\x78\x5b\xb4\x84\x61\x8a\xff\x1f\xb9\xc1\xca\xf5\x9b\x88\x7d\xaa\xfe\xac\xbb\x1f\xbc\x22\xcc\x66\x23\xf1\x79\x53\xf9\x6f\xa2\x3e\x9e\x12\x0f\x77\x42\x2b\x97\x0a\x6b\x50\xba\x77\x86\x58\x3a\x56\x76\xc9\x95\xf8\x38\xe1\x11\x8e\x12\xfa\x6c\x4f\x4f\x62\x32\x26\xd8\xaf\x78\x44\xbd\x07\x92\x61\x4f\x19\x82\xd6\x89\xa5\x14\x8f\x31\x95\xeb\xb1\xea\xc8\xcb\x3a\x09\xe3\x9d\x72\xe2\x4b\xdc\xb9\x5e\x0f\x89\x35\x39\x16\xf7\x4d\x15\x33\x87\x49\xdd\xc3\xd3\xba\xf8\x62\xf5\x69\x45\x75\xc3\x47\x7f\x52\x72\x1b\xf3\x4d\x14\xfd\x12\xd1\x91\x21\x2c\xd3\xe8\x57\x82\xf6\xac\xfb\x0f\x3e\x85\x36\xcf\xcc\xb8\xef\xd2\xe1\xd4\x18\x7e\x26\x32\xcd\x30\xa5\x2f\xc5\x8e\x7f\x59\xbc\xbe\xf3\x75\xbe\x70\x77\x1c\x21\x5d\xe6\xcc\x45\x4a\x1e\x25\x3e\xfc\xc3\x55\xe0\x78\x12\x86\xe6\x74\xee\x36\x7c\x51\x63\x4c\xec\xbe\xf8\xaf\xef\x51\xbe\x10\x03\x55\x97\x93\x83\x89\x8b\x72\xcb\x0b\x6c\xb5\xd3\x02\x0b\x85\x5b\xa8\x5e\x1a\xc7\x53\xbd\xaa\x19\x64\x45\xa3\x7b\x35\xdb\xbf\xf8\xb3\xdd\x2c\x9e\xc2\xd4\x8d\x67\x6b\xb1\x00\xd0\x4e\xd4\x5e\xae\x7b\x79\x34\x35\x6a\xad\x76\x7e\x06\x2b\xf6\x42\xac\x66\x1f\xfc\x45\xbc\xe6\x85\x50\x46\x8b\xc3\xd2\xfb\x32\xed\x91\x4a\x5a\x1e\x6c\xb9\x2f\xbc\x72\x33\xe9\xbd\x7a\x3e\x1b\x4f\xb4\x7f\x88\x34\x59\x92\x18\xc7\x19\xe3\xee\xcb\xad\x3c\x96\xa5\x3b\xaf\x6b\x37\x88\xca\x01\x22\x9a\x84\x19\x91\xfe\x9e\x3a\x14\x5d\x83\x0a\x36\x34\x23\x6a\x2f\xbe\x5f\x22\x68\x4c\x7b\x16\x43\xa5\xe8\x21\x96\xb9\x48\x87\xfb\x84\xa5\x21\x7a\xbe\x99\xaa\x93\x76\xd6\x9e\xe9\x7c\x2e\xe3\x88\xba\xf4\x77\xf4\x92\x29\xa6\xd2\xd1\xb1\x72\x3a\x87\xd6\x8a\xdc\x8d\xbb\xce\xf4\xd5\x79\x5b\x88\x52\x6c\x76\x5c\x05\xef\x76\xa6\xac\xf4\x5b\xd6\x66\x06\x0b\x93\xc6\x6f\xb2\x79\x08\xc2\x9b\xfd\x5a\x14\xb3\x9d\x50\x81\x77\x7d\xf1\x63\x39\x17\x25\x14\x96\x5b\xef\x35\xce\x7d\x5d\xd5\x6e\x7f\xa8\xea\xe2\x6b\xb1\xce\x8b\x0f\xbe\xdd\x17\x0e\x3b\x4a\x80\x63\x65\xd9\x99\x4b\x2d\x49\x7c\x6a\xb0\xa5\x13\x1a\x60\xee\x5e\x39\x39\x99\xe8\xeb\x2c\xdd\x35\xb9\x68\x92\x93\xb7\xa4\x6c\xda\xb1\xf6\x26\x17\x14\x53\xc6\x84\x75\x66\xf5\x47\xc0\x14\x08\xee\x6e\x8a\x54\x7e\xd7\x9e\x8c\xbb\x0c\xc5\xa8\x28\xc5\xee\x16\xd6\xb7\x21\x75\xd7\x9c\xcf\xb6\x32\xea\x81\xb3\x5e\x79\xdc\xe5\xb8\xa2\xe8\x99\xfc\x24\x26\xff\x9a\x58\xc8\x1f\x47\x0c\x0f\xa5\x6a\x33\xc1\x6e\xe4\x38\x7c\xfc\x9e\x56\xfc\xda\x18\x00\x19\x77\x4e\x92\x00\xf7\x87\x30\x9f\x7f\xef\x5e\x02\xd5\x9b\xba\xe3\xc6\x90\x84\x24\xe0\xa6\x7f\xe5\x7b\x77\xcb\x20\xdd\xee\xc9\xa4\x3a\x47\x82\xf5\x98\x23\xa5\x4f\xc5\x6c\x29\x1d\x4f\xcf\x96\xd2\x25\x44\xb1\xdf\x31\xd7\x23\xdf\x2d\x7f\xe5\x57\x6c\x4f\x11\x1c\xfa\xfc\xae\x23\x77\x6f\xcd\xb1\x0c\x79\x5f\xb1\xaf\x79\xc6\xef\xfa\x27\x71\x7f\xbd\xf4\x17\x1f\x43\xf5\xb4\x40\xf7\x4f\xc8\x8e\x3c\x8c\x3d\xca\xf6\xeb\x92\x9f\x15\xc8\xc4\x66\x61\x02\x0c\x65\xb9\xca\xd7\xb6\x62\xa4\x8b\xb2\xe3\x81\x21\x89\xcc\x21\xda\x5a\xec\xbe\xe5\xee\xbc\x80\xa5\x8f\x22\x8f\x70\xf6\x90\xf8\x3c\x89\x40\x67\x58\x0a\x39\x1c\x9d\xd9\x40\xea\xd0\xcc\x06\x98\xc4\x3e\x1e\x13\x79\x4d\xa1\x81\xea\x5f\x9f\x1f\x9b\xf9\x40\x5b\x51\x1f\x9b\xf9\x74\xb6\xda\x8b\xf2\x9a\xb6\x4d\x54\x54\xce\xe9\x6c\x27\xc0\x48\x2d\xe8\x76\x54\xce\xc1\x98\x8c\xec\x3c\xce\x8b\x0e\x08\x7b\x99\xbb\x6e\x07\x16\x10\xc6\x5f\x56\x72\x97\x79\xc1\xcd\xdb\x12\xce\x3a\xf7\x28\x94\xfb\xfa\xbe\x76\xec\x93\x39\xb9\x2f\xfa\x2f\xb8\x6d\xdd\x54\xf0\xeb\x18\x1b\x48\x51\x75\x1f\x7f\x31\x88\xbe\x6a\xcf\xc1\x28\xcd\x26\x23\xe5\xef\xa8\x23\xf8\x81\x75\x6f\x4c\x42\x87\x0c\x1c\xa4\x3e\x9e\xf8\x62\xed\x78\xa3\xcd\x27\x01\xc9\x50\xa8\xee\x1b\xa2\x43\x20\x43\x99\x17\xec\x1b\x3d\xdc\x73\xf5\x23\x33\x7b\x35\xb1\xfd\x15\xaf\x2e\xb7\x39\x53\xfc\x18\x85\xfb\xba\xb6\x57\xc3\x71\x83\x91\x2a\xd8\x36\x18\x38\x8b\xba\x0d\xe6\x4e\x82\xb7\x82\xa9\x5a\x14\x57\x30\x53\x51\x2a\x37\x28\x54\x8b\xeb\x0a\x2f\x5c\x15\xe0\xb5\x53\xe5\xdd\x06\xdb\xa3\x66\xc2\xd1\xd3\x00\x9d\x16\xdc\xa9\x8b\xc5\xe3\x72\xf7\xad\x5b\x12\xe6\xfe\x57\x97\x04\x1a\x23\x7f\x5a\xe1\x15\x24\x43\x87\xea\x20\xa2\xc3\x0c\x00\xdf\x3e\xb6\xa2\xd8\x2e\xb7\xc2\xd9\x08\xf0\xf0\x9c\x62\x2a\x43\x44\xa8\x38\x11\xea\x4a\x1a\x0f\x71\x1c\xc8\x77\x77\x80\x1d\x4e\xbe\xc8\x72\x4c\xc6\x74\xf1\x6e\x30\x94\x3a\x12\xd5\xe6\x79\x47\xdc\x7c\xfb\x56\xb4\x01\xbd\xac\x03\x11\x92\x88\x64\xac\x43\x41\xcd\xb5\x35\x7b\x50\x45\x56\xe6\xa8\xda\xa1\xb7\xe6\x6f\x8d\x55\x17\x9f\x82\xda\xea\x79\xa8\x3a\x69\x2c\x76\x3f\xf3\xe2\x3b\x1c\x3f\x90\xb6\x9f\xe0\x69\xbe\xb6\xb4\x89\x6c\xdb\x99\x97\x46\xf2\x01\xee\x5a\xe8\x91\x28\x4d\xb8\x0f\x78\x0a\x07\xd8\x16\x3c\xcd\xfa\x3a\xdd\x7f\xc4\xda\x3a\x5e\xaa\x55\x8b\x33\x9b\x65\x41\xc3\xa3\x1c\x60\xb6\xf1\x75\x0e\x4b\xfa\xfc\xf5\x0c\x30\xde\xf4\x5e\x87\x1d\xdc\x39\xeb\xb3\x17\x3c\xc0\xdc\xf9\x53\x1e\x60\xf0\xf2\x4d\x0f\x30\x6a\x9e\x5c\xeb\x90\xe7\xa9\xb5\x67\x83\xd6\x83\xd7\x1f\xec\x25\x6b\xf2\x68\x26\x59\xa3\x6a\x5c\x87\xcf\x24\x49\x9a\x91\x08\x85\x1d\xcb\x7c\x4e\x5b\x96\xfb\x32\x6e\x3f\x18\x3d\x0b\xe0\x0f\xe6\xec\xf4\xb3\xd4\xe7\x18\xdb\x1f\x8c\x98\x20\xff\x60\x79\x86\xa6\x16\xd2\xc6\x8e\x83\x8a\xb7\x56\xe6\x0d\x4f\xe8\xeb\xf6\xed\xcd\xc9\xb5\x0b\xaa\x66\xa9\x2e\x60\x2d\xfe\x3a\x1c\x3a\x8f\xc3\x3e\xcc\x7c\xa8\x36\x92\x5f\xf4\xf1\x6f\xf7\x49\xb1\xed\x60\x19\xae\x75\xfd\x90\xd8\xc0\xe5\x80\x9a\x0f\x7a\x0a\x3f\x4c\xb9\xf5\xa1\x60\x21\xca\xe5\x7f\xdd\x99\x5d\xec\x39\xf6\xcc\x81\x17\xf4\xa3\x55\x88\x3a\x00\x80\xb7\xae\x5c\x99\x7a\xdf\x66\x9b\x8d\x58\x65\xb9\x7e\x74\x02\x0e\x1b\x90\x8a\xf9\x0e\x0e\xa9\xfe\xd4\x9b\xf4\xc5\x6a\x37\x2b\xe1\x6c\x3d\x43\xfd\xa4\xf5\x60\x1d\xa1\xcc\xf7\xc5\xbc\x47\x47\xb8\x3e\xb4\xaa\xf3\x2e\xa6\x92\xef\x32\xbc\xb6\xe0\x2e\xf4\x97\x1b\x1b\xbc\x54\x83\x64\x5f\x7f\x9a\xb3\xce\x6d\x6e\xc0\x31\x1c\x4a\x2b\x39\xa0\xc9\x24\xe5\x43\x68\xc1\xe7\xfb\xf2\x5b\xaf\x90\xa5\x97\xed\xa7\x53\x64\x01\x71\x5e\xd8\xf5\xec\xbb\xe0\x73\x7d\x11\x81\x17\x62\xb6\x70\x58\xec\xda\x83\xb4\xf2\x29\x94\x86\xe2\xb7\x45\x92\x3a\x7b\xe3\xdf\xb9\xf6\xf5\x10\x31\x0e\x15\xaf\xfb\xaa\x0f\x51\x6c\x33\x4c\x7c\x6c\xe6\x0e\x0b\x1d\x88\x93\x07\xfb\x3f\x7d\x86\x40\xe7\xcf\xfc\xbb\x55\x75\x12\xab\xe0\x6c\xbb\xe3\x63\xf0\x5c\x3e\xf1\xff\xee\x56\xeb\x86\x97\xf8\xbb\xa8\x51\xfb\xa1\xfc\xf3\x37\x49\xbc\x2d\xcb\x5d\xf1\xf1\xe7\x6f\xff\x1b\x00\x72\x2a\x6e\x5b\x67\x7a\x01\x00")
+
+func registryGlxXmlBytes() ([]byte, error) {
+	return bindataRead(
+		_registryGlxXml,
+		"registry/glx.xml",
+	)
+}
+
+func registryGlxXml() (*asset, error) {
+	bytes, err := registryGlxXmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "registry/glx.xml", size: 96871, mode: os.FileMode(420), modTime: time.Unix(1792218352, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesCmdbufTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\x6d\x6f\xdb\x38\x12\xfe\xae\x5f\x31\xe7\xcb\x1d\xa4\xc4\x95\x73\xdb\x62\x0f\x48\xd6\x07\xb4\x6e\x5a\x14\x08\x9a\x22\xcd\xe2\x3e\x14\x45\x40\x91\x23\x89\x08\x45\x0a\x7c\xb1\x9b\x35\xfc\xdf\x0f\x43\x51\xb2\x9d\x2c\x72\xdd\xd8\x70\x24\x72\x66\x9e\x79\x9f\x59\x2c\x60\x65\x04\x42\x83\x1a\x2d\xf3\x28\xa0\x7a\x84\xc6\x34\x0a\xf2\xd6\xfb\xde\x5d\x2c\x16\x8d\xf4\x6d\xa8\x4a\x6e\xba\x85\xa8\xde\xfc\xbb\x5d\xd0\x75\x71\x09\xef\x6f\xe0\xf3\xcd\x1d\x5c\xbd\xff\x74\x97\x65\xdb\xed\x2b\x90\x35\x94\x77\xac\x71\xb0\xdb\x65\xd9\x62\x01\x67\x55\x90\x4a\xc0\x76\xbb\x3f\x26\x32\xd4\x82\x1e\xb3\x9e\xf1\x07\xd6\x60\xbc\xff\x92\x9e\xe9\x7c\x71\x9a\xfd\x5d\x6a\xae\x82\x40\x98\x35\xaa\x6c\x67\xfb\xf7\xdf\x9c\x17\x02\xeb\xb2\xfd\x4f\x96\x39\xcf\xbc\xe4\x50\x2b\xc3\x7c\x54\xf9\xbe\x7e\xfd\x4b\x1e\xa4\xf6\xbf\xbe\xb9\xf7\xb0\x29\x60\x9b\x01\x00\x04\x2d\x8d\x86\x2d\xd0\xcd\xeb\x5f\xee\x3d\x84\xcb\xc4\x54\x5f\xc2\x0e\xd6\x97\x91\x6a\x5d\x06\x58\x42\x3e\x12\x15\x9b\xe1\xd8\xa2\x0f\x56\xc3\xba\xac\x2f\xb3\xdd\x84\x29\x4c\xa8\x14\x26\xd0\x5f\xdf\xfc\x1f\xd0\xa8\x4e\xb8\x1c\xb9\xc4\x33\xd4\xa7\x58\x22\x62\x2d\x16\x03\x00\xfe\x40\xbe\x32\x5d\xc7\xb4\x70\x40\x2f\xc1\xa3\x03\xdf\x22\x68\xd8\x18\x2b\x1c\x98\x1a\xf8\x48\x60\x91\x1b\x2b\x86\x38\x32\x8a\x42\x62\x7d\x17\xea\x1a\x6d\x09\x57\x8c\xb7\x23\x35\x48\x07\x4c\x83\xe9\x39\xa5\x40\x6d\x94\x32\x9b\x81\xd3\x68\x8c\xb2\xa1\x47\x0b\xcc\x36\xa1\x43\xed\xcb\x6c\xb1\xc8\xd6\x46\x8a\xe7\x7a\xe5\xdc\x68\xe7\xf7\xd6\x9e\xf6\x73\x70\xf2\x0f\xbc\xf7\xa0\x47\x97\x3c\x25\xa1\x3c\x58\x42\x0f\x67\xa0\x07\xfb\x37\xad\x54\x08\x79\x0f\xbf\x01\x6a\x31\xb2\xd1\xc7\x6d\xa4\xe7\x2d\xe4\xa7\xfd\xd9\x19\x9d\x53\x1a\x59\xa6\x1b\x84\x13\x29\xe6\x70\xc2\xe1\x62\x09\xe5\xa8\x0c\x65\x17\x71\x8d\x39\x79\x1b\x5d\xc2\x28\x62\xe9\x86\xbe\x9c\xb9\x98\x7b\x27\x92\xd2\xf1\x62\x3a\xa7\x2f\xa5\xe4\x67\xd6\x11\x7d\xfe\xe4\x62\x0f\x3c\x87\x13\x8c\xb8\x5f\x98\x65\xdd\x84\x7a\xf8\x49\x1a\x9c\x48\xd8\xed\xe6\x04\x96\x72\x7f\xbc\x1f\xff\x88\xee\x04\xcb\xbb\xc7\x1e\xcb\x0f\xd6\x74\xff\x25\xd7\xe7\xbd\x95\xda\xd7\x30\xeb\xbf\xfd\x43\x7c\x9f\xc1\x89\x2c\x9e\xf2\xee\xcb\xa9\xb8\x3c\xba\xe8\xe1\x6c\x49\x78\x0a\xf5\x81\x7e\xc7\x34\x95\x45\xf6\x70\x39\x79\x2a\xa9\x76\xf0\x38\x12\x0a\xac\x59\x50\xfe\xd8\x45\x43\x61\xec\x25\x0e\x8a\xed\xb2\x5d\x76\xba\xc8\x64\xd7\x1b\xeb\x61\xb6\x9a\xc5\xc6\x70\xd2\x31\xdf\x92\xab\x6a\xa6\x1c\x8e\x28\x83\x1f\xff\x42\xd4\x0e\x98\x8e\x5d\x3e\xb2\x0c\xfe\x8b\xa5\xbd\xdb\x51\x68\x23\xee\x12\xbc\x0d\x24\xe4\xd8\xff\x4f\x0c\x3d\x78\x3d\x78\x4c\x86\xe4\x63\x7f\x1b\x2c\x49\x2c\x33\x7a\x99\x1d\xf6\x34\x92\x3b\x0b\xda\xb1\x1a\x67\x59\x91\x3d\x2b\xbf\x54\x9d\x0e\x38\x53\xca\x81\x37\x70\xd3\xa3\xfe\x78\x0d\x75\xd0\xdc\x4b\xa3\xa9\x1e\xc5\x51\x91\x77\x20\x35\x30\x70\x52\x37\x0a\x49\x20\x6f\x4c\x64\x9f\x83\x63\x6b\xa9\x9b\xd8\x09\xe8\xd0\xac\xd1\xb6\xc8\x04\xb5\x03\xa9\x85\x5c\x4b\x11\x98\x8a\xb4\xae\x84\x4f\x1e\x5a\xe6\x62\x61\x0f\x4a\x48\xdd\x90\xb8\x0e\x7d\x6b\x86\x3a\x1f\x95\x00\xdf\x32\x0f\xc2\xa0\x03\x6d\xfc\xd8\x96\x18\xac\x99\x0a\x18\x15\x24\x49\xda\x40\x6f\xa4\xf6\x68\x49\x4c\x4f\x01\x41\x8f\xd6\xcd\x61\x23\x7d\x1b\xb5\x72\x54\x42\x9a\x7e\x88\x69\x4f\x02\x2c\x9a\x36\x59\x1d\x1b\xcb\x62\x01\x77\x2d\xc2\x1f\x68\x4d\x42\x1a\xba\x13\x76\xbd\x7f\x9c\x3a\x56\x35\xfa\x91\x89\x47\xf2\x5f\x70\x58\xc2\xdb\x27\x5e\xee\x82\xf3\xa4\x14\x69\x5f\x21\x04\x87\x02\xb8\xd1\x3c\x58\x8b\xda\xab\x47\xa8\xad\xe9\xa0\x0b\xca\xcb\x3e\xb6\x71\x6b\x82\x97\x1a\xdd\xa1\x22\x43\x3b\x24\x07\xe3\x0f\xd6\x11\xdd\x18\x3c\x06\x35\x59\x02\x46\x73\x3c\x8e\x97\x24\x6f\xf5\x48\x73\x54\x3d\x5e\x24\x61\xb0\x66\x16\x78\x05\x8d\x1a\x73\x7d\x50\x93\x80\x80\x57\xe5\x4a\x21\xb3\x2b\xa3\x8c\xcd\xcf\xe7\x30\x7c\xff\x55\x1c\xdd\xe6\x8d\x2a\x3f\x5e\xdf\xaf\x6e\xae\x6f\x6e\xef\xdf\xfd\xfe\xe1\xc3\xd5\xed\xfd\xbb\x4f\x77\x13\xd1\xef\x0e\xbf\x58\xd3\x58\xd6\xe5\xbd\x35\xcd\x74\xfe\xde\xb2\xcd\x5b\x6b\xd9\xa3\x4b\x12\xee\x6e\x3f\xbd\xfd\xfc\xf1\xfa\xea\x6b\x44\x79\x3d\x10\xd6\xc6\xc2\xdf\x36\x52\x0b\xb3\x29\xbf\xb6\x26\x28\xb1\x52\xc6\x61\x4e\x9d\x96\xee\xe9\xc3\xab\xf2\x6b\xa8\x3a\xe9\xf3\x62\x3a\x1b\x59\x36\xac\x1f\x2c\x72\xe9\x72\x47\x96\xfb\xc7\x1e\x9f\xc4\xc5\x79\x1b\xb8\x4f\x7d\xbd\x0a\x35\x7c\xfb\x3e\x8c\x83\x78\xa0\x01\x40\x6a\x9f\x46\xdf\x35\xea\x94\x79\x69\xd6\x85\xae\x42\x4b\xb9\x3d\x4d\xb8\x94\x13\x43\xd4\x28\x97\x20\xe7\x15\x9c\x1e\x61\x16\x70\x8d\x3a\x2f\x48\x30\x6c\x0f\xa7\x2c\xaf\x4a\x9d\xa0\x6e\xd1\xa1\x07\x21\x1d\x67\x31\xbc\x4a\x3d\xc7\x48\xc3\xe9\x01\xb1\x1f\x6b\x8e\x29\x65\x38\x85\x1a\x9c\x37\x96\x35\xf8\xb2\x1e\x11\x25\x9f\x86\x61\x55\x92\x03\x96\xe9\xe1\xdb\xc5\xf9\xf7\xf1\x5c\xc3\x12\xce\x93\x6a\x83\xcf\x8f\x1a\xc2\x9f\xe8\x26\x35\xd0\x89\x2d\x63\x05\x4d\xc7\xcc\x22\x3c\x60\xef\x29\x47\x29\x2e\xc4\x9c\xea\x87\x33\x0d\x15\x82\x8b\xe2\xc9\x04\xd6\x30\xa9\xc7\xf4\x7f\xeb\x86\x1a\x16\xd2\x22\xf7\x43\x07\x99\xc7\x7f\x64\x3b\x9b\xea\x76\x68\x14\x9b\xd8\x0c\x3c\x28\xc3\x04\x8a\x39\x54\xc8\x59\x70\x08\xd2\x83\x74\x63\x19\xb2\x35\x93\x2a\x8e\x60\xa9\x63\x3c\x53\xe3\xe3\x46\x7b\xfc\xe1\xe7\x60\xd1\x05\xe5\xdd\xd0\xed\xb8\x65\xae\x7d\xd9\x9d\x63\x36\x26\x7f\xca\x1a\x14\xea\x7c\xf0\x66\x01\xcb\x25\x9c\xa7\x9b\x7d\xcc\xd3\x84\xa2\xdf\x55\xf9\x7c\x85\xc9\x4f\x57\xe5\xb8\x9d\x14\xf9\xd0\xc5\xcb\x2f\x43\x8b\xcb\xff\x99\xe2\x74\xfe\xbd\x28\xe6\xb0\x2a\x87\xe5\x26\x3f\xc0\x2c\x8a\x69\x09\x7e\x8f\x55\x68\xc6\x79\x20\x6b\x10\xf1\x7d\xaf\x0e\x6f\x91\x3f\x5c\x59\x6b\x6c\x3e\x3b\x32\x2b\xd5\xd8\xac\x48\xaa\x1e\x8c\x96\x5d\xf6\x13\x5b\xcf\x9f\xcf\x4e\x8a\x01\x6d\x34\x1f\x4d\xda\x69\x52\x0a\x51\x27\xa3\xa0\x52\x1f\x3d\xba\x7f\xd9\xf3\x47\xa4\x79\xf6\x93\x6b\xd1\x4b\xab\x50\x5a\x7f\x92\x48\xda\x5b\xc6\x65\x28\xe1\x4c\x7b\xc3\x48\x4d\x4e\x79\xb5\xdb\x65\xcf\xca\x89\xf5\x3d\x6a\x91\x62\x12\x71\x86\xfd\x6e\xbb\x4d\x0a\xee\xd5\x8a\xb7\x03\xcc\x9d\x89\x1b\xd7\xb8\xf3\x4d\xda\x15\x53\x4d\x9e\x9d\x65\x47\xe1\xd8\x6e\x5f\x01\x6a\x01\xbb\x5d\xf6\xbf\x01\x00\x30\xab\xcb\x43\x43\x0d\x00\x00")
+
+func templatesCmdbufTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesCmdbufTmpl,
+		"templates/cmdbuf.tmpl",
+	)
+}
+
+func templatesCmdbufTmpl() (*asset, error) {
+	bytes, err := templatesCmdbufTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/cmdbuf.tmpl", size: 3395, mode: os.FileMode(420), modTime: time.Unix(1792219534, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDebugTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x55\x71\x6f\xda\xc8\x13\xfd\xdf\x9f\x62\x7e\xd6\x2f\x27\xfb\x44\x6d\x7a\x6d\xaf\x12\x15\x7f\xd0\xc6\x70\xb9\x23\x38\x22\xd0\xeb\xa9\xaa\xd0\x62\x8f\xed\x55\xed\x5d\xb4\xbb\x2e\x8d\x10\xdf\xfd\x34\x6b\x1b\x5c\x9a\xe4\xa2\x24\x60\xcf\xbe\x37\x6f\x66\xdf\xce\x86\x21\x7c\x90\x29\x42\x8e\x02\x15\x33\x98\xc2\xf6\x01\x72\x99\x97\xe0\x15\xc6\xec\xf4\x28\x0c\x73\x6e\x8a\x7a\x1b\x24\xb2\x0a\xd3\xed\xeb\xb7\x45\x48\x61\xff\x1d\x5c\xc7\xb0\x88\x57\x10\x5d\xdf\xac\x1c\xe7\x70\x78\x01\xff\x4f\x88\x69\x34\x86\x99\x92\xf5\x6e\xf5\xb0\x43\x08\x66\x73\xfa\x8b\xee\xc1\x8d\x94\x92\x8a\x72\xb9\x70\x3c\x3a\xce\x8e\x25\x5f\x59\x8e\x70\x38\xd0\x82\xe0\xae\x7d\xa4\x10\xaf\x76\x52\x19\xf0\x1c\x00\x00\x37\xab\x8c\xdb\x7c\x2b\x65\xde\x7e\xd3\x46\x71\x91\x6b\xd7\xf1\x1d\x87\x2a\x60\x65\x69\xe9\x21\x45\x9d\x28\xbe\x45\x0d\x4c\x40\xbc\x43\x31\x9b\x03\xda\x88\x42\x22\x6d\xeb\x2b\x67\x68\x1a\x00\xcb\x0c\x2a\x60\x90\xd5\x22\x31\x5c\x0a\xa2\x4b\x58\x59\x02\x17\x90\xe2\xb6\xce\xa1\x92\x29\x06\x4e\x18\x3a\x86\x0a\x3a\xa7\xd2\x46\xd5\x89\x81\x83\x55\x34\xad\x45\x02\x8d\x2a\x68\x7f\x48\x17\x08\x56\x21\xc8\x0c\x4c\x81\xa7\x14\x03\xc0\x20\x0f\x20\x2f\xdf\x73\x91\xbe\xaf\xb3\x0c\x95\xe5\x98\xa8\x5c\xc3\xe7\x2f\x5c\x18\x54\x19\x4b\xf0\x70\x84\x30\x04\xa6\xf2\xba\x42\x61\x74\x47\x43\xea\xec\x7a\xea\x25\xb5\x6f\xa7\xb8\x30\x19\xb8\x57\x2f\x5e\xbe\xd2\x6e\xbb\x0b\x47\x0b\x6e\x4a\xa7\x17\x6d\xd2\xd9\x7c\x73\xb3\xf8\x38\x99\xdf\x5c\x6f\xe2\xbb\x68\x39\x59\xdd\xc4\x0b\xe7\xe8\x38\xa4\x0d\x3c\x84\x5f\x4f\xf5\xf9\x60\x3f\x3c\xbf\x2b\xab\x29\x94\x91\xc8\xd1\x18\x2a\xf6\x15\xbd\xcf\x5f\x9a\xd8\x00\x4a\x14\x1e\x06\x54\x81\xef\xdb\x75\x99\x54\xc0\x07\xc0\x60\x34\x06\xc5\x44\x8e\xd0\x84\x5b\x9a\x8e\xea\x33\xff\x02\x63\xc8\x2a\x13\xdc\xdb\x32\x3c\xd6\xc0\x8f\xf6\xbf\x42\x53\x2b\xd1\x0b\x67\x9e\x7b\xa5\xbd\x2b\xed\x8f\xe0\x4a\xbb\x54\x13\x35\x7e\xd0\x4a\xd4\xc1\x9f\x92\x0b\x8f\x78\x07\xe0\x0e\xc0\xf5\x07\xcd\xe6\x2f\x58\x85\x1e\x06\xd4\x30\xdf\x77\x8e\xd6\xac\x3c\xb3\xbe\x8b\x44\x5d\x51\x58\x5b\x53\x86\x21\xac\xc5\x5e\xb1\x5d\x9b\x5a\xdb\x8d\x3b\x77\x91\xf6\x00\x81\x59\x77\xd9\xf6\x58\x63\x3c\xd6\xbc\x86\xc6\xf3\x5b\xf7\x1d\xfa\xf5\x58\x64\xa7\xa7\x95\x83\x22\xed\x14\xd8\xf0\x1f\x4c\xa4\x25\x2a\xe0\xda\x6e\x38\xa6\xb0\x2f\x50\xf4\x8c\x6a\x5f\x83\x42\x5d\x97\x46\x93\x5b\x2f\x0c\x6f\x95\x85\x21\xdc\x18\xe2\x90\xa2\x7c\x80\x5a\x63\x0a\xbc\x71\x51\x77\xfc\xb8\x86\x6d\xcd\x4b\x03\x7b\x6e\x0a\x1b\xa1\xd3\xbd\x69\xac\x6f\x58\x3e\x20\xea\x7d\xc1\x93\x82\xa4\x25\x4c\x23\xe0\x37\x54\x0f\x67\x1d\xf8\x3d\xc1\x9d\x81\xd3\x89\x22\x5d\xfa\xe7\x23\x46\xd4\x2c\x31\x35\x2b\xad\x72\x92\x77\xc6\x98\x02\x15\x66\x52\x21\xb0\x72\xcf\x1e\xf4\xa9\xfd\xb3\xf9\x66\x11\x6f\xa2\xe5\x32\x5e\x5e\x9c\x48\x58\x48\x83\x60\x0a\x66\x88\xa9\x97\xae\xaa\xb5\x01\x21\x0d\x6c\xb1\x6b\xdd\x16\xcd\x1e\x51\xd0\x91\xc3\xdc\xb6\x2a\x85\xbc\x8c\x44\x3a\x00\x2d\x7b\xa4\x90\x30\x21\xa4\x25\xdc\x62\xd3\x2e\xdb\x16\x5e\x55\x98\x72\x66\xb0\x59\xa5\x50\xa4\x48\xbe\xef\x7a\xbc\x2a\x10\x52\xcc\x58\x5d\x1a\x28\xce\x1b\x77\xc7\x04\x4f\x62\x61\x4b\x0c\x68\x23\x12\x26\x48\x95\x46\x03\x46\xc2\x5c\xe6\x36\x04\x52\xd1\x23\x83\xa4\xd6\x46\x56\x44\xd8\x35\xd7\x26\xf8\xc6\xd4\x8f\x9e\x18\xff\xc0\x6c\x27\x60\xff\x05\xf0\xb3\x3f\x3b\x08\xf5\x09\x76\xb4\x48\x37\x3b\x8d\xaa\xe7\xdd\x3e\xda\x43\xa5\x7e\x70\x72\x63\x5d\x8b\xa5\x18\x9d\x1f\xca\x78\x52\xff\x54\xb6\x52\xe6\x9a\xdc\x7f\x76\x96\x36\x4c\xa4\x4c\xa5\x50\xca\x3c\xc7\x5e\xfe\x8e\xeb\x89\xdc\xa5\xcc\x83\x3b\x3b\x1d\xba\xfc\x16\x95\x14\x98\x7c\xb5\x0b\x3d\x3b\x66\xbb\x59\x44\xe7\x1f\x82\x20\xe8\x0d\xd2\x8e\x89\x67\xd0\xdd\x51\x87\xc3\x69\x52\x7a\x79\xeb\x1d\xcf\xf7\xdf\x35\x0b\xfe\x37\x86\x61\x8b\xa1\xdf\x7e\x75\xde\x2f\x27\x81\x07\xca\xdb\x24\x1c\x58\xdc\xb1\x9b\x5e\x9d\xc6\xf3\xf8\x49\xda\x69\xdd\x25\xed\xcd\xd5\xa7\xc6\xd1\x4f\x23\x83\xa0\x7e\xd0\x4a\x6d\xc6\x46\xa9\xb1\x5b\xaa\xf7\xdc\x24\x85\xd5\xd1\x4a\xb7\xc7\x75\xf8\x7d\xf8\x66\x38\x1c\x9d\x6a\x69\x19\xdd\xde\x55\x10\x2d\xd6\xb7\xee\x05\xe2\xe5\xb3\x88\x8f\x93\xf9\x3a\xba\x84\xfc\xf6\x2c\xe4\x74\xdf\x5c\xc2\x5e\x3d\x0a\xbb\x5f\x4d\x3e\xfc\xb5\x89\x3f\x46\xcb\xe9\x3c\xfe\xfb\x12\xf3\xfa\x19\xcc\x7a\x71\xfd\x38\xe8\xcd\xa3\xa0\x78\xbd\xda\xc4\xd3\xcd\x6d\x74\x1b\x2f\xff\xb9\x84\xfc\xfe\x6c\x49\xd3\xe5\xe4\x36\x7a\xbf\x9e\x4e\xa3\xe5\xf9\x3a\xbd\xa4\x78\xfb\x28\xc5\x87\x78\xb1\x8a\x3e\xad\x36\xf3\xf8\x7e\xe5\xfe\xc7\x95\x37\xfc\x7e\x35\x7c\xfd\xc9\x6d\x2c\xe6\xf7\xaf\x8b\xa3\xf3\xef\x00\xb7\x4b\xc3\xa3\xc0\x09\x00\x00")
+
+func templatesDebugTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDebugTmpl,
+		"templates/debug.tmpl",
+	)
+}
+
+func templatesDebugTmpl() (*asset, error) {
+	bytes, err := templatesDebugTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/debug.tmpl", size: 2496, mode: os.FileMode(420), modTime: time.Unix(1792221877, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDebugDisabledTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcb\x3d\x4b\xc5\x30\x18\xc5\xf1\x3d\x9f\xe2\xb8\x29\x42\x9e\x45\x10\x14\x27\xef\xe5\x22\x88\x75\xe8\x2e\x79\x79\x9a\x84\xc6\xa4\xe4\x65\x90\xd2\xef\x2e\x2d\x1d\x0f\xff\xf3\x23\xc2\x7b\xb6\x0c\xc7\x89\x8b\x6a\x6c\xa1\xff\xe0\xb2\x8b\xb8\xf7\xad\x2d\xf5\x85\xc8\x85\xe6\xbb\x96\x26\xff\x92\xd5\x4f\xcf\x9e\xf6\xfc\xf0\x8a\xcb\x80\xaf\x61\xc4\xf5\xf2\x31\x0a\x41\x84\x47\xdd\x43\xb4\xb8\xdb\xf3\x8f\x65\xdd\x9d\x10\x8b\x32\xb3\x72\x8c\x75\x85\xbc\x7d\xca\xef\x73\x6e\xdb\x21\x8e\x13\x38\x29\x1d\xb9\xc2\xc5\x1b\xb7\x6b\x29\xb9\xc0\x78\x36\x73\x45\x48\x50\x31\x62\xea\xc9\xb4\x90\x53\x95\x82\x48\x98\x9c\x6a\x3b\xe9\x1b\x26\x15\x2b\x8b\xff\x01\x00\xbe\xbf\x06\x2d\xc6\x00\x00\x00")
+
+func templatesDebugDisabledTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDebugDisabledTmpl,
+		"templates/debug_disabled.tmpl",
+	)
+}
+
+func templatesDebugDisabledTmpl() (*asset, error) {
+	bytes, err := templatesDebugDisabledTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/debug_disabled.tmpl", size: 198, mode: os.FileMode(420), modTime: time.Unix(1792207119, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDebugEnabledTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcb\xbd\x4a\x04\x31\x14\xc5\xf1\x3e\x4f\x71\x4a\x45\xc8\x6d\x04\x41\xb1\x72\x97\x45\x10\xd7\x62\x7a\xc9\xc7\x35\x09\x13\x93\x21\xb9\x29\x64\x98\x77\x97\x1d\xa6\x3c\x9c\xff\x8f\x08\x6f\xd5\x33\x02\x17\x6e\x46\xd8\xc3\xfe\x21\xd4\x90\x71\x17\x45\x96\xfe\x4c\x14\x92\xc4\x61\xb5\xab\xbf\xe4\xed\xe3\x53\xa4\xdb\x7d\xff\x82\xd3\x15\x9f\xd7\x09\xe7\xd3\xfb\xa4\x14\x11\x1e\xec\x48\xd9\xef\xf8\xdb\xb3\x1d\x41\xa9\xc5\xb8\xd9\x04\xc6\xba\x42\x5f\x3e\xf4\xd7\x31\xb7\x6d\x07\x7b\x04\x2e\xc6\x66\xee\x08\xf9\xc2\x72\x6e\xad\x36\xb8\xc8\x6e\xee\x48\x05\x26\x67\xfc\x8c\xe2\x24\xd5\xd2\xb5\x22\x52\xae\x96\x2e\x07\x7d\x85\xb4\xc1\xea\x7f\x00\x72\xb8\xa1\x7e\xc4\x00\x00\x00")
+
+func templatesDebugEnabledTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDebugEnabledTmpl,
+		"templates/debug_enabled.tmpl",
+	)
+}
+
+func templatesDebugEnabledTmpl() (*asset, error) {
+	bytes, err := templatesDebugEnabledTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/debug_enabled.tmpl", size: 196, mode: os.FileMode(420), modTime: time.Unix(1792207119, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDebugcbTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x5d\x8b\xeb\x36\x10\x7d\xd7\xaf\x18\xdc\x3e\xd8\xc1\xb5\x4a\x5b\x28\x6c\x49\xa1\x75\xdc\x6c\x20\xdd\x84\x24\xbb\xb0\x4f\x41\x91\xc7\x8e\x58\x5b\x32\xfa\x08\x9b\x1b\xf2\xdf\x2f\x92\x9d\x8f\xcb\xc2\xde\x0b\x89\x93\x99\xd1\x39\x9a\x33\x73\x4c\x29\xe4\xaa\x44\xa8\x51\xa2\x66\x16\x4b\xd8\x1d\xa1\x56\x75\x03\xf1\xde\xda\xce\x3c\x50\x5a\x0b\xbb\x77\xbb\x8c\xab\x96\x96\xbb\x3f\xfe\xdc\x53\x5f\x4e\xfe\x82\xc9\x02\x9e\x16\x1b\x28\x26\xb3\x0d\x21\xa7\xd3\x2f\xf0\xb3\xd1\x1c\x1e\xc6\x30\xd5\xca\x75\x9b\x63\x87\x90\x4d\xe7\xfe\x5b\xac\x21\x9a\xe0\xce\xd5\x6b\xe5\x34\xc7\x08\xce\xe7\x1e\x60\x8f\xdd\xe7\x00\xcf\x72\x3b\x6e\xf0\xf0\x1d\x7e\x3c\xa0\x16\xf6\x18\x20\xa4\x63\xfc\x8d\xd5\x08\xa7\x93\x6f\x22\x5b\x0e\xa1\x2f\xd1\x11\xf9\x49\x48\xde\xb8\x12\x21\xaa\x9b\x6c\x1f\x91\x11\x25\xa2\xed\x94\xb6\x10\xe5\xd1\xe5\x6f\x4c\x00\x00\x22\x73\x94\x9c\x32\xab\x5a\xc1\xa3\x3e\xe3\xa4\x61\x15\x46\x24\x21\x84\x52\x08\xe2\xfe\x73\x92\x83\x46\x8e\xe2\x80\x06\xec\x1e\xa1\x45\x63\x58\x8d\x06\x54\x15\xe2\xd2\x1f\x03\xe5\x6c\xe7\xec\x25\xc7\x9d\xd6\x28\x2d\x70\x25\x2d\xbe\xdb\x07\x4f\x67\xc2\x98\x52\xb0\xc7\x0e\x53\x10\x25\x30\x59\x82\x19\xb4\x5d\x80\x03\x79\x0a\x98\xd5\x19\x4c\xe7\xdb\x49\xf1\xef\xf3\x74\xbb\x5e\x3c\xaf\xf2\x62\xfb\xcf\x72\x96\x7a\xaa\x6b\x7e\xf3\xba\x2c\xb6\xc5\x6a\xb5\x58\xa5\xc0\x24\x88\xb6\x6b\xb0\x45\x69\x99\x15\x4a\x82\xe9\x90\x8b\x4a\xf0\xe1\xb2\x6f\x90\xeb\xe2\xa5\x58\xcd\x36\xaf\xdb\xc7\xd9\xf4\xd1\x83\xcb\xfb\xfb\x41\x58\x83\x4d\x95\x11\x4a\x89\xef\xf7\x6e\x16\x95\x93\x3c\xee\xb5\xf8\x1d\x04\x7b\x9c\xcf\x41\x56\x88\xfd\xaf\x8f\x45\x09\x4e\x48\xfb\xfb\x6f\xe9\x4d\xa4\xaf\xfb\x75\xfb\x7a\x6b\x6a\x30\x56\x0b\x59\x27\x84\x1c\x98\x86\xf2\x7a\x45\xbf\x93\xec\x85\x35\x0e\xc3\x26\xbc\x37\x43\x07\x39\x6b\x9a\x1d\xe3\x6f\x20\x0c\x70\xd6\x34\xbd\xb3\x7d\xe3\x39\x58\xcd\xda\x4e\x35\x42\x22\x74\xcc\x18\x2c\xc1\xaa\x00\xee\xa1\xff\xf7\xca\x2e\x0c\x41\x1a\xa5\xf8\x1e\xcc\xf1\xe1\x02\xe2\x65\x7e\x4c\x0f\xc2\x7b\xb5\x79\x36\x9d\xa3\x74\x6d\xd0\xea\x03\xaf\xf7\x4e\xed\xad\xde\xa0\xac\xed\x3e\x24\x8c\xf8\x82\x22\xbd\xce\x79\xe4\x73\x7c\xcf\x74\x02\xa7\xe0\xc1\x2a\x85\xad\x7f\x1d\xae\xd3\xc8\xe6\x8a\x95\x71\x92\xc5\xd7\x15\x24\xe1\xa0\xa8\xa0\x82\xf1\x18\xa4\x68\x06\xa8\xff\x68\xb4\x4e\xcb\x10\x9e\xc3\xd3\x4f\xf6\x36\xea\x0b\x72\x68\xe8\xef\x31\xfc\x7a\x07\xf6\xe7\xc6\xbe\x4b\xb5\x0e\x8b\x79\x8a\xe3\x51\x9e\x85\xee\xe2\xfe\xd5\xc8\x96\x4a\x48\x8b\x3a\x1e\xfa\x4f\x92\x14\xf2\x4c\x48\x1b\xf7\x8c\x49\xdf\xdb\x19\xb0\x31\xf8\x09\xf3\x0f\x10\x0f\x4c\xe1\x59\xc5\x37\xa7\x0d\x2b\x48\xd2\x3b\xb7\xc5\xf6\xd8\x25\xe9\xe0\xb7\x58\x94\x43\xb1\xb7\x5a\x7c\x59\x48\x92\x42\x6b\xea\x84\x9c\xc9\xd7\x01\x00\x0c\xc2\x6b\x0c\x20\x05\x00\x00")
+
+func templatesDebugcbTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDebugcbTmpl,
+		"templates/debugcb.tmpl",
+	)
+}
+
+func templatesDebugcbTmpl() (*asset, error) {
+	bytes, err := templatesDebugcbTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/debugcb.tmpl", size: 1312, mode: os.FileMode(420), modTime: time.Unix(1792220135, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDebugcbApiTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\xdf\x73\xdb\x36\x12\x7e\xe7\x5f\xb1\xc7\xbb\x07\xd2\xa7\x23\x73\x6e\x66\x9a\x71\xea\xce\xb8\xb2\xe2\x78\xe2\x48\x1a\x5b\xee\x34\x4f\x1e\x88\x5c\x92\x48\x28\x80\x01\x40\xd9\xaa\xa2\xff\xbd\xb3\xe0\x4f\xd1\x52\xe2\x3e\xf4\x45\x43\x2e\xb0\xdf\x7e\xbb\xfb\x2d\x08\x85\x21\x8c\x65\x8c\x90\xa2\x40\xc5\x0c\xc6\xb0\xdc\x40\x2a\xd3\x1c\xbc\xcc\x98\x42\x9f\x85\x61\xca\x4d\x56\x2e\x83\x48\xae\xc2\x78\xf9\xfa\xe7\x2c\xa4\x65\xff\x2d\x5c\xce\x60\x3a\x5b\xc0\xe4\xf2\x7a\xe1\x38\xdb\xed\xff\x80\x27\x10\x2c\x58\xaa\x61\xb7\x73\x9c\x30\x84\xff\x2e\x4b\x9e\xc7\xb0\xdd\x76\x66\xda\x86\x22\x6e\x1e\xff\x13\x2d\xe1\xec\x1c\x82\x77\x5c\xc4\x63\xb9\x5a\x31\x11\x83\x9b\xe6\x97\xb8\x2c\xd3\x8f\xa8\x35\x4b\x71\xcc\xf2\x7c\xc9\xa2\x2f\xee\xb1\x85\x0f\xef\x6f\xdd\x16\xaf\x28\x75\x76\x08\x71\x5e\xea\xcc\x3a\x5f\x29\x59\x16\xee\x73\xd3\x3e\x8a\x2c\x0e\x82\xc8\x62\x88\x21\x8b\x23\x10\x39\x5b\x62\x7e\x08\x64\xb6\xfc\x8c\x91\xb9\xa1\x65\x77\xf0\xbe\x07\xf0\x25\x53\xe4\x9e\xb0\x5c\x63\x63\x7c\xe4\x26\xab\x8a\x35\x79\x32\x28\x34\x97\x02\xdc\xab\x9b\x87\x0f\xef\x6f\x1f\x62\x62\x46\x85\xd8\x6e\x2b\xe7\x73\x08\xe6\x4a\xae\x79\x8c\xda\xd6\xd9\xae\xf4\x6b\xcf\x0a\x4e\x11\xdc\x59\x81\xe2\xea\xa6\x8b\x8c\xc2\xa8\x8d\x5d\xb9\x98\x5f\x4f\xa6\x8b\xdb\x4f\xed\x1a\x4f\x00\xbf\x42\x70\x31\xbf\x26\xea\xa8\x4f\x9b\x80\x84\xd5\x42\x4d\xee\x1a\x73\x05\x75\x6e\x49\xf6\xc1\x06\x4c\x14\x7e\xa5\x78\x85\xe2\xc2\x54\x58\x2e\xb8\x44\x3a\xf8\x1d\x15\x65\x19\x7c\x64\x9f\xa5\x02\x37\x18\x58\xb9\x90\xaa\xc7\x4d\x48\x73\xc0\xcb\x86\xb3\x31\xce\x0f\x56\x0b\xa9\xc2\x3c\xa9\xaa\xd6\xdf\x5c\xf3\xa1\x17\x17\xa4\x82\x43\xbe\x55\x16\x4e\xc1\xa2\x2f\x2c\x45\xab\xf5\x79\xfd\x6c\xa7\xe0\xc4\xf9\x37\x17\x51\x5e\xc6\x48\x15\x0b\x32\xb7\x7b\xff\x45\x9b\x38\xe7\xcb\x20\xfb\xd5\x71\xf0\xc9\xa0\x12\xb0\x96\x3c\xb6\xd3\x67\x55\xd5\x28\xdc\xbb\xba\x41\x51\xae\x40\xcb\x52\x45\x38\x82\xfa\xd5\x6c\x0a\xfb\x52\x12\x4b\x1e\xb7\x76\x8d\x6b\x54\xdc\x6c\xc8\xa0\xf9\x9f\xc8\x21\x47\x91\x9a\x8c\xde\xa3\x8c\x29\x38\x59\x55\x13\xe4\xbf\x75\x1c\x6d\x98\xe1\x51\x15\xb8\xeb\xd8\x6e\x67\x59\x3c\xc4\xff\x08\x8d\x48\x0a\x6d\x86\x64\x1a\xb3\x65\x72\x52\x6a\x54\x73\xa6\xd8\xca\x87\xad\x03\x00\x07\x8a\xd2\xd0\xa8\xca\x40\x81\xbb\x88\x4d\x24\xaf\x09\xe2\x77\x29\xef\xda\xa4\xb7\x5b\xf0\xb8\x88\xf1\xc9\x8a\xc6\x86\xd3\xf0\xca\x0f\x16\x9b\x02\x83\x29\x5b\xe1\x7e\x1d\xe6\x4a\x46\x1e\xd1\x6b\x38\x29\x34\xa5\x12\x07\x2a\x45\x41\x4e\x42\x87\xaf\x0a\xa9\x0c\xb8\x63\xb7\x79\xf4\x6c\x2e\x2e\x2a\x25\x95\x76\xab\x97\x52\x68\x96\xa0\xeb\xf8\x8e\xb3\x66\x0a\x50\xa9\xa9\xb4\xed\x9f\x95\xa6\x28\x0d\x9c\x93\x49\x2a\x1d\x4c\xf1\xd1\x73\xf7\x05\x76\x06\x36\x2e\xc8\x6a\xab\xc2\xaf\x25\x57\xa8\xa1\xd1\xf0\x6e\xe7\xfa\xf6\x28\x8e\x7b\x80\x15\x6d\x0d\x46\x95\x56\xf7\x26\xc3\x7d\x98\xa4\x14\x91\xe1\x52\x68\x60\x0a\x81\xad\x19\xcf\xd9\x32\x47\xe0\x02\x4c\x86\x04\x17\x95\x4a\xa1\x30\xd4\x32\x83\x4f\x26\x70\xc2\xd0\x21\xaf\x7e\x1c\xcf\x87\xa5\x94\xf9\x7e\xad\xb6\x5b\x8a\x78\x68\x46\x6f\x4b\x61\xf8\x0a\x6b\xb3\xe7\x07\x57\x13\xaf\x39\x59\x76\xbb\x91\x4d\xe9\x80\xdb\xf3\x85\xfa\x4c\xf0\xeb\x50\xd5\x50\xc3\xb7\x6f\xd0\x8e\x6b\xff\xa1\xdb\xd2\x9f\xee\x76\x87\x43\x33\x1c\xc2\x1d\x9a\x3d\xf1\x81\x46\xa3\x21\x01\xa6\x7b\xd5\xab\x25\x06\x51\xb3\x49\x26\x76\x75\x50\xac\x11\x01\x4a\x05\x0a\x57\x72\x8d\x15\x40\xeb\xc2\x13\x48\x80\x6b\x10\x3c\x0f\xe0\xba\xeb\x15\x13\x95\x0a\x9a\x7e\xd5\x58\x10\x4b\xd4\x84\x47\x27\x9f\x2e\x0b\xab\xb7\xae\xf9\xb6\x2f\x61\x08\x09\xac\xd8\x06\x96\x55\x1c\x8c\x21\x51\x72\x05\x4c\x6c\xc0\x64\x0a\x59\x3c\x82\x52\xe4\xa8\x35\x1d\x70\x97\x93\xdf\xee\xaf\x1e\x66\xf7\x8b\xf9\xfd\xe2\xe1\xee\xd3\x74\xfc\xfe\x76\x36\x9d\xdd\xdf\x01\xb7\x71\x50\x90\x12\xe2\x11\x69\xe1\x31\xe3\x51\x06\x11\xa3\xd3\xd3\x10\xe9\x3e\x3a\x91\xac\xd0\x9b\x32\x34\xaa\xb2\x24\x08\xcb\x64\xcc\x40\xc4\x4a\x8d\xb1\x2d\x42\x5d\xbf\x00\xea\x0f\x7c\x25\x3f\x29\xf2\x4d\xef\x76\xf2\x98\xf1\x1c\x87\x44\x87\xe4\x2a\x66\xbc\x69\x4e\xc2\xca\xdc\x40\x22\x55\xdd\xa8\xba\x78\x3a\x80\x45\x86\x0a\x89\x3a\x03\xcd\x45\x4a\xc8\x92\xa8\xb5\xfd\x20\x27\x96\xe7\x4d\xbd\x75\xa7\xf4\xa1\x24\xbc\x04\xac\x44\xde\x95\x22\xf2\xeb\x66\x55\xd2\xe7\x09\xfc\x6b\x7f\x2e\x2a\x7b\x6f\x2c\x86\x43\x6f\xd7\x77\xf6\x37\x6e\x40\x83\x3b\x23\x15\x7a\x89\xdf\x80\x26\x70\x7e\x4e\x42\xe9\xc1\xd5\xb3\x70\x25\xeb\xd3\xcb\x13\x3c\x1f\xd1\x1e\x7f\x18\x51\xf0\xbc\x17\xe4\x99\x5f\x75\x28\x05\x73\xc9\x85\x41\xe5\x8d\x83\xc1\x31\xe8\xfb\x3d\xd8\x1e\x64\xfb\x29\xa6\x9b\x5c\x75\x1b\xb3\xb7\xa9\xfa\x46\xd8\x5d\x96\x80\xd6\x90\x0a\x6f\x13\x84\xd4\x1a\xed\x05\x87\x9a\x96\xf2\x35\x0a\xe0\xb1\xc5\xa9\x85\x31\x6a\x95\x45\x2d\x62\x45\x91\xf3\x88\x19\xba\x03\x35\x9f\x02\xda\xdc\x4e\x4c\x27\xb8\x42\x16\x05\x17\x29\x70\x63\x67\x8a\x66\x06\x84\x34\x99\xb5\x25\x04\x36\x1c\xa9\x63\xf3\x44\xee\x24\xab\x4d\xc1\x49\x22\x1b\xb0\xe2\x65\x1a\x12\x99\xe7\xf2\x51\x9f\xd5\x03\x07\x31\x26\xa8\x20\xcd\x83\x2e\x63\xef\xd5\x08\x5c\x9d\xb1\x58\x3e\x42\xc1\xb4\x76\x7d\xcf\x6f\xd5\xd4\xdb\xc6\x63\xa0\x2f\xfa\x4f\xa7\xa3\x26\x71\xd0\x46\x71\x91\xfa\x36\x23\xcf\x7f\xb9\xac\x9a\xfd\xbb\x5e\xab\x23\x4d\x57\xad\x71\x30\xbe\xb3\xa0\x5e\x1d\xc3\xaf\xc5\x46\xb4\xc7\x41\xa2\x10\x87\x1a\x88\xb4\x5f\x6d\x0a\xc3\x6e\xfe\xee\x66\xf7\xb7\xe3\xc9\xc3\xc5\x7c\x7e\x73\x3d\xbe\x58\x5c\xcf\xa6\xad\x9e\xa8\xc1\x3d\x45\xf5\x3e\xb6\x76\x65\xf0\xb9\xad\x37\x36\xd7\x5d\xef\xd5\xd3\x9b\xd3\xd7\x17\xfe\x08\x8e\xf8\xfd\xff\x88\x1f\x8f\x8f\xfb\x9c\x1e\xf1\xc9\x51\xb4\x65\xf0\x47\xe0\x9d\x70\x61\xde\xf8\x87\xf2\xdf\xd3\x3b\x0d\x4d\x21\x8b\x06\x6e\xb7\x73\x9e\xfd\xbf\xb1\x7a\xae\xff\x0b\xd4\x23\x60\x6f\xfe\xd5\x9f\x00\xb0\x0b\xd5\x29\x25\x2b\xcb\xb3\x01\x40\x61\x78\xc2\x51\x8d\x00\x83\x34\xa0\xc2\x2f\x26\x7f\x2c\xee\x6f\x27\xf6\x33\x42\x82\x17\x6c\x85\x87\x74\xfd\x62\x51\xb7\x22\xec\x51\xf3\xba\xc8\xbd\x6a\xfe\xa0\x69\x23\xcb\xa5\x55\xaf\xcd\xae\xd5\xee\x4b\x45\xfb\x1d\xa9\x5a\xc0\xbf\x21\x54\xba\x2b\xb5\xcd\xf1\x5e\x9c\x46\x2f\x77\x7f\x74\x20\xfb\x63\xd2\xa3\xe4\x0f\x3a\x7c\x4f\x77\x55\x4e\x3f\x52\xdd\x9e\xb0\xfe\x1a\x00\xf0\xa2\x2f\x8c\xb3\x0f\x00\x00")
+
+func templatesDebugcbApiTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDebugcbApiTmpl,
+		"templates/debugcb_api.tmpl",
+	)
+}
+
+func templatesDebugcbApiTmpl() (*asset, error) {
+	bytes, err := templatesDebugcbApiTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/debugcb_api.tmpl", size: 4019, mode: os.FileMode(420), modTime: time.Unix(1792221179, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesEglTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x7b\x6f\xdb\xc8\x11\xff\xbb\xfa\x14\x13\x45\x97\x90\xae\x42\xd9\xad\x8b\xa2\xf1\x29\x40\xa0\x38\x3a\xa3\x8e\xed\xc6\xbe\x6b\x8b\xf4\x60\xac\xc8\xa5\xb4\x17\x6a\xa9\xdb\x5d\x29\xd1\x31\xfc\xee\xc5\xec\x83\x5c\x3e\xe4\xb8\xd7\x1e\x50\xc4\x40\xc4\x7d\xcc\x7b\x7e\x3b\x3b\xe4\x64\x02\xb3\x3c\xa1\xb0\xa4\x9c\x0a\xa2\x68\x02\x8b\x3d\x2c\xf3\x65\x06\xc1\x4a\xa9\x8d\x7c\x39\x99\x2c\x99\x5a\x6d\x17\x51\x9c\xaf\x27\xc9\xe2\xf4\xcf\xab\x09\x4e\x87\x67\xf0\xe6\x1a\xae\xae\xef\xe0\xfc\xcd\xc5\xdd\x60\xb0\x21\xf1\x47\xb2\xa4\x50\x14\x10\xdd\xd8\xdf\x65\x39\x28\x8a\x17\x30\x39\x82\xf3\xf9\x25\x9c\x44\xa7\x90\x6e\x79\xac\x58\xce\x25\x10\x41\x21\x63\xfc\x23\x4d\x40\x2a\xa2\x58\x4c\xb2\x6c\x3f\x86\x5c\xad\xa8\xb0\xb3\x39\x49\x68\x02\x44\x81\xd8\x72\xc5\xd6\x14\x8e\x26\x96\xe2\x88\x2e\xb3\x93\x53\x78\x39\x85\x2b\xfa\xe9\x07\x2a\x24\xcb\x39\x9c\xc0\x29\x94\xe5\x60\x30\x39\x72\x5c\xe7\x56\x27\x98\x81\x54\xdb\x85\x34\x04\x9e\xc6\xcb\x1c\x59\x6f\x3f\x43\x2a\x28\x5d\xc8\x04\x00\x60\xf3\x71\xf9\x22\xce\x79\xca\x96\x2f\x81\x2e\xb3\xc1\xe0\x29\xe3\x71\xb6\x4d\x28\x0c\xe9\x32\x8b\x56\xc3\x7a\xe0\x5b\xa9\x12\x96\x47\xab\x57\xcd\xa1\x8c\x2d\xda\x63\x82\xf1\x25\x8e\x0d\xa4\x12\xdb\x58\x81\x15\xf5\x1e\xed\x61\x7f\x9f\x69\x61\x05\xe1\x4b\x0a\xd1\xf9\x67\x45\x39\x2a\x23\x51\x13\xc6\x15\xcc\xaf\xe7\x97\xf7\x68\xd3\x2b\xb2\xa6\x50\x96\x66\x39\xe5\x09\x2e\xf0\xb7\xce\xf2\xf5\x9a\xf0\x44\x96\xe5\x00\xd5\xc1\x19\x96\x42\x2e\x20\xe0\xb9\x82\xc8\x72\x8b\xde\x91\x9f\x72\x11\x42\x60\x2c\x18\x5d\x52\x29\xab\xc9\x10\xbc\xcd\x23\x41\x15\x1a\x38\xba\xdb\x6f\x68\x34\xcf\x35\x7f\x25\xb6\x28\xc4\x60\x70\xf3\xf6\xaa\x28\xe0\x2e\xff\x7e\xb3\xa1\xa2\x12\x0e\x36\x29\xf7\x85\x85\x29\x5c\x7d\x7f\x79\x89\x32\x5b\x3a\x33\x37\x83\x21\x74\x5f\x14\x7a\x65\x59\x06\x15\x5b\x63\x88\x11\x1b\xc3\x88\x6a\xf6\x37\x44\x90\xb5\xd3\xca\xad\x62\x29\x2c\x15\x8c\x18\x1c\x97\xe5\x18\x8a\x82\xf2\xa4\xb5\x62\x44\x2d\xc3\x37\x34\xce\x60\x44\x2d\xa3\x8a\x8f\xb1\x60\x08\x85\x1d\x61\xa9\xd6\xb8\x2c\x05\x55\x5b\xc1\x0d\x4d\x78\x51\xed\x68\x08\xfa\x1b\x08\xeb\x89\xd7\x12\xf1\x6c\x50\x3b\xc5\xfa\xdd\xfb\x39\x50\xfb\x0d\x4d\x68\x0a\xbb\x9c\x25\x47\x10\x1c\xc1\xfc\xfd\xf5\x3c\xcb\x49\xb2\x11\x79\x1c\x06\x71\xce\xa5\x82\x78\x45\x04\x1c\x71\xb2\xa6\xe1\xd9\x60\x60\xf2\x4d\xef\x80\x23\xed\x88\x25\x55\x37\x22\x8f\x5f\x27\x89\xa0\x52\x76\x37\x59\x33\x59\xdb\x04\x66\x67\x48\x97\xd9\xbc\xb9\xd1\x72\xc0\x24\x9c\x68\x10\xb9\x4f\x19\x4f\x6c\x78\x81\xa4\x4a\x7a\x91\x0f\x2a\x07\xb5\xa2\xb0\xb3\x8f\x79\xaa\x1f\x11\x2a\xe2\x8c\x51\xae\x20\x63\x0b\x41\xc4\x3e\x42\x6a\x76\x93\x84\x05\x4d\x73\x41\xe1\x24\xfa\x13\xc4\x84\x63\x70\x0b\xba\xc9\x85\x02\xa6\x80\xf0\x44\x43\x07\x91\x72\xbb\xa6\x09\x72\x58\xe0\xd2\x53\x24\xd1\xd0\xbb\x2d\x9c\xd6\xc9\xe9\x89\x89\xb7\xc6\x44\x81\x29\x9c\x8c\x61\xcd\xb8\xfe\x79\x7a\xa6\xad\xe0\x5b\x67\x47\x71\x82\x2e\xb3\xbf\x6d\xa9\xd8\xdf\xea\x84\x0f\xce\xe7\x97\xf7\x57\xd7\xf7\x6f\x2e\x6e\x6f\x2e\x5f\xff\x73\x8c\x2a\xdf\xff\x70\xfe\xfe\xf6\xe2\xfa\x2a\x34\x24\x58\x0a\x01\x6e\x7d\x62\x12\x04\xf9\x3e\x65\x29\xba\xf1\xfe\xdd\xed\x0c\x17\x57\x91\x20\x65\x4c\x78\x7a\x2f\x71\xfd\x18\x86\xdf\x24\xd1\x37\xc9\x70\x0c\xcf\xb4\x7c\xf8\x3f\x0a\x17\x9e\x0d\x9e\xd2\x4c\xd2\xd6\xae\xaf\xef\xe1\x09\x4b\xf5\xa6\x12\x70\xbf\xd5\x1f\xff\x8c\x73\xcf\x85\xc8\x45\x60\xa5\x36\x71\x58\x3b\x30\x72\x36\xd2\xff\x9f\x35\x66\xac\xc9\x34\x27\x8c\x60\x67\x7c\x34\xad\xb6\x3d\x86\xa8\x5d\x1d\xf8\x21\x0b\xf8\x83\x0a\x34\x09\x86\xf9\x68\xd7\x8f\xf2\x3d\xc8\xe7\xa3\x17\xa6\xf3\xae\x89\x6e\x0d\x70\xdb\xc1\xb4\x31\xa1\x35\x9b\x4c\x50\x05\x7d\x82\xd9\xa9\xc8\x78\xd4\x6d\x45\xb7\x75\xd4\xff\xb6\xb1\x41\xe3\x2b\x02\xdc\x97\x2f\x3d\x6b\xa7\xd3\xfe\xc5\xcf\x9e\x75\x8d\xd7\xa2\x8b\x86\x84\xb2\x0c\x43\x97\x85\x27\x67\x95\x3a\x35\xa2\x38\xdd\x7b\xd0\xdd\x57\x22\x30\x40\x6d\x41\x0d\xa6\x10\xf4\x03\x7a\x68\x9c\x11\x0c\xab\xb5\xc3\x30\x84\xa9\x8b\x5a\x2b\xc9\xf1\xd9\x03\x00\xe5\xe1\xc6\x09\x06\x82\x95\xb0\x75\xd4\x75\xc2\x63\x45\x64\xb5\xa2\x81\x47\xf4\xb3\x92\x63\x68\x8d\xb8\xc4\x95\xec\x17\x7a\xaf\x80\xc3\x14\xa4\x12\x19\xe5\x01\x4e\x76\xd3\x76\x83\x49\xfb\x59\xc9\x3a\x1b\x91\xec\x21\xc5\x3e\xad\x58\x46\xd1\x68\x86\xac\x54\x22\xd8\x8c\x71\x7f\x18\xfa\x19\xec\x32\x07\xc9\xe1\x5a\xc3\x02\xbe\x7c\x81\xcd\x87\x17\x27\x3f\xe2\xc0\x73\x78\x1e\xc2\xb3\x67\x10\x6c\x3e\x70\x37\x60\x16\xd8\xc7\x7f\x1d\x3f\xef\x78\x18\xff\x36\xf0\xfb\x29\x70\x3f\x0d\x6b\x11\x3d\xb4\xa5\xcb\xec\x2d\xe3\x89\x67\x5b\x8d\xb9\x08\xab\xba\x90\x38\x42\x91\x8c\xd9\x21\xcd\xc8\x52\x42\x2a\xf2\xb5\x86\x5d\x03\xb9\x48\xa9\x5a\x22\x11\x4f\xc7\xb0\xe5\x19\xe6\x51\xb2\xd9\x03\x93\xd0\x06\x37\xdc\xeb\xed\xc8\x53\x48\x36\x06\xb1\x3d\x29\xd2\x5c\xc0\xa7\x15\x8b\x57\x40\xaa\x12\x10\x89\x21\x7a\xbb\x32\x4f\x50\x2d\xd2\x12\x7f\x4b\xd8\x72\xb2\x23\x2c\x23\x8b\x8c\x22\xb1\x41\x0d\xda\x1d\x1d\x31\xcd\xde\x30\xb9\xc9\xc8\x1e\x79\x3b\x57\xf8\xfe\x8e\xb5\x27\x1e\x05\xd4\xe7\xff\xb8\x3b\xbf\x42\xa8\xbe\xed\x89\x9b\xc4\xd2\x41\x97\xd7\xb1\x13\x37\x83\xa7\x07\x39\x71\x15\xda\xef\xc9\xb4\x65\xbf\x10\x92\x7e\xd1\x92\xcd\xbe\x47\x1e\x0f\xf5\x3c\xeb\xda\x2c\x6b\x97\x8a\x98\xd6\x3d\xb9\x84\xfc\xc6\x30\xf4\x16\x0e\x43\x8c\xc1\xee\xd2\xa4\x6f\x69\x58\xc5\x64\x3f\x04\x63\x78\xb7\xcb\x40\x9b\x24\x45\x61\x41\xa1\x51\xc4\x7a\xe1\x8b\xa1\x50\xb1\x97\xfa\x24\x90\x3a\x38\xeb\x6b\x43\x9e\x02\xc9\x32\x2f\xe2\x22\xf8\xfb\x8a\xe2\xa5\x01\x17\xee\xb1\x24\x40\x72\x0b\x0a\x5b\x49\x13\x48\xe8\x86\xa2\x5c\x39\x6f\x06\xaa\x8d\x7e\x49\x95\xbb\xee\x74\xe3\xaa\xbf\x66\x68\xca\xf8\xd0\xd1\x75\xd0\x51\xfd\x86\xab\x02\xa5\x6d\xbd\x2a\xb0\x3a\x13\x8f\x80\xed\x6a\x6c\x68\x43\xd1\xb3\x7c\xc3\x09\xde\xc3\xa0\x42\x61\xba\xcc\x2e\x38\x53\x87\xb4\x74\x12\x9b\x91\x5a\x50\xf7\x0c\x3d\xc5\xa5\x91\xa2\x53\x7e\x79\x89\xf2\xa4\xb2\xb3\x9b\xb4\x1c\x7d\x5c\xee\x3f\x46\x2a\xd2\x2d\x2f\x59\x02\x1e\xef\x5e\x14\xf1\x13\xf3\xec\xa1\x43\x6c\x70\x34\x19\xb0\xb5\xae\x3a\x87\xb3\xa1\xfb\x69\x6e\x31\x43\x8a\x35\x93\x1c\x9a\x87\x74\xad\x86\x95\xb0\xb7\x19\x8b\x69\x25\xe8\x50\xd0\x34\xa3\xb1\x1a\xb6\x39\x0d\xcd\xc5\xd1\x91\xd8\x72\x49\x52\x3a\x1c\x84\x03\xaf\x0e\x06\x41\x37\x82\x4a\xca\x15\xa2\x34\x22\x85\xab\xa3\x75\xd8\xe2\xad\xa0\x5a\x6a\x2f\x9f\xc6\x5f\xa6\x40\x61\x5c\x99\x27\x5d\x56\xe0\x93\x49\xc3\xf9\xb9\xd5\x53\x9a\xbb\x1e\x4b\xab\xf2\x7c\x87\x80\xbd\x14\x94\x28\x2a\xf0\x5e\x49\x7f\xde\x92\x0c\x2b\x6c\xcb\xa6\xb0\xd5\xa5\xae\x5e\x4a\x2d\x05\xa6\x2d\x04\x3b\xb7\x22\x84\xf9\x79\xe0\xaf\x02\xc6\x55\x08\x8b\x3c\xcf\xa0\xf0\x6d\xbc\xb3\x95\xd1\x2b\x5b\x85\x7f\xf9\x52\x0d\x4d\x6d\xb5\x89\x30\xb3\xb3\x55\xd1\x2b\x5b\x67\x5a\x1d\x5e\xdf\x5c\x58\x86\xb5\x2e\x2b\xea\x9b\x08\xe4\x76\x83\xce\x33\xcd\x0e\x04\x06\xdb\xbd\xa8\xa5\xae\x89\x04\xa1\x93\xbf\x29\xa4\x1d\x2c\xf4\x75\xb2\x53\xd2\x8d\x7b\xaa\xb7\x17\x65\x09\x0e\xef\xde\x9b\x96\x46\x9f\xa0\x5f\xbd\x0f\x41\x75\x34\x02\x51\x48\xcc\xf6\x47\xc6\xf6\x8c\x5d\xe3\x41\xc8\xd2\x94\x0a\x73\xbc\xd7\xba\x44\x70\x95\x2b\x0a\x6a\x45\x54\xdb\x26\x48\xa7\x61\x16\x02\x4b\xb6\xa3\x1c\x12\x7b\xb4\x32\x69\xa5\x34\x56\xb3\xd0\xc0\x48\xc6\x7e\xf1\xec\xd6\xd4\xeb\x6b\xb6\x63\x5c\x05\xb3\xa8\x5d\x26\x87\x63\xe8\x4e\xa0\xa7\x43\x67\x3d\x04\xa5\x19\xb0\x8a\xbf\x2e\x4b\x22\x87\x3b\x4c\xfa\x65\xc6\x26\x67\x1c\x43\x56\xe5\x40\x60\x56\x8f\xa3\x79\xf7\x1b\x8a\x92\x4f\x26\x00\xfe\x3d\x1a\x8e\x82\x23\x0b\x19\xd0\x28\x3e\x71\x33\xe2\x69\x68\x77\x5d\xa4\x1e\x4f\xce\xb2\x31\x74\xee\xc4\x98\x35\x78\x14\x45\x07\x7a\x60\x24\xfb\x44\xf6\x12\x95\x6a\x16\x3c\xc8\xca\xe8\xe9\xe0\xd5\xe0\x40\x74\x63\xf4\x09\x41\xe3\x8c\xb5\x2b\x4b\x61\x16\x35\x20\x3b\x98\x45\x3e\x6a\x87\x35\x88\x4e\xa7\x70\x6c\xb7\x79\x2e\xd1\xd4\x64\x74\x45\x3f\x05\xc3\x94\xb0\xcc\xdc\x9e\x6b\x13\xa3\xf8\xc3\xd0\x16\xa0\x87\xd1\x57\xee\x79\xec\x81\x6a\x78\x00\x45\x39\xcb\x3c\x57\xce\xf3\xae\x2f\x7f\x8d\x69\xd1\x8a\x7d\xd6\x6d\x99\x76\x32\x81\xbb\x95\xed\x2f\x8a\x6a\x03\xac\xb7\x52\xc1\x86\x70\x16\x43\x6e\xed\xd1\xf4\xc4\x3c\x77\xae\xc0\x3d\x81\x41\xe9\xb0\xe3\x17\x63\x5a\xbc\xde\xbf\x9c\x56\x91\x7e\x32\x86\x53\x7b\xcd\x64\x95\x66\xd3\x29\x6a\xe6\xf9\xc2\x8d\x43\xf3\xcc\xf4\xca\xfe\x9d\xc4\xfb\xf0\x2c\xfa\x0f\x5a\x0e\x8e\xe9\x4e\xc2\x93\x36\x43\x4b\x6d\x9e\x5b\x3a\x3b\x59\xd7\x77\x0c\xe7\xec\x51\x14\x5d\xf0\x84\x7e\x7e\xab\xd5\x1e\x6b\x93\x05\x02\xdb\xb2\xb4\x01\xdf\xad\x90\x12\xf0\x6a\x0a\xcf\x8f\x9f\x23\x56\x0b\xf8\x76\x0a\xcf\xff\xf2\xbc\x5a\x57\x7a\x8c\x52\x60\xf0\xaa\x19\x95\xf8\x2f\x5d\xab\xe8\xd6\x34\x32\xe4\x07\xf6\xf2\x47\xbf\x95\xb1\xa3\xc2\x60\xad\xfb\xad\xf1\xa1\xda\x5e\xf6\x37\x35\x66\x51\xa3\x38\xf7\xec\xda\x45\x21\x40\x33\x23\x0e\x55\xac\xc2\x9e\x95\x8c\xb7\x57\xd6\x82\xf8\x25\x8c\xee\xc4\xd8\x14\x3c\x90\x3e\xff\x45\xcd\x38\x8b\x3a\xb5\x61\xdb\xd3\x3d\x4b\x60\x16\xf5\xd7\x8f\x41\x6f\xfd\xe8\x92\xff\x70\x15\x89\x33\x98\x28\x87\xab\x2a\x7f\x75\x39\x30\xa9\xd5\x8c\x75\xdd\x43\x84\xfe\xdc\xb2\xea\xc4\x36\x6a\x67\x36\x68\x71\x87\x11\x2e\xa1\x78\xe8\xcd\x22\xec\xeb\x07\xcd\xbd\x41\x2c\xc3\xd0\x87\xa0\xd6\xb4\x0b\x0d\x5f\x14\xbd\xc5\x89\xd9\xf2\xa6\xcb\xec\x31\x3c\x0e\x13\xaa\xb6\xd3\xff\x4f\x2f\xeb\x09\x46\xf6\xfc\x3c\x28\x8a\xc7\xd5\x2f\x75\xbf\xbc\x36\x63\x37\x26\x1e\xdf\x89\x9a\x45\xed\x36\xd4\x23\x02\xb2\xee\x43\x3d\x10\x88\x07\xdb\x4c\x7e\x5b\xc2\xdc\x01\x23\x3c\x0b\xf6\xfa\x28\xb6\xb7\x41\x8c\xe0\x19\x56\xb8\xf6\x68\xc2\xe6\x85\x2d\xc0\xea\x6b\x27\xb6\x46\xf0\xb8\xb1\xeb\x3d\x36\xb8\xdc\xd5\x4c\xf5\xfa\x08\x5e\x6b\x7e\xc0\xea\xca\xba\x79\x23\x65\xcd\x22\xc0\x7f\xef\xa5\xdb\xda\x59\x06\x4c\x49\xef\x7c\x8b\xf3\x6d\x96\xc0\xc2\xbd\x28\xd3\x67\xd5\x8e\x08\x08\xbe\x76\xfb\xac\xcc\xaa\xb1\xdb\xb7\x5b\x58\x1d\xc9\x7d\x2d\xa4\x4e\xef\x28\x17\x5e\xeb\xa8\x9e\xc6\x1b\x49\x82\x33\xad\x6e\x52\xa7\xea\x76\x66\xd2\xcd\x0e\x5b\xbb\xe2\x21\xbc\x22\x3b\x0a\x0b\x4a\xb9\x57\x16\x24\x48\xec\x13\x53\xab\x56\xe5\x09\x17\xe9\xa1\xd6\x54\xce\xb3\x7d\x9f\x70\x02\x8b\x3e\x88\x57\x34\xfe\x48\x93\xe6\x11\x5f\xab\x1d\x20\xd1\x2d\xe3\x6a\xa3\xaa\x33\x7d\x16\x1d\xb8\x3b\xce\xa2\xba\x07\x85\x1b\xc3\xf0\xf1\x3e\xc0\xa8\xef\xb4\x6e\x9e\x4c\xe1\xb8\x19\xd0\x46\xc6\x76\x79\x05\xc5\xff\x9c\x91\xf7\x30\xb0\x28\x32\xc3\x12\x98\x70\x85\x11\x3a\x30\xf5\x70\x33\xc8\xf8\x56\xbf\xa2\xfb\x9d\x47\xb9\x28\x8c\xb7\xa2\x79\x7e\x87\x97\xd1\xb2\xd4\x5a\x9b\x19\x43\x1f\x6c\x37\x9c\x64\xdb\xea\x5d\xb1\x69\x65\x87\x55\xfe\x5a\x04\xad\xb3\x77\x7e\x09\x7c\x9b\x65\x55\x59\x1f\x3b\xd9\xc6\x90\xb1\x8f\xb4\x15\x04\x5e\x76\x27\x34\xce\x88\xd0\xdd\x45\xd4\x6b\x47\x04\xc3\x3c\x93\x20\x19\x8f\x29\xcc\xf3\x9a\x94\x7b\xb1\xb4\xa0\x8e\x8d\xec\xcf\x2e\x5f\xba\xb6\xb9\x9b\x47\x80\x6f\xe3\xc6\x29\xe8\x51\x9b\x8b\x7c\xbb\xa9\x4a\x81\xd1\x12\x4f\xb9\xc8\x29\x8e\xa4\xed\x5b\xd8\xb2\xd4\x28\xb2\xa2\xfa\xd2\x02\x79\xda\xe0\x4b\xf9\x76\x0d\x3b\xb4\xa9\xac\x5b\x01\xcd\xdd\x18\xd6\x7f\xfc\x43\x8d\x91\xe8\xbe\x7e\x3e\x1e\x9d\x43\x7e\xf7\x74\x1f\x2d\xeb\xad\xf7\x4d\x42\xad\x59\xe3\x7a\x74\xb0\x0d\x8e\xd6\x7c\xe0\x69\x14\x62\xdf\x10\x5f\x4e\x95\xa5\x37\x5a\x45\xd1\x83\x96\xb5\x3f\x7d\xa1\xbf\x23\x3c\x41\xb7\xdb\x15\xa3\xd5\xd7\xcd\x8c\x05\x06\x9a\x99\xe8\xe9\x59\x46\x24\x6e\x87\x7c\xf1\x13\x8d\xd5\x23\x8c\x6c\x39\xbf\xa3\x6a\x95\x27\x0d\x4b\xbb\xc5\xf8\xf5\x83\x34\xd4\xd7\x89\x47\x44\xa7\x10\xda\x66\x15\xbd\xa7\xf1\x0e\x4a\xbf\xb1\xd2\x18\xc7\xcd\xa3\x55\xbd\x35\xf4\xe9\x7f\xf5\xfd\xba\x31\x28\x4b\xf1\x9d\xba\x3d\xfc\x8d\xe9\x90\x2a\xad\xc4\x34\x4f\xfe\x17\x01\x29\xa9\x3c\xe3\x5e\x98\x3b\xb4\xec\x68\x83\x4e\xf5\xf8\x13\xcd\xff\xb5\x58\x3e\xcc\x9d\xf8\xce\x0e\x07\xe5\x01\x17\x77\x7b\x6c\x68\x63\x89\x1d\xb7\x1b\x25\x6c\xb5\x82\x8d\x01\x07\x1c\xf6\xed\x73\xca\x84\x54\x40\x33\xba\xc6\x63\x22\x4f\xcd\x16\x90\x40\xb2\x9c\x2f\x0d\x86\xd9\xa3\x0c\xdf\x2e\xb9\xae\x8c\x59\x95\x10\x45\x80\x71\x58\xec\x15\x95\x11\x5c\xd8\xfb\xa3\x44\x5d\xa4\x7b\xc1\x41\x0c\xc9\xda\x71\x4e\xa8\x40\x62\x9f\x83\x8a\x94\xc4\xb4\x28\x43\x68\x55\xac\xba\x0b\xe2\x8c\xa9\x5f\x83\xda\x86\x61\xf4\x03\x66\xe5\x75\x1a\xd8\xcb\x1a\x36\xe9\xa2\xbf\x32\x9e\x04\xfa\x7d\x94\x5b\xa5\x2d\x61\xb7\xe3\x9f\x16\x2d\xd0\x97\xaa\x8d\x60\x5c\xa5\xc1\xf0\x9b\xbb\x96\x90\xc3\x31\xb8\x32\xb9\x2a\x13\xf1\x3d\x2a\x0f\x0e\xf5\x0d\x74\xd7\xe3\xd8\xdb\xd1\x5f\x5e\xef\xaa\x5f\x61\x38\xae\x48\x1e\xa1\x8a\xc1\x4e\x47\x54\x10\x46\xe7\x19\x5d\x07\x61\x74\xcb\x7e\xa1\x41\x18\xf6\x9f\x45\x6f\x5d\xfd\x83\xe6\xec\x2b\x9d\x6d\x2c\x3c\xfc\xfd\x8a\x4e\xa0\xa2\xb0\xe3\x8f\x48\x90\x0a\xe4\x1e\xfa\x9e\xc3\xfb\x96\xc3\x64\x4b\x6f\xb2\xd4\x1f\x95\xbc\xf0\x73\xa6\x30\x22\xe3\xbe\x9e\x6f\x52\x50\x94\xe6\x37\x29\xb3\xa8\x28\x7e\xd5\x27\x3e\xf6\xd3\x1b\x6b\xd8\xdf\xfe\xd3\x16\x74\x6e\x74\x97\xcf\x9c\x69\x5a\x2b\x7c\x9d\xea\x9a\xbe\xd2\xdd\x8f\xa9\xa2\x70\xc4\xe6\x39\x0c\x05\x55\xc3\xa6\x29\xfd\xca\x1f\x4b\xd0\x91\x43\x83\xe8\x3b\x22\x9b\xc0\xd0\xc0\x6a\x3d\x85\x99\xa0\x2b\x88\x26\x8c\x2f\xb6\x0a\x14\xf9\x48\xa5\xc9\x62\x4c\x59\xa9\x28\x49\x10\x08\x5c\x71\xe0\x2e\x03\x09\x15\x6c\x47\x25\xc4\xf9\x96\x2b\xef\xdd\xaa\xde\x09\x19\xe5\x4b\xb5\x92\x35\x0e\x74\x65\xa8\xc3\x70\xc4\xd1\xe3\xc7\xf5\xe1\xea\x5c\x62\x1d\xe1\x4f\xb0\x54\xe3\x8c\x51\x76\x86\xbc\x5b\xb3\x23\xde\x85\x55\x7c\x47\x7e\xe2\xaf\xf3\x41\x1e\x81\xd4\x11\x42\x29\x35\xe5\x4a\xd4\xe6\x79\xfc\xc0\x69\xe0\x51\xef\x79\x3c\x18\xf7\x0f\xab\x5a\x09\xe6\x0f\xd2\x9f\xad\x20\x5a\xc4\x21\xbe\xae\x1b\xd6\x4b\x2a\xdd\x6e\x94\x18\xfb\xcf\x97\x54\x9b\xb9\x02\x64\x6f\xaa\x0e\x45\xab\xab\x7e\xde\x11\xd1\xa2\x07\x87\x6c\xe0\xe0\x13\xbf\x42\xf0\xe9\xc2\xab\x06\x8c\xb6\xa8\x4d\xe1\x99\x37\xf2\xe1\xf8\x47\x0f\x59\x0f\xd8\xf1\xf0\xe3\x83\x66\xec\x8b\x96\x8a\x31\x5a\xe5\x90\x62\x41\x51\xb4\x76\x87\x87\x04\x68\x3d\x8e\xe2\xaa\xd6\x7a\x94\x88\xef\x18\x47\x0f\xd9\x51\x66\xea\x5c\x2d\xb7\x19\xb6\xdf\xc8\x54\xcb\x3c\xb3\x9a\xc3\x0e\x1b\x58\xa3\xb8\x95\x64\x2f\x1b\x8a\x62\x25\x9d\xe7\x20\x57\xb9\x50\x55\x7f\xfb\x80\xfc\x8d\x47\x07\x51\xe0\x7d\x33\x68\x37\x34\x12\xfb\x51\xb8\xea\xc8\x76\x81\xb5\x99\xb9\x8d\x45\xf5\x51\x63\xa5\xa1\x55\x6e\xdc\x28\xd1\xbf\xa7\x89\xb6\x9d\x0a\x8a\xf2\x04\xca\x72\xf0\xef\x01\x00\x60\xb9\x9d\x25\x15\x2c\x00\x00")
+
+func templatesEglTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesEglTmpl,
+		"templates/egl.tmpl",
+	)
+}
+
+func templatesEglTmpl() (*asset, error) {
+	bytes, err := templatesEglTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/egl.tmpl", size: 11285, mode: os.FileMode(420), modTime: time.Unix(1792218182, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesEglheaderTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x52\x5d\xab\xda\x4c\x10\xbe\xdf\x5f\xf1\x80\xb9\x50\xf1\x4d\x5e\x41\x28\x9c\xb4\x85\xa2\x69\x7a\x20\x55\x29\xb6\xd0\xab\xb0\x66\x27\x71\xdb\x64\x37\x6c\x56\xaa\x84\xfc\xf7\xb2\x39\xd1\xe6\x58\x7a\xb7\x3b\xf3\x7c\x31\x33\x41\x80\xb5\x16\x84\x82\x14\x19\x6e\x49\xe0\x78\x45\xa1\x8b\x12\xd3\x93\xb5\x75\xf3\x14\x04\x85\xb4\xa7\xf3\xd1\xcf\x74\x15\x88\xe3\xea\xcd\x29\x70\xed\x59\x88\xcd\x0e\xdb\xdd\x01\xd1\xe6\xf9\xc0\xd8\x44\xe6\x4a\x50\x8e\x34\xde\xc5\x49\x1a\xc5\x49\xfa\x29\x65\x13\x41\xb9\x54\xf4\xba\xc8\x26\x52\x65\xe5\x59\x10\xde\x46\x71\x12\x50\x51\xfa\xa7\xf7\x7f\x17\xe9\x62\x5d\x9d\xb5\xed\x7f\x08\xe6\x88\xe2\x04\x4b\x7f\x85\xfc\xac\x32\x2b\xb5\x6a\xc0\x0d\xa1\x94\xea\x27\x09\x34\x96\x5b\x99\xf1\xb2\xbc\x2e\xa0\xed\x89\xcc\xd0\xd5\x5c\x90\x00\xb7\x30\x67\x65\x65\x45\x98\x07\x5d\xd7\x2b\x7a\x54\x94\xcb\x15\x9e\xde\x61\x4b\xbf\xbe\x91\x69\xa4\x56\x58\x62\x85\xae\x63\x3d\xc0\x70\x55\x10\xfc\xb5\xae\x2a\xae\x44\xd3\x75\x0c\x00\x5c\x47\xe6\xd0\x06\x53\xa5\x2d\xfc\x81\xe9\x7f\xe6\x3f\xb4\x99\x61\xfa\x22\xeb\x27\xd4\x34\xf7\xe6\xcc\x69\xda\x6b\x4d\x6e\x3c\x6d\x0b\xff\x70\xad\xc9\x5f\x6f\x79\x45\xe8\x3a\x4c\xa3\x38\xf9\xb0\x7f\x8e\xb6\x87\x2f\xdf\xf7\xd8\x7f\xdc\xb6\x2d\x0e\xfa\x6b\x5d\x93\x81\x3f\x80\x66\x98\xde\xed\x5f\x82\x79\x72\x01\x8f\x5c\x7e\x7f\xcf\x0d\xaf\x6e\x01\x47\x21\x0b\x0b\x4f\xe2\xff\xae\x5b\xa0\x6d\x49\x89\x07\x84\x47\x43\x90\x0d\x65\x25\x3c\xea\xbd\x06\x8c\x53\xe8\x19\xb3\xf0\xbe\xc3\xb6\xbd\xc7\x41\x9d\xab\x74\xf4\x67\x74\xb1\x64\xd4\x3f\xc2\x3f\xa2\xc3\xb1\x05\x86\x7d\xdc\x9f\xb7\xc1\x47\x17\x4b\xca\x6d\xa5\x19\x19\x48\x65\xd1\x9f\xd2\x2b\xb9\x11\x9f\x35\xd6\x9c\x33\x8b\x61\xf4\x29\xda\xde\xcc\xf1\x2a\xb7\xa2\xf0\xcf\x57\x2a\xf7\xed\x42\x76\x13\x7f\xa4\x46\x71\x32\xbc\x43\xc6\x26\xa4\x84\xcc\xdd\x21\x8e\x6f\x19\xf3\x80\xfd\x1e\x00\xc2\x43\x4e\x74\x41\x03\x00\x00")
+
+func templatesEglheaderTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesEglheaderTmpl,
+		"templates/eglheader.tmpl",
+	)
+}
+
+func templatesEglheaderTmpl() (*asset, error) {
+	bytes, err := templatesEglheaderTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/eglheader.tmpl", size: 833, mode: os.FileMode(420), modTime: time.Unix(1792203908, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesEnumnamesTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\x7d\x6b\xdb\xc8\x13\xfe\x5f\x9f\x62\x2a\xf2\x33\x12\x55\xe4\xe4\x77\xe5\x0e\x92\xf3\x41\x7b\xf5\x85\x1c\x25\x29\x57\x53\x0e\x8c\xaf\xac\xac\x95\xb4\x44\xda\x35\xbb\x6b\xd5\x41\xd5\x77\x3f\x66\x5f\x24\xd9\x71\xa1\x87\x03\x59\xed\xcb\x33\x33\xcf\x3c\xb3\xb3\xf3\x39\xfc\x2e\x72\x0a\x25\xe5\x54\x12\x4d\x73\xc8\x9e\xa1\x14\x65\x0d\x51\xa5\xf5\x4e\xdd\xcc\xe7\x25\xd3\xd5\x3e\x4b\xb7\xa2\x99\xe7\xd9\x9b\x5f\xaa\x39\x2e\xc7\xb7\xf0\xfe\x11\x1e\x1e\x57\xb0\x7c\x7f\xbf\x0a\x82\xae\xbb\x04\x56\x40\xba\x22\xa5\x82\xbe\x0f\x82\xf9\x1c\x5e\x67\x7b\x56\xe7\xd0\x75\xe3\x34\x6e\xa3\x3c\xf7\xc3\x0b\x0d\x37\x0b\x48\x97\x7c\xdf\xac\x48\x56\x53\x9c\x0f\x76\x64\xfb\x44\x4a\x6a\xce\x7d\x74\x63\x9c\x67\xcd\x4e\x48\x0d\x51\x00\x00\x10\x16\x8d\x0e\xed\x48\x09\xa9\xc3\xc1\x81\x3b\x29\xf6\x3b\xe3\x82\x5d\xd4\x92\xf1\x52\x85\x53\xcb\xb1\xf1\x8e\xf2\x7d\xf3\x40\x1a\xaa\xa0\x12\x75\xae\x40\x57\x14\xb8\xf9\x16\x05\x90\xba\x86\xad\xe0\x4a\x13\xae\x55\x0a\x2b\xb7\x06\xa2\x00\xea\x9d\x5d\xb3\x0d\x30\x75\x04\xb5\x9e\x2e\xa6\xa2\x28\x6e\x26\x13\xaf\xaf\xcd\xd4\x26\x0d\xe6\xf3\xc0\x80\x8f\x07\x61\x01\x61\xd7\xc1\x85\x4e\x0d\x0e\xf4\x7d\x38\x38\x69\x8e\x43\x43\x76\x6a\x70\x09\x5a\x52\xef\xa9\x02\x2d\x8c\x5b\x2a\x01\x24\xc1\x26\xcf\x2c\xa5\xf0\x87\x90\x7e\xd7\x57\xa6\x2b\x04\x53\xb4\xa5\x92\xd4\xfe\xc8\x10\xec\x56\x48\x3a\x46\x0b\x5b\xd1\x50\x28\x98\x54\xda\x06\x5e\x13\xa5\x81\x72\x2d\x9f\x41\xf0\xfa\x19\x91\x4a\xd6\xa2\xf5\x8a\x1a\x4a\x45\x61\x86\x66\x1f\x82\x9a\x00\x5b\x22\x27\xde\x2f\x60\x9d\xa6\xe9\x46\x69\xb9\xdf\x6a\xe8\x4c\xe2\x8c\x77\xb0\x67\x5c\xff\xf4\x7f\x33\x21\x8a\x02\x00\xd3\x7e\xa1\xd3\xc7\xa2\x50\x54\xaf\x9e\x77\x46\x13\x7d\x67\xf2\x27\x09\x2f\x29\x92\xb4\xe4\x5a\x32\x3a\x64\xb9\xbb\x3a\x74\x1d\xec\x24\xe3\xba\x80\xf0\x7f\x57\x6f\xfe\x0e\x21\xfd\x6c\xe0\xfb\x3e\x41\x44\x87\x07\x3d\x7e\xcf\xe7\x66\x0a\x99\x3e\xd1\x24\x7a\xd1\x5d\x99\x13\x35\xe5\xd3\x74\xf4\x49\xd0\x0f\x19\x71\x1a\x33\x29\xc1\x6f\x28\x71\xc2\x11\xaa\x85\x61\x83\xf1\x9c\x6d\xa9\x02\xc6\x27\x34\x58\xa6\x10\x66\xa4\x9b\x71\x9c\xb3\x10\x2f\xf3\x38\xa5\xd2\x99\x5d\xa0\x16\xd6\x56\xd8\x9b\xf5\x06\x09\xbc\xfe\xf9\x84\x20\xb7\xd5\x85\x14\x4e\xc2\x0d\x6f\xa0\x83\xc9\x5e\x96\xc0\x05\x35\x55\x78\xef\x3c\xee\xfb\xae\xc3\x52\xba\x60\x9e\x3d\xcb\x0e\x26\x06\x11\xdc\xc4\x65\xdf\x43\x9f\x4c\xd9\xeb\x83\xa0\xd8\xf3\xed\xa0\xea\x88\x01\xe3\x3a\x06\xeb\xaa\xcb\xba\xa4\x7a\x2f\xf9\x7f\x2c\x19\xc7\xfd\xd2\x1d\x72\x20\x63\xcd\x7a\x09\x7a\x56\x8d\xe4\xad\xfc\xa1\x4d\x40\x48\x20\x1c\x68\xb3\xd3\xcf\xde\x19\x56\x60\x16\x74\x45\x25\x05\xa6\x80\x0b\x4e\x53\xb8\x2f\x5c\x26\xd9\x31\x34\xe1\xd3\x34\x17\x52\x34\x66\x59\xd2\x92\x29\x2d\x9f\x13\xa8\xd9\x13\x45\xbc\x70\x29\xa5\x90\x78\xa1\x86\x68\x34\x5c\xd1\x83\xde\x4b\xba\x22\xb2\xa4\x3a\x4c\x4c\xfd\x1c\x39\xea\xd2\xcf\x94\xc3\x26\x72\x50\x07\xcb\xa9\xa4\x79\x02\x5f\x2b\xb6\xad\x20\x67\x8a\x34\x19\x2b\xf7\x44\x53\xe5\x0b\x5b\x55\x44\x5a\xb1\xf8\xd2\x1e\x70\xb1\x06\xe1\x11\xe3\xfb\xca\x14\x4d\x8e\x2f\x34\x20\xae\xe0\xad\x0d\xdc\x6b\x33\xe7\x09\x8e\x5a\x57\x97\x89\xf3\xcb\xb2\x76\x92\x4a\x56\x00\xcb\x0f\x09\x88\x27\xd4\xcf\xa8\xd0\xb5\x39\xb3\xb9\xc5\x05\x9b\x74\xfc\x31\xdc\x84\xf2\x4e\x3f\x51\x22\xb7\x55\x54\x53\x1e\xb1\xfc\x10\x27\x80\xb6\xbd\x58\x32\x21\x6a\xe8\x5c\x86\xc7\xd2\x59\xb3\xfc\xb0\x66\x9b\x4d\x6a\x42\x87\xdf\x16\xd0\x42\x1f\x8f\xe0\x05\x30\xf8\x15\x3c\x24\xcc\x66\xdf\x3d\xba\xc0\xa3\xa3\x5b\x67\x34\x19\x31\xae\xd1\xb3\x35\xdb\xc4\xa3\x89\x3e\x38\xd9\x1e\xda\xfe\x63\xe7\x39\x46\x87\xe6\x07\xb3\x31\x5c\xc2\x75\x70\x36\x72\xfe\x83\x21\x9f\x8b\xd6\x45\xca\x4f\x42\xfc\x4e\x74\x13\x4c\x93\x57\x16\x4f\x7c\x76\x8b\x61\x38\xb9\xda\x3e\x99\x44\x9f\x2d\xb0\x16\xb5\xea\xae\x29\x6c\x2e\xf8\x59\xd1\x03\xc9\xe9\x96\x35\xa4\xc6\x1c\xf8\x7a\x42\xf1\x99\x92\x1a\x94\x35\x62\xff\xb0\xb6\x0c\xa5\xa3\x22\xdd\xf6\xf8\x16\x38\xbc\x5a\x40\x18\xbe\x0c\x93\xbf\x0c\xae\x68\x74\xfa\xc9\x76\x86\x28\xbc\x3a\x98\xde\x90\x40\x1b\x07\xfd\x99\x17\x83\x27\xe1\x1d\xd3\xea\x05\x05\xca\x5f\x32\x19\xae\x62\x33\x61\x1c\x2f\x17\x45\x77\x64\x78\x39\x7d\x4b\xa0\x10\xd2\x5f\xf2\x19\xd3\x0d\x51\x4f\xd6\xf1\xb1\xca\xbc\x89\x1f\x66\xa2\x85\xc5\x02\xae\x5e\xc6\x3b\x25\xd5\xd3\x33\xa1\x00\xfb\x06\x5e\x61\x0a\xd6\xd8\x79\x19\x2f\xcd\x34\x3a\xf8\x25\xb1\xaa\xb4\x4d\xe0\x45\xe9\x4e\x4c\x65\xbe\xb6\x8f\x85\x36\xac\xb3\x02\xb2\x59\x94\x5d\x5e\xc7\xd6\xc9\xd9\x0c\xda\x59\x06\xaf\x8e\x1d\xc6\x1f\x77\x0f\x1d\xb2\xdb\x51\x9e\x47\xee\x15\x72\x5c\x74\xf1\xa4\xe0\xf0\xaf\x85\xd9\x3f\x0b\xc8\x86\xb9\x7e\x12\x9e\x21\xe6\xc4\xce\x79\x1b\xa7\x22\xb0\x12\x38\x53\x0a\xee\xb5\x98\xfe\x29\x18\xf7\x87\xc3\x6f\xa1\x57\x8b\x65\x6b\x2a\x18\xa3\xed\xa8\xc5\x07\x43\x7a\x27\x5c\x8b\x8d\xc1\x25\xe5\x24\x93\xce\x88\xed\xae\xe9\x3b\x27\x8e\xbe\xf7\x7a\xc0\xc6\x5a\x2b\x6c\xb1\x63\x62\x87\xee\x1b\xd9\x0b\x39\x6a\xe3\xe4\xb8\x9f\xc7\xc1\xd1\x13\x66\x32\x44\x09\x9a\x86\x84\x1d\x0e\x7b\x98\x19\x6f\xf1\xb9\x6f\x5d\xb1\xbd\xe3\x8e\x6a\xb3\xcb\x08\x54\xe3\x8b\xcb\x7c\x3a\x6d\x4e\x50\xce\x5d\x0a\x38\x1e\x71\x13\xa0\x69\x99\xc2\xdd\x87\x2f\xf7\x0f\x9f\xdf\x7e\xb8\x7f\xff\xe5\xf1\xe3\xf2\xaf\xb7\xab\xfb\xc7\x87\x51\xfe\x91\x33\x10\xdb\x7f\xdf\x61\x69\xa2\x6d\x17\x39\x8d\x93\x69\x83\x8d\xfd\xa3\x40\x9e\x78\x46\x0f\xda\xb9\x24\xe9\x6e\x78\x4e\xf9\x28\x81\x18\x2e\x0c\x8e\x79\x19\x70\x36\x5e\x5d\x88\xe7\x5f\x03\x83\xbf\x4b\x29\xa3\xd8\x21\x0e\x25\x89\xd1\x62\x65\x78\xd8\x28\xbe\xb5\x73\x27\x82\x74\xd1\x18\x73\x11\x6e\x38\xa3\x3a\xce\xea\xa0\x0f\xfe\x1d\x00\x55\x5d\xd3\xb1\x8a\x0d\x00\x00")
+
+func templatesEnumnamesTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesEnumnamesTmpl,
+		"templates/enumnames.tmpl",
+	)
+}
+
+func templatesEnumnamesTmpl() (*asset, error) {
+	bytes, err := templatesEnumnamesTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/enumnames.tmpl", size: 3466, mode: os.FileMode(420), modTime: time.Unix(1792210738, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesGlTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7c\xff\x73\xdb\xb6\x92\xf8\xcf\xd5\x5f\xb1\x55\xf5\x1c\xd2\x55\x68\xbb\x79\x33\x9f\xcf\xc5\x75\x66\x72\x8a\xaa\xe7\x39\xc7\xf1\xc4\x6e\xe7\x6e\x7a\x1d\x0f\x45\x82\x12\x5e\x28\x50\x8f\x80\xe4\xb8\xaa\xfe\xf7\x9b\x05\x16\x20\x40\x52\x8e\xd3\xb9\x77\xf3\x2e\xbd\x37\x24\xb8\x58\xec\x77\xec\x2e\x20\x9f\x9c\xc0\xa4\xca\x19\x2c\x98\x60\x75\xaa\x58\x0e\xf3\x47\x58\x54\x8b\x12\xa2\xa5\x52\x6b\xf9\xfa\xe4\x64\xc1\xd5\x72\x33\x4f\xb2\x6a\x75\x92\xcf\xff\xfa\xff\x96\x27\xf8\x39\x3e\x87\x77\x1f\xe0\xfa\xc3\x1d\x4c\xdf\x5d\xde\x0d\x06\xbb\xdd\x4b\xe0\x05\x24\x77\xe9\x42\xc2\x7e\x3f\x18\x9c\x9c\xc0\xf7\xf3\x0d\x2f\x73\xd8\xed\x9a\x61\x04\x63\x22\xc7\xc7\xc1\x3a\xcd\x3e\xa5\x0b\xa6\xbf\xdf\xd0\x33\x8e\x9f\x1c\x6b\x6c\x27\xc7\x30\x23\xa2\x60\x02\x52\x6d\xe6\x12\x8e\x4f\xf6\xfb\xc1\x77\xd9\xa2\x82\x92\x8b\xcd\x67\x28\x6a\xc6\xe6\x32\x07\x00\x58\x7f\x5a\xbc\xcc\x2a\x51\xf0\xc5\x6b\x58\x94\x06\xa8\xf3\x7f\x93\x9f\xae\xde\xce\x6e\x5f\xc3\xcb\x77\xb3\x0f\x77\x6f\x67\xf7\x8b\x72\x30\xf8\x8e\x8b\xac\xdc\xe4\x0c\x86\x8b\x32\x59\x0e\x9b\xf7\x1f\xa5\xca\x79\x95\x2c\xdf\x04\x43\x35\x17\x0b\x1c\xb3\x0c\xbf\xdf\x94\x8a\x4f\x2a\xa1\xd8\x67\x85\x6c\x05\xd3\x4b\x3e\x47\xd8\xc1\xec\xc3\xec\xea\xfe\xee\xea\x56\x4b\xf6\xde\x42\x1f\xeb\xb7\x6c\x53\xd7\x4c\x28\xb8\x80\xeb\x9f\xaf\xae\xce\x07\x03\xa9\x52\xc5\xb3\x3e\xd0\x05\x53\x13\x03\x1d\x6d\x2b\x9e\xc7\xb0\x83\x9a\xa9\x4d\x2d\xc0\xc7\x74\x0e\x7b\x8b\x03\xa1\xcc\x37\xd9\x4c\x0d\x11\x67\x88\xa5\x45\x48\x86\x28\x90\x41\x56\x4a\x86\x4c\x0d\xa4\xaa\x37\x99\x82\x5f\x58\x2d\x79\x25\xee\x61\x76\x45\x8f\xe7\x5a\x57\x75\x2a\x16\x0c\x92\xe9\x67\xc5\x04\x02\x68\x13\xe0\x42\x81\x66\x1c\x15\x7c\x9d\xae\x10\xd3\xb9\x6f\x01\xbe\x31\x78\x58\x26\xd5\x6a\x95\x8a\x5c\xee\xf7\x03\x54\x1a\x7e\x19\xd5\x4c\xc1\xeb\x0b\x48\xee\x1e\xd7\x2c\x99\x55\x1a\x9b\xaa\x37\x88\x72\xb0\xdb\xa1\xe5\x89\x4a\xc1\xa8\xa3\x8d\x9b\x9f\xae\x77\x3b\xb8\xab\x7e\x5e\xaf\x59\xed\xa8\x80\x75\x21\x7c\xaa\x9c\xec\x03\xe2\x68\xb5\x89\x05\xd2\x42\xda\xed\x34\x92\xfd\x3e\x72\xc4\x19\xb2\x47\x7c\x0c\x23\xa6\x89\xbc\x49\xeb\x74\x65\xc9\xb7\x50\xbc\x80\x85\x82\x11\x87\xd3\xfd\x7e\x0c\xbb\x1d\x13\x79\x0b\x62\xc4\x68\xc1\x77\x2c\x2b\x61\xc4\x68\x21\xb7\x8e\x21\x2c\x86\x1d\x8d\xf0\x42\xcb\x65\xbf\x27\x1b\xd0\x38\xe1\xa5\x9b\x11\x10\xfa\x4f\x20\xd6\x23\xaf\x45\xe2\xf9\x20\x54\xae\x7a\x5c\xb3\x9c\x15\xda\x1c\x8f\x21\x3a\x86\xd9\xc7\x0f\xb3\xb2\x4a\xf3\x75\x5d\x65\x71\x94\x55\x42\x2a\xc8\x96\x69\x0d\xc7\x22\x5d\xb1\xb8\x71\x02\xb4\x21\x2d\x77\x84\x26\x93\x8b\xfc\xd9\x80\x0f\xac\xb6\x52\x41\xf8\x55\xfa\xf7\xaa\x1e\xc3\x8a\x8b\xaa\x3e\xd7\xe4\x39\x6b\x4d\xf4\x37\xb8\x80\xd3\xf3\xc6\x84\x13\x0d\xa9\x07\x35\x34\x2f\x20\x8a\xd0\x42\x16\xe5\x8c\xa9\x5b\xed\xf1\x70\x01\xd1\xcd\x4f\xd7\xb3\xab\xd9\xf4\xee\xf6\xee\xe3\xe5\xf5\x2c\x36\x0b\x47\x43\x0f\x6a\x18\xc7\x70\x61\x6c\x29\xb6\x9e\x49\x48\x7d\x1e\xb7\xac\x46\x7c\xfe\x50\xec\x61\x89\x66\x57\xf7\xbf\x4c\x3f\xde\x5e\x7e\xb8\x8e\x1b\x8a\xf4\xa4\x7e\xdc\x0f\x4b\x5e\x32\x88\x8e\x11\xe4\xdb\x0b\x78\xf1\xdf\xa7\x2f\xe0\xe8\x88\x06\x7e\x84\x17\xa7\x2f\xe0\x8f\x3f\xcc\xb2\x6f\xe0\xc5\xbf\xbd\x88\x63\xd8\xb2\xfa\xfb\xef\x1b\xe4\xc7\x84\x1d\xa7\xfa\xd8\xbf\xe3\x05\xea\xed\xfe\xfd\xed\x04\x49\xd2\xf0\x52\x66\xa9\x28\xee\x65\xb4\x65\xf5\x18\x86\x7f\xc9\x93\xbf\xe4\xc3\x31\x1c\x91\xd8\x8f\xb4\x34\xe3\xf3\xc1\x77\x18\x3c\xbc\x19\x5f\x86\x17\x39\x2f\x0e\xe8\x4b\x03\xf7\xe9\x8c\xb4\xbc\xdb\xc1\x68\x8b\xf6\x7c\xcd\x1e\x08\x04\xce\xe0\xd4\x46\x99\x56\x64\x01\x32\x5b\x32\xf5\xd1\x36\xb9\x62\x52\x42\x62\x67\x7a\x9f\x47\x5b\xb8\x08\x3e\xe8\x2f\x27\x27\xf0\x61\xcd\xc4\xec\x4a\x6f\x5e\xf4\x35\x21\x53\xa1\xd9\x28\xd7\x36\x23\x3f\x06\xf0\xef\x91\x29\x8c\x2c\x7f\xfc\xd1\x05\xbd\xb8\xe8\x87\x3d\x3a\xea\x48\xa1\x85\x55\x8f\xed\xf7\xb1\x53\xe4\xd9\xb9\x63\xa7\x71\x64\xe2\xbd\xb3\x80\xa3\x5d\xbb\x80\x8b\x22\xe4\x00\x3d\xc1\xd4\x39\x82\x83\x7d\xc2\x0d\xbc\xa8\xe0\x3d\xe2\x92\x0d\xad\x7b\xb7\xb7\x86\xfb\x89\x0b\x23\xc6\x71\x66\x57\x9b\xf9\xa3\x62\x70\x1c\xbd\xbd\xb9\x9c\x5e\xdf\x7d\xfc\xaf\x1b\xb3\xd5\x85\x7e\x7a\x19\x47\xb3\x2b\x26\x36\x2b\xc0\xd0\x32\x86\xd9\xd5\x06\x83\x04\x17\x39\xfb\x1c\x9f\x07\xa1\x09\x0e\x61\xba\xbc\xbe\x9b\xce\xa6\x1f\x7f\x71\xa8\xd6\x16\x17\xa2\x3a\xce\x53\x95\xf6\xc5\xac\x65\x2a\x1d\x07\x81\xb7\xb3\xcf\x4a\x8e\xa1\x35\x62\x43\x98\xe4\xbf\xb3\x7b\x05\x02\x2e\x40\xaa\xba\x64\x22\xc2\x8f\xdd\x28\xb2\x86\x0b\x40\x44\x41\x0c\x88\x70\x54\xaa\x5a\xaa\x3a\x5a\x8f\xf1\x7b\x1c\xc3\xb7\x56\x17\x3b\x17\xac\xd1\x38\x11\xd6\xa0\xc0\xf0\xb0\xfe\xf5\xe5\xd9\x6f\x38\xf0\x02\x5e\xc4\x3a\x7c\xac\x7f\x15\x76\xc0\x00\xd0\x2b\xc6\x88\xb6\x6d\xe1\xbf\x35\x7c\x7f\x01\xc2\xbc\x07\x3a\x3d\x45\x9d\x62\x42\xa8\xd5\x53\x70\x91\x7b\x8a\x95\x4c\x49\x50\x4b\x66\x52\x85\x63\xa4\xc7\xe4\x10\x50\x94\xe9\x42\x26\xb0\xb0\x71\x91\x43\x2a\x72\x8d\x86\xa9\x4b\xa1\xd8\x82\xd5\x5b\x48\x6b\x06\x95\x28\x1f\x61\x23\x59\x0e\x0f\x5c\x2d\xad\x67\xbe\x4a\x4e\x71\x02\xa4\xf3\x6a\xcb\xf4\xd3\x2a\x7d\x84\x39\xd3\xb2\x48\x06\x27\x27\x83\x26\x3b\x0a\x69\xd2\xc9\x15\x1c\x37\x0b\x8f\xc1\x8d\xd8\x85\xad\x30\x5b\x4a\x94\x4f\xe4\x43\x08\xde\xce\x87\xcc\xae\xd3\x72\x85\xbe\xc8\xf1\xe6\x02\x5e\xa1\x56\x3c\x69\x90\x5a\x69\xd4\x49\xa4\x47\xdb\xc6\x4e\xf9\x58\x1b\x15\x79\x22\x85\xb1\xd3\xcf\xff\xff\x87\xb3\x77\xc0\x25\xcc\xae\xee\xaf\x7f\x7e\x7f\x3f\xfd\xcf\xbb\xe9\x35\xee\x3d\xb7\x63\x78\x58\xf2\x6c\x89\xdf\x30\xb9\xca\x59\xc1\x05\xd6\x07\xac\xa8\x6a\xe6\x49\xd9\xa1\x8b\xa2\x7e\xaf\xf1\xc5\x16\x99\x05\xc7\x70\x24\xc8\xa6\xf1\xbf\xa2\xaa\x21\xe2\x9a\x38\xe0\xf0\x23\x88\x73\xe0\xdf\x7f\xef\xb3\x80\xff\x50\xbe\x9d\x8d\x33\x8a\x7a\x9d\xbe\x91\x13\x3a\x6d\xc0\x15\xf7\x16\xb6\xe2\x36\xa8\xad\xe4\xb2\x4a\x28\x2e\x36\xec\x0b\xca\xb4\xff\x10\x81\x54\x75\xb6\x5a\xa3\xab\xca\x31\x0c\x3d\x0d\x0f\x63\xc4\x7b\x1a\xf7\xa9\xfe\xac\xa3\xfa\xc6\x77\x1a\xff\xf1\x3d\xaa\x57\x04\xad\xdc\xa1\xe1\x95\x18\xed\x32\x68\x11\x7f\xad\xad\x76\xc3\x5a\x1f\xc3\x01\x57\x9e\xe7\xe3\x46\xe1\x2d\x83\xaf\xc6\xf5\x8b\x8d\xc8\x94\x1e\xab\x0a\x48\xb7\x29\x2f\xd3\x79\xc9\x9a\x50\x20\x13\x68\xe6\x21\x3a\xb4\x17\x63\x9c\xa9\x9b\x0c\x59\x2a\xd0\x4e\xe7\x4c\x63\x66\xb9\x8e\x0d\x18\x44\x16\xf8\x2c\x61\x23\x1c\xea\x96\xfb\x87\x84\x1d\xca\x2f\x9f\x14\x96\xdd\xb2\xda\x29\x06\xca\xbe\x2d\x48\xdf\xae\x9f\xc8\x4e\xec\xf4\x4e\xb1\x62\xb5\xd8\xf9\xf0\x8c\x2d\xda\x8d\x0d\x3d\x2f\xe8\x31\xc2\x1e\xe5\xef\x76\x44\xa9\xae\x1e\x32\xcc\xb6\x7c\x9a\x4d\x19\x36\xe2\x94\xa4\xec\x76\x84\x92\xa8\x1c\x65\x0e\x15\x45\x28\x07\xe1\x1b\xb8\x47\x8a\xf7\x18\xbc\x0c\xdc\x06\x7b\x29\xb8\x7a\xb2\x1a\x28\x20\xfa\xd6\x69\x98\x02\x6a\x44\x30\x6e\x0b\x3b\x3d\x3f\x90\x70\x3c\x15\x8b\x7d\x1d\xf6\x6d\x21\x56\xe2\x9e\x6f\xf2\x61\x3c\x86\x60\xdc\x46\xc5\x61\x4c\xca\xd8\x03\x26\xcd\x5f\x40\x8d\xca\x1f\x6b\x09\xda\x59\x03\x07\xda\xb2\x64\x62\xb5\x13\x66\x88\xf3\x33\xbd\x31\x1f\x9f\x0c\xf8\x6a\x5d\xd5\x0a\x86\x93\xa1\x86\x1c\xe9\x62\x7b\x38\x49\x86\x46\xaf\x23\x2c\x0f\xcc\x88\x93\xc5\xd0\xaa\xa8\xa7\x09\x82\x53\xb0\xa1\x31\x54\x01\x06\x3d\xb0\x6d\xa6\x3b\x03\xd0\x78\x46\xe9\x9a\xeb\x65\xcd\xce\x82\x00\x96\x2e\x53\x6b\x0f\x59\x5d\x57\xb5\x1c\x9a\x97\x62\xa5\x86\x6e\xfd\xdb\x92\x67\xcc\xe9\x6c\x58\xb3\xa2\x64\x99\x1a\xb6\xd9\x1e\x4a\xad\x08\x8b\x62\x23\x64\x5a\xb0\xe1\x20\xd6\x21\x6a\x52\xd5\xec\xa6\xae\x0a\xcc\xa3\xb8\x34\xdd\x05\x5e\xe8\xf8\xf4\xf6\xe6\x12\x1e\x52\x89\x19\x5b\xc1\x17\x9b\x9a\xe5\x3a\x02\xa9\xa5\xdb\x06\x33\xdc\x12\xd7\x66\x36\x06\x17\xb8\x5b\x72\x89\x3b\x67\x5a\x3e\xa4\x8f\x12\x8a\x14\xf5\xca\x0b\x8d\x4a\x6f\xb6\xd3\xdb\x1f\x10\x70\x60\x22\xb9\xbf\xb8\x49\xfc\xfd\x11\xea\xa7\xe1\x5c\xcc\x57\x5f\xdb\x55\xab\x9a\x9e\xa6\xb7\x1a\x17\x7e\x34\x2b\x08\xe5\x66\xfc\x92\x96\x1b\x26\xbd\xb5\x8c\x34\x09\x05\x42\x5f\x00\xaf\x54\xea\x8d\x4e\x6f\x51\x26\x18\x58\x21\x4a\x11\x49\x0c\xb4\xbd\xc4\x98\x93\x62\x3d\xec\x1c\x2c\xc5\x5d\x85\x90\x35\x76\x4b\x06\x66\x75\xe9\x99\x69\xf8\x65\x7a\x3b\xa4\xdc\x90\xcc\x0a\x6a\xb6\xae\x99\x64\x42\x49\x48\x05\xae\x0d\x64\x31\x0d\x87\x16\x94\x9a\x52\x66\x55\x84\xd4\xff\xab\xdf\x4c\x69\xc5\x85\x32\x6f\xba\x24\xc2\x37\xb3\xd6\x6c\x4a\x04\x36\x6a\xa6\x45\x60\x8b\x4a\x5b\xd4\x2c\x55\xac\x86\xaa\x06\xf6\x8f\x4d\x5a\x82\xaa\xec\xa2\xbb\x74\xcd\xc7\x41\x8b\x61\x8f\x18\x31\xbd\xdc\x26\xa4\x5c\x37\x07\x0d\x04\xad\x3a\xad\x17\x9b\x15\x13\x4a\xb3\xa0\x8d\x83\x41\x51\x95\x65\xf5\x80\xa2\x64\x9f\xd3\xd5\xba\x64\x20\x97\xd5\x83\x84\x65\xf5\x80\xcb\x6d\xd0\x5c\xb0\x4c\x81\xac\x5a\xad\x53\xc5\xe7\xbc\xe4\xea\x11\xb2\x25\xcb\x3e\xc9\xd7\x84\x08\xc8\x31\x17\x65\xf2\x71\x23\x14\x5f\x31\x22\x33\x8a\x91\x2a\x90\x0f\x5c\x65\x4b\x0d\xb5\xd3\x03\x59\x2a\x19\xbe\x26\xb3\x69\x64\x34\x30\x86\xbf\x8e\xe1\x34\xc6\x0c\x3f\x18\x9f\xde\x8e\xe1\xd5\x18\xce\x62\x5c\xcb\xa5\x8b\x59\x5a\x96\xb0\x28\xdf\xd5\xe9\xc3\xdb\xba\x4e\x1f\xe5\xa5\xc8\x79\xcd\x32\x75\x10\xbb\xc6\x71\x08\xfb\xe9\x17\xb1\x4b\x95\x8a\x8c\xe9\xb4\x1f\x72\x56\xa4\x9b\x52\x05\x53\x8a\xb4\x2c\xe7\x69\xf6\x49\x8f\xa1\x2a\xc8\x6c\xb7\x56\x61\x31\xcc\xa6\x11\x2a\xe1\xed\xcd\x65\xa8\x38\xe0\x42\xc5\x30\xaf\xaa\x12\x76\xbe\x69\x1a\x3d\x5e\x5c\x00\xce\xc2\x42\x68\x4b\xc5\xf1\x1b\x33\x5d\x33\x43\x43\x17\xd4\x9a\xc0\x24\x7c\x4b\xa5\xf7\x1b\xea\x4a\xc4\x64\x6d\x6f\x6f\x2e\x89\x96\xc6\xea\x96\xac\xc7\x87\x9d\x11\xca\xcd\x1a\xe3\x31\x26\xdb\x8f\x1a\x96\x5a\xe5\x89\xe3\xaf\xc1\x19\xc5\x96\xd3\x90\x0b\x1a\x6c\x5c\x92\x62\x25\xfb\x07\x68\xfe\x86\x8b\x72\xb8\xdf\x1b\x1b\xc0\x40\x8c\xf1\xc9\xbe\x4f\x6f\x5d\x68\x1e\xf7\x76\x21\x5a\xa3\x9a\x6d\xdb\x78\xb4\x29\x5f\x68\x90\xcf\x65\xbd\xc9\xfe\x52\x05\xb5\x41\x31\x46\x74\x26\xdd\xc3\x12\x2e\xe7\x45\xc1\x6a\x28\xea\x6a\xe5\xc9\xa1\x91\x4d\xdb\x13\x3c\xf9\x1c\xd8\xaf\xb4\x8c\xf4\x9e\x37\x49\xda\x8d\xf6\xd8\x06\x3a\x85\x81\x4e\x70\x6b\x2c\x3d\xa2\x06\xb7\x8d\x61\xd7\xf4\x74\x0c\xa7\x56\x22\xfd\xdb\xef\xff\x8a\x8a\xac\xd8\xf1\xdf\x18\x4d\x3a\xb2\xdb\xed\x7e\x6f\xda\x67\x71\x77\x18\x15\x16\x5b\x45\x61\x16\x35\x01\x2e\xb8\xe2\x69\xc9\x7f\x67\x92\xb4\x92\x50\xb2\x82\x11\xd1\x4b\xb3\xd7\x15\x17\x18\x1c\x55\x05\x29\x4c\x9a\xf1\xaa\x00\xf5\xb8\x66\x36\x32\x05\x8d\x95\xe3\xe8\x98\x52\x91\xb0\x74\xc1\xc9\x98\x15\xc6\x34\xeb\x32\xdc\x20\xc7\x3a\x04\x4a\xc0\x6d\x1a\x83\xe2\x3a\x95\x48\x0a\x2a\xc1\xa3\x42\xe1\x56\x6b\xc9\x40\x5e\x4d\xdd\x8a\xf8\x74\xb8\xd3\xed\x81\xac\xa9\x59\xa7\xb7\xf0\x43\x72\xea\x66\x48\x5d\x27\x84\xa5\x01\x7e\x33\x72\xa1\x44\x0e\x4c\xae\x90\xdc\x98\x65\x63\xd0\xb9\xc8\x17\x2c\x8a\x0e\x54\xee\xb0\x98\x89\x62\xdf\x08\xbe\xe1\x85\x35\x35\x5c\x25\x8a\x26\x89\x9f\xc6\xc6\xb4\xaa\xe9\xa3\x9d\x76\x2d\x4e\xaf\x2e\x93\x6b\xf6\x10\x0d\x8b\x94\x97\x2c\x47\xf1\x34\x2a\x24\x4e\x87\xb1\x67\x7f\xfd\xf9\xad\x7c\x14\x99\x97\x30\x86\x54\xd2\x6a\x82\x97\x9e\xa9\xcc\xaa\x5e\x5b\xb9\x5b\x32\xa8\x59\x56\xad\x56\x4c\xe4\x2c\x87\x2d\xa6\x1d\x3a\x4d\x6a\xac\x68\x51\x16\x0f\xc9\x8c\xa9\x9b\xba\xca\xde\xe6\x79\xcd\xa4\xa4\x64\x89\x6a\xb6\xda\x69\x05\x56\x1b\xa9\x60\x9d\x0a\x9e\x41\x45\x0c\x27\xff\xaa\x56\x32\xab\xac\x99\xe0\x50\x64\x52\xa4\xb8\x63\x33\x46\x8d\xb4\x65\x5b\xe7\xb7\xfb\xe4\xcb\x33\xfc\xff\xfd\x97\x43\xd4\x61\xab\x1a\x7c\x83\x3e\xae\xa8\xe6\xf2\x2a\x0f\xb8\x80\x49\x12\x76\x48\xa2\xa0\x10\x71\xc7\x17\x83\x6f\xb6\xd2\xc4\xc1\x59\x45\x29\x5f\x74\x3c\x49\xd0\x5b\xe3\x28\xe4\x27\xb2\xb1\xb2\x41\x80\x66\xac\x7b\xad\xfe\x11\x46\x1c\xc7\x14\x43\x11\x31\x65\xe0\xc9\x25\xf6\x5f\x7f\x42\x69\x6d\xe5\x58\x6b\x3d\xaa\x31\xde\xb3\x60\x47\xf6\x4c\x5e\x97\x5d\x78\x94\x71\x74\x04\x35\xfc\x78\x81\x07\x19\x1a\x66\xef\x22\x34\x87\x37\xa1\xb7\x14\x2b\x95\xdc\xd2\xe1\x83\xfc\x95\xbf\xfe\xcd\x3f\x7f\xc0\x7c\xe4\x3d\x9d\x41\xe8\x67\x1d\x14\xc9\x61\x08\xe3\xb7\x41\xd2\x32\x86\x33\x4c\x59\xbc\x05\xb4\x7d\x06\x2e\x98\x33\xa1\x78\xf1\x48\x46\x64\xb7\x35\xe7\x88\x38\xab\x1d\x9f\xb5\x76\x30\x42\x3b\x8a\xe2\x0e\x1c\x17\x6d\xb8\x86\x5a\xbf\xb6\xdd\xed\xfa\x8c\x47\x8d\xc1\x6d\xe8\xfa\x14\x86\xc2\xcb\x81\xb8\x60\xe5\xd9\x62\xfe\x55\x8b\xf9\x49\xd2\x57\x9d\x7e\x6d\xe1\xdb\x5f\xf7\xf6\xe3\x16\xbc\x1c\x83\xe0\xa5\x95\xe6\x9f\xed\xc8\x58\x37\xe9\x34\x39\xbe\x0d\xed\xe7\xcb\xcd\x19\xdf\xe1\x82\x6e\x49\x3b\x55\xb0\x0a\xed\x05\x36\xde\xd9\xd3\xb8\x71\xd2\xf4\xc0\xad\xcc\x1a\x83\xb2\xa4\x52\x18\xb0\x43\xbc\xf8\x9a\x96\xcd\x1f\x7f\x34\x36\xd2\x22\xd4\x6b\xdd\x98\x14\xc8\x01\x1e\x60\xb0\xa7\x79\x74\xea\x00\x6d\x4e\xd4\xa2\xba\xc5\xc0\x53\xfb\xd2\x9e\x6a\xd3\x67\x18\x3e\x1c\x93\x25\xd1\x58\xe8\x08\x36\x03\x1b\xc3\xf3\xc2\x37\x1d\x08\xfe\xab\x1d\x33\xb6\xa3\xd4\x73\x33\xf6\xb0\x13\x69\xe2\x6c\x57\x39\xcf\x39\x2f\x0c\x0d\xa6\x39\x32\x7c\x86\x5d\x37\x67\x86\x6e\x19\x52\xb3\xf7\x78\x78\x5b\xc4\xfd\xfb\xa6\xae\x32\x9d\x64\xc1\xb2\x2a\x5b\x2d\x65\x9b\x02\xc8\xb1\xad\x26\x9a\x52\x43\xe4\xed\xd3\x26\xc4\x86\x2d\x68\x81\x02\xa7\x4c\x1e\xbb\x3e\xb8\x5a\x02\x3f\xb9\x5c\x00\xab\x73\xac\xcb\x94\x5e\x95\x76\x64\xcc\x3c\x71\x10\xab\x58\x54\xd0\x87\x5b\x44\xa7\x96\x35\x4b\xf3\x31\x48\xa6\xcc\xf1\xd4\xfb\xf4\x13\xa3\xe2\x22\xa1\xdc\x1b\xbb\x07\x9d\xd4\x4a\xb3\x61\x51\xeb\x85\x74\x1d\x94\x61\x6f\x02\xd1\x57\x42\x37\x94\x04\xcb\x98\x94\x69\xfd\x38\x06\x89\x19\x79\x23\x0c\x9d\xf7\x0a\xc6\x50\x22\x15\x1e\x7c\xad\x52\x91\x2e\x4c\x2d\xcd\x3e\xaf\x4b\x9e\x71\x55\x3e\xc2\xc3\x92\x09\xd8\x48\x44\x29\xd9\x96\xd5\x69\x69\x39\x6e\xda\x0c\x24\xba\xe4\xaa\xca\x3e\x7d\xb8\xbd\xd3\x2c\x51\x8b\x41\x9d\x8d\x41\xfd\x40\x4d\x88\x6b\xf6\xe0\xd6\x8f\xe2\x71\x77\x48\x4f\x79\xe0\x22\xaf\x1e\xce\x12\x2d\x09\xb3\x54\x53\x6d\x21\x80\xa2\x6f\xc1\xe0\xa2\x4c\x28\xd7\xea\x49\x23\x7d\xc4\x3f\x3c\x81\xf8\x87\x3f\x87\xf8\xe4\xc4\xb6\x52\xb0\xd7\x80\xe2\x24\x1e\xfe\x34\x3f\x38\xf1\xad\x0c\x0e\x2c\xad\xd4\xc7\x81\x15\x2d\xaa\xba\xda\x28\x2e\x98\xc9\x88\xf5\xe9\x46\xf6\x89\xb2\x0d\x25\x03\x2b\x33\xe8\xfa\x94\x65\xf2\xf3\xd0\xda\x61\x9b\xd6\x1c\xd5\x62\x72\x5b\xb5\xac\x30\x0d\x2e\x90\xb2\xc6\xb6\xcb\x54\xe2\x8d\x9a\xbc\x31\xc5\xaa\xf6\x8c\x34\x6f\xba\x72\x4e\xcb\x61\x5f\xae\x13\x82\xa9\x94\xf0\xed\x82\x62\x8f\xce\xda\xd9\x03\xb0\xd5\x5a\x3d\x9a\xf5\x13\xb8\x54\x8e\xf1\x80\x0c\x3a\x0b\xf6\x48\x31\xdc\xa3\x8d\x4c\xb0\x73\x47\x0e\x65\x0e\xc6\x91\xa1\xac\xaa\x6b\x26\xd7\x95\xc8\xd1\xd6\x49\xda\x58\x45\x10\xca\x26\xa7\x0f\x6d\x16\x8e\x1b\x42\x83\x16\xcb\x91\x1b\xdf\x45\x2d\x26\xe3\x68\x92\x60\x1c\xa8\xb2\xe8\x6c\x0c\x93\x04\x0f\xf5\xab\xe2\x3e\x00\x71\x05\xb8\x67\x1b\xb0\x4a\x3f\x31\x09\x6b\x4f\x03\xfa\x60\x7b\xfe\xe8\x55\x22\x88\x18\x5b\xd0\xd8\x00\x39\x14\x70\x1a\x66\xa2\xb5\xc7\x40\xec\x87\x9f\xc8\x86\xff\x49\xd2\xbe\x23\xb8\x4e\x54\xfc\x27\xab\xc6\x76\x3b\xb7\x69\xfb\xb4\x03\x30\x76\x0c\x96\xcc\x69\x62\xad\x7b\xec\xbe\x42\x8b\xaa\x3e\xc8\x47\x5f\x7b\xc7\xd3\x0d\x0d\xb6\x7a\x32\x98\x65\xaf\x13\x95\x10\x05\x41\x7f\x24\x18\x0f\x1a\x24\xef\x58\xc9\x14\xd3\x97\x4d\x25\xac\x1b\x93\xa4\xd3\x46\x6b\x91\x5c\x40\x2a\x1e\xa1\x52\x4b\x56\x7f\x49\x0b\x06\xa5\x53\x00\x2f\xfa\x3a\x4f\x58\xfc\xaf\x13\xd5\x4d\x8b\x3d\x45\x79\xd9\xb0\x01\x40\x2a\xdb\x95\x1a\x6a\xd3\x24\x8d\x88\x4e\xa7\x70\xc4\x1a\xd1\x1e\x7a\x61\x63\x7b\x55\x11\x18\x98\xdd\xcb\xdc\x1e\x64\xfc\xd5\xec\x44\xa8\x75\xa1\x1f\x7b\x3c\xca\x5f\x07\x3d\x6a\x92\xf8\xae\xd0\x48\xe1\x50\x13\xee\x1c\x14\x7c\xdb\xce\xa9\x49\xd5\xca\xe3\x5f\xd1\x1d\x30\x27\xea\x28\x4e\xd4\x01\x1b\x57\xb1\x6f\x30\x2a\xbc\xb3\xd8\x6f\xfb\xc8\xe3\xb4\x7d\x41\xe5\x6e\xc9\x1e\x75\x04\xc5\x2d\x7e\xfe\xd8\x89\x40\xb8\xbb\xe3\x67\xef\x44\x09\xf1\x34\xc1\x98\xcb\xa6\xb1\xe0\xb5\x3d\x75\x82\x82\xfd\x76\xae\x9a\xce\x05\x1e\x42\x6d\xca\xbc\x39\xe5\xd6\x0e\xb2\x4d\x6b\x88\xbe\x54\x0e\x35\xe9\x38\x56\xd8\x3e\xb3\xf6\xb0\xa7\xed\xd6\x7f\xb6\x63\xda\x92\xe3\xb3\x68\xba\x38\x5c\x3d\x60\x55\xe6\xe3\xec\x55\xd4\xac\xc2\xcb\xb3\x4e\x4b\xb3\x4a\x77\x23\x25\xac\x38\xf6\xca\x30\x3c\xa2\x59\x4f\x68\xd4\x06\x55\x2f\xcd\x73\x12\x4e\xe0\x36\x4b\xcb\xb4\x86\x35\x5e\x8d\x65\x8a\xd5\xd2\x1e\xf1\x90\xad\xe8\xde\x96\xb4\xde\xd1\x5c\x9d\x2f\xda\x09\xe2\x6a\x4c\x17\xb3\x6c\x16\x0a\x9f\x18\x5b\x23\xb6\x75\x99\x72\x01\xb3\x0a\x58\xc9\xf0\x68\xc8\xd0\xa5\xb7\x69\x5e\x43\x06\x2b\xa6\x96\x55\x8e\xb1\x71\xcb\x6a\xa5\x7d\x72\x65\x8f\x94\xc2\x8d\x6c\x82\xe8\x70\xb6\x6e\xb6\x65\x1b\xa9\xaa\x15\xe0\xed\xf7\xac\xca\x19\xa4\x39\x36\xe3\x6c\xff\xcb\x3f\x47\xd0\x53\x02\xa3\x31\x22\xcc\x59\xd1\xab\x20\x34\x20\x03\x01\xfb\x40\x03\xf1\x61\x1c\x48\x5a\xd6\x30\xb1\xa5\xa6\x71\xe2\xe1\xf5\x42\xe4\xd6\x5f\x2f\x86\x2c\x8a\x43\x50\xd8\xf9\x0e\x1b\x7c\x8a\xb6\x71\x68\x16\xb8\x32\x9a\xf9\x5d\x35\x69\xd6\x9f\x9b\xf5\x67\x57\xf8\x85\xa5\xa2\x59\x9b\x40\xa3\xb9\xee\x3e\xe1\xba\x0e\x88\x56\xe5\x05\xcc\xe9\xd1\x23\xe2\xcc\x8b\x3d\x34\x74\xda\x25\x64\x76\x05\x13\x3c\x7a\x4d\x85\x92\x8d\xc1\xd6\xd5\x66\xad\xa5\x64\xba\x9a\x0e\x02\xd4\x32\xc5\x8d\xa5\xac\xd0\x68\x91\x60\x4c\xce\xf1\x3e\x0c\xde\x62\x5c\xe0\x34\x58\xa6\x5b\xaa\x40\x50\x8b\xda\x10\x53\x65\xbe\xb9\x8e\xaa\xde\x88\x4c\x6e\xb7\x11\xa8\xed\x5c\x47\x94\x2c\x15\x18\x3d\xb0\x97\x6e\x2c\x03\x37\x2d\x67\xea\xb8\x47\xe2\x6f\x1d\xb8\x58\x20\x9e\xc6\x05\xd0\xda\x3d\x02\x10\x9f\x4c\x7c\x46\xbd\xf3\x65\xcf\x1e\xa6\x62\xa3\xaf\xc2\x7f\xe3\x29\x6b\xb7\x33\xa9\x1a\x19\x8b\x35\x2e\xff\x46\x00\x1d\x82\xeb\xb3\x6b\xcf\xdc\x3a\xd6\xe6\x64\x88\x00\xa3\x05\x06\xa4\xc4\x4a\x1d\x71\xd2\x4f\x05\xf6\x7b\xcc\xf3\x7c\x79\x79\xe4\x18\xb9\x6e\x9b\x63\x72\x0d\x13\xce\xde\x70\xa1\x5e\xfd\xe0\x74\xa7\xb9\xea\x5f\x67\xdb\x3d\x6e\x6f\x8b\xc3\xf3\xae\xd1\xa2\x99\x7a\x1f\x22\x6a\x7d\x35\x12\xc1\x2d\x89\x64\xd6\xfa\x1e\x79\x1c\xc5\xcd\x69\x91\x37\xea\x84\xeb\x6b\xad\x13\xac\xe9\xd1\x97\xf2\xdf\x52\x91\x63\x95\x40\x10\xa3\xe5\x97\xc5\x8c\xf7\x63\x51\xcc\xa9\xfe\x3c\x29\xb1\x25\xbf\xdf\x43\x35\xff\x3b\xcb\xd4\x33\x84\x4c\x2b\xbf\xd7\x51\xd0\x85\x12\x8f\x19\x9d\x93\x48\x8d\x60\xb2\xca\x3d\x24\xda\xb2\x50\x36\xcb\xe4\x23\xcb\xb6\x61\x84\x09\xc6\x71\xf2\x68\xd9\x4c\x8d\x7d\xa3\xf8\xe2\xcf\x3b\xc2\xf6\x99\xd7\x57\x42\xac\xcc\x91\x69\xde\xfc\x9f\xad\x98\xab\x20\x4e\x1d\x78\x7e\x67\xd3\xc0\x0e\x37\x51\xd8\xc0\x4b\xf5\xfa\x6f\xeb\xc5\xd3\xab\xa7\xbe\xb2\x5b\x71\xb1\xf5\x18\x5e\x9e\x41\x6b\x96\x78\x95\xe6\x46\xd5\x14\xe1\xb0\x26\xa3\xcd\xcb\x6e\x3f\x05\xaf\xa5\x72\xfb\x56\x55\x98\x29\x80\x77\x5d\x30\x62\x69\x05\x50\x8e\x83\x65\x8f\xdd\x26\x0d\x14\xde\x95\xc6\x46\x09\x5e\xdd\x96\x3a\x95\xd6\x3d\x74\x89\x6e\x25\xed\x55\xd3\xd4\xa0\x6c\x14\x67\x89\x8a\x24\xa6\xea\xac\x2e\xd2\x8c\xed\xf6\x31\xb4\x12\x5d\x0c\x5e\xca\x0a\x53\xff\x1c\x80\x6e\x02\x99\x40\xf2\xa1\x88\xa4\x3b\x2c\xd8\x26\xff\xc1\x45\x1e\xe9\xdb\xd1\x16\x4a\x4b\xa2\xd3\xde\xd7\xa7\x08\xeb\x9a\x0b\x55\x44\xc3\xbf\xdc\xb5\x88\x1c\x8e\x41\x52\x72\xed\x1a\x72\xd8\xe8\x13\xd1\xa1\xc3\x3b\xdd\xc5\x3e\xed\x6e\x1c\xad\xa4\x7d\xeb\x9e\xe2\x78\xec\x50\x1e\x23\x8b\xd1\x56\x5b\x54\x14\x27\xd3\x92\xad\xa2\x38\xb9\xe5\xbf\xb3\x28\x6e\x6f\x81\x56\xc1\x94\xb6\x35\x0e\x6c\xce\x78\xec\x38\x95\x4a\xce\x02\x68\x18\xf9\xe4\x78\x31\x05\xd5\xdc\x5c\x45\xa0\xdc\xc7\xd0\x6c\x86\x29\xf1\xd3\xda\xc4\xeb\x2f\xab\x39\xd3\x59\x07\x17\xb6\x97\x40\x75\x15\xcb\x2d\xf2\xfb\xe3\x46\x91\xd2\x5e\x34\xc6\x92\x3c\xa5\x26\x5a\x93\x4a\x35\xa9\xb1\xc1\x97\xc2\x82\x6f\x59\x70\x35\x68\x0c\x2c\x59\x24\x0e\xf7\xec\xea\xfe\xd5\xfd\xab\x31\xa6\xe0\x76\x68\x7a\x7b\xff\xea\xfe\x54\x67\x48\xf6\x78\x2f\x81\x9f\xa5\x36\xd2\x54\xe7\x5e\xd4\xe0\x19\x43\xcd\xb3\x25\x93\xca\xe2\x36\xe6\x3e\x46\xe3\xd7\x77\x6f\xdc\xcd\x2f\xa2\xcb\x5c\xcb\x31\x6d\x13\x47\xb4\x6b\x9c\x51\xd7\x28\x53\x9f\xa9\x43\xe6\x92\xe4\x24\xc2\x55\x63\xff\x5e\x0e\x7e\x6e\x58\xf8\xeb\xfd\xab\xe6\xa6\x4b\xa6\x3e\x27\xef\xb8\x5c\xa7\x2a\x5b\x4e\xaa\xd5\x7a\xa3\x58\xf4\x79\x0c\x8f\x63\xf8\x3d\xfe\x8a\xbb\x31\xb8\xa4\x95\x49\xa3\x00\x32\xd1\x03\x55\xbb\x27\xe7\x56\xc5\x9e\xe8\x69\x9d\x42\xdc\x22\x6b\x14\xd7\xbe\x6c\xe5\x57\xfd\x16\x35\x97\xde\xb5\x97\xa6\x08\x32\x6b\x38\x54\x91\x39\x1e\xa4\xea\x95\x84\x19\x90\x6b\xb9\xb3\x6a\xb2\xea\xf4\xd9\x08\xee\xd7\xcc\x2b\xb5\xb4\x76\x4a\xf9\xb1\xce\x93\xfc\xf6\x6b\x5f\x37\xf8\xb2\x59\x17\xcb\x52\x5e\xf8\x18\x10\xe1\x32\x95\xd4\x22\x60\xa2\xd3\x32\x43\x53\xb1\x8e\x13\xc5\x8e\x6a\xa3\x09\xa4\x5d\xdf\x85\xc4\x5f\x7f\xd0\x82\x32\x86\x97\x70\x76\x4e\x87\x9c\xe7\xc0\x5f\xbe\xf4\x42\x0b\x2f\x2c\x61\xf2\x57\xfe\x5b\xe2\xcb\xab\x01\xf2\x62\x8d\x07\xdc\x7b\x30\xd3\x39\xf9\xb7\x13\xa0\xe4\x52\x85\x92\x76\x41\x22\xd5\xb6\x8f\x11\x81\x0b\x6c\x11\x60\xda\x6a\x65\xee\xca\x54\x87\xe8\x02\x7e\x4d\x92\xe4\x37\xc2\xe2\x5f\xaf\x1e\x6d\x5d\x8a\x44\xd0\x7e\x39\xb8\xdb\x8f\xfd\x28\xb7\xef\x4e\xc4\x81\x51\xb6\x0d\xb2\x94\x49\x17\x91\x8d\x37\x86\x1b\x4f\xc5\xbd\x87\x2d\x4d\x44\x6a\xd2\x97\x3e\xa4\x6d\xaf\x42\x62\xf4\x96\x98\xdc\xd4\x6c\x6b\xf9\xea\x99\xe9\xa0\x29\x73\xf3\xe1\x06\x3d\x47\x7c\x4f\x1e\x38\x79\x69\xd5\xb3\x7e\xff\x69\x67\x5a\xb0\x9e\xcc\xa2\xef\xe7\x9f\x4f\x67\x38\xc1\x0c\xff\xae\x52\xec\x2a\x80\x9e\xdf\xf3\x76\x6a\x01\xc7\x2d\xbd\xd2\x2f\xc1\x20\xeb\xca\x90\x5a\xcf\xbb\xae\xd4\xfb\x0c\xc9\x2e\x00\xf6\x40\x31\xea\x81\x8a\xbb\x01\x0e\x76\xbe\x8f\xf4\x77\x1a\x77\xbb\xee\x51\x59\xdf\x19\xdc\x4b\xbd\xfc\xd3\x04\xb4\xa3\x5f\xb8\x7e\xfb\xba\x1b\x9e\x04\xee\x76\x5f\x4f\x09\xae\x74\x74\xe4\xe9\x0c\x23\x75\xe7\x17\xd5\x3d\xed\x9e\xa4\xa9\x36\x74\x4d\x1e\x5c\xfb\x8f\xf4\x0f\xed\x3c\x13\x3d\x8d\x2d\x63\xd4\xd0\x0b\x3c\x38\x30\xe5\xae\x4c\x46\xd9\xb6\x11\x4b\x8f\x95\x3f\xc3\xc2\x9f\xb2\xee\xaf\xb3\x6c\xdf\xaa\xbf\xce\xa2\x49\x81\x5e\x3d\xd7\x9a\x41\xaa\x75\x13\xfe\x2f\x1d\xfa\xb0\xd7\x92\xa6\x68\xf6\xe1\x47\xcc\x39\xef\x6a\x0c\x81\x14\x7c\x15\xbe\x20\xf5\xf6\xa4\x09\x1b\x71\x58\x22\xba\x1e\x9a\x0b\xae\x98\xc2\xe7\xec\x33\xe5\x9b\x38\x0f\x78\xee\x36\x0f\x0f\x11\x6d\x1f\xe6\xb0\x7e\x77\xc8\x84\x90\x91\xe0\x57\x2f\x3d\x7b\x47\x8b\xf2\x77\x6c\xbe\x59\x58\xca\x17\x4c\x4d\xb1\x7f\x48\xe5\xa6\xbe\xbc\x62\x06\x6c\x7e\xa1\x93\x42\xcd\x47\xae\x27\xae\xaa\x9c\xe9\xe4\x60\xfe\xa8\x5b\x2c\x12\xec\x14\xdc\x86\x24\x96\x50\xa9\x42\x9e\x25\x23\xa4\x28\x12\xcc\x13\x34\x73\x5e\x76\x60\xd7\x8e\x62\xaa\x8c\x43\xbf\x37\x63\xc1\x2d\x2c\x02\x6f\xd7\x01\xd8\x0e\xbd\x6a\x4e\xaf\x71\x01\x4f\x5e\x23\x9e\x77\xee\x86\x90\x38\x9e\xfc\xb3\x0b\xc6\x3b\x77\x3b\x1a\x7f\x86\x0b\x92\x3e\x9e\xfe\x03\x03\x1d\x1f\xec\x75\x41\x6b\x93\x96\x4d\x7c\xd7\x11\x41\x93\xec\x79\x98\x5e\x6a\xd4\x98\x23\x25\x4b\x5a\xd6\x04\x84\xff\xe5\x0c\xaf\x14\xeb\xd1\xa9\xc8\x23\xfd\xf0\xef\x6c\xc1\xf5\xc5\x92\x11\x27\x1f\x24\x03\x73\x1e\x36\xf6\x3b\x06\xce\xaf\x82\x6a\xd0\xd3\x43\xf7\xaf\x36\xa0\x6c\xc2\xbf\xda\x40\xda\x74\xd7\x22\x9e\xe5\xeb\xfb\x7d\x00\xf5\xac\x3f\xde\xa0\xc3\x14\x36\x47\x49\xd6\x2d\x08\x9f\xa6\xe6\x62\x06\xfe\xdc\x43\xe4\x30\x22\x1f\x89\x04\x23\xee\x87\x8d\xf9\x0d\x63\x4f\xcc\xc6\x25\x1a\x31\x6b\x67\xd1\x60\xe1\x95\xa6\xe7\xcb\xf6\x29\xd1\x6a\x02\xd1\x00\xf6\x7b\xdf\x51\x76\x3b\xcb\xed\xac\x82\x61\xcd\xd4\xd0\x9b\x61\x84\xe3\xbc\x1f\x13\xff\x91\xed\x88\x24\x7f\x4b\x65\xd8\x1c\x09\xe2\xb0\xfe\x84\x55\x72\xc9\x3f\xb5\x5b\x59\xf3\x0d\xde\xd9\xc0\xa3\x5c\xdd\x24\xc0\xb6\x85\x54\x78\x42\x5f\x15\xcd\x59\x00\x1d\x61\xe7\xac\xe6\x5b\xa6\x0f\x76\xb0\xfb\xeb\x8e\x75\xf5\x4c\x28\x99\x58\xa8\xa5\x6c\xc2\x42\x97\x86\xc6\xf1\x46\x02\xcd\xe2\xd4\x17\x49\x5b\xae\xee\x03\xfd\xcd\x14\xc3\xec\xa4\xda\x08\xd5\xfa\x3a\x12\xdd\xd6\x12\xfe\x80\xf8\xcc\x87\xb3\x1a\xb2\xcd\x49\x8b\x08\xa9\xd4\x98\x1d\xa9\x61\x4f\xb2\xeb\xd2\x9e\x96\x0f\xa9\xf7\x0b\x9e\xfe\x14\xab\x8e\x30\x7f\x10\x2f\xda\x6b\x42\x34\x89\x43\xbc\xaa\x3e\x6c\x40\x1c\x6f\x37\xaa\x0e\xac\xf1\x8a\x69\x31\xbb\xa6\x94\xf7\xa9\xf1\x15\xe2\x55\xbf\xe3\xd6\xe5\x01\x61\x77\xed\x90\x0c\xac\xe7\x60\xe5\xe7\xe3\x85\x37\xad\x9b\x89\x01\xb6\x0b\x38\xf2\x46\x7e\x3d\xfd\xed\x69\x37\x79\xfa\xf5\x49\x31\xf6\x59\x8b\x5b\x18\xa5\x72\x88\xb1\x68\xb7\x6b\xcd\x8e\x0f\x11\xd0\x7a\xa5\xbd\xe9\xd9\x24\xbe\xe7\x02\x35\x44\xa3\xe6\x16\xa4\x59\xd9\x0c\xd3\x5f\xe1\x70\x60\x9e\x58\x75\x2f\x52\x87\xa6\x51\xd6\x72\xb2\xd7\x01\xa3\x78\x9a\x50\x55\xf8\x93\xad\x5a\xb5\x2e\xf7\x3e\xcd\x8e\x0d\x51\xff\xac\x24\x8f\x22\x7f\xe8\xb9\x01\x50\xb3\xb9\x12\x35\xcc\xf9\xc6\x8d\xaa\xfb\xe7\x84\xdb\x41\x27\x6d\x62\x22\x87\xfd\x7e\xf0\x3f\x03\x00\x88\x7d\x22\x92\x4c\x4c\x00\x00")
+
+func templatesGlTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesGlTmpl,
+		"templates/gl.tmpl",
+	)
+}
+
+func templatesGlTmpl() (*asset, error) {
+	bytes, err := templatesGlTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/gl.tmpl", size: 19532, mode: os.FileMode(420), modTime: time.Unix(1792221877, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesGles2Tmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x3b\xfd\x6f\x1b\x37\x96\x3f\x57\x7f\xc5\xab\x56\x4d\x66\xbc\xca\xd8\x49\x0a\x1c\x2e\xae\x02\xe4\x14\x57\x6b\x9c\x9b\x18\xb5\x5b\x1c\x90\x2b\x0c\x6a\x86\x23\x71\x33\x22\x67\x87\x94\x6c\x57\xd1\xff\x7e\x78\xfc\x1a\x72\x46\x52\xdc\xe2\x76\xb1\x49\x5a\x68\x38\xe4\xe3\xfb\xfe\x22\xe7\xf4\x14\xa6\xa2\xa0\xb0\xa0\x9c\x36\x44\xd1\x02\xe6\x8f\xb0\x10\x8b\x0a\x92\xa5\x52\xb5\x7c\x73\x7a\xba\x60\x6a\xb9\x9e\x67\xb9\x58\x9d\x16\xf3\xef\xff\x63\x79\x8a\xaf\xd3\x73\x78\xff\x11\x3e\x7c\xbc\x85\x8b\xf7\x97\xb7\x83\xc1\x76\xfb\x02\x58\x09\xd9\x2d\x59\x48\xd8\xed\x06\x83\xd3\x53\xf8\xeb\x7c\xcd\xaa\x02\xb6\xdb\x76\x18\xa7\x51\x5e\xe0\xcf\x41\x4d\xf2\xcf\x64\x41\xf5\xfb\x6b\xfb\xdb\x4e\x39\x3d\x81\x8f\x35\xe5\xb3\xab\x8b\x1b\x78\x95\x9d\x41\xb9\xe6\xb9\x62\x82\x4b\x20\x0d\x85\x8a\xf1\xcf\xb4\x00\xa9\x88\x62\x39\xa9\xaa\xc7\x31\x08\xb5\xa4\x8d\x7d\x2b\x48\x41\x0b\x20\x0a\x9a\x35\x57\x6c\x45\xe1\xe4\xd4\x82\x1d\x51\xf9\xea\x0c\xde\x4c\xe0\x03\xbd\xff\x95\x36\x92\x09\x0e\xaf\xe0\x4c\x63\x73\x7a\xe2\x76\x9e\x59\x56\xc0\x14\xa4\x5a\xcf\xa5\x59\xff\x97\x7c\x21\x70\xe7\xf5\x03\x94\x0d\xa5\x73\x59\x00\x00\xd4\x9f\x17\x2f\x72\xc1\x4b\xb6\x78\x03\x8b\x8a\xca\xcd\x2b\x33\xb1\xf7\x67\xfa\xe3\xd5\xbb\xd9\xcd\x1b\x78\xf1\x7e\xf6\xf1\xf6\xdd\xec\x0e\x27\xbf\x1a\x0c\xfe\xc2\x78\x5e\xad\x0b\x0a\xc3\x45\x95\x2d\x87\xed\xf3\x0f\x52\x15\x4c\x64\xcb\xb7\xd1\x50\xc3\xf8\x02\xc7\x1c\xb7\x7f\x5a\x57\x8a\x4d\x05\x57\xf4\x41\x21\x15\xd1\xf2\x8a\xcd\x71\xee\x60\xf6\x71\x76\x75\x77\x7b\x75\xa3\xc5\x7a\xe7\x66\x9f\xe8\xa7\x7c\xdd\x34\x94\x2b\x98\xc0\x87\x5f\xae\xae\xce\x07\x03\xc3\xd4\x7d\x53\x17\x54\x4d\xcd\xec\x64\x23\x58\x91\xc2\x16\x1a\xaa\xd6\x0d\x87\x10\xd2\x39\xec\x1c\x0c\x9c\x65\xde\xc9\x76\x69\x0c\x38\x47\x28\x1d\x44\x72\x04\x81\x04\xd2\x4a\x52\x24\x6a\x20\x55\xb3\xce\x15\x58\x89\xdd\xc1\xec\xca\xfe\x3c\xd7\x22\x6b\x08\x5f\x50\xc8\x2e\x1e\x14\xe5\x28\x52\xad\x7f\x8c\x2b\xd0\x84\xa3\x76\x7d\x20\x2b\x84\x74\x1e\xaa\x5f\xa8\x89\x01\x94\xa9\x58\xad\x08\x2f\xe4\x6e\x37\x40\xb9\x59\x46\x8b\x06\x12\x2e\x14\x64\x76\xe3\xec\x27\xf2\x77\xd1\xa4\x90\x68\x8d\xca\xae\xa8\x94\xfe\x5d\x0a\xc1\xda\x51\x43\x15\x2a\x5c\x76\xfb\x58\xd3\x6c\x26\x34\x26\xaa\x59\x5b\x4d\x47\x93\x41\xb8\xa3\x9e\x24\xaf\x7f\xfc\xb0\xdd\xc2\xad\xf8\xa5\xae\x69\xe3\x29\x80\xba\xe4\x21\x45\x5e\x6e\x11\x61\x76\xb7\xa9\x9b\xa4\x19\xbc\xdd\x6a\x20\xbb\x5d\xe2\x91\x33\x24\x8f\xd8\x18\x46\x54\x23\x79\x4d\x1a\xb2\x72\xa4\xbb\x59\xac\x84\x85\x82\x11\x83\xb3\xdd\x6e\x0c\xdb\x2d\xe5\x45\x67\xc6\x88\xda\x0d\xdf\xd3\xbc\x82\x11\xb5\x1b\xf9\x7d\x0c\x62\x29\x6c\xed\x08\x2b\x35\x5f\x76\x3b\xab\x3f\x1a\x26\xbc\xf0\x2b\x22\x44\xff\x09\xc8\x06\xe8\x75\x50\x3c\x1f\xb4\xa2\xf3\xec\xf4\x3f\x07\xea\xb1\xa6\x05\x2d\xb5\x66\x9f\x40\x72\x02\xb3\x9f\x3f\xce\x2a\x41\x8a\xba\x11\x79\x9a\xe4\x82\x4b\x05\xf9\x92\x34\x70\xc2\xc9\x8a\xa6\xe7\x03\xad\x87\x9a\xff\x38\xcd\x6a\x48\x12\x2e\x03\xfc\x41\x1b\xe4\x0e\xee\x3a\xda\xec\xf7\x4f\x2d\x0b\xbc\x8a\x86\x7a\x86\x2c\xdd\xc4\x7a\x18\xa9\xe1\x06\x26\xd1\x0b\x4d\xe5\xe9\x69\xeb\x65\xb7\x5b\xff\x3e\xbb\xd1\x7e\xc6\xad\x67\x25\x24\xde\xe2\xb2\x15\x2a\x3e\xfc\x10\xcd\xd7\xc6\x80\x8a\xf6\xe5\x4b\x7f\xea\x64\xb2\x7f\xee\xb3\x67\xad\x1d\x67\x2b\xc6\xfb\x50\xf5\xd8\x6e\x97\xa6\xce\xcf\xbc\x3c\xf7\x04\xb5\x72\x75\xd4\xf7\x0d\x31\x24\x20\x31\x86\x63\x35\x0b\x26\x90\xec\x37\xb0\xd4\x48\x23\x19\xfa\xb9\xc3\x34\x85\x89\x31\x33\x8f\xc8\xd9\xf9\x11\x2d\xc1\x37\x2d\xc2\x3b\xef\xab\x63\xff\xe4\x9c\xa4\xd7\x8f\x25\x91\x7e\x46\xa4\x49\xf4\x41\xc9\x31\x74\x46\x9c\x31\x49\xf6\x3b\xbd\x53\xc0\x61\x02\x52\x35\x15\xe5\x09\xbe\x34\xe8\x85\x4b\x6a\x98\x00\x02\x32\x6f\xee\x97\xac\xa2\xc8\x14\xb3\x4c\xaa\x26\xa9\xc7\xf8\x3e\x4d\xe1\x5b\x47\xeb\xd6\x1b\x88\x61\x20\x72\x01\x41\xc0\x97\x2f\x50\x7f\x7a\xf1\xf2\x37\x1c\x78\x0e\xcf\x53\x78\xf6\x0c\x92\xfa\x13\x77\x03\x66\x82\x7d\xfc\xdf\xb3\xe7\x3d\x01\xe2\xbf\x1a\xfe\x3a\x01\x6e\xd0\x89\x78\x76\x86\x3c\xc3\xec\x41\x73\xa5\x64\xbc\x08\x18\x27\xa9\x92\xa0\x96\xd4\xb8\xf6\x13\x44\xd9\xf8\x7c\x28\x2b\xb2\x90\xd9\xe0\xf4\x74\xd0\x86\x9d\x78\xb1\x8b\x5a\x3d\xde\x68\xa2\x26\x10\x31\x3d\x5d\x54\x33\xaa\x8c\x21\x24\xb3\xab\xbb\x8b\xff\xb9\xbd\xf8\x70\x73\xf9\xf1\xc3\x4d\x7a\x24\xe8\x20\xe4\x6e\xd0\xb1\x8c\x77\x7c\x45\x66\xf5\xe5\x8d\x28\x8c\x61\x18\x2c\x1b\xa6\xe7\xa1\x52\x05\x2c\x41\x0d\x0d\xb6\xc5\x47\xc3\x93\x36\x49\x12\x25\x90\x0d\x61\x15\x99\x57\xb4\xe5\x91\xcc\xa0\x5d\x87\xe0\x4a\xd1\xc0\xfd\x92\xe5\x4b\x20\x7e\x31\xe4\x84\x63\x44\x9a\xb7\x89\x54\x43\x35\x77\x17\xf8\x5b\xc2\x9a\x7b\xd0\x1d\x76\xc7\x88\x1d\x73\x72\x07\x99\xe7\x6c\xa5\xeb\xe2\x50\x03\xbb\x8c\x0d\x35\xf4\x88\x77\x74\xcb\x7b\x91\xd3\xe9\x79\xef\xc5\x13\x7c\x83\x1f\x1b\xa6\xe7\x11\x12\x81\x0f\x38\xa0\x0c\xdb\xad\xc5\x54\x87\xb2\x1c\xbd\x7d\x88\xb3\xc9\x09\x46\xcc\xba\xc8\xed\xd6\x82\xb4\x58\x8e\x72\x0f\xca\x2a\x94\x9f\x11\xda\x52\x80\x4a\xf0\x33\x7a\x18\x9c\x9c\x0e\xd8\xaa\x16\x8d\x82\xe1\x74\xa8\xdf\x8c\x74\xaa\x32\x9c\x66\x43\x83\xc8\x68\x43\x1b\x3b\xe2\x5d\xf5\xd0\xc1\xdc\x93\x7e\xe2\x12\x4c\x25\x87\x2a\x82\xa0\x07\x36\xed\x72\x8f\xb1\x86\x33\x22\x35\xd3\x9b\xb8\x50\x84\x53\x1c\x66\x26\x57\x19\xd2\xa6\x11\x8d\x1c\x9a\x87\x72\xa5\x86\x1e\x83\x9b\x8a\xe5\xd4\xcb\x7a\xd8\xd0\xb2\xa2\xb9\x1a\x86\x84\xea\x45\x52\x5b\xb2\x03\xb1\xe6\x92\x94\x74\x38\x48\xb5\x55\x4d\x45\x43\xaf\x1b\x51\xa2\x4f\x64\xd2\x64\x67\xac\xd4\x26\xf5\xee\xfa\x12\xee\x89\x44\x77\x51\xb2\xc5\xba\xa1\x85\x36\x1a\x7c\x65\xd0\x85\x5c\x34\x14\x6a\xb3\x1a\xed\x01\x6e\x97\x4c\x02\x93\x40\xaa\x7b\xf2\x28\xa1\x24\x98\xc5\xb2\x52\x83\x62\x12\x90\xc2\x57\x38\x71\x60\xdc\x4d\xb8\xb9\x89\x94\xe1\x88\x2d\xa4\x70\x2d\xe6\x1e\x6f\xdc\xae\xa2\xb1\xbf\x2e\x6e\x34\x2c\x7c\x69\x76\xe0\xca\xaf\xf8\x95\x54\x6b\x2a\x83\xbd\x0c\x37\x2d\x08\x9c\x3d\x01\x26\x14\x09\x46\x2f\x6e\x90\x27\xe8\x0b\x20\x21\x08\x24\x05\xeb\x03\x53\x0c\x14\x98\x15\x6c\x9d\x41\x12\xf4\xef\x16\x58\x6b\x89\xd6\x8b\x0f\xcd\x8b\x61\xdf\xbf\xdb\x37\x17\x37\x43\xeb\xd4\x5c\xb4\x6e\x68\xdd\x50\x49\xb9\x92\x40\x38\xee\x0d\x56\x67\x5a\x0a\xdd\x54\x5b\x10\x98\x5d\x71\xa6\xfe\xbf\x7e\x32\xb9\x08\xe3\xca\x3c\xe9\x1c\x02\x9f\xcc\x5e\xb3\x0b\x8b\x46\x2b\x66\xbb\x09\x6c\x50\x68\x8b\x86\x12\x45\x1b\x10\x0d\xd0\x7f\xac\x49\x05\x4a\xb8\x4d\xb7\xa4\x66\x63\xd0\x39\xcd\x18\x74\xbe\xb2\x43\x88\x84\x17\xb0\xc9\xac\x70\xfd\x1a\x54\x10\xd4\x6b\xd2\x2c\xd6\x2b\xca\x95\x26\x41\x2b\x07\x85\x52\x54\x95\xb8\x47\x56\xd2\x07\xb2\xaa\x2b\x0a\x72\x29\xee\x25\x2c\xc5\x3d\x6e\xb7\x46\x75\x51\xc0\x38\xe4\x62\x55\x13\xc5\xe6\xac\x62\xea\x11\xf2\x25\xcd\x3f\xcb\x37\x16\x10\x58\xd3\x5c\x54\xd9\xcf\xa6\xca\xb5\x68\x26\x29\x62\x05\xf2\x9e\xa9\x7c\xa9\x67\x6d\xf5\x40\x4e\x24\xc5\xc7\x6c\x76\x91\x18\x09\x8c\xe1\xfb\x31\x9c\xa5\x18\xad\xa3\xf1\x8b\x9b\x31\xbc\x1e\xc3\xcb\x14\xf7\x42\x26\x02\x9c\x9e\x02\xd6\xd9\xb0\xa8\xde\x37\xe4\xfe\x5d\xd3\x90\x47\x79\xc9\x0b\xd6\xd0\x5c\x1d\x84\xae\x61\x1c\x82\x7e\xf6\x55\xe8\x52\x11\x9e\xd3\x42\xcf\x2a\x68\x49\xd6\x95\x8a\x96\x94\xa4\xaa\xe6\x24\xff\xac\xc7\x50\x14\x56\x6d\x37\x4e\x60\x29\xcc\x2e\x12\x14\xc2\xbb\xeb\xcb\x58\x70\xc0\xb8\x4a\x61\x2e\x44\x05\xdb\x50\x35\x8d\x1c\x27\x13\xc0\x55\x98\xd4\x6c\x6c\xba\xfa\xd6\x2c\xd7\xc4\xd8\xa1\xc9\xc4\x8e\x3d\x7b\x06\x1b\x9b\xab\xbe\x9d\x18\xf8\xa9\xd5\xb6\x77\xd7\x97\x16\x97\x56\xeb\x96\x74\x8f\x0d\x7b\x25\x94\xeb\x1a\x3d\xb2\xe9\xc2\xa0\x12\xd9\x1e\x49\xe6\xe9\x6b\x61\x26\xa9\xa3\x34\xa6\xc2\x0e\xc6\xc1\x91\x95\x40\xff\x01\x9a\xbe\xe1\xa2\x1a\xee\x76\x46\x07\xd0\x15\xa3\x7f\x72\xcf\x17\x37\xde\x39\x8f\xf7\xa6\xed\x9d\x51\xa4\xd6\x17\x6e\x2e\x4b\x89\x15\xf2\xa9\xa4\xb7\x09\x4b\xdb\xb9\x19\x23\x38\x93\xa1\xac\xc8\x23\x14\xac\x2c\x69\x03\x65\x23\x56\x01\x1f\x5a\xde\x74\x2d\x21\xe0\xcf\x81\x88\xa5\xf1\xd6\x51\x6f\x9a\x75\x9b\x1c\xa9\x73\x74\x0a\x1d\x1d\x67\x4e\x59\xf6\xb0\x1a\x7c\x20\xc3\xaa\xf3\x6c\x0c\x67\x8e\x23\x9d\x38\xf4\xff\x29\x22\xc7\x76\xfc\x3b\x46\x95\x4e\x5c\xc0\xdd\xed\x4c\x99\x96\xf6\x87\x51\x60\xa9\x13\xd4\x25\x67\x6a\x0a\x8c\x33\xc5\x48\xc5\x7e\xa7\xd2\x4a\x25\xb3\xe9\x1a\x7a\xc4\x20\x33\xac\x05\xe3\xe8\x1c\x95\x00\x02\xd3\x76\x5c\x94\xa0\x1e\x6b\xea\x3c\x53\x58\x24\xc3\x49\x72\xe2\x52\xbf\x28\xbf\xc6\xc5\x98\xc8\xa4\x76\xd5\x65\x1c\x20\xc7\xda\x05\x4a\xc0\x30\x8d\x4e\xb1\x26\x12\x51\x41\x21\x04\x58\x28\x0c\xb5\x0e\x0d\xa4\xd5\x64\xb3\x08\x4f\xbb\x3b\xc1\xab\x47\x13\xa0\x8f\x34\x13\xe3\x6c\x16\xa1\x19\xbe\x24\x96\x07\x26\x57\xc8\xae\xcd\xb6\x29\xe8\x5c\x04\xb6\x83\x6f\x70\xd6\x4c\x24\x9c\x55\x5e\x53\xec\x92\x43\xea\xc2\x59\x65\xd5\xc2\xce\x9f\x66\xbd\xd6\x40\x32\xcd\xc2\xc4\x39\xb5\x68\x98\x22\xf4\xac\x0f\x53\xa3\x23\xb3\x0f\xf4\x3e\x19\x96\x84\x55\xb4\x40\x7e\xb5\x32\xf5\xa4\x0f\xd3\x40\x25\xfb\xf5\x28\xbe\x9b\x66\xfb\xca\xa6\x34\x7c\xd7\xc9\xf1\x0f\x62\xab\xd7\xc8\x47\x9e\x47\x80\xf6\x5b\x03\xb2\xa5\x55\xc8\x99\xd8\xab\x91\xb7\x4b\x0a\x0d\xcd\xc5\x6a\x45\x39\xb6\x77\x37\x98\xdc\xe8\x64\xcc\x32\x1d\xa3\x77\x55\xde\x67\x33\xaa\xae\x1b\x91\xbf\x2b\x8a\x86\x4a\x69\x53\x32\x5b\xcc\x34\x5e\xf6\xb0\x5a\x4b\x05\x35\xe1\x2c\x07\x61\xb9\x98\xfd\xbb\xea\xe2\x4c\x58\xbe\x6a\xf4\x13\x93\x88\xa5\x3d\xcd\x34\xba\x61\x13\x03\xe7\x62\xdc\x6e\x63\x78\xf1\x12\xff\xdb\x7d\xdd\x15\xda\x56\xec\x2d\x56\x8f\xb1\xd0\x06\xdf\x6c\xa4\x71\x96\x33\x61\xf3\xc2\xe4\x64\x9a\x61\x8b\x21\x4d\x62\x74\x92\x69\x16\x96\xd0\xd3\x6c\x76\x45\xf9\x7a\x85\xb5\xf4\xaf\x17\x3f\x63\x25\x9d\xe2\x1f\x8d\xb2\xce\xff\x6d\x86\x9e\x5d\xf2\x82\x3e\xfc\x88\x74\x6e\xe4\xd8\x10\xdc\x60\x3c\xa0\x51\xc4\x0e\xd4\xa7\x81\xb7\x13\x78\x7e\xf6\x1c\x2b\xeb\x06\x7e\x98\xc0\xf3\xff\x7c\xae\xe7\xec\xbc\x5d\x32\x78\x1b\x1b\x4f\xb9\x52\xd9\x8d\xcc\x09\x2f\x93\x8d\xfc\xc4\xde\xfc\x36\x86\xe1\x77\x45\xf6\x5d\x31\x1c\xc3\x33\xcc\x57\x74\x90\x77\xbf\xb5\xd3\x8c\x2d\xf7\xdb\x5e\x52\xf3\x0a\x93\x9a\x60\x0b\xad\x5b\x91\x4d\x16\x94\x2b\x56\x3e\xf6\x42\x9f\xb7\x4c\x5c\xd7\xf5\xe1\x80\xdc\x46\x2f\xee\xb1\x4a\x7b\xf3\x18\xef\xce\x6b\x31\x6e\xbd\xd2\xb7\x5d\xaf\x14\xba\x9d\xed\x76\x9f\x4e\xa8\x31\xf8\x7c\x60\x43\x9b\xb1\x05\x75\xdc\x93\x1c\x75\x25\x7f\xb6\xfa\x47\x72\x11\xa3\x5e\x41\xfd\x6d\x2c\xd8\x23\xe4\x7e\xa5\x47\xd0\xd9\xc8\xd6\xdb\x7e\x9f\x9e\x4f\x77\x7f\x0f\x2e\x80\x69\xb6\xbf\x8f\x90\xec\xed\x23\xa4\xbe\x8f\xd0\x2a\x83\x43\x3b\xf0\x99\xf1\x4b\x56\xfe\x91\x7e\xc2\x97\x2f\xad\x38\x3b\x68\x07\x7d\x05\x43\xa9\x9f\xd8\x21\xf9\xa0\x20\x26\x70\xe6\x27\xba\xec\xa7\x83\x7f\x87\x94\x63\xe1\x61\x67\xab\xd0\x27\xe8\x28\x9c\x58\x65\xb3\x63\xb1\xce\xba\x5c\x6b\x0c\x4f\x73\xa1\xbe\x5b\xfe\x6f\xd6\x82\xef\x3b\x9c\xa7\xa6\xe7\x71\xa7\xcc\x38\xcd\xbe\x7c\x9e\xdc\x4d\x8f\x15\xc7\x36\xc9\x9f\xa6\xed\x76\xb2\xd3\xf5\x40\xdc\xc1\xcf\xc3\x01\x0a\x63\xe9\x75\x23\x72\x1d\x98\x60\x29\xaa\x4e\xdf\xd3\x85\x63\x39\xf6\x27\xbf\xbe\xb8\xe0\x45\xb7\x57\x8c\xd0\xb0\x4f\xca\x51\x5d\x6c\xee\x8e\x7d\x1e\xdc\x2d\x83\x1f\x7d\x5c\xc6\x7a\x1c\x2b\x31\xa5\x77\x75\xc7\x94\x8c\xeb\xad\xb1\x6e\xc5\x4a\xfe\xe3\x0d\x82\x53\xcb\x86\x92\x62\x0c\x92\x2a\xb8\x67\x6a\x09\x3f\x91\xcf\xd4\x96\x13\x99\xcd\xb6\xb1\x5f\xd0\x4b\x73\x0c\x2c\x0b\x5a\x6f\xa4\x2b\x9f\x1c\xbb\x11\x08\x5e\x70\xdd\x42\xe2\x34\xa7\x52\x92\xe6\x71\x0c\x12\x73\xf0\x96\x19\x3a\xd3\xe5\x94\x22\x47\x04\xcc\x29\xac\x08\x27\x0b\x53\x3d\xd3\x87\xba\x62\x39\x53\xd5\x23\xdc\x2f\x29\x87\xb5\x44\x90\x92\x6e\x68\x43\x2a\x47\x71\xdb\x58\xb0\xac\xcb\xae\x44\xfe\xf9\xe3\xcd\xad\x26\xc9\x36\x15\xd4\xcb\x31\xa8\x57\xb6\xed\xf0\x81\xde\xfb\xfd\x93\x74\xdc\x1f\xd2\x4b\xee\x19\x2f\xc4\xfd\xcb\x4c\x73\xc2\x6c\xd5\xd6\x57\x38\x41\xd9\x77\xd1\xe0\xa2\xca\x6c\xde\xb3\x27\xa5\x0b\x01\xbf\x3a\x02\xf8\xd5\x9f\x03\x7c\x7a\xea\x9a\x27\xd8\x5d\x40\x76\x5a\x1a\xfe\x34\x3d\xb8\xf0\x9d\x34\x1a\x61\x0b\x60\xc7\xf5\x71\xa4\x45\x0b\xd1\x88\xb5\x62\x9c\x9a\xec\x54\xb7\xe0\x73\xbc\xe9\x80\x19\xbd\x92\x91\x96\x19\x70\xfb\x84\x65\x72\xe5\x58\xdb\x61\x43\x1a\x86\x62\x31\x79\xa6\x5a\x0a\x4c\x49\x4b\xc4\xac\xd5\xed\x8a\x48\x05\x2b\x52\xb4\xaa\x28\x9a\x40\x49\x8b\xb6\x0f\xe7\xa5\x1c\x77\xe2\x7a\xae\xd8\xa6\xf5\xa1\x5e\x58\x07\xa4\x33\x68\x7a\x0f\x74\x55\xab\x47\xb3\x7f\x06\x97\xca\x13\x1e\xa1\x41\xb8\x56\xe4\x00\x15\x43\x3d\xea\xc8\x14\x7b\x75\xd6\xa0\xcc\xb1\x16\x12\x94\x8b\xa6\xa1\xb2\x16\xbc\x40\x5d\xb7\xdc\xc6\x8c\xde\x82\x6c\xf3\xeb\x58\x67\xe1\xa4\x45\x34\x6a\xaa\x3c\xf3\xe3\xdb\xa4\x43\x64\x9a\x4c\x33\xf4\x03\x22\x4f\x5e\x8e\x61\x9a\xe1\x91\x9c\x28\xef\xa2\x29\xbe\xe4\x0e\x74\x03\x56\xe4\x33\x95\x50\x07\x12\x58\x4b\xd3\xf9\x69\xab\x02\x04\x8c\x4d\x67\x6c\x79\x1c\x72\x38\x2d\x31\x49\x1d\x10\x90\x86\xee\x27\x71\x31\x60\x9a\x75\x6f\x64\xd4\x99\x4a\x0f\xa4\x72\x4f\x88\xd2\x51\x03\xb7\x6d\xf4\x58\xc5\xf4\xdd\x1d\xec\x11\x2c\xa9\x97\x44\xad\xbb\xea\xa1\x40\x4b\xd1\x1c\xa4\x63\x5f\x43\x27\x90\x8d\x1d\xec\x74\x61\x30\x67\xae\x33\x95\x59\x0c\xa2\x8e\x48\x34\x1e\xb5\x44\xde\xd3\x8a\x2a\xaa\x6f\xf8\x48\xa8\x5b\x95\xb4\x47\x62\x4e\x23\x19\x86\x94\x47\x73\xeb\xe8\x6b\x52\x30\x20\xbd\x00\x58\xb9\xaf\xd7\x84\xd5\x7d\x9d\x39\x43\xda\x2f\x28\xdf\x69\x70\xd5\x3a\x62\xd9\x2d\xbb\x50\x9a\x26\xbc\x22\xb8\x49\x50\x5c\x5b\xdc\x63\x2b\x6c\x75\x4f\x94\x91\x82\xb9\x58\xe6\x63\x90\xb1\x57\x13\x89\x10\x1c\xd7\x3f\xf7\x58\x54\xb8\x0f\x5a\xd4\x34\x0b\x4d\xa1\xe5\xc2\xa1\xb6\xdb\x39\xa8\x7e\xf2\x6e\x45\xad\x02\xfa\x95\xbd\x28\xe1\x59\x9d\xa4\x99\x3a\xa0\xe3\x2a\x0d\x15\x46\x0d\x76\xa1\x16\xef\xd7\x7d\xa4\xf1\xa2\x73\xbc\x8c\x9e\xf5\x51\x7b\x50\x0c\xf1\xf3\xc7\x9e\x07\xc2\xe8\x8e\xaf\x83\x33\x24\x84\xd3\x3a\x63\x26\xdb\x22\x3f\xbc\xa2\xa6\x17\x56\x95\x76\xf3\x81\xf5\x8b\x75\x55\xb4\x47\xb1\xda\x40\x36\xa4\x81\xe4\x6b\xa7\xa8\x6d\x5a\x8e\x35\x73\x48\xac\x3b\xde\xe9\x9a\xf5\x9f\xed\x91\x76\xf8\xf8\x24\x9c\x26\x87\xab\x08\x2c\xe7\x42\x98\x7b\x05\x35\x13\x78\xdd\xc8\x4b\x69\x26\x74\xff\x51\xc2\x8a\x61\x33\x0c\xdd\x23\xaa\xf5\xd4\x8e\x3a\xa7\x1a\xa4\x79\x9e\xc3\x19\xdc\xe4\xa4\x22\x0d\xd4\x78\x99\x88\x2a\xda\x48\x77\xa8\x63\x75\x45\xf7\x99\xa4\xb3\x8e\xf6\x96\x64\xd9\x4d\x10\x57\x63\x7b\xad\xc2\x65\xa1\xf0\x99\xd2\x1a\xa1\xd5\x15\x61\x1c\x66\x02\x68\x45\xf1\x30\xc8\xe0\xa5\xc3\x34\x6b\x20\x87\x15\x55\x4b\x51\xa0\x6f\xdc\xd0\x46\x69\x9b\x5c\xb9\x43\xa4\x38\x90\x4d\x11\x1c\xae\xd6\x8d\xaf\x7c\x2d\x95\x58\x01\x5e\x37\xcc\x45\x41\x81\x14\xd8\x18\x73\xbd\xa8\xf0\xe4\x40\x2f\x89\x94\xc6\xb0\xb0\xa0\xe5\x5e\x01\xa1\x02\x99\x19\xb0\x8b\x24\x90\x1e\x86\x81\xa8\xe5\x2d\x11\x1b\xdb\x26\xce\x02\xb8\x81\x8b\xdc\x84\xfb\xa5\x90\x27\x69\x3c\x15\xb6\xa1\xc1\x46\xaf\x92\x4d\x1a\xab\x05\xee\x8c\x6a\x7e\x2b\xa6\xed\xfe\x73\xb3\xff\xec\x0a\xdf\x50\xc2\xdb\xbd\xed\xd4\x64\xae\xfb\x49\xb8\xaf\x9f\x64\x77\x65\x25\xcc\xed\xcf\x00\x89\x97\x81\xef\xb1\x43\x67\x7d\x44\x66\x57\x30\xc5\x3e\x37\xe1\x4a\xb6\x0a\xdb\x88\x75\xad\xb9\x64\x3a\x8c\x7e\x06\xa8\x25\xc1\xc0\x52\x09\x54\x5a\x44\x18\x93\x73\xbc\xb4\xc1\xd7\x2b\x58\xe0\x32\x58\x92\x8d\xad\x40\x50\x8a\x5a\x11\x89\x32\xef\x7c\x77\x33\xb8\xfe\xba\xe6\x28\xed\x42\xeb\x70\x4e\x38\x7a\x0f\xec\x9e\x1b\xcd\xc0\xa0\xe5\x55\x1d\x63\x24\xde\x2c\x65\x7c\x81\x70\x5a\x13\x40\x6d\x0f\x10\x40\x78\x32\x0b\x09\x0d\x4e\x94\x03\x7d\xb8\xe0\x6b\x7d\x79\xf0\x9b\x40\x58\xdb\xad\x49\xd5\xac\xb2\x38\xe5\x0a\x6f\x01\xd8\x63\x6f\x7d\x5a\x1d\xa8\x5b\x4f\xdb\x3c\x0f\x71\xc2\x68\x81\x0e\x29\x73\x5c\x47\x98\xf6\x72\xe5\x6e\x87\x79\x5e\xc8\xaf\x00\x1d\xc3\xd7\x4d\x7b\x30\xae\xe7\xc4\xab\xd7\x8c\xab\xd7\xaf\xbc\xec\x34\x55\xfb\xf7\xd9\xf4\x0f\xd8\xbb\xec\x08\xac\x6b\xb4\x68\x97\xde\xc5\x80\x3a\x6f\x0d\x47\x30\x24\x59\x9e\x75\xde\x27\x01\x45\x69\x7b\x3e\x14\x8c\x7a\xe6\x86\x52\xeb\x39\x6b\xfb\x33\xe4\xf2\xdf\x08\x2f\xb0\x4a\xb0\x33\x46\xcb\xaf\xb3\x19\x6f\x3a\x22\x9b\x89\x7e\x3d\xad\xb0\x3d\xbe\xdb\x81\x98\xff\x9d\xe6\xea\x09\x4c\xb6\x3b\xff\xa4\xbd\xa0\x77\x25\x01\x31\x3a\x27\x91\x1a\xc0\x74\x55\x04\x40\xb4\x66\x21\x6f\x96\xd9\xcf\x34\xdf\xc4\x1e\x26\x1a\xc7\xc5\xa3\x65\xbb\x34\x0d\x95\xe2\xab\x17\x62\xe3\x36\x5a\xd0\x5f\x42\xa8\xd4\xa3\x69\x9e\xc2\x8b\xbe\xe6\xf2\x87\x17\x07\x9e\xd8\xb9\x34\xb0\x47\x4d\x12\x37\xf2\x88\xde\xff\x5d\xb3\x38\xbe\x3b\x09\x85\xdd\xf1\x8b\x9d\x9f\xf1\x75\x19\xd4\x66\x89\x97\x67\xae\x55\x63\x3d\x1c\xd6\x64\x36\x78\xb9\xf0\x53\xb2\x46\x2a\x1f\xb7\x44\x69\x96\x00\xde\x6e\x41\x8f\xa5\x05\x60\x73\x1c\x2c\x7b\x5c\x98\x34\xb3\x0a\xa2\x08\x1e\xca\xcd\x1f\x15\x86\xbb\x4b\x7b\xde\x22\xd1\xac\xf4\x15\x19\x4c\xaa\x89\x01\xd9\x0a\xce\x21\x95\x48\x4c\xd5\x69\x53\x92\x9c\x6e\x77\x29\x74\x12\x5d\x74\x5e\xca\x31\x53\xdf\x99\xb5\x77\x7f\x8c\x23\xf9\x58\x26\xd2\x37\xc0\x37\xd9\x7f\x33\x5e\x24\xfa\x6e\xa3\x9b\xa5\x39\xd1\x6b\xd7\xeb\x73\x81\xba\x61\x5c\x95\xc9\xf0\xbb\xdb\x0e\x92\xc3\x31\x48\x9b\x5c\xfb\xbe\x1c\x5e\xba\xe5\xc9\xa1\xd3\x39\xce\xaa\x31\x9c\xf5\x03\x47\x27\x69\xdf\xf8\x5f\x69\x3a\xf6\x20\x4f\x90\xc4\x64\xa3\x35\x2a\x49\xb3\x8b\x8a\xae\x92\x34\xbb\x61\xbf\xd3\x24\xed\x86\x40\x27\x60\x9b\xb6\xb5\x06\x6c\x0e\x6c\xdc\xb8\x2d\x95\xbc\x06\xd8\x61\xa4\x93\xe1\x55\x14\x14\x73\x7b\xf9\xc0\xe6\x3e\x46\x3b\xcc\xb0\x4d\xfc\xb4\x34\xf1\xc2\xcb\x6a\x4e\x75\xd6\xc1\xb8\xeb\x25\xd8\xba\x8a\x16\x0e\xf8\xdd\x49\x2b\x48\x39\xb6\xc7\xf9\x58\x92\x13\xdb\x44\x6b\x53\xa9\x36\x35\x36\xf0\x08\x2c\xd8\x86\x46\x97\x81\xc6\x40\xb3\x45\xe6\x61\xcf\xae\xee\x5e\xdf\xbd\x1e\x63\x0a\xee\x86\x2e\x6e\xee\x5e\xdf\x9d\xe9\x0c\xc9\x35\x4b\x33\xf8\x45\x6a\x25\x25\x3a\xf7\xb2\x0d\x9e\x31\x34\x2c\x5f\x52\xa9\x1c\x6c\xa3\xee\x63\x54\x7e\x7d\xdb\xc6\xdf\xf5\xb2\x78\x99\x8b\x38\xa6\x6d\xe2\x91\xf6\x8d\x33\xdb\x35\xca\xd5\x83\xed\x90\xf9\x24\x39\x4b\x70\xd7\x34\xbc\x89\x83\xaf\x5b\x12\xbe\xbf\x7b\xdd\xde\x6d\xc9\xd5\x43\xf6\x9e\xc9\x9a\xa8\x7c\x39\x15\xab\x7a\xad\x68\xf2\x30\x86\xc7\x31\xfc\x9e\xfe\x81\xdb\x30\xb8\xa5\xe3\x49\x2b\x00\xab\xa2\x07\xaa\xf6\x80\xcf\x9d\x8a\x3d\xd3\xcb\x7a\x85\xb8\x03\xd6\x0a\xae\x7b\xbd\x2a\xac\xfa\x1d\x68\x26\x83\x8b\x2e\x6d\x11\x64\xf6\xf0\xa0\x12\x73\xe0\x67\xab\x57\xcb\xcc\x08\x5d\x47\x9d\x13\x93\x13\x67\x48\x46\x74\xa3\x66\x2e\xd4\xd2\xe9\xa9\xcd\x8f\x75\x9e\x14\xb6\x5f\xf7\x75\x83\x2f\xdb\x7d\xb1\x2c\x65\x65\x08\x01\x01\x2e\x89\xb4\x2d\x02\xca\x7b\x2d\x33\x54\x15\x67\x38\x49\xea\xb1\x36\x92\x40\xdc\xf5\xf9\x27\xde\xdd\xb6\x1b\xca\x14\x5e\xc0\xcb\x73\x7b\x6c\x79\x0e\xec\xc5\x8b\xc0\xb5\xb0\xd2\x21\x26\x3f\xb1\xdf\xb2\x90\x5f\xed\xa4\xc0\xd7\x04\x93\xf7\x1e\xd0\xf4\x4e\xe1\xdd\x02\xa8\x98\x54\x31\xa7\xbd\x93\x20\x5a\xf7\xd1\x23\x30\x8e\x2d\x02\x4c\x5b\x1d\xcf\x7d\x99\xea\x01\x4d\xe0\x53\x96\x65\xbf\x59\x28\xe1\x1d\xe0\xd1\xc6\xa7\x48\x76\x76\x58\x0e\x6e\x77\xe3\xd0\xcb\xed\xfa\x0b\x71\x60\x94\x6f\xa2\x2c\x65\xda\x07\xe4\xfc\x8d\xa1\x26\x10\xf1\xde\x33\x97\xd6\x23\xb5\xe9\xcb\x3e\xa0\x5d\xab\x42\x64\x74\x48\xcc\xae\x1b\xba\x71\x74\xed\x59\xe9\x67\xdb\xcc\x2d\x9c\x37\xd8\x73\xe8\x77\xf4\xe4\x29\x48\xab\x9e\xf4\xc5\x8c\x5b\xe9\xa6\xed\xc9\x2c\xf6\x7d\x30\x73\x3c\xc3\x89\x56\x84\xb7\x93\x52\x5f\x01\xec\xf9\x02\xaa\x57\x0b\x78\x6a\xed\xa3\xfd\xe6\x06\xf2\x3e\x0f\x6d\xeb\x79\xdb\xe7\xfa\x3e\x45\x72\x1b\x80\x3b\x58\x4c\xf6\xcc\x4a\xfb\x0e\x0e\xb6\xa1\x8d\xec\xef\x34\x3e\xf5\x20\xee\x85\xde\xfe\x38\x02\x5d\xef\x17\xef\xdf\xbd\xe0\x86\x07\x82\xdb\xed\x1f\xc7\x64\x67\xaf\x46\x1c\x3d\xed\x83\x67\xcf\x02\xa9\xa2\x2f\xef\x7d\xa5\xb6\xa7\x21\x94\xb5\xf5\x88\xae\xda\xa3\xdb\xeb\x09\xc3\xcb\x1d\x81\x12\x9f\xa5\x8e\x74\xdb\xf2\xeb\x2b\xc0\x01\xdd\xef\x33\x71\x94\x6f\x5a\x3e\xee\x31\x8b\x27\x98\xc4\x31\x73\xf8\x63\xa6\xe0\x48\x40\x33\xf8\x63\x26\x60\x25\x1e\x14\x80\x9d\x15\x56\x17\xfc\x82\x7f\xa5\x07\x38\x6c\xe6\x56\x52\x76\xf5\xe1\x9f\x98\xa4\xde\x36\xe8\x33\xad\xb7\x56\xf8\x80\xd8\xbb\xa3\x29\xec\xdc\x61\x4d\xe9\x9b\x6e\xde\x1b\x63\xce\x5f\xd0\x07\x9b\xa0\xe2\x3a\x60\x6d\x53\x34\x00\x64\xe3\x8d\x39\xe5\xdf\x1e\x52\x21\x24\x24\xba\x83\xb1\x27\xd8\x74\x30\x7f\x4f\xe7\xeb\x85\xc3\x7c\x41\xd5\x05\x36\x1c\x6d\x7d\xaa\xaf\x3b\x99\x01\x97\x90\xe8\x2c\x52\xd3\x51\xe8\x85\x2b\x51\x50\x9d\x4d\xcc\x1f\x75\x4f\x46\x82\x5b\x82\xf0\x24\xd6\x5c\x44\x21\xcd\x92\x5a\xa0\xc8\x12\x4c\x2c\x34\x71\x41\x3a\xe1\xf6\x4e\x52\x5b\x4a\xc7\x8e\xc2\x8c\xb9\x3b\x58\x76\x66\xb7\x66\xc0\xd6\xe9\x55\x7b\xd2\x8d\xb0\x03\x56\x8d\x58\xd1\xbb\x4f\x62\x39\x71\xf4\xa3\x56\x63\x98\xdb\xad\x1d\x7f\x82\xf5\x59\x51\x1c\xff\x7c\xb3\x67\x7e\x7b\xad\xcf\xa9\xa3\x23\x13\x9f\xb5\x33\xd0\x28\x07\xc6\xa5\xb7\x1a\xb5\x9a\x68\x13\x2b\xcd\x66\x3b\x09\xff\x15\x14\x2f\x1c\xeb\xd1\x0b\x5e\x24\xfa\xc7\x7f\xd1\x05\xd3\x97\x51\x46\xcc\x9a\x9f\xd5\x2d\x6f\x5c\xe3\xb0\xbb\xe0\x4d\x2a\xaa\x1c\x03\x39\xf4\xbf\x89\x45\xde\xc4\xdf\xc4\x6a\x57\xfa\x27\xbe\x43\xb6\x5f\xfe\xda\xad\xfc\x0d\x8c\x27\x79\x89\xdd\x2e\x9a\xf5\xa4\x2f\x6b\xb5\x83\xc3\x3e\xac\x15\x55\x67\x46\x48\x52\x14\x81\x30\x09\x1f\x59\xeb\x4a\x38\xb5\xcc\x1b\xb6\xda\x3b\xf4\x5f\x55\x33\x67\x4c\xad\x94\xb4\x99\x19\x25\x8f\xec\xf9\xe9\xa2\x39\x26\x19\x8d\x20\xea\xcf\x6e\x17\x9a\xd8\x76\xeb\xa8\x9d\x09\x18\x36\x54\x0d\x83\x15\x86\x39\xde\x6f\x18\xf2\x6c\xf3\x25\xfb\x1b\x91\x71\x1f\x26\xf2\xe0\xfa\x15\x16\xe4\x15\xfb\xdc\xed\x9a\xcd\xd7\x0a\x94\x3e\x35\xd6\xfd\x08\xec\x90\x48\x85\x97\x01\x44\xe9\x2f\xbf\xb8\xd3\xf2\x82\x36\x6c\x43\xf5\x19\x12\x36\x9a\xfd\x09\xb2\x5e\x09\x15\xe5\x0b\xb5\x94\xad\x43\xe9\xe3\xd0\xda\xed\x88\xa3\x5a\x9c\x85\x2c\xe9\xf2\xd5\xbf\xb0\x1f\xb4\x67\x9a\x8c\xa9\x58\x73\xd5\x79\x3b\xe2\x4e\x0c\x3e\x7c\x8d\xf0\xf3\xd5\x97\xe1\x3c\x27\x21\xd7\x07\x75\x80\x10\x4b\x0d\xd9\xa3\x1a\xb7\x3f\xfb\x1e\x21\x90\xf2\x21\xf1\x7e\xc5\x51\x1c\x23\xd5\x23\x16\x0e\xe2\x87\x16\x1a\x11\x8d\xe2\x10\xef\xc1\x0f\xdb\x29\x9e\xb6\x6b\xd5\x44\xda\x78\x45\x35\x9b\x7d\xff\x2b\x78\xd5\xda\x8a\xa5\x55\x3f\x63\xd0\x0b\x26\x61\x23\xef\x10\x0f\x9c\xe5\x60\x91\x19\xc2\x85\xb7\x51\xd7\xaa\x03\x6d\x02\xcf\x82\x91\x4f\x67\xbf\x1d\x37\x93\xe3\x8f\x47\xd9\xb8\x4f\x5b\xfc\xc6\xc8\x95\x43\x84\x25\xdb\x6d\x67\x75\x7a\x08\x81\xce\xa3\x0d\x6d\x4f\x46\xf1\x27\xc6\x51\x42\x76\xd4\x5c\xbc\x34\x3b\x9b\x61\xfb\xf5\xba\x9f\x16\xb0\x55\xb7\x3d\xb5\x6b\x1a\xe5\x1d\x23\x7b\x13\x11\x8a\x07\x17\x42\xe0\xf7\x60\x8d\xea\xdc\x0a\x3e\x4e\x8e\x73\x51\xff\xac\xf4\xd0\x7a\xfe\xd8\x72\xa3\x49\x6d\x6c\xb6\xd8\x50\x6f\x1b\xd7\xaa\xd9\xbf\x26\x0e\x07\xbd\x84\x8b\xf2\x02\x76\xbb\xc1\xff\x0d\x00\x46\x94\x4f\x85\xa2\x46\x00\x00")
+
+func templatesGles2TmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesGles2Tmpl,
+		"templates/gles2.tmpl",
+	)
+}
+
+func templatesGles2Tmpl() (*asset, error) {
+	bytes, err := templatesGles2TmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/gles2.tmpl", size: 18082, mode: os.FileMode(420), modTime: time.Unix(1792221877, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesGlxTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\x7b\x8f\xdb\xc6\x11\xff\xbb\xfa\x14\x63\x45\x75\x48\x55\xa6\xce\x6d\x81\x02\xbe\xc8\x80\x41\x3b\xca\xa1\x67\xfb\x1a\x5f\xd2\x2b\xd2\xe0\xb0\x22\x97\xd2\xc6\xd4\x92\xd9\x5d\xca\x56\x68\x7e\xf7\x62\xf6\x41\x2e\xa9\xc7\x5d\x0a\x04\x30\x70\xe2\x3e\xe6\x3d\xbf\x99\xdd\xf5\x7c\x0e\x71\x91\x52\x58\x53\x4e\x05\x51\x34\x85\xd5\x1e\xd6\xc5\x3a\x87\x60\xa3\x54\x29\x5f\xcc\xe7\x6b\xa6\x36\xd5\x2a\x4a\x8a\xed\x3c\x5d\xfd\xfd\x1f\x9b\x39\x4e\x87\x97\xf0\xfa\x3d\xbc\x7b\x7f\x0b\x6f\x5e\x5f\xdd\x8e\x46\x25\x49\x3e\x92\x35\x85\xba\x86\xe8\xc6\xfe\x6e\x9a\x51\x5d\x3f\x83\xf9\x14\x96\xd7\x77\x90\x14\x82\x42\x56\xf1\x44\xb1\x82\x4b\x20\x82\x42\xce\xf8\x47\x9a\x82\x54\x44\xb1\x84\xe4\xf9\x7e\x06\xf4\xb3\xa2\x5c\x76\x2b\x0a\x92\xd2\x14\x88\x02\x51\x71\xc5\xb6\x14\xa6\xf3\xa6\x19\x8d\xe6\xd3\x96\xb4\x15\x1c\x62\x90\xaa\x5a\x49\xb3\xe0\xab\x64\x5d\x20\xf9\xea\x33\x64\x82\xd2\x95\x4c\x01\x00\xca\x8f\xeb\x67\x49\xc1\x33\xb6\x7e\x01\xeb\x1c\x3e\x3f\x7f\x3e\x1a\x7d\xc5\x78\x92\x57\x29\x85\xf1\x3a\xff\x1c\x6d\xc6\xdd\xc0\x37\x52\xa5\x39\x5b\x45\x9b\x97\xbd\x31\xc1\xf8\x1a\xc7\x46\x52\x89\x2a\x51\xf0\x23\x15\x28\xef\x3d\x2a\x69\x7f\x5f\x6a\xe1\x04\xe1\x6b\x0a\xd1\x9b\x4e\xa3\xa6\x19\x31\xae\x60\xf9\x7e\x79\x7d\x8f\x86\x7a\x47\xb6\x14\x9a\xc6\x2c\xa7\x3c\x05\x54\xcd\xdb\x1a\x17\xdb\x2d\xe1\xa9\x6c\x9a\x11\x8a\x8f\x33\x2c\x03\x5e\x28\x88\x2c\xa7\xe8\x2d\xf9\xa5\x10\xe0\x2d\x98\x08\xaa\xe0\xc5\x02\xa2\xdb\x7d\x49\xa3\x65\xa1\x79\x28\x51\x21\xa3\xd1\xe8\xe6\xdb\x77\x75\x0d\xb7\xc5\x0f\x65\x49\x45\x2b\x00\x94\x19\xf7\x05\x82\x05\xbc\xfb\xe1\xfa\x1a\xe5\xb2\x74\x62\x37\x83\xbe\xbf\xaf\x6b\xbd\xb2\x69\x82\x96\xad\x91\x78\xc2\x66\x30\xa1\x9a\xfd\x0d\x11\x64\xeb\x24\x77\xab\x58\x06\x6b\x05\x13\x06\x17\x4d\x33\x83\xba\xa6\x3c\x1d\xac\x98\x50\xcb\xf0\x35\x4d\x72\x98\x50\xcb\xa8\xe5\x63\xac\x14\x42\x6d\x47\x58\xa6\x35\x6e\x1a\x41\x55\x25\xb8\xa1\x09\xcf\xda\x1d\x3d\x41\xff\x00\x61\x3d\xf1\x06\x22\x5e\x8e\x3a\xa7\x58\xdf\x7a\x3f\x47\x6a\x5f\xd2\x94\x66\xb0\x2b\x58\x3a\x85\x60\x0a\xcb\xef\xdf\x2f\xf3\x82\xa4\xa5\x28\x92\x30\x48\x0a\x2e\x15\x24\x1b\x22\x60\xca\xc9\x96\x86\x97\xa3\x91\xc9\x13\xbd\x03\xa6\xda\x11\x6b\xaa\x6e\x44\x91\xbc\x4a\x53\x41\xa5\x3c\xdc\x64\xcd\x64\x6d\x13\x98\x9d\xe1\x3a\xbf\x5b\xf6\x37\xda\x9d\xcb\xeb\x6a\xb5\x57\x14\xa6\xa1\x65\x69\x12\x98\x65\xc3\x28\x76\xa2\x60\x34\x6b\x41\x36\x44\xb6\x2b\x7a\x62\xd0\xcf\x4a\xce\x60\x30\xe2\xe4\x92\xec\x37\x7a\xaf\x80\xc3\x02\xa4\x12\x39\xe5\x01\x4e\x5e\x6a\x99\xfd\x2d\x25\x2c\x00\x09\x99\x19\x96\x01\xae\x93\xb0\x30\x61\x1a\x3a\xfd\x2e\xcc\xfc\xa7\x0d\xcb\x29\x04\x01\xee\x92\x4a\x48\x25\x82\x52\x03\x4b\x18\xc2\x13\xb7\xa5\x6e\x5d\x86\xe4\x70\xad\x61\x01\x5f\xbe\x40\xf9\xd3\xb3\xe7\x3f\xe3\xc0\xd7\xf0\x75\x08\x4f\x9f\x42\x50\xfe\xc4\xdd\x80\x59\x60\x3f\xff\x7b\xf1\x75\xd8\xb2\x7f\x7e\xd9\xd2\x2c\xe1\x2f\x0b\xe0\xe6\xbb\xf1\x5d\x70\x81\x51\x31\x9a\xcf\x35\xc4\xde\xa3\xbb\x3d\xc3\xe2\xa7\x04\xb5\xf1\x61\xb2\xc8\x80\xe4\xb9\x07\x8b\x11\xfc\x7b\x43\xd5\x86\x0a\x5c\xb8\x87\x84\x70\x24\xb7\xa2\x50\x49\x9a\x42\x4a\x4b\xca\x53\x09\x05\xc7\xe9\x6e\x1b\x64\x39\x59\x4b\x90\x54\x39\x7c\xbf\x5f\xe7\x9f\xaf\x38\x53\xaf\x99\x2c\x73\xb2\x8f\x46\xf3\x79\x2f\xc0\x8e\x08\x18\xf8\x21\x6a\xa0\x59\xa0\x25\x4f\x82\x9d\x0b\xff\x01\x9c\xb9\x29\xb4\xfc\x01\xf2\x38\x07\x1d\x4c\x40\x70\x1c\xbc\x42\x23\x48\x30\xf6\x56\x8f\xc3\xcb\x33\xb9\xd7\x4f\xc4\xf9\xfc\x98\x3d\xd0\x54\xd2\x83\x74\x50\x85\xb6\x28\x56\xb2\x9d\x1d\x92\x55\x59\x16\xc2\xd6\xcc\xb4\xdc\x03\xe1\x29\x3a\x43\xaf\x43\x8c\x9f\x1e\x38\x20\x13\xc5\xb6\xef\x19\xd9\x27\x23\x13\x41\x29\x8f\xe0\x4a\x21\x25\x13\x35\x12\x2e\x80\x65\x9a\x43\x5a\x50\xa9\x0b\x80\xdd\x84\x12\x6a\xd7\xb5\x99\xd8\x57\x23\x70\xea\x4c\xd3\x72\x3f\x03\x5c\x65\x38\xb8\x0c\xc0\x91\xad\xae\x21\x0b\xb8\x98\xc1\x96\x71\xf3\xf3\xf2\x44\xe6\x0f\x53\x13\x73\xa6\x57\xbf\x9c\x63\x9f\xac\xf3\xbb\x7f\x55\x54\xec\xad\x01\x03\x2d\xc0\x53\xcd\x6b\x06\x4f\x35\xa3\x70\x98\xba\x9d\xbd\x23\x27\x94\xfe\x7b\xe9\x79\x22\x72\x32\xea\xbf\xe7\xe4\x44\xd9\x60\x01\x4e\x90\x6e\xc1\x07\x5d\xc4\x8d\x44\xd6\x1c\x67\x4a\x36\x92\x1a\x96\x6c\x58\x1c\xc1\x3d\xe4\x37\x83\x7e\x1c\xb6\x90\x70\x3c\x0f\x10\x5d\x86\x91\x6e\x31\xaa\xae\xad\x49\x7b\xe6\x1d\x58\xda\x9a\xef\xb9\x86\x95\x61\x0c\x9c\xca\x57\xe7\x22\x33\xd2\xa5\x9c\xfb\x86\x23\xb5\xe5\x9c\x9d\x8f\x41\x85\xe5\x76\x79\x56\xe0\xe9\x7c\xc4\xb6\x98\x42\x30\x8e\xc7\xee\xa7\xe9\x29\xc6\x54\x88\x42\xc8\x71\xcb\xf6\x43\xce\x12\xda\xb2\x1c\x67\x5b\x35\x36\xbf\x04\xcd\x72\x9a\xa8\xf1\x90\xd3\xb8\xe2\x92\x64\x74\x3c\x0a\x75\x8e\xdb\xe8\x01\x41\x4b\x41\x25\xe5\x4a\x02\xf1\xb3\x59\x67\x11\x16\x65\xd7\xd0\x81\xed\xef\x8c\xbd\x4c\x9f\xc5\xb8\x32\x5f\x18\x7a\x98\x4d\x16\xcd\x97\x6f\xac\x62\xd2\xb4\x5a\x2c\x6b\x41\x62\x07\x4c\xc2\x5a\x50\xa2\xa8\x80\x42\x00\xfd\xb5\x22\x39\xa2\x89\x65\x53\xdb\x8c\xd0\xd1\xdc\x68\x29\x10\xfd\x21\xd8\xb9\x15\x21\x2c\xdf\x04\xfe\x2a\x64\x1c\xc2\xaa\x28\x72\xa8\x7d\xa3\xee\x6c\x37\xf8\xd2\x66\xf4\x97\x2f\xed\xd0\xc2\x26\x12\xc6\xdb\x2e\x7a\xab\xa9\xbc\xb4\x29\x64\x75\x78\x75\x73\x65\x19\x76\xba\x9c\x03\x3c\x44\x31\xdb\xf5\x77\x52\x77\x44\x82\xd0\xc9\xdf\x17\xd2\x0e\xd6\x1a\x9b\x0f\xda\x58\xec\xb3\xbc\xe6\x56\xcb\xf9\xac\x69\xa0\xb1\x42\x7e\x6f\x4e\x01\xbf\x5b\xd0\xd4\xc2\x60\x4e\xa4\x82\x92\x48\x49\x0d\x52\x17\xe0\x81\xe5\x0c\x3e\x6d\x58\xb2\x81\x2d\xd9\x43\xca\xb2\x8c\x0a\x83\xd7\x9d\x52\x9d\xa2\x7d\x41\x1e\x52\x96\x71\x15\xc4\xd1\x10\xdd\xc2\x19\x1c\x4e\xa0\xca\xa1\x53\x17\x85\x8b\xbd\xbe\x00\x75\xf4\x4a\x8a\xeb\x12\x22\x97\xba\x0c\x83\xda\x35\x0f\x50\x16\x8c\x63\xd4\xa9\x02\x08\xc4\x48\xaf\x9d\x2a\x32\xc0\x58\x47\x75\xe6\x73\x00\xbf\x19\x85\x69\x30\x75\x58\xd1\x6b\xe5\x70\x33\x62\x54\x68\x77\x5d\x65\x1e\x5b\xce\xf2\x19\x1c\x34\x96\x18\xfb\xd8\x97\x44\xa7\x4e\x80\x24\xff\x44\xf6\x12\x45\x23\x3b\xc2\x72\xb2\xca\x69\x04\x6f\x06\x45\x13\x17\xda\xce\xc5\xf3\x56\xe7\x0b\x1c\x8c\x1d\x9a\x99\xac\x8f\x6e\x8c\xee\x21\x68\x1c\xb1\x5e\x61\x19\xc4\x51\x0f\x21\xd1\x2b\x1e\x48\x86\x0e\xb7\x42\x44\xc5\x0b\xbb\xcd\x73\xa8\xa6\x26\xa3\x77\xf4\x53\x30\xce\x08\xcb\x69\x8a\x31\xc4\x38\x53\x8c\xe4\xec\x37\x1d\x85\x16\xf4\x7b\x78\xc7\x59\xee\xb9\x74\x59\x3c\xc6\xa7\x07\xf6\x45\x33\xfd\xbf\x26\xf6\xec\x8b\x64\x7e\x87\x89\xe7\x73\xb8\xdd\xd8\x93\xb8\x68\x69\xc3\xb6\xd2\x99\xc4\x59\x82\xcd\xa6\xb6\x4b\xdf\x23\xcb\xc2\xb9\x04\xf7\x04\x52\xd7\xdc\xf0\xc0\x3f\xad\x67\xec\xe2\xc5\x02\x75\xf5\x2c\xef\xc6\xa1\x5f\x90\xac\x8d\xcf\x56\xed\xe3\x35\xb7\x0b\x85\x61\xe5\x3d\xe0\x7d\x64\x09\xc4\xd1\xf1\x3e\x34\x38\xda\x87\xfa\xb1\xe0\x15\x28\xef\x67\x33\x32\x36\xeb\xab\x17\xe0\x09\x0c\x8e\x1b\xcd\x0a\x98\x48\x3c\xbb\xc6\x51\x6c\xdb\x19\xdc\x61\xd8\xa5\x14\xb1\x2b\x8e\xf0\xea\x23\xe8\xef\x0d\x12\x19\x86\x7e\x64\x0e\xa6\xe3\xe8\x20\xc2\x82\x60\x8a\x10\xa5\x8f\x86\xe1\x31\x72\x61\xe8\xc5\xb6\x0d\x1d\xf8\xb5\xa2\x82\xd1\x47\x40\xf3\x5d\x0b\xce\xb6\x85\xd6\xad\xb7\xeb\xa3\xbd\xcc\xd0\x81\x9a\x15\xfa\xe0\x03\x6b\xb6\xa3\xdc\x36\x6f\xd8\x2d\x9b\x78\x5c\x51\x48\x48\x8e\x59\x49\x32\x34\x14\xc6\x61\x0c\x85\xe8\x12\x6f\x86\x4d\xba\xd5\x5d\x02\xb1\x91\xfb\x60\x7b\xdd\x46\xb5\x55\x0f\x7b\xc7\x81\xe9\x5c\x2b\x89\x98\xfe\x00\xec\x38\x22\x68\x58\xfb\x3b\x44\x8a\xe1\x0c\xe2\x08\x4b\x82\x6d\x4a\x1f\x83\x42\x68\x5b\x4f\x62\x9a\x8e\xc3\xc7\xa5\x46\x1b\xa4\x80\x41\x74\xd0\xe0\x3e\x59\xc0\xc5\x89\xe6\xad\x03\xb3\xf7\x25\xe5\x56\x01\x28\x4a\x8a\x97\x76\x78\xd6\xe7\x34\x51\xde\x99\xe9\x0e\x24\x15\x3b\x2a\x34\xa4\x61\x94\x62\x59\xa0\xdb\x52\xed\x67\x38\x8f\x84\x5e\x5f\x7d\xb8\xb9\x7e\xf5\x1f\xa0\x7c\xc7\x44\xc1\xb7\x94\x2b\xd8\x11\xc1\x10\xb0\x3a\x84\xbb\x52\x56\x04\xa9\xf3\x14\xe1\x92\xb0\xbc\x12\xb4\xf3\x91\x27\xd1\x23\x52\x68\x47\x04\x24\x12\xa6\x71\x84\xa7\x19\xe7\x2c\xbd\xef\xc9\x02\xc6\x63\xcf\xf4\x89\x84\xe3\xc9\xf6\xe8\x84\xeb\xd9\x70\xb0\x28\x8e\xee\x7c\xc9\xf5\x1e\x63\xe2\x38\x2f\x24\xb5\xe3\x90\xe0\x87\xc9\xa9\x33\x66\x6e\x8d\xe1\xef\x3d\x12\xb1\x0e\x7c\xe3\xe8\xae\xb7\xf2\x20\x2c\x9d\x2c\xaf\x69\x46\xaa\x5c\x7d\xd0\xd1\xd9\xba\x02\x79\xa7\x66\xc6\xa5\x00\xaf\xb6\x2b\x6c\x77\x75\x56\x75\xce\xe9\xed\x3f\x2a\x10\x1e\x61\x7a\x1d\x14\x66\x43\x1c\xdd\xf5\x77\x1e\x0a\x88\x12\x1e\x3f\xa0\x1c\xa9\x76\x11\x16\xb4\xfd\x89\x9a\xa7\xf1\x01\xa7\x5c\x23\xdf\x47\x21\xd6\x6f\x56\xfc\x9b\x69\xbd\x31\xcf\x81\x29\xe9\x95\xe0\xa4\xa8\xf2\x14\x56\xee\x2a\x5b\x1b\x03\xc3\x2e\x78\x7c\x7e\x62\xa7\xef\xa7\x62\xe8\x7f\xa0\x38\x88\x01\x31\x76\x6a\x84\x2b\x94\x6f\x64\xda\xb6\x3e\x0b\x5e\xe9\xeb\xd8\x3f\x79\x94\xeb\x1a\x3e\x31\xb5\x81\x68\x59\xdc\xe2\xc9\xa7\x69\x74\xfb\x6d\x66\x4c\x79\x82\x85\x1e\xfa\x91\xe4\x55\x7b\xa1\x6f\x2e\x42\x43\x9f\xfa\x52\x14\x55\x29\xdd\x82\xc9\x1a\x2b\x53\xe4\xc4\x43\x02\xf6\x22\xba\x69\x30\x9b\xd1\xa4\xd8\x72\x62\x80\xf8\x8a\x52\x5e\x6d\x61\x87\xac\x64\x77\x1c\xeb\xef\xae\x18\x57\x7f\xfb\x6b\xe7\x6b\xd4\xea\x38\x1f\x8f\xce\x29\x73\x78\xc6\x9e\xac\xbb\xad\xf7\x7d\x42\x83\x59\x63\x11\x0c\x34\x6b\xb3\xc1\x7c\xe0\x69\x14\xe2\x21\x3e\x97\x76\x95\x1b\x6d\x8d\x7b\xd2\xa9\xde\x4f\x5f\xe8\xef\x08\x4f\x73\xda\x99\x79\xf3\xb0\x99\x35\x96\xe1\x25\xa2\x9e\x8e\x73\x22\x71\x3b\x14\xab\x5f\x68\xa2\x1e\x61\x64\xcb\xf9\x2d\x55\x9b\x22\xed\x59\xda\x2d\xc6\x82\x2b\xf5\x48\xbc\x4d\x3d\x22\x3a\xb2\xd0\x36\x9b\xe8\x7b\x9a\xec\xa0\xf1\x0f\xb7\xbd\x71\xdc\x3c\xd9\x74\x5b\x43\x9f\xfe\x83\x4f\x0c\xc6\xb6\xf8\x0e\xc0\xdc\x01\xd2\x98\x0e\xa9\x52\x47\x06\x87\xdd\xdb\x82\x65\x94\x91\xd6\x33\xee\xcd\xc0\x21\xe2\x81\x36\xe8\x54\x8f\x3f\xd1\xfc\x5f\x89\xf5\x79\xee\xc4\x77\x76\x38\xea\xf9\x75\xf0\xb3\x7f\xc5\x81\xd1\x2c\xf1\xc2\xe3\x46\x89\x16\x67\x89\x7f\xa0\x43\xdf\x66\x4c\x48\x05\x34\xa7\xba\x5c\x16\x99\xd9\x02\x12\x48\x5e\xf0\xb5\x49\x6d\x5b\x5f\xf1\xa6\x1d\xb3\x0d\xb7\x99\x55\x29\x51\x04\x18\x07\xec\xea\xa4\x2e\xad\x25\xe1\x2c\x91\xa8\x8b\x3e\x54\x60\x53\x41\x0c\xc9\xce\x71\x4e\xa8\x40\x62\x9b\x43\x45\x46\x12\x5a\x37\x21\x0c\x8a\x9e\x3e\xd8\x3a\x63\xee\xd0\x58\xf6\x96\xc6\x00\xc9\xfb\x2c\x90\xa1\xab\xb6\xbb\xe8\x9f\x8c\xa7\x81\xbe\x9b\x77\xab\xb4\x25\xec\x76\xfc\xa7\x45\x0b\xb2\xad\x8a\x3e\x94\x82\x71\x95\x05\xe3\x3f\xdf\x0e\x84\x1c\xcf\xa0\x5f\x69\x35\xed\x6b\xca\x83\x53\x6d\x94\x3e\xb3\x5e\x3c\x58\x9b\x77\xed\xaf\x30\x9c\xb5\x24\xa7\xa8\x62\xb0\xd3\x11\x15\x84\xd1\x9b\x9c\x6e\x83\x30\xfa\xc0\x7e\xa3\x41\x38\x70\xb5\x83\xe8\x6f\x5d\x51\x40\x73\x8e\x8e\x1c\x4e\x6c\x2c\x9c\x7f\xc2\xd3\x09\x54\xd7\x76\xfc\x11\x09\xd2\x82\xdc\xb9\x27\x2d\xef\x39\xcb\x64\xcb\xd1\x64\xe9\xde\xd5\x9e\xf9\x39\x53\x1b\x91\x71\xdf\x91\x67\x39\x14\xa5\xff\x2c\x17\x47\x75\x7d\xf2\x25\xd3\xbe\x30\x5a\xe3\xfd\xf1\x2f\x78\xe8\xc0\xe8\xb6\x88\x9d\xfa\x83\x15\xbe\xdc\x61\xab\x7c\xab\x9f\x1f\x37\x75\xed\x88\x2d\x0b\xbc\x97\x54\xe3\xbe\xb9\xda\xa7\x07\x96\xe9\x2e\x63\xe2\x32\x3e\xfa\x8e\xc8\x7e\xf2\xf7\xf0\x58\x4f\x61\xb4\xe7\xec\xe3\x10\xaa\x57\x95\x02\x45\x3e\x52\x69\x32\x15\xd3\x52\x2a\x4a\x52\x4c\x76\x0b\x17\xd2\xbd\x4b\xa4\x54\xb0\x1d\x95\x90\x14\x15\x57\xde\x5b\x84\xde\x09\x39\xe5\x6b\xb5\x91\x5d\xae\x1f\xca\xd0\x85\xda\x84\xa3\x57\x2f\xba\x02\xea\x5c\x62\x1d\x01\x47\x9e\xac\xb5\x1a\x31\xf2\x1e\xcc\x4e\xf8\x21\x74\xe2\x9b\xe0\x73\x7f\x9d\x0f\xe4\x08\x96\x8e\x10\x4a\xa9\x29\xb7\xa2\xf6\x6b\xee\x19\xc4\xf7\xa8\x1f\xf9\x3c\x19\xdb\xe7\x55\x6d\x05\xf3\x07\xe9\xaf\x56\x10\x2d\xe2\x18\x1f\xd8\xc6\xdd\x92\x56\xb7\x1b\x25\x66\xfe\xf7\x35\xd5\x66\x6e\x41\xd7\x9b\xea\x42\xd1\xea\xda\x9e\x67\xbc\x45\x58\x3d\x4e\xd9\xc0\x41\x24\xbe\xba\xfa\x74\xe1\x65\x0f\x2a\x07\xd4\x16\xf0\xd4\x1b\xf9\xe9\xe2\x67\x0f\x3d\x4f\xd8\xf1\xf4\xe7\x59\x33\x1e\x8b\x96\x96\x31\x5a\xe5\x94\x62\x41\x5d\x0f\x76\x87\xa7\x04\x18\x7c\x4e\x92\xb6\x9f\x7a\x94\x88\x6f\x19\x47\x0f\xd9\x51\x66\x7a\x59\x2d\xb7\x19\x86\x6f\xf4\x48\xbb\xcc\x33\xab\x29\x68\x78\x55\x34\x49\x06\x49\xf6\xa2\xa7\x28\x76\xcb\x45\x01\x72\x53\x08\xd5\xbb\x58\x7c\x48\x1d\x07\x51\xe0\xfd\xd7\x08\xbb\xa2\x97\xd8\x8f\xc2\x55\x47\xf6\x10\x58\xfb\x99\xdb\x5b\xd4\x95\x13\x2b\x0d\x6d\x73\xe3\x46\x89\xe3\x7b\xfa\x68\x7b\xd0\x25\x51\x9e\x42\xd3\x8c\xfe\x37\x00\x8e\x3d\x73\x7b\xb5\x24\x00\x00")
+
+func templatesGlxTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesGlxTmpl,
+		"templates/glx.tmpl",
+	)
+}
+
+func templatesGlxTmpl() (*asset, error) {
+	bytes, err := templatesGlxTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/glx.tmpl", size: 9397, mode: os.FileMode(420), modTime: time.Unix(1792218229, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesGlxheaderTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x4d\x8f\xda\x30\x10\xbd\xfb\x57\x3c\x09\x0e\x0b\xa2\x71\x0f\x95\x2a\x6d\xda\x5e\x60\x9b\x56\xa2\xb0\x07\x5a\x71\x8b\x4c\x3c\x09\x6e\x13\x3b\x72\x8c\x04\xb2\xfc\xdf\x2b\xb3\x01\x02\xed\xcd\x33\xf3\x3e\x26\x2f\xc3\x39\xe6\x46\x12\x2a\xd2\x64\x85\x23\x89\xdd\x09\x95\xa9\x6a\x3c\xed\x9d\x6b\xbb\x67\xce\x2b\xe5\xf6\x87\x5d\x52\x98\x86\xcb\xdd\x87\x8f\x7b\x1e\xc7\x93\x14\x8b\x35\x56\xeb\x0d\x5e\x16\xdf\x37\x8c\x8d\x54\xa9\x25\x95\xc8\xb3\x75\xb6\xcc\xb3\xe5\x36\xff\x96\xb3\x91\xa4\x52\x69\xba\x6f\xb2\x91\xd2\x45\x7d\x90\x84\x4f\xd9\x92\x57\xf5\x31\xd9\x7f\xf9\xa7\x47\x47\x17\xdb\xcc\xfb\x77\xe0\x53\x64\xcb\x2d\x0a\x63\x09\xe5\x41\x17\x4e\x19\xdd\x41\x58\x42\xad\xf4\x1f\x92\xe8\x9c\x70\xaa\x10\x75\x7d\x9a\x81\x8e\x8e\x74\x77\x43\x18\x21\x49\x42\x38\xd8\x83\x76\xaa\x21\x4c\x79\x08\x6f\xba\x56\xe8\x8a\x90\xcc\x4d\xd3\x08\x2d\xbb\x10\x18\x00\xc4\x89\x2a\xa1\x8d\x43\xf2\x8b\x6c\x94\x4a\x7e\x88\xdf\xc6\x22\x04\xe6\x4e\x2d\xc5\xaf\xf4\x1e\xc9\xe6\xd4\x52\x32\x5f\x89\x86\x10\x02\x9e\xa6\xaf\x5f\x57\xde\x63\x63\x7e\xb6\x2d\x59\x24\xfd\x60\x82\xa7\xab\xec\x9b\xe1\x58\xcd\x30\x26\x3c\x7f\x46\xf2\x2a\xac\x68\x2e\xc6\x03\xf3\xca\x61\xac\xf0\x3e\x84\x19\xbc\x27\x2d\x1f\x10\x63\xea\xcd\x17\x54\xd4\x18\xd3\xd9\xab\xc7\x44\x85\x33\x63\x92\x5e\xe3\xf7\xfe\xba\x0e\xda\x52\xe7\x83\x9a\xc5\xbc\xac\xc6\xff\x97\x7f\x44\xa7\x43\x8b\xc8\xbe\x7b\x5e\x02\x7d\xb9\xfd\x82\x9b\x81\xd2\x0e\xe7\x2b\xb8\x93\x1b\xf0\x59\xe7\xec\xa1\x70\xe8\x33\xcf\xe1\xcf\x66\x91\xd7\xc4\xf8\xd3\x5b\xa9\x74\x2c\x43\xca\x2e\xe2\x8f\xd4\x6c\xb9\xed\xdf\x29\x63\x23\xd2\x52\x95\xf1\x8a\x86\x67\x88\x29\x67\x7f\x07\x00\xb2\xcc\xeb\xd1\xfc\x02\x00\x00")
+
+func templatesGlxheaderTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesGlxheaderTmpl,
+		"templates/glxheader.tmpl",
+	)
+}
+
+func templatesGlxheaderTmpl() (*asset, error) {
+	bytes, err := templatesGlxheaderTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/glxheader.tmpl", size: 764, mode: os.FileMode(420), modTime: time.Unix(1792204576, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesHeaderTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x6d\x6f\xdb\x36\x10\xfe\xae\x5f\x71\x45\x8c\xc2\x32\x52\xa9\x71\x8a\x6e\xa8\xb7\x02\x86\xe3\xa9\x06\x64\xd9\x48\xbc\x74\xfd\x44\x28\xd6\x49\xd6\x20\x53\x9e\x44\x2d\x09\x04\xff\xf7\x81\x14\x49\xbd\x44\x0e\x9a\xac\xd8\xa7\xb5\x40\x60\x1f\x1f\x3e\xbc\x97\xe7\xc8\xb3\x6d\xc3\x2c\x0d\x10\x22\xa4\x98\xf9\x0c\x03\xb8\x7b\x84\x28\x8d\x12\x18\xee\x18\x3b\xe4\x9f\x6c\x3b\x8a\xd9\xae\xb8\xb3\xb6\xe9\xde\x0e\xee\x3e\xfc\xb4\xb3\xf9\xb2\x39\x81\xab\x15\x78\xab\x0d\xcc\xaf\x16\x1b\xc3\x38\x8b\x43\x1a\x60\x08\xc4\xb9\x5e\x39\xc4\x71\xc9\x17\x22\x8c\xdc\xe6\xac\x36\x53\x87\x44\x09\xe6\x63\x6e\xa3\xdb\xa4\x08\x10\x7e\x71\xdc\xf9\xcd\xd8\x8e\x92\xb1\xb5\xfb\x6c\x94\xe5\x3b\x88\x43\xc0\xbf\xc0\xe2\x76\xeb\x16\xb3\x3c\x4e\xa9\xb5\xf4\xff\x4c\x33\xb8\x84\xe3\xf1\x34\x24\xa6\x69\x06\xef\x39\xa4\x4d\x7e\x69\x47\xc9\xa5\x22\xc7\x24\xc7\x67\xb6\x5f\x9c\xd8\x7e\xd1\xda\xdf\x8f\xd1\x01\x20\x0d\x94\xa3\xf2\x63\x1b\x2d\xa2\xc5\x07\xc6\xf1\x3a\x63\x8e\x3b\x5d\x2f\x8c\x33\x08\x30\x8c\x29\x56\x5f\x01\x1f\x18\x66\xd4\x38\x43\x1a\xc4\xa1\x21\xc8\x07\x98\x8f\xdf\xc3\xa7\x5f\xc1\xc3\x7b\xe9\x3b\x8c\xab\xb0\xc5\x7a\xe6\xd3\x08\x65\x6c\xb3\x74\xbf\xf7\x69\x90\x1f\x8f\x06\x00\x80\xcc\x5c\x9a\xc1\x90\xa6\x0c\xea\xd0\x79\x72\x4d\x18\x0a\x6a\xcb\xc5\x3c\xd7\x6b\x26\xe7\x65\x8f\x07\xe4\x05\x2c\x4b\xb0\x36\x8f\x07\xb4\x66\x9e\xbf\x47\x38\x1e\x61\xe8\xb8\x64\xba\x5e\xcc\xbd\xcd\xf5\xb7\x35\xac\x7f\xf3\xca\x12\x36\xe9\xef\x87\x03\x66\x60\x49\x90\x09\x43\x7d\x7a\xe5\xdc\x20\x3e\x87\x01\xf2\x18\xac\xb5\x9f\xf9\x7b\xe5\x5f\xc3\xc7\x88\xc1\x20\x86\xf7\xc7\xe3\x39\x94\x25\xd2\xa0\x83\x18\xa0\x74\xe4\x0a\xb7\x09\x0c\x50\x9c\x25\x31\x32\xed\xc7\xa3\x39\x31\xce\x64\x36\xcb\x52\xbb\x03\x87\x90\x92\xc6\x77\xa5\x27\x9e\x91\x81\xe5\xb8\xd6\xb2\x48\x58\x3c\x4b\x29\xc3\x07\xc6\xd7\xab\x4a\xf4\xc7\xd6\x25\x9b\x34\x8b\xde\x70\xa6\x23\x87\xb2\x54\x27\xf6\x1d\xd8\xad\xe2\xfc\x81\x21\xe5\x85\xca\x6b\x77\x62\xca\xc0\x59\x39\xee\xc9\xc3\x1b\x1f\x8d\x33\x21\x5a\x7b\x04\x8e\x0b\x23\x9b\xf7\x5e\x28\x65\x16\x0c\xc9\xd7\x85\x77\x39\x36\xe1\xed\x5b\x78\xa3\x6c\xaa\xa2\x6d\x2b\x21\xb3\x6f\xce\xd7\x85\x47\x48\xd7\x7e\x33\x5b\x6c\xe6\xb3\x2f\xe4\xc6\x9b\xae\x09\x31\xb5\xa4\x05\x35\x71\xe7\x53\x8f\x4c\xbd\x2b\xb2\x9c\x4f\x3d\x5d\x91\x9e\x35\xb8\x50\x42\x57\x04\xde\x6a\xb9\xf0\x96\xd3\x3f\xf4\x2e\x65\x68\x42\x55\x67\xdd\xc7\x34\x48\xef\x73\xde\x54\x72\x4d\xf3\xa8\x80\x34\x4f\x6d\x68\x1f\xa8\xec\xeb\x27\xc8\xb5\xfe\x04\xa3\x27\xfc\x8e\x5b\x13\xca\x7d\xb5\xe9\xc9\x61\x2f\x6a\xf9\x9e\x5a\xe9\x7e\xcc\xe3\x88\x62\x00\x00\xdb\x9d\x9f\xa9\xf6\x00\x88\x29\xfb\x99\xb0\x89\x86\x15\x54\x02\xdb\xb0\xa2\x8b\xd3\x74\xf9\x2e\xcd\x18\xa7\x51\x74\x17\x1f\x7b\xf9\xda\xb8\xe2\x09\x90\x90\x98\xb2\xcb\xb1\x3a\x50\xfd\x13\xc6\x5e\xc2\xf6\x86\xe2\x09\x50\xac\x7f\xfc\x20\xd7\xf5\x7f\x61\x3c\x4d\xa8\x37\x14\x1a\x28\x5f\x24\x9e\xd0\x8f\x1f\xf4\x36\xb9\x09\x20\x49\x69\x54\xfd\xe1\xc1\xc5\x94\x1d\x58\xd6\xcb\xdf\x06\x16\x35\x52\xb4\xdc\x09\xe2\x66\x66\x9f\x25\x6e\xa5\x56\x13\x0b\x0d\x55\xfc\xb5\xf8\x73\x16\xc4\x94\x35\xb4\x5f\x96\xf5\x1d\x22\xee\xca\x00\xc3\x5c\xde\x3d\x56\xe7\x82\x28\xcb\x1a\x3a\xa7\xc5\x5e\xe0\x94\x90\x1b\x17\x8c\xf8\x7c\xeb\x27\x05\x76\x08\xda\x57\x56\xdf\xb3\x33\xc8\x90\x89\x0b\x9f\xbb\x62\x39\xa9\x60\x64\x59\xc5\xf4\xcc\xfb\xf2\xff\xe3\x72\xe2\x71\x79\xdd\x6b\xf2\x23\xde\x12\xae\x3f\xb0\x47\xf0\x66\xd6\x18\xe8\xc4\xbb\x92\xb3\xac\xd8\x32\x90\xa3\x03\x81\xd2\x90\x2a\x87\x3d\x9f\x30\x26\xf5\x57\x3e\x6b\x4d\x0c\x79\x4a\x1c\xf6\x3a\xde\x7e\xa6\x96\x37\x33\x72\x3b\xbf\x36\x1b\xd7\x25\x77\x79\xe3\xde\x00\x21\x01\x6e\x93\xfc\x80\xdb\x21\xdb\x65\xe8\x07\xa6\x6a\x8f\x1e\x68\x85\x50\x5d\x62\xd8\xb6\x98\x72\x89\x3a\x77\x97\x26\x41\x0e\x6c\x87\x10\x16\x74\xcb\xf8\x70\x75\x48\x63\xca\x30\xcb\xcf\xe1\x6f\x39\x6e\xf9\x34\x10\x93\x99\x78\x94\x21\x4c\xfc\x28\x87\x34\x04\x9f\xb3\x6d\x2b\x22\x0b\x36\x3b\x14\x05\x1c\x9d\x83\xe3\xca\x94\x88\x9d\xc2\x99\x11\x50\x7f\x8f\x39\xdc\x61\x92\xde\x43\x86\x21\x66\xc0\xd2\xea\xe0\x18\xb9\x0f\x69\xc8\xe9\xb8\x41\x52\xc2\xb6\xc8\x32\x14\xf7\x91\xc0\x6d\xfd\x24\x89\x69\x04\x55\x44\x96\x61\xdb\xba\x91\x64\x21\x5a\x91\x55\xc5\xe8\x96\x48\x86\x34\xe9\x9b\xd1\x4f\x4d\x93\xf0\x2f\xc7\x49\xbe\xf7\x7b\x15\xdf\xe8\xb9\x8e\x12\x9f\x9f\x92\x94\xd2\x9e\xd5\x75\xa5\x92\xfe\xbb\xeb\xa5\x8e\x9e\xf2\xec\x75\x7e\xf1\x1e\x33\x8e\x2d\x69\x4e\x0c\xd9\xae\x5a\xcc\xad\xf2\x8e\xc4\x37\x29\x91\x89\xd1\x98\x43\x64\xea\x61\xd8\x44\xbc\xfb\x2c\x2b\x6f\xfe\x97\x95\x57\x4e\x75\xb2\xd7\x75\xad\xb3\x6c\xbe\x5a\x05\x3a\x09\x9d\x6c\x77\x0f\xec\x56\xc3\x7c\x89\x4a\x5e\x1b\xd4\x77\x2a\xe6\x07\xc5\x20\x87\x82\xfa\x07\xac\x54\x53\xf7\x42\xd0\x7a\x69\x29\x52\x12\xf0\x6b\xbf\xf1\xcb\x1e\x46\xb6\xf1\xcf\x00\x0b\x99\x8d\xc4\x39\x10\x00\x00")
+
+func templatesHeaderTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesHeaderTmpl,
+		"templates/header.tmpl",
+	)
+}
+
+func templatesHeaderTmpl() (*asset, error) {
+	bytes, err := templatesHeaderTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/header.tmpl", size: 4153, mode: os.FileMode(420), modTime: time.Unix(1792208774, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesQueriesTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x57\x7f\x6f\xdb\x36\x10\xfd\xdf\x9f\xe2\x16\x18\x85\x8d\xb9\xb2\xd7\x0e\x6d\xe7\xd6\x05\xb2\xc6\xce\x0a\x34\x3f\xe6\xb8\xc5\x86\x20\x08\x28\xeb\x24\x13\x95\xc8\x84\xa4\x9c\x14\x82\xbe\xfb\x70\x14\x29\x4b\xb6\xd3\xa4\x18\xf6\xcf\x80\x02\x95\x79\xbc\xbb\xc7\x7b\x77\x8f\xcc\x70\x08\x1f\x64\x84\x90\xa0\x40\xc5\x0c\x46\x10\x7e\x83\x44\x26\x29\xf4\x56\xc6\xdc\xe8\xf1\x70\x98\x70\xb3\xca\xc3\x60\x29\xb3\x61\x14\xfe\xfa\x7a\x35\x24\x73\xff\x2d\x1c\x9d\xc1\xe9\xd9\x02\xa6\x47\x1f\x17\x9d\x4e\x51\x3c\x07\x1e\x43\xb0\x60\x89\x86\xb2\xec\x74\x86\x43\xf8\x39\xcc\x79\x1a\x41\x51\x6c\x96\x69\x1b\x8a\x88\x3e\x3b\x37\x6c\xf9\x95\x25\x68\xed\xe7\xee\xdb\x79\x66\xec\xfe\xcf\x1c\xd5\xb7\x2f\x2c\xcd\x51\x03\xd7\x60\x56\x08\x22\xcf\x42\x54\x20\x63\x58\x57\xeb\x66\xc5\x0c\xdc\xd2\x46\x58\x33\xc5\x99\x30\x1a\x14\x9a\x5c\x09\x2e\x12\x60\x84\x41\x73\x91\xa4\x58\x39\x40\xc6\xbe\x22\x28\x29\x33\x88\xa5\x1a\x80\x96\x55\x04\x06\x37\x4c\xb1\x0c\x0d\x2a\x10\x2c\xc3\x46\x8c\x4c\x2a\xe7\xac\x07\x14\x2e\xe5\x5f\x11\x18\x64\xcc\x28\x7e\x3f\x80\x48\xa2\x06\x21\x0d\xc8\x35\xaa\x38\x95\x77\x16\xa7\x42\x9d\xa7\x26\x80\x2f\x1e\x93\x8c\x41\x1b\x66\xd0\x62\xe5\xa8\x2d\x10\x0a\xe7\xb1\x34\xf3\xc0\xdd\x0a\x45\xb5\xdf\xd6\xe0\x13\x0a\x50\x78\x9b\x73\x45\x95\x30\x41\x67\x38\xec\x2c\xa5\xd0\x66\xbb\x4a\x13\xf8\xe5\x55\xcd\xc3\x85\xf7\xe7\x58\x17\xbe\x9b\x70\x18\x4f\x20\x98\x71\x11\x7d\x90\x59\xc6\x44\x04\x07\x49\x7a\x8c\xe6\xa3\x30\x98\xa0\x5a\x1f\x78\xea\xb6\xd3\x53\x4d\x1f\x20\x61\x85\xa9\x6d\x1a\x32\x5a\xb7\x8a\x8b\x30\x45\xb8\xa1\x6a\x52\x38\x1e\x03\x37\x44\xa3\x37\x0d\xaa\x4a\x1e\x7f\xba\xfe\x70\x76\x72\x3e\x9f\x5e\x5c\x4c\x8f\xae\x17\xd3\xbf\x16\x9f\xe7\xd3\xeb\xd9\xd9\xfc\xe4\x70\x71\x31\x00\xa9\x60\x64\xcf\x1b\xe7\x62\xd9\xc6\xd4\xb3\xb1\x21\xe7\xc2\xbc\x7c\xd1\x07\x2e\x0c\x14\x1d\x00\xa0\xe4\xb0\x94\x39\xfd\x2e\xa0\xc7\x45\x84\xf7\xd0\x4d\x78\x70\x4e\x14\x6b\x18\xf5\x83\xc5\xb7\x1b\x0c\x8e\xe5\x29\xf9\xc7\x2c\xd5\x48\x87\x26\x57\x7d\xc7\xcd\x72\x55\xa1\x76\xd1\x96\x4c\x23\x8c\xee\xdf\xbc\x3a\x7c\x39\x86\xe1\xf0\xfb\x80\xad\x07\xfd\xab\xf2\x4f\x2a\xc7\x17\xce\xef\xf4\xf3\xc9\x63\xbe\x3e\xdb\xeb\xd9\xcc\x67\x3b\x9f\x9f\x1d\xcf\x0f\x4f\xae\x7f\xff\x78\x7a\x38\xff\xfb\x7b\x99\x5e\xcf\xa6\x8d\x4c\xdf\xf1\xf3\x59\x8e\x66\x6f\x7c\x96\x8b\x3f\x0e\x8f\xa6\xf3\x27\x24\x39\x9a\xfd\xd6\x48\xf2\xb0\x5b\x84\x31\xcb\x53\x33\xae\x63\x54\x2d\x04\x23\xbb\x50\xd6\x4c\x09\x62\x89\xe8\xb1\xbc\x12\x33\x9e\x0c\xb7\xee\x78\x2a\xcb\x9e\x45\x31\x80\x67\xa2\xdf\x69\x04\xe4\xc2\xf4\x44\xbf\xd3\x56\x16\xfa\x56\x4c\x24\x08\x81\xeb\xf3\x7a\x06\x48\xa3\x6c\xae\x7a\x28\x94\x9d\x09\xbb\x36\xb7\x83\x5b\x5b\xcc\xc6\xe2\x91\xd9\xf5\x90\xd6\x69\x78\xba\xc1\xb1\x24\x8b\x86\x1e\xde\x42\x57\x55\xad\x65\x01\x1f\x1c\x7f\x0a\xa5\x4c\x91\x89\x83\xbe\x1f\xaa\xa2\xd8\xe4\xa6\x61\xb0\x23\x40\x8b\xf5\x21\x21\xcc\x4d\x6b\xda\x62\xae\xb4\x71\xea\x25\x63\x5b\x2c\x15\xb8\xcd\xc1\xee\xb0\xdb\xc8\x33\xa9\xea\x11\xae\x07\x4e\xc3\x4a\xa6\x91\x95\xc5\x7a\x6d\x67\x96\xab\xa9\xec\x3c\xd6\xe7\x83\xfd\x42\xc0\xb5\xd3\xb7\xa8\x82\x1d\x34\x39\xf1\x43\xdc\xac\x41\xcf\x11\xed\xc9\xea\xf2\x01\x74\x91\x8a\xeb\xa7\xb5\xee\x05\x7b\xb9\x08\x84\xae\x2b\xef\xa6\x0a\xcd\x0d\x5d\x0e\x65\x39\xa0\x2a\xb9\xac\xde\xe6\xdd\xca\x92\x8c\x5d\x7c\x58\x03\x1a\x90\x9b\x3f\x9f\x97\x65\xa7\x4f\xbe\x3c\x86\x6e\x08\x65\x49\xe4\x52\x9e\x4a\x3e\x28\xa8\xa9\xfe\xa7\xdd\x94\x66\x3f\x39\x75\xe3\x87\x79\x0c\x97\x6d\x01\xbf\xf2\x51\x1c\x90\x06\xd5\x54\x93\x30\x8f\x2f\xc7\x57\xd6\x46\xb5\xa0\xa5\xb6\x22\x16\x45\x33\xd9\xb9\x73\xed\xbf\x05\x01\xef\x21\x45\xd1\x6b\x85\xec\x3b\x85\xdb\xcd\x35\xb1\xd7\x53\xef\xb2\xc6\x33\x00\x37\x73\x6e\xca\x1a\xe5\xa2\x19\x6e\x7b\x3f\x78\xa8\x9d\xca\x36\xfa\xbe\xd7\x80\xf2\x68\x37\x3c\x46\x78\xc3\x8e\xb7\x35\xf5\x1b\x88\xcf\x8a\xa2\x5e\x2d\xcb\xcb\xd1\x55\x9b\xc7\xda\xb2\x3f\xaa\xef\x06\xfa\xdd\x52\xa2\x56\x19\xaa\xa8\xbe\x59\xe0\xa7\x09\x8c\x36\xe1\xb6\x94\xc8\x5d\xdf\x5b\x22\xc1\x51\xbb\x58\x4f\x90\x0a\xe1\x67\x70\x47\x23\x60\x4e\x4f\x0c\xae\x1f\x68\x47\xfb\xd6\x8a\xd0\xbe\x41\x98\x81\x14\xd9\xee\xbb\xc2\x6b\x03\xae\x51\x50\x08\x61\x01\xc9\x3b\x54\x03\xab\x82\xd6\x37\x4d\x49\x14\x48\x3a\x36\x48\xfe\x3b\x05\x0a\x60\x26\x55\xfd\x9a\xda\xff\x5c\x03\x01\x59\xae\x0d\x84\x36\x5c\x7d\x38\xb3\x42\xae\x76\xf2\x06\xad\xce\xfe\x77\x55\x69\x81\xa3\xdc\x8f\xe1\x7b\x22\xb8\xfd\x42\xba\xe9\x93\x6d\x39\x7d\x50\x44\xb7\x07\x82\x42\xb9\x4f\xea\x9e\xbd\xe2\xb8\x4f\x55\x9b\xa3\x60\x6f\xe2\x3e\x5c\x5e\xfd\x90\x46\xd6\x62\xf6\x6e\x02\xa3\x86\x20\xb9\x91\x12\x3c\x6d\xbc\x17\x32\xd2\x02\xe1\x05\x30\x83\x77\xdb\x94\x6c\xfc\x33\x98\x6c\x19\x1b\xfa\xb5\x5f\x94\x79\x0c\xe9\x0f\x68\x6a\x0a\xef\x21\xdb\xca\xe8\xc1\xee\x2a\xdd\xa6\xd6\x30\xde\x23\xaf\x59\xdf\x6f\xfc\x1f\x48\xa2\xc7\x11\xfa\x6d\x6b\xdd\x38\x34\xdd\x9b\xf5\x7d\x42\xf3\x65\xff\x24\xa9\x8e\xb7\x6e\x52\xb8\xd6\x97\xfc\x0a\x26\xed\xea\xd1\x12\x69\xa9\x2b\x74\xa3\x55\xd6\x7a\xe7\x6e\x72\x96\x76\x80\xb1\xb8\x6a\xf2\xd3\x22\x6b\xff\xe7\x3f\x03\x00\x20\xe7\x9e\x9e\x22\x0f\x00\x00")
+
+func templatesQueriesTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesQueriesTmpl,
+		"templates/queries.tmpl",
+	)
+}
+
+func templatesQueriesTmpl() (*asset, error) {
+	bytes, err := templatesQueriesTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/queries.tmpl", size: 3874, mode: os.FileMode(420), modTime: time.Unix(1792218658, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesStringsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x6d\x6f\xe4\xb6\x11\xfe\xae\x5f\x31\x70\x8d\x40\x5a\xaf\xb5\xb6\xd3\x36\x80\xeb\x2d\x90\x6c\x8a\x43\x80\xf4\x62\xf4\xae\x9f\x16\xdb\x80\x92\x46\x5a\xc2\x12\xa9\x92\xd4\xb9\x9b\x85\xfe\x7b\x31\x7c\x91\xb4\x6f\x67\xb7\xc8\xe1\xce\x27\x71\x86\xc3\x99\xe7\x99\x19\x8e\xbc\x58\xc0\x4a\x16\x08\x15\x0a\x54\xcc\x60\x01\xd9\x0e\x2a\x59\xd5\x10\x6f\x8d\x69\xf5\xe3\x62\x51\x71\xb3\xed\xb2\x34\x97\xcd\xa2\xc8\xfe\xf8\xdd\x76\x41\xe2\xe4\x2f\xf0\xe3\x2f\xf0\xf1\x97\xcf\xf0\xb7\x1f\x7f\xfa\x1c\x45\xfb\xfd\x2d\xf0\x12\xd2\xcf\xac\xd2\xd0\xf7\x51\xb4\x58\xc0\x4d\xd6\xf1\xba\x80\xfd\x7e\x5c\x26\x35\x14\x05\x3d\x46\x2d\xcb\x5f\x58\x85\x56\xfe\xec\x9f\x69\x7d\x31\x8b\xfe\xc0\x45\x5e\x77\x05\xc2\x93\x36\x45\xcd\xb3\x74\xfb\xd7\x68\xb6\x88\x78\xd3\x4a\x65\xe0\x6a\x75\x15\x1e\xe3\x08\x00\xe0\x4a\xef\x44\x7e\xe5\x1e\x3b\xa1\x59\x89\x57\x51\x62\x5d\xd0\x46\x71\x51\xfd\xd0\x95\x25\x2a\xe0\x1a\x56\xd0\x60\x23\xd5\x0e\xb6\xb2\x2e\xb8\xa8\xc0\x6c\xd1\x2b\x69\x68\x99\xd6\x58\x80\x91\xb0\x22\x14\xdc\x32\x6c\xb1\x6e\x51\xe9\x94\xcc\x39\x43\x1a\x98\x42\x50\xd8\x91\x36\xcb\x95\xd4\x1a\x72\x56\xd7\x1a\x98\x28\x40\x8a\x7a\x07\x95\x92\xaf\x73\xd0\x12\xcc\x96\x99\x60\x02\x0a\x09\x42\x1a\x32\xc4\xea\x5a\xe6\xcc\xe0\xe8\x90\x14\x80\x5f\x50\xed\xac\xa5\x14\x3e\x71\x91\x93\xf4\x05\xb1\xd5\x20\x24\x28\x2c\x51\x21\x2d\x1a\x39\xf5\x9a\xac\x49\x5a\x66\x50\x76\x22\x37\x5c\x0a\x50\x68\x3a\x25\xf4\x1c\x98\x3f\xda\x39\x25\x5f\x85\x06\x6e\x34\x64\x36\x0c\x28\xa5\xb2\x96\x8a\x4e\x31\xbb\x4f\x96\x64\x8d\x39\x17\xa2\xc5\x22\x32\xbb\x16\x0f\x31\xd4\x46\x75\xb9\x81\xbd\x05\xbb\x05\x00\x87\x77\xfa\x2c\xb9\x30\xa8\xec\xb2\x00\x00\x2e\x0c\x10\xfe\xfc\x37\x04\x59\x42\x6b\x05\xb2\x2c\x83\x20\xdb\x19\xd4\xc0\x05\x74\x1a\xa3\x3e\x70\xf5\x43\x57\x6a\x4b\x8d\xb6\x8e\x65\x1e\x6e\x21\x8d\x57\x4d\xe1\xa3\x84\x5a\xe6\x2f\x44\xe6\x16\xeb\x02\x8a\x4e\x05\x1e\x57\xd6\x71\x82\x9d\xcc\x59\xe4\x07\x00\x48\x82\x05\x94\x4a\x36\xc0\xc0\x28\x96\x23\x6c\xa5\x7c\x01\xa9\x80\x41\x81\x59\x57\xd9\xdd\x19\xcb\x5f\x82\x4d\x26\xa4\xd9\xa2\x22\x63\x13\x2b\x50\xa1\x71\x30\xca\x57\x11\xa0\xe4\x42\x1b\x64\x05\x85\x5a\x20\x2b\xc8\x43\x2e\x2a\xca\x99\xe8\x0b\x53\x43\x68\x07\xe8\x51\xd2\xa6\x7f\xef\x0c\xfe\xc7\xbe\x96\x0a\x11\xd6\x9b\xd9\x14\x6e\x8f\x4c\x85\xe6\xd3\x94\x04\xcf\x2f\x30\xb7\xc9\xf9\x30\x77\xa1\x08\x7c\x05\x6c\x5a\x43\x19\x85\x54\x92\x14\x02\x12\x5c\x42\x0a\xb4\x1e\x51\x9e\x1c\xdb\x8c\x13\x38\x38\x39\xf8\xe8\x1c\x4f\x7f\x96\xf9\x4b\x9c\xd8\xa5\x02\x7d\x1e\x58\xc1\x3f\x45\x3d\x8a\x04\x3c\x2e\xa1\x46\x11\x07\x29\xb9\xe7\x76\xf1\x12\x04\x2c\x97\x70\xe7\x0d\xd3\x5f\x17\x06\x08\x7c\x8d\xa7\x47\xbb\x0d\xbd\xfd\x99\x91\xc5\xa9\xb5\xb5\xb8\xbd\xdf\x1c\xb8\x46\xab\x70\xa4\xf4\x38\x68\xf9\x33\x32\x8f\xa4\xc2\x1a\x99\xc6\x01\xc1\x2c\x54\xd3\x04\x48\x3d\x82\x14\x67\x87\xa8\x24\xc1\x40\x9c\x5c\x06\x68\xea\x09\x2c\x81\xb5\x2d\x8a\xe2\x00\x92\x39\x64\x87\xaa\x03\x8a\xc1\x4b\x8d\xea\x0b\x42\xc1\x75\xce\x94\x2f\x86\x5c\x0a\x83\xc2\x68\xca\xb1\xcc\x76\x9a\x86\xbd\xa0\x06\xdd\x29\x74\x6d\x86\x1b\xc8\x99\xb0\xf5\x03\xcc\x00\x39\x6a\x9b\x8d\x70\xd5\x96\xc2\x4f\x66\x08\x9c\x2c\x86\xe2\xcc\xbe\x1e\xb0\xf5\x25\x16\x54\xb9\x09\xfd\xf0\x91\x67\x29\x95\xf3\x12\xee\x46\x7e\x9f\x96\x90\xa5\xe2\x94\xe1\x2c\x15\x13\x4e\x6d\x2a\x3c\xc1\xc3\xec\x50\x57\xc0\x12\x1e\x60\x76\xa4\xbc\xb2\x80\xc5\x59\xda\x26\xfe\xd4\x16\x96\xb0\x4a\x1b\xdb\x41\xe3\x55\x4a\x41\xfc\x6a\x62\x91\x04\x39\xd9\x11\x53\xee\x05\x71\x7f\x21\x3a\x0b\x4c\x9c\xc0\x7a\x43\x4f\xb0\x9f\xee\x8b\x67\xeb\x7b\x78\x7a\x82\x6f\xef\xac\x30\xb1\x4e\xac\x1f\xb3\x54\xd0\xbf\x8d\xcf\x28\x6d\x14\xe4\xb2\xe5\xc4\x04\x25\x53\x06\x4c\x03\x03\xd1\xd5\x35\x18\x54\x0d\x17\xf6\x56\xf5\x97\x09\xd1\x16\x28\x60\xd0\xba\xa6\x49\xdb\xb8\xf9\x1a\x09\xda\xa8\x58\xfb\x46\x9c\x1c\xb5\x5c\xef\x74\x83\x0d\xd5\x4a\x96\xfa\x90\x7c\x83\x7e\x5c\x1e\xa9\xc7\xdf\x34\xd8\xac\x2d\x79\x9b\x00\x19\x11\x79\xb3\xa4\x30\x76\xf1\x20\x7d\xdc\xcc\x41\x27\xc1\xb6\xdf\x31\xf0\x6d\x5f\x6f\x6e\xa6\x78\xb5\x23\x22\x7a\x80\x64\x82\x89\x00\xa6\x14\xdb\x51\xc6\xf9\xc0\xad\xf0\x3c\x52\xa4\x5f\x50\xf2\x9e\x45\x8b\xb2\xd7\x1a\x7b\x03\x34\x1d\x6b\x0d\xeb\xcd\x57\x81\xcb\xa5\xd0\xc6\x5d\x54\x4b\x4a\xef\xd8\x6b\x7d\xe2\xbf\xa1\x2c\xe3\x8e\x0b\xd3\x1a\x15\xdf\x25\x43\x86\x11\x5c\x4b\x88\x3d\x6e\x6e\xeb\x2d\xdc\x27\xf0\xcd\xbf\x20\x1e\xde\x7e\x2f\x56\x6c\x3f\xd5\x09\xcc\xec\x41\x56\xd6\x12\xc2\x8f\xcb\x21\x43\x1f\xbe\xdb\x78\x37\x93\x98\x52\xd4\x6f\x09\xff\xbb\x7e\x49\xb7\x3d\x9f\x83\xdd\xa8\x98\xa8\x10\xb4\xf6\x10\x04\x9b\x6b\xbe\x81\x25\x84\x88\xb3\xd4\xa6\x9d\x0f\xbb\xbf\x40\x35\x17\x95\x26\xa8\x06\xa2\x88\x1b\xd1\x35\x19\x2a\x62\xda\xc6\x4d\x97\x7c\x31\x8e\x54\x8e\x20\xb2\x6e\x13\x80\xd2\x8e\x98\xd6\x7a\x0e\x6e\xee\xb3\x97\x6f\xcd\x2b\xd1\xa0\x98\xd4\xc5\xe4\xb4\x43\x5e\xc7\xa6\x44\x10\xc1\xe3\xbb\x78\xb4\x97\x95\xd5\x9f\x41\xec\x91\x82\x9b\x40\x1c\xa1\xf5\xeb\x65\xb4\xc4\xc0\x8c\xdd\x73\x8a\x90\x6d\x3a\x34\xed\x3a\xa8\xd3\x95\x6c\x1a\x26\x8a\x61\x08\xa6\x59\xd9\xdd\xbf\xfa\x23\x6b\x30\x2c\x5f\x2b\x34\xe4\x57\xfa\x79\xd7\x62\xfa\x41\x5a\x99\x51\xdd\xa8\x20\x3b\xa7\xe0\x36\xff\x03\x75\x57\x9b\x20\xe4\xa5\x93\xfb\xf9\x7b\xbf\x3f\x3e\x83\xc6\x80\x9a\xbf\xb8\x99\xdb\x5b\xef\x7b\xc8\xba\xf1\x62\xd8\xef\xad\x8d\x34\xc8\x6c\x37\xfb\x20\x47\xb6\xe7\x76\xb8\x0d\x33\x97\x9f\x7f\x3a\x61\x78\x0d\xdc\x4c\x86\xb7\xd7\xad\xac\xc3\xf0\x68\x39\x24\x0f\xb1\xd6\xf8\x7f\xb9\x67\xec\x5d\xf7\x41\x7a\x83\x7a\x32\x71\x91\x6b\xef\x6c\x28\x50\xa0\xe2\x5f\x50\xd3\x90\x52\x99\xad\x5b\xcb\x65\x47\xd7\xaa\x1d\x0d\xcd\x16\x9b\xd1\x59\xf7\xa1\x62\x73\xef\xd4\x59\xf7\xe9\x41\x41\x5d\xdb\x54\xba\x23\xdd\xb0\xe4\x59\x7f\x66\x8a\x35\x7a\x2a\xe0\xa5\x3d\x33\xa6\x91\xd6\x1b\xfc\x5e\x55\x09\xc4\x02\xc3\x3b\x5c\xc9\xce\x5c\x25\x47\xbb\xae\x05\xf4\xfd\x9c\x40\x73\xdf\x4f\xc4\x13\x5d\x75\xf7\x53\xbd\x81\xb5\xfd\x9e\x6e\x64\xfc\xf7\x68\x93\x8b\x2b\xe8\x7b\x87\x05\x19\x21\x22\x8e\x54\x6c\x47\x25\xad\xf5\xe6\x50\xcf\x9e\x76\x90\x92\x25\x1b\xd6\x3d\x4a\xc1\x85\x33\xaf\xb7\x7d\x1f\x25\xe4\xf9\x98\x9f\x67\xec\xdb\xd4\x9f\x58\x0c\x15\x6d\xe7\x26\x02\xf8\x64\x62\x3d\x19\x47\xd3\x61\x3a\x3b\x2e\x07\xd2\x3c\xca\xec\x15\x6b\xc7\xe1\x32\x0d\x63\xce\xc3\x9f\xfe\x3c\x36\x80\xb1\xde\x69\x88\x3f\xda\xff\x33\xda\x91\xe8\xdb\x87\x41\xe9\x20\x6b\xe3\x61\x39\x40\xe1\x72\xe2\x9a\xcf\xe1\x1a\xe9\xe4\xa3\xec\x08\x7f\xbc\xe7\x95\x81\x6b\x0e\x77\x87\xa4\x9f\x53\xbc\x46\x4f\xe0\xf7\xaa\xf2\x48\x1e\xae\x9c\x6c\x9a\x70\x3f\x6c\x76\x49\x47\x7e\x53\x98\x78\x96\xec\xc4\x0f\xb1\x61\x16\x3b\xb1\x19\x4e\xf7\x10\x5c\x76\x3b\x64\x45\x58\x1b\xed\x71\xfb\x85\x18\x9f\x62\x9d\xc0\xd3\x19\x06\x6f\xef\x27\x24\x4d\xda\xef\x2a\xfd\x20\x1d\x06\x1f\xe3\x78\xb6\x4a\xf3\x2d\x53\x13\xf7\xe7\xb0\x4a\x2f\x1c\x33\xba\x32\xba\x77\x7a\x2e\x9c\x26\x0e\xcc\xce\xe8\x85\xbb\x73\x02\xd1\x24\xab\x87\xcd\x93\xee\x62\xef\xd2\xbe\x4f\xde\xd5\x4a\x4e\x0b\xdc\x4b\x87\x4e\xf0\x6c\xd4\x24\xcb\xe9\x42\x9f\x08\x93\xe8\x4c\x4a\x9c\xb6\x83\x37\x6d\xea\x0b\x46\x47\xf2\x4f\x5e\xe9\x30\x5f\xf1\x9e\xb2\x21\x5b\x2e\x94\xd1\x3b\x4b\xe8\x5d\xe5\xf3\x3f\x97\xce\x14\xa3\x41\xe7\xad\x6a\x39\x28\x86\x67\xa3\x92\x53\x73\x6f\x56\xcc\x71\xb5\x24\xd3\x9b\xe9\xe0\xf7\x69\xfb\xfd\x2d\xa0\x28\xa0\xef\xa3\xff\x0e\x00\xc1\xb8\xfb\x21\xd5\x13\x00\x00")
+
+func templatesStringsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesStringsTmpl,
+		"templates/strings.tmpl",
+	)
+}
+
+func templatesStringsTmpl() (*asset, error) {
+	bytes, err := templatesStringsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/strings.tmpl", size: 5077, mode: os.FileMode(420), modTime: time.Unix(1792222140, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTraceTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x51\x8b\xdc\x46\x0c\x7e\xf7\xaf\x50\xf2\x10\xec\x62\xec\x14\x42\x0b\x69\xb7\x90\x5c\x42\x7b\x10\x2e\xa5\x77\x7d\x3a\x96\x32\xeb\x91\xbd\x93\xd8\x33\xcb\x8c\x9c\xeb\x72\xec\x7f\x2f\x92\xc7\xeb\xf1\x26\xa1\x4d\x6f\xe1\x58\x5b\xd2\xa7\x4f\xfa\xa4\x99\xad\x6b\xb8\x72\x1a\xa1\x43\x8b\x5e\x11\x6a\xd8\x1d\xa1\x73\x5d\x0f\xf9\x9e\xe8\x10\x5e\xd6\x75\x67\x68\x3f\xee\xaa\xc6\x0d\xb5\xde\xbd\xf8\x71\x5f\xb3\xb9\xf8\x09\xde\xbc\x87\x9b\xf7\x77\xf0\xf6\xcd\xf5\x5d\x96\x1d\x54\xf3\x51\x75\x08\x8f\x8f\x50\xfd\xfa\xae\xfa\x3d\x3e\x9e\x4e\x59\x66\x86\x83\xf3\x04\x79\x06\x00\xf0\x34\x38\x4f\x4f\xe3\xd7\xa3\x6d\x6a\x45\x6e\x30\x4d\x7c\x43\x66\xc0\xa7\x59\x91\x65\x75\x0d\xaf\xb1\x75\x1e\xaf\x54\xdf\x83\xb2\x1a\x5e\xb5\x84\x7e\x7a\xf2\x08\xe4\x55\x63\x6c\x07\x7b\xe7\x3e\x06\x68\x54\xdf\x33\x73\x89\x10\x6f\xc5\xde\x80\xaa\xd9\x33\x54\x3b\xda\x86\x8c\xb3\xe2\x08\x0f\x7b\xb4\x40\x7b\x84\x99\xb3\x09\xb0\x1b\x4d\x4f\xf0\x60\x68\x2f\x16\xae\xf0\x2f\xce\x81\x40\xaa\xab\xc0\xaa\x41\xdc\x68\x8f\x8c\x77\xb5\x20\xb2\xa5\x04\xe5\xbb\x20\x81\x92\x40\xf9\x6e\x1c\xd0\x52\x28\x85\x8b\x16\x8b\x1e\xbd\x12\x0e\xae\x3d\x7b\x56\x59\x5d\x33\xde\x6f\x52\x85\xf2\x38\x57\xd2\x7a\x37\x44\x22\xde\x8d\x64\x2c\xc2\xa0\x3e\x72\xc1\xe7\x50\xb8\xdb\xe3\x11\x86\x31\x10\xec\x10\x02\x52\x2c\x9f\xf1\x94\x3d\x2e\x0c\xcd\xdc\x1f\xc9\xf6\x49\xf9\xa8\x44\xd2\x5f\xf6\xcd\xb9\x12\x08\xe4\x8d\xed\x62\x41\xf7\x5b\x63\x09\x7d\xab\x1a\x7c\x3c\x15\x12\xb4\xa8\xf0\x9f\x82\x4a\xd0\xc0\x9a\x56\x6f\x62\xf5\x45\x14\x97\xd3\xde\x92\xa2\x00\x7b\xd7\xeb\xa9\x77\x76\x1c\x76\xe8\xc1\xb5\xc2\x37\x00\x39\x50\x4b\x19\xdc\x49\xf6\x6a\xc6\x61\xec\x15\x99\x4f\x28\xc8\x5c\x6e\x38\xa0\x25\x30\x22\x6a\x98\xda\x13\xa4\x58\x3a\x1e\x30\x49\x15\xc8\x8f\x0d\xc1\xa3\x54\x72\xc3\xe5\x46\xea\x10\xff\x3e\x97\x56\x5c\x19\x21\xc0\x68\x2c\xfd\xf0\x22\x71\xbd\xe0\x2b\xae\x77\x86\x51\x57\x25\x43\x5d\x7f\x46\xfa\x94\x4d\xdc\x64\xc4\xae\xdc\xc8\x6d\x5e\xd3\x6b\x92\x9c\x82\x4c\x82\x2c\x1c\xb2\x93\xf4\xf0\x80\x7e\x21\xdb\x4c\x20\xa1\x04\x63\x35\xfe\xcd\xdb\x70\x94\x2d\x41\x30\xba\x12\xd9\xd3\x64\x01\xee\x7b\xb4\xb9\xbc\xe2\x4e\x84\x62\x9b\x9a\x05\x7f\x6a\x9a\x47\x1a\xbd\x9d\x14\x0a\xa4\xc8\x04\x32\x4d\x98\xa7\x78\xce\x7f\xde\xc1\x60\x6c\x83\xe2\xdc\xab\x40\xe0\x31\x20\x95\x8c\xc6\x7b\x3f\xb1\xd2\xd8\x78\x54\x81\xfb\x7e\xd1\x97\x0a\xae\xdb\x29\x44\x96\xcd\x8f\x58\x0a\xd4\x5c\x1c\xaf\x3e\x63\x89\x4b\x09\x58\x75\x15\xcb\xee\xbc\x46\xcf\xe3\xd2\xb8\xbe\xc7\x86\x52\x9e\xd2\x24\xaf\x06\xac\xe0\x76\x79\xcb\xab\xe6\x6c\x7f\x64\xb0\x18\x84\xfa\x7f\x1c\x0c\x3c\x64\xdc\x02\xc1\x0e\xb9\xf0\x82\x9d\x73\x7d\x01\xf7\xdb\x65\xf0\x26\x49\x59\x03\x66\x16\x52\x9b\x58\x5a\xe7\xc1\xc0\xcb\x0d\x78\x65\xbb\xf5\x54\xcc\xc1\xfc\x69\xd8\xe5\xd9\xca\x7a\x6f\xb6\x67\xb3\xe0\x2f\xe3\x7e\x7e\x6f\xe6\x9e\x2e\x48\xfc\x09\x15\xbb\x06\xd8\xc0\x74\x04\x57\xb7\x0f\xea\xf0\xa7\x0c\x58\xfe\xac\xa9\x58\xcf\x50\xc2\xf3\xe2\x22\x48\x26\x7c\xb3\x1e\xf1\x3c\x41\xb8\x9e\x01\xd8\x83\xe3\x17\x80\x13\x60\x1f\xf0\xdf\x68\xbc\x73\x4a\x5f\xd0\xf8\x16\x0e\x1c\xbe\xe2\x90\x12\x38\x7f\x33\xed\x92\x78\x03\xcf\x2f\x38\x35\xce\x92\xb1\x23\x7e\x21\x30\x54\xbc\x2e\x9c\xfc\xbc\x3a\xa9\x06\x93\xbe\x1b\x50\x87\x03\x5a\x9d\xcb\x63\x09\x61\xa2\x70\x92\xff\xbc\x08\xd5\x6d\x6f\x1a\x9c\xcd\x3c\x41\xb9\x29\xe1\x03\x18\x4b\x85\xcc\x4f\x42\x88\xa9\xb2\xdf\xbd\xd9\x4e\x85\x3f\xd9\xc4\x17\x1f\xe2\x8b\x35\xf9\x69\x61\x2f\x62\x7e\x59\x87\x7c\xa1\xb0\xcb\x30\x2e\x0d\x7e\x5e\xc2\x6e\xe6\xd3\x30\xde\x04\xa9\x7f\x3c\x90\xfe\xe0\xf1\x9f\x4f\x8d\x80\x14\x80\x2f\x0a\x56\x30\xd9\xc8\x65\x67\x16\xf7\xbc\x80\xc7\x6f\x59\x84\x28\xf5\x2d\x39\x8f\xf3\xa8\x5c\xae\xc5\x17\x06\x38\x0d\xbb\xfe\x4a\xd4\x3c\xb5\x51\xb0\xf5\x41\x3d\x55\x92\x9c\xd2\x46\xb3\x9b\xb1\x24\x4f\x72\x69\xae\x2f\xc0\x2c\x0e\x85\xa7\x69\x5a\xef\xe2\xe9\xcf\x92\x4f\x23\xf4\x1a\x3b\x63\x73\xa3\x59\xfb\x78\x85\x56\x55\x95\x40\x14\xf0\xdd\x92\x3d\xa6\x6d\xd3\x5f\x47\x4f\x36\x60\xcd\x6c\x5a\xdf\xec\xc9\x01\x7f\x6f\xf4\x76\xc2\x4f\x87\x31\xaa\x18\xfb\xa0\xfa\xfe\xd1\xe8\xc9\xab\x9c\x08\xdf\xb8\x87\xbc\x38\xad\x29\xbf\xb5\x3a\xa7\x84\xd5\xac\x9e\x66\xe5\x24\xea\x96\x6f\x81\x9c\x2a\xa9\xbc\xc8\xbe\x76\x78\x51\x65\xf4\x36\x4b\xa4\x79\xa5\x2f\x57\xbf\x84\xef\x8b\x0b\x8f\xd5\x76\xf3\x7d\xc7\x8f\x3a\xae\xb9\x69\x93\x5f\x8a\x9f\x75\xe6\x6c\x4a\x1b\x23\x2c\x4a\xa0\x6a\x2a\x5b\x17\x19\x00\xc0\x29\x3b\x65\xff\x0c\x00\xff\x7d\xe4\x35\x1d\x0b\x00\x00")
+
+func templatesTraceTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTraceTmpl,
+		"templates/trace.tmpl",
+	)
+}
+
+func templatesTraceTmpl() (*asset, error) {
+	bytes, err := templatesTraceTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/trace.tmpl", size: 2845, mode: os.FileMode(420), modTime: time.Unix(1792207587, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTraceDisabledTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\x8b\xc1\x4a\x43\x31\x10\x45\xf7\xf9\x8a\xeb\x4e\x11\xde\x6c\x04\x41\x71\x65\x45\x04\xb1\x2e\xba\x97\x49\x32\xcd\x0b\x8d\x93\xd2\x99\xb7\x90\xd2\x7f\x97\x17\xba\x3c\xf7\xdc\x43\x84\xd7\x9e\x05\x45\x54\x4e\xec\x92\x11\xff\x50\x7a\x69\xb8\x9d\xdd\x8f\xf6\x44\x54\xaa\xcf\x4b\x9c\x52\xff\xa5\x1c\x1f\x1e\x67\x5a\xf5\xdd\x33\x36\x5b\x7c\x6d\x77\x78\xdb\x7c\xec\x42\x20\xc2\x7d\x5c\x6a\xcb\xb8\x59\xf5\x8f\x9f\x38\x49\x08\x47\x4e\x07\x2e\x82\xf3\x19\xd3\xfb\xe7\xf4\x7d\xc5\xcb\x65\x14\xe3\x04\x51\x8e\x4d\x6c\x50\xd5\x82\xb9\xf7\x83\x81\x35\x23\x71\x6b\x30\x67\xaf\xe6\x35\x19\xaa\x62\x5d\xf6\x8b\x26\xaf\x5d\x6d\x0a\x44\x21\x75\x35\x1f\xb1\xe0\x05\x7b\x6e\x26\xe1\x7f\x00\xfb\xbd\xe2\x49\xd6\x00\x00\x00")
+
+func templatesTraceDisabledTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTraceDisabledTmpl,
+		"templates/trace_disabled.tmpl",
+	)
+}
+
+func templatesTraceDisabledTmpl() (*asset, error) {
+	bytes, err := templatesTraceDisabledTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/trace_disabled.tmpl", size: 214, mode: os.FileMode(420), modTime: time.Unix(1792207417, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTraceEnabledTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcb\xb1\x4a\x04\x31\x10\xc6\xf1\x3e\x4f\xf1\x95\x8a\xb0\xd3\x08\x82\x62\xe5\x89\x08\xe2\x59\x5c\x2f\x93\x64\xcc\x86\x8b\x93\x63\x67\xb6\x90\xe3\xde\x5d\x36\x5c\xf9\x9f\xf9\x7e\x44\x78\xe9\x59\x50\x44\x65\x61\x97\x8c\xf8\x87\xd2\x4b\xc3\xcd\xec\x7e\xb2\x47\xa2\x52\x7d\x5e\xe3\x94\xfa\x2f\xe5\x78\xff\x30\xd3\xf6\xbe\x7d\xc2\x6e\x8f\xcf\xfd\x01\xaf\xbb\xf7\x43\x08\x44\xb8\x8b\x6b\x6d\x79\xe0\x6f\x5f\x38\x49\x08\x27\x4e\x47\x2e\x82\xf3\x19\xd3\xdb\xc7\xf4\x75\xcd\xcb\x65\x80\x31\x82\x28\xc7\x26\x36\xaa\x6a\xc1\xdc\xfb\xd1\xc0\x9a\x91\xb8\x35\x98\xb3\x57\xf3\x9a\x0c\x55\xb1\x5d\x7e\x56\x4d\x5e\xbb\xda\x14\x88\x42\xea\x6a\x3e\xb0\xe0\x19\xbe\xac\x12\xfe\x07\x00\x52\xd0\x2f\xc8\xd4\x00\x00\x00")
+
+func templatesTraceEnabledTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTraceEnabledTmpl,
+		"templates/trace_enabled.tmpl",
+	)
+}
+
+func templatesTraceEnabledTmpl() (*asset, error) {
+	bytes, err := templatesTraceEnabledTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/trace_enabled.tmpl", size: 212, mode: os.FileMode(420), modTime: time.Unix(1792207417, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"registry/egl.xml": registryEglXml,
+	"registry/gl.xml": registryGlXml,
+	"registry/glx.xml": registryGlxXml,
+	"templates/cmdbuf.tmpl": templatesCmdbufTmpl,
+	"templates/debug.tmpl": templatesDebugTmpl,
+	"templates/debug_disabled.tmpl": templatesDebugDisabledTmpl,
+	"templates/debug_enabled.tmpl": templatesDebugEnabledTmpl,
+	"templates/debugcb.tmpl": templatesDebugcbTmpl,
+	"templates/debugcb_api.tmpl": templatesDebugcbApiTmpl,
+	"templates/egl.tmpl": templatesEglTmpl,
+	"templates/eglheader.tmpl": templatesEglheaderTmpl,
+	"templates/enumnames.tmpl": templatesEnumnamesTmpl,
+	"templates/gl.tmpl": templatesGlTmpl,
+	"templates/gles2.tmpl": templatesGles2Tmpl,
+	"templates/glx.tmpl": templatesGlxTmpl,
+	"templates/glxheader.tmpl": templatesGlxheaderTmpl,
+	"templates/header.tmpl": templatesHeaderTmpl,
+	"templates/queries.tmpl": templatesQueriesTmpl,
+	"templates/strings.tmpl": templatesStringsTmpl,
+	"templates/trace.tmpl": templatesTraceTmpl,
+	"templates/trace_disabled.tmpl": templatesTraceDisabledTmpl,
+	"templates/trace_enabled.tmpl": templatesTraceEnabledTmpl,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"registry": &bintree{nil, map[string]*bintree{
+		"egl.xml": &bintree{registryEglXml, map[string]*bintree{}},
+		"gl.xml": &bintree{registryGlXml, map[string]*bintree{}},
+		"glx.xml": &bintree{registryGlxXml, map[string]*bintree{}},
+	}},
+	"templates": &bintree{nil, map[string]*bintree{
+		"cmdbuf.tmpl": &bintree{templatesCmdbufTmpl, map[string]*bintree{}},
+		"debug.tmpl": &bintree{templatesDebugTmpl, map[string]*bintree{}},
+		"debug_disabled.tmpl": &bintree{templatesDebugDisabledTmpl, map[string]*bintree{}},
+		"debug_enabled.tmpl": &bintree{templatesDebugEnabledTmpl, map[string]*bintree{}},
+		"debugcb.tmpl": &bintree{templatesDebugcbTmpl, map[string]*bintree{}},
+		"debugcb_api.tmpl": &bintree{templatesDebugcbApiTmpl, map[string]*bintree{}},
+		"egl.tmpl": &bintree{templatesEglTmpl, map[string]*bintree{}},
+		"eglheader.tmpl": &bintree{templatesEglheaderTmpl, map[string]*bintree{}},
+		"enumnames.tmpl": &bintree{templatesEnumnamesTmpl, map[string]*bintree{}},
+		"gl.tmpl": &bintree{templatesGlTmpl, map[string]*bintree{}},
+		"gles2.tmpl": &bintree{templatesGles2Tmpl, map[string]*bintree{}},
+		"glx.tmpl": &bintree{templatesGlxTmpl, map[string]*bintree{}},
+		"glxheader.tmpl": &bintree{templatesGlxheaderTmpl, map[string]*bintree{}},
+		"header.tmpl": &bintree{templatesHeaderTmpl, map[string]*bintree{}},
+		"queries.tmpl": &bintree{templatesQueriesTmpl, map[string]*bintree{}},
+		"strings.tmpl": &bintree{templatesStringsTmpl, map[string]*bintree{}},
+		"trace.tmpl": &bintree{templatesTraceTmpl, map[string]*bintree{}},
+		"trace_disabled.tmpl": &bintree{templatesTraceDisabledTmpl, map[string]*bintree{}},
+		"trace_enabled.tmpl": &bintree{templatesTraceEnabledTmpl, map[string]*bintree{}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
@@ -0,0 +1,412 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Command is a function from the registry.
+//
+type Command struct {
+	Type    Type
+	Name    string
+	Params  []Param
+	Version Version
+	Alias   string // name of the command this one is an alias of, if any
+	Query   string // name of the value returning variants of a query command, see setQueries
+}
+
+// Param is a parameter of a Command.
+//
+type Param struct {
+	Type Type
+	Name string
+	Len  string // len attribute from gl.xml
+
+	// Slice wrapper setup, see Command.setSlices.
+	Count      string // for slice parameters, name of the count parameter
+	MinLen     string // for slice parameters, minimum length check, if any
+	SliceCount string // for count parameters, Go expression giving the count
+
+	// String helper setup, see Command.setStrings.
+	String    string // for GLchar pointers: "in", "array" or "out"
+	StringArg string // for parameters derived from strings, Go expression giving the argument
+}
+
+// SliceGoName returns the Go type of p in slice wrappers.
+//
+func (p *Param) SliceGoName() string {
+	if p.Type.Name == "void" {
+		return "interface{}"
+	}
+	if p.Type.goType != "" {
+		return "[]" + p.Type.goType[1:]
+	}
+	return "[]" + types[p.Type.Name][0]
+}
+
+// SliceLen returns a Go expression for the length of p in slice wrappers. For
+// void pointers, this is the byte length returned by slicePtr.
+//
+func (p *Param) SliceLen() string {
+	if p.Type.Name == "void" {
+		return p.Name + "Len"
+	}
+	return "len(" + p.Name + ")"
+}
+
+// GoName returns the name of the Go wrapper for c, i.e. its name without the
+// gl, glX or egl prefix.
+//
+func (c *Command) GoName() string {
+	for _, p := range []string{"egl", "glX", "gl"} {
+		if strings.HasPrefix(c.Name, p) {
+			return strings.ToUpper(c.Name[len(p):len(p)+1]) + c.Name[len(p)+1:]
+		}
+	}
+	return c.Name
+}
+
+func (c *Command) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var xc struct {
+		Proto struct {
+			Type  string `xml:"ptype"`
+			Ptr   string `xml:",chardata"`
+			Name  string `xml:"name"`
+			Group string `xml:"group,attr"`
+			Class string `xml:"class,attr"`
+		} `xml:"proto"`
+		Params []struct {
+			Type  string `xml:"ptype"`
+			Ptr   string `xml:",chardata"`
+			Name  string `xml:"name"`
+			Group string `xml:"group,attr"`
+			Len   string `xml:"len,attr"`
+			Class string `xml:"class,attr"`
+		} `xml:"param"`
+		Alias struct {
+			Name string `xml:"name,attr"`
+		} `xml:"alias"`
+	}
+	err := d.DecodeElement(&xc, &start)
+	if err != nil {
+		return err
+	}
+
+	c.Type, err = MkType(xc.Proto.Type, xc.Proto.Ptr)
+	if err != nil {
+		return err
+	}
+	c.Type.Group = xc.Proto.Group
+	c.Type.Class = xc.Proto.Class
+	c.Name = xc.Proto.Name
+	c.Alias = xc.Alias.Name
+	c.Params = make([]Param, 0, len(xc.Params))
+	for _, xp := range xc.Params {
+		t, err := MkType(xp.Type, xp.Ptr)
+		if err != nil {
+			return fmt.Errorf("%s: %v", c.Name, err)
+		}
+		t.Group = xp.Group
+		t.Class = xp.Class
+		c.Params = append(c.Params, Param{
+			Name: paramName(xp.Name),
+			Type: t,
+			Len:  xp.Len,
+		})
+	}
+	return nil
+}
+
+// CProto returns the C prototype of c.
+//
+func (c *Command) CProto() string {
+	var sb strings.Builder
+	sb.WriteString(c.Type.CDecl(c.Name))
+	sb.WriteByte('(')
+	for i := range c.Params {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		// undo paramName
+		sb.WriteString(c.Params[i].Type.CDecl(strings.TrimSuffix(c.Params[i].Name, "_")))
+	}
+	if len(c.Params) == 0 {
+		sb.WriteString("void")
+	}
+	sb.WriteString(");")
+	return sb.String()
+}
+
+// GoSignature returns the signature of the Go wrapper for c.
+//
+func (c *Command) GoSignature() string {
+	var sb strings.Builder
+	sb.WriteString("func " + c.GoName() + "(")
+	for i := range c.Params {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(c.Params[i].Name + " " + c.Params[i].Type.GoName(false))
+	}
+	sb.WriteByte(')')
+	if ret := c.Type.GoName(true); ret != "" {
+		sb.WriteString(" " + ret)
+	}
+	return sb.String()
+}
+
+// HasSlices returns true if a slice wrapper can be generated for c.
+//
+func (c *Command) HasSlices() bool {
+	for i := range c.Params {
+		if c.Params[i].SliceCount != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Recordable returns true if calls to c can be recorded in a CommandBuffer,
+// i.e. if c does not return a value and has no pointer parameters.
+//
+func (c *Command) Recordable() bool {
+	if c.Type.Name != "void" || c.Type.Ptr > 0 {
+		return false
+	}
+	for i := range c.Params {
+		t := &c.Params[i].Type
+		switch t.GoName(false) {
+		case "", "unsafe.Pointer", "uintptr":
+			return false
+		}
+		if t.Ptr > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// setSlices looks for pointer parameters whose len attribute refers to an
+// integer parameter of c, optionally multiplied by a constant (e.g. len="n" or
+// len="count*4"), and sets them up for the generation of a slice wrapper.
+//
+// A count parameter is only replaced by slice lengths if all the parameters
+// that refer to it can be converted to slices.
+//
+func (c *Command) setSlices() {
+	type slice struct {
+		p   *Param
+		mul int
+	}
+	counts := make(map[string][]slice)
+	bad := make(map[string]bool)
+	for i := range c.Params {
+		p := &c.Params[i]
+		if p.Len == "" {
+			continue
+		}
+		n, m := p.Len, 1
+		if k := strings.IndexByte(n, '*'); k >= 0 {
+			var err error
+			if m, err = strconv.Atoi(n[k+1:]); err != nil || m < 1 {
+				continue
+			}
+			n = n[:k]
+		}
+		n = paramName(n)
+		q := c.param(n)
+		if q == nil || q == p || q.Type.Ptr != 0 {
+			continue
+		}
+		switch q.Type.Name {
+		case "GLsizei", "GLint", "GLuint", "GLsizeiptr":
+		default:
+			continue
+		}
+		if !p.sliceable(q, m) {
+			bad[n] = true
+			continue
+		}
+		counts[n] = append(counts[n], slice{p, m})
+	}
+	for n, ss := range counts {
+		if bad[n] {
+			continue
+		}
+		q := c.param(n)
+		q.SliceCount = ss[0].p.SliceLen()
+		if ss[0].mul > 1 {
+			q.SliceCount += " / " + strconv.Itoa(ss[0].mul)
+		}
+		for i, s := range ss {
+			s.p.Count = n
+			// the first slice gives the count, others must be large enough.
+			if i > 0 {
+				s.p.MinLen = "int(" + n + ")"
+				if s.mul > 1 {
+					s.p.MinLen += " * " + strconv.Itoa(s.mul)
+				}
+			}
+		}
+	}
+}
+
+func (c *Command) param(name string) *Param {
+	for i := range c.Params {
+		if c.Params[i].Name == name {
+			return &c.Params[i]
+		}
+	}
+	return nil
+}
+
+// sliceable returns true if p can be converted to a slice whose length gives
+// the value of count, multiplied by mul.
+//
+func (p *Param) sliceable(count *Param, mul int) bool {
+	if p.Type.Ptr != 1 {
+		return false
+	}
+	// size and bufSize are usually a number of bytes.
+	inBytes := count.Type.Name == "GLsizeiptr" || count.Name == "size" || count.Name == "bufSize"
+	switch p.Type.Name {
+	case "void":
+		return inBytes && mul == 1
+	case "GLchar", "GLcharARB", "GLubyte", "GLbyte", "GLboolean":
+	default:
+		if inBytes {
+			return false
+		}
+	}
+	gn := types[p.Type.Name]
+	return gn[1] == "*"+gn[0]
+}
+
+var reserved = map[string]struct{}{
+	"int":        {},
+	"int8":       {},
+	"int16":      {},
+	"int32":      {},
+	"uint32":     {},
+	"uint":       {},
+	"uint8":      {},
+	"uint16":     {},
+	"int64":      {},
+	"uint64":     {},
+	"uintptr":    {},
+	"float32":    {},
+	"float64":    {},
+	"complex64":  {},
+	"complex128": {},
+	"string":     {},
+	"byte":       {},
+	"rune":       {},
+	"bool":       {},
+
+	"break":       {},
+	"default":     {},
+	"func":        {},
+	"interface":   {},
+	"select":      {},
+	"case":        {},
+	"defer":       {},
+	"go":          {},
+	"map":         {},
+	"struct":      {},
+	"chan":        {},
+	"else":        {},
+	"goto":        {},
+	"package":     {},
+	"switch":      {},
+	"const":       {},
+	"fallthrough": {},
+	"if":          {},
+	"range":       {},
+	"type":        {},
+	"continue":    {},
+	"for":         {},
+	"import":      {},
+	"return":      {},
+	"var":         {},
+}
+
+func paramName(n string) string {
+	if _, ok := reserved[n]; ok {
+		return n + "_"
+	}
+	return n
+}
+
+// Enum is a constant from the registry.
+//
+type Enum struct {
+	Name   string
+	Value  string
+	Type   string // type attribute: "u" or "ull" for unsigned constants
+	API    string // api attribute, if the value is specific to an API
+	Alias  string // name of the enum this one is an alias of, if any
+	Groups []string
+	goType string // Go type of the enum group set by enumGroups
+}
+
+// GoType returns the Go type of e, or an empty string for untyped constants.
+//
+func (e *Enum) GoType() string {
+	if e.goType != "" {
+		return e.goType
+	}
+	switch e.Type {
+	case "u":
+		return "uint32"
+	case "ull":
+		return "uint64"
+	}
+	return ""
+}
+
+func (e *Enum) GoName() string {
+	if strings.HasPrefix(e.Name, "GL_") {
+		return e.Name[3:]
+	}
+	return e.Name
+}
+
+func sortEnums(em map[string]Enum) []Enum {
+	enums := make([]Enum, 0, len(em))
+	for _, v := range em {
+		enums = append(enums, v)
+	}
+	sort.Slice(enums, func(i, j int) bool { return enums[i].Name < enums[j].Name })
+	return enums
+}
+
+func sortCommands(cm map[string]*Command) []*Command {
+	cmds := make([]*Command, 0, len(cm))
+	for _, v := range cm {
+		cmds = append(cmds, v)
+	}
+	// commands only required by extensions have a zero Version and go last.
+	less := func(l, r *Version) bool {
+		if l.Major == 0 || r.Major == 0 {
+			return l.Major != 0 && r.Major == 0
+		}
+		return l.Less(r)
+	}
+	sort.Slice(cmds, func(i, j int) bool {
+		return less(&cmds[i].Version, &cmds[j].Version) || (!less(&cmds[j].Version, &cmds[i].Version)) && cmds[i].Name < cmds[j].Name
+	})
+	return cmds
+}
+
+func sortExtensions(exts []*Extension) []*Extension {
+	sort.Slice(exts, func(i, j int) bool { return exts[i].Name < exts[j].Name })
+	return exts
+}
@@ -0,0 +1,46 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import "strconv"
+
+// ContextVersion is an API version for which a Context interface is
+// generated.
+//
+type ContextVersion struct {
+	Version  Version
+	Name     string          // name suffix of the interface, e.g. GL_3_3 for Context_GL_3_3
+	Prev     *ContextVersion // previous version, embedded in the interface; nil for the first one
+	Commands []*Command      // commands introduced in this version
+}
+
+// ContextVersions returns the API versions of r that introduce commands, in
+// increasing order. Commands only required by extensions are not part of any
+// version.
+//
+func (r *Registry) ContextVersions() []*ContextVersion {
+	prefix := "GL_"
+	if r.API == "gles2" {
+		prefix = "ES_"
+	}
+	var vs []*ContextVersion
+	var cv *ContextVersion
+	// r.Commands is sorted by version.
+	for _, c := range r.Commands {
+		if c.Version.Major == 0 {
+			continue
+		}
+		if cv == nil || cv.Version != c.Version {
+			cv = &ContextVersion{
+				Version: c.Version,
+				Name:    prefix + strconv.Itoa(c.Version.Major) + "_" + strconv.Itoa(c.Version.Minor),
+				Prev:    cv,
+			}
+			vs = append(vs, cv)
+		}
+		cv.Commands = append(cv.Commands, c)
+	}
+	return vs
+}
@@ -0,0 +1,75 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+// debugHelpers maps the debug output helpers generated with
+// Config.DebugCallback to the commands they call, core or KHR_debug.
+//
+var debugHelpers = map[string][]string{
+	"SetDebugCallback": {"glDebugMessageCallback", "glDebugMessageCallbackKHR"},
+	"DebugGroup":       {"glPushDebugGroup", "glPopDebugGroup", "glPushDebugGroupKHR", "glPopDebugGroupKHR"},
+	"LabelObject":      {"glObjectLabel", "glObjectLabelKHR"},
+}
+
+// FindCommand returns the first command of r named after one of names, or nil
+// if there is none.
+//
+func (r *Registry) FindCommand(names ...string) *Command {
+	for _, n := range names {
+		for _, c := range r.Commands {
+			if c.Name == n {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// FindExtension returns the extension of r with the given name, or nil if it
+// was not selected.
+//
+func (r *Registry) FindExtension(name string) *Extension {
+	for _, e := range r.Extensions {
+		if e.Name == name {
+			return e
+		}
+	}
+	return nil
+}
+
+// groupType returns the Go type of the enum group name if both gl and gles
+// have it, or uint32 otherwise. It is used by the templates shared by both
+// APIs.
+//
+func groupType(gl, gles *Registry, name string) string {
+	g := gl.FindGroup(name)
+	if g == nil || gles.FindGroup(name) == nil {
+		return "uint32"
+	}
+	return g.GoName
+}
+
+// FindGroup returns the enum group of r with the given name, or nil if there
+// is none.
+//
+func (r *Registry) FindGroup(name string) *Group {
+	for _, g := range r.Groups {
+		if g.Name == name {
+			return g
+		}
+	}
+	return nil
+}
+
+// Provides returns true if c is one of the commands of e.
+//
+func (e *Extension) Provides(c *Command) bool {
+	for _, ec := range e.Commands {
+		if ec.Name == c.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Target is an API, version and profile compared by Diff.
+//
+type Target struct {
+	API     string  `json:"api"` // gl, gles2, egl or glx
+	Version Version `json:"version"`
+	Profile string  `json:"profile,omitempty"` // core or compatibility, for gl only
+}
+
+// ParseTarget parses a target of the form api:version[:profile], e.g.
+// gl:3.3:core or gles2:3.0.
+//
+func ParseTarget(s string) (Target, error) {
+	var t Target
+	f := strings.Split(s, ":")
+	if len(f) < 2 || len(f) > 3 {
+		return t, fmt.Errorf("invalid target %s, expected api:version[:profile]", s)
+	}
+	t.API = f[0]
+	if err := t.Version.Set(f[1]); err != nil {
+		return t, fmt.Errorf("invalid version in target %s: %v", s, err)
+	}
+	if len(f) == 3 {
+		t.Profile = f[2]
+	}
+	return t, t.normalize()
+}
+
+func (t Target) String() string {
+	s := t.API + " " + t.Version.String()
+	if t.Profile != "" {
+		s += " " + t.Profile
+	}
+	return s
+}
+
+// normalize checks t and sets the default profile.
+//
+func (t *Target) normalize() error {
+	switch t.API {
+	case "gl":
+		switch t.Profile {
+		case "":
+			t.Profile = "compatibility"
+		case "core", "compatibility":
+		default:
+			return fmt.Errorf("unsupported profile %s", t.Profile)
+		}
+		if t.Profile == "core" && t.Version.Less(&Version{Major: 3, Minor: 2}) {
+			return fmt.Errorf("core profile only supported in OpenGL versions >= 3.2")
+		}
+	case "gles2", "egl", "glx":
+		if t.Profile != "" {
+			return fmt.Errorf("profiles are not supported for %s", t.API)
+		}
+	default:
+		return fmt.Errorf("unsupported api %s", t.API)
+	}
+	return nil
+}
+
+// registryAPI returns the API of the registry file describing t.
+//
+func (t *Target) registryAPI() string {
+	if t.API == "gles2" {
+		return "gl"
+	}
+	return t.API
+}
+
+// Changes lists the commands and enums added and removed between two targets.
+//
+type Changes struct {
+	From            Target   `json:"from"`
+	To              Target   `json:"to"`
+	AddedCommands   []string `json:"addedCommands"`
+	RemovedCommands []string `json:"removedCommands"`
+	AddedEnums      []string `json:"addedEnums"`
+	RemovedEnums    []string `json:"removedEnums"`
+}
+
+// Diff returns the commands and enums added and removed between from and to.
+// Both targets must be described by the same registry file, so OpenGL can be
+// compared with OpenGLES but not with EGL. Only cfg.RegistryFile,
+// cfg.ForceUpdate and cfg.Logger are used.
+//
+func Diff(ctx context.Context, cfg Config, from, to Target) (*Changes, error) {
+	for _, t := range []*Target{&from, &to} {
+		if err := t.normalize(); err != nil {
+			return nil, err
+		}
+	}
+	if from.registryAPI() != to.registryAPI() {
+		return nil, fmt.Errorf("cannot compare %s and %s", from.API, to.API)
+	}
+	cfg = Config{
+		API:          from.registryAPI(),
+		RegistryFile: cfg.RegistryFile,
+		ForceUpdate:  cfg.ForceUpdate,
+		Logger:       cfg.Logger,
+	}
+	if err := cfg.normalize(); err != nil {
+		return nil, err
+	}
+	data, err := ReadRegistry(ctx, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	var regs [2]*Registry
+	for i, t := range []Target{from, to} {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		c := cfg
+		c.Profile = t.Profile
+		if regs[i], err = decodeRegistry(bytes.NewReader(data), &c, nil, t.API, t.Version, ""); err != nil {
+			return nil, err
+		}
+	}
+	ch := &Changes{From: from, To: to}
+	ch.AddedCommands, ch.RemovedCommands = diffNames(commandNames(regs[0]), commandNames(regs[1]))
+	ch.AddedEnums, ch.RemovedEnums = diffNames(enumNames(regs[0]), enumNames(regs[1]))
+	return ch, nil
+}
+
+func commandNames(r *Registry) map[string]struct{} {
+	m := make(map[string]struct{}, len(r.Commands))
+	for _, c := range r.Commands {
+		m[c.Name] = struct{}{}
+	}
+	return m
+}
+
+func enumNames(r *Registry) map[string]struct{} {
+	m := make(map[string]struct{}, len(r.Enums)+len(r.Pointers))
+	for _, e := range r.Enums {
+		m[e.Name] = struct{}{}
+	}
+	for _, e := range r.Pointers {
+		m[e.Name] = struct{}{}
+	}
+	return m
+}
+
+// diffNames returns the sorted names in to but not in from, and in from but not
+// in to.
+//
+func diffNames(from, to map[string]struct{}) (added, removed []string) {
+	added, removed = []string{}, []string{}
+	for n := range to {
+		if _, ok := from[n]; !ok {
+			added = append(added, n)
+		}
+	}
+	for n := range from {
+		if _, ok := to[n]; !ok {
+			removed = append(removed, n)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
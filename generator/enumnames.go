@@ -0,0 +1,123 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EnumTable is a reverse lookup table of enum values to enum names, generated
+// with Config.EnumNames.
+//
+type EnumTable struct {
+	Names   string      // all names, concatenated
+	Entries []EnumEntry // sorted by value
+	Groups  []EnumGroup // enum groups used by the commands
+}
+
+// EnumEntry is an entry of an EnumTable.
+//
+type EnumEntry struct {
+	Name   string
+	Value  uint32
+	Offset int // offset of Name in EnumTable.Names
+}
+
+// EnumGroup is the subset of an EnumTable for an enum group.
+//
+type EnumGroup struct {
+	Name    string // group name in gl.xml
+	Indices []int  // indices of the group entries in the table, sorted by value
+}
+
+// vendorSuffixes are the vendor suffixes of extension enums. For values with
+// several names, names without a vendor suffix come first.
+//
+var vendorSuffixes = map[string]bool{
+	"3DFX": true, "AMD": true, "ANGLE": true, "APPLE": true, "ARB": true,
+	"ARM": true, "ATI": true, "EXT": true, "HP": true, "IBM": true, "IMG": true,
+	"INGR": true, "INTEL": true, "KHR": true, "MESA": true, "MESAX": true,
+	"NV": true, "NVX": true, "OES": true, "OML": true, "OVR": true, "PGI": true,
+	"QCOM": true, "REND": true, "SGI": true, "SGIS": true, "SGIX": true,
+	"SUN": true, "SUNX": true, "VIV": true, "WIN": true,
+}
+
+func hasVendorSuffix(name string) bool {
+	return vendorSuffixes[name[strings.LastIndexByte(name, '_')+1:]]
+}
+
+// EnumTable returns the reverse lookup table of the enums of r. Enums whose
+// value does not fit in a GLenum are not part of it. Groups are those of
+// r.Groups and those used by the parameters and return values of r.Commands.
+//
+func (r *Registry) EnumTable() *EnumTable {
+	type entry struct {
+		name   string
+		value  uint32
+		groups []string
+	}
+	var es []entry
+	for _, e := range r.Enums {
+		v, err := strconv.ParseUint(e.Value, 0, 32)
+		if err != nil {
+			continue
+		}
+		es = append(es, entry{e.Name, uint32(v), e.Groups})
+	}
+	// r.Enums is sorted by name.
+	sort.SliceStable(es, func(i, j int) bool {
+		if es[i].value != es[j].value {
+			return es[i].value < es[j].value
+		}
+		return !hasVendorSuffix(es[i].name) && hasVendorSuffix(es[j].name)
+	})
+
+	used := make(map[string]bool)
+	for _, g := range r.Groups {
+		used[g.Name] = true
+	}
+	for _, c := range r.Commands {
+		used[c.Type.Group] = true
+		for _, p := range c.Params {
+			used[p.Type.Group] = true
+		}
+	}
+	t := &EnumTable{Entries: make([]EnumEntry, len(es))}
+	var sb strings.Builder
+	gm := make(map[string]*EnumGroup)
+	for i, e := range es {
+		t.Entries[i] = EnumEntry{Name: e.name, Value: e.value, Offset: sb.Len()}
+		sb.WriteString(e.name)
+		for _, n := range e.groups {
+			if !used[n] {
+				continue
+			}
+			g := gm[n]
+			if g == nil {
+				g = &EnumGroup{Name: n}
+				gm[n] = g
+			}
+			g.Indices = append(g.Indices, i)
+		}
+	}
+	t.Names = sb.String()
+	for _, g := range gm {
+		t.Groups = append(t.Groups, *g)
+	}
+	sort.Slice(t.Groups, func(i, j int) bool { return t.Groups[i].Name < t.Groups[j].Name })
+	return t
+}
+
+// OffsetType returns the smallest unsigned integer type holding the name
+// offsets of t.
+//
+func (t *EnumTable) OffsetType() string {
+	if len(t.Names) <= 0xffff {
+		return "uint16"
+	}
+	return "uint32"
+}
@@ -0,0 +1,125 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Registry URLs by file name.
+//
+var regURLs = map[string]string{
+	"gl.xml":  "https://raw.githubusercontent.com/KhronosGroup/OpenGL-Registry/master/xml/gl.xml",
+	"egl.xml": "https://raw.githubusercontent.com/KhronosGroup/EGL-Registry/main/api/egl.xml",
+	"glx.xml": "https://raw.githubusercontent.com/KhronosGroup/OpenGL-Registry/master/xml/glx.xml",
+}
+
+// httpClient fetches the registry. Its timeouts ensure that ReadRegistry falls
+// back to the embedded registry when the network is unreachable or a
+// connection hangs, even if ctx has no deadline.
+//
+var httpClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout: 10 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 15 * time.Second,
+	},
+	Timeout: 2 * time.Minute,
+}
+
+// ReadRegistry returns the contents of the registry file for cfg.API (gl.xml,
+// egl.xml or glx.xml). It is read from cfg.RegistryFile if set, from the
+// cache, or fetched from github. If fetching fails, it falls back to the
+// registry snapshot embedded in bindata.go.
+//
+func ReadRegistry(ctx context.Context, cfg *Config) ([]byte, error) {
+	if cfg.RegistryFile != "" {
+		cfg.logf("Using registry file %s", cfg.RegistryFile)
+		return ioutil.ReadFile(cfg.RegistryFile)
+	}
+	name := cfg.API + ".xml"
+	url, ok := regURLs[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported api %s", cfg.API)
+	}
+	c := cacheFile(name)
+	if c != "" && !cfg.ForceUpdate {
+		if fi, err := os.Stat(c); err == nil {
+			cfg.logf("Using cached registry file %s; last updated: %v", c, fi.ModTime().Format(time.RFC1123))
+			cfg.logf("Use the -f switch to force an update")
+			return ioutil.ReadFile(c)
+		}
+	}
+	data, err := fetchRegistry(ctx, cfg, url)
+	if err != nil {
+		if cfg.ForceUpdate || ctx.Err() != nil {
+			return nil, err
+		}
+		return embeddedRegistry(cfg, name, err)
+	}
+	// write cache
+	if c != "" {
+		ioutil.WriteFile(c, data, 0666)
+	}
+	return data, nil
+}
+
+// cacheFile returns the path of the cached registry file name or an empty
+// string if the cache directory is not available.
+//
+func cacheFile(name string) string {
+	c, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	// create gogl dir
+	c = filepath.Join(c, "gogl")
+	err = os.MkdirAll(c, 0777)
+	if err != nil && !os.IsExist(err) {
+		return ""
+	}
+	return filepath.Join(c, name)
+}
+
+func embeddedRegistry(cfg *Config, name string, fetchErr error) ([]byte, error) {
+	data, err := Asset("registry/" + name)
+	if err != nil {
+		return nil, fmt.Errorf("%v; no embedded registry available, use the -registry flag", fetchErr)
+	}
+	cfg.logf("Warning: %v", fetchErr)
+	cfg.logf("Using embedded registry snapshot")
+	return data, nil
+}
+
+func fetchRegistry(ctx context.Context, cfg *Config, url string) ([]byte, error) {
+	cfg.logf("Fetching registry from %s", url)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", url, resp.Status)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
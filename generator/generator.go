@@ -0,0 +1,487 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package generator implements the gogl code generator.
+//
+// Generate reads a Khronos registry file and writes the Go package it
+// describes. Tools that need to adjust the generated code can instead call
+// Load, modify the returned registries, then call Write.
+//
+package generator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// Config describes the package to generate. Zero values select the defaults
+// documented for each field.
+//
+type Config struct {
+	// API to generate: "gl" (OpenGL and OpenGLES, the default), "egl" or
+	// "glx".
+	API string
+	// API version. Defaults to 3.2 for gl, 1.5 for egl and 1.4 for glx.
+	Version Version
+	// OpenGLES version generated along with OpenGL. Defaults to 2.0.
+	GLESVersion Version
+	// OpenGL profile: "core" or "compatibility" (the default). The core
+	// profile requires OpenGL 3.2 or above.
+	Profile string
+	// Extension names to generate. Names may contain wildcards (e.g.
+	// GL_ARB_*). For glx, defaults to GLX_ARB_create_context,
+	// GLX_ARB_create_context_profile and GLX_EXT_swap_control.
+	Extensions []string
+	// Directories scanned for Go files using the generated package. A
+	// trailing "/..." also scans subdirectories. If not empty, only the
+	// functions, constants and types used by these files, or by custom files
+	// in OutDir, are generated.
+	Usage []string
+	// Command or enum names always generated (with Usage) or never generated.
+	// Names may contain wildcards (e.g. glUniform*).
+	Include []string
+	Exclude []string
+	// Name of the generated package. Defaults to API.
+	Package string
+	// Output directory.
+	OutDir string
+
+	EnumTypes   bool // generate Go types for enum groups; GL_* constants stay untyped
+	HandleTypes bool // generate Go types for object handles
+	Slices      bool // generate slice based variants of functions
+	Strings     bool // generate string based variants of functions taking GLchar pointers
+	Queries     bool // generate value returning variants of query functions
+	EnumNames   bool // generate a reverse lookup table of enum names and the Error type
+	// Generate glGetError checks after each call, enabled by the gogl_debug
+	// build tag in the generated package. Only supported for gl.
+	Debug bool
+	// Generate tracing hooks and call statistics, enabled by the gogl_trace
+	// build tag in the generated package. Only supported for gl.
+	Trace bool
+	// Generate the CommandBuffer type, which records calls to functions
+	// without pointer parameters and executes them in a single cgo call. Only
+	// supported for gl.
+	CommandBuffer bool
+	// Generate versioned Context interfaces and the Current function
+	// returning the richest Context available at runtime.
+	Contexts bool
+	// Store function pointers, version and extension flags in per-context
+	// tables, selected per thread with ProcTable.MakeCurrent, instead of
+	// global variables. Only supported for gl.
+	MultiContext bool
+	// Generate SetDebugCallback, which forwards OpenGL debug output to a Go
+	// function, and the DebugGroup and LabelObject helpers. They require
+	// OpenGL 4.3, OpenGLES 3.2 or the GL_KHR_debug extension, which is added
+	// to Extensions for lower versions. Only supported for gl.
+	DebugCallback bool
+	// Generate Go types mirroring the C typedefs and use them for scalar
+	// parameters and return values. Use bool for GLboolean values and string
+	// for string return values. Only supported for gl.
+	GoTypes bool
+
+	// Directory of user templates. Templates with the same name as a
+	// built-in template (e.g. gl.tmpl) replace it. Other NAME.tmpl files are
+	// executed with the same data as the header template and written to
+	// OutDir/NAME.
+	TemplateDir string
+
+	RegistryFile string      // read the registry from this file instead of the cache or network
+	ForceUpdate  bool        // force update of the cached registry file
+	Logger       *log.Logger // verbose output, if not nil
+}
+
+func (cfg *Config) logf(format string, args ...interface{}) {
+	if cfg.Logger != nil {
+		cfg.Logger.Printf(format, args...)
+	}
+}
+
+// normalize checks cfg and sets default values.
+//
+func (cfg *Config) normalize() error {
+	if cfg.API == "" {
+		cfg.API = "gl"
+	}
+	var def Version
+	switch cfg.API {
+	case "gl":
+		def = Version{3, 2}
+	case "egl":
+		def = Version{1, 5}
+	case "glx":
+		def = Version{1, 4}
+		if len(cfg.Extensions) == 0 {
+			// needed to create core profile contexts
+			cfg.Extensions = []string{"GLX_ARB_create_context", "GLX_ARB_create_context_profile", "GLX_EXT_swap_control"}
+		}
+	default:
+		return fmt.Errorf("unsupported api %s", cfg.API)
+	}
+	if cfg.Version.Major == 0 && cfg.Version.Minor == 0 {
+		cfg.Version = def
+	}
+	if cfg.Debug && cfg.API != "gl" {
+		return fmt.Errorf("debug mode is not supported for the %s api", cfg.API)
+	}
+	if cfg.Trace && cfg.API != "gl" {
+		return fmt.Errorf("tracing is not supported for the %s api", cfg.API)
+	}
+	if cfg.CommandBuffer && cfg.API != "gl" {
+		return fmt.Errorf("command buffers are not supported for the %s api", cfg.API)
+	}
+	if cfg.Strings && cfg.API != "gl" {
+		return fmt.Errorf("string helpers are not supported for the %s api", cfg.API)
+	}
+	if cfg.Queries && cfg.API != "gl" {
+		return fmt.Errorf("query variants are not supported for the %s api", cfg.API)
+	}
+	if cfg.EnumNames && cfg.API != "gl" {
+		return fmt.Errorf("enum names are not supported for the %s api", cfg.API)
+	}
+	if cfg.DebugCallback && cfg.API != "gl" {
+		return fmt.Errorf("debug callbacks are not supported for the %s api", cfg.API)
+	}
+	if cfg.MultiContext && cfg.API != "gl" {
+		return fmt.Errorf("multiple contexts are not supported for the %s api", cfg.API)
+	}
+	if cfg.GoTypes && cfg.API != "gl" {
+		return fmt.Errorf("Go types are not supported for the %s api", cfg.API)
+	}
+	switch cfg.Profile {
+	case "":
+		cfg.Profile = "compatibility"
+	case "compatibility":
+	case "core":
+		if cfg.API == "gl" && cfg.Version.Less(&Version{Major: 3, Minor: 2}) {
+			cfg.Profile = "compatibility"
+			cfg.logf("Warning: core profile only supported in OpenGL versions >= 3.2")
+		}
+	default:
+		return fmt.Errorf("unsupported profile %s", cfg.Profile)
+	}
+	if cfg.GLESVersion.Less(&Version{Major: 2, Minor: 0}) {
+		if cfg.GLESVersion.Major != 0 || cfg.GLESVersion.Minor != 0 {
+			cfg.logf("Warning: OpenGLES versions < 2.0 are not supported")
+		}
+		cfg.GLESVersion = Version{2, 0}
+	}
+	if cfg.DebugCallback && (cfg.Version.Less(&Version{4, 3}) || cfg.GLESVersion.Less(&Version{3, 2})) &&
+		!matchAny(cfg.Extensions, "GL_KHR_debug") {
+		// provides the debug output functions before OpenGL 4.3 and OpenGLES 3.2
+		cfg.Extensions = append(cfg.Extensions[:len(cfg.Extensions):len(cfg.Extensions)], "GL_KHR_debug")
+	}
+	for _, p := range cfg.Extensions {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("extension %s: %v", p, err)
+		}
+	}
+	for _, ps := range [][]string{cfg.Include, cfg.Exclude} {
+		for _, p := range ps {
+			if _, err := path.Match(p, ""); err != nil {
+				return fmt.Errorf("pattern %s: %v", p, err)
+			}
+		}
+	}
+	if cfg.Package == "" {
+		cfg.Package = cfg.API
+	}
+	return nil
+}
+
+// Generate generates the package described by cfg.
+//
+func Generate(ctx context.Context, cfg Config) error {
+	regs, err := Load(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+	return Write(cfg, regs)
+}
+
+// Load reads and decodes the registry for cfg. For the gl API, it returns the
+// OpenGL and OpenGLES registries, in that order. For other APIs it returns a
+// single registry.
+//
+func Load(ctx context.Context, cfg Config) ([]*Registry, error) {
+	if err := cfg.normalize(); err != nil {
+		return nil, err
+	}
+	data, err := ReadRegistry(ctx, &cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var u *usage
+	switch {
+	case len(cfg.Usage) > 0:
+		if u, err = scanUsage(&cfg); err != nil {
+			return nil, err
+		}
+	case len(cfg.Exclude) > 0:
+		u = new(usage)
+	}
+
+	var regs []*Registry
+	decode := func(api string, v Version, tags string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		cfg.logf("Parsing %s.xml (%s)", cfg.API, api)
+		r, err := decodeRegistry(bytes.NewReader(data), &cfg, u, api, v, tags)
+		if err != nil {
+			return err
+		}
+		regs = append(regs, r)
+		return nil
+	}
+	if cfg.API == "gl" {
+		err = decode("gl", cfg.Version, "!gles2 darwin")
+		if err == nil {
+			err = decode("gles2", cfg.GLESVersion, "gles2,!darwin")
+		}
+	} else {
+		err = decode(cfg.API, cfg.Version, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range cfg.Extensions {
+		found := false
+		for _, r := range regs {
+			found = found || hasExtension(r, p)
+		}
+		if !found {
+			cfg.logf("Warning: no extension matching %s", p)
+		}
+	}
+	return regs, nil
+}
+
+// Write executes the templates for cfg.API with the registries returned by
+// Load and writes the generated files to cfg.OutDir.
+//
+func Write(cfg Config, regs []*Registry) error {
+	if err := cfg.normalize(); err != nil {
+		return err
+	}
+	if cfg.OutDir != "" {
+		if err := os.MkdirAll(cfg.OutDir, 0777); err != nil {
+			return err
+		}
+	}
+	var data interface{}
+	if cfg.API == "gl" {
+		if len(regs) != 2 {
+			return errors.New("gl api requires OpenGL and OpenGLES registries")
+		}
+		if err := execTemplate(&cfg, "gl.tmpl", "gl.go", regs[0]); err != nil {
+			return err
+		}
+		if err := execTemplate(&cfg, "gles2.tmpl", "gles2.go", regs[1]); err != nil {
+			return err
+		}
+		// The header declares both APIs.
+		data = struct{ GL, GLES *Registry }{regs[0], regs[1]}
+		if err := execTemplate(&cfg, "header.tmpl", "gl.h", data); err != nil {
+			return err
+		}
+		if cfg.CommandBuffer {
+			if err := execTemplate(&cfg, "cmdbuf.tmpl", "cmdbuf_gl.go", regs[0]); err != nil {
+				return err
+			}
+			if err := execTemplate(&cfg, "cmdbuf.tmpl", "cmdbuf_gles2.go", regs[1]); err != nil {
+				return err
+			}
+		}
+		if cfg.DebugCallback {
+			if err := execTemplate(&cfg, "debugcb_api.tmpl", "debugcb_gl.go", regs[0]); err != nil {
+				return err
+			}
+			if err := execTemplate(&cfg, "debugcb_api.tmpl", "debugcb_gles2.go", regs[1]); err != nil {
+				return err
+			}
+		}
+		if cfg.EnumNames {
+			if err := execTemplate(&cfg, "enumnames.tmpl", "enumnames_gl.go", regs[0]); err != nil {
+				return err
+			}
+			if err := execTemplate(&cfg, "enumnames.tmpl", "enumnames_gles2.go", regs[1]); err != nil {
+				return err
+			}
+		}
+		if cfg.Queries {
+			if err := execTemplate(&cfg, "queries.tmpl", "queries_gl.go", regs[0]); err != nil {
+				return err
+			}
+			if err := execTemplate(&cfg, "queries.tmpl", "queries_gles2.go", regs[1]); err != nil {
+				return err
+			}
+		}
+		if cfg.Strings {
+			if err := execTemplate(&cfg, "strings.tmpl", "strings_gl.go", regs[0]); err != nil {
+				return err
+			}
+			if err := execTemplate(&cfg, "strings.tmpl", "strings_gles2.go", regs[1]); err != nil {
+				return err
+			}
+		}
+		var extra []string
+		if cfg.Debug {
+			extra = append(extra, "debug", "debug_enabled", "debug_disabled")
+		}
+		if cfg.Trace {
+			extra = append(extra, "trace", "trace_enabled", "trace_disabled")
+		}
+		if cfg.DebugCallback {
+			extra = append(extra, "debugcb")
+		}
+		for _, n := range extra {
+			if err := execTemplate(&cfg, n+".tmpl", n+".go", data); err != nil {
+				return err
+			}
+		}
+	} else {
+		if len(regs) != 1 {
+			return fmt.Errorf("%s api requires a single registry", cfg.API)
+		}
+		if err := execTemplate(&cfg, cfg.API+".tmpl", cfg.API+".go", regs[0]); err != nil {
+			return err
+		}
+		data = regs[0]
+		if err := execTemplate(&cfg, cfg.API+"header.tmpl", cfg.API+".h", data); err != nil {
+			return err
+		}
+	}
+	return execUserTemplates(&cfg, data)
+}
+
+// execUserTemplates executes the NAME.tmpl files in cfg.TemplateDir that do
+// not override a built-in template and writes the output to NAME in
+// cfg.OutDir.
+//
+func execUserTemplates(cfg *Config, data interface{}) error {
+	if cfg.TemplateDir == "" {
+		return nil
+	}
+	fis, err := ioutil.ReadDir(cfg.TemplateDir)
+	if err != nil {
+		return err
+	}
+	for _, fi := range fis {
+		fname := fi.Name()
+		if fi.IsDir() || !strings.HasSuffix(fname, ".tmpl") {
+			continue
+		}
+		if _, err := Asset("templates/" + fname); err == nil {
+			continue
+		}
+		if err = execTemplate(cfg, fname, strings.TrimSuffix(fname, ".tmpl"), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportTemplates writes the built-in templates to dir, as a starting point for
+// Config.TemplateDir. Existing files are only overwritten if overwrite is true.
+//
+func ExportTemplates(dir string, overwrite bool) error {
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+	var names []string
+	for _, name := range AssetNames() {
+		if !strings.HasPrefix(name, "templates/") {
+			continue
+		}
+		names = append(names, name)
+		if !overwrite {
+			of := filepath.Join(dir, path.Base(name))
+			if _, err := os.Stat(of); err == nil {
+				return fmt.Errorf("%s already exists", of)
+			}
+		}
+	}
+	for _, name := range names {
+		data, err := Asset(name)
+		if err != nil {
+			return err
+		}
+		if err = ioutil.WriteFile(filepath.Join(dir, path.Base(name)), data, 0666); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var fmap = template.FuncMap{"ToUpper": strings.ToUpper, "NewVersion": NewVersion, "GroupType": groupType}
+
+// execTemplate executes the template fname and writes the output to file name
+// in cfg.OutDir.
+//
+func execTemplate(cfg *Config, fname, name string, data interface{}) error {
+	of := filepath.Join(cfg.OutDir, name)
+	cfg.logf("Generating %s", of)
+	text, err := loadTemplate(cfg, fname)
+	if err != nil {
+		return err
+	}
+	t, err := template.New(fname).Funcs(fmap).Parse(text)
+	if err != nil {
+		return err
+	}
+	o, err := os.Create(of)
+	if err != nil {
+		return err
+	}
+	err = t.Execute(o, data)
+	if cerr := o.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// loadTemplate returns the template fname from cfg.TemplateDir if it exists
+// there, or the built-in one.
+//
+func loadTemplate(cfg *Config, fname string) (string, error) {
+	if cfg.TemplateDir != "" {
+		fn := filepath.Join(cfg.TemplateDir, fname)
+		data, err := ioutil.ReadFile(fn)
+		if err == nil {
+			cfg.logf("Using template %s", fn)
+			return string(data), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	asset, err := Asset("templates/" + fname)
+	if err != nil {
+		return "", err
+	}
+	return string(asset), nil
+}
+
+func hasExtension(r *Registry, pattern string) bool {
+	for _, e := range r.Extensions {
+		if ok, _ := path.Match(pattern, e.Name); ok {
+			return true
+		}
+	}
+	return false
+}
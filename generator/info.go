@@ -0,0 +1,241 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Entry describes a command or enum found by Search.
+//
+type Entry struct {
+	Name       string
+	Command    *Command      // nil for enums
+	Enums      []Enum        // enum definitions, one per API specific value
+	RequiredBy []Requirement // features and extensions requiring or removing the entry
+	Aliases    []string      // entries this one is an alias of, or aliased by
+}
+
+// Requirement is a feature or extension that requires or removes an Entry.
+//
+type Requirement struct {
+	Name    string  // feature or extension name
+	API     string  // api of a feature or require block, or supported attribute of an extension
+	Version Version // feature version, zero for extensions
+	Profile string  // profile attribute of the require or remove block
+	Removed bool    // true for remove blocks
+}
+
+func (r Requirement) String() string {
+	s := r.API
+	if r.Version != (Version{}) {
+		s += " " + r.Version.String()
+	}
+	if r.Profile != "" {
+		s += ", " + r.Profile + " profile"
+	}
+	if r.Removed {
+		return "removed by " + r.Name + " (" + s + ")"
+	}
+	return r.Name + " (" + s + ")"
+}
+
+// LoadIndex reads and decodes the registry for cfg.API, regardless of API and
+// version, so that it can be searched for several patterns.
+//
+func LoadIndex(ctx context.Context, cfg Config) (*Index, error) {
+	if err := cfg.normalize(); err != nil {
+		return nil, err
+	}
+	data, err := ReadRegistry(ctx, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	x := new(Index)
+	if err = xml.NewDecoder(bytes.NewReader(data)).Decode(x); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// Search returns the commands and enums of x whose name matches pattern,
+// sorted by name. If pattern is a plain identifier, it must match the C or Go
+// name exactly (e.g. glTexStorage2D or TexStorage2D). Otherwise it is used as a
+// regular expression.
+//
+func (x *Index) Search(pattern string) ([]*Entry, error) {
+	match, err := nameMatcher(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*Entry
+	for n, c := range x.commands {
+		if !match(n, c.GoName()) {
+			continue
+		}
+		e := &Entry{Name: n, Command: c, RequiredBy: x.reqs[n]}
+		if c.Alias != "" {
+			e.Aliases = append(e.Aliases, c.Alias)
+		}
+		for _, a := range x.commands {
+			if a.Alias == n {
+				e.Aliases = append(e.Aliases, a.Name)
+			}
+		}
+		entries = append(entries, e)
+	}
+	for n, es := range x.enums {
+		if !match(n, es[0].GoName()) {
+			continue
+		}
+		e := &Entry{Name: n, Enums: es, RequiredBy: x.reqs[n]}
+		for _, en := range es {
+			if en.Alias != "" && !hasGroup(e.Aliases, en.Alias) {
+				e.Aliases = append(e.Aliases, en.Alias)
+			}
+		}
+		for an, aes := range x.enums {
+			if aes[0].Alias == n {
+				e.Aliases = append(e.Aliases, an)
+			}
+		}
+		entries = append(entries, e)
+	}
+	for _, e := range entries {
+		sort.Strings(e.Aliases)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries, nil
+}
+
+var identOnly = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func nameMatcher(pattern string) (func(name, goName string) bool, error) {
+	if identOnly.MatchString(pattern) {
+		return func(name, goName string) bool { return name == pattern || goName == pattern }, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return func(name, _ string) bool { return re.MatchString(name) }, nil
+}
+
+// Index holds all the commands and enums of a registry file, along with the
+// features and extensions that require them, regardless of API and version.
+//
+type Index struct {
+	commands map[string]*Command
+	enums    map[string][]Enum
+	reqs     map[string][]Requirement
+}
+
+func (x *Index) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	x.commands = make(map[string]*Command)
+	x.enums = make(map[string][]Enum)
+	x.reqs = make(map[string][]Requirement)
+
+	for {
+		t, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		t0, ok := t.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch t0.Name.Local {
+		case "enums":
+			err = x.decodeEnums(d, &t0)
+		case "commands":
+			var cmds struct {
+				Commands []*Command `xml:"command"`
+			}
+			err = d.DecodeElement(&cmds, &t0)
+			for _, c := range cmds.Commands {
+				x.commands[c.Name] = c
+			}
+		case "feature":
+			var ft struct {
+				API     string  `xml:"api,attr"`
+				Name    string  `xml:"name,attr"`
+				Number  Version `xml:"number,attr"`
+				Require []block `xml:"require"`
+				Remove  []block `xml:"remove"`
+			}
+			err = d.DecodeElement(&ft, &t0)
+			req := Requirement{Name: ft.Name, API: ft.API, Version: ft.Number}
+			x.addRequirements(ft.Require, req)
+			req.Removed = true
+			x.addRequirements(ft.Remove, req)
+		case "extension":
+			var ext struct {
+				Name      string  `xml:"name,attr"`
+				Supported string  `xml:"supported,attr"`
+				Require   []block `xml:"require"`
+			}
+			err = d.DecodeElement(&ext, &t0)
+			x.addRequirements(ext.Require, Requirement{Name: ext.Name, API: ext.Supported})
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (x *Index) decodeEnums(d *xml.Decoder, start *xml.StartElement) error {
+	var es struct {
+		Enums []struct {
+			Name  string `xml:"name,attr"`
+			Value string `xml:"value,attr"`
+			Type  string `xml:"type,attr"`
+			API   string `xml:"api,attr"`
+			Alias string `xml:"alias,attr"`
+			Group string `xml:"group,attr"`
+		} `xml:"enum"`
+		Group string `xml:"group,attr"`
+	}
+	if err := d.DecodeElement(&es, start); err != nil {
+		return err
+	}
+	for _, e := range es.Enums {
+		en := Enum{Name: e.Name, Value: e.Value, Type: e.Type, API: e.API, Alias: e.Alias}
+		if e.Group != "" {
+			en.Groups = strings.Split(e.Group, ",")
+		}
+		if es.Group != "" && !hasGroup(en.Groups, es.Group) {
+			en.Groups = append(en.Groups, es.Group)
+		}
+		x.enums[e.Name] = append(x.enums[e.Name], en)
+	}
+	return nil
+}
+
+func (x *Index) addRequirements(blocks []block, req Requirement) {
+	for i := range blocks {
+		b := &blocks[i]
+		r := req
+		if b.API != "" {
+			r.API = b.API
+		}
+		r.Profile = b.Profile
+		for _, e := range b.Enums {
+			x.reqs[e.Name] = append(x.reqs[e.Name], r)
+		}
+		for _, c := range b.Cmds {
+			x.reqs[c.Name] = append(x.reqs[c.Name], r)
+		}
+	}
+}
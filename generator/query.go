@@ -0,0 +1,136 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import "strings"
+
+// queryTypes are the element types of the out parameters of query commands.
+//
+var queryTypes = map[string]bool{
+	"GLint":     true,
+	"GLuint":    true,
+	"GLint64":   true,
+	"GLuint64":  true,
+	"GLfloat":   true,
+	"GLdouble":  true,
+	"GLboolean": true,
+	"GLsizei":   true,
+}
+
+// QueryResult returns the out parameter of c, returned by its query variants.
+//
+func (c *Command) QueryResult() *Param {
+	return &c.Params[len(c.Params)-1]
+}
+
+// QueryType returns the Go type of the values returned by the query variants of
+// c.
+//
+func (c *Command) QueryType() string {
+	return strings.TrimPrefix(c.QueryResult().Type.GoName(false), "*")
+}
+
+// QueriesName returns the name of the query variant of c returning n values.
+//
+func (c *Command) QueriesName() string {
+	return pluralize(c.Query)
+}
+
+// QueryValues returns true if the out parameter of c may hold several values,
+// in which case a variant returning n values is generated as well.
+//
+func (c *Command) QueryValues() bool {
+	return c.QueryResult().Len != "1"
+}
+
+// StateQuery returns true if c is a generic state query like glGetIntegerv,
+// whose out parameter holds the value of the state variable pname. Some state
+// variables hold a variable number of values that must be queried first.
+//
+func (c *Command) StateQuery() bool {
+	return len(c.Params) == 2 && c.Params[0].Name == "pname" && c.QueryResult().Len == "COMPSIZE(pname)"
+}
+
+// StateQueryPName returns the Go expression converting the pname parameter of
+// a state query to the uint32 expected by stateQueryLen.
+//
+func (c *Command) StateQueryPName() string {
+	if c.Params[0].Type.GoName(false) == "uint32" {
+		return "pname"
+	}
+	return "uint32(pname)"
+}
+
+// StateQueries returns true if query variants of state queries are generated
+// for r.
+//
+func (r *Registry) StateQueries() bool {
+	for _, c := range r.Commands {
+		if c.Query != "" && c.StateQuery() {
+			return true
+		}
+	}
+	return false
+}
+
+// queryName returns the name of the value returning variants of the query
+// command with Go name gn: gn without its type suffix, except for types other
+// than GLint (e.g. GetInteger for glGetIntegerv, GetProgram for glGetProgramiv
+// and GetTexParameterf for glGetTexParameterfv), or without the v of indexed
+// queries (e.g. GetIntegeri for glGetIntegeri_v).
+//
+func queryName(gn string) string {
+	switch {
+	case strings.HasSuffix(gn, "_v"):
+		return gn[:len(gn)-2]
+	case strings.HasSuffix(gn, "uiv"):
+		return gn[:len(gn)-1]
+	case strings.HasSuffix(gn, "iv"):
+		return gn[:len(gn)-2]
+	case strings.HasSuffix(gn, "v"):
+		return gn[:len(gn)-1]
+	}
+	return ""
+}
+
+// setQueries looks for query commands in cmds, i.e. glGet* commands returning
+// nothing whose only pointer parameter is their last one, a non-const pointer
+// to numeric values with a len attribute of 1, COMPSIZE(...) or none, and sets
+// up the generation of value returning variants for them. Variants whose name
+// is already in use are not generated.
+//
+func setQueries(cmds []*Command, names map[string]struct{}) {
+	for _, c := range cmds {
+		gn := c.GoName()
+		if !strings.HasPrefix(gn, "Get") || c.Type.Name != "void" || c.Type.Ptr != 0 || len(c.Params) == 0 {
+			continue
+		}
+		p := c.QueryResult()
+		if p.Type.Ptr != 1 || p.Type.Const || !queryTypes[p.Type.Name] ||
+			p.Len != "" && p.Len != "1" && !strings.HasPrefix(p.Len, "COMPSIZE(") {
+			continue
+		}
+		ok := true
+		for _, q := range c.Params[:len(c.Params)-1] {
+			// n is the parameter of the plural variant.
+			if q.Type.Ptr != 0 || q.Name == "n" {
+				ok = false
+			}
+		}
+		name := queryName(gn)
+		if !ok || name == "" {
+			continue
+		}
+		if _, ok := names[name]; ok {
+			continue
+		}
+		if _, ok := names[pluralize(name)]; ok {
+			continue
+		}
+		names[name] = struct{}{}
+		names[pluralize(name)] = struct{}{}
+		c.Query = name
+	}
+}
@@ -0,0 +1,831 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Registry is the decoded registry for a given API, version and profile, as
+// passed to the templates. It may be modified before calling Write.
+//
+type Registry struct {
+	API          string
+	Version      Version
+	Tags         string
+	Package      string
+	CoreProfile  bool
+	Slices       bool
+	Debug        bool
+	Trace        bool
+	Contexts     bool
+	MultiContext bool
+	GoTypes      bool
+	EnumNames    bool
+	Typedefs     []string
+	Enums        []Enum
+	Pointers     []Enum // null pointer constants, like EGL_NO_DISPLAY
+	Groups       []*Group
+	Handles      []*Handle
+	Commands     []*Command
+	Extensions   []*Extension
+}
+
+// Extension represents an extension selected with Config.Extensions along with
+// the commands it requires.
+//
+type Extension struct {
+	Name     string
+	Commands []*Command
+}
+
+// Group is an enum group used by the parameters or return values of the
+// generated commands. Groups are only generated with Config.EnumTypes.
+//
+type Group struct {
+	Name    string // group name in gl.xml
+	GoName  string // name of the Go type
+	Bitmask bool   // group of GLbitfield values
+	Enums   []Enum
+}
+
+// Handle is an object handle type for a class of GL objects like textures or
+// buffers. Handles are only generated with Config.HandleTypes.
+//
+type Handle struct {
+	Class   string // class name in gl.xml
+	GoName  string // name of the Go type
+	Recv    string // receiver name for methods
+	Methods []*Method
+}
+
+// Method is a convenience method on a Handle that calls Cmd with the handle
+// value as argument.
+//
+type Method struct {
+	Name   string
+	Cmd    *Command
+	Params []*Param // method parameters
+	Args   []string // arguments passed to Cmd
+}
+
+// decodeRegistry decodes the registry for the given api and version. Other
+// settings are taken from cfg. If u is not nil, the registry is trimmed to the
+// commands and enums it uses.
+//
+func decodeRegistry(r io.Reader, cfg *Config, u *usage, api string, version Version, tags string) (*Registry, error) {
+	reg := registry{
+		api:         api,
+		version:     version,
+		coreProfile: api == "gl" && cfg.Profile == "core",
+		extensions:  cfg.Extensions,
+		logf:        cfg.logf,
+	}
+	if api == "gl" {
+		reg.profile = cfg.Profile
+	}
+	d := xml.NewDecoder(r)
+	err := d.Decode(&reg)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := &Registry{
+		API:          api,
+		Version:      version,
+		Tags:         tags,
+		Package:      cfg.Package,
+		CoreProfile:  reg.coreProfile,
+		Slices:       cfg.Slices,
+		Debug:        cfg.Debug,
+		Trace:        cfg.Trace,
+		Contexts:     cfg.Contexts,
+		MultiContext: cfg.MultiContext,
+		GoTypes:      cfg.GoTypes,
+		EnumNames:    cfg.EnumNames,
+		Typedefs:     reg.Typedefs,
+		Enums:        sortEnums(reg.Enums),
+		Commands:     sortCommands(reg.Commands),
+		Extensions:   sortExtensions(reg.Extensions),
+	}
+	rr.Enums, rr.Pointers, err = castEnums(rr.Enums, cfg)
+	if err != nil {
+		return nil, err
+	}
+	// identifiers that generated types must not shadow
+	names := map[string]struct{}{"API": {}, "Version": {}, "CoreProfile": {}}
+	for _, c := range rr.Commands {
+		names[c.GoName()] = struct{}{}
+	}
+	if cfg.EnumNames {
+		for _, n := range []string{"EnumName", "Error", "Err"} {
+			names[n] = struct{}{}
+		}
+	}
+	if cfg.DebugCallback {
+		for n := range debugHelpers {
+			names[n] = struct{}{}
+		}
+		names["DebugFunc"] = struct{}{}
+		if rr.FindCommand(debugHelpers["SetDebugCallback"]...) == nil {
+			req := "OpenGL 4.3"
+			if api == "gles2" {
+				req = "OpenGLES 3.2"
+			}
+			return nil, fmt.Errorf("debug callbacks require %s or the GL_KHR_debug extension", req)
+		}
+	}
+	if cfg.EnumTypes {
+		rr.Groups = enumGroups(rr.Enums, rr.Commands, names)
+	}
+	if cfg.HandleTypes {
+		rr.Handles = objectHandles(rr.Commands, names)
+	}
+	if cfg.Queries {
+		setQueries(rr.Commands, names)
+	}
+	if cfg.GoTypes {
+		goTypes(rr.Commands, rr.Typedefs)
+	}
+	if cfg.Slices {
+		for _, c := range rr.Commands {
+			c.setSlices()
+		}
+	}
+	if cfg.Strings {
+		for _, c := range rr.Commands {
+			c.setStrings()
+		}
+	}
+	if u != nil {
+		u.trim(rr, cfg)
+	}
+	return rr, nil
+}
+
+// castEnums converts enum values of the form EGL_CAST(type,value). Integer
+// casts are replaced by the value, and casts of 0 to a pointer type are
+// returned in ptrs since they cannot be Go constants. Other casts are dropped.
+//
+func castEnums(enums []Enum, cfg *Config) (consts []Enum, ptrs []Enum, err error) {
+	consts = enums[:0]
+	for _, e := range enums {
+		if !strings.HasPrefix(e.Value, "EGL_CAST(") {
+			consts = append(consts, e)
+			continue
+		}
+		args := strings.Split(strings.TrimSuffix(e.Value[len("EGL_CAST("):], ")"), ",")
+		if len(args) != 2 {
+			return nil, nil, fmt.Errorf("invalid value %s for enum %s", e.Value, e.Name)
+		}
+		t, v := strings.TrimSpace(args[0]), strings.TrimSpace(args[1])
+		switch gn := types[t]; {
+		case gn == nil:
+		case gn[0] != "unsafe.Pointer":
+			e.Value = v
+			consts = append(consts, e)
+			continue
+		case gn[0] == "unsafe.Pointer" && v == "0":
+			ptrs = append(ptrs, e)
+			continue
+		}
+		cfg.logf("Warning: skipping enum %s = %s", e.Name, e.Value)
+	}
+	return consts, ptrs, nil
+}
+
+// enumGroups collects the enum groups used by GLenum and GLbitfield parameters
+// and return values in cmds and sets the Go type of these to the group type.
+// Untyped enums belonging to a single group get the group type as well.
+//
+func enumGroups(enums []Enum, cmds []*Command, names map[string]struct{}) []*Group {
+	gm := make(map[string]*Group)
+	setGroup := func(t *Type) {
+		if t.Group == "" || t.Ptr != 0 || t.Name != "GLenum" && t.Name != "GLbitfield" {
+			return
+		}
+		g := gm[t.Group]
+		if g == nil {
+			g = &Group{Name: t.Group, GoName: t.Group}
+			// e.g. group PolygonMode and glPolygonMode
+			if _, ok := names[g.GoName]; ok {
+				g.GoName += "Enum"
+			}
+			names[g.GoName] = struct{}{}
+			gm[t.Group] = g
+		}
+		if t.Name == "GLbitfield" {
+			g.Bitmask = true
+		}
+		t.goType = g.GoName
+	}
+	for _, c := range cmds {
+		setGroup(&c.Type)
+		for i := range c.Params {
+			setGroup(&c.Params[i].Type)
+		}
+	}
+	for i := range enums {
+		e := &enums[i]
+		// skip values that do not fit in a GLenum, like GL_TIMEOUT_IGNORED
+		if _, err := strconv.ParseUint(e.Value, 0, 32); err != nil {
+			continue
+		}
+		if len(e.Groups) == 1 && e.Type == "" && gm[e.Groups[0]] != nil {
+			e.goType = gm[e.Groups[0]].GoName
+		}
+		for _, n := range e.Groups {
+			if g := gm[n]; g != nil {
+				g.Enums = append(g.Enums, *e)
+			}
+		}
+	}
+	groups := make([]*Group, 0, len(gm))
+	for _, g := range gm {
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].GoName < groups[j].GoName })
+	return groups
+}
+
+type registry struct {
+	// decoding settings
+	api         string
+	version     Version
+	profile     string // "core" or "compatibility" for gl, empty otherwise
+	coreProfile bool
+	extensions  []string
+	logf        func(format string, args ...interface{})
+
+	All struct {
+		Enums    map[string]Enum
+		Commands map[string]*Command
+	}
+	Typedefs   []string
+	Enums      map[string]Enum
+	Commands   map[string]*Command
+	Extensions []*Extension
+}
+
+func (r *registry) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	r.All.Enums = make(map[string]Enum)
+	r.All.Commands = make(map[string]*Command)
+	r.Enums = make(map[string]Enum)
+	r.Commands = make(map[string]*Command)
+
+	for {
+		t, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "types":
+				if err = r.decodeTypes(d, &t); err != nil {
+					return err
+				}
+			case "enums":
+				if err = r.decodeEnums(d, &t); err != nil {
+					return err
+				}
+			case "commands":
+				if err = r.decodeCommands(d, &t); err != nil {
+					return err
+				}
+			case "feature":
+				if err = r.decodeFeature(d, &t); err != nil {
+					return err
+				}
+			case "extension":
+				if err = r.decodeExtension(d, &t); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+		case xml.CharData:
+		case xml.Comment:
+		default:
+			return fmt.Errorf("unexpected token type %T", t)
+		}
+	}
+}
+
+func (r *registry) decodeTypes(d *xml.Decoder, start *xml.StartElement) error {
+	var td []byte
+	inType := false
+L:
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "type":
+				inType = true
+				for _, a := range t.Attr {
+					if a.Name.Local == "name" && a.Value == "khrplatform" {
+						inType = false
+					}
+				}
+			case "apientry":
+				td = append(td, "APIENTRY"...)
+			}
+		case xml.CharData:
+			if inType {
+				td = append(td, t...)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "type":
+				if inType {
+					s, err := convertTypedef(td)
+					if err != nil {
+						return err
+					}
+					r.Typedefs = append(r.Typedefs, s)
+					td = td[:0]
+					inType = false
+				}
+			case "types":
+				break L
+			}
+		}
+	}
+	return nil
+}
+
+// Remove dependency on khrplatform.h
+//
+func convertTypedef(td []byte) (string, error) {
+	k := bytes.Index(td, []byte("khronos_"))
+	if k < 0 {
+		return string(td), nil
+	}
+	n := bytes.IndexByte(td[k:], '_') + 1 + k
+	e := bytes.IndexByte(td[n:], ' ') + n
+	subst := ""
+	switch s := string(td[n:e]); s {
+	case "int8_t", "uint8_t", "int16_t", "uint16_t", "int32_t", "uint32_t", "int64_t", "uint64_t", "intptr_t", "uintptr_t":
+		subst = s
+	case "float_t":
+		subst = "float"
+	case "utime_nanoseconds_t":
+		subst = "uint64_t"
+	case "stime_nanoseconds_t":
+		subst = "int64_t"
+	case "ssize_t":
+		subst = "intptr_t"
+	case "usize_t":
+		subst = "uintptr_t"
+	default:
+		return "", fmt.Errorf("invalid type khronos_%s", s)
+	}
+	copy(td[k:], subst)
+	copy(td[k+len(subst):], td[e:])
+	td = td[:len(td)-(e-k)+len(subst)]
+	return string(td), nil
+}
+
+// block is a require or remove block of a feature or extension.
+//
+type block struct {
+	API     string `xml:"api,attr"`
+	Profile string `xml:"profile,attr"`
+	Enums   []struct {
+		Name string `xml:"name,attr"`
+	} `xml:"enum"`
+	Cmds []struct {
+		Name string `xml:"name,attr"`
+	} `xml:"command"`
+}
+
+// selects returns true if the api and profile attributes of b match the
+// current api and profile. Profile attributes are ignored for APIs other than
+// gl.
+//
+func (r *registry) selects(b *block) bool {
+	if b.API != "" && b.API != r.api {
+		return false
+	}
+	return b.Profile == "" || r.profile == "" || b.Profile == r.profile
+}
+
+func (r *registry) decodeFeature(d *xml.Decoder, start *xml.StartElement) error {
+	var ft struct {
+		Require []block `xml:"require"`
+		Remove  []block `xml:"remove"`
+	}
+	var fv Version
+	for _, a := range start.Attr {
+		if a.Name.Local == "api" && a.Value != r.api {
+			return d.Skip()
+		}
+		if a.Name.Local == "number" {
+			fv.Set(a.Value)
+			if r.version.Less(&fv) {
+				return d.Skip()
+			}
+		}
+	}
+	err := d.DecodeElement(&ft, start)
+	if err != nil {
+		return err
+	}
+	for i := range ft.Require {
+		req := &ft.Require[i]
+		if !r.selects(req) {
+			continue
+		}
+		for _, e := range req.Enums {
+			v, ok := r.All.Enums[e.Name]
+			if !ok {
+				return fmt.Errorf("unknown enum %s in feature", e.Name)
+			}
+			r.Enums[e.Name] = v
+		}
+		for _, c := range req.Cmds {
+			v, ok := r.All.Commands[c.Name]
+			if !ok {
+				return fmt.Errorf("unknown command %s in feature", c.Name)
+			}
+			// keep the version in which the command was first introduced
+			if v.Version == (Version{}) || fv.Less(&v.Version) {
+				v.Version = fv
+			}
+			r.Commands[c.Name] = v
+		}
+	}
+	for i := range ft.Remove {
+		rem := &ft.Remove[i]
+		if !r.selects(rem) {
+			continue
+		}
+		for _, e := range rem.Enums {
+			delete(r.Enums, e.Name)
+		}
+		for _, c := range rem.Cmds {
+			delete(r.Commands, c.Name)
+		}
+	}
+
+	return nil
+}
+
+func (r *registry) decodeExtension(d *xml.Decoder, start *xml.StartElement) error {
+	var ext struct {
+		Require []block `xml:"require"`
+	}
+	var name string
+	supported := false
+	for _, a := range start.Attr {
+		switch a.Name.Local {
+		case "name":
+			name = a.Value
+		case "supported":
+			supported = r.supportsAPI(a.Value)
+		}
+	}
+	if !supported || !r.matchExtension(name) {
+		return d.Skip()
+	}
+	err := d.DecodeElement(&ext, start)
+	if err != nil {
+		return err
+	}
+	e := &Extension{Name: name}
+	for i := range ext.Require {
+		req := &ext.Require[i]
+		if !r.selects(req) {
+			continue
+		}
+		for _, en := range req.Enums {
+			v, ok := r.All.Enums[en.Name]
+			if !ok {
+				return fmt.Errorf("unknown enum %s in extension %s", en.Name, name)
+			}
+			r.Enums[en.Name] = v
+		}
+		for _, c := range req.Cmds {
+			v, ok := r.All.Commands[c.Name]
+			if !ok {
+				return fmt.Errorf("unknown command %s in extension %s", c.Name, name)
+			}
+			r.Commands[c.Name] = v
+			e.Commands = append(e.Commands, v)
+		}
+	}
+	r.Extensions = append(r.Extensions, e)
+	return nil
+}
+
+// supportsAPI checks the supported attribute of an extension against the
+// current api and profile.
+//
+func (r *registry) supportsAPI(supported string) bool {
+	want := r.api
+	if r.coreProfile {
+		want = "glcore"
+	}
+	for _, s := range strings.Split(supported, "|") {
+		if s == want {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *registry) matchExtension(name string) bool {
+	return matchAny(r.extensions, name)
+}
+
+func (r *registry) decodeCommands(d *xml.Decoder, start *xml.StartElement) error {
+	var cmds struct {
+		Commands []*Command `xml:"command"`
+	}
+	err := d.DecodeElement(&cmds, start)
+	if err != nil {
+		return err
+	}
+	for _, c := range cmds.Commands {
+		r.All.Commands[c.Name] = c
+	}
+	return nil
+}
+
+func (r *registry) decodeEnums(d *xml.Decoder, start *xml.StartElement) error {
+	var es struct {
+		Enums []struct {
+			Name  string `xml:"name,attr"`
+			Value string `xml:"value,attr"`
+			Type  string `xml:"type,attr"`
+			API   string `xml:"api,attr"`
+			Alias string `xml:"alias,attr"`
+			Group string `xml:"group,attr"`
+		} `xml:"enum"`
+		Group string `xml:"group,attr"`
+	}
+	err := d.DecodeElement(&es, start)
+	if err != nil {
+		return err
+	}
+	for _, e := range es.Enums {
+		if e.API != "" && e.API != r.api {
+			continue
+		}
+		en := Enum{Name: e.Name, Value: e.Value, Type: e.Type, API: e.API, Alias: e.Alias}
+		if e.Group != "" {
+			en.Groups = strings.Split(e.Group, ",")
+		}
+		if es.Group != "" && !hasGroup(en.Groups, es.Group) {
+			en.Groups = append(en.Groups, es.Group)
+		}
+		if prev, ok := r.All.Enums[e.Name]; ok {
+			en, ok = r.mergeEnum(prev, en)
+			if !ok {
+				continue
+			}
+		}
+		r.All.Enums[e.Name] = en
+	}
+	return nil
+}
+
+// mergeEnum resolves duplicate definitions of an enum. A definition for the
+// current api takes precedence over a generic one. Otherwise the first
+// definition is kept, along with the groups of both. mergeEnum returns false
+// if prev should be kept unchanged.
+//
+func (r *registry) mergeEnum(prev, e Enum) (Enum, bool) {
+	switch {
+	case prev.API == "" && e.API != "":
+		return e, true
+	case prev.API != "" && e.API == "":
+		return prev, false
+	case prev.Value != e.Value || prev.Type != e.Type:
+		r.logf("Warning: conflicting values %s and %s for enum %s, keeping %s", prev.Value, e.Value, e.Name, prev.Value)
+		return prev, false
+	}
+	for _, g := range e.Groups {
+		if !hasGroup(prev.Groups, g) {
+			prev.Groups = append(prev.Groups, g)
+		}
+	}
+	return prev, true
+}
+
+// goTypes sets the Go type of GLboolean parameters and return values in cmds to
+// bool, and the Go type of const GLubyte * and const GLchar * return values to
+// string. Other parameters and return values whose type is one of the scalar
+// typedefs get the Go type mirroring it, unless their Go type is already set,
+// e.g. to an enum group or handle type. Pointers keep plain Go element types.
+//
+func goTypes(cmds []*Command, typedefs []string) {
+	mirror := make(map[string]bool)
+	for _, td := range scalarTypedefs(typedefs) {
+		mirror[td.Name] = true
+	}
+	for _, c := range cmds {
+		if t := &c.Type; t.Ptr == 1 && t.Const && (t.Name == "GLubyte" || t.Name == "GLchar") {
+			t.goType = "string"
+		}
+		for _, t := range append([]*Type{&c.Type}, paramTypes(c)...) {
+			switch {
+			case t.Ptr != 0 || t.goType != "":
+			case t.Name == "GLboolean":
+				t.goType = "bool"
+			case mirror[t.Name]:
+				t.goType = t.Name
+			}
+		}
+	}
+}
+
+func paramTypes(c *Command) []*Type {
+	ts := make([]*Type, len(c.Params))
+	for i := range c.Params {
+		ts[i] = &c.Params[i].Type
+	}
+	return ts
+}
+
+// Typedef is a Go type mirroring a scalar C typedef.
+//
+type Typedef struct {
+	Name   string // C and Go name, e.g. GLenum
+	GoType string // underlying Go type, e.g. uint32
+}
+
+var typedefRe = regexp.MustCompile(`^typedef\s[^;()*]*\b(\w+);$`)
+
+// scalarTypedefs returns the typedefs of numeric types in typedefs, the C
+// declarations of the registry types, in order.
+//
+func scalarTypedefs(typedefs []string) []Typedef {
+	var tds []Typedef
+	seen := make(map[string]bool)
+	for _, td := range typedefs {
+		for _, l := range strings.Split(td, "\n") {
+			m := typedefRe.FindStringSubmatch(strings.TrimSpace(l))
+			if m == nil || seen[m[1]] || types[m[1]] == nil {
+				continue
+			}
+			switch gt := types[m[1]][0]; gt {
+			case "", "unsafe.Pointer", "uintptr":
+			default:
+				tds = append(tds, Typedef{m[1], gt})
+				seen[m[1]] = true
+			}
+		}
+	}
+	return tds
+}
+
+// GoTypedefs returns the scalar typedefs of r used by its commands, in the
+// order of r.Typedefs.
+//
+func (r *Registry) GoTypedefs() []Typedef {
+	used := make(map[string]bool)
+	for _, c := range r.Commands {
+		for _, t := range append([]*Type{&c.Type}, paramTypes(c)...) {
+			used[t.Name] = true
+		}
+	}
+	var tds []Typedef
+	for _, td := range scalarTypedefs(r.Typedefs) {
+		if used[td.Name] {
+			tds = append(tds, td)
+		}
+	}
+	return tds
+}
+
+// objectHandles collects the object classes used by GLuint parameters and
+// return values in cmds and sets the Go type of these to the handle type. It
+// also sets up Bind (or Use) and Delete methods for the handle types.
+//
+func objectHandles(cmds []*Command, names map[string]struct{}) []*Handle {
+	hm := make(map[string]*Handle)
+	setHandle := func(t *Type) {
+		if t.Class == "" || t.Ptr > 1 || t.Name != "GLuint" {
+			return
+		}
+		h := hm[t.Class]
+		if h == nil {
+			h = &Handle{Class: t.Class}
+			for _, w := range strings.Fields(t.Class) {
+				h.GoName += strings.ToUpper(w[:1]) + w[1:]
+			}
+			if _, ok := names[h.GoName]; ok {
+				h.GoName += "Handle"
+			}
+			names[h.GoName] = struct{}{}
+			h.Recv = strings.ToLower(h.GoName[:1])
+			hm[t.Class] = h
+		}
+		t.goType = strings.Repeat("*", t.Ptr) + h.GoName
+	}
+	for _, c := range cmds {
+		setHandle(&c.Type)
+		for i := range c.Params {
+			setHandle(&c.Params[i].Type)
+		}
+	}
+	for _, c := range cmds {
+		if c.Type.Name != "void" {
+			continue
+		}
+		for _, h := range hm {
+			if m := h.method(c); m != nil {
+				h.Methods = append(h.Methods, m)
+			}
+		}
+	}
+	handles := make([]*Handle, 0, len(hm))
+	for _, h := range hm {
+		sort.Slice(h.Methods, func(i, j int) bool { return h.Methods[i].Name < h.Methods[j].Name })
+		handles = append(handles, h)
+	}
+	sort.Slice(handles, func(i, j int) bool { return handles[i].GoName < handles[j].GoName })
+	return handles
+}
+
+// pluralize returns the plural form of the identifier name.
+//
+func pluralize(name string) string {
+	if n := len(name); n > 1 && name[n-1] == 'y' && !strings.ContainsRune("aeiou", rune(name[n-2])) {
+		// Query, but not VertexArray
+		return name[:n-1] + "ies"
+	}
+	return name + "s"
+}
+
+// method returns a Bind, Use or Delete method for h if c is the corresponding
+// command, e.g. glBindTexture, glUseProgram or glDeleteTextures.
+//
+func (h *Handle) method(c *Command) *Method {
+	plural := pluralize(h.GoName)
+	m := &Method{Cmd: c}
+	switch gn := c.GoName(); gn {
+	case "Delete" + h.GoName, "Delete" + plural:
+		m.Name = "Delete"
+		switch {
+		case len(c.Params) == 1 && c.Params[0].Type.goType == h.GoName:
+			// glDeleteProgram(GLuint program)
+			m.Args = []string{h.Recv}
+		case len(c.Params) == 2 && c.Params[1].Type.goType == "*"+h.GoName:
+			// glDeleteTextures(GLsizei n, const GLuint *textures)
+			m.Args = []string{"1", "&" + h.Recv}
+		default:
+			return nil
+		}
+		return m
+	case "Bind" + h.GoName:
+		m.Name = "Bind"
+	case "Use" + h.GoName:
+		m.Name = "Use"
+	default:
+		return nil
+	}
+	found := false
+	for i := range c.Params {
+		p := &c.Params[i]
+		if p.Type.goType == h.GoName && !found {
+			m.Args = append(m.Args, h.Recv)
+			found = true
+			continue
+		}
+		if p.Name == h.Recv {
+			return nil
+		}
+		m.Params = append(m.Params, p)
+		m.Args = append(m.Args, p.Name)
+	}
+	if !found {
+		return nil
+	}
+	return m
+}
+
+func hasGroup(groups []string, g string) bool {
+	for _, n := range groups {
+		if n == g {
+			return true
+		}
+	}
+	return false
+}
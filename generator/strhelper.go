@@ -0,0 +1,165 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import "strings"
+
+// StringsName returns the name of the string helper of c, or an empty string
+// if c has none. Helpers taking string slices have a Strings suffix, others a
+// String suffix.
+//
+func (c *Command) StringsName() string {
+	name := ""
+	for i := range c.Params {
+		switch c.Params[i].String {
+		case "array":
+			return c.GoName() + "Strings"
+		case "in", "out":
+			name = c.GoName() + "String"
+		}
+	}
+	return name
+}
+
+// StringResult returns the output string parameter of c, returned by its
+// string helper, or nil if there is none.
+//
+func (c *Command) StringResult() *Param {
+	for i := range c.Params {
+		if c.Params[i].String == "out" {
+			return &c.Params[i]
+		}
+	}
+	return nil
+}
+
+// StringsSize returns a Go expression giving the number of bytes of C memory
+// needed to copy the input strings of c.
+//
+func (c *Command) StringsSize() string {
+	var ss []string
+	for _, p := range c.Params {
+		switch p.String {
+		case "in":
+			ss = append(ss, "len("+p.Name+") + 1")
+		case "array":
+			ss = append(ss, "stringsSize("+p.Name+")")
+		}
+	}
+	return strings.Join(ss, " + ")
+}
+
+// setStrings looks for GLchar pointer parameters of c that can be replaced by
+// Go strings and sets them up for the generation of a string helper:
+//
+//  - const GLchar * parameters become strings. A length parameter is derived
+//    from the string length if it is given by the len attribute, if it is
+//    named after the string (e.g. namelen for name), or if it is named length
+//    and there is a single string.
+//  - const GLchar *const* parameters whose len attribute refers to an integer
+//    parameter become string slices and the count is derived from the slice
+//    length. Since strings are null terminated, a const GLint *length
+//    parameter with the same len attribute is set to nil.
+//  - in commands returning nothing and without input strings, a single
+//    GLchar * parameter whose len attribute refers to an integer parameter,
+//    along with a GLsizei *length parameter, becomes the string returned by
+//    the helper.
+//
+// Commands with other GLchar pointer parameters get no string helper.
+//
+func (c *Command) setStrings() {
+	kinds := make(map[*Param]string)
+	args := make(map[*Param]string)
+	var ins, outs []*Param
+	for i := range c.Params {
+		p := &c.Params[i]
+		switch p.Type.Name {
+		case "GLchar", "GLcharARB":
+		default:
+			continue
+		}
+		switch {
+		case p.Type.Const && (p.Type.Ptr == 1 || p.Type.Ptr == 2):
+			ins = append(ins, p)
+		case !p.Type.Const && p.Type.Ptr == 1:
+			outs = append(outs, p)
+		default:
+			return
+		}
+	}
+	if len(ins) == 0 && len(outs) == 0 {
+		return
+	}
+
+	// derive sets the argument of the integer parameter named n, if any, to
+	// the length of p.
+	derive := func(p *Param, n string) bool {
+		if n == "" {
+			return false
+		}
+		q := c.param(paramName(n))
+		if q == nil || q == p || q.Type.Ptr != 0 || args[q] != "" || !isCount(q) {
+			return false
+		}
+		args[q] = q.Type.GoName(false) + "(len(" + p.Name + "))"
+		return true
+	}
+	for _, p := range ins {
+		if p.Type.Ptr == 1 {
+			kinds[p] = "in"
+			if !derive(p, p.Len) && !derive(p, strings.TrimSuffix(p.Name, "_")+"len") && len(ins) == 1 {
+				derive(p, "length")
+			}
+			continue
+		}
+		if p.Len == "" || !derive(p, p.Len) {
+			return
+		}
+		kinds[p] = "array"
+		for i := range c.Params {
+			q := &c.Params[i]
+			if q == p || q.Len != p.Len {
+				continue
+			}
+			if q.Name != "length" || q.Type.Ptr != 1 || !q.Type.Const || !isCount(q) {
+				return
+			}
+			args[q] = "nil"
+		}
+	}
+
+	if len(outs) > 0 {
+		if len(outs) > 1 || len(ins) > 0 || c.Type.Name != "void" || c.Type.Ptr != 0 {
+			return
+		}
+		p := outs[0]
+		q := c.param(paramName(p.Len))
+		l := c.param("length")
+		if q == nil || q.Type.Ptr != 0 || !isCount(q) ||
+			l == nil || l.Type.Ptr != 1 || l.Type.Const || l.Type.Name != "GLsizei" {
+			return
+		}
+		kinds[p] = "out"
+		args[q] = q.Type.GoName(false) + "(" + p.Name + "Cap)"
+		args[l] = "&" + p.Name + "Len"
+	}
+
+	for p, k := range kinds {
+		p.String = k
+	}
+	for p, a := range args {
+		p.StringArg = a
+	}
+}
+
+// isCount returns true if p is of an integer type used for counts and lengths.
+//
+func isCount(p *Param) bool {
+	switch p.Type.Name {
+	case "GLsizei", "GLint", "GLuint":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,325 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+var types = map[string][]string{
+	"void":                 {"", "unsafe.Pointer", "*unsafe.Pointer"},
+	"GLboolean":            {"byte", "*byte", "**byte"},
+	"GLbyte":               {"int8", "*int8", "**int8"},
+	"GLubyte":              {"uint8", "*uint8", "**uint8"},
+	"GLchar":               {"int8", "*int8", "**int8"},
+	"GLuchar":              {"byte", "*byte", "**byte"},
+	"GLcharARB":            {"int8", "*int8", "**byte"},
+	"GLshort":              {"int16", "*int16", "**int16"},
+	"GLushort":             {"uint16", "*uint16", "**uint16"},
+	"GLint":                {"int32", "*int32", "**int32"},
+	"GLuint":               {"uint32", "*uint32", "**uint32"},
+	"GLfixed":              {"int32", "*int32", "**int32"},
+	"GLint64":              {"int64", "*int64", "**int64"},
+	"GLint64EXT":           {"int64", "*int64", "**int64"},
+	"GLuint64":             {"uint64", "*uint64", "**uint64"},
+	"GLuint64EXT":          {"uint64", "*uint64", "**uint64"},
+	"GLsizei":              {"int32", "*int32", "**int32"},
+	"GLenum":               {"uint32", "*uint32", "**uint32"},
+	"GLintptr":             {"int", "*int", "**int"},
+	"GLintptrARB":          {"int", "*int", "**int"},
+	"GLvdpauSurfaceNV":     {"int", "*int", "**int"},
+	"GLsizeiptr":           {"int", "*int", "**int"},
+	"GLsizeiptrARB":        {"int", "*int", "**int"},
+	"GLbitfield":           {"uint32", "*uint32", "**uint32"},
+	"GLhalf":               {"uint16", "*uint16", "**uint16"},
+	"GLhalfNV":             {"uint16", "*uint16", "**uint16"},
+	"GLfloat":              {"float32", "*float32", "**float32"},
+	"GLclampf":             {"float32", "*float32", "**float32"},
+	"GLclampx":             {"int32", "*int32", "**int32"},
+	"GLdouble":             {"float64", "*float64", "**float64"},
+	"GLclampd":             {"float64", "*float64", "**float64"},
+	"GLhandleARB":          {"uintptr", "unsafe.Pointer", "*unsafe.Pointer"},
+	"GLsync":               {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"GLeglClientBufferEXT": {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"GLeglImageOES":        {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"GLDEBUGPROC":          {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"GLDEBUGPROCARB":       {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"GLDEBUGPROCAMD":       {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"GLDEBUGPROCKHR":       {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"GLVULKANPROCNV":       {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"struct _cl_context":   {"", "unsafe.Pointer", "unsafe.Pointer"},
+	"struct _cl_event":     {"", "unsafe.Pointer", "unsafe.Pointer"},
+	"char":                 {"int8", "*int8", "**int8"},
+	"int":                  {"int32", "*int32", "**int32"},
+	"unsigned int":         {"uint32", "*uint32", "**uint32"},
+	"unsigned long":        {"uint", "*uint", "**uint"},
+	"float":                {"float32", "*float32", "**float32"},
+	"int32_t":              {"int32", "*int32", "**int32"},
+	"int64_t":              {"int64", "*int64", "**int64"},
+
+	// EGL
+	"EGLBoolean":                 {"uint32", "*uint32", "**uint32"},
+	"EGLenum":                    {"uint32", "*uint32", "**uint32"},
+	"EGLint":                     {"int32", "*int32", "**int32"},
+	"EGLAttrib":                  {"int", "*int", "**int"},
+	"EGLAttribKHR":               {"int", "*int", "**int"},
+	"EGLTime":                    {"uint64", "*uint64", "**uint64"},
+	"EGLTimeKHR":                 {"uint64", "*uint64", "**uint64"},
+	"EGLTimeNV":                  {"uint64", "*uint64", "**uint64"},
+	"EGLuint64KHR":               {"uint64", "*uint64", "**uint64"},
+	"EGLuint64NV":                {"uint64", "*uint64", "**uint64"},
+	"EGLnsecsANDROID":            {"int64", "*int64", "**int64"},
+	"EGLsizeiANDROID":            {"int", "*int", "**int"},
+	"EGLNativeFileDescriptorKHR": {"int32", "*int32", "**int32"},
+	"EGLNativeDisplayType":       {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLNativeWindowType":        {"uintptr", "*uintptr", "**uintptr"},
+	"EGLNativePixmapType":        {"uintptr", "*uintptr", "**uintptr"},
+	"EGLDisplay":                 {"uintptr", "*uintptr", "**uintptr"}, // cgo maps EGLDisplay and EGLConfig to uintptr
+	"EGLConfig":                  {"uintptr", "*uintptr", "**uintptr"},
+	"EGLSurface":                 {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLContext":                 {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLClientBuffer":            {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLSync":                    {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLSyncKHR":                 {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLSyncNV":                  {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLImage":                   {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLImageKHR":                {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLStreamKHR":               {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLDeviceEXT":               {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLOutputLayerEXT":          {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLOutputPortEXT":           {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLLabelKHR":                {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLObjectKHR":               {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"EGLDEBUGPROCKHR":            {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"EGLSetBlobFuncANDROID":      {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"EGLGetBlobFuncANDROID":      {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"__eglMustCastToProperFunctionPointerType": {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"struct AHardwareBuffer":                   {"", "unsafe.Pointer", "unsafe.Pointer"},
+	"struct EGLClientPixmapHI":                 {"", "unsafe.Pointer", "unsafe.Pointer"},
+	"struct wl_buffer":                         {"", "unsafe.Pointer", "unsafe.Pointer"},
+	"struct wl_display":                        {"", "unsafe.Pointer", "unsafe.Pointer"},
+	"struct wl_resource":                       {"", "unsafe.Pointer", "unsafe.Pointer"},
+
+	// GLX. XIDs are unsigned long.
+	"Bool":                    {"int32", "*int32", "**int32"},
+	"Status":                  {"int32", "*int32", "**int32"},
+	"Display":                 {"", "unsafe.Pointer", "*unsafe.Pointer"},
+	"XVisualInfo":             {"", "unsafe.Pointer", "*unsafe.Pointer"},
+	"Window":                  {"uint", "*uint", "**uint"},
+	"Pixmap":                  {"uint", "*uint", "**uint"},
+	"Font":                    {"uint", "*uint", "**uint"},
+	"Colormap":                {"uint", "*uint", "**uint"},
+	"GLXContext":              {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"GLXFBConfig":             {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"GLXFBConfigSGIX":         {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"GLXDrawable":             {"uint", "*uint", "**uint"},
+	"GLXPixmap":               {"uint", "*uint", "**uint"},
+	"GLXWindow":               {"uint", "*uint", "**uint"},
+	"GLXPbuffer":              {"uint", "*uint", "**uint"},
+	"GLXPbufferSGIX":          {"uint", "*uint", "**uint"},
+	"GLXContextID":            {"uint", "*uint", "**uint"},
+	"GLXFBConfigID":           {"uint", "*uint", "**uint"},
+	"GLXFBConfigIDSGIX":       {"uint", "*uint", "**uint"},
+	"GLXVideoSourceSGIX":      {"uint", "*uint", "**uint"},
+	"GLXVideoCaptureDeviceNV": {"uint", "*uint", "**uint"},
+	"GLXVideoDeviceNV":        {"uint32", "*uint32", "**uint32"},
+	"GLXHyperpipeConfigSGIX":  {"", "unsafe.Pointer", "*unsafe.Pointer"},
+	"GLXHyperpipeNetworkSGIX": {"", "unsafe.Pointer", "*unsafe.Pointer"},
+	"__GLXextFuncPtr":         {"unsafe.Pointer", "unsafe.Pointer", "unsafe.Pointer"},
+	"DMbuffer":                {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"DMparams":                {"", "unsafe.Pointer", "*unsafe.Pointer"},
+	"VLServer":                {"unsafe.Pointer", "*unsafe.Pointer", "**unsafe.Pointer"},
+	"VLPath":                  {"int32", "*int32", "**int32"},
+	"VLNode":                  {"int32", "*int32", "**int32"},
+}
+
+// Type is the C type of a parameter or return value.
+//
+type Type struct {
+	Name    string
+	Ptr     int
+	Const   bool
+	Typedef string
+	Group   string // enum group from gl.xml, if any
+	Class   string // object class from gl.xml, if any
+	goType  string // Go type set by enumGroups, objectHandles or goTypes
+}
+
+// MkType returns the Type for the ptype name and raw C declaration of a
+// parameter or return value.
+//
+func MkType(name string, raw string) (Type, error) {
+	if name == "" {
+		// no ptype, e.g. "const char *", "unsigned int" or "struct _cl_context *"
+		var ws []string
+		for _, w := range strings.Fields(strings.Replace(raw, "*", " ", -1)) {
+			if w != "const" {
+				ws = append(ws, w)
+			}
+		}
+		name = strings.Join(ws, " ")
+		if name == "" {
+			name = "void"
+		}
+	} else if strings.Contains(raw, "struct") && !strings.HasPrefix(name, "struct ") {
+		name = "struct " + name
+	}
+	if _, ok := types[name]; !ok {
+		return Type{}, fmt.Errorf("invalid type %s", name)
+	}
+	return Type{
+		Name:  name,
+		Ptr:   strings.Count(raw, "*"),
+		Const: strings.Index(raw, "const") >= 0,
+	}, nil
+}
+
+func (t *Type) CName() string {
+	var sb strings.Builder
+	if t.Const {
+		sb.WriteString("const ")
+	}
+	sb.WriteString(t.Name)
+	if t.Ptr > 0 {
+		sb.WriteString(" *")
+		if t.Ptr > 1 {
+			if t.Const {
+				sb.WriteString("const*")
+			} else {
+				sb.WriteByte('*')
+			}
+		}
+	}
+	return sb.String()
+}
+
+func (t *Type) GoName(ret bool) string {
+	if ret && t.Name == "void" {
+		return ""
+	}
+	if t.goType != "" {
+		return t.goType
+	}
+	return types[t.Name][t.Ptr]
+}
+
+func (t *Type) CDecl(arg string) string {
+	if !t.Const {
+		return t.Name + " " + strings.Repeat("*", t.Ptr) + arg
+	}
+	if t.Ptr == 0 {
+		return "const " + t.Name + " " + arg
+	}
+	n := "const " + t.Name + " *"
+	if t.Ptr > 1 {
+		n += "const*"
+	}
+	return n + arg
+}
+
+func (t *Type) ToC(arg string) string {
+	gn := t.GoName(false)
+	switch gn {
+	case "bool":
+		return "boolToC(" + arg + ")"
+	case "uintptr":
+		// native window types are either integers or pointers depending on
+		// the platform.
+		if t.Name == "EGLNativeWindowType" || t.Name == "EGLNativePixmapType" {
+			return "*(*C." + t.Name + ")(unsafe.Pointer(&" + arg + "))"
+		}
+		return "C." + t.Name + "(" + arg + ")"
+	case "unsafe.Pointer", "*unsafe.Pointer":
+		if t.Name == "void" {
+			return arg
+		}
+		fallthrough
+	default:
+		cn := t.cgoName()
+		if t.Ptr > 0 {
+			return "(" + strings.Repeat("*", t.Ptr) + "C." + cn + ")(unsafe.Pointer(" + arg + "))"
+		}
+		return "C." + cn + "(" + arg + ")"
+	}
+}
+
+// Float returns true if the Go type of t is a floating point type or a Go type
+// mirroring a floating point C typedef.
+//
+func (t *Type) Float() bool {
+	switch types[t.Name][t.Ptr] {
+	case "float32", "float64":
+		return true
+	}
+	return false
+}
+
+// ToWord returns a Go expression converting arg to the uint64 stored in a
+// CommandBuffer. Floating point values are stored as their IEEE 754 binary
+// representation.
+//
+func (t *Type) ToWord(arg string) string {
+	gn := t.GoName(false)
+	if gn == "bool" {
+		return "uint64(boolToC(" + arg + "))"
+	}
+	switch bn := types[t.Name][t.Ptr]; bn {
+	case "float32", "float64":
+		if gn != bn {
+			// Go type mirroring a C typedef
+			arg = bn + "(" + arg + ")"
+		}
+		if bn == "float32" {
+			return "uint64(math.Float32bits(" + arg + "))"
+		}
+		return "math.Float64bits(" + arg + ")"
+	}
+	return "uint64(" + arg + ")"
+}
+
+// FromWord returns a C expression converting the uint64_t word stored by the Go
+// expression returned by ToWord back to t.
+//
+func (t *Type) FromWord(word string) string {
+	switch types[t.Name][t.Ptr] {
+	case "float32":
+		return "gogl_f32(" + word + ")"
+	case "float64":
+		return "gogl_f64(" + word + ")"
+	}
+	return "(" + t.Name + ")" + word
+}
+
+// cgoName returns the name of t as seen from Go by cgo, e.g. C.uint for
+// unsigned int.
+//
+func (t *Type) cgoName() string {
+	switch {
+	case strings.HasPrefix(t.Name, "struct "):
+		return "struct_" + t.Name[len("struct "):]
+	case strings.HasPrefix(t.Name, "unsigned "):
+		return "u" + t.Name[len("unsigned "):]
+	}
+	return t.Name
+}
+
+func (t *Type) ToGo(arg string) (string, error) {
+	gn := t.GoName(false)
+	switch gn {
+	case "":
+		return "", fmt.Errorf("cannot convert C type %s to a Go type", t.Name)
+	case "bool":
+		return arg + " != 0", nil
+	case "string":
+		return "C.GoString((*C.char)(unsafe.Pointer(" + arg + ")))", nil
+	}
+	if strings.IndexByte(gn, '*') >= 0 {
+		// C and Go element types may differ, e.g. GLXFBConfig * to
+		// *unsafe.Pointer or XID * (unsigned long) to *uint.
+		return "(" + gn + ")(unsafe.Pointer(" + arg + "))", nil
+	}
+	return gn + "(" + arg + ")", nil
+}
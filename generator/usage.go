@@ -0,0 +1,392 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// templateNames are the commands and enums used by the templates themselves.
+// They are never trimmed.
+//
+var templateNames = map[string][]string{
+	"gl":    {"glGetString", "GL_VERSION", "GL_EXTENSIONS"},
+	"gles2": {"GL_VERSION"},
+	"egl":   {"EGL_NO_DISPLAY", "EGL_VERSION", "EGL_EXTENSIONS"},
+	"glx":   {"glXGetProcAddress", "glXQueryVersion", "glXQueryExtensionsString"},
+}
+
+// usage is the set of identifiers used from the generated package, as found
+// by scanUsage. A usage with nil sets uses everything.
+//
+type usage struct {
+	names map[string]struct{} // package level identifiers, Go or C names
+	sels  map[string]struct{} // all selector names, for handle methods
+}
+
+func (u *usage) uses(names ...string) bool {
+	if u.names == nil {
+		return true
+	}
+	for _, n := range names {
+		if _, ok := u.names[n]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *usage) usesMethod(name string) bool {
+	if u.sels == nil {
+		return true
+	}
+	_, ok := u.sels[name]
+	return ok
+}
+
+// scanUsage collects the identifiers of the generated package used by the Go
+// files in cfg.Usage that import it. Non-generated Go and C files in
+// cfg.OutDir are scanned for any identifier, so that functions called from
+// custom code in the generated package are kept as well.
+//
+func scanUsage(cfg *Config) (*usage, error) {
+	u := &usage{names: make(map[string]struct{}), sels: make(map[string]struct{})}
+	out, err := filepath.Abs(cfg.OutDir)
+	if err != nil {
+		return nil, err
+	}
+	ip := importPath(out)
+	match := func(p string) bool {
+		if ip != "" {
+			return p == ip
+		}
+		return path.Base(p) == filepath.Base(out)
+	}
+
+	fset := token.NewFileSet()
+	n := 0
+	for _, pattern := range cfg.Usage {
+		dir, recursive := pattern, false
+		if pattern == "..." || strings.HasSuffix(pattern, "/...") {
+			dir, recursive = strings.TrimSuffix(strings.TrimSuffix(pattern, "..."), "/"), true
+			if dir == "" {
+				dir = "."
+			}
+		}
+		err = filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if fi.IsDir() {
+				if p == dir {
+					return nil
+				}
+				name := fi.Name()
+				if abs, _ := filepath.Abs(p); !recursive || abs == out ||
+					name[0] == '.' || name[0] == '_' || name == "testdata" || name == "vendor" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(p, ".go") {
+				return nil
+			}
+			ok, err := u.scanFile(fset, p, cfg.Package, match)
+			if ok {
+				n++
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if n == 0 {
+		cfg.logf("Warning: no Go files using the generated package in %s", strings.Join(cfg.Usage, ", "))
+	}
+	if err = u.scanCustom(out); err != nil {
+		return nil, err
+	}
+	cfg.logf("Found %d identifiers used in %d files", len(u.names), n)
+	return u, nil
+}
+
+// scanFile collects the identifiers used from the generated package in the Go
+// file p. It returns false if p does not import the generated package.
+//
+func (u *usage) scanFile(fset *token.FileSet, p string, pkg string, match func(string) bool) (bool, error) {
+	f, err := parser.ParseFile(fset, p, nil, 0)
+	if err != nil {
+		return false, err
+	}
+	name := ""
+	for _, is := range f.Imports {
+		ip, err := strconv.Unquote(is.Path.Value)
+		if err != nil || !match(ip) {
+			continue
+		}
+		name = pkg
+		if is.Name != nil {
+			name = is.Name.Name
+		}
+		break
+	}
+	if name == "" || name == "_" {
+		return false, nil
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			u.sels[n.Sel.Name] = struct{}{}
+			// Obj is nil for identifiers not declared in the file, like
+			// package names.
+			if x, ok := n.X.(*ast.Ident); ok && x.Name == name && x.Obj == nil {
+				u.names[n.Sel.Name] = struct{}{}
+			}
+		case *ast.Ident:
+			if name == "." && n.Obj == nil {
+				u.names[n.Name] = struct{}{}
+			}
+		}
+		return true
+	})
+	return true, nil
+}
+
+var identRe = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+// scanCustom collects all identifiers in the Go and C files in dir that were
+// not generated by gogl.
+//
+func (u *usage) scanCustom(dir string) error {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, fi := range fis {
+		switch filepath.Ext(fi.Name()) {
+		case ".go", ".c", ".h":
+		default:
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			return err
+		}
+		if l, _ := bufio.NewReader(bytes.NewReader(data)).ReadString('\n'); strings.Contains(l, "Code generated by gogl") {
+			continue
+		}
+		for _, id := range identRe.FindAll(data, -1) {
+			u.names[string(id)] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// importPath returns the import path of dir according to the go.mod file of
+// the enclosing module, or an empty string if dir is not in a module.
+//
+func importPath(dir string) string {
+	for d := dir; ; {
+		if data, err := ioutil.ReadFile(filepath.Join(d, "go.mod")); err == nil {
+			mod := modulePath(data)
+			if mod == "" {
+				return ""
+			}
+			rel, err := filepath.Rel(d, dir)
+			if err != nil {
+				return ""
+			}
+			return path.Join(mod, filepath.ToSlash(rel))
+		}
+		p := filepath.Dir(d)
+		if p == d {
+			return ""
+		}
+		d = p
+	}
+}
+
+func modulePath(mod []byte) string {
+	for _, l := range strings.Split(string(mod), "\n") {
+		f := strings.Fields(l)
+		if len(f) >= 2 && f[0] == "module" {
+			if p, err := strconv.Unquote(f[1]); err == nil {
+				return p
+			}
+			return f[1]
+		}
+	}
+	return ""
+}
+
+// trim removes the commands, enums, enum groups and handles not used by u,
+// taking cfg.Include and cfg.Exclude into account.
+//
+func (u *usage) trim(r *Registry, cfg *Config) {
+	required := make(map[string]bool)
+	for _, n := range templateNames[r.API] {
+		required[n] = true
+	}
+	if cfg.Debug || cfg.EnumNames {
+		required["glGetError"] = true
+	}
+	if cfg.DebugCallback {
+		// the C trampoline is always generated
+		for _, c := range debugHelpers["SetDebugCallback"] {
+			required[c] = true
+		}
+		for n, cmds := range debugHelpers {
+			if u.uses(n) {
+				for _, c := range cmds {
+					required[c] = true
+				}
+			}
+		}
+	}
+	if cfg.Queries {
+		// used by the state query variants to size their result
+		required["glGetIntegerv"] = true
+	}
+	if cfg.EnumNames {
+		// names of the values of Error
+		for _, e := range r.Enums {
+			for _, g := range e.Groups {
+				if g == "ErrorCode" {
+					required[e.Name] = true
+				}
+			}
+		}
+	}
+	keep := func(name string, used bool) bool {
+		switch {
+		case required[name]:
+			return true
+		case matchAny(cfg.Exclude, name):
+			return false
+		}
+		return used || matchAny(cfg.Include, name)
+	}
+
+	cmds := make(map[*Command]bool)
+	for _, c := range r.Commands {
+		gn := c.GoName()
+		sn := c.StringsName()
+		used := u.uses(c.Name, gn, gn+"Slice") ||
+			sn != "" && u.uses(sn) ||
+			c.Query != "" && u.uses(c.Query, c.QueriesName()) ||
+			cfg.CommandBuffer && c.Recordable() && u.usesMethod(gn) ||
+			cfg.Contexts && c.Version.Major > 0 && u.usesMethod(gn)
+		if keep(c.Name, used) {
+			cmds[c] = true
+		}
+	}
+	types := make(map[string]bool)
+	addTypes := func(c *Command) {
+		types[strings.TrimLeft(c.Type.goType, "*")] = true
+		for _, p := range c.Params {
+			types[strings.TrimLeft(p.Type.goType, "*")] = true
+		}
+	}
+	for c := range cmds {
+		addTypes(c)
+	}
+	// methods of used handle types
+	for _, h := range r.Handles {
+		if !types[h.GoName] && !u.uses(h.GoName) {
+			continue
+		}
+		for _, m := range h.Methods {
+			if !cmds[m.Cmd] && u.usesMethod(m.Name) && keep(m.Cmd.Name, true) {
+				cmds[m.Cmd] = true
+				addTypes(m.Cmd)
+			}
+		}
+	}
+
+	n := len(r.Commands)
+	r.Commands = filterCommands(r.Commands, cmds)
+	for _, e := range r.Extensions {
+		e.Commands = filterCommands(e.Commands, cmds)
+	}
+	handles := r.Handles[:0]
+	for _, h := range r.Handles {
+		if !types[h.GoName] && !u.uses(h.GoName) {
+			continue
+		}
+		ms := h.Methods[:0]
+		for _, m := range h.Methods {
+			if cmds[m.Cmd] {
+				ms = append(ms, m)
+			}
+		}
+		h.Methods = ms
+		handles = append(handles, h)
+	}
+	r.Handles = handles
+
+	enums := make(map[string]bool)
+	groups := r.Groups[:0]
+	for _, g := range r.Groups {
+		es := g.Enums[:0]
+		for _, e := range g.Enums {
+			if keep(e.Name, u.uses(g.GoName+"_"+e.GoName())) {
+				es = append(es, e)
+				enums[e.Name] = true
+			}
+		}
+		g.Enums = es
+		if len(es) > 0 || types[g.GoName] || u.uses(g.GoName) {
+			groups = append(groups, g)
+		}
+	}
+	r.Groups = groups
+	filterEnums := func(list []Enum) []Enum {
+		es := list[:0]
+		for _, e := range list {
+			if enums[e.Name] || keep(e.Name, u.uses(e.Name)) {
+				es = append(es, e)
+			}
+		}
+		return es
+	}
+	m := len(r.Enums)
+	r.Enums = filterEnums(r.Enums)
+	r.Pointers = filterEnums(r.Pointers)
+	cfg.logf("Trimmed %s: kept %d of %d commands and %d of %d enums", r.API, len(r.Commands), n, len(r.Enums), m)
+}
+
+func filterCommands(list []*Command, keep map[*Command]bool) []*Command {
+	cmds := list[:0]
+	for _, c := range list {
+		if keep[c] {
+			cmds = append(cmds, c)
+		}
+	}
+	return cmds
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -2,13 +2,15 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-package main
+package generator
 
 import (
 	"strconv"
 	"strings"
 )
 
+// Version is an API version. It implements flag.Value.
+//
 type Version struct {
 	Major int
 	Minor int
@@ -49,6 +51,18 @@ func (v *Version) Set(s string) error {
 	return nil
 }
 
+// MarshalText implements encoding.TextMarshaler.
+//
+func (v *Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+//
+func (v *Version) UnmarshalText(text []byte) error {
+	return v.Set(string(text))
+}
+
 // Less returns true if v < rhs
 func (v *Version) Less(rhs *Version) bool {
 	if v.Major == rhs.Major {
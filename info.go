@@ -0,0 +1,126 @@
+// Copyright 2019 Denis Bernard <db047h@gmail.com>
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/db47h/gogl/generator"
+)
+
+// runInfo implements the info command.
+//
+func runInfo(args []string) {
+	var (
+		cfg     generator.Config
+		verbose bool
+	)
+	fs := flag.NewFlagSet("info", flag.ExitOnError)
+	fs.StringVar(&cfg.API, "api", "gl", "`api` registry to search: gl (OpenGL and OpenGLES), egl or glx")
+	fs.BoolVar(&cfg.ForceUpdate, "f", false, "force update of the registry file")
+	fs.StringVar(&cfg.RegistryFile, "registry", "", "read the registry from `file` instead of the cache or network")
+	fs.BoolVar(&verbose, "v", false, "verbose output")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: gogl info [flags] pattern...\n\n"+
+			"Describes the commands and enums matching the given names (e.g. glTexStorage2D\n"+
+			"or TexStorage2D) or regular expressions (e.g. '^glTexStorage').\n\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if verbose {
+		cfg.Logger = log.New(os.Stderr, "", log.LstdFlags)
+	}
+
+	x, err := generator.LoadIndex(context.Background(), cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	w := bufio.NewWriter(os.Stdout)
+	n := 0
+	for _, p := range fs.Args() {
+		entries, err := x.Search(p)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(entries) == 0 {
+			log.Printf("no command or enum matching %s", p)
+		}
+		for _, e := range entries {
+			if n > 0 {
+				w.WriteByte('\n')
+			}
+			writeEntry(w, e)
+			n++
+		}
+	}
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+	if n == 0 {
+		os.Exit(1)
+	}
+}
+
+func writeEntry(w *bufio.Writer, e *generator.Entry) {
+	fmt.Fprintln(w, e.Name)
+	line := func(label, s string) {
+		fmt.Fprintf(w, "    %-13s%s\n", label, s)
+	}
+	if c := e.Command; c != nil {
+		line("C:", c.CProto())
+		line("Go:", c.GoSignature())
+		label := "Params:"
+		for _, p := range append([]generator.Param{{Name: "return", Type: c.Type}}, c.Params...) {
+			var info []string
+			if p.Type.Group != "" {
+				info = append(info, "group "+p.Type.Group)
+			}
+			if p.Type.Class != "" {
+				info = append(info, "class "+p.Type.Class)
+			}
+			if p.Len != "" {
+				info = append(info, "len "+p.Len)
+			}
+			if len(info) > 0 {
+				line(label, p.Name+" "+p.Type.CName()+", "+strings.Join(info, ", "))
+				label = ""
+			}
+		}
+	}
+	for _, en := range e.Enums {
+		v := en.Value
+		if t := en.GoType(); t != "" {
+			v += " (" + t + ")"
+		}
+		if en.API != "" {
+			v += " [" + en.API + "]"
+		}
+		line("Value:", v)
+		if len(en.Groups) > 0 {
+			line("Groups:", strings.Join(en.Groups, ", "))
+		}
+	}
+	label := "Required by:"
+	for _, r := range e.RequiredBy {
+		line(label, r.String())
+		label = ""
+	}
+	if len(e.RequiredBy) == 0 {
+		line(label, "none")
+	}
+	if len(e.Aliases) > 0 {
+		line("Aliases:", strings.Join(e.Aliases, ", "))
+	}
+}
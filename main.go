@@ -2,344 +2,136 @@
 // Use of this source code is governed by a MIT-style
 // license that can be found in the LICENSE file.
 
-//go:generate go-bindata templates
+//go:generate go-bindata -pkg generator -o generator/bindata.go -ignore README templates registry
 
 package main
 
 import (
-	"bytes"
-	"encoding/xml"
+	"context"
 	"flag"
-	"io/ioutil"
+	"fmt"
 	"log"
-	"net/http"
 	"os"
-	"path/filepath"
-	"sort"
 	"strings"
-	"text/template"
-	"time"
-)
 
-var (
-	api            = "gl"
-	version        Version
-	versionGLES    Version
-	coreProfile    bool
-	tags           string
-	pkgname        string
-	forceRegUpdate bool
-	verbose        bool
+	"github.com/db47h/gogl/generator"
 )
 
 func main() {
-	var out string
-
-	flag.Var(&version, "gl", "OpenGL api `version` (default: 3.1)")
-	// flag.Var(&version, "gles", "OpenGLES api `version` (default: 2.0)")
-	flag.BoolVar(&coreProfile, "core", false, "use OpenGL core profile")
-	flag.StringVar(&pkgname, "p", "gl", "package `name`")
-	flag.StringVar(&out, "o", "", "output `directory`")
-	flag.BoolVar(&forceRegUpdate, "f", false, "force update of gl.xml")
-	flag.BoolVar(&verbose, "v", false, "verbose output")
-
-	flag.Parse()
-
-	if version.Major == 0 && version.Minor == 0 {
-		version.Set("3.2")
-	}
-	if coreProfile && version.Less(&Version{Major: 3, Minor: 2}) {
-		coreProfile = false
-		if verbose {
-			log.Print("Warning: core profile only supported in OpenGL versions >= 3.2")
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "diff":
+			runDiff(os.Args[2:])
+			return
+		case "info", "search":
+			runInfo(os.Args[2:])
+			return
+		case "templates":
+			runTemplates(os.Args[2:])
+			return
 		}
 	}
-	if versionGLES.Major == 0 && versionGLES.Minor == 0 {
-		versionGLES.Set("2.0")
-	}
 
-	regXML, err := getRegistry(forceRegUpdate)
-	if err != nil {
-		panic(err)
-	}
-	if verbose {
-		log.Print("Parsing gl.xml (GL)")
-	}
-	r, err := decodeRegistry(bytes.NewReader(regXML))
-	if err != nil {
-		panic(err)
-	}
-
-	fmap := template.FuncMap{"ToUpper": strings.ToUpper, "NewVersion": NewVersion}
+	var (
+		cfg         generator.Config
+		coreProfile bool
+		verbose     bool
+	)
+
+	flag.StringVar(&cfg.API, "api", "gl", "`api` to generate: gl (OpenGL and OpenGLES), egl or glx")
+	flag.Var(&cfg.Version, "gl", "OpenGL api `version` (default: 3.2)")
+	flag.Var(&cfg.GLESVersion, "gles", "OpenGLES api `version` (default: 2.0)")
+	egl := flag.String("egl", "1.5", "EGL api `version`")
+	glx := flag.String("glx", "1.4", "GLX api `version`")
+	flag.StringVar(&cfg.Profile, "profile", "compatibility", "OpenGL `profile`: core or compatibility")
+	flag.BoolVar(&coreProfile, "core", false, "use OpenGL core profile (same as -profile core)")
+	flag.Var((*list)(&cfg.Extensions), "ext", "comma separated list of extension `names` to generate; may contain wildcards (e.g. GL_ARB_*)")
+	flag.Var((*list)(&cfg.Usage), "usage", "comma separated list of `directories` to scan for uses of the generated package (e.g. ./...); only the functions and constants used are generated")
+	flag.Var((*list)(&cfg.Include), "include", "comma separated list of function or constant `names` to generate even if unused; may contain wildcards")
+	flag.Var((*list)(&cfg.Exclude), "exclude", "comma separated list of function or constant `names` not to generate; may contain wildcards")
+	flag.BoolVar(&cfg.EnumTypes, "enumtypes", false, "generate Go types and typed constants for enum groups and use the types in function signatures; GL_* constants stay untyped, so only the typed constants are checked by the compiler")
+	flag.BoolVar(&cfg.HandleTypes, "handletypes", false, "generate Go types for object handles (textures, buffers, etc.) and use them in function signatures")
+	flag.BoolVar(&cfg.Slices, "slices", false, "generate slice based variants of functions taking pointers and counts")
+	flag.BoolVar(&cfg.Strings, "strings", false, "generate string based variants of functions taking GLchar pointers")
+	flag.BoolVar(&cfg.Queries, "queries", false, "generate value returning variants of query functions (e.g. GetInteger for GetIntegerv)")
+	flag.BoolVar(&cfg.EnumNames, "enumnames", false, "generate a reverse lookup table of constant names, String methods for enum types and the Error type")
+	flag.BoolVar(&cfg.Debug, "debug", false, "generate glGetError checks after each function call, enabled by the gogl_debug build tag")
+	flag.BoolVar(&cfg.Trace, "trace", false, "generate tracing hooks and call statistics, enabled by the gogl_trace build tag")
+	flag.BoolVar(&cfg.CommandBuffer, "cmdbuf", false, "generate the CommandBuffer type, executing recorded function calls in a single cgo call")
+	flag.BoolVar(&cfg.Contexts, "contexts", false, "generate versioned Context interfaces for type-switch capability checks")
+	flag.BoolVar(&cfg.MultiContext, "multicontext", false, "generate per-context function tables for applications using several contexts")
+	flag.BoolVar(&cfg.DebugCallback, "debugcallback", false, "generate SetDebugCallback, forwarding debug output to a Go function, and debug group and object label helpers")
+	flag.BoolVar(&cfg.GoTypes, "gotypes", false, "generate Go types mirroring C typedefs and use them for scalar parameters and return values, and use bool and string in place of GLboolean and string return values")
+	flag.StringVar(&cfg.Package, "p", "", "package `name` (default: same as api)")
+	flag.StringVar(&cfg.OutDir, "o", "", "output `directory`")
+	flag.BoolVar(&cfg.ForceUpdate, "f", false, "force update of the registry file")
+	flag.StringVar(&cfg.TemplateDir, "templates", "", "`directory` of templates overriding the built-in ones or generating extra files")
+	flag.StringVar(&cfg.RegistryFile, "registry", "", "read the registry (gl.xml, egl.xml or glx.xml) from `file` instead of the cache or network")
+	flag.BoolVar(&verbose, "v", false, "verbose output")
 
-	fname := "header.tmpl"
-	of := filepath.Join(out, "gl.h")
-	if verbose {
-		log.Printf("Generating %s", of)
-	}
-	asset, err := Asset("templates/" + fname)
-	if err != nil {
-		panic(err)
-	}
-	t, err := template.New(fname).Funcs(fmap).Parse(string(asset))
-	if err != nil {
-		panic(err)
-	}
-	o, err := os.Create(of)
-	if err != nil {
-		panic(err)
-	}
-	err = t.Execute(o, r)
-	o.Close()
-	if err != nil {
-		panic(err)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: gogl [flags]\n       gogl diff [flags] from to\n       gogl info [flags] pattern...\n       gogl templates [flags] dir\n\n")
+		flag.PrintDefaults()
 	}
+	flag.Parse()
 
-	fname = "gl.tmpl"
-	of = filepath.Join(out, "gl.go")
-	if verbose {
-		log.Printf("Generating %s", of)
+	if coreProfile {
+		cfg.Profile = "core"
 	}
-	asset, err = Asset("templates/" + fname)
-	if err != nil {
-		panic(err)
-	}
-	t, err = template.New(fname).Funcs(fmap).Parse(string(asset))
-	if err != nil {
-		panic(err)
-	}
-	o, err = os.Create(of)
-	if err != nil {
-		panic(err)
+	var err error
+	switch cfg.API {
+	case "egl":
+		err = cfg.Version.Set(*egl)
+	case "glx":
+		err = cfg.Version.Set(*glx)
 	}
-	r.Tags = "!gles2 darwin"
-	err = t.Execute(o, r)
-	o.Close()
 	if err != nil {
-		panic(err)
+		log.Fatal(err)
 	}
-
-	api = "gles2"
-	version = versionGLES
-	coreProfile = false
-	tags = "gles2,!darwin"
 	if verbose {
-		log.Print("Parsing gl.xml (GLES)")
-	}
-	r, err = decodeRegistry(bytes.NewReader(regXML))
-	if err != nil {
-		panic(err)
+		cfg.Logger = log.New(os.Stderr, "", log.LstdFlags)
 	}
 
-	fname = "gles2.tmpl"
-	of = filepath.Join(out, "gles2.go")
-	if verbose {
-		log.Printf("Generating %s", of)
-	}
-	asset, err = Asset("templates/" + fname)
-	if err != nil {
-		panic(err)
-	}
-	t, err = template.New(fname).Funcs(fmap).Parse(string(asset))
-	if err != nil {
-		panic(err)
-	}
-	o, err = os.Create(of)
-	if err != nil {
-		panic(err)
-	}
-	err = t.Execute(o, r)
-	o.Close()
-	if err != nil {
-		panic(err)
+	if err = generator.Generate(context.Background(), cfg); err != nil {
+		log.Fatal(err)
 	}
 }
 
-const regUrl = "https://raw.githubusercontent.com/KhronosGroup/OpenGL-Registry/master/xml/gl.xml"
-
-func getRegistry(forceFetch bool) ([]byte, error) {
-	c, err := os.UserCacheDir()
-	if err != nil {
-		return fetchRegistry()
-	}
-	// create gogl dir
-	c = filepath.Join(c, "gogl")
-	err = os.MkdirAll(c, 0777)
-	if err != nil && !os.IsExist(err) {
-		return fetchRegistry()
+// runTemplates implements the templates command.
+//
+func runTemplates(args []string) {
+	fs := flag.NewFlagSet("templates", flag.ExitOnError)
+	overwrite := fs.Bool("f", false, "overwrite existing files")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: gogl templates [flags] dir\n\n"+
+			"Writes the built-in templates to dir, for use with the -templates flag.\n\n")
+		fs.PrintDefaults()
 	}
-	c = filepath.Join(c, "gl.xml")
-	fi, err := os.Stat(c)
-	if err != nil && !os.IsNotExist(err) {
-		return fetchRegistry()
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
 	}
-	if !forceFetch && err == nil {
-		if verbose {
-			log.Printf("Using cached registry file %s; last updated: %v", c, fi.ModTime().Format(time.RFC1123))
-			log.Printf("Use the -f switch to force an update")
-		}
-		return ioutil.ReadFile(c)
+	if err := generator.ExportTemplates(fs.Arg(0), *overwrite); err != nil {
+		log.Fatal(err)
 	}
-	// write cache
-	data, err := fetchRegistry()
-	if err != nil {
-		panic(err)
-	}
-	ioutil.WriteFile(c, data, 0666)
-	return data, nil
 }
 
-func fetchRegistry() ([]byte, error) {
-	if verbose {
-		log.Printf("Fetching OpenGL registry from %s", regUrl)
-	}
-	resp, err := http.Get(regUrl)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	data, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return data, nil
-}
-
-type Command struct {
-	Type    Type
-	Name    string
-	Params  []Param
-	Version Version
-}
+// list is a flag.Value for comma separated lists of names.
+//
+type list []string
 
-type Param struct {
-	Type Type
-	Name string
+func (l *list) String() string {
+	return strings.Join(*l, ",")
 }
 
-func (c *Command) GoName() string {
-	if strings.HasPrefix(c.Name, "gl") {
-		return strings.ToUpper(c.Name[2:3]) + c.Name[3:]
-	}
-	return c.Name
-}
-
-func (c *Command) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
-	var xc struct {
-		Proto struct {
-			Type string `xml:"ptype"`
-			Ptr  string `xml:",chardata"`
-			Name string `xml:"name"`
-		} `xml:"proto"`
-		Params []struct {
-			Type string `xml:"ptype"`
-			Ptr  string `xml:",chardata"`
-			Name string `xml:"name"`
-		} `xml:"param"`
-	}
-	err := d.DecodeElement(&xc, &start)
-	if err != nil {
-		return err
-	}
-
-	c.Type = MkType(xc.Proto.Type, xc.Proto.Ptr)
-	c.Name = xc.Proto.Name
-	c.Params = make([]Param, 0, len(xc.Params))
-	for _, xp := range xc.Params {
-		if xp.Type == "" {
-			xp.Type = "void"
+func (l *list) Set(s string) error {
+	for _, p := range strings.Split(s, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
 		}
-		c.Params = append(c.Params, Param{
-			Name: paramName(xp.Name),
-			Type: MkType(xp.Type, xp.Ptr),
-		})
+		*l = append(*l, p)
 	}
 	return nil
 }
-
-var reserved = map[string]struct{}{
-	"int":        {},
-	"int8":       {},
-	"int16":      {},
-	"int32":      {},
-	"uint32":     {},
-	"uint":       {},
-	"uint8":      {},
-	"uint16":     {},
-	"int64":      {},
-	"uint64":     {},
-	"uintptr":    {},
-	"float32":    {},
-	"float64":    {},
-	"complex64":  {},
-	"complex128": {},
-	"string":     {},
-	"byte":       {},
-	"rune":       {},
-	"bool":       {},
-
-	"break":       {},
-	"default":     {},
-	"func":        {},
-	"interface":   {},
-	"select":      {},
-	"case":        {},
-	"defer":       {},
-	"go":          {},
-	"map":         {},
-	"struct":      {},
-	"chan":        {},
-	"else":        {},
-	"goto":        {},
-	"package":     {},
-	"switch":      {},
-	"const":       {},
-	"fallthrough": {},
-	"if":          {},
-	"range":       {},
-	"type":        {},
-	"continue":    {},
-	"for":         {},
-	"import":      {},
-	"return":      {},
-	"var":         {},
-}
-
-func paramName(n string) string {
-	if _, ok := reserved[n]; ok {
-		return n + "_"
-	}
-	return n
-}
-
-type Enum struct {
-	Name  string
-	Value string
-}
-
-func (e *Enum) GoName() string {
-	if strings.HasPrefix(e.Name, "GL_") {
-		return e.Name[3:]
-	}
-	return e.Name
-}
-
-func sortEnums(em map[string]string) []Enum {
-	enums := make([]Enum, 0, len(em))
-	for k, v := range em {
-		enums = append(enums, Enum{k, v})
-	}
-	sort.Slice(enums, func(i, j int) bool { return enums[i].Name < enums[j].Name })
-	return enums
-}
-
-func sortCommands(cm map[string]*Command) []*Command {
-	cmds := make([]*Command, 0, len(cm))
-	for _, v := range cm {
-		cmds = append(cmds, v)
-	}
-	sort.Slice(cmds, func(i, j int) bool {
-		return cmds[i].Version.Less(&cmds[j].Version) || (!cmds[j].Version.Less(&cmds[i].Version)) && cmds[i].Name < cmds[j].Name
-	})
-	return cmds
-}